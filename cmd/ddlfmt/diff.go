@@ -0,0 +1,157 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+const diffContext = 3
+
+// edit describes a line of diff, kind is one of ' ', '-' and '+', a and b describe the number
+// of lines before the edit in the old and the new content.
+type edit struct {
+	kind byte
+	text string
+	a, b int
+}
+
+// unifiedDiff returns the unified diff between the old content and the new content.
+func unifiedDiff(oldName, newName, oldContent, newContent string) string {
+	edits := lineDiff(splitLines(oldContent), splitLines(newContent))
+	var b strings.Builder
+	fmt.Fprintf(&b, "--- %s\n+++ %s\n", oldName, newName)
+	for i := 0; i < len(edits); {
+		if edits[i].kind == ' ' {
+			i++
+			continue
+		}
+
+		start := i - diffContext
+		if start < 0 {
+			start = 0
+		}
+
+		// the changes separated by less than two contexts are in the same hunk
+		end := i
+		for end < len(edits) {
+			if edits[end].kind != ' ' {
+				end++
+				continue
+			}
+
+			next := end
+			for next < len(edits) && edits[next].kind == ' ' {
+				next++
+			}
+			if next == len(edits) || next-end > 2*diffContext {
+				end += diffContext
+				if end > len(edits) {
+					end = len(edits)
+				}
+				break
+			}
+			end = next
+		}
+
+		writeHunk(&b, edits[start:end])
+		i = end
+	}
+
+	return b.String()
+}
+
+func writeHunk(b *strings.Builder, hunk []edit) {
+	var oldCount, newCount int
+	for _, e := range hunk {
+		if e.kind != '+' {
+			oldCount++
+		}
+		if e.kind != '-' {
+			newCount++
+		}
+	}
+
+	oldStart, newStart := hunk[0].a, hunk[0].b
+	if oldCount > 0 {
+		oldStart++
+	}
+	if newCount > 0 {
+		newStart++
+	}
+
+	fmt.Fprintf(b, "@@ -%d,%d +%d,%d @@\n", oldStart, oldCount, newStart, newCount)
+	for _, e := range hunk {
+		b.WriteByte(e.kind)
+		b.WriteString(e.text)
+		if !strings.HasSuffix(e.text, "\n") {
+			b.WriteString("\n\\ No newline at end of file\n")
+		}
+	}
+}
+
+// lineDiff returns the edits which transform the old lines into the new lines by the
+// longest common subsequence.
+func lineDiff(oldLines, newLines []string) []edit {
+	n, m := len(oldLines), len(newLines)
+	lcs := make([][]int, n+1)
+	for i := range lcs {
+		lcs[i] = make([]int, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			switch {
+			case oldLines[i] == newLines[j]:
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			case lcs[i+1][j] >= lcs[i][j+1]:
+				lcs[i][j] = lcs[i+1][j]
+			default:
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var ret []edit
+	i, j := 0, 0
+	for i < n || j < m {
+		switch {
+		case i < n && j < m && oldLines[i] == newLines[j]:
+			ret = append(ret, edit{kind: ' ', text: oldLines[i], a: i, b: j})
+			i++
+			j++
+		case j == m || i < n && lcs[i+1][j] >= lcs[i][j+1]:
+			ret = append(ret, edit{kind: '-', text: oldLines[i], a: i, b: j})
+			i++
+		default:
+			ret = append(ret, edit{kind: '+', text: newLines[j], a: i, b: j})
+			j++
+		}
+	}
+
+	return ret
+}
+
+// splitLines splits the content into lines, the line breaks are kept.
+func splitLines(content string) []string {
+	lines := strings.SplitAfter(content, "\n")
+	if len(lines) > 0 && len(lines[len(lines)-1]) == 0 {
+		lines = lines[:len(lines)-1]
+	}
+
+	return lines
+}
@@ -0,0 +1,34 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnifiedDiff(t *testing.T) {
+	oldContent := "a\nb\nc\nd\ne\nf\ng\nh\ni\nj\nk\nl"
+	newContent := "a\nB\nc\nd\ne\nf\ng\nh\ni\nj\nk\nl\nm\n"
+	assert.Equal(t, "--- a.sql.orig\n+++ a.sql\n"+
+		"@@ -1,5 +1,5 @@\n a\n-b\n+B\n c\n d\n e\n"+
+		"@@ -9,4 +9,5 @@\n i\n j\n k\n-l\n\\ No newline at end of file\n+l\n+m\n",
+		unifiedDiff("a.sql.orig", "a.sql", oldContent, newContent))
+	assert.Equal(t, "--- a.sql.orig\n+++ a.sql\n", unifiedDiff("a.sql.orig", "a.sql", "a\n", "a\n"))
+	assert.Equal(t, "--- a.sql.orig\n+++ a.sql\n@@ -0,0 +1,1 @@\n+a\n", unifiedDiff("a.sql.orig", "a.sql", "", "a\n"))
+}
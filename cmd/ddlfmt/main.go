@@ -0,0 +1,165 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+// ddlfmt formats the sql files like gofmt, the comments are retained.
+//
+// Usage:
+//
+//	ddlfmt [flags] [path ...]
+//
+// Without paths, it formats the standard input. Given a directory, it formats all .sql files
+// in the directory recursively. By default, ddlfmt prints the formatted sql to standard output.
+//
+// The flags are:
+//
+//	-d  print the diffs instead of the formatted sql
+//	-l  list the files whose formatting differs from ddlfmt's
+//	-w  write the formatted sql to the file instead of standard output
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/zeromicro/ddl-parser/format"
+)
+
+var (
+	list  = flag.Bool("l", false, "list files whose formatting differs from ddlfmt's")
+	write = flag.Bool("w", false, "write result to (source) file instead of stdout")
+	diff  = flag.Bool("d", false, "display diffs instead of rewriting files")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: ddlfmt [flags] [path ...]\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		if *write {
+			fmt.Fprintln(os.Stderr, "error: cannot use -w with standard input")
+			os.Exit(2)
+		}
+
+		if err := processFile("<standard input>", os.Stdin, os.Stdout); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(2)
+		}
+		return
+	}
+
+	exitCode := 0
+	for _, path := range flag.Args() {
+		if err := walk(path); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			exitCode = 2
+		}
+	}
+	os.Exit(exitCode)
+}
+
+func walk(path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+
+	if !info.IsDir() {
+		return processFile(path, nil, os.Stdout)
+	}
+
+	var errs []string
+	err = filepath.Walk(path, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || !strings.HasSuffix(info.Name(), ".sql") {
+			return nil
+		}
+
+		if err := processFile(path, nil, os.Stdout); err != nil {
+			errs = append(errs, err.Error())
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("%s", strings.Join(errs, "\n"))
+	}
+
+	return nil
+}
+
+func processFile(filename string, in io.Reader, out io.Writer) error {
+	if in == nil {
+		f, err := os.Open(filename)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		in = f
+	}
+
+	src, err := ioutil.ReadAll(in)
+	if err != nil {
+		return err
+	}
+
+	res, err := format.Source(filepath.Base(filename), src)
+	if err != nil {
+		return err
+	}
+
+	if bytes.Equal(src, res) {
+		if !*list && !*write && !*diff {
+			_, err = out.Write(res)
+		}
+		return err
+	}
+
+	if *list {
+		fmt.Fprintln(out, filename)
+	}
+	if *write {
+		info, err := os.Stat(filename)
+		if err != nil {
+			return err
+		}
+		if err = ioutil.WriteFile(filename, res, info.Mode().Perm()); err != nil {
+			return err
+		}
+	}
+	if *diff {
+		fmt.Fprint(out, unifiedDiff(filename+".orig", filename, string(src), string(res)))
+	}
+	if !*list && !*write && !*diff {
+		_, err = out.Write(res)
+	}
+
+	return err
+}
@@ -0,0 +1,242 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package compat
+
+import (
+	"math"
+	"strings"
+
+	"github.com/zeromicro/ddl-parser/diff"
+	"github.com/zeromicro/ddl-parser/lint"
+	"github.com/zeromicro/ddl-parser/parser"
+)
+
+type columnChange struct {
+	description string
+	reader      Impact
+	writer      Impact
+	reason      string
+}
+
+// checkColumn returns the changes of a modified column.
+func checkColumn(d *diff.TableDiff, c *diff.ColumnDiff) []*columnChange {
+	var ret []*columnChange
+	if c.DataTypeChanged() {
+		ret = append(ret, checkDataType(c.From.DataType, c.To.DataType)...)
+	}
+	if from, to := lint.ColumnCharset(d.From, c.From, "utf8mb4"), lint.ColumnCharset(d.To, c.To, "utf8mb4"); !strings.EqualFold(from, to) &&
+		isText(c.To.DataType) {
+		if lint.CharsetMaxBytes(to) < lint.CharsetMaxBytes(from) {
+			ret = append(ret, &columnChange{"character set changed to " + to, Compatible, Breaking, "the old writers may write the characters which can not be stored"})
+		} else {
+			ret = append(ret, &columnChange{"character set changed to " + to, Compatible, Compatible, "the characters written before can be stored"})
+		}
+	}
+
+	notNullFrom, notNullTo := d.From.IsNotNull(c.From), d.To.IsNotNull(c.To)
+	switch {
+	case !notNullFrom && notNullTo && !hasDefault(c.To):
+		ret = append(ret, &columnChange{"changed to NOT NULL", Compatible, Breaking, "the column has no default value, the old writers may write NULL or omit the column"})
+	case !notNullFrom && notNullTo:
+		ret = append(ret, &columnChange{"changed to NOT NULL", Compatible, Breaking, "the old writers may write NULL explicitly"})
+	case notNullFrom && !notNullTo:
+		ret = append(ret, &columnChange{"changed to NULL", Breaking, Compatible, "the old readers may not expect NULL"})
+	case notNullTo && hasDefault(c.From) && !hasDefault(c.To):
+		ret = append(ret, &columnChange{"default value dropped", Compatible, Breaking, "the column is NOT NULL, the old writers which omit the column fail"})
+	}
+
+	from, to := constraintOf(c.From), constraintOf(c.To)
+	if from.AutoIncrement && !to.AutoIncrement {
+		ret = append(ret, &columnChange{"AUTO_INCREMENT dropped", Compatible, Breaking, "the old writers which omit the column do not get generated values"})
+	}
+
+	if len(ret) == 0 {
+		ret = append(ret, &columnChange{"modified", Compatible, Compatible, "the default value, the comment or the attributes which do not affect the old version are changed"})
+	}
+
+	return ret
+}
+
+func constraintOf(column *parser.Column) *parser.ColumnConstraint {
+	if column.Constraint == nil {
+		return &parser.ColumnConstraint{}
+	}
+
+	return column.Constraint
+}
+
+// checkDataType returns the changes of data type, the narrowed types break the old writers
+// since the values written before may not be stored, the widened types break the old readers
+// since the new writers may write the values out of the range of old readers.
+func checkDataType(from, to parser.DataType) []*columnChange {
+	if from == nil || to == nil {
+		return []*columnChange{{"type changed", Breaking, Breaking, "the values are incompatible"}}
+	}
+
+	description := "type changed to " + defaultPrinter.DataType(to)
+	narrowed := &columnChange{description, Compatible, Breaking, "the old writers may write the values which can not be stored"}
+	widened := &columnChange{description, Breaking, Compatible, "the old readers may read the values out of their range"}
+	same := &columnChange{description, Compatible, Compatible, "the values are compatible"}
+
+	a, b := from.Type(), to.Type()
+	if a == b && from.Length() == to.Length() && from.Decimal() == to.Decimal() && from.Unsigned() == to.Unsigned() &&
+		len(missing(from.Value(), to.Value())) == 0 && len(missing(to.Value(), from.Value())) == 0 && diff.IsAppended(from, to) {
+		// the character set and the collation are checked by checkColumn
+		return nil
+	}
+
+	switch {
+	case a.IsInteger() && b.IsInteger(), isExactNumeric(a) && isExactNumeric(b):
+		if to.Decimal() < from.Decimal() {
+			return []*columnChange{{description, Compatible, Breaking, "the decimals written by the old writers are rounded"}}
+		}
+		return compareRange(numericRange(from), numericRange(to), narrowed, widened, same)
+	case a.IsFloatingPoint() && b.IsFloatingPoint():
+		return compareRange(floatRange(from), floatRange(to), narrowed, widened, same)
+	case a.IsEnumSet() && a == b:
+		var ret []*columnChange
+		if removed := missing(from.Value(), to.Value()); len(removed) > 0 {
+			ret = append(ret, &columnChange{"members removed: " + strings.Join(removed, ", "), Compatible, Breaking, "the old writers may write the removed members"})
+		}
+		if added := missing(to.Value(), from.Value()); len(added) > 0 {
+			ret = append(ret, &columnChange{"members added: " + strings.Join(added, ", "), Breaking, Compatible, "the old readers may read the members which they do not know"})
+		}
+		if len(ret) == 0 {
+			ret = append(ret, &columnChange{"members reordered", Breaking, Compatible, "the indexes and the sort order of members are changed, the old readers may depend on them"})
+		}
+		return ret
+	case a.IsString() && b.IsString(), a.IsBinary() && b.IsBinary():
+		// the longer strings do not break the old readers
+		widened.reader, widened.reason = Compatible, "the values written before can be stored"
+		return compareRange([2]float64{0, capacity(from)}, [2]float64{0, capacity(to)}, narrowed, widened, same)
+	case a.IsTemporal() && a == b:
+		if to.Length() < from.Length() {
+			return []*columnChange{{description, Compatible, Breaking, "the fractional seconds written by the old writers are rounded"}}
+		}
+		return []*columnChange{same}
+	}
+
+	return []*columnChange{{description, Breaking, Breaking, "the values of the old type and the new type are incompatible"}}
+}
+
+func compareRange(from, to [2]float64, narrowed, widened, same *columnChange) []*columnChange {
+	switch {
+	case to[0] > from[0] || to[1] < from[1]:
+		return []*columnChange{narrowed}
+	case to[0] < from[0] || to[1] > from[1]:
+		return []*columnChange{widened}
+	default:
+		return []*columnChange{same}
+	}
+}
+
+func isExactNumeric(kind parser.Kind) bool {
+	return kind.IsInteger() || kind.IsFixedPoint()
+}
+
+func isText(dataType parser.DataType) bool {
+	return dataType != nil && (dataType.Type().IsString() || dataType.Type().IsEnumSet())
+}
+
+// numericRange returns the range of integer or fixed-point type, the range of fixed-point type
+// is approximated by the digits of integer part.
+func numericRange(dataType parser.DataType) [2]float64 {
+	kind := dataType.Type()
+	if kind.IsFixedPoint() {
+		precision := dataType.Length()
+		if precision == 0 {
+			precision = 10
+		}
+		digits := math.Pow10(precision - dataType.Decimal())
+		return [2]float64{-digits, digits}
+	}
+
+	bits := integerBits(kind)
+	if dataType.Unsigned() || kind == parser.Serial {
+		return [2]float64{0, math.Ldexp(1, bits) - 1}
+	}
+
+	return [2]float64{-math.Ldexp(1, bits-1), math.Ldexp(1, bits-1) - 1}
+}
+
+func integerBits(kind parser.Kind) int {
+	switch kind {
+	case parser.TinyInt, parser.Int1, parser.Bool, parser.Boolean:
+		return 8
+	case parser.SmallInt, parser.Int2:
+		return 16
+	case parser.MediumInt, parser.MiddleInt, parser.Int3:
+		return 24
+	case parser.Int, parser.Integer, parser.Int4:
+		return 32
+	default:
+		return 64
+	}
+}
+
+func floatRange(dataType parser.DataType) [2]float64 {
+	switch dataType.Type() {
+	case parser.Float, parser.Float4:
+		if dataType.Length() <= 24 {
+			return [2]float64{-math.MaxFloat32, math.MaxFloat32}
+		}
+	}
+
+	return [2]float64{-math.MaxFloat64, math.MaxFloat64}
+}
+
+// capacity returns the maximum characters of string type or the maximum bytes of binary type.
+func capacity(dataType parser.DataType) float64 {
+	switch dataType.Type() {
+	case parser.TinyText, parser.TinyBlob:
+		return 1<<8 - 1
+	case parser.Text, parser.Blob:
+		if dataType.Length() > 0 {
+			return float64(dataType.Length())
+		}
+		return 1<<16 - 1
+	case parser.MediumText, parser.MediumBlob, parser.LongVarChar, parser.LongVarBinary:
+		return 1<<24 - 1
+	case parser.LongText, parser.LongBlob:
+		return 1<<32 - 1
+	}
+
+	if dataType.Length() == 0 {
+		return 1
+	}
+
+	return float64(dataType.Length())
+}
+
+// missing returns the values of a which are not in b.
+func missing(a, b []string) []string {
+	var ret []string
+	for _, e := range a {
+		found := false
+		for _, other := range b {
+			if e == other {
+				found = true
+				break
+			}
+		}
+		if !found {
+			ret = append(ret, e)
+		}
+	}
+
+	return ret
+}
@@ -0,0 +1,245 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+// Package compat checks whether the changes between two schemas are backward compatible with
+// the old version of application, during a rolling deployment the old version and the new
+// version share the database, so the old readers and writers must keep working after the
+// schema is migrated.
+package compat
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/zeromicro/ddl-parser/diff"
+	"github.com/zeromicro/ddl-parser/parser"
+	"github.com/zeromicro/ddl-parser/printer"
+)
+
+var defaultPrinter = printer.NewPrinter()
+
+// Impact describes whether a change breaks the old version of application.
+type Impact int
+
+const (
+	// Compatible describes the old version of application keeps working.
+	Compatible Impact = iota
+	// Breaking describes the old version of application may fail or misbehave.
+	Breaking
+)
+
+// String returns the name of impact, such as breaking.
+func (i Impact) String() string {
+	if i == Breaking {
+		return "breaking"
+	}
+
+	return "compatible"
+}
+
+// Change describes a change between two schemas and its impact on the old readers, which
+// query the tables, and the old writers, which insert and update the rows.
+type Change struct {
+	Table string
+	// Object describes the kind of changed object, such as table, column, index, foreign key
+	Object string
+	// Name describes the name of changed object, such as the name of column
+	Name string
+	// Description describes the change, such as dropped, renamed to nickname
+	Description string
+	Reader      Impact
+	Writer      Impact
+	// Reason describes why the change is compatible or breaking
+	Reason string
+}
+
+// Breaking returns true if the change breaks the old readers or the old writers.
+func (c *Change) Breaking() bool {
+	return c.Reader == Breaking || c.Writer == Breaking
+}
+
+// String returns the change in a line, such as
+// user.email: column dropped (readers: breaking, writers: breaking), the old readers select the column.
+func (c *Change) String() string {
+	name := c.Table
+	if len(c.Name) > 0 && c.Object != "table" {
+		name += "." + c.Name
+	}
+
+	return fmt.Sprintf("%s: %s %s (readers: %s, writers: %s), %s", name, c.Object, c.Description, c.Reader, c.Writer, c.Reason)
+}
+
+// Report describes the changes between two schemas.
+type Report struct {
+	Changes []*Change
+}
+
+// Compatible returns true if no change breaks the old version of application.
+func (r *Report) Compatible() bool {
+	return len(r.Breaking()) == 0
+}
+
+// Breaking returns the changes which break the old readers or the old writers.
+func (r *Report) Breaking() []*Change {
+	var ret []*Change
+	for _, e := range r.Changes {
+		if e.Breaking() {
+			ret = append(ret, e)
+		}
+	}
+
+	return ret
+}
+
+// Check compares the old schema from with the new schema to, and classifies the changes by
+// their impact on the old version of application. The options are passed to diff.Compare, the
+// renamed tables and columns are detected by default.
+func Check(from, to []*parser.Table, options ...diff.Option) *Report {
+	d := diff.Compare(from, to, options...)
+	var ret Report
+	for _, e := range d.AddedTables {
+		ret.Changes = append(ret.Changes, &Change{
+			Table:       e.Name,
+			Object:      "table",
+			Name:        e.Name,
+			Description: "added",
+			Reason:      "the old version does not use the table",
+		})
+	}
+	for _, e := range d.DroppedTables {
+		ret.Changes = append(ret.Changes, &Change{
+			Table:       e.Name,
+			Object:      "table",
+			Name:        e.Name,
+			Description: "dropped",
+			Reader:      Breaking,
+			Writer:      Breaking,
+			Reason:      "the old version still uses the table",
+		})
+	}
+	for _, e := range d.RenamedTables {
+		ret.Changes = append(ret.Changes, &Change{
+			Table:       e.From.Name,
+			Object:      "table",
+			Name:        e.From.Name,
+			Description: "renamed to " + e.To.Name,
+			Reader:      Breaking,
+			Writer:      Breaking,
+			Reason:      "the old version still uses the old name",
+		})
+		ret.Changes = append(ret.Changes, CheckTable(e)...)
+	}
+	for _, e := range d.ModifiedTables {
+		ret.Changes = append(ret.Changes, CheckTable(e)...)
+	}
+
+	return &ret
+}
+
+// CheckTable classifies the changes of a table by their impact on the old version of
+// application, the changes of table options are not included.
+func CheckTable(d *diff.TableDiff) []*Change {
+	var ret []*Change
+	add := func(object, name, description string, reader, writer Impact, reason string) {
+		ret = append(ret, &Change{
+			Table:       d.From.Name,
+			Object:      object,
+			Name:        name,
+			Description: description,
+			Reader:      reader,
+			Writer:      writer,
+			Reason:      reason,
+		})
+	}
+
+	for _, e := range d.DroppedColumns {
+		add("column", e.Name, "dropped", Breaking, Breaking, "the old readers select the column and the old writers write it")
+	}
+	for _, e := range d.RenamedColumns {
+		add("column", e.From.Name, "renamed to "+e.To.Name, Breaking, Breaking, "the old version still uses the old name")
+	}
+	for _, e := range d.AddedColumns {
+		if d.To.IsNotNull(e) && !hasDefault(e) {
+			add("column", e.Name, "added", Compatible, Breaking, "the column is NOT NULL without default value, the old writers do not write it")
+		} else {
+			add("column", e.Name, "added", Compatible, Compatible, "the old writers which do not write the column use its default value")
+		}
+	}
+	for _, e := range d.ModifiedColumns {
+		for _, c := range checkColumn(d, e) {
+			add("column", e.From.Name, c.description, c.reader, c.writer, c.reason)
+		}
+	}
+
+	for _, e := range d.AddedIndexes {
+		switch {
+		case e.Primary:
+			add("index", "PRIMARY", "added", Compatible, Breaking, "the old writers may write duplicate or NULL values")
+		case e.Unique:
+			add("index", indexName(e), "added", Compatible, Breaking, "the old writers may write duplicate values")
+		default:
+			add("index", indexName(e), "added", Compatible, Compatible, "the index does not change the results of queries")
+		}
+	}
+	for _, e := range d.ModifiedIndexes {
+		switch {
+		case e.To.Primary:
+			add("index", "PRIMARY", "modified", Compatible, Breaking, "the old writers may write duplicate values of the new primary key")
+		case e.To.Unique && !sameColumnNames(e.From, e.To) || e.To.Unique && !e.From.Unique:
+			add("index", indexName(e.To), "modified", Compatible, Breaking, "the old writers may write duplicate values of the new unique key")
+		default:
+			add("index", indexName(e.To), "modified", Compatible, Compatible, "the index does not reject the writes which are accepted before")
+		}
+	}
+	for _, e := range d.DroppedIndexes {
+		add("index", indexName(e), "dropped", Compatible, Compatible, "the old version does not depend on the index, the queries may be slower")
+	}
+
+	for _, e := range d.AddedForeignKeys {
+		add("foreign key", e.Name, "added", Compatible, Breaking, "the old writers may write the rows without references and delete the referenced rows")
+	}
+	for _, e := range d.DroppedForeignKeys {
+		add("foreign key", e.Name, "dropped", Compatible, Compatible, "the writes accepted before are still accepted")
+	}
+
+	return ret
+}
+
+func indexName(index *parser.Index) string {
+	if index.Primary {
+		return "PRIMARY"
+	}
+	if len(index.Name) > 0 {
+		return index.Name
+	}
+
+	return strings.Join(index.ColumnNames(), ",")
+}
+
+func sameColumnNames(a, b *parser.Index) bool {
+	return strings.EqualFold(strings.Join(a.ColumnNames(), ","), strings.Join(b.ColumnNames(), ","))
+}
+
+// hasDefault returns true if the column has a default value or an implicit value, such as
+// AUTO_INCREMENT.
+func hasDefault(column *parser.Column) bool {
+	if column.DataType != nil && column.DataType.Type() == parser.Serial {
+		return true
+	}
+
+	c := column.Constraint
+	return c != nil && (c.HasDefaultValue || c.AutoIncrement || c.SerialDefaultValue)
+}
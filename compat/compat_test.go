@@ -0,0 +1,128 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package compat
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zeromicro/ddl-parser/internal/testutil"
+)
+
+func TestCheck(t *testing.T) {
+	from := testutil.Tables(t, "CREATE TABLE `user` (\n"+
+		"  `id` BIGINT NOT NULL AUTO_INCREMENT,\n"+
+		"  `name` VARCHAR(64) NOT NULL DEFAULT '',\n"+
+		"  `nick` VARCHAR(64),\n"+
+		"  `email` VARCHAR(255),\n"+
+		"  `age` TINYINT UNSIGNED NOT NULL DEFAULT 0,\n"+
+		"  `score` INT NOT NULL DEFAULT 0,\n"+
+		"  `status` ENUM('on','off') NOT NULL DEFAULT 'on',\n"+
+		"  `level` ENUM('a','b') NOT NULL DEFAULT 'a',\n"+
+		"  `bio` TEXT,\n"+
+		"  `balance` DECIMAL(10,2),\n"+
+		"  `legacy` INT,\n"+
+		"  PRIMARY KEY (`id`),\n"+
+		"  KEY `idx_email` (`email`)\n"+
+		");\n"+
+		"CREATE TABLE `log` (`id` BIGINT NOT NULL);\n"+
+		"CREATE TABLE `tag` (`id` BIGINT NOT NULL, `name` VARCHAR(32));")
+	to := testutil.Tables(t, "CREATE TABLE `user` (\n"+
+		"  `id` BIGINT NOT NULL AUTO_INCREMENT,\n"+
+		"  `name` VARCHAR(32) NOT NULL DEFAULT '',\n"+
+		"  `nick` VARCHAR(64) NOT NULL,\n"+
+		"  `email` VARCHAR(255) COMMENT 'email',\n"+
+		"  `age` SMALLINT UNSIGNED NOT NULL DEFAULT 0,\n"+
+		"  `score` INT,\n"+
+		"  `status` ENUM('on') NOT NULL DEFAULT 'on',\n"+
+		"  `level` ENUM('a','b','c') NOT NULL DEFAULT 'a',\n"+
+		"  `bio` TEXT CHARACTER SET latin1,\n"+
+		"  `balance` DECIMAL(10,1),\n"+
+		"  `legacy` VARCHAR(16),\n"+
+		"  `org_id` BIGINT NOT NULL,\n"+
+		"  `phone` VARCHAR(16),\n"+
+		"  PRIMARY KEY (`id`),\n"+
+		"  UNIQUE KEY `uk_email` (`email`)\n"+
+		");\n"+
+		"CREATE TABLE `tags` (`id` BIGINT NOT NULL, `name` VARCHAR(32));\n"+
+		"CREATE TABLE `team` (`id` BIGINT NOT NULL, `name` VARCHAR(32));")
+
+	report := Check(from, to)
+	var list []string
+	for _, e := range report.Changes {
+		list = append(list, e.String())
+	}
+	assert.Equal(t, []string{
+		"team: table added (readers: compatible, writers: compatible), the old version does not use the table",
+		"log: table dropped (readers: breaking, writers: breaking), the old version still uses the table",
+		"tag: table renamed to tags (readers: breaking, writers: breaking), the old version still uses the old name",
+		"user.org_id: column added (readers: compatible, writers: breaking), the column is NOT NULL without default value, the old writers do not write it",
+		"user.phone: column added (readers: compatible, writers: compatible), the old writers which do not write the column use its default value",
+		"user.name: column type changed to VARCHAR(32) (readers: compatible, writers: breaking), the old writers may write the values which can not be stored",
+		"user.nick: column changed to NOT NULL (readers: compatible, writers: breaking), the column has no default value, the old writers may write NULL or omit the column",
+		"user.email: column modified (readers: compatible, writers: compatible), the default value, the comment or the attributes which do not affect the old version are changed",
+		"user.age: column type changed to SMALLINT UNSIGNED (readers: breaking, writers: compatible), the old readers may read the values out of their range",
+		"user.score: column changed to NULL (readers: breaking, writers: compatible), the old readers may not expect NULL",
+		"user.status: column members removed: off (readers: compatible, writers: breaking), the old writers may write the removed members",
+		"user.level: column members added: c (readers: breaking, writers: compatible), the old readers may read the members which they do not know",
+		"user.bio: column character set changed to latin1 (readers: compatible, writers: breaking), the old writers may write the characters which can not be stored",
+		"user.balance: column type changed to DECIMAL(10,1) (readers: compatible, writers: breaking), the decimals written by the old writers are rounded",
+		"user.legacy: column type changed to VARCHAR(16) (readers: breaking, writers: breaking), the values of the old type and the new type are incompatible",
+		"user.uk_email: index added (readers: compatible, writers: breaking), the old writers may write duplicate values",
+		"user.idx_email: index dropped (readers: compatible, writers: compatible), the old version does not depend on the index, the queries may be slower",
+	}, list)
+	assert.False(t, report.Compatible())
+	assert.Len(t, report.Breaking(), 13)
+}
+
+func TestCheck_compatible(t *testing.T) {
+	from := testutil.Tables(t, "CREATE TABLE `user` (`id` BIGINT NOT NULL, `name` VARCHAR(32), `age` INT, PRIMARY KEY (`id`));")
+	to := testutil.Tables(t, "CREATE TABLE `user` (\n"+
+		"  `id` BIGINT NOT NULL,\n"+
+		"  `name` VARCHAR(64) NOT NULL DEFAULT '',\n"+
+		"  `age` INT DEFAULT 18,\n"+
+		"  `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,\n"+
+		"  PRIMARY KEY (`id`),\n"+
+		"  KEY `idx_name` (`name`)\n"+
+		");")
+
+	report := Check(from, to)
+	for _, e := range report.Breaking() {
+		t.Log(e)
+	}
+	assert.Len(t, report.Changes, 5)
+	assert.Len(t, report.Breaking(), 1)
+	assert.Equal(t, "changed to NOT NULL", report.Breaking()[0].Description)
+	assert.Equal(t, "the old writers may write NULL explicitly", report.Breaking()[0].Reason)
+}
+
+func TestCheck_reordered(t *testing.T) {
+	from := testutil.Tables(t, "CREATE TABLE `user` (`status` ENUM('on','off') NOT NULL DEFAULT 'on');")
+	to := testutil.Tables(t, "CREATE TABLE `user` (`status` ENUM('off','on') NOT NULL DEFAULT 'on');")
+
+	report := Check(from, to)
+	if assert.Len(t, report.Changes, 1) {
+		assert.Equal(t, "members reordered", report.Changes[0].Description)
+		assert.Equal(t, Breaking, report.Changes[0].Reader)
+		assert.Equal(t, Compatible, report.Changes[0].Writer)
+	}
+}
+
+func TestImpact_String(t *testing.T) {
+	assert.Equal(t, "compatible", Compatible.String())
+	assert.Equal(t, "breaking", Breaking.String())
+}
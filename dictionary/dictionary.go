@@ -0,0 +1,183 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+// Package dictionary generates the data dictionary of parsed tables as a Markdown document or a
+// standalone HTML document, each table is listed with its comment, options, columns, indexes
+// and foreign keys, and a table of contents links to the tables.
+package dictionary
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/zeromicro/ddl-parser/parser"
+	"github.com/zeromicro/ddl-parser/printer"
+)
+
+// Generator generates the data dictionary, you can use NewGenerator to create an instance with
+// options, WithTitle option sets the title of document.
+type Generator struct {
+	title   string
+	printer *printer.Printer
+}
+
+// Option is the alias of function.
+type Option func(g *Generator)
+
+// NewGenerator creates an instance of Generator.
+func NewGenerator(options ...Option) *Generator {
+	g := &Generator{
+		title:   "Data Dictionary",
+		printer: printer.NewPrinter(),
+	}
+	for _, opt := range options {
+		opt(g)
+	}
+
+	return g
+}
+
+// WithTitle is a Generator option to set the title of document, the default title is
+// Data Dictionary.
+func WithTitle(title string) Option {
+	return func(g *Generator) {
+		g.title = title
+	}
+}
+
+// section describes the rows of a table in the document, such as the columns of a table.
+type section struct {
+	title  string
+	header []string
+	rows   [][]string
+}
+
+// sections returns the options, columns, indexes and foreign keys of table, the sections without
+// rows are omitted.
+func (g *Generator) sections(table *parser.Table) []*section {
+	options := section{title: "Options", header: []string{"Name", "Value"}}
+	for _, e := range []string{parser.TableOptionEngine, parser.TableOptionCharset, parser.TableOptionCollate} {
+		if value := table.Option(e); len(value) > 0 {
+			options.rows = append(options.rows, []string{e, value})
+		}
+	}
+
+	columns := section{title: "Columns", header: []string{"#", "Name", "Type", "Nullable", "Default", "Comment"}}
+	for i, e := range table.Columns {
+		var dataType string
+		if e.DataType != nil {
+			dataType = g.printer.DataType(e.DataType)
+		}
+
+		c := e.Constraint
+		if c == nil {
+			c = &parser.ColumnConstraint{}
+		}
+		nullable := "YES"
+		if table.IsNotNull(e) {
+			nullable = "NO"
+		}
+		var defaultValue string
+		if c.HasDefaultValue {
+			defaultValue = c.DefaultValue
+		}
+		if c.AutoIncrement {
+			defaultValue = strings.TrimSpace(defaultValue + " AUTO_INCREMENT")
+		}
+
+		columns.rows = append(columns.rows, []string{
+			fmt.Sprint(i + 1), e.Name, dataType, nullable, defaultValue, parser.Unescape(c.Comment),
+		})
+	}
+
+	indexes := section{title: "Indexes", header: []string{"Name", "Type", "Columns", "Comment"}}
+	allIndexes := table.AllIndexes()
+	names := parser.IndexNames(allIndexes)
+	for i, e := range allIndexes {
+		indexes.rows = append(indexes.rows, []string{names[i], indexType(e), indexColumns(e), parser.Unescape(e.Comment)})
+	}
+
+	foreignKeys := section{title: "Foreign Keys", header: []string{"Name", "Columns", "References", "On Delete", "On Update"}}
+	for _, e := range table.ForeignKeys() {
+		reference := e.ReferenceTable
+		if len(e.ReferenceSchema) > 0 {
+			reference = e.ReferenceSchema + "." + reference
+		}
+		foreignKeys.rows = append(foreignKeys.rows, []string{
+			e.Name,
+			strings.Join(e.Columns, ", "),
+			reference + " (" + strings.Join(e.ReferenceColumns, ", ") + ")",
+			e.OnDelete,
+			e.OnUpdate,
+		})
+	}
+
+	var ret []*section
+	for _, e := range []*section{&options, &columns, &indexes, &foreignKeys} {
+		if len(e.rows) > 0 {
+			ret = append(ret, e)
+		}
+	}
+
+	return ret
+}
+
+func indexType(index *parser.Index) string {
+	switch {
+	case index.Primary:
+		return "PRIMARY KEY"
+	case index.Unique:
+		return "UNIQUE"
+	case index.Fulltext:
+		return "FULLTEXT"
+	case index.Spatial:
+		return "SPATIAL"
+	}
+
+	return "INDEX"
+}
+
+func indexColumns(index *parser.Index) string {
+	var list []string
+	for _, e := range index.Columns {
+		column := e.Name
+		if e.Length > 0 {
+			column += fmt.Sprintf("(%d)", e.Length)
+		}
+		if e.Desc {
+			column += " DESC"
+		}
+		list = append(list, column)
+	}
+
+	return strings.Join(list, ", ")
+}
+
+// anchor returns the anchor of heading in the way of GitHub, such as user_info for user_info,
+// order-item for Order Item.
+func anchor(heading string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(heading) {
+		switch {
+		case r == ' ':
+			b.WriteRune('-')
+		case r == '-' || r == '_' || r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r > 127:
+			b.WriteRune(r)
+		}
+	}
+
+	return b.String()
+}
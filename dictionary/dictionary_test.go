@@ -0,0 +1,117 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package dictionary
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zeromicro/ddl-parser/internal/testutil"
+)
+
+const schemaSql = "CREATE TABLE `org` (\n" +
+	"  `id` bigint NOT NULL,\n" +
+	"  PRIMARY KEY (`id`)\n" +
+	");\n" +
+	"CREATE TABLE `user` (\n" +
+	"  `id` bigint unsigned NOT NULL AUTO_INCREMENT,\n" +
+	"  `name` varchar(64) NOT NULL DEFAULT '' COMMENT 'name|nick',\n" +
+	"  `org_id` bigint,\n" +
+	"  PRIMARY KEY (`id`),\n" +
+	"  KEY `idx_name` (`name`(16), `org_id` DESC) COMMENT 'search',\n" +
+	"  CONSTRAINT `fk_org` FOREIGN KEY (`org_id`) REFERENCES `org` (`id`) ON DELETE CASCADE\n" +
+	") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='users <all>';"
+
+func TestGenerator_Markdown(t *testing.T) {
+	actual := NewGenerator(WithTitle("Shop")).Markdown(testutil.Tables(t, schemaSql)...)
+	assert.Equal(t, `# Shop
+
+## Table of Contents
+
+- [org](#org)
+- [user](#user): users &lt;all&gt;
+
+## org
+
+### Columns
+
+| # | Name | Type | Nullable | Default | Comment |
+| --- | --- | --- | --- | --- | --- |
+| 1 | id | BIGINT | NO |  |  |
+
+### Indexes
+
+| Name | Type | Columns | Comment |
+| --- | --- | --- | --- |
+| PRIMARY | PRIMARY KEY | id |  |
+
+## user
+
+users &lt;all&gt;
+
+### Options
+
+| Name | Value |
+| --- | --- |
+| ENGINE | InnoDB |
+| CHARSET | utf8mb4 |
+
+### Columns
+
+| # | Name | Type | Nullable | Default | Comment |
+| --- | --- | --- | --- | --- | --- |
+| 1 | id | BIGINT UNSIGNED | NO | AUTO\_INCREMENT |  |
+| 2 | name | VARCHAR(64) | NO | '' | name\|nick |
+| 3 | org\_id | BIGINT | YES |  |  |
+
+### Indexes
+
+| Name | Type | Columns | Comment |
+| --- | --- | --- | --- |
+| PRIMARY | PRIMARY KEY | id |  |
+| idx\_name | INDEX | name(16), org\_id DESC | search |
+
+### Foreign Keys
+
+| Name | Columns | References | On Delete | On Update |
+| --- | --- | --- | --- | --- |
+| fk\_org | org\_id | org (id) | CASCADE |  |
+`, actual)
+}
+
+func TestGenerator_HTML(t *testing.T) {
+	actual := NewGenerator().HTML(testutil.Tables(t, schemaSql)...)
+	assert.Contains(t, actual, "<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n<title>Data Dictionary</title>\n")
+	assert.Contains(t, actual, "<li><a href=\"#user\">user</a>: users &lt;all&gt;</li>\n")
+	assert.Contains(t, actual, "<h2 id=\"user\">user</h2>\n<p>users &lt;all&gt;</p>\n<h3>Options</h3>\n")
+	assert.Contains(t, actual, "<tr><td>2</td><td>name</td><td>VARCHAR(64)</td><td>NO</td><td>&#39;&#39;</td><td>name|nick</td></tr>\n")
+	assert.Contains(t, actual, "</table>\n</body>\n</html>\n")
+}
+
+func TestGenerator_indexNames(t *testing.T) {
+	table := testutil.Table(t, "CREATE TABLE `t` (`a` int, `b` int, KEY (`a`), KEY (`a`, `b`), FULLTEXT (`b`));")
+	actual := NewGenerator().Markdown(table)
+	assert.Contains(t, string(actual), "| a | INDEX | a |  |\n"+
+		"| a\\_2 | INDEX | a, b |  |\n"+
+		"| b | FULLTEXT | b |  |\n")
+}
+
+func Test_anchor(t *testing.T) {
+	assert.Equal(t, "user_info", anchor("user_info"))
+	assert.Equal(t, "order-item", anchor("Order Item"))
+	assert.Equal(t, "a-b", anchor("a.-b"))
+}
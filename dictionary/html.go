@@ -0,0 +1,82 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package dictionary
+
+import (
+	"html"
+	"strings"
+
+	"github.com/zeromicro/ddl-parser/parser"
+)
+
+const style = `body { font-family: sans-serif; margin: 2em; }
+table { border-collapse: collapse; margin-bottom: 1em; }
+th, td { border: 1px solid #ccc; padding: 4px 8px; text-align: left; vertical-align: top; }
+th { background: #f0f0f0; }`
+
+// HTML returns the data dictionary of tables as a standalone HTML document.
+func (g *Generator) HTML(tables ...*parser.Table) string {
+	var b strings.Builder
+	b.WriteString("<!DOCTYPE html>\n")
+	b.WriteString("<html>\n<head>\n<meta charset=\"utf-8\">\n")
+	b.WriteString("<title>" + html.EscapeString(g.title) + "</title>\n")
+	b.WriteString("<style>\n" + style + "\n</style>\n")
+	b.WriteString("</head>\n<body>\n")
+	b.WriteString("<h1>" + html.EscapeString(g.title) + "</h1>\n")
+
+	b.WriteString("<h2>Table of Contents</h2>\n<ul>\n")
+	for _, e := range tables {
+		b.WriteString("<li><a href=\"#" + html.EscapeString(anchor(e.Name)) + "\">" + html.EscapeString(e.Name) + "</a>")
+		if comment := parser.Unescape(e.Option(parser.TableOptionComment)); len(comment) > 0 {
+			b.WriteString(": " + htmlText(comment))
+		}
+		b.WriteString("</li>\n")
+	}
+	b.WriteString("</ul>\n")
+
+	for _, table := range tables {
+		b.WriteString("<h2 id=\"" + html.EscapeString(anchor(table.Name)) + "\">" + html.EscapeString(table.Name) + "</h2>\n")
+		if comment := parser.Unescape(table.Option(parser.TableOptionComment)); len(comment) > 0 {
+			b.WriteString("<p>" + htmlText(comment) + "</p>\n")
+		}
+
+		for _, e := range g.sections(table) {
+			b.WriteString("<h3>" + e.title + "</h3>\n<table>\n")
+			writeHTMLRow(&b, "th", e.header)
+			for _, row := range e.rows {
+				writeHTMLRow(&b, "td", row)
+			}
+			b.WriteString("</table>\n")
+		}
+	}
+	b.WriteString("</body>\n</html>\n")
+
+	return b.String()
+}
+
+func writeHTMLRow(b *strings.Builder, tag string, cells []string) {
+	b.WriteString("<tr>")
+	for _, e := range cells {
+		b.WriteString("<" + tag + ">" + htmlText(e) + "</" + tag + ">")
+	}
+	b.WriteString("</tr>\n")
+}
+
+// htmlText escapes the text, the line breaks are replaced with <br>.
+func htmlText(s string) string {
+	return strings.ReplaceAll(html.EscapeString(s), "\n", "<br>")
+}
@@ -0,0 +1,76 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package dictionary
+
+import (
+	"strings"
+
+	"github.com/zeromicro/ddl-parser/parser"
+)
+
+// Markdown returns the data dictionary of tables as a Markdown document.
+func (g *Generator) Markdown(tables ...*parser.Table) string {
+	var b strings.Builder
+	b.WriteString("# " + g.title + "\n\n")
+	b.WriteString("## Table of Contents\n\n")
+	for _, e := range tables {
+		b.WriteString("- [" + markdownText(e.Name) + "](#" + anchor(e.Name) + ")")
+		if comment := parser.Unescape(e.Option(parser.TableOptionComment)); len(comment) > 0 {
+			b.WriteString(": " + markdownText(comment))
+		}
+		b.WriteString("\n")
+	}
+
+	for _, table := range tables {
+		b.WriteString("\n## " + markdownText(table.Name) + "\n")
+		if comment := parser.Unescape(table.Option(parser.TableOptionComment)); len(comment) > 0 {
+			b.WriteString("\n" + markdownText(comment) + "\n")
+		}
+
+		for _, e := range g.sections(table) {
+			b.WriteString("\n### " + e.title + "\n\n")
+			writeMarkdownRow(&b, e.header)
+			separator := make([]string, len(e.header))
+			for i := range separator {
+				separator[i] = "---"
+			}
+			writeMarkdownRow(&b, separator)
+			for _, row := range e.rows {
+				writeMarkdownRow(&b, row)
+			}
+		}
+	}
+
+	return b.String()
+}
+
+func writeMarkdownRow(b *strings.Builder, cells []string) {
+	var list []string
+	for _, e := range cells {
+		list = append(list, markdownText(e))
+	}
+	b.WriteString("| " + strings.Join(list, " | ") + " |\n")
+}
+
+var markdownReplacer = strings.NewReplacer(`\`, `\\`, "|", `\|`, "*", `\*`, "_", `\_`, "`", "\\`",
+	"<", "&lt;", ">", "&gt;", "\r\n", "<br>", "\n", "<br>")
+
+// markdownText escapes the characters which are interpreted by Markdown, the line breaks are
+// replaced with <br> so the text can be used in the cells of table.
+func markdownText(s string) string {
+	return markdownReplacer.Replace(s)
+}
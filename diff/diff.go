@@ -0,0 +1,373 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+// Package diff compares two sets of tables, such as the tables parsed from two revisions
+// of schema.sql, and returns the structured changes between them.
+package diff
+
+import (
+	"strings"
+
+	"github.com/zeromicro/ddl-parser/parser"
+)
+
+// SchemaDiff describes the changes between two sets of tables.
+type SchemaDiff struct {
+	AddedTables   []*parser.Table
+	DroppedTables []*parser.Table
+	// RenamedTables describes the tables which are renamed, a renamed table may have
+	// other changes too
+	RenamedTables []*TableDiff
+	// ModifiedTables describes the tables which have the same name but different definitions
+	ModifiedTables []*TableDiff
+}
+
+// TableDiff describes the changes between two definitions of a table.
+type TableDiff struct {
+	From               *parser.Table
+	To                 *parser.Table
+	AddedColumns       []*parser.Column
+	DroppedColumns     []*parser.Column
+	RenamedColumns     []*ColumnDiff
+	ModifiedColumns    []*ColumnDiff
+	AddedIndexes       []*parser.Index
+	DroppedIndexes     []*parser.Index
+	ModifiedIndexes    []*IndexDiff
+	AddedForeignKeys   []*parser.ForeignKey
+	DroppedForeignKeys []*parser.ForeignKey
+	ModifiedOptions    []*OptionDiff
+}
+
+// ColumnDiff describes the changes between two definitions of a column.
+type ColumnDiff struct {
+	From *parser.Column
+	To   *parser.Column
+}
+
+// IndexDiff describes the changes between two definitions of an index.
+type IndexDiff struct {
+	From *parser.Index
+	To   *parser.Index
+}
+
+// OptionDiff describes the changes of a table option, From is empty if the option is added,
+// To is empty if the option is removed.
+type OptionDiff struct {
+	Name string
+	From string
+	To   string
+}
+
+// Option is the alias of function.
+type Option func(d *differ)
+
+type differ struct {
+	detectRename bool
+}
+
+// WithRenameDetection is an option to detect the renamed tables and columns, a dropped
+// table and an added table with the same columns are treated as a renamed table, a dropped
+// column and an added column with the same position and data type are treated as a renamed
+// column. It's enabled by default.
+func WithRenameDetection(detect bool) Option {
+	return func(d *differ) {
+		d.detectRename = detect
+	}
+}
+
+func newDiffer(options ...Option) *differ {
+	d := &differ{detectRename: true}
+	for _, opt := range options {
+		opt(d)
+	}
+
+	return d
+}
+
+// Compare compares the tables from and to, and returns the changes which transform from into to.
+func Compare(from, to []*parser.Table, options ...Option) *SchemaDiff {
+	d := newDiffer(options...)
+	var ret SchemaDiff
+	for _, e := range to {
+		old := findTable(from, e)
+		if old == nil {
+			ret.AddedTables = append(ret.AddedTables, e)
+			continue
+		}
+
+		if tableDiff := d.compareTable(old, e); !tableDiff.Empty() {
+			ret.ModifiedTables = append(ret.ModifiedTables, tableDiff)
+		}
+	}
+
+	for _, e := range from {
+		if findTable(to, e) == nil {
+			ret.DroppedTables = append(ret.DroppedTables, e)
+		}
+	}
+
+	if d.detectRename {
+		d.detectRenamedTables(&ret)
+	}
+
+	return &ret
+}
+
+// CompareTable compares the table from and to, and returns the changes which transform from into to.
+func CompareTable(from, to *parser.Table, options ...Option) *TableDiff {
+	return newDiffer(options...).compareTable(from, to)
+}
+
+// Empty returns true if there is no change.
+func (s *SchemaDiff) Empty() bool {
+	return len(s.AddedTables) == 0 && len(s.DroppedTables) == 0 &&
+		len(s.RenamedTables) == 0 && len(s.ModifiedTables) == 0
+}
+
+// Empty returns true if there is no change.
+func (t *TableDiff) Empty() bool {
+	return !t.Renamed() && len(t.AddedColumns) == 0 && len(t.DroppedColumns) == 0 &&
+		len(t.RenamedColumns) == 0 && len(t.ModifiedColumns) == 0 &&
+		len(t.AddedIndexes) == 0 && len(t.DroppedIndexes) == 0 && len(t.ModifiedIndexes) == 0 &&
+		len(t.AddedForeignKeys) == 0 && len(t.DroppedForeignKeys) == 0 && len(t.ModifiedOptions) == 0
+}
+
+// Renamed returns true if the table is renamed.
+func (t *TableDiff) Renamed() bool {
+	return t.From.Name != t.To.Name || t.From.Schema != t.To.Schema
+}
+
+// Renamed returns true if the column is renamed.
+func (c *ColumnDiff) Renamed() bool {
+	return !strings.EqualFold(c.From.Name, c.To.Name)
+}
+
+// DataTypeChanged returns true if the data type of column is changed.
+func (c *ColumnDiff) DataTypeChanged() bool {
+	return !EqualDataType(c.From.DataType, c.To.DataType)
+}
+
+// ConstraintChanged returns true if the constraint of column is changed.
+func (c *ColumnDiff) ConstraintChanged() bool {
+	return !equalColumnConstraint(c.From.Constraint, c.To.Constraint)
+}
+
+func (d *differ) compareTable(from, to *parser.Table) *TableDiff {
+	ret := TableDiff{From: from, To: to}
+	for _, e := range to.Columns {
+		old := from.Column(e.Name)
+		if old == nil {
+			ret.AddedColumns = append(ret.AddedColumns, e)
+			continue
+		}
+
+		columnDiff := &ColumnDiff{From: old, To: e}
+		if columnDiff.DataTypeChanged() || columnDiff.ConstraintChanged() {
+			ret.ModifiedColumns = append(ret.ModifiedColumns, columnDiff)
+		}
+	}
+
+	for _, e := range from.Columns {
+		if to.Column(e.Name) == nil {
+			ret.DroppedColumns = append(ret.DroppedColumns, e)
+		}
+	}
+
+	if d.detectRename {
+		detectRenamedColumns(&ret)
+	}
+
+	compareIndexes(&ret)
+	compareForeignKeys(&ret)
+	compareOptions(&ret)
+	return &ret
+}
+
+func (d *differ) detectRenamedTables(s *SchemaDiff) {
+	var added []*parser.Table
+	for _, e := range s.AddedTables {
+		index := -1
+		for i, dropped := range s.DroppedTables {
+			if sameColumns(dropped, e) {
+				index = i
+				break
+			}
+		}
+
+		if index < 0 {
+			added = append(added, e)
+			continue
+		}
+
+		s.RenamedTables = append(s.RenamedTables, d.compareTable(s.DroppedTables[index], e))
+		s.DroppedTables = append(s.DroppedTables[:index], s.DroppedTables[index+1:]...)
+	}
+
+	s.AddedTables = added
+}
+
+func detectRenamedColumns(t *TableDiff) {
+	var added []*parser.Column
+	for _, e := range t.AddedColumns {
+		position := columnPosition(t.To, e)
+		index := -1
+		for i, dropped := range t.DroppedColumns {
+			if columnPosition(t.From, dropped) == position && EqualDataType(dropped.DataType, e.DataType) {
+				index = i
+				break
+			}
+		}
+
+		if index < 0 {
+			added = append(added, e)
+			continue
+		}
+
+		t.RenamedColumns = append(t.RenamedColumns, &ColumnDiff{From: t.DroppedColumns[index], To: e})
+		t.DroppedColumns = append(t.DroppedColumns[:index], t.DroppedColumns[index+1:]...)
+	}
+
+	t.AddedColumns = added
+}
+
+func compareIndexes(t *TableDiff) {
+	from := t.From.AllIndexes()
+	to := t.To.AllIndexes()
+	for _, e := range to {
+		old := findIndex(from, e)
+		if old == nil {
+			t.AddedIndexes = append(t.AddedIndexes, e)
+			continue
+		}
+
+		if !equalIndex(old, e) {
+			t.ModifiedIndexes = append(t.ModifiedIndexes, &IndexDiff{From: old, To: e})
+		}
+	}
+
+	for _, e := range from {
+		if findIndex(to, e) == nil {
+			t.DroppedIndexes = append(t.DroppedIndexes, e)
+		}
+	}
+}
+
+func compareForeignKeys(t *TableDiff) {
+	from := t.From.ForeignKeys()
+	to := t.To.ForeignKeys()
+	for _, e := range to {
+		if findForeignKey(from, e) == nil {
+			t.AddedForeignKeys = append(t.AddedForeignKeys, e)
+		}
+	}
+
+	for _, e := range from {
+		if findForeignKey(to, e) == nil {
+			t.DroppedForeignKeys = append(t.DroppedForeignKeys, e)
+		}
+	}
+}
+
+func compareOptions(t *TableDiff) {
+	for _, e := range t.To.Options {
+		// the value of AUTO_INCREMENT is the state of data rather than the definition
+		if e.Name == parser.TableOptionAutoIncrement {
+			continue
+		}
+
+		if old := t.From.Option(e.Name); old != e.Value {
+			t.ModifiedOptions = append(t.ModifiedOptions, &OptionDiff{Name: e.Name, From: old, To: e.Value})
+		}
+	}
+
+	for _, e := range t.From.Options {
+		if e.Name == parser.TableOptionAutoIncrement {
+			continue
+		}
+
+		if len(t.To.Option(e.Name)) == 0 {
+			t.ModifiedOptions = append(t.ModifiedOptions, &OptionDiff{Name: e.Name, From: e.Value})
+		}
+	}
+}
+
+func findTable(list []*parser.Table, table *parser.Table) *parser.Table {
+	for _, e := range list {
+		if e.Name == table.Name && e.Schema == table.Schema {
+			return e
+		}
+	}
+
+	return nil
+}
+
+func findIndex(list []*parser.Index, index *parser.Index) *parser.Index {
+	for _, e := range list {
+		if indexKey(e) == indexKey(index) {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// findForeignKey returns the equal foreign key, a modified foreign key is treated
+// as a dropped one and an added one since it can not be modified in place.
+func findForeignKey(list []*parser.ForeignKey, fk *parser.ForeignKey) *parser.ForeignKey {
+	for _, e := range list {
+		if equalForeignKey(e, fk) {
+			return e
+		}
+	}
+
+	return nil
+}
+
+func indexKey(index *parser.Index) string {
+	if index.Primary {
+		return "PRIMARY"
+	}
+
+	if len(index.Name) > 0 {
+		return "name:" + strings.ToLower(index.Name)
+	}
+
+	return "columns:" + strings.ToLower(strings.Join(index.ColumnNames(), ","))
+}
+
+func columnPosition(table *parser.Table, column *parser.Column) int {
+	for i, e := range table.Columns {
+		if e == column {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func sameColumns(a, b *parser.Table) bool {
+	if len(a.Columns) != len(b.Columns) {
+		return false
+	}
+
+	for i, e := range a.Columns {
+		if !strings.EqualFold(e.Name, b.Columns[i].Name) || !EqualDataType(e.DataType, b.Columns[i].DataType) {
+			return false
+		}
+	}
+
+	return true
+}
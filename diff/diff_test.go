@@ -0,0 +1,137 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package diff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zeromicro/ddl-parser/internal/testutil"
+)
+
+func TestCompare(t *testing.T) {
+	from := testutil.Tables(t, "CREATE TABLE `user` (\n"+
+		"  `id` bigint NOT NULL AUTO_INCREMENT,\n"+
+		"  `name` varchar(64) NOT NULL DEFAULT '',\n"+
+		"  `nick` varchar(64) NOT NULL DEFAULT '',\n"+
+		"  `age` int NOT NULL,\n"+
+		"  `org_id` bigint NOT NULL,\n"+
+		"  PRIMARY KEY (`id`),\n"+
+		"  KEY `idx_name` (`name`),\n"+
+		"  KEY `idx_age` (`age`),\n"+
+		"  CONSTRAINT `fk_org` FOREIGN KEY (`org_id`) REFERENCES `org` (`id`)\n"+
+		");\n"+
+		"CREATE TABLE `log` (`id` bigint NOT NULL, `content` text);\n"+
+		"CREATE TABLE `tag` (`id` bigint NOT NULL, `name` varchar(32));\n"+
+		"CREATE TABLE `org` (`id` bigint NOT NULL);")
+	to := testutil.Tables(t, "CREATE TABLE `user` (\n"+
+		"  `id` bigint NOT NULL AUTO_INCREMENT,\n"+
+		"  `name` varchar(128) NOT NULL DEFAULT '',\n"+
+		"  `nickname` varchar(64) NOT NULL DEFAULT '',\n"+
+		"  `age` int NOT NULL COMMENT 'age',\n"+
+		"  `org_id` bigint NOT NULL,\n"+
+		"  `email` varchar(255),\n"+
+		"  PRIMARY KEY (`id`),\n"+
+		"  KEY `idx_name` (`name`, `age`),\n"+
+		"  UNIQUE KEY `uk_email` (`email`),\n"+
+		"  CONSTRAINT `fk_org` FOREIGN KEY (`org_id`) REFERENCES `org` (`id`) ON DELETE CASCADE\n"+
+		");\n"+
+		"CREATE TABLE `log` (`id` bigint NOT NULL, `content` text);\n"+
+		"CREATE TABLE `tags` (`id` bigint NOT NULL, `name` varchar(32));\n"+
+		"CREATE TABLE `org` (`id` bigint NOT NULL);\n"+
+		"CREATE TABLE `team` (`id` bigint NOT NULL);")
+
+	t.Run("renameDetection", func(t *testing.T) {
+		ret := Compare(from, to)
+		assert.False(t, ret.Empty())
+		assert.Len(t, ret.AddedTables, 1)
+		assert.Equal(t, "team", ret.AddedTables[0].Name)
+		assert.Len(t, ret.DroppedTables, 0)
+		assert.Len(t, ret.RenamedTables, 1)
+		assert.Equal(t, "tag", ret.RenamedTables[0].From.Name)
+		assert.Equal(t, "tags", ret.RenamedTables[0].To.Name)
+		assert.True(t, ret.RenamedTables[0].Renamed())
+		assert.Len(t, ret.ModifiedTables, 1)
+
+		user := ret.ModifiedTables[0]
+		assert.Equal(t, "user", user.To.Name)
+		assert.Len(t, user.AddedColumns, 1)
+		assert.Equal(t, "email", user.AddedColumns[0].Name)
+		assert.Len(t, user.DroppedColumns, 0)
+		assert.Len(t, user.RenamedColumns, 1)
+		assert.Equal(t, "nick", user.RenamedColumns[0].From.Name)
+		assert.Equal(t, "nickname", user.RenamedColumns[0].To.Name)
+		assert.Len(t, user.ModifiedColumns, 2)
+		assert.Equal(t, "name", user.ModifiedColumns[0].To.Name)
+		assert.True(t, user.ModifiedColumns[0].DataTypeChanged())
+		assert.False(t, user.ModifiedColumns[0].ConstraintChanged())
+		assert.Equal(t, 64, user.ModifiedColumns[0].From.DataType.Length())
+		assert.Equal(t, 128, user.ModifiedColumns[0].To.DataType.Length())
+		assert.Equal(t, "age", user.ModifiedColumns[1].To.Name)
+		assert.False(t, user.ModifiedColumns[1].DataTypeChanged())
+		assert.True(t, user.ModifiedColumns[1].ConstraintChanged())
+
+		assert.Len(t, user.AddedIndexes, 1)
+		assert.Equal(t, "uk_email", user.AddedIndexes[0].Name)
+		assert.Len(t, user.DroppedIndexes, 1)
+		assert.Equal(t, "idx_age", user.DroppedIndexes[0].Name)
+		assert.Len(t, user.ModifiedIndexes, 1)
+		assert.Equal(t, []string{"name", "age"}, user.ModifiedIndexes[0].To.ColumnNames())
+
+		assert.Len(t, user.AddedForeignKeys, 1)
+		assert.Equal(t, "CASCADE", user.AddedForeignKeys[0].OnDelete)
+		assert.Len(t, user.DroppedForeignKeys, 1)
+	})
+
+	t.Run("withoutRenameDetection", func(t *testing.T) {
+		ret := Compare(from, to, WithRenameDetection(false))
+		assert.Len(t, ret.AddedTables, 2)
+		assert.Len(t, ret.DroppedTables, 1)
+		assert.Len(t, ret.RenamedTables, 0)
+		user := ret.ModifiedTables[0]
+		assert.Len(t, user.AddedColumns, 2)
+		assert.Len(t, user.DroppedColumns, 1)
+		assert.Len(t, user.RenamedColumns, 0)
+	})
+
+	t.Run("equal", func(t *testing.T) {
+		assert.True(t, Compare(from, from).Empty())
+		assert.True(t, CompareTable(from[0], from[0]).Empty())
+	})
+}
+
+func TestCompareTable_columnLevelKey(t *testing.T) {
+	from := testutil.Tables(t, "CREATE TABLE `user` (`id` bigint NOT NULL PRIMARY KEY, `email` varchar(255) UNIQUE);")
+	to := testutil.Tables(t, "CREATE TABLE `user` (`id` bigint NOT NULL, `email` varchar(255), "+
+		"PRIMARY KEY (`id`), UNIQUE KEY `email` (`email`));")
+	ret := CompareTable(from[0], to[0])
+	assert.Len(t, ret.AddedIndexes, 0)
+	assert.Len(t, ret.DroppedIndexes, 0)
+	assert.Len(t, ret.ModifiedIndexes, 0)
+	// the column constraints are changed even though the indexes are equal
+	assert.Len(t, ret.ModifiedColumns, 2)
+}
+
+func TestIsAppended(t *testing.T) {
+	tables := testutil.Tables(t, "CREATE TABLE `user` (`a` ENUM('x','y'), `b` ENUM('x','y','z'), `c` ENUM('y','x'));")
+	a, b, c := tables[0].Columns[0].DataType, tables[0].Columns[1].DataType, tables[0].Columns[2].DataType
+	assert.True(t, IsAppended(a, a))
+	assert.True(t, IsAppended(a, b))
+	assert.False(t, IsAppended(b, a))
+	assert.False(t, IsAppended(a, c))
+	assert.False(t, IsAppended(nil, a))
+}
@@ -0,0 +1,118 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package diff
+
+import (
+	"strings"
+
+	"github.com/zeromicro/ddl-parser/parser"
+)
+
+// EqualDataType returns true if the data types are equal.
+func EqualDataType(a, b parser.DataType) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+
+	return a.Type() == b.Type() &&
+		a.Unsigned() == b.Unsigned() &&
+		a.Length() == b.Length() &&
+		a.Decimal() == b.Decimal() &&
+		strings.EqualFold(a.Charset(), b.Charset()) &&
+		strings.EqualFold(a.Collation(), b.Collation()) &&
+		equalStrings(a.Value(), b.Value())
+}
+
+// IsAppended returns true if the values of b start with the values of a, such as the members
+// appended to ENUM or SET.
+func IsAppended(a, b parser.DataType) bool {
+	if a == nil || b == nil {
+		return false
+	}
+
+	from, to := a.Value(), b.Value()
+	return len(from) <= len(to) && equalStrings(from, to[:len(from)])
+}
+
+func equalColumnConstraint(a, b *parser.ColumnConstraint) bool {
+	if a == nil {
+		a = &parser.ColumnConstraint{}
+	}
+	if b == nil {
+		b = &parser.ColumnConstraint{}
+	}
+
+	return *a == *b
+}
+
+func equalIndex(a, b *parser.Index) bool {
+	if a.Primary != b.Primary || a.Unique != b.Unique || a.Fulltext != b.Fulltext ||
+		a.Spatial != b.Spatial || a.Using != b.Using || a.Comment != b.Comment ||
+		a.Invisible != b.Invisible || len(a.Columns) != len(b.Columns) {
+		return false
+	}
+
+	for i, e := range a.Columns {
+		other := b.Columns[i]
+		if !strings.EqualFold(e.Name, other.Name) || e.Length != other.Length || e.Desc != other.Desc {
+			return false
+		}
+	}
+
+	return true
+}
+
+func equalForeignKey(a, b *parser.ForeignKey) bool {
+	if len(a.Name) > 0 && len(b.Name) > 0 && !strings.EqualFold(a.Name, b.Name) {
+		return false
+	}
+
+	return equalFoldStrings(a.Columns, b.Columns) &&
+		a.ReferenceSchema == b.ReferenceSchema &&
+		a.ReferenceTable == b.ReferenceTable &&
+		equalFoldStrings(a.ReferenceColumns, b.ReferenceColumns) &&
+		a.OnDelete == b.OnDelete &&
+		a.OnUpdate == b.OnUpdate
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i, e := range a {
+		if e != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func equalFoldStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i, e := range a {
+		if !strings.EqualFold(e, b[i]) {
+			return false
+		}
+	}
+
+	return true
+}
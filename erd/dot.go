@@ -0,0 +1,83 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package erd
+
+import (
+	"fmt"
+	"html"
+	"strings"
+
+	"github.com/zeromicro/ddl-parser/parser"
+)
+
+// DOT returns the Graphviz DOT diagram of tables, each table is rendered as a node with an HTML
+// label whose rows are the columns, the inferred relationships are rendered as dashed edges.
+func (d *Diagram) DOT(tables ...*parser.Table) string {
+	relationships := d.Relationships(tables...)
+	var b strings.Builder
+	b.WriteString("digraph schema {\n")
+	b.WriteString("  rankdir=LR;\n")
+	b.WriteString("  node [shape=plaintext];\n")
+	for _, table := range tables {
+		b.WriteString("\n")
+		fmt.Fprintf(&b, "  %s [label=<\n", dotID(table.Name))
+		b.WriteString("    <table border=\"0\" cellborder=\"1\" cellspacing=\"0\">\n")
+		fmt.Fprintf(&b, "      <tr><td bgcolor=\"lightgrey\" colspan=\"3\"><b>%s</b></td></tr>\n", html.EscapeString(table.Name))
+		for _, column := range table.Columns {
+			fmt.Fprintf(&b, "      <tr><td port=\"%s\" align=\"left\">%s</td><td align=\"left\">%s</td><td>%s</td></tr>\n",
+				html.EscapeString(column.Name), html.EscapeString(column.Name), html.EscapeString(d.dataType(column)),
+				strings.Join(d.markers(table, column, relationships), ","))
+		}
+		b.WriteString("    </table>\n")
+		b.WriteString("  >];\n")
+	}
+
+	if len(relationships) > 0 {
+		b.WriteString("\n")
+	}
+	for _, e := range relationships {
+		var attributes []string
+		if e.Inferred {
+			attributes = append(attributes, "style=dashed")
+		}
+		if len(e.Name) > 0 {
+			attributes = append(attributes, "label="+dotID(e.Name))
+		}
+
+		fmt.Fprintf(&b, "  %s:%s -> %s:%s", dotID(e.Table), dotID(e.Columns[0]),
+			dotID(e.ReferenceTable), dotID(first(e.ReferenceColumns)))
+		if len(attributes) > 0 {
+			b.WriteString(" [" + strings.Join(attributes, ", ") + "]")
+		}
+		b.WriteString(";\n")
+	}
+	b.WriteString("}\n")
+
+	return b.String()
+}
+
+func dotID(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
+
+func first(list []string) string {
+	if len(list) == 0 {
+		return ""
+	}
+
+	return list[0]
+}
@@ -0,0 +1,220 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+// Package erd renders the parsed tables as entity-relationship diagrams in Graphviz DOT, Mermaid
+// and PlantUML, the columns are rendered with their data types and key markers, and the
+// relationships are derived from the foreign keys or from a naming convention.
+package erd
+
+import (
+	"strings"
+
+	"github.com/zeromicro/ddl-parser/parser"
+	"github.com/zeromicro/ddl-parser/printer"
+)
+
+// Convention returns the referenced table and column of column, such as user.id of user_id, it
+// returns false if the column references nothing.
+type Convention func(column string) (table, referenceColumn string, ok bool)
+
+// SuffixConvention returns a Convention which matches the columns named <table><suffix>, such
+// as user_id references the column id of table user if the suffix is _id.
+func SuffixConvention(suffix, referenceColumn string) Convention {
+	return func(column string) (string, string, bool) {
+		lower := strings.ToLower(column)
+		if len(lower) <= len(suffix) || !strings.HasSuffix(lower, strings.ToLower(suffix)) {
+			return "", "", false
+		}
+
+		return column[:len(column)-len(suffix)], referenceColumn, true
+	}
+}
+
+// Relationship describes the relationship from the columns of table to the columns of the
+// referenced table.
+type Relationship struct {
+	Name             string
+	Table            string
+	Columns          []string
+	ReferenceTable   string
+	ReferenceColumns []string
+	// Inferred describes whether the relationship is inferred by the naming convention
+	Inferred bool
+	// Optional describes whether the columns can be NULL, the row may reference nothing
+	Optional bool
+	// Unique describes whether the columns are unique, the relationship is one-to-one
+	Unique bool
+}
+
+// Diagram renders the tables as diagrams, you can use NewDiagram to create an instance with
+// options, WithConvention option sets the naming convention to infer the relationships of
+// the columns which are not foreign keys.
+type Diagram struct {
+	convention Convention
+	printer    *printer.Printer
+}
+
+// Option is the alias of function.
+type Option func(d *Diagram)
+
+// NewDiagram creates an instance of Diagram.
+func NewDiagram(options ...Option) *Diagram {
+	d := &Diagram{printer: printer.NewPrinter(printer.WithKeywordCase(printer.LowerCase))}
+	for _, opt := range options {
+		opt(d)
+	}
+
+	return d
+}
+
+// WithConvention is a Diagram option to infer the relationships by the naming convention, the
+// columns which are not foreign keys are matched, and the relationships are inferred only if
+// the referenced table and column exist.
+func WithConvention(convention Convention) Option {
+	return func(d *Diagram) {
+		d.convention = convention
+	}
+}
+
+// Relationships returns the relationships between tables, the relationships declared by foreign
+// keys are followed by the inferred relationships.
+func (d *Diagram) Relationships(tables ...*parser.Table) []*Relationship {
+	var ret []*Relationship
+	for _, table := range tables {
+		for _, fk := range table.ForeignKeys() {
+			ret = append(ret, &Relationship{
+				Name:             fk.Name,
+				Table:            table.Name,
+				Columns:          fk.Columns,
+				ReferenceTable:   fk.ReferenceTable,
+				ReferenceColumns: fk.ReferenceColumns,
+				Optional:         isOptional(table, fk.Columns),
+				Unique:           table.IsUnique(fk.Columns...),
+			})
+		}
+	}
+	if d.convention == nil {
+		return ret
+	}
+
+	for _, table := range tables {
+		for _, column := range table.Columns {
+			if isForeignKey(table, column.Name) {
+				continue
+			}
+
+			name, referenceColumn, ok := d.convention(column.Name)
+			if !ok {
+				continue
+			}
+
+			reference := findTable(tables, name)
+			if reference == nil || reference == table || reference.Column(referenceColumn) == nil {
+				continue
+			}
+
+			ret = append(ret, &Relationship{
+				Table:            table.Name,
+				Columns:          []string{column.Name},
+				ReferenceTable:   reference.Name,
+				ReferenceColumns: []string{reference.Column(referenceColumn).Name},
+				Inferred:         true,
+				Optional:         isOptional(table, []string{column.Name}),
+				Unique:           table.IsUnique(column.Name),
+			})
+		}
+	}
+
+	return ret
+}
+
+// markers returns the key markers of column, such as PK, FK.
+func (d *Diagram) markers(table *parser.Table, column *parser.Column, relationships []*Relationship) []string {
+	var ret []string
+	if table.IsPrimaryKey(column.Name) {
+		ret = append(ret, "PK")
+	}
+	if isUniqueKey(table, column.Name) {
+		ret = append(ret, "UK")
+	}
+	for _, e := range relationships {
+		if strings.EqualFold(e.Table, table.Name) && containsFold(e.Columns, column.Name) {
+			ret = append(ret, "FK")
+			break
+		}
+	}
+
+	return ret
+}
+
+func (d *Diagram) dataType(column *parser.Column) string {
+	if column.DataType == nil {
+		return ""
+	}
+
+	return d.printer.DataType(column.DataType)
+}
+
+func isForeignKey(table *parser.Table, column string) bool {
+	for _, e := range table.ForeignKeys() {
+		if containsFold(e.Columns, column) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isUniqueKey(table *parser.Table, column string) bool {
+	for _, e := range table.UniqueKeys() {
+		if containsFold(e, column) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isOptional(table *parser.Table, columns []string) bool {
+	for _, e := range columns {
+		column := table.Column(e)
+		if column != nil && !table.IsNotNull(column) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func findTable(tables []*parser.Table, name string) *parser.Table {
+	for _, e := range tables {
+		if strings.EqualFold(e.Name, name) {
+			return e
+		}
+	}
+
+	return nil
+}
+
+func containsFold(list []string, s string) bool {
+	for _, e := range list {
+		if strings.EqualFold(e, s) {
+			return true
+		}
+	}
+
+	return false
+}
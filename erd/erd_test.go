@@ -0,0 +1,124 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package erd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zeromicro/ddl-parser/internal/testutil"
+)
+
+const schemaSql = "CREATE TABLE `org` (\n" +
+	"  `id` bigint NOT NULL,\n" +
+	"  `name` varchar(64) NOT NULL,\n" +
+	"  PRIMARY KEY (`id`),\n" +
+	"  UNIQUE KEY `uk_name` (`name`)\n" +
+	");\n" +
+	"CREATE TABLE `user` (\n" +
+	"  `id` bigint NOT NULL,\n" +
+	"  `org_id` bigint NOT NULL COMMENT 'org',\n" +
+	"  `team_id` bigint,\n" +
+	"  PRIMARY KEY (`id`),\n" +
+	"  CONSTRAINT `fk_org` FOREIGN KEY (`org_id`) REFERENCES `org` (`id`)\n" +
+	");\n" +
+	"CREATE TABLE `team` (\n" +
+	"  `id` bigint NOT NULL,\n" +
+	"  `user_id` bigint UNIQUE,\n" +
+	"  PRIMARY KEY (`id`)\n" +
+	");"
+
+func TestDiagram_Relationships(t *testing.T) {
+	tables := testutil.Tables(t, schemaSql)
+	assert.Equal(t, []*Relationship{
+		{Name: "fk_org", Table: "user", Columns: []string{"org_id"}, ReferenceTable: "org", ReferenceColumns: []string{"id"}},
+	}, NewDiagram().Relationships(tables...))
+
+	assert.Equal(t, []*Relationship{
+		{Name: "fk_org", Table: "user", Columns: []string{"org_id"}, ReferenceTable: "org", ReferenceColumns: []string{"id"}},
+		{Table: "user", Columns: []string{"team_id"}, ReferenceTable: "team", ReferenceColumns: []string{"id"}, Inferred: true, Optional: true},
+		{Table: "team", Columns: []string{"user_id"}, ReferenceTable: "user", ReferenceColumns: []string{"id"}, Inferred: true, Optional: true, Unique: true},
+	}, NewDiagram(WithConvention(SuffixConvention("_id", "id"))).Relationships(tables...))
+}
+
+func TestDiagram_Relationships_implicitNotNull(t *testing.T) {
+	tables := testutil.Tables(t, "CREATE TABLE `user` (`id` bigint PRIMARY KEY);\n"+
+		"CREATE TABLE `profile` (\n"+
+		"  `user_id` bigint,\n"+
+		"  `owner_id` bigint SERIAL DEFAULT VALUE,\n"+
+		"  PRIMARY KEY (`user_id`),\n"+
+		"  FOREIGN KEY (`user_id`) REFERENCES `user` (`id`),\n"+
+		"  FOREIGN KEY (`owner_id`) REFERENCES `user` (`id`)\n"+
+		");")
+	relationships := NewDiagram().Relationships(tables...)
+	assert.Len(t, relationships, 2)
+	for _, e := range relationships {
+		assert.False(t, e.Optional, e.Columns)
+	}
+}
+
+func TestDiagram_Mermaid(t *testing.T) {
+	actual := NewDiagram(WithConvention(SuffixConvention("_id", "id"))).Mermaid(testutil.Tables(t, schemaSql)...)
+	assert.Equal(t, "erDiagram\n"+
+		"  org {\n"+
+		"    bigint id PK\n"+
+		"    varchar(64) name UK\n"+
+		"  }\n"+
+		"  user {\n"+
+		"    bigint id PK\n"+
+		"    bigint org_id FK \"org\"\n"+
+		"    bigint team_id FK\n"+
+		"  }\n"+
+		"  team {\n"+
+		"    bigint id PK\n"+
+		"    bigint user_id UK, FK\n"+
+		"  }\n"+
+		"  org ||--o{ user : \"org_id\"\n"+
+		"  team |o..o{ user : \"team_id\"\n"+
+		"  user |o..o| team : \"user_id\"\n", actual)
+}
+
+func TestDiagram_PlantUML(t *testing.T) {
+	actual := NewDiagram().PlantUML(testutil.Tables(t, schemaSql)...)
+	assert.Equal(t, "@startuml\n"+
+		"entity \"org\" as org {\n"+
+		"  * id : bigint <<PK>>\n"+
+		"  --\n"+
+		"  * name : varchar(64) <<UK>>\n"+
+		"}\n"+
+		"entity \"user\" as user {\n"+
+		"  * id : bigint <<PK>>\n"+
+		"  --\n"+
+		"  * org_id : bigint <<FK>>\n"+
+		"  team_id : bigint\n"+
+		"}\n"+
+		"entity \"team\" as team {\n"+
+		"  * id : bigint <<PK>>\n"+
+		"  --\n"+
+		"  user_id : bigint <<UK>>\n"+
+		"}\n"+
+		"user }o--|| org\n"+
+		"@enduml\n", actual)
+}
+
+func TestDiagram_DOT(t *testing.T) {
+	actual := NewDiagram(WithConvention(SuffixConvention("_id", "id"))).DOT(testutil.Tables(t, schemaSql)...)
+	assert.Contains(t, actual, "digraph schema {\n  rankdir=LR;\n  node [shape=plaintext];\n\n  \"org\" [label=<\n")
+	assert.Contains(t, actual, "      <tr><td port=\"org_id\" align=\"left\">org_id</td><td align=\"left\">bigint</td><td>FK</td></tr>\n")
+	assert.Contains(t, actual, "  \"user\":\"org_id\" -> \"org\":\"id\" [label=\"fk_org\"];\n")
+	assert.Contains(t, actual, "  \"team\":\"user_id\" -> \"user\":\"id\" [style=dashed];\n}\n")
+}
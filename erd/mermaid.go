@@ -0,0 +1,91 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package erd
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/zeromicro/ddl-parser/parser"
+)
+
+var mermaidInvalidRegex = regexp.MustCompile(`[^A-Za-z0-9_\-]+`)
+
+// Mermaid returns the Mermaid erDiagram of tables, the cardinality of relationship is derived
+// from the nullability and the uniqueness of columns.
+func (d *Diagram) Mermaid(tables ...*parser.Table) string {
+	relationships := d.Relationships(tables...)
+	var b strings.Builder
+	b.WriteString("erDiagram\n")
+	for _, table := range tables {
+		fmt.Fprintf(&b, "  %s {\n", mermaidName(table.Name))
+		for _, column := range table.Columns {
+			fmt.Fprintf(&b, "    %s %s", mermaidType(column), mermaidName(column.Name))
+			if markers := d.markers(table, column, relationships); len(markers) > 0 {
+				b.WriteString(" " + strings.Join(markers, ", "))
+			}
+			if column.Constraint != nil && len(column.Constraint.Comment) > 0 {
+				b.WriteString(` "` + strings.ReplaceAll(column.Constraint.Comment, `"`, `'`) + `"`)
+			}
+			b.WriteString("\n")
+		}
+		b.WriteString("  }\n")
+	}
+
+	for _, e := range relationships {
+		parent := "||"
+		if e.Optional {
+			parent = "|o"
+		}
+		child := "o{"
+		if e.Unique {
+			child = "o|"
+		}
+		line := "--"
+		if e.Inferred {
+			line = ".."
+		}
+
+		fmt.Fprintf(&b, "  %s %s%s%s %s : %q\n", mermaidName(e.ReferenceTable), parent, line, child,
+			mermaidName(e.Table), strings.Join(e.Columns, ", "))
+	}
+
+	return b.String()
+}
+
+// mermaidType returns the data type without the characters which are invalid in Mermaid,
+// such as varchar(64), decimal(10-2).
+func mermaidType(column *parser.Column) string {
+	if column.DataType == nil {
+		return "unknown"
+	}
+
+	tp := strings.ToLower(strings.ReplaceAll(column.DataType.Type().String(), " ", "_"))
+	switch {
+	case column.DataType.Decimal() > 0:
+		tp += fmt.Sprintf("(%d-%d)", column.DataType.Length(), column.DataType.Decimal())
+	case column.DataType.Length() > 0:
+		tp += fmt.Sprintf("(%d)", column.DataType.Length())
+	}
+
+	return tp
+}
+
+func mermaidName(name string) string {
+	return mermaidInvalidRegex.ReplaceAllString(name, "_")
+}
@@ -0,0 +1,88 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package erd
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/zeromicro/ddl-parser/parser"
+)
+
+var plantUMLInvalidRegex = regexp.MustCompile(`[^A-Za-z0-9_]+`)
+
+// PlantUML returns the PlantUML entity diagram of tables, the columns of primary key are
+// rendered above the separator, and the NOT NULL columns are marked with asterisks.
+func (d *Diagram) PlantUML(tables ...*parser.Table) string {
+	relationships := d.Relationships(tables...)
+	var b strings.Builder
+	b.WriteString("@startuml\n")
+	for _, table := range tables {
+		fmt.Fprintf(&b, "entity %q as %s {\n", table.Name, plantUMLName(table.Name))
+		var keys, others []string
+		for _, column := range table.Columns {
+			line := "  "
+			if table.IsNotNull(column) {
+				line += "* "
+			}
+			line += column.Name + " : " + d.dataType(column)
+			for _, e := range d.markers(table, column, relationships) {
+				line += " <<" + e + ">>"
+			}
+
+			if table.IsPrimaryKey(column.Name) {
+				keys = append(keys, line)
+			} else {
+				others = append(others, line)
+			}
+		}
+
+		for _, e := range keys {
+			b.WriteString(e + "\n")
+		}
+		b.WriteString("  --\n")
+		for _, e := range others {
+			b.WriteString(e + "\n")
+		}
+		b.WriteString("}\n")
+	}
+
+	for _, e := range relationships {
+		child := "}o"
+		if e.Unique {
+			child = "|o"
+		}
+		parent := "||"
+		if e.Optional {
+			parent = "o|"
+		}
+		line := "--"
+		if e.Inferred {
+			line = ".."
+		}
+
+		fmt.Fprintf(&b, "%s %s%s%s %s\n", plantUMLName(e.Table), child, line, parent, plantUMLName(e.ReferenceTable))
+	}
+	b.WriteString("@enduml\n")
+
+	return b.String()
+}
+
+func plantUMLName(name string) string {
+	return plantUMLInvalidRegex.ReplaceAllString(name, "_")
+}
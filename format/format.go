@@ -0,0 +1,59 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+// Package format formats the sql files in canonical style, the CREATE TABLE statements are
+// printed by printer with their comments, the other statements are kept as they are.
+package format
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/zeromicro/ddl-parser/parser"
+	"github.com/zeromicro/ddl-parser/printer"
+)
+
+// Source formats the sql content, the name is used as the prefix of error messages, such as
+// the file name of content. The statements are separated by a blank line and the formatted
+// content ends with a line break. It returns an error if a CREATE TABLE statement contains a
+// clause which can not be printed from the parsed table, such as TEMPORARY and ZEROFILL, so that
+// the formatted content never loses the clauses silently.
+func Source(name string, src []byte, options ...printer.Option) ([]byte, error) {
+	statements, err := parser.NewParser().Statements(name, string(src))
+	if err != nil {
+		return nil, err
+	}
+
+	p := printer.NewPrinter(options...)
+	var list []string
+	for _, e := range statements {
+		if e.Table != nil {
+			if len(e.Unsupported) > 0 {
+				c := e.Unsupported[0]
+				return nil, fmt.Errorf("%s line %v %s is not supported", name, c.Position, c.Clause)
+			}
+			list = append(list, p.CreateTable(e.Table))
+		} else {
+			list = append(list, e.Text)
+		}
+	}
+
+	if len(list) == 0 {
+		return nil, nil
+	}
+
+	return []byte(strings.Join(list, "\n\n") + "\n"), nil
+}
@@ -0,0 +1,113 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package format
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const src = `/*!40101 SET NAMES utf8mb4 */;
+-- drop the old table
+DROP TABLE IF EXISTS user;
+
+# user table
+create table user(
+  id bigint not null auto_increment, -- primary key
+  /* the name of user */
+  name varchar(64) not null default '',
+  primary key(id)
+)engine=InnoDB;
+-- the end
+`
+
+func TestSource(t *testing.T) {
+	formatted, err := Source("schema.sql", []byte(src))
+	assert.Nil(t, err)
+	expected := "/*!40101 SET NAMES utf8mb4 */;\n\n" +
+		"-- drop the old table\nDROP TABLE IF EXISTS user;\n\n" +
+		"# user table\n" +
+		"CREATE TABLE `user` (\n" +
+		"  -- primary key\n" +
+		"  `id` BIGINT NOT NULL AUTO_INCREMENT,\n" +
+		"  /* the name of user */\n" +
+		"  `name` VARCHAR(64) NOT NULL DEFAULT '',\n" +
+		"  PRIMARY KEY (`id`)\n" +
+		") ENGINE=InnoDB;\n\n" +
+		"-- the end\n"
+	assert.Equal(t, expected, string(formatted))
+
+	// the formatted content is stable
+	formatted, err = Source("schema.sql", formatted)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, string(formatted))
+
+	_, err = Source("schema.sql", []byte("create table"))
+	assert.NotNil(t, err)
+}
+
+func TestSource_clauses(t *testing.T) {
+	formatted, err := Source("schema.sql", []byte("create table t (\n"+
+		"  a int,\n"+
+		"  b int generated always as (a + 1) stored,\n"+
+		"  c int check (c > 0),\n"+
+		"  d int column_format fixed storage disk\n"+
+		");"))
+	assert.Nil(t, err)
+	assert.Equal(t, "CREATE TABLE `t` (\n"+
+		"  `a` INT,\n"+
+		"  `b` INT GENERATED ALWAYS AS (a + 1) STORED,\n"+
+		"  `c` INT CHECK (c > 0),\n"+
+		"  `d` INT COLUMN_FORMAT FIXED STORAGE DISK\n"+
+		");\n", string(formatted))
+
+	// the comments in the clauses are kept in the original text of clauses
+	formatted, err = Source("schema.sql", []byte("create table t (\n"+
+		"  id int,\n"+
+		"  b int as (id + -- plus one\n"+
+		"  1)\n"+
+		")\n"+
+		"partition by range (id) ( -- first\n"+
+		"  partition p0 values less than (10)\n"+
+		");"))
+	assert.Nil(t, err)
+	expected := "CREATE TABLE `t` (\n" +
+		"  `id` INT,\n" +
+		"  `b` INT GENERATED ALWAYS AS (id + -- plus one\n" +
+		"  1) VIRTUAL\n" +
+		")\n" +
+		"partition by range (id) ( -- first\n" +
+		"  partition p0 values less than (10)\n" +
+		");\n"
+	assert.Equal(t, expected, string(formatted))
+	formatted, err = Source("schema.sql", formatted)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, string(formatted))
+
+	for sql, expected := range map[string]string{
+		"create temporary table t (a int);":                                    "schema.sql line 1:7 TEMPORARY is not supported",
+		"create table if not exists t (a int);":                                "schema.sql line 1:13 IF NOT EXISTS is not supported",
+		"create table t (a int zerofill);":                                     "schema.sql line 1:22 ZEROFILL is not supported",
+		"create table t (a varchar(8) binary);":                                "schema.sql line 1:29 BINARY is not supported",
+		"create table t (a int, key (a) key_block_size = 8);":                  "schema.sql line 1:31 KEY_BLOCK_SIZE is not supported",
+		"create table t (a int, foreign key (a) references u (a) match full);": "schema.sql line 1:56 MATCH is not supported",
+	} {
+		_, err := Source("schema.sql", []byte(sql))
+		assert.EqualError(t, err, expected, sql)
+	}
+}
@@ -30,8 +30,8 @@ var _ = fmt.Printf
 var _ = unicode.IsLetter
 
 var serializedLexerAtn = []uint16{
-	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 2, 1092, 12809,
-	8, 1, 4, 2, 9, 2, 4, 3, 9, 3, 4, 4, 9, 4, 4, 5, 9, 5, 4, 6, 9, 6, 4, 7,
+	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 2, 1093, 12819,
+	8, 2, 4, 2, 9, 2, 4, 3, 9, 3, 4, 4, 9, 4, 4, 5, 9, 5, 4, 6, 9, 6, 4, 7,
 	9, 7, 4, 8, 9, 8, 4, 9, 9, 9, 4, 10, 9, 10, 4, 11, 9, 11, 4, 12, 9, 12,
 	4, 13, 9, 13, 4, 14, 9, 14, 4, 15, 9, 15, 4, 16, 9, 16, 4, 17, 9, 17, 4,
 	18, 9, 18, 4, 19, 9, 19, 4, 20, 9, 20, 4, 21, 9, 21, 4, 22, 9, 22, 4, 23,
@@ -275,5710 +275,5716 @@ var serializedLexerAtn = []uint16{
 	4, 1090, 9, 1090, 4, 1091, 9, 1091, 4, 1092, 9, 1092, 4, 1093, 9, 1093,
 	4, 1094, 9, 1094, 4, 1095, 9, 1095, 4, 1096, 9, 1096, 4, 1097, 9, 1097,
 	4, 1098, 9, 1098, 4, 1099, 9, 1099, 4, 1100, 9, 1100, 4, 1101, 9, 1101,
-	3, 2, 6, 2, 2205, 10, 2, 13, 2, 14, 2, 2206, 3, 2, 3, 2, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 6, 3, 2216, 10, 3, 13, 3, 14, 3, 2217, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 4, 3, 4, 3, 4, 3, 4, 7, 4, 2229, 10, 4, 12, 4, 14, 4, 2232,
-	11, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 5, 5,
-	2244, 10, 5, 3, 5, 7, 5, 2247, 10, 5, 12, 5, 14, 5, 2250, 11, 5, 3, 5,
-	5, 5, 2253, 10, 5, 3, 5, 3, 5, 5, 5, 2257, 10, 5, 3, 5, 3, 5, 3, 5, 3,
-	5, 5, 5, 2263, 10, 5, 3, 5, 3, 5, 5, 5, 2267, 10, 5, 5, 5, 2269, 10, 5,
-	3, 5, 3, 5, 3, 6, 3, 6, 3, 6, 3, 6, 3, 7, 3, 7, 3, 7, 3, 7, 3, 8, 3, 8,
-	3, 8, 3, 8, 3, 8, 3, 8, 3, 9, 3, 9, 3, 9, 3, 9, 3, 9, 3, 9, 3, 9, 3, 10,
-	3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 3, 11, 3, 11, 3, 11, 3,
-	11, 3, 12, 3, 12, 3, 12, 3, 13, 3, 13, 3, 13, 3, 13, 3, 14, 3, 14, 3, 14,
-	3, 14, 3, 14, 3, 14, 3, 14, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3,
-	15, 3, 15, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 17, 3, 17, 3, 17, 3, 18,
-	3, 18, 3, 18, 3, 18, 3, 18, 3, 19, 3, 19, 3, 19, 3, 19, 3, 19, 3, 19, 3,
-	19, 3, 19, 3, 20, 3, 20, 3, 20, 3, 20, 3, 20, 3, 21, 3, 21, 3, 21, 3, 21,
-	3, 21, 3, 22, 3, 22, 3, 22, 3, 22, 3, 22, 3, 22, 3, 22, 3, 23, 3, 23, 3,
-	23, 3, 23, 3, 23, 3, 23, 3, 23, 3, 23, 3, 23, 3, 23, 3, 24, 3, 24, 3, 24,
-	3, 24, 3, 24, 3, 24, 3, 25, 3, 25, 3, 25, 3, 25, 3, 25, 3, 25, 3, 25, 3,
-	25, 3, 26, 3, 26, 3, 26, 3, 26, 3, 26, 3, 26, 3, 26, 3, 27, 3, 27, 3, 27,
-	3, 27, 3, 27, 3, 27, 3, 27, 3, 27, 3, 27, 3, 27, 3, 28, 3, 28, 3, 28, 3,
-	28, 3, 28, 3, 28, 3, 28, 3, 28, 3, 28, 3, 28, 3, 28, 3, 29, 3, 29, 3, 29,
-	3, 29, 3, 29, 3, 29, 3, 29, 3, 29, 3, 29, 3, 30, 3, 30, 3, 30, 3, 30, 3,
-	30, 3, 30, 3, 30, 3, 30, 3, 31, 3, 31, 3, 31, 3, 31, 3, 31, 3, 31, 3, 31,
-	3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 33, 3, 33, 3, 33, 3, 33, 3,
-	33, 3, 33, 3, 33, 3, 33, 3, 34, 3, 34, 3, 34, 3, 34, 3, 34, 3, 34, 3, 34,
-	3, 34, 3, 34, 3, 34, 3, 34, 3, 34, 3, 34, 3, 35, 3, 35, 3, 35, 3, 35, 3,
-	35, 3, 35, 3, 35, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36,
-	3, 36, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3,
-	37, 3, 38, 3, 38, 3, 38, 3, 38, 3, 38, 3, 38, 3, 38, 3, 38, 3, 39, 3, 39,
-	3, 39, 3, 39, 3, 39, 3, 39, 3, 39, 3, 39, 3, 40, 3, 40, 3, 40, 3, 40, 3,
-	40, 3, 40, 3, 40, 3, 40, 3, 41, 3, 41, 3, 41, 3, 41, 3, 41, 3, 41, 3, 41,
-	3, 42, 3, 42, 3, 42, 3, 42, 3, 42, 3, 43, 3, 43, 3, 43, 3, 43, 3, 43, 3,
-	43, 3, 43, 3, 43, 3, 43, 3, 44, 3, 44, 3, 44, 3, 44, 3, 44, 3, 44, 3, 44,
-	3, 44, 3, 44, 3, 44, 3, 44, 3, 44, 3, 44, 3, 44, 3, 45, 3, 45, 3, 45, 3,
-	45, 3, 45, 3, 45, 3, 45, 3, 45, 3, 45, 3, 45, 3, 45, 3, 45, 3, 46, 3, 46,
-	3, 46, 3, 46, 3, 46, 3, 46, 3, 46, 3, 46, 3, 46, 3, 47, 3, 47, 3, 47, 3,
-	47, 3, 47, 3, 47, 3, 47, 3, 47, 3, 47, 3, 47, 3, 47, 3, 47, 3, 48, 3, 48,
-	3, 48, 3, 48, 3, 48, 3, 49, 3, 49, 3, 49, 3, 49, 3, 49, 3, 50, 3, 50, 3,
-	50, 3, 50, 3, 50, 3, 51, 3, 51, 3, 51, 3, 51, 3, 51, 3, 51, 3, 51, 3, 52,
-	3, 52, 3, 52, 3, 52, 3, 52, 3, 52, 3, 53, 3, 53, 3, 53, 3, 53, 3, 53, 3,
-	53, 3, 53, 3, 53, 3, 53, 3, 54, 3, 54, 3, 54, 3, 54, 3, 54, 3, 54, 3, 54,
-	3, 54, 3, 55, 3, 55, 3, 55, 3, 55, 3, 55, 3, 55, 3, 55, 3, 56, 3, 56, 3,
-	56, 3, 56, 3, 56, 3, 57, 3, 57, 3, 57, 3, 57, 3, 57, 3, 57, 3, 57, 3, 57,
-	3, 58, 3, 58, 3, 58, 3, 58, 3, 58, 3, 58, 3, 59, 3, 59, 3, 59, 3, 59, 3,
-	59, 3, 59, 3, 60, 3, 60, 3, 60, 3, 60, 3, 61, 3, 61, 3, 61, 3, 61, 3, 61,
-	3, 61, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 63, 3,
-	63, 3, 63, 3, 63, 3, 63, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64,
-	3, 64, 3, 64, 3, 65, 3, 65, 3, 65, 3, 65, 3, 65, 3, 65, 3, 65, 3, 65, 3,
-	65, 3, 65, 3, 66, 3, 66, 3, 66, 3, 66, 3, 67, 3, 67, 3, 67, 3, 67, 3, 67,
-	3, 67, 3, 68, 3, 68, 3, 68, 3, 68, 3, 68, 3, 68, 3, 69, 3, 69, 3, 69, 3,
-	69, 3, 69, 3, 69, 3, 69, 3, 70, 3, 70, 3, 70, 3, 70, 3, 70, 3, 70, 3, 70,
-	3, 70, 3, 70, 3, 70, 3, 70, 3, 70, 3, 70, 3, 70, 3, 71, 3, 71, 3, 71, 3,
-	72, 3, 72, 3, 72, 3, 72, 3, 72, 3, 72, 3, 72, 3, 73, 3, 73, 3, 73, 3, 74,
-	3, 74, 3, 74, 3, 74, 3, 74, 3, 74, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3,
-	75, 3, 75, 3, 76, 3, 76, 3, 76, 3, 76, 3, 76, 3, 76, 3, 77, 3, 77, 3, 77,
-	3, 77, 3, 77, 3, 77, 3, 78, 3, 78, 3, 78, 3, 78, 3, 78, 3, 78, 3, 78, 3,
-	79, 3, 79, 3, 79, 3, 79, 3, 79, 3, 79, 3, 79, 3, 79, 3, 79, 3, 80, 3, 80,
-	3, 80, 3, 80, 3, 80, 3, 81, 3, 81, 3, 81, 3, 82, 3, 82, 3, 82, 3, 82, 3,
-	82, 3, 82, 3, 82, 3, 82, 3, 83, 3, 83, 3, 83, 3, 83, 3, 83, 3, 84, 3, 84,
-	3, 84, 3, 84, 3, 85, 3, 85, 3, 85, 3, 85, 3, 85, 3, 86, 3, 86, 3, 86, 3,
-	86, 3, 86, 3, 87, 3, 87, 3, 87, 3, 87, 3, 87, 3, 87, 3, 87, 3, 87, 3, 88,
-	3, 88, 3, 88, 3, 88, 3, 88, 3, 88, 3, 89, 3, 89, 3, 89, 3, 89, 3, 89, 3,
-	90, 3, 90, 3, 90, 3, 90, 3, 90, 3, 91, 3, 91, 3, 91, 3, 91, 3, 91, 3, 91,
-	3, 92, 3, 92, 3, 92, 3, 92, 3, 92, 3, 92, 3, 92, 3, 93, 3, 93, 3, 93, 3,
-	93, 3, 93, 3, 93, 3, 94, 3, 94, 3, 94, 3, 94, 3, 94, 3, 95, 3, 95, 3, 95,
-	3, 95, 3, 95, 3, 96, 3, 96, 3, 96, 3, 96, 3, 96, 3, 97, 3, 97, 3, 97, 3,
-	97, 3, 97, 3, 97, 3, 97, 3, 97, 3, 97, 3, 97, 3, 97, 3, 97, 3, 97, 3, 98,
-	3, 98, 3, 98, 3, 98, 3, 98, 3, 98, 3, 98, 3, 98, 3, 98, 3, 98, 3, 98, 3,
-	98, 3, 99, 3, 99, 3, 99, 3, 99, 3, 99, 3, 99, 3, 99, 3, 99, 3, 99, 3, 99,
+	4, 1102, 9, 1102, 3, 2, 6, 2, 2207, 10, 2, 13, 2, 14, 2, 2208, 3, 2, 3,
+	2, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 6, 3, 2218, 10, 3, 13, 3, 14, 3, 2219,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 4, 3, 4, 3, 4, 3, 4, 7, 4, 2231, 10, 4,
+	12, 4, 14, 4, 2234, 11, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 5, 3, 5, 3,
+	5, 3, 5, 3, 5, 5, 5, 2246, 10, 5, 3, 5, 7, 5, 2249, 10, 5, 12, 5, 14, 5,
+	2252, 11, 5, 3, 5, 5, 5, 2255, 10, 5, 3, 5, 3, 5, 5, 5, 2259, 10, 5, 3,
+	5, 3, 5, 3, 5, 3, 5, 5, 5, 2265, 10, 5, 3, 5, 3, 5, 5, 5, 2269, 10, 5,
+	5, 5, 2271, 10, 5, 3, 5, 3, 5, 3, 6, 3, 6, 3, 6, 3, 6, 3, 7, 3, 7, 3, 7,
+	3, 7, 3, 8, 3, 8, 3, 8, 3, 8, 3, 8, 3, 8, 3, 9, 3, 9, 3, 9, 3, 9, 3, 9,
+	3, 9, 3, 9, 3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 3,
+	11, 3, 11, 3, 11, 3, 11, 3, 12, 3, 12, 3, 12, 3, 13, 3, 13, 3, 13, 3, 13,
+	3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 15, 3, 15, 3, 15, 3,
+	15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 17,
+	3, 17, 3, 17, 3, 18, 3, 18, 3, 18, 3, 18, 3, 18, 3, 19, 3, 19, 3, 19, 3,
+	19, 3, 19, 3, 19, 3, 19, 3, 19, 3, 20, 3, 20, 3, 20, 3, 20, 3, 20, 3, 21,
+	3, 21, 3, 21, 3, 21, 3, 21, 3, 22, 3, 22, 3, 22, 3, 22, 3, 22, 3, 22, 3,
+	22, 3, 23, 3, 23, 3, 23, 3, 23, 3, 23, 3, 23, 3, 23, 3, 23, 3, 23, 3, 23,
+	3, 24, 3, 24, 3, 24, 3, 24, 3, 24, 3, 24, 3, 25, 3, 25, 3, 25, 3, 25, 3,
+	25, 3, 25, 3, 25, 3, 25, 3, 26, 3, 26, 3, 26, 3, 26, 3, 26, 3, 26, 3, 26,
+	3, 27, 3, 27, 3, 27, 3, 27, 3, 27, 3, 27, 3, 27, 3, 27, 3, 27, 3, 27, 3,
+	28, 3, 28, 3, 28, 3, 28, 3, 28, 3, 28, 3, 28, 3, 28, 3, 28, 3, 28, 3, 28,
+	3, 29, 3, 29, 3, 29, 3, 29, 3, 29, 3, 29, 3, 29, 3, 29, 3, 29, 3, 30, 3,
+	30, 3, 30, 3, 30, 3, 30, 3, 30, 3, 30, 3, 30, 3, 31, 3, 31, 3, 31, 3, 31,
+	3, 31, 3, 31, 3, 31, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 33, 3,
+	33, 3, 33, 3, 33, 3, 33, 3, 33, 3, 33, 3, 33, 3, 34, 3, 34, 3, 34, 3, 34,
+	3, 34, 3, 34, 3, 34, 3, 34, 3, 34, 3, 34, 3, 34, 3, 34, 3, 34, 3, 35, 3,
+	35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36,
+	3, 36, 3, 36, 3, 36, 3, 36, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3,
+	37, 3, 37, 3, 37, 3, 37, 3, 38, 3, 38, 3, 38, 3, 38, 3, 38, 3, 38, 3, 38,
+	3, 38, 3, 39, 3, 39, 3, 39, 3, 39, 3, 39, 3, 39, 3, 39, 3, 39, 3, 40, 3,
+	40, 3, 40, 3, 40, 3, 40, 3, 40, 3, 40, 3, 40, 3, 41, 3, 41, 3, 41, 3, 41,
+	3, 41, 3, 41, 3, 41, 3, 42, 3, 42, 3, 42, 3, 42, 3, 42, 3, 43, 3, 43, 3,
+	43, 3, 43, 3, 43, 3, 43, 3, 43, 3, 43, 3, 43, 3, 44, 3, 44, 3, 44, 3, 44,
+	3, 44, 3, 44, 3, 44, 3, 44, 3, 44, 3, 44, 3, 44, 3, 44, 3, 44, 3, 44, 3,
+	45, 3, 45, 3, 45, 3, 45, 3, 45, 3, 45, 3, 45, 3, 45, 3, 45, 3, 45, 3, 45,
+	3, 45, 3, 46, 3, 46, 3, 46, 3, 46, 3, 46, 3, 46, 3, 46, 3, 46, 3, 46, 3,
+	47, 3, 47, 3, 47, 3, 47, 3, 47, 3, 47, 3, 47, 3, 47, 3, 47, 3, 47, 3, 47,
+	3, 47, 3, 48, 3, 48, 3, 48, 3, 48, 3, 48, 3, 49, 3, 49, 3, 49, 3, 49, 3,
+	49, 3, 50, 3, 50, 3, 50, 3, 50, 3, 50, 3, 51, 3, 51, 3, 51, 3, 51, 3, 51,
+	3, 51, 3, 51, 3, 52, 3, 52, 3, 52, 3, 52, 3, 52, 3, 52, 3, 53, 3, 53, 3,
+	53, 3, 53, 3, 53, 3, 53, 3, 53, 3, 53, 3, 53, 3, 54, 3, 54, 3, 54, 3, 54,
+	3, 54, 3, 54, 3, 54, 3, 54, 3, 55, 3, 55, 3, 55, 3, 55, 3, 55, 3, 55, 3,
+	55, 3, 56, 3, 56, 3, 56, 3, 56, 3, 56, 3, 57, 3, 57, 3, 57, 3, 57, 3, 57,
+	3, 57, 3, 57, 3, 57, 3, 58, 3, 58, 3, 58, 3, 58, 3, 58, 3, 58, 3, 59, 3,
+	59, 3, 59, 3, 59, 3, 59, 3, 59, 3, 60, 3, 60, 3, 60, 3, 60, 3, 61, 3, 61,
+	3, 61, 3, 61, 3, 61, 3, 61, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3,
+	62, 3, 62, 3, 63, 3, 63, 3, 63, 3, 63, 3, 63, 3, 64, 3, 64, 3, 64, 3, 64,
+	3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 65, 3, 65, 3, 65, 3, 65, 3, 65, 3,
+	65, 3, 65, 3, 65, 3, 65, 3, 65, 3, 66, 3, 66, 3, 66, 3, 66, 3, 67, 3, 67,
+	3, 67, 3, 67, 3, 67, 3, 67, 3, 68, 3, 68, 3, 68, 3, 68, 3, 68, 3, 68, 3,
+	69, 3, 69, 3, 69, 3, 69, 3, 69, 3, 69, 3, 69, 3, 70, 3, 70, 3, 70, 3, 70,
+	3, 70, 3, 70, 3, 70, 3, 70, 3, 70, 3, 70, 3, 70, 3, 70, 3, 70, 3, 70, 3,
+	71, 3, 71, 3, 71, 3, 72, 3, 72, 3, 72, 3, 72, 3, 72, 3, 72, 3, 72, 3, 73,
+	3, 73, 3, 73, 3, 74, 3, 74, 3, 74, 3, 74, 3, 74, 3, 74, 3, 75, 3, 75, 3,
+	75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 76, 3, 76, 3, 76, 3, 76, 3, 76, 3, 76,
+	3, 77, 3, 77, 3, 77, 3, 77, 3, 77, 3, 77, 3, 78, 3, 78, 3, 78, 3, 78, 3,
+	78, 3, 78, 3, 78, 3, 79, 3, 79, 3, 79, 3, 79, 3, 79, 3, 79, 3, 79, 3, 79,
+	3, 79, 3, 80, 3, 80, 3, 80, 3, 80, 3, 80, 3, 81, 3, 81, 3, 81, 3, 82, 3,
+	82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 83, 3, 83, 3, 83, 3, 83,
+	3, 83, 3, 84, 3, 84, 3, 84, 3, 84, 3, 85, 3, 85, 3, 85, 3, 85, 3, 85, 3,
+	86, 3, 86, 3, 86, 3, 86, 3, 86, 3, 87, 3, 87, 3, 87, 3, 87, 3, 87, 3, 87,
+	3, 87, 3, 87, 3, 88, 3, 88, 3, 88, 3, 88, 3, 88, 3, 88, 3, 89, 3, 89, 3,
+	89, 3, 89, 3, 89, 3, 90, 3, 90, 3, 90, 3, 90, 3, 90, 3, 91, 3, 91, 3, 91,
+	3, 91, 3, 91, 3, 91, 3, 92, 3, 92, 3, 92, 3, 92, 3, 92, 3, 92, 3, 92, 3,
+	93, 3, 93, 3, 93, 3, 93, 3, 93, 3, 93, 3, 94, 3, 94, 3, 94, 3, 94, 3, 94,
+	3, 95, 3, 95, 3, 95, 3, 95, 3, 95, 3, 96, 3, 96, 3, 96, 3, 96, 3, 96, 3,
+	97, 3, 97, 3, 97, 3, 97, 3, 97, 3, 97, 3, 97, 3, 97, 3, 97, 3, 97, 3, 97,
+	3, 97, 3, 97, 3, 98, 3, 98, 3, 98, 3, 98, 3, 98, 3, 98, 3, 98, 3, 98, 3,
+	98, 3, 98, 3, 98, 3, 98, 3, 99, 3, 99, 3, 99, 3, 99, 3, 99, 3, 99, 3, 99,
 	3, 99, 3, 99, 3, 99, 3, 99, 3, 99, 3, 99, 3, 99, 3, 99, 3, 99, 3, 99, 3,
-	99, 3, 99, 3, 99, 3, 99, 3, 99, 3, 99, 3, 99, 3, 99, 3, 99, 3, 99, 3, 100,
-	3, 100, 3, 100, 3, 100, 3, 100, 3, 100, 3, 101, 3, 101, 3, 101, 3, 101,
-	3, 101, 3, 101, 3, 101, 3, 101, 3, 101, 3, 102, 3, 102, 3, 102, 3, 102,
-	3, 102, 3, 102, 3, 102, 3, 102, 3, 102, 3, 103, 3, 103, 3, 103, 3, 103,
-	3, 103, 3, 103, 3, 103, 3, 103, 3, 104, 3, 104, 3, 104, 3, 104, 3, 105,
-	3, 105, 3, 105, 3, 105, 3, 105, 3, 105, 3, 105, 3, 105, 3, 105, 3, 105,
-	3, 105, 3, 105, 3, 105, 3, 105, 3, 105, 3, 105, 3, 105, 3, 105, 3, 105,
-	3, 106, 3, 106, 3, 107, 3, 107, 3, 107, 3, 107, 3, 107, 3, 108, 3, 108,
-	3, 108, 3, 108, 3, 108, 3, 108, 3, 108, 3, 109, 3, 109, 3, 109, 3, 110,
-	3, 110, 3, 110, 3, 110, 3, 110, 3, 110, 3, 110, 3, 110, 3, 110, 3, 111,
-	3, 111, 3, 111, 3, 111, 3, 111, 3, 111, 3, 111, 3, 112, 3, 112, 3, 112,
-	3, 112, 3, 112, 3, 112, 3, 112, 3, 112, 3, 112, 3, 112, 3, 112, 3, 113,
-	3, 113, 3, 113, 3, 114, 3, 114, 3, 114, 3, 114, 3, 114, 3, 114, 3, 115,
-	3, 115, 3, 115, 3, 115, 3, 116, 3, 116, 3, 116, 3, 116, 3, 116, 3, 116,
-	3, 117, 3, 117, 3, 117, 3, 117, 3, 117, 3, 117, 3, 117, 3, 117, 3, 118,
-	3, 118, 3, 118, 3, 118, 3, 118, 3, 118, 3, 118, 3, 118, 3, 118, 3, 118,
-	3, 119, 3, 119, 3, 119, 3, 119, 3, 119, 3, 119, 3, 119, 3, 119, 3, 120,
-	3, 120, 3, 120, 3, 120, 3, 120, 3, 120, 3, 120, 3, 120, 3, 120, 3, 120,
-	3, 121, 3, 121, 3, 121, 3, 121, 3, 121, 3, 121, 3, 122, 3, 122, 3, 122,
-	3, 122, 3, 122, 3, 122, 3, 123, 3, 123, 3, 123, 3, 123, 3, 123, 3, 124,
-	3, 124, 3, 124, 3, 124, 3, 124, 3, 124, 3, 125, 3, 125, 3, 125, 3, 125,
-	3, 125, 3, 125, 3, 125, 3, 125, 3, 125, 3, 125, 3, 125, 3, 126, 3, 126,
-	3, 126, 3, 126, 3, 126, 3, 126, 3, 126, 3, 127, 3, 127, 3, 127, 3, 127,
-	3, 127, 3, 127, 3, 127, 3, 127, 3, 128, 3, 128, 3, 128, 3, 128, 3, 128,
-	3, 128, 3, 128, 3, 129, 3, 129, 3, 129, 3, 129, 3, 129, 3, 129, 3, 129,
-	3, 130, 3, 130, 3, 130, 3, 130, 3, 130, 3, 130, 3, 130, 3, 130, 3, 131,
-	3, 131, 3, 131, 3, 131, 3, 131, 3, 131, 3, 131, 3, 131, 3, 132, 3, 132,
-	3, 132, 3, 132, 3, 132, 3, 132, 3, 132, 3, 132, 3, 132, 3, 133, 3, 133,
-	3, 133, 3, 133, 3, 133, 3, 133, 3, 133, 3, 133, 3, 133, 3, 134, 3, 134,
-	3, 134, 3, 134, 3, 134, 3, 134, 3, 134, 3, 135, 3, 135, 3, 135, 3, 135,
-	3, 135, 3, 135, 3, 135, 3, 136, 3, 136, 3, 136, 3, 136, 3, 136, 3, 136,
-	3, 137, 3, 137, 3, 137, 3, 137, 3, 137, 3, 137, 3, 138, 3, 138, 3, 138,
-	3, 138, 3, 138, 3, 138, 3, 138, 3, 139, 3, 139, 3, 139, 3, 139, 3, 139,
-	3, 139, 3, 139, 3, 139, 3, 140, 3, 140, 3, 140, 3, 140, 3, 140, 3, 140,
-	3, 140, 3, 141, 3, 141, 3, 141, 3, 141, 3, 142, 3, 142, 3, 142, 3, 142,
-	3, 142, 3, 142, 3, 142, 3, 142, 3, 142, 3, 142, 3, 143, 3, 143, 3, 143,
-	3, 143, 3, 143, 3, 144, 3, 144, 3, 144, 3, 144, 3, 144, 3, 144, 3, 144,
-	3, 145, 3, 145, 3, 145, 3, 145, 3, 145, 3, 145, 3, 145, 3, 145, 3, 146,
-	3, 146, 3, 146, 3, 146, 3, 147, 3, 147, 3, 147, 3, 147, 3, 147, 3, 147,
-	3, 147, 3, 147, 3, 147, 3, 147, 3, 147, 3, 147, 3, 147, 3, 148, 3, 148,
-	3, 148, 3, 148, 3, 148, 3, 148, 3, 148, 3, 148, 3, 148, 3, 149, 3, 149,
-	3, 149, 3, 149, 3, 149, 3, 149, 3, 149, 3, 149, 3, 149, 3, 149, 3, 149,
-	3, 150, 3, 150, 3, 150, 3, 150, 3, 150, 3, 150, 3, 150, 3, 150, 3, 150,
-	3, 150, 3, 150, 3, 150, 3, 150, 3, 150, 3, 150, 3, 151, 3, 151, 3, 151,
-	3, 151, 3, 151, 3, 151, 3, 151, 3, 151, 3, 151, 3, 151, 3, 151, 3, 151,
-	3, 151, 3, 151, 3, 151, 3, 151, 3, 151, 3, 151, 3, 151, 3, 151, 3, 152,
-	3, 152, 3, 152, 3, 152, 3, 152, 3, 152, 3, 152, 3, 152, 3, 152, 3, 152,
-	3, 152, 3, 152, 3, 152, 3, 152, 3, 152, 3, 152, 3, 152, 3, 153, 3, 153,
-	3, 153, 3, 153, 3, 154, 3, 154, 3, 154, 3, 154, 3, 154, 3, 154, 3, 154,
-	3, 154, 3, 155, 3, 155, 3, 155, 3, 155, 3, 155, 3, 155, 3, 155, 3, 155,
-	3, 155, 3, 156, 3, 156, 3, 156, 3, 156, 3, 156, 3, 156, 3, 156, 3, 156,
-	3, 156, 3, 156, 3, 156, 3, 156, 3, 156, 3, 156, 3, 157, 3, 157, 3, 157,
-	3, 157, 3, 157, 3, 157, 3, 158, 3, 158, 3, 158, 3, 158, 3, 158, 3, 158,
-	3, 158, 3, 158, 3, 158, 3, 158, 3, 158, 3, 159, 3, 159, 3, 159, 3, 159,
-	3, 159, 3, 160, 3, 160, 3, 160, 3, 161, 3, 161, 3, 161, 3, 161, 3, 161,
-	3, 161, 3, 161, 3, 161, 3, 161, 3, 162, 3, 162, 3, 162, 3, 162, 3, 162,
-	3, 162, 3, 162, 3, 162, 3, 163, 3, 163, 3, 163, 3, 163, 3, 163, 3, 164,
-	3, 164, 3, 164, 3, 164, 3, 164, 3, 165, 3, 165, 3, 165, 3, 165, 3, 165,
-	3, 165, 3, 166, 3, 166, 3, 166, 3, 166, 3, 166, 3, 166, 3, 166, 3, 167,
-	3, 167, 3, 167, 3, 167, 3, 167, 3, 167, 3, 167, 3, 168, 3, 168, 3, 168,
-	3, 168, 3, 168, 3, 168, 3, 168, 3, 168, 3, 168, 3, 169, 3, 169, 3, 169,
-	3, 169, 3, 169, 3, 169, 3, 169, 3, 170, 3, 170, 3, 170, 3, 170, 3, 170,
-	3, 170, 3, 171, 3, 171, 3, 171, 3, 171, 3, 172, 3, 172, 3, 172, 3, 172,
-	3, 172, 3, 172, 3, 173, 3, 173, 3, 173, 3, 173, 3, 173, 3, 173, 3, 173,
-	3, 174, 3, 174, 3, 174, 3, 174, 3, 174, 3, 175, 3, 175, 3, 175, 3, 175,
-	3, 175, 3, 175, 3, 176, 3, 176, 3, 176, 3, 176, 3, 176, 3, 176, 3, 177,
-	3, 177, 3, 177, 3, 177, 3, 177, 3, 178, 3, 178, 3, 178, 3, 178, 3, 178,
-	3, 178, 3, 179, 3, 179, 3, 179, 3, 179, 3, 180, 3, 180, 3, 180, 3, 180,
-	3, 180, 3, 180, 3, 180, 3, 180, 3, 180, 3, 181, 3, 181, 3, 181, 3, 181,
-	3, 181, 3, 181, 3, 181, 3, 181, 3, 182, 3, 182, 3, 182, 3, 182, 3, 182,
-	3, 182, 3, 182, 3, 182, 3, 182, 3, 183, 3, 183, 3, 183, 3, 183, 3, 183,
-	3, 183, 3, 183, 3, 183, 3, 183, 3, 183, 3, 184, 3, 184, 3, 184, 3, 184,
-	3, 184, 3, 184, 3, 184, 3, 184, 3, 184, 3, 184, 3, 185, 3, 185, 3, 185,
-	3, 185, 3, 186, 3, 186, 3, 186, 3, 186, 3, 186, 3, 187, 3, 187, 3, 187,
-	3, 187, 3, 187, 3, 188, 3, 188, 3, 188, 3, 188, 3, 188, 3, 189, 3, 189,
-	3, 189, 3, 189, 3, 189, 3, 190, 3, 190, 3, 190, 3, 190, 3, 190, 3, 191,
-	3, 191, 3, 191, 3, 191, 3, 191, 3, 191, 3, 191, 3, 191, 3, 192, 3, 192,
-	3, 192, 3, 192, 3, 192, 3, 192, 3, 192, 3, 193, 3, 193, 3, 193, 3, 193,
-	3, 193, 3, 194, 3, 194, 3, 194, 3, 194, 3, 194, 3, 194, 3, 194, 3, 195,
-	3, 195, 3, 195, 3, 195, 3, 195, 3, 195, 3, 195, 3, 195, 3, 195, 3, 195,
-	3, 196, 3, 196, 3, 196, 3, 196, 3, 196, 3, 196, 3, 197, 3, 197, 3, 197,
-	3, 197, 3, 197, 3, 197, 3, 197, 3, 198, 3, 198, 3, 198, 3, 198, 3, 198,
-	3, 198, 3, 198, 3, 199, 3, 199, 3, 199, 3, 199, 3, 199, 3, 199, 3, 199,
-	3, 199, 3, 200, 3, 200, 3, 200, 3, 200, 3, 201, 3, 201, 3, 201, 3, 201,
-	3, 201, 3, 201, 3, 201, 3, 201, 3, 202, 3, 202, 3, 202, 3, 202, 3, 202,
-	3, 203, 3, 203, 3, 203, 3, 203, 3, 203, 3, 204, 3, 204, 3, 204, 3, 204,
-	3, 204, 3, 204, 3, 204, 3, 204, 3, 204, 3, 204, 3, 205, 3, 205, 3, 205,
-	3, 205, 3, 205, 3, 205, 3, 205, 3, 205, 3, 205, 3, 206, 3, 206, 3, 206,
-	3, 206, 3, 206, 3, 207, 3, 207, 3, 207, 3, 207, 3, 207, 3, 208, 3, 208,
-	3, 208, 3, 208, 3, 208, 3, 208, 3, 208, 3, 208, 3, 209, 3, 209, 3, 209,
-	3, 209, 3, 209, 3, 209, 3, 209, 3, 209, 3, 209, 3, 210, 3, 210, 3, 210,
-	3, 210, 3, 210, 3, 210, 3, 210, 3, 210, 3, 210, 3, 211, 3, 211, 3, 211,
-	3, 211, 3, 211, 3, 211, 3, 211, 3, 212, 3, 212, 3, 212, 3, 212, 3, 212,
-	3, 212, 3, 212, 3, 212, 3, 212, 3, 212, 3, 213, 3, 213, 3, 213, 3, 213,
-	3, 213, 3, 213, 3, 213, 3, 213, 3, 213, 3, 214, 3, 214, 3, 214, 3, 214,
-	3, 214, 3, 215, 3, 215, 3, 215, 3, 215, 3, 215, 3, 215, 3, 215, 3, 215,
-	3, 215, 3, 215, 3, 215, 3, 216, 3, 216, 3, 216, 3, 216, 3, 216, 3, 217,
-	3, 217, 3, 217, 3, 217, 3, 217, 3, 217, 3, 217, 3, 217, 3, 217, 3, 218,
-	3, 218, 3, 218, 3, 218, 3, 218, 3, 218, 3, 218, 3, 218, 3, 218, 3, 219,
-	3, 219, 3, 219, 3, 219, 3, 219, 3, 220, 3, 220, 3, 220, 3, 220, 3, 220,
-	3, 220, 3, 220, 3, 220, 3, 220, 3, 220, 3, 220, 3, 221, 3, 221, 3, 221,
-	3, 221, 3, 221, 3, 221, 3, 221, 3, 221, 3, 221, 3, 222, 3, 222, 3, 222,
-	3, 222, 3, 222, 3, 223, 3, 223, 3, 223, 3, 223, 3, 223, 3, 223, 3, 223,
-	3, 223, 3, 224, 3, 224, 3, 224, 3, 224, 3, 224, 3, 224, 3, 224, 3, 225,
-	3, 225, 3, 225, 3, 225, 3, 225, 3, 225, 3, 225, 3, 225, 3, 225, 3, 225,
-	3, 225, 3, 226, 3, 226, 3, 226, 3, 226, 3, 226, 3, 226, 3, 226, 3, 226,
-	3, 226, 3, 227, 3, 227, 3, 227, 3, 227, 3, 227, 3, 227, 3, 227, 3, 227,
-	3, 227, 3, 227, 3, 227, 3, 228, 3, 228, 3, 228, 3, 228, 3, 228, 3, 228,
-	3, 228, 3, 228, 3, 228, 3, 228, 3, 228, 3, 229, 3, 229, 3, 229, 3, 229,
-	3, 229, 3, 229, 3, 229, 3, 229, 3, 229, 3, 229, 3, 229, 3, 229, 3, 230,
-	3, 230, 3, 230, 3, 230, 3, 230, 3, 230, 3, 230, 3, 230, 3, 230, 3, 230,
-	3, 230, 3, 230, 3, 231, 3, 231, 3, 231, 3, 231, 3, 231, 3, 231, 3, 231,
-	3, 231, 3, 231, 3, 231, 3, 231, 3, 231, 3, 231, 3, 231, 3, 232, 3, 232,
-	3, 232, 3, 232, 3, 232, 3, 232, 3, 232, 3, 232, 3, 232, 3, 232, 3, 232,
-	3, 232, 3, 232, 3, 232, 3, 232, 3, 232, 3, 232, 3, 232, 3, 232, 3, 233,
-	3, 233, 3, 233, 3, 233, 3, 233, 3, 233, 3, 233, 3, 233, 3, 233, 3, 233,
-	3, 233, 3, 233, 3, 233, 3, 233, 3, 233, 3, 233, 3, 233, 3, 233, 3, 233,
-	3, 234, 3, 234, 3, 234, 3, 234, 3, 234, 3, 234, 3, 234, 3, 234, 3, 234,
-	3, 234, 3, 234, 3, 234, 3, 234, 3, 234, 3, 234, 3, 234, 3, 234, 3, 235,
-	3, 235, 3, 235, 3, 235, 3, 235, 3, 235, 3, 235, 3, 235, 3, 235, 3, 235,
-	3, 235, 3, 235, 3, 235, 3, 235, 3, 235, 3, 235, 3, 236, 3, 236, 3, 236,
-	3, 236, 3, 236, 3, 236, 3, 236, 3, 236, 3, 236, 3, 236, 3, 236, 3, 237,
-	3, 237, 3, 237, 3, 237, 3, 237, 3, 237, 3, 237, 3, 237, 3, 237, 3, 237,
-	3, 237, 3, 237, 3, 238, 3, 238, 3, 238, 3, 238, 3, 238, 3, 238, 3, 238,
-	3, 238, 3, 238, 3, 238, 3, 238, 3, 239, 3, 239, 3, 239, 3, 239, 3, 239,
-	3, 239, 3, 239, 3, 239, 3, 239, 3, 239, 3, 239, 3, 239, 3, 239, 3, 239,
-	3, 240, 3, 240, 3, 240, 3, 240, 3, 240, 3, 240, 3, 240, 3, 240, 3, 240,
-	3, 240, 3, 240, 3, 240, 3, 240, 3, 240, 3, 240, 3, 240, 3, 240, 3, 240,
-	3, 240, 3, 241, 3, 241, 3, 241, 3, 241, 3, 241, 3, 241, 3, 241, 3, 241,
-	3, 241, 3, 241, 3, 241, 3, 241, 3, 241, 3, 242, 3, 242, 3, 242, 3, 242,
-	3, 242, 3, 242, 3, 242, 3, 242, 3, 242, 3, 242, 3, 243, 3, 243, 3, 243,
-	3, 243, 3, 243, 3, 243, 3, 243, 3, 243, 3, 243, 3, 243, 3, 243, 3, 243,
-	3, 243, 3, 243, 3, 244, 3, 244, 3, 244, 3, 244, 3, 244, 3, 244, 3, 244,
-	3, 244, 3, 244, 3, 244, 3, 244, 3, 244, 3, 245, 3, 245, 3, 245, 3, 245,
-	3, 245, 3, 245, 3, 245, 3, 245, 3, 245, 3, 245, 3, 245, 3, 246, 3, 246,
-	3, 246, 3, 246, 3, 246, 3, 246, 3, 246, 3, 246, 3, 246, 3, 246, 3, 246,
-	3, 246, 3, 246, 3, 246, 3, 246, 3, 246, 3, 246, 3, 246, 3, 247, 3, 247,
-	3, 247, 3, 247, 3, 247, 3, 247, 3, 247, 3, 247, 3, 247, 3, 247, 3, 247,
-	3, 247, 3, 247, 3, 247, 3, 247, 3, 247, 3, 247, 3, 247, 3, 248, 3, 248,
-	3, 248, 3, 248, 3, 248, 3, 248, 3, 248, 3, 248, 3, 248, 3, 248, 3, 248,
-	3, 248, 3, 249, 3, 249, 3, 249, 3, 249, 3, 249, 3, 249, 3, 249, 3, 249,
-	3, 249, 3, 249, 3, 249, 3, 250, 3, 250, 3, 250, 3, 250, 3, 250, 3, 250,
-	3, 250, 3, 250, 3, 250, 3, 250, 3, 250, 3, 250, 3, 250, 3, 250, 3, 250,
-	3, 250, 3, 250, 3, 251, 3, 251, 3, 251, 3, 251, 3, 251, 3, 251, 3, 251,
-	3, 251, 3, 251, 3, 251, 3, 251, 3, 251, 3, 251, 3, 251, 3, 251, 3, 251,
-	3, 251, 3, 251, 3, 251, 3, 251, 3, 252, 3, 252, 3, 252, 3, 252, 3, 252,
-	3, 252, 3, 252, 3, 252, 3, 252, 3, 252, 3, 252, 3, 252, 3, 253, 3, 253,
-	3, 253, 3, 253, 3, 253, 3, 253, 3, 253, 3, 253, 3, 253, 3, 253, 3, 253,
-	3, 253, 3, 253, 3, 254, 3, 254, 3, 254, 3, 254, 3, 254, 3, 254, 3, 254,
-	3, 254, 3, 254, 3, 255, 3, 255, 3, 255, 3, 255, 3, 255, 3, 255, 3, 255,
-	3, 255, 3, 255, 3, 255, 3, 255, 3, 255, 3, 255, 3, 256, 3, 256, 3, 256,
-	3, 256, 3, 256, 3, 256, 3, 256, 3, 256, 3, 256, 3, 256, 3, 256, 3, 257,
-	3, 257, 3, 257, 3, 257, 3, 257, 3, 257, 3, 257, 3, 257, 3, 257, 3, 257,
-	3, 257, 3, 257, 3, 258, 3, 258, 3, 258, 3, 258, 3, 258, 3, 258, 3, 258,
-	3, 258, 3, 258, 3, 258, 3, 259, 3, 259, 3, 259, 3, 259, 3, 259, 3, 259,
-	3, 259, 3, 259, 3, 259, 3, 259, 3, 259, 3, 260, 3, 260, 3, 260, 3, 260,
-	3, 260, 3, 260, 3, 260, 3, 260, 3, 260, 3, 260, 3, 260, 3, 261, 3, 261,
-	3, 261, 3, 261, 3, 261, 3, 261, 3, 261, 3, 261, 3, 261, 3, 261, 3, 261,
-	3, 261, 3, 261, 3, 261, 3, 261, 3, 261, 3, 261, 3, 261, 3, 262, 3, 262,
-	3, 262, 3, 262, 3, 262, 3, 262, 3, 262, 3, 262, 3, 262, 3, 262, 3, 262,
-	3, 262, 3, 262, 3, 262, 3, 262, 3, 262, 3, 262, 3, 262, 3, 262, 3, 262,
-	3, 262, 3, 262, 3, 262, 3, 262, 3, 262, 3, 262, 3, 262, 3, 262, 3, 262,
-	3, 262, 3, 263, 3, 263, 3, 263, 3, 263, 3, 263, 3, 263, 3, 263, 3, 263,
-	3, 263, 3, 263, 3, 263, 3, 263, 3, 264, 3, 264, 3, 264, 3, 264, 3, 264,
-	3, 264, 3, 264, 3, 264, 3, 264, 3, 264, 3, 264, 3, 264, 3, 264, 3, 264,
-	3, 264, 3, 264, 3, 264, 3, 264, 3, 265, 3, 265, 3, 265, 3, 265, 3, 265,
-	3, 265, 3, 265, 3, 265, 3, 265, 3, 265, 3, 265, 3, 265, 3, 265, 3, 265,
-	3, 265, 3, 265, 3, 265, 3, 265, 3, 266, 3, 266, 3, 266, 3, 266, 3, 266,
-	3, 266, 3, 266, 3, 266, 3, 266, 3, 266, 3, 266, 3, 266, 3, 266, 3, 266,
-	3, 267, 3, 267, 3, 267, 3, 267, 3, 267, 3, 267, 3, 267, 3, 267, 3, 267,
-	3, 267, 3, 267, 3, 267, 3, 267, 3, 267, 3, 267, 3, 268, 3, 268, 3, 268,
-	3, 268, 3, 269, 3, 269, 3, 269, 3, 269, 3, 269, 3, 269, 3, 269, 3, 269,
-	3, 270, 3, 270, 3, 270, 3, 270, 3, 270, 3, 270, 3, 270, 3, 271, 3, 271,
-	3, 271, 3, 271, 3, 271, 3, 271, 3, 271, 3, 271, 3, 272, 3, 272, 3, 272,
-	3, 272, 3, 272, 3, 272, 3, 273, 3, 273, 3, 273, 3, 273, 3, 273, 3, 273,
-	3, 273, 3, 273, 3, 273, 3, 273, 3, 273, 3, 273, 3, 273, 3, 274, 3, 274,
-	3, 274, 3, 274, 3, 275, 3, 275, 3, 275, 3, 275, 3, 276, 3, 276, 3, 276,
-	3, 276, 3, 277, 3, 277, 3, 277, 3, 277, 3, 277, 3, 277, 3, 277, 3, 278,
-	3, 278, 3, 278, 3, 278, 3, 278, 3, 278, 3, 278, 3, 278, 3, 278, 3, 278,
-	3, 278, 3, 279, 3, 279, 3, 279, 3, 279, 3, 279, 3, 279, 3, 279, 3, 279,
-	3, 279, 3, 279, 3, 279, 3, 279, 3, 280, 3, 280, 3, 280, 3, 280, 3, 281,
-	3, 281, 3, 281, 3, 281, 3, 281, 3, 281, 3, 281, 3, 281, 3, 282, 3, 282,
-	3, 282, 3, 282, 3, 282, 3, 282, 3, 282, 3, 282, 3, 282, 3, 283, 3, 283,
-	3, 283, 3, 283, 3, 283, 3, 283, 3, 283, 3, 283, 3, 283, 3, 284, 3, 284,
-	3, 284, 3, 284, 3, 284, 3, 284, 3, 284, 3, 284, 3, 284, 3, 284, 3, 284,
-	3, 284, 3, 284, 3, 285, 3, 285, 3, 285, 3, 285, 3, 285, 3, 285, 3, 285,
-	3, 285, 3, 285, 3, 285, 3, 285, 3, 285, 3, 285, 3, 286, 3, 286, 3, 286,
-	3, 286, 3, 286, 3, 286, 3, 286, 3, 286, 3, 286, 3, 286, 3, 286, 3, 286,
-	3, 286, 3, 286, 3, 286, 3, 286, 3, 286, 3, 286, 3, 287, 3, 287, 3, 287,
-	3, 287, 3, 287, 3, 287, 3, 287, 3, 287, 3, 287, 3, 287, 3, 288, 3, 288,
-	3, 288, 3, 288, 3, 288, 3, 288, 3, 288, 3, 288, 3, 289, 3, 289, 3, 289,
-	3, 289, 3, 289, 3, 289, 3, 289, 3, 289, 3, 290, 3, 290, 3, 290, 3, 290,
-	3, 290, 3, 290, 3, 290, 3, 290, 3, 290, 3, 291, 3, 291, 3, 291, 3, 291,
-	3, 291, 3, 291, 3, 291, 3, 291, 3, 291, 3, 292, 3, 292, 3, 292, 3, 292,
-	3, 292, 3, 292, 3, 292, 3, 292, 3, 293, 3, 293, 3, 293, 3, 293, 3, 293,
-	3, 293, 3, 293, 3, 293, 3, 293, 3, 293, 3, 293, 3, 293, 3, 293, 3, 293,
-	3, 293, 3, 294, 3, 294, 3, 294, 3, 294, 3, 295, 3, 295, 3, 295, 3, 295,
-	3, 295, 3, 295, 3, 295, 3, 295, 3, 295, 3, 296, 3, 296, 3, 296, 3, 296,
-	3, 296, 3, 296, 3, 296, 3, 297, 3, 297, 3, 297, 3, 297, 3, 297, 3, 297,
-	3, 297, 3, 297, 3, 297, 3, 297, 3, 298, 3, 298, 3, 298, 3, 298, 3, 298,
-	3, 298, 3, 298, 3, 298, 3, 299, 3, 299, 3, 299, 3, 299, 3, 299, 3, 300,
-	3, 300, 3, 300, 3, 300, 3, 300, 3, 300, 3, 300, 3, 300, 3, 300, 3, 301,
-	3, 301, 3, 301, 3, 301, 3, 301, 3, 301, 3, 301, 3, 301, 3, 301, 3, 302,
-	3, 302, 3, 302, 3, 302, 3, 302, 3, 302, 3, 302, 3, 302, 3, 302, 3, 302,
-	3, 302, 3, 302, 3, 302, 3, 302, 3, 303, 3, 303, 3, 303, 3, 303, 3, 303,
-	3, 303, 3, 303, 3, 303, 3, 304, 3, 304, 3, 304, 3, 304, 3, 304, 3, 304,
-	3, 304, 3, 305, 3, 305, 3, 305, 3, 305, 3, 305, 3, 305, 3, 306, 3, 306,
-	3, 306, 3, 306, 3, 306, 3, 306, 3, 306, 3, 306, 3, 306, 3, 306, 3, 307,
-	3, 307, 3, 307, 3, 307, 3, 307, 3, 307, 3, 307, 3, 307, 3, 307, 3, 307,
-	3, 308, 3, 308, 3, 308, 3, 308, 3, 309, 3, 309, 3, 309, 3, 310, 3, 310,
-	3, 310, 3, 310, 3, 310, 3, 310, 3, 310, 3, 310, 3, 311, 3, 311, 3, 311,
-	3, 311, 3, 311, 3, 311, 3, 311, 3, 311, 3, 311, 3, 311, 3, 311, 3, 312,
-	3, 312, 3, 312, 3, 312, 3, 312, 3, 312, 3, 312, 3, 312, 3, 312, 3, 312,
-	3, 312, 3, 312, 3, 312, 3, 312, 3, 312, 3, 312, 3, 313, 3, 313, 3, 313,
-	3, 313, 3, 313, 3, 313, 3, 313, 3, 313, 3, 313, 3, 313, 3, 313, 3, 313,
-	3, 313, 3, 313, 3, 313, 3, 314, 3, 314, 3, 314, 3, 314, 3, 314, 3, 314,
-	3, 314, 3, 314, 3, 314, 3, 314, 3, 314, 3, 314, 3, 314, 3, 314, 3, 314,
-	3, 315, 3, 315, 3, 315, 3, 315, 3, 315, 3, 315, 3, 316, 3, 316, 3, 316,
-	3, 316, 3, 316, 3, 316, 3, 316, 3, 317, 3, 317, 3, 317, 3, 317, 3, 318,
-	3, 318, 3, 318, 3, 318, 3, 318, 3, 318, 3, 319, 3, 319, 3, 319, 3, 319,
-	3, 319, 3, 320, 3, 320, 3, 320, 3, 320, 3, 320, 3, 320, 3, 320, 3, 320,
-	3, 321, 3, 321, 3, 321, 3, 321, 3, 321, 3, 321, 3, 322, 3, 322, 3, 322,
-	3, 322, 3, 322, 3, 322, 3, 323, 3, 323, 3, 323, 3, 323, 3, 323, 3, 323,
-	3, 323, 3, 323, 3, 323, 3, 324, 3, 324, 3, 324, 3, 324, 3, 324, 3, 324,
-	3, 325, 3, 325, 3, 325, 3, 325, 3, 325, 3, 325, 3, 325, 3, 325, 3, 326,
-	3, 326, 3, 326, 3, 326, 3, 326, 3, 326, 3, 326, 3, 326, 3, 327, 3, 327,
-	3, 327, 3, 327, 3, 327, 3, 327, 3, 327, 3, 327, 3, 327, 3, 328, 3, 328,
-	3, 328, 3, 328, 3, 328, 3, 328, 3, 328, 3, 328, 3, 328, 3, 328, 3, 328,
-	3, 328, 3, 328, 3, 328, 3, 329, 3, 329, 3, 329, 3, 329, 3, 329, 3, 329,
-	3, 329, 3, 330, 3, 330, 3, 330, 3, 330, 3, 330, 3, 330, 3, 330, 3, 330,
-	3, 330, 3, 330, 3, 330, 3, 330, 3, 330, 3, 331, 3, 331, 3, 331, 3, 331,
-	3, 331, 3, 331, 3, 331, 3, 332, 3, 332, 3, 332, 3, 332, 3, 332, 3, 332,
-	3, 333, 3, 333, 3, 333, 3, 333, 3, 333, 3, 333, 3, 333, 3, 333, 3, 333,
-	3, 334, 3, 334, 3, 334, 3, 334, 3, 334, 3, 335, 3, 335, 3, 335, 3, 335,
-	3, 335, 3, 335, 3, 335, 3, 335, 3, 336, 3, 336, 3, 336, 3, 336, 3, 336,
-	3, 336, 3, 336, 3, 336, 3, 336, 3, 336, 3, 336, 3, 336, 3, 336, 3, 336,
-	3, 337, 3, 337, 3, 337, 3, 337, 3, 337, 3, 337, 3, 337, 3, 337, 3, 337,
-	3, 337, 3, 337, 3, 337, 3, 338, 3, 338, 3, 338, 3, 338, 3, 338, 3, 338,
-	3, 338, 3, 338, 3, 339, 3, 339, 3, 339, 3, 339, 3, 339, 3, 339, 3, 339,
-	3, 340, 3, 340, 3, 340, 3, 340, 3, 340, 3, 340, 3, 340, 3, 340, 3, 341,
-	3, 341, 3, 341, 3, 341, 3, 341, 3, 341, 3, 341, 3, 341, 3, 341, 3, 341,
-	3, 341, 3, 342, 3, 342, 3, 342, 3, 342, 3, 342, 3, 342, 3, 342, 3, 342,
-	3, 342, 3, 342, 3, 342, 3, 343, 3, 343, 3, 343, 3, 343, 3, 343, 3, 343,
-	3, 343, 3, 343, 3, 343, 3, 343, 3, 343, 3, 343, 3, 344, 3, 344, 3, 344,
-	3, 344, 3, 344, 3, 344, 3, 344, 3, 344, 3, 344, 3, 344, 3, 344, 3, 345,
-	3, 345, 3, 345, 3, 345, 3, 345, 3, 345, 3, 345, 3, 345, 3, 345, 3, 345,
-	3, 345, 3, 346, 3, 346, 3, 346, 3, 346, 3, 346, 3, 346, 3, 346, 3, 346,
-	3, 346, 3, 346, 3, 346, 3, 347, 3, 347, 3, 347, 3, 347, 3, 347, 3, 347,
-	3, 347, 3, 347, 3, 347, 3, 347, 3, 347, 3, 347, 3, 347, 3, 347, 3, 347,
-	3, 347, 3, 347, 3, 347, 3, 347, 3, 348, 3, 348, 3, 348, 3, 348, 3, 348,
-	3, 348, 3, 348, 3, 348, 3, 348, 3, 348, 3, 348, 3, 348, 3, 348, 3, 348,
-	3, 348, 3, 348, 3, 348, 3, 348, 3, 349, 3, 349, 3, 349, 3, 349, 3, 349,
-	3, 349, 3, 349, 3, 349, 3, 349, 3, 349, 3, 349, 3, 349, 3, 349, 3, 349,
-	3, 349, 3, 349, 3, 350, 3, 350, 3, 350, 3, 350, 3, 350, 3, 350, 3, 350,
-	3, 350, 3, 350, 3, 351, 3, 351, 3, 351, 3, 351, 3, 351, 3, 351, 3, 351,
-	3, 351, 3, 352, 3, 352, 3, 352, 3, 352, 3, 352, 3, 352, 3, 352, 3, 352,
-	3, 352, 3, 352, 3, 352, 3, 352, 3, 352, 3, 353, 3, 353, 3, 353, 3, 353,
-	3, 353, 3, 354, 3, 354, 3, 354, 3, 354, 3, 355, 3, 355, 3, 355, 3, 355,
-	3, 355, 3, 355, 3, 355, 3, 355, 3, 355, 3, 355, 3, 355, 3, 355, 3, 356,
-	3, 356, 3, 356, 3, 356, 3, 356, 3, 357, 3, 357, 3, 357, 3, 357, 3, 357,
-	3, 357, 3, 357, 3, 357, 3, 357, 3, 358, 3, 358, 3, 358, 3, 358, 3, 358,
-	3, 358, 3, 358, 3, 358, 3, 358, 3, 358, 3, 358, 3, 359, 3, 359, 3, 359,
-	3, 359, 3, 359, 3, 359, 3, 359, 3, 359, 3, 359, 3, 359, 3, 359, 3, 359,
-	3, 359, 3, 360, 3, 360, 3, 360, 3, 360, 3, 360, 3, 360, 3, 360, 3, 360,
-	3, 361, 3, 361, 3, 361, 3, 361, 3, 361, 3, 361, 3, 361, 3, 361, 3, 361,
-	3, 361, 3, 361, 3, 361, 3, 361, 3, 361, 3, 361, 3, 361, 3, 362, 3, 362,
-	3, 362, 3, 362, 3, 362, 3, 362, 3, 362, 3, 362, 3, 362, 3, 362, 3, 362,
-	3, 362, 3, 362, 3, 363, 3, 363, 3, 363, 3, 363, 3, 363, 3, 363, 3, 363,
-	3, 363, 3, 363, 3, 363, 3, 364, 3, 364, 3, 364, 3, 364, 3, 364, 3, 364,
-	3, 364, 3, 364, 3, 365, 3, 365, 3, 365, 3, 365, 3, 365, 3, 365, 3, 365,
-	3, 365, 3, 366, 3, 366, 3, 366, 3, 366, 3, 366, 3, 367, 3, 367, 3, 367,
-	3, 368, 3, 368, 3, 368, 3, 368, 3, 368, 3, 368, 3, 368, 3, 368, 3, 368,
-	3, 369, 3, 369, 3, 369, 3, 369, 3, 369, 3, 369, 3, 369, 3, 369, 3, 369,
-	3, 369, 3, 370, 3, 370, 3, 370, 3, 370, 3, 370, 3, 370, 3, 370, 3, 370,
-	3, 371, 3, 371, 3, 371, 3, 371, 3, 371, 3, 371, 3, 371, 3, 372, 3, 372,
-	3, 372, 3, 372, 3, 372, 3, 372, 3, 372, 3, 372, 3, 372, 3, 372, 3, 372,
-	3, 373, 3, 373, 3, 373, 3, 373, 3, 374, 3, 374, 3, 374, 3, 374, 3, 374,
-	3, 375, 3, 375, 3, 375, 3, 375, 3, 375, 3, 375, 3, 375, 3, 376, 3, 376,
-	3, 376, 3, 376, 3, 376, 3, 376, 3, 376, 3, 376, 3, 377, 3, 377, 3, 377,
-	3, 377, 3, 377, 3, 377, 3, 378, 3, 378, 3, 378, 3, 378, 3, 378, 3, 378,
-	3, 378, 3, 379, 3, 379, 3, 379, 3, 379, 3, 379, 3, 379, 3, 379, 3, 380,
-	3, 380, 3, 380, 3, 380, 3, 380, 3, 381, 3, 381, 3, 381, 3, 381, 3, 381,
-	3, 381, 3, 382, 3, 382, 3, 382, 3, 382, 3, 382, 3, 382, 3, 382, 3, 383,
-	3, 383, 3, 383, 3, 383, 3, 383, 3, 383, 3, 384, 3, 384, 3, 384, 3, 384,
-	3, 384, 3, 384, 3, 384, 3, 384, 3, 384, 3, 385, 3, 385, 3, 385, 3, 385,
-	3, 385, 3, 385, 3, 385, 3, 385, 3, 385, 3, 385, 3, 386, 3, 386, 3, 386,
-	3, 386, 3, 386, 3, 386, 3, 386, 3, 387, 3, 387, 3, 387, 3, 387, 3, 387,
-	3, 387, 3, 387, 3, 388, 3, 388, 3, 388, 3, 388, 3, 388, 3, 388, 3, 388,
-	3, 388, 3, 388, 3, 389, 3, 389, 3, 389, 3, 389, 3, 389, 3, 389, 3, 389,
-	3, 389, 3, 389, 3, 389, 3, 389, 3, 389, 3, 390, 3, 390, 3, 390, 3, 390,
-	3, 390, 3, 391, 3, 391, 3, 391, 3, 391, 3, 391, 3, 391, 3, 391, 3, 392,
-	3, 392, 3, 392, 3, 392, 3, 392, 3, 392, 3, 392, 3, 393, 3, 393, 3, 393,
-	3, 393, 3, 393, 3, 393, 3, 393, 3, 393, 3, 393, 3, 393, 3, 393, 3, 393,
-	3, 393, 3, 393, 3, 393, 3, 393, 3, 394, 3, 394, 3, 394, 3, 394, 3, 394,
-	3, 394, 3, 394, 3, 395, 3, 395, 3, 395, 3, 395, 3, 395, 3, 395, 3, 396,
-	3, 396, 3, 396, 3, 396, 3, 396, 3, 396, 3, 397, 3, 397, 3, 397, 3, 397,
-	3, 397, 3, 397, 3, 398, 3, 398, 3, 398, 3, 398, 3, 398, 3, 398, 3, 398,
-	3, 398, 3, 399, 3, 399, 3, 399, 3, 399, 3, 399, 3, 399, 3, 400, 3, 400,
-	3, 400, 3, 400, 3, 400, 3, 401, 3, 401, 3, 401, 3, 401, 3, 401, 3, 401,
-	3, 401, 3, 401, 3, 401, 3, 402, 3, 402, 3, 402, 3, 402, 3, 402, 3, 402,
-	3, 402, 3, 402, 3, 403, 3, 403, 3, 403, 3, 403, 3, 403, 3, 403, 3, 403,
-	3, 404, 3, 404, 3, 404, 3, 404, 3, 404, 3, 404, 3, 404, 3, 405, 3, 405,
-	3, 405, 3, 405, 3, 405, 3, 405, 3, 405, 3, 405, 3, 405, 3, 405, 3, 405,
-	3, 405, 3, 405, 3, 405, 3, 405, 3, 405, 3, 405, 3, 405, 3, 406, 3, 406,
-	3, 406, 3, 406, 3, 406, 3, 406, 3, 406, 3, 406, 3, 407, 3, 407, 3, 407,
-	3, 407, 3, 407, 3, 408, 3, 408, 3, 408, 3, 408, 3, 408, 3, 409, 3, 409,
-	3, 409, 3, 409, 3, 409, 3, 410, 3, 410, 3, 410, 3, 410, 3, 410, 3, 410,
-	3, 411, 3, 411, 3, 411, 3, 411, 3, 411, 3, 411, 3, 411, 3, 411, 3, 411,
-	3, 411, 3, 411, 3, 412, 3, 412, 3, 412, 3, 412, 3, 412, 3, 412, 3, 412,
-	3, 412, 3, 412, 3, 412, 3, 412, 3, 412, 3, 412, 3, 412, 3, 412, 3, 412,
-	3, 412, 3, 412, 3, 413, 3, 413, 3, 413, 3, 413, 3, 413, 3, 413, 3, 413,
-	3, 414, 3, 414, 3, 414, 3, 414, 3, 414, 3, 414, 3, 414, 3, 414, 3, 415,
-	3, 415, 3, 415, 3, 415, 3, 415, 3, 415, 3, 415, 3, 415, 3, 415, 3, 415,
-	3, 415, 3, 415, 3, 415, 3, 416, 3, 416, 3, 416, 3, 416, 3, 416, 3, 416,
-	3, 416, 3, 416, 3, 417, 3, 417, 3, 417, 3, 417, 3, 417, 3, 417, 3, 417,
-	3, 417, 3, 417, 3, 417, 3, 417, 3, 417, 3, 417, 3, 417, 3, 418, 3, 418,
-	3, 418, 3, 418, 3, 418, 3, 418, 3, 418, 3, 418, 3, 419, 3, 419, 3, 419,
-	3, 419, 3, 419, 3, 419, 3, 419, 3, 419, 3, 419, 3, 420, 3, 420, 3, 420,
-	3, 420, 3, 420, 3, 420, 3, 420, 3, 420, 3, 420, 3, 420, 3, 421, 3, 421,
-	3, 421, 3, 421, 3, 421, 3, 421, 3, 421, 3, 421, 3, 422, 3, 422, 3, 422,
-	3, 423, 3, 423, 3, 423, 3, 423, 3, 423, 3, 423, 3, 423, 3, 423, 3, 423,
-	3, 423, 3, 424, 3, 424, 3, 424, 3, 424, 3, 425, 3, 425, 3, 425, 3, 425,
-	3, 425, 3, 425, 3, 425, 3, 425, 3, 425, 3, 425, 3, 426, 3, 426, 3, 426,
-	3, 426, 3, 426, 3, 426, 3, 426, 3, 427, 3, 427, 3, 427, 3, 427, 3, 427,
-	3, 428, 3, 428, 3, 428, 3, 428, 3, 428, 3, 428, 3, 428, 3, 428, 3, 428,
-	3, 428, 3, 428, 3, 428, 3, 428, 3, 428, 3, 428, 3, 429, 3, 429, 3, 429,
-	3, 429, 3, 429, 3, 429, 3, 429, 3, 429, 3, 429, 3, 430, 3, 430, 3, 430,
-	3, 430, 3, 430, 3, 431, 3, 431, 3, 431, 3, 431, 3, 431, 3, 431, 3, 431,
-	3, 432, 3, 432, 3, 432, 3, 432, 3, 432, 3, 433, 3, 433, 3, 433, 3, 433,
-	3, 433, 3, 433, 3, 434, 3, 434, 3, 434, 3, 434, 3, 434, 3, 435, 3, 435,
-	3, 435, 3, 435, 3, 435, 3, 435, 3, 436, 3, 436, 3, 436, 3, 436, 3, 436,
-	3, 436, 3, 436, 3, 436, 3, 437, 3, 437, 3, 437, 3, 437, 3, 437, 3, 438,
-	3, 438, 3, 438, 3, 438, 3, 438, 3, 438, 3, 438, 3, 439, 3, 439, 3, 439,
-	3, 439, 3, 439, 3, 439, 3, 439, 3, 439, 3, 439, 3, 439, 3, 439, 3, 439,
-	3, 439, 3, 439, 3, 439, 3, 439, 3, 439, 3, 439, 3, 439, 3, 439, 3, 439,
-	3, 440, 3, 440, 3, 440, 3, 440, 3, 440, 3, 440, 3, 440, 3, 440, 3, 440,
-	3, 440, 3, 440, 3, 440, 3, 440, 3, 440, 3, 440, 3, 440, 3, 440, 3, 440,
-	3, 440, 3, 440, 3, 440, 3, 441, 3, 441, 3, 441, 3, 441, 3, 441, 3, 441,
-	3, 441, 3, 441, 3, 441, 3, 441, 3, 441, 3, 441, 3, 441, 3, 442, 3, 442,
-	3, 442, 3, 442, 3, 442, 3, 442, 3, 442, 3, 442, 3, 442, 3, 442, 3, 442,
-	3, 442, 3, 442, 3, 442, 3, 442, 3, 442, 3, 442, 3, 442, 3, 442, 3, 442,
-	3, 442, 3, 442, 3, 442, 3, 442, 3, 443, 3, 443, 3, 443, 3, 443, 3, 443,
-	3, 443, 3, 443, 3, 443, 3, 443, 3, 443, 3, 443, 3, 443, 3, 444, 3, 444,
-	3, 444, 3, 444, 3, 444, 3, 444, 3, 444, 3, 444, 3, 444, 3, 444, 3, 444,
-	3, 444, 3, 444, 3, 444, 3, 444, 3, 444, 3, 445, 3, 445, 3, 445, 3, 445,
-	3, 445, 3, 445, 3, 445, 3, 445, 3, 445, 3, 445, 3, 445, 3, 445, 3, 445,
-	3, 445, 3, 445, 3, 446, 3, 446, 3, 446, 3, 446, 3, 446, 3, 446, 3, 446,
-	3, 446, 3, 446, 3, 446, 3, 446, 3, 446, 3, 446, 3, 446, 3, 446, 3, 446,
-	3, 447, 3, 447, 3, 447, 3, 447, 3, 447, 3, 447, 3, 447, 3, 447, 3, 447,
-	3, 447, 3, 447, 3, 447, 3, 448, 3, 448, 3, 448, 3, 448, 3, 448, 3, 448,
-	3, 448, 3, 448, 3, 448, 3, 448, 3, 448, 3, 448, 3, 448, 3, 448, 3, 448,
-	3, 448, 3, 448, 3, 448, 3, 448, 3, 449, 3, 449, 3, 449, 3, 449, 3, 449,
-	3, 449, 3, 449, 3, 449, 3, 449, 3, 449, 3, 449, 3, 450, 3, 450, 3, 450,
-	3, 450, 3, 450, 3, 450, 3, 450, 3, 450, 3, 450, 3, 450, 3, 450, 3, 450,
-	3, 450, 3, 450, 3, 451, 3, 451, 3, 451, 3, 451, 3, 451, 3, 451, 3, 451,
-	3, 451, 3, 451, 3, 451, 3, 451, 3, 451, 3, 451, 3, 451, 3, 451, 3, 451,
-	3, 451, 3, 451, 3, 452, 3, 452, 3, 452, 3, 452, 3, 452, 3, 452, 3, 452,
-	3, 452, 3, 452, 3, 452, 3, 452, 3, 452, 3, 452, 3, 452, 3, 452, 3, 452,
-	3, 453, 3, 453, 3, 453, 3, 453, 3, 453, 3, 453, 3, 453, 3, 453, 3, 453,
-	3, 453, 3, 453, 3, 453, 3, 453, 3, 453, 3, 453, 3, 453, 3, 453, 3, 453,
-	3, 454, 3, 454, 3, 454, 3, 454, 3, 454, 3, 454, 3, 454, 3, 454, 3, 454,
-	3, 454, 3, 454, 3, 454, 3, 454, 3, 454, 3, 454, 3, 455, 3, 455, 3, 455,
-	3, 455, 3, 455, 3, 455, 3, 455, 3, 455, 3, 455, 3, 455, 3, 455, 3, 455,
-	3, 455, 3, 455, 3, 455, 3, 455, 3, 455, 3, 455, 3, 455, 3, 456, 3, 456,
-	3, 456, 3, 456, 3, 456, 3, 456, 3, 456, 3, 456, 3, 456, 3, 456, 3, 456,
-	3, 456, 3, 456, 3, 456, 3, 456, 3, 457, 3, 457, 3, 457, 3, 457, 3, 457,
-	3, 457, 3, 457, 3, 457, 3, 457, 3, 457, 3, 457, 3, 457, 3, 457, 3, 457,
-	3, 457, 3, 457, 3, 457, 3, 457, 3, 457, 3, 458, 3, 458, 3, 458, 3, 458,
-	3, 458, 3, 458, 3, 458, 3, 458, 3, 458, 3, 458, 3, 458, 3, 458, 3, 459,
-	3, 459, 3, 459, 3, 459, 3, 459, 3, 459, 3, 459, 3, 459, 3, 459, 3, 459,
-	3, 459, 3, 459, 3, 459, 3, 459, 3, 459, 3, 459, 3, 459, 3, 459, 3, 459,
-	3, 459, 3, 459, 3, 459, 3, 459, 3, 459, 3, 459, 3, 460, 3, 460, 3, 460,
-	3, 460, 3, 460, 3, 460, 3, 460, 3, 460, 3, 460, 3, 460, 3, 460, 3, 460,
-	3, 460, 3, 460, 3, 460, 3, 460, 3, 460, 3, 460, 3, 460, 3, 460, 3, 460,
-	3, 461, 3, 461, 3, 461, 3, 461, 3, 461, 3, 461, 3, 461, 3, 461, 3, 461,
-	3, 462, 3, 462, 3, 462, 3, 462, 3, 462, 3, 462, 3, 462, 3, 462, 3, 462,
-	3, 463, 3, 463, 3, 463, 3, 463, 3, 463, 3, 463, 3, 463, 3, 463, 3, 463,
-	3, 463, 3, 463, 3, 463, 3, 463, 3, 463, 3, 463, 3, 463, 3, 463, 3, 463,
-	3, 463, 3, 463, 3, 463, 3, 464, 3, 464, 3, 464, 3, 464, 3, 464, 3, 464,
-	3, 464, 3, 464, 3, 464, 3, 464, 3, 464, 3, 464, 3, 464, 3, 464, 3, 464,
-	3, 464, 3, 464, 3, 464, 3, 464, 3, 464, 3, 464, 3, 465, 3, 465, 3, 465,
-	3, 465, 3, 465, 3, 465, 3, 465, 3, 466, 3, 466, 3, 466, 3, 466, 3, 466,
-	3, 466, 3, 466, 3, 467, 3, 467, 3, 467, 3, 467, 3, 467, 3, 467, 3, 468,
-	3, 468, 3, 468, 3, 468, 3, 468, 3, 468, 3, 468, 3, 468, 3, 468, 3, 468,
-	3, 468, 3, 468, 3, 468, 3, 469, 3, 469, 3, 469, 3, 469, 3, 470, 3, 470,
-	3, 470, 3, 470, 3, 470, 3, 470, 3, 470, 3, 470, 3, 471, 3, 471, 3, 471,
-	3, 471, 3, 471, 3, 471, 3, 471, 3, 471, 3, 471, 3, 472, 3, 472, 3, 472,
-	3, 472, 3, 472, 3, 473, 3, 473, 3, 473, 3, 473, 3, 473, 3, 473, 3, 473,
-	3, 474, 3, 474, 3, 474, 3, 474, 3, 474, 3, 474, 3, 475, 3, 475, 3, 475,
-	3, 475, 3, 475, 3, 475, 3, 476, 3, 476, 3, 476, 3, 476, 3, 476, 3, 476,
-	3, 476, 3, 476, 3, 476, 3, 476, 3, 476, 3, 476, 3, 477, 3, 477, 3, 477,
-	3, 477, 3, 477, 3, 478, 3, 478, 3, 478, 3, 478, 3, 478, 3, 478, 3, 479,
-	3, 479, 3, 479, 3, 479, 3, 479, 3, 479, 3, 480, 3, 480, 3, 480, 3, 480,
-	3, 480, 3, 480, 3, 481, 3, 481, 3, 481, 3, 481, 3, 481, 3, 482, 3, 482,
-	3, 482, 3, 483, 3, 483, 3, 483, 3, 483, 3, 483, 3, 483, 3, 483, 3, 483,
-	3, 483, 3, 483, 3, 484, 3, 484, 3, 484, 3, 484, 3, 484, 3, 485, 3, 485,
-	3, 485, 3, 485, 3, 485, 3, 485, 3, 485, 3, 485, 3, 486, 3, 486, 3, 486,
-	3, 486, 3, 486, 3, 486, 3, 486, 3, 487, 3, 487, 3, 487, 3, 488, 3, 488,
-	3, 488, 3, 489, 3, 489, 3, 489, 3, 489, 3, 489, 3, 489, 3, 489, 3, 489,
-	3, 489, 3, 489, 3, 489, 3, 489, 3, 489, 3, 490, 3, 490, 3, 490, 3, 490,
-	3, 491, 3, 491, 3, 491, 3, 491, 3, 491, 3, 491, 3, 491, 3, 492, 3, 492,
-	3, 492, 3, 492, 3, 492, 3, 493, 3, 493, 3, 493, 3, 493, 3, 493, 3, 494,
-	3, 494, 3, 494, 3, 494, 3, 494, 3, 494, 3, 494, 3, 494, 3, 494, 3, 494,
-	3, 494, 3, 494, 3, 494, 3, 494, 3, 494, 3, 494, 3, 495, 3, 495, 3, 495,
-	3, 495, 3, 495, 3, 495, 3, 495, 3, 495, 3, 496, 3, 496, 3, 496, 3, 496,
-	3, 496, 3, 496, 3, 497, 3, 497, 3, 497, 3, 497, 3, 497, 3, 497, 3, 497,
-	3, 497, 3, 497, 3, 497, 3, 498, 3, 498, 3, 498, 3, 498, 3, 498, 3, 499,
-	3, 499, 3, 499, 3, 499, 3, 499, 3, 499, 3, 499, 3, 500, 3, 500, 3, 500,
-	3, 500, 3, 500, 3, 500, 3, 500, 3, 500, 3, 501, 3, 501, 3, 501, 3, 501,
-	3, 501, 3, 501, 3, 501, 3, 501, 3, 501, 3, 501, 3, 501, 3, 501, 3, 501,
-	3, 502, 3, 502, 3, 502, 3, 502, 3, 502, 3, 502, 3, 502, 3, 502, 3, 502,
-	3, 502, 3, 502, 3, 503, 3, 503, 3, 503, 3, 503, 3, 503, 3, 503, 3, 503,
-	3, 503, 3, 503, 3, 504, 3, 504, 3, 504, 3, 504, 3, 504, 3, 504, 3, 505,
-	3, 505, 3, 505, 3, 505, 3, 505, 3, 505, 3, 505, 3, 506, 3, 506, 3, 506,
-	3, 506, 3, 506, 3, 506, 3, 506, 3, 506, 3, 506, 3, 506, 3, 506, 3, 507,
-	3, 507, 3, 507, 3, 507, 3, 507, 3, 507, 3, 507, 3, 507, 3, 508, 3, 508,
-	3, 508, 3, 508, 3, 508, 3, 509, 3, 509, 3, 509, 3, 509, 3, 509, 3, 509,
-	3, 509, 3, 509, 3, 509, 3, 510, 3, 510, 3, 510, 3, 510, 3, 510, 3, 510,
-	3, 510, 3, 510, 3, 511, 3, 511, 3, 511, 3, 511, 3, 511, 3, 511, 3, 511,
-	3, 511, 3, 511, 3, 512, 3, 512, 3, 512, 3, 512, 3, 512, 3, 513, 3, 513,
-	3, 513, 3, 513, 3, 513, 3, 513, 3, 513, 3, 513, 3, 513, 3, 513, 3, 513,
-	3, 513, 3, 514, 3, 514, 3, 514, 3, 514, 3, 514, 3, 514, 3, 514, 3, 514,
-	3, 515, 3, 515, 3, 515, 3, 515, 3, 515, 3, 515, 3, 515, 3, 515, 3, 515,
-	3, 516, 3, 516, 3, 516, 3, 516, 3, 516, 3, 516, 3, 517, 3, 517, 3, 517,
-	3, 517, 3, 517, 3, 517, 3, 518, 3, 518, 3, 518, 3, 518, 3, 518, 3, 518,
-	3, 519, 3, 519, 3, 519, 3, 519, 3, 519, 3, 519, 3, 519, 3, 519, 3, 520,
-	3, 520, 3, 520, 3, 520, 3, 520, 3, 520, 3, 520, 3, 520, 3, 521, 3, 521,
-	3, 521, 3, 521, 3, 521, 3, 521, 3, 521, 3, 521, 3, 521, 3, 521, 3, 521,
-	3, 521, 3, 521, 3, 521, 3, 521, 3, 521, 3, 521, 3, 522, 3, 522, 3, 522,
-	3, 522, 3, 522, 3, 522, 3, 522, 3, 522, 3, 522, 3, 522, 3, 523, 3, 523,
-	3, 523, 3, 523, 3, 523, 3, 523, 3, 524, 3, 524, 3, 524, 3, 524, 3, 524,
-	3, 524, 3, 524, 3, 524, 3, 524, 3, 524, 3, 524, 3, 524, 3, 524, 3, 524,
-	3, 524, 3, 525, 3, 525, 3, 525, 3, 525, 3, 525, 3, 525, 3, 525, 3, 525,
-	3, 525, 3, 525, 3, 525, 3, 525, 3, 525, 3, 525, 3, 526, 3, 526, 3, 526,
-	3, 526, 3, 526, 3, 526, 3, 526, 3, 526, 3, 526, 3, 527, 3, 527, 3, 527,
-	3, 527, 3, 527, 3, 527, 3, 527, 3, 528, 3, 528, 3, 528, 3, 528, 3, 528,
-	3, 528, 3, 528, 3, 528, 3, 528, 3, 528, 3, 528, 3, 529, 3, 529, 3, 529,
-	3, 529, 3, 529, 3, 529, 3, 529, 3, 530, 3, 530, 3, 530, 3, 530, 3, 530,
-	3, 530, 3, 530, 3, 530, 3, 530, 3, 530, 3, 530, 3, 530, 3, 530, 3, 530,
-	3, 530, 3, 530, 3, 531, 3, 531, 3, 531, 3, 531, 3, 531, 3, 531, 3, 531,
-	3, 531, 3, 531, 3, 531, 3, 531, 3, 531, 3, 531, 3, 531, 3, 531, 3, 531,
-	3, 531, 3, 531, 3, 531, 3, 532, 3, 532, 3, 532, 3, 532, 3, 532, 3, 532,
-	3, 532, 3, 532, 3, 532, 3, 532, 3, 532, 3, 532, 3, 532, 3, 532, 3, 532,
-	3, 532, 3, 532, 3, 532, 3, 532, 3, 532, 3, 533, 3, 533, 3, 533, 3, 533,
-	3, 533, 3, 533, 3, 533, 3, 533, 3, 533, 3, 533, 3, 533, 3, 533, 3, 533,
-	3, 533, 3, 533, 3, 533, 3, 533, 3, 533, 3, 533, 3, 533, 3, 533, 3, 533,
-	3, 533, 3, 534, 3, 534, 3, 534, 3, 534, 3, 534, 3, 534, 3, 534, 3, 534,
-	3, 534, 3, 534, 3, 534, 3, 534, 3, 534, 3, 534, 3, 534, 3, 534, 3, 534,
-	3, 534, 3, 534, 3, 534, 3, 534, 3, 535, 3, 535, 3, 535, 3, 535, 3, 535,
-	3, 535, 3, 535, 3, 535, 3, 535, 3, 535, 3, 535, 3, 535, 3, 535, 3, 535,
-	3, 535, 3, 535, 3, 535, 3, 535, 3, 535, 3, 535, 3, 535, 3, 535, 3, 535,
-	3, 535, 3, 536, 3, 536, 3, 536, 3, 536, 3, 536, 3, 536, 3, 536, 3, 536,
-	3, 536, 3, 536, 3, 536, 3, 536, 3, 536, 3, 536, 3, 536, 3, 536, 3, 536,
-	3, 536, 3, 536, 3, 536, 3, 536, 3, 536, 3, 536, 3, 536, 3, 536, 3, 536,
-	3, 536, 3, 536, 3, 537, 3, 537, 3, 537, 3, 537, 3, 537, 3, 537, 3, 537,
-	3, 537, 3, 537, 3, 537, 3, 537, 3, 537, 3, 538, 3, 538, 3, 538, 3, 538,
-	3, 538, 3, 538, 3, 539, 3, 539, 3, 539, 3, 539, 3, 539, 3, 539, 3, 539,
-	3, 540, 3, 540, 3, 540, 3, 540, 3, 540, 3, 540, 3, 540, 3, 540, 3, 540,
-	3, 540, 3, 540, 3, 540, 3, 540, 3, 540, 3, 540, 3, 540, 3, 540, 3, 540,
-	3, 541, 3, 541, 3, 541, 3, 541, 3, 541, 3, 541, 3, 541, 3, 541, 3, 541,
-	3, 541, 3, 542, 3, 542, 3, 542, 3, 542, 3, 542, 3, 542, 3, 542, 3, 542,
-	3, 543, 3, 543, 3, 543, 3, 543, 3, 543, 3, 544, 3, 544, 3, 544, 3, 544,
-	3, 544, 3, 544, 3, 544, 3, 544, 3, 544, 3, 545, 3, 545, 3, 545, 3, 545,
-	3, 545, 3, 545, 3, 545, 3, 546, 3, 546, 3, 546, 3, 546, 3, 546, 3, 546,
-	3, 546, 3, 547, 3, 547, 3, 547, 3, 547, 3, 548, 3, 548, 3, 548, 3, 548,
-	3, 548, 3, 549, 3, 549, 3, 549, 3, 549, 3, 549, 3, 549, 3, 549, 3, 549,
-	3, 549, 3, 549, 3, 549, 3, 550, 3, 550, 3, 550, 3, 550, 3, 550, 3, 550,
-	3, 550, 3, 550, 3, 550, 3, 550, 3, 551, 3, 551, 3, 551, 3, 551, 3, 551,
-	3, 551, 3, 551, 3, 551, 3, 551, 3, 552, 3, 552, 3, 552, 3, 552, 3, 552,
-	3, 552, 3, 552, 3, 552, 3, 552, 3, 553, 3, 553, 3, 553, 3, 553, 3, 553,
-	3, 553, 3, 553, 3, 554, 3, 554, 3, 554, 3, 554, 3, 554, 3, 554, 3, 554,
-	3, 554, 3, 555, 3, 555, 3, 555, 3, 555, 3, 555, 3, 555, 3, 556, 3, 556,
-	3, 556, 3, 556, 3, 556, 3, 556, 3, 556, 3, 557, 3, 557, 3, 557, 3, 557,
-	3, 557, 3, 557, 3, 557, 3, 558, 3, 558, 3, 558, 3, 558, 3, 558, 3, 558,
-	3, 558, 3, 559, 3, 559, 3, 559, 3, 559, 3, 559, 3, 559, 3, 560, 3, 560,
-	3, 560, 3, 560, 3, 560, 3, 561, 3, 561, 3, 561, 3, 561, 3, 561, 3, 561,
-	3, 561, 3, 561, 3, 561, 3, 562, 3, 562, 3, 562, 3, 562, 3, 562, 3, 562,
-	3, 562, 3, 563, 3, 563, 3, 563, 3, 563, 3, 563, 3, 564, 3, 564, 3, 564,
-	3, 564, 3, 564, 3, 564, 3, 564, 3, 565, 3, 565, 3, 565, 3, 565, 3, 565,
-	3, 565, 3, 565, 3, 566, 3, 566, 3, 566, 3, 566, 3, 566, 3, 566, 3, 566,
-	3, 567, 3, 567, 3, 567, 3, 567, 3, 567, 3, 567, 3, 567, 3, 567, 3, 567,
-	3, 567, 3, 567, 3, 567, 3, 567, 3, 567, 3, 567, 3, 567, 3, 568, 3, 568,
-	3, 568, 3, 568, 3, 568, 3, 568, 3, 568, 3, 568, 3, 568, 3, 568, 3, 568,
-	3, 568, 3, 568, 3, 568, 3, 568, 3, 568, 3, 568, 3, 568, 3, 568, 3, 569,
-	3, 569, 3, 569, 3, 569, 3, 569, 3, 569, 3, 569, 3, 569, 3, 569, 3, 569,
-	3, 569, 3, 569, 3, 569, 3, 569, 3, 569, 3, 569, 3, 569, 3, 570, 3, 570,
-	3, 570, 3, 570, 3, 570, 3, 570, 3, 570, 3, 570, 3, 570, 3, 570, 3, 570,
-	3, 570, 3, 570, 3, 570, 3, 570, 3, 570, 3, 570, 3, 570, 3, 571, 3, 571,
-	3, 571, 3, 571, 3, 571, 3, 571, 3, 571, 3, 571, 3, 571, 3, 571, 3, 572,
-	3, 572, 3, 572, 3, 572, 3, 572, 3, 572, 3, 572, 3, 572, 3, 572, 3, 572,
-	3, 572, 3, 572, 3, 572, 3, 573, 3, 573, 3, 573, 3, 573, 3, 573, 3, 573,
-	3, 573, 3, 573, 3, 573, 3, 573, 3, 573, 3, 574, 3, 574, 3, 574, 3, 574,
-	3, 574, 3, 574, 3, 575, 3, 575, 3, 575, 3, 575, 3, 575, 3, 575, 3, 575,
-	3, 576, 3, 576, 3, 576, 3, 576, 3, 576, 3, 576, 3, 576, 3, 576, 3, 576,
-	3, 576, 3, 576, 3, 576, 3, 576, 3, 576, 3, 576, 3, 576, 3, 576, 3, 576,
-	3, 577, 3, 577, 3, 577, 3, 577, 3, 577, 3, 577, 3, 577, 3, 577, 3, 577,
-	3, 577, 3, 577, 3, 577, 3, 577, 3, 577, 3, 577, 3, 577, 3, 577, 3, 578,
-	3, 578, 3, 578, 3, 578, 3, 578, 3, 578, 3, 578, 3, 578, 3, 578, 3, 578,
-	3, 578, 3, 578, 3, 578, 3, 578, 3, 578, 3, 578, 3, 578, 3, 578, 3, 578,
-	3, 579, 3, 579, 3, 579, 3, 579, 3, 579, 3, 579, 3, 579, 3, 580, 3, 580,
-	3, 580, 3, 580, 3, 580, 3, 581, 3, 581, 3, 581, 3, 581, 3, 581, 3, 581,
-	3, 581, 3, 581, 3, 582, 3, 582, 3, 582, 3, 582, 3, 582, 3, 582, 3, 582,
-	3, 583, 3, 583, 3, 583, 3, 583, 3, 583, 3, 583, 3, 583, 3, 584, 3, 584,
-	3, 584, 3, 584, 3, 584, 3, 584, 3, 584, 3, 584, 3, 584, 3, 584, 3, 584,
-	3, 584, 3, 584, 3, 584, 3, 584, 3, 584, 3, 585, 3, 585, 3, 585, 3, 585,
-	3, 585, 3, 585, 3, 585, 3, 585, 3, 586, 3, 586, 3, 586, 3, 586, 3, 586,
-	3, 586, 3, 586, 3, 586, 3, 586, 3, 586, 3, 586, 3, 586, 3, 586, 3, 587,
-	3, 587, 3, 587, 3, 587, 3, 587, 3, 587, 3, 587, 3, 587, 3, 587, 3, 587,
-	3, 587, 3, 587, 3, 587, 3, 587, 3, 588, 3, 588, 3, 588, 3, 588, 3, 588,
-	3, 588, 3, 588, 3, 588, 3, 589, 3, 589, 3, 589, 3, 589, 3, 589, 3, 589,
-	3, 590, 3, 590, 3, 590, 3, 590, 3, 590, 3, 590, 3, 590, 3, 590, 3, 590,
-	3, 591, 3, 591, 3, 591, 3, 591, 3, 591, 3, 591, 3, 591, 3, 591, 3, 591,
-	3, 591, 3, 591, 3, 592, 3, 592, 3, 592, 3, 592, 3, 592, 3, 592, 3, 592,
-	3, 592, 3, 592, 3, 592, 3, 592, 3, 593, 3, 593, 3, 593, 3, 593, 3, 593,
-	3, 593, 3, 593, 3, 593, 3, 593, 3, 593, 3, 594, 3, 594, 3, 594, 3, 594,
-	3, 594, 3, 594, 3, 594, 3, 594, 3, 594, 3, 594, 3, 595, 3, 595, 3, 595,
-	3, 595, 3, 595, 3, 596, 3, 596, 3, 596, 3, 596, 3, 596, 3, 596, 3, 596,
-	3, 596, 3, 596, 3, 596, 3, 596, 3, 596, 3, 597, 3, 597, 3, 597, 3, 597,
-	3, 597, 3, 597, 3, 597, 3, 597, 3, 597, 3, 597, 3, 597, 3, 597, 3, 598,
-	3, 598, 3, 598, 3, 598, 3, 598, 3, 598, 3, 598, 3, 598, 3, 598, 3, 598,
-	3, 598, 3, 598, 3, 598, 3, 598, 3, 599, 3, 599, 3, 599, 3, 599, 3, 599,
-	3, 599, 3, 599, 3, 599, 3, 599, 3, 600, 3, 600, 3, 600, 3, 600, 3, 600,
-	3, 600, 3, 600, 3, 600, 3, 600, 3, 601, 3, 601, 3, 601, 3, 601, 3, 601,
-	3, 601, 3, 601, 3, 601, 3, 601, 3, 601, 3, 602, 3, 602, 3, 602, 3, 602,
-	3, 602, 3, 602, 3, 602, 3, 602, 3, 602, 3, 603, 3, 603, 3, 603, 3, 603,
-	3, 603, 3, 603, 3, 603, 3, 603, 3, 603, 3, 603, 3, 603, 3, 603, 3, 603,
-	3, 603, 3, 603, 3, 603, 3, 603, 3, 604, 3, 604, 3, 604, 3, 604, 3, 604,
-	3, 604, 3, 604, 3, 604, 3, 604, 3, 604, 3, 605, 3, 605, 3, 605, 3, 605,
-	3, 605, 3, 605, 3, 605, 3, 605, 3, 606, 3, 606, 3, 606, 3, 606, 3, 606,
-	3, 606, 3, 607, 3, 607, 3, 607, 3, 607, 3, 607, 3, 607, 3, 607, 3, 607,
-	3, 608, 3, 608, 3, 608, 3, 608, 3, 608, 3, 609, 3, 609, 3, 609, 3, 609,
-	3, 609, 3, 609, 3, 609, 3, 609, 3, 610, 3, 610, 3, 610, 3, 610, 3, 610,
-	3, 610, 3, 610, 3, 610, 3, 610, 3, 610, 3, 610, 3, 610, 3, 610, 3, 610,
-	3, 610, 3, 611, 3, 611, 3, 611, 3, 611, 3, 611, 3, 611, 3, 611, 3, 611,
-	3, 611, 3, 611, 3, 611, 3, 612, 3, 612, 3, 612, 3, 612, 3, 612, 3, 612,
-	3, 613, 3, 613, 3, 613, 3, 613, 3, 613, 3, 613, 3, 613, 3, 613, 3, 613,
-	3, 613, 3, 614, 3, 614, 3, 614, 3, 614, 3, 614, 3, 615, 3, 615, 3, 615,
-	3, 615, 3, 615, 3, 615, 3, 615, 3, 615, 3, 616, 3, 616, 3, 616, 3, 616,
-	3, 616, 3, 616, 3, 616, 3, 616, 3, 617, 3, 617, 3, 617, 3, 617, 3, 617,
-	3, 618, 3, 618, 3, 618, 3, 618, 3, 618, 3, 618, 3, 618, 3, 618, 3, 618,
-	3, 619, 3, 619, 3, 619, 3, 619, 3, 619, 3, 619, 3, 619, 3, 619, 3, 620,
-	3, 620, 3, 620, 3, 620, 3, 620, 3, 621, 3, 621, 3, 621, 3, 621, 3, 621,
-	3, 621, 3, 621, 3, 621, 3, 622, 3, 622, 3, 622, 3, 622, 3, 622, 3, 623,
-	3, 623, 3, 623, 3, 624, 3, 624, 3, 624, 3, 624, 3, 625, 3, 625, 3, 625,
-	3, 625, 3, 626, 3, 626, 3, 626, 3, 626, 3, 627, 3, 627, 3, 627, 3, 627,
-	3, 628, 3, 628, 3, 628, 3, 628, 3, 629, 3, 629, 3, 629, 3, 629, 3, 629,
-	3, 629, 3, 629, 3, 629, 3, 629, 3, 630, 3, 630, 3, 630, 3, 630, 3, 630,
-	3, 630, 3, 630, 3, 630, 3, 631, 3, 631, 3, 631, 3, 631, 3, 631, 3, 631,
-	3, 632, 3, 632, 3, 632, 3, 632, 3, 633, 3, 633, 3, 633, 3, 633, 3, 633,
-	3, 634, 3, 634, 3, 634, 3, 634, 3, 634, 3, 634, 3, 634, 3, 635, 3, 635,
-	3, 635, 3, 635, 3, 635, 3, 636, 3, 636, 3, 636, 3, 636, 3, 636, 3, 636,
-	3, 636, 3, 637, 3, 637, 3, 637, 3, 637, 3, 637, 3, 637, 3, 637, 3, 637,
-	3, 637, 3, 637, 3, 637, 3, 637, 3, 638, 3, 638, 3, 638, 3, 638, 3, 638,
-	3, 638, 3, 638, 3, 639, 3, 639, 3, 639, 3, 639, 3, 639, 3, 639, 3, 639,
-	3, 639, 3, 640, 3, 640, 3, 640, 3, 640, 3, 640, 3, 640, 3, 640, 3, 640,
-	3, 641, 3, 641, 3, 641, 3, 641, 3, 641, 3, 642, 3, 642, 3, 642, 3, 642,
-	3, 642, 3, 642, 3, 642, 3, 642, 3, 643, 3, 643, 3, 643, 3, 643, 3, 643,
-	3, 643, 3, 643, 3, 644, 3, 644, 3, 644, 3, 644, 3, 644, 3, 644, 3, 644,
-	3, 644, 3, 644, 3, 645, 3, 645, 3, 645, 3, 645, 3, 645, 3, 645, 3, 646,
-	3, 646, 3, 646, 3, 646, 3, 646, 3, 646, 3, 646, 3, 646, 3, 646, 3, 646,
-	3, 646, 3, 647, 3, 647, 3, 647, 3, 647, 3, 647, 3, 647, 3, 647, 3, 647,
-	3, 647, 3, 647, 3, 647, 3, 647, 3, 647, 3, 647, 3, 647, 3, 647, 3, 647,
-	3, 647, 3, 647, 3, 647, 3, 647, 3, 647, 3, 647, 3, 647, 3, 647, 3, 647,
-	3, 647, 3, 648, 3, 648, 3, 648, 3, 648, 3, 648, 3, 648, 3, 648, 3, 648,
-	3, 648, 3, 648, 3, 648, 3, 648, 3, 649, 3, 649, 3, 649, 3, 649, 3, 649,
-	3, 649, 3, 649, 3, 649, 3, 649, 3, 649, 3, 649, 3, 649, 3, 649, 3, 650,
-	3, 650, 3, 650, 3, 650, 3, 650, 3, 650, 3, 650, 3, 650, 3, 650, 3, 650,
-	3, 650, 3, 650, 3, 650, 3, 651, 3, 651, 3, 651, 3, 651, 3, 651, 3, 651,
-	3, 651, 3, 651, 3, 651, 3, 651, 3, 651, 3, 651, 3, 651, 3, 651, 3, 651,
-	3, 651, 3, 651, 3, 651, 3, 651, 3, 651, 3, 651, 3, 651, 3, 651, 3, 651,
-	3, 652, 3, 652, 3, 652, 3, 652, 3, 652, 3, 652, 3, 652, 3, 652, 3, 652,
-	3, 652, 3, 652, 3, 652, 3, 653, 3, 653, 3, 653, 3, 653, 3, 653, 3, 653,
-	3, 653, 3, 653, 3, 653, 3, 653, 3, 653, 3, 653, 3, 653, 3, 653, 3, 653,
-	3, 653, 3, 653, 3, 654, 3, 654, 3, 654, 3, 654, 3, 654, 3, 654, 3, 654,
-	3, 654, 3, 654, 3, 654, 3, 654, 3, 654, 3, 654, 3, 654, 3, 654, 3, 654,
-	3, 654, 3, 654, 3, 654, 3, 654, 3, 654, 3, 655, 3, 655, 3, 655, 3, 655,
-	3, 655, 3, 655, 3, 655, 3, 655, 3, 655, 3, 655, 3, 655, 3, 655, 3, 655,
-	3, 655, 3, 655, 3, 656, 3, 656, 3, 656, 3, 656, 3, 656, 3, 656, 3, 656,
-	3, 656, 3, 656, 3, 656, 3, 656, 3, 656, 3, 656, 3, 656, 3, 657, 3, 657,
-	3, 657, 3, 657, 3, 657, 3, 657, 3, 657, 3, 657, 3, 657, 3, 657, 3, 657,
-	3, 657, 3, 657, 3, 657, 3, 657, 3, 657, 3, 657, 3, 657, 3, 657, 3, 657,
-	3, 657, 3, 657, 3, 658, 3, 658, 3, 658, 3, 658, 3, 658, 3, 658, 3, 658,
-	3, 658, 3, 658, 3, 658, 3, 658, 3, 658, 3, 658, 3, 659, 3, 659, 3, 659,
-	3, 659, 3, 659, 3, 659, 3, 659, 3, 659, 3, 659, 3, 659, 3, 659, 3, 659,
-	3, 659, 3, 660, 3, 660, 3, 660, 3, 660, 3, 660, 3, 660, 3, 660, 3, 660,
-	3, 660, 3, 660, 3, 660, 3, 660, 3, 660, 3, 660, 3, 660, 3, 660, 3, 660,
-	3, 660, 3, 660, 3, 660, 3, 660, 3, 661, 3, 661, 3, 661, 3, 661, 3, 661,
-	3, 661, 3, 661, 3, 661, 3, 661, 3, 661, 3, 661, 3, 661, 3, 661, 3, 661,
-	3, 661, 3, 661, 3, 661, 3, 661, 3, 661, 3, 661, 3, 661, 3, 661, 3, 661,
-	3, 661, 3, 662, 3, 662, 3, 662, 3, 662, 3, 662, 3, 662, 3, 662, 3, 662,
-	3, 662, 3, 662, 3, 662, 3, 662, 3, 662, 3, 662, 3, 662, 3, 662, 3, 662,
-	3, 662, 3, 662, 3, 662, 3, 662, 3, 662, 3, 662, 3, 662, 3, 663, 3, 663,
-	3, 663, 3, 663, 3, 663, 3, 663, 3, 663, 3, 663, 3, 663, 3, 663, 3, 663,
-	3, 663, 3, 663, 3, 663, 3, 663, 3, 663, 3, 663, 3, 663, 3, 663, 3, 663,
-	3, 663, 3, 663, 3, 663, 3, 664, 3, 664, 3, 664, 3, 664, 3, 664, 3, 664,
-	3, 664, 3, 664, 3, 664, 3, 664, 3, 664, 3, 664, 3, 664, 3, 664, 3, 664,
-	3, 664, 3, 665, 3, 665, 3, 665, 3, 665, 3, 665, 3, 665, 3, 665, 3, 665,
-	3, 665, 3, 665, 3, 665, 3, 665, 3, 665, 3, 665, 3, 665, 3, 665, 3, 665,
-	3, 665, 3, 665, 3, 665, 3, 665, 3, 665, 3, 665, 3, 665, 3, 665, 3, 665,
-	3, 665, 3, 666, 3, 666, 3, 666, 3, 666, 3, 666, 3, 666, 3, 666, 3, 666,
-	3, 666, 3, 666, 3, 666, 3, 666, 3, 666, 3, 666, 3, 666, 3, 666, 3, 666,
-	3, 666, 3, 666, 3, 666, 3, 667, 3, 667, 3, 667, 3, 667, 3, 667, 3, 667,
-	3, 667, 3, 667, 3, 667, 3, 667, 3, 667, 3, 667, 3, 667, 3, 667, 3, 667,
-	3, 667, 3, 667, 3, 667, 3, 667, 3, 667, 3, 667, 3, 667, 3, 667, 3, 667,
-	3, 668, 3, 668, 3, 668, 3, 668, 3, 668, 3, 668, 3, 668, 3, 668, 3, 668,
-	3, 668, 3, 668, 3, 668, 3, 668, 3, 668, 3, 668, 3, 668, 3, 668, 3, 668,
-	3, 668, 3, 668, 3, 668, 3, 669, 3, 669, 3, 669, 3, 669, 3, 669, 3, 669,
-	3, 669, 3, 669, 3, 669, 3, 669, 3, 669, 3, 669, 3, 669, 3, 669, 3, 669,
-	3, 669, 3, 669, 3, 669, 3, 669, 3, 669, 3, 670, 3, 670, 3, 670, 3, 670,
-	3, 670, 3, 670, 3, 670, 3, 670, 3, 670, 3, 670, 3, 670, 3, 671, 3, 671,
-	3, 671, 3, 671, 3, 671, 3, 671, 3, 671, 3, 671, 3, 671, 3, 671, 3, 671,
-	3, 671, 3, 671, 3, 671, 3, 671, 3, 671, 3, 671, 3, 671, 3, 671, 3, 671,
-	3, 671, 3, 671, 3, 671, 3, 671, 3, 671, 3, 672, 5, 672, 8451, 10, 672,
-	3, 672, 3, 672, 3, 672, 3, 672, 3, 672, 3, 672, 3, 672, 3, 672, 3, 672,
-	3, 672, 3, 672, 3, 672, 3, 672, 3, 672, 3, 672, 3, 672, 3, 672, 3, 672,
-	3, 672, 3, 672, 3, 672, 3, 672, 3, 672, 3, 672, 3, 672, 5, 672, 8478, 10,
-	672, 3, 673, 3, 673, 3, 673, 3, 673, 3, 673, 3, 673, 3, 673, 3, 673, 3,
-	673, 3, 673, 3, 673, 3, 673, 3, 674, 3, 674, 3, 674, 3, 674, 3, 674, 3,
-	674, 3, 674, 3, 674, 3, 674, 3, 674, 3, 674, 3, 674, 3, 674, 3, 675, 3,
-	675, 3, 675, 3, 675, 3, 675, 3, 675, 3, 675, 3, 675, 3, 675, 3, 675, 3,
-	675, 3, 675, 3, 675, 3, 675, 3, 675, 3, 675, 3, 675, 3, 675, 3, 675, 3,
-	675, 3, 675, 3, 675, 3, 675, 3, 676, 3, 676, 3, 676, 3, 676, 3, 676, 3,
-	676, 3, 676, 3, 676, 3, 676, 3, 676, 3, 676, 3, 676, 3, 676, 3, 676, 3,
-	676, 3, 676, 3, 676, 3, 676, 3, 676, 3, 676, 3, 676, 3, 676, 3, 676, 3,
-	677, 3, 677, 3, 677, 3, 677, 3, 677, 3, 677, 3, 677, 3, 677, 3, 677, 3,
-	677, 3, 677, 3, 677, 3, 677, 3, 677, 3, 677, 3, 677, 3, 677, 3, 677, 3,
-	677, 3, 677, 3, 678, 3, 678, 3, 678, 3, 678, 3, 678, 3, 678, 3, 678, 3,
-	678, 3, 678, 3, 678, 3, 678, 3, 678, 3, 678, 3, 678, 3, 678, 3, 678, 3,
-	678, 3, 679, 3, 679, 3, 679, 3, 679, 3, 679, 3, 679, 3, 679, 3, 679, 3,
-	679, 3, 680, 3, 680, 3, 680, 3, 680, 3, 680, 3, 680, 3, 681, 3, 681, 3,
-	681, 3, 681, 3, 681, 3, 682, 3, 682, 3, 682, 3, 682, 3, 682, 3, 682, 3,
-	682, 3, 683, 3, 683, 3, 683, 3, 683, 3, 683, 3, 683, 3, 683, 3, 684, 3,
-	684, 3, 684, 3, 684, 3, 684, 3, 684, 3, 684, 3, 685, 3, 685, 3, 685, 3,
-	685, 3, 685, 3, 685, 3, 685, 3, 686, 3, 686, 3, 686, 3, 686, 3, 686, 3,
-	686, 3, 687, 3, 687, 3, 687, 3, 687, 3, 687, 3, 687, 3, 688, 3, 688, 3,
-	688, 3, 688, 3, 688, 3, 688, 3, 689, 3, 689, 3, 689, 3, 689, 3, 689, 3,
-	689, 3, 690, 3, 690, 3, 690, 3, 690, 3, 690, 3, 691, 3, 691, 3, 691, 3,
-	691, 3, 691, 3, 691, 3, 691, 3, 691, 3, 692, 3, 692, 3, 692, 3, 692, 3,
-	692, 3, 692, 3, 693, 3, 693, 3, 693, 3, 693, 3, 693, 3, 693, 3, 693, 3,
-	694, 3, 694, 3, 694, 3, 694, 3, 695, 3, 695, 3, 695, 3, 695, 3, 695, 3,
-	695, 3, 695, 3, 695, 3, 696, 3, 696, 3, 696, 3, 696, 3, 696, 3, 696, 3,
-	697, 3, 697, 3, 697, 3, 697, 3, 697, 3, 697, 3, 697, 3, 698, 3, 698, 3,
-	698, 3, 698, 3, 699, 3, 699, 3, 699, 3, 699, 3, 699, 3, 699, 3, 699, 3,
-	699, 3, 700, 3, 700, 3, 700, 3, 700, 3, 700, 3, 700, 3, 701, 3, 701, 3,
-	701, 3, 701, 3, 701, 3, 701, 3, 702, 3, 702, 3, 702, 3, 702, 3, 702, 3,
-	702, 3, 702, 3, 703, 3, 703, 3, 703, 3, 703, 3, 703, 3, 703, 3, 703, 3,
-	704, 3, 704, 3, 704, 3, 704, 3, 704, 3, 704, 3, 704, 3, 705, 3, 705, 3,
-	705, 3, 705, 3, 705, 3, 705, 3, 705, 3, 706, 3, 706, 3, 706, 3, 706, 3,
-	706, 3, 706, 3, 707, 3, 707, 3, 707, 3, 707, 3, 707, 3, 707, 3, 707, 3,
-	707, 3, 707, 3, 708, 3, 708, 3, 708, 3, 708, 3, 708, 3, 709, 3, 709, 3,
-	709, 3, 709, 3, 709, 3, 710, 3, 710, 3, 710, 3, 710, 3, 710, 3, 710, 3,
-	710, 3, 711, 3, 711, 3, 711, 3, 711, 3, 711, 3, 712, 3, 712, 3, 712, 3,
-	712, 3, 712, 3, 713, 3, 713, 3, 713, 3, 713, 3, 713, 3, 713, 3, 714, 3,
-	714, 3, 714, 3, 714, 3, 714, 3, 714, 3, 714, 3, 714, 3, 715, 3, 715, 3,
-	715, 3, 715, 3, 715, 3, 715, 3, 716, 3, 716, 3, 716, 3, 716, 3, 716, 3,
-	717, 3, 717, 3, 717, 3, 717, 3, 717, 3, 717, 3, 717, 3, 717, 3, 718, 3,
-	718, 3, 718, 3, 718, 3, 718, 3, 718, 3, 718, 3, 718, 3, 719, 3, 719, 3,
-	719, 3, 719, 3, 719, 3, 719, 3, 719, 3, 719, 3, 720, 3, 720, 3, 720, 3,
-	720, 3, 720, 3, 720, 3, 720, 3, 720, 3, 720, 3, 720, 3, 721, 3, 721, 3,
-	721, 3, 721, 3, 722, 3, 722, 3, 722, 3, 722, 3, 722, 3, 722, 3, 722, 3,
-	722, 3, 722, 3, 722, 3, 723, 3, 723, 3, 723, 3, 723, 3, 723, 3, 723, 3,
-	723, 3, 724, 3, 724, 3, 724, 3, 724, 3, 724, 3, 724, 3, 724, 3, 725, 3,
-	725, 3, 725, 3, 725, 3, 725, 3, 725, 3, 725, 3, 725, 3, 725, 3, 725, 3,
-	725, 3, 726, 3, 726, 3, 726, 3, 726, 3, 726, 3, 726, 3, 726, 3, 727, 3,
-	727, 3, 727, 3, 727, 3, 728, 3, 728, 3, 728, 3, 728, 3, 728, 3, 728, 3,
-	728, 3, 728, 3, 728, 3, 728, 3, 728, 3, 729, 3, 729, 3, 729, 3, 729, 3,
-	729, 3, 729, 3, 729, 3, 729, 3, 729, 3, 729, 3, 729, 3, 729, 3, 729, 3,
-	729, 3, 729, 3, 729, 3, 729, 3, 729, 3, 729, 3, 730, 3, 730, 3, 730, 3,
-	730, 3, 730, 3, 730, 3, 730, 3, 731, 3, 731, 3, 731, 3, 731, 3, 731, 3,
-	731, 3, 731, 3, 731, 3, 731, 3, 731, 3, 731, 3, 732, 3, 732, 3, 732, 3,
-	732, 3, 732, 3, 732, 3, 732, 3, 732, 3, 732, 3, 732, 3, 733, 3, 733, 3,
-	733, 3, 733, 3, 733, 3, 733, 3, 733, 3, 733, 3, 733, 3, 733, 3, 733, 3,
-	733, 3, 734, 3, 734, 3, 734, 3, 734, 3, 734, 3, 734, 3, 734, 3, 734, 3,
-	734, 3, 734, 3, 734, 3, 734, 3, 734, 3, 735, 3, 735, 3, 735, 3, 735, 3,
-	735, 3, 735, 3, 735, 3, 735, 3, 735, 3, 735, 3, 735, 3, 735, 3, 735, 3,
-	735, 3, 735, 3, 735, 3, 735, 3, 735, 3, 735, 3, 736, 3, 736, 3, 736, 3,
-	736, 3, 736, 3, 736, 3, 736, 3, 736, 3, 736, 3, 736, 3, 736, 3, 736, 3,
-	736, 3, 736, 3, 736, 3, 737, 3, 737, 3, 737, 3, 737, 3, 737, 3, 737, 3,
-	737, 3, 737, 3, 737, 3, 738, 3, 738, 3, 738, 3, 738, 3, 738, 3, 738, 3,
-	738, 3, 738, 3, 738, 3, 738, 3, 738, 3, 739, 3, 739, 3, 739, 3, 739, 3,
-	739, 3, 739, 3, 739, 3, 739, 3, 739, 3, 739, 3, 739, 3, 739, 3, 739, 3,
-	739, 3, 739, 3, 739, 3, 740, 3, 740, 3, 740, 3, 740, 3, 740, 3, 740, 3,
-	740, 3, 740, 3, 740, 3, 740, 3, 740, 3, 741, 3, 741, 3, 741, 3, 741, 3,
-	741, 3, 741, 3, 741, 3, 741, 3, 741, 3, 741, 3, 741, 3, 741, 3, 741, 3,
-	742, 3, 742, 3, 742, 3, 742, 3, 742, 3, 742, 3, 743, 3, 743, 3, 743, 3,
-	743, 3, 743, 3, 743, 3, 743, 3, 743, 3, 744, 3, 744, 3, 744, 3, 744, 3,
-	745, 3, 745, 3, 745, 3, 745, 3, 745, 3, 746, 3, 746, 3, 746, 3, 746, 3,
-	746, 3, 746, 3, 746, 3, 746, 3, 747, 3, 747, 3, 747, 3, 747, 3, 747, 3,
-	747, 3, 747, 3, 747, 3, 748, 3, 748, 3, 748, 3, 748, 3, 748, 3, 748, 3,
-	748, 3, 748, 3, 748, 3, 748, 3, 748, 3, 748, 3, 749, 3, 749, 3, 749, 3,
-	749, 3, 749, 3, 749, 3, 749, 3, 749, 3, 749, 3, 749, 3, 749, 3, 749, 3,
-	750, 3, 750, 3, 750, 3, 750, 3, 750, 3, 751, 3, 751, 3, 751, 3, 751, 3,
-	751, 3, 751, 3, 751, 3, 751, 3, 751, 3, 752, 3, 752, 3, 752, 3, 752, 3,
-	752, 3, 753, 3, 753, 3, 753, 3, 753, 3, 753, 3, 753, 3, 753, 3, 754, 3,
-	754, 3, 754, 3, 754, 3, 754, 3, 754, 3, 755, 3, 755, 3, 755, 3, 755, 3,
-	755, 3, 755, 3, 756, 3, 756, 3, 756, 3, 756, 3, 756, 3, 756, 3, 756, 3,
-	756, 3, 756, 3, 756, 3, 756, 3, 756, 3, 756, 3, 756, 3, 756, 3, 756, 3,
-	756, 3, 756, 3, 756, 3, 757, 3, 757, 3, 757, 3, 757, 3, 757, 3, 757, 3,
-	757, 3, 757, 3, 757, 3, 757, 3, 757, 3, 757, 3, 757, 3, 757, 3, 757, 3,
-	757, 3, 757, 3, 757, 3, 758, 3, 758, 3, 758, 3, 758, 3, 758, 3, 758, 3,
-	758, 3, 758, 3, 758, 3, 758, 3, 758, 3, 758, 3, 758, 3, 758, 3, 758, 3,
-	758, 3, 758, 3, 758, 3, 758, 3, 759, 3, 759, 3, 759, 3, 759, 3, 759, 3,
-	759, 3, 759, 3, 759, 3, 759, 3, 759, 3, 759, 3, 759, 3, 759, 3, 759, 3,
-	759, 3, 759, 3, 760, 3, 760, 3, 760, 3, 760, 3, 760, 3, 760, 3, 760, 3,
-	760, 3, 760, 3, 760, 3, 760, 3, 760, 3, 760, 3, 760, 3, 760, 3, 760, 3,
-	760, 3, 760, 3, 761, 3, 761, 3, 761, 3, 761, 3, 761, 3, 762, 3, 762, 3,
-	762, 3, 762, 3, 762, 3, 762, 3, 763, 3, 763, 3, 763, 3, 763, 3, 763, 3,
-	763, 3, 763, 3, 763, 3, 763, 3, 763, 3, 764, 3, 764, 3, 764, 3, 764, 3,
-	765, 3, 765, 3, 765, 3, 765, 3, 765, 3, 765, 3, 765, 3, 765, 3, 765, 3,
-	765, 3, 766, 3, 766, 3, 766, 3, 766, 3, 766, 3, 766, 3, 766, 3, 766, 3,
-	766, 3, 766, 3, 766, 3, 767, 3, 767, 3, 767, 3, 767, 3, 767, 3, 767, 3,
-	767, 3, 768, 3, 768, 3, 768, 3, 768, 3, 768, 3, 768, 3, 768, 3, 768, 3,
-	768, 3, 768, 3, 768, 3, 768, 3, 768, 3, 769, 3, 769, 3, 769, 3, 769, 3,
-	769, 3, 770, 3, 770, 3, 770, 3, 770, 3, 770, 3, 770, 3, 770, 3, 770, 3,
-	771, 3, 771, 3, 771, 3, 771, 3, 771, 3, 771, 3, 771, 3, 771, 3, 771, 3,
-	772, 3, 772, 3, 772, 3, 772, 3, 772, 3, 772, 3, 772, 3, 772, 3, 772, 3,
-	772, 3, 772, 3, 772, 3, 772, 3, 772, 3, 772, 3, 772, 3, 772, 3, 773, 3,
-	773, 3, 773, 3, 773, 3, 773, 3, 773, 3, 773, 3, 773, 3, 774, 3, 774, 3,
-	774, 3, 774, 3, 774, 3, 774, 3, 774, 3, 774, 3, 774, 3, 774, 3, 774, 3,
-	774, 3, 775, 3, 775, 3, 775, 3, 775, 3, 775, 3, 775, 3, 775, 3, 775, 3,
-	775, 3, 775, 3, 775, 3, 775, 3, 775, 3, 776, 3, 776, 3, 776, 3, 776, 3,
-	776, 3, 776, 3, 776, 3, 776, 3, 776, 3, 776, 3, 777, 3, 777, 3, 777, 3,
-	777, 3, 777, 3, 777, 3, 777, 3, 777, 3, 777, 3, 778, 3, 778, 3, 778, 3,
-	778, 3, 778, 3, 778, 3, 778, 3, 779, 3, 779, 3, 779, 3, 779, 3, 779, 3,
-	779, 3, 779, 3, 779, 3, 779, 3, 779, 3, 780, 3, 780, 3, 780, 3, 780, 3,
-	780, 3, 780, 3, 780, 3, 780, 3, 780, 3, 780, 3, 780, 3, 780, 3, 780, 3,
-	780, 3, 781, 3, 781, 3, 781, 3, 781, 3, 781, 3, 782, 3, 782, 3, 782, 3,
-	782, 3, 782, 3, 782, 3, 782, 3, 782, 3, 782, 3, 782, 3, 782, 3, 783, 3,
-	783, 3, 783, 3, 783, 3, 784, 3, 784, 3, 784, 3, 784, 3, 785, 3, 785, 3,
-	785, 3, 785, 3, 785, 3, 785, 3, 786, 3, 786, 3, 786, 3, 786, 3, 786, 3,
-	786, 3, 786, 3, 786, 3, 786, 3, 786, 3, 786, 3, 786, 3, 786, 3, 786, 3,
-	786, 3, 786, 3, 786, 3, 786, 3, 786, 3, 786, 3, 786, 3, 786, 3, 786, 3,
-	786, 3, 786, 3, 786, 3, 786, 3, 787, 3, 787, 3, 787, 3, 787, 3, 787, 3,
-	787, 3, 787, 3, 787, 3, 787, 3, 787, 3, 787, 3, 787, 3, 787, 3, 787, 3,
-	787, 3, 787, 3, 787, 3, 787, 3, 787, 3, 787, 3, 787, 3, 787, 3, 787, 3,
-	787, 3, 787, 3, 787, 3, 788, 3, 788, 3, 788, 3, 788, 3, 788, 3, 788, 3,
-	788, 3, 788, 3, 788, 3, 788, 3, 788, 3, 788, 3, 788, 3, 788, 3, 788, 3,
-	788, 3, 788, 3, 788, 3, 788, 3, 788, 3, 788, 3, 789, 3, 789, 3, 789, 3,
-	789, 3, 789, 3, 789, 3, 789, 3, 789, 3, 789, 3, 789, 3, 789, 3, 789, 3,
-	789, 3, 789, 3, 790, 3, 790, 3, 790, 3, 790, 3, 790, 3, 790, 3, 790, 3,
-	790, 3, 791, 3, 791, 3, 791, 3, 791, 3, 791, 3, 791, 3, 791, 3, 791, 3,
-	791, 3, 792, 3, 792, 3, 792, 3, 792, 3, 792, 3, 792, 3, 792, 3, 792, 3,
-	792, 3, 792, 3, 792, 3, 792, 3, 793, 3, 793, 3, 793, 3, 793, 3, 793, 3,
-	793, 3, 793, 3, 793, 3, 794, 3, 794, 3, 794, 3, 794, 3, 794, 3, 794, 3,
-	794, 3, 794, 3, 794, 3, 794, 3, 794, 3, 795, 3, 795, 3, 795, 3, 795, 3,
-	795, 3, 795, 3, 795, 3, 795, 3, 795, 3, 795, 3, 796, 3, 796, 3, 796, 3,
-	796, 3, 796, 3, 796, 3, 796, 3, 796, 3, 796, 3, 796, 3, 797, 3, 797, 3,
-	797, 3, 797, 3, 797, 3, 797, 3, 797, 3, 798, 3, 798, 3, 798, 3, 798, 3,
-	798, 3, 798, 3, 798, 3, 798, 3, 799, 3, 799, 3, 799, 3, 799, 3, 799, 3,
-	799, 3, 799, 3, 799, 3, 799, 3, 799, 3, 799, 3, 799, 3, 800, 3, 800, 3,
-	800, 3, 800, 3, 800, 3, 800, 3, 800, 3, 800, 3, 800, 3, 800, 3, 800, 3,
-	800, 3, 801, 3, 801, 3, 801, 3, 801, 3, 801, 3, 801, 3, 801, 3, 801, 3,
-	801, 3, 801, 3, 802, 3, 802, 3, 802, 3, 802, 3, 802, 3, 802, 3, 802, 3,
-	802, 3, 802, 3, 803, 3, 803, 3, 803, 3, 803, 3, 804, 3, 804, 3, 804, 3,
-	804, 3, 804, 3, 804, 3, 804, 3, 805, 3, 805, 3, 805, 3, 805, 3, 805, 3,
-	805, 3, 805, 3, 805, 3, 806, 3, 806, 3, 806, 3, 806, 3, 806, 3, 806, 3,
-	806, 3, 806, 3, 806, 3, 807, 3, 807, 3, 807, 3, 807, 3, 807, 3, 807, 3,
-	807, 3, 807, 3, 807, 3, 808, 3, 808, 3, 808, 3, 808, 3, 808, 3, 808, 3,
-	808, 3, 809, 3, 809, 3, 809, 3, 809, 3, 810, 3, 810, 3, 810, 3, 810, 3,
-	810, 3, 810, 3, 810, 3, 810, 3, 810, 3, 810, 3, 810, 3, 811, 3, 811, 3,
-	811, 3, 811, 3, 811, 3, 811, 3, 811, 3, 811, 3, 811, 3, 811, 3, 811, 3,
-	811, 3, 811, 3, 812, 3, 812, 3, 812, 3, 812, 3, 812, 3, 812, 3, 812, 3,
-	812, 3, 812, 3, 812, 3, 812, 3, 812, 3, 812, 3, 813, 3, 813, 3, 813, 3,
-	813, 3, 813, 3, 813, 3, 814, 3, 814, 3, 814, 3, 814, 3, 814, 3, 814, 3,
-	814, 3, 814, 3, 814, 3, 814, 3, 814, 3, 814, 3, 815, 3, 815, 3, 815, 3,
-	815, 3, 815, 3, 815, 3, 816, 3, 816, 3, 816, 3, 816, 3, 816, 3, 816, 3,
-	816, 3, 817, 3, 817, 3, 817, 3, 817, 3, 817, 3, 817, 3, 817, 3, 817, 3,
-	817, 3, 817, 3, 817, 3, 818, 3, 818, 3, 818, 3, 818, 3, 818, 3, 818, 3,
-	818, 3, 818, 3, 818, 3, 818, 3, 818, 3, 818, 3, 819, 3, 819, 3, 819, 3,
-	819, 3, 819, 3, 819, 3, 819, 3, 819, 3, 819, 3, 819, 3, 820, 3, 820, 3,
-	820, 3, 820, 3, 820, 3, 820, 3, 820, 3, 820, 3, 820, 3, 820, 3, 820, 3,
-	820, 3, 820, 3, 820, 3, 821, 3, 821, 3, 821, 3, 821, 3, 821, 3, 821, 3,
-	821, 3, 821, 3, 821, 3, 821, 3, 821, 3, 821, 3, 821, 3, 821, 3, 821, 3,
-	821, 3, 821, 3, 822, 3, 822, 3, 822, 3, 822, 3, 822, 3, 822, 3, 822, 3,
-	822, 3, 822, 3, 822, 3, 822, 3, 822, 3, 822, 3, 822, 3, 822, 3, 822, 3,
-	823, 3, 823, 3, 823, 3, 823, 3, 823, 3, 823, 3, 823, 3, 823, 3, 823, 3,
-	823, 3, 823, 3, 823, 3, 823, 3, 823, 3, 823, 3, 823, 3, 823, 3, 823, 3,
-	823, 3, 823, 3, 823, 3, 823, 3, 823, 3, 823, 3, 823, 3, 823, 3, 823, 3,
-	824, 3, 824, 3, 824, 3, 824, 3, 824, 3, 824, 3, 824, 3, 824, 3, 824, 3,
-	824, 3, 824, 3, 824, 3, 824, 3, 824, 3, 824, 3, 824, 3, 824, 3, 824, 3,
-	824, 3, 824, 3, 824, 3, 824, 3, 824, 3, 824, 3, 824, 3, 824, 3, 825, 3,
-	825, 3, 825, 3, 825, 3, 825, 3, 825, 3, 825, 3, 825, 3, 825, 3, 825, 3,
-	825, 3, 825, 3, 825, 3, 825, 3, 825, 3, 825, 3, 825, 3, 826, 3, 826, 3,
-	826, 3, 826, 3, 826, 3, 826, 3, 826, 3, 826, 3, 826, 3, 826, 3, 826, 3,
-	826, 3, 826, 3, 826, 3, 826, 3, 826, 3, 827, 3, 827, 3, 827, 3, 827, 3,
-	827, 3, 827, 3, 827, 3, 827, 3, 827, 3, 827, 3, 828, 3, 828, 3, 828, 3,
-	828, 3, 828, 3, 828, 3, 828, 3, 828, 3, 828, 3, 828, 3, 828, 3, 828, 3,
-	828, 3, 829, 3, 829, 3, 829, 3, 829, 3, 829, 3, 829, 3, 829, 3, 829, 3,
-	829, 3, 829, 3, 829, 3, 829, 3, 829, 3, 830, 3, 830, 3, 830, 3, 830, 3,
-	830, 3, 830, 3, 830, 3, 830, 3, 830, 3, 830, 3, 830, 3, 830, 3, 831, 3,
-	831, 3, 831, 3, 831, 3, 831, 3, 831, 3, 831, 3, 831, 3, 831, 3, 831, 3,
-	831, 3, 832, 3, 832, 3, 832, 3, 832, 3, 832, 3, 832, 3, 832, 3, 832, 3,
-	832, 3, 833, 3, 833, 3, 833, 3, 833, 3, 833, 3, 833, 3, 833, 3, 833, 3,
-	834, 3, 834, 3, 834, 3, 834, 3, 834, 3, 834, 3, 834, 3, 834, 3, 834, 3,
-	835, 3, 835, 3, 835, 3, 835, 3, 835, 3, 835, 3, 835, 3, 835, 3, 835, 3,
-	835, 3, 835, 3, 835, 3, 836, 3, 836, 3, 836, 3, 836, 3, 836, 3, 836, 3,
-	836, 3, 836, 3, 836, 3, 836, 3, 836, 3, 836, 3, 836, 3, 836, 3, 837, 3,
-	837, 3, 837, 3, 837, 3, 838, 3, 838, 3, 838, 3, 838, 3, 838, 3, 838, 3,
-	838, 3, 839, 3, 839, 3, 839, 3, 839, 3, 839, 3, 839, 3, 839, 3, 839, 3,
-	839, 3, 839, 3, 839, 3, 840, 3, 840, 3, 840, 3, 840, 3, 840, 3, 840, 3,
-	840, 3, 840, 3, 840, 3, 840, 3, 840, 3, 841, 3, 841, 3, 841, 3, 841, 3,
-	841, 3, 841, 3, 841, 3, 841, 3, 841, 3, 841, 3, 842, 3, 842, 3, 842, 3,
-	842, 3, 842, 3, 842, 3, 842, 3, 842, 3, 842, 3, 842, 3, 843, 3, 843, 3,
-	843, 3, 843, 3, 843, 3, 843, 3, 844, 3, 844, 3, 844, 3, 844, 3, 844, 3,
-	844, 3, 844, 3, 844, 3, 844, 3, 844, 3, 844, 3, 844, 3, 844, 3, 844, 3,
-	845, 3, 845, 3, 845, 3, 845, 3, 845, 3, 845, 3, 845, 3, 845, 3, 845, 3,
-	845, 3, 845, 3, 846, 3, 846, 3, 846, 3, 846, 3, 846, 3, 846, 3, 846, 3,
-	846, 3, 846, 3, 847, 3, 847, 3, 847, 3, 847, 3, 847, 3, 847, 3, 847, 3,
-	847, 3, 848, 3, 848, 3, 848, 3, 848, 3, 848, 3, 848, 3, 848, 3, 849, 3,
-	849, 3, 849, 3, 849, 3, 849, 3, 849, 3, 849, 3, 849, 3, 849, 3, 850, 3,
-	850, 3, 850, 3, 850, 3, 850, 3, 850, 3, 850, 3, 850, 3, 850, 3, 850, 3,
-	850, 3, 850, 3, 850, 3, 851, 3, 851, 3, 851, 3, 851, 3, 851, 3, 851, 3,
-	851, 3, 851, 3, 852, 3, 852, 3, 852, 3, 852, 3, 852, 3, 852, 3, 852, 3,
-	852, 3, 852, 3, 852, 3, 852, 3, 852, 3, 852, 3, 852, 3, 852, 3, 853, 3,
-	853, 3, 853, 3, 853, 3, 853, 3, 853, 3, 853, 3, 853, 3, 853, 3, 853, 3,
-	853, 3, 853, 3, 853, 3, 853, 3, 853, 3, 854, 3, 854, 3, 854, 3, 854, 3,
-	854, 3, 854, 3, 854, 3, 854, 3, 855, 3, 855, 3, 855, 3, 855, 3, 855, 3,
-	855, 3, 855, 3, 855, 3, 855, 3, 855, 3, 855, 3, 855, 3, 855, 3, 856, 3,
-	856, 3, 856, 3, 856, 3, 856, 3, 856, 3, 856, 3, 856, 3, 856, 3, 856, 3,
-	856, 3, 856, 3, 856, 3, 856, 3, 856, 3, 857, 3, 857, 3, 857, 3, 857, 3,
-	857, 3, 857, 3, 858, 3, 858, 3, 858, 3, 858, 3, 858, 3, 858, 3, 859, 3,
-	859, 3, 859, 3, 859, 3, 859, 3, 859, 3, 859, 3, 860, 3, 860, 3, 860, 3,
-	860, 3, 860, 3, 860, 3, 860, 3, 860, 3, 860, 3, 860, 3, 860, 3, 860, 3,
-	860, 3, 861, 3, 861, 3, 861, 3, 861, 3, 861, 3, 861, 3, 861, 3, 861, 3,
-	861, 3, 861, 3, 861, 3, 861, 3, 862, 3, 862, 3, 862, 3, 862, 3, 862, 3,
-	862, 3, 862, 3, 862, 3, 862, 3, 862, 3, 862, 3, 862, 3, 862, 3, 862, 3,
-	862, 3, 862, 3, 862, 3, 862, 3, 862, 3, 863, 3, 863, 3, 863, 3, 863, 3,
-	863, 3, 863, 3, 863, 3, 863, 3, 863, 3, 863, 3, 863, 3, 863, 3, 863, 3,
-	863, 3, 863, 3, 863, 3, 863, 3, 863, 3, 864, 3, 864, 3, 864, 3, 865, 3,
-	865, 3, 865, 3, 865, 3, 865, 3, 865, 3, 865, 3, 865, 3, 865, 3, 865, 3,
-	866, 3, 866, 3, 866, 3, 866, 3, 866, 3, 866, 3, 866, 3, 867, 3, 867, 3,
-	867, 3, 867, 3, 868, 3, 868, 3, 868, 3, 868, 3, 868, 3, 868, 3, 869, 3,
-	869, 3, 869, 3, 869, 3, 869, 3, 870, 3, 870, 3, 870, 3, 870, 3, 870, 3,
-	870, 3, 871, 3, 871, 3, 871, 3, 871, 3, 871, 3, 872, 3, 872, 3, 872, 3,
-	872, 3, 872, 3, 872, 3, 873, 3, 873, 3, 873, 3, 873, 3, 873, 3, 873, 3,
-	873, 3, 873, 3, 873, 3, 874, 3, 874, 3, 874, 3, 874, 3, 874, 3, 874, 3,
-	874, 3, 874, 3, 874, 3, 875, 3, 875, 3, 875, 3, 875, 3, 875, 3, 875, 3,
-	875, 3, 875, 3, 875, 3, 876, 3, 876, 3, 876, 3, 876, 3, 876, 3, 876, 3,
-	876, 3, 876, 3, 876, 3, 876, 3, 876, 3, 876, 3, 876, 3, 876, 3, 876, 3,
-	876, 3, 877, 3, 877, 3, 877, 3, 877, 3, 877, 3, 877, 3, 877, 3, 877, 3,
-	877, 3, 877, 3, 877, 3, 877, 3, 878, 3, 878, 3, 878, 3, 878, 3, 878, 3,
-	878, 3, 878, 3, 878, 3, 878, 3, 878, 3, 878, 3, 878, 3, 879, 3, 879, 3,
-	879, 3, 879, 3, 879, 3, 879, 3, 879, 3, 879, 3, 879, 3, 880, 3, 880, 3,
-	880, 3, 880, 3, 880, 3, 880, 3, 880, 3, 880, 3, 880, 3, 880, 3, 880, 3,
-	880, 3, 880, 3, 880, 3, 881, 3, 881, 3, 881, 3, 881, 3, 881, 3, 881, 3,
-	881, 3, 881, 3, 881, 3, 881, 3, 881, 3, 881, 3, 882, 3, 882, 3, 882, 3,
-	882, 3, 882, 3, 882, 3, 882, 3, 882, 3, 882, 3, 882, 3, 882, 3, 883, 3,
-	883, 3, 883, 3, 883, 3, 883, 3, 883, 3, 883, 3, 883, 3, 883, 3, 883, 3,
-	884, 3, 884, 3, 884, 3, 884, 3, 885, 3, 885, 3, 885, 3, 885, 3, 885, 3,
-	885, 3, 885, 3, 885, 3, 885, 3, 885, 3, 885, 3, 885, 3, 885, 3, 885, 3,
-	886, 3, 886, 3, 886, 3, 886, 3, 886, 3, 886, 3, 886, 3, 886, 3, 886, 3,
-	886, 3, 886, 3, 886, 3, 886, 3, 887, 3, 887, 3, 887, 3, 887, 3, 887, 3,
-	887, 3, 887, 3, 887, 3, 887, 3, 887, 3, 888, 3, 888, 3, 888, 3, 888, 3,
-	888, 3, 888, 3, 888, 3, 888, 3, 888, 3, 888, 3, 888, 3, 888, 3, 888, 3,
-	888, 3, 888, 3, 889, 3, 889, 3, 889, 3, 889, 3, 889, 3, 889, 3, 889, 3,
-	889, 3, 889, 3, 889, 3, 889, 3, 889, 3, 889, 3, 889, 3, 890, 3, 890, 3,
-	890, 3, 890, 3, 890, 3, 890, 3, 890, 3, 890, 3, 890, 3, 890, 3, 890, 3,
-	890, 3, 890, 3, 890, 3, 891, 3, 891, 3, 891, 3, 891, 3, 891, 3, 891, 3,
-	891, 3, 891, 3, 891, 3, 891, 3, 891, 3, 891, 3, 891, 3, 892, 3, 892, 3,
-	892, 3, 892, 3, 892, 3, 892, 3, 892, 3, 892, 3, 892, 3, 892, 3, 892, 3,
-	892, 3, 892, 3, 892, 3, 892, 3, 892, 3, 892, 3, 892, 3, 892, 3, 892, 3,
-	892, 3, 892, 3, 892, 3, 892, 3, 893, 3, 893, 3, 893, 3, 893, 3, 893, 3,
-	893, 3, 893, 3, 893, 3, 893, 3, 893, 3, 893, 3, 893, 3, 893, 3, 893, 3,
-	893, 3, 893, 3, 893, 3, 893, 3, 893, 3, 893, 3, 893, 3, 893, 3, 893, 3,
-	894, 3, 894, 3, 894, 3, 894, 3, 894, 3, 894, 3, 894, 3, 894, 3, 894, 3,
-	894, 3, 894, 3, 894, 3, 894, 3, 894, 3, 894, 3, 894, 3, 894, 3, 894, 3,
-	894, 3, 895, 3, 895, 3, 895, 3, 895, 3, 895, 3, 895, 3, 895, 3, 895, 3,
-	895, 3, 895, 3, 895, 3, 895, 3, 895, 3, 895, 3, 895, 3, 895, 3, 895, 3,
-	895, 3, 896, 3, 896, 3, 896, 3, 896, 3, 896, 3, 896, 3, 896, 3, 896, 3,
-	896, 3, 896, 3, 896, 3, 896, 3, 896, 3, 896, 3, 896, 3, 896, 3, 896, 3,
-	896, 3, 896, 3, 896, 3, 896, 3, 897, 3, 897, 3, 897, 3, 897, 3, 897, 3,
-	897, 3, 897, 3, 897, 3, 897, 3, 897, 3, 897, 3, 897, 3, 897, 3, 897, 3,
-	897, 3, 897, 3, 897, 3, 897, 3, 897, 3, 897, 3, 898, 3, 898, 3, 898, 3,
-	898, 3, 898, 3, 898, 3, 898, 3, 898, 3, 898, 3, 898, 3, 898, 3, 899, 3,
-	899, 3, 899, 3, 899, 3, 899, 3, 899, 3, 899, 3, 900, 3, 900, 3, 900, 3,
-	900, 3, 900, 3, 900, 3, 900, 3, 900, 3, 900, 3, 900, 3, 900, 3, 900, 3,
-	900, 3, 900, 3, 901, 3, 901, 3, 901, 3, 901, 3, 901, 3, 901, 3, 901, 3,
-	901, 3, 901, 3, 901, 3, 901, 3, 901, 3, 901, 3, 901, 3, 901, 3, 901, 3,
-	901, 3, 902, 3, 902, 3, 902, 3, 902, 3, 902, 3, 902, 3, 902, 3, 902, 3,
-	902, 3, 902, 3, 903, 3, 903, 3, 903, 3, 903, 3, 904, 3, 904, 3, 904, 3,
-	904, 3, 904, 3, 904, 3, 904, 3, 904, 3, 904, 3, 904, 3, 904, 3, 904, 3,
-	904, 3, 905, 3, 905, 3, 905, 3, 905, 3, 906, 3, 906, 3, 906, 3, 906, 3,
-	906, 3, 906, 3, 906, 3, 906, 3, 906, 3, 907, 3, 907, 3, 907, 3, 907, 3,
-	907, 3, 907, 3, 907, 3, 907, 3, 907, 3, 907, 3, 907, 3, 908, 3, 908, 3,
-	908, 3, 908, 3, 908, 3, 908, 3, 908, 3, 908, 3, 908, 3, 908, 3, 908, 3,
-	908, 3, 909, 3, 909, 3, 909, 3, 910, 3, 910, 3, 910, 3, 910, 3, 910, 3,
-	910, 3, 910, 3, 910, 3, 910, 3, 910, 3, 910, 3, 910, 3, 910, 3, 910, 3,
-	911, 3, 911, 3, 911, 3, 911, 3, 911, 3, 911, 3, 911, 3, 911, 3, 911, 3,
-	911, 3, 911, 3, 911, 3, 911, 3, 912, 3, 912, 3, 912, 3, 912, 3, 912, 3,
-	912, 3, 912, 3, 913, 3, 913, 3, 913, 3, 913, 3, 913, 3, 913, 3, 913, 3,
-	913, 3, 913, 3, 913, 3, 913, 3, 913, 3, 913, 3, 914, 3, 914, 3, 914, 3,
-	914, 3, 914, 3, 914, 3, 914, 3, 914, 3, 914, 3, 914, 3, 914, 3, 914, 3,
-	915, 3, 915, 3, 915, 3, 915, 3, 915, 3, 915, 3, 915, 3, 915, 3, 915, 3,
-	915, 3, 915, 3, 915, 3, 915, 3, 915, 3, 915, 3, 915, 3, 916, 3, 916, 3,
-	916, 3, 916, 3, 916, 3, 916, 3, 916, 3, 916, 3, 916, 3, 916, 3, 916, 3,
-	916, 3, 916, 3, 916, 3, 916, 3, 917, 3, 917, 3, 917, 3, 917, 3, 918, 3,
-	918, 3, 918, 3, 918, 3, 918, 3, 918, 3, 919, 3, 919, 3, 919, 3, 919, 3,
-	919, 3, 919, 3, 920, 3, 920, 3, 920, 3, 920, 3, 920, 3, 920, 3, 920, 3,
-	920, 3, 921, 3, 921, 3, 921, 3, 921, 3, 921, 3, 922, 3, 922, 3, 922, 3,
-	922, 3, 922, 3, 922, 3, 922, 3, 922, 3, 922, 3, 922, 3, 922, 3, 922, 3,
-	922, 3, 923, 3, 923, 3, 923, 3, 923, 3, 923, 3, 923, 3, 923, 3, 923, 3,
-	923, 3, 923, 3, 923, 3, 923, 3, 923, 3, 924, 3, 924, 3, 924, 3, 924, 3,
-	924, 3, 924, 3, 924, 3, 924, 3, 925, 3, 925, 3, 925, 3, 925, 3, 925, 3,
-	925, 3, 926, 3, 926, 3, 926, 3, 926, 3, 926, 3, 926, 3, 926, 3, 926, 3,
-	926, 3, 926, 3, 927, 3, 927, 3, 927, 3, 927, 3, 927, 3, 928, 3, 928, 3,
-	928, 3, 928, 3, 928, 3, 928, 3, 929, 3, 929, 3, 929, 3, 929, 3, 929, 3,
-	929, 3, 929, 3, 929, 3, 929, 3, 929, 3, 929, 3, 929, 3, 930, 3, 930, 3,
-	930, 3, 930, 3, 930, 3, 930, 3, 930, 3, 930, 3, 930, 3, 930, 3, 930, 3,
-	930, 3, 930, 3, 931, 3, 931, 3, 931, 3, 931, 3, 932, 3, 932, 3, 932, 3,
-	932, 3, 932, 3, 933, 3, 933, 3, 933, 3, 933, 3, 933, 3, 934, 3, 934, 3,
-	934, 3, 934, 3, 934, 3, 934, 3, 934, 3, 934, 3, 934, 3, 934, 3, 934, 3,
-	934, 3, 935, 3, 935, 3, 935, 3, 935, 3, 935, 3, 936, 3, 936, 3, 936, 3,
-	936, 3, 937, 3, 937, 3, 937, 3, 937, 3, 937, 3, 937, 3, 938, 3, 938, 3,
-	938, 3, 938, 3, 938, 3, 938, 3, 938, 3, 938, 3, 939, 3, 939, 3, 939, 3,
-	939, 3, 939, 3, 939, 3, 939, 3, 939, 3, 939, 3, 939, 3, 939, 3, 939, 3,
-	939, 3, 939, 3, 939, 3, 939, 3, 939, 3, 939, 3, 939, 3, 939, 3, 939, 3,
-	939, 3, 939, 3, 939, 3, 939, 3, 939, 3, 939, 3, 939, 3, 940, 3, 940, 3,
-	940, 3, 940, 3, 940, 3, 941, 3, 941, 3, 941, 3, 941, 3, 941, 3, 942, 3,
-	942, 3, 942, 3, 942, 3, 942, 3, 942, 3, 942, 3, 942, 3, 942, 3, 942, 3,
-	942, 3, 943, 3, 943, 3, 943, 3, 943, 3, 943, 3, 943, 3, 943, 3, 944, 3,
-	944, 3, 944, 3, 944, 3, 944, 3, 944, 3, 944, 3, 944, 3, 944, 3, 944, 3,
-	944, 3, 944, 3, 945, 3, 945, 3, 945, 3, 945, 3, 945, 3, 945, 3, 945, 3,
-	945, 3, 946, 3, 946, 3, 946, 3, 946, 3, 946, 3, 946, 3, 946, 3, 946, 3,
-	946, 3, 946, 3, 946, 3, 946, 3, 947, 3, 947, 3, 947, 3, 947, 3, 947, 3,
-	947, 3, 947, 3, 947, 3, 947, 3, 947, 3, 948, 3, 948, 3, 948, 3, 948, 3,
-	948, 3, 948, 3, 948, 3, 948, 3, 948, 3, 949, 3, 949, 3, 949, 3, 949, 3,
-	949, 3, 949, 3, 949, 3, 949, 3, 949, 3, 950, 3, 950, 3, 950, 3, 950, 3,
-	950, 3, 950, 3, 950, 3, 950, 3, 950, 3, 950, 3, 951, 3, 951, 3, 951, 3,
-	951, 3, 951, 3, 951, 3, 951, 3, 951, 3, 951, 3, 951, 3, 951, 3, 951, 3,
-	952, 3, 952, 3, 952, 3, 952, 3, 952, 3, 952, 3, 952, 3, 952, 3, 952, 3,
-	952, 3, 952, 3, 952, 3, 953, 3, 953, 3, 953, 3, 953, 3, 953, 3, 953, 3,
-	953, 3, 953, 3, 953, 3, 953, 3, 953, 3, 954, 3, 954, 3, 954, 3, 954, 3,
-	954, 3, 954, 3, 954, 3, 954, 3, 954, 3, 954, 3, 954, 3, 954, 3, 954, 3,
-	954, 3, 955, 3, 955, 3, 955, 3, 955, 3, 955, 3, 955, 3, 955, 3, 955, 3,
-	955, 3, 955, 3, 955, 3, 955, 3, 955, 3, 956, 3, 956, 3, 956, 3, 956, 3,
-	956, 3, 956, 3, 956, 3, 956, 3, 956, 3, 956, 3, 956, 3, 956, 3, 957, 3,
-	957, 3, 957, 3, 957, 3, 957, 3, 957, 3, 957, 3, 957, 3, 957, 3, 957, 3,
-	957, 3, 957, 3, 958, 3, 958, 3, 958, 3, 958, 3, 958, 3, 958, 3, 958, 3,
-	958, 3, 958, 3, 958, 3, 958, 3, 958, 3, 959, 3, 959, 3, 959, 3, 959, 3,
-	959, 3, 959, 3, 959, 3, 959, 3, 959, 3, 959, 3, 959, 3, 959, 3, 960, 3,
-	960, 3, 960, 3, 960, 3, 960, 3, 960, 3, 960, 3, 960, 3, 960, 3, 960, 3,
-	961, 3, 961, 3, 961, 3, 961, 3, 961, 3, 961, 3, 961, 3, 961, 3, 961, 3,
-	961, 3, 961, 3, 961, 3, 961, 3, 961, 3, 961, 3, 961, 3, 962, 3, 962, 3,
-	962, 3, 962, 3, 962, 3, 962, 3, 962, 3, 962, 3, 962, 3, 962, 3, 962, 3,
-	962, 3, 962, 3, 962, 3, 962, 3, 962, 3, 962, 3, 962, 3, 962, 3, 962, 3,
-	963, 3, 963, 3, 963, 3, 963, 3, 963, 3, 963, 3, 963, 3, 963, 3, 963, 3,
-	963, 3, 963, 3, 963, 3, 963, 3, 963, 3, 963, 3, 963, 3, 963, 3, 963, 3,
-	963, 3, 964, 3, 964, 3, 964, 3, 964, 3, 964, 3, 964, 3, 964, 3, 964, 3,
-	964, 3, 964, 3, 964, 3, 964, 3, 964, 3, 964, 3, 964, 3, 964, 3, 964, 3,
-	964, 3, 964, 3, 965, 3, 965, 3, 965, 3, 965, 3, 965, 3, 965, 3, 965, 3,
-	965, 3, 965, 3, 965, 3, 965, 3, 965, 3, 965, 3, 965, 3, 965, 3, 965, 3,
-	965, 3, 965, 3, 965, 3, 965, 3, 965, 3, 965, 3, 965, 3, 965, 3, 965, 3,
-	965, 3, 965, 3, 965, 3, 965, 3, 965, 3, 966, 3, 966, 3, 966, 3, 966, 3,
-	966, 3, 966, 3, 966, 3, 966, 3, 966, 3, 966, 3, 966, 3, 966, 3, 966, 3,
-	966, 3, 966, 3, 966, 3, 966, 3, 966, 3, 966, 3, 966, 3, 966, 3, 966, 3,
-	966, 3, 966, 3, 966, 3, 966, 3, 966, 3, 966, 3, 966, 3, 967, 3, 967, 3,
-	967, 3, 967, 3, 967, 3, 967, 3, 967, 3, 967, 3, 967, 3, 967, 3, 967, 3,
-	967, 3, 967, 3, 967, 3, 967, 3, 967, 3, 967, 3, 967, 3, 967, 3, 967, 3,
-	968, 3, 968, 3, 968, 3, 968, 3, 968, 3, 968, 3, 968, 3, 968, 3, 968, 3,
-	968, 3, 968, 3, 968, 3, 968, 3, 968, 3, 968, 3, 968, 3, 968, 3, 968, 3,
-	968, 3, 969, 3, 969, 3, 969, 3, 969, 3, 969, 3, 969, 3, 969, 3, 969, 3,
-	969, 3, 969, 3, 969, 3, 969, 3, 969, 3, 970, 3, 970, 3, 970, 3, 970, 3,
-	970, 3, 970, 3, 970, 3, 970, 3, 970, 3, 970, 3, 970, 3, 970, 3, 970, 3,
-	970, 3, 970, 3, 970, 3, 971, 3, 971, 3, 971, 3, 971, 3, 971, 3, 971, 3,
-	971, 3, 971, 3, 971, 3, 971, 3, 971, 3, 971, 3, 971, 3, 971, 3, 971, 3,
-	971, 3, 972, 3, 972, 3, 972, 3, 972, 3, 972, 3, 972, 3, 972, 3, 972, 3,
-	972, 3, 972, 3, 972, 3, 972, 3, 972, 3, 972, 3, 972, 3, 973, 3, 973, 3,
-	973, 3, 973, 3, 973, 3, 973, 3, 973, 3, 973, 3, 973, 3, 973, 3, 973, 3,
-	973, 3, 973, 3, 973, 3, 973, 3, 973, 3, 973, 3, 974, 3, 974, 3, 974, 3,
-	974, 3, 974, 3, 974, 3, 974, 3, 974, 3, 974, 3, 974, 3, 974, 3, 974, 3,
-	974, 3, 974, 3, 974, 3, 974, 3, 975, 3, 975, 3, 975, 3, 975, 3, 975, 3,
-	975, 3, 975, 3, 975, 3, 975, 3, 975, 3, 975, 3, 975, 3, 975, 3, 975, 3,
-	976, 3, 976, 3, 976, 3, 976, 3, 976, 3, 976, 3, 976, 3, 976, 3, 976, 3,
-	976, 3, 976, 3, 976, 3, 977, 3, 977, 3, 977, 3, 977, 3, 977, 3, 977, 3,
-	977, 3, 977, 3, 977, 3, 977, 3, 977, 3, 978, 3, 978, 3, 978, 3, 978, 3,
-	978, 3, 978, 3, 978, 3, 978, 3, 978, 3, 978, 3, 978, 3, 978, 3, 979, 3,
-	979, 3, 979, 3, 979, 3, 979, 3, 979, 3, 979, 3, 979, 3, 979, 3, 979, 3,
-	979, 3, 979, 3, 979, 3, 979, 3, 979, 3, 979, 3, 980, 3, 980, 3, 980, 3,
-	980, 3, 980, 3, 980, 3, 980, 3, 980, 3, 980, 3, 980, 3, 980, 3, 980, 3,
-	980, 3, 980, 3, 980, 3, 981, 3, 981, 3, 981, 3, 981, 3, 981, 3, 981, 3,
-	981, 3, 981, 3, 981, 3, 981, 3, 981, 3, 981, 3, 981, 3, 981, 3, 981, 3,
-	981, 3, 981, 3, 981, 3, 981, 3, 981, 3, 981, 3, 981, 3, 982, 3, 982, 3,
-	982, 3, 982, 3, 982, 3, 982, 3, 982, 3, 982, 3, 982, 3, 982, 3, 982, 3,
-	982, 3, 982, 3, 982, 3, 982, 3, 982, 3, 982, 3, 982, 3, 982, 3, 982, 3,
-	982, 3, 983, 3, 983, 3, 983, 3, 983, 3, 983, 3, 983, 3, 983, 3, 983, 3,
-	983, 3, 983, 3, 983, 3, 983, 3, 983, 3, 983, 3, 983, 3, 983, 3, 983, 3,
-	984, 3, 984, 3, 984, 3, 984, 3, 984, 3, 984, 3, 984, 3, 984, 3, 984, 3,
-	984, 3, 984, 3, 984, 3, 984, 3, 984, 3, 984, 3, 984, 3, 984, 3, 984, 3,
-	984, 3, 985, 3, 985, 3, 985, 3, 985, 3, 985, 3, 985, 3, 985, 3, 985, 3,
-	985, 3, 985, 3, 985, 3, 985, 3, 985, 3, 985, 3, 985, 3, 985, 3, 985, 3,
-	985, 3, 985, 3, 985, 3, 986, 3, 986, 3, 986, 3, 986, 3, 986, 3, 986, 3,
-	986, 3, 986, 3, 986, 3, 986, 3, 986, 3, 986, 3, 986, 3, 987, 3, 987, 3,
-	987, 3, 987, 3, 987, 3, 987, 3, 987, 3, 987, 3, 987, 3, 987, 3, 987, 3,
-	987, 3, 988, 3, 988, 3, 988, 3, 988, 3, 988, 3, 988, 3, 988, 3, 988, 3,
-	988, 3, 988, 3, 988, 3, 988, 3, 988, 3, 988, 3, 988, 3, 988, 3, 988, 3,
-	989, 3, 989, 3, 989, 3, 989, 3, 989, 3, 989, 3, 989, 3, 989, 3, 989, 3,
-	989, 3, 989, 3, 989, 3, 989, 3, 989, 3, 989, 3, 989, 3, 990, 3, 990, 3,
-	990, 3, 990, 3, 990, 3, 990, 3, 990, 3, 990, 3, 990, 3, 990, 3, 991, 3,
-	991, 3, 991, 3, 991, 3, 991, 3, 991, 3, 991, 3, 991, 3, 991, 3, 991, 3,
-	991, 3, 991, 3, 991, 3, 991, 3, 991, 3, 991, 3, 992, 3, 992, 3, 992, 3,
-	992, 3, 992, 3, 992, 3, 992, 3, 992, 3, 992, 3, 992, 3, 992, 3, 992, 3,
-	992, 3, 992, 3, 992, 3, 993, 3, 993, 3, 993, 3, 993, 3, 993, 3, 993, 3,
-	993, 3, 993, 3, 993, 3, 993, 3, 993, 3, 993, 3, 993, 3, 993, 3, 993, 3,
-	993, 3, 993, 3, 993, 3, 993, 3, 994, 3, 994, 3, 994, 3, 994, 3, 994, 3,
-	994, 3, 994, 3, 994, 3, 994, 3, 994, 3, 994, 3, 994, 3, 994, 3, 994, 3,
-	994, 3, 994, 3, 994, 3, 994, 3, 995, 3, 995, 3, 995, 3, 995, 3, 995, 3,
-	995, 3, 995, 3, 995, 3, 996, 3, 996, 3, 996, 3, 996, 3, 996, 3, 996, 3,
-	996, 3, 996, 3, 996, 3, 996, 3, 996, 3, 996, 3, 996, 3, 996, 3, 997, 3,
-	997, 3, 997, 3, 997, 3, 997, 3, 997, 3, 997, 3, 997, 3, 997, 3, 997, 3,
-	997, 3, 997, 3, 997, 3, 997, 3, 997, 3, 997, 3, 997, 3, 998, 3, 998, 3,
-	998, 3, 998, 3, 998, 3, 998, 3, 998, 3, 998, 3, 998, 3, 998, 3, 998, 3,
-	999, 3, 999, 3, 999, 3, 999, 3, 999, 3, 999, 3, 999, 3, 999, 3, 999, 3,
-	1000, 3, 1000, 3, 1000, 3, 1000, 3, 1000, 3, 1000, 3, 1000, 3, 1000, 3,
-	1000, 3, 1000, 3, 1001, 3, 1001, 3, 1001, 3, 1001, 3, 1001, 3, 1002, 3,
-	1002, 3, 1002, 3, 1002, 3, 1002, 3, 1003, 3, 1003, 3, 1003, 3, 1003, 3,
-	1003, 3, 1003, 3, 1003, 3, 1003, 3, 1004, 3, 1004, 3, 1004, 3, 1004, 3,
-	1004, 3, 1004, 3, 1004, 3, 1004, 3, 1004, 3, 1004, 3, 1004, 3, 1004, 3,
-	1004, 3, 1004, 3, 1004, 3, 1004, 3, 1005, 3, 1005, 3, 1005, 3, 1005, 3,
-	1005, 3, 1005, 3, 1005, 3, 1005, 3, 1006, 3, 1006, 3, 1006, 3, 1006, 3,
-	1006, 3, 1006, 3, 1006, 3, 1006, 3, 1006, 3, 1006, 3, 1006, 3, 1006, 3,
-	1007, 3, 1007, 3, 1007, 3, 1007, 3, 1008, 3, 1008, 3, 1008, 3, 1008, 3,
-	1008, 3, 1008, 3, 1008, 3, 1008, 3, 1008, 3, 1009, 3, 1009, 3, 1009, 3,
-	1009, 3, 1009, 3, 1009, 3, 1009, 3, 1009, 3, 1009, 3, 1009, 3, 1009, 3,
-	1009, 3, 1009, 3, 1010, 3, 1010, 3, 1010, 3, 1010, 3, 1010, 3, 1010, 3,
-	1010, 3, 1010, 3, 1010, 3, 1010, 3, 1010, 3, 1010, 3, 1010, 3, 1010, 3,
-	1011, 3, 1011, 3, 1011, 3, 1011, 3, 1011, 3, 1011, 3, 1011, 3, 1011, 3,
-	1011, 3, 1011, 3, 1011, 3, 1011, 3, 1012, 3, 1012, 3, 1012, 3, 1012, 3,
-	1012, 3, 1012, 3, 1012, 3, 1012, 3, 1012, 3, 1012, 3, 1012, 3, 1012, 3,
-	1013, 3, 1013, 3, 1013, 3, 1013, 3, 1013, 3, 1013, 3, 1013, 3, 1013, 3,
-	1014, 3, 1014, 3, 1014, 3, 1014, 3, 1014, 3, 1014, 3, 1014, 3, 1014, 3,
-	1014, 3, 1014, 3, 1015, 3, 1015, 3, 1015, 3, 1015, 3, 1015, 3, 1015, 3,
-	1015, 3, 1015, 3, 1016, 3, 1016, 3, 1016, 3, 1016, 3, 1016, 3, 1016, 3,
-	1016, 3, 1016, 3, 1016, 3, 1016, 3, 1016, 3, 1017, 3, 1017, 3, 1017, 3,
-	1017, 3, 1017, 3, 1017, 3, 1018, 3, 1018, 3, 1018, 3, 1018, 3, 1018, 3,
-	1018, 3, 1018, 3, 1018, 3, 1018, 3, 1018, 3, 1018, 3, 1019, 3, 1019, 3,
-	1019, 3, 1019, 3, 1019, 3, 1019, 3, 1019, 3, 1019, 3, 1019, 3, 1019, 3,
-	1019, 3, 1019, 3, 1019, 3, 1019, 3, 1019, 3, 1019, 3, 1019, 3, 1019, 3,
-	1019, 3, 1019, 3, 1020, 3, 1020, 3, 1020, 3, 1020, 3, 1020, 3, 1020, 3,
-	1021, 3, 1021, 3, 1021, 3, 1021, 3, 1021, 3, 1021, 3, 1021, 3, 1021, 3,
-	1021, 3, 1021, 3, 1021, 3, 1021, 3, 1021, 3, 1021, 3, 1021, 3, 1022, 3,
-	1022, 3, 1022, 3, 1022, 3, 1022, 3, 1022, 3, 1022, 3, 1022, 3, 1022, 3,
-	1022, 3, 1023, 3, 1023, 3, 1023, 3, 1023, 3, 1023, 3, 1023, 3, 1024, 3,
-	1024, 3, 1024, 3, 1024, 3, 1024, 3, 1025, 3, 1025, 3, 1025, 3, 1025, 3,
-	1025, 3, 1025, 3, 1025, 3, 1025, 3, 1025, 3, 1025, 3, 1025, 3, 1026, 3,
-	1026, 3, 1026, 3, 1026, 3, 1026, 3, 1026, 3, 1026, 3, 1026, 3, 1026, 3,
-	1026, 3, 1026, 3, 1026, 3, 1026, 3, 1026, 3, 1026, 3, 1026, 3, 1026, 3,
-	1026, 3, 1026, 3, 1026, 3, 1026, 3, 1026, 3, 1026, 3, 1026, 3, 1026, 3,
-	1026, 3, 1026, 3, 1027, 3, 1027, 3, 1027, 3, 1027, 3, 1027, 3, 1027, 3,
-	1027, 3, 1027, 3, 1028, 3, 1028, 3, 1028, 3, 1028, 3, 1028, 3, 1028, 3,
-	1028, 3, 1028, 3, 1028, 3, 1028, 3, 1028, 3, 1028, 3, 1028, 3, 1028, 3,
-	1028, 3, 1028, 3, 1028, 3, 1028, 3, 1028, 3, 1028, 3, 1028, 3, 1028, 3,
-	1028, 3, 1028, 3, 1028, 3, 1028, 3, 1028, 3, 1028, 3, 1028, 3, 1028, 3,
-	1028, 3, 1028, 3, 1028, 3, 1028, 3, 1029, 3, 1029, 3, 1029, 3, 1029, 3,
-	1029, 3, 1029, 3, 1029, 3, 1029, 3, 1030, 3, 1030, 3, 1030, 3, 1030, 3,
-	1030, 3, 1030, 3, 1030, 3, 1030, 3, 1030, 3, 1030, 3, 1030, 3, 1031, 3,
-	1031, 3, 1031, 3, 1031, 3, 1031, 3, 1031, 3, 1031, 3, 1031, 3, 1031, 3,
-	1031, 3, 1031, 3, 1031, 3, 1031, 3, 1031, 3, 1032, 3, 1032, 3, 1032, 3,
-	1032, 3, 1032, 3, 1032, 3, 1032, 3, 1033, 3, 1033, 3, 1033, 3, 1033, 3,
-	1033, 3, 1033, 3, 1033, 3, 1033, 3, 1033, 3, 1034, 3, 1034, 3, 1035, 3,
-	1035, 3, 1036, 3, 1036, 3, 1036, 3, 1037, 3, 1037, 3, 1037, 3, 1038, 3,
-	1038, 3, 1038, 3, 1039, 3, 1039, 3, 1039, 3, 1040, 3, 1040, 3, 1040, 3,
-	1041, 3, 1041, 3, 1041, 3, 1042, 3, 1042, 3, 1042, 3, 1043, 3, 1043, 3,
-	1043, 3, 1044, 3, 1044, 3, 1044, 3, 1045, 3, 1045, 3, 1046, 3, 1046, 3,
-	1047, 3, 1047, 3, 1048, 3, 1048, 3, 1049, 3, 1049, 3, 1049, 3, 1050, 3,
-	1050, 3, 1051, 3, 1051, 3, 1051, 3, 1051, 3, 1052, 3, 1052, 3, 1052, 3,
-	1052, 3, 1053, 3, 1053, 3, 1054, 3, 1054, 3, 1055, 3, 1055, 3, 1056, 3,
-	1056, 3, 1057, 3, 1057, 3, 1058, 3, 1058, 3, 1059, 3, 1059, 3, 1060, 3,
-	1060, 3, 1061, 3, 1061, 3, 1062, 3, 1062, 3, 1063, 3, 1063, 3, 1064, 3,
-	1064, 3, 1065, 3, 1065, 3, 1066, 3, 1066, 3, 1067, 3, 1067, 3, 1068, 3,
-	1068, 3, 1069, 3, 1069, 3, 1070, 3, 1070, 3, 1071, 3, 1071, 3, 1072, 3,
-	1072, 3, 1073, 3, 1073, 3, 1074, 3, 1074, 3, 1074, 5, 1074, 12513, 10,
-	1074, 3, 1075, 3, 1075, 3, 1075, 3, 1075, 3, 1076, 6, 1076, 12520, 10,
-	1076, 13, 1076, 14, 1076, 12521, 3, 1076, 3, 1076, 3, 1077, 3, 1077, 3,
-	1077, 3, 1078, 3, 1078, 3, 1078, 5, 1078, 12532, 10, 1078, 3, 1079, 6,
-	1079, 12535, 10, 1079, 13, 1079, 14, 1079, 12536, 3, 1080, 3, 1080, 3,
-	1080, 3, 1080, 3, 1080, 6, 1080, 12544, 10, 1080, 13, 1080, 14, 1080, 12545,
-	3, 1080, 3, 1080, 3, 1080, 3, 1080, 3, 1080, 3, 1080, 6, 1080, 12554, 10,
-	1080, 13, 1080, 14, 1080, 12555, 5, 1080, 12558, 10, 1080, 3, 1081, 6,
-	1081, 12561, 10, 1081, 13, 1081, 14, 1081, 12562, 5, 1081, 12565, 10, 1081,
-	3, 1081, 3, 1081, 6, 1081, 12569, 10, 1081, 13, 1081, 14, 1081, 12570,
-	3, 1081, 6, 1081, 12574, 10, 1081, 13, 1081, 14, 1081, 12575, 3, 1081,
-	3, 1081, 3, 1081, 3, 1081, 6, 1081, 12582, 10, 1081, 13, 1081, 14, 1081,
-	12583, 5, 1081, 12586, 10, 1081, 3, 1081, 3, 1081, 6, 1081, 12590, 10,
-	1081, 13, 1081, 14, 1081, 12591, 3, 1081, 3, 1081, 3, 1081, 6, 1081, 12597,
-	10, 1081, 13, 1081, 14, 1081, 12598, 3, 1081, 3, 1081, 5, 1081, 12603,
-	10, 1081, 3, 1082, 3, 1082, 3, 1082, 3, 1083, 3, 1083, 3, 1084, 3, 1084,
-	3, 1084, 3, 1085, 3, 1085, 3, 1085, 3, 1086, 3, 1086, 3, 1087, 3, 1087,
-	6, 1087, 12620, 10, 1087, 13, 1087, 14, 1087, 12621, 3, 1087, 3, 1087,
-	3, 1088, 3, 1088, 3, 1088, 3, 1088, 5, 1088, 12630, 10, 1088, 3, 1088,
-	3, 1088, 3, 1088, 3, 1088, 3, 1088, 3, 1088, 5, 1088, 12638, 10, 1088,
-	3, 1089, 6, 1089, 12641, 10, 1089, 13, 1089, 14, 1089, 12642, 3, 1089,
-	3, 1089, 6, 1089, 12647, 10, 1089, 13, 1089, 14, 1089, 12648, 3, 1089,
-	6, 1089, 12652, 10, 1089, 13, 1089, 14, 1089, 12653, 3, 1089, 3, 1089,
-	6, 1089, 12658, 10, 1089, 13, 1089, 14, 1089, 12659, 5, 1089, 12662, 10,
-	1089, 3, 1090, 3, 1090, 6, 1090, 12666, 10, 1090, 13, 1090, 14, 1090, 12667,
-	3, 1090, 3, 1090, 3, 1090, 5, 1090, 12673, 10, 1090, 3, 1091, 3, 1091,
-	3, 1091, 6, 1091, 12678, 10, 1091, 13, 1091, 14, 1091, 12679, 3, 1091,
-	5, 1091, 12683, 10, 1091, 3, 1092, 3, 1092, 3, 1092, 3, 1092, 3, 1092,
-	3, 1092, 3, 1092, 3, 1092, 3, 1092, 3, 1092, 3, 1092, 3, 1092, 3, 1092,
-	3, 1092, 3, 1092, 3, 1092, 3, 1092, 3, 1092, 3, 1092, 3, 1092, 3, 1092,
-	3, 1092, 3, 1092, 3, 1092, 3, 1092, 3, 1092, 3, 1092, 3, 1092, 3, 1092,
-	3, 1092, 3, 1092, 3, 1092, 3, 1092, 3, 1092, 3, 1092, 3, 1092, 3, 1092,
-	3, 1092, 3, 1092, 3, 1092, 3, 1092, 5, 1092, 12726, 10, 1092, 3, 1093,
-	3, 1093, 5, 1093, 12730, 10, 1093, 3, 1093, 6, 1093, 12733, 10, 1093, 13,
-	1093, 14, 1093, 12734, 3, 1094, 7, 1094, 12738, 10, 1094, 12, 1094, 14,
-	1094, 12741, 11, 1094, 3, 1094, 6, 1094, 12744, 10, 1094, 13, 1094, 14,
-	1094, 12745, 3, 1094, 7, 1094, 12749, 10, 1094, 12, 1094, 14, 1094, 12752,
-	11, 1094, 3, 1095, 3, 1095, 3, 1095, 3, 1095, 3, 1095, 3, 1095, 7, 1095,
-	12760, 10, 1095, 12, 1095, 14, 1095, 12763, 11, 1095, 3, 1095, 3, 1095,
-	3, 1096, 3, 1096, 3, 1096, 3, 1096, 3, 1096, 3, 1096, 7, 1096, 12773, 10,
-	1096, 12, 1096, 14, 1096, 12776, 11, 1096, 3, 1096, 3, 1096, 3, 1097, 3,
-	1097, 3, 1097, 3, 1097, 3, 1097, 3, 1097, 7, 1097, 12786, 10, 1097, 12,
-	1097, 14, 1097, 12789, 11, 1097, 3, 1097, 3, 1097, 3, 1098, 3, 1098, 3,
-	1099, 3, 1099, 3, 1100, 3, 1100, 3, 1100, 6, 1100, 12800, 10, 1100, 13,
-	1100, 14, 1100, 12801, 3, 1100, 3, 1100, 3, 1101, 3, 1101, 3, 1101, 3,
-	1101, 6, 2217, 2230, 12739, 12745, 2, 1102, 3, 3, 5, 4, 7, 5, 9, 6, 11,
-	7, 13, 8, 15, 9, 17, 10, 19, 11, 21, 12, 23, 13, 25, 14, 27, 15, 29, 16,
-	31, 17, 33, 18, 35, 19, 37, 20, 39, 21, 41, 22, 43, 23, 45, 24, 47, 25,
-	49, 26, 51, 27, 53, 28, 55, 29, 57, 30, 59, 31, 61, 32, 63, 33, 65, 34,
-	67, 35, 69, 36, 71, 37, 73, 38, 75, 39, 77, 40, 79, 41, 81, 42, 83, 43,
-	85, 44, 87, 45, 89, 46, 91, 47, 93, 48, 95, 49, 97, 50, 99, 51, 101, 52,
-	103, 53, 105, 54, 107, 55, 109, 56, 111, 57, 113, 58, 115, 59, 117, 60,
-	119, 61, 121, 62, 123, 63, 125, 64, 127, 65, 129, 66, 131, 67, 133, 68,
-	135, 69, 137, 70, 139, 71, 141, 72, 143, 73, 145, 74, 147, 75, 149, 76,
-	151, 77, 153, 78, 155, 79, 157, 80, 159, 81, 161, 82, 163, 83, 165, 84,
-	167, 85, 169, 86, 171, 87, 173, 88, 175, 89, 177, 90, 179, 91, 181, 92,
-	183, 93, 185, 94, 187, 95, 189, 96, 191, 97, 193, 98, 195, 99, 197, 100,
-	199, 101, 201, 102, 203, 103, 205, 104, 207, 105, 209, 106, 211, 107, 213,
-	108, 215, 109, 217, 110, 219, 111, 221, 112, 223, 113, 225, 114, 227, 115,
-	229, 116, 231, 117, 233, 118, 235, 119, 237, 120, 239, 121, 241, 122, 243,
-	123, 245, 124, 247, 125, 249, 126, 251, 127, 253, 128, 255, 129, 257, 130,
-	259, 131, 261, 132, 263, 133, 265, 134, 267, 135, 269, 136, 271, 137, 273,
-	138, 275, 139, 277, 140, 279, 141, 281, 142, 283, 143, 285, 144, 287, 145,
-	289, 146, 291, 147, 293, 148, 295, 149, 297, 150, 299, 151, 301, 152, 303,
-	153, 305, 154, 307, 155, 309, 156, 311, 157, 313, 158, 315, 159, 317, 160,
-	319, 161, 321, 162, 323, 163, 325, 164, 327, 165, 329, 166, 331, 167, 333,
-	168, 335, 169, 337, 170, 339, 171, 341, 172, 343, 173, 345, 174, 347, 175,
-	349, 176, 351, 177, 353, 178, 355, 179, 357, 180, 359, 181, 361, 182, 363,
-	183, 365, 184, 367, 185, 369, 186, 371, 187, 373, 188, 375, 189, 377, 190,
-	379, 191, 381, 192, 383, 193, 385, 194, 387, 195, 389, 196, 391, 197, 393,
-	198, 395, 199, 397, 200, 399, 201, 401, 202, 403, 203, 405, 204, 407, 205,
-	409, 206, 411, 207, 413, 208, 415, 209, 417, 210, 419, 211, 421, 212, 423,
-	213, 425, 214, 427, 215, 429, 216, 431, 217, 433, 218, 435, 219, 437, 220,
-	439, 221, 441, 222, 443, 223, 445, 224, 447, 225, 449, 226, 451, 227, 453,
-	228, 455, 229, 457, 230, 459, 231, 461, 232, 463, 233, 465, 234, 467, 235,
-	469, 236, 471, 237, 473, 238, 475, 239, 477, 240, 479, 241, 481, 242, 483,
-	243, 485, 244, 487, 245, 489, 246, 491, 247, 493, 248, 495, 249, 497, 250,
-	499, 251, 501, 252, 503, 253, 505, 254, 507, 255, 509, 256, 511, 257, 513,
-	258, 515, 259, 517, 260, 519, 261, 521, 262, 523, 263, 525, 264, 527, 265,
-	529, 266, 531, 267, 533, 268, 535, 269, 537, 270, 539, 271, 541, 272, 543,
-	273, 545, 274, 547, 275, 549, 276, 551, 277, 553, 278, 555, 279, 557, 280,
-	559, 281, 561, 282, 563, 283, 565, 284, 567, 285, 569, 286, 571, 287, 573,
-	288, 575, 289, 577, 290, 579, 291, 581, 292, 583, 293, 585, 294, 587, 295,
-	589, 296, 591, 297, 593, 298, 595, 299, 597, 300, 599, 301, 601, 302, 603,
-	303, 605, 304, 607, 305, 609, 306, 611, 307, 613, 308, 615, 309, 617, 310,
-	619, 311, 621, 312, 623, 313, 625, 314, 627, 315, 629, 316, 631, 317, 633,
-	318, 635, 319, 637, 320, 639, 321, 641, 322, 643, 323, 645, 324, 647, 325,
-	649, 326, 651, 327, 653, 328, 655, 329, 657, 330, 659, 331, 661, 332, 663,
-	333, 665, 334, 667, 335, 669, 336, 671, 337, 673, 338, 675, 339, 677, 340,
-	679, 341, 681, 342, 683, 343, 685, 344, 687, 345, 689, 346, 691, 347, 693,
-	348, 695, 349, 697, 350, 699, 351, 701, 352, 703, 353, 705, 354, 707, 355,
-	709, 356, 711, 357, 713, 358, 715, 359, 717, 360, 719, 361, 721, 362, 723,
-	363, 725, 364, 727, 365, 729, 366, 731, 367, 733, 368, 735, 369, 737, 370,
-	739, 371, 741, 372, 743, 373, 745, 374, 747, 375, 749, 376, 751, 377, 753,
-	378, 755, 379, 757, 380, 759, 381, 761, 382, 763, 383, 765, 384, 767, 385,
-	769, 386, 771, 387, 773, 388, 775, 389, 777, 390, 779, 391, 781, 392, 783,
-	393, 785, 394, 787, 395, 789, 396, 791, 397, 793, 398, 795, 399, 797, 400,
-	799, 401, 801, 402, 803, 403, 805, 404, 807, 405, 809, 406, 811, 407, 813,
-	408, 815, 409, 817, 410, 819, 411, 821, 412, 823, 413, 825, 414, 827, 415,
-	829, 416, 831, 417, 833, 418, 835, 419, 837, 420, 839, 421, 841, 422, 843,
-	423, 845, 424, 847, 425, 849, 426, 851, 427, 853, 428, 855, 429, 857, 430,
-	859, 431, 861, 432, 863, 433, 865, 434, 867, 435, 869, 436, 871, 437, 873,
-	438, 875, 439, 877, 440, 879, 441, 881, 442, 883, 443, 885, 444, 887, 445,
-	889, 446, 891, 447, 893, 448, 895, 449, 897, 450, 899, 451, 901, 452, 903,
-	453, 905, 454, 907, 455, 909, 456, 911, 457, 913, 458, 915, 459, 917, 460,
-	919, 461, 921, 462, 923, 463, 925, 464, 927, 465, 929, 466, 931, 467, 933,
-	468, 935, 469, 937, 470, 939, 471, 941, 472, 943, 473, 945, 474, 947, 475,
-	949, 476, 951, 477, 953, 478, 955, 479, 957, 480, 959, 481, 961, 482, 963,
-	483, 965, 484, 967, 485, 969, 486, 971, 487, 973, 488, 975, 489, 977, 490,
-	979, 491, 981, 492, 983, 493, 985, 494, 987, 495, 989, 496, 991, 497, 993,
-	498, 995, 499, 997, 500, 999, 501, 1001, 502, 1003, 503, 1005, 504, 1007,
-	505, 1009, 506, 1011, 507, 1013, 508, 1015, 509, 1017, 510, 1019, 511,
-	1021, 512, 1023, 513, 1025, 514, 1027, 515, 1029, 516, 1031, 517, 1033,
-	518, 1035, 519, 1037, 520, 1039, 521, 1041, 522, 1043, 523, 1045, 524,
-	1047, 525, 1049, 526, 1051, 527, 1053, 528, 1055, 529, 1057, 530, 1059,
-	531, 1061, 532, 1063, 533, 1065, 534, 1067, 535, 1069, 536, 1071, 537,
-	1073, 538, 1075, 539, 1077, 540, 1079, 541, 1081, 542, 1083, 543, 1085,
-	544, 1087, 545, 1089, 546, 1091, 547, 1093, 548, 1095, 549, 1097, 550,
-	1099, 551, 1101, 552, 1103, 553, 1105, 554, 1107, 555, 1109, 556, 1111,
-	557, 1113, 558, 1115, 559, 1117, 560, 1119, 561, 1121, 562, 1123, 563,
-	1125, 564, 1127, 565, 1129, 566, 1131, 567, 1133, 568, 1135, 569, 1137,
-	570, 1139, 571, 1141, 572, 1143, 573, 1145, 574, 1147, 575, 1149, 576,
-	1151, 577, 1153, 578, 1155, 579, 1157, 580, 1159, 581, 1161, 582, 1163,
-	583, 1165, 584, 1167, 585, 1169, 586, 1171, 587, 1173, 588, 1175, 589,
-	1177, 590, 1179, 591, 1181, 592, 1183, 593, 1185, 594, 1187, 595, 1189,
-	596, 1191, 597, 1193, 598, 1195, 599, 1197, 600, 1199, 601, 1201, 602,
-	1203, 603, 1205, 604, 1207, 605, 1209, 606, 1211, 607, 1213, 608, 1215,
-	609, 1217, 610, 1219, 611, 1221, 612, 1223, 613, 1225, 614, 1227, 615,
-	1229, 616, 1231, 617, 1233, 618, 1235, 619, 1237, 620, 1239, 621, 1241,
-	622, 1243, 623, 1245, 624, 1247, 625, 1249, 626, 1251, 627, 1253, 628,
-	1255, 629, 1257, 630, 1259, 631, 1261, 632, 1263, 633, 1265, 634, 1267,
-	635, 1269, 636, 1271, 637, 1273, 638, 1275, 639, 1277, 640, 1279, 641,
-	1281, 642, 1283, 643, 1285, 644, 1287, 645, 1289, 646, 1291, 647, 1293,
-	648, 1295, 649, 1297, 650, 1299, 651, 1301, 652, 1303, 653, 1305, 654,
-	1307, 655, 1309, 656, 1311, 657, 1313, 658, 1315, 659, 1317, 660, 1319,
-	661, 1321, 662, 1323, 663, 1325, 664, 1327, 665, 1329, 666, 1331, 667,
-	1333, 668, 1335, 669, 1337, 670, 1339, 671, 1341, 672, 1343, 673, 1345,
-	674, 1347, 675, 1349, 676, 1351, 677, 1353, 678, 1355, 679, 1357, 680,
-	1359, 681, 1361, 682, 1363, 683, 1365, 684, 1367, 685, 1369, 686, 1371,
-	687, 1373, 688, 1375, 689, 1377, 690, 1379, 691, 1381, 692, 1383, 693,
-	1385, 694, 1387, 695, 1389, 696, 1391, 697, 1393, 698, 1395, 699, 1397,
-	700, 1399, 701, 1401, 702, 1403, 703, 1405, 704, 1407, 705, 1409, 706,
-	1411, 707, 1413, 708, 1415, 709, 1417, 710, 1419, 711, 1421, 712, 1423,
-	713, 1425, 714, 1427, 715, 1429, 716, 1431, 717, 1433, 718, 1435, 719,
-	1437, 720, 1439, 721, 1441, 722, 1443, 723, 1445, 724, 1447, 725, 1449,
-	726, 1451, 727, 1453, 728, 1455, 729, 1457, 730, 1459, 731, 1461, 732,
-	1463, 733, 1465, 734, 1467, 735, 1469, 736, 1471, 737, 1473, 738, 1475,
-	739, 1477, 740, 1479, 741, 1481, 742, 1483, 743, 1485, 744, 1487, 745,
-	1489, 746, 1491, 747, 1493, 748, 1495, 749, 1497, 750, 1499, 751, 1501,
-	752, 1503, 753, 1505, 754, 1507, 755, 1509, 756, 1511, 757, 1513, 758,
-	1515, 759, 1517, 760, 1519, 761, 1521, 762, 1523, 763, 1525, 764, 1527,
-	765, 1529, 766, 1531, 767, 1533, 768, 1535, 769, 1537, 770, 1539, 771,
-	1541, 772, 1543, 773, 1545, 774, 1547, 775, 1549, 776, 1551, 777, 1553,
-	778, 1555, 779, 1557, 780, 1559, 781, 1561, 782, 1563, 783, 1565, 784,
-	1567, 785, 1569, 786, 1571, 787, 1573, 788, 1575, 789, 1577, 790, 1579,
-	791, 1581, 792, 1583, 793, 1585, 794, 1587, 795, 1589, 796, 1591, 797,
-	1593, 798, 1595, 799, 1597, 800, 1599, 801, 1601, 802, 1603, 803, 1605,
-	804, 1607, 805, 1609, 806, 1611, 807, 1613, 808, 1615, 809, 1617, 810,
-	1619, 811, 1621, 812, 1623, 813, 1625, 814, 1627, 815, 1629, 816, 1631,
-	817, 1633, 818, 1635, 819, 1637, 820, 1639, 821, 1641, 822, 1643, 823,
-	1645, 824, 1647, 825, 1649, 826, 1651, 827, 1653, 828, 1655, 829, 1657,
-	830, 1659, 831, 1661, 832, 1663, 833, 1665, 834, 1667, 835, 1669, 836,
-	1671, 837, 1673, 838, 1675, 839, 1677, 840, 1679, 841, 1681, 842, 1683,
-	843, 1685, 844, 1687, 845, 1689, 846, 1691, 847, 1693, 848, 1695, 849,
-	1697, 850, 1699, 851, 1701, 852, 1703, 853, 1705, 854, 1707, 855, 1709,
-	856, 1711, 857, 1713, 858, 1715, 859, 1717, 860, 1719, 861, 1721, 862,
-	1723, 863, 1725, 864, 1727, 865, 1729, 866, 1731, 867, 1733, 868, 1735,
-	869, 1737, 870, 1739, 871, 1741, 872, 1743, 873, 1745, 874, 1747, 875,
-	1749, 876, 1751, 877, 1753, 878, 1755, 879, 1757, 880, 1759, 881, 1761,
-	882, 1763, 883, 1765, 884, 1767, 885, 1769, 886, 1771, 887, 1773, 888,
-	1775, 889, 1777, 890, 1779, 891, 1781, 892, 1783, 893, 1785, 894, 1787,
-	895, 1789, 896, 1791, 897, 1793, 898, 1795, 899, 1797, 900, 1799, 901,
-	1801, 902, 1803, 903, 1805, 904, 1807, 905, 1809, 906, 1811, 907, 1813,
-	908, 1815, 909, 1817, 910, 1819, 911, 1821, 912, 1823, 913, 1825, 914,
-	1827, 915, 1829, 916, 1831, 917, 1833, 918, 1835, 919, 1837, 920, 1839,
-	921, 1841, 922, 1843, 923, 1845, 924, 1847, 925, 1849, 926, 1851, 927,
-	1853, 928, 1855, 929, 1857, 930, 1859, 931, 1861, 932, 1863, 933, 1865,
-	934, 1867, 935, 1869, 936, 1871, 937, 1873, 938, 1875, 939, 1877, 940,
-	1879, 941, 1881, 942, 1883, 943, 1885, 944, 1887, 945, 1889, 946, 1891,
-	947, 1893, 948, 1895, 949, 1897, 950, 1899, 951, 1901, 952, 1903, 953,
-	1905, 954, 1907, 955, 1909, 956, 1911, 957, 1913, 958, 1915, 959, 1917,
-	960, 1919, 961, 1921, 962, 1923, 963, 1925, 964, 1927, 965, 1929, 966,
-	1931, 967, 1933, 968, 1935, 969, 1937, 970, 1939, 971, 1941, 972, 1943,
-	973, 1945, 974, 1947, 975, 1949, 976, 1951, 977, 1953, 978, 1955, 979,
-	1957, 980, 1959, 981, 1961, 982, 1963, 983, 1965, 984, 1967, 985, 1969,
-	986, 1971, 987, 1973, 988, 1975, 989, 1977, 990, 1979, 991, 1981, 992,
-	1983, 993, 1985, 994, 1987, 995, 1989, 996, 1991, 997, 1993, 998, 1995,
-	999, 1997, 1000, 1999, 1001, 2001, 1002, 2003, 1003, 2005, 1004, 2007,
-	1005, 2009, 1006, 2011, 1007, 2013, 1008, 2015, 1009, 2017, 1010, 2019,
-	1011, 2021, 1012, 2023, 1013, 2025, 1014, 2027, 1015, 2029, 1016, 2031,
-	1017, 2033, 1018, 2035, 1019, 2037, 1020, 2039, 1021, 2041, 1022, 2043,
-	1023, 2045, 1024, 2047, 1025, 2049, 1026, 2051, 1027, 2053, 1028, 2055,
-	1029, 2057, 1030, 2059, 1031, 2061, 1032, 2063, 1033, 2065, 1034, 2067,
-	1035, 2069, 1036, 2071, 1037, 2073, 1038, 2075, 1039, 2077, 1040, 2079,
-	1041, 2081, 1042, 2083, 1043, 2085, 1044, 2087, 1045, 2089, 1046, 2091,
-	1047, 2093, 1048, 2095, 1049, 2097, 1050, 2099, 1051, 2101, 1052, 2103,
-	1053, 2105, 1054, 2107, 1055, 2109, 1056, 2111, 1057, 2113, 1058, 2115,
-	1059, 2117, 1060, 2119, 1061, 2121, 1062, 2123, 1063, 2125, 1064, 2127,
-	1065, 2129, 1066, 2131, 1067, 2133, 1068, 2135, 1069, 2137, 1070, 2139,
-	1071, 2141, 1072, 2143, 1073, 2145, 1074, 2147, 2, 2149, 1075, 2151, 1076,
-	2153, 1077, 2155, 1078, 2157, 1079, 2159, 1080, 2161, 1081, 2163, 1082,
-	2165, 1083, 2167, 1084, 2169, 1085, 2171, 1086, 2173, 1087, 2175, 1088,
-	2177, 1089, 2179, 1090, 2181, 1091, 2183, 2, 2185, 2, 2187, 2, 2189, 2,
-	2191, 2, 2193, 2, 2195, 2, 2197, 2, 2199, 2, 2201, 1092, 3, 2, 19, 5, 2,
-	11, 12, 15, 15, 34, 34, 4, 2, 11, 11, 34, 34, 4, 2, 12, 12, 15, 15, 6,
-	2, 73, 73, 77, 77, 79, 79, 86, 86, 3, 2, 98, 98, 3, 2, 50, 59, 4, 2, 48,
-	48, 50, 59, 4, 2, 50, 60, 67, 72, 7, 2, 38, 38, 48, 48, 50, 59, 67, 92,
-	97, 97, 4, 2, 45, 45, 47, 47, 7, 2, 38, 38, 50, 59, 67, 92, 97, 97, 130,
-	1, 6, 2, 38, 38, 67, 92, 97, 97, 130, 1, 4, 2, 36, 36, 94, 94, 4, 2, 41,
-	41, 94, 94, 4, 2, 94, 94, 98, 98, 4, 2, 50, 59, 67, 72, 3, 2, 50, 51, 2,
-	12904, 2, 3, 3, 2, 2, 2, 2, 5, 3, 2, 2, 2, 2, 7, 3, 2, 2, 2, 2, 9, 3, 2,
-	2, 2, 2, 11, 3, 2, 2, 2, 2, 13, 3, 2, 2, 2, 2, 15, 3, 2, 2, 2, 2, 17, 3,
-	2, 2, 2, 2, 19, 3, 2, 2, 2, 2, 21, 3, 2, 2, 2, 2, 23, 3, 2, 2, 2, 2, 25,
-	3, 2, 2, 2, 2, 27, 3, 2, 2, 2, 2, 29, 3, 2, 2, 2, 2, 31, 3, 2, 2, 2, 2,
-	33, 3, 2, 2, 2, 2, 35, 3, 2, 2, 2, 2, 37, 3, 2, 2, 2, 2, 39, 3, 2, 2, 2,
-	2, 41, 3, 2, 2, 2, 2, 43, 3, 2, 2, 2, 2, 45, 3, 2, 2, 2, 2, 47, 3, 2, 2,
-	2, 2, 49, 3, 2, 2, 2, 2, 51, 3, 2, 2, 2, 2, 53, 3, 2, 2, 2, 2, 55, 3, 2,
-	2, 2, 2, 57, 3, 2, 2, 2, 2, 59, 3, 2, 2, 2, 2, 61, 3, 2, 2, 2, 2, 63, 3,
-	2, 2, 2, 2, 65, 3, 2, 2, 2, 2, 67, 3, 2, 2, 2, 2, 69, 3, 2, 2, 2, 2, 71,
-	3, 2, 2, 2, 2, 73, 3, 2, 2, 2, 2, 75, 3, 2, 2, 2, 2, 77, 3, 2, 2, 2, 2,
-	79, 3, 2, 2, 2, 2, 81, 3, 2, 2, 2, 2, 83, 3, 2, 2, 2, 2, 85, 3, 2, 2, 2,
-	2, 87, 3, 2, 2, 2, 2, 89, 3, 2, 2, 2, 2, 91, 3, 2, 2, 2, 2, 93, 3, 2, 2,
-	2, 2, 95, 3, 2, 2, 2, 2, 97, 3, 2, 2, 2, 2, 99, 3, 2, 2, 2, 2, 101, 3,
-	2, 2, 2, 2, 103, 3, 2, 2, 2, 2, 105, 3, 2, 2, 2, 2, 107, 3, 2, 2, 2, 2,
-	109, 3, 2, 2, 2, 2, 111, 3, 2, 2, 2, 2, 113, 3, 2, 2, 2, 2, 115, 3, 2,
-	2, 2, 2, 117, 3, 2, 2, 2, 2, 119, 3, 2, 2, 2, 2, 121, 3, 2, 2, 2, 2, 123,
-	3, 2, 2, 2, 2, 125, 3, 2, 2, 2, 2, 127, 3, 2, 2, 2, 2, 129, 3, 2, 2, 2,
-	2, 131, 3, 2, 2, 2, 2, 133, 3, 2, 2, 2, 2, 135, 3, 2, 2, 2, 2, 137, 3,
-	2, 2, 2, 2, 139, 3, 2, 2, 2, 2, 141, 3, 2, 2, 2, 2, 143, 3, 2, 2, 2, 2,
-	145, 3, 2, 2, 2, 2, 147, 3, 2, 2, 2, 2, 149, 3, 2, 2, 2, 2, 151, 3, 2,
-	2, 2, 2, 153, 3, 2, 2, 2, 2, 155, 3, 2, 2, 2, 2, 157, 3, 2, 2, 2, 2, 159,
-	3, 2, 2, 2, 2, 161, 3, 2, 2, 2, 2, 163, 3, 2, 2, 2, 2, 165, 3, 2, 2, 2,
-	2, 167, 3, 2, 2, 2, 2, 169, 3, 2, 2, 2, 2, 171, 3, 2, 2, 2, 2, 173, 3,
-	2, 2, 2, 2, 175, 3, 2, 2, 2, 2, 177, 3, 2, 2, 2, 2, 179, 3, 2, 2, 2, 2,
-	181, 3, 2, 2, 2, 2, 183, 3, 2, 2, 2, 2, 185, 3, 2, 2, 2, 2, 187, 3, 2,
-	2, 2, 2, 189, 3, 2, 2, 2, 2, 191, 3, 2, 2, 2, 2, 193, 3, 2, 2, 2, 2, 195,
-	3, 2, 2, 2, 2, 197, 3, 2, 2, 2, 2, 199, 3, 2, 2, 2, 2, 201, 3, 2, 2, 2,
-	2, 203, 3, 2, 2, 2, 2, 205, 3, 2, 2, 2, 2, 207, 3, 2, 2, 2, 2, 209, 3,
-	2, 2, 2, 2, 211, 3, 2, 2, 2, 2, 213, 3, 2, 2, 2, 2, 215, 3, 2, 2, 2, 2,
-	217, 3, 2, 2, 2, 2, 219, 3, 2, 2, 2, 2, 221, 3, 2, 2, 2, 2, 223, 3, 2,
-	2, 2, 2, 225, 3, 2, 2, 2, 2, 227, 3, 2, 2, 2, 2, 229, 3, 2, 2, 2, 2, 231,
-	3, 2, 2, 2, 2, 233, 3, 2, 2, 2, 2, 235, 3, 2, 2, 2, 2, 237, 3, 2, 2, 2,
-	2, 239, 3, 2, 2, 2, 2, 241, 3, 2, 2, 2, 2, 243, 3, 2, 2, 2, 2, 245, 3,
-	2, 2, 2, 2, 247, 3, 2, 2, 2, 2, 249, 3, 2, 2, 2, 2, 251, 3, 2, 2, 2, 2,
-	253, 3, 2, 2, 2, 2, 255, 3, 2, 2, 2, 2, 257, 3, 2, 2, 2, 2, 259, 3, 2,
-	2, 2, 2, 261, 3, 2, 2, 2, 2, 263, 3, 2, 2, 2, 2, 265, 3, 2, 2, 2, 2, 267,
-	3, 2, 2, 2, 2, 269, 3, 2, 2, 2, 2, 271, 3, 2, 2, 2, 2, 273, 3, 2, 2, 2,
-	2, 275, 3, 2, 2, 2, 2, 277, 3, 2, 2, 2, 2, 279, 3, 2, 2, 2, 2, 281, 3,
-	2, 2, 2, 2, 283, 3, 2, 2, 2, 2, 285, 3, 2, 2, 2, 2, 287, 3, 2, 2, 2, 2,
-	289, 3, 2, 2, 2, 2, 291, 3, 2, 2, 2, 2, 293, 3, 2, 2, 2, 2, 295, 3, 2,
-	2, 2, 2, 297, 3, 2, 2, 2, 2, 299, 3, 2, 2, 2, 2, 301, 3, 2, 2, 2, 2, 303,
-	3, 2, 2, 2, 2, 305, 3, 2, 2, 2, 2, 307, 3, 2, 2, 2, 2, 309, 3, 2, 2, 2,
-	2, 311, 3, 2, 2, 2, 2, 313, 3, 2, 2, 2, 2, 315, 3, 2, 2, 2, 2, 317, 3,
-	2, 2, 2, 2, 319, 3, 2, 2, 2, 2, 321, 3, 2, 2, 2, 2, 323, 3, 2, 2, 2, 2,
-	325, 3, 2, 2, 2, 2, 327, 3, 2, 2, 2, 2, 329, 3, 2, 2, 2, 2, 331, 3, 2,
-	2, 2, 2, 333, 3, 2, 2, 2, 2, 335, 3, 2, 2, 2, 2, 337, 3, 2, 2, 2, 2, 339,
-	3, 2, 2, 2, 2, 341, 3, 2, 2, 2, 2, 343, 3, 2, 2, 2, 2, 345, 3, 2, 2, 2,
-	2, 347, 3, 2, 2, 2, 2, 349, 3, 2, 2, 2, 2, 351, 3, 2, 2, 2, 2, 353, 3,
-	2, 2, 2, 2, 355, 3, 2, 2, 2, 2, 357, 3, 2, 2, 2, 2, 359, 3, 2, 2, 2, 2,
-	361, 3, 2, 2, 2, 2, 363, 3, 2, 2, 2, 2, 365, 3, 2, 2, 2, 2, 367, 3, 2,
-	2, 2, 2, 369, 3, 2, 2, 2, 2, 371, 3, 2, 2, 2, 2, 373, 3, 2, 2, 2, 2, 375,
-	3, 2, 2, 2, 2, 377, 3, 2, 2, 2, 2, 379, 3, 2, 2, 2, 2, 381, 3, 2, 2, 2,
-	2, 383, 3, 2, 2, 2, 2, 385, 3, 2, 2, 2, 2, 387, 3, 2, 2, 2, 2, 389, 3,
-	2, 2, 2, 2, 391, 3, 2, 2, 2, 2, 393, 3, 2, 2, 2, 2, 395, 3, 2, 2, 2, 2,
-	397, 3, 2, 2, 2, 2, 399, 3, 2, 2, 2, 2, 401, 3, 2, 2, 2, 2, 403, 3, 2,
-	2, 2, 2, 405, 3, 2, 2, 2, 2, 407, 3, 2, 2, 2, 2, 409, 3, 2, 2, 2, 2, 411,
-	3, 2, 2, 2, 2, 413, 3, 2, 2, 2, 2, 415, 3, 2, 2, 2, 2, 417, 3, 2, 2, 2,
-	2, 419, 3, 2, 2, 2, 2, 421, 3, 2, 2, 2, 2, 423, 3, 2, 2, 2, 2, 425, 3,
-	2, 2, 2, 2, 427, 3, 2, 2, 2, 2, 429, 3, 2, 2, 2, 2, 431, 3, 2, 2, 2, 2,
-	433, 3, 2, 2, 2, 2, 435, 3, 2, 2, 2, 2, 437, 3, 2, 2, 2, 2, 439, 3, 2,
-	2, 2, 2, 441, 3, 2, 2, 2, 2, 443, 3, 2, 2, 2, 2, 445, 3, 2, 2, 2, 2, 447,
-	3, 2, 2, 2, 2, 449, 3, 2, 2, 2, 2, 451, 3, 2, 2, 2, 2, 453, 3, 2, 2, 2,
-	2, 455, 3, 2, 2, 2, 2, 457, 3, 2, 2, 2, 2, 459, 3, 2, 2, 2, 2, 461, 3,
-	2, 2, 2, 2, 463, 3, 2, 2, 2, 2, 465, 3, 2, 2, 2, 2, 467, 3, 2, 2, 2, 2,
-	469, 3, 2, 2, 2, 2, 471, 3, 2, 2, 2, 2, 473, 3, 2, 2, 2, 2, 475, 3, 2,
-	2, 2, 2, 477, 3, 2, 2, 2, 2, 479, 3, 2, 2, 2, 2, 481, 3, 2, 2, 2, 2, 483,
-	3, 2, 2, 2, 2, 485, 3, 2, 2, 2, 2, 487, 3, 2, 2, 2, 2, 489, 3, 2, 2, 2,
-	2, 491, 3, 2, 2, 2, 2, 493, 3, 2, 2, 2, 2, 495, 3, 2, 2, 2, 2, 497, 3,
-	2, 2, 2, 2, 499, 3, 2, 2, 2, 2, 501, 3, 2, 2, 2, 2, 503, 3, 2, 2, 2, 2,
-	505, 3, 2, 2, 2, 2, 507, 3, 2, 2, 2, 2, 509, 3, 2, 2, 2, 2, 511, 3, 2,
-	2, 2, 2, 513, 3, 2, 2, 2, 2, 515, 3, 2, 2, 2, 2, 517, 3, 2, 2, 2, 2, 519,
-	3, 2, 2, 2, 2, 521, 3, 2, 2, 2, 2, 523, 3, 2, 2, 2, 2, 525, 3, 2, 2, 2,
-	2, 527, 3, 2, 2, 2, 2, 529, 3, 2, 2, 2, 2, 531, 3, 2, 2, 2, 2, 533, 3,
-	2, 2, 2, 2, 535, 3, 2, 2, 2, 2, 537, 3, 2, 2, 2, 2, 539, 3, 2, 2, 2, 2,
-	541, 3, 2, 2, 2, 2, 543, 3, 2, 2, 2, 2, 545, 3, 2, 2, 2, 2, 547, 3, 2,
-	2, 2, 2, 549, 3, 2, 2, 2, 2, 551, 3, 2, 2, 2, 2, 553, 3, 2, 2, 2, 2, 555,
-	3, 2, 2, 2, 2, 557, 3, 2, 2, 2, 2, 559, 3, 2, 2, 2, 2, 561, 3, 2, 2, 2,
-	2, 563, 3, 2, 2, 2, 2, 565, 3, 2, 2, 2, 2, 567, 3, 2, 2, 2, 2, 569, 3,
-	2, 2, 2, 2, 571, 3, 2, 2, 2, 2, 573, 3, 2, 2, 2, 2, 575, 3, 2, 2, 2, 2,
-	577, 3, 2, 2, 2, 2, 579, 3, 2, 2, 2, 2, 581, 3, 2, 2, 2, 2, 583, 3, 2,
-	2, 2, 2, 585, 3, 2, 2, 2, 2, 587, 3, 2, 2, 2, 2, 589, 3, 2, 2, 2, 2, 591,
-	3, 2, 2, 2, 2, 593, 3, 2, 2, 2, 2, 595, 3, 2, 2, 2, 2, 597, 3, 2, 2, 2,
-	2, 599, 3, 2, 2, 2, 2, 601, 3, 2, 2, 2, 2, 603, 3, 2, 2, 2, 2, 605, 3,
-	2, 2, 2, 2, 607, 3, 2, 2, 2, 2, 609, 3, 2, 2, 2, 2, 611, 3, 2, 2, 2, 2,
-	613, 3, 2, 2, 2, 2, 615, 3, 2, 2, 2, 2, 617, 3, 2, 2, 2, 2, 619, 3, 2,
-	2, 2, 2, 621, 3, 2, 2, 2, 2, 623, 3, 2, 2, 2, 2, 625, 3, 2, 2, 2, 2, 627,
-	3, 2, 2, 2, 2, 629, 3, 2, 2, 2, 2, 631, 3, 2, 2, 2, 2, 633, 3, 2, 2, 2,
-	2, 635, 3, 2, 2, 2, 2, 637, 3, 2, 2, 2, 2, 639, 3, 2, 2, 2, 2, 641, 3,
-	2, 2, 2, 2, 643, 3, 2, 2, 2, 2, 645, 3, 2, 2, 2, 2, 647, 3, 2, 2, 2, 2,
-	649, 3, 2, 2, 2, 2, 651, 3, 2, 2, 2, 2, 653, 3, 2, 2, 2, 2, 655, 3, 2,
-	2, 2, 2, 657, 3, 2, 2, 2, 2, 659, 3, 2, 2, 2, 2, 661, 3, 2, 2, 2, 2, 663,
-	3, 2, 2, 2, 2, 665, 3, 2, 2, 2, 2, 667, 3, 2, 2, 2, 2, 669, 3, 2, 2, 2,
-	2, 671, 3, 2, 2, 2, 2, 673, 3, 2, 2, 2, 2, 675, 3, 2, 2, 2, 2, 677, 3,
-	2, 2, 2, 2, 679, 3, 2, 2, 2, 2, 681, 3, 2, 2, 2, 2, 683, 3, 2, 2, 2, 2,
-	685, 3, 2, 2, 2, 2, 687, 3, 2, 2, 2, 2, 689, 3, 2, 2, 2, 2, 691, 3, 2,
-	2, 2, 2, 693, 3, 2, 2, 2, 2, 695, 3, 2, 2, 2, 2, 697, 3, 2, 2, 2, 2, 699,
-	3, 2, 2, 2, 2, 701, 3, 2, 2, 2, 2, 703, 3, 2, 2, 2, 2, 705, 3, 2, 2, 2,
-	2, 707, 3, 2, 2, 2, 2, 709, 3, 2, 2, 2, 2, 711, 3, 2, 2, 2, 2, 713, 3,
-	2, 2, 2, 2, 715, 3, 2, 2, 2, 2, 717, 3, 2, 2, 2, 2, 719, 3, 2, 2, 2, 2,
-	721, 3, 2, 2, 2, 2, 723, 3, 2, 2, 2, 2, 725, 3, 2, 2, 2, 2, 727, 3, 2,
-	2, 2, 2, 729, 3, 2, 2, 2, 2, 731, 3, 2, 2, 2, 2, 733, 3, 2, 2, 2, 2, 735,
-	3, 2, 2, 2, 2, 737, 3, 2, 2, 2, 2, 739, 3, 2, 2, 2, 2, 741, 3, 2, 2, 2,
-	2, 743, 3, 2, 2, 2, 2, 745, 3, 2, 2, 2, 2, 747, 3, 2, 2, 2, 2, 749, 3,
-	2, 2, 2, 2, 751, 3, 2, 2, 2, 2, 753, 3, 2, 2, 2, 2, 755, 3, 2, 2, 2, 2,
-	757, 3, 2, 2, 2, 2, 759, 3, 2, 2, 2, 2, 761, 3, 2, 2, 2, 2, 763, 3, 2,
-	2, 2, 2, 765, 3, 2, 2, 2, 2, 767, 3, 2, 2, 2, 2, 769, 3, 2, 2, 2, 2, 771,
-	3, 2, 2, 2, 2, 773, 3, 2, 2, 2, 2, 775, 3, 2, 2, 2, 2, 777, 3, 2, 2, 2,
-	2, 779, 3, 2, 2, 2, 2, 781, 3, 2, 2, 2, 2, 783, 3, 2, 2, 2, 2, 785, 3,
-	2, 2, 2, 2, 787, 3, 2, 2, 2, 2, 789, 3, 2, 2, 2, 2, 791, 3, 2, 2, 2, 2,
-	793, 3, 2, 2, 2, 2, 795, 3, 2, 2, 2, 2, 797, 3, 2, 2, 2, 2, 799, 3, 2,
-	2, 2, 2, 801, 3, 2, 2, 2, 2, 803, 3, 2, 2, 2, 2, 805, 3, 2, 2, 2, 2, 807,
-	3, 2, 2, 2, 2, 809, 3, 2, 2, 2, 2, 811, 3, 2, 2, 2, 2, 813, 3, 2, 2, 2,
-	2, 815, 3, 2, 2, 2, 2, 817, 3, 2, 2, 2, 2, 819, 3, 2, 2, 2, 2, 821, 3,
-	2, 2, 2, 2, 823, 3, 2, 2, 2, 2, 825, 3, 2, 2, 2, 2, 827, 3, 2, 2, 2, 2,
-	829, 3, 2, 2, 2, 2, 831, 3, 2, 2, 2, 2, 833, 3, 2, 2, 2, 2, 835, 3, 2,
-	2, 2, 2, 837, 3, 2, 2, 2, 2, 839, 3, 2, 2, 2, 2, 841, 3, 2, 2, 2, 2, 843,
-	3, 2, 2, 2, 2, 845, 3, 2, 2, 2, 2, 847, 3, 2, 2, 2, 2, 849, 3, 2, 2, 2,
-	2, 851, 3, 2, 2, 2, 2, 853, 3, 2, 2, 2, 2, 855, 3, 2, 2, 2, 2, 857, 3,
-	2, 2, 2, 2, 859, 3, 2, 2, 2, 2, 861, 3, 2, 2, 2, 2, 863, 3, 2, 2, 2, 2,
-	865, 3, 2, 2, 2, 2, 867, 3, 2, 2, 2, 2, 869, 3, 2, 2, 2, 2, 871, 3, 2,
-	2, 2, 2, 873, 3, 2, 2, 2, 2, 875, 3, 2, 2, 2, 2, 877, 3, 2, 2, 2, 2, 879,
-	3, 2, 2, 2, 2, 881, 3, 2, 2, 2, 2, 883, 3, 2, 2, 2, 2, 885, 3, 2, 2, 2,
-	2, 887, 3, 2, 2, 2, 2, 889, 3, 2, 2, 2, 2, 891, 3, 2, 2, 2, 2, 893, 3,
-	2, 2, 2, 2, 895, 3, 2, 2, 2, 2, 897, 3, 2, 2, 2, 2, 899, 3, 2, 2, 2, 2,
-	901, 3, 2, 2, 2, 2, 903, 3, 2, 2, 2, 2, 905, 3, 2, 2, 2, 2, 907, 3, 2,
-	2, 2, 2, 909, 3, 2, 2, 2, 2, 911, 3, 2, 2, 2, 2, 913, 3, 2, 2, 2, 2, 915,
-	3, 2, 2, 2, 2, 917, 3, 2, 2, 2, 2, 919, 3, 2, 2, 2, 2, 921, 3, 2, 2, 2,
-	2, 923, 3, 2, 2, 2, 2, 925, 3, 2, 2, 2, 2, 927, 3, 2, 2, 2, 2, 929, 3,
-	2, 2, 2, 2, 931, 3, 2, 2, 2, 2, 933, 3, 2, 2, 2, 2, 935, 3, 2, 2, 2, 2,
-	937, 3, 2, 2, 2, 2, 939, 3, 2, 2, 2, 2, 941, 3, 2, 2, 2, 2, 943, 3, 2,
-	2, 2, 2, 945, 3, 2, 2, 2, 2, 947, 3, 2, 2, 2, 2, 949, 3, 2, 2, 2, 2, 951,
-	3, 2, 2, 2, 2, 953, 3, 2, 2, 2, 2, 955, 3, 2, 2, 2, 2, 957, 3, 2, 2, 2,
-	2, 959, 3, 2, 2, 2, 2, 961, 3, 2, 2, 2, 2, 963, 3, 2, 2, 2, 2, 965, 3,
-	2, 2, 2, 2, 967, 3, 2, 2, 2, 2, 969, 3, 2, 2, 2, 2, 971, 3, 2, 2, 2, 2,
-	973, 3, 2, 2, 2, 2, 975, 3, 2, 2, 2, 2, 977, 3, 2, 2, 2, 2, 979, 3, 2,
-	2, 2, 2, 981, 3, 2, 2, 2, 2, 983, 3, 2, 2, 2, 2, 985, 3, 2, 2, 2, 2, 987,
-	3, 2, 2, 2, 2, 989, 3, 2, 2, 2, 2, 991, 3, 2, 2, 2, 2, 993, 3, 2, 2, 2,
-	2, 995, 3, 2, 2, 2, 2, 997, 3, 2, 2, 2, 2, 999, 3, 2, 2, 2, 2, 1001, 3,
-	2, 2, 2, 2, 1003, 3, 2, 2, 2, 2, 1005, 3, 2, 2, 2, 2, 1007, 3, 2, 2, 2,
-	2, 1009, 3, 2, 2, 2, 2, 1011, 3, 2, 2, 2, 2, 1013, 3, 2, 2, 2, 2, 1015,
-	3, 2, 2, 2, 2, 1017, 3, 2, 2, 2, 2, 1019, 3, 2, 2, 2, 2, 1021, 3, 2, 2,
-	2, 2, 1023, 3, 2, 2, 2, 2, 1025, 3, 2, 2, 2, 2, 1027, 3, 2, 2, 2, 2, 1029,
-	3, 2, 2, 2, 2, 1031, 3, 2, 2, 2, 2, 1033, 3, 2, 2, 2, 2, 1035, 3, 2, 2,
-	2, 2, 1037, 3, 2, 2, 2, 2, 1039, 3, 2, 2, 2, 2, 1041, 3, 2, 2, 2, 2, 1043,
-	3, 2, 2, 2, 2, 1045, 3, 2, 2, 2, 2, 1047, 3, 2, 2, 2, 2, 1049, 3, 2, 2,
-	2, 2, 1051, 3, 2, 2, 2, 2, 1053, 3, 2, 2, 2, 2, 1055, 3, 2, 2, 2, 2, 1057,
-	3, 2, 2, 2, 2, 1059, 3, 2, 2, 2, 2, 1061, 3, 2, 2, 2, 2, 1063, 3, 2, 2,
-	2, 2, 1065, 3, 2, 2, 2, 2, 1067, 3, 2, 2, 2, 2, 1069, 3, 2, 2, 2, 2, 1071,
-	3, 2, 2, 2, 2, 1073, 3, 2, 2, 2, 2, 1075, 3, 2, 2, 2, 2, 1077, 3, 2, 2,
-	2, 2, 1079, 3, 2, 2, 2, 2, 1081, 3, 2, 2, 2, 2, 1083, 3, 2, 2, 2, 2, 1085,
-	3, 2, 2, 2, 2, 1087, 3, 2, 2, 2, 2, 1089, 3, 2, 2, 2, 2, 1091, 3, 2, 2,
-	2, 2, 1093, 3, 2, 2, 2, 2, 1095, 3, 2, 2, 2, 2, 1097, 3, 2, 2, 2, 2, 1099,
-	3, 2, 2, 2, 2, 1101, 3, 2, 2, 2, 2, 1103, 3, 2, 2, 2, 2, 1105, 3, 2, 2,
-	2, 2, 1107, 3, 2, 2, 2, 2, 1109, 3, 2, 2, 2, 2, 1111, 3, 2, 2, 2, 2, 1113,
-	3, 2, 2, 2, 2, 1115, 3, 2, 2, 2, 2, 1117, 3, 2, 2, 2, 2, 1119, 3, 2, 2,
-	2, 2, 1121, 3, 2, 2, 2, 2, 1123, 3, 2, 2, 2, 2, 1125, 3, 2, 2, 2, 2, 1127,
-	3, 2, 2, 2, 2, 1129, 3, 2, 2, 2, 2, 1131, 3, 2, 2, 2, 2, 1133, 3, 2, 2,
-	2, 2, 1135, 3, 2, 2, 2, 2, 1137, 3, 2, 2, 2, 2, 1139, 3, 2, 2, 2, 2, 1141,
-	3, 2, 2, 2, 2, 1143, 3, 2, 2, 2, 2, 1145, 3, 2, 2, 2, 2, 1147, 3, 2, 2,
-	2, 2, 1149, 3, 2, 2, 2, 2, 1151, 3, 2, 2, 2, 2, 1153, 3, 2, 2, 2, 2, 1155,
-	3, 2, 2, 2, 2, 1157, 3, 2, 2, 2, 2, 1159, 3, 2, 2, 2, 2, 1161, 3, 2, 2,
-	2, 2, 1163, 3, 2, 2, 2, 2, 1165, 3, 2, 2, 2, 2, 1167, 3, 2, 2, 2, 2, 1169,
-	3, 2, 2, 2, 2, 1171, 3, 2, 2, 2, 2, 1173, 3, 2, 2, 2, 2, 1175, 3, 2, 2,
-	2, 2, 1177, 3, 2, 2, 2, 2, 1179, 3, 2, 2, 2, 2, 1181, 3, 2, 2, 2, 2, 1183,
-	3, 2, 2, 2, 2, 1185, 3, 2, 2, 2, 2, 1187, 3, 2, 2, 2, 2, 1189, 3, 2, 2,
-	2, 2, 1191, 3, 2, 2, 2, 2, 1193, 3, 2, 2, 2, 2, 1195, 3, 2, 2, 2, 2, 1197,
-	3, 2, 2, 2, 2, 1199, 3, 2, 2, 2, 2, 1201, 3, 2, 2, 2, 2, 1203, 3, 2, 2,
-	2, 2, 1205, 3, 2, 2, 2, 2, 1207, 3, 2, 2, 2, 2, 1209, 3, 2, 2, 2, 2, 1211,
-	3, 2, 2, 2, 2, 1213, 3, 2, 2, 2, 2, 1215, 3, 2, 2, 2, 2, 1217, 3, 2, 2,
-	2, 2, 1219, 3, 2, 2, 2, 2, 1221, 3, 2, 2, 2, 2, 1223, 3, 2, 2, 2, 2, 1225,
-	3, 2, 2, 2, 2, 1227, 3, 2, 2, 2, 2, 1229, 3, 2, 2, 2, 2, 1231, 3, 2, 2,
-	2, 2, 1233, 3, 2, 2, 2, 2, 1235, 3, 2, 2, 2, 2, 1237, 3, 2, 2, 2, 2, 1239,
-	3, 2, 2, 2, 2, 1241, 3, 2, 2, 2, 2, 1243, 3, 2, 2, 2, 2, 1245, 3, 2, 2,
-	2, 2, 1247, 3, 2, 2, 2, 2, 1249, 3, 2, 2, 2, 2, 1251, 3, 2, 2, 2, 2, 1253,
-	3, 2, 2, 2, 2, 1255, 3, 2, 2, 2, 2, 1257, 3, 2, 2, 2, 2, 1259, 3, 2, 2,
-	2, 2, 1261, 3, 2, 2, 2, 2, 1263, 3, 2, 2, 2, 2, 1265, 3, 2, 2, 2, 2, 1267,
-	3, 2, 2, 2, 2, 1269, 3, 2, 2, 2, 2, 1271, 3, 2, 2, 2, 2, 1273, 3, 2, 2,
-	2, 2, 1275, 3, 2, 2, 2, 2, 1277, 3, 2, 2, 2, 2, 1279, 3, 2, 2, 2, 2, 1281,
-	3, 2, 2, 2, 2, 1283, 3, 2, 2, 2, 2, 1285, 3, 2, 2, 2, 2, 1287, 3, 2, 2,
-	2, 2, 1289, 3, 2, 2, 2, 2, 1291, 3, 2, 2, 2, 2, 1293, 3, 2, 2, 2, 2, 1295,
-	3, 2, 2, 2, 2, 1297, 3, 2, 2, 2, 2, 1299, 3, 2, 2, 2, 2, 1301, 3, 2, 2,
-	2, 2, 1303, 3, 2, 2, 2, 2, 1305, 3, 2, 2, 2, 2, 1307, 3, 2, 2, 2, 2, 1309,
-	3, 2, 2, 2, 2, 1311, 3, 2, 2, 2, 2, 1313, 3, 2, 2, 2, 2, 1315, 3, 2, 2,
-	2, 2, 1317, 3, 2, 2, 2, 2, 1319, 3, 2, 2, 2, 2, 1321, 3, 2, 2, 2, 2, 1323,
-	3, 2, 2, 2, 2, 1325, 3, 2, 2, 2, 2, 1327, 3, 2, 2, 2, 2, 1329, 3, 2, 2,
-	2, 2, 1331, 3, 2, 2, 2, 2, 1333, 3, 2, 2, 2, 2, 1335, 3, 2, 2, 2, 2, 1337,
-	3, 2, 2, 2, 2, 1339, 3, 2, 2, 2, 2, 1341, 3, 2, 2, 2, 2, 1343, 3, 2, 2,
-	2, 2, 1345, 3, 2, 2, 2, 2, 1347, 3, 2, 2, 2, 2, 1349, 3, 2, 2, 2, 2, 1351,
-	3, 2, 2, 2, 2, 1353, 3, 2, 2, 2, 2, 1355, 3, 2, 2, 2, 2, 1357, 3, 2, 2,
-	2, 2, 1359, 3, 2, 2, 2, 2, 1361, 3, 2, 2, 2, 2, 1363, 3, 2, 2, 2, 2, 1365,
-	3, 2, 2, 2, 2, 1367, 3, 2, 2, 2, 2, 1369, 3, 2, 2, 2, 2, 1371, 3, 2, 2,
-	2, 2, 1373, 3, 2, 2, 2, 2, 1375, 3, 2, 2, 2, 2, 1377, 3, 2, 2, 2, 2, 1379,
-	3, 2, 2, 2, 2, 1381, 3, 2, 2, 2, 2, 1383, 3, 2, 2, 2, 2, 1385, 3, 2, 2,
-	2, 2, 1387, 3, 2, 2, 2, 2, 1389, 3, 2, 2, 2, 2, 1391, 3, 2, 2, 2, 2, 1393,
-	3, 2, 2, 2, 2, 1395, 3, 2, 2, 2, 2, 1397, 3, 2, 2, 2, 2, 1399, 3, 2, 2,
-	2, 2, 1401, 3, 2, 2, 2, 2, 1403, 3, 2, 2, 2, 2, 1405, 3, 2, 2, 2, 2, 1407,
-	3, 2, 2, 2, 2, 1409, 3, 2, 2, 2, 2, 1411, 3, 2, 2, 2, 2, 1413, 3, 2, 2,
-	2, 2, 1415, 3, 2, 2, 2, 2, 1417, 3, 2, 2, 2, 2, 1419, 3, 2, 2, 2, 2, 1421,
-	3, 2, 2, 2, 2, 1423, 3, 2, 2, 2, 2, 1425, 3, 2, 2, 2, 2, 1427, 3, 2, 2,
-	2, 2, 1429, 3, 2, 2, 2, 2, 1431, 3, 2, 2, 2, 2, 1433, 3, 2, 2, 2, 2, 1435,
-	3, 2, 2, 2, 2, 1437, 3, 2, 2, 2, 2, 1439, 3, 2, 2, 2, 2, 1441, 3, 2, 2,
-	2, 2, 1443, 3, 2, 2, 2, 2, 1445, 3, 2, 2, 2, 2, 1447, 3, 2, 2, 2, 2, 1449,
-	3, 2, 2, 2, 2, 1451, 3, 2, 2, 2, 2, 1453, 3, 2, 2, 2, 2, 1455, 3, 2, 2,
-	2, 2, 1457, 3, 2, 2, 2, 2, 1459, 3, 2, 2, 2, 2, 1461, 3, 2, 2, 2, 2, 1463,
-	3, 2, 2, 2, 2, 1465, 3, 2, 2, 2, 2, 1467, 3, 2, 2, 2, 2, 1469, 3, 2, 2,
-	2, 2, 1471, 3, 2, 2, 2, 2, 1473, 3, 2, 2, 2, 2, 1475, 3, 2, 2, 2, 2, 1477,
-	3, 2, 2, 2, 2, 1479, 3, 2, 2, 2, 2, 1481, 3, 2, 2, 2, 2, 1483, 3, 2, 2,
-	2, 2, 1485, 3, 2, 2, 2, 2, 1487, 3, 2, 2, 2, 2, 1489, 3, 2, 2, 2, 2, 1491,
-	3, 2, 2, 2, 2, 1493, 3, 2, 2, 2, 2, 1495, 3, 2, 2, 2, 2, 1497, 3, 2, 2,
-	2, 2, 1499, 3, 2, 2, 2, 2, 1501, 3, 2, 2, 2, 2, 1503, 3, 2, 2, 2, 2, 1505,
-	3, 2, 2, 2, 2, 1507, 3, 2, 2, 2, 2, 1509, 3, 2, 2, 2, 2, 1511, 3, 2, 2,
-	2, 2, 1513, 3, 2, 2, 2, 2, 1515, 3, 2, 2, 2, 2, 1517, 3, 2, 2, 2, 2, 1519,
-	3, 2, 2, 2, 2, 1521, 3, 2, 2, 2, 2, 1523, 3, 2, 2, 2, 2, 1525, 3, 2, 2,
-	2, 2, 1527, 3, 2, 2, 2, 2, 1529, 3, 2, 2, 2, 2, 1531, 3, 2, 2, 2, 2, 1533,
-	3, 2, 2, 2, 2, 1535, 3, 2, 2, 2, 2, 1537, 3, 2, 2, 2, 2, 1539, 3, 2, 2,
-	2, 2, 1541, 3, 2, 2, 2, 2, 1543, 3, 2, 2, 2, 2, 1545, 3, 2, 2, 2, 2, 1547,
-	3, 2, 2, 2, 2, 1549, 3, 2, 2, 2, 2, 1551, 3, 2, 2, 2, 2, 1553, 3, 2, 2,
-	2, 2, 1555, 3, 2, 2, 2, 2, 1557, 3, 2, 2, 2, 2, 1559, 3, 2, 2, 2, 2, 1561,
-	3, 2, 2, 2, 2, 1563, 3, 2, 2, 2, 2, 1565, 3, 2, 2, 2, 2, 1567, 3, 2, 2,
-	2, 2, 1569, 3, 2, 2, 2, 2, 1571, 3, 2, 2, 2, 2, 1573, 3, 2, 2, 2, 2, 1575,
-	3, 2, 2, 2, 2, 1577, 3, 2, 2, 2, 2, 1579, 3, 2, 2, 2, 2, 1581, 3, 2, 2,
-	2, 2, 1583, 3, 2, 2, 2, 2, 1585, 3, 2, 2, 2, 2, 1587, 3, 2, 2, 2, 2, 1589,
-	3, 2, 2, 2, 2, 1591, 3, 2, 2, 2, 2, 1593, 3, 2, 2, 2, 2, 1595, 3, 2, 2,
-	2, 2, 1597, 3, 2, 2, 2, 2, 1599, 3, 2, 2, 2, 2, 1601, 3, 2, 2, 2, 2, 1603,
-	3, 2, 2, 2, 2, 1605, 3, 2, 2, 2, 2, 1607, 3, 2, 2, 2, 2, 1609, 3, 2, 2,
-	2, 2, 1611, 3, 2, 2, 2, 2, 1613, 3, 2, 2, 2, 2, 1615, 3, 2, 2, 2, 2, 1617,
-	3, 2, 2, 2, 2, 1619, 3, 2, 2, 2, 2, 1621, 3, 2, 2, 2, 2, 1623, 3, 2, 2,
-	2, 2, 1625, 3, 2, 2, 2, 2, 1627, 3, 2, 2, 2, 2, 1629, 3, 2, 2, 2, 2, 1631,
-	3, 2, 2, 2, 2, 1633, 3, 2, 2, 2, 2, 1635, 3, 2, 2, 2, 2, 1637, 3, 2, 2,
-	2, 2, 1639, 3, 2, 2, 2, 2, 1641, 3, 2, 2, 2, 2, 1643, 3, 2, 2, 2, 2, 1645,
-	3, 2, 2, 2, 2, 1647, 3, 2, 2, 2, 2, 1649, 3, 2, 2, 2, 2, 1651, 3, 2, 2,
-	2, 2, 1653, 3, 2, 2, 2, 2, 1655, 3, 2, 2, 2, 2, 1657, 3, 2, 2, 2, 2, 1659,
-	3, 2, 2, 2, 2, 1661, 3, 2, 2, 2, 2, 1663, 3, 2, 2, 2, 2, 1665, 3, 2, 2,
-	2, 2, 1667, 3, 2, 2, 2, 2, 1669, 3, 2, 2, 2, 2, 1671, 3, 2, 2, 2, 2, 1673,
-	3, 2, 2, 2, 2, 1675, 3, 2, 2, 2, 2, 1677, 3, 2, 2, 2, 2, 1679, 3, 2, 2,
-	2, 2, 1681, 3, 2, 2, 2, 2, 1683, 3, 2, 2, 2, 2, 1685, 3, 2, 2, 2, 2, 1687,
-	3, 2, 2, 2, 2, 1689, 3, 2, 2, 2, 2, 1691, 3, 2, 2, 2, 2, 1693, 3, 2, 2,
-	2, 2, 1695, 3, 2, 2, 2, 2, 1697, 3, 2, 2, 2, 2, 1699, 3, 2, 2, 2, 2, 1701,
-	3, 2, 2, 2, 2, 1703, 3, 2, 2, 2, 2, 1705, 3, 2, 2, 2, 2, 1707, 3, 2, 2,
-	2, 2, 1709, 3, 2, 2, 2, 2, 1711, 3, 2, 2, 2, 2, 1713, 3, 2, 2, 2, 2, 1715,
-	3, 2, 2, 2, 2, 1717, 3, 2, 2, 2, 2, 1719, 3, 2, 2, 2, 2, 1721, 3, 2, 2,
-	2, 2, 1723, 3, 2, 2, 2, 2, 1725, 3, 2, 2, 2, 2, 1727, 3, 2, 2, 2, 2, 1729,
-	3, 2, 2, 2, 2, 1731, 3, 2, 2, 2, 2, 1733, 3, 2, 2, 2, 2, 1735, 3, 2, 2,
-	2, 2, 1737, 3, 2, 2, 2, 2, 1739, 3, 2, 2, 2, 2, 1741, 3, 2, 2, 2, 2, 1743,
-	3, 2, 2, 2, 2, 1745, 3, 2, 2, 2, 2, 1747, 3, 2, 2, 2, 2, 1749, 3, 2, 2,
-	2, 2, 1751, 3, 2, 2, 2, 2, 1753, 3, 2, 2, 2, 2, 1755, 3, 2, 2, 2, 2, 1757,
-	3, 2, 2, 2, 2, 1759, 3, 2, 2, 2, 2, 1761, 3, 2, 2, 2, 2, 1763, 3, 2, 2,
-	2, 2, 1765, 3, 2, 2, 2, 2, 1767, 3, 2, 2, 2, 2, 1769, 3, 2, 2, 2, 2, 1771,
-	3, 2, 2, 2, 2, 1773, 3, 2, 2, 2, 2, 1775, 3, 2, 2, 2, 2, 1777, 3, 2, 2,
-	2, 2, 1779, 3, 2, 2, 2, 2, 1781, 3, 2, 2, 2, 2, 1783, 3, 2, 2, 2, 2, 1785,
-	3, 2, 2, 2, 2, 1787, 3, 2, 2, 2, 2, 1789, 3, 2, 2, 2, 2, 1791, 3, 2, 2,
-	2, 2, 1793, 3, 2, 2, 2, 2, 1795, 3, 2, 2, 2, 2, 1797, 3, 2, 2, 2, 2, 1799,
-	3, 2, 2, 2, 2, 1801, 3, 2, 2, 2, 2, 1803, 3, 2, 2, 2, 2, 1805, 3, 2, 2,
-	2, 2, 1807, 3, 2, 2, 2, 2, 1809, 3, 2, 2, 2, 2, 1811, 3, 2, 2, 2, 2, 1813,
-	3, 2, 2, 2, 2, 1815, 3, 2, 2, 2, 2, 1817, 3, 2, 2, 2, 2, 1819, 3, 2, 2,
-	2, 2, 1821, 3, 2, 2, 2, 2, 1823, 3, 2, 2, 2, 2, 1825, 3, 2, 2, 2, 2, 1827,
-	3, 2, 2, 2, 2, 1829, 3, 2, 2, 2, 2, 1831, 3, 2, 2, 2, 2, 1833, 3, 2, 2,
-	2, 2, 1835, 3, 2, 2, 2, 2, 1837, 3, 2, 2, 2, 2, 1839, 3, 2, 2, 2, 2, 1841,
-	3, 2, 2, 2, 2, 1843, 3, 2, 2, 2, 2, 1845, 3, 2, 2, 2, 2, 1847, 3, 2, 2,
-	2, 2, 1849, 3, 2, 2, 2, 2, 1851, 3, 2, 2, 2, 2, 1853, 3, 2, 2, 2, 2, 1855,
-	3, 2, 2, 2, 2, 1857, 3, 2, 2, 2, 2, 1859, 3, 2, 2, 2, 2, 1861, 3, 2, 2,
-	2, 2, 1863, 3, 2, 2, 2, 2, 1865, 3, 2, 2, 2, 2, 1867, 3, 2, 2, 2, 2, 1869,
-	3, 2, 2, 2, 2, 1871, 3, 2, 2, 2, 2, 1873, 3, 2, 2, 2, 2, 1875, 3, 2, 2,
-	2, 2, 1877, 3, 2, 2, 2, 2, 1879, 3, 2, 2, 2, 2, 1881, 3, 2, 2, 2, 2, 1883,
-	3, 2, 2, 2, 2, 1885, 3, 2, 2, 2, 2, 1887, 3, 2, 2, 2, 2, 1889, 3, 2, 2,
-	2, 2, 1891, 3, 2, 2, 2, 2, 1893, 3, 2, 2, 2, 2, 1895, 3, 2, 2, 2, 2, 1897,
-	3, 2, 2, 2, 2, 1899, 3, 2, 2, 2, 2, 1901, 3, 2, 2, 2, 2, 1903, 3, 2, 2,
-	2, 2, 1905, 3, 2, 2, 2, 2, 1907, 3, 2, 2, 2, 2, 1909, 3, 2, 2, 2, 2, 1911,
-	3, 2, 2, 2, 2, 1913, 3, 2, 2, 2, 2, 1915, 3, 2, 2, 2, 2, 1917, 3, 2, 2,
-	2, 2, 1919, 3, 2, 2, 2, 2, 1921, 3, 2, 2, 2, 2, 1923, 3, 2, 2, 2, 2, 1925,
-	3, 2, 2, 2, 2, 1927, 3, 2, 2, 2, 2, 1929, 3, 2, 2, 2, 2, 1931, 3, 2, 2,
-	2, 2, 1933, 3, 2, 2, 2, 2, 1935, 3, 2, 2, 2, 2, 1937, 3, 2, 2, 2, 2, 1939,
-	3, 2, 2, 2, 2, 1941, 3, 2, 2, 2, 2, 1943, 3, 2, 2, 2, 2, 1945, 3, 2, 2,
-	2, 2, 1947, 3, 2, 2, 2, 2, 1949, 3, 2, 2, 2, 2, 1951, 3, 2, 2, 2, 2, 1953,
-	3, 2, 2, 2, 2, 1955, 3, 2, 2, 2, 2, 1957, 3, 2, 2, 2, 2, 1959, 3, 2, 2,
-	2, 2, 1961, 3, 2, 2, 2, 2, 1963, 3, 2, 2, 2, 2, 1965, 3, 2, 2, 2, 2, 1967,
-	3, 2, 2, 2, 2, 1969, 3, 2, 2, 2, 2, 1971, 3, 2, 2, 2, 2, 1973, 3, 2, 2,
-	2, 2, 1975, 3, 2, 2, 2, 2, 1977, 3, 2, 2, 2, 2, 1979, 3, 2, 2, 2, 2, 1981,
-	3, 2, 2, 2, 2, 1983, 3, 2, 2, 2, 2, 1985, 3, 2, 2, 2, 2, 1987, 3, 2, 2,
-	2, 2, 1989, 3, 2, 2, 2, 2, 1991, 3, 2, 2, 2, 2, 1993, 3, 2, 2, 2, 2, 1995,
-	3, 2, 2, 2, 2, 1997, 3, 2, 2, 2, 2, 1999, 3, 2, 2, 2, 2, 2001, 3, 2, 2,
-	2, 2, 2003, 3, 2, 2, 2, 2, 2005, 3, 2, 2, 2, 2, 2007, 3, 2, 2, 2, 2, 2009,
-	3, 2, 2, 2, 2, 2011, 3, 2, 2, 2, 2, 2013, 3, 2, 2, 2, 2, 2015, 3, 2, 2,
-	2, 2, 2017, 3, 2, 2, 2, 2, 2019, 3, 2, 2, 2, 2, 2021, 3, 2, 2, 2, 2, 2023,
-	3, 2, 2, 2, 2, 2025, 3, 2, 2, 2, 2, 2027, 3, 2, 2, 2, 2, 2029, 3, 2, 2,
-	2, 2, 2031, 3, 2, 2, 2, 2, 2033, 3, 2, 2, 2, 2, 2035, 3, 2, 2, 2, 2, 2037,
-	3, 2, 2, 2, 2, 2039, 3, 2, 2, 2, 2, 2041, 3, 2, 2, 2, 2, 2043, 3, 2, 2,
-	2, 2, 2045, 3, 2, 2, 2, 2, 2047, 3, 2, 2, 2, 2, 2049, 3, 2, 2, 2, 2, 2051,
-	3, 2, 2, 2, 2, 2053, 3, 2, 2, 2, 2, 2055, 3, 2, 2, 2, 2, 2057, 3, 2, 2,
-	2, 2, 2059, 3, 2, 2, 2, 2, 2061, 3, 2, 2, 2, 2, 2063, 3, 2, 2, 2, 2, 2065,
-	3, 2, 2, 2, 2, 2067, 3, 2, 2, 2, 2, 2069, 3, 2, 2, 2, 2, 2071, 3, 2, 2,
-	2, 2, 2073, 3, 2, 2, 2, 2, 2075, 3, 2, 2, 2, 2, 2077, 3, 2, 2, 2, 2, 2079,
-	3, 2, 2, 2, 2, 2081, 3, 2, 2, 2, 2, 2083, 3, 2, 2, 2, 2, 2085, 3, 2, 2,
-	2, 2, 2087, 3, 2, 2, 2, 2, 2089, 3, 2, 2, 2, 2, 2091, 3, 2, 2, 2, 2, 2093,
-	3, 2, 2, 2, 2, 2095, 3, 2, 2, 2, 2, 2097, 3, 2, 2, 2, 2, 2099, 3, 2, 2,
-	2, 2, 2101, 3, 2, 2, 2, 2, 2103, 3, 2, 2, 2, 2, 2105, 3, 2, 2, 2, 2, 2107,
-	3, 2, 2, 2, 2, 2109, 3, 2, 2, 2, 2, 2111, 3, 2, 2, 2, 2, 2113, 3, 2, 2,
-	2, 2, 2115, 3, 2, 2, 2, 2, 2117, 3, 2, 2, 2, 2, 2119, 3, 2, 2, 2, 2, 2121,
-	3, 2, 2, 2, 2, 2123, 3, 2, 2, 2, 2, 2125, 3, 2, 2, 2, 2, 2127, 3, 2, 2,
-	2, 2, 2129, 3, 2, 2, 2, 2, 2131, 3, 2, 2, 2, 2, 2133, 3, 2, 2, 2, 2, 2135,
-	3, 2, 2, 2, 2, 2137, 3, 2, 2, 2, 2, 2139, 3, 2, 2, 2, 2, 2141, 3, 2, 2,
-	2, 2, 2143, 3, 2, 2, 2, 2, 2145, 3, 2, 2, 2, 2, 2149, 3, 2, 2, 2, 2, 2151,
-	3, 2, 2, 2, 2, 2153, 3, 2, 2, 2, 2, 2155, 3, 2, 2, 2, 2, 2157, 3, 2, 2,
-	2, 2, 2159, 3, 2, 2, 2, 2, 2161, 3, 2, 2, 2, 2, 2163, 3, 2, 2, 2, 2, 2165,
-	3, 2, 2, 2, 2, 2167, 3, 2, 2, 2, 2, 2169, 3, 2, 2, 2, 2, 2171, 3, 2, 2,
-	2, 2, 2173, 3, 2, 2, 2, 2, 2175, 3, 2, 2, 2, 2, 2177, 3, 2, 2, 2, 2, 2179,
-	3, 2, 2, 2, 2, 2181, 3, 2, 2, 2, 2, 2201, 3, 2, 2, 2, 3, 2204, 3, 2, 2,
-	2, 5, 2210, 3, 2, 2, 2, 7, 2224, 3, 2, 2, 2, 9, 2268, 3, 2, 2, 2, 11, 2272,
-	3, 2, 2, 2, 13, 2276, 3, 2, 2, 2, 15, 2280, 3, 2, 2, 2, 17, 2286, 3, 2,
-	2, 2, 19, 2293, 3, 2, 2, 2, 21, 2301, 3, 2, 2, 2, 23, 2305, 3, 2, 2, 2,
-	25, 2308, 3, 2, 2, 2, 27, 2312, 3, 2, 2, 2, 29, 2319, 3, 2, 2, 2, 31, 2327,
-	3, 2, 2, 2, 33, 2332, 3, 2, 2, 2, 35, 2335, 3, 2, 2, 2, 37, 2340, 3, 2,
-	2, 2, 39, 2348, 3, 2, 2, 2, 41, 2353, 3, 2, 2, 2, 43, 2358, 3, 2, 2, 2,
-	45, 2365, 3, 2, 2, 2, 47, 2375, 3, 2, 2, 2, 49, 2381, 3, 2, 2, 2, 51, 2389,
-	3, 2, 2, 2, 53, 2396, 3, 2, 2, 2, 55, 2406, 3, 2, 2, 2, 57, 2417, 3, 2,
-	2, 2, 59, 2426, 3, 2, 2, 2, 61, 2434, 3, 2, 2, 2, 63, 2441, 3, 2, 2, 2,
-	65, 2447, 3, 2, 2, 2, 67, 2455, 3, 2, 2, 2, 69, 2468, 3, 2, 2, 2, 71, 2475,
-	3, 2, 2, 2, 73, 2484, 3, 2, 2, 2, 75, 2494, 3, 2, 2, 2, 77, 2502, 3, 2,
-	2, 2, 79, 2510, 3, 2, 2, 2, 81, 2518, 3, 2, 2, 2, 83, 2525, 3, 2, 2, 2,
-	85, 2530, 3, 2, 2, 2, 87, 2539, 3, 2, 2, 2, 89, 2553, 3, 2, 2, 2, 91, 2565,
-	3, 2, 2, 2, 93, 2574, 3, 2, 2, 2, 95, 2586, 3, 2, 2, 2, 97, 2591, 3, 2,
-	2, 2, 99, 2596, 3, 2, 2, 2, 101, 2601, 3, 2, 2, 2, 103, 2608, 3, 2, 2,
-	2, 105, 2614, 3, 2, 2, 2, 107, 2623, 3, 2, 2, 2, 109, 2631, 3, 2, 2, 2,
-	111, 2638, 3, 2, 2, 2, 113, 2643, 3, 2, 2, 2, 115, 2651, 3, 2, 2, 2, 117,
-	2657, 3, 2, 2, 2, 119, 2663, 3, 2, 2, 2, 121, 2667, 3, 2, 2, 2, 123, 2673,
-	3, 2, 2, 2, 125, 2681, 3, 2, 2, 2, 127, 2686, 3, 2, 2, 2, 129, 2695, 3,
-	2, 2, 2, 131, 2705, 3, 2, 2, 2, 133, 2709, 3, 2, 2, 2, 135, 2715, 3, 2,
-	2, 2, 137, 2721, 3, 2, 2, 2, 139, 2728, 3, 2, 2, 2, 141, 2742, 3, 2, 2,
-	2, 143, 2745, 3, 2, 2, 2, 145, 2752, 3, 2, 2, 2, 147, 2755, 3, 2, 2, 2,
-	149, 2761, 3, 2, 2, 2, 151, 2768, 3, 2, 2, 2, 153, 2774, 3, 2, 2, 2, 155,
-	2780, 3, 2, 2, 2, 157, 2787, 3, 2, 2, 2, 159, 2796, 3, 2, 2, 2, 161, 2801,
-	3, 2, 2, 2, 163, 2804, 3, 2, 2, 2, 165, 2812, 3, 2, 2, 2, 167, 2817, 3,
-	2, 2, 2, 169, 2821, 3, 2, 2, 2, 171, 2826, 3, 2, 2, 2, 173, 2831, 3, 2,
-	2, 2, 175, 2839, 3, 2, 2, 2, 177, 2845, 3, 2, 2, 2, 179, 2850, 3, 2, 2,
-	2, 181, 2855, 3, 2, 2, 2, 183, 2861, 3, 2, 2, 2, 185, 2868, 3, 2, 2, 2,
-	187, 2874, 3, 2, 2, 2, 189, 2879, 3, 2, 2, 2, 191, 2884, 3, 2, 2, 2, 193,
-	2889, 3, 2, 2, 2, 195, 2902, 3, 2, 2, 2, 197, 2914, 3, 2, 2, 2, 199, 2944,
-	3, 2, 2, 2, 201, 2950, 3, 2, 2, 2, 203, 2959, 3, 2, 2, 2, 205, 2968, 3,
-	2, 2, 2, 207, 2976, 3, 2, 2, 2, 209, 2980, 3, 2, 2, 2, 211, 2999, 3, 2,
-	2, 2, 213, 3001, 3, 2, 2, 2, 215, 3006, 3, 2, 2, 2, 217, 3013, 3, 2, 2,
-	2, 219, 3016, 3, 2, 2, 2, 221, 3025, 3, 2, 2, 2, 223, 3032, 3, 2, 2, 2,
-	225, 3043, 3, 2, 2, 2, 227, 3046, 3, 2, 2, 2, 229, 3052, 3, 2, 2, 2, 231,
-	3056, 3, 2, 2, 2, 233, 3062, 3, 2, 2, 2, 235, 3070, 3, 2, 2, 2, 237, 3080,
-	3, 2, 2, 2, 239, 3088, 3, 2, 2, 2, 241, 3098, 3, 2, 2, 2, 243, 3104, 3,
-	2, 2, 2, 245, 3110, 3, 2, 2, 2, 247, 3115, 3, 2, 2, 2, 249, 3121, 3, 2,
-	2, 2, 251, 3132, 3, 2, 2, 2, 253, 3139, 3, 2, 2, 2, 255, 3147, 3, 2, 2,
-	2, 257, 3154, 3, 2, 2, 2, 259, 3161, 3, 2, 2, 2, 261, 3169, 3, 2, 2, 2,
-	263, 3177, 3, 2, 2, 2, 265, 3186, 3, 2, 2, 2, 267, 3195, 3, 2, 2, 2, 269,
-	3202, 3, 2, 2, 2, 271, 3209, 3, 2, 2, 2, 273, 3215, 3, 2, 2, 2, 275, 3221,
-	3, 2, 2, 2, 277, 3228, 3, 2, 2, 2, 279, 3236, 3, 2, 2, 2, 281, 3243, 3,
-	2, 2, 2, 283, 3247, 3, 2, 2, 2, 285, 3257, 3, 2, 2, 2, 287, 3262, 3, 2,
-	2, 2, 289, 3269, 3, 2, 2, 2, 291, 3277, 3, 2, 2, 2, 293, 3281, 3, 2, 2,
-	2, 295, 3294, 3, 2, 2, 2, 297, 3303, 3, 2, 2, 2, 299, 3314, 3, 2, 2, 2,
-	301, 3329, 3, 2, 2, 2, 303, 3349, 3, 2, 2, 2, 305, 3366, 3, 2, 2, 2, 307,
-	3370, 3, 2, 2, 2, 309, 3378, 3, 2, 2, 2, 311, 3387, 3, 2, 2, 2, 313, 3401,
-	3, 2, 2, 2, 315, 3407, 3, 2, 2, 2, 317, 3418, 3, 2, 2, 2, 319, 3423, 3,
-	2, 2, 2, 321, 3426, 3, 2, 2, 2, 323, 3435, 3, 2, 2, 2, 325, 3443, 3, 2,
-	2, 2, 327, 3448, 3, 2, 2, 2, 329, 3453, 3, 2, 2, 2, 331, 3459, 3, 2, 2,
-	2, 333, 3466, 3, 2, 2, 2, 335, 3473, 3, 2, 2, 2, 337, 3482, 3, 2, 2, 2,
-	339, 3489, 3, 2, 2, 2, 341, 3495, 3, 2, 2, 2, 343, 3499, 3, 2, 2, 2, 345,
-	3505, 3, 2, 2, 2, 347, 3512, 3, 2, 2, 2, 349, 3517, 3, 2, 2, 2, 351, 3523,
-	3, 2, 2, 2, 353, 3529, 3, 2, 2, 2, 355, 3534, 3, 2, 2, 2, 357, 3540, 3,
-	2, 2, 2, 359, 3544, 3, 2, 2, 2, 361, 3553, 3, 2, 2, 2, 363, 3561, 3, 2,
-	2, 2, 365, 3570, 3, 2, 2, 2, 367, 3580, 3, 2, 2, 2, 369, 3590, 3, 2, 2,
-	2, 371, 3594, 3, 2, 2, 2, 373, 3599, 3, 2, 2, 2, 375, 3604, 3, 2, 2, 2,
-	377, 3609, 3, 2, 2, 2, 379, 3614, 3, 2, 2, 2, 381, 3619, 3, 2, 2, 2, 383,
-	3627, 3, 2, 2, 2, 385, 3634, 3, 2, 2, 2, 387, 3639, 3, 2, 2, 2, 389, 3646,
-	3, 2, 2, 2, 391, 3656, 3, 2, 2, 2, 393, 3662, 3, 2, 2, 2, 395, 3669, 3,
-	2, 2, 2, 397, 3676, 3, 2, 2, 2, 399, 3684, 3, 2, 2, 2, 401, 3688, 3, 2,
-	2, 2, 403, 3696, 3, 2, 2, 2, 405, 3701, 3, 2, 2, 2, 407, 3706, 3, 2, 2,
-	2, 409, 3716, 3, 2, 2, 2, 411, 3725, 3, 2, 2, 2, 413, 3730, 3, 2, 2, 2,
-	415, 3735, 3, 2, 2, 2, 417, 3743, 3, 2, 2, 2, 419, 3752, 3, 2, 2, 2, 421,
-	3761, 3, 2, 2, 2, 423, 3768, 3, 2, 2, 2, 425, 3778, 3, 2, 2, 2, 427, 3787,
-	3, 2, 2, 2, 429, 3792, 3, 2, 2, 2, 431, 3803, 3, 2, 2, 2, 433, 3808, 3,
-	2, 2, 2, 435, 3817, 3, 2, 2, 2, 437, 3826, 3, 2, 2, 2, 439, 3831, 3, 2,
-	2, 2, 441, 3842, 3, 2, 2, 2, 443, 3851, 3, 2, 2, 2, 445, 3856, 3, 2, 2,
-	2, 447, 3864, 3, 2, 2, 2, 449, 3871, 3, 2, 2, 2, 451, 3882, 3, 2, 2, 2,
-	453, 3891, 3, 2, 2, 2, 455, 3902, 3, 2, 2, 2, 457, 3913, 3, 2, 2, 2, 459,
-	3925, 3, 2, 2, 2, 461, 3937, 3, 2, 2, 2, 463, 3951, 3, 2, 2, 2, 465, 3970,
-	3, 2, 2, 2, 467, 3989, 3, 2, 2, 2, 469, 4006, 3, 2, 2, 2, 471, 4022, 3,
-	2, 2, 2, 473, 4033, 3, 2, 2, 2, 475, 4045, 3, 2, 2, 2, 477, 4056, 3, 2,
-	2, 2, 479, 4070, 3, 2, 2, 2, 481, 4089, 3, 2, 2, 2, 483, 4102, 3, 2, 2,
-	2, 485, 4112, 3, 2, 2, 2, 487, 4126, 3, 2, 2, 2, 489, 4138, 3, 2, 2, 2,
-	491, 4149, 3, 2, 2, 2, 493, 4167, 3, 2, 2, 2, 495, 4185, 3, 2, 2, 2, 497,
-	4197, 3, 2, 2, 2, 499, 4208, 3, 2, 2, 2, 501, 4225, 3, 2, 2, 2, 503, 4245,
-	3, 2, 2, 2, 505, 4257, 3, 2, 2, 2, 507, 4270, 3, 2, 2, 2, 509, 4279, 3,
-	2, 2, 2, 511, 4292, 3, 2, 2, 2, 513, 4303, 3, 2, 2, 2, 515, 4315, 3, 2,
-	2, 2, 517, 4325, 3, 2, 2, 2, 519, 4336, 3, 2, 2, 2, 521, 4347, 3, 2, 2,
-	2, 523, 4365, 3, 2, 2, 2, 525, 4395, 3, 2, 2, 2, 527, 4407, 3, 2, 2, 2,
-	529, 4425, 3, 2, 2, 2, 531, 4443, 3, 2, 2, 2, 533, 4457, 3, 2, 2, 2, 535,
-	4472, 3, 2, 2, 2, 537, 4476, 3, 2, 2, 2, 539, 4484, 3, 2, 2, 2, 541, 4491,
-	3, 2, 2, 2, 543, 4499, 3, 2, 2, 2, 545, 4505, 3, 2, 2, 2, 547, 4518, 3,
-	2, 2, 2, 549, 4522, 3, 2, 2, 2, 551, 4526, 3, 2, 2, 2, 553, 4530, 3, 2,
-	2, 2, 555, 4537, 3, 2, 2, 2, 557, 4548, 3, 2, 2, 2, 559, 4560, 3, 2, 2,
-	2, 561, 4564, 3, 2, 2, 2, 563, 4572, 3, 2, 2, 2, 565, 4581, 3, 2, 2, 2,
-	567, 4590, 3, 2, 2, 2, 569, 4603, 3, 2, 2, 2, 571, 4616, 3, 2, 2, 2, 573,
-	4634, 3, 2, 2, 2, 575, 4644, 3, 2, 2, 2, 577, 4652, 3, 2, 2, 2, 579, 4660,
-	3, 2, 2, 2, 581, 4669, 3, 2, 2, 2, 583, 4678, 3, 2, 2, 2, 585, 4686, 3,
-	2, 2, 2, 587, 4701, 3, 2, 2, 2, 589, 4705, 3, 2, 2, 2, 591, 4714, 3, 2,
-	2, 2, 593, 4721, 3, 2, 2, 2, 595, 4731, 3, 2, 2, 2, 597, 4739, 3, 2, 2,
-	2, 599, 4744, 3, 2, 2, 2, 601, 4753, 3, 2, 2, 2, 603, 4762, 3, 2, 2, 2,
-	605, 4776, 3, 2, 2, 2, 607, 4784, 3, 2, 2, 2, 609, 4791, 3, 2, 2, 2, 611,
-	4797, 3, 2, 2, 2, 613, 4807, 3, 2, 2, 2, 615, 4817, 3, 2, 2, 2, 617, 4821,
-	3, 2, 2, 2, 619, 4824, 3, 2, 2, 2, 621, 4832, 3, 2, 2, 2, 623, 4843, 3,
-	2, 2, 2, 625, 4859, 3, 2, 2, 2, 627, 4874, 3, 2, 2, 2, 629, 4889, 3, 2,
-	2, 2, 631, 4895, 3, 2, 2, 2, 633, 4902, 3, 2, 2, 2, 635, 4906, 3, 2, 2,
-	2, 637, 4912, 3, 2, 2, 2, 639, 4917, 3, 2, 2, 2, 641, 4925, 3, 2, 2, 2,
-	643, 4931, 3, 2, 2, 2, 645, 4937, 3, 2, 2, 2, 647, 4946, 3, 2, 2, 2, 649,
-	4952, 3, 2, 2, 2, 651, 4960, 3, 2, 2, 2, 653, 4968, 3, 2, 2, 2, 655, 4977,
-	3, 2, 2, 2, 657, 4991, 3, 2, 2, 2, 659, 4998, 3, 2, 2, 2, 661, 5011, 3,
-	2, 2, 2, 663, 5018, 3, 2, 2, 2, 665, 5024, 3, 2, 2, 2, 667, 5033, 3, 2,
-	2, 2, 669, 5038, 3, 2, 2, 2, 671, 5046, 3, 2, 2, 2, 673, 5060, 3, 2, 2,
-	2, 675, 5072, 3, 2, 2, 2, 677, 5080, 3, 2, 2, 2, 679, 5087, 3, 2, 2, 2,
-	681, 5095, 3, 2, 2, 2, 683, 5106, 3, 2, 2, 2, 685, 5117, 3, 2, 2, 2, 687,
-	5129, 3, 2, 2, 2, 689, 5140, 3, 2, 2, 2, 691, 5151, 3, 2, 2, 2, 693, 5162,
-	3, 2, 2, 2, 695, 5181, 3, 2, 2, 2, 697, 5199, 3, 2, 2, 2, 699, 5215, 3,
-	2, 2, 2, 701, 5224, 3, 2, 2, 2, 703, 5232, 3, 2, 2, 2, 705, 5245, 3, 2,
-	2, 2, 707, 5250, 3, 2, 2, 2, 709, 5254, 3, 2, 2, 2, 711, 5266, 3, 2, 2,
-	2, 713, 5271, 3, 2, 2, 2, 715, 5280, 3, 2, 2, 2, 717, 5291, 3, 2, 2, 2,
-	719, 5304, 3, 2, 2, 2, 721, 5312, 3, 2, 2, 2, 723, 5328, 3, 2, 2, 2, 725,
-	5341, 3, 2, 2, 2, 727, 5351, 3, 2, 2, 2, 729, 5359, 3, 2, 2, 2, 731, 5367,
-	3, 2, 2, 2, 733, 5372, 3, 2, 2, 2, 735, 5375, 3, 2, 2, 2, 737, 5384, 3,
-	2, 2, 2, 739, 5394, 3, 2, 2, 2, 741, 5402, 3, 2, 2, 2, 743, 5409, 3, 2,
-	2, 2, 745, 5420, 3, 2, 2, 2, 747, 5424, 3, 2, 2, 2, 749, 5429, 3, 2, 2,
-	2, 751, 5436, 3, 2, 2, 2, 753, 5444, 3, 2, 2, 2, 755, 5450, 3, 2, 2, 2,
-	757, 5457, 3, 2, 2, 2, 759, 5464, 3, 2, 2, 2, 761, 5469, 3, 2, 2, 2, 763,
-	5475, 3, 2, 2, 2, 765, 5482, 3, 2, 2, 2, 767, 5488, 3, 2, 2, 2, 769, 5497,
-	3, 2, 2, 2, 771, 5507, 3, 2, 2, 2, 773, 5514, 3, 2, 2, 2, 775, 5521, 3,
-	2, 2, 2, 777, 5530, 3, 2, 2, 2, 779, 5542, 3, 2, 2, 2, 781, 5547, 3, 2,
-	2, 2, 783, 5554, 3, 2, 2, 2, 785, 5561, 3, 2, 2, 2, 787, 5577, 3, 2, 2,
-	2, 789, 5584, 3, 2, 2, 2, 791, 5590, 3, 2, 2, 2, 793, 5596, 3, 2, 2, 2,
-	795, 5602, 3, 2, 2, 2, 797, 5610, 3, 2, 2, 2, 799, 5616, 3, 2, 2, 2, 801,
-	5621, 3, 2, 2, 2, 803, 5630, 3, 2, 2, 2, 805, 5638, 3, 2, 2, 2, 807, 5645,
-	3, 2, 2, 2, 809, 5652, 3, 2, 2, 2, 811, 5670, 3, 2, 2, 2, 813, 5678, 3,
-	2, 2, 2, 815, 5683, 3, 2, 2, 2, 817, 5688, 3, 2, 2, 2, 819, 5693, 3, 2,
-	2, 2, 821, 5699, 3, 2, 2, 2, 823, 5710, 3, 2, 2, 2, 825, 5728, 3, 2, 2,
-	2, 827, 5735, 3, 2, 2, 2, 829, 5743, 3, 2, 2, 2, 831, 5756, 3, 2, 2, 2,
-	833, 5764, 3, 2, 2, 2, 835, 5778, 3, 2, 2, 2, 837, 5786, 3, 2, 2, 2, 839,
-	5795, 3, 2, 2, 2, 841, 5805, 3, 2, 2, 2, 843, 5813, 3, 2, 2, 2, 845, 5816,
-	3, 2, 2, 2, 847, 5826, 3, 2, 2, 2, 849, 5830, 3, 2, 2, 2, 851, 5840, 3,
-	2, 2, 2, 853, 5847, 3, 2, 2, 2, 855, 5852, 3, 2, 2, 2, 857, 5867, 3, 2,
-	2, 2, 859, 5876, 3, 2, 2, 2, 861, 5881, 3, 2, 2, 2, 863, 5888, 3, 2, 2,
-	2, 865, 5893, 3, 2, 2, 2, 867, 5899, 3, 2, 2, 2, 869, 5904, 3, 2, 2, 2,
-	871, 5910, 3, 2, 2, 2, 873, 5918, 3, 2, 2, 2, 875, 5923, 3, 2, 2, 2, 877,
-	5930, 3, 2, 2, 2, 879, 5951, 3, 2, 2, 2, 881, 5972, 3, 2, 2, 2, 883, 5985,
-	3, 2, 2, 2, 885, 6009, 3, 2, 2, 2, 887, 6021, 3, 2, 2, 2, 889, 6037, 3,
-	2, 2, 2, 891, 6052, 3, 2, 2, 2, 893, 6068, 3, 2, 2, 2, 895, 6080, 3, 2,
-	2, 2, 897, 6099, 3, 2, 2, 2, 899, 6110, 3, 2, 2, 2, 901, 6124, 3, 2, 2,
-	2, 903, 6142, 3, 2, 2, 2, 905, 6158, 3, 2, 2, 2, 907, 6176, 3, 2, 2, 2,
-	909, 6191, 3, 2, 2, 2, 911, 6210, 3, 2, 2, 2, 913, 6225, 3, 2, 2, 2, 915,
-	6244, 3, 2, 2, 2, 917, 6256, 3, 2, 2, 2, 919, 6281, 3, 2, 2, 2, 921, 6302,
-	3, 2, 2, 2, 923, 6311, 3, 2, 2, 2, 925, 6320, 3, 2, 2, 2, 927, 6341, 3,
-	2, 2, 2, 929, 6362, 3, 2, 2, 2, 931, 6369, 3, 2, 2, 2, 933, 6376, 3, 2,
-	2, 2, 935, 6382, 3, 2, 2, 2, 937, 6395, 3, 2, 2, 2, 939, 6399, 3, 2, 2,
-	2, 941, 6407, 3, 2, 2, 2, 943, 6416, 3, 2, 2, 2, 945, 6421, 3, 2, 2, 2,
-	947, 6428, 3, 2, 2, 2, 949, 6434, 3, 2, 2, 2, 951, 6440, 3, 2, 2, 2, 953,
-	6452, 3, 2, 2, 2, 955, 6457, 3, 2, 2, 2, 957, 6463, 3, 2, 2, 2, 959, 6469,
-	3, 2, 2, 2, 961, 6475, 3, 2, 2, 2, 963, 6480, 3, 2, 2, 2, 965, 6483, 3,
-	2, 2, 2, 967, 6493, 3, 2, 2, 2, 969, 6498, 3, 2, 2, 2, 971, 6506, 3, 2,
-	2, 2, 973, 6513, 3, 2, 2, 2, 975, 6516, 3, 2, 2, 2, 977, 6519, 3, 2, 2,
-	2, 979, 6532, 3, 2, 2, 2, 981, 6536, 3, 2, 2, 2, 983, 6543, 3, 2, 2, 2,
-	985, 6548, 3, 2, 2, 2, 987, 6553, 3, 2, 2, 2, 989, 6569, 3, 2, 2, 2, 991,
-	6577, 3, 2, 2, 2, 993, 6583, 3, 2, 2, 2, 995, 6593, 3, 2, 2, 2, 997, 6598,
-	3, 2, 2, 2, 999, 6605, 3, 2, 2, 2, 1001, 6613, 3, 2, 2, 2, 1003, 6626,
-	3, 2, 2, 2, 1005, 6637, 3, 2, 2, 2, 1007, 6646, 3, 2, 2, 2, 1009, 6652,
-	3, 2, 2, 2, 1011, 6659, 3, 2, 2, 2, 1013, 6670, 3, 2, 2, 2, 1015, 6678,
-	3, 2, 2, 2, 1017, 6683, 3, 2, 2, 2, 1019, 6692, 3, 2, 2, 2, 1021, 6700,
-	3, 2, 2, 2, 1023, 6709, 3, 2, 2, 2, 1025, 6714, 3, 2, 2, 2, 1027, 6726,
-	3, 2, 2, 2, 1029, 6734, 3, 2, 2, 2, 1031, 6743, 3, 2, 2, 2, 1033, 6749,
-	3, 2, 2, 2, 1035, 6755, 3, 2, 2, 2, 1037, 6761, 3, 2, 2, 2, 1039, 6769,
-	3, 2, 2, 2, 1041, 6777, 3, 2, 2, 2, 1043, 6794, 3, 2, 2, 2, 1045, 6804,
-	3, 2, 2, 2, 1047, 6810, 3, 2, 2, 2, 1049, 6825, 3, 2, 2, 2, 1051, 6839,
-	3, 2, 2, 2, 1053, 6848, 3, 2, 2, 2, 1055, 6855, 3, 2, 2, 2, 1057, 6866,
-	3, 2, 2, 2, 1059, 6873, 3, 2, 2, 2, 1061, 6889, 3, 2, 2, 2, 1063, 6908,
-	3, 2, 2, 2, 1065, 6928, 3, 2, 2, 2, 1067, 6951, 3, 2, 2, 2, 1069, 6972,
-	3, 2, 2, 2, 1071, 6996, 3, 2, 2, 2, 1073, 7024, 3, 2, 2, 2, 1075, 7036,
-	3, 2, 2, 2, 1077, 7042, 3, 2, 2, 2, 1079, 7049, 3, 2, 2, 2, 1081, 7067,
-	3, 2, 2, 2, 1083, 7077, 3, 2, 2, 2, 1085, 7085, 3, 2, 2, 2, 1087, 7090,
-	3, 2, 2, 2, 1089, 7099, 3, 2, 2, 2, 1091, 7106, 3, 2, 2, 2, 1093, 7113,
-	3, 2, 2, 2, 1095, 7117, 3, 2, 2, 2, 1097, 7122, 3, 2, 2, 2, 1099, 7133,
-	3, 2, 2, 2, 1101, 7143, 3, 2, 2, 2, 1103, 7152, 3, 2, 2, 2, 1105, 7161,
-	3, 2, 2, 2, 1107, 7168, 3, 2, 2, 2, 1109, 7176, 3, 2, 2, 2, 1111, 7182,
-	3, 2, 2, 2, 1113, 7189, 3, 2, 2, 2, 1115, 7196, 3, 2, 2, 2, 1117, 7203,
-	3, 2, 2, 2, 1119, 7209, 3, 2, 2, 2, 1121, 7214, 3, 2, 2, 2, 1123, 7223,
-	3, 2, 2, 2, 1125, 7230, 3, 2, 2, 2, 1127, 7235, 3, 2, 2, 2, 1129, 7242,
-	3, 2, 2, 2, 1131, 7249, 3, 2, 2, 2, 1133, 7256, 3, 2, 2, 2, 1135, 7272,
-	3, 2, 2, 2, 1137, 7291, 3, 2, 2, 2, 1139, 7308, 3, 2, 2, 2, 1141, 7326,
-	3, 2, 2, 2, 1143, 7336, 3, 2, 2, 2, 1145, 7349, 3, 2, 2, 2, 1147, 7360,
-	3, 2, 2, 2, 1149, 7366, 3, 2, 2, 2, 1151, 7373, 3, 2, 2, 2, 1153, 7391,
-	3, 2, 2, 2, 1155, 7408, 3, 2, 2, 2, 1157, 7427, 3, 2, 2, 2, 1159, 7434,
-	3, 2, 2, 2, 1161, 7439, 3, 2, 2, 2, 1163, 7447, 3, 2, 2, 2, 1165, 7454,
-	3, 2, 2, 2, 1167, 7461, 3, 2, 2, 2, 1169, 7477, 3, 2, 2, 2, 1171, 7485,
-	3, 2, 2, 2, 1173, 7498, 3, 2, 2, 2, 1175, 7512, 3, 2, 2, 2, 1177, 7520,
-	3, 2, 2, 2, 1179, 7526, 3, 2, 2, 2, 1181, 7535, 3, 2, 2, 2, 1183, 7546,
-	3, 2, 2, 2, 1185, 7557, 3, 2, 2, 2, 1187, 7567, 3, 2, 2, 2, 1189, 7577,
-	3, 2, 2, 2, 1191, 7582, 3, 2, 2, 2, 1193, 7594, 3, 2, 2, 2, 1195, 7606,
-	3, 2, 2, 2, 1197, 7620, 3, 2, 2, 2, 1199, 7629, 3, 2, 2, 2, 1201, 7638,
-	3, 2, 2, 2, 1203, 7648, 3, 2, 2, 2, 1205, 7657, 3, 2, 2, 2, 1207, 7674,
-	3, 2, 2, 2, 1209, 7684, 3, 2, 2, 2, 1211, 7692, 3, 2, 2, 2, 1213, 7698,
-	3, 2, 2, 2, 1215, 7706, 3, 2, 2, 2, 1217, 7711, 3, 2, 2, 2, 1219, 7719,
-	3, 2, 2, 2, 1221, 7734, 3, 2, 2, 2, 1223, 7745, 3, 2, 2, 2, 1225, 7751,
-	3, 2, 2, 2, 1227, 7761, 3, 2, 2, 2, 1229, 7766, 3, 2, 2, 2, 1231, 7774,
-	3, 2, 2, 2, 1233, 7782, 3, 2, 2, 2, 1235, 7787, 3, 2, 2, 2, 1237, 7796,
-	3, 2, 2, 2, 1239, 7804, 3, 2, 2, 2, 1241, 7809, 3, 2, 2, 2, 1243, 7817,
-	3, 2, 2, 2, 1245, 7822, 3, 2, 2, 2, 1247, 7825, 3, 2, 2, 2, 1249, 7829,
-	3, 2, 2, 2, 1251, 7833, 3, 2, 2, 2, 1253, 7837, 3, 2, 2, 2, 1255, 7841,
-	3, 2, 2, 2, 1257, 7845, 3, 2, 2, 2, 1259, 7854, 3, 2, 2, 2, 1261, 7862,
-	3, 2, 2, 2, 1263, 7868, 3, 2, 2, 2, 1265, 7872, 3, 2, 2, 2, 1267, 7877,
-	3, 2, 2, 2, 1269, 7884, 3, 2, 2, 2, 1271, 7889, 3, 2, 2, 2, 1273, 7896,
-	3, 2, 2, 2, 1275, 7908, 3, 2, 2, 2, 1277, 7915, 3, 2, 2, 2, 1279, 7923,
-	3, 2, 2, 2, 1281, 7931, 3, 2, 2, 2, 1283, 7936, 3, 2, 2, 2, 1285, 7944,
-	3, 2, 2, 2, 1287, 7951, 3, 2, 2, 2, 1289, 7960, 3, 2, 2, 2, 1291, 7966,
-	3, 2, 2, 2, 1293, 7977, 3, 2, 2, 2, 1295, 8004, 3, 2, 2, 2, 1297, 8016,
-	3, 2, 2, 2, 1299, 8029, 3, 2, 2, 2, 1301, 8042, 3, 2, 2, 2, 1303, 8066,
-	3, 2, 2, 2, 1305, 8078, 3, 2, 2, 2, 1307, 8095, 3, 2, 2, 2, 1309, 8116,
-	3, 2, 2, 2, 1311, 8131, 3, 2, 2, 2, 1313, 8145, 3, 2, 2, 2, 1315, 8167,
-	3, 2, 2, 2, 1317, 8180, 3, 2, 2, 2, 1319, 8193, 3, 2, 2, 2, 1321, 8214,
-	3, 2, 2, 2, 1323, 8238, 3, 2, 2, 2, 1325, 8262, 3, 2, 2, 2, 1327, 8285,
-	3, 2, 2, 2, 1329, 8301, 3, 2, 2, 2, 1331, 8328, 3, 2, 2, 2, 1333, 8348,
-	3, 2, 2, 2, 1335, 8372, 3, 2, 2, 2, 1337, 8393, 3, 2, 2, 2, 1339, 8413,
-	3, 2, 2, 2, 1341, 8424, 3, 2, 2, 2, 1343, 8450, 3, 2, 2, 2, 1345, 8479,
-	3, 2, 2, 2, 1347, 8491, 3, 2, 2, 2, 1349, 8504, 3, 2, 2, 2, 1351, 8527,
-	3, 2, 2, 2, 1353, 8550, 3, 2, 2, 2, 1355, 8570, 3, 2, 2, 2, 1357, 8587,
-	3, 2, 2, 2, 1359, 8596, 3, 2, 2, 2, 1361, 8602, 3, 2, 2, 2, 1363, 8607,
-	3, 2, 2, 2, 1365, 8614, 3, 2, 2, 2, 1367, 8621, 3, 2, 2, 2, 1369, 8628,
-	3, 2, 2, 2, 1371, 8635, 3, 2, 2, 2, 1373, 8641, 3, 2, 2, 2, 1375, 8647,
-	3, 2, 2, 2, 1377, 8653, 3, 2, 2, 2, 1379, 8659, 3, 2, 2, 2, 1381, 8664,
-	3, 2, 2, 2, 1383, 8672, 3, 2, 2, 2, 1385, 8678, 3, 2, 2, 2, 1387, 8685,
-	3, 2, 2, 2, 1389, 8689, 3, 2, 2, 2, 1391, 8697, 3, 2, 2, 2, 1393, 8703,
-	3, 2, 2, 2, 1395, 8710, 3, 2, 2, 2, 1397, 8714, 3, 2, 2, 2, 1399, 8722,
-	3, 2, 2, 2, 1401, 8728, 3, 2, 2, 2, 1403, 8734, 3, 2, 2, 2, 1405, 8741,
-	3, 2, 2, 2, 1407, 8748, 3, 2, 2, 2, 1409, 8755, 3, 2, 2, 2, 1411, 8762,
-	3, 2, 2, 2, 1413, 8768, 3, 2, 2, 2, 1415, 8777, 3, 2, 2, 2, 1417, 8782,
-	3, 2, 2, 2, 1419, 8787, 3, 2, 2, 2, 1421, 8794, 3, 2, 2, 2, 1423, 8799,
-	3, 2, 2, 2, 1425, 8804, 3, 2, 2, 2, 1427, 8810, 3, 2, 2, 2, 1429, 8818,
-	3, 2, 2, 2, 1431, 8824, 3, 2, 2, 2, 1433, 8829, 3, 2, 2, 2, 1435, 8837,
-	3, 2, 2, 2, 1437, 8845, 3, 2, 2, 2, 1439, 8853, 3, 2, 2, 2, 1441, 8863,
-	3, 2, 2, 2, 1443, 8867, 3, 2, 2, 2, 1445, 8877, 3, 2, 2, 2, 1447, 8884,
-	3, 2, 2, 2, 1449, 8891, 3, 2, 2, 2, 1451, 8902, 3, 2, 2, 2, 1453, 8909,
-	3, 2, 2, 2, 1455, 8913, 3, 2, 2, 2, 1457, 8924, 3, 2, 2, 2, 1459, 8943,
-	3, 2, 2, 2, 1461, 8950, 3, 2, 2, 2, 1463, 8961, 3, 2, 2, 2, 1465, 8971,
-	3, 2, 2, 2, 1467, 8983, 3, 2, 2, 2, 1469, 8996, 3, 2, 2, 2, 1471, 9015,
-	3, 2, 2, 2, 1473, 9030, 3, 2, 2, 2, 1475, 9039, 3, 2, 2, 2, 1477, 9050,
-	3, 2, 2, 2, 1479, 9066, 3, 2, 2, 2, 1481, 9077, 3, 2, 2, 2, 1483, 9090,
-	3, 2, 2, 2, 1485, 9096, 3, 2, 2, 2, 1487, 9104, 3, 2, 2, 2, 1489, 9108,
-	3, 2, 2, 2, 1491, 9113, 3, 2, 2, 2, 1493, 9121, 3, 2, 2, 2, 1495, 9129,
-	3, 2, 2, 2, 1497, 9141, 3, 2, 2, 2, 1499, 9153, 3, 2, 2, 2, 1501, 9158,
-	3, 2, 2, 2, 1503, 9167, 3, 2, 2, 2, 1505, 9172, 3, 2, 2, 2, 1507, 9179,
-	3, 2, 2, 2, 1509, 9185, 3, 2, 2, 2, 1511, 9191, 3, 2, 2, 2, 1513, 9210,
-	3, 2, 2, 2, 1515, 9228, 3, 2, 2, 2, 1517, 9247, 3, 2, 2, 2, 1519, 9263,
-	3, 2, 2, 2, 1521, 9281, 3, 2, 2, 2, 1523, 9286, 3, 2, 2, 2, 1525, 9292,
-	3, 2, 2, 2, 1527, 9302, 3, 2, 2, 2, 1529, 9306, 3, 2, 2, 2, 1531, 9316,
-	3, 2, 2, 2, 1533, 9327, 3, 2, 2, 2, 1535, 9334, 3, 2, 2, 2, 1537, 9347,
-	3, 2, 2, 2, 1539, 9352, 3, 2, 2, 2, 1541, 9360, 3, 2, 2, 2, 1543, 9369,
-	3, 2, 2, 2, 1545, 9386, 3, 2, 2, 2, 1547, 9394, 3, 2, 2, 2, 1549, 9406,
-	3, 2, 2, 2, 1551, 9419, 3, 2, 2, 2, 1553, 9429, 3, 2, 2, 2, 1555, 9438,
-	3, 2, 2, 2, 1557, 9445, 3, 2, 2, 2, 1559, 9455, 3, 2, 2, 2, 1561, 9469,
-	3, 2, 2, 2, 1563, 9474, 3, 2, 2, 2, 1565, 9485, 3, 2, 2, 2, 1567, 9489,
-	3, 2, 2, 2, 1569, 9493, 3, 2, 2, 2, 1571, 9499, 3, 2, 2, 2, 1573, 9526,
-	3, 2, 2, 2, 1575, 9552, 3, 2, 2, 2, 1577, 9573, 3, 2, 2, 2, 1579, 9587,
-	3, 2, 2, 2, 1581, 9595, 3, 2, 2, 2, 1583, 9604, 3, 2, 2, 2, 1585, 9616,
-	3, 2, 2, 2, 1587, 9624, 3, 2, 2, 2, 1589, 9635, 3, 2, 2, 2, 1591, 9645,
-	3, 2, 2, 2, 1593, 9655, 3, 2, 2, 2, 1595, 9662, 3, 2, 2, 2, 1597, 9670,
-	3, 2, 2, 2, 1599, 9682, 3, 2, 2, 2, 1601, 9694, 3, 2, 2, 2, 1603, 9704,
-	3, 2, 2, 2, 1605, 9713, 3, 2, 2, 2, 1607, 9717, 3, 2, 2, 2, 1609, 9724,
-	3, 2, 2, 2, 1611, 9732, 3, 2, 2, 2, 1613, 9741, 3, 2, 2, 2, 1615, 9750,
-	3, 2, 2, 2, 1617, 9757, 3, 2, 2, 2, 1619, 9761, 3, 2, 2, 2, 1621, 9772,
-	3, 2, 2, 2, 1623, 9785, 3, 2, 2, 2, 1625, 9798, 3, 2, 2, 2, 1627, 9804,
-	3, 2, 2, 2, 1629, 9816, 3, 2, 2, 2, 1631, 9822, 3, 2, 2, 2, 1633, 9829,
-	3, 2, 2, 2, 1635, 9840, 3, 2, 2, 2, 1637, 9852, 3, 2, 2, 2, 1639, 9862,
-	3, 2, 2, 2, 1641, 9876, 3, 2, 2, 2, 1643, 9893, 3, 2, 2, 2, 1645, 9909,
-	3, 2, 2, 2, 1647, 9936, 3, 2, 2, 2, 1649, 9962, 3, 2, 2, 2, 1651, 9979,
-	3, 2, 2, 2, 1653, 9995, 3, 2, 2, 2, 1655, 10005, 3, 2, 2, 2, 1657, 10018,
-	3, 2, 2, 2, 1659, 10031, 3, 2, 2, 2, 1661, 10043, 3, 2, 2, 2, 1663, 10054,
-	3, 2, 2, 2, 1665, 10063, 3, 2, 2, 2, 1667, 10071, 3, 2, 2, 2, 1669, 10080,
-	3, 2, 2, 2, 1671, 10092, 3, 2, 2, 2, 1673, 10106, 3, 2, 2, 2, 1675, 10110,
-	3, 2, 2, 2, 1677, 10117, 3, 2, 2, 2, 1679, 10128, 3, 2, 2, 2, 1681, 10139,
-	3, 2, 2, 2, 1683, 10149, 3, 2, 2, 2, 1685, 10159, 3, 2, 2, 2, 1687, 10165,
-	3, 2, 2, 2, 1689, 10179, 3, 2, 2, 2, 1691, 10190, 3, 2, 2, 2, 1693, 10199,
-	3, 2, 2, 2, 1695, 10207, 3, 2, 2, 2, 1697, 10214, 3, 2, 2, 2, 1699, 10223,
-	3, 2, 2, 2, 1701, 10236, 3, 2, 2, 2, 1703, 10244, 3, 2, 2, 2, 1705, 10259,
-	3, 2, 2, 2, 1707, 10274, 3, 2, 2, 2, 1709, 10282, 3, 2, 2, 2, 1711, 10295,
-	3, 2, 2, 2, 1713, 10310, 3, 2, 2, 2, 1715, 10316, 3, 2, 2, 2, 1717, 10322,
-	3, 2, 2, 2, 1719, 10329, 3, 2, 2, 2, 1721, 10342, 3, 2, 2, 2, 1723, 10354,
-	3, 2, 2, 2, 1725, 10373, 3, 2, 2, 2, 1727, 10391, 3, 2, 2, 2, 1729, 10394,
-	3, 2, 2, 2, 1731, 10404, 3, 2, 2, 2, 1733, 10411, 3, 2, 2, 2, 1735, 10415,
-	3, 2, 2, 2, 1737, 10421, 3, 2, 2, 2, 1739, 10426, 3, 2, 2, 2, 1741, 10432,
-	3, 2, 2, 2, 1743, 10437, 3, 2, 2, 2, 1745, 10443, 3, 2, 2, 2, 1747, 10452,
-	3, 2, 2, 2, 1749, 10461, 3, 2, 2, 2, 1751, 10470, 3, 2, 2, 2, 1753, 10486,
-	3, 2, 2, 2, 1755, 10498, 3, 2, 2, 2, 1757, 10510, 3, 2, 2, 2, 1759, 10519,
-	3, 2, 2, 2, 1761, 10533, 3, 2, 2, 2, 1763, 10545, 3, 2, 2, 2, 1765, 10556,
-	3, 2, 2, 2, 1767, 10566, 3, 2, 2, 2, 1769, 10570, 3, 2, 2, 2, 1771, 10584,
-	3, 2, 2, 2, 1773, 10597, 3, 2, 2, 2, 1775, 10607, 3, 2, 2, 2, 1777, 10622,
-	3, 2, 2, 2, 1779, 10636, 3, 2, 2, 2, 1781, 10650, 3, 2, 2, 2, 1783, 10663,
-	3, 2, 2, 2, 1785, 10687, 3, 2, 2, 2, 1787, 10710, 3, 2, 2, 2, 1789, 10729,
-	3, 2, 2, 2, 1791, 10747, 3, 2, 2, 2, 1793, 10768, 3, 2, 2, 2, 1795, 10788,
-	3, 2, 2, 2, 1797, 10799, 3, 2, 2, 2, 1799, 10806, 3, 2, 2, 2, 1801, 10820,
-	3, 2, 2, 2, 1803, 10837, 3, 2, 2, 2, 1805, 10847, 3, 2, 2, 2, 1807, 10851,
-	3, 2, 2, 2, 1809, 10864, 3, 2, 2, 2, 1811, 10868, 3, 2, 2, 2, 1813, 10877,
-	3, 2, 2, 2, 1815, 10888, 3, 2, 2, 2, 1817, 10900, 3, 2, 2, 2, 1819, 10903,
-	3, 2, 2, 2, 1821, 10917, 3, 2, 2, 2, 1823, 10930, 3, 2, 2, 2, 1825, 10937,
-	3, 2, 2, 2, 1827, 10950, 3, 2, 2, 2, 1829, 10962, 3, 2, 2, 2, 1831, 10978,
-	3, 2, 2, 2, 1833, 10993, 3, 2, 2, 2, 1835, 10997, 3, 2, 2, 2, 1837, 11003,
-	3, 2, 2, 2, 1839, 11009, 3, 2, 2, 2, 1841, 11017, 3, 2, 2, 2, 1843, 11022,
-	3, 2, 2, 2, 1845, 11035, 3, 2, 2, 2, 1847, 11048, 3, 2, 2, 2, 1849, 11056,
-	3, 2, 2, 2, 1851, 11062, 3, 2, 2, 2, 1853, 11072, 3, 2, 2, 2, 1855, 11077,
-	3, 2, 2, 2, 1857, 11083, 3, 2, 2, 2, 1859, 11095, 3, 2, 2, 2, 1861, 11108,
-	3, 2, 2, 2, 1863, 11112, 3, 2, 2, 2, 1865, 11117, 3, 2, 2, 2, 1867, 11122,
-	3, 2, 2, 2, 1869, 11134, 3, 2, 2, 2, 1871, 11139, 3, 2, 2, 2, 1873, 11143,
-	3, 2, 2, 2, 1875, 11149, 3, 2, 2, 2, 1877, 11157, 3, 2, 2, 2, 1879, 11185,
-	3, 2, 2, 2, 1881, 11190, 3, 2, 2, 2, 1883, 11195, 3, 2, 2, 2, 1885, 11206,
-	3, 2, 2, 2, 1887, 11213, 3, 2, 2, 2, 1889, 11225, 3, 2, 2, 2, 1891, 11233,
-	3, 2, 2, 2, 1893, 11245, 3, 2, 2, 2, 1895, 11255, 3, 2, 2, 2, 1897, 11264,
-	3, 2, 2, 2, 1899, 11273, 3, 2, 2, 2, 1901, 11283, 3, 2, 2, 2, 1903, 11295,
-	3, 2, 2, 2, 1905, 11307, 3, 2, 2, 2, 1907, 11318, 3, 2, 2, 2, 1909, 11332,
-	3, 2, 2, 2, 1911, 11345, 3, 2, 2, 2, 1913, 11357, 3, 2, 2, 2, 1915, 11369,
-	3, 2, 2, 2, 1917, 11381, 3, 2, 2, 2, 1919, 11393, 3, 2, 2, 2, 1921, 11403,
-	3, 2, 2, 2, 1923, 11419, 3, 2, 2, 2, 1925, 11439, 3, 2, 2, 2, 1927, 11458,
-	3, 2, 2, 2, 1929, 11477, 3, 2, 2, 2, 1931, 11507, 3, 2, 2, 2, 1933, 11536,
-	3, 2, 2, 2, 1935, 11556, 3, 2, 2, 2, 1937, 11575, 3, 2, 2, 2, 1939, 11588,
-	3, 2, 2, 2, 1941, 11604, 3, 2, 2, 2, 1943, 11620, 3, 2, 2, 2, 1945, 11635,
-	3, 2, 2, 2, 1947, 11652, 3, 2, 2, 2, 1949, 11668, 3, 2, 2, 2, 1951, 11682,
-	3, 2, 2, 2, 1953, 11694, 3, 2, 2, 2, 1955, 11705, 3, 2, 2, 2, 1957, 11717,
-	3, 2, 2, 2, 1959, 11733, 3, 2, 2, 2, 1961, 11748, 3, 2, 2, 2, 1963, 11770,
-	3, 2, 2, 2, 1965, 11791, 3, 2, 2, 2, 1967, 11808, 3, 2, 2, 2, 1969, 11827,
-	3, 2, 2, 2, 1971, 11847, 3, 2, 2, 2, 1973, 11860, 3, 2, 2, 2, 1975, 11872,
-	3, 2, 2, 2, 1977, 11889, 3, 2, 2, 2, 1979, 11905, 3, 2, 2, 2, 1981, 11915,
-	3, 2, 2, 2, 1983, 11931, 3, 2, 2, 2, 1985, 11946, 3, 2, 2, 2, 1987, 11965,
-	3, 2, 2, 2, 1989, 11983, 3, 2, 2, 2, 1991, 11991, 3, 2, 2, 2, 1993, 12005,
-	3, 2, 2, 2, 1995, 12022, 3, 2, 2, 2, 1997, 12033, 3, 2, 2, 2, 1999, 12042,
-	3, 2, 2, 2, 2001, 12052, 3, 2, 2, 2, 2003, 12057, 3, 2, 2, 2, 2005, 12062,
-	3, 2, 2, 2, 2007, 12070, 3, 2, 2, 2, 2009, 12086, 3, 2, 2, 2, 2011, 12094,
-	3, 2, 2, 2, 2013, 12106, 3, 2, 2, 2, 2015, 12110, 3, 2, 2, 2, 2017, 12119,
-	3, 2, 2, 2, 2019, 12132, 3, 2, 2, 2, 2021, 12146, 3, 2, 2, 2, 2023, 12158,
-	3, 2, 2, 2, 2025, 12170, 3, 2, 2, 2, 2027, 12178, 3, 2, 2, 2, 2029, 12188,
-	3, 2, 2, 2, 2031, 12196, 3, 2, 2, 2, 2033, 12207, 3, 2, 2, 2, 2035, 12213,
-	3, 2, 2, 2, 2037, 12224, 3, 2, 2, 2, 2039, 12244, 3, 2, 2, 2, 2041, 12250,
-	3, 2, 2, 2, 2043, 12265, 3, 2, 2, 2, 2045, 12275, 3, 2, 2, 2, 2047, 12281,
-	3, 2, 2, 2, 2049, 12286, 3, 2, 2, 2, 2051, 12297, 3, 2, 2, 2, 2053, 12324,
-	3, 2, 2, 2, 2055, 12332, 3, 2, 2, 2, 2057, 12366, 3, 2, 2, 2, 2059, 12374,
-	3, 2, 2, 2, 2061, 12385, 3, 2, 2, 2, 2063, 12399, 3, 2, 2, 2, 2065, 12406,
-	3, 2, 2, 2, 2067, 12415, 3, 2, 2, 2, 2069, 12417, 3, 2, 2, 2, 2071, 12419,
-	3, 2, 2, 2, 2073, 12422, 3, 2, 2, 2, 2075, 12425, 3, 2, 2, 2, 2077, 12428,
-	3, 2, 2, 2, 2079, 12431, 3, 2, 2, 2, 2081, 12434, 3, 2, 2, 2, 2083, 12437,
-	3, 2, 2, 2, 2085, 12440, 3, 2, 2, 2, 2087, 12443, 3, 2, 2, 2, 2089, 12446,
-	3, 2, 2, 2, 2091, 12448, 3, 2, 2, 2, 2093, 12450, 3, 2, 2, 2, 2095, 12452,
-	3, 2, 2, 2, 2097, 12454, 3, 2, 2, 2, 2099, 12457, 3, 2, 2, 2, 2101, 12459,
-	3, 2, 2, 2, 2103, 12463, 3, 2, 2, 2, 2105, 12467, 3, 2, 2, 2, 2107, 12469,
-	3, 2, 2, 2, 2109, 12471, 3, 2, 2, 2, 2111, 12473, 3, 2, 2, 2, 2113, 12475,
-	3, 2, 2, 2, 2115, 12477, 3, 2, 2, 2, 2117, 12479, 3, 2, 2, 2, 2119, 12481,
-	3, 2, 2, 2, 2121, 12483, 3, 2, 2, 2, 2123, 12485, 3, 2, 2, 2, 2125, 12487,
-	3, 2, 2, 2, 2127, 12489, 3, 2, 2, 2, 2129, 12491, 3, 2, 2, 2, 2131, 12493,
-	3, 2, 2, 2, 2133, 12495, 3, 2, 2, 2, 2135, 12497, 3, 2, 2, 2, 2137, 12499,
-	3, 2, 2, 2, 2139, 12501, 3, 2, 2, 2, 2141, 12503, 3, 2, 2, 2, 2143, 12505,
-	3, 2, 2, 2, 2145, 12507, 3, 2, 2, 2, 2147, 12512, 3, 2, 2, 2, 2149, 12514,
-	3, 2, 2, 2, 2151, 12519, 3, 2, 2, 2, 2153, 12525, 3, 2, 2, 2, 2155, 12531,
-	3, 2, 2, 2, 2157, 12534, 3, 2, 2, 2, 2159, 12557, 3, 2, 2, 2, 2161, 12602,
-	3, 2, 2, 2, 2163, 12604, 3, 2, 2, 2, 2165, 12607, 3, 2, 2, 2, 2167, 12609,
-	3, 2, 2, 2, 2169, 12612, 3, 2, 2, 2, 2171, 12615, 3, 2, 2, 2, 2173, 12617,
-	3, 2, 2, 2, 2175, 12629, 3, 2, 2, 2, 2177, 12661, 3, 2, 2, 2, 2179, 12663,
-	3, 2, 2, 2, 2181, 12674, 3, 2, 2, 2, 2183, 12725, 3, 2, 2, 2, 2185, 12727,
-	3, 2, 2, 2, 2187, 12739, 3, 2, 2, 2, 2189, 12753, 3, 2, 2, 2, 2191, 12766,
-	3, 2, 2, 2, 2193, 12779, 3, 2, 2, 2, 2195, 12792, 3, 2, 2, 2, 2197, 12794,
-	3, 2, 2, 2, 2199, 12796, 3, 2, 2, 2, 2201, 12805, 3, 2, 2, 2, 2203, 2205,
-	9, 2, 2, 2, 2204, 2203, 3, 2, 2, 2, 2205, 2206, 3, 2, 2, 2, 2206, 2204,
-	3, 2, 2, 2, 2206, 2207, 3, 2, 2, 2, 2207, 2208, 3, 2, 2, 2, 2208, 2209,
-	8, 2, 2, 2, 2209, 4, 3, 2, 2, 2, 2210, 2211, 7, 49, 2, 2, 2211, 2212, 7,
-	44, 2, 2, 2212, 2213, 7, 35, 2, 2, 2213, 2215, 3, 2, 2, 2, 2214, 2216,
-	11, 2, 2, 2, 2215, 2214, 3, 2, 2, 2, 2216, 2217, 3, 2, 2, 2, 2217, 2218,
-	3, 2, 2, 2, 2217, 2215, 3, 2, 2, 2, 2218, 2219, 3, 2, 2, 2, 2219, 2220,
-	7, 44, 2, 2, 2220, 2221, 7, 49, 2, 2, 2221, 2222, 3, 2, 2, 2, 2222, 2223,
-	8, 3, 3, 2, 2223, 6, 3, 2, 2, 2, 2224, 2225, 7, 49, 2, 2, 2225, 2226, 7,
-	44, 2, 2, 2226, 2230, 3, 2, 2, 2, 2227, 2229, 11, 2, 2, 2, 2228, 2227,
-	3, 2, 2, 2, 2229, 2232, 3, 2, 2, 2, 2230, 2231, 3, 2, 2, 2, 2230, 2228,
-	3, 2, 2, 2, 2231, 2233, 3, 2, 2, 2, 2232, 2230, 3, 2, 2, 2, 2233, 2234,
-	7, 44, 2, 2, 2234, 2235, 7, 49, 2, 2, 2235, 2236, 3, 2, 2, 2, 2236, 2237,
-	8, 4, 2, 2, 2237, 8, 3, 2, 2, 2, 2238, 2239, 7, 47, 2, 2, 2239, 2240, 7,
-	47, 2, 2, 2240, 2241, 3, 2, 2, 2, 2241, 2244, 9, 3, 2, 2, 2242, 2244, 7,
-	37, 2, 2, 2243, 2238, 3, 2, 2, 2, 2243, 2242, 3, 2, 2, 2, 2244, 2248, 3,
-	2, 2, 2, 2245, 2247, 10, 4, 2, 2, 2246, 2245, 3, 2, 2, 2, 2247, 2250, 3,
-	2, 2, 2, 2248, 2246, 3, 2, 2, 2, 2248, 2249, 3, 2, 2, 2, 2249, 2256, 3,
-	2, 2, 2, 2250, 2248, 3, 2, 2, 2, 2251, 2253, 7, 15, 2, 2, 2252, 2251, 3,
-	2, 2, 2, 2252, 2253, 3, 2, 2, 2, 2253, 2254, 3, 2, 2, 2, 2254, 2257, 7,
-	12, 2, 2, 2255, 2257, 7, 2, 2, 3, 2256, 2252, 3, 2, 2, 2, 2256, 2255, 3,
-	2, 2, 2, 2257, 2269, 3, 2, 2, 2, 2258, 2259, 7, 47, 2, 2, 2259, 2260, 7,
-	47, 2, 2, 2260, 2266, 3, 2, 2, 2, 2261, 2263, 7, 15, 2, 2, 2262, 2261,
-	3, 2, 2, 2, 2262, 2263, 3, 2, 2, 2, 2263, 2264, 3, 2, 2, 2, 2264, 2267,
-	7, 12, 2, 2, 2265, 2267, 7, 2, 2, 3, 2266, 2262, 3, 2, 2, 2, 2266, 2265,
-	3, 2, 2, 2, 2267, 2269, 3, 2, 2, 2, 2268, 2243, 3, 2, 2, 2, 2268, 2258,
-	3, 2, 2, 2, 2269, 2270, 3, 2, 2, 2, 2270, 2271, 8, 5, 2, 2, 2271, 10, 3,
-	2, 2, 2, 2272, 2273, 7, 67, 2, 2, 2273, 2274, 7, 70, 2, 2, 2274, 2275,
-	7, 70, 2, 2, 2275, 12, 3, 2, 2, 2, 2276, 2277, 7, 67, 2, 2, 2277, 2278,
-	7, 78, 2, 2, 2278, 2279, 7, 78, 2, 2, 2279, 14, 3, 2, 2, 2, 2280, 2281,
-	7, 67, 2, 2, 2281, 2282, 7, 78, 2, 2, 2282, 2283, 7, 86, 2, 2, 2283, 2284,
-	7, 71, 2, 2, 2284, 2285, 7, 84, 2, 2, 2285, 16, 3, 2, 2, 2, 2286, 2287,
-	7, 67, 2, 2, 2287, 2288, 7, 78, 2, 2, 2288, 2289, 7, 89, 2, 2, 2289, 2290,
-	7, 67, 2, 2, 2290, 2291, 7, 91, 2, 2, 2291, 2292, 7, 85, 2, 2, 2292, 18,
-	3, 2, 2, 2, 2293, 2294, 7, 67, 2, 2, 2294, 2295, 7, 80, 2, 2, 2295, 2296,
-	7, 67, 2, 2, 2296, 2297, 7, 78, 2, 2, 2297, 2298, 7, 91, 2, 2, 2298, 2299,
-	7, 92, 2, 2, 2299, 2300, 7, 71, 2, 2, 2300, 20, 3, 2, 2, 2, 2301, 2302,
-	7, 67, 2, 2, 2302, 2303, 7, 80, 2, 2, 2303, 2304, 7, 70, 2, 2, 2304, 22,
-	3, 2, 2, 2, 2305, 2306, 7, 67, 2, 2, 2306, 2307, 7, 85, 2, 2, 2307, 24,
-	3, 2, 2, 2, 2308, 2309, 7, 67, 2, 2, 2309, 2310, 7, 85, 2, 2, 2310, 2311,
-	7, 69, 2, 2, 2311, 26, 3, 2, 2, 2, 2312, 2313, 7, 68, 2, 2, 2313, 2314,
-	7, 71, 2, 2, 2314, 2315, 7, 72, 2, 2, 2315, 2316, 7, 81, 2, 2, 2316, 2317,
-	7, 84, 2, 2, 2317, 2318, 7, 71, 2, 2, 2318, 28, 3, 2, 2, 2, 2319, 2320,
-	7, 68, 2, 2, 2320, 2321, 7, 71, 2, 2, 2321, 2322, 7, 86, 2, 2, 2322, 2323,
-	7, 89, 2, 2, 2323, 2324, 7, 71, 2, 2, 2324, 2325, 7, 71, 2, 2, 2325, 2326,
-	7, 80, 2, 2, 2326, 30, 3, 2, 2, 2, 2327, 2328, 7, 68, 2, 2, 2328, 2329,
-	7, 81, 2, 2, 2329, 2330, 7, 86, 2, 2, 2330, 2331, 7, 74, 2, 2, 2331, 32,
-	3, 2, 2, 2, 2332, 2333, 7, 68, 2, 2, 2333, 2334, 7, 91, 2, 2, 2334, 34,
-	3, 2, 2, 2, 2335, 2336, 7, 69, 2, 2, 2336, 2337, 7, 67, 2, 2, 2337, 2338,
-	7, 78, 2, 2, 2338, 2339, 7, 78, 2, 2, 2339, 36, 3, 2, 2, 2, 2340, 2341,
-	7, 69, 2, 2, 2341, 2342, 7, 67, 2, 2, 2342, 2343, 7, 85, 2, 2, 2343, 2344,
-	7, 69, 2, 2, 2344, 2345, 7, 67, 2, 2, 2345, 2346, 7, 70, 2, 2, 2346, 2347,
-	7, 71, 2, 2, 2347, 38, 3, 2, 2, 2, 2348, 2349, 7, 69, 2, 2, 2349, 2350,
-	7, 67, 2, 2, 2350, 2351, 7, 85, 2, 2, 2351, 2352, 7, 71, 2, 2, 2352, 40,
-	3, 2, 2, 2, 2353, 2354, 7, 69, 2, 2, 2354, 2355, 7, 67, 2, 2, 2355, 2356,
-	7, 85, 2, 2, 2356, 2357, 7, 86, 2, 2, 2357, 42, 3, 2, 2, 2, 2358, 2359,
-	7, 69, 2, 2, 2359, 2360, 7, 74, 2, 2, 2360, 2361, 7, 67, 2, 2, 2361, 2362,
-	7, 80, 2, 2, 2362, 2363, 7, 73, 2, 2, 2363, 2364, 7, 71, 2, 2, 2364, 44,
-	3, 2, 2, 2, 2365, 2366, 7, 69, 2, 2, 2366, 2367, 7, 74, 2, 2, 2367, 2368,
-	7, 67, 2, 2, 2368, 2369, 7, 84, 2, 2, 2369, 2370, 7, 67, 2, 2, 2370, 2371,
-	7, 69, 2, 2, 2371, 2372, 7, 86, 2, 2, 2372, 2373, 7, 71, 2, 2, 2373, 2374,
-	7, 84, 2, 2, 2374, 46, 3, 2, 2, 2, 2375, 2376, 7, 69, 2, 2, 2376, 2377,
-	7, 74, 2, 2, 2377, 2378, 7, 71, 2, 2, 2378, 2379, 7, 69, 2, 2, 2379, 2380,
-	7, 77, 2, 2, 2380, 48, 3, 2, 2, 2, 2381, 2382, 7, 69, 2, 2, 2382, 2383,
-	7, 81, 2, 2, 2383, 2384, 7, 78, 2, 2, 2384, 2385, 7, 78, 2, 2, 2385, 2386,
-	7, 67, 2, 2, 2386, 2387, 7, 86, 2, 2, 2387, 2388, 7, 71, 2, 2, 2388, 50,
-	3, 2, 2, 2, 2389, 2390, 7, 69, 2, 2, 2390, 2391, 7, 81, 2, 2, 2391, 2392,
-	7, 78, 2, 2, 2392, 2393, 7, 87, 2, 2, 2393, 2394, 7, 79, 2, 2, 2394, 2395,
-	7, 80, 2, 2, 2395, 52, 3, 2, 2, 2, 2396, 2397, 7, 69, 2, 2, 2397, 2398,
-	7, 81, 2, 2, 2398, 2399, 7, 80, 2, 2, 2399, 2400, 7, 70, 2, 2, 2400, 2401,
-	7, 75, 2, 2, 2401, 2402, 7, 86, 2, 2, 2402, 2403, 7, 75, 2, 2, 2403, 2404,
-	7, 81, 2, 2, 2404, 2405, 7, 80, 2, 2, 2405, 54, 3, 2, 2, 2, 2406, 2407,
-	7, 69, 2, 2, 2407, 2408, 7, 81, 2, 2, 2408, 2409, 7, 80, 2, 2, 2409, 2410,
-	7, 85, 2, 2, 2410, 2411, 7, 86, 2, 2, 2411, 2412, 7, 84, 2, 2, 2412, 2413,
-	7, 67, 2, 2, 2413, 2414, 7, 75, 2, 2, 2414, 2415, 7, 80, 2, 2, 2415, 2416,
-	7, 86, 2, 2, 2416, 56, 3, 2, 2, 2, 2417, 2418, 7, 69, 2, 2, 2418, 2419,
-	7, 81, 2, 2, 2419, 2420, 7, 80, 2, 2, 2420, 2421, 7, 86, 2, 2, 2421, 2422,
-	7, 75, 2, 2, 2422, 2423, 7, 80, 2, 2, 2423, 2424, 7, 87, 2, 2, 2424, 2425,
-	7, 71, 2, 2, 2425, 58, 3, 2, 2, 2, 2426, 2427, 7, 69, 2, 2, 2427, 2428,
-	7, 81, 2, 2, 2428, 2429, 7, 80, 2, 2, 2429, 2430, 7, 88, 2, 2, 2430, 2431,
-	7, 71, 2, 2, 2431, 2432, 7, 84, 2, 2, 2432, 2433, 7, 86, 2, 2, 2433, 60,
-	3, 2, 2, 2, 2434, 2435, 7, 69, 2, 2, 2435, 2436, 7, 84, 2, 2, 2436, 2437,
-	7, 71, 2, 2, 2437, 2438, 7, 67, 2, 2, 2438, 2439, 7, 86, 2, 2, 2439, 2440,
-	7, 71, 2, 2, 2440, 62, 3, 2, 2, 2, 2441, 2442, 7, 69, 2, 2, 2442, 2443,
-	7, 84, 2, 2, 2443, 2444, 7, 81, 2, 2, 2444, 2445, 7, 85, 2, 2, 2445, 2446,
-	7, 85, 2, 2, 2446, 64, 3, 2, 2, 2, 2447, 2448, 7, 69, 2, 2, 2448, 2449,
-	7, 87, 2, 2, 2449, 2450, 7, 84, 2, 2, 2450, 2451, 7, 84, 2, 2, 2451, 2452,
-	7, 71, 2, 2, 2452, 2453, 7, 80, 2, 2, 2453, 2454, 7, 86, 2, 2, 2454, 66,
-	3, 2, 2, 2, 2455, 2456, 7, 69, 2, 2, 2456, 2457, 7, 87, 2, 2, 2457, 2458,
-	7, 84, 2, 2, 2458, 2459, 7, 84, 2, 2, 2459, 2460, 7, 71, 2, 2, 2460, 2461,
-	7, 80, 2, 2, 2461, 2462, 7, 86, 2, 2, 2462, 2463, 7, 97, 2, 2, 2463, 2464,
-	7, 87, 2, 2, 2464, 2465, 7, 85, 2, 2, 2465, 2466, 7, 71, 2, 2, 2466, 2467,
-	7, 84, 2, 2, 2467, 68, 3, 2, 2, 2, 2468, 2469, 7, 69, 2, 2, 2469, 2470,
-	7, 87, 2, 2, 2470, 2471, 7, 84, 2, 2, 2471, 2472, 7, 85, 2, 2, 2472, 2473,
-	7, 81, 2, 2, 2473, 2474, 7, 84, 2, 2, 2474, 70, 3, 2, 2, 2, 2475, 2476,
-	7, 70, 2, 2, 2476, 2477, 7, 67, 2, 2, 2477, 2478, 7, 86, 2, 2, 2478, 2479,
-	7, 67, 2, 2, 2479, 2480, 7, 68, 2, 2, 2480, 2481, 7, 67, 2, 2, 2481, 2482,
-	7, 85, 2, 2, 2482, 2483, 7, 71, 2, 2, 2483, 72, 3, 2, 2, 2, 2484, 2485,
-	7, 70, 2, 2, 2485, 2486, 7, 67, 2, 2, 2486, 2487, 7, 86, 2, 2, 2487, 2488,
-	7, 67, 2, 2, 2488, 2489, 7, 68, 2, 2, 2489, 2490, 7, 67, 2, 2, 2490, 2491,
-	7, 85, 2, 2, 2491, 2492, 7, 71, 2, 2, 2492, 2493, 7, 85, 2, 2, 2493, 74,
-	3, 2, 2, 2, 2494, 2495, 7, 70, 2, 2, 2495, 2496, 7, 71, 2, 2, 2496, 2497,
-	7, 69, 2, 2, 2497, 2498, 7, 78, 2, 2, 2498, 2499, 7, 67, 2, 2, 2499, 2500,
-	7, 84, 2, 2, 2500, 2501, 7, 71, 2, 2, 2501, 76, 3, 2, 2, 2, 2502, 2503,
-	7, 70, 2, 2, 2503, 2504, 7, 71, 2, 2, 2504, 2505, 7, 72, 2, 2, 2505, 2506,
-	7, 67, 2, 2, 2506, 2507, 7, 87, 2, 2, 2507, 2508, 7, 78, 2, 2, 2508, 2509,
-	7, 86, 2, 2, 2509, 78, 3, 2, 2, 2, 2510, 2511, 7, 70, 2, 2, 2511, 2512,
-	7, 71, 2, 2, 2512, 2513, 7, 78, 2, 2, 2513, 2514, 7, 67, 2, 2, 2514, 2515,
-	7, 91, 2, 2, 2515, 2516, 7, 71, 2, 2, 2516, 2517, 7, 70, 2, 2, 2517, 80,
-	3, 2, 2, 2, 2518, 2519, 7, 70, 2, 2, 2519, 2520, 7, 71, 2, 2, 2520, 2521,
-	7, 78, 2, 2, 2521, 2522, 7, 71, 2, 2, 2522, 2523, 7, 86, 2, 2, 2523, 2524,
-	7, 71, 2, 2, 2524, 82, 3, 2, 2, 2, 2525, 2526, 7, 70, 2, 2, 2526, 2527,
-	7, 71, 2, 2, 2527, 2528, 7, 85, 2, 2, 2528, 2529, 7, 69, 2, 2, 2529, 84,
-	3, 2, 2, 2, 2530, 2531, 7, 70, 2, 2, 2531, 2532, 7, 71, 2, 2, 2532, 2533,
-	7, 85, 2, 2, 2533, 2534, 7, 69, 2, 2, 2534, 2535, 7, 84, 2, 2, 2535, 2536,
-	7, 75, 2, 2, 2536, 2537, 7, 68, 2, 2, 2537, 2538, 7, 71, 2, 2, 2538, 86,
-	3, 2, 2, 2, 2539, 2540, 7, 70, 2, 2, 2540, 2541, 7, 71, 2, 2, 2541, 2542,
-	7, 86, 2, 2, 2542, 2543, 7, 71, 2, 2, 2543, 2544, 7, 84, 2, 2, 2544, 2545,
-	7, 79, 2, 2, 2545, 2546, 7, 75, 2, 2, 2546, 2547, 7, 80, 2, 2, 2547, 2548,
-	7, 75, 2, 2, 2548, 2549, 7, 85, 2, 2, 2549, 2550, 7, 86, 2, 2, 2550, 2551,
-	7, 75, 2, 2, 2551, 2552, 7, 69, 2, 2, 2552, 88, 3, 2, 2, 2, 2553, 2554,
-	7, 70, 2, 2, 2554, 2555, 7, 75, 2, 2, 2555, 2556, 7, 67, 2, 2, 2556, 2557,
-	7, 73, 2, 2, 2557, 2558, 7, 80, 2, 2, 2558, 2559, 7, 81, 2, 2, 2559, 2560,
-	7, 85, 2, 2, 2560, 2561, 7, 86, 2, 2, 2561, 2562, 7, 75, 2, 2, 2562, 2563,
-	7, 69, 2, 2, 2563, 2564, 7, 85, 2, 2, 2564, 90, 3, 2, 2, 2, 2565, 2566,
-	7, 70, 2, 2, 2566, 2567, 7, 75, 2, 2, 2567, 2568, 7, 85, 2, 2, 2568, 2569,
-	7, 86, 2, 2, 2569, 2570, 7, 75, 2, 2, 2570, 2571, 7, 80, 2, 2, 2571, 2572,
-	7, 69, 2, 2, 2572, 2573, 7, 86, 2, 2, 2573, 92, 3, 2, 2, 2, 2574, 2575,
-	7, 70, 2, 2, 2575, 2576, 7, 75, 2, 2, 2576, 2577, 7, 85, 2, 2, 2577, 2578,
-	7, 86, 2, 2, 2578, 2579, 7, 75, 2, 2, 2579, 2580, 7, 80, 2, 2, 2580, 2581,
-	7, 69, 2, 2, 2581, 2582, 7, 86, 2, 2, 2582, 2583, 7, 84, 2, 2, 2583, 2584,
-	7, 81, 2, 2, 2584, 2585, 7, 89, 2, 2, 2585, 94, 3, 2, 2, 2, 2586, 2587,
-	7, 70, 2, 2, 2587, 2588, 7, 84, 2, 2, 2588, 2589, 7, 81, 2, 2, 2589, 2590,
-	7, 82, 2, 2, 2590, 96, 3, 2, 2, 2, 2591, 2592, 7, 71, 2, 2, 2592, 2593,
-	7, 67, 2, 2, 2593, 2594, 7, 69, 2, 2, 2594, 2595, 7, 74, 2, 2, 2595, 98,
-	3, 2, 2, 2, 2596, 2597, 7, 71, 2, 2, 2597, 2598, 7, 78, 2, 2, 2598, 2599,
-	7, 85, 2, 2, 2599, 2600, 7, 71, 2, 2, 2600, 100, 3, 2, 2, 2, 2601, 2602,
-	7, 71, 2, 2, 2602, 2603, 7, 78, 2, 2, 2603, 2604, 7, 85, 2, 2, 2604, 2605,
-	7, 71, 2, 2, 2605, 2606, 7, 75, 2, 2, 2606, 2607, 7, 72, 2, 2, 2607, 102,
-	3, 2, 2, 2, 2608, 2609, 7, 71, 2, 2, 2609, 2610, 7, 79, 2, 2, 2610, 2611,
-	7, 82, 2, 2, 2611, 2612, 7, 86, 2, 2, 2612, 2613, 7, 91, 2, 2, 2613, 104,
-	3, 2, 2, 2, 2614, 2615, 7, 71, 2, 2, 2615, 2616, 7, 80, 2, 2, 2616, 2617,
-	7, 69, 2, 2, 2617, 2618, 7, 78, 2, 2, 2618, 2619, 7, 81, 2, 2, 2619, 2620,
-	7, 85, 2, 2, 2620, 2621, 7, 71, 2, 2, 2621, 2622, 7, 70, 2, 2, 2622, 106,
-	3, 2, 2, 2, 2623, 2624, 7, 71, 2, 2, 2624, 2625, 7, 85, 2, 2, 2625, 2626,
-	7, 69, 2, 2, 2626, 2627, 7, 67, 2, 2, 2627, 2628, 7, 82, 2, 2, 2628, 2629,
-	7, 71, 2, 2, 2629, 2630, 7, 70, 2, 2, 2630, 108, 3, 2, 2, 2, 2631, 2632,
-	7, 71, 2, 2, 2632, 2633, 7, 90, 2, 2, 2633, 2634, 7, 75, 2, 2, 2634, 2635,
-	7, 85, 2, 2, 2635, 2636, 7, 86, 2, 2, 2636, 2637, 7, 85, 2, 2, 2637, 110,
-	3, 2, 2, 2, 2638, 2639, 7, 71, 2, 2, 2639, 2640, 7, 90, 2, 2, 2640, 2641,
-	7, 75, 2, 2, 2641, 2642, 7, 86, 2, 2, 2642, 112, 3, 2, 2, 2, 2643, 2644,
-	7, 71, 2, 2, 2644, 2645, 7, 90, 2, 2, 2645, 2646, 7, 82, 2, 2, 2646, 2647,
-	7, 78, 2, 2, 2647, 2648, 7, 67, 2, 2, 2648, 2649, 7, 75, 2, 2, 2649, 2650,
-	7, 80, 2, 2, 2650, 114, 3, 2, 2, 2, 2651, 2652, 7, 72, 2, 2, 2652, 2653,
-	7, 67, 2, 2, 2653, 2654, 7, 78, 2, 2, 2654, 2655, 7, 85, 2, 2, 2655, 2656,
-	7, 71, 2, 2, 2656, 116, 3, 2, 2, 2, 2657, 2658, 7, 72, 2, 2, 2658, 2659,
-	7, 71, 2, 2, 2659, 2660, 7, 86, 2, 2, 2660, 2661, 7, 69, 2, 2, 2661, 2662,
-	7, 74, 2, 2, 2662, 118, 3, 2, 2, 2, 2663, 2664, 7, 72, 2, 2, 2664, 2665,
-	7, 81, 2, 2, 2665, 2666, 7, 84, 2, 2, 2666, 120, 3, 2, 2, 2, 2667, 2668,
-	7, 72, 2, 2, 2668, 2669, 7, 81, 2, 2, 2669, 2670, 7, 84, 2, 2, 2670, 2671,
-	7, 69, 2, 2, 2671, 2672, 7, 71, 2, 2, 2672, 122, 3, 2, 2, 2, 2673, 2674,
-	7, 72, 2, 2, 2674, 2675, 7, 81, 2, 2, 2675, 2676, 7, 84, 2, 2, 2676, 2677,
-	7, 71, 2, 2, 2677, 2678, 7, 75, 2, 2, 2678, 2679, 7, 73, 2, 2, 2679, 2680,
-	7, 80, 2, 2, 2680, 124, 3, 2, 2, 2, 2681, 2682, 7, 72, 2, 2, 2682, 2683,
-	7, 84, 2, 2, 2683, 2684, 7, 81, 2, 2, 2684, 2685, 7, 79, 2, 2, 2685, 126,
-	3, 2, 2, 2, 2686, 2687, 7, 72, 2, 2, 2687, 2688, 7, 87, 2, 2, 2688, 2689,
-	7, 78, 2, 2, 2689, 2690, 7, 78, 2, 2, 2690, 2691, 7, 86, 2, 2, 2691, 2692,
-	7, 71, 2, 2, 2692, 2693, 7, 90, 2, 2, 2693, 2694, 7, 86, 2, 2, 2694, 128,
-	3, 2, 2, 2, 2695, 2696, 7, 73, 2, 2, 2696, 2697, 7, 71, 2, 2, 2697, 2698,
-	7, 80, 2, 2, 2698, 2699, 7, 71, 2, 2, 2699, 2700, 7, 84, 2, 2, 2700, 2701,
-	7, 67, 2, 2, 2701, 2702, 7, 86, 2, 2, 2702, 2703, 7, 71, 2, 2, 2703, 2704,
-	7, 70, 2, 2, 2704, 130, 3, 2, 2, 2, 2705, 2706, 7, 73, 2, 2, 2706, 2707,
-	7, 71, 2, 2, 2707, 2708, 7, 86, 2, 2, 2708, 132, 3, 2, 2, 2, 2709, 2710,
-	7, 73, 2, 2, 2710, 2711, 7, 84, 2, 2, 2711, 2712, 7, 67, 2, 2, 2712, 2713,
-	7, 80, 2, 2, 2713, 2714, 7, 86, 2, 2, 2714, 134, 3, 2, 2, 2, 2715, 2716,
-	7, 73, 2, 2, 2716, 2717, 7, 84, 2, 2, 2717, 2718, 7, 81, 2, 2, 2718, 2719,
-	7, 87, 2, 2, 2719, 2720, 7, 82, 2, 2, 2720, 136, 3, 2, 2, 2, 2721, 2722,
-	7, 74, 2, 2, 2722, 2723, 7, 67, 2, 2, 2723, 2724, 7, 88, 2, 2, 2724, 2725,
-	7, 75, 2, 2, 2725, 2726, 7, 80, 2, 2, 2726, 2727, 7, 73, 2, 2, 2727, 138,
-	3, 2, 2, 2, 2728, 2729, 7, 74, 2, 2, 2729, 2730, 7, 75, 2, 2, 2730, 2731,
-	7, 73, 2, 2, 2731, 2732, 7, 74, 2, 2, 2732, 2733, 7, 97, 2, 2, 2733, 2734,
-	7, 82, 2, 2, 2734, 2735, 7, 84, 2, 2, 2735, 2736, 7, 75, 2, 2, 2736, 2737,
-	7, 81, 2, 2, 2737, 2738, 7, 84, 2, 2, 2738, 2739, 7, 75, 2, 2, 2739, 2740,
-	7, 86, 2, 2, 2740, 2741, 7, 91, 2, 2, 2741, 140, 3, 2, 2, 2, 2742, 2743,
-	7, 75, 2, 2, 2743, 2744, 7, 72, 2, 2, 2744, 142, 3, 2, 2, 2, 2745, 2746,
-	7, 75, 2, 2, 2746, 2747, 7, 73, 2, 2, 2747, 2748, 7, 80, 2, 2, 2748, 2749,
-	7, 81, 2, 2, 2749, 2750, 7, 84, 2, 2, 2750, 2751, 7, 71, 2, 2, 2751, 144,
-	3, 2, 2, 2, 2752, 2753, 7, 75, 2, 2, 2753, 2754, 7, 80, 2, 2, 2754, 146,
-	3, 2, 2, 2, 2755, 2756, 7, 75, 2, 2, 2756, 2757, 7, 80, 2, 2, 2757, 2758,
-	7, 70, 2, 2, 2758, 2759, 7, 71, 2, 2, 2759, 2760, 7, 90, 2, 2, 2760, 148,
-	3, 2, 2, 2, 2761, 2762, 7, 75, 2, 2, 2762, 2763, 7, 80, 2, 2, 2763, 2764,
-	7, 72, 2, 2, 2764, 2765, 7, 75, 2, 2, 2765, 2766, 7, 78, 2, 2, 2766, 2767,
-	7, 71, 2, 2, 2767, 150, 3, 2, 2, 2, 2768, 2769, 7, 75, 2, 2, 2769, 2770,
-	7, 80, 2, 2, 2770, 2771, 7, 80, 2, 2, 2771, 2772, 7, 71, 2, 2, 2772, 2773,
-	7, 84, 2, 2, 2773, 152, 3, 2, 2, 2, 2774, 2775, 7, 75, 2, 2, 2775, 2776,
-	7, 80, 2, 2, 2776, 2777, 7, 81, 2, 2, 2777, 2778, 7, 87, 2, 2, 2778, 2779,
-	7, 86, 2, 2, 2779, 154, 3, 2, 2, 2, 2780, 2781, 7, 75, 2, 2, 2781, 2782,
-	7, 80, 2, 2, 2782, 2783, 7, 85, 2, 2, 2783, 2784, 7, 71, 2, 2, 2784, 2785,
-	7, 84, 2, 2, 2785, 2786, 7, 86, 2, 2, 2786, 156, 3, 2, 2, 2, 2787, 2788,
-	7, 75, 2, 2, 2788, 2789, 7, 80, 2, 2, 2789, 2790, 7, 86, 2, 2, 2790, 2791,
-	7, 71, 2, 2, 2791, 2792, 7, 84, 2, 2, 2792, 2793, 7, 88, 2, 2, 2793, 2794,
-	7, 67, 2, 2, 2794, 2795, 7, 78, 2, 2, 2795, 158, 3, 2, 2, 2, 2796, 2797,
-	7, 75, 2, 2, 2797, 2798, 7, 80, 2, 2, 2798, 2799, 7, 86, 2, 2, 2799, 2800,
-	7, 81, 2, 2, 2800, 160, 3, 2, 2, 2, 2801, 2802, 7, 75, 2, 2, 2802, 2803,
-	7, 85, 2, 2, 2803, 162, 3, 2, 2, 2, 2804, 2805, 7, 75, 2, 2, 2805, 2806,
-	7, 86, 2, 2, 2806, 2807, 7, 71, 2, 2, 2807, 2808, 7, 84, 2, 2, 2808, 2809,
-	7, 67, 2, 2, 2809, 2810, 7, 86, 2, 2, 2810, 2811, 7, 71, 2, 2, 2811, 164,
-	3, 2, 2, 2, 2812, 2813, 7, 76, 2, 2, 2813, 2814, 7, 81, 2, 2, 2814, 2815,
-	7, 75, 2, 2, 2815, 2816, 7, 80, 2, 2, 2816, 166, 3, 2, 2, 2, 2817, 2818,
-	7, 77, 2, 2, 2818, 2819, 7, 71, 2, 2, 2819, 2820, 7, 91, 2, 2, 2820, 168,
-	3, 2, 2, 2, 2821, 2822, 7, 77, 2, 2, 2822, 2823, 7, 71, 2, 2, 2823, 2824,
-	7, 91, 2, 2, 2824, 2825, 7, 85, 2, 2, 2825, 170, 3, 2, 2, 2, 2826, 2827,
-	7, 77, 2, 2, 2827, 2828, 7, 75, 2, 2, 2828, 2829, 7, 78, 2, 2, 2829, 2830,
-	7, 78, 2, 2, 2830, 172, 3, 2, 2, 2, 2831, 2832, 7, 78, 2, 2, 2832, 2833,
-	7, 71, 2, 2, 2833, 2834, 7, 67, 2, 2, 2834, 2835, 7, 70, 2, 2, 2835, 2836,
-	7, 75, 2, 2, 2836, 2837, 7, 80, 2, 2, 2837, 2838, 7, 73, 2, 2, 2838, 174,
-	3, 2, 2, 2, 2839, 2840, 7, 78, 2, 2, 2840, 2841, 7, 71, 2, 2, 2841, 2842,
-	7, 67, 2, 2, 2842, 2843, 7, 88, 2, 2, 2843, 2844, 7, 71, 2, 2, 2844, 176,
-	3, 2, 2, 2, 2845, 2846, 7, 78, 2, 2, 2846, 2847, 7, 71, 2, 2, 2847, 2848,
-	7, 72, 2, 2, 2848, 2849, 7, 86, 2, 2, 2849, 178, 3, 2, 2, 2, 2850, 2851,
-	7, 78, 2, 2, 2851, 2852, 7, 75, 2, 2, 2852, 2853, 7, 77, 2, 2, 2853, 2854,
-	7, 71, 2, 2, 2854, 180, 3, 2, 2, 2, 2855, 2856, 7, 78, 2, 2, 2856, 2857,
-	7, 75, 2, 2, 2857, 2858, 7, 79, 2, 2, 2858, 2859, 7, 75, 2, 2, 2859, 2860,
-	7, 86, 2, 2, 2860, 182, 3, 2, 2, 2, 2861, 2862, 7, 78, 2, 2, 2862, 2863,
-	7, 75, 2, 2, 2863, 2864, 7, 80, 2, 2, 2864, 2865, 7, 71, 2, 2, 2865, 2866,
-	7, 67, 2, 2, 2866, 2867, 7, 84, 2, 2, 2867, 184, 3, 2, 2, 2, 2868, 2869,
-	7, 78, 2, 2, 2869, 2870, 7, 75, 2, 2, 2870, 2871, 7, 80, 2, 2, 2871, 2872,
-	7, 71, 2, 2, 2872, 2873, 7, 85, 2, 2, 2873, 186, 3, 2, 2, 2, 2874, 2875,
-	7, 78, 2, 2, 2875, 2876, 7, 81, 2, 2, 2876, 2877, 7, 67, 2, 2, 2877, 2878,
-	7, 70, 2, 2, 2878, 188, 3, 2, 2, 2, 2879, 2880, 7, 78, 2, 2, 2880, 2881,
-	7, 81, 2, 2, 2881, 2882, 7, 69, 2, 2, 2882, 2883, 7, 77, 2, 2, 2883, 190,
-	3, 2, 2, 2, 2884, 2885, 7, 78, 2, 2, 2885, 2886, 7, 81, 2, 2, 2886, 2887,
-	7, 81, 2, 2, 2887, 2888, 7, 82, 2, 2, 2888, 192, 3, 2, 2, 2, 2889, 2890,
-	7, 78, 2, 2, 2890, 2891, 7, 81, 2, 2, 2891, 2892, 7, 89, 2, 2, 2892, 2893,
-	7, 97, 2, 2, 2893, 2894, 7, 82, 2, 2, 2894, 2895, 7, 84, 2, 2, 2895, 2896,
-	7, 75, 2, 2, 2896, 2897, 7, 81, 2, 2, 2897, 2898, 7, 84, 2, 2, 2898, 2899,
-	7, 75, 2, 2, 2899, 2900, 7, 86, 2, 2, 2900, 2901, 7, 91, 2, 2, 2901, 194,
-	3, 2, 2, 2, 2902, 2903, 7, 79, 2, 2, 2903, 2904, 7, 67, 2, 2, 2904, 2905,
-	7, 85, 2, 2, 2905, 2906, 7, 86, 2, 2, 2906, 2907, 7, 71, 2, 2, 2907, 2908,
-	7, 84, 2, 2, 2908, 2909, 7, 97, 2, 2, 2909, 2910, 7, 68, 2, 2, 2910, 2911,
-	7, 75, 2, 2, 2911, 2912, 7, 80, 2, 2, 2912, 2913, 7, 70, 2, 2, 2913, 196,
-	3, 2, 2, 2, 2914, 2915, 7, 79, 2, 2, 2915, 2916, 7, 67, 2, 2, 2916, 2917,
-	7, 85, 2, 2, 2917, 2918, 7, 86, 2, 2, 2918, 2919, 7, 71, 2, 2, 2919, 2920,
-	7, 84, 2, 2, 2920, 2921, 7, 97, 2, 2, 2921, 2922, 7, 85, 2, 2, 2922, 2923,
-	7, 85, 2, 2, 2923, 2924, 7, 78, 2, 2, 2924, 2925, 7, 97, 2, 2, 2925, 2926,
-	7, 88, 2, 2, 2926, 2927, 7, 71, 2, 2, 2927, 2928, 7, 84, 2, 2, 2928, 2929,
-	7, 75, 2, 2, 2929, 2930, 7, 72, 2, 2, 2930, 2931, 7, 91, 2, 2, 2931, 2932,
-	7, 97, 2, 2, 2932, 2933, 7, 85, 2, 2, 2933, 2934, 7, 71, 2, 2, 2934, 2935,
-	7, 84, 2, 2, 2935, 2936, 7, 88, 2, 2, 2936, 2937, 7, 71, 2, 2, 2937, 2938,
-	7, 84, 2, 2, 2938, 2939, 7, 97, 2, 2, 2939, 2940, 7, 69, 2, 2, 2940, 2941,
-	7, 71, 2, 2, 2941, 2942, 7, 84, 2, 2, 2942, 2943, 7, 86, 2, 2, 2943, 198,
-	3, 2, 2, 2, 2944, 2945, 7, 79, 2, 2, 2945, 2946, 7, 67, 2, 2, 2946, 2947,
-	7, 86, 2, 2, 2947, 2948, 7, 69, 2, 2, 2948, 2949, 7, 74, 2, 2, 2949, 200,
-	3, 2, 2, 2, 2950, 2951, 7, 79, 2, 2, 2951, 2952, 7, 67, 2, 2, 2952, 2953,
-	7, 90, 2, 2, 2953, 2954, 7, 88, 2, 2, 2954, 2955, 7, 67, 2, 2, 2955, 2956,
-	7, 78, 2, 2, 2956, 2957, 7, 87, 2, 2, 2957, 2958, 7, 71, 2, 2, 2958, 202,
-	3, 2, 2, 2, 2959, 2960, 7, 79, 2, 2, 2960, 2961, 7, 81, 2, 2, 2961, 2962,
-	7, 70, 2, 2, 2962, 2963, 7, 75, 2, 2, 2963, 2964, 7, 72, 2, 2, 2964, 2965,
-	7, 75, 2, 2, 2965, 2966, 7, 71, 2, 2, 2966, 2967, 7, 85, 2, 2, 2967, 204,
-	3, 2, 2, 2, 2968, 2969, 7, 80, 2, 2, 2969, 2970, 7, 67, 2, 2, 2970, 2971,
-	7, 86, 2, 2, 2971, 2972, 7, 87, 2, 2, 2972, 2973, 7, 84, 2, 2, 2973, 2974,
-	7, 67, 2, 2, 2974, 2975, 7, 78, 2, 2, 2975, 206, 3, 2, 2, 2, 2976, 2977,
-	7, 80, 2, 2, 2977, 2978, 7, 81, 2, 2, 2978, 2979, 7, 86, 2, 2, 2979, 208,
-	3, 2, 2, 2, 2980, 2981, 7, 80, 2, 2, 2981, 2982, 7, 81, 2, 2, 2982, 2983,
-	7, 97, 2, 2, 2983, 2984, 7, 89, 2, 2, 2984, 2985, 7, 84, 2, 2, 2985, 2986,
-	7, 75, 2, 2, 2986, 2987, 7, 86, 2, 2, 2987, 2988, 7, 71, 2, 2, 2988, 2989,
-	7, 97, 2, 2, 2989, 2990, 7, 86, 2, 2, 2990, 2991, 7, 81, 2, 2, 2991, 2992,
-	7, 97, 2, 2, 2992, 2993, 7, 68, 2, 2, 2993, 2994, 7, 75, 2, 2, 2994, 2995,
-	7, 80, 2, 2, 2995, 2996, 7, 78, 2, 2, 2996, 2997, 7, 81, 2, 2, 2997, 2998,
-	7, 73, 2, 2, 2998, 210, 3, 2, 2, 2, 2999, 3000, 5, 213, 107, 2, 3000, 212,
-	3, 2, 2, 2, 3001, 3002, 7, 80, 2, 2, 3002, 3003, 7, 87, 2, 2, 3003, 3004,
-	7, 78, 2, 2, 3004, 3005, 7, 78, 2, 2, 3005, 214, 3, 2, 2, 2, 3006, 3007,
-	7, 80, 2, 2, 3007, 3008, 7, 87, 2, 2, 3008, 3009, 7, 79, 2, 2, 3009, 3010,
-	7, 68, 2, 2, 3010, 3011, 7, 71, 2, 2, 3011, 3012, 7, 84, 2, 2, 3012, 216,
-	3, 2, 2, 2, 3013, 3014, 7, 81, 2, 2, 3014, 3015, 7, 80, 2, 2, 3015, 218,
-	3, 2, 2, 2, 3016, 3017, 7, 81, 2, 2, 3017, 3018, 7, 82, 2, 2, 3018, 3019,
-	7, 86, 2, 2, 3019, 3020, 7, 75, 2, 2, 3020, 3021, 7, 79, 2, 2, 3021, 3022,
-	7, 75, 2, 2, 3022, 3023, 7, 92, 2, 2, 3023, 3024, 7, 71, 2, 2, 3024, 220,
-	3, 2, 2, 2, 3025, 3026, 7, 81, 2, 2, 3026, 3027, 7, 82, 2, 2, 3027, 3028,
-	7, 86, 2, 2, 3028, 3029, 7, 75, 2, 2, 3029, 3030, 7, 81, 2, 2, 3030, 3031,
-	7, 80, 2, 2, 3031, 222, 3, 2, 2, 2, 3032, 3033, 7, 81, 2, 2, 3033, 3034,
-	7, 82, 2, 2, 3034, 3035, 7, 86, 2, 2, 3035, 3036, 7, 75, 2, 2, 3036, 3037,
-	7, 81, 2, 2, 3037, 3038, 7, 80, 2, 2, 3038, 3039, 7, 67, 2, 2, 3039, 3040,
-	7, 78, 2, 2, 3040, 3041, 7, 78, 2, 2, 3041, 3042, 7, 91, 2, 2, 3042, 224,
-	3, 2, 2, 2, 3043, 3044, 7, 81, 2, 2, 3044, 3045, 7, 84, 2, 2, 3045, 226,
-	3, 2, 2, 2, 3046, 3047, 7, 81, 2, 2, 3047, 3048, 7, 84, 2, 2, 3048, 3049,
-	7, 70, 2, 2, 3049, 3050, 7, 71, 2, 2, 3050, 3051, 7, 84, 2, 2, 3051, 228,
-	3, 2, 2, 2, 3052, 3053, 7, 81, 2, 2, 3053, 3054, 7, 87, 2, 2, 3054, 3055,
-	7, 86, 2, 2, 3055, 230, 3, 2, 2, 2, 3056, 3057, 7, 81, 2, 2, 3057, 3058,
-	7, 87, 2, 2, 3058, 3059, 7, 86, 2, 2, 3059, 3060, 7, 71, 2, 2, 3060, 3061,
-	7, 84, 2, 2, 3061, 232, 3, 2, 2, 2, 3062, 3063, 7, 81, 2, 2, 3063, 3064,
-	7, 87, 2, 2, 3064, 3065, 7, 86, 2, 2, 3065, 3066, 7, 72, 2, 2, 3066, 3067,
-	7, 75, 2, 2, 3067, 3068, 7, 78, 2, 2, 3068, 3069, 7, 71, 2, 2, 3069, 234,
-	3, 2, 2, 2, 3070, 3071, 7, 82, 2, 2, 3071, 3072, 7, 67, 2, 2, 3072, 3073,
-	7, 84, 2, 2, 3073, 3074, 7, 86, 2, 2, 3074, 3075, 7, 75, 2, 2, 3075, 3076,
-	7, 86, 2, 2, 3076, 3077, 7, 75, 2, 2, 3077, 3078, 7, 81, 2, 2, 3078, 3079,
-	7, 80, 2, 2, 3079, 236, 3, 2, 2, 2, 3080, 3081, 7, 82, 2, 2, 3081, 3082,
-	7, 84, 2, 2, 3082, 3083, 7, 75, 2, 2, 3083, 3084, 7, 79, 2, 2, 3084, 3085,
-	7, 67, 2, 2, 3085, 3086, 7, 84, 2, 2, 3086, 3087, 7, 91, 2, 2, 3087, 238,
-	3, 2, 2, 2, 3088, 3089, 7, 82, 2, 2, 3089, 3090, 7, 84, 2, 2, 3090, 3091,
-	7, 81, 2, 2, 3091, 3092, 7, 69, 2, 2, 3092, 3093, 7, 71, 2, 2, 3093, 3094,
-	7, 70, 2, 2, 3094, 3095, 7, 87, 2, 2, 3095, 3096, 7, 84, 2, 2, 3096, 3097,
-	7, 71, 2, 2, 3097, 240, 3, 2, 2, 2, 3098, 3099, 7, 82, 2, 2, 3099, 3100,
-	7, 87, 2, 2, 3100, 3101, 7, 84, 2, 2, 3101, 3102, 7, 73, 2, 2, 3102, 3103,
-	7, 71, 2, 2, 3103, 242, 3, 2, 2, 2, 3104, 3105, 7, 84, 2, 2, 3105, 3106,
-	7, 67, 2, 2, 3106, 3107, 7, 80, 2, 2, 3107, 3108, 7, 73, 2, 2, 3108, 3109,
-	7, 71, 2, 2, 3109, 244, 3, 2, 2, 2, 3110, 3111, 7, 84, 2, 2, 3111, 3112,
-	7, 71, 2, 2, 3112, 3113, 7, 67, 2, 2, 3113, 3114, 7, 70, 2, 2, 3114, 246,
-	3, 2, 2, 2, 3115, 3116, 7, 84, 2, 2, 3116, 3117, 7, 71, 2, 2, 3117, 3118,
-	7, 67, 2, 2, 3118, 3119, 7, 70, 2, 2, 3119, 3120, 7, 85, 2, 2, 3120, 248,
-	3, 2, 2, 2, 3121, 3122, 7, 84, 2, 2, 3122, 3123, 7, 71, 2, 2, 3123, 3124,
-	7, 72, 2, 2, 3124, 3125, 7, 71, 2, 2, 3125, 3126, 7, 84, 2, 2, 3126, 3127,
-	7, 71, 2, 2, 3127, 3128, 7, 80, 2, 2, 3128, 3129, 7, 69, 2, 2, 3129, 3130,
-	7, 71, 2, 2, 3130, 3131, 7, 85, 2, 2, 3131, 250, 3, 2, 2, 2, 3132, 3133,
-	7, 84, 2, 2, 3133, 3134, 7, 71, 2, 2, 3134, 3135, 7, 73, 2, 2, 3135, 3136,
-	7, 71, 2, 2, 3136, 3137, 7, 90, 2, 2, 3137, 3138, 7, 82, 2, 2, 3138, 252,
-	3, 2, 2, 2, 3139, 3140, 7, 84, 2, 2, 3140, 3141, 7, 71, 2, 2, 3141, 3142,
-	7, 78, 2, 2, 3142, 3143, 7, 71, 2, 2, 3143, 3144, 7, 67, 2, 2, 3144, 3145,
-	7, 85, 2, 2, 3145, 3146, 7, 71, 2, 2, 3146, 254, 3, 2, 2, 2, 3147, 3148,
-	7, 84, 2, 2, 3148, 3149, 7, 71, 2, 2, 3149, 3150, 7, 80, 2, 2, 3150, 3151,
-	7, 67, 2, 2, 3151, 3152, 7, 79, 2, 2, 3152, 3153, 7, 71, 2, 2, 3153, 256,
-	3, 2, 2, 2, 3154, 3155, 7, 84, 2, 2, 3155, 3156, 7, 71, 2, 2, 3156, 3157,
-	7, 82, 2, 2, 3157, 3158, 7, 71, 2, 2, 3158, 3159, 7, 67, 2, 2, 3159, 3160,
-	7, 86, 2, 2, 3160, 258, 3, 2, 2, 2, 3161, 3162, 7, 84, 2, 2, 3162, 3163,
-	7, 71, 2, 2, 3163, 3164, 7, 82, 2, 2, 3164, 3165, 7, 78, 2, 2, 3165, 3166,
-	7, 67, 2, 2, 3166, 3167, 7, 69, 2, 2, 3167, 3168, 7, 71, 2, 2, 3168, 260,
-	3, 2, 2, 2, 3169, 3170, 7, 84, 2, 2, 3170, 3171, 7, 71, 2, 2, 3171, 3172,
-	7, 83, 2, 2, 3172, 3173, 7, 87, 2, 2, 3173, 3174, 7, 75, 2, 2, 3174, 3175,
-	7, 84, 2, 2, 3175, 3176, 7, 71, 2, 2, 3176, 262, 3, 2, 2, 2, 3177, 3178,
-	7, 84, 2, 2, 3178, 3179, 7, 71, 2, 2, 3179, 3180, 7, 85, 2, 2, 3180, 3181,
-	7, 75, 2, 2, 3181, 3182, 7, 73, 2, 2, 3182, 3183, 7, 80, 2, 2, 3183, 3184,
-	7, 67, 2, 2, 3184, 3185, 7, 78, 2, 2, 3185, 264, 3, 2, 2, 2, 3186, 3187,
-	7, 84, 2, 2, 3187, 3188, 7, 71, 2, 2, 3188, 3189, 7, 85, 2, 2, 3189, 3190,
-	7, 86, 2, 2, 3190, 3191, 7, 84, 2, 2, 3191, 3192, 7, 75, 2, 2, 3192, 3193,
-	7, 69, 2, 2, 3193, 3194, 7, 86, 2, 2, 3194, 266, 3, 2, 2, 2, 3195, 3196,
-	7, 84, 2, 2, 3196, 3197, 7, 71, 2, 2, 3197, 3198, 7, 86, 2, 2, 3198, 3199,
-	7, 87, 2, 2, 3199, 3200, 7, 84, 2, 2, 3200, 3201, 7, 80, 2, 2, 3201, 268,
-	3, 2, 2, 2, 3202, 3203, 7, 84, 2, 2, 3203, 3204, 7, 71, 2, 2, 3204, 3205,
-	7, 88, 2, 2, 3205, 3206, 7, 81, 2, 2, 3206, 3207, 7, 77, 2, 2, 3207, 3208,
-	7, 71, 2, 2, 3208, 270, 3, 2, 2, 2, 3209, 3210, 7, 84, 2, 2, 3210, 3211,
-	7, 75, 2, 2, 3211, 3212, 7, 73, 2, 2, 3212, 3213, 7, 74, 2, 2, 3213, 3214,
-	7, 86, 2, 2, 3214, 272, 3, 2, 2, 2, 3215, 3216, 7, 84, 2, 2, 3216, 3217,
-	7, 78, 2, 2, 3217, 3218, 7, 75, 2, 2, 3218, 3219, 7, 77, 2, 2, 3219, 3220,
-	7, 71, 2, 2, 3220, 274, 3, 2, 2, 2, 3221, 3222, 7, 85, 2, 2, 3222, 3223,
-	7, 69, 2, 2, 3223, 3224, 7, 74, 2, 2, 3224, 3225, 7, 71, 2, 2, 3225, 3226,
-	7, 79, 2, 2, 3226, 3227, 7, 67, 2, 2, 3227, 276, 3, 2, 2, 2, 3228, 3229,
-	7, 85, 2, 2, 3229, 3230, 7, 69, 2, 2, 3230, 3231, 7, 74, 2, 2, 3231, 3232,
-	7, 71, 2, 2, 3232, 3233, 7, 79, 2, 2, 3233, 3234, 7, 67, 2, 2, 3234, 3235,
-	7, 85, 2, 2, 3235, 278, 3, 2, 2, 2, 3236, 3237, 7, 85, 2, 2, 3237, 3238,
-	7, 71, 2, 2, 3238, 3239, 7, 78, 2, 2, 3239, 3240, 7, 71, 2, 2, 3240, 3241,
-	7, 69, 2, 2, 3241, 3242, 7, 86, 2, 2, 3242, 280, 3, 2, 2, 2, 3243, 3244,
-	7, 85, 2, 2, 3244, 3245, 7, 71, 2, 2, 3245, 3246, 7, 86, 2, 2, 3246, 282,
-	3, 2, 2, 2, 3247, 3248, 7, 85, 2, 2, 3248, 3249, 7, 71, 2, 2, 3249, 3250,
-	7, 82, 2, 2, 3250, 3251, 7, 67, 2, 2, 3251, 3252, 7, 84, 2, 2, 3252, 3253,
-	7, 67, 2, 2, 3253, 3254, 7, 86, 2, 2, 3254, 3255, 7, 81, 2, 2, 3255, 3256,
-	7, 84, 2, 2, 3256, 284, 3, 2, 2, 2, 3257, 3258, 7, 85, 2, 2, 3258, 3259,
-	7, 74, 2, 2, 3259, 3260, 7, 81, 2, 2, 3260, 3261, 7, 89, 2, 2, 3261, 286,
-	3, 2, 2, 2, 3262, 3263, 7, 85, 2, 2, 3263, 3264, 7, 75, 2, 2, 3264, 3265,
-	7, 73, 2, 2, 3265, 3266, 7, 80, 2, 2, 3266, 3267, 7, 67, 2, 2, 3267, 3268,
-	7, 78, 2, 2, 3268, 288, 3, 2, 2, 2, 3269, 3270, 7, 85, 2, 2, 3270, 3271,
-	7, 82, 2, 2, 3271, 3272, 7, 67, 2, 2, 3272, 3273, 7, 86, 2, 2, 3273, 3274,
-	7, 75, 2, 2, 3274, 3275, 7, 67, 2, 2, 3275, 3276, 7, 78, 2, 2, 3276, 290,
-	3, 2, 2, 2, 3277, 3278, 7, 85, 2, 2, 3278, 3279, 7, 83, 2, 2, 3279, 3280,
-	7, 78, 2, 2, 3280, 292, 3, 2, 2, 2, 3281, 3282, 7, 85, 2, 2, 3282, 3283,
-	7, 83, 2, 2, 3283, 3284, 7, 78, 2, 2, 3284, 3285, 7, 71, 2, 2, 3285, 3286,
-	7, 90, 2, 2, 3286, 3287, 7, 69, 2, 2, 3287, 3288, 7, 71, 2, 2, 3288, 3289,
-	7, 82, 2, 2, 3289, 3290, 7, 86, 2, 2, 3290, 3291, 7, 75, 2, 2, 3291, 3292,
-	7, 81, 2, 2, 3292, 3293, 7, 80, 2, 2, 3293, 294, 3, 2, 2, 2, 3294, 3295,
-	7, 85, 2, 2, 3295, 3296, 7, 83, 2, 2, 3296, 3297, 7, 78, 2, 2, 3297, 3298,
-	7, 85, 2, 2, 3298, 3299, 7, 86, 2, 2, 3299, 3300, 7, 67, 2, 2, 3300, 3301,
-	7, 86, 2, 2, 3301, 3302, 7, 71, 2, 2, 3302, 296, 3, 2, 2, 2, 3303, 3304,
-	7, 85, 2, 2, 3304, 3305, 7, 83, 2, 2, 3305, 3306, 7, 78, 2, 2, 3306, 3307,
-	7, 89, 2, 2, 3307, 3308, 7, 67, 2, 2, 3308, 3309, 7, 84, 2, 2, 3309, 3310,
-	7, 80, 2, 2, 3310, 3311, 7, 75, 2, 2, 3311, 3312, 7, 80, 2, 2, 3312, 3313,
-	7, 73, 2, 2, 3313, 298, 3, 2, 2, 2, 3314, 3315, 7, 85, 2, 2, 3315, 3316,
-	7, 83, 2, 2, 3316, 3317, 7, 78, 2, 2, 3317, 3318, 7, 97, 2, 2, 3318, 3319,
-	7, 68, 2, 2, 3319, 3320, 7, 75, 2, 2, 3320, 3321, 7, 73, 2, 2, 3321, 3322,
-	7, 97, 2, 2, 3322, 3323, 7, 84, 2, 2, 3323, 3324, 7, 71, 2, 2, 3324, 3325,
-	7, 85, 2, 2, 3325, 3326, 7, 87, 2, 2, 3326, 3327, 7, 78, 2, 2, 3327, 3328,
-	7, 86, 2, 2, 3328, 300, 3, 2, 2, 2, 3329, 3330, 7, 85, 2, 2, 3330, 3331,
-	7, 83, 2, 2, 3331, 3332, 7, 78, 2, 2, 3332, 3333, 7, 97, 2, 2, 3333, 3334,
-	7, 69, 2, 2, 3334, 3335, 7, 67, 2, 2, 3335, 3336, 7, 78, 2, 2, 3336, 3337,
-	7, 69, 2, 2, 3337, 3338, 7, 97, 2, 2, 3338, 3339, 7, 72, 2, 2, 3339, 3340,
-	7, 81, 2, 2, 3340, 3341, 7, 87, 2, 2, 3341, 3342, 7, 80, 2, 2, 3342, 3343,
-	7, 70, 2, 2, 3343, 3344, 7, 97, 2, 2, 3344, 3345, 7, 84, 2, 2, 3345, 3346,
-	7, 81, 2, 2, 3346, 3347, 7, 89, 2, 2, 3347, 3348, 7, 85, 2, 2, 3348, 302,
-	3, 2, 2, 2, 3349, 3350, 7, 85, 2, 2, 3350, 3351, 7, 83, 2, 2, 3351, 3352,
-	7, 78, 2, 2, 3352, 3353, 7, 97, 2, 2, 3353, 3354, 7, 85, 2, 2, 3354, 3355,
-	7, 79, 2, 2, 3355, 3356, 7, 67, 2, 2, 3356, 3357, 7, 78, 2, 2, 3357, 3358,
-	7, 78, 2, 2, 3358, 3359, 7, 97, 2, 2, 3359, 3360, 7, 84, 2, 2, 3360, 3361,
-	7, 71, 2, 2, 3361, 3362, 7, 85, 2, 2, 3362, 3363, 7, 87, 2, 2, 3363, 3364,
-	7, 78, 2, 2, 3364, 3365, 7, 86, 2, 2, 3365, 304, 3, 2, 2, 2, 3366, 3367,
-	7, 85, 2, 2, 3367, 3368, 7, 85, 2, 2, 3368, 3369, 7, 78, 2, 2, 3369, 306,
-	3, 2, 2, 2, 3370, 3371, 7, 85, 2, 2, 3371, 3372, 7, 86, 2, 2, 3372, 3373,
-	7, 67, 2, 2, 3373, 3374, 7, 69, 2, 2, 3374, 3375, 7, 77, 2, 2, 3375, 3376,
-	7, 71, 2, 2, 3376, 3377, 7, 70, 2, 2, 3377, 308, 3, 2, 2, 2, 3378, 3379,
-	7, 85, 2, 2, 3379, 3380, 7, 86, 2, 2, 3380, 3381, 7, 67, 2, 2, 3381, 3382,
-	7, 84, 2, 2, 3382, 3383, 7, 86, 2, 2, 3383, 3384, 7, 75, 2, 2, 3384, 3385,
-	7, 80, 2, 2, 3385, 3386, 7, 73, 2, 2, 3386, 310, 3, 2, 2, 2, 3387, 3388,
-	7, 85, 2, 2, 3388, 3389, 7, 86, 2, 2, 3389, 3390, 7, 84, 2, 2, 3390, 3391,
-	7, 67, 2, 2, 3391, 3392, 7, 75, 2, 2, 3392, 3393, 7, 73, 2, 2, 3393, 3394,
-	7, 74, 2, 2, 3394, 3395, 7, 86, 2, 2, 3395, 3396, 7, 97, 2, 2, 3396, 3397,
-	7, 76, 2, 2, 3397, 3398, 7, 81, 2, 2, 3398, 3399, 7, 75, 2, 2, 3399, 3400,
-	7, 80, 2, 2, 3400, 312, 3, 2, 2, 2, 3401, 3402, 7, 86, 2, 2, 3402, 3403,
-	7, 67, 2, 2, 3403, 3404, 7, 68, 2, 2, 3404, 3405, 7, 78, 2, 2, 3405, 3406,
-	7, 71, 2, 2, 3406, 314, 3, 2, 2, 2, 3407, 3408, 7, 86, 2, 2, 3408, 3409,
-	7, 71, 2, 2, 3409, 3410, 7, 84, 2, 2, 3410, 3411, 7, 79, 2, 2, 3411, 3412,
-	7, 75, 2, 2, 3412, 3413, 7, 80, 2, 2, 3413, 3414, 7, 67, 2, 2, 3414, 3415,
-	7, 86, 2, 2, 3415, 3416, 7, 71, 2, 2, 3416, 3417, 7, 70, 2, 2, 3417, 316,
-	3, 2, 2, 2, 3418, 3419, 7, 86, 2, 2, 3419, 3420, 7, 74, 2, 2, 3420, 3421,
-	7, 71, 2, 2, 3421, 3422, 7, 80, 2, 2, 3422, 318, 3, 2, 2, 2, 3423, 3424,
-	7, 86, 2, 2, 3424, 3425, 7, 81, 2, 2, 3425, 320, 3, 2, 2, 2, 3426, 3427,
-	7, 86, 2, 2, 3427, 3428, 7, 84, 2, 2, 3428, 3429, 7, 67, 2, 2, 3429, 3430,
-	7, 75, 2, 2, 3430, 3431, 7, 78, 2, 2, 3431, 3432, 7, 75, 2, 2, 3432, 3433,
-	7, 80, 2, 2, 3433, 3434, 7, 73, 2, 2, 3434, 322, 3, 2, 2, 2, 3435, 3436,
-	7, 86, 2, 2, 3436, 3437, 7, 84, 2, 2, 3437, 3438, 7, 75, 2, 2, 3438, 3439,
-	7, 73, 2, 2, 3439, 3440, 7, 73, 2, 2, 3440, 3441, 7, 71, 2, 2, 3441, 3442,
-	7, 84, 2, 2, 3442, 324, 3, 2, 2, 2, 3443, 3444, 7, 86, 2, 2, 3444, 3445,
-	7, 84, 2, 2, 3445, 3446, 7, 87, 2, 2, 3446, 3447, 7, 71, 2, 2, 3447, 326,
-	3, 2, 2, 2, 3448, 3449, 7, 87, 2, 2, 3449, 3450, 7, 80, 2, 2, 3450, 3451,
-	7, 70, 2, 2, 3451, 3452, 7, 81, 2, 2, 3452, 328, 3, 2, 2, 2, 3453, 3454,
-	7, 87, 2, 2, 3454, 3455, 7, 80, 2, 2, 3455, 3456, 7, 75, 2, 2, 3456, 3457,
-	7, 81, 2, 2, 3457, 3458, 7, 80, 2, 2, 3458, 330, 3, 2, 2, 2, 3459, 3460,
-	7, 87, 2, 2, 3460, 3461, 7, 80, 2, 2, 3461, 3462, 7, 75, 2, 2, 3462, 3463,
-	7, 83, 2, 2, 3463, 3464, 7, 87, 2, 2, 3464, 3465, 7, 71, 2, 2, 3465, 332,
-	3, 2, 2, 2, 3466, 3467, 7, 87, 2, 2, 3467, 3468, 7, 80, 2, 2, 3468, 3469,
-	7, 78, 2, 2, 3469, 3470, 7, 81, 2, 2, 3470, 3471, 7, 69, 2, 2, 3471, 3472,
-	7, 77, 2, 2, 3472, 334, 3, 2, 2, 2, 3473, 3474, 7, 87, 2, 2, 3474, 3475,
-	7, 80, 2, 2, 3475, 3476, 7, 85, 2, 2, 3476, 3477, 7, 75, 2, 2, 3477, 3478,
-	7, 73, 2, 2, 3478, 3479, 7, 80, 2, 2, 3479, 3480, 7, 71, 2, 2, 3480, 3481,
-	7, 70, 2, 2, 3481, 336, 3, 2, 2, 2, 3482, 3483, 7, 87, 2, 2, 3483, 3484,
-	7, 82, 2, 2, 3484, 3485, 7, 70, 2, 2, 3485, 3486, 7, 67, 2, 2, 3486, 3487,
-	7, 86, 2, 2, 3487, 3488, 7, 71, 2, 2, 3488, 338, 3, 2, 2, 2, 3489, 3490,
-	7, 87, 2, 2, 3490, 3491, 7, 85, 2, 2, 3491, 3492, 7, 67, 2, 2, 3492, 3493,
-	7, 73, 2, 2, 3493, 3494, 7, 71, 2, 2, 3494, 340, 3, 2, 2, 2, 3495, 3496,
-	7, 87, 2, 2, 3496, 3497, 7, 85, 2, 2, 3497, 3498, 7, 71, 2, 2, 3498, 342,
-	3, 2, 2, 2, 3499, 3500, 7, 87, 2, 2, 3500, 3501, 7, 85, 2, 2, 3501, 3502,
-	7, 75, 2, 2, 3502, 3503, 7, 80, 2, 2, 3503, 3504, 7, 73, 2, 2, 3504, 344,
-	3, 2, 2, 2, 3505, 3506, 7, 88, 2, 2, 3506, 3507, 7, 67, 2, 2, 3507, 3508,
-	7, 78, 2, 2, 3508, 3509, 7, 87, 2, 2, 3509, 3510, 7, 71, 2, 2, 3510, 3511,
-	7, 85, 2, 2, 3511, 346, 3, 2, 2, 2, 3512, 3513, 7, 89, 2, 2, 3513, 3514,
-	7, 74, 2, 2, 3514, 3515, 7, 71, 2, 2, 3515, 3516, 7, 80, 2, 2, 3516, 348,
-	3, 2, 2, 2, 3517, 3518, 7, 89, 2, 2, 3518, 3519, 7, 74, 2, 2, 3519, 3520,
-	7, 71, 2, 2, 3520, 3521, 7, 84, 2, 2, 3521, 3522, 7, 71, 2, 2, 3522, 350,
-	3, 2, 2, 2, 3523, 3524, 7, 89, 2, 2, 3524, 3525, 7, 74, 2, 2, 3525, 3526,
-	7, 75, 2, 2, 3526, 3527, 7, 78, 2, 2, 3527, 3528, 7, 71, 2, 2, 3528, 352,
-	3, 2, 2, 2, 3529, 3530, 7, 89, 2, 2, 3530, 3531, 7, 75, 2, 2, 3531, 3532,
-	7, 86, 2, 2, 3532, 3533, 7, 74, 2, 2, 3533, 354, 3, 2, 2, 2, 3534, 3535,
-	7, 89, 2, 2, 3535, 3536, 7, 84, 2, 2, 3536, 3537, 7, 75, 2, 2, 3537, 3538,
-	7, 86, 2, 2, 3538, 3539, 7, 71, 2, 2, 3539, 356, 3, 2, 2, 2, 3540, 3541,
-	7, 90, 2, 2, 3541, 3542, 7, 81, 2, 2, 3542, 3543, 7, 84, 2, 2, 3543, 358,
-	3, 2, 2, 2, 3544, 3545, 7, 92, 2, 2, 3545, 3546, 7, 71, 2, 2, 3546, 3547,
-	7, 84, 2, 2, 3547, 3548, 7, 81, 2, 2, 3548, 3549, 7, 72, 2, 2, 3549, 3550,
-	7, 75, 2, 2, 3550, 3551, 7, 78, 2, 2, 3551, 3552, 7, 78, 2, 2, 3552, 360,
-	3, 2, 2, 2, 3553, 3554, 7, 86, 2, 2, 3554, 3555, 7, 75, 2, 2, 3555, 3556,
-	7, 80, 2, 2, 3556, 3557, 7, 91, 2, 2, 3557, 3558, 7, 75, 2, 2, 3558, 3559,
-	7, 80, 2, 2, 3559, 3560, 7, 86, 2, 2, 3560, 362, 3, 2, 2, 2, 3561, 3562,
-	7, 85, 2, 2, 3562, 3563, 7, 79, 2, 2, 3563, 3564, 7, 67, 2, 2, 3564, 3565,
-	7, 78, 2, 2, 3565, 3566, 7, 78, 2, 2, 3566, 3567, 7, 75, 2, 2, 3567, 3568,
-	7, 80, 2, 2, 3568, 3569, 7, 86, 2, 2, 3569, 364, 3, 2, 2, 2, 3570, 3571,
-	7, 79, 2, 2, 3571, 3572, 7, 71, 2, 2, 3572, 3573, 7, 70, 2, 2, 3573, 3574,
-	7, 75, 2, 2, 3574, 3575, 7, 87, 2, 2, 3575, 3576, 7, 79, 2, 2, 3576, 3577,
-	7, 75, 2, 2, 3577, 3578, 7, 80, 2, 2, 3578, 3579, 7, 86, 2, 2, 3579, 366,
-	3, 2, 2, 2, 3580, 3581, 7, 79, 2, 2, 3581, 3582, 7, 75, 2, 2, 3582, 3583,
-	7, 70, 2, 2, 3583, 3584, 7, 70, 2, 2, 3584, 3585, 7, 78, 2, 2, 3585, 3586,
-	7, 71, 2, 2, 3586, 3587, 7, 75, 2, 2, 3587, 3588, 7, 80, 2, 2, 3588, 3589,
-	7, 86, 2, 2, 3589, 368, 3, 2, 2, 2, 3590, 3591, 7, 75, 2, 2, 3591, 3592,
-	7, 80, 2, 2, 3592, 3593, 7, 86, 2, 2, 3593, 370, 3, 2, 2, 2, 3594, 3595,
-	7, 75, 2, 2, 3595, 3596, 7, 80, 2, 2, 3596, 3597, 7, 86, 2, 2, 3597, 3598,
-	7, 51, 2, 2, 3598, 372, 3, 2, 2, 2, 3599, 3600, 7, 75, 2, 2, 3600, 3601,
-	7, 80, 2, 2, 3601, 3602, 7, 86, 2, 2, 3602, 3603, 7, 52, 2, 2, 3603, 374,
-	3, 2, 2, 2, 3604, 3605, 7, 75, 2, 2, 3605, 3606, 7, 80, 2, 2, 3606, 3607,
-	7, 86, 2, 2, 3607, 3608, 7, 53, 2, 2, 3608, 376, 3, 2, 2, 2, 3609, 3610,
-	7, 75, 2, 2, 3610, 3611, 7, 80, 2, 2, 3611, 3612, 7, 86, 2, 2, 3612, 3613,
-	7, 54, 2, 2, 3613, 378, 3, 2, 2, 2, 3614, 3615, 7, 75, 2, 2, 3615, 3616,
-	7, 80, 2, 2, 3616, 3617, 7, 86, 2, 2, 3617, 3618, 7, 58, 2, 2, 3618, 380,
-	3, 2, 2, 2, 3619, 3620, 7, 75, 2, 2, 3620, 3621, 7, 80, 2, 2, 3621, 3622,
-	7, 86, 2, 2, 3622, 3623, 7, 71, 2, 2, 3623, 3624, 7, 73, 2, 2, 3624, 3625,
-	7, 71, 2, 2, 3625, 3626, 7, 84, 2, 2, 3626, 382, 3, 2, 2, 2, 3627, 3628,
-	7, 68, 2, 2, 3628, 3629, 7, 75, 2, 2, 3629, 3630, 7, 73, 2, 2, 3630, 3631,
-	7, 75, 2, 2, 3631, 3632, 7, 80, 2, 2, 3632, 3633, 7, 86, 2, 2, 3633, 384,
-	3, 2, 2, 2, 3634, 3635, 7, 84, 2, 2, 3635, 3636, 7, 71, 2, 2, 3636, 3637,
-	7, 67, 2, 2, 3637, 3638, 7, 78, 2, 2, 3638, 386, 3, 2, 2, 2, 3639, 3640,
-	7, 70, 2, 2, 3640, 3641, 7, 81, 2, 2, 3641, 3642, 7, 87, 2, 2, 3642, 3643,
-	7, 68, 2, 2, 3643, 3644, 7, 78, 2, 2, 3644, 3645, 7, 71, 2, 2, 3645, 388,
-	3, 2, 2, 2, 3646, 3647, 7, 82, 2, 2, 3647, 3648, 7, 84, 2, 2, 3648, 3649,
-	7, 71, 2, 2, 3649, 3650, 7, 69, 2, 2, 3650, 3651, 7, 75, 2, 2, 3651, 3652,
-	7, 85, 2, 2, 3652, 3653, 7, 75, 2, 2, 3653, 3654, 7, 81, 2, 2, 3654, 3655,
-	7, 80, 2, 2, 3655, 390, 3, 2, 2, 2, 3656, 3657, 7, 72, 2, 2, 3657, 3658,
-	7, 78, 2, 2, 3658, 3659, 7, 81, 2, 2, 3659, 3660, 7, 67, 2, 2, 3660, 3661,
-	7, 86, 2, 2, 3661, 392, 3, 2, 2, 2, 3662, 3663, 7, 72, 2, 2, 3663, 3664,
-	7, 78, 2, 2, 3664, 3665, 7, 81, 2, 2, 3665, 3666, 7, 67, 2, 2, 3666, 3667,
-	7, 86, 2, 2, 3667, 3668, 7, 54, 2, 2, 3668, 394, 3, 2, 2, 2, 3669, 3670,
-	7, 72, 2, 2, 3670, 3671, 7, 78, 2, 2, 3671, 3672, 7, 81, 2, 2, 3672, 3673,
-	7, 67, 2, 2, 3673, 3674, 7, 86, 2, 2, 3674, 3675, 7, 58, 2, 2, 3675, 396,
-	3, 2, 2, 2, 3676, 3677, 7, 70, 2, 2, 3677, 3678, 7, 71, 2, 2, 3678, 3679,
-	7, 69, 2, 2, 3679, 3680, 7, 75, 2, 2, 3680, 3681, 7, 79, 2, 2, 3681, 3682,
-	7, 67, 2, 2, 3682, 3683, 7, 78, 2, 2, 3683, 398, 3, 2, 2, 2, 3684, 3685,
-	7, 70, 2, 2, 3685, 3686, 7, 71, 2, 2, 3686, 3687, 7, 69, 2, 2, 3687, 400,
-	3, 2, 2, 2, 3688, 3689, 7, 80, 2, 2, 3689, 3690, 7, 87, 2, 2, 3690, 3691,
-	7, 79, 2, 2, 3691, 3692, 7, 71, 2, 2, 3692, 3693, 7, 84, 2, 2, 3693, 3694,
-	7, 75, 2, 2, 3694, 3695, 7, 69, 2, 2, 3695, 402, 3, 2, 2, 2, 3696, 3697,
-	7, 70, 2, 2, 3697, 3698, 7, 67, 2, 2, 3698, 3699, 7, 86, 2, 2, 3699, 3700,
-	7, 71, 2, 2, 3700, 404, 3, 2, 2, 2, 3701, 3702, 7, 86, 2, 2, 3702, 3703,
-	7, 75, 2, 2, 3703, 3704, 7, 79, 2, 2, 3704, 3705, 7, 71, 2, 2, 3705, 406,
-	3, 2, 2, 2, 3706, 3707, 7, 86, 2, 2, 3707, 3708, 7, 75, 2, 2, 3708, 3709,
-	7, 79, 2, 2, 3709, 3710, 7, 71, 2, 2, 3710, 3711, 7, 85, 2, 2, 3711, 3712,
-	7, 86, 2, 2, 3712, 3713, 7, 67, 2, 2, 3713, 3714, 7, 79, 2, 2, 3714, 3715,
-	7, 82, 2, 2, 3715, 408, 3, 2, 2, 2, 3716, 3717, 7, 70, 2, 2, 3717, 3718,
-	7, 67, 2, 2, 3718, 3719, 7, 86, 2, 2, 3719, 3720, 7, 71, 2, 2, 3720, 3721,
-	7, 86, 2, 2, 3721, 3722, 7, 75, 2, 2, 3722, 3723, 7, 79, 2, 2, 3723, 3724,
-	7, 71, 2, 2, 3724, 410, 3, 2, 2, 2, 3725, 3726, 7, 91, 2, 2, 3726, 3727,
-	7, 71, 2, 2, 3727, 3728, 7, 67, 2, 2, 3728, 3729, 7, 84, 2, 2, 3729, 412,
-	3, 2, 2, 2, 3730, 3731, 7, 69, 2, 2, 3731, 3732, 7, 74, 2, 2, 3732, 3733,
-	7, 67, 2, 2, 3733, 3734, 7, 84, 2, 2, 3734, 414, 3, 2, 2, 2, 3735, 3736,
-	7, 88, 2, 2, 3736, 3737, 7, 67, 2, 2, 3737, 3738, 7, 84, 2, 2, 3738, 3739,
-	7, 69, 2, 2, 3739, 3740, 7, 74, 2, 2, 3740, 3741, 7, 67, 2, 2, 3741, 3742,
-	7, 84, 2, 2, 3742, 416, 3, 2, 2, 2, 3743, 3744, 7, 80, 2, 2, 3744, 3745,
-	7, 88, 2, 2, 3745, 3746, 7, 67, 2, 2, 3746, 3747, 7, 84, 2, 2, 3747, 3748,
-	7, 69, 2, 2, 3748, 3749, 7, 74, 2, 2, 3749, 3750, 7, 67, 2, 2, 3750, 3751,
-	7, 84, 2, 2, 3751, 418, 3, 2, 2, 2, 3752, 3753, 7, 80, 2, 2, 3753, 3754,
-	7, 67, 2, 2, 3754, 3755, 7, 86, 2, 2, 3755, 3756, 7, 75, 2, 2, 3756, 3757,
-	7, 81, 2, 2, 3757, 3758, 7, 80, 2, 2, 3758, 3759, 7, 67, 2, 2, 3759, 3760,
-	7, 78, 2, 2, 3760, 420, 3, 2, 2, 2, 3761, 3762, 7, 68, 2, 2, 3762, 3763,
-	7, 75, 2, 2, 3763, 3764, 7, 80, 2, 2, 3764, 3765, 7, 67, 2, 2, 3765, 3766,
-	7, 84, 2, 2, 3766, 3767, 7, 91, 2, 2, 3767, 422, 3, 2, 2, 2, 3768, 3769,
-	7, 88, 2, 2, 3769, 3770, 7, 67, 2, 2, 3770, 3771, 7, 84, 2, 2, 3771, 3772,
-	7, 68, 2, 2, 3772, 3773, 7, 75, 2, 2, 3773, 3774, 7, 80, 2, 2, 3774, 3775,
-	7, 67, 2, 2, 3775, 3776, 7, 84, 2, 2, 3776, 3777, 7, 91, 2, 2, 3777, 424,
-	3, 2, 2, 2, 3778, 3779, 7, 86, 2, 2, 3779, 3780, 7, 75, 2, 2, 3780, 3781,
-	7, 80, 2, 2, 3781, 3782, 7, 91, 2, 2, 3782, 3783, 7, 68, 2, 2, 3783, 3784,
-	7, 78, 2, 2, 3784, 3785, 7, 81, 2, 2, 3785, 3786, 7, 68, 2, 2, 3786, 426,
-	3, 2, 2, 2, 3787, 3788, 7, 68, 2, 2, 3788, 3789, 7, 78, 2, 2, 3789, 3790,
-	7, 81, 2, 2, 3790, 3791, 7, 68, 2, 2, 3791, 428, 3, 2, 2, 2, 3792, 3793,
-	7, 79, 2, 2, 3793, 3794, 7, 71, 2, 2, 3794, 3795, 7, 70, 2, 2, 3795, 3796,
-	7, 75, 2, 2, 3796, 3797, 7, 87, 2, 2, 3797, 3798, 7, 79, 2, 2, 3798, 3799,
-	7, 68, 2, 2, 3799, 3800, 7, 78, 2, 2, 3800, 3801, 7, 81, 2, 2, 3801, 3802,
-	7, 68, 2, 2, 3802, 430, 3, 2, 2, 2, 3803, 3804, 7, 78, 2, 2, 3804, 3805,
-	7, 81, 2, 2, 3805, 3806, 7, 80, 2, 2, 3806, 3807, 7, 73, 2, 2, 3807, 432,
-	3, 2, 2, 2, 3808, 3809, 7, 78, 2, 2, 3809, 3810, 7, 81, 2, 2, 3810, 3811,
-	7, 80, 2, 2, 3811, 3812, 7, 73, 2, 2, 3812, 3813, 7, 68, 2, 2, 3813, 3814,
-	7, 78, 2, 2, 3814, 3815, 7, 81, 2, 2, 3815, 3816, 7, 68, 2, 2, 3816, 434,
-	3, 2, 2, 2, 3817, 3818, 7, 86, 2, 2, 3818, 3819, 7, 75, 2, 2, 3819, 3820,
-	7, 80, 2, 2, 3820, 3821, 7, 91, 2, 2, 3821, 3822, 7, 86, 2, 2, 3822, 3823,
-	7, 71, 2, 2, 3823, 3824, 7, 90, 2, 2, 3824, 3825, 7, 86, 2, 2, 3825, 436,
-	3, 2, 2, 2, 3826, 3827, 7, 86, 2, 2, 3827, 3828, 7, 71, 2, 2, 3828, 3829,
-	7, 90, 2, 2, 3829, 3830, 7, 86, 2, 2, 3830, 438, 3, 2, 2, 2, 3831, 3832,
-	7, 79, 2, 2, 3832, 3833, 7, 71, 2, 2, 3833, 3834, 7, 70, 2, 2, 3834, 3835,
-	7, 75, 2, 2, 3835, 3836, 7, 87, 2, 2, 3836, 3837, 7, 79, 2, 2, 3837, 3838,
-	7, 86, 2, 2, 3838, 3839, 7, 71, 2, 2, 3839, 3840, 7, 90, 2, 2, 3840, 3841,
-	7, 86, 2, 2, 3841, 440, 3, 2, 2, 2, 3842, 3843, 7, 78, 2, 2, 3843, 3844,
-	7, 81, 2, 2, 3844, 3845, 7, 80, 2, 2, 3845, 3846, 7, 73, 2, 2, 3846, 3847,
-	7, 86, 2, 2, 3847, 3848, 7, 71, 2, 2, 3848, 3849, 7, 90, 2, 2, 3849, 3850,
-	7, 86, 2, 2, 3850, 442, 3, 2, 2, 2, 3851, 3852, 7, 71, 2, 2, 3852, 3853,
-	7, 80, 2, 2, 3853, 3854, 7, 87, 2, 2, 3854, 3855, 7, 79, 2, 2, 3855, 444,
-	3, 2, 2, 2, 3856, 3857, 7, 88, 2, 2, 3857, 3858, 7, 67, 2, 2, 3858, 3859,
-	7, 84, 2, 2, 3859, 3860, 7, 91, 2, 2, 3860, 3861, 7, 75, 2, 2, 3861, 3862,
-	7, 80, 2, 2, 3862, 3863, 7, 73, 2, 2, 3863, 446, 3, 2, 2, 2, 3864, 3865,
-	7, 85, 2, 2, 3865, 3866, 7, 71, 2, 2, 3866, 3867, 7, 84, 2, 2, 3867, 3868,
-	7, 75, 2, 2, 3868, 3869, 7, 67, 2, 2, 3869, 3870, 7, 78, 2, 2, 3870, 448,
-	3, 2, 2, 2, 3871, 3872, 7, 91, 2, 2, 3872, 3873, 7, 71, 2, 2, 3873, 3874,
-	7, 67, 2, 2, 3874, 3875, 7, 84, 2, 2, 3875, 3876, 7, 97, 2, 2, 3876, 3877,
-	7, 79, 2, 2, 3877, 3878, 7, 81, 2, 2, 3878, 3879, 7, 80, 2, 2, 3879, 3880,
-	7, 86, 2, 2, 3880, 3881, 7, 74, 2, 2, 3881, 450, 3, 2, 2, 2, 3882, 3883,
-	7, 70, 2, 2, 3883, 3884, 7, 67, 2, 2, 3884, 3885, 7, 91, 2, 2, 3885, 3886,
-	7, 97, 2, 2, 3886, 3887, 7, 74, 2, 2, 3887, 3888, 7, 81, 2, 2, 3888, 3889,
-	7, 87, 2, 2, 3889, 3890, 7, 84, 2, 2, 3890, 452, 3, 2, 2, 2, 3891, 3892,
-	7, 70, 2, 2, 3892, 3893, 7, 67, 2, 2, 3893, 3894, 7, 91, 2, 2, 3894, 3895,
-	7, 97, 2, 2, 3895, 3896, 7, 79, 2, 2, 3896, 3897, 7, 75, 2, 2, 3897, 3898,
-	7, 80, 2, 2, 3898, 3899, 7, 87, 2, 2, 3899, 3900, 7, 86, 2, 2, 3900, 3901,
-	7, 71, 2, 2, 3901, 454, 3, 2, 2, 2, 3902, 3903, 7, 70, 2, 2, 3903, 3904,
-	7, 67, 2, 2, 3904, 3905, 7, 91, 2, 2, 3905, 3906, 7, 97, 2, 2, 3906, 3907,
-	7, 85, 2, 2, 3907, 3908, 7, 71, 2, 2, 3908, 3909, 7, 69, 2, 2, 3909, 3910,
-	7, 81, 2, 2, 3910, 3911, 7, 80, 2, 2, 3911, 3912, 7, 70, 2, 2, 3912, 456,
-	3, 2, 2, 2, 3913, 3914, 7, 74, 2, 2, 3914, 3915, 7, 81, 2, 2, 3915, 3916,
-	7, 87, 2, 2, 3916, 3917, 7, 84, 2, 2, 3917, 3918, 7, 97, 2, 2, 3918, 3919,
-	7, 79, 2, 2, 3919, 3920, 7, 75, 2, 2, 3920, 3921, 7, 80, 2, 2, 3921, 3922,
-	7, 87, 2, 2, 3922, 3923, 7, 86, 2, 2, 3923, 3924, 7, 71, 2, 2, 3924, 458,
-	3, 2, 2, 2, 3925, 3926, 7, 74, 2, 2, 3926, 3927, 7, 81, 2, 2, 3927, 3928,
-	7, 87, 2, 2, 3928, 3929, 7, 84, 2, 2, 3929, 3930, 7, 97, 2, 2, 3930, 3931,
-	7, 85, 2, 2, 3931, 3932, 7, 71, 2, 2, 3932, 3933, 7, 69, 2, 2, 3933, 3934,
-	7, 81, 2, 2, 3934, 3935, 7, 80, 2, 2, 3935, 3936, 7, 70, 2, 2, 3936, 460,
-	3, 2, 2, 2, 3937, 3938, 7, 79, 2, 2, 3938, 3939, 7, 75, 2, 2, 3939, 3940,
-	7, 80, 2, 2, 3940, 3941, 7, 87, 2, 2, 3941, 3942, 7, 86, 2, 2, 3942, 3943,
-	7, 71, 2, 2, 3943, 3944, 7, 97, 2, 2, 3944, 3945, 7, 85, 2, 2, 3945, 3946,
-	7, 71, 2, 2, 3946, 3947, 7, 69, 2, 2, 3947, 3948, 7, 81, 2, 2, 3948, 3949,
-	7, 80, 2, 2, 3949, 3950, 7, 70, 2, 2, 3950, 462, 3, 2, 2, 2, 3951, 3952,
-	7, 85, 2, 2, 3952, 3953, 7, 71, 2, 2, 3953, 3954, 7, 69, 2, 2, 3954, 3955,
-	7, 81, 2, 2, 3955, 3956, 7, 80, 2, 2, 3956, 3957, 7, 70, 2, 2, 3957, 3958,
-	7, 97, 2, 2, 3958, 3959, 7, 79, 2, 2, 3959, 3960, 7, 75, 2, 2, 3960, 3961,
-	7, 69, 2, 2, 3961, 3962, 7, 84, 2, 2, 3962, 3963, 7, 81, 2, 2, 3963, 3964,
-	7, 85, 2, 2, 3964, 3965, 7, 71, 2, 2, 3965, 3966, 7, 69, 2, 2, 3966, 3967,
-	7, 81, 2, 2, 3967, 3968, 7, 80, 2, 2, 3968, 3969, 7, 70, 2, 2, 3969, 464,
-	3, 2, 2, 2, 3970, 3971, 7, 79, 2, 2, 3971, 3972, 7, 75, 2, 2, 3972, 3973,
-	7, 80, 2, 2, 3973, 3974, 7, 87, 2, 2, 3974, 3975, 7, 86, 2, 2, 3975, 3976,
-	7, 71, 2, 2, 3976, 3977, 7, 97, 2, 2, 3977, 3978, 7, 79, 2, 2, 3978, 3979,
-	7, 75, 2, 2, 3979, 3980, 7, 69, 2, 2, 3980, 3981, 7, 84, 2, 2, 3981, 3982,
-	7, 81, 2, 2, 3982, 3983, 7, 85, 2, 2, 3983, 3984, 7, 71, 2, 2, 3984, 3985,
-	7, 69, 2, 2, 3985, 3986, 7, 81, 2, 2, 3986, 3987, 7, 80, 2, 2, 3987, 3988,
-	7, 70, 2, 2, 3988, 466, 3, 2, 2, 2, 3989, 3990, 7, 74, 2, 2, 3990, 3991,
-	7, 81, 2, 2, 3991, 3992, 7, 87, 2, 2, 3992, 3993, 7, 84, 2, 2, 3993, 3994,
-	7, 97, 2, 2, 3994, 3995, 7, 79, 2, 2, 3995, 3996, 7, 75, 2, 2, 3996, 3997,
-	7, 69, 2, 2, 3997, 3998, 7, 84, 2, 2, 3998, 3999, 7, 81, 2, 2, 3999, 4000,
-	7, 85, 2, 2, 4000, 4001, 7, 71, 2, 2, 4001, 4002, 7, 69, 2, 2, 4002, 4003,
-	7, 81, 2, 2, 4003, 4004, 7, 80, 2, 2, 4004, 4005, 7, 70, 2, 2, 4005, 468,
-	3, 2, 2, 2, 4006, 4007, 7, 70, 2, 2, 4007, 4008, 7, 67, 2, 2, 4008, 4009,
-	7, 91, 2, 2, 4009, 4010, 7, 97, 2, 2, 4010, 4011, 7, 79, 2, 2, 4011, 4012,
-	7, 75, 2, 2, 4012, 4013, 7, 69, 2, 2, 4013, 4014, 7, 84, 2, 2, 4014, 4015,
-	7, 81, 2, 2, 4015, 4016, 7, 85, 2, 2, 4016, 4017, 7, 71, 2, 2, 4017, 4018,
-	7, 69, 2, 2, 4018, 4019, 7, 81, 2, 2, 4019, 4020, 7, 80, 2, 2, 4020, 4021,
-	7, 70, 2, 2, 4021, 470, 3, 2, 2, 2, 4022, 4023, 7, 76, 2, 2, 4023, 4024,
-	7, 85, 2, 2, 4024, 4025, 7, 81, 2, 2, 4025, 4026, 7, 80, 2, 2, 4026, 4027,
-	7, 97, 2, 2, 4027, 4028, 7, 67, 2, 2, 4028, 4029, 7, 84, 2, 2, 4029, 4030,
-	7, 84, 2, 2, 4030, 4031, 7, 67, 2, 2, 4031, 4032, 7, 91, 2, 2, 4032, 472,
-	3, 2, 2, 2, 4033, 4034, 7, 76, 2, 2, 4034, 4035, 7, 85, 2, 2, 4035, 4036,
-	7, 81, 2, 2, 4036, 4037, 7, 80, 2, 2, 4037, 4038, 7, 97, 2, 2, 4038, 4039,
-	7, 81, 2, 2, 4039, 4040, 7, 68, 2, 2, 4040, 4041, 7, 76, 2, 2, 4041, 4042,
-	7, 71, 2, 2, 4042, 4043, 7, 69, 2, 2, 4043, 4044, 7, 86, 2, 2, 4044, 474,
-	3, 2, 2, 2, 4045, 4046, 7, 76, 2, 2, 4046, 4047, 7, 85, 2, 2, 4047, 4048,
-	7, 81, 2, 2, 4048, 4049, 7, 80, 2, 2, 4049, 4050, 7, 97, 2, 2, 4050, 4051,
-	7, 83, 2, 2, 4051, 4052, 7, 87, 2, 2, 4052, 4053, 7, 81, 2, 2, 4053, 4054,
-	7, 86, 2, 2, 4054, 4055, 7, 71, 2, 2, 4055, 476, 3, 2, 2, 2, 4056, 4057,
-	7, 76, 2, 2, 4057, 4058, 7, 85, 2, 2, 4058, 4059, 7, 81, 2, 2, 4059, 4060,
-	7, 80, 2, 2, 4060, 4061, 7, 97, 2, 2, 4061, 4062, 7, 69, 2, 2, 4062, 4063,
-	7, 81, 2, 2, 4063, 4064, 7, 80, 2, 2, 4064, 4065, 7, 86, 2, 2, 4065, 4066,
-	7, 67, 2, 2, 4066, 4067, 7, 75, 2, 2, 4067, 4068, 7, 80, 2, 2, 4068, 4069,
-	7, 85, 2, 2, 4069, 478, 3, 2, 2, 2, 4070, 4071, 7, 76, 2, 2, 4071, 4072,
-	7, 85, 2, 2, 4072, 4073, 7, 81, 2, 2, 4073, 4074, 7, 80, 2, 2, 4074, 4075,
-	7, 97, 2, 2, 4075, 4076, 7, 69, 2, 2, 4076, 4077, 7, 81, 2, 2, 4077, 4078,
-	7, 80, 2, 2, 4078, 4079, 7, 86, 2, 2, 4079, 4080, 7, 67, 2, 2, 4080, 4081,
-	7, 75, 2, 2, 4081, 4082, 7, 80, 2, 2, 4082, 4083, 7, 85, 2, 2, 4083, 4084,
-	7, 97, 2, 2, 4084, 4085, 7, 82, 2, 2, 4085, 4086, 7, 67, 2, 2, 4086, 4087,
-	7, 86, 2, 2, 4087, 4088, 7, 74, 2, 2, 4088, 480, 3, 2, 2, 2, 4089, 4090,
-	7, 76, 2, 2, 4090, 4091, 7, 85, 2, 2, 4091, 4092, 7, 81, 2, 2, 4092, 4093,
-	7, 80, 2, 2, 4093, 4094, 7, 97, 2, 2, 4094, 4095, 7, 71, 2, 2, 4095, 4096,
-	7, 90, 2, 2, 4096, 4097, 7, 86, 2, 2, 4097, 4098, 7, 84, 2, 2, 4098, 4099,
-	7, 67, 2, 2, 4099, 4100, 7, 69, 2, 2, 4100, 4101, 7, 86, 2, 2, 4101, 482,
-	3, 2, 2, 2, 4102, 4103, 7, 76, 2, 2, 4103, 4104, 7, 85, 2, 2, 4104, 4105,
-	7, 81, 2, 2, 4105, 4106, 7, 80, 2, 2, 4106, 4107, 7, 97, 2, 2, 4107, 4108,
-	7, 77, 2, 2, 4108, 4109, 7, 71, 2, 2, 4109, 4110, 7, 91, 2, 2, 4110, 4111,
-	7, 85, 2, 2, 4111, 484, 3, 2, 2, 2, 4112, 4113, 7, 76, 2, 2, 4113, 4114,
-	7, 85, 2, 2, 4114, 4115, 7, 81, 2, 2, 4115, 4116, 7, 80, 2, 2, 4116, 4117,
-	7, 97, 2, 2, 4117, 4118, 7, 81, 2, 2, 4118, 4119, 7, 88, 2, 2, 4119, 4120,
-	7, 71, 2, 2, 4120, 4121, 7, 84, 2, 2, 4121, 4122, 7, 78, 2, 2, 4122, 4123,
-	7, 67, 2, 2, 4123, 4124, 7, 82, 2, 2, 4124, 4125, 7, 85, 2, 2, 4125, 486,
-	3, 2, 2, 2, 4126, 4127, 7, 76, 2, 2, 4127, 4128, 7, 85, 2, 2, 4128, 4129,
-	7, 81, 2, 2, 4129, 4130, 7, 80, 2, 2, 4130, 4131, 7, 97, 2, 2, 4131, 4132,
-	7, 85, 2, 2, 4132, 4133, 7, 71, 2, 2, 4133, 4134, 7, 67, 2, 2, 4134, 4135,
-	7, 84, 2, 2, 4135, 4136, 7, 69, 2, 2, 4136, 4137, 7, 74, 2, 2, 4137, 488,
-	3, 2, 2, 2, 4138, 4139, 7, 76, 2, 2, 4139, 4140, 7, 85, 2, 2, 4140, 4141,
-	7, 81, 2, 2, 4141, 4142, 7, 80, 2, 2, 4142, 4143, 7, 97, 2, 2, 4143, 4144,
-	7, 88, 2, 2, 4144, 4145, 7, 67, 2, 2, 4145, 4146, 7, 78, 2, 2, 4146, 4147,
-	7, 87, 2, 2, 4147, 4148, 7, 71, 2, 2, 4148, 490, 3, 2, 2, 2, 4149, 4150,
-	7, 76, 2, 2, 4150, 4151, 7, 85, 2, 2, 4151, 4152, 7, 81, 2, 2, 4152, 4153,
-	7, 80, 2, 2, 4153, 4154, 7, 97, 2, 2, 4154, 4155, 7, 67, 2, 2, 4155, 4156,
-	7, 84, 2, 2, 4156, 4157, 7, 84, 2, 2, 4157, 4158, 7, 67, 2, 2, 4158, 4159,
-	7, 91, 2, 2, 4159, 4160, 7, 97, 2, 2, 4160, 4161, 7, 67, 2, 2, 4161, 4162,
-	7, 82, 2, 2, 4162, 4163, 7, 82, 2, 2, 4163, 4164, 7, 71, 2, 2, 4164, 4165,
-	7, 80, 2, 2, 4165, 4166, 7, 70, 2, 2, 4166, 492, 3, 2, 2, 2, 4167, 4168,
-	7, 76, 2, 2, 4168, 4169, 7, 85, 2, 2, 4169, 4170, 7, 81, 2, 2, 4170, 4171,
-	7, 80, 2, 2, 4171, 4172, 7, 97, 2, 2, 4172, 4173, 7, 67, 2, 2, 4173, 4174,
-	7, 84, 2, 2, 4174, 4175, 7, 84, 2, 2, 4175, 4176, 7, 67, 2, 2, 4176, 4177,
-	7, 91, 2, 2, 4177, 4178, 7, 97, 2, 2, 4178, 4179, 7, 75, 2, 2, 4179, 4180,
-	7, 80, 2, 2, 4180, 4181, 7, 85, 2, 2, 4181, 4182, 7, 71, 2, 2, 4182, 4183,
-	7, 84, 2, 2, 4183, 4184, 7, 86, 2, 2, 4184, 494, 3, 2, 2, 2, 4185, 4186,
-	7, 76, 2, 2, 4186, 4187, 7, 85, 2, 2, 4187, 4188, 7, 81, 2, 2, 4188, 4189,
-	7, 80, 2, 2, 4189, 4190, 7, 97, 2, 2, 4190, 4191, 7, 75, 2, 2, 4191, 4192,
-	7, 80, 2, 2, 4192, 4193, 7, 85, 2, 2, 4193, 4194, 7, 71, 2, 2, 4194, 4195,
-	7, 84, 2, 2, 4195, 4196, 7, 86, 2, 2, 4196, 496, 3, 2, 2, 2, 4197, 4198,
-	7, 76, 2, 2, 4198, 4199, 7, 85, 2, 2, 4199, 4200, 7, 81, 2, 2, 4200, 4201,
-	7, 80, 2, 2, 4201, 4202, 7, 97, 2, 2, 4202, 4203, 7, 79, 2, 2, 4203, 4204,
-	7, 71, 2, 2, 4204, 4205, 7, 84, 2, 2, 4205, 4206, 7, 73, 2, 2, 4206, 4207,
-	7, 71, 2, 2, 4207, 498, 3, 2, 2, 2, 4208, 4209, 7, 76, 2, 2, 4209, 4210,
-	7, 85, 2, 2, 4210, 4211, 7, 81, 2, 2, 4211, 4212, 7, 80, 2, 2, 4212, 4213,
-	7, 97, 2, 2, 4213, 4214, 7, 79, 2, 2, 4214, 4215, 7, 71, 2, 2, 4215, 4216,
-	7, 84, 2, 2, 4216, 4217, 7, 73, 2, 2, 4217, 4218, 7, 71, 2, 2, 4218, 4219,
-	7, 97, 2, 2, 4219, 4220, 7, 82, 2, 2, 4220, 4221, 7, 67, 2, 2, 4221, 4222,
-	7, 86, 2, 2, 4222, 4223, 7, 69, 2, 2, 4223, 4224, 7, 74, 2, 2, 4224, 500,
-	3, 2, 2, 2, 4225, 4226, 7, 76, 2, 2, 4226, 4227, 7, 85, 2, 2, 4227, 4228,
-	7, 81, 2, 2, 4228, 4229, 7, 80, 2, 2, 4229, 4230, 7, 97, 2, 2, 4230, 4231,
-	7, 79, 2, 2, 4231, 4232, 7, 71, 2, 2, 4232, 4233, 7, 84, 2, 2, 4233, 4234,
-	7, 73, 2, 2, 4234, 4235, 7, 71, 2, 2, 4235, 4236, 7, 97, 2, 2, 4236, 4237,
-	7, 82, 2, 2, 4237, 4238, 7, 84, 2, 2, 4238, 4239, 7, 71, 2, 2, 4239, 4240,
-	7, 85, 2, 2, 4240, 4241, 7, 71, 2, 2, 4241, 4242, 7, 84, 2, 2, 4242, 4243,
-	7, 88, 2, 2, 4243, 4244, 7, 71, 2, 2, 4244, 502, 3, 2, 2, 2, 4245, 4246,
-	7, 76, 2, 2, 4246, 4247, 7, 85, 2, 2, 4247, 4248, 7, 81, 2, 2, 4248, 4249,
-	7, 80, 2, 2, 4249, 4250, 7, 97, 2, 2, 4250, 4251, 7, 84, 2, 2, 4251, 4252,
-	7, 71, 2, 2, 4252, 4253, 7, 79, 2, 2, 4253, 4254, 7, 81, 2, 2, 4254, 4255,
-	7, 88, 2, 2, 4255, 4256, 7, 71, 2, 2, 4256, 504, 3, 2, 2, 2, 4257, 4258,
-	7, 76, 2, 2, 4258, 4259, 7, 85, 2, 2, 4259, 4260, 7, 81, 2, 2, 4260, 4261,
-	7, 80, 2, 2, 4261, 4262, 7, 97, 2, 2, 4262, 4263, 7, 84, 2, 2, 4263, 4264,
-	7, 71, 2, 2, 4264, 4265, 7, 82, 2, 2, 4265, 4266, 7, 78, 2, 2, 4266, 4267,
-	7, 67, 2, 2, 4267, 4268, 7, 69, 2, 2, 4268, 4269, 7, 71, 2, 2, 4269, 506,
-	3, 2, 2, 2, 4270, 4271, 7, 76, 2, 2, 4271, 4272, 7, 85, 2, 2, 4272, 4273,
-	7, 81, 2, 2, 4273, 4274, 7, 80, 2, 2, 4274, 4275, 7, 97, 2, 2, 4275, 4276,
-	7, 85, 2, 2, 4276, 4277, 7, 71, 2, 2, 4277, 4278, 7, 86, 2, 2, 4278, 508,
-	3, 2, 2, 2, 4279, 4280, 7, 76, 2, 2, 4280, 4281, 7, 85, 2, 2, 4281, 4282,
-	7, 81, 2, 2, 4282, 4283, 7, 80, 2, 2, 4283, 4284, 7, 97, 2, 2, 4284, 4285,
-	7, 87, 2, 2, 4285, 4286, 7, 80, 2, 2, 4286, 4287, 7, 83, 2, 2, 4287, 4288,
-	7, 87, 2, 2, 4288, 4289, 7, 81, 2, 2, 4289, 4290, 7, 86, 2, 2, 4290, 4291,
-	7, 71, 2, 2, 4291, 510, 3, 2, 2, 2, 4292, 4293, 7, 76, 2, 2, 4293, 4294,
-	7, 85, 2, 2, 4294, 4295, 7, 81, 2, 2, 4295, 4296, 7, 80, 2, 2, 4296, 4297,
-	7, 97, 2, 2, 4297, 4298, 7, 70, 2, 2, 4298, 4299, 7, 71, 2, 2, 4299, 4300,
-	7, 82, 2, 2, 4300, 4301, 7, 86, 2, 2, 4301, 4302, 7, 74, 2, 2, 4302, 512,
-	3, 2, 2, 2, 4303, 4304, 7, 76, 2, 2, 4304, 4305, 7, 85, 2, 2, 4305, 4306,
-	7, 81, 2, 2, 4306, 4307, 7, 80, 2, 2, 4307, 4308, 7, 97, 2, 2, 4308, 4309,
-	7, 78, 2, 2, 4309, 4310, 7, 71, 2, 2, 4310, 4311, 7, 80, 2, 2, 4311, 4312,
-	7, 73, 2, 2, 4312, 4313, 7, 86, 2, 2, 4313, 4314, 7, 74, 2, 2, 4314, 514,
-	3, 2, 2, 2, 4315, 4316, 7, 76, 2, 2, 4316, 4317, 7, 85, 2, 2, 4317, 4318,
-	7, 81, 2, 2, 4318, 4319, 7, 80, 2, 2, 4319, 4320, 7, 97, 2, 2, 4320, 4321,
-	7, 86, 2, 2, 4321, 4322, 7, 91, 2, 2, 4322, 4323, 7, 82, 2, 2, 4323, 4324,
-	7, 71, 2, 2, 4324, 516, 3, 2, 2, 2, 4325, 4326, 7, 76, 2, 2, 4326, 4327,
-	7, 85, 2, 2, 4327, 4328, 7, 81, 2, 2, 4328, 4329, 7, 80, 2, 2, 4329, 4330,
-	7, 97, 2, 2, 4330, 4331, 7, 88, 2, 2, 4331, 4332, 7, 67, 2, 2, 4332, 4333,
-	7, 78, 2, 2, 4333, 4334, 7, 75, 2, 2, 4334, 4335, 7, 70, 2, 2, 4335, 518,
-	3, 2, 2, 2, 4336, 4337, 7, 76, 2, 2, 4337, 4338, 7, 85, 2, 2, 4338, 4339,
-	7, 81, 2, 2, 4339, 4340, 7, 80, 2, 2, 4340, 4341, 7, 97, 2, 2, 4341, 4342,
-	7, 86, 2, 2, 4342, 4343, 7, 67, 2, 2, 4343, 4344, 7, 68, 2, 2, 4344, 4345,
-	7, 78, 2, 2, 4345, 4346, 7, 71, 2, 2, 4346, 520, 3, 2, 2, 2, 4347, 4348,
-	7, 76, 2, 2, 4348, 4349, 7, 85, 2, 2, 4349, 4350, 7, 81, 2, 2, 4350, 4351,
-	7, 80, 2, 2, 4351, 4352, 7, 97, 2, 2, 4352, 4353, 7, 85, 2, 2, 4353, 4354,
-	7, 69, 2, 2, 4354, 4355, 7, 74, 2, 2, 4355, 4356, 7, 71, 2, 2, 4356, 4357,
-	7, 79, 2, 2, 4357, 4358, 7, 67, 2, 2, 4358, 4359, 7, 97, 2, 2, 4359, 4360,
-	7, 88, 2, 2, 4360, 4361, 7, 67, 2, 2, 4361, 4362, 7, 78, 2, 2, 4362, 4363,
-	7, 75, 2, 2, 4363, 4364, 7, 70, 2, 2, 4364, 522, 3, 2, 2, 2, 4365, 4366,
-	7, 76, 2, 2, 4366, 4367, 7, 85, 2, 2, 4367, 4368, 7, 81, 2, 2, 4368, 4369,
-	7, 80, 2, 2, 4369, 4370, 7, 97, 2, 2, 4370, 4371, 7, 85, 2, 2, 4371, 4372,
-	7, 69, 2, 2, 4372, 4373, 7, 74, 2, 2, 4373, 4374, 7, 71, 2, 2, 4374, 4375,
-	7, 79, 2, 2, 4375, 4376, 7, 67, 2, 2, 4376, 4377, 7, 97, 2, 2, 4377, 4378,
-	7, 88, 2, 2, 4378, 4379, 7, 67, 2, 2, 4379, 4380, 7, 78, 2, 2, 4380, 4381,
-	7, 75, 2, 2, 4381, 4382, 7, 70, 2, 2, 4382, 4383, 7, 67, 2, 2, 4383, 4384,
-	7, 86, 2, 2, 4384, 4385, 7, 75, 2, 2, 4385, 4386, 7, 81, 2, 2, 4386, 4387,
-	7, 80, 2, 2, 4387, 4388, 7, 97, 2, 2, 4388, 4389, 7, 84, 2, 2, 4389, 4390,
-	7, 71, 2, 2, 4390, 4391, 7, 82, 2, 2, 4391, 4392, 7, 81, 2, 2, 4392, 4393,
-	7, 84, 2, 2, 4393, 4394, 7, 86, 2, 2, 4394, 524, 3, 2, 2, 2, 4395, 4396,
-	7, 76, 2, 2, 4396, 4397, 7, 85, 2, 2, 4397, 4398, 7, 81, 2, 2, 4398, 4399,
-	7, 80, 2, 2, 4399, 4400, 7, 97, 2, 2, 4400, 4401, 7, 82, 2, 2, 4401, 4402,
-	7, 84, 2, 2, 4402, 4403, 7, 71, 2, 2, 4403, 4404, 7, 86, 2, 2, 4404, 4405,
-	7, 86, 2, 2, 4405, 4406, 7, 91, 2, 2, 4406, 526, 3, 2, 2, 2, 4407, 4408,
-	7, 76, 2, 2, 4408, 4409, 7, 85, 2, 2, 4409, 4410, 7, 81, 2, 2, 4410, 4411,
-	7, 80, 2, 2, 4411, 4412, 7, 97, 2, 2, 4412, 4413, 7, 85, 2, 2, 4413, 4414,
-	7, 86, 2, 2, 4414, 4415, 7, 81, 2, 2, 4415, 4416, 7, 84, 2, 2, 4416, 4417,
-	7, 67, 2, 2, 4417, 4418, 7, 73, 2, 2, 4418, 4419, 7, 71, 2, 2, 4419, 4420,
-	7, 97, 2, 2, 4420, 4421, 7, 72, 2, 2, 4421, 4422, 7, 84, 2, 2, 4422, 4423,
-	7, 71, 2, 2, 4423, 4424, 7, 71, 2, 2, 4424, 528, 3, 2, 2, 2, 4425, 4426,
-	7, 76, 2, 2, 4426, 4427, 7, 85, 2, 2, 4427, 4428, 7, 81, 2, 2, 4428, 4429,
-	7, 80, 2, 2, 4429, 4430, 7, 97, 2, 2, 4430, 4431, 7, 85, 2, 2, 4431, 4432,
-	7, 86, 2, 2, 4432, 4433, 7, 81, 2, 2, 4433, 4434, 7, 84, 2, 2, 4434, 4435,
-	7, 67, 2, 2, 4435, 4436, 7, 73, 2, 2, 4436, 4437, 7, 71, 2, 2, 4437, 4438,
-	7, 97, 2, 2, 4438, 4439, 7, 85, 2, 2, 4439, 4440, 7, 75, 2, 2, 4440, 4441,
-	7, 92, 2, 2, 4441, 4442, 7, 71, 2, 2, 4442, 530, 3, 2, 2, 2, 4443, 4444,
-	7, 76, 2, 2, 4444, 4445, 7, 85, 2, 2, 4445, 4446, 7, 81, 2, 2, 4446, 4447,
-	7, 80, 2, 2, 4447, 4448, 7, 97, 2, 2, 4448, 4449, 7, 67, 2, 2, 4449, 4450,
-	7, 84, 2, 2, 4450, 4451, 7, 84, 2, 2, 4451, 4452, 7, 67, 2, 2, 4452, 4453,
-	7, 91, 2, 2, 4453, 4454, 7, 67, 2, 2, 4454, 4455, 7, 73, 2, 2, 4455, 4456,
-	7, 73, 2, 2, 4456, 532, 3, 2, 2, 2, 4457, 4458, 7, 76, 2, 2, 4458, 4459,
-	7, 85, 2, 2, 4459, 4460, 7, 81, 2, 2, 4460, 4461, 7, 80, 2, 2, 4461, 4462,
-	7, 97, 2, 2, 4462, 4463, 7, 81, 2, 2, 4463, 4464, 7, 68, 2, 2, 4464, 4465,
-	7, 76, 2, 2, 4465, 4466, 7, 71, 2, 2, 4466, 4467, 7, 69, 2, 2, 4467, 4468,
-	7, 86, 2, 2, 4468, 4469, 7, 67, 2, 2, 4469, 4470, 7, 73, 2, 2, 4470, 4471,
-	7, 73, 2, 2, 4471, 534, 3, 2, 2, 2, 4472, 4473, 7, 67, 2, 2, 4473, 4474,
-	7, 88, 2, 2, 4474, 4475, 7, 73, 2, 2, 4475, 536, 3, 2, 2, 2, 4476, 4477,
-	7, 68, 2, 2, 4477, 4478, 7, 75, 2, 2, 4478, 4479, 7, 86, 2, 2, 4479, 4480,
-	7, 97, 2, 2, 4480, 4481, 7, 67, 2, 2, 4481, 4482, 7, 80, 2, 2, 4482, 4483,
-	7, 70, 2, 2, 4483, 538, 3, 2, 2, 2, 4484, 4485, 7, 68, 2, 2, 4485, 4486,
-	7, 75, 2, 2, 4486, 4487, 7, 86, 2, 2, 4487, 4488, 7, 97, 2, 2, 4488, 4489,
-	7, 81, 2, 2, 4489, 4490, 7, 84, 2, 2, 4490, 540, 3, 2, 2, 2, 4491, 4492,
-	7, 68, 2, 2, 4492, 4493, 7, 75, 2, 2, 4493, 4494, 7, 86, 2, 2, 4494, 4495,
-	7, 97, 2, 2, 4495, 4496, 7, 90, 2, 2, 4496, 4497, 7, 81, 2, 2, 4497, 4498,
-	7, 84, 2, 2, 4498, 542, 3, 2, 2, 2, 4499, 4500, 7, 69, 2, 2, 4500, 4501,
-	7, 81, 2, 2, 4501, 4502, 7, 87, 2, 2, 4502, 4503, 7, 80, 2, 2, 4503, 4504,
-	7, 86, 2, 2, 4504, 544, 3, 2, 2, 2, 4505, 4506, 7, 73, 2, 2, 4506, 4507,
-	7, 84, 2, 2, 4507, 4508, 7, 81, 2, 2, 4508, 4509, 7, 87, 2, 2, 4509, 4510,
-	7, 82, 2, 2, 4510, 4511, 7, 97, 2, 2, 4511, 4512, 7, 69, 2, 2, 4512, 4513,
-	7, 81, 2, 2, 4513, 4514, 7, 80, 2, 2, 4514, 4515, 7, 69, 2, 2, 4515, 4516,
-	7, 67, 2, 2, 4516, 4517, 7, 86, 2, 2, 4517, 546, 3, 2, 2, 2, 4518, 4519,
-	7, 79, 2, 2, 4519, 4520, 7, 67, 2, 2, 4520, 4521, 7, 90, 2, 2, 4521, 548,
-	3, 2, 2, 2, 4522, 4523, 7, 79, 2, 2, 4523, 4524, 7, 75, 2, 2, 4524, 4525,
-	7, 80, 2, 2, 4525, 550, 3, 2, 2, 2, 4526, 4527, 7, 85, 2, 2, 4527, 4528,
-	7, 86, 2, 2, 4528, 4529, 7, 70, 2, 2, 4529, 552, 3, 2, 2, 2, 4530, 4531,
-	7, 85, 2, 2, 4531, 4532, 7, 86, 2, 2, 4532, 4533, 7, 70, 2, 2, 4533, 4534,
-	7, 70, 2, 2, 4534, 4535, 7, 71, 2, 2, 4535, 4536, 7, 88, 2, 2, 4536, 554,
-	3, 2, 2, 2, 4537, 4538, 7, 85, 2, 2, 4538, 4539, 7, 86, 2, 2, 4539, 4540,
-	7, 70, 2, 2, 4540, 4541, 7, 70, 2, 2, 4541, 4542, 7, 71, 2, 2, 4542, 4543,
-	7, 88, 2, 2, 4543, 4544, 7, 97, 2, 2, 4544, 4545, 7, 82, 2, 2, 4545, 4546,
-	7, 81, 2, 2, 4546, 4547, 7, 82, 2, 2, 4547, 556, 3, 2, 2, 2, 4548, 4549,
-	7, 85, 2, 2, 4549, 4550, 7, 86, 2, 2, 4550, 4551, 7, 70, 2, 2, 4551, 4552,
-	7, 70, 2, 2, 4552, 4553, 7, 71, 2, 2, 4553, 4554, 7, 88, 2, 2, 4554, 4555,
-	7, 97, 2, 2, 4555, 4556, 7, 85, 2, 2, 4556, 4557, 7, 67, 2, 2, 4557, 4558,
-	7, 79, 2, 2, 4558, 4559, 7, 82, 2, 2, 4559, 558, 3, 2, 2, 2, 4560, 4561,
-	7, 85, 2, 2, 4561, 4562, 7, 87, 2, 2, 4562, 4563, 7, 79, 2, 2, 4563, 560,
-	3, 2, 2, 2, 4564, 4565, 7, 88, 2, 2, 4565, 4566, 7, 67, 2, 2, 4566, 4567,
-	7, 84, 2, 2, 4567, 4568, 7, 97, 2, 2, 4568, 4569, 7, 82, 2, 2, 4569, 4570,
-	7, 81, 2, 2, 4570, 4571, 7, 82, 2, 2, 4571, 562, 3, 2, 2, 2, 4572, 4573,
-	7, 88, 2, 2, 4573, 4574, 7, 67, 2, 2, 4574, 4575, 7, 84, 2, 2, 4575, 4576,
-	7, 97, 2, 2, 4576, 4577, 7, 85, 2, 2, 4577, 4578, 7, 67, 2, 2, 4578, 4579,
-	7, 79, 2, 2, 4579, 4580, 7, 82, 2, 2, 4580, 564, 3, 2, 2, 2, 4581, 4582,
-	7, 88, 2, 2, 4582, 4583, 7, 67, 2, 2, 4583, 4584, 7, 84, 2, 2, 4584, 4585,
-	7, 75, 2, 2, 4585, 4586, 7, 67, 2, 2, 4586, 4587, 7, 80, 2, 2, 4587, 4588,
-	7, 69, 2, 2, 4588, 4589, 7, 71, 2, 2, 4589, 566, 3, 2, 2, 2, 4590, 4591,
-	7, 69, 2, 2, 4591, 4592, 7, 87, 2, 2, 4592, 4593, 7, 84, 2, 2, 4593, 4594,
-	7, 84, 2, 2, 4594, 4595, 7, 71, 2, 2, 4595, 4596, 7, 80, 2, 2, 4596, 4597,
-	7, 86, 2, 2, 4597, 4598, 7, 97, 2, 2, 4598, 4599, 7, 70, 2, 2, 4599, 4600,
-	7, 67, 2, 2, 4600, 4601, 7, 86, 2, 2, 4601, 4602, 7, 71, 2, 2, 4602, 568,
-	3, 2, 2, 2, 4603, 4604, 7, 69, 2, 2, 4604, 4605, 7, 87, 2, 2, 4605, 4606,
-	7, 84, 2, 2, 4606, 4607, 7, 84, 2, 2, 4607, 4608, 7, 71, 2, 2, 4608, 4609,
-	7, 80, 2, 2, 4609, 4610, 7, 86, 2, 2, 4610, 4611, 7, 97, 2, 2, 4611, 4612,
-	7, 86, 2, 2, 4612, 4613, 7, 75, 2, 2, 4613, 4614, 7, 79, 2, 2, 4614, 4615,
-	7, 71, 2, 2, 4615, 570, 3, 2, 2, 2, 4616, 4617, 7, 69, 2, 2, 4617, 4618,
-	7, 87, 2, 2, 4618, 4619, 7, 84, 2, 2, 4619, 4620, 7, 84, 2, 2, 4620, 4621,
-	7, 71, 2, 2, 4621, 4622, 7, 80, 2, 2, 4622, 4623, 7, 86, 2, 2, 4623, 4624,
-	7, 97, 2, 2, 4624, 4625, 7, 86, 2, 2, 4625, 4626, 7, 75, 2, 2, 4626, 4627,
-	7, 79, 2, 2, 4627, 4628, 7, 71, 2, 2, 4628, 4629, 7, 85, 2, 2, 4629, 4630,
-	7, 86, 2, 2, 4630, 4631, 7, 67, 2, 2, 4631, 4632, 7, 79, 2, 2, 4632, 4633,
-	7, 82, 2, 2, 4633, 572, 3, 2, 2, 2, 4634, 4635, 7, 78, 2, 2, 4635, 4636,
-	7, 81, 2, 2, 4636, 4637, 7, 69, 2, 2, 4637, 4638, 7, 67, 2, 2, 4638, 4639,
-	7, 78, 2, 2, 4639, 4640, 7, 86, 2, 2, 4640, 4641, 7, 75, 2, 2, 4641, 4642,
-	7, 79, 2, 2, 4642, 4643, 7, 71, 2, 2, 4643, 574, 3, 2, 2, 2, 4644, 4645,
-	7, 69, 2, 2, 4645, 4646, 7, 87, 2, 2, 4646, 4647, 7, 84, 2, 2, 4647, 4648,
-	7, 70, 2, 2, 4648, 4649, 7, 67, 2, 2, 4649, 4650, 7, 86, 2, 2, 4650, 4651,
-	7, 71, 2, 2, 4651, 576, 3, 2, 2, 2, 4652, 4653, 7, 69, 2, 2, 4653, 4654,
-	7, 87, 2, 2, 4654, 4655, 7, 84, 2, 2, 4655, 4656, 7, 86, 2, 2, 4656, 4657,
-	7, 75, 2, 2, 4657, 4658, 7, 79, 2, 2, 4658, 4659, 7, 71, 2, 2, 4659, 578,
-	3, 2, 2, 2, 4660, 4661, 7, 70, 2, 2, 4661, 4662, 7, 67, 2, 2, 4662, 4663,
-	7, 86, 2, 2, 4663, 4664, 7, 71, 2, 2, 4664, 4665, 7, 97, 2, 2, 4665, 4666,
-	7, 67, 2, 2, 4666, 4667, 7, 70, 2, 2, 4667, 4668, 7, 70, 2, 2, 4668, 580,
-	3, 2, 2, 2, 4669, 4670, 7, 70, 2, 2, 4670, 4671, 7, 67, 2, 2, 4671, 4672,
-	7, 86, 2, 2, 4672, 4673, 7, 71, 2, 2, 4673, 4674, 7, 97, 2, 2, 4674, 4675,
-	7, 85, 2, 2, 4675, 4676, 7, 87, 2, 2, 4676, 4677, 7, 68, 2, 2, 4677, 582,
-	3, 2, 2, 2, 4678, 4679, 7, 71, 2, 2, 4679, 4680, 7, 90, 2, 2, 4680, 4681,
-	7, 86, 2, 2, 4681, 4682, 7, 84, 2, 2, 4682, 4683, 7, 67, 2, 2, 4683, 4684,
-	7, 69, 2, 2, 4684, 4685, 7, 86, 2, 2, 4685, 584, 3, 2, 2, 2, 4686, 4687,
-	7, 78, 2, 2, 4687, 4688, 7, 81, 2, 2, 4688, 4689, 7, 69, 2, 2, 4689, 4690,
-	7, 67, 2, 2, 4690, 4691, 7, 78, 2, 2, 4691, 4692, 7, 86, 2, 2, 4692, 4693,
-	7, 75, 2, 2, 4693, 4694, 7, 79, 2, 2, 4694, 4695, 7, 71, 2, 2, 4695, 4696,
-	7, 85, 2, 2, 4696, 4697, 7, 86, 2, 2, 4697, 4698, 7, 67, 2, 2, 4698, 4699,
-	7, 79, 2, 2, 4699, 4700, 7, 82, 2, 2, 4700, 586, 3, 2, 2, 2, 4701, 4702,
-	7, 80, 2, 2, 4702, 4703, 7, 81, 2, 2, 4703, 4704, 7, 89, 2, 2, 4704, 588,
-	3, 2, 2, 2, 4705, 4706, 7, 82, 2, 2, 4706, 4707, 7, 81, 2, 2, 4707, 4708,
-	7, 85, 2, 2, 4708, 4709, 7, 75, 2, 2, 4709, 4710, 7, 86, 2, 2, 4710, 4711,
-	7, 75, 2, 2, 4711, 4712, 7, 81, 2, 2, 4712, 4713, 7, 80, 2, 2, 4713, 590,
-	3, 2, 2, 2, 4714, 4715, 7, 85, 2, 2, 4715, 4716, 7, 87, 2, 2, 4716, 4717,
-	7, 68, 2, 2, 4717, 4718, 7, 85, 2, 2, 4718, 4719, 7, 86, 2, 2, 4719, 4720,
-	7, 84, 2, 2, 4720, 592, 3, 2, 2, 2, 4721, 4722, 7, 85, 2, 2, 4722, 4723,
-	7, 87, 2, 2, 4723, 4724, 7, 68, 2, 2, 4724, 4725, 7, 85, 2, 2, 4725, 4726,
-	7, 86, 2, 2, 4726, 4727, 7, 84, 2, 2, 4727, 4728, 7, 75, 2, 2, 4728, 4729,
-	7, 80, 2, 2, 4729, 4730, 7, 73, 2, 2, 4730, 594, 3, 2, 2, 2, 4731, 4732,
-	7, 85, 2, 2, 4732, 4733, 7, 91, 2, 2, 4733, 4734, 7, 85, 2, 2, 4734, 4735,
-	7, 70, 2, 2, 4735, 4736, 7, 67, 2, 2, 4736, 4737, 7, 86, 2, 2, 4737, 4738,
-	7, 71, 2, 2, 4738, 596, 3, 2, 2, 2, 4739, 4740, 7, 86, 2, 2, 4740, 4741,
-	7, 84, 2, 2, 4741, 4742, 7, 75, 2, 2, 4742, 4743, 7, 79, 2, 2, 4743, 598,
-	3, 2, 2, 2, 4744, 4745, 7, 87, 2, 2, 4745, 4746, 7, 86, 2, 2, 4746, 4747,
-	7, 69, 2, 2, 4747, 4748, 7, 97, 2, 2, 4748, 4749, 7, 70, 2, 2, 4749, 4750,
-	7, 67, 2, 2, 4750, 4751, 7, 86, 2, 2, 4751, 4752, 7, 71, 2, 2, 4752, 600,
-	3, 2, 2, 2, 4753, 4754, 7, 87, 2, 2, 4754, 4755, 7, 86, 2, 2, 4755, 4756,
-	7, 69, 2, 2, 4756, 4757, 7, 97, 2, 2, 4757, 4758, 7, 86, 2, 2, 4758, 4759,
-	7, 75, 2, 2, 4759, 4760, 7, 79, 2, 2, 4760, 4761, 7, 71, 2, 2, 4761, 602,
-	3, 2, 2, 2, 4762, 4763, 7, 87, 2, 2, 4763, 4764, 7, 86, 2, 2, 4764, 4765,
-	7, 69, 2, 2, 4765, 4766, 7, 97, 2, 2, 4766, 4767, 7, 86, 2, 2, 4767, 4768,
-	7, 75, 2, 2, 4768, 4769, 7, 79, 2, 2, 4769, 4770, 7, 71, 2, 2, 4770, 4771,
-	7, 85, 2, 2, 4771, 4772, 7, 86, 2, 2, 4772, 4773, 7, 67, 2, 2, 4773, 4774,
-	7, 79, 2, 2, 4774, 4775, 7, 82, 2, 2, 4775, 604, 3, 2, 2, 2, 4776, 4777,
-	7, 67, 2, 2, 4777, 4778, 7, 69, 2, 2, 4778, 4779, 7, 69, 2, 2, 4779, 4780,
-	7, 81, 2, 2, 4780, 4781, 7, 87, 2, 2, 4781, 4782, 7, 80, 2, 2, 4782, 4783,
-	7, 86, 2, 2, 4783, 606, 3, 2, 2, 2, 4784, 4785, 7, 67, 2, 2, 4785, 4786,
-	7, 69, 2, 2, 4786, 4787, 7, 86, 2, 2, 4787, 4788, 7, 75, 2, 2, 4788, 4789,
-	7, 81, 2, 2, 4789, 4790, 7, 80, 2, 2, 4790, 608, 3, 2, 2, 2, 4791, 4792,
-	7, 67, 2, 2, 4792, 4793, 7, 72, 2, 2, 4793, 4794, 7, 86, 2, 2, 4794, 4795,
-	7, 71, 2, 2, 4795, 4796, 7, 84, 2, 2, 4796, 610, 3, 2, 2, 2, 4797, 4798,
-	7, 67, 2, 2, 4798, 4799, 7, 73, 2, 2, 4799, 4800, 7, 73, 2, 2, 4800, 4801,
-	7, 84, 2, 2, 4801, 4802, 7, 71, 2, 2, 4802, 4803, 7, 73, 2, 2, 4803, 4804,
-	7, 67, 2, 2, 4804, 4805, 7, 86, 2, 2, 4805, 4806, 7, 71, 2, 2, 4806, 612,
-	3, 2, 2, 2, 4807, 4808, 7, 67, 2, 2, 4808, 4809, 7, 78, 2, 2, 4809, 4810,
-	7, 73, 2, 2, 4810, 4811, 7, 81, 2, 2, 4811, 4812, 7, 84, 2, 2, 4812, 4813,
-	7, 75, 2, 2, 4813, 4814, 7, 86, 2, 2, 4814, 4815, 7, 74, 2, 2, 4815, 4816,
-	7, 79, 2, 2, 4816, 614, 3, 2, 2, 2, 4817, 4818, 7, 67, 2, 2, 4818, 4819,
-	7, 80, 2, 2, 4819, 4820, 7, 91, 2, 2, 4820, 616, 3, 2, 2, 2, 4821, 4822,
-	7, 67, 2, 2, 4822, 4823, 7, 86, 2, 2, 4823, 618, 3, 2, 2, 2, 4824, 4825,
-	7, 67, 2, 2, 4825, 4826, 7, 87, 2, 2, 4826, 4827, 7, 86, 2, 2, 4827, 4828,
-	7, 74, 2, 2, 4828, 4829, 7, 81, 2, 2, 4829, 4830, 7, 84, 2, 2, 4830, 4831,
-	7, 85, 2, 2, 4831, 620, 3, 2, 2, 2, 4832, 4833, 7, 67, 2, 2, 4833, 4834,
-	7, 87, 2, 2, 4834, 4835, 7, 86, 2, 2, 4835, 4836, 7, 81, 2, 2, 4836, 4837,
-	7, 69, 2, 2, 4837, 4838, 7, 81, 2, 2, 4838, 4839, 7, 79, 2, 2, 4839, 4840,
-	7, 79, 2, 2, 4840, 4841, 7, 75, 2, 2, 4841, 4842, 7, 86, 2, 2, 4842, 622,
-	3, 2, 2, 2, 4843, 4844, 7, 67, 2, 2, 4844, 4845, 7, 87, 2, 2, 4845, 4846,
-	7, 86, 2, 2, 4846, 4847, 7, 81, 2, 2, 4847, 4848, 7, 71, 2, 2, 4848, 4849,
-	7, 90, 2, 2, 4849, 4850, 7, 86, 2, 2, 4850, 4851, 7, 71, 2, 2, 4851, 4852,
-	7, 80, 2, 2, 4852, 4853, 7, 70, 2, 2, 4853, 4854, 7, 97, 2, 2, 4854, 4855,
-	7, 85, 2, 2, 4855, 4856, 7, 75, 2, 2, 4856, 4857, 7, 92, 2, 2, 4857, 4858,
-	7, 71, 2, 2, 4858, 624, 3, 2, 2, 2, 4859, 4860, 7, 67, 2, 2, 4860, 4861,
-	7, 87, 2, 2, 4861, 4862, 7, 86, 2, 2, 4862, 4863, 7, 81, 2, 2, 4863, 4864,
-	7, 97, 2, 2, 4864, 4865, 7, 75, 2, 2, 4865, 4866, 7, 80, 2, 2, 4866, 4867,
-	7, 69, 2, 2, 4867, 4868, 7, 84, 2, 2, 4868, 4869, 7, 71, 2, 2, 4869, 4870,
-	7, 79, 2, 2, 4870, 4871, 7, 71, 2, 2, 4871, 4872, 7, 80, 2, 2, 4872, 4873,
-	7, 86, 2, 2, 4873, 626, 3, 2, 2, 2, 4874, 4875, 7, 67, 2, 2, 4875, 4876,
-	7, 88, 2, 2, 4876, 4877, 7, 73, 2, 2, 4877, 4878, 7, 97, 2, 2, 4878, 4879,
-	7, 84, 2, 2, 4879, 4880, 7, 81, 2, 2, 4880, 4881, 7, 89, 2, 2, 4881, 4882,
-	7, 97, 2, 2, 4882, 4883, 7, 78, 2, 2, 4883, 4884, 7, 71, 2, 2, 4884, 4885,
-	7, 80, 2, 2, 4885, 4886, 7, 73, 2, 2, 4886, 4887, 7, 86, 2, 2, 4887, 4888,
-	7, 74, 2, 2, 4888, 628, 3, 2, 2, 2, 4889, 4890, 7, 68, 2, 2, 4890, 4891,
-	7, 71, 2, 2, 4891, 4892, 7, 73, 2, 2, 4892, 4893, 7, 75, 2, 2, 4893, 4894,
-	7, 80, 2, 2, 4894, 630, 3, 2, 2, 2, 4895, 4896, 7, 68, 2, 2, 4896, 4897,
-	7, 75, 2, 2, 4897, 4898, 7, 80, 2, 2, 4898, 4899, 7, 78, 2, 2, 4899, 4900,
-	7, 81, 2, 2, 4900, 4901, 7, 73, 2, 2, 4901, 632, 3, 2, 2, 2, 4902, 4903,
-	7, 68, 2, 2, 4903, 4904, 7, 75, 2, 2, 4904, 4905, 7, 86, 2, 2, 4905, 634,
-	3, 2, 2, 2, 4906, 4907, 7, 68, 2, 2, 4907, 4908, 7, 78, 2, 2, 4908, 4909,
-	7, 81, 2, 2, 4909, 4910, 7, 69, 2, 2, 4910, 4911, 7, 77, 2, 2, 4911, 636,
-	3, 2, 2, 2, 4912, 4913, 7, 68, 2, 2, 4913, 4914, 7, 81, 2, 2, 4914, 4915,
-	7, 81, 2, 2, 4915, 4916, 7, 78, 2, 2, 4916, 638, 3, 2, 2, 2, 4917, 4918,
-	7, 68, 2, 2, 4918, 4919, 7, 81, 2, 2, 4919, 4920, 7, 81, 2, 2, 4920, 4921,
-	7, 78, 2, 2, 4921, 4922, 7, 71, 2, 2, 4922, 4923, 7, 67, 2, 2, 4923, 4924,
-	7, 80, 2, 2, 4924, 640, 3, 2, 2, 2, 4925, 4926, 7, 68, 2, 2, 4926, 4927,
-	7, 86, 2, 2, 4927, 4928, 7, 84, 2, 2, 4928, 4929, 7, 71, 2, 2, 4929, 4930,
-	7, 71, 2, 2, 4930, 642, 3, 2, 2, 2, 4931, 4932, 7, 69, 2, 2, 4932, 4933,
-	7, 67, 2, 2, 4933, 4934, 7, 69, 2, 2, 4934, 4935, 7, 74, 2, 2, 4935, 4936,
-	7, 71, 2, 2, 4936, 644, 3, 2, 2, 2, 4937, 4938, 7, 69, 2, 2, 4938, 4939,
-	7, 67, 2, 2, 4939, 4940, 7, 85, 2, 2, 4940, 4941, 7, 69, 2, 2, 4941, 4942,
-	7, 67, 2, 2, 4942, 4943, 7, 70, 2, 2, 4943, 4944, 7, 71, 2, 2, 4944, 4945,
-	7, 70, 2, 2, 4945, 646, 3, 2, 2, 2, 4946, 4947, 7, 69, 2, 2, 4947, 4948,
-	7, 74, 2, 2, 4948, 4949, 7, 67, 2, 2, 4949, 4950, 7, 75, 2, 2, 4950, 4951,
-	7, 80, 2, 2, 4951, 648, 3, 2, 2, 2, 4952, 4953, 7, 69, 2, 2, 4953, 4954,
-	7, 74, 2, 2, 4954, 4955, 7, 67, 2, 2, 4955, 4956, 7, 80, 2, 2, 4956, 4957,
-	7, 73, 2, 2, 4957, 4958, 7, 71, 2, 2, 4958, 4959, 7, 70, 2, 2, 4959, 650,
-	3, 2, 2, 2, 4960, 4961, 7, 69, 2, 2, 4961, 4962, 7, 74, 2, 2, 4962, 4963,
-	7, 67, 2, 2, 4963, 4964, 7, 80, 2, 2, 4964, 4965, 7, 80, 2, 2, 4965, 4966,
-	7, 71, 2, 2, 4966, 4967, 7, 78, 2, 2, 4967, 652, 3, 2, 2, 2, 4968, 4969,
-	7, 69, 2, 2, 4969, 4970, 7, 74, 2, 2, 4970, 4971, 7, 71, 2, 2, 4971, 4972,
-	7, 69, 2, 2, 4972, 4973, 7, 77, 2, 2, 4973, 4974, 7, 85, 2, 2, 4974, 4975,
-	7, 87, 2, 2, 4975, 4976, 7, 79, 2, 2, 4976, 654, 3, 2, 2, 2, 4977, 4978,
-	7, 82, 2, 2, 4978, 4979, 7, 67, 2, 2, 4979, 4980, 7, 73, 2, 2, 4980, 4981,
-	7, 71, 2, 2, 4981, 4982, 7, 97, 2, 2, 4982, 4983, 7, 69, 2, 2, 4983, 4984,
-	7, 74, 2, 2, 4984, 4985, 7, 71, 2, 2, 4985, 4986, 7, 69, 2, 2, 4986, 4987,
-	7, 77, 2, 2, 4987, 4988, 7, 85, 2, 2, 4988, 4989, 7, 87, 2, 2, 4989, 4990,
-	7, 79, 2, 2, 4990, 656, 3, 2, 2, 2, 4991, 4992, 7, 69, 2, 2, 4992, 4993,
-	7, 75, 2, 2, 4993, 4994, 7, 82, 2, 2, 4994, 4995, 7, 74, 2, 2, 4995, 4996,
-	7, 71, 2, 2, 4996, 4997, 7, 84, 2, 2, 4997, 658, 3, 2, 2, 2, 4998, 4999,
-	7, 69, 2, 2, 4999, 5000, 7, 78, 2, 2, 5000, 5001, 7, 67, 2, 2, 5001, 5002,
-	7, 85, 2, 2, 5002, 5003, 7, 85, 2, 2, 5003, 5004, 7, 97, 2, 2, 5004, 5005,
-	7, 81, 2, 2, 5005, 5006, 7, 84, 2, 2, 5006, 5007, 7, 75, 2, 2, 5007, 5008,
-	7, 73, 2, 2, 5008, 5009, 7, 75, 2, 2, 5009, 5010, 7, 80, 2, 2, 5010, 660,
-	3, 2, 2, 2, 5011, 5012, 7, 69, 2, 2, 5012, 5013, 7, 78, 2, 2, 5013, 5014,
-	7, 75, 2, 2, 5014, 5015, 7, 71, 2, 2, 5015, 5016, 7, 80, 2, 2, 5016, 5017,
-	7, 86, 2, 2, 5017, 662, 3, 2, 2, 2, 5018, 5019, 7, 69, 2, 2, 5019, 5020,
-	7, 78, 2, 2, 5020, 5021, 7, 81, 2, 2, 5021, 5022, 7, 85, 2, 2, 5022, 5023,
-	7, 71, 2, 2, 5023, 664, 3, 2, 2, 2, 5024, 5025, 7, 69, 2, 2, 5025, 5026,
-	7, 81, 2, 2, 5026, 5027, 7, 67, 2, 2, 5027, 5028, 7, 78, 2, 2, 5028, 5029,
-	7, 71, 2, 2, 5029, 5030, 7, 85, 2, 2, 5030, 5031, 7, 69, 2, 2, 5031, 5032,
-	7, 71, 2, 2, 5032, 666, 3, 2, 2, 2, 5033, 5034, 7, 69, 2, 2, 5034, 5035,
-	7, 81, 2, 2, 5035, 5036, 7, 70, 2, 2, 5036, 5037, 7, 71, 2, 2, 5037, 668,
-	3, 2, 2, 2, 5038, 5039, 7, 69, 2, 2, 5039, 5040, 7, 81, 2, 2, 5040, 5041,
-	7, 78, 2, 2, 5041, 5042, 7, 87, 2, 2, 5042, 5043, 7, 79, 2, 2, 5043, 5044,
-	7, 80, 2, 2, 5044, 5045, 7, 85, 2, 2, 5045, 670, 3, 2, 2, 2, 5046, 5047,
-	7, 69, 2, 2, 5047, 5048, 7, 81, 2, 2, 5048, 5049, 7, 78, 2, 2, 5049, 5050,
-	7, 87, 2, 2, 5050, 5051, 7, 79, 2, 2, 5051, 5052, 7, 80, 2, 2, 5052, 5053,
-	7, 97, 2, 2, 5053, 5054, 7, 72, 2, 2, 5054, 5055, 7, 81, 2, 2, 5055, 5056,
-	7, 84, 2, 2, 5056, 5057, 7, 79, 2, 2, 5057, 5058, 7, 67, 2, 2, 5058, 5059,
-	7, 86, 2, 2, 5059, 672, 3, 2, 2, 2, 5060, 5061, 7, 69, 2, 2, 5061, 5062,
-	7, 81, 2, 2, 5062, 5063, 7, 78, 2, 2, 5063, 5064, 7, 87, 2, 2, 5064, 5065,
-	7, 79, 2, 2, 5065, 5066, 7, 80, 2, 2, 5066, 5067, 7, 97, 2, 2, 5067, 5068,
-	7, 80, 2, 2, 5068, 5069, 7, 67, 2, 2, 5069, 5070, 7, 79, 2, 2, 5070, 5071,
-	7, 71, 2, 2, 5071, 674, 3, 2, 2, 2, 5072, 5073, 7, 69, 2, 2, 5073, 5074,
-	7, 81, 2, 2, 5074, 5075, 7, 79, 2, 2, 5075, 5076, 7, 79, 2, 2, 5076, 5077,
-	7, 71, 2, 2, 5077, 5078, 7, 80, 2, 2, 5078, 5079, 7, 86, 2, 2, 5079, 676,
-	3, 2, 2, 2, 5080, 5081, 7, 69, 2, 2, 5081, 5082, 7, 81, 2, 2, 5082, 5083,
-	7, 79, 2, 2, 5083, 5084, 7, 79, 2, 2, 5084, 5085, 7, 75, 2, 2, 5085, 5086,
-	7, 86, 2, 2, 5086, 678, 3, 2, 2, 2, 5087, 5088, 7, 69, 2, 2, 5088, 5089,
-	7, 81, 2, 2, 5089, 5090, 7, 79, 2, 2, 5090, 5091, 7, 82, 2, 2, 5091, 5092,
-	7, 67, 2, 2, 5092, 5093, 7, 69, 2, 2, 5093, 5094, 7, 86, 2, 2, 5094, 680,
-	3, 2, 2, 2, 5095, 5096, 7, 69, 2, 2, 5096, 5097, 7, 81, 2, 2, 5097, 5098,
-	7, 79, 2, 2, 5098, 5099, 7, 82, 2, 2, 5099, 5100, 7, 78, 2, 2, 5100, 5101,
-	7, 71, 2, 2, 5101, 5102, 7, 86, 2, 2, 5102, 5103, 7, 75, 2, 2, 5103, 5104,
-	7, 81, 2, 2, 5104, 5105, 7, 80, 2, 2, 5105, 682, 3, 2, 2, 2, 5106, 5107,
-	7, 69, 2, 2, 5107, 5108, 7, 81, 2, 2, 5108, 5109, 7, 79, 2, 2, 5109, 5110,
-	7, 82, 2, 2, 5110, 5111, 7, 84, 2, 2, 5111, 5112, 7, 71, 2, 2, 5112, 5113,
-	7, 85, 2, 2, 5113, 5114, 7, 85, 2, 2, 5114, 5115, 7, 71, 2, 2, 5115, 5116,
-	7, 70, 2, 2, 5116, 684, 3, 2, 2, 2, 5117, 5118, 7, 69, 2, 2, 5118, 5119,
-	7, 81, 2, 2, 5119, 5120, 7, 79, 2, 2, 5120, 5121, 7, 82, 2, 2, 5121, 5122,
-	7, 84, 2, 2, 5122, 5123, 7, 71, 2, 2, 5123, 5124, 7, 85, 2, 2, 5124, 5125,
-	7, 85, 2, 2, 5125, 5126, 7, 75, 2, 2, 5126, 5127, 7, 81, 2, 2, 5127, 5128,
-	7, 80, 2, 2, 5128, 686, 3, 2, 2, 2, 5129, 5130, 7, 69, 2, 2, 5130, 5131,
-	7, 81, 2, 2, 5131, 5132, 7, 80, 2, 2, 5132, 5133, 7, 69, 2, 2, 5133, 5134,
-	7, 87, 2, 2, 5134, 5135, 7, 84, 2, 2, 5135, 5136, 7, 84, 2, 2, 5136, 5137,
-	7, 71, 2, 2, 5137, 5138, 7, 80, 2, 2, 5138, 5139, 7, 86, 2, 2, 5139, 688,
-	3, 2, 2, 2, 5140, 5141, 7, 69, 2, 2, 5141, 5142, 7, 81, 2, 2, 5142, 5143,
-	7, 80, 2, 2, 5143, 5144, 7, 80, 2, 2, 5144, 5145, 7, 71, 2, 2, 5145, 5146,
-	7, 69, 2, 2, 5146, 5147, 7, 86, 2, 2, 5147, 5148, 7, 75, 2, 2, 5148, 5149,
-	7, 81, 2, 2, 5149, 5150, 7, 80, 2, 2, 5150, 690, 3, 2, 2, 2, 5151, 5152,
-	7, 69, 2, 2, 5152, 5153, 7, 81, 2, 2, 5153, 5154, 7, 80, 2, 2, 5154, 5155,
-	7, 85, 2, 2, 5155, 5156, 7, 75, 2, 2, 5156, 5157, 7, 85, 2, 2, 5157, 5158,
-	7, 86, 2, 2, 5158, 5159, 7, 71, 2, 2, 5159, 5160, 7, 80, 2, 2, 5160, 5161,
-	7, 86, 2, 2, 5161, 692, 3, 2, 2, 2, 5162, 5163, 7, 69, 2, 2, 5163, 5164,
-	7, 81, 2, 2, 5164, 5165, 7, 80, 2, 2, 5165, 5166, 7, 85, 2, 2, 5166, 5167,
-	7, 86, 2, 2, 5167, 5168, 7, 84, 2, 2, 5168, 5169, 7, 67, 2, 2, 5169, 5170,
-	7, 75, 2, 2, 5170, 5171, 7, 80, 2, 2, 5171, 5172, 7, 86, 2, 2, 5172, 5173,
-	7, 97, 2, 2, 5173, 5174, 7, 69, 2, 2, 5174, 5175, 7, 67, 2, 2, 5175, 5176,
-	7, 86, 2, 2, 5176, 5177, 7, 67, 2, 2, 5177, 5178, 7, 78, 2, 2, 5178, 5179,
-	7, 81, 2, 2, 5179, 5180, 7, 73, 2, 2, 5180, 694, 3, 2, 2, 2, 5181, 5182,
-	7, 69, 2, 2, 5182, 5183, 7, 81, 2, 2, 5183, 5184, 7, 80, 2, 2, 5184, 5185,
-	7, 85, 2, 2, 5185, 5186, 7, 86, 2, 2, 5186, 5187, 7, 84, 2, 2, 5187, 5188,
-	7, 67, 2, 2, 5188, 5189, 7, 75, 2, 2, 5189, 5190, 7, 80, 2, 2, 5190, 5191,
-	7, 86, 2, 2, 5191, 5192, 7, 97, 2, 2, 5192, 5193, 7, 85, 2, 2, 5193, 5194,
-	7, 69, 2, 2, 5194, 5195, 7, 74, 2, 2, 5195, 5196, 7, 71, 2, 2, 5196, 5197,
-	7, 79, 2, 2, 5197, 5198, 7, 67, 2, 2, 5198, 696, 3, 2, 2, 2, 5199, 5200,
-	7, 69, 2, 2, 5200, 5201, 7, 81, 2, 2, 5201, 5202, 7, 80, 2, 2, 5202, 5203,
-	7, 85, 2, 2, 5203, 5204, 7, 86, 2, 2, 5204, 5205, 7, 84, 2, 2, 5205, 5206,
-	7, 67, 2, 2, 5206, 5207, 7, 75, 2, 2, 5207, 5208, 7, 80, 2, 2, 5208, 5209,
-	7, 86, 2, 2, 5209, 5210, 7, 97, 2, 2, 5210, 5211, 7, 80, 2, 2, 5211, 5212,
-	7, 67, 2, 2, 5212, 5213, 7, 79, 2, 2, 5213, 5214, 7, 71, 2, 2, 5214, 698,
-	3, 2, 2, 2, 5215, 5216, 7, 69, 2, 2, 5216, 5217, 7, 81, 2, 2, 5217, 5218,
-	7, 80, 2, 2, 5218, 5219, 7, 86, 2, 2, 5219, 5220, 7, 67, 2, 2, 5220, 5221,
-	7, 75, 2, 2, 5221, 5222, 7, 80, 2, 2, 5222, 5223, 7, 85, 2, 2, 5223, 700,
-	3, 2, 2, 2, 5224, 5225, 7, 69, 2, 2, 5225, 5226, 7, 81, 2, 2, 5226, 5227,
-	7, 80, 2, 2, 5227, 5228, 7, 86, 2, 2, 5228, 5229, 7, 71, 2, 2, 5229, 5230,
-	7, 90, 2, 2, 5230, 5231, 7, 86, 2, 2, 5231, 702, 3, 2, 2, 2, 5232, 5233,
-	7, 69, 2, 2, 5233, 5234, 7, 81, 2, 2, 5234, 5235, 7, 80, 2, 2, 5235, 5236,
-	7, 86, 2, 2, 5236, 5237, 7, 84, 2, 2, 5237, 5238, 7, 75, 2, 2, 5238, 5239,
-	7, 68, 2, 2, 5239, 5240, 7, 87, 2, 2, 5240, 5241, 7, 86, 2, 2, 5241, 5242,
-	7, 81, 2, 2, 5242, 5243, 7, 84, 2, 2, 5243, 5244, 7, 85, 2, 2, 5244, 704,
-	3, 2, 2, 2, 5245, 5246, 7, 69, 2, 2, 5246, 5247, 7, 81, 2, 2, 5247, 5248,
-	7, 82, 2, 2, 5248, 5249, 7, 91, 2, 2, 5249, 706, 3, 2, 2, 2, 5250, 5251,
-	7, 69, 2, 2, 5251, 5252, 7, 82, 2, 2, 5252, 5253, 7, 87, 2, 2, 5253, 708,
-	3, 2, 2, 2, 5254, 5255, 7, 69, 2, 2, 5255, 5256, 7, 87, 2, 2, 5256, 5257,
-	7, 84, 2, 2, 5257, 5258, 7, 85, 2, 2, 5258, 5259, 7, 81, 2, 2, 5259, 5260,
-	7, 84, 2, 2, 5260, 5261, 7, 97, 2, 2, 5261, 5262, 7, 80, 2, 2, 5262, 5263,
-	7, 67, 2, 2, 5263, 5264, 7, 79, 2, 2, 5264, 5265, 7, 71, 2, 2, 5265, 710,
-	3, 2, 2, 2, 5266, 5267, 7, 70, 2, 2, 5267, 5268, 7, 67, 2, 2, 5268, 5269,
-	7, 86, 2, 2, 5269, 5270, 7, 67, 2, 2, 5270, 712, 3, 2, 2, 2, 5271, 5272,
-	7, 70, 2, 2, 5272, 5273, 7, 67, 2, 2, 5273, 5274, 7, 86, 2, 2, 5274, 5275,
-	7, 67, 2, 2, 5275, 5276, 7, 72, 2, 2, 5276, 5277, 7, 75, 2, 2, 5277, 5278,
-	7, 78, 2, 2, 5278, 5279, 7, 71, 2, 2, 5279, 714, 3, 2, 2, 2, 5280, 5281,
-	7, 70, 2, 2, 5281, 5282, 7, 71, 2, 2, 5282, 5283, 7, 67, 2, 2, 5283, 5284,
-	7, 78, 2, 2, 5284, 5285, 7, 78, 2, 2, 5285, 5286, 7, 81, 2, 2, 5286, 5287,
-	7, 69, 2, 2, 5287, 5288, 7, 67, 2, 2, 5288, 5289, 7, 86, 2, 2, 5289, 5290,
-	7, 71, 2, 2, 5290, 716, 3, 2, 2, 2, 5291, 5292, 7, 70, 2, 2, 5292, 5293,
-	7, 71, 2, 2, 5293, 5294, 7, 72, 2, 2, 5294, 5295, 7, 67, 2, 2, 5295, 5296,
-	7, 87, 2, 2, 5296, 5297, 7, 78, 2, 2, 5297, 5298, 7, 86, 2, 2, 5298, 5299,
-	7, 97, 2, 2, 5299, 5300, 7, 67, 2, 2, 5300, 5301, 7, 87, 2, 2, 5301, 5302,
-	7, 86, 2, 2, 5302, 5303, 7, 74, 2, 2, 5303, 718, 3, 2, 2, 2, 5304, 5305,
-	7, 70, 2, 2, 5305, 5306, 7, 71, 2, 2, 5306, 5307, 7, 72, 2, 2, 5307, 5308,
-	7, 75, 2, 2, 5308, 5309, 7, 80, 2, 2, 5309, 5310, 7, 71, 2, 2, 5310, 5311,
-	7, 84, 2, 2, 5311, 720, 3, 2, 2, 2, 5312, 5313, 7, 70, 2, 2, 5313, 5314,
-	7, 71, 2, 2, 5314, 5315, 7, 78, 2, 2, 5315, 5316, 7, 67, 2, 2, 5316, 5317,
-	7, 91, 2, 2, 5317, 5318, 7, 97, 2, 2, 5318, 5319, 7, 77, 2, 2, 5319, 5320,
-	7, 71, 2, 2, 5320, 5321, 7, 91, 2, 2, 5321, 5322, 7, 97, 2, 2, 5322, 5323,
-	7, 89, 2, 2, 5323, 5324, 7, 84, 2, 2, 5324, 5325, 7, 75, 2, 2, 5325, 5326,
-	7, 86, 2, 2, 5326, 5327, 7, 71, 2, 2, 5327, 722, 3, 2, 2, 2, 5328, 5329,
-	7, 70, 2, 2, 5329, 5330, 7, 71, 2, 2, 5330, 5331, 7, 85, 2, 2, 5331, 5332,
-	7, 97, 2, 2, 5332, 5333, 7, 77, 2, 2, 5333, 5334, 7, 71, 2, 2, 5334, 5335,
-	7, 91, 2, 2, 5335, 5336, 7, 97, 2, 2, 5336, 5337, 7, 72, 2, 2, 5337, 5338,
-	7, 75, 2, 2, 5338, 5339, 7, 78, 2, 2, 5339, 5340, 7, 71, 2, 2, 5340, 724,
-	3, 2, 2, 2, 5341, 5342, 7, 70, 2, 2, 5342, 5343, 7, 75, 2, 2, 5343, 5344,
-	7, 84, 2, 2, 5344, 5345, 7, 71, 2, 2, 5345, 5346, 7, 69, 2, 2, 5346, 5347,
-	7, 86, 2, 2, 5347, 5348, 7, 81, 2, 2, 5348, 5349, 7, 84, 2, 2, 5349, 5350,
-	7, 91, 2, 2, 5350, 726, 3, 2, 2, 2, 5351, 5352, 7, 70, 2, 2, 5352, 5353,
-	7, 75, 2, 2, 5353, 5354, 7, 85, 2, 2, 5354, 5355, 7, 67, 2, 2, 5355, 5356,
-	7, 68, 2, 2, 5356, 5357, 7, 78, 2, 2, 5357, 5358, 7, 71, 2, 2, 5358, 728,
-	3, 2, 2, 2, 5359, 5360, 7, 70, 2, 2, 5360, 5361, 7, 75, 2, 2, 5361, 5362,
-	7, 85, 2, 2, 5362, 5363, 7, 69, 2, 2, 5363, 5364, 7, 67, 2, 2, 5364, 5365,
-	7, 84, 2, 2, 5365, 5366, 7, 70, 2, 2, 5366, 730, 3, 2, 2, 2, 5367, 5368,
-	7, 70, 2, 2, 5368, 5369, 7, 75, 2, 2, 5369, 5370, 7, 85, 2, 2, 5370, 5371,
-	7, 77, 2, 2, 5371, 732, 3, 2, 2, 2, 5372, 5373, 7, 70, 2, 2, 5373, 5374,
-	7, 81, 2, 2, 5374, 734, 3, 2, 2, 2, 5375, 5376, 7, 70, 2, 2, 5376, 5377,
-	7, 87, 2, 2, 5377, 5378, 7, 79, 2, 2, 5378, 5379, 7, 82, 2, 2, 5379, 5380,
-	7, 72, 2, 2, 5380, 5381, 7, 75, 2, 2, 5381, 5382, 7, 78, 2, 2, 5382, 5383,
-	7, 71, 2, 2, 5383, 736, 3, 2, 2, 2, 5384, 5385, 7, 70, 2, 2, 5385, 5386,
-	7, 87, 2, 2, 5386, 5387, 7, 82, 2, 2, 5387, 5388, 7, 78, 2, 2, 5388, 5389,
-	7, 75, 2, 2, 5389, 5390, 7, 69, 2, 2, 5390, 5391, 7, 67, 2, 2, 5391, 5392,
-	7, 86, 2, 2, 5392, 5393, 7, 71, 2, 2, 5393, 738, 3, 2, 2, 2, 5394, 5395,
-	7, 70, 2, 2, 5395, 5396, 7, 91, 2, 2, 5396, 5397, 7, 80, 2, 2, 5397, 5398,
-	7, 67, 2, 2, 5398, 5399, 7, 79, 2, 2, 5399, 5400, 7, 75, 2, 2, 5400, 5401,
-	7, 69, 2, 2, 5401, 740, 3, 2, 2, 2, 5402, 5403, 7, 71, 2, 2, 5403, 5404,
-	7, 80, 2, 2, 5404, 5405, 7, 67, 2, 2, 5405, 5406, 7, 68, 2, 2, 5406, 5407,
-	7, 78, 2, 2, 5407, 5408, 7, 71, 2, 2, 5408, 742, 3, 2, 2, 2, 5409, 5410,
-	7, 71, 2, 2, 5410, 5411, 7, 80, 2, 2, 5411, 5412, 7, 69, 2, 2, 5412, 5413,
-	7, 84, 2, 2, 5413, 5414, 7, 91, 2, 2, 5414, 5415, 7, 82, 2, 2, 5415, 5416,
-	7, 86, 2, 2, 5416, 5417, 7, 75, 2, 2, 5417, 5418, 7, 81, 2, 2, 5418, 5419,
-	7, 80, 2, 2, 5419, 744, 3, 2, 2, 2, 5420, 5421, 7, 71, 2, 2, 5421, 5422,
-	7, 80, 2, 2, 5422, 5423, 7, 70, 2, 2, 5423, 746, 3, 2, 2, 2, 5424, 5425,
-	7, 71, 2, 2, 5425, 5426, 7, 80, 2, 2, 5426, 5427, 7, 70, 2, 2, 5427, 5428,
-	7, 85, 2, 2, 5428, 748, 3, 2, 2, 2, 5429, 5430, 7, 71, 2, 2, 5430, 5431,
-	7, 80, 2, 2, 5431, 5432, 7, 73, 2, 2, 5432, 5433, 7, 75, 2, 2, 5433, 5434,
-	7, 80, 2, 2, 5434, 5435, 7, 71, 2, 2, 5435, 750, 3, 2, 2, 2, 5436, 5437,
-	7, 71, 2, 2, 5437, 5438, 7, 80, 2, 2, 5438, 5439, 7, 73, 2, 2, 5439, 5440,
-	7, 75, 2, 2, 5440, 5441, 7, 80, 2, 2, 5441, 5442, 7, 71, 2, 2, 5442, 5443,
-	7, 85, 2, 2, 5443, 752, 3, 2, 2, 2, 5444, 5445, 7, 71, 2, 2, 5445, 5446,
-	7, 84, 2, 2, 5446, 5447, 7, 84, 2, 2, 5447, 5448, 7, 81, 2, 2, 5448, 5449,
-	7, 84, 2, 2, 5449, 754, 3, 2, 2, 2, 5450, 5451, 7, 71, 2, 2, 5451, 5452,
-	7, 84, 2, 2, 5452, 5453, 7, 84, 2, 2, 5453, 5454, 7, 81, 2, 2, 5454, 5455,
-	7, 84, 2, 2, 5455, 5456, 7, 85, 2, 2, 5456, 756, 3, 2, 2, 2, 5457, 5458,
-	7, 71, 2, 2, 5458, 5459, 7, 85, 2, 2, 5459, 5460, 7, 69, 2, 2, 5460, 5461,
-	7, 67, 2, 2, 5461, 5462, 7, 82, 2, 2, 5462, 5463, 7, 71, 2, 2, 5463, 758,
-	3, 2, 2, 2, 5464, 5465, 7, 71, 2, 2, 5465, 5466, 7, 88, 2, 2, 5466, 5467,
-	7, 71, 2, 2, 5467, 5468, 7, 80, 2, 2, 5468, 760, 3, 2, 2, 2, 5469, 5470,
-	7, 71, 2, 2, 5470, 5471, 7, 88, 2, 2, 5471, 5472, 7, 71, 2, 2, 5472, 5473,
-	7, 80, 2, 2, 5473, 5474, 7, 86, 2, 2, 5474, 762, 3, 2, 2, 2, 5475, 5476,
-	7, 71, 2, 2, 5476, 5477, 7, 88, 2, 2, 5477, 5478, 7, 71, 2, 2, 5478, 5479,
-	7, 80, 2, 2, 5479, 5480, 7, 86, 2, 2, 5480, 5481, 7, 85, 2, 2, 5481, 764,
-	3, 2, 2, 2, 5482, 5483, 7, 71, 2, 2, 5483, 5484, 7, 88, 2, 2, 5484, 5485,
-	7, 71, 2, 2, 5485, 5486, 7, 84, 2, 2, 5486, 5487, 7, 91, 2, 2, 5487, 766,
-	3, 2, 2, 2, 5488, 5489, 7, 71, 2, 2, 5489, 5490, 7, 90, 2, 2, 5490, 5491,
-	7, 69, 2, 2, 5491, 5492, 7, 74, 2, 2, 5492, 5493, 7, 67, 2, 2, 5493, 5494,
-	7, 80, 2, 2, 5494, 5495, 7, 73, 2, 2, 5495, 5496, 7, 71, 2, 2, 5496, 768,
-	3, 2, 2, 2, 5497, 5498, 7, 71, 2, 2, 5498, 5499, 7, 90, 2, 2, 5499, 5500,
-	7, 69, 2, 2, 5500, 5501, 7, 78, 2, 2, 5501, 5502, 7, 87, 2, 2, 5502, 5503,
-	7, 85, 2, 2, 5503, 5504, 7, 75, 2, 2, 5504, 5505, 7, 88, 2, 2, 5505, 5506,
-	7, 71, 2, 2, 5506, 770, 3, 2, 2, 2, 5507, 5508, 7, 71, 2, 2, 5508, 5509,
-	7, 90, 2, 2, 5509, 5510, 7, 82, 2, 2, 5510, 5511, 7, 75, 2, 2, 5511, 5512,
-	7, 84, 2, 2, 5512, 5513, 7, 71, 2, 2, 5513, 772, 3, 2, 2, 2, 5514, 5515,
-	7, 71, 2, 2, 5515, 5516, 7, 90, 2, 2, 5516, 5517, 7, 82, 2, 2, 5517, 5518,
-	7, 81, 2, 2, 5518, 5519, 7, 84, 2, 2, 5519, 5520, 7, 86, 2, 2, 5520, 774,
-	3, 2, 2, 2, 5521, 5522, 7, 71, 2, 2, 5522, 5523, 7, 90, 2, 2, 5523, 5524,
-	7, 86, 2, 2, 5524, 5525, 7, 71, 2, 2, 5525, 5526, 7, 80, 2, 2, 5526, 5527,
-	7, 70, 2, 2, 5527, 5528, 7, 71, 2, 2, 5528, 5529, 7, 70, 2, 2, 5529, 776,
-	3, 2, 2, 2, 5530, 5531, 7, 71, 2, 2, 5531, 5532, 7, 90, 2, 2, 5532, 5533,
-	7, 86, 2, 2, 5533, 5534, 7, 71, 2, 2, 5534, 5535, 7, 80, 2, 2, 5535, 5536,
-	7, 86, 2, 2, 5536, 5537, 7, 97, 2, 2, 5537, 5538, 7, 85, 2, 2, 5538, 5539,
-	7, 75, 2, 2, 5539, 5540, 7, 92, 2, 2, 5540, 5541, 7, 71, 2, 2, 5541, 778,
-	3, 2, 2, 2, 5542, 5543, 7, 72, 2, 2, 5543, 5544, 7, 67, 2, 2, 5544, 5545,
-	7, 85, 2, 2, 5545, 5546, 7, 86, 2, 2, 5546, 780, 3, 2, 2, 2, 5547, 5548,
-	7, 72, 2, 2, 5548, 5549, 7, 67, 2, 2, 5549, 5550, 7, 87, 2, 2, 5550, 5551,
-	7, 78, 2, 2, 5551, 5552, 7, 86, 2, 2, 5552, 5553, 7, 85, 2, 2, 5553, 782,
-	3, 2, 2, 2, 5554, 5555, 7, 72, 2, 2, 5555, 5556, 7, 75, 2, 2, 5556, 5557,
-	7, 71, 2, 2, 5557, 5558, 7, 78, 2, 2, 5558, 5559, 7, 70, 2, 2, 5559, 5560,
-	7, 85, 2, 2, 5560, 784, 3, 2, 2, 2, 5561, 5562, 7, 72, 2, 2, 5562, 5563,
-	7, 75, 2, 2, 5563, 5564, 7, 78, 2, 2, 5564, 5565, 7, 71, 2, 2, 5565, 5566,
-	7, 97, 2, 2, 5566, 5567, 7, 68, 2, 2, 5567, 5568, 7, 78, 2, 2, 5568, 5569,
-	7, 81, 2, 2, 5569, 5570, 7, 69, 2, 2, 5570, 5571, 7, 77, 2, 2, 5571, 5572,
-	7, 97, 2, 2, 5572, 5573, 7, 85, 2, 2, 5573, 5574, 7, 75, 2, 2, 5574, 5575,
-	7, 92, 2, 2, 5575, 5576, 7, 71, 2, 2, 5576, 786, 3, 2, 2, 2, 5577, 5578,
-	7, 72, 2, 2, 5578, 5579, 7, 75, 2, 2, 5579, 5580, 7, 78, 2, 2, 5580, 5581,
-	7, 86, 2, 2, 5581, 5582, 7, 71, 2, 2, 5582, 5583, 7, 84, 2, 2, 5583, 788,
-	3, 2, 2, 2, 5584, 5585, 7, 72, 2, 2, 5585, 5586, 7, 75, 2, 2, 5586, 5587,
-	7, 84, 2, 2, 5587, 5588, 7, 85, 2, 2, 5588, 5589, 7, 86, 2, 2, 5589, 790,
-	3, 2, 2, 2, 5590, 5591, 7, 72, 2, 2, 5591, 5592, 7, 75, 2, 2, 5592, 5593,
-	7, 90, 2, 2, 5593, 5594, 7, 71, 2, 2, 5594, 5595, 7, 70, 2, 2, 5595, 792,
-	3, 2, 2, 2, 5596, 5597, 7, 72, 2, 2, 5597, 5598, 7, 78, 2, 2, 5598, 5599,
-	7, 87, 2, 2, 5599, 5600, 7, 85, 2, 2, 5600, 5601, 7, 74, 2, 2, 5601, 794,
-	3, 2, 2, 2, 5602, 5603, 7, 72, 2, 2, 5603, 5604, 7, 81, 2, 2, 5604, 5605,
-	7, 78, 2, 2, 5605, 5606, 7, 78, 2, 2, 5606, 5607, 7, 81, 2, 2, 5607, 5608,
-	7, 89, 2, 2, 5608, 5609, 7, 85, 2, 2, 5609, 796, 3, 2, 2, 2, 5610, 5611,
-	7, 72, 2, 2, 5611, 5612, 7, 81, 2, 2, 5612, 5613, 7, 87, 2, 2, 5613, 5614,
-	7, 80, 2, 2, 5614, 5615, 7, 70, 2, 2, 5615, 798, 3, 2, 2, 2, 5616, 5617,
-	7, 72, 2, 2, 5617, 5618, 7, 87, 2, 2, 5618, 5619, 7, 78, 2, 2, 5619, 5620,
-	7, 78, 2, 2, 5620, 800, 3, 2, 2, 2, 5621, 5622, 7, 72, 2, 2, 5622, 5623,
-	7, 87, 2, 2, 5623, 5624, 7, 80, 2, 2, 5624, 5625, 7, 69, 2, 2, 5625, 5626,
-	7, 86, 2, 2, 5626, 5627, 7, 75, 2, 2, 5627, 5628, 7, 81, 2, 2, 5628, 5629,
-	7, 80, 2, 2, 5629, 802, 3, 2, 2, 2, 5630, 5631, 7, 73, 2, 2, 5631, 5632,
-	7, 71, 2, 2, 5632, 5633, 7, 80, 2, 2, 5633, 5634, 7, 71, 2, 2, 5634, 5635,
-	7, 84, 2, 2, 5635, 5636, 7, 67, 2, 2, 5636, 5637, 7, 78, 2, 2, 5637, 804,
-	3, 2, 2, 2, 5638, 5639, 7, 73, 2, 2, 5639, 5640, 7, 78, 2, 2, 5640, 5641,
-	7, 81, 2, 2, 5641, 5642, 7, 68, 2, 2, 5642, 5643, 7, 67, 2, 2, 5643, 5644,
-	7, 78, 2, 2, 5644, 806, 3, 2, 2, 2, 5645, 5646, 7, 73, 2, 2, 5646, 5647,
-	7, 84, 2, 2, 5647, 5648, 7, 67, 2, 2, 5648, 5649, 7, 80, 2, 2, 5649, 5650,
-	7, 86, 2, 2, 5650, 5651, 7, 85, 2, 2, 5651, 808, 3, 2, 2, 2, 5652, 5653,
-	7, 73, 2, 2, 5653, 5654, 7, 84, 2, 2, 5654, 5655, 7, 81, 2, 2, 5655, 5656,
-	7, 87, 2, 2, 5656, 5657, 7, 82, 2, 2, 5657, 5658, 7, 97, 2, 2, 5658, 5659,
-	7, 84, 2, 2, 5659, 5660, 7, 71, 2, 2, 5660, 5661, 7, 82, 2, 2, 5661, 5662,
-	7, 78, 2, 2, 5662, 5663, 7, 75, 2, 2, 5663, 5664, 7, 69, 2, 2, 5664, 5665,
-	7, 67, 2, 2, 5665, 5666, 7, 86, 2, 2, 5666, 5667, 7, 75, 2, 2, 5667, 5668,
-	7, 81, 2, 2, 5668, 5669, 7, 80, 2, 2, 5669, 810, 3, 2, 2, 2, 5670, 5671,
-	7, 74, 2, 2, 5671, 5672, 7, 67, 2, 2, 5672, 5673, 7, 80, 2, 2, 5673, 5674,
-	7, 70, 2, 2, 5674, 5675, 7, 78, 2, 2, 5675, 5676, 7, 71, 2, 2, 5676, 5677,
-	7, 84, 2, 2, 5677, 812, 3, 2, 2, 2, 5678, 5679, 7, 74, 2, 2, 5679, 5680,
-	7, 67, 2, 2, 5680, 5681, 7, 85, 2, 2, 5681, 5682, 7, 74, 2, 2, 5682, 814,
-	3, 2, 2, 2, 5683, 5684, 7, 74, 2, 2, 5684, 5685, 7, 71, 2, 2, 5685, 5686,
-	7, 78, 2, 2, 5686, 5687, 7, 82, 2, 2, 5687, 816, 3, 2, 2, 2, 5688, 5689,
-	7, 74, 2, 2, 5689, 5690, 7, 81, 2, 2, 5690, 5691, 7, 85, 2, 2, 5691, 5692,
-	7, 86, 2, 2, 5692, 818, 3, 2, 2, 2, 5693, 5694, 7, 74, 2, 2, 5694, 5695,
-	7, 81, 2, 2, 5695, 5696, 7, 85, 2, 2, 5696, 5697, 7, 86, 2, 2, 5697, 5698,
-	7, 85, 2, 2, 5698, 820, 3, 2, 2, 2, 5699, 5700, 7, 75, 2, 2, 5700, 5701,
-	7, 70, 2, 2, 5701, 5702, 7, 71, 2, 2, 5702, 5703, 7, 80, 2, 2, 5703, 5704,
-	7, 86, 2, 2, 5704, 5705, 7, 75, 2, 2, 5705, 5706, 7, 72, 2, 2, 5706, 5707,
-	7, 75, 2, 2, 5707, 5708, 7, 71, 2, 2, 5708, 5709, 7, 70, 2, 2, 5709, 822,
-	3, 2, 2, 2, 5710, 5711, 7, 75, 2, 2, 5711, 5712, 7, 73, 2, 2, 5712, 5713,
-	7, 80, 2, 2, 5713, 5714, 7, 81, 2, 2, 5714, 5715, 7, 84, 2, 2, 5715, 5716,
-	7, 71, 2, 2, 5716, 5717, 7, 97, 2, 2, 5717, 5718, 7, 85, 2, 2, 5718, 5719,
-	7, 71, 2, 2, 5719, 5720, 7, 84, 2, 2, 5720, 5721, 7, 88, 2, 2, 5721, 5722,
-	7, 71, 2, 2, 5722, 5723, 7, 84, 2, 2, 5723, 5724, 7, 97, 2, 2, 5724, 5725,
-	7, 75, 2, 2, 5725, 5726, 7, 70, 2, 2, 5726, 5727, 7, 85, 2, 2, 5727, 824,
-	3, 2, 2, 2, 5728, 5729, 7, 75, 2, 2, 5729, 5730, 7, 79, 2, 2, 5730, 5731,
-	7, 82, 2, 2, 5731, 5732, 7, 81, 2, 2, 5732, 5733, 7, 84, 2, 2, 5733, 5734,
-	7, 86, 2, 2, 5734, 826, 3, 2, 2, 2, 5735, 5736, 7, 75, 2, 2, 5736, 5737,
-	7, 80, 2, 2, 5737, 5738, 7, 70, 2, 2, 5738, 5739, 7, 71, 2, 2, 5739, 5740,
-	7, 90, 2, 2, 5740, 5741, 7, 71, 2, 2, 5741, 5742, 7, 85, 2, 2, 5742, 828,
-	3, 2, 2, 2, 5743, 5744, 7, 75, 2, 2, 5744, 5745, 7, 80, 2, 2, 5745, 5746,
-	7, 75, 2, 2, 5746, 5747, 7, 86, 2, 2, 5747, 5748, 7, 75, 2, 2, 5748, 5749,
-	7, 67, 2, 2, 5749, 5750, 7, 78, 2, 2, 5750, 5751, 7, 97, 2, 2, 5751, 5752,
-	7, 85, 2, 2, 5752, 5753, 7, 75, 2, 2, 5753, 5754, 7, 92, 2, 2, 5754, 5755,
-	7, 71, 2, 2, 5755, 830, 3, 2, 2, 2, 5756, 5757, 7, 75, 2, 2, 5757, 5758,
-	7, 80, 2, 2, 5758, 5759, 7, 82, 2, 2, 5759, 5760, 7, 78, 2, 2, 5760, 5761,
-	7, 67, 2, 2, 5761, 5762, 7, 69, 2, 2, 5762, 5763, 7, 71, 2, 2, 5763, 832,
-	3, 2, 2, 2, 5764, 5765, 7, 75, 2, 2, 5765, 5766, 7, 80, 2, 2, 5766, 5767,
-	7, 85, 2, 2, 5767, 5768, 7, 71, 2, 2, 5768, 5769, 7, 84, 2, 2, 5769, 5770,
-	7, 86, 2, 2, 5770, 5771, 7, 97, 2, 2, 5771, 5772, 7, 79, 2, 2, 5772, 5773,
-	7, 71, 2, 2, 5773, 5774, 7, 86, 2, 2, 5774, 5775, 7, 74, 2, 2, 5775, 5776,
-	7, 81, 2, 2, 5776, 5777, 7, 70, 2, 2, 5777, 834, 3, 2, 2, 2, 5778, 5779,
-	7, 75, 2, 2, 5779, 5780, 7, 80, 2, 2, 5780, 5781, 7, 85, 2, 2, 5781, 5782,
-	7, 86, 2, 2, 5782, 5783, 7, 67, 2, 2, 5783, 5784, 7, 78, 2, 2, 5784, 5785,
-	7, 78, 2, 2, 5785, 836, 3, 2, 2, 2, 5786, 5787, 7, 75, 2, 2, 5787, 5788,
-	7, 80, 2, 2, 5788, 5789, 7, 85, 2, 2, 5789, 5790, 7, 86, 2, 2, 5790, 5791,
-	7, 67, 2, 2, 5791, 5792, 7, 80, 2, 2, 5792, 5793, 7, 69, 2, 2, 5793, 5794,
-	7, 71, 2, 2, 5794, 838, 3, 2, 2, 2, 5795, 5796, 7, 75, 2, 2, 5796, 5797,
-	7, 80, 2, 2, 5797, 5798, 7, 88, 2, 2, 5798, 5799, 7, 75, 2, 2, 5799, 5800,
-	7, 85, 2, 2, 5800, 5801, 7, 75, 2, 2, 5801, 5802, 7, 68, 2, 2, 5802, 5803,
-	7, 78, 2, 2, 5803, 5804, 7, 71, 2, 2, 5804, 840, 3, 2, 2, 2, 5805, 5806,
-	7, 75, 2, 2, 5806, 5807, 7, 80, 2, 2, 5807, 5808, 7, 88, 2, 2, 5808, 5809,
-	7, 81, 2, 2, 5809, 5810, 7, 77, 2, 2, 5810, 5811, 7, 71, 2, 2, 5811, 5812,
-	7, 84, 2, 2, 5812, 842, 3, 2, 2, 2, 5813, 5814, 7, 75, 2, 2, 5814, 5815,
-	7, 81, 2, 2, 5815, 844, 3, 2, 2, 2, 5816, 5817, 7, 75, 2, 2, 5817, 5818,
-	7, 81, 2, 2, 5818, 5819, 7, 97, 2, 2, 5819, 5820, 7, 86, 2, 2, 5820, 5821,
-	7, 74, 2, 2, 5821, 5822, 7, 84, 2, 2, 5822, 5823, 7, 71, 2, 2, 5823, 5824,
-	7, 67, 2, 2, 5824, 5825, 7, 70, 2, 2, 5825, 846, 3, 2, 2, 2, 5826, 5827,
-	7, 75, 2, 2, 5827, 5828, 7, 82, 2, 2, 5828, 5829, 7, 69, 2, 2, 5829, 848,
-	3, 2, 2, 2, 5830, 5831, 7, 75, 2, 2, 5831, 5832, 7, 85, 2, 2, 5832, 5833,
-	7, 81, 2, 2, 5833, 5834, 7, 78, 2, 2, 5834, 5835, 7, 67, 2, 2, 5835, 5836,
-	7, 86, 2, 2, 5836, 5837, 7, 75, 2, 2, 5837, 5838, 7, 81, 2, 2, 5838, 5839,
-	7, 80, 2, 2, 5839, 850, 3, 2, 2, 2, 5840, 5841, 7, 75, 2, 2, 5841, 5842,
-	7, 85, 2, 2, 5842, 5843, 7, 85, 2, 2, 5843, 5844, 7, 87, 2, 2, 5844, 5845,
-	7, 71, 2, 2, 5845, 5846, 7, 84, 2, 2, 5846, 852, 3, 2, 2, 2, 5847, 5848,
-	7, 76, 2, 2, 5848, 5849, 7, 85, 2, 2, 5849, 5850, 7, 81, 2, 2, 5850, 5851,
-	7, 80, 2, 2, 5851, 854, 3, 2, 2, 2, 5852, 5853, 7, 77, 2, 2, 5853, 5854,
-	7, 71, 2, 2, 5854, 5855, 7, 91, 2, 2, 5855, 5856, 7, 97, 2, 2, 5856, 5857,
-	7, 68, 2, 2, 5857, 5858, 7, 78, 2, 2, 5858, 5859, 7, 81, 2, 2, 5859, 5860,
-	7, 69, 2, 2, 5860, 5861, 7, 77, 2, 2, 5861, 5862, 7, 97, 2, 2, 5862, 5863,
-	7, 85, 2, 2, 5863, 5864, 7, 75, 2, 2, 5864, 5865, 7, 92, 2, 2, 5865, 5866,
-	7, 71, 2, 2, 5866, 856, 3, 2, 2, 2, 5867, 5868, 7, 78, 2, 2, 5868, 5869,
-	7, 67, 2, 2, 5869, 5870, 7, 80, 2, 2, 5870, 5871, 7, 73, 2, 2, 5871, 5872,
-	7, 87, 2, 2, 5872, 5873, 7, 67, 2, 2, 5873, 5874, 7, 73, 2, 2, 5874, 5875,
-	7, 71, 2, 2, 5875, 858, 3, 2, 2, 2, 5876, 5877, 7, 78, 2, 2, 5877, 5878,
-	7, 67, 2, 2, 5878, 5879, 7, 85, 2, 2, 5879, 5880, 7, 86, 2, 2, 5880, 860,
-	3, 2, 2, 2, 5881, 5882, 7, 78, 2, 2, 5882, 5883, 7, 71, 2, 2, 5883, 5884,
-	7, 67, 2, 2, 5884, 5885, 7, 88, 2, 2, 5885, 5886, 7, 71, 2, 2, 5886, 5887,
-	7, 85, 2, 2, 5887, 862, 3, 2, 2, 2, 5888, 5889, 7, 78, 2, 2, 5889, 5890,
-	7, 71, 2, 2, 5890, 5891, 7, 85, 2, 2, 5891, 5892, 7, 85, 2, 2, 5892, 864,
-	3, 2, 2, 2, 5893, 5894, 7, 78, 2, 2, 5894, 5895, 7, 71, 2, 2, 5895, 5896,
-	7, 88, 2, 2, 5896, 5897, 7, 71, 2, 2, 5897, 5898, 7, 78, 2, 2, 5898, 866,
-	3, 2, 2, 2, 5899, 5900, 7, 78, 2, 2, 5900, 5901, 7, 75, 2, 2, 5901, 5902,
-	7, 85, 2, 2, 5902, 5903, 7, 86, 2, 2, 5903, 868, 3, 2, 2, 2, 5904, 5905,
-	7, 78, 2, 2, 5905, 5906, 7, 81, 2, 2, 5906, 5907, 7, 69, 2, 2, 5907, 5908,
-	7, 67, 2, 2, 5908, 5909, 7, 78, 2, 2, 5909, 870, 3, 2, 2, 2, 5910, 5911,
-	7, 78, 2, 2, 5911, 5912, 7, 81, 2, 2, 5912, 5913, 7, 73, 2, 2, 5913, 5914,
-	7, 72, 2, 2, 5914, 5915, 7, 75, 2, 2, 5915, 5916, 7, 78, 2, 2, 5916, 5917,
-	7, 71, 2, 2, 5917, 872, 3, 2, 2, 2, 5918, 5919, 7, 78, 2, 2, 5919, 5920,
-	7, 81, 2, 2, 5920, 5921, 7, 73, 2, 2, 5921, 5922, 7, 85, 2, 2, 5922, 874,
-	3, 2, 2, 2, 5923, 5924, 7, 79, 2, 2, 5924, 5925, 7, 67, 2, 2, 5925, 5926,
-	7, 85, 2, 2, 5926, 5927, 7, 86, 2, 2, 5927, 5928, 7, 71, 2, 2, 5928, 5929,
-	7, 84, 2, 2, 5929, 876, 3, 2, 2, 2, 5930, 5931, 7, 79, 2, 2, 5931, 5932,
-	7, 67, 2, 2, 5932, 5933, 7, 85, 2, 2, 5933, 5934, 7, 86, 2, 2, 5934, 5935,
-	7, 71, 2, 2, 5935, 5936, 7, 84, 2, 2, 5936, 5937, 7, 97, 2, 2, 5937, 5938,
-	7, 67, 2, 2, 5938, 5939, 7, 87, 2, 2, 5939, 5940, 7, 86, 2, 2, 5940, 5941,
-	7, 81, 2, 2, 5941, 5942, 7, 97, 2, 2, 5942, 5943, 7, 82, 2, 2, 5943, 5944,
-	7, 81, 2, 2, 5944, 5945, 7, 85, 2, 2, 5945, 5946, 7, 75, 2, 2, 5946, 5947,
-	7, 86, 2, 2, 5947, 5948, 7, 75, 2, 2, 5948, 5949, 7, 81, 2, 2, 5949, 5950,
-	7, 80, 2, 2, 5950, 878, 3, 2, 2, 2, 5951, 5952, 7, 79, 2, 2, 5952, 5953,
-	7, 67, 2, 2, 5953, 5954, 7, 85, 2, 2, 5954, 5955, 7, 86, 2, 2, 5955, 5956,
-	7, 71, 2, 2, 5956, 5957, 7, 84, 2, 2, 5957, 5958, 7, 97, 2, 2, 5958, 5959,
-	7, 69, 2, 2, 5959, 5960, 7, 81, 2, 2, 5960, 5961, 7, 80, 2, 2, 5961, 5962,
-	7, 80, 2, 2, 5962, 5963, 7, 71, 2, 2, 5963, 5964, 7, 69, 2, 2, 5964, 5965,
-	7, 86, 2, 2, 5965, 5966, 7, 97, 2, 2, 5966, 5967, 7, 84, 2, 2, 5967, 5968,
-	7, 71, 2, 2, 5968, 5969, 7, 86, 2, 2, 5969, 5970, 7, 84, 2, 2, 5970, 5971,
-	7, 91, 2, 2, 5971, 880, 3, 2, 2, 2, 5972, 5973, 7, 79, 2, 2, 5973, 5974,
-	7, 67, 2, 2, 5974, 5975, 7, 85, 2, 2, 5975, 5976, 7, 86, 2, 2, 5976, 5977,
-	7, 71, 2, 2, 5977, 5978, 7, 84, 2, 2, 5978, 5979, 7, 97, 2, 2, 5979, 5980,
-	7, 70, 2, 2, 5980, 5981, 7, 71, 2, 2, 5981, 5982, 7, 78, 2, 2, 5982, 5983,
-	7, 67, 2, 2, 5983, 5984, 7, 91, 2, 2, 5984, 882, 3, 2, 2, 2, 5985, 5986,
-	7, 79, 2, 2, 5986, 5987, 7, 67, 2, 2, 5987, 5988, 7, 85, 2, 2, 5988, 5989,
-	7, 86, 2, 2, 5989, 5990, 7, 71, 2, 2, 5990, 5991, 7, 84, 2, 2, 5991, 5992,
-	7, 97, 2, 2, 5992, 5993, 7, 74, 2, 2, 5993, 5994, 7, 71, 2, 2, 5994, 5995,
-	7, 67, 2, 2, 5995, 5996, 7, 84, 2, 2, 5996, 5997, 7, 86, 2, 2, 5997, 5998,
-	7, 68, 2, 2, 5998, 5999, 7, 71, 2, 2, 5999, 6000, 7, 67, 2, 2, 6000, 6001,
-	7, 86, 2, 2, 6001, 6002, 7, 97, 2, 2, 6002, 6003, 7, 82, 2, 2, 6003, 6004,
-	7, 71, 2, 2, 6004, 6005, 7, 84, 2, 2, 6005, 6006, 7, 75, 2, 2, 6006, 6007,
-	7, 81, 2, 2, 6007, 6008, 7, 70, 2, 2, 6008, 884, 3, 2, 2, 2, 6009, 6010,
-	7, 79, 2, 2, 6010, 6011, 7, 67, 2, 2, 6011, 6012, 7, 85, 2, 2, 6012, 6013,
-	7, 86, 2, 2, 6013, 6014, 7, 71, 2, 2, 6014, 6015, 7, 84, 2, 2, 6015, 6016,
-	7, 97, 2, 2, 6016, 6017, 7, 74, 2, 2, 6017, 6018, 7, 81, 2, 2, 6018, 6019,
-	7, 85, 2, 2, 6019, 6020, 7, 86, 2, 2, 6020, 886, 3, 2, 2, 2, 6021, 6022,
-	7, 79, 2, 2, 6022, 6023, 7, 67, 2, 2, 6023, 6024, 7, 85, 2, 2, 6024, 6025,
-	7, 86, 2, 2, 6025, 6026, 7, 71, 2, 2, 6026, 6027, 7, 84, 2, 2, 6027, 6028,
-	7, 97, 2, 2, 6028, 6029, 7, 78, 2, 2, 6029, 6030, 7, 81, 2, 2, 6030, 6031,
-	7, 73, 2, 2, 6031, 6032, 7, 97, 2, 2, 6032, 6033, 7, 72, 2, 2, 6033, 6034,
-	7, 75, 2, 2, 6034, 6035, 7, 78, 2, 2, 6035, 6036, 7, 71, 2, 2, 6036, 888,
-	3, 2, 2, 2, 6037, 6038, 7, 79, 2, 2, 6038, 6039, 7, 67, 2, 2, 6039, 6040,
-	7, 85, 2, 2, 6040, 6041, 7, 86, 2, 2, 6041, 6042, 7, 71, 2, 2, 6042, 6043,
-	7, 84, 2, 2, 6043, 6044, 7, 97, 2, 2, 6044, 6045, 7, 78, 2, 2, 6045, 6046,
-	7, 81, 2, 2, 6046, 6047, 7, 73, 2, 2, 6047, 6048, 7, 97, 2, 2, 6048, 6049,
-	7, 82, 2, 2, 6049, 6050, 7, 81, 2, 2, 6050, 6051, 7, 85, 2, 2, 6051, 890,
-	3, 2, 2, 2, 6052, 6053, 7, 79, 2, 2, 6053, 6054, 7, 67, 2, 2, 6054, 6055,
-	7, 85, 2, 2, 6055, 6056, 7, 86, 2, 2, 6056, 6057, 7, 71, 2, 2, 6057, 6058,
-	7, 84, 2, 2, 6058, 6059, 7, 97, 2, 2, 6059, 6060, 7, 82, 2, 2, 6060, 6061,
-	7, 67, 2, 2, 6061, 6062, 7, 85, 2, 2, 6062, 6063, 7, 85, 2, 2, 6063, 6064,
-	7, 89, 2, 2, 6064, 6065, 7, 81, 2, 2, 6065, 6066, 7, 84, 2, 2, 6066, 6067,
-	7, 70, 2, 2, 6067, 892, 3, 2, 2, 2, 6068, 6069, 7, 79, 2, 2, 6069, 6070,
-	7, 67, 2, 2, 6070, 6071, 7, 85, 2, 2, 6071, 6072, 7, 86, 2, 2, 6072, 6073,
-	7, 71, 2, 2, 6073, 6074, 7, 84, 2, 2, 6074, 6075, 7, 97, 2, 2, 6075, 6076,
-	7, 82, 2, 2, 6076, 6077, 7, 81, 2, 2, 6077, 6078, 7, 84, 2, 2, 6078, 6079,
-	7, 86, 2, 2, 6079, 894, 3, 2, 2, 2, 6080, 6081, 7, 79, 2, 2, 6081, 6082,
-	7, 67, 2, 2, 6082, 6083, 7, 85, 2, 2, 6083, 6084, 7, 86, 2, 2, 6084, 6085,
-	7, 71, 2, 2, 6085, 6086, 7, 84, 2, 2, 6086, 6087, 7, 97, 2, 2, 6087, 6088,
-	7, 84, 2, 2, 6088, 6089, 7, 71, 2, 2, 6089, 6090, 7, 86, 2, 2, 6090, 6091,
-	7, 84, 2, 2, 6091, 6092, 7, 91, 2, 2, 6092, 6093, 7, 97, 2, 2, 6093, 6094,
-	7, 69, 2, 2, 6094, 6095, 7, 81, 2, 2, 6095, 6096, 7, 87, 2, 2, 6096, 6097,
-	7, 80, 2, 2, 6097, 6098, 7, 86, 2, 2, 6098, 896, 3, 2, 2, 2, 6099, 6100,
-	7, 79, 2, 2, 6100, 6101, 7, 67, 2, 2, 6101, 6102, 7, 85, 2, 2, 6102, 6103,
-	7, 86, 2, 2, 6103, 6104, 7, 71, 2, 2, 6104, 6105, 7, 84, 2, 2, 6105, 6106,
-	7, 97, 2, 2, 6106, 6107, 7, 85, 2, 2, 6107, 6108, 7, 85, 2, 2, 6108, 6109,
-	7, 78, 2, 2, 6109, 898, 3, 2, 2, 2, 6110, 6111, 7, 79, 2, 2, 6111, 6112,
-	7, 67, 2, 2, 6112, 6113, 7, 85, 2, 2, 6113, 6114, 7, 86, 2, 2, 6114, 6115,
-	7, 71, 2, 2, 6115, 6116, 7, 84, 2, 2, 6116, 6117, 7, 97, 2, 2, 6117, 6118,
-	7, 85, 2, 2, 6118, 6119, 7, 85, 2, 2, 6119, 6120, 7, 78, 2, 2, 6120, 6121,
-	7, 97, 2, 2, 6121, 6122, 7, 69, 2, 2, 6122, 6123, 7, 67, 2, 2, 6123, 900,
-	3, 2, 2, 2, 6124, 6125, 7, 79, 2, 2, 6125, 6126, 7, 67, 2, 2, 6126, 6127,
-	7, 85, 2, 2, 6127, 6128, 7, 86, 2, 2, 6128, 6129, 7, 71, 2, 2, 6129, 6130,
-	7, 84, 2, 2, 6130, 6131, 7, 97, 2, 2, 6131, 6132, 7, 85, 2, 2, 6132, 6133,
-	7, 85, 2, 2, 6133, 6134, 7, 78, 2, 2, 6134, 6135, 7, 97, 2, 2, 6135, 6136,
-	7, 69, 2, 2, 6136, 6137, 7, 67, 2, 2, 6137, 6138, 7, 82, 2, 2, 6138, 6139,
-	7, 67, 2, 2, 6139, 6140, 7, 86, 2, 2, 6140, 6141, 7, 74, 2, 2, 6141, 902,
-	3, 2, 2, 2, 6142, 6143, 7, 79, 2, 2, 6143, 6144, 7, 67, 2, 2, 6144, 6145,
-	7, 85, 2, 2, 6145, 6146, 7, 86, 2, 2, 6146, 6147, 7, 71, 2, 2, 6147, 6148,
-	7, 84, 2, 2, 6148, 6149, 7, 97, 2, 2, 6149, 6150, 7, 85, 2, 2, 6150, 6151,
-	7, 85, 2, 2, 6151, 6152, 7, 78, 2, 2, 6152, 6153, 7, 97, 2, 2, 6153, 6154,
-	7, 69, 2, 2, 6154, 6155, 7, 71, 2, 2, 6155, 6156, 7, 84, 2, 2, 6156, 6157,
-	7, 86, 2, 2, 6157, 904, 3, 2, 2, 2, 6158, 6159, 7, 79, 2, 2, 6159, 6160,
-	7, 67, 2, 2, 6160, 6161, 7, 85, 2, 2, 6161, 6162, 7, 86, 2, 2, 6162, 6163,
-	7, 71, 2, 2, 6163, 6164, 7, 84, 2, 2, 6164, 6165, 7, 97, 2, 2, 6165, 6166,
-	7, 85, 2, 2, 6166, 6167, 7, 85, 2, 2, 6167, 6168, 7, 78, 2, 2, 6168, 6169,
-	7, 97, 2, 2, 6169, 6170, 7, 69, 2, 2, 6170, 6171, 7, 75, 2, 2, 6171, 6172,
-	7, 82, 2, 2, 6172, 6173, 7, 74, 2, 2, 6173, 6174, 7, 71, 2, 2, 6174, 6175,
-	7, 84, 2, 2, 6175, 906, 3, 2, 2, 2, 6176, 6177, 7, 79, 2, 2, 6177, 6178,
-	7, 67, 2, 2, 6178, 6179, 7, 85, 2, 2, 6179, 6180, 7, 86, 2, 2, 6180, 6181,
-	7, 71, 2, 2, 6181, 6182, 7, 84, 2, 2, 6182, 6183, 7, 97, 2, 2, 6183, 6184,
-	7, 85, 2, 2, 6184, 6185, 7, 85, 2, 2, 6185, 6186, 7, 78, 2, 2, 6186, 6187,
-	7, 97, 2, 2, 6187, 6188, 7, 69, 2, 2, 6188, 6189, 7, 84, 2, 2, 6189, 6190,
-	7, 78, 2, 2, 6190, 908, 3, 2, 2, 2, 6191, 6192, 7, 79, 2, 2, 6192, 6193,
-	7, 67, 2, 2, 6193, 6194, 7, 85, 2, 2, 6194, 6195, 7, 86, 2, 2, 6195, 6196,
-	7, 71, 2, 2, 6196, 6197, 7, 84, 2, 2, 6197, 6198, 7, 97, 2, 2, 6198, 6199,
-	7, 85, 2, 2, 6199, 6200, 7, 85, 2, 2, 6200, 6201, 7, 78, 2, 2, 6201, 6202,
-	7, 97, 2, 2, 6202, 6203, 7, 69, 2, 2, 6203, 6204, 7, 84, 2, 2, 6204, 6205,
-	7, 78, 2, 2, 6205, 6206, 7, 82, 2, 2, 6206, 6207, 7, 67, 2, 2, 6207, 6208,
-	7, 86, 2, 2, 6208, 6209, 7, 74, 2, 2, 6209, 910, 3, 2, 2, 2, 6210, 6211,
-	7, 79, 2, 2, 6211, 6212, 7, 67, 2, 2, 6212, 6213, 7, 85, 2, 2, 6213, 6214,
-	7, 86, 2, 2, 6214, 6215, 7, 71, 2, 2, 6215, 6216, 7, 84, 2, 2, 6216, 6217,
-	7, 97, 2, 2, 6217, 6218, 7, 85, 2, 2, 6218, 6219, 7, 85, 2, 2, 6219, 6220,
-	7, 78, 2, 2, 6220, 6221, 7, 97, 2, 2, 6221, 6222, 7, 77, 2, 2, 6222, 6223,
-	7, 71, 2, 2, 6223, 6224, 7, 91, 2, 2, 6224, 912, 3, 2, 2, 2, 6225, 6226,
-	7, 79, 2, 2, 6226, 6227, 7, 67, 2, 2, 6227, 6228, 7, 85, 2, 2, 6228, 6229,
-	7, 86, 2, 2, 6229, 6230, 7, 71, 2, 2, 6230, 6231, 7, 84, 2, 2, 6231, 6232,
-	7, 97, 2, 2, 6232, 6233, 7, 86, 2, 2, 6233, 6234, 7, 78, 2, 2, 6234, 6235,
-	7, 85, 2, 2, 6235, 6236, 7, 97, 2, 2, 6236, 6237, 7, 88, 2, 2, 6237, 6238,
-	7, 71, 2, 2, 6238, 6239, 7, 84, 2, 2, 6239, 6240, 7, 85, 2, 2, 6240, 6241,
-	7, 75, 2, 2, 6241, 6242, 7, 81, 2, 2, 6242, 6243, 7, 80, 2, 2, 6243, 914,
-	3, 2, 2, 2, 6244, 6245, 7, 79, 2, 2, 6245, 6246, 7, 67, 2, 2, 6246, 6247,
-	7, 85, 2, 2, 6247, 6248, 7, 86, 2, 2, 6248, 6249, 7, 71, 2, 2, 6249, 6250,
-	7, 84, 2, 2, 6250, 6251, 7, 97, 2, 2, 6251, 6252, 7, 87, 2, 2, 6252, 6253,
-	7, 85, 2, 2, 6253, 6254, 7, 71, 2, 2, 6254, 6255, 7, 84, 2, 2, 6255, 916,
-	3, 2, 2, 2, 6256, 6257, 7, 79, 2, 2, 6257, 6258, 7, 67, 2, 2, 6258, 6259,
-	7, 90, 2, 2, 6259, 6260, 7, 97, 2, 2, 6260, 6261, 7, 69, 2, 2, 6261, 6262,
-	7, 81, 2, 2, 6262, 6263, 7, 80, 2, 2, 6263, 6264, 7, 80, 2, 2, 6264, 6265,
-	7, 71, 2, 2, 6265, 6266, 7, 69, 2, 2, 6266, 6267, 7, 86, 2, 2, 6267, 6268,
-	7, 75, 2, 2, 6268, 6269, 7, 81, 2, 2, 6269, 6270, 7, 80, 2, 2, 6270, 6271,
-	7, 85, 2, 2, 6271, 6272, 7, 97, 2, 2, 6272, 6273, 7, 82, 2, 2, 6273, 6274,
-	7, 71, 2, 2, 6274, 6275, 7, 84, 2, 2, 6275, 6276, 7, 97, 2, 2, 6276, 6277,
-	7, 74, 2, 2, 6277, 6278, 7, 81, 2, 2, 6278, 6279, 7, 87, 2, 2, 6279, 6280,
-	7, 84, 2, 2, 6280, 918, 3, 2, 2, 2, 6281, 6282, 7, 79, 2, 2, 6282, 6283,
-	7, 67, 2, 2, 6283, 6284, 7, 90, 2, 2, 6284, 6285, 7, 97, 2, 2, 6285, 6286,
-	7, 83, 2, 2, 6286, 6287, 7, 87, 2, 2, 6287, 6288, 7, 71, 2, 2, 6288, 6289,
-	7, 84, 2, 2, 6289, 6290, 7, 75, 2, 2, 6290, 6291, 7, 71, 2, 2, 6291, 6292,
-	7, 85, 2, 2, 6292, 6293, 7, 97, 2, 2, 6293, 6294, 7, 82, 2, 2, 6294, 6295,
-	7, 71, 2, 2, 6295, 6296, 7, 84, 2, 2, 6296, 6297, 7, 97, 2, 2, 6297, 6298,
-	7, 74, 2, 2, 6298, 6299, 7, 81, 2, 2, 6299, 6300, 7, 87, 2, 2, 6300, 6301,
-	7, 84, 2, 2, 6301, 920, 3, 2, 2, 2, 6302, 6303, 7, 79, 2, 2, 6303, 6304,
-	7, 67, 2, 2, 6304, 6305, 7, 90, 2, 2, 6305, 6306, 7, 97, 2, 2, 6306, 6307,
-	7, 84, 2, 2, 6307, 6308, 7, 81, 2, 2, 6308, 6309, 7, 89, 2, 2, 6309, 6310,
-	7, 85, 2, 2, 6310, 922, 3, 2, 2, 2, 6311, 6312, 7, 79, 2, 2, 6312, 6313,
-	7, 67, 2, 2, 6313, 6314, 7, 90, 2, 2, 6314, 6315, 7, 97, 2, 2, 6315, 6316,
-	7, 85, 2, 2, 6316, 6317, 7, 75, 2, 2, 6317, 6318, 7, 92, 2, 2, 6318, 6319,
-	7, 71, 2, 2, 6319, 924, 3, 2, 2, 2, 6320, 6321, 7, 79, 2, 2, 6321, 6322,
-	7, 67, 2, 2, 6322, 6323, 7, 90, 2, 2, 6323, 6324, 7, 97, 2, 2, 6324, 6325,
-	7, 87, 2, 2, 6325, 6326, 7, 82, 2, 2, 6326, 6327, 7, 70, 2, 2, 6327, 6328,
-	7, 67, 2, 2, 6328, 6329, 7, 86, 2, 2, 6329, 6330, 7, 71, 2, 2, 6330, 6331,
-	7, 85, 2, 2, 6331, 6332, 7, 97, 2, 2, 6332, 6333, 7, 82, 2, 2, 6333, 6334,
-	7, 71, 2, 2, 6334, 6335, 7, 84, 2, 2, 6335, 6336, 7, 97, 2, 2, 6336, 6337,
-	7, 74, 2, 2, 6337, 6338, 7, 81, 2, 2, 6338, 6339, 7, 87, 2, 2, 6339, 6340,
-	7, 84, 2, 2, 6340, 926, 3, 2, 2, 2, 6341, 6342, 7, 79, 2, 2, 6342, 6343,
-	7, 67, 2, 2, 6343, 6344, 7, 90, 2, 2, 6344, 6345, 7, 97, 2, 2, 6345, 6346,
-	7, 87, 2, 2, 6346, 6347, 7, 85, 2, 2, 6347, 6348, 7, 71, 2, 2, 6348, 6349,
-	7, 84, 2, 2, 6349, 6350, 7, 97, 2, 2, 6350, 6351, 7, 69, 2, 2, 6351, 6352,
-	7, 81, 2, 2, 6352, 6353, 7, 80, 2, 2, 6353, 6354, 7, 80, 2, 2, 6354, 6355,
-	7, 71, 2, 2, 6355, 6356, 7, 69, 2, 2, 6356, 6357, 7, 86, 2, 2, 6357, 6358,
-	7, 75, 2, 2, 6358, 6359, 7, 81, 2, 2, 6359, 6360, 7, 80, 2, 2, 6360, 6361,
-	7, 85, 2, 2, 6361, 928, 3, 2, 2, 2, 6362, 6363, 7, 79, 2, 2, 6363, 6364,
-	7, 71, 2, 2, 6364, 6365, 7, 70, 2, 2, 6365, 6366, 7, 75, 2, 2, 6366, 6367,
-	7, 87, 2, 2, 6367, 6368, 7, 79, 2, 2, 6368, 930, 3, 2, 2, 2, 6369, 6370,
-	7, 79, 2, 2, 6370, 6371, 7, 71, 2, 2, 6371, 6372, 7, 79, 2, 2, 6372, 6373,
-	7, 68, 2, 2, 6373, 6374, 7, 71, 2, 2, 6374, 6375, 7, 84, 2, 2, 6375, 932,
-	3, 2, 2, 2, 6376, 6377, 7, 79, 2, 2, 6377, 6378, 7, 71, 2, 2, 6378, 6379,
-	7, 84, 2, 2, 6379, 6380, 7, 73, 2, 2, 6380, 6381, 7, 71, 2, 2, 6381, 934,
-	3, 2, 2, 2, 6382, 6383, 7, 79, 2, 2, 6383, 6384, 7, 71, 2, 2, 6384, 6385,
-	7, 85, 2, 2, 6385, 6386, 7, 85, 2, 2, 6386, 6387, 7, 67, 2, 2, 6387, 6388,
-	7, 73, 2, 2, 6388, 6389, 7, 71, 2, 2, 6389, 6390, 7, 97, 2, 2, 6390, 6391,
-	7, 86, 2, 2, 6391, 6392, 7, 71, 2, 2, 6392, 6393, 7, 90, 2, 2, 6393, 6394,
-	7, 86, 2, 2, 6394, 936, 3, 2, 2, 2, 6395, 6396, 7, 79, 2, 2, 6396, 6397,
-	7, 75, 2, 2, 6397, 6398, 7, 70, 2, 2, 6398, 938, 3, 2, 2, 2, 6399, 6400,
-	7, 79, 2, 2, 6400, 6401, 7, 75, 2, 2, 6401, 6402, 7, 73, 2, 2, 6402, 6403,
-	7, 84, 2, 2, 6403, 6404, 7, 67, 2, 2, 6404, 6405, 7, 86, 2, 2, 6405, 6406,
-	7, 71, 2, 2, 6406, 940, 3, 2, 2, 2, 6407, 6408, 7, 79, 2, 2, 6408, 6409,
-	7, 75, 2, 2, 6409, 6410, 7, 80, 2, 2, 6410, 6411, 7, 97, 2, 2, 6411, 6412,
-	7, 84, 2, 2, 6412, 6413, 7, 81, 2, 2, 6413, 6414, 7, 89, 2, 2, 6414, 6415,
-	7, 85, 2, 2, 6415, 942, 3, 2, 2, 2, 6416, 6417, 7, 79, 2, 2, 6417, 6418,
-	7, 81, 2, 2, 6418, 6419, 7, 70, 2, 2, 6419, 6420, 7, 71, 2, 2, 6420, 944,
-	3, 2, 2, 2, 6421, 6422, 7, 79, 2, 2, 6422, 6423, 7, 81, 2, 2, 6423, 6424,
-	7, 70, 2, 2, 6424, 6425, 7, 75, 2, 2, 6425, 6426, 7, 72, 2, 2, 6426, 6427,
-	7, 91, 2, 2, 6427, 946, 3, 2, 2, 2, 6428, 6429, 7, 79, 2, 2, 6429, 6430,
-	7, 87, 2, 2, 6430, 6431, 7, 86, 2, 2, 6431, 6432, 7, 71, 2, 2, 6432, 6433,
-	7, 90, 2, 2, 6433, 948, 3, 2, 2, 2, 6434, 6435, 7, 79, 2, 2, 6435, 6436,
-	7, 91, 2, 2, 6436, 6437, 7, 85, 2, 2, 6437, 6438, 7, 83, 2, 2, 6438, 6439,
-	7, 78, 2, 2, 6439, 950, 3, 2, 2, 2, 6440, 6441, 7, 79, 2, 2, 6441, 6442,
-	7, 91, 2, 2, 6442, 6443, 7, 85, 2, 2, 6443, 6444, 7, 83, 2, 2, 6444, 6445,
-	7, 78, 2, 2, 6445, 6446, 7, 97, 2, 2, 6446, 6447, 7, 71, 2, 2, 6447, 6448,
-	7, 84, 2, 2, 6448, 6449, 7, 84, 2, 2, 6449, 6450, 7, 80, 2, 2, 6450, 6451,
-	7, 81, 2, 2, 6451, 952, 3, 2, 2, 2, 6452, 6453, 7, 80, 2, 2, 6453, 6454,
-	7, 67, 2, 2, 6454, 6455, 7, 79, 2, 2, 6455, 6456, 7, 71, 2, 2, 6456, 954,
-	3, 2, 2, 2, 6457, 6458, 7, 80, 2, 2, 6458, 6459, 7, 67, 2, 2, 6459, 6460,
-	7, 79, 2, 2, 6460, 6461, 7, 71, 2, 2, 6461, 6462, 7, 85, 2, 2, 6462, 956,
-	3, 2, 2, 2, 6463, 6464, 7, 80, 2, 2, 6464, 6465, 7, 69, 2, 2, 6465, 6466,
-	7, 74, 2, 2, 6466, 6467, 7, 67, 2, 2, 6467, 6468, 7, 84, 2, 2, 6468, 958,
-	3, 2, 2, 2, 6469, 6470, 7, 80, 2, 2, 6470, 6471, 7, 71, 2, 2, 6471, 6472,
-	7, 88, 2, 2, 6472, 6473, 7, 71, 2, 2, 6473, 6474, 7, 84, 2, 2, 6474, 960,
-	3, 2, 2, 2, 6475, 6476, 7, 80, 2, 2, 6476, 6477, 7, 71, 2, 2, 6477, 6478,
-	7, 90, 2, 2, 6478, 6479, 7, 86, 2, 2, 6479, 962, 3, 2, 2, 2, 6480, 6481,
-	7, 80, 2, 2, 6481, 6482, 7, 81, 2, 2, 6482, 964, 3, 2, 2, 2, 6483, 6484,
-	7, 80, 2, 2, 6484, 6485, 7, 81, 2, 2, 6485, 6486, 7, 70, 2, 2, 6486, 6487,
-	7, 71, 2, 2, 6487, 6488, 7, 73, 2, 2, 6488, 6489, 7, 84, 2, 2, 6489, 6490,
-	7, 81, 2, 2, 6490, 6491, 7, 87, 2, 2, 6491, 6492, 7, 82, 2, 2, 6492, 966,
-	3, 2, 2, 2, 6493, 6494, 7, 80, 2, 2, 6494, 6495, 7, 81, 2, 2, 6495, 6496,
-	7, 80, 2, 2, 6496, 6497, 7, 71, 2, 2, 6497, 968, 3, 2, 2, 2, 6498, 6499,
-	7, 81, 2, 2, 6499, 6500, 7, 72, 2, 2, 6500, 6501, 7, 72, 2, 2, 6501, 6502,
-	7, 78, 2, 2, 6502, 6503, 7, 75, 2, 2, 6503, 6504, 7, 80, 2, 2, 6504, 6505,
-	7, 71, 2, 2, 6505, 970, 3, 2, 2, 2, 6506, 6507, 7, 81, 2, 2, 6507, 6508,
-	7, 72, 2, 2, 6508, 6509, 7, 72, 2, 2, 6509, 6510, 7, 85, 2, 2, 6510, 6511,
-	7, 71, 2, 2, 6511, 6512, 7, 86, 2, 2, 6512, 972, 3, 2, 2, 2, 6513, 6514,
-	7, 81, 2, 2, 6514, 6515, 7, 72, 2, 2, 6515, 974, 3, 2, 2, 2, 6516, 6517,
-	7, 81, 2, 2, 6517, 6518, 7, 76, 2, 2, 6518, 976, 3, 2, 2, 2, 6519, 6520,
-	7, 81, 2, 2, 6520, 6521, 7, 78, 2, 2, 6521, 6522, 7, 70, 2, 2, 6522, 6523,
-	7, 97, 2, 2, 6523, 6524, 7, 82, 2, 2, 6524, 6525, 7, 67, 2, 2, 6525, 6526,
-	7, 85, 2, 2, 6526, 6527, 7, 85, 2, 2, 6527, 6528, 7, 89, 2, 2, 6528, 6529,
-	7, 81, 2, 2, 6529, 6530, 7, 84, 2, 2, 6530, 6531, 7, 70, 2, 2, 6531, 978,
-	3, 2, 2, 2, 6532, 6533, 7, 81, 2, 2, 6533, 6534, 7, 80, 2, 2, 6534, 6535,
-	7, 71, 2, 2, 6535, 980, 3, 2, 2, 2, 6536, 6537, 7, 81, 2, 2, 6537, 6538,
-	7, 80, 2, 2, 6538, 6539, 7, 78, 2, 2, 6539, 6540, 7, 75, 2, 2, 6540, 6541,
-	7, 80, 2, 2, 6541, 6542, 7, 71, 2, 2, 6542, 982, 3, 2, 2, 2, 6543, 6544,
-	7, 81, 2, 2, 6544, 6545, 7, 80, 2, 2, 6545, 6546, 7, 78, 2, 2, 6546, 6547,
-	7, 91, 2, 2, 6547, 984, 3, 2, 2, 2, 6548, 6549, 7, 81, 2, 2, 6549, 6550,
-	7, 82, 2, 2, 6550, 6551, 7, 71, 2, 2, 6551, 6552, 7, 80, 2, 2, 6552, 986,
-	3, 2, 2, 2, 6553, 6554, 7, 81, 2, 2, 6554, 6555, 7, 82, 2, 2, 6555, 6556,
-	7, 86, 2, 2, 6556, 6557, 7, 75, 2, 2, 6557, 6558, 7, 79, 2, 2, 6558, 6559,
-	7, 75, 2, 2, 6559, 6560, 7, 92, 2, 2, 6560, 6561, 7, 71, 2, 2, 6561, 6562,
-	7, 84, 2, 2, 6562, 6563, 7, 97, 2, 2, 6563, 6564, 7, 69, 2, 2, 6564, 6565,
-	7, 81, 2, 2, 6565, 6566, 7, 85, 2, 2, 6566, 6567, 7, 86, 2, 2, 6567, 6568,
-	7, 85, 2, 2, 6568, 988, 3, 2, 2, 2, 6569, 6570, 7, 81, 2, 2, 6570, 6571,
-	7, 82, 2, 2, 6571, 6572, 7, 86, 2, 2, 6572, 6573, 7, 75, 2, 2, 6573, 6574,
-	7, 81, 2, 2, 6574, 6575, 7, 80, 2, 2, 6575, 6576, 7, 85, 2, 2, 6576, 990,
-	3, 2, 2, 2, 6577, 6578, 7, 81, 2, 2, 6578, 6579, 7, 89, 2, 2, 6579, 6580,
-	7, 80, 2, 2, 6580, 6581, 7, 71, 2, 2, 6581, 6582, 7, 84, 2, 2, 6582, 992,
-	3, 2, 2, 2, 6583, 6584, 7, 82, 2, 2, 6584, 6585, 7, 67, 2, 2, 6585, 6586,
-	7, 69, 2, 2, 6586, 6587, 7, 77, 2, 2, 6587, 6588, 7, 97, 2, 2, 6588, 6589,
-	7, 77, 2, 2, 6589, 6590, 7, 71, 2, 2, 6590, 6591, 7, 91, 2, 2, 6591, 6592,
-	7, 85, 2, 2, 6592, 994, 3, 2, 2, 2, 6593, 6594, 7, 82, 2, 2, 6594, 6595,
-	7, 67, 2, 2, 6595, 6596, 7, 73, 2, 2, 6596, 6597, 7, 71, 2, 2, 6597, 996,
-	3, 2, 2, 2, 6598, 6599, 7, 82, 2, 2, 6599, 6600, 7, 67, 2, 2, 6600, 6601,
-	7, 84, 2, 2, 6601, 6602, 7, 85, 2, 2, 6602, 6603, 7, 71, 2, 2, 6603, 6604,
-	7, 84, 2, 2, 6604, 998, 3, 2, 2, 2, 6605, 6606, 7, 82, 2, 2, 6606, 6607,
-	7, 67, 2, 2, 6607, 6608, 7, 84, 2, 2, 6608, 6609, 7, 86, 2, 2, 6609, 6610,
-	7, 75, 2, 2, 6610, 6611, 7, 67, 2, 2, 6611, 6612, 7, 78, 2, 2, 6612, 1000,
-	3, 2, 2, 2, 6613, 6614, 7, 82, 2, 2, 6614, 6615, 7, 67, 2, 2, 6615, 6616,
-	7, 84, 2, 2, 6616, 6617, 7, 86, 2, 2, 6617, 6618, 7, 75, 2, 2, 6618, 6619,
-	7, 86, 2, 2, 6619, 6620, 7, 75, 2, 2, 6620, 6621, 7, 81, 2, 2, 6621, 6622,
-	7, 80, 2, 2, 6622, 6623, 7, 75, 2, 2, 6623, 6624, 7, 80, 2, 2, 6624, 6625,
-	7, 73, 2, 2, 6625, 1002, 3, 2, 2, 2, 6626, 6627, 7, 82, 2, 2, 6627, 6628,
-	7, 67, 2, 2, 6628, 6629, 7, 84, 2, 2, 6629, 6630, 7, 86, 2, 2, 6630, 6631,
-	7, 75, 2, 2, 6631, 6632, 7, 86, 2, 2, 6632, 6633, 7, 75, 2, 2, 6633, 6634,
-	7, 81, 2, 2, 6634, 6635, 7, 80, 2, 2, 6635, 6636, 7, 85, 2, 2, 6636, 1004,
-	3, 2, 2, 2, 6637, 6638, 7, 82, 2, 2, 6638, 6639, 7, 67, 2, 2, 6639, 6640,
-	7, 85, 2, 2, 6640, 6641, 7, 85, 2, 2, 6641, 6642, 7, 89, 2, 2, 6642, 6643,
-	7, 81, 2, 2, 6643, 6644, 7, 84, 2, 2, 6644, 6645, 7, 70, 2, 2, 6645, 1006,
-	3, 2, 2, 2, 6646, 6647, 7, 82, 2, 2, 6647, 6648, 7, 74, 2, 2, 6648, 6649,
-	7, 67, 2, 2, 6649, 6650, 7, 85, 2, 2, 6650, 6651, 7, 71, 2, 2, 6651, 1008,
-	3, 2, 2, 2, 6652, 6653, 7, 82, 2, 2, 6653, 6654, 7, 78, 2, 2, 6654, 6655,
-	7, 87, 2, 2, 6655, 6656, 7, 73, 2, 2, 6656, 6657, 7, 75, 2, 2, 6657, 6658,
-	7, 80, 2, 2, 6658, 1010, 3, 2, 2, 2, 6659, 6660, 7, 82, 2, 2, 6660, 6661,
-	7, 78, 2, 2, 6661, 6662, 7, 87, 2, 2, 6662, 6663, 7, 73, 2, 2, 6663, 6664,
-	7, 75, 2, 2, 6664, 6665, 7, 80, 2, 2, 6665, 6666, 7, 97, 2, 2, 6666, 6667,
-	7, 70, 2, 2, 6667, 6668, 7, 75, 2, 2, 6668, 6669, 7, 84, 2, 2, 6669, 1012,
-	3, 2, 2, 2, 6670, 6671, 7, 82, 2, 2, 6671, 6672, 7, 78, 2, 2, 6672, 6673,
-	7, 87, 2, 2, 6673, 6674, 7, 73, 2, 2, 6674, 6675, 7, 75, 2, 2, 6675, 6676,
-	7, 80, 2, 2, 6676, 6677, 7, 85, 2, 2, 6677, 1014, 3, 2, 2, 2, 6678, 6679,
-	7, 82, 2, 2, 6679, 6680, 7, 81, 2, 2, 6680, 6681, 7, 84, 2, 2, 6681, 6682,
-	7, 86, 2, 2, 6682, 1016, 3, 2, 2, 2, 6683, 6684, 7, 82, 2, 2, 6684, 6685,
-	7, 84, 2, 2, 6685, 6686, 7, 71, 2, 2, 6686, 6687, 7, 69, 2, 2, 6687, 6688,
-	7, 71, 2, 2, 6688, 6689, 7, 70, 2, 2, 6689, 6690, 7, 71, 2, 2, 6690, 6691,
-	7, 85, 2, 2, 6691, 1018, 3, 2, 2, 2, 6692, 6693, 7, 82, 2, 2, 6693, 6694,
-	7, 84, 2, 2, 6694, 6695, 7, 71, 2, 2, 6695, 6696, 7, 82, 2, 2, 6696, 6697,
-	7, 67, 2, 2, 6697, 6698, 7, 84, 2, 2, 6698, 6699, 7, 71, 2, 2, 6699, 1020,
-	3, 2, 2, 2, 6700, 6701, 7, 82, 2, 2, 6701, 6702, 7, 84, 2, 2, 6702, 6703,
-	7, 71, 2, 2, 6703, 6704, 7, 85, 2, 2, 6704, 6705, 7, 71, 2, 2, 6705, 6706,
-	7, 84, 2, 2, 6706, 6707, 7, 88, 2, 2, 6707, 6708, 7, 71, 2, 2, 6708, 1022,
-	3, 2, 2, 2, 6709, 6710, 7, 82, 2, 2, 6710, 6711, 7, 84, 2, 2, 6711, 6712,
-	7, 71, 2, 2, 6712, 6713, 7, 88, 2, 2, 6713, 1024, 3, 2, 2, 2, 6714, 6715,
-	7, 82, 2, 2, 6715, 6716, 7, 84, 2, 2, 6716, 6717, 7, 81, 2, 2, 6717, 6718,
-	7, 69, 2, 2, 6718, 6719, 7, 71, 2, 2, 6719, 6720, 7, 85, 2, 2, 6720, 6721,
-	7, 85, 2, 2, 6721, 6722, 7, 78, 2, 2, 6722, 6723, 7, 75, 2, 2, 6723, 6724,
-	7, 85, 2, 2, 6724, 6725, 7, 86, 2, 2, 6725, 1026, 3, 2, 2, 2, 6726, 6727,
-	7, 82, 2, 2, 6727, 6728, 7, 84, 2, 2, 6728, 6729, 7, 81, 2, 2, 6729, 6730,
-	7, 72, 2, 2, 6730, 6731, 7, 75, 2, 2, 6731, 6732, 7, 78, 2, 2, 6732, 6733,
-	7, 71, 2, 2, 6733, 1028, 3, 2, 2, 2, 6734, 6735, 7, 82, 2, 2, 6735, 6736,
-	7, 84, 2, 2, 6736, 6737, 7, 81, 2, 2, 6737, 6738, 7, 72, 2, 2, 6738, 6739,
-	7, 75, 2, 2, 6739, 6740, 7, 78, 2, 2, 6740, 6741, 7, 71, 2, 2, 6741, 6742,
-	7, 85, 2, 2, 6742, 1030, 3, 2, 2, 2, 6743, 6744, 7, 82, 2, 2, 6744, 6745,
-	7, 84, 2, 2, 6745, 6746, 7, 81, 2, 2, 6746, 6747, 7, 90, 2, 2, 6747, 6748,
-	7, 91, 2, 2, 6748, 1032, 3, 2, 2, 2, 6749, 6750, 7, 83, 2, 2, 6750, 6751,
-	7, 87, 2, 2, 6751, 6752, 7, 71, 2, 2, 6752, 6753, 7, 84, 2, 2, 6753, 6754,
-	7, 91, 2, 2, 6754, 1034, 3, 2, 2, 2, 6755, 6756, 7, 83, 2, 2, 6756, 6757,
-	7, 87, 2, 2, 6757, 6758, 7, 75, 2, 2, 6758, 6759, 7, 69, 2, 2, 6759, 6760,
-	7, 77, 2, 2, 6760, 1036, 3, 2, 2, 2, 6761, 6762, 7, 84, 2, 2, 6762, 6763,
-	7, 71, 2, 2, 6763, 6764, 7, 68, 2, 2, 6764, 6765, 7, 87, 2, 2, 6765, 6766,
-	7, 75, 2, 2, 6766, 6767, 7, 78, 2, 2, 6767, 6768, 7, 70, 2, 2, 6768, 1038,
-	3, 2, 2, 2, 6769, 6770, 7, 84, 2, 2, 6770, 6771, 7, 71, 2, 2, 6771, 6772,
-	7, 69, 2, 2, 6772, 6773, 7, 81, 2, 2, 6773, 6774, 7, 88, 2, 2, 6774, 6775,
-	7, 71, 2, 2, 6775, 6776, 7, 84, 2, 2, 6776, 1040, 3, 2, 2, 2, 6777, 6778,
-	7, 84, 2, 2, 6778, 6779, 7, 71, 2, 2, 6779, 6780, 7, 70, 2, 2, 6780, 6781,
-	7, 81, 2, 2, 6781, 6782, 7, 97, 2, 2, 6782, 6783, 7, 68, 2, 2, 6783, 6784,
-	7, 87, 2, 2, 6784, 6785, 7, 72, 2, 2, 6785, 6786, 7, 72, 2, 2, 6786, 6787,
-	7, 71, 2, 2, 6787, 6788, 7, 84, 2, 2, 6788, 6789, 7, 97, 2, 2, 6789, 6790,
-	7, 85, 2, 2, 6790, 6791, 7, 75, 2, 2, 6791, 6792, 7, 92, 2, 2, 6792, 6793,
-	7, 71, 2, 2, 6793, 1042, 3, 2, 2, 2, 6794, 6795, 7, 84, 2, 2, 6795, 6796,
-	7, 71, 2, 2, 6796, 6797, 7, 70, 2, 2, 6797, 6798, 7, 87, 2, 2, 6798, 6799,
-	7, 80, 2, 2, 6799, 6800, 7, 70, 2, 2, 6800, 6801, 7, 67, 2, 2, 6801, 6802,
-	7, 80, 2, 2, 6802, 6803, 7, 86, 2, 2, 6803, 1044, 3, 2, 2, 2, 6804, 6805,
-	7, 84, 2, 2, 6805, 6806, 7, 71, 2, 2, 6806, 6807, 7, 78, 2, 2, 6807, 6808,
-	7, 67, 2, 2, 6808, 6809, 7, 91, 2, 2, 6809, 1046, 3, 2, 2, 2, 6810, 6811,
-	7, 84, 2, 2, 6811, 6812, 7, 71, 2, 2, 6812, 6813, 7, 78, 2, 2, 6813, 6814,
-	7, 67, 2, 2, 6814, 6815, 7, 91, 2, 2, 6815, 6816, 7, 97, 2, 2, 6816, 6817,
-	7, 78, 2, 2, 6817, 6818, 7, 81, 2, 2, 6818, 6819, 7, 73, 2, 2, 6819, 6820,
-	7, 97, 2, 2, 6820, 6821, 7, 72, 2, 2, 6821, 6822, 7, 75, 2, 2, 6822, 6823,
-	7, 78, 2, 2, 6823, 6824, 7, 71, 2, 2, 6824, 1048, 3, 2, 2, 2, 6825, 6826,
-	7, 84, 2, 2, 6826, 6827, 7, 71, 2, 2, 6827, 6828, 7, 78, 2, 2, 6828, 6829,
-	7, 67, 2, 2, 6829, 6830, 7, 91, 2, 2, 6830, 6831, 7, 97, 2, 2, 6831, 6832,
-	7, 78, 2, 2, 6832, 6833, 7, 81, 2, 2, 6833, 6834, 7, 73, 2, 2, 6834, 6835,
-	7, 97, 2, 2, 6835, 6836, 7, 82, 2, 2, 6836, 6837, 7, 81, 2, 2, 6837, 6838,
-	7, 85, 2, 2, 6838, 1050, 3, 2, 2, 2, 6839, 6840, 7, 84, 2, 2, 6840, 6841,
-	7, 71, 2, 2, 6841, 6842, 7, 78, 2, 2, 6842, 6843, 7, 67, 2, 2, 6843, 6844,
-	7, 91, 2, 2, 6844, 6845, 7, 78, 2, 2, 6845, 6846, 7, 81, 2, 2, 6846, 6847,
-	7, 73, 2, 2, 6847, 1052, 3, 2, 2, 2, 6848, 6849, 7, 84, 2, 2, 6849, 6850,
-	7, 71, 2, 2, 6850, 6851, 7, 79, 2, 2, 6851, 6852, 7, 81, 2, 2, 6852, 6853,
-	7, 88, 2, 2, 6853, 6854, 7, 71, 2, 2, 6854, 1054, 3, 2, 2, 2, 6855, 6856,
-	7, 84, 2, 2, 6856, 6857, 7, 71, 2, 2, 6857, 6858, 7, 81, 2, 2, 6858, 6859,
-	7, 84, 2, 2, 6859, 6860, 7, 73, 2, 2, 6860, 6861, 7, 67, 2, 2, 6861, 6862,
-	7, 80, 2, 2, 6862, 6863, 7, 75, 2, 2, 6863, 6864, 7, 92, 2, 2, 6864, 6865,
-	7, 71, 2, 2, 6865, 1056, 3, 2, 2, 2, 6866, 6867, 7, 84, 2, 2, 6867, 6868,
-	7, 71, 2, 2, 6868, 6869, 7, 82, 2, 2, 6869, 6870, 7, 67, 2, 2, 6870, 6871,
-	7, 75, 2, 2, 6871, 6872, 7, 84, 2, 2, 6872, 1058, 3, 2, 2, 2, 6873, 6874,
-	7, 84, 2, 2, 6874, 6875, 7, 71, 2, 2, 6875, 6876, 7, 82, 2, 2, 6876, 6877,
-	7, 78, 2, 2, 6877, 6878, 7, 75, 2, 2, 6878, 6879, 7, 69, 2, 2, 6879, 6880,
-	7, 67, 2, 2, 6880, 6881, 7, 86, 2, 2, 6881, 6882, 7, 71, 2, 2, 6882, 6883,
-	7, 97, 2, 2, 6883, 6884, 7, 70, 2, 2, 6884, 6885, 7, 81, 2, 2, 6885, 6886,
-	7, 97, 2, 2, 6886, 6887, 7, 70, 2, 2, 6887, 6888, 7, 68, 2, 2, 6888, 1060,
-	3, 2, 2, 2, 6889, 6890, 7, 84, 2, 2, 6890, 6891, 7, 71, 2, 2, 6891, 6892,
-	7, 82, 2, 2, 6892, 6893, 7, 78, 2, 2, 6893, 6894, 7, 75, 2, 2, 6894, 6895,
-	7, 69, 2, 2, 6895, 6896, 7, 67, 2, 2, 6896, 6897, 7, 86, 2, 2, 6897, 6898,
-	7, 71, 2, 2, 6898, 6899, 7, 97, 2, 2, 6899, 6900, 7, 70, 2, 2, 6900, 6901,
-	7, 81, 2, 2, 6901, 6902, 7, 97, 2, 2, 6902, 6903, 7, 86, 2, 2, 6903, 6904,
-	7, 67, 2, 2, 6904, 6905, 7, 68, 2, 2, 6905, 6906, 7, 78, 2, 2, 6906, 6907,
-	7, 71, 2, 2, 6907, 1062, 3, 2, 2, 2, 6908, 6909, 7, 84, 2, 2, 6909, 6910,
-	7, 71, 2, 2, 6910, 6911, 7, 82, 2, 2, 6911, 6912, 7, 78, 2, 2, 6912, 6913,
-	7, 75, 2, 2, 6913, 6914, 7, 69, 2, 2, 6914, 6915, 7, 67, 2, 2, 6915, 6916,
-	7, 86, 2, 2, 6916, 6917, 7, 71, 2, 2, 6917, 6918, 7, 97, 2, 2, 6918, 6919,
-	7, 75, 2, 2, 6919, 6920, 7, 73, 2, 2, 6920, 6921, 7, 80, 2, 2, 6921, 6922,
-	7, 81, 2, 2, 6922, 6923, 7, 84, 2, 2, 6923, 6924, 7, 71, 2, 2, 6924, 6925,
-	7, 97, 2, 2, 6925, 6926, 7, 70, 2, 2, 6926, 6927, 7, 68, 2, 2, 6927, 1064,
-	3, 2, 2, 2, 6928, 6929, 7, 84, 2, 2, 6929, 6930, 7, 71, 2, 2, 6930, 6931,
-	7, 82, 2, 2, 6931, 6932, 7, 78, 2, 2, 6932, 6933, 7, 75, 2, 2, 6933, 6934,
-	7, 69, 2, 2, 6934, 6935, 7, 67, 2, 2, 6935, 6936, 7, 86, 2, 2, 6936, 6937,
-	7, 71, 2, 2, 6937, 6938, 7, 97, 2, 2, 6938, 6939, 7, 75, 2, 2, 6939, 6940,
-	7, 73, 2, 2, 6940, 6941, 7, 80, 2, 2, 6941, 6942, 7, 81, 2, 2, 6942, 6943,
-	7, 84, 2, 2, 6943, 6944, 7, 71, 2, 2, 6944, 6945, 7, 97, 2, 2, 6945, 6946,
-	7, 86, 2, 2, 6946, 6947, 7, 67, 2, 2, 6947, 6948, 7, 68, 2, 2, 6948, 6949,
-	7, 78, 2, 2, 6949, 6950, 7, 71, 2, 2, 6950, 1066, 3, 2, 2, 2, 6951, 6952,
-	7, 84, 2, 2, 6952, 6953, 7, 71, 2, 2, 6953, 6954, 7, 82, 2, 2, 6954, 6955,
-	7, 78, 2, 2, 6955, 6956, 7, 75, 2, 2, 6956, 6957, 7, 69, 2, 2, 6957, 6958,
-	7, 67, 2, 2, 6958, 6959, 7, 86, 2, 2, 6959, 6960, 7, 71, 2, 2, 6960, 6961,
-	7, 97, 2, 2, 6961, 6962, 7, 84, 2, 2, 6962, 6963, 7, 71, 2, 2, 6963, 6964,
-	7, 89, 2, 2, 6964, 6965, 7, 84, 2, 2, 6965, 6966, 7, 75, 2, 2, 6966, 6967,
-	7, 86, 2, 2, 6967, 6968, 7, 71, 2, 2, 6968, 6969, 7, 97, 2, 2, 6969, 6970,
-	7, 70, 2, 2, 6970, 6971, 7, 68, 2, 2, 6971, 1068, 3, 2, 2, 2, 6972, 6973,
-	7, 84, 2, 2, 6973, 6974, 7, 71, 2, 2, 6974, 6975, 7, 82, 2, 2, 6975, 6976,
-	7, 78, 2, 2, 6976, 6977, 7, 75, 2, 2, 6977, 6978, 7, 69, 2, 2, 6978, 6979,
-	7, 67, 2, 2, 6979, 6980, 7, 86, 2, 2, 6980, 6981, 7, 71, 2, 2, 6981, 6982,
-	7, 97, 2, 2, 6982, 6983, 7, 89, 2, 2, 6983, 6984, 7, 75, 2, 2, 6984, 6985,
-	7, 78, 2, 2, 6985, 6986, 7, 70, 2, 2, 6986, 6987, 7, 97, 2, 2, 6987, 6988,
-	7, 70, 2, 2, 6988, 6989, 7, 81, 2, 2, 6989, 6990, 7, 97, 2, 2, 6990, 6991,
-	7, 86, 2, 2, 6991, 6992, 7, 67, 2, 2, 6992, 6993, 7, 68, 2, 2, 6993, 6994,
-	7, 78, 2, 2, 6994, 6995, 7, 71, 2, 2, 6995, 1070, 3, 2, 2, 2, 6996, 6997,
-	7, 84, 2, 2, 6997, 6998, 7, 71, 2, 2, 6998, 6999, 7, 82, 2, 2, 6999, 7000,
-	7, 78, 2, 2, 7000, 7001, 7, 75, 2, 2, 7001, 7002, 7, 69, 2, 2, 7002, 7003,
-	7, 67, 2, 2, 7003, 7004, 7, 86, 2, 2, 7004, 7005, 7, 71, 2, 2, 7005, 7006,
-	7, 97, 2, 2, 7006, 7007, 7, 89, 2, 2, 7007, 7008, 7, 75, 2, 2, 7008, 7009,
-	7, 78, 2, 2, 7009, 7010, 7, 70, 2, 2, 7010, 7011, 7, 97, 2, 2, 7011, 7012,
-	7, 75, 2, 2, 7012, 7013, 7, 73, 2, 2, 7013, 7014, 7, 80, 2, 2, 7014, 7015,
-	7, 81, 2, 2, 7015, 7016, 7, 84, 2, 2, 7016, 7017, 7, 71, 2, 2, 7017, 7018,
-	7, 97, 2, 2, 7018, 7019, 7, 86, 2, 2, 7019, 7020, 7, 67, 2, 2, 7020, 7021,
-	7, 68, 2, 2, 7021, 7022, 7, 78, 2, 2, 7022, 7023, 7, 71, 2, 2, 7023, 1072,
-	3, 2, 2, 2, 7024, 7025, 7, 84, 2, 2, 7025, 7026, 7, 71, 2, 2, 7026, 7027,
-	7, 82, 2, 2, 7027, 7028, 7, 78, 2, 2, 7028, 7029, 7, 75, 2, 2, 7029, 7030,
-	7, 69, 2, 2, 7030, 7031, 7, 67, 2, 2, 7031, 7032, 7, 86, 2, 2, 7032, 7033,
-	7, 75, 2, 2, 7033, 7034, 7, 81, 2, 2, 7034, 7035, 7, 80, 2, 2, 7035, 1074,
-	3, 2, 2, 2, 7036, 7037, 7, 84, 2, 2, 7037, 7038, 7, 71, 2, 2, 7038, 7039,
-	7, 85, 2, 2, 7039, 7040, 7, 71, 2, 2, 7040, 7041, 7, 86, 2, 2, 7041, 1076,
-	3, 2, 2, 2, 7042, 7043, 7, 84, 2, 2, 7043, 7044, 7, 71, 2, 2, 7044, 7045,
-	7, 85, 2, 2, 7045, 7046, 7, 87, 2, 2, 7046, 7047, 7, 79, 2, 2, 7047, 7048,
-	7, 71, 2, 2, 7048, 1078, 3, 2, 2, 2, 7049, 7050, 7, 84, 2, 2, 7050, 7051,
-	7, 71, 2, 2, 7051, 7052, 7, 86, 2, 2, 7052, 7053, 7, 87, 2, 2, 7053, 7054,
-	7, 84, 2, 2, 7054, 7055, 7, 80, 2, 2, 7055, 7056, 7, 71, 2, 2, 7056, 7057,
-	7, 70, 2, 2, 7057, 7058, 7, 97, 2, 2, 7058, 7059, 7, 85, 2, 2, 7059, 7060,
-	7, 83, 2, 2, 7060, 7061, 7, 78, 2, 2, 7061, 7062, 7, 85, 2, 2, 7062, 7063,
-	7, 86, 2, 2, 7063, 7064, 7, 67, 2, 2, 7064, 7065, 7, 86, 2, 2, 7065, 7066,
-	7, 71, 2, 2, 7066, 1080, 3, 2, 2, 2, 7067, 7068, 7, 84, 2, 2, 7068, 7069,
-	7, 71, 2, 2, 7069, 7070, 7, 86, 2, 2, 7070, 7071, 7, 87, 2, 2, 7071, 7072,
-	7, 84, 2, 2, 7072, 7073, 7, 80, 2, 2, 7073, 7074, 7, 75, 2, 2, 7074, 7075,
-	7, 80, 2, 2, 7075, 7076, 7, 73, 2, 2, 7076, 1082, 3, 2, 2, 2, 7077, 7078,
-	7, 84, 2, 2, 7078, 7079, 7, 71, 2, 2, 7079, 7080, 7, 86, 2, 2, 7080, 7081,
-	7, 87, 2, 2, 7081, 7082, 7, 84, 2, 2, 7082, 7083, 7, 80, 2, 2, 7083, 7084,
-	7, 85, 2, 2, 7084, 1084, 3, 2, 2, 2, 7085, 7086, 7, 84, 2, 2, 7086, 7087,
-	7, 81, 2, 2, 7087, 7088, 7, 78, 2, 2, 7088, 7089, 7, 71, 2, 2, 7089, 1086,
-	3, 2, 2, 2, 7090, 7091, 7, 84, 2, 2, 7091, 7092, 7, 81, 2, 2, 7092, 7093,
-	7, 78, 2, 2, 7093, 7094, 7, 78, 2, 2, 7094, 7095, 7, 68, 2, 2, 7095, 7096,
-	7, 67, 2, 2, 7096, 7097, 7, 69, 2, 2, 7097, 7098, 7, 77, 2, 2, 7098, 1088,
-	3, 2, 2, 2, 7099, 7100, 7, 84, 2, 2, 7100, 7101, 7, 81, 2, 2, 7101, 7102,
-	7, 78, 2, 2, 7102, 7103, 7, 78, 2, 2, 7103, 7104, 7, 87, 2, 2, 7104, 7105,
-	7, 82, 2, 2, 7105, 1090, 3, 2, 2, 2, 7106, 7107, 7, 84, 2, 2, 7107, 7108,
-	7, 81, 2, 2, 7108, 7109, 7, 86, 2, 2, 7109, 7110, 7, 67, 2, 2, 7110, 7111,
-	7, 86, 2, 2, 7111, 7112, 7, 71, 2, 2, 7112, 1092, 3, 2, 2, 2, 7113, 7114,
-	7, 84, 2, 2, 7114, 7115, 7, 81, 2, 2, 7115, 7116, 7, 89, 2, 2, 7116, 1094,
-	3, 2, 2, 2, 7117, 7118, 7, 84, 2, 2, 7118, 7119, 7, 81, 2, 2, 7119, 7120,
-	7, 89, 2, 2, 7120, 7121, 7, 85, 2, 2, 7121, 1096, 3, 2, 2, 2, 7122, 7123,
-	7, 84, 2, 2, 7123, 7124, 7, 81, 2, 2, 7124, 7125, 7, 89, 2, 2, 7125, 7126,
-	7, 97, 2, 2, 7126, 7127, 7, 72, 2, 2, 7127, 7128, 7, 81, 2, 2, 7128, 7129,
-	7, 84, 2, 2, 7129, 7130, 7, 79, 2, 2, 7130, 7131, 7, 67, 2, 2, 7131, 7132,
-	7, 86, 2, 2, 7132, 1098, 3, 2, 2, 2, 7133, 7134, 7, 85, 2, 2, 7134, 7135,
-	7, 67, 2, 2, 7135, 7136, 7, 88, 2, 2, 7136, 7137, 7, 71, 2, 2, 7137, 7138,
-	7, 82, 2, 2, 7138, 7139, 7, 81, 2, 2, 7139, 7140, 7, 75, 2, 2, 7140, 7141,
-	7, 80, 2, 2, 7141, 7142, 7, 86, 2, 2, 7142, 1100, 3, 2, 2, 2, 7143, 7144,
-	7, 85, 2, 2, 7144, 7145, 7, 69, 2, 2, 7145, 7146, 7, 74, 2, 2, 7146, 7147,
-	7, 71, 2, 2, 7147, 7148, 7, 70, 2, 2, 7148, 7149, 7, 87, 2, 2, 7149, 7150,
-	7, 78, 2, 2, 7150, 7151, 7, 71, 2, 2, 7151, 1102, 3, 2, 2, 2, 7152, 7153,
-	7, 85, 2, 2, 7153, 7154, 7, 71, 2, 2, 7154, 7155, 7, 69, 2, 2, 7155, 7156,
-	7, 87, 2, 2, 7156, 7157, 7, 84, 2, 2, 7157, 7158, 7, 75, 2, 2, 7158, 7159,
-	7, 86, 2, 2, 7159, 7160, 7, 91, 2, 2, 7160, 1104, 3, 2, 2, 2, 7161, 7162,
-	7, 85, 2, 2, 7162, 7163, 7, 71, 2, 2, 7163, 7164, 7, 84, 2, 2, 7164, 7165,
-	7, 88, 2, 2, 7165, 7166, 7, 71, 2, 2, 7166, 7167, 7, 84, 2, 2, 7167, 1106,
-	3, 2, 2, 2, 7168, 7169, 7, 85, 2, 2, 7169, 7170, 7, 71, 2, 2, 7170, 7171,
-	7, 85, 2, 2, 7171, 7172, 7, 85, 2, 2, 7172, 7173, 7, 75, 2, 2, 7173, 7174,
-	7, 81, 2, 2, 7174, 7175, 7, 80, 2, 2, 7175, 1108, 3, 2, 2, 2, 7176, 7177,
-	7, 85, 2, 2, 7177, 7178, 7, 74, 2, 2, 7178, 7179, 7, 67, 2, 2, 7179, 7180,
-	7, 84, 2, 2, 7180, 7181, 7, 71, 2, 2, 7181, 1110, 3, 2, 2, 2, 7182, 7183,
-	7, 85, 2, 2, 7183, 7184, 7, 74, 2, 2, 7184, 7185, 7, 67, 2, 2, 7185, 7186,
-	7, 84, 2, 2, 7186, 7187, 7, 71, 2, 2, 7187, 7188, 7, 70, 2, 2, 7188, 1112,
-	3, 2, 2, 2, 7189, 7190, 7, 85, 2, 2, 7190, 7191, 7, 75, 2, 2, 7191, 7192,
-	7, 73, 2, 2, 7192, 7193, 7, 80, 2, 2, 7193, 7194, 7, 71, 2, 2, 7194, 7195,
-	7, 70, 2, 2, 7195, 1114, 3, 2, 2, 2, 7196, 7197, 7, 85, 2, 2, 7197, 7198,
-	7, 75, 2, 2, 7198, 7199, 7, 79, 2, 2, 7199, 7200, 7, 82, 2, 2, 7200, 7201,
-	7, 78, 2, 2, 7201, 7202, 7, 71, 2, 2, 7202, 1116, 3, 2, 2, 2, 7203, 7204,
-	7, 85, 2, 2, 7204, 7205, 7, 78, 2, 2, 7205, 7206, 7, 67, 2, 2, 7206, 7207,
-	7, 88, 2, 2, 7207, 7208, 7, 71, 2, 2, 7208, 1118, 3, 2, 2, 2, 7209, 7210,
-	7, 85, 2, 2, 7210, 7211, 7, 78, 2, 2, 7211, 7212, 7, 81, 2, 2, 7212, 7213,
-	7, 89, 2, 2, 7213, 1120, 3, 2, 2, 2, 7214, 7215, 7, 85, 2, 2, 7215, 7216,
-	7, 80, 2, 2, 7216, 7217, 7, 67, 2, 2, 7217, 7218, 7, 82, 2, 2, 7218, 7219,
-	7, 85, 2, 2, 7219, 7220, 7, 74, 2, 2, 7220, 7221, 7, 81, 2, 2, 7221, 7222,
-	7, 86, 2, 2, 7222, 1122, 3, 2, 2, 2, 7223, 7224, 7, 85, 2, 2, 7224, 7225,
-	7, 81, 2, 2, 7225, 7226, 7, 69, 2, 2, 7226, 7227, 7, 77, 2, 2, 7227, 7228,
-	7, 71, 2, 2, 7228, 7229, 7, 86, 2, 2, 7229, 1124, 3, 2, 2, 2, 7230, 7231,
-	7, 85, 2, 2, 7231, 7232, 7, 81, 2, 2, 7232, 7233, 7, 79, 2, 2, 7233, 7234,
-	7, 71, 2, 2, 7234, 1126, 3, 2, 2, 2, 7235, 7236, 7, 85, 2, 2, 7236, 7237,
-	7, 81, 2, 2, 7237, 7238, 7, 80, 2, 2, 7238, 7239, 7, 67, 2, 2, 7239, 7240,
-	7, 79, 2, 2, 7240, 7241, 7, 71, 2, 2, 7241, 1128, 3, 2, 2, 2, 7242, 7243,
-	7, 85, 2, 2, 7243, 7244, 7, 81, 2, 2, 7244, 7245, 7, 87, 2, 2, 7245, 7246,
-	7, 80, 2, 2, 7246, 7247, 7, 70, 2, 2, 7247, 7248, 7, 85, 2, 2, 7248, 1130,
-	3, 2, 2, 2, 7249, 7250, 7, 85, 2, 2, 7250, 7251, 7, 81, 2, 2, 7251, 7252,
-	7, 87, 2, 2, 7252, 7253, 7, 84, 2, 2, 7253, 7254, 7, 69, 2, 2, 7254, 7255,
-	7, 71, 2, 2, 7255, 1132, 3, 2, 2, 2, 7256, 7257, 7, 85, 2, 2, 7257, 7258,
-	7, 83, 2, 2, 7258, 7259, 7, 78, 2, 2, 7259, 7260, 7, 97, 2, 2, 7260, 7261,
-	7, 67, 2, 2, 7261, 7262, 7, 72, 2, 2, 7262, 7263, 7, 86, 2, 2, 7263, 7264,
-	7, 71, 2, 2, 7264, 7265, 7, 84, 2, 2, 7265, 7266, 7, 97, 2, 2, 7266, 7267,
-	7, 73, 2, 2, 7267, 7268, 7, 86, 2, 2, 7268, 7269, 7, 75, 2, 2, 7269, 7270,
-	7, 70, 2, 2, 7270, 7271, 7, 85, 2, 2, 7271, 1134, 3, 2, 2, 2, 7272, 7273,
-	7, 85, 2, 2, 7273, 7274, 7, 83, 2, 2, 7274, 7275, 7, 78, 2, 2, 7275, 7276,
-	7, 97, 2, 2, 7276, 7277, 7, 67, 2, 2, 7277, 7278, 7, 72, 2, 2, 7278, 7279,
-	7, 86, 2, 2, 7279, 7280, 7, 71, 2, 2, 7280, 7281, 7, 84, 2, 2, 7281, 7282,
-	7, 97, 2, 2, 7282, 7283, 7, 79, 2, 2, 7283, 7284, 7, 86, 2, 2, 7284, 7285,
-	7, 85, 2, 2, 7285, 7286, 7, 97, 2, 2, 7286, 7287, 7, 73, 2, 2, 7287, 7288,
-	7, 67, 2, 2, 7288, 7289, 7, 82, 2, 2, 7289, 7290, 7, 85, 2, 2, 7290, 1136,
-	3, 2, 2, 2, 7291, 7292, 7, 85, 2, 2, 7292, 7293, 7, 83, 2, 2, 7293, 7294,
-	7, 78, 2, 2, 7294, 7295, 7, 97, 2, 2, 7295, 7296, 7, 68, 2, 2, 7296, 7297,
-	7, 71, 2, 2, 7297, 7298, 7, 72, 2, 2, 7298, 7299, 7, 81, 2, 2, 7299, 7300,
-	7, 84, 2, 2, 7300, 7301, 7, 71, 2, 2, 7301, 7302, 7, 97, 2, 2, 7302, 7303,
-	7, 73, 2, 2, 7303, 7304, 7, 86, 2, 2, 7304, 7305, 7, 75, 2, 2, 7305, 7306,
-	7, 70, 2, 2, 7306, 7307, 7, 85, 2, 2, 7307, 1138, 3, 2, 2, 2, 7308, 7309,
-	7, 85, 2, 2, 7309, 7310, 7, 83, 2, 2, 7310, 7311, 7, 78, 2, 2, 7311, 7312,
-	7, 97, 2, 2, 7312, 7313, 7, 68, 2, 2, 7313, 7314, 7, 87, 2, 2, 7314, 7315,
-	7, 72, 2, 2, 7315, 7316, 7, 72, 2, 2, 7316, 7317, 7, 71, 2, 2, 7317, 7318,
-	7, 84, 2, 2, 7318, 7319, 7, 97, 2, 2, 7319, 7320, 7, 84, 2, 2, 7320, 7321,
-	7, 71, 2, 2, 7321, 7322, 7, 85, 2, 2, 7322, 7323, 7, 87, 2, 2, 7323, 7324,
-	7, 78, 2, 2, 7324, 7325, 7, 86, 2, 2, 7325, 1140, 3, 2, 2, 2, 7326, 7327,
-	7, 85, 2, 2, 7327, 7328, 7, 83, 2, 2, 7328, 7329, 7, 78, 2, 2, 7329, 7330,
-	7, 97, 2, 2, 7330, 7331, 7, 69, 2, 2, 7331, 7332, 7, 67, 2, 2, 7332, 7333,
-	7, 69, 2, 2, 7333, 7334, 7, 74, 2, 2, 7334, 7335, 7, 71, 2, 2, 7335, 1142,
-	3, 2, 2, 2, 7336, 7337, 7, 85, 2, 2, 7337, 7338, 7, 83, 2, 2, 7338, 7339,
-	7, 78, 2, 2, 7339, 7340, 7, 97, 2, 2, 7340, 7341, 7, 80, 2, 2, 7341, 7342,
-	7, 81, 2, 2, 7342, 7343, 7, 97, 2, 2, 7343, 7344, 7, 69, 2, 2, 7344, 7345,
-	7, 67, 2, 2, 7345, 7346, 7, 69, 2, 2, 7346, 7347, 7, 74, 2, 2, 7347, 7348,
-	7, 71, 2, 2, 7348, 1144, 3, 2, 2, 2, 7349, 7350, 7, 85, 2, 2, 7350, 7351,
-	7, 83, 2, 2, 7351, 7352, 7, 78, 2, 2, 7352, 7353, 7, 97, 2, 2, 7353, 7354,
-	7, 86, 2, 2, 7354, 7355, 7, 74, 2, 2, 7355, 7356, 7, 84, 2, 2, 7356, 7357,
-	7, 71, 2, 2, 7357, 7358, 7, 67, 2, 2, 7358, 7359, 7, 70, 2, 2, 7359, 1146,
-	3, 2, 2, 2, 7360, 7361, 7, 85, 2, 2, 7361, 7362, 7, 86, 2, 2, 7362, 7363,
-	7, 67, 2, 2, 7363, 7364, 7, 84, 2, 2, 7364, 7365, 7, 86, 2, 2, 7365, 1148,
-	3, 2, 2, 2, 7366, 7367, 7, 85, 2, 2, 7367, 7368, 7, 86, 2, 2, 7368, 7369,
-	7, 67, 2, 2, 7369, 7370, 7, 84, 2, 2, 7370, 7371, 7, 86, 2, 2, 7371, 7372,
-	7, 85, 2, 2, 7372, 1150, 3, 2, 2, 2, 7373, 7374, 7, 85, 2, 2, 7374, 7375,
-	7, 86, 2, 2, 7375, 7376, 7, 67, 2, 2, 7376, 7377, 7, 86, 2, 2, 7377, 7378,
-	7, 85, 2, 2, 7378, 7379, 7, 97, 2, 2, 7379, 7380, 7, 67, 2, 2, 7380, 7381,
-	7, 87, 2, 2, 7381, 7382, 7, 86, 2, 2, 7382, 7383, 7, 81, 2, 2, 7383, 7384,
-	7, 97, 2, 2, 7384, 7385, 7, 84, 2, 2, 7385, 7386, 7, 71, 2, 2, 7386, 7387,
-	7, 69, 2, 2, 7387, 7388, 7, 67, 2, 2, 7388, 7389, 7, 78, 2, 2, 7389, 7390,
-	7, 69, 2, 2, 7390, 1152, 3, 2, 2, 2, 7391, 7392, 7, 85, 2, 2, 7392, 7393,
-	7, 86, 2, 2, 7393, 7394, 7, 67, 2, 2, 7394, 7395, 7, 86, 2, 2, 7395, 7396,
-	7, 85, 2, 2, 7396, 7397, 7, 97, 2, 2, 7397, 7398, 7, 82, 2, 2, 7398, 7399,
-	7, 71, 2, 2, 7399, 7400, 7, 84, 2, 2, 7400, 7401, 7, 85, 2, 2, 7401, 7402,
-	7, 75, 2, 2, 7402, 7403, 7, 85, 2, 2, 7403, 7404, 7, 86, 2, 2, 7404, 7405,
-	7, 71, 2, 2, 7405, 7406, 7, 80, 2, 2, 7406, 7407, 7, 86, 2, 2, 7407, 1154,
-	3, 2, 2, 2, 7408, 7409, 7, 85, 2, 2, 7409, 7410, 7, 86, 2, 2, 7410, 7411,
-	7, 67, 2, 2, 7411, 7412, 7, 86, 2, 2, 7412, 7413, 7, 85, 2, 2, 7413, 7414,
-	7, 97, 2, 2, 7414, 7415, 7, 85, 2, 2, 7415, 7416, 7, 67, 2, 2, 7416, 7417,
-	7, 79, 2, 2, 7417, 7418, 7, 82, 2, 2, 7418, 7419, 7, 78, 2, 2, 7419, 7420,
-	7, 71, 2, 2, 7420, 7421, 7, 97, 2, 2, 7421, 7422, 7, 82, 2, 2, 7422, 7423,
-	7, 67, 2, 2, 7423, 7424, 7, 73, 2, 2, 7424, 7425, 7, 71, 2, 2, 7425, 7426,
-	7, 85, 2, 2, 7426, 1156, 3, 2, 2, 2, 7427, 7428, 7, 85, 2, 2, 7428, 7429,
-	7, 86, 2, 2, 7429, 7430, 7, 67, 2, 2, 7430, 7431, 7, 86, 2, 2, 7431, 7432,
-	7, 87, 2, 2, 7432, 7433, 7, 85, 2, 2, 7433, 1158, 3, 2, 2, 2, 7434, 7435,
-	7, 85, 2, 2, 7435, 7436, 7, 86, 2, 2, 7436, 7437, 7, 81, 2, 2, 7437, 7438,
-	7, 82, 2, 2, 7438, 1160, 3, 2, 2, 2, 7439, 7440, 7, 85, 2, 2, 7440, 7441,
-	7, 86, 2, 2, 7441, 7442, 7, 81, 2, 2, 7442, 7443, 7, 84, 2, 2, 7443, 7444,
-	7, 67, 2, 2, 7444, 7445, 7, 73, 2, 2, 7445, 7446, 7, 71, 2, 2, 7446, 1162,
-	3, 2, 2, 2, 7447, 7448, 7, 85, 2, 2, 7448, 7449, 7, 86, 2, 2, 7449, 7450,
-	7, 81, 2, 2, 7450, 7451, 7, 84, 2, 2, 7451, 7452, 7, 71, 2, 2, 7452, 7453,
-	7, 70, 2, 2, 7453, 1164, 3, 2, 2, 2, 7454, 7455, 7, 85, 2, 2, 7455, 7456,
-	7, 86, 2, 2, 7456, 7457, 7, 84, 2, 2, 7457, 7458, 7, 75, 2, 2, 7458, 7459,
-	7, 80, 2, 2, 7459, 7460, 7, 73, 2, 2, 7460, 1166, 3, 2, 2, 2, 7461, 7462,
-	7, 85, 2, 2, 7462, 7463, 7, 87, 2, 2, 7463, 7464, 7, 68, 2, 2, 7464, 7465,
-	7, 69, 2, 2, 7465, 7466, 7, 78, 2, 2, 7466, 7467, 7, 67, 2, 2, 7467, 7468,
-	7, 85, 2, 2, 7468, 7469, 7, 85, 2, 2, 7469, 7470, 7, 97, 2, 2, 7470, 7471,
-	7, 81, 2, 2, 7471, 7472, 7, 84, 2, 2, 7472, 7473, 7, 75, 2, 2, 7473, 7474,
-	7, 73, 2, 2, 7474, 7475, 7, 75, 2, 2, 7475, 7476, 7, 80, 2, 2, 7476, 1168,
-	3, 2, 2, 2, 7477, 7478, 7, 85, 2, 2, 7478, 7479, 7, 87, 2, 2, 7479, 7480,
-	7, 68, 2, 2, 7480, 7481, 7, 76, 2, 2, 7481, 7482, 7, 71, 2, 2, 7482, 7483,
-	7, 69, 2, 2, 7483, 7484, 7, 86, 2, 2, 7484, 1170, 3, 2, 2, 2, 7485, 7486,
-	7, 85, 2, 2, 7486, 7487, 7, 87, 2, 2, 7487, 7488, 7, 68, 2, 2, 7488, 7489,
-	7, 82, 2, 2, 7489, 7490, 7, 67, 2, 2, 7490, 7491, 7, 84, 2, 2, 7491, 7492,
-	7, 86, 2, 2, 7492, 7493, 7, 75, 2, 2, 7493, 7494, 7, 86, 2, 2, 7494, 7495,
-	7, 75, 2, 2, 7495, 7496, 7, 81, 2, 2, 7496, 7497, 7, 80, 2, 2, 7497, 1172,
-	3, 2, 2, 2, 7498, 7499, 7, 85, 2, 2, 7499, 7500, 7, 87, 2, 2, 7500, 7501,
-	7, 68, 2, 2, 7501, 7502, 7, 82, 2, 2, 7502, 7503, 7, 67, 2, 2, 7503, 7504,
-	7, 84, 2, 2, 7504, 7505, 7, 86, 2, 2, 7505, 7506, 7, 75, 2, 2, 7506, 7507,
-	7, 86, 2, 2, 7507, 7508, 7, 75, 2, 2, 7508, 7509, 7, 81, 2, 2, 7509, 7510,
-	7, 80, 2, 2, 7510, 7511, 7, 85, 2, 2, 7511, 1174, 3, 2, 2, 2, 7512, 7513,
-	7, 85, 2, 2, 7513, 7514, 7, 87, 2, 2, 7514, 7515, 7, 85, 2, 2, 7515, 7516,
-	7, 82, 2, 2, 7516, 7517, 7, 71, 2, 2, 7517, 7518, 7, 80, 2, 2, 7518, 7519,
-	7, 70, 2, 2, 7519, 1176, 3, 2, 2, 2, 7520, 7521, 7, 85, 2, 2, 7521, 7522,
-	7, 89, 2, 2, 7522, 7523, 7, 67, 2, 2, 7523, 7524, 7, 82, 2, 2, 7524, 7525,
-	7, 85, 2, 2, 7525, 1178, 3, 2, 2, 2, 7526, 7527, 7, 85, 2, 2, 7527, 7528,
-	7, 89, 2, 2, 7528, 7529, 7, 75, 2, 2, 7529, 7530, 7, 86, 2, 2, 7530, 7531,
-	7, 69, 2, 2, 7531, 7532, 7, 74, 2, 2, 7532, 7533, 7, 71, 2, 2, 7533, 7534,
-	7, 85, 2, 2, 7534, 1180, 3, 2, 2, 2, 7535, 7536, 7, 86, 2, 2, 7536, 7537,
-	7, 67, 2, 2, 7537, 7538, 7, 68, 2, 2, 7538, 7539, 7, 78, 2, 2, 7539, 7540,
-	7, 71, 2, 2, 7540, 7541, 7, 97, 2, 2, 7541, 7542, 7, 80, 2, 2, 7542, 7543,
-	7, 67, 2, 2, 7543, 7544, 7, 79, 2, 2, 7544, 7545, 7, 71, 2, 2, 7545, 1182,
-	3, 2, 2, 2, 7546, 7547, 7, 86, 2, 2, 7547, 7548, 7, 67, 2, 2, 7548, 7549,
-	7, 68, 2, 2, 7549, 7550, 7, 78, 2, 2, 7550, 7551, 7, 71, 2, 2, 7551, 7552,
-	7, 85, 2, 2, 7552, 7553, 7, 82, 2, 2, 7553, 7554, 7, 67, 2, 2, 7554, 7555,
-	7, 69, 2, 2, 7555, 7556, 7, 71, 2, 2, 7556, 1184, 3, 2, 2, 2, 7557, 7558,
-	7, 86, 2, 2, 7558, 7559, 7, 71, 2, 2, 7559, 7560, 7, 79, 2, 2, 7560, 7561,
-	7, 82, 2, 2, 7561, 7562, 7, 81, 2, 2, 7562, 7563, 7, 84, 2, 2, 7563, 7564,
-	7, 67, 2, 2, 7564, 7565, 7, 84, 2, 2, 7565, 7566, 7, 91, 2, 2, 7566, 1186,
-	3, 2, 2, 2, 7567, 7568, 7, 86, 2, 2, 7568, 7569, 7, 71, 2, 2, 7569, 7570,
-	7, 79, 2, 2, 7570, 7571, 7, 82, 2, 2, 7571, 7572, 7, 86, 2, 2, 7572, 7573,
-	7, 67, 2, 2, 7573, 7574, 7, 68, 2, 2, 7574, 7575, 7, 78, 2, 2, 7575, 7576,
-	7, 71, 2, 2, 7576, 1188, 3, 2, 2, 2, 7577, 7578, 7, 86, 2, 2, 7578, 7579,
-	7, 74, 2, 2, 7579, 7580, 7, 67, 2, 2, 7580, 7581, 7, 80, 2, 2, 7581, 1190,
-	3, 2, 2, 2, 7582, 7583, 7, 86, 2, 2, 7583, 7584, 7, 84, 2, 2, 7584, 7585,
-	7, 67, 2, 2, 7585, 7586, 7, 70, 2, 2, 7586, 7587, 7, 75, 2, 2, 7587, 7588,
-	7, 86, 2, 2, 7588, 7589, 7, 75, 2, 2, 7589, 7590, 7, 81, 2, 2, 7590, 7591,
-	7, 80, 2, 2, 7591, 7592, 7, 67, 2, 2, 7592, 7593, 7, 78, 2, 2, 7593, 1192,
-	3, 2, 2, 2, 7594, 7595, 7, 86, 2, 2, 7595, 7596, 7, 84, 2, 2, 7596, 7597,
-	7, 67, 2, 2, 7597, 7598, 7, 80, 2, 2, 7598, 7599, 7, 85, 2, 2, 7599, 7600,
-	7, 67, 2, 2, 7600, 7601, 7, 69, 2, 2, 7601, 7602, 7, 86, 2, 2, 7602, 7603,
-	7, 75, 2, 2, 7603, 7604, 7, 81, 2, 2, 7604, 7605, 7, 80, 2, 2, 7605, 1194,
-	3, 2, 2, 2, 7606, 7607, 7, 86, 2, 2, 7607, 7608, 7, 84, 2, 2, 7608, 7609,
-	7, 67, 2, 2, 7609, 7610, 7, 80, 2, 2, 7610, 7611, 7, 85, 2, 2, 7611, 7612,
-	7, 67, 2, 2, 7612, 7613, 7, 69, 2, 2, 7613, 7614, 7, 86, 2, 2, 7614, 7615,
-	7, 75, 2, 2, 7615, 7616, 7, 81, 2, 2, 7616, 7617, 7, 80, 2, 2, 7617, 7618,
-	7, 67, 2, 2, 7618, 7619, 7, 78, 2, 2, 7619, 1196, 3, 2, 2, 2, 7620, 7621,
-	7, 86, 2, 2, 7621, 7622, 7, 84, 2, 2, 7622, 7623, 7, 75, 2, 2, 7623, 7624,
-	7, 73, 2, 2, 7624, 7625, 7, 73, 2, 2, 7625, 7626, 7, 71, 2, 2, 7626, 7627,
-	7, 84, 2, 2, 7627, 7628, 7, 85, 2, 2, 7628, 1198, 3, 2, 2, 2, 7629, 7630,
-	7, 86, 2, 2, 7630, 7631, 7, 84, 2, 2, 7631, 7632, 7, 87, 2, 2, 7632, 7633,
-	7, 80, 2, 2, 7633, 7634, 7, 69, 2, 2, 7634, 7635, 7, 67, 2, 2, 7635, 7636,
-	7, 86, 2, 2, 7636, 7637, 7, 71, 2, 2, 7637, 1200, 3, 2, 2, 2, 7638, 7639,
-	7, 87, 2, 2, 7639, 7640, 7, 80, 2, 2, 7640, 7641, 7, 70, 2, 2, 7641, 7642,
-	7, 71, 2, 2, 7642, 7643, 7, 72, 2, 2, 7643, 7644, 7, 75, 2, 2, 7644, 7645,
-	7, 80, 2, 2, 7645, 7646, 7, 71, 2, 2, 7646, 7647, 7, 70, 2, 2, 7647, 1202,
-	3, 2, 2, 2, 7648, 7649, 7, 87, 2, 2, 7649, 7650, 7, 80, 2, 2, 7650, 7651,
-	7, 70, 2, 2, 7651, 7652, 7, 81, 2, 2, 7652, 7653, 7, 72, 2, 2, 7653, 7654,
-	7, 75, 2, 2, 7654, 7655, 7, 78, 2, 2, 7655, 7656, 7, 71, 2, 2, 7656, 1204,
-	3, 2, 2, 2, 7657, 7658, 7, 87, 2, 2, 7658, 7659, 7, 80, 2, 2, 7659, 7660,
-	7, 70, 2, 2, 7660, 7661, 7, 81, 2, 2, 7661, 7662, 7, 97, 2, 2, 7662, 7663,
-	7, 68, 2, 2, 7663, 7664, 7, 87, 2, 2, 7664, 7665, 7, 72, 2, 2, 7665, 7666,
-	7, 72, 2, 2, 7666, 7667, 7, 71, 2, 2, 7667, 7668, 7, 84, 2, 2, 7668, 7669,
-	7, 97, 2, 2, 7669, 7670, 7, 85, 2, 2, 7670, 7671, 7, 75, 2, 2, 7671, 7672,
-	7, 92, 2, 2, 7672, 7673, 7, 71, 2, 2, 7673, 1206, 3, 2, 2, 2, 7674, 7675,
-	7, 87, 2, 2, 7675, 7676, 7, 80, 2, 2, 7676, 7677, 7, 75, 2, 2, 7677, 7678,
-	7, 80, 2, 2, 7678, 7679, 7, 85, 2, 2, 7679, 7680, 7, 86, 2, 2, 7680, 7681,
-	7, 67, 2, 2, 7681, 7682, 7, 78, 2, 2, 7682, 7683, 7, 78, 2, 2, 7683, 1208,
-	3, 2, 2, 2, 7684, 7685, 7, 87, 2, 2, 7685, 7686, 7, 80, 2, 2, 7686, 7687,
-	7, 77, 2, 2, 7687, 7688, 7, 80, 2, 2, 7688, 7689, 7, 81, 2, 2, 7689, 7690,
-	7, 89, 2, 2, 7690, 7691, 7, 80, 2, 2, 7691, 1210, 3, 2, 2, 2, 7692, 7693,
-	7, 87, 2, 2, 7693, 7694, 7, 80, 2, 2, 7694, 7695, 7, 86, 2, 2, 7695, 7696,
-	7, 75, 2, 2, 7696, 7697, 7, 78, 2, 2, 7697, 1212, 3, 2, 2, 2, 7698, 7699,
-	7, 87, 2, 2, 7699, 7700, 7, 82, 2, 2, 7700, 7701, 7, 73, 2, 2, 7701, 7702,
-	7, 84, 2, 2, 7702, 7703, 7, 67, 2, 2, 7703, 7704, 7, 70, 2, 2, 7704, 7705,
-	7, 71, 2, 2, 7705, 1214, 3, 2, 2, 2, 7706, 7707, 7, 87, 2, 2, 7707, 7708,
-	7, 85, 2, 2, 7708, 7709, 7, 71, 2, 2, 7709, 7710, 7, 84, 2, 2, 7710, 1216,
-	3, 2, 2, 2, 7711, 7712, 7, 87, 2, 2, 7712, 7713, 7, 85, 2, 2, 7713, 7714,
-	7, 71, 2, 2, 7714, 7715, 7, 97, 2, 2, 7715, 7716, 7, 72, 2, 2, 7716, 7717,
-	7, 84, 2, 2, 7717, 7718, 7, 79, 2, 2, 7718, 1218, 3, 2, 2, 2, 7719, 7720,
-	7, 87, 2, 2, 7720, 7721, 7, 85, 2, 2, 7721, 7722, 7, 71, 2, 2, 7722, 7723,
-	7, 84, 2, 2, 7723, 7724, 7, 97, 2, 2, 7724, 7725, 7, 84, 2, 2, 7725, 7726,
-	7, 71, 2, 2, 7726, 7727, 7, 85, 2, 2, 7727, 7728, 7, 81, 2, 2, 7728, 7729,
-	7, 87, 2, 2, 7729, 7730, 7, 84, 2, 2, 7730, 7731, 7, 69, 2, 2, 7731, 7732,
-	7, 71, 2, 2, 7732, 7733, 7, 85, 2, 2, 7733, 1220, 3, 2, 2, 2, 7734, 7735,
-	7, 88, 2, 2, 7735, 7736, 7, 67, 2, 2, 7736, 7737, 7, 78, 2, 2, 7737, 7738,
-	7, 75, 2, 2, 7738, 7739, 7, 70, 2, 2, 7739, 7740, 7, 67, 2, 2, 7740, 7741,
-	7, 86, 2, 2, 7741, 7742, 7, 75, 2, 2, 7742, 7743, 7, 81, 2, 2, 7743, 7744,
-	7, 80, 2, 2, 7744, 1222, 3, 2, 2, 2, 7745, 7746, 7, 88, 2, 2, 7746, 7747,
-	7, 67, 2, 2, 7747, 7748, 7, 78, 2, 2, 7748, 7749, 7, 87, 2, 2, 7749, 7750,
-	7, 71, 2, 2, 7750, 1224, 3, 2, 2, 2, 7751, 7752, 7, 88, 2, 2, 7752, 7753,
-	7, 67, 2, 2, 7753, 7754, 7, 84, 2, 2, 7754, 7755, 7, 75, 2, 2, 7755, 7756,
-	7, 67, 2, 2, 7756, 7757, 7, 68, 2, 2, 7757, 7758, 7, 78, 2, 2, 7758, 7759,
-	7, 71, 2, 2, 7759, 7760, 7, 85, 2, 2, 7760, 1226, 3, 2, 2, 2, 7761, 7762,
-	7, 88, 2, 2, 7762, 7763, 7, 75, 2, 2, 7763, 7764, 7, 71, 2, 2, 7764, 7765,
-	7, 89, 2, 2, 7765, 1228, 3, 2, 2, 2, 7766, 7767, 7, 88, 2, 2, 7767, 7768,
-	7, 75, 2, 2, 7768, 7769, 7, 84, 2, 2, 7769, 7770, 7, 86, 2, 2, 7770, 7771,
-	7, 87, 2, 2, 7771, 7772, 7, 67, 2, 2, 7772, 7773, 7, 78, 2, 2, 7773, 1230,
-	3, 2, 2, 2, 7774, 7775, 7, 88, 2, 2, 7775, 7776, 7, 75, 2, 2, 7776, 7777,
-	7, 85, 2, 2, 7777, 7778, 7, 75, 2, 2, 7778, 7779, 7, 68, 2, 2, 7779, 7780,
-	7, 78, 2, 2, 7780, 7781, 7, 71, 2, 2, 7781, 1232, 3, 2, 2, 2, 7782, 7783,
-	7, 89, 2, 2, 7783, 7784, 7, 67, 2, 2, 7784, 7785, 7, 75, 2, 2, 7785, 7786,
-	7, 86, 2, 2, 7786, 1234, 3, 2, 2, 2, 7787, 7788, 7, 89, 2, 2, 7788, 7789,
-	7, 67, 2, 2, 7789, 7790, 7, 84, 2, 2, 7790, 7791, 7, 80, 2, 2, 7791, 7792,
-	7, 75, 2, 2, 7792, 7793, 7, 80, 2, 2, 7793, 7794, 7, 73, 2, 2, 7794, 7795,
-	7, 85, 2, 2, 7795, 1236, 3, 2, 2, 2, 7796, 7797, 7, 89, 2, 2, 7797, 7798,
-	7, 75, 2, 2, 7798, 7799, 7, 86, 2, 2, 7799, 7800, 7, 74, 2, 2, 7800, 7801,
-	7, 81, 2, 2, 7801, 7802, 7, 87, 2, 2, 7802, 7803, 7, 86, 2, 2, 7803, 1238,
-	3, 2, 2, 2, 7804, 7805, 7, 89, 2, 2, 7805, 7806, 7, 81, 2, 2, 7806, 7807,
-	7, 84, 2, 2, 7807, 7808, 7, 77, 2, 2, 7808, 1240, 3, 2, 2, 2, 7809, 7810,
-	7, 89, 2, 2, 7810, 7811, 7, 84, 2, 2, 7811, 7812, 7, 67, 2, 2, 7812, 7813,
-	7, 82, 2, 2, 7813, 7814, 7, 82, 2, 2, 7814, 7815, 7, 71, 2, 2, 7815, 7816,
-	7, 84, 2, 2, 7816, 1242, 3, 2, 2, 2, 7817, 7818, 7, 90, 2, 2, 7818, 7819,
-	7, 55, 2, 2, 7819, 7820, 7, 50, 2, 2, 7820, 7821, 7, 59, 2, 2, 7821, 1244,
-	3, 2, 2, 2, 7822, 7823, 7, 90, 2, 2, 7823, 7824, 7, 67, 2, 2, 7824, 1246,
-	3, 2, 2, 2, 7825, 7826, 7, 90, 2, 2, 7826, 7827, 7, 79, 2, 2, 7827, 7828,
-	7, 78, 2, 2, 7828, 1248, 3, 2, 2, 2, 7829, 7830, 7, 71, 2, 2, 7830, 7831,
-	7, 87, 2, 2, 7831, 7832, 7, 84, 2, 2, 7832, 1250, 3, 2, 2, 2, 7833, 7834,
-	7, 87, 2, 2, 7834, 7835, 7, 85, 2, 2, 7835, 7836, 7, 67, 2, 2, 7836, 1252,
-	3, 2, 2, 2, 7837, 7838, 7, 76, 2, 2, 7838, 7839, 7, 75, 2, 2, 7839, 7840,
-	7, 85, 2, 2, 7840, 1254, 3, 2, 2, 2, 7841, 7842, 7, 75, 2, 2, 7842, 7843,
-	7, 85, 2, 2, 7843, 7844, 7, 81, 2, 2, 7844, 1256, 3, 2, 2, 2, 7845, 7846,
-	7, 75, 2, 2, 7846, 7847, 7, 80, 2, 2, 7847, 7848, 7, 86, 2, 2, 7848, 7849,
-	7, 71, 2, 2, 7849, 7850, 7, 84, 2, 2, 7850, 7851, 7, 80, 2, 2, 7851, 7852,
-	7, 67, 2, 2, 7852, 7853, 7, 78, 2, 2, 7853, 1258, 3, 2, 2, 2, 7854, 7855,
-	7, 83, 2, 2, 7855, 7856, 7, 87, 2, 2, 7856, 7857, 7, 67, 2, 2, 7857, 7858,
-	7, 84, 2, 2, 7858, 7859, 7, 86, 2, 2, 7859, 7860, 7, 71, 2, 2, 7860, 7861,
-	7, 84, 2, 2, 7861, 1260, 3, 2, 2, 2, 7862, 7863, 7, 79, 2, 2, 7863, 7864,
-	7, 81, 2, 2, 7864, 7865, 7, 80, 2, 2, 7865, 7866, 7, 86, 2, 2, 7866, 7867,
-	7, 74, 2, 2, 7867, 1262, 3, 2, 2, 2, 7868, 7869, 7, 70, 2, 2, 7869, 7870,
-	7, 67, 2, 2, 7870, 7871, 7, 91, 2, 2, 7871, 1264, 3, 2, 2, 2, 7872, 7873,
-	7, 74, 2, 2, 7873, 7874, 7, 81, 2, 2, 7874, 7875, 7, 87, 2, 2, 7875, 7876,
-	7, 84, 2, 2, 7876, 1266, 3, 2, 2, 2, 7877, 7878, 7, 79, 2, 2, 7878, 7879,
-	7, 75, 2, 2, 7879, 7880, 7, 80, 2, 2, 7880, 7881, 7, 87, 2, 2, 7881, 7882,
-	7, 86, 2, 2, 7882, 7883, 7, 71, 2, 2, 7883, 1268, 3, 2, 2, 2, 7884, 7885,
-	7, 89, 2, 2, 7885, 7886, 7, 71, 2, 2, 7886, 7887, 7, 71, 2, 2, 7887, 7888,
-	7, 77, 2, 2, 7888, 1270, 3, 2, 2, 2, 7889, 7890, 7, 85, 2, 2, 7890, 7891,
-	7, 71, 2, 2, 7891, 7892, 7, 69, 2, 2, 7892, 7893, 7, 81, 2, 2, 7893, 7894,
-	7, 80, 2, 2, 7894, 7895, 7, 70, 2, 2, 7895, 1272, 3, 2, 2, 2, 7896, 7897,
-	7, 79, 2, 2, 7897, 7898, 7, 75, 2, 2, 7898, 7899, 7, 69, 2, 2, 7899, 7900,
-	7, 84, 2, 2, 7900, 7901, 7, 81, 2, 2, 7901, 7902, 7, 85, 2, 2, 7902, 7903,
-	7, 71, 2, 2, 7903, 7904, 7, 69, 2, 2, 7904, 7905, 7, 81, 2, 2, 7905, 7906,
-	7, 80, 2, 2, 7906, 7907, 7, 70, 2, 2, 7907, 1274, 3, 2, 2, 2, 7908, 7909,
-	7, 86, 2, 2, 7909, 7910, 7, 67, 2, 2, 7910, 7911, 7, 68, 2, 2, 7911, 7912,
-	7, 78, 2, 2, 7912, 7913, 7, 71, 2, 2, 7913, 7914, 7, 85, 2, 2, 7914, 1276,
-	3, 2, 2, 2, 7915, 7916, 7, 84, 2, 2, 7916, 7917, 7, 81, 2, 2, 7917, 7918,
-	7, 87, 2, 2, 7918, 7919, 7, 86, 2, 2, 7919, 7920, 7, 75, 2, 2, 7920, 7921,
-	7, 80, 2, 2, 7921, 7922, 7, 71, 2, 2, 7922, 1278, 3, 2, 2, 2, 7923, 7924,
-	7, 71, 2, 2, 7924, 7925, 7, 90, 2, 2, 7925, 7926, 7, 71, 2, 2, 7926, 7927,
-	7, 69, 2, 2, 7927, 7928, 7, 87, 2, 2, 7928, 7929, 7, 86, 2, 2, 7929, 7930,
-	7, 71, 2, 2, 7930, 1280, 3, 2, 2, 2, 7931, 7932, 7, 72, 2, 2, 7932, 7933,
-	7, 75, 2, 2, 7933, 7934, 7, 78, 2, 2, 7934, 7935, 7, 71, 2, 2, 7935, 1282,
-	3, 2, 2, 2, 7936, 7937, 7, 82, 2, 2, 7937, 7938, 7, 84, 2, 2, 7938, 7939,
-	7, 81, 2, 2, 7939, 7940, 7, 69, 2, 2, 7940, 7941, 7, 71, 2, 2, 7941, 7942,
-	7, 85, 2, 2, 7942, 7943, 7, 85, 2, 2, 7943, 1284, 3, 2, 2, 2, 7944, 7945,
-	7, 84, 2, 2, 7945, 7946, 7, 71, 2, 2, 7946, 7947, 7, 78, 2, 2, 7947, 7948,
-	7, 81, 2, 2, 7948, 7949, 7, 67, 2, 2, 7949, 7950, 7, 70, 2, 2, 7950, 1286,
-	3, 2, 2, 2, 7951, 7952, 7, 85, 2, 2, 7952, 7953, 7, 74, 2, 2, 7953, 7954,
-	7, 87, 2, 2, 7954, 7955, 7, 86, 2, 2, 7955, 7956, 7, 70, 2, 2, 7956, 7957,
-	7, 81, 2, 2, 7957, 7958, 7, 89, 2, 2, 7958, 7959, 7, 80, 2, 2, 7959, 1288,
-	3, 2, 2, 2, 7960, 7961, 7, 85, 2, 2, 7961, 7962, 7, 87, 2, 2, 7962, 7963,
-	7, 82, 2, 2, 7963, 7964, 7, 71, 2, 2, 7964, 7965, 7, 84, 2, 2, 7965, 1290,
-	3, 2, 2, 2, 7966, 7967, 7, 82, 2, 2, 7967, 7968, 7, 84, 2, 2, 7968, 7969,
-	7, 75, 2, 2, 7969, 7970, 7, 88, 2, 2, 7970, 7971, 7, 75, 2, 2, 7971, 7972,
-	7, 78, 2, 2, 7972, 7973, 7, 71, 2, 2, 7973, 7974, 7, 73, 2, 2, 7974, 7975,
-	7, 71, 2, 2, 7975, 7976, 7, 85, 2, 2, 7976, 1292, 3, 2, 2, 2, 7977, 7978,
-	7, 67, 2, 2, 7978, 7979, 7, 82, 2, 2, 7979, 7980, 7, 82, 2, 2, 7980, 7981,
-	7, 78, 2, 2, 7981, 7982, 7, 75, 2, 2, 7982, 7983, 7, 69, 2, 2, 7983, 7984,
-	7, 67, 2, 2, 7984, 7985, 7, 86, 2, 2, 7985, 7986, 7, 75, 2, 2, 7986, 7987,
-	7, 81, 2, 2, 7987, 7988, 7, 80, 2, 2, 7988, 7989, 7, 97, 2, 2, 7989, 7990,
-	7, 82, 2, 2, 7990, 7991, 7, 67, 2, 2, 7991, 7992, 7, 85, 2, 2, 7992, 7993,
-	7, 85, 2, 2, 7993, 7994, 7, 89, 2, 2, 7994, 7995, 7, 81, 2, 2, 7995, 7996,
-	7, 84, 2, 2, 7996, 7997, 7, 70, 2, 2, 7997, 7998, 7, 97, 2, 2, 7998, 7999,
-	7, 67, 2, 2, 7999, 8000, 7, 70, 2, 2, 8000, 8001, 7, 79, 2, 2, 8001, 8002,
-	7, 75, 2, 2, 8002, 8003, 7, 80, 2, 2, 8003, 1294, 3, 2, 2, 2, 8004, 8005,
-	7, 67, 2, 2, 8005, 8006, 7, 87, 2, 2, 8006, 8007, 7, 70, 2, 2, 8007, 8008,
-	7, 75, 2, 2, 8008, 8009, 7, 86, 2, 2, 8009, 8010, 7, 97, 2, 2, 8010, 8011,
-	7, 67, 2, 2, 8011, 8012, 7, 70, 2, 2, 8012, 8013, 7, 79, 2, 2, 8013, 8014,
-	7, 75, 2, 2, 8014, 8015, 7, 80, 2, 2, 8015, 1296, 3, 2, 2, 2, 8016, 8017,
-	7, 68, 2, 2, 8017, 8018, 7, 67, 2, 2, 8018, 8019, 7, 69, 2, 2, 8019, 8020,
-	7, 77, 2, 2, 8020, 8021, 7, 87, 2, 2, 8021, 8022, 7, 82, 2, 2, 8022, 8023,
-	7, 97, 2, 2, 8023, 8024, 7, 67, 2, 2, 8024, 8025, 7, 70, 2, 2, 8025, 8026,
-	7, 79, 2, 2, 8026, 8027, 7, 75, 2, 2, 8027, 8028, 7, 80, 2, 2, 8028, 1298,
-	3, 2, 2, 2, 8029, 8030, 7, 68, 2, 2, 8030, 8031, 7, 75, 2, 2, 8031, 8032,
-	7, 80, 2, 2, 8032, 8033, 7, 78, 2, 2, 8033, 8034, 7, 81, 2, 2, 8034, 8035,
-	7, 73, 2, 2, 8035, 8036, 7, 97, 2, 2, 8036, 8037, 7, 67, 2, 2, 8037, 8038,
-	7, 70, 2, 2, 8038, 8039, 7, 79, 2, 2, 8039, 8040, 7, 75, 2, 2, 8040, 8041,
-	7, 80, 2, 2, 8041, 1300, 3, 2, 2, 2, 8042, 8043, 7, 68, 2, 2, 8043, 8044,
-	7, 75, 2, 2, 8044, 8045, 7, 80, 2, 2, 8045, 8046, 7, 78, 2, 2, 8046, 8047,
-	7, 81, 2, 2, 8047, 8048, 7, 73, 2, 2, 8048, 8049, 7, 97, 2, 2, 8049, 8050,
-	7, 71, 2, 2, 8050, 8051, 7, 80, 2, 2, 8051, 8052, 7, 69, 2, 2, 8052, 8053,
-	7, 84, 2, 2, 8053, 8054, 7, 91, 2, 2, 8054, 8055, 7, 82, 2, 2, 8055, 8056,
-	7, 86, 2, 2, 8056, 8057, 7, 75, 2, 2, 8057, 8058, 7, 81, 2, 2, 8058, 8059,
-	7, 80, 2, 2, 8059, 8060, 7, 97, 2, 2, 8060, 8061, 7, 67, 2, 2, 8061, 8062,
-	7, 70, 2, 2, 8062, 8063, 7, 79, 2, 2, 8063, 8064, 7, 75, 2, 2, 8064, 8065,
-	7, 80, 2, 2, 8065, 1302, 3, 2, 2, 2, 8066, 8067, 7, 69, 2, 2, 8067, 8068,
-	7, 78, 2, 2, 8068, 8069, 7, 81, 2, 2, 8069, 8070, 7, 80, 2, 2, 8070, 8071,
-	7, 71, 2, 2, 8071, 8072, 7, 97, 2, 2, 8072, 8073, 7, 67, 2, 2, 8073, 8074,
-	7, 70, 2, 2, 8074, 8075, 7, 79, 2, 2, 8075, 8076, 7, 75, 2, 2, 8076, 8077,
-	7, 80, 2, 2, 8077, 1304, 3, 2, 2, 2, 8078, 8079, 7, 69, 2, 2, 8079, 8080,
-	7, 81, 2, 2, 8080, 8081, 7, 80, 2, 2, 8081, 8082, 7, 80, 2, 2, 8082, 8083,
-	7, 71, 2, 2, 8083, 8084, 7, 69, 2, 2, 8084, 8085, 7, 86, 2, 2, 8085, 8086,
-	7, 75, 2, 2, 8086, 8087, 7, 81, 2, 2, 8087, 8088, 7, 80, 2, 2, 8088, 8089,
-	7, 97, 2, 2, 8089, 8090, 7, 67, 2, 2, 8090, 8091, 7, 70, 2, 2, 8091, 8092,
-	7, 79, 2, 2, 8092, 8093, 7, 75, 2, 2, 8093, 8094, 7, 80, 2, 2, 8094, 1306,
-	3, 2, 2, 2, 8095, 8096, 7, 71, 2, 2, 8096, 8097, 7, 80, 2, 2, 8097, 8098,
-	7, 69, 2, 2, 8098, 8099, 7, 84, 2, 2, 8099, 8100, 7, 91, 2, 2, 8100, 8101,
-	7, 82, 2, 2, 8101, 8102, 7, 86, 2, 2, 8102, 8103, 7, 75, 2, 2, 8103, 8104,
-	7, 81, 2, 2, 8104, 8105, 7, 80, 2, 2, 8105, 8106, 7, 97, 2, 2, 8106, 8107,
-	7, 77, 2, 2, 8107, 8108, 7, 71, 2, 2, 8108, 8109, 7, 91, 2, 2, 8109, 8110,
-	7, 97, 2, 2, 8110, 8111, 7, 67, 2, 2, 8111, 8112, 7, 70, 2, 2, 8112, 8113,
-	7, 79, 2, 2, 8113, 8114, 7, 75, 2, 2, 8114, 8115, 7, 80, 2, 2, 8115, 1308,
-	3, 2, 2, 2, 8116, 8117, 7, 72, 2, 2, 8117, 8118, 7, 75, 2, 2, 8118, 8119,
-	7, 84, 2, 2, 8119, 8120, 7, 71, 2, 2, 8120, 8121, 7, 89, 2, 2, 8121, 8122,
-	7, 67, 2, 2, 8122, 8123, 7, 78, 2, 2, 8123, 8124, 7, 78, 2, 2, 8124, 8125,
-	7, 97, 2, 2, 8125, 8126, 7, 67, 2, 2, 8126, 8127, 7, 70, 2, 2, 8127, 8128,
-	7, 79, 2, 2, 8128, 8129, 7, 75, 2, 2, 8129, 8130, 7, 80, 2, 2, 8130, 1310,
-	3, 2, 2, 2, 8131, 8132, 7, 72, 2, 2, 8132, 8133, 7, 75, 2, 2, 8133, 8134,
-	7, 84, 2, 2, 8134, 8135, 7, 71, 2, 2, 8135, 8136, 7, 89, 2, 2, 8136, 8137,
-	7, 67, 2, 2, 8137, 8138, 7, 78, 2, 2, 8138, 8139, 7, 78, 2, 2, 8139, 8140,
-	7, 97, 2, 2, 8140, 8141, 7, 87, 2, 2, 8141, 8142, 7, 85, 2, 2, 8142, 8143,
-	7, 71, 2, 2, 8143, 8144, 7, 84, 2, 2, 8144, 1312, 3, 2, 2, 2, 8145, 8146,
-	7, 72, 2, 2, 8146, 8147, 7, 78, 2, 2, 8147, 8148, 7, 87, 2, 2, 8148, 8149,
-	7, 85, 2, 2, 8149, 8150, 7, 74, 2, 2, 8150, 8151, 7, 97, 2, 2, 8151, 8152,
-	7, 81, 2, 2, 8152, 8153, 7, 82, 2, 2, 8153, 8154, 7, 86, 2, 2, 8154, 8155,
-	7, 75, 2, 2, 8155, 8156, 7, 79, 2, 2, 8156, 8157, 7, 75, 2, 2, 8157, 8158,
-	7, 92, 2, 2, 8158, 8159, 7, 71, 2, 2, 8159, 8160, 7, 84, 2, 2, 8160, 8161,
-	7, 97, 2, 2, 8161, 8162, 7, 69, 2, 2, 8162, 8163, 7, 81, 2, 2, 8163, 8164,
-	7, 85, 2, 2, 8164, 8165, 7, 86, 2, 2, 8165, 8166, 7, 85, 2, 2, 8166, 1314,
-	3, 2, 2, 2, 8167, 8168, 7, 72, 2, 2, 8168, 8169, 7, 78, 2, 2, 8169, 8170,
-	7, 87, 2, 2, 8170, 8171, 7, 85, 2, 2, 8171, 8172, 7, 74, 2, 2, 8172, 8173,
-	7, 97, 2, 2, 8173, 8174, 7, 85, 2, 2, 8174, 8175, 7, 86, 2, 2, 8175, 8176,
-	7, 67, 2, 2, 8176, 8177, 7, 86, 2, 2, 8177, 8178, 7, 87, 2, 2, 8178, 8179,
-	7, 85, 2, 2, 8179, 1316, 3, 2, 2, 2, 8180, 8181, 7, 72, 2, 2, 8181, 8182,
-	7, 78, 2, 2, 8182, 8183, 7, 87, 2, 2, 8183, 8184, 7, 85, 2, 2, 8184, 8185,
-	7, 74, 2, 2, 8185, 8186, 7, 97, 2, 2, 8186, 8187, 7, 86, 2, 2, 8187, 8188,
-	7, 67, 2, 2, 8188, 8189, 7, 68, 2, 2, 8189, 8190, 7, 78, 2, 2, 8190, 8191,
-	7, 71, 2, 2, 8191, 8192, 7, 85, 2, 2, 8192, 1318, 3, 2, 2, 2, 8193, 8194,
-	7, 72, 2, 2, 8194, 8195, 7, 78, 2, 2, 8195, 8196, 7, 87, 2, 2, 8196, 8197,
-	7, 85, 2, 2, 8197, 8198, 7, 74, 2, 2, 8198, 8199, 7, 97, 2, 2, 8199, 8200,
-	7, 87, 2, 2, 8200, 8201, 7, 85, 2, 2, 8201, 8202, 7, 71, 2, 2, 8202, 8203,
-	7, 84, 2, 2, 8203, 8204, 7, 97, 2, 2, 8204, 8205, 7, 84, 2, 2, 8205, 8206,
-	7, 71, 2, 2, 8206, 8207, 7, 85, 2, 2, 8207, 8208, 7, 81, 2, 2, 8208, 8209,
-	7, 87, 2, 2, 8209, 8210, 7, 84, 2, 2, 8210, 8211, 7, 69, 2, 2, 8211, 8212,
-	7, 71, 2, 2, 8212, 8213, 7, 85, 2, 2, 8213, 1320, 3, 2, 2, 2, 8214, 8215,
-	7, 73, 2, 2, 8215, 8216, 7, 84, 2, 2, 8216, 8217, 7, 81, 2, 2, 8217, 8218,
-	7, 87, 2, 2, 8218, 8219, 7, 82, 2, 2, 8219, 8220, 7, 97, 2, 2, 8220, 8221,
-	7, 84, 2, 2, 8221, 8222, 7, 71, 2, 2, 8222, 8223, 7, 82, 2, 2, 8223, 8224,
-	7, 78, 2, 2, 8224, 8225, 7, 75, 2, 2, 8225, 8226, 7, 69, 2, 2, 8226, 8227,
-	7, 67, 2, 2, 8227, 8228, 7, 86, 2, 2, 8228, 8229, 7, 75, 2, 2, 8229, 8230,
-	7, 81, 2, 2, 8230, 8231, 7, 80, 2, 2, 8231, 8232, 7, 97, 2, 2, 8232, 8233,
-	7, 67, 2, 2, 8233, 8234, 7, 70, 2, 2, 8234, 8235, 7, 79, 2, 2, 8235, 8236,
-	7, 75, 2, 2, 8236, 8237, 7, 80, 2, 2, 8237, 1322, 3, 2, 2, 2, 8238, 8239,
-	7, 75, 2, 2, 8239, 8240, 7, 80, 2, 2, 8240, 8241, 7, 80, 2, 2, 8241, 8242,
-	7, 81, 2, 2, 8242, 8243, 7, 70, 2, 2, 8243, 8244, 7, 68, 2, 2, 8244, 8245,
-	7, 97, 2, 2, 8245, 8246, 7, 84, 2, 2, 8246, 8247, 7, 71, 2, 2, 8247, 8248,
-	7, 70, 2, 2, 8248, 8249, 7, 81, 2, 2, 8249, 8250, 7, 97, 2, 2, 8250, 8251,
-	7, 78, 2, 2, 8251, 8252, 7, 81, 2, 2, 8252, 8253, 7, 73, 2, 2, 8253, 8254,
-	7, 97, 2, 2, 8254, 8255, 7, 67, 2, 2, 8255, 8256, 7, 84, 2, 2, 8256, 8257,
-	7, 69, 2, 2, 8257, 8258, 7, 74, 2, 2, 8258, 8259, 7, 75, 2, 2, 8259, 8260,
-	7, 88, 2, 2, 8260, 8261, 7, 71, 2, 2, 8261, 1324, 3, 2, 2, 2, 8262, 8263,
-	7, 75, 2, 2, 8263, 8264, 7, 80, 2, 2, 8264, 8265, 7, 80, 2, 2, 8265, 8266,
-	7, 81, 2, 2, 8266, 8267, 7, 70, 2, 2, 8267, 8268, 7, 68, 2, 2, 8268, 8269,
-	7, 97, 2, 2, 8269, 8270, 7, 84, 2, 2, 8270, 8271, 7, 71, 2, 2, 8271, 8272,
-	7, 70, 2, 2, 8272, 8273, 7, 81, 2, 2, 8273, 8274, 7, 97, 2, 2, 8274, 8275,
-	7, 78, 2, 2, 8275, 8276, 7, 81, 2, 2, 8276, 8277, 7, 73, 2, 2, 8277, 8278,
-	7, 97, 2, 2, 8278, 8279, 7, 71, 2, 2, 8279, 8280, 7, 80, 2, 2, 8280, 8281,
-	7, 67, 2, 2, 8281, 8282, 7, 68, 2, 2, 8282, 8283, 7, 78, 2, 2, 8283, 8284,
-	7, 71, 2, 2, 8284, 1326, 3, 2, 2, 2, 8285, 8286, 7, 80, 2, 2, 8286, 8287,
-	7, 70, 2, 2, 8287, 8288, 7, 68, 2, 2, 8288, 8289, 7, 97, 2, 2, 8289, 8290,
-	7, 85, 2, 2, 8290, 8291, 7, 86, 2, 2, 8291, 8292, 7, 81, 2, 2, 8292, 8293,
-	7, 84, 2, 2, 8293, 8294, 7, 71, 2, 2, 8294, 8295, 7, 70, 2, 2, 8295, 8296,
-	7, 97, 2, 2, 8296, 8297, 7, 87, 2, 2, 8297, 8298, 7, 85, 2, 2, 8298, 8299,
-	7, 71, 2, 2, 8299, 8300, 7, 84, 2, 2, 8300, 1328, 3, 2, 2, 2, 8301, 8302,
-	7, 82, 2, 2, 8302, 8303, 7, 71, 2, 2, 8303, 8304, 7, 84, 2, 2, 8304, 8305,
-	7, 85, 2, 2, 8305, 8306, 7, 75, 2, 2, 8306, 8307, 7, 85, 2, 2, 8307, 8308,
-	7, 86, 2, 2, 8308, 8309, 7, 97, 2, 2, 8309, 8310, 7, 84, 2, 2, 8310, 8311,
-	7, 81, 2, 2, 8311, 8312, 7, 97, 2, 2, 8312, 8313, 7, 88, 2, 2, 8313, 8314,
-	7, 67, 2, 2, 8314, 8315, 7, 84, 2, 2, 8315, 8316, 7, 75, 2, 2, 8316, 8317,
-	7, 67, 2, 2, 8317, 8318, 7, 68, 2, 2, 8318, 8319, 7, 78, 2, 2, 8319, 8320,
-	7, 71, 2, 2, 8320, 8321, 7, 85, 2, 2, 8321, 8322, 7, 97, 2, 2, 8322, 8323,
-	7, 67, 2, 2, 8323, 8324, 7, 70, 2, 2, 8324, 8325, 7, 79, 2, 2, 8325, 8326,
-	7, 75, 2, 2, 8326, 8327, 7, 80, 2, 2, 8327, 1330, 3, 2, 2, 2, 8328, 8329,
-	7, 84, 2, 2, 8329, 8330, 7, 71, 2, 2, 8330, 8331, 7, 82, 2, 2, 8331, 8332,
-	7, 78, 2, 2, 8332, 8333, 7, 75, 2, 2, 8333, 8334, 7, 69, 2, 2, 8334, 8335,
-	7, 67, 2, 2, 8335, 8336, 7, 86, 2, 2, 8336, 8337, 7, 75, 2, 2, 8337, 8338,
-	7, 81, 2, 2, 8338, 8339, 7, 80, 2, 2, 8339, 8340, 7, 97, 2, 2, 8340, 8341,
-	7, 67, 2, 2, 8341, 8342, 7, 82, 2, 2, 8342, 8343, 7, 82, 2, 2, 8343, 8344,
-	7, 78, 2, 2, 8344, 8345, 7, 75, 2, 2, 8345, 8346, 7, 71, 2, 2, 8346, 8347,
-	7, 84, 2, 2, 8347, 1332, 3, 2, 2, 2, 8348, 8349, 7, 84, 2, 2, 8349, 8350,
-	7, 71, 2, 2, 8350, 8351, 7, 82, 2, 2, 8351, 8352, 7, 78, 2, 2, 8352, 8353,
-	7, 75, 2, 2, 8353, 8354, 7, 69, 2, 2, 8354, 8355, 7, 67, 2, 2, 8355, 8356,
-	7, 86, 2, 2, 8356, 8357, 7, 75, 2, 2, 8357, 8358, 7, 81, 2, 2, 8358, 8359,
-	7, 80, 2, 2, 8359, 8360, 7, 97, 2, 2, 8360, 8361, 7, 85, 2, 2, 8361, 8362,
-	7, 78, 2, 2, 8362, 8363, 7, 67, 2, 2, 8363, 8364, 7, 88, 2, 2, 8364, 8365,
-	7, 71, 2, 2, 8365, 8366, 7, 97, 2, 2, 8366, 8367, 7, 67, 2, 2, 8367, 8368,
-	7, 70, 2, 2, 8368, 8369, 7, 79, 2, 2, 8369, 8370, 7, 75, 2, 2, 8370, 8371,
-	7, 80, 2, 2, 8371, 1334, 3, 2, 2, 2, 8372, 8373, 7, 84, 2, 2, 8373, 8374,
-	7, 71, 2, 2, 8374, 8375, 7, 85, 2, 2, 8375, 8376, 7, 81, 2, 2, 8376, 8377,
-	7, 87, 2, 2, 8377, 8378, 7, 84, 2, 2, 8378, 8379, 7, 69, 2, 2, 8379, 8380,
-	7, 71, 2, 2, 8380, 8381, 7, 97, 2, 2, 8381, 8382, 7, 73, 2, 2, 8382, 8383,
-	7, 84, 2, 2, 8383, 8384, 7, 81, 2, 2, 8384, 8385, 7, 87, 2, 2, 8385, 8386,
-	7, 82, 2, 2, 8386, 8387, 7, 97, 2, 2, 8387, 8388, 7, 67, 2, 2, 8388, 8389,
-	7, 70, 2, 2, 8389, 8390, 7, 79, 2, 2, 8390, 8391, 7, 75, 2, 2, 8391, 8392,
-	7, 80, 2, 2, 8392, 1336, 3, 2, 2, 2, 8393, 8394, 7, 84, 2, 2, 8394, 8395,
-	7, 71, 2, 2, 8395, 8396, 7, 85, 2, 2, 8396, 8397, 7, 81, 2, 2, 8397, 8398,
-	7, 87, 2, 2, 8398, 8399, 7, 84, 2, 2, 8399, 8400, 7, 69, 2, 2, 8400, 8401,
-	7, 71, 2, 2, 8401, 8402, 7, 97, 2, 2, 8402, 8403, 7, 73, 2, 2, 8403, 8404,
-	7, 84, 2, 2, 8404, 8405, 7, 81, 2, 2, 8405, 8406, 7, 87, 2, 2, 8406, 8407,
-	7, 82, 2, 2, 8407, 8408, 7, 97, 2, 2, 8408, 8409, 7, 87, 2, 2, 8409, 8410,
-	7, 85, 2, 2, 8410, 8411, 7, 71, 2, 2, 8411, 8412, 7, 84, 2, 2, 8412, 1338,
-	3, 2, 2, 2, 8413, 8414, 7, 84, 2, 2, 8414, 8415, 7, 81, 2, 2, 8415, 8416,
-	7, 78, 2, 2, 8416, 8417, 7, 71, 2, 2, 8417, 8418, 7, 97, 2, 2, 8418, 8419,
-	7, 67, 2, 2, 8419, 8420, 7, 70, 2, 2, 8420, 8421, 7, 79, 2, 2, 8421, 8422,
-	7, 75, 2, 2, 8422, 8423, 7, 80, 2, 2, 8423, 1340, 3, 2, 2, 2, 8424, 8425,
-	7, 85, 2, 2, 8425, 8426, 7, 71, 2, 2, 8426, 8427, 7, 84, 2, 2, 8427, 8428,
-	7, 88, 2, 2, 8428, 8429, 7, 75, 2, 2, 8429, 8430, 7, 69, 2, 2, 8430, 8431,
-	7, 71, 2, 2, 8431, 8432, 7, 97, 2, 2, 8432, 8433, 7, 69, 2, 2, 8433, 8434,
-	7, 81, 2, 2, 8434, 8435, 7, 80, 2, 2, 8435, 8436, 7, 80, 2, 2, 8436, 8437,
-	7, 71, 2, 2, 8437, 8438, 7, 69, 2, 2, 8438, 8439, 7, 86, 2, 2, 8439, 8440,
-	7, 75, 2, 2, 8440, 8441, 7, 81, 2, 2, 8441, 8442, 7, 80, 2, 2, 8442, 8443,
-	7, 97, 2, 2, 8443, 8444, 7, 67, 2, 2, 8444, 8445, 7, 70, 2, 2, 8445, 8446,
-	7, 79, 2, 2, 8446, 8447, 7, 75, 2, 2, 8447, 8448, 7, 80, 2, 2, 8448, 1342,
-	3, 2, 2, 2, 8449, 8451, 5, 2147, 1074, 2, 8450, 8449, 3, 2, 2, 2, 8450,
-	8451, 3, 2, 2, 2, 8451, 8452, 3, 2, 2, 2, 8452, 8453, 7, 85, 2, 2, 8453,
-	8454, 7, 71, 2, 2, 8454, 8455, 7, 85, 2, 2, 8455, 8456, 7, 85, 2, 2, 8456,
-	8457, 7, 75, 2, 2, 8457, 8458, 7, 81, 2, 2, 8458, 8459, 7, 80, 2, 2, 8459,
-	8460, 7, 97, 2, 2, 8460, 8461, 7, 88, 2, 2, 8461, 8462, 7, 67, 2, 2, 8462,
-	8463, 7, 84, 2, 2, 8463, 8464, 7, 75, 2, 2, 8464, 8465, 7, 67, 2, 2, 8465,
-	8466, 7, 68, 2, 2, 8466, 8467, 7, 78, 2, 2, 8467, 8468, 7, 71, 2, 2, 8468,
-	8469, 7, 85, 2, 2, 8469, 8470, 7, 97, 2, 2, 8470, 8471, 7, 67, 2, 2, 8471,
-	8472, 7, 70, 2, 2, 8472, 8473, 7, 79, 2, 2, 8473, 8474, 7, 75, 2, 2, 8474,
-	8475, 7, 80, 2, 2, 8475, 8477, 3, 2, 2, 2, 8476, 8478, 5, 2147, 1074, 2,
-	8477, 8476, 3, 2, 2, 2, 8477, 8478, 3, 2, 2, 2, 8478, 1344, 3, 2, 2, 2,
-	8479, 8480, 7, 85, 2, 2, 8480, 8481, 7, 71, 2, 2, 8481, 8482, 7, 86, 2,
-	2, 8482, 8483, 7, 97, 2, 2, 8483, 8484, 7, 87, 2, 2, 8484, 8485, 7, 85,
-	2, 2, 8485, 8486, 7, 71, 2, 2, 8486, 8487, 7, 84, 2, 2, 8487, 8488, 7,
-	97, 2, 2, 8488, 8489, 7, 75, 2, 2, 8489, 8490, 7, 70, 2, 2, 8490, 1346,
-	3, 2, 2, 2, 8491, 8492, 7, 85, 2, 2, 8492, 8493, 7, 74, 2, 2, 8493, 8494,
-	7, 81, 2, 2, 8494, 8495, 7, 89, 2, 2, 8495, 8496, 7, 97, 2, 2, 8496, 8497,
-	7, 84, 2, 2, 8497, 8498, 7, 81, 2, 2, 8498, 8499, 7, 87, 2, 2, 8499, 8500,
-	7, 86, 2, 2, 8500, 8501, 7, 75, 2, 2, 8501, 8502, 7, 80, 2, 2, 8502, 8503,
-	7, 71, 2, 2, 8503, 1348, 3, 2, 2, 2, 8504, 8505, 7, 85, 2, 2, 8505, 8506,
-	7, 91, 2, 2, 8506, 8507, 7, 85, 2, 2, 8507, 8508, 7, 86, 2, 2, 8508, 8509,
-	7, 71, 2, 2, 8509, 8510, 7, 79, 2, 2, 8510, 8511, 7, 97, 2, 2, 8511, 8512,
-	7, 88, 2, 2, 8512, 8513, 7, 67, 2, 2, 8513, 8514, 7, 84, 2, 2, 8514, 8515,
-	7, 75, 2, 2, 8515, 8516, 7, 67, 2, 2, 8516, 8517, 7, 68, 2, 2, 8517, 8518,
-	7, 78, 2, 2, 8518, 8519, 7, 71, 2, 2, 8519, 8520, 7, 85, 2, 2, 8520, 8521,
-	7, 97, 2, 2, 8521, 8522, 7, 67, 2, 2, 8522, 8523, 7, 70, 2, 2, 8523, 8524,
-	7, 79, 2, 2, 8524, 8525, 7, 75, 2, 2, 8525, 8526, 7, 80, 2, 2, 8526, 1350,
-	3, 2, 2, 2, 8527, 8528, 7, 86, 2, 2, 8528, 8529, 7, 67, 2, 2, 8529, 8530,
-	7, 68, 2, 2, 8530, 8531, 7, 78, 2, 2, 8531, 8532, 7, 71, 2, 2, 8532, 8533,
-	7, 97, 2, 2, 8533, 8534, 7, 71, 2, 2, 8534, 8535, 7, 80, 2, 2, 8535, 8536,
-	7, 69, 2, 2, 8536, 8537, 7, 84, 2, 2, 8537, 8538, 7, 91, 2, 2, 8538, 8539,
-	7, 82, 2, 2, 8539, 8540, 7, 86, 2, 2, 8540, 8541, 7, 75, 2, 2, 8541, 8542,
-	7, 81, 2, 2, 8542, 8543, 7, 80, 2, 2, 8543, 8544, 7, 97, 2, 2, 8544, 8545,
-	7, 67, 2, 2, 8545, 8546, 7, 70, 2, 2, 8546, 8547, 7, 79, 2, 2, 8547, 8548,
-	7, 75, 2, 2, 8548, 8549, 7, 80, 2, 2, 8549, 1352, 3, 2, 2, 2, 8550, 8551,
-	7, 88, 2, 2, 8551, 8552, 7, 71, 2, 2, 8552, 8553, 7, 84, 2, 2, 8553, 8554,
-	7, 85, 2, 2, 8554, 8555, 7, 75, 2, 2, 8555, 8556, 7, 81, 2, 2, 8556, 8557,
-	7, 80, 2, 2, 8557, 8558, 7, 97, 2, 2, 8558, 8559, 7, 86, 2, 2, 8559, 8560,
-	7, 81, 2, 2, 8560, 8561, 7, 77, 2, 2, 8561, 8562, 7, 71, 2, 2, 8562, 8563,
-	7, 80, 2, 2, 8563, 8564, 7, 97, 2, 2, 8564, 8565, 7, 67, 2, 2, 8565, 8566,
-	7, 70, 2, 2, 8566, 8567, 7, 79, 2, 2, 8567, 8568, 7, 75, 2, 2, 8568, 8569,
-	7, 80, 2, 2, 8569, 1354, 3, 2, 2, 2, 8570, 8571, 7, 90, 2, 2, 8571, 8572,
-	7, 67, 2, 2, 8572, 8573, 7, 97, 2, 2, 8573, 8574, 7, 84, 2, 2, 8574, 8575,
-	7, 71, 2, 2, 8575, 8576, 7, 69, 2, 2, 8576, 8577, 7, 81, 2, 2, 8577, 8578,
-	7, 88, 2, 2, 8578, 8579, 7, 71, 2, 2, 8579, 8580, 7, 84, 2, 2, 8580, 8581,
-	7, 97, 2, 2, 8581, 8582, 7, 67, 2, 2, 8582, 8583, 7, 70, 2, 2, 8583, 8584,
-	7, 79, 2, 2, 8584, 8585, 7, 75, 2, 2, 8585, 8586, 7, 80, 2, 2, 8586, 1356,
-	3, 2, 2, 2, 8587, 8588, 7, 67, 2, 2, 8588, 8589, 7, 84, 2, 2, 8589, 8590,
-	7, 79, 2, 2, 8590, 8591, 7, 85, 2, 2, 8591, 8592, 7, 69, 2, 2, 8592, 8593,
-	7, 75, 2, 2, 8593, 8594, 7, 75, 2, 2, 8594, 8595, 7, 58, 2, 2, 8595, 1358,
-	3, 2, 2, 2, 8596, 8597, 7, 67, 2, 2, 8597, 8598, 7, 85, 2, 2, 8598, 8599,
-	7, 69, 2, 2, 8599, 8600, 7, 75, 2, 2, 8600, 8601, 7, 75, 2, 2, 8601, 1360,
-	3, 2, 2, 2, 8602, 8603, 7, 68, 2, 2, 8603, 8604, 7, 75, 2, 2, 8604, 8605,
-	7, 73, 2, 2, 8605, 8606, 7, 55, 2, 2, 8606, 1362, 3, 2, 2, 2, 8607, 8608,
-	7, 69, 2, 2, 8608, 8609, 7, 82, 2, 2, 8609, 8610, 7, 51, 2, 2, 8610, 8611,
-	7, 52, 2, 2, 8611, 8612, 7, 55, 2, 2, 8612, 8613, 7, 50, 2, 2, 8613, 1364,
-	3, 2, 2, 2, 8614, 8615, 7, 69, 2, 2, 8615, 8616, 7, 82, 2, 2, 8616, 8617,
-	7, 51, 2, 2, 8617, 8618, 7, 52, 2, 2, 8618, 8619, 7, 55, 2, 2, 8619, 8620,
-	7, 51, 2, 2, 8620, 1366, 3, 2, 2, 2, 8621, 8622, 7, 69, 2, 2, 8622, 8623,
-	7, 82, 2, 2, 8623, 8624, 7, 51, 2, 2, 8624, 8625, 7, 52, 2, 2, 8625, 8626,
-	7, 55, 2, 2, 8626, 8627, 7, 56, 2, 2, 8627, 1368, 3, 2, 2, 2, 8628, 8629,
-	7, 69, 2, 2, 8629, 8630, 7, 82, 2, 2, 8630, 8631, 7, 51, 2, 2, 8631, 8632,
-	7, 52, 2, 2, 8632, 8633, 7, 55, 2, 2, 8633, 8634, 7, 57, 2, 2, 8634, 1370,
-	3, 2, 2, 2, 8635, 8636, 7, 69, 2, 2, 8636, 8637, 7, 82, 2, 2, 8637, 8638,
-	7, 58, 2, 2, 8638, 8639, 7, 55, 2, 2, 8639, 8640, 7, 50, 2, 2, 8640, 1372,
-	3, 2, 2, 2, 8641, 8642, 7, 69, 2, 2, 8642, 8643, 7, 82, 2, 2, 8643, 8644,
-	7, 58, 2, 2, 8644, 8645, 7, 55, 2, 2, 8645, 8646, 7, 52, 2, 2, 8646, 1374,
-	3, 2, 2, 2, 8647, 8648, 7, 69, 2, 2, 8648, 8649, 7, 82, 2, 2, 8649, 8650,
-	7, 58, 2, 2, 8650, 8651, 7, 56, 2, 2, 8651, 8652, 7, 56, 2, 2, 8652, 1376,
-	3, 2, 2, 2, 8653, 8654, 7, 69, 2, 2, 8654, 8655, 7, 82, 2, 2, 8655, 8656,
-	7, 59, 2, 2, 8656, 8657, 7, 53, 2, 2, 8657, 8658, 7, 52, 2, 2, 8658, 1378,
-	3, 2, 2, 2, 8659, 8660, 7, 70, 2, 2, 8660, 8661, 7, 71, 2, 2, 8661, 8662,
-	7, 69, 2, 2, 8662, 8663, 7, 58, 2, 2, 8663, 1380, 3, 2, 2, 2, 8664, 8665,
-	7, 71, 2, 2, 8665, 8666, 7, 87, 2, 2, 8666, 8667, 7, 69, 2, 2, 8667, 8668,
-	7, 76, 2, 2, 8668, 8669, 7, 82, 2, 2, 8669, 8670, 7, 79, 2, 2, 8670, 8671,
-	7, 85, 2, 2, 8671, 1382, 3, 2, 2, 2, 8672, 8673, 7, 71, 2, 2, 8673, 8674,
-	7, 87, 2, 2, 8674, 8675, 7, 69, 2, 2, 8675, 8676, 7, 77, 2, 2, 8676, 8677,
-	7, 84, 2, 2, 8677, 1384, 3, 2, 2, 2, 8678, 8679, 7, 73, 2, 2, 8679, 8680,
-	7, 68, 2, 2, 8680, 8681, 7, 52, 2, 2, 8681, 8682, 7, 53, 2, 2, 8682, 8683,
-	7, 51, 2, 2, 8683, 8684, 7, 52, 2, 2, 8684, 1386, 3, 2, 2, 2, 8685, 8686,
-	7, 73, 2, 2, 8686, 8687, 7, 68, 2, 2, 8687, 8688, 7, 77, 2, 2, 8688, 1388,
-	3, 2, 2, 2, 8689, 8690, 7, 73, 2, 2, 8690, 8691, 7, 71, 2, 2, 8691, 8692,
-	7, 81, 2, 2, 8692, 8693, 7, 85, 2, 2, 8693, 8694, 7, 86, 2, 2, 8694, 8695,
-	7, 70, 2, 2, 8695, 8696, 7, 58, 2, 2, 8696, 1390, 3, 2, 2, 2, 8697, 8698,
-	7, 73, 2, 2, 8698, 8699, 7, 84, 2, 2, 8699, 8700, 7, 71, 2, 2, 8700, 8701,
-	7, 71, 2, 2, 8701, 8702, 7, 77, 2, 2, 8702, 1392, 3, 2, 2, 2, 8703, 8704,
-	7, 74, 2, 2, 8704, 8705, 7, 71, 2, 2, 8705, 8706, 7, 68, 2, 2, 8706, 8707,
-	7, 84, 2, 2, 8707, 8708, 7, 71, 2, 2, 8708, 8709, 7, 89, 2, 2, 8709, 1394,
-	3, 2, 2, 2, 8710, 8711, 7, 74, 2, 2, 8711, 8712, 7, 82, 2, 2, 8712, 8713,
-	7, 58, 2, 2, 8713, 1396, 3, 2, 2, 2, 8714, 8715, 7, 77, 2, 2, 8715, 8716,
-	7, 71, 2, 2, 8716, 8717, 7, 91, 2, 2, 8717, 8718, 7, 68, 2, 2, 8718, 8719,
-	7, 69, 2, 2, 8719, 8720, 7, 85, 2, 2, 8720, 8721, 7, 52, 2, 2, 8721, 1398,
-	3, 2, 2, 2, 8722, 8723, 7, 77, 2, 2, 8723, 8724, 7, 81, 2, 2, 8724, 8725,
-	7, 75, 2, 2, 8725, 8726, 7, 58, 2, 2, 8726, 8727, 7, 84, 2, 2, 8727, 1400,
-	3, 2, 2, 2, 8728, 8729, 7, 77, 2, 2, 8729, 8730, 7, 81, 2, 2, 8730, 8731,
-	7, 75, 2, 2, 8731, 8732, 7, 58, 2, 2, 8732, 8733, 7, 87, 2, 2, 8733, 1402,
-	3, 2, 2, 2, 8734, 8735, 7, 78, 2, 2, 8735, 8736, 7, 67, 2, 2, 8736, 8737,
-	7, 86, 2, 2, 8737, 8738, 7, 75, 2, 2, 8738, 8739, 7, 80, 2, 2, 8739, 8740,
-	7, 51, 2, 2, 8740, 1404, 3, 2, 2, 2, 8741, 8742, 7, 78, 2, 2, 8742, 8743,
-	7, 67, 2, 2, 8743, 8744, 7, 86, 2, 2, 8744, 8745, 7, 75, 2, 2, 8745, 8746,
-	7, 80, 2, 2, 8746, 8747, 7, 52, 2, 2, 8747, 1406, 3, 2, 2, 2, 8748, 8749,
-	7, 78, 2, 2, 8749, 8750, 7, 67, 2, 2, 8750, 8751, 7, 86, 2, 2, 8751, 8752,
-	7, 75, 2, 2, 8752, 8753, 7, 80, 2, 2, 8753, 8754, 7, 55, 2, 2, 8754, 1408,
-	3, 2, 2, 2, 8755, 8756, 7, 78, 2, 2, 8756, 8757, 7, 67, 2, 2, 8757, 8758,
-	7, 86, 2, 2, 8758, 8759, 7, 75, 2, 2, 8759, 8760, 7, 80, 2, 2, 8760, 8761,
-	7, 57, 2, 2, 8761, 1410, 3, 2, 2, 2, 8762, 8763, 7, 79, 2, 2, 8763, 8764,
-	7, 67, 2, 2, 8764, 8765, 7, 69, 2, 2, 8765, 8766, 7, 69, 2, 2, 8766, 8767,
-	7, 71, 2, 2, 8767, 1412, 3, 2, 2, 2, 8768, 8769, 7, 79, 2, 2, 8769, 8770,
-	7, 67, 2, 2, 8770, 8771, 7, 69, 2, 2, 8771, 8772, 7, 84, 2, 2, 8772, 8773,
-	7, 81, 2, 2, 8773, 8774, 7, 79, 2, 2, 8774, 8775, 7, 67, 2, 2, 8775, 8776,
-	7, 80, 2, 2, 8776, 1414, 3, 2, 2, 2, 8777, 8778, 7, 85, 2, 2, 8778, 8779,
-	7, 76, 2, 2, 8779, 8780, 7, 75, 2, 2, 8780, 8781, 7, 85, 2, 2, 8781, 1416,
-	3, 2, 2, 2, 8782, 8783, 7, 85, 2, 2, 8783, 8784, 7, 89, 2, 2, 8784, 8785,
-	7, 71, 2, 2, 8785, 8786, 7, 57, 2, 2, 8786, 1418, 3, 2, 2, 2, 8787, 8788,
-	7, 86, 2, 2, 8788, 8789, 7, 75, 2, 2, 8789, 8790, 7, 85, 2, 2, 8790, 8791,
-	7, 56, 2, 2, 8791, 8792, 7, 52, 2, 2, 8792, 8793, 7, 50, 2, 2, 8793, 1420,
-	3, 2, 2, 2, 8794, 8795, 7, 87, 2, 2, 8795, 8796, 7, 69, 2, 2, 8796, 8797,
-	7, 85, 2, 2, 8797, 8798, 7, 52, 2, 2, 8798, 1422, 3, 2, 2, 2, 8799, 8800,
-	7, 87, 2, 2, 8800, 8801, 7, 76, 2, 2, 8801, 8802, 7, 75, 2, 2, 8802, 8803,
-	7, 85, 2, 2, 8803, 1424, 3, 2, 2, 2, 8804, 8805, 7, 87, 2, 2, 8805, 8806,
-	7, 86, 2, 2, 8806, 8807, 7, 72, 2, 2, 8807, 8808, 7, 51, 2, 2, 8808, 8809,
-	7, 56, 2, 2, 8809, 1426, 3, 2, 2, 2, 8810, 8811, 7, 87, 2, 2, 8811, 8812,
-	7, 86, 2, 2, 8812, 8813, 7, 72, 2, 2, 8813, 8814, 7, 51, 2, 2, 8814, 8815,
-	7, 56, 2, 2, 8815, 8816, 7, 78, 2, 2, 8816, 8817, 7, 71, 2, 2, 8817, 1428,
-	3, 2, 2, 2, 8818, 8819, 7, 87, 2, 2, 8819, 8820, 7, 86, 2, 2, 8820, 8821,
-	7, 72, 2, 2, 8821, 8822, 7, 53, 2, 2, 8822, 8823, 7, 52, 2, 2, 8823, 1430,
-	3, 2, 2, 2, 8824, 8825, 7, 87, 2, 2, 8825, 8826, 7, 86, 2, 2, 8826, 8827,
-	7, 72, 2, 2, 8827, 8828, 7, 58, 2, 2, 8828, 1432, 3, 2, 2, 2, 8829, 8830,
-	7, 87, 2, 2, 8830, 8831, 7, 86, 2, 2, 8831, 8832, 7, 72, 2, 2, 8832, 8833,
-	7, 58, 2, 2, 8833, 8834, 7, 79, 2, 2, 8834, 8835, 7, 68, 2, 2, 8835, 8836,
-	7, 53, 2, 2, 8836, 1434, 3, 2, 2, 2, 8837, 8838, 7, 87, 2, 2, 8838, 8839,
-	7, 86, 2, 2, 8839, 8840, 7, 72, 2, 2, 8840, 8841, 7, 58, 2, 2, 8841, 8842,
-	7, 79, 2, 2, 8842, 8843, 7, 68, 2, 2, 8843, 8844, 7, 54, 2, 2, 8844, 1436,
-	3, 2, 2, 2, 8845, 8846, 7, 67, 2, 2, 8846, 8847, 7, 84, 2, 2, 8847, 8848,
-	7, 69, 2, 2, 8848, 8849, 7, 74, 2, 2, 8849, 8850, 7, 75, 2, 2, 8850, 8851,
-	7, 88, 2, 2, 8851, 8852, 7, 71, 2, 2, 8852, 1438, 3, 2, 2, 2, 8853, 8854,
-	7, 68, 2, 2, 8854, 8855, 7, 78, 2, 2, 8855, 8856, 7, 67, 2, 2, 8856, 8857,
-	7, 69, 2, 2, 8857, 8858, 7, 77, 2, 2, 8858, 8859, 7, 74, 2, 2, 8859, 8860,
-	7, 81, 2, 2, 8860, 8861, 7, 78, 2, 2, 8861, 8862, 7, 71, 2, 2, 8862, 1440,
-	3, 2, 2, 2, 8863, 8864, 7, 69, 2, 2, 8864, 8865, 7, 85, 2, 2, 8865, 8866,
-	7, 88, 2, 2, 8866, 1442, 3, 2, 2, 2, 8867, 8868, 7, 72, 2, 2, 8868, 8869,
-	7, 71, 2, 2, 8869, 8870, 7, 70, 2, 2, 8870, 8871, 7, 71, 2, 2, 8871, 8872,
-	7, 84, 2, 2, 8872, 8873, 7, 67, 2, 2, 8873, 8874, 7, 86, 2, 2, 8874, 8875,
-	7, 71, 2, 2, 8875, 8876, 7, 70, 2, 2, 8876, 1444, 3, 2, 2, 2, 8877, 8878,
-	7, 75, 2, 2, 8878, 8879, 7, 80, 2, 2, 8879, 8880, 7, 80, 2, 2, 8880, 8881,
-	7, 81, 2, 2, 8881, 8882, 7, 70, 2, 2, 8882, 8883, 7, 68, 2, 2, 8883, 1446,
-	3, 2, 2, 2, 8884, 8885, 7, 79, 2, 2, 8885, 8886, 7, 71, 2, 2, 8886, 8887,
-	7, 79, 2, 2, 8887, 8888, 7, 81, 2, 2, 8888, 8889, 7, 84, 2, 2, 8889, 8890,
-	7, 91, 2, 2, 8890, 1448, 3, 2, 2, 2, 8891, 8892, 7, 79, 2, 2, 8892, 8893,
-	7, 84, 2, 2, 8893, 8894, 7, 73, 2, 2, 8894, 8895, 7, 97, 2, 2, 8895, 8896,
-	7, 79, 2, 2, 8896, 8897, 7, 91, 2, 2, 8897, 8898, 7, 75, 2, 2, 8898, 8899,
-	7, 85, 2, 2, 8899, 8900, 7, 67, 2, 2, 8900, 8901, 7, 79, 2, 2, 8901, 1450,
-	3, 2, 2, 2, 8902, 8903, 7, 79, 2, 2, 8903, 8904, 7, 91, 2, 2, 8904, 8905,
-	7, 75, 2, 2, 8905, 8906, 7, 85, 2, 2, 8906, 8907, 7, 67, 2, 2, 8907, 8908,
-	7, 79, 2, 2, 8908, 1452, 3, 2, 2, 2, 8909, 8910, 7, 80, 2, 2, 8910, 8911,
-	7, 70, 2, 2, 8911, 8912, 7, 68, 2, 2, 8912, 1454, 3, 2, 2, 2, 8913, 8914,
-	7, 80, 2, 2, 8914, 8915, 7, 70, 2, 2, 8915, 8916, 7, 68, 2, 2, 8916, 8917,
-	7, 69, 2, 2, 8917, 8918, 7, 78, 2, 2, 8918, 8919, 7, 87, 2, 2, 8919, 8920,
-	7, 85, 2, 2, 8920, 8921, 7, 86, 2, 2, 8921, 8922, 7, 71, 2, 2, 8922, 8923,
-	7, 84, 2, 2, 8923, 1456, 3, 2, 2, 2, 8924, 8925, 7, 82, 2, 2, 8925, 8926,
-	7, 71, 2, 2, 8926, 8927, 7, 84, 2, 2, 8927, 8928, 7, 72, 2, 2, 8928, 8929,
-	7, 81, 2, 2, 8929, 8930, 7, 84, 2, 2, 8930, 8931, 7, 79, 2, 2, 8931, 8932,
-	7, 67, 2, 2, 8932, 8933, 7, 80, 2, 2, 8933, 8934, 7, 69, 2, 2, 8934, 8935,
-	7, 71, 2, 2, 8935, 8936, 7, 97, 2, 2, 8936, 8937, 7, 85, 2, 2, 8937, 8938,
-	7, 69, 2, 2, 8938, 8939, 7, 74, 2, 2, 8939, 8940, 7, 71, 2, 2, 8940, 8941,
-	7, 79, 2, 2, 8941, 8942, 7, 67, 2, 2, 8942, 1458, 3, 2, 2, 2, 8943, 8944,
-	7, 86, 2, 2, 8944, 8945, 7, 81, 2, 2, 8945, 8946, 7, 77, 2, 2, 8946, 8947,
-	7, 87, 2, 2, 8947, 8948, 7, 70, 2, 2, 8948, 8949, 7, 68, 2, 2, 8949, 1460,
-	3, 2, 2, 2, 8950, 8951, 7, 84, 2, 2, 8951, 8952, 7, 71, 2, 2, 8952, 8953,
-	7, 82, 2, 2, 8953, 8954, 7, 71, 2, 2, 8954, 8955, 7, 67, 2, 2, 8955, 8956,
-	7, 86, 2, 2, 8956, 8957, 7, 67, 2, 2, 8957, 8958, 7, 68, 2, 2, 8958, 8959,
-	7, 78, 2, 2, 8959, 8960, 7, 71, 2, 2, 8960, 1462, 3, 2, 2, 2, 8961, 8962,
-	7, 69, 2, 2, 8962, 8963, 7, 81, 2, 2, 8963, 8964, 7, 79, 2, 2, 8964, 8965,
-	7, 79, 2, 2, 8965, 8966, 7, 75, 2, 2, 8966, 8967, 7, 86, 2, 2, 8967, 8968,
-	7, 86, 2, 2, 8968, 8969, 7, 71, 2, 2, 8969, 8970, 7, 70, 2, 2, 8970, 1464,
-	3, 2, 2, 2, 8971, 8972, 7, 87, 2, 2, 8972, 8973, 7, 80, 2, 2, 8973, 8974,
-	7, 69, 2, 2, 8974, 8975, 7, 81, 2, 2, 8975, 8976, 7, 79, 2, 2, 8976, 8977,
-	7, 79, 2, 2, 8977, 8978, 7, 75, 2, 2, 8978, 8979, 7, 86, 2, 2, 8979, 8980,
-	7, 86, 2, 2, 8980, 8981, 7, 71, 2, 2, 8981, 8982, 7, 70, 2, 2, 8982, 1466,
-	3, 2, 2, 2, 8983, 8984, 7, 85, 2, 2, 8984, 8985, 7, 71, 2, 2, 8985, 8986,
-	7, 84, 2, 2, 8986, 8987, 7, 75, 2, 2, 8987, 8988, 7, 67, 2, 2, 8988, 8989,
-	7, 78, 2, 2, 8989, 8990, 7, 75, 2, 2, 8990, 8991, 7, 92, 2, 2, 8991, 8992,
-	7, 67, 2, 2, 8992, 8993, 7, 68, 2, 2, 8993, 8994, 7, 78, 2, 2, 8994, 8995,
-	7, 71, 2, 2, 8995, 1468, 3, 2, 2, 2, 8996, 8997, 7, 73, 2, 2, 8997, 8998,
-	7, 71, 2, 2, 8998, 8999, 7, 81, 2, 2, 8999, 9000, 7, 79, 2, 2, 9000, 9001,
-	7, 71, 2, 2, 9001, 9002, 7, 86, 2, 2, 9002, 9003, 7, 84, 2, 2, 9003, 9004,
-	7, 91, 2, 2, 9004, 9005, 7, 69, 2, 2, 9005, 9006, 7, 81, 2, 2, 9006, 9007,
-	7, 78, 2, 2, 9007, 9008, 7, 78, 2, 2, 9008, 9009, 7, 71, 2, 2, 9009, 9010,
-	7, 69, 2, 2, 9010, 9011, 7, 86, 2, 2, 9011, 9012, 7, 75, 2, 2, 9012, 9013,
-	7, 81, 2, 2, 9013, 9014, 7, 80, 2, 2, 9014, 1470, 3, 2, 2, 2, 9015, 9016,
-	7, 73, 2, 2, 9016, 9017, 7, 71, 2, 2, 9017, 9018, 7, 81, 2, 2, 9018, 9019,
-	7, 79, 2, 2, 9019, 9020, 7, 69, 2, 2, 9020, 9021, 7, 81, 2, 2, 9021, 9022,
-	7, 78, 2, 2, 9022, 9023, 7, 78, 2, 2, 9023, 9024, 7, 71, 2, 2, 9024, 9025,
-	7, 69, 2, 2, 9025, 9026, 7, 86, 2, 2, 9026, 9027, 7, 75, 2, 2, 9027, 9028,
-	7, 81, 2, 2, 9028, 9029, 7, 80, 2, 2, 9029, 1472, 3, 2, 2, 2, 9030, 9031,
-	7, 73, 2, 2, 9031, 9032, 7, 71, 2, 2, 9032, 9033, 7, 81, 2, 2, 9033, 9034,
-	7, 79, 2, 2, 9034, 9035, 7, 71, 2, 2, 9035, 9036, 7, 86, 2, 2, 9036, 9037,
-	7, 84, 2, 2, 9037, 9038, 7, 91, 2, 2, 9038, 1474, 3, 2, 2, 2, 9039, 9040,
-	7, 78, 2, 2, 9040, 9041, 7, 75, 2, 2, 9041, 9042, 7, 80, 2, 2, 9042, 9043,
-	7, 71, 2, 2, 9043, 9044, 7, 85, 2, 2, 9044, 9045, 7, 86, 2, 2, 9045, 9046,
-	7, 84, 2, 2, 9046, 9047, 7, 75, 2, 2, 9047, 9048, 7, 80, 2, 2, 9048, 9049,
-	7, 73, 2, 2, 9049, 1476, 3, 2, 2, 2, 9050, 9051, 7, 79, 2, 2, 9051, 9052,
-	7, 87, 2, 2, 9052, 9053, 7, 78, 2, 2, 9053, 9054, 7, 86, 2, 2, 9054, 9055,
-	7, 75, 2, 2, 9055, 9056, 7, 78, 2, 2, 9056, 9057, 7, 75, 2, 2, 9057, 9058,
-	7, 80, 2, 2, 9058, 9059, 7, 71, 2, 2, 9059, 9060, 7, 85, 2, 2, 9060, 9061,
-	7, 86, 2, 2, 9061, 9062, 7, 84, 2, 2, 9062, 9063, 7, 75, 2, 2, 9063, 9064,
-	7, 80, 2, 2, 9064, 9065, 7, 73, 2, 2, 9065, 1478, 3, 2, 2, 2, 9066, 9067,
-	7, 79, 2, 2, 9067, 9068, 7, 87, 2, 2, 9068, 9069, 7, 78, 2, 2, 9069, 9070,
-	7, 86, 2, 2, 9070, 9071, 7, 75, 2, 2, 9071, 9072, 7, 82, 2, 2, 9072, 9073,
-	7, 81, 2, 2, 9073, 9074, 7, 75, 2, 2, 9074, 9075, 7, 80, 2, 2, 9075, 9076,
-	7, 86, 2, 2, 9076, 1480, 3, 2, 2, 2, 9077, 9078, 7, 79, 2, 2, 9078, 9079,
-	7, 87, 2, 2, 9079, 9080, 7, 78, 2, 2, 9080, 9081, 7, 86, 2, 2, 9081, 9082,
-	7, 75, 2, 2, 9082, 9083, 7, 82, 2, 2, 9083, 9084, 7, 81, 2, 2, 9084, 9085,
-	7, 78, 2, 2, 9085, 9086, 7, 91, 2, 2, 9086, 9087, 7, 73, 2, 2, 9087, 9088,
-	7, 81, 2, 2, 9088, 9089, 7, 80, 2, 2, 9089, 1482, 3, 2, 2, 2, 9090, 9091,
-	7, 82, 2, 2, 9091, 9092, 7, 81, 2, 2, 9092, 9093, 7, 75, 2, 2, 9093, 9094,
-	7, 80, 2, 2, 9094, 9095, 7, 86, 2, 2, 9095, 1484, 3, 2, 2, 2, 9096, 9097,
-	7, 82, 2, 2, 9097, 9098, 7, 81, 2, 2, 9098, 9099, 7, 78, 2, 2, 9099, 9100,
-	7, 91, 2, 2, 9100, 9101, 7, 73, 2, 2, 9101, 9102, 7, 81, 2, 2, 9102, 9103,
-	7, 80, 2, 2, 9103, 1486, 3, 2, 2, 2, 9104, 9105, 7, 67, 2, 2, 9105, 9106,
-	7, 68, 2, 2, 9106, 9107, 7, 85, 2, 2, 9107, 1488, 3, 2, 2, 2, 9108, 9109,
-	7, 67, 2, 2, 9109, 9110, 7, 69, 2, 2, 9110, 9111, 7, 81, 2, 2, 9111, 9112,
-	7, 85, 2, 2, 9112, 1490, 3, 2, 2, 2, 9113, 9114, 7, 67, 2, 2, 9114, 9115,
-	7, 70, 2, 2, 9115, 9116, 7, 70, 2, 2, 9116, 9117, 7, 70, 2, 2, 9117, 9118,
-	7, 67, 2, 2, 9118, 9119, 7, 86, 2, 2, 9119, 9120, 7, 71, 2, 2, 9120, 1492,
-	3, 2, 2, 2, 9121, 9122, 7, 67, 2, 2, 9122, 9123, 7, 70, 2, 2, 9123, 9124,
-	7, 70, 2, 2, 9124, 9125, 7, 86, 2, 2, 9125, 9126, 7, 75, 2, 2, 9126, 9127,
-	7, 79, 2, 2, 9127, 9128, 7, 71, 2, 2, 9128, 1494, 3, 2, 2, 2, 9129, 9130,
-	7, 67, 2, 2, 9130, 9131, 7, 71, 2, 2, 9131, 9132, 7, 85, 2, 2, 9132, 9133,
-	7, 97, 2, 2, 9133, 9134, 7, 70, 2, 2, 9134, 9135, 7, 71, 2, 2, 9135, 9136,
-	7, 69, 2, 2, 9136, 9137, 7, 84, 2, 2, 9137, 9138, 7, 91, 2, 2, 9138, 9139,
-	7, 82, 2, 2, 9139, 9140, 7, 86, 2, 2, 9140, 1496, 3, 2, 2, 2, 9141, 9142,
-	7, 67, 2, 2, 9142, 9143, 7, 71, 2, 2, 9143, 9144, 7, 85, 2, 2, 9144, 9145,
-	7, 97, 2, 2, 9145, 9146, 7, 71, 2, 2, 9146, 9147, 7, 80, 2, 2, 9147, 9148,
-	7, 69, 2, 2, 9148, 9149, 7, 84, 2, 2, 9149, 9150, 7, 91, 2, 2, 9150, 9151,
-	7, 82, 2, 2, 9151, 9152, 7, 86, 2, 2, 9152, 1498, 3, 2, 2, 2, 9153, 9154,
-	7, 67, 2, 2, 9154, 9155, 7, 84, 2, 2, 9155, 9156, 7, 71, 2, 2, 9156, 9157,
-	7, 67, 2, 2, 9157, 1500, 3, 2, 2, 2, 9158, 9159, 7, 67, 2, 2, 9159, 9160,
-	7, 85, 2, 2, 9160, 9161, 7, 68, 2, 2, 9161, 9162, 7, 75, 2, 2, 9162, 9163,
-	7, 80, 2, 2, 9163, 9164, 7, 67, 2, 2, 9164, 9165, 7, 84, 2, 2, 9165, 9166,
-	7, 91, 2, 2, 9166, 1502, 3, 2, 2, 2, 9167, 9168, 7, 67, 2, 2, 9168, 9169,
-	7, 85, 2, 2, 9169, 9170, 7, 75, 2, 2, 9170, 9171, 7, 80, 2, 2, 9171, 1504,
-	3, 2, 2, 2, 9172, 9173, 7, 67, 2, 2, 9173, 9174, 7, 85, 2, 2, 9174, 9175,
-	7, 86, 2, 2, 9175, 9176, 7, 71, 2, 2, 9176, 9177, 7, 90, 2, 2, 9177, 9178,
-	7, 86, 2, 2, 9178, 1506, 3, 2, 2, 2, 9179, 9180, 7, 67, 2, 2, 9180, 9181,
-	7, 85, 2, 2, 9181, 9182, 7, 89, 2, 2, 9182, 9183, 7, 77, 2, 2, 9183, 9184,
-	7, 68, 2, 2, 9184, 1508, 3, 2, 2, 2, 9185, 9186, 7, 67, 2, 2, 9186, 9187,
-	7, 85, 2, 2, 9187, 9188, 7, 89, 2, 2, 9188, 9189, 7, 77, 2, 2, 9189, 9190,
-	7, 86, 2, 2, 9190, 1510, 3, 2, 2, 2, 9191, 9192, 7, 67, 2, 2, 9192, 9193,
-	7, 85, 2, 2, 9193, 9194, 7, 91, 2, 2, 9194, 9195, 7, 79, 2, 2, 9195, 9196,
-	7, 79, 2, 2, 9196, 9197, 7, 71, 2, 2, 9197, 9198, 7, 86, 2, 2, 9198, 9199,
-	7, 84, 2, 2, 9199, 9200, 7, 75, 2, 2, 9200, 9201, 7, 69, 2, 2, 9201, 9202,
-	7, 97, 2, 2, 9202, 9203, 7, 70, 2, 2, 9203, 9204, 7, 71, 2, 2, 9204, 9205,
-	7, 69, 2, 2, 9205, 9206, 7, 84, 2, 2, 9206, 9207, 7, 91, 2, 2, 9207, 9208,
-	7, 82, 2, 2, 9208, 9209, 7, 86, 2, 2, 9209, 1512, 3, 2, 2, 2, 9210, 9211,
-	7, 67, 2, 2, 9211, 9212, 7, 85, 2, 2, 9212, 9213, 7, 91, 2, 2, 9213, 9214,
-	7, 79, 2, 2, 9214, 9215, 7, 79, 2, 2, 9215, 9216, 7, 71, 2, 2, 9216, 9217,
-	7, 86, 2, 2, 9217, 9218, 7, 84, 2, 2, 9218, 9219, 7, 75, 2, 2, 9219, 9220,
-	7, 69, 2, 2, 9220, 9221, 7, 97, 2, 2, 9221, 9222, 7, 70, 2, 2, 9222, 9223,
-	7, 71, 2, 2, 9223, 9224, 7, 84, 2, 2, 9224, 9225, 7, 75, 2, 2, 9225, 9226,
-	7, 88, 2, 2, 9226, 9227, 7, 71, 2, 2, 9227, 1514, 3, 2, 2, 2, 9228, 9229,
-	7, 67, 2, 2, 9229, 9230, 7, 85, 2, 2, 9230, 9231, 7, 91, 2, 2, 9231, 9232,
-	7, 79, 2, 2, 9232, 9233, 7, 79, 2, 2, 9233, 9234, 7, 71, 2, 2, 9234, 9235,
-	7, 86, 2, 2, 9235, 9236, 7, 84, 2, 2, 9236, 9237, 7, 75, 2, 2, 9237, 9238,
-	7, 69, 2, 2, 9238, 9239, 7, 97, 2, 2, 9239, 9240, 7, 71, 2, 2, 9240, 9241,
-	7, 80, 2, 2, 9241, 9242, 7, 69, 2, 2, 9242, 9243, 7, 84, 2, 2, 9243, 9244,
-	7, 91, 2, 2, 9244, 9245, 7, 82, 2, 2, 9245, 9246, 7, 86, 2, 2, 9246, 1516,
-	3, 2, 2, 2, 9247, 9248, 7, 67, 2, 2, 9248, 9249, 7, 85, 2, 2, 9249, 9250,
-	7, 91, 2, 2, 9250, 9251, 7, 79, 2, 2, 9251, 9252, 7, 79, 2, 2, 9252, 9253,
-	7, 71, 2, 2, 9253, 9254, 7, 86, 2, 2, 9254, 9255, 7, 84, 2, 2, 9255, 9256,
-	7, 75, 2, 2, 9256, 9257, 7, 69, 2, 2, 9257, 9258, 7, 97, 2, 2, 9258, 9259,
-	7, 85, 2, 2, 9259, 9260, 7, 75, 2, 2, 9260, 9261, 7, 73, 2, 2, 9261, 9262,
-	7, 80, 2, 2, 9262, 1518, 3, 2, 2, 2, 9263, 9264, 7, 67, 2, 2, 9264, 9265,
-	7, 85, 2, 2, 9265, 9266, 7, 91, 2, 2, 9266, 9267, 7, 79, 2, 2, 9267, 9268,
-	7, 79, 2, 2, 9268, 9269, 7, 71, 2, 2, 9269, 9270, 7, 86, 2, 2, 9270, 9271,
-	7, 84, 2, 2, 9271, 9272, 7, 75, 2, 2, 9272, 9273, 7, 69, 2, 2, 9273, 9274,
-	7, 97, 2, 2, 9274, 9275, 7, 88, 2, 2, 9275, 9276, 7, 71, 2, 2, 9276, 9277,
-	7, 84, 2, 2, 9277, 9278, 7, 75, 2, 2, 9278, 9279, 7, 72, 2, 2, 9279, 9280,
-	7, 91, 2, 2, 9280, 1520, 3, 2, 2, 2, 9281, 9282, 7, 67, 2, 2, 9282, 9283,
-	7, 86, 2, 2, 9283, 9284, 7, 67, 2, 2, 9284, 9285, 7, 80, 2, 2, 9285, 1522,
-	3, 2, 2, 2, 9286, 9287, 7, 67, 2, 2, 9287, 9288, 7, 86, 2, 2, 9288, 9289,
-	7, 67, 2, 2, 9289, 9290, 7, 80, 2, 2, 9290, 9291, 7, 52, 2, 2, 9291, 1524,
-	3, 2, 2, 2, 9292, 9293, 7, 68, 2, 2, 9293, 9294, 7, 71, 2, 2, 9294, 9295,
-	7, 80, 2, 2, 9295, 9296, 7, 69, 2, 2, 9296, 9297, 7, 74, 2, 2, 9297, 9298,
-	7, 79, 2, 2, 9298, 9299, 7, 67, 2, 2, 9299, 9300, 7, 84, 2, 2, 9300, 9301,
-	7, 77, 2, 2, 9301, 1526, 3, 2, 2, 2, 9302, 9303, 7, 68, 2, 2, 9303, 9304,
-	7, 75, 2, 2, 9304, 9305, 7, 80, 2, 2, 9305, 1528, 3, 2, 2, 2, 9306, 9307,
-	7, 68, 2, 2, 9307, 9308, 7, 75, 2, 2, 9308, 9309, 7, 86, 2, 2, 9309, 9310,
-	7, 97, 2, 2, 9310, 9311, 7, 69, 2, 2, 9311, 9312, 7, 81, 2, 2, 9312, 9313,
-	7, 87, 2, 2, 9313, 9314, 7, 80, 2, 2, 9314, 9315, 7, 86, 2, 2, 9315, 1530,
-	3, 2, 2, 2, 9316, 9317, 7, 68, 2, 2, 9317, 9318, 7, 75, 2, 2, 9318, 9319,
-	7, 86, 2, 2, 9319, 9320, 7, 97, 2, 2, 9320, 9321, 7, 78, 2, 2, 9321, 9322,
-	7, 71, 2, 2, 9322, 9323, 7, 80, 2, 2, 9323, 9324, 7, 73, 2, 2, 9324, 9325,
-	7, 86, 2, 2, 9325, 9326, 7, 74, 2, 2, 9326, 1532, 3, 2, 2, 2, 9327, 9328,
-	7, 68, 2, 2, 9328, 9329, 7, 87, 2, 2, 9329, 9330, 7, 72, 2, 2, 9330, 9331,
-	7, 72, 2, 2, 9331, 9332, 7, 71, 2, 2, 9332, 9333, 7, 84, 2, 2, 9333, 1534,
-	3, 2, 2, 2, 9334, 9335, 7, 69, 2, 2, 9335, 9336, 7, 67, 2, 2, 9336, 9337,
-	7, 86, 2, 2, 9337, 9338, 7, 67, 2, 2, 9338, 9339, 7, 78, 2, 2, 9339, 9340,
-	7, 81, 2, 2, 9340, 9341, 7, 73, 2, 2, 9341, 9342, 7, 97, 2, 2, 9342, 9343,
-	7, 80, 2, 2, 9343, 9344, 7, 67, 2, 2, 9344, 9345, 7, 79, 2, 2, 9345, 9346,
-	7, 71, 2, 2, 9346, 1536, 3, 2, 2, 2, 9347, 9348, 7, 69, 2, 2, 9348, 9349,
-	7, 71, 2, 2, 9349, 9350, 7, 75, 2, 2, 9350, 9351, 7, 78, 2, 2, 9351, 1538,
-	3, 2, 2, 2, 9352, 9353, 7, 69, 2, 2, 9353, 9354, 7, 71, 2, 2, 9354, 9355,
-	7, 75, 2, 2, 9355, 9356, 7, 78, 2, 2, 9356, 9357, 7, 75, 2, 2, 9357, 9358,
-	7, 80, 2, 2, 9358, 9359, 7, 73, 2, 2, 9359, 1540, 3, 2, 2, 2, 9360, 9361,
-	7, 69, 2, 2, 9361, 9362, 7, 71, 2, 2, 9362, 9363, 7, 80, 2, 2, 9363, 9364,
-	7, 86, 2, 2, 9364, 9365, 7, 84, 2, 2, 9365, 9366, 7, 81, 2, 2, 9366, 9367,
-	7, 75, 2, 2, 9367, 9368, 7, 70, 2, 2, 9368, 1542, 3, 2, 2, 2, 9369, 9370,
-	7, 69, 2, 2, 9370, 9371, 7, 74, 2, 2, 9371, 9372, 7, 67, 2, 2, 9372, 9373,
-	7, 84, 2, 2, 9373, 9374, 7, 67, 2, 2, 9374, 9375, 7, 69, 2, 2, 9375, 9376,
-	7, 86, 2, 2, 9376, 9377, 7, 71, 2, 2, 9377, 9378, 7, 84, 2, 2, 9378, 9379,
-	7, 97, 2, 2, 9379, 9380, 7, 78, 2, 2, 9380, 9381, 7, 71, 2, 2, 9381, 9382,
-	7, 80, 2, 2, 9382, 9383, 7, 73, 2, 2, 9383, 9384, 7, 86, 2, 2, 9384, 9385,
-	7, 74, 2, 2, 9385, 1544, 3, 2, 2, 2, 9386, 9387, 7, 69, 2, 2, 9387, 9388,
-	7, 74, 2, 2, 9388, 9389, 7, 67, 2, 2, 9389, 9390, 7, 84, 2, 2, 9390, 9391,
-	7, 85, 2, 2, 9391, 9392, 7, 71, 2, 2, 9392, 9393, 7, 86, 2, 2, 9393, 1546,
-	3, 2, 2, 2, 9394, 9395, 7, 69, 2, 2, 9395, 9396, 7, 74, 2, 2, 9396, 9397,
-	7, 67, 2, 2, 9397, 9398, 7, 84, 2, 2, 9398, 9399, 7, 97, 2, 2, 9399, 9400,
-	7, 78, 2, 2, 9400, 9401, 7, 71, 2, 2, 9401, 9402, 7, 80, 2, 2, 9402, 9403,
-	7, 73, 2, 2, 9403, 9404, 7, 86, 2, 2, 9404, 9405, 7, 74, 2, 2, 9405, 1548,
-	3, 2, 2, 2, 9406, 9407, 7, 69, 2, 2, 9407, 9408, 7, 81, 2, 2, 9408, 9409,
-	7, 71, 2, 2, 9409, 9410, 7, 84, 2, 2, 9410, 9411, 7, 69, 2, 2, 9411, 9412,
-	7, 75, 2, 2, 9412, 9413, 7, 68, 2, 2, 9413, 9414, 7, 75, 2, 2, 9414, 9415,
-	7, 78, 2, 2, 9415, 9416, 7, 75, 2, 2, 9416, 9417, 7, 86, 2, 2, 9417, 9418,
-	7, 91, 2, 2, 9418, 1550, 3, 2, 2, 2, 9419, 9420, 7, 69, 2, 2, 9420, 9421,
-	7, 81, 2, 2, 9421, 9422, 7, 78, 2, 2, 9422, 9423, 7, 78, 2, 2, 9423, 9424,
-	7, 67, 2, 2, 9424, 9425, 7, 86, 2, 2, 9425, 9426, 7, 75, 2, 2, 9426, 9427,
-	7, 81, 2, 2, 9427, 9428, 7, 80, 2, 2, 9428, 1552, 3, 2, 2, 2, 9429, 9430,
-	7, 69, 2, 2, 9430, 9431, 7, 81, 2, 2, 9431, 9432, 7, 79, 2, 2, 9432, 9433,
-	7, 82, 2, 2, 9433, 9434, 7, 84, 2, 2, 9434, 9435, 7, 71, 2, 2, 9435, 9436,
-	7, 85, 2, 2, 9436, 9437, 7, 85, 2, 2, 9437, 1554, 3, 2, 2, 2, 9438, 9439,
-	7, 69, 2, 2, 9439, 9440, 7, 81, 2, 2, 9440, 9441, 7, 80, 2, 2, 9441, 9442,
-	7, 69, 2, 2, 9442, 9443, 7, 67, 2, 2, 9443, 9444, 7, 86, 2, 2, 9444, 1556,
-	3, 2, 2, 2, 9445, 9446, 7, 69, 2, 2, 9446, 9447, 7, 81, 2, 2, 9447, 9448,
-	7, 80, 2, 2, 9448, 9449, 7, 69, 2, 2, 9449, 9450, 7, 67, 2, 2, 9450, 9451,
-	7, 86, 2, 2, 9451, 9452, 7, 97, 2, 2, 9452, 9453, 7, 89, 2, 2, 9453, 9454,
-	7, 85, 2, 2, 9454, 1558, 3, 2, 2, 2, 9455, 9456, 7, 69, 2, 2, 9456, 9457,
-	7, 81, 2, 2, 9457, 9458, 7, 80, 2, 2, 9458, 9459, 7, 80, 2, 2, 9459, 9460,
-	7, 71, 2, 2, 9460, 9461, 7, 69, 2, 2, 9461, 9462, 7, 86, 2, 2, 9462, 9463,
-	7, 75, 2, 2, 9463, 9464, 7, 81, 2, 2, 9464, 9465, 7, 80, 2, 2, 9465, 9466,
-	7, 97, 2, 2, 9466, 9467, 7, 75, 2, 2, 9467, 9468, 7, 70, 2, 2, 9468, 1560,
-	3, 2, 2, 2, 9469, 9470, 7, 69, 2, 2, 9470, 9471, 7, 81, 2, 2, 9471, 9472,
-	7, 80, 2, 2, 9472, 9473, 7, 88, 2, 2, 9473, 1562, 3, 2, 2, 2, 9474, 9475,
-	7, 69, 2, 2, 9475, 9476, 7, 81, 2, 2, 9476, 9477, 7, 80, 2, 2, 9477, 9478,
-	7, 88, 2, 2, 9478, 9479, 7, 71, 2, 2, 9479, 9480, 7, 84, 2, 2, 9480, 9481,
-	7, 86, 2, 2, 9481, 9482, 7, 97, 2, 2, 9482, 9483, 7, 86, 2, 2, 9483, 9484,
-	7, 92, 2, 2, 9484, 1564, 3, 2, 2, 2, 9485, 9486, 7, 69, 2, 2, 9486, 9487,
-	7, 81, 2, 2, 9487, 9488, 7, 85, 2, 2, 9488, 1566, 3, 2, 2, 2, 9489, 9490,
-	7, 69, 2, 2, 9490, 9491, 7, 81, 2, 2, 9491, 9492, 7, 86, 2, 2, 9492, 1568,
-	3, 2, 2, 2, 9493, 9494, 7, 69, 2, 2, 9494, 9495, 7, 84, 2, 2, 9495, 9496,
-	7, 69, 2, 2, 9496, 9497, 7, 53, 2, 2, 9497, 9498, 7, 52, 2, 2, 9498, 1570,
-	3, 2, 2, 2, 9499, 9500, 7, 69, 2, 2, 9500, 9501, 7, 84, 2, 2, 9501, 9502,
-	7, 71, 2, 2, 9502, 9503, 7, 67, 2, 2, 9503, 9504, 7, 86, 2, 2, 9504, 9505,
-	7, 71, 2, 2, 9505, 9506, 7, 97, 2, 2, 9506, 9507, 7, 67, 2, 2, 9507, 9508,
-	7, 85, 2, 2, 9508, 9509, 7, 91, 2, 2, 9509, 9510, 7, 79, 2, 2, 9510, 9511,
-	7, 79, 2, 2, 9511, 9512, 7, 71, 2, 2, 9512, 9513, 7, 86, 2, 2, 9513, 9514,
-	7, 84, 2, 2, 9514, 9515, 7, 75, 2, 2, 9515, 9516, 7, 69, 2, 2, 9516, 9517,
-	7, 97, 2, 2, 9517, 9518, 7, 82, 2, 2, 9518, 9519, 7, 84, 2, 2, 9519, 9520,
-	7, 75, 2, 2, 9520, 9521, 7, 88, 2, 2, 9521, 9522, 7, 97, 2, 2, 9522, 9523,
-	7, 77, 2, 2, 9523, 9524, 7, 71, 2, 2, 9524, 9525, 7, 91, 2, 2, 9525, 1572,
-	3, 2, 2, 2, 9526, 9527, 7, 69, 2, 2, 9527, 9528, 7, 84, 2, 2, 9528, 9529,
-	7, 71, 2, 2, 9529, 9530, 7, 67, 2, 2, 9530, 9531, 7, 86, 2, 2, 9531, 9532,
-	7, 71, 2, 2, 9532, 9533, 7, 97, 2, 2, 9533, 9534, 7, 67, 2, 2, 9534, 9535,
-	7, 85, 2, 2, 9535, 9536, 7, 91, 2, 2, 9536, 9537, 7, 79, 2, 2, 9537, 9538,
-	7, 79, 2, 2, 9538, 9539, 7, 71, 2, 2, 9539, 9540, 7, 86, 2, 2, 9540, 9541,
-	7, 84, 2, 2, 9541, 9542, 7, 75, 2, 2, 9542, 9543, 7, 69, 2, 2, 9543, 9544,
-	7, 97, 2, 2, 9544, 9545, 7, 82, 2, 2, 9545, 9546, 7, 87, 2, 2, 9546, 9547,
-	7, 68, 2, 2, 9547, 9548, 7, 97, 2, 2, 9548, 9549, 7, 77, 2, 2, 9549, 9550,
-	7, 71, 2, 2, 9550, 9551, 7, 91, 2, 2, 9551, 1574, 3, 2, 2, 2, 9552, 9553,
-	7, 69, 2, 2, 9553, 9554, 7, 84, 2, 2, 9554, 9555, 7, 71, 2, 2, 9555, 9556,
-	7, 67, 2, 2, 9556, 9557, 7, 86, 2, 2, 9557, 9558, 7, 71, 2, 2, 9558, 9559,
-	7, 97, 2, 2, 9559, 9560, 7, 70, 2, 2, 9560, 9561, 7, 74, 2, 2, 9561, 9562,
-	7, 97, 2, 2, 9562, 9563, 7, 82, 2, 2, 9563, 9564, 7, 67, 2, 2, 9564, 9565,
-	7, 84, 2, 2, 9565, 9566, 7, 67, 2, 2, 9566, 9567, 7, 79, 2, 2, 9567, 9568,
-	7, 71, 2, 2, 9568, 9569, 7, 86, 2, 2, 9569, 9570, 7, 71, 2, 2, 9570, 9571,
-	7, 84, 2, 2, 9571, 9572, 7, 85, 2, 2, 9572, 1576, 3, 2, 2, 2, 9573, 9574,
-	7, 69, 2, 2, 9574, 9575, 7, 84, 2, 2, 9575, 9576, 7, 71, 2, 2, 9576, 9577,
-	7, 67, 2, 2, 9577, 9578, 7, 86, 2, 2, 9578, 9579, 7, 71, 2, 2, 9579, 9580,
-	7, 97, 2, 2, 9580, 9581, 7, 70, 2, 2, 9581, 9582, 7, 75, 2, 2, 9582, 9583,
-	7, 73, 2, 2, 9583, 9584, 7, 71, 2, 2, 9584, 9585, 7, 85, 2, 2, 9585, 9586,
-	7, 86, 2, 2, 9586, 1578, 3, 2, 2, 2, 9587, 9588, 7, 69, 2, 2, 9588, 9589,
-	7, 84, 2, 2, 9589, 9590, 7, 81, 2, 2, 9590, 9591, 7, 85, 2, 2, 9591, 9592,
-	7, 85, 2, 2, 9592, 9593, 7, 71, 2, 2, 9593, 9594, 7, 85, 2, 2, 9594, 1580,
-	3, 2, 2, 2, 9595, 9596, 7, 70, 2, 2, 9596, 9597, 7, 67, 2, 2, 9597, 9598,
-	7, 86, 2, 2, 9598, 9599, 7, 71, 2, 2, 9599, 9600, 7, 70, 2, 2, 9600, 9601,
-	7, 75, 2, 2, 9601, 9602, 7, 72, 2, 2, 9602, 9603, 7, 72, 2, 2, 9603, 1582,
-	3, 2, 2, 2, 9604, 9605, 7, 70, 2, 2, 9605, 9606, 7, 67, 2, 2, 9606, 9607,
-	7, 86, 2, 2, 9607, 9608, 7, 71, 2, 2, 9608, 9609, 7, 97, 2, 2, 9609, 9610,
-	7, 72, 2, 2, 9610, 9611, 7, 81, 2, 2, 9611, 9612, 7, 84, 2, 2, 9612, 9613,
-	7, 79, 2, 2, 9613, 9614, 7, 67, 2, 2, 9614, 9615, 7, 86, 2, 2, 9615, 1584,
-	3, 2, 2, 2, 9616, 9617, 7, 70, 2, 2, 9617, 9618, 7, 67, 2, 2, 9618, 9619,
-	7, 91, 2, 2, 9619, 9620, 7, 80, 2, 2, 9620, 9621, 7, 67, 2, 2, 9621, 9622,
-	7, 79, 2, 2, 9622, 9623, 7, 71, 2, 2, 9623, 1586, 3, 2, 2, 2, 9624, 9625,
-	7, 70, 2, 2, 9625, 9626, 7, 67, 2, 2, 9626, 9627, 7, 91, 2, 2, 9627, 9628,
-	7, 81, 2, 2, 9628, 9629, 7, 72, 2, 2, 9629, 9630, 7, 79, 2, 2, 9630, 9631,
-	7, 81, 2, 2, 9631, 9632, 7, 80, 2, 2, 9632, 9633, 7, 86, 2, 2, 9633, 9634,
-	7, 74, 2, 2, 9634, 1588, 3, 2, 2, 2, 9635, 9636, 7, 70, 2, 2, 9636, 9637,
-	7, 67, 2, 2, 9637, 9638, 7, 91, 2, 2, 9638, 9639, 7, 81, 2, 2, 9639, 9640,
-	7, 72, 2, 2, 9640, 9641, 7, 89, 2, 2, 9641, 9642, 7, 71, 2, 2, 9642, 9643,
-	7, 71, 2, 2, 9643, 9644, 7, 77, 2, 2, 9644, 1590, 3, 2, 2, 2, 9645, 9646,
-	7, 70, 2, 2, 9646, 9647, 7, 67, 2, 2, 9647, 9648, 7, 91, 2, 2, 9648, 9649,
-	7, 81, 2, 2, 9649, 9650, 7, 72, 2, 2, 9650, 9651, 7, 91, 2, 2, 9651, 9652,
-	7, 71, 2, 2, 9652, 9653, 7, 67, 2, 2, 9653, 9654, 7, 84, 2, 2, 9654, 1592,
-	3, 2, 2, 2, 9655, 9656, 7, 70, 2, 2, 9656, 9657, 7, 71, 2, 2, 9657, 9658,
-	7, 69, 2, 2, 9658, 9659, 7, 81, 2, 2, 9659, 9660, 7, 70, 2, 2, 9660, 9661,
-	7, 71, 2, 2, 9661, 1594, 3, 2, 2, 2, 9662, 9663, 7, 70, 2, 2, 9663, 9664,
-	7, 71, 2, 2, 9664, 9665, 7, 73, 2, 2, 9665, 9666, 7, 84, 2, 2, 9666, 9667,
-	7, 71, 2, 2, 9667, 9668, 7, 71, 2, 2, 9668, 9669, 7, 85, 2, 2, 9669, 1596,
-	3, 2, 2, 2, 9670, 9671, 7, 70, 2, 2, 9671, 9672, 7, 71, 2, 2, 9672, 9673,
-	7, 85, 2, 2, 9673, 9674, 7, 97, 2, 2, 9674, 9675, 7, 70, 2, 2, 9675, 9676,
-	7, 71, 2, 2, 9676, 9677, 7, 69, 2, 2, 9677, 9678, 7, 84, 2, 2, 9678, 9679,
-	7, 91, 2, 2, 9679, 9680, 7, 82, 2, 2, 9680, 9681, 7, 86, 2, 2, 9681, 1598,
-	3, 2, 2, 2, 9682, 9683, 7, 70, 2, 2, 9683, 9684, 7, 71, 2, 2, 9684, 9685,
-	7, 85, 2, 2, 9685, 9686, 7, 97, 2, 2, 9686, 9687, 7, 71, 2, 2, 9687, 9688,
-	7, 80, 2, 2, 9688, 9689, 7, 69, 2, 2, 9689, 9690, 7, 84, 2, 2, 9690, 9691,
-	7, 91, 2, 2, 9691, 9692, 7, 82, 2, 2, 9692, 9693, 7, 86, 2, 2, 9693, 1600,
-	3, 2, 2, 2, 9694, 9695, 7, 70, 2, 2, 9695, 9696, 7, 75, 2, 2, 9696, 9697,
-	7, 79, 2, 2, 9697, 9698, 7, 71, 2, 2, 9698, 9699, 7, 80, 2, 2, 9699, 9700,
-	7, 85, 2, 2, 9700, 9701, 7, 75, 2, 2, 9701, 9702, 7, 81, 2, 2, 9702, 9703,
-	7, 80, 2, 2, 9703, 1602, 3, 2, 2, 2, 9704, 9705, 7, 70, 2, 2, 9705, 9706,
-	7, 75, 2, 2, 9706, 9707, 7, 85, 2, 2, 9707, 9708, 7, 76, 2, 2, 9708, 9709,
-	7, 81, 2, 2, 9709, 9710, 7, 75, 2, 2, 9710, 9711, 7, 80, 2, 2, 9711, 9712,
-	7, 86, 2, 2, 9712, 1604, 3, 2, 2, 2, 9713, 9714, 7, 71, 2, 2, 9714, 9715,
-	7, 78, 2, 2, 9715, 9716, 7, 86, 2, 2, 9716, 1606, 3, 2, 2, 2, 9717, 9718,
-	7, 71, 2, 2, 9718, 9719, 7, 80, 2, 2, 9719, 9720, 7, 69, 2, 2, 9720, 9721,
-	7, 81, 2, 2, 9721, 9722, 7, 70, 2, 2, 9722, 9723, 7, 71, 2, 2, 9723, 1608,
-	3, 2, 2, 2, 9724, 9725, 7, 71, 2, 2, 9725, 9726, 7, 80, 2, 2, 9726, 9727,
-	7, 69, 2, 2, 9727, 9728, 7, 84, 2, 2, 9728, 9729, 7, 91, 2, 2, 9729, 9730,
-	7, 82, 2, 2, 9730, 9731, 7, 86, 2, 2, 9731, 1610, 3, 2, 2, 2, 9732, 9733,
-	7, 71, 2, 2, 9733, 9734, 7, 80, 2, 2, 9734, 9735, 7, 70, 2, 2, 9735, 9736,
-	7, 82, 2, 2, 9736, 9737, 7, 81, 2, 2, 9737, 9738, 7, 75, 2, 2, 9738, 9739,
-	7, 80, 2, 2, 9739, 9740, 7, 86, 2, 2, 9740, 1612, 3, 2, 2, 2, 9741, 9742,
-	7, 71, 2, 2, 9742, 9743, 7, 80, 2, 2, 9743, 9744, 7, 88, 2, 2, 9744, 9745,
-	7, 71, 2, 2, 9745, 9746, 7, 78, 2, 2, 9746, 9747, 7, 81, 2, 2, 9747, 9748,
-	7, 82, 2, 2, 9748, 9749, 7, 71, 2, 2, 9749, 1614, 3, 2, 2, 2, 9750, 9751,
-	7, 71, 2, 2, 9751, 9752, 7, 83, 2, 2, 9752, 9753, 7, 87, 2, 2, 9753, 9754,
-	7, 67, 2, 2, 9754, 9755, 7, 78, 2, 2, 9755, 9756, 7, 85, 2, 2, 9756, 1616,
-	3, 2, 2, 2, 9757, 9758, 7, 71, 2, 2, 9758, 9759, 7, 90, 2, 2, 9759, 9760,
-	7, 82, 2, 2, 9760, 1618, 3, 2, 2, 2, 9761, 9762, 7, 71, 2, 2, 9762, 9763,
-	7, 90, 2, 2, 9763, 9764, 7, 82, 2, 2, 9764, 9765, 7, 81, 2, 2, 9765, 9766,
-	7, 84, 2, 2, 9766, 9767, 7, 86, 2, 2, 9767, 9768, 7, 97, 2, 2, 9768, 9769,
-	7, 85, 2, 2, 9769, 9770, 7, 71, 2, 2, 9770, 9771, 7, 86, 2, 2, 9771, 1620,
-	3, 2, 2, 2, 9772, 9773, 7, 71, 2, 2, 9773, 9774, 7, 90, 2, 2, 9774, 9775,
-	7, 86, 2, 2, 9775, 9776, 7, 71, 2, 2, 9776, 9777, 7, 84, 2, 2, 9777, 9778,
-	7, 75, 2, 2, 9778, 9779, 7, 81, 2, 2, 9779, 9780, 7, 84, 2, 2, 9780, 9781,
-	7, 84, 2, 2, 9781, 9782, 7, 75, 2, 2, 9782, 9783, 7, 80, 2, 2, 9783, 9784,
-	7, 73, 2, 2, 9784, 1622, 3, 2, 2, 2, 9785, 9786, 7, 71, 2, 2, 9786, 9787,
-	7, 90, 2, 2, 9787, 9788, 7, 86, 2, 2, 9788, 9789, 7, 84, 2, 2, 9789, 9790,
-	7, 67, 2, 2, 9790, 9791, 7, 69, 2, 2, 9791, 9792, 7, 86, 2, 2, 9792, 9793,
-	7, 88, 2, 2, 9793, 9794, 7, 67, 2, 2, 9794, 9795, 7, 78, 2, 2, 9795, 9796,
-	7, 87, 2, 2, 9796, 9797, 7, 71, 2, 2, 9797, 1624, 3, 2, 2, 2, 9798, 9799,
-	7, 72, 2, 2, 9799, 9800, 7, 75, 2, 2, 9800, 9801, 7, 71, 2, 2, 9801, 9802,
-	7, 78, 2, 2, 9802, 9803, 7, 70, 2, 2, 9803, 1626, 3, 2, 2, 2, 9804, 9805,
-	7, 72, 2, 2, 9805, 9806, 7, 75, 2, 2, 9806, 9807, 7, 80, 2, 2, 9807, 9808,
-	7, 70, 2, 2, 9808, 9809, 7, 97, 2, 2, 9809, 9810, 7, 75, 2, 2, 9810, 9811,
-	7, 80, 2, 2, 9811, 9812, 7, 97, 2, 2, 9812, 9813, 7, 85, 2, 2, 9813, 9814,
-	7, 71, 2, 2, 9814, 9815, 7, 86, 2, 2, 9815, 1628, 3, 2, 2, 2, 9816, 9817,
-	7, 72, 2, 2, 9817, 9818, 7, 78, 2, 2, 9818, 9819, 7, 81, 2, 2, 9819, 9820,
-	7, 81, 2, 2, 9820, 9821, 7, 84, 2, 2, 9821, 1630, 3, 2, 2, 2, 9822, 9823,
-	7, 72, 2, 2, 9823, 9824, 7, 81, 2, 2, 9824, 9825, 7, 84, 2, 2, 9825, 9826,
-	7, 79, 2, 2, 9826, 9827, 7, 67, 2, 2, 9827, 9828, 7, 86, 2, 2, 9828, 1632,
-	3, 2, 2, 2, 9829, 9830, 7, 72, 2, 2, 9830, 9831, 7, 81, 2, 2, 9831, 9832,
-	7, 87, 2, 2, 9832, 9833, 7, 80, 2, 2, 9833, 9834, 7, 70, 2, 2, 9834, 9835,
-	7, 97, 2, 2, 9835, 9836, 7, 84, 2, 2, 9836, 9837, 7, 81, 2, 2, 9837, 9838,
-	7, 89, 2, 2, 9838, 9839, 7, 85, 2, 2, 9839, 1634, 3, 2, 2, 2, 9840, 9841,
-	7, 72, 2, 2, 9841, 9842, 7, 84, 2, 2, 9842, 9843, 7, 81, 2, 2, 9843, 9844,
-	7, 79, 2, 2, 9844, 9845, 7, 97, 2, 2, 9845, 9846, 7, 68, 2, 2, 9846, 9847,
-	7, 67, 2, 2, 9847, 9848, 7, 85, 2, 2, 9848, 9849, 7, 71, 2, 2, 9849, 9850,
-	7, 56, 2, 2, 9850, 9851, 7, 54, 2, 2, 9851, 1636, 3, 2, 2, 2, 9852, 9853,
-	7, 72, 2, 2, 9853, 9854, 7, 84, 2, 2, 9854, 9855, 7, 81, 2, 2, 9855, 9856,
-	7, 79, 2, 2, 9856, 9857, 7, 97, 2, 2, 9857, 9858, 7, 70, 2, 2, 9858, 9859,
-	7, 67, 2, 2, 9859, 9860, 7, 91, 2, 2, 9860, 9861, 7, 85, 2, 2, 9861, 1638,
-	3, 2, 2, 2, 9862, 9863, 7, 72, 2, 2, 9863, 9864, 7, 84, 2, 2, 9864, 9865,
-	7, 81, 2, 2, 9865, 9866, 7, 79, 2, 2, 9866, 9867, 7, 97, 2, 2, 9867, 9868,
-	7, 87, 2, 2, 9868, 9869, 7, 80, 2, 2, 9869, 9870, 7, 75, 2, 2, 9870, 9871,
-	7, 90, 2, 2, 9871, 9872, 7, 86, 2, 2, 9872, 9873, 7, 75, 2, 2, 9873, 9874,
-	7, 79, 2, 2, 9874, 9875, 7, 71, 2, 2, 9875, 1640, 3, 2, 2, 2, 9876, 9877,
-	7, 73, 2, 2, 9877, 9878, 7, 71, 2, 2, 9878, 9879, 7, 81, 2, 2, 9879, 9880,
-	7, 79, 2, 2, 9880, 9881, 7, 69, 2, 2, 9881, 9882, 7, 81, 2, 2, 9882, 9883,
-	7, 78, 2, 2, 9883, 9884, 7, 78, 2, 2, 9884, 9885, 7, 72, 2, 2, 9885, 9886,
-	7, 84, 2, 2, 9886, 9887, 7, 81, 2, 2, 9887, 9888, 7, 79, 2, 2, 9888, 9889,
-	7, 86, 2, 2, 9889, 9890, 7, 71, 2, 2, 9890, 9891, 7, 90, 2, 2, 9891, 9892,
-	7, 86, 2, 2, 9892, 1642, 3, 2, 2, 2, 9893, 9894, 7, 73, 2, 2, 9894, 9895,
-	7, 71, 2, 2, 9895, 9896, 7, 81, 2, 2, 9896, 9897, 7, 79, 2, 2, 9897, 9898,
-	7, 69, 2, 2, 9898, 9899, 7, 81, 2, 2, 9899, 9900, 7, 78, 2, 2, 9900, 9901,
-	7, 78, 2, 2, 9901, 9902, 7, 72, 2, 2, 9902, 9903, 7, 84, 2, 2, 9903, 9904,
-	7, 81, 2, 2, 9904, 9905, 7, 79, 2, 2, 9905, 9906, 7, 89, 2, 2, 9906, 9907,
-	7, 77, 2, 2, 9907, 9908, 7, 68, 2, 2, 9908, 1644, 3, 2, 2, 2, 9909, 9910,
-	7, 73, 2, 2, 9910, 9911, 7, 71, 2, 2, 9911, 9912, 7, 81, 2, 2, 9912, 9913,
-	7, 79, 2, 2, 9913, 9914, 7, 71, 2, 2, 9914, 9915, 7, 86, 2, 2, 9915, 9916,
-	7, 84, 2, 2, 9916, 9917, 7, 91, 2, 2, 9917, 9918, 7, 69, 2, 2, 9918, 9919,
-	7, 81, 2, 2, 9919, 9920, 7, 78, 2, 2, 9920, 9921, 7, 78, 2, 2, 9921, 9922,
-	7, 71, 2, 2, 9922, 9923, 7, 69, 2, 2, 9923, 9924, 7, 86, 2, 2, 9924, 9925,
-	7, 75, 2, 2, 9925, 9926, 7, 81, 2, 2, 9926, 9927, 7, 80, 2, 2, 9927, 9928,
-	7, 72, 2, 2, 9928, 9929, 7, 84, 2, 2, 9929, 9930, 7, 81, 2, 2, 9930, 9931,
-	7, 79, 2, 2, 9931, 9932, 7, 86, 2, 2, 9932, 9933, 7, 71, 2, 2, 9933, 9934,
-	7, 90, 2, 2, 9934, 9935, 7, 86, 2, 2, 9935, 1646, 3, 2, 2, 2, 9936, 9937,
-	7, 73, 2, 2, 9937, 9938, 7, 71, 2, 2, 9938, 9939, 7, 81, 2, 2, 9939, 9940,
-	7, 79, 2, 2, 9940, 9941, 7, 71, 2, 2, 9941, 9942, 7, 86, 2, 2, 9942, 9943,
-	7, 84, 2, 2, 9943, 9944, 7, 91, 2, 2, 9944, 9945, 7, 69, 2, 2, 9945, 9946,
-	7, 81, 2, 2, 9946, 9947, 7, 78, 2, 2, 9947, 9948, 7, 78, 2, 2, 9948, 9949,
-	7, 71, 2, 2, 9949, 9950, 7, 69, 2, 2, 9950, 9951, 7, 86, 2, 2, 9951, 9952,
-	7, 75, 2, 2, 9952, 9953, 7, 81, 2, 2, 9953, 9954, 7, 80, 2, 2, 9954, 9955,
-	7, 72, 2, 2, 9955, 9956, 7, 84, 2, 2, 9956, 9957, 7, 81, 2, 2, 9957, 9958,
-	7, 79, 2, 2, 9958, 9959, 7, 89, 2, 2, 9959, 9960, 7, 77, 2, 2, 9960, 9961,
-	7, 68, 2, 2, 9961, 1648, 3, 2, 2, 2, 9962, 9963, 7, 73, 2, 2, 9963, 9964,
-	7, 71, 2, 2, 9964, 9965, 7, 81, 2, 2, 9965, 9966, 7, 79, 2, 2, 9966, 9967,
-	7, 71, 2, 2, 9967, 9968, 7, 86, 2, 2, 9968, 9969, 7, 84, 2, 2, 9969, 9970,
-	7, 91, 2, 2, 9970, 9971, 7, 72, 2, 2, 9971, 9972, 7, 84, 2, 2, 9972, 9973,
-	7, 81, 2, 2, 9973, 9974, 7, 79, 2, 2, 9974, 9975, 7, 86, 2, 2, 9975, 9976,
-	7, 71, 2, 2, 9976, 9977, 7, 90, 2, 2, 9977, 9978, 7, 86, 2, 2, 9978, 1650,
-	3, 2, 2, 2, 9979, 9980, 7, 73, 2, 2, 9980, 9981, 7, 71, 2, 2, 9981, 9982,
-	7, 81, 2, 2, 9982, 9983, 7, 79, 2, 2, 9983, 9984, 7, 71, 2, 2, 9984, 9985,
-	7, 86, 2, 2, 9985, 9986, 7, 84, 2, 2, 9986, 9987, 7, 91, 2, 2, 9987, 9988,
-	7, 72, 2, 2, 9988, 9989, 7, 84, 2, 2, 9989, 9990, 7, 81, 2, 2, 9990, 9991,
-	7, 79, 2, 2, 9991, 9992, 7, 89, 2, 2, 9992, 9993, 7, 77, 2, 2, 9993, 9994,
-	7, 68, 2, 2, 9994, 1652, 3, 2, 2, 2, 9995, 9996, 7, 73, 2, 2, 9996, 9997,
-	7, 71, 2, 2, 9997, 9998, 7, 81, 2, 2, 9998, 9999, 7, 79, 2, 2, 9999, 10000,
-	7, 71, 2, 2, 10000, 10001, 7, 86, 2, 2, 10001, 10002, 7, 84, 2, 2, 10002,
-	10003, 7, 91, 2, 2, 10003, 10004, 7, 80, 2, 2, 10004, 1654, 3, 2, 2, 2,
-	10005, 10006, 7, 73, 2, 2, 10006, 10007, 7, 71, 2, 2, 10007, 10008, 7,
-	81, 2, 2, 10008, 10009, 7, 79, 2, 2, 10009, 10010, 7, 71, 2, 2, 10010,
-	10011, 7, 86, 2, 2, 10011, 10012, 7, 84, 2, 2, 10012, 10013, 7, 91, 2,
-	2, 10013, 10014, 7, 86, 2, 2, 10014, 10015, 7, 91, 2, 2, 10015, 10016,
-	7, 82, 2, 2, 10016, 10017, 7, 71, 2, 2, 10017, 1656, 3, 2, 2, 2, 10018,
-	10019, 7, 73, 2, 2, 10019, 10020, 7, 71, 2, 2, 10020, 10021, 7, 81, 2,
-	2, 10021, 10022, 7, 79, 2, 2, 10022, 10023, 7, 72, 2, 2, 10023, 10024,
-	7, 84, 2, 2, 10024, 10025, 7, 81, 2, 2, 10025, 10026, 7, 79, 2, 2, 10026,
-	10027, 7, 86, 2, 2, 10027, 10028, 7, 71, 2, 2, 10028, 10029, 7, 90, 2,
-	2, 10029, 10030, 7, 86, 2, 2, 10030, 1658, 3, 2, 2, 2, 10031, 10032, 7,
-	73, 2, 2, 10032, 10033, 7, 71, 2, 2, 10033, 10034, 7, 81, 2, 2, 10034,
-	10035, 7, 79, 2, 2, 10035, 10036, 7, 72, 2, 2, 10036, 10037, 7, 84, 2,
-	2, 10037, 10038, 7, 81, 2, 2, 10038, 10039, 7, 79, 2, 2, 10039, 10040,
-	7, 89, 2, 2, 10040, 10041, 7, 77, 2, 2, 10041, 10042, 7, 68, 2, 2, 10042,
-	1660, 3, 2, 2, 2, 10043, 10044, 7, 73, 2, 2, 10044, 10045, 7, 71, 2, 2,
-	10045, 10046, 7, 86, 2, 2, 10046, 10047, 7, 97, 2, 2, 10047, 10048, 7,
-	72, 2, 2, 10048, 10049, 7, 81, 2, 2, 10049, 10050, 7, 84, 2, 2, 10050,
-	10051, 7, 79, 2, 2, 10051, 10052, 7, 67, 2, 2, 10052, 10053, 7, 86, 2,
-	2, 10053, 1662, 3, 2, 2, 2, 10054, 10055, 7, 73, 2, 2, 10055, 10056, 7,
-	71, 2, 2, 10056, 10057, 7, 86, 2, 2, 10057, 10058, 7, 97, 2, 2, 10058,
-	10059, 7, 78, 2, 2, 10059, 10060, 7, 81, 2, 2, 10060, 10061, 7, 69, 2,
-	2, 10061, 10062, 7, 77, 2, 2, 10062, 1664, 3, 2, 2, 2, 10063, 10064, 7,
-	73, 2, 2, 10064, 10065, 7, 78, 2, 2, 10065, 10066, 7, 71, 2, 2, 10066,
-	10067, 7, 80, 2, 2, 10067, 10068, 7, 73, 2, 2, 10068, 10069, 7, 86, 2,
-	2, 10069, 10070, 7, 74, 2, 2, 10070, 1666, 3, 2, 2, 2, 10071, 10072, 7,
-	73, 2, 2, 10072, 10073, 7, 84, 2, 2, 10073, 10074, 7, 71, 2, 2, 10074,
-	10075, 7, 67, 2, 2, 10075, 10076, 7, 86, 2, 2, 10076, 10077, 7, 71, 2,
-	2, 10077, 10078, 7, 85, 2, 2, 10078, 10079, 7, 86, 2, 2, 10079, 1668, 3,
-	2, 2, 2, 10080, 10081, 7, 73, 2, 2, 10081, 10082, 7, 86, 2, 2, 10082, 10083,
-	7, 75, 2, 2, 10083, 10084, 7, 70, 2, 2, 10084, 10085, 7, 97, 2, 2, 10085,
-	10086, 7, 85, 2, 2, 10086, 10087, 7, 87, 2, 2, 10087, 10088, 7, 68, 2,
-	2, 10088, 10089, 7, 85, 2, 2, 10089, 10090, 7, 71, 2, 2, 10090, 10091,
-	7, 86, 2, 2, 10091, 1670, 3, 2, 2, 2, 10092, 10093, 7, 73, 2, 2, 10093,
-	10094, 7, 86, 2, 2, 10094, 10095, 7, 75, 2, 2, 10095, 10096, 7, 70, 2,
-	2, 10096, 10097, 7, 97, 2, 2, 10097, 10098, 7, 85, 2, 2, 10098, 10099,
-	7, 87, 2, 2, 10099, 10100, 7, 68, 2, 2, 10100, 10101, 7, 86, 2, 2, 10101,
-	10102, 7, 84, 2, 2, 10102, 10103, 7, 67, 2, 2, 10103, 10104, 7, 69, 2,
-	2, 10104, 10105, 7, 86, 2, 2, 10105, 1672, 3, 2, 2, 2, 10106, 10107, 7,
-	74, 2, 2, 10107, 10108, 7, 71, 2, 2, 10108, 10109, 7, 90, 2, 2, 10109,
-	1674, 3, 2, 2, 2, 10110, 10111, 7, 75, 2, 2, 10111, 10112, 7, 72, 2, 2,
-	10112, 10113, 7, 80, 2, 2, 10113, 10114, 7, 87, 2, 2, 10114, 10115, 7,
-	78, 2, 2, 10115, 10116, 7, 78, 2, 2, 10116, 1676, 3, 2, 2, 2, 10117, 10118,
-	7, 75, 2, 2, 10118, 10119, 7, 80, 2, 2, 10119, 10120, 7, 71, 2, 2, 10120,
-	10121, 7, 86, 2, 2, 10121, 10122, 7, 56, 2, 2, 10122, 10123, 7, 97, 2,
-	2, 10123, 10124, 7, 67, 2, 2, 10124, 10125, 7, 86, 2, 2, 10125, 10126,
-	7, 81, 2, 2, 10126, 10127, 7, 80, 2, 2, 10127, 1678, 3, 2, 2, 2, 10128,
-	10129, 7, 75, 2, 2, 10129, 10130, 7, 80, 2, 2, 10130, 10131, 7, 71, 2,
-	2, 10131, 10132, 7, 86, 2, 2, 10132, 10133, 7, 56, 2, 2, 10133, 10134,
-	7, 97, 2, 2, 10134, 10135, 7, 80, 2, 2, 10135, 10136, 7, 86, 2, 2, 10136,
-	10137, 7, 81, 2, 2, 10137, 10138, 7, 67, 2, 2, 10138, 1680, 3, 2, 2, 2,
-	10139, 10140, 7, 75, 2, 2, 10140, 10141, 7, 80, 2, 2, 10141, 10142, 7,
-	71, 2, 2, 10142, 10143, 7, 86, 2, 2, 10143, 10144, 7, 97, 2, 2, 10144,
-	10145, 7, 67, 2, 2, 10145, 10146, 7, 86, 2, 2, 10146, 10147, 7, 81, 2,
-	2, 10147, 10148, 7, 80, 2, 2, 10148, 1682, 3, 2, 2, 2, 10149, 10150, 7,
-	75, 2, 2, 10150, 10151, 7, 80, 2, 2, 10151, 10152, 7, 71, 2, 2, 10152,
-	10153, 7, 86, 2, 2, 10153, 10154, 7, 97, 2, 2, 10154, 10155, 7, 80, 2,
-	2, 10155, 10156, 7, 86, 2, 2, 10156, 10157, 7, 81, 2, 2, 10157, 10158,
-	7, 67, 2, 2, 10158, 1684, 3, 2, 2, 2, 10159, 10160, 7, 75, 2, 2, 10160,
-	10161, 7, 80, 2, 2, 10161, 10162, 7, 85, 2, 2, 10162, 10163, 7, 86, 2,
-	2, 10163, 10164, 7, 84, 2, 2, 10164, 1686, 3, 2, 2, 2, 10165, 10166, 7,
-	75, 2, 2, 10166, 10167, 7, 80, 2, 2, 10167, 10168, 7, 86, 2, 2, 10168,
-	10169, 7, 71, 2, 2, 10169, 10170, 7, 84, 2, 2, 10170, 10171, 7, 75, 2,
-	2, 10171, 10172, 7, 81, 2, 2, 10172, 10173, 7, 84, 2, 2, 10173, 10174,
-	7, 84, 2, 2, 10174, 10175, 7, 75, 2, 2, 10175, 10176, 7, 80, 2, 2, 10176,
-	10177, 7, 73, 2, 2, 10177, 10178, 7, 80, 2, 2, 10178, 1688, 3, 2, 2, 2,
-	10179, 10180, 7, 75, 2, 2, 10180, 10181, 7, 80, 2, 2, 10181, 10182, 7,
-	86, 2, 2, 10182, 10183, 7, 71, 2, 2, 10183, 10184, 7, 84, 2, 2, 10184,
-	10185, 7, 85, 2, 2, 10185, 10186, 7, 71, 2, 2, 10186, 10187, 7, 69, 2,
-	2, 10187, 10188, 7, 86, 2, 2, 10188, 10189, 7, 85, 2, 2, 10189, 1690, 3,
-	2, 2, 2, 10190, 10191, 7, 75, 2, 2, 10191, 10192, 7, 85, 2, 2, 10192, 10193,
-	7, 69, 2, 2, 10193, 10194, 7, 78, 2, 2, 10194, 10195, 7, 81, 2, 2, 10195,
-	10196, 7, 85, 2, 2, 10196, 10197, 7, 71, 2, 2, 10197, 10198, 7, 70, 2,
-	2, 10198, 1692, 3, 2, 2, 2, 10199, 10200, 7, 75, 2, 2, 10200, 10201, 7,
-	85, 2, 2, 10201, 10202, 7, 71, 2, 2, 10202, 10203, 7, 79, 2, 2, 10203,
-	10204, 7, 82, 2, 2, 10204, 10205, 7, 86, 2, 2, 10205, 10206, 7, 91, 2,
-	2, 10206, 1694, 3, 2, 2, 2, 10207, 10208, 7, 75, 2, 2, 10208, 10209, 7,
-	85, 2, 2, 10209, 10210, 7, 80, 2, 2, 10210, 10211, 7, 87, 2, 2, 10211,
-	10212, 7, 78, 2, 2, 10212, 10213, 7, 78, 2, 2, 10213, 1696, 3, 2, 2, 2,
-	10214, 10215, 7, 75, 2, 2, 10215, 10216, 7, 85, 2, 2, 10216, 10217, 7,
-	85, 2, 2, 10217, 10218, 7, 75, 2, 2, 10218, 10219, 7, 79, 2, 2, 10219,
-	10220, 7, 82, 2, 2, 10220, 10221, 7, 78, 2, 2, 10221, 10222, 7, 71, 2,
-	2, 10222, 1698, 3, 2, 2, 2, 10223, 10224, 7, 75, 2, 2, 10224, 10225, 7,
-	85, 2, 2, 10225, 10226, 7, 97, 2, 2, 10226, 10227, 7, 72, 2, 2, 10227,
-	10228, 7, 84, 2, 2, 10228, 10229, 7, 71, 2, 2, 10229, 10230, 7, 71, 2,
-	2, 10230, 10231, 7, 97, 2, 2, 10231, 10232, 7, 78, 2, 2, 10232, 10233,
-	7, 81, 2, 2, 10233, 10234, 7, 69, 2, 2, 10234, 10235, 7, 77, 2, 2, 10235,
-	1700, 3, 2, 2, 2, 10236, 10237, 7, 75, 2, 2, 10237, 10238, 7, 85, 2, 2,
-	10238, 10239, 7, 97, 2, 2, 10239, 10240, 7, 75, 2, 2, 10240, 10241, 7,
-	82, 2, 2, 10241, 10242, 7, 88, 2, 2, 10242, 10243, 7, 54, 2, 2, 10243,
-	1702, 3, 2, 2, 2, 10244, 10245, 7, 75, 2, 2, 10245, 10246, 7, 85, 2, 2,
-	10246, 10247, 7, 97, 2, 2, 10247, 10248, 7, 75, 2, 2, 10248, 10249, 7,
-	82, 2, 2, 10249, 10250, 7, 88, 2, 2, 10250, 10251, 7, 54, 2, 2, 10251,
-	10252, 7, 97, 2, 2, 10252, 10253, 7, 69, 2, 2, 10253, 10254, 7, 81, 2,
-	2, 10254, 10255, 7, 79, 2, 2, 10255, 10256, 7, 82, 2, 2, 10256, 10257,
-	7, 67, 2, 2, 10257, 10258, 7, 86, 2, 2, 10258, 1704, 3, 2, 2, 2, 10259,
-	10260, 7, 75, 2, 2, 10260, 10261, 7, 85, 2, 2, 10261, 10262, 7, 97, 2,
-	2, 10262, 10263, 7, 75, 2, 2, 10263, 10264, 7, 82, 2, 2, 10264, 10265,
-	7, 88, 2, 2, 10265, 10266, 7, 54, 2, 2, 10266, 10267, 7, 97, 2, 2, 10267,
-	10268, 7, 79, 2, 2, 10268, 10269, 7, 67, 2, 2, 10269, 10270, 7, 82, 2,
-	2, 10270, 10271, 7, 82, 2, 2, 10271, 10272, 7, 71, 2, 2, 10272, 10273,
-	7, 70, 2, 2, 10273, 1706, 3, 2, 2, 2, 10274, 10275, 7, 75, 2, 2, 10275,
-	10276, 7, 85, 2, 2, 10276, 10277, 7, 97, 2, 2, 10277, 10278, 7, 75, 2,
-	2, 10278, 10279, 7, 82, 2, 2, 10279, 10280, 7, 88, 2, 2, 10280, 10281,
-	7, 56, 2, 2, 10281, 1708, 3, 2, 2, 2, 10282, 10283, 7, 75, 2, 2, 10283,
-	10284, 7, 85, 2, 2, 10284, 10285, 7, 97, 2, 2, 10285, 10286, 7, 87, 2,
-	2, 10286, 10287, 7, 85, 2, 2, 10287, 10288, 7, 71, 2, 2, 10288, 10289,
-	7, 70, 2, 2, 10289, 10290, 7, 97, 2, 2, 10290, 10291, 7, 78, 2, 2, 10291,
-	10292, 7, 81, 2, 2, 10292, 10293, 7, 69, 2, 2, 10293, 10294, 7, 77, 2,
-	2, 10294, 1710, 3, 2, 2, 2, 10295, 10296, 7, 78, 2, 2, 10296, 10297, 7,
-	67, 2, 2, 10297, 10298, 7, 85, 2, 2, 10298, 10299, 7, 86, 2, 2, 10299,
-	10300, 7, 97, 2, 2, 10300, 10301, 7, 75, 2, 2, 10301, 10302, 7, 80, 2,
-	2, 10302, 10303, 7, 85, 2, 2, 10303, 10304, 7, 71, 2, 2, 10304, 10305,
-	7, 84, 2, 2, 10305, 10306, 7, 86, 2, 2, 10306, 10307, 7, 97, 2, 2, 10307,
-	10308, 7, 75, 2, 2, 10308, 10309, 7, 70, 2, 2, 10309, 1712, 3, 2, 2, 2,
-	10310, 10311, 7, 78, 2, 2, 10311, 10312, 7, 69, 2, 2, 10312, 10313, 7,
-	67, 2, 2, 10313, 10314, 7, 85, 2, 2, 10314, 10315, 7, 71, 2, 2, 10315,
-	1714, 3, 2, 2, 2, 10316, 10317, 7, 78, 2, 2, 10317, 10318, 7, 71, 2, 2,
-	10318, 10319, 7, 67, 2, 2, 10319, 10320, 7, 85, 2, 2, 10320, 10321, 7,
-	86, 2, 2, 10321, 1716, 3, 2, 2, 2, 10322, 10323, 7, 78, 2, 2, 10323, 10324,
-	7, 71, 2, 2, 10324, 10325, 7, 80, 2, 2, 10325, 10326, 7, 73, 2, 2, 10326,
-	10327, 7, 86, 2, 2, 10327, 10328, 7, 74, 2, 2, 10328, 1718, 3, 2, 2, 2,
-	10329, 10330, 7, 78, 2, 2, 10330, 10331, 7, 75, 2, 2, 10331, 10332, 7,
-	80, 2, 2, 10332, 10333, 7, 71, 2, 2, 10333, 10334, 7, 72, 2, 2, 10334,
-	10335, 7, 84, 2, 2, 10335, 10336, 7, 81, 2, 2, 10336, 10337, 7, 79, 2,
-	2, 10337, 10338, 7, 86, 2, 2, 10338, 10339, 7, 71, 2, 2, 10339, 10340,
-	7, 90, 2, 2, 10340, 10341, 7, 86, 2, 2, 10341, 1720, 3, 2, 2, 2, 10342,
-	10343, 7, 78, 2, 2, 10343, 10344, 7, 75, 2, 2, 10344, 10345, 7, 80, 2,
-	2, 10345, 10346, 7, 71, 2, 2, 10346, 10347, 7, 72, 2, 2, 10347, 10348,
-	7, 84, 2, 2, 10348, 10349, 7, 81, 2, 2, 10349, 10350, 7, 79, 2, 2, 10350,
-	10351, 7, 89, 2, 2, 10351, 10352, 7, 77, 2, 2, 10352, 10353, 7, 68, 2,
-	2, 10353, 1722, 3, 2, 2, 2, 10354, 10355, 7, 78, 2, 2, 10355, 10356, 7,
-	75, 2, 2, 10356, 10357, 7, 80, 2, 2, 10357, 10358, 7, 71, 2, 2, 10358,
-	10359, 7, 85, 2, 2, 10359, 10360, 7, 86, 2, 2, 10360, 10361, 7, 84, 2,
-	2, 10361, 10362, 7, 75, 2, 2, 10362, 10363, 7, 80, 2, 2, 10363, 10364,
-	7, 73, 2, 2, 10364, 10365, 7, 72, 2, 2, 10365, 10366, 7, 84, 2, 2, 10366,
-	10367, 7, 81, 2, 2, 10367, 10368, 7, 79, 2, 2, 10368, 10369, 7, 86, 2,
-	2, 10369, 10370, 7, 71, 2, 2, 10370, 10371, 7, 90, 2, 2, 10371, 10372,
-	7, 86, 2, 2, 10372, 1724, 3, 2, 2, 2, 10373, 10374, 7, 78, 2, 2, 10374,
-	10375, 7, 75, 2, 2, 10375, 10376, 7, 80, 2, 2, 10376, 10377, 7, 71, 2,
-	2, 10377, 10378, 7, 85, 2, 2, 10378, 10379, 7, 86, 2, 2, 10379, 10380,
-	7, 84, 2, 2, 10380, 10381, 7, 75, 2, 2, 10381, 10382, 7, 80, 2, 2, 10382,
-	10383, 7, 73, 2, 2, 10383, 10384, 7, 72, 2, 2, 10384, 10385, 7, 84, 2,
-	2, 10385, 10386, 7, 81, 2, 2, 10386, 10387, 7, 79, 2, 2, 10387, 10388,
-	7, 89, 2, 2, 10388, 10389, 7, 77, 2, 2, 10389, 10390, 7, 68, 2, 2, 10390,
-	1726, 3, 2, 2, 2, 10391, 10392, 7, 78, 2, 2, 10392, 10393, 7, 80, 2, 2,
-	10393, 1728, 3, 2, 2, 2, 10394, 10395, 7, 78, 2, 2, 10395, 10396, 7, 81,
-	2, 2, 10396, 10397, 7, 67, 2, 2, 10397, 10398, 7, 70, 2, 2, 10398, 10399,
-	7, 97, 2, 2, 10399, 10400, 7, 72, 2, 2, 10400, 10401, 7, 75, 2, 2, 10401,
-	10402, 7, 78, 2, 2, 10402, 10403, 7, 71, 2, 2, 10403, 1730, 3, 2, 2, 2,
-	10404, 10405, 7, 78, 2, 2, 10405, 10406, 7, 81, 2, 2, 10406, 10407, 7,
-	69, 2, 2, 10407, 10408, 7, 67, 2, 2, 10408, 10409, 7, 86, 2, 2, 10409,
-	10410, 7, 71, 2, 2, 10410, 1732, 3, 2, 2, 2, 10411, 10412, 7, 78, 2, 2,
-	10412, 10413, 7, 81, 2, 2, 10413, 10414, 7, 73, 2, 2, 10414, 1734, 3, 2,
-	2, 2, 10415, 10416, 7, 78, 2, 2, 10416, 10417, 7, 81, 2, 2, 10417, 10418,
-	7, 73, 2, 2, 10418, 10419, 7, 51, 2, 2, 10419, 10420, 7, 50, 2, 2, 10420,
-	1736, 3, 2, 2, 2, 10421, 10422, 7, 78, 2, 2, 10422, 10423, 7, 81, 2, 2,
-	10423, 10424, 7, 73, 2, 2, 10424, 10425, 7, 52, 2, 2, 10425, 1738, 3, 2,
-	2, 2, 10426, 10427, 7, 78, 2, 2, 10427, 10428, 7, 81, 2, 2, 10428, 10429,
-	7, 89, 2, 2, 10429, 10430, 7, 71, 2, 2, 10430, 10431, 7, 84, 2, 2, 10431,
-	1740, 3, 2, 2, 2, 10432, 10433, 7, 78, 2, 2, 10433, 10434, 7, 82, 2, 2,
-	10434, 10435, 7, 67, 2, 2, 10435, 10436, 7, 70, 2, 2, 10436, 1742, 3, 2,
-	2, 2, 10437, 10438, 7, 78, 2, 2, 10438, 10439, 7, 86, 2, 2, 10439, 10440,
-	7, 84, 2, 2, 10440, 10441, 7, 75, 2, 2, 10441, 10442, 7, 79, 2, 2, 10442,
-	1744, 3, 2, 2, 2, 10443, 10444, 7, 79, 2, 2, 10444, 10445, 7, 67, 2, 2,
-	10445, 10446, 7, 77, 2, 2, 10446, 10447, 7, 71, 2, 2, 10447, 10448, 7,
-	70, 2, 2, 10448, 10449, 7, 67, 2, 2, 10449, 10450, 7, 86, 2, 2, 10450,
-	10451, 7, 71, 2, 2, 10451, 1746, 3, 2, 2, 2, 10452, 10453, 7, 79, 2, 2,
-	10453, 10454, 7, 67, 2, 2, 10454, 10455, 7, 77, 2, 2, 10455, 10456, 7,
-	71, 2, 2, 10456, 10457, 7, 86, 2, 2, 10457, 10458, 7, 75, 2, 2, 10458,
-	10459, 7, 79, 2, 2, 10459, 10460, 7, 71, 2, 2, 10460, 1748, 3, 2, 2, 2,
-	10461, 10462, 7, 79, 2, 2, 10462, 10463, 7, 67, 2, 2, 10463, 10464, 7,
-	77, 2, 2, 10464, 10465, 7, 71, 2, 2, 10465, 10466, 7, 97, 2, 2, 10466,
-	10467, 7, 85, 2, 2, 10467, 10468, 7, 71, 2, 2, 10468, 10469, 7, 86, 2,
-	2, 10469, 1750, 3, 2, 2, 2, 10470, 10471, 7, 79, 2, 2, 10471, 10472, 7,
-	67, 2, 2, 10472, 10473, 7, 85, 2, 2, 10473, 10474, 7, 86, 2, 2, 10474,
-	10475, 7, 71, 2, 2, 10475, 10476, 7, 84, 2, 2, 10476, 10477, 7, 97, 2,
-	2, 10477, 10478, 7, 82, 2, 2, 10478, 10479, 7, 81, 2, 2, 10479, 10480,
-	7, 85, 2, 2, 10480, 10481, 7, 97, 2, 2, 10481, 10482, 7, 89, 2, 2, 10482,
-	10483, 7, 67, 2, 2, 10483, 10484, 7, 75, 2, 2, 10484, 10485, 7, 86, 2,
-	2, 10485, 1752, 3, 2, 2, 2, 10486, 10487, 7, 79, 2, 2, 10487, 10488, 7,
-	68, 2, 2, 10488, 10489, 7, 84, 2, 2, 10489, 10490, 7, 69, 2, 2, 10490,
-	10491, 7, 81, 2, 2, 10491, 10492, 7, 80, 2, 2, 10492, 10493, 7, 86, 2,
-	2, 10493, 10494, 7, 67, 2, 2, 10494, 10495, 7, 75, 2, 2, 10495, 10496,
-	7, 80, 2, 2, 10496, 10497, 7, 85, 2, 2, 10497, 1754, 3, 2, 2, 2, 10498,
-	10499, 7, 79, 2, 2, 10499, 10500, 7, 68, 2, 2, 10500, 10501, 7, 84, 2,
-	2, 10501, 10502, 7, 70, 2, 2, 10502, 10503, 7, 75, 2, 2, 10503, 10504,
-	7, 85, 2, 2, 10504, 10505, 7, 76, 2, 2, 10505, 10506, 7, 81, 2, 2, 10506,
-	10507, 7, 75, 2, 2, 10507, 10508, 7, 80, 2, 2, 10508, 10509, 7, 86, 2,
-	2, 10509, 1756, 3, 2, 2, 2, 10510, 10511, 7, 79, 2, 2, 10511, 10512, 7,
-	68, 2, 2, 10512, 10513, 7, 84, 2, 2, 10513, 10514, 7, 71, 2, 2, 10514,
-	10515, 7, 83, 2, 2, 10515, 10516, 7, 87, 2, 2, 10516, 10517, 7, 67, 2,
-	2, 10517, 10518, 7, 78, 2, 2, 10518, 1758, 3, 2, 2, 2, 10519, 10520, 7,
-	79, 2, 2, 10520, 10521, 7, 68, 2, 2, 10521, 10522, 7, 84, 2, 2, 10522,
-	10523, 7, 75, 2, 2, 10523, 10524, 7, 80, 2, 2, 10524, 10525, 7, 86, 2,
-	2, 10525, 10526, 7, 71, 2, 2, 10526, 10527, 7, 84, 2, 2, 10527, 10528,
-	7, 85, 2, 2, 10528, 10529, 7, 71, 2, 2, 10529, 10530, 7, 69, 2, 2, 10530,
-	10531, 7, 86, 2, 2, 10531, 10532, 7, 85, 2, 2, 10532, 1760, 3, 2, 2, 2,
-	10533, 10534, 7, 79, 2, 2, 10534, 10535, 7, 68, 2, 2, 10535, 10536, 7,
-	84, 2, 2, 10536, 10537, 7, 81, 2, 2, 10537, 10538, 7, 88, 2, 2, 10538,
-	10539, 7, 71, 2, 2, 10539, 10540, 7, 84, 2, 2, 10540, 10541, 7, 78, 2,
-	2, 10541, 10542, 7, 67, 2, 2, 10542, 10543, 7, 82, 2, 2, 10543, 10544,
-	7, 85, 2, 2, 10544, 1762, 3, 2, 2, 2, 10545, 10546, 7, 79, 2, 2, 10546,
-	10547, 7, 68, 2, 2, 10547, 10548, 7, 84, 2, 2, 10548, 10549, 7, 86, 2,
-	2, 10549, 10550, 7, 81, 2, 2, 10550, 10551, 7, 87, 2, 2, 10551, 10552,
-	7, 69, 2, 2, 10552, 10553, 7, 74, 2, 2, 10553, 10554, 7, 71, 2, 2, 10554,
-	10555, 7, 85, 2, 2, 10555, 1764, 3, 2, 2, 2, 10556, 10557, 7, 79, 2, 2,
-	10557, 10558, 7, 68, 2, 2, 10558, 10559, 7, 84, 2, 2, 10559, 10560, 7,
-	89, 2, 2, 10560, 10561, 7, 75, 2, 2, 10561, 10562, 7, 86, 2, 2, 10562,
-	10563, 7, 74, 2, 2, 10563, 10564, 7, 75, 2, 2, 10564, 10565, 7, 80, 2,
-	2, 10565, 1766, 3, 2, 2, 2, 10566, 10567, 7, 79, 2, 2, 10567, 10568, 7,
-	70, 2, 2, 10568, 10569, 7, 55, 2, 2, 10569, 1768, 3, 2, 2, 2, 10570, 10571,
-	7, 79, 2, 2, 10571, 10572, 7, 78, 2, 2, 10572, 10573, 7, 75, 2, 2, 10573,
-	10574, 7, 80, 2, 2, 10574, 10575, 7, 71, 2, 2, 10575, 10576, 7, 72, 2,
-	2, 10576, 10577, 7, 84, 2, 2, 10577, 10578, 7, 81, 2, 2, 10578, 10579,
-	7, 79, 2, 2, 10579, 10580, 7, 86, 2, 2, 10580, 10581, 7, 71, 2, 2, 10581,
-	10582, 7, 90, 2, 2, 10582, 10583, 7, 86, 2, 2, 10583, 1770, 3, 2, 2, 2,
-	10584, 10585, 7, 79, 2, 2, 10585, 10586, 7, 78, 2, 2, 10586, 10587, 7,
-	75, 2, 2, 10587, 10588, 7, 80, 2, 2, 10588, 10589, 7, 71, 2, 2, 10589,
-	10590, 7, 72, 2, 2, 10590, 10591, 7, 84, 2, 2, 10591, 10592, 7, 81, 2,
-	2, 10592, 10593, 7, 79, 2, 2, 10593, 10594, 7, 89, 2, 2, 10594, 10595,
-	7, 77, 2, 2, 10595, 10596, 7, 68, 2, 2, 10596, 1772, 3, 2, 2, 2, 10597,
-	10598, 7, 79, 2, 2, 10598, 10599, 7, 81, 2, 2, 10599, 10600, 7, 80, 2,
-	2, 10600, 10601, 7, 86, 2, 2, 10601, 10602, 7, 74, 2, 2, 10602, 10603,
-	7, 80, 2, 2, 10603, 10604, 7, 67, 2, 2, 10604, 10605, 7, 79, 2, 2, 10605,
-	10606, 7, 71, 2, 2, 10606, 1774, 3, 2, 2, 2, 10607, 10608, 7, 79, 2, 2,
-	10608, 10609, 7, 82, 2, 2, 10609, 10610, 7, 81, 2, 2, 10610, 10611, 7,
-	75, 2, 2, 10611, 10612, 7, 80, 2, 2, 10612, 10613, 7, 86, 2, 2, 10613,
-	10614, 7, 72, 2, 2, 10614, 10615, 7, 84, 2, 2, 10615, 10616, 7, 81, 2,
-	2, 10616, 10617, 7, 79, 2, 2, 10617, 10618, 7, 86, 2, 2, 10618, 10619,
-	7, 71, 2, 2, 10619, 10620, 7, 90, 2, 2, 10620, 10621, 7, 86, 2, 2, 10621,
-	1776, 3, 2, 2, 2, 10622, 10623, 7, 79, 2, 2, 10623, 10624, 7, 82, 2, 2,
-	10624, 10625, 7, 81, 2, 2, 10625, 10626, 7, 75, 2, 2, 10626, 10627, 7,
-	80, 2, 2, 10627, 10628, 7, 86, 2, 2, 10628, 10629, 7, 72, 2, 2, 10629,
-	10630, 7, 84, 2, 2, 10630, 10631, 7, 81, 2, 2, 10631, 10632, 7, 79, 2,
-	2, 10632, 10633, 7, 89, 2, 2, 10633, 10634, 7, 77, 2, 2, 10634, 10635,
-	7, 68, 2, 2, 10635, 1778, 3, 2, 2, 2, 10636, 10637, 7, 79, 2, 2, 10637,
-	10638, 7, 82, 2, 2, 10638, 10639, 7, 81, 2, 2, 10639, 10640, 7, 78, 2,
-	2, 10640, 10641, 7, 91, 2, 2, 10641, 10642, 7, 72, 2, 2, 10642, 10643,
-	7, 84, 2, 2, 10643, 10644, 7, 81, 2, 2, 10644, 10645, 7, 79, 2, 2, 10645,
-	10646, 7, 86, 2, 2, 10646, 10647, 7, 71, 2, 2, 10647, 10648, 7, 90, 2,
-	2, 10648, 10649, 7, 86, 2, 2, 10649, 1780, 3, 2, 2, 2, 10650, 10651, 7,
-	79, 2, 2, 10651, 10652, 7, 82, 2, 2, 10652, 10653, 7, 81, 2, 2, 10653,
-	10654, 7, 78, 2, 2, 10654, 10655, 7, 91, 2, 2, 10655, 10656, 7, 72, 2,
-	2, 10656, 10657, 7, 84, 2, 2, 10657, 10658, 7, 81, 2, 2, 10658, 10659,
-	7, 79, 2, 2, 10659, 10660, 7, 89, 2, 2, 10660, 10661, 7, 77, 2, 2, 10661,
-	10662, 7, 68, 2, 2, 10662, 1782, 3, 2, 2, 2, 10663, 10664, 7, 79, 2, 2,
-	10664, 10665, 7, 87, 2, 2, 10665, 10666, 7, 78, 2, 2, 10666, 10667, 7,
-	86, 2, 2, 10667, 10668, 7, 75, 2, 2, 10668, 10669, 7, 78, 2, 2, 10669,
-	10670, 7, 75, 2, 2, 10670, 10671, 7, 80, 2, 2, 10671, 10672, 7, 71, 2,
-	2, 10672, 10673, 7, 85, 2, 2, 10673, 10674, 7, 86, 2, 2, 10674, 10675,
-	7, 84, 2, 2, 10675, 10676, 7, 75, 2, 2, 10676, 10677, 7, 80, 2, 2, 10677,
-	10678, 7, 73, 2, 2, 10678, 10679, 7, 72, 2, 2, 10679, 10680, 7, 84, 2,
-	2, 10680, 10681, 7, 81, 2, 2, 10681, 10682, 7, 79, 2, 2, 10682, 10683,
-	7, 86, 2, 2, 10683, 10684, 7, 71, 2, 2, 10684, 10685, 7, 90, 2, 2, 10685,
-	10686, 7, 86, 2, 2, 10686, 1784, 3, 2, 2, 2, 10687, 10688, 7, 79, 2, 2,
-	10688, 10689, 7, 87, 2, 2, 10689, 10690, 7, 78, 2, 2, 10690, 10691, 7,
-	86, 2, 2, 10691, 10692, 7, 75, 2, 2, 10692, 10693, 7, 78, 2, 2, 10693,
-	10694, 7, 75, 2, 2, 10694, 10695, 7, 80, 2, 2, 10695, 10696, 7, 71, 2,
-	2, 10696, 10697, 7, 85, 2, 2, 10697, 10698, 7, 86, 2, 2, 10698, 10699,
-	7, 84, 2, 2, 10699, 10700, 7, 75, 2, 2, 10700, 10701, 7, 80, 2, 2, 10701,
-	10702, 7, 73, 2, 2, 10702, 10703, 7, 72, 2, 2, 10703, 10704, 7, 84, 2,
-	2, 10704, 10705, 7, 81, 2, 2, 10705, 10706, 7, 79, 2, 2, 10706, 10707,
-	7, 89, 2, 2, 10707, 10708, 7, 77, 2, 2, 10708, 10709, 7, 68, 2, 2, 10709,
-	1786, 3, 2, 2, 2, 10710, 10711, 7, 79, 2, 2, 10711, 10712, 7, 87, 2, 2,
-	10712, 10713, 7, 78, 2, 2, 10713, 10714, 7, 86, 2, 2, 10714, 10715, 7,
-	75, 2, 2, 10715, 10716, 7, 82, 2, 2, 10716, 10717, 7, 81, 2, 2, 10717,
-	10718, 7, 75, 2, 2, 10718, 10719, 7, 80, 2, 2, 10719, 10720, 7, 86, 2,
-	2, 10720, 10721, 7, 72, 2, 2, 10721, 10722, 7, 84, 2, 2, 10722, 10723,
-	7, 81, 2, 2, 10723, 10724, 7, 79, 2, 2, 10724, 10725, 7, 86, 2, 2, 10725,
-	10726, 7, 71, 2, 2, 10726, 10727, 7, 90, 2, 2, 10727, 10728, 7, 86, 2,
-	2, 10728, 1788, 3, 2, 2, 2, 10729, 10730, 7, 79, 2, 2, 10730, 10731, 7,
-	87, 2, 2, 10731, 10732, 7, 78, 2, 2, 10732, 10733, 7, 86, 2, 2, 10733,
-	10734, 7, 75, 2, 2, 10734, 10735, 7, 82, 2, 2, 10735, 10736, 7, 81, 2,
-	2, 10736, 10737, 7, 75, 2, 2, 10737, 10738, 7, 80, 2, 2, 10738, 10739,
-	7, 86, 2, 2, 10739, 10740, 7, 72, 2, 2, 10740, 10741, 7, 84, 2, 2, 10741,
-	10742, 7, 81, 2, 2, 10742, 10743, 7, 79, 2, 2, 10743, 10744, 7, 89, 2,
-	2, 10744, 10745, 7, 77, 2, 2, 10745, 10746, 7, 68, 2, 2, 10746, 1790, 3,
-	2, 2, 2, 10747, 10748, 7, 79, 2, 2, 10748, 10749, 7, 87, 2, 2, 10749, 10750,
-	7, 78, 2, 2, 10750, 10751, 7, 86, 2, 2, 10751, 10752, 7, 75, 2, 2, 10752,
-	10753, 7, 82, 2, 2, 10753, 10754, 7, 81, 2, 2, 10754, 10755, 7, 78, 2,
-	2, 10755, 10756, 7, 91, 2, 2, 10756, 10757, 7, 73, 2, 2, 10757, 10758,
-	7, 81, 2, 2, 10758, 10759, 7, 80, 2, 2, 10759, 10760, 7, 72, 2, 2, 10760,
-	10761, 7, 84, 2, 2, 10761, 10762, 7, 81, 2, 2, 10762, 10763, 7, 79, 2,
-	2, 10763, 10764, 7, 86, 2, 2, 10764, 10765, 7, 71, 2, 2, 10765, 10766,
-	7, 90, 2, 2, 10766, 10767, 7, 86, 2, 2, 10767, 1792, 3, 2, 2, 2, 10768,
-	10769, 7, 79, 2, 2, 10769, 10770, 7, 87, 2, 2, 10770, 10771, 7, 78, 2,
-	2, 10771, 10772, 7, 86, 2, 2, 10772, 10773, 7, 75, 2, 2, 10773, 10774,
-	7, 82, 2, 2, 10774, 10775, 7, 81, 2, 2, 10775, 10776, 7, 78, 2, 2, 10776,
-	10777, 7, 91, 2, 2, 10777, 10778, 7, 73, 2, 2, 10778, 10779, 7, 81, 2,
-	2, 10779, 10780, 7, 80, 2, 2, 10780, 10781, 7, 72, 2, 2, 10781, 10782,
-	7, 84, 2, 2, 10782, 10783, 7, 81, 2, 2, 10783, 10784, 7, 79, 2, 2, 10784,
-	10785, 7, 89, 2, 2, 10785, 10786, 7, 77, 2, 2, 10786, 10787, 7, 68, 2,
-	2, 10787, 1794, 3, 2, 2, 2, 10788, 10789, 7, 80, 2, 2, 10789, 10790, 7,
-	67, 2, 2, 10790, 10791, 7, 79, 2, 2, 10791, 10792, 7, 71, 2, 2, 10792,
-	10793, 7, 97, 2, 2, 10793, 10794, 7, 69, 2, 2, 10794, 10795, 7, 81, 2,
-	2, 10795, 10796, 7, 80, 2, 2, 10796, 10797, 7, 85, 2, 2, 10797, 10798,
-	7, 86, 2, 2, 10798, 1796, 3, 2, 2, 2, 10799, 10800, 7, 80, 2, 2, 10800,
-	10801, 7, 87, 2, 2, 10801, 10802, 7, 78, 2, 2, 10802, 10803, 7, 78, 2,
-	2, 10803, 10804, 7, 75, 2, 2, 10804, 10805, 7, 72, 2, 2, 10805, 1798, 3,
-	2, 2, 2, 10806, 10807, 7, 80, 2, 2, 10807, 10808, 7, 87, 2, 2, 10808, 10809,
-	7, 79, 2, 2, 10809, 10810, 7, 73, 2, 2, 10810, 10811, 7, 71, 2, 2, 10811,
-	10812, 7, 81, 2, 2, 10812, 10813, 7, 79, 2, 2, 10813, 10814, 7, 71, 2,
-	2, 10814, 10815, 7, 86, 2, 2, 10815, 10816, 7, 84, 2, 2, 10816, 10817,
-	7, 75, 2, 2, 10817, 10818, 7, 71, 2, 2, 10818, 10819, 7, 85, 2, 2, 10819,
-	1800, 3, 2, 2, 2, 10820, 10821, 7, 80, 2, 2, 10821, 10822, 7, 87, 2, 2,
-	10822, 10823, 7, 79, 2, 2, 10823, 10824, 7, 75, 2, 2, 10824, 10825, 7,
-	80, 2, 2, 10825, 10826, 7, 86, 2, 2, 10826, 10827, 7, 71, 2, 2, 10827,
-	10828, 7, 84, 2, 2, 10828, 10829, 7, 75, 2, 2, 10829, 10830, 7, 81, 2,
-	2, 10830, 10831, 7, 84, 2, 2, 10831, 10832, 7, 84, 2, 2, 10832, 10833,
-	7, 75, 2, 2, 10833, 10834, 7, 80, 2, 2, 10834, 10835, 7, 73, 2, 2, 10835,
-	10836, 7, 85, 2, 2, 10836, 1802, 3, 2, 2, 2, 10837, 10838, 7, 80, 2, 2,
-	10838, 10839, 7, 87, 2, 2, 10839, 10840, 7, 79, 2, 2, 10840, 10841, 7,
-	82, 2, 2, 10841, 10842, 7, 81, 2, 2, 10842, 10843, 7, 75, 2, 2, 10843,
-	10844, 7, 80, 2, 2, 10844, 10845, 7, 86, 2, 2, 10845, 10846, 7, 85, 2,
-	2, 10846, 1804, 3, 2, 2, 2, 10847, 10848, 7, 81, 2, 2, 10848, 10849, 7,
-	69, 2, 2, 10849, 10850, 7, 86, 2, 2, 10850, 1806, 3, 2, 2, 2, 10851, 10852,
-	7, 81, 2, 2, 10852, 10853, 7, 69, 2, 2, 10853, 10854, 7, 86, 2, 2, 10854,
-	10855, 7, 71, 2, 2, 10855, 10856, 7, 86, 2, 2, 10856, 10857, 7, 97, 2,
-	2, 10857, 10858, 7, 78, 2, 2, 10858, 10859, 7, 71, 2, 2, 10859, 10860,
-	7, 80, 2, 2, 10860, 10861, 7, 73, 2, 2, 10861, 10862, 7, 86, 2, 2, 10862,
-	10863, 7, 74, 2, 2, 10863, 1808, 3, 2, 2, 2, 10864, 10865, 7, 81, 2, 2,
-	10865, 10866, 7, 84, 2, 2, 10866, 10867, 7, 70, 2, 2, 10867, 1810, 3, 2,
-	2, 2, 10868, 10869, 7, 81, 2, 2, 10869, 10870, 7, 88, 2, 2, 10870, 10871,
-	7, 71, 2, 2, 10871, 10872, 7, 84, 2, 2, 10872, 10873, 7, 78, 2, 2, 10873,
-	10874, 7, 67, 2, 2, 10874, 10875, 7, 82, 2, 2, 10875, 10876, 7, 85, 2,
-	2, 10876, 1812, 3, 2, 2, 2, 10877, 10878, 7, 82, 2, 2, 10878, 10879, 7,
-	71, 2, 2, 10879, 10880, 7, 84, 2, 2, 10880, 10881, 7, 75, 2, 2, 10881,
-	10882, 7, 81, 2, 2, 10882, 10883, 7, 70, 2, 2, 10883, 10884, 7, 97, 2,
-	2, 10884, 10885, 7, 67, 2, 2, 10885, 10886, 7, 70, 2, 2, 10886, 10887,
-	7, 70, 2, 2, 10887, 1814, 3, 2, 2, 2, 10888, 10889, 7, 82, 2, 2, 10889,
-	10890, 7, 71, 2, 2, 10890, 10891, 7, 84, 2, 2, 10891, 10892, 7, 75, 2,
-	2, 10892, 10893, 7, 81, 2, 2, 10893, 10894, 7, 70, 2, 2, 10894, 10895,
-	7, 97, 2, 2, 10895, 10896, 7, 70, 2, 2, 10896, 10897, 7, 75, 2, 2, 10897,
-	10898, 7, 72, 2, 2, 10898, 10899, 7, 72, 2, 2, 10899, 1816, 3, 2, 2, 2,
-	10900, 10901, 7, 82, 2, 2, 10901, 10902, 7, 75, 2, 2, 10902, 1818, 3, 2,
-	2, 2, 10903, 10904, 7, 82, 2, 2, 10904, 10905, 7, 81, 2, 2, 10905, 10906,
-	7, 75, 2, 2, 10906, 10907, 7, 80, 2, 2, 10907, 10908, 7, 86, 2, 2, 10908,
-	10909, 7, 72, 2, 2, 10909, 10910, 7, 84, 2, 2, 10910, 10911, 7, 81, 2,
-	2, 10911, 10912, 7, 79, 2, 2, 10912, 10913, 7, 86, 2, 2, 10913, 10914,
-	7, 71, 2, 2, 10914, 10915, 7, 90, 2, 2, 10915, 10916, 7, 86, 2, 2, 10916,
-	1820, 3, 2, 2, 2, 10917, 10918, 7, 82, 2, 2, 10918, 10919, 7, 81, 2, 2,
-	10919, 10920, 7, 75, 2, 2, 10920, 10921, 7, 80, 2, 2, 10921, 10922, 7,
-	86, 2, 2, 10922, 10923, 7, 72, 2, 2, 10923, 10924, 7, 84, 2, 2, 10924,
-	10925, 7, 81, 2, 2, 10925, 10926, 7, 79, 2, 2, 10926, 10927, 7, 89, 2,
-	2, 10927, 10928, 7, 77, 2, 2, 10928, 10929, 7, 68, 2, 2, 10929, 1822, 3,
-	2, 2, 2, 10930, 10931, 7, 82, 2, 2, 10931, 10932, 7, 81, 2, 2, 10932, 10933,
-	7, 75, 2, 2, 10933, 10934, 7, 80, 2, 2, 10934, 10935, 7, 86, 2, 2, 10935,
-	10936, 7, 80, 2, 2, 10936, 1824, 3, 2, 2, 2, 10937, 10938, 7, 82, 2, 2,
-	10938, 10939, 7, 81, 2, 2, 10939, 10940, 7, 78, 2, 2, 10940, 10941, 7,
-	91, 2, 2, 10941, 10942, 7, 72, 2, 2, 10942, 10943, 7, 84, 2, 2, 10943,
-	10944, 7, 81, 2, 2, 10944, 10945, 7, 79, 2, 2, 10945, 10946, 7, 86, 2,
-	2, 10946, 10947, 7, 71, 2, 2, 10947, 10948, 7, 90, 2, 2, 10948, 10949,
-	7, 86, 2, 2, 10949, 1826, 3, 2, 2, 2, 10950, 10951, 7, 82, 2, 2, 10951,
-	10952, 7, 81, 2, 2, 10952, 10953, 7, 78, 2, 2, 10953, 10954, 7, 91, 2,
-	2, 10954, 10955, 7, 72, 2, 2, 10955, 10956, 7, 84, 2, 2, 10956, 10957,
-	7, 81, 2, 2, 10957, 10958, 7, 79, 2, 2, 10958, 10959, 7, 89, 2, 2, 10959,
-	10960, 7, 77, 2, 2, 10960, 10961, 7, 68, 2, 2, 10961, 1828, 3, 2, 2, 2,
-	10962, 10963, 7, 82, 2, 2, 10963, 10964, 7, 81, 2, 2, 10964, 10965, 7,
-	78, 2, 2, 10965, 10966, 7, 91, 2, 2, 10966, 10967, 7, 73, 2, 2, 10967,
-	10968, 7, 81, 2, 2, 10968, 10969, 7, 80, 2, 2, 10969, 10970, 7, 72, 2,
-	2, 10970, 10971, 7, 84, 2, 2, 10971, 10972, 7, 81, 2, 2, 10972, 10973,
-	7, 79, 2, 2, 10973, 10974, 7, 86, 2, 2, 10974, 10975, 7, 71, 2, 2, 10975,
-	10976, 7, 90, 2, 2, 10976, 10977, 7, 86, 2, 2, 10977, 1830, 3, 2, 2, 2,
-	10978, 10979, 7, 82, 2, 2, 10979, 10980, 7, 81, 2, 2, 10980, 10981, 7,
-	78, 2, 2, 10981, 10982, 7, 91, 2, 2, 10982, 10983, 7, 73, 2, 2, 10983,
-	10984, 7, 81, 2, 2, 10984, 10985, 7, 80, 2, 2, 10985, 10986, 7, 72, 2,
-	2, 10986, 10987, 7, 84, 2, 2, 10987, 10988, 7, 81, 2, 2, 10988, 10989,
-	7, 79, 2, 2, 10989, 10990, 7, 89, 2, 2, 10990, 10991, 7, 77, 2, 2, 10991,
-	10992, 7, 68, 2, 2, 10992, 1832, 3, 2, 2, 2, 10993, 10994, 7, 82, 2, 2,
-	10994, 10995, 7, 81, 2, 2, 10995, 10996, 7, 89, 2, 2, 10996, 1834, 3, 2,
-	2, 2, 10997, 10998, 7, 82, 2, 2, 10998, 10999, 7, 81, 2, 2, 10999, 11000,
-	7, 89, 2, 2, 11000, 11001, 7, 71, 2, 2, 11001, 11002, 7, 84, 2, 2, 11002,
-	1836, 3, 2, 2, 2, 11003, 11004, 7, 83, 2, 2, 11004, 11005, 7, 87, 2, 2,
-	11005, 11006, 7, 81, 2, 2, 11006, 11007, 7, 86, 2, 2, 11007, 11008, 7,
-	71, 2, 2, 11008, 1838, 3, 2, 2, 2, 11009, 11010, 7, 84, 2, 2, 11010, 11011,
-	7, 67, 2, 2, 11011, 11012, 7, 70, 2, 2, 11012, 11013, 7, 75, 2, 2, 11013,
-	11014, 7, 67, 2, 2, 11014, 11015, 7, 80, 2, 2, 11015, 11016, 7, 85, 2,
-	2, 11016, 1840, 3, 2, 2, 2, 11017, 11018, 7, 84, 2, 2, 11018, 11019, 7,
-	67, 2, 2, 11019, 11020, 7, 80, 2, 2, 11020, 11021, 7, 70, 2, 2, 11021,
-	1842, 3, 2, 2, 2, 11022, 11023, 7, 84, 2, 2, 11023, 11024, 7, 67, 2, 2,
-	11024, 11025, 7, 80, 2, 2, 11025, 11026, 7, 70, 2, 2, 11026, 11027, 7,
-	81, 2, 2, 11027, 11028, 7, 79, 2, 2, 11028, 11029, 7, 97, 2, 2, 11029,
-	11030, 7, 68, 2, 2, 11030, 11031, 7, 91, 2, 2, 11031, 11032, 7, 86, 2,
-	2, 11032, 11033, 7, 71, 2, 2, 11033, 11034, 7, 85, 2, 2, 11034, 1844, 3,
-	2, 2, 2, 11035, 11036, 7, 84, 2, 2, 11036, 11037, 7, 71, 2, 2, 11037, 11038,
-	7, 78, 2, 2, 11038, 11039, 7, 71, 2, 2, 11039, 11040, 7, 67, 2, 2, 11040,
-	11041, 7, 85, 2, 2, 11041, 11042, 7, 71, 2, 2, 11042, 11043, 7, 97, 2,
-	2, 11043, 11044, 7, 78, 2, 2, 11044, 11045, 7, 81, 2, 2, 11045, 11046,
-	7, 69, 2, 2, 11046, 11047, 7, 77, 2, 2, 11047, 1846, 3, 2, 2, 2, 11048,
-	11049, 7, 84, 2, 2, 11049, 11050, 7, 71, 2, 2, 11050, 11051, 7, 88, 2,
-	2, 11051, 11052, 7, 71, 2, 2, 11052, 11053, 7, 84, 2, 2, 11053, 11054,
-	7, 85, 2, 2, 11054, 11055, 7, 71, 2, 2, 11055, 1848, 3, 2, 2, 2, 11056,
-	11057, 7, 84, 2, 2, 11057, 11058, 7, 81, 2, 2, 11058, 11059, 7, 87, 2,
-	2, 11059, 11060, 7, 80, 2, 2, 11060, 11061, 7, 70, 2, 2, 11061, 1850, 3,
-	2, 2, 2, 11062, 11063, 7, 84, 2, 2, 11063, 11064, 7, 81, 2, 2, 11064, 11065,
-	7, 89, 2, 2, 11065, 11066, 7, 97, 2, 2, 11066, 11067, 7, 69, 2, 2, 11067,
-	11068, 7, 81, 2, 2, 11068, 11069, 7, 87, 2, 2, 11069, 11070, 7, 80, 2,
-	2, 11070, 11071, 7, 86, 2, 2, 11071, 1852, 3, 2, 2, 2, 11072, 11073, 7,
-	84, 2, 2, 11073, 11074, 7, 82, 2, 2, 11074, 11075, 7, 67, 2, 2, 11075,
-	11076, 7, 70, 2, 2, 11076, 1854, 3, 2, 2, 2, 11077, 11078, 7, 84, 2, 2,
-	11078, 11079, 7, 86, 2, 2, 11079, 11080, 7, 84, 2, 2, 11080, 11081, 7,
-	75, 2, 2, 11081, 11082, 7, 79, 2, 2, 11082, 1856, 3, 2, 2, 2, 11083, 11084,
-	7, 85, 2, 2, 11084, 11085, 7, 71, 2, 2, 11085, 11086, 7, 69, 2, 2, 11086,
-	11087, 7, 97, 2, 2, 11087, 11088, 7, 86, 2, 2, 11088, 11089, 7, 81, 2,
-	2, 11089, 11090, 7, 97, 2, 2, 11090, 11091, 7, 86, 2, 2, 11091, 11092,
-	7, 75, 2, 2, 11092, 11093, 7, 79, 2, 2, 11093, 11094, 7, 71, 2, 2, 11094,
-	1858, 3, 2, 2, 2, 11095, 11096, 7, 85, 2, 2, 11096, 11097, 7, 71, 2, 2,
-	11097, 11098, 7, 85, 2, 2, 11098, 11099, 7, 85, 2, 2, 11099, 11100, 7,
-	75, 2, 2, 11100, 11101, 7, 81, 2, 2, 11101, 11102, 7, 80, 2, 2, 11102,
-	11103, 7, 97, 2, 2, 11103, 11104, 7, 87, 2, 2, 11104, 11105, 7, 85, 2,
-	2, 11105, 11106, 7, 71, 2, 2, 11106, 11107, 7, 84, 2, 2, 11107, 1860, 3,
-	2, 2, 2, 11108, 11109, 7, 85, 2, 2, 11109, 11110, 7, 74, 2, 2, 11110, 11111,
-	7, 67, 2, 2, 11111, 1862, 3, 2, 2, 2, 11112, 11113, 7, 85, 2, 2, 11113,
-	11114, 7, 74, 2, 2, 11114, 11115, 7, 67, 2, 2, 11115, 11116, 7, 51, 2,
-	2, 11116, 1864, 3, 2, 2, 2, 11117, 11118, 7, 85, 2, 2, 11118, 11119, 7,
-	74, 2, 2, 11119, 11120, 7, 67, 2, 2, 11120, 11121, 7, 52, 2, 2, 11121,
-	1866, 3, 2, 2, 2, 11122, 11123, 7, 85, 2, 2, 11123, 11124, 7, 69, 2, 2,
-	11124, 11125, 7, 74, 2, 2, 11125, 11126, 7, 71, 2, 2, 11126, 11127, 7,
-	79, 2, 2, 11127, 11128, 7, 67, 2, 2, 11128, 11129, 7, 97, 2, 2, 11129,
-	11130, 7, 80, 2, 2, 11130, 11131, 7, 67, 2, 2, 11131, 11132, 7, 79, 2,
-	2, 11132, 11133, 7, 71, 2, 2, 11133, 1868, 3, 2, 2, 2, 11134, 11135, 7,
-	85, 2, 2, 11135, 11136, 7, 75, 2, 2, 11136, 11137, 7, 73, 2, 2, 11137,
-	11138, 7, 80, 2, 2, 11138, 1870, 3, 2, 2, 2, 11139, 11140, 7, 85, 2, 2,
-	11140, 11141, 7, 75, 2, 2, 11141, 11142, 7, 80, 2, 2, 11142, 1872, 3, 2,
-	2, 2, 11143, 11144, 7, 85, 2, 2, 11144, 11145, 7, 78, 2, 2, 11145, 11146,
-	7, 71, 2, 2, 11146, 11147, 7, 71, 2, 2, 11147, 11148, 7, 82, 2, 2, 11148,
-	1874, 3, 2, 2, 2, 11149, 11150, 7, 85, 2, 2, 11150, 11151, 7, 81, 2, 2,
-	11151, 11152, 7, 87, 2, 2, 11152, 11153, 7, 80, 2, 2, 11153, 11154, 7,
-	70, 2, 2, 11154, 11155, 7, 71, 2, 2, 11155, 11156, 7, 90, 2, 2, 11156,
-	1876, 3, 2, 2, 2, 11157, 11158, 7, 85, 2, 2, 11158, 11159, 7, 83, 2, 2,
-	11159, 11160, 7, 78, 2, 2, 11160, 11161, 7, 97, 2, 2, 11161, 11162, 7,
-	86, 2, 2, 11162, 11163, 7, 74, 2, 2, 11163, 11164, 7, 84, 2, 2, 11164,
-	11165, 7, 71, 2, 2, 11165, 11166, 7, 67, 2, 2, 11166, 11167, 7, 70, 2,
-	2, 11167, 11168, 7, 97, 2, 2, 11168, 11169, 7, 89, 2, 2, 11169, 11170,
-	7, 67, 2, 2, 11170, 11171, 7, 75, 2, 2, 11171, 11172, 7, 86, 2, 2, 11172,
-	11173, 7, 97, 2, 2, 11173, 11174, 7, 67, 2, 2, 11174, 11175, 7, 72, 2,
-	2, 11175, 11176, 7, 86, 2, 2, 11176, 11177, 7, 71, 2, 2, 11177, 11178,
-	7, 84, 2, 2, 11178, 11179, 7, 97, 2, 2, 11179, 11180, 7, 73, 2, 2, 11180,
-	11181, 7, 86, 2, 2, 11181, 11182, 7, 75, 2, 2, 11182, 11183, 7, 70, 2,
-	2, 11183, 11184, 7, 85, 2, 2, 11184, 1878, 3, 2, 2, 2, 11185, 11186, 7,
-	85, 2, 2, 11186, 11187, 7, 83, 2, 2, 11187, 11188, 7, 84, 2, 2, 11188,
-	11189, 7, 86, 2, 2, 11189, 1880, 3, 2, 2, 2, 11190, 11191, 7, 85, 2, 2,
-	11191, 11192, 7, 84, 2, 2, 11192, 11193, 7, 75, 2, 2, 11193, 11194, 7,
-	70, 2, 2, 11194, 1882, 3, 2, 2, 2, 11195, 11196, 7, 85, 2, 2, 11196, 11197,
-	7, 86, 2, 2, 11197, 11198, 7, 67, 2, 2, 11198, 11199, 7, 84, 2, 2, 11199,
-	11200, 7, 86, 2, 2, 11200, 11201, 7, 82, 2, 2, 11201, 11202, 7, 81, 2,
-	2, 11202, 11203, 7, 75, 2, 2, 11203, 11204, 7, 80, 2, 2, 11204, 11205,
-	7, 86, 2, 2, 11205, 1884, 3, 2, 2, 2, 11206, 11207, 7, 85, 2, 2, 11207,
-	11208, 7, 86, 2, 2, 11208, 11209, 7, 84, 2, 2, 11209, 11210, 7, 69, 2,
-	2, 11210, 11211, 7, 79, 2, 2, 11211, 11212, 7, 82, 2, 2, 11212, 1886, 3,
-	2, 2, 2, 11213, 11214, 7, 85, 2, 2, 11214, 11215, 7, 86, 2, 2, 11215, 11216,
-	7, 84, 2, 2, 11216, 11217, 7, 97, 2, 2, 11217, 11218, 7, 86, 2, 2, 11218,
-	11219, 7, 81, 2, 2, 11219, 11220, 7, 97, 2, 2, 11220, 11221, 7, 70, 2,
-	2, 11221, 11222, 7, 67, 2, 2, 11222, 11223, 7, 86, 2, 2, 11223, 11224,
-	7, 71, 2, 2, 11224, 1888, 3, 2, 2, 2, 11225, 11226, 7, 85, 2, 2, 11226,
-	11227, 7, 86, 2, 2, 11227, 11228, 7, 97, 2, 2, 11228, 11229, 7, 67, 2,
-	2, 11229, 11230, 7, 84, 2, 2, 11230, 11231, 7, 71, 2, 2, 11231, 11232,
-	7, 67, 2, 2, 11232, 1890, 3, 2, 2, 2, 11233, 11234, 7, 85, 2, 2, 11234,
-	11235, 7, 86, 2, 2, 11235, 11236, 7, 97, 2, 2, 11236, 11237, 7, 67, 2,
-	2, 11237, 11238, 7, 85, 2, 2, 11238, 11239, 7, 68, 2, 2, 11239, 11240,
-	7, 75, 2, 2, 11240, 11241, 7, 80, 2, 2, 11241, 11242, 7, 67, 2, 2, 11242,
-	11243, 7, 84, 2, 2, 11243, 11244, 7, 91, 2, 2, 11244, 1892, 3, 2, 2, 2,
-	11245, 11246, 7, 85, 2, 2, 11246, 11247, 7, 86, 2, 2, 11247, 11248, 7,
-	97, 2, 2, 11248, 11249, 7, 67, 2, 2, 11249, 11250, 7, 85, 2, 2, 11250,
-	11251, 7, 86, 2, 2, 11251, 11252, 7, 71, 2, 2, 11252, 11253, 7, 90, 2,
-	2, 11253, 11254, 7, 86, 2, 2, 11254, 1894, 3, 2, 2, 2, 11255, 11256, 7,
-	85, 2, 2, 11256, 11257, 7, 86, 2, 2, 11257, 11258, 7, 97, 2, 2, 11258,
-	11259, 7, 67, 2, 2, 11259, 11260, 7, 85, 2, 2, 11260, 11261, 7, 89, 2,
-	2, 11261, 11262, 7, 77, 2, 2, 11262, 11263, 7, 68, 2, 2, 11263, 1896, 3,
-	2, 2, 2, 11264, 11265, 7, 85, 2, 2, 11265, 11266, 7, 86, 2, 2, 11266, 11267,
-	7, 97, 2, 2, 11267, 11268, 7, 67, 2, 2, 11268, 11269, 7, 85, 2, 2, 11269,
-	11270, 7, 89, 2, 2, 11270, 11271, 7, 77, 2, 2, 11271, 11272, 7, 86, 2,
-	2, 11272, 1898, 3, 2, 2, 2, 11273, 11274, 7, 85, 2, 2, 11274, 11275, 7,
-	86, 2, 2, 11275, 11276, 7, 97, 2, 2, 11276, 11277, 7, 68, 2, 2, 11277,
-	11278, 7, 87, 2, 2, 11278, 11279, 7, 72, 2, 2, 11279, 11280, 7, 72, 2,
-	2, 11280, 11281, 7, 71, 2, 2, 11281, 11282, 7, 84, 2, 2, 11282, 1900, 3,
-	2, 2, 2, 11283, 11284, 7, 85, 2, 2, 11284, 11285, 7, 86, 2, 2, 11285, 11286,
-	7, 97, 2, 2, 11286, 11287, 7, 69, 2, 2, 11287, 11288, 7, 71, 2, 2, 11288,
-	11289, 7, 80, 2, 2, 11289, 11290, 7, 86, 2, 2, 11290, 11291, 7, 84, 2,
-	2, 11291, 11292, 7, 81, 2, 2, 11292, 11293, 7, 75, 2, 2, 11293, 11294,
-	7, 70, 2, 2, 11294, 1902, 3, 2, 2, 2, 11295, 11296, 7, 85, 2, 2, 11296,
-	11297, 7, 86, 2, 2, 11297, 11298, 7, 97, 2, 2, 11298, 11299, 7, 69, 2,
-	2, 11299, 11300, 7, 81, 2, 2, 11300, 11301, 7, 80, 2, 2, 11301, 11302,
-	7, 86, 2, 2, 11302, 11303, 7, 67, 2, 2, 11303, 11304, 7, 75, 2, 2, 11304,
-	11305, 7, 80, 2, 2, 11305, 11306, 7, 85, 2, 2, 11306, 1904, 3, 2, 2, 2,
-	11307, 11308, 7, 85, 2, 2, 11308, 11309, 7, 86, 2, 2, 11309, 11310, 7,
-	97, 2, 2, 11310, 11311, 7, 69, 2, 2, 11311, 11312, 7, 84, 2, 2, 11312,
-	11313, 7, 81, 2, 2, 11313, 11314, 7, 85, 2, 2, 11314, 11315, 7, 85, 2,
-	2, 11315, 11316, 7, 71, 2, 2, 11316, 11317, 7, 85, 2, 2, 11317, 1906, 3,
-	2, 2, 2, 11318, 11319, 7, 85, 2, 2, 11319, 11320, 7, 86, 2, 2, 11320, 11321,
-	7, 97, 2, 2, 11321, 11322, 7, 70, 2, 2, 11322, 11323, 7, 75, 2, 2, 11323,
-	11324, 7, 72, 2, 2, 11324, 11325, 7, 72, 2, 2, 11325, 11326, 7, 71, 2,
-	2, 11326, 11327, 7, 84, 2, 2, 11327, 11328, 7, 71, 2, 2, 11328, 11329,
-	7, 80, 2, 2, 11329, 11330, 7, 69, 2, 2, 11330, 11331, 7, 71, 2, 2, 11331,
-	1908, 3, 2, 2, 2, 11332, 11333, 7, 85, 2, 2, 11333, 11334, 7, 86, 2, 2,
-	11334, 11335, 7, 97, 2, 2, 11335, 11336, 7, 70, 2, 2, 11336, 11337, 7,
-	75, 2, 2, 11337, 11338, 7, 79, 2, 2, 11338, 11339, 7, 71, 2, 2, 11339,
-	11340, 7, 80, 2, 2, 11340, 11341, 7, 85, 2, 2, 11341, 11342, 7, 75, 2,
-	2, 11342, 11343, 7, 81, 2, 2, 11343, 11344, 7, 80, 2, 2, 11344, 1910, 3,
-	2, 2, 2, 11345, 11346, 7, 85, 2, 2, 11346, 11347, 7, 86, 2, 2, 11347, 11348,
-	7, 97, 2, 2, 11348, 11349, 7, 70, 2, 2, 11349, 11350, 7, 75, 2, 2, 11350,
-	11351, 7, 85, 2, 2, 11351, 11352, 7, 76, 2, 2, 11352, 11353, 7, 81, 2,
-	2, 11353, 11354, 7, 75, 2, 2, 11354, 11355, 7, 80, 2, 2, 11355, 11356,
-	7, 86, 2, 2, 11356, 1912, 3, 2, 2, 2, 11357, 11358, 7, 85, 2, 2, 11358,
-	11359, 7, 86, 2, 2, 11359, 11360, 7, 97, 2, 2, 11360, 11361, 7, 70, 2,
-	2, 11361, 11362, 7, 75, 2, 2, 11362, 11363, 7, 85, 2, 2, 11363, 11364,
-	7, 86, 2, 2, 11364, 11365, 7, 67, 2, 2, 11365, 11366, 7, 80, 2, 2, 11366,
-	11367, 7, 69, 2, 2, 11367, 11368, 7, 71, 2, 2, 11368, 1914, 3, 2, 2, 2,
-	11369, 11370, 7, 85, 2, 2, 11370, 11371, 7, 86, 2, 2, 11371, 11372, 7,
-	97, 2, 2, 11372, 11373, 7, 71, 2, 2, 11373, 11374, 7, 80, 2, 2, 11374,
-	11375, 7, 70, 2, 2, 11375, 11376, 7, 82, 2, 2, 11376, 11377, 7, 81, 2,
-	2, 11377, 11378, 7, 75, 2, 2, 11378, 11379, 7, 80, 2, 2, 11379, 11380,
-	7, 86, 2, 2, 11380, 1916, 3, 2, 2, 2, 11381, 11382, 7, 85, 2, 2, 11382,
-	11383, 7, 86, 2, 2, 11383, 11384, 7, 97, 2, 2, 11384, 11385, 7, 71, 2,
-	2, 11385, 11386, 7, 80, 2, 2, 11386, 11387, 7, 88, 2, 2, 11387, 11388,
-	7, 71, 2, 2, 11388, 11389, 7, 78, 2, 2, 11389, 11390, 7, 81, 2, 2, 11390,
-	11391, 7, 82, 2, 2, 11391, 11392, 7, 71, 2, 2, 11392, 1918, 3, 2, 2, 2,
-	11393, 11394, 7, 85, 2, 2, 11394, 11395, 7, 86, 2, 2, 11395, 11396, 7,
-	97, 2, 2, 11396, 11397, 7, 71, 2, 2, 11397, 11398, 7, 83, 2, 2, 11398,
-	11399, 7, 87, 2, 2, 11399, 11400, 7, 67, 2, 2, 11400, 11401, 7, 78, 2,
-	2, 11401, 11402, 7, 85, 2, 2, 11402, 1920, 3, 2, 2, 2, 11403, 11404, 7,
-	85, 2, 2, 11404, 11405, 7, 86, 2, 2, 11405, 11406, 7, 97, 2, 2, 11406,
-	11407, 7, 71, 2, 2, 11407, 11408, 7, 90, 2, 2, 11408, 11409, 7, 86, 2,
-	2, 11409, 11410, 7, 71, 2, 2, 11410, 11411, 7, 84, 2, 2, 11411, 11412,
-	7, 75, 2, 2, 11412, 11413, 7, 81, 2, 2, 11413, 11414, 7, 84, 2, 2, 11414,
-	11415, 7, 84, 2, 2, 11415, 11416, 7, 75, 2, 2, 11416, 11417, 7, 80, 2,
-	2, 11417, 11418, 7, 73, 2, 2, 11418, 1922, 3, 2, 2, 2, 11419, 11420, 7,
-	85, 2, 2, 11420, 11421, 7, 86, 2, 2, 11421, 11422, 7, 97, 2, 2, 11422,
-	11423, 7, 73, 2, 2, 11423, 11424, 7, 71, 2, 2, 11424, 11425, 7, 81, 2,
-	2, 11425, 11426, 7, 79, 2, 2, 11426, 11427, 7, 69, 2, 2, 11427, 11428,
-	7, 81, 2, 2, 11428, 11429, 7, 78, 2, 2, 11429, 11430, 7, 78, 2, 2, 11430,
-	11431, 7, 72, 2, 2, 11431, 11432, 7, 84, 2, 2, 11432, 11433, 7, 81, 2,
-	2, 11433, 11434, 7, 79, 2, 2, 11434, 11435, 7, 86, 2, 2, 11435, 11436,
-	7, 71, 2, 2, 11436, 11437, 7, 90, 2, 2, 11437, 11438, 7, 86, 2, 2, 11438,
-	1924, 3, 2, 2, 2, 11439, 11440, 7, 85, 2, 2, 11440, 11441, 7, 86, 2, 2,
-	11441, 11442, 7, 97, 2, 2, 11442, 11443, 7, 73, 2, 2, 11443, 11444, 7,
-	71, 2, 2, 11444, 11445, 7, 81, 2, 2, 11445, 11446, 7, 79, 2, 2, 11446,
-	11447, 7, 69, 2, 2, 11447, 11448, 7, 81, 2, 2, 11448, 11449, 7, 78, 2,
-	2, 11449, 11450, 7, 78, 2, 2, 11450, 11451, 7, 72, 2, 2, 11451, 11452,
-	7, 84, 2, 2, 11452, 11453, 7, 81, 2, 2, 11453, 11454, 7, 79, 2, 2, 11454,
-	11455, 7, 86, 2, 2, 11455, 11456, 7, 90, 2, 2, 11456, 11457, 7, 86, 2,
-	2, 11457, 1926, 3, 2, 2, 2, 11458, 11459, 7, 85, 2, 2, 11459, 11460, 7,
-	86, 2, 2, 11460, 11461, 7, 97, 2, 2, 11461, 11462, 7, 73, 2, 2, 11462,
-	11463, 7, 71, 2, 2, 11463, 11464, 7, 81, 2, 2, 11464, 11465, 7, 79, 2,
-	2, 11465, 11466, 7, 69, 2, 2, 11466, 11467, 7, 81, 2, 2, 11467, 11468,
-	7, 78, 2, 2, 11468, 11469, 7, 78, 2, 2, 11469, 11470, 7, 72, 2, 2, 11470,
-	11471, 7, 84, 2, 2, 11471, 11472, 7, 81, 2, 2, 11472, 11473, 7, 79, 2,
-	2, 11473, 11474, 7, 89, 2, 2, 11474, 11475, 7, 77, 2, 2, 11475, 11476,
-	7, 68, 2, 2, 11476, 1928, 3, 2, 2, 2, 11477, 11478, 7, 85, 2, 2, 11478,
-	11479, 7, 86, 2, 2, 11479, 11480, 7, 97, 2, 2, 11480, 11481, 7, 73, 2,
-	2, 11481, 11482, 7, 71, 2, 2, 11482, 11483, 7, 81, 2, 2, 11483, 11484,
-	7, 79, 2, 2, 11484, 11485, 7, 71, 2, 2, 11485, 11486, 7, 86, 2, 2, 11486,
-	11487, 7, 84, 2, 2, 11487, 11488, 7, 91, 2, 2, 11488, 11489, 7, 69, 2,
-	2, 11489, 11490, 7, 81, 2, 2, 11490, 11491, 7, 78, 2, 2, 11491, 11492,
-	7, 78, 2, 2, 11492, 11493, 7, 71, 2, 2, 11493, 11494, 7, 69, 2, 2, 11494,
-	11495, 7, 86, 2, 2, 11495, 11496, 7, 75, 2, 2, 11496, 11497, 7, 81, 2,
-	2, 11497, 11498, 7, 80, 2, 2, 11498, 11499, 7, 72, 2, 2, 11499, 11500,
-	7, 84, 2, 2, 11500, 11501, 7, 81, 2, 2, 11501, 11502, 7, 79, 2, 2, 11502,
-	11503, 7, 86, 2, 2, 11503, 11504, 7, 71, 2, 2, 11504, 11505, 7, 90, 2,
-	2, 11505, 11506, 7, 86, 2, 2, 11506, 1930, 3, 2, 2, 2, 11507, 11508, 7,
-	85, 2, 2, 11508, 11509, 7, 86, 2, 2, 11509, 11510, 7, 97, 2, 2, 11510,
-	11511, 7, 73, 2, 2, 11511, 11512, 7, 71, 2, 2, 11512, 11513, 7, 81, 2,
-	2, 11513, 11514, 7, 79, 2, 2, 11514, 11515, 7, 71, 2, 2, 11515, 11516,
-	7, 86, 2, 2, 11516, 11517, 7, 84, 2, 2, 11517, 11518, 7, 91, 2, 2, 11518,
-	11519, 7, 69, 2, 2, 11519, 11520, 7, 81, 2, 2, 11520, 11521, 7, 78, 2,
-	2, 11521, 11522, 7, 78, 2, 2, 11522, 11523, 7, 71, 2, 2, 11523, 11524,
-	7, 69, 2, 2, 11524, 11525, 7, 86, 2, 2, 11525, 11526, 7, 75, 2, 2, 11526,
-	11527, 7, 81, 2, 2, 11527, 11528, 7, 80, 2, 2, 11528, 11529, 7, 72, 2,
-	2, 11529, 11530, 7, 84, 2, 2, 11530, 11531, 7, 81, 2, 2, 11531, 11532,
-	7, 79, 2, 2, 11532, 11533, 7, 89, 2, 2, 11533, 11534, 7, 77, 2, 2, 11534,
-	11535, 7, 68, 2, 2, 11535, 1932, 3, 2, 2, 2, 11536, 11537, 7, 85, 2, 2,
-	11537, 11538, 7, 86, 2, 2, 11538, 11539, 7, 97, 2, 2, 11539, 11540, 7,
-	73, 2, 2, 11540, 11541, 7, 71, 2, 2, 11541, 11542, 7, 81, 2, 2, 11542,
-	11543, 7, 79, 2, 2, 11543, 11544, 7, 71, 2, 2, 11544, 11545, 7, 86, 2,
-	2, 11545, 11546, 7, 84, 2, 2, 11546, 11547, 7, 91, 2, 2, 11547, 11548,
-	7, 72, 2, 2, 11548, 11549, 7, 84, 2, 2, 11549, 11550, 7, 81, 2, 2, 11550,
-	11551, 7, 79, 2, 2, 11551, 11552, 7, 86, 2, 2, 11552, 11553, 7, 71, 2,
-	2, 11553, 11554, 7, 90, 2, 2, 11554, 11555, 7, 86, 2, 2, 11555, 1934, 3,
-	2, 2, 2, 11556, 11557, 7, 85, 2, 2, 11557, 11558, 7, 86, 2, 2, 11558, 11559,
-	7, 97, 2, 2, 11559, 11560, 7, 73, 2, 2, 11560, 11561, 7, 71, 2, 2, 11561,
-	11562, 7, 81, 2, 2, 11562, 11563, 7, 79, 2, 2, 11563, 11564, 7, 71, 2,
-	2, 11564, 11565, 7, 86, 2, 2, 11565, 11566, 7, 84, 2, 2, 11566, 11567,
-	7, 91, 2, 2, 11567, 11568, 7, 72, 2, 2, 11568, 11569, 7, 84, 2, 2, 11569,
-	11570, 7, 81, 2, 2, 11570, 11571, 7, 79, 2, 2, 11571, 11572, 7, 89, 2,
-	2, 11572, 11573, 7, 77, 2, 2, 11573, 11574, 7, 68, 2, 2, 11574, 1936, 3,
-	2, 2, 2, 11575, 11576, 7, 85, 2, 2, 11576, 11577, 7, 86, 2, 2, 11577, 11578,
-	7, 97, 2, 2, 11578, 11579, 7, 73, 2, 2, 11579, 11580, 7, 71, 2, 2, 11580,
-	11581, 7, 81, 2, 2, 11581, 11582, 7, 79, 2, 2, 11582, 11583, 7, 71, 2,
-	2, 11583, 11584, 7, 86, 2, 2, 11584, 11585, 7, 84, 2, 2, 11585, 11586,
-	7, 91, 2, 2, 11586, 11587, 7, 80, 2, 2, 11587, 1938, 3, 2, 2, 2, 11588,
-	11589, 7, 85, 2, 2, 11589, 11590, 7, 86, 2, 2, 11590, 11591, 7, 97, 2,
-	2, 11591, 11592, 7, 73, 2, 2, 11592, 11593, 7, 71, 2, 2, 11593, 11594,
-	7, 81, 2, 2, 11594, 11595, 7, 79, 2, 2, 11595, 11596, 7, 71, 2, 2, 11596,
-	11597, 7, 86, 2, 2, 11597, 11598, 7, 84, 2, 2, 11598, 11599, 7, 91, 2,
-	2, 11599, 11600, 7, 86, 2, 2, 11600, 11601, 7, 91, 2, 2, 11601, 11602,
-	7, 82, 2, 2, 11602, 11603, 7, 71, 2, 2, 11603, 1940, 3, 2, 2, 2, 11604,
-	11605, 7, 85, 2, 2, 11605, 11606, 7, 86, 2, 2, 11606, 11607, 7, 97, 2,
-	2, 11607, 11608, 7, 73, 2, 2, 11608, 11609, 7, 71, 2, 2, 11609, 11610,
-	7, 81, 2, 2, 11610, 11611, 7, 79, 2, 2, 11611, 11612, 7, 72, 2, 2, 11612,
-	11613, 7, 84, 2, 2, 11613, 11614, 7, 81, 2, 2, 11614, 11615, 7, 79, 2,
-	2, 11615, 11616, 7, 86, 2, 2, 11616, 11617, 7, 71, 2, 2, 11617, 11618,
-	7, 90, 2, 2, 11618, 11619, 7, 86, 2, 2, 11619, 1942, 3, 2, 2, 2, 11620,
-	11621, 7, 85, 2, 2, 11621, 11622, 7, 86, 2, 2, 11622, 11623, 7, 97, 2,
-	2, 11623, 11624, 7, 73, 2, 2, 11624, 11625, 7, 71, 2, 2, 11625, 11626,
-	7, 81, 2, 2, 11626, 11627, 7, 79, 2, 2, 11627, 11628, 7, 72, 2, 2, 11628,
-	11629, 7, 84, 2, 2, 11629, 11630, 7, 81, 2, 2, 11630, 11631, 7, 79, 2,
-	2, 11631, 11632, 7, 89, 2, 2, 11632, 11633, 7, 77, 2, 2, 11633, 11634,
-	7, 68, 2, 2, 11634, 1944, 3, 2, 2, 2, 11635, 11636, 7, 85, 2, 2, 11636,
-	11637, 7, 86, 2, 2, 11637, 11638, 7, 97, 2, 2, 11638, 11639, 7, 75, 2,
-	2, 11639, 11640, 7, 80, 2, 2, 11640, 11641, 7, 86, 2, 2, 11641, 11642,
-	7, 71, 2, 2, 11642, 11643, 7, 84, 2, 2, 11643, 11644, 7, 75, 2, 2, 11644,
-	11645, 7, 81, 2, 2, 11645, 11646, 7, 84, 2, 2, 11646, 11647, 7, 84, 2,
-	2, 11647, 11648, 7, 75, 2, 2, 11648, 11649, 7, 80, 2, 2, 11649, 11650,
-	7, 73, 2, 2, 11650, 11651, 7, 80, 2, 2, 11651, 1946, 3, 2, 2, 2, 11652,
-	11653, 7, 85, 2, 2, 11653, 11654, 7, 86, 2, 2, 11654, 11655, 7, 97, 2,
-	2, 11655, 11656, 7, 75, 2, 2, 11656, 11657, 7, 80, 2, 2, 11657, 11658,
-	7, 86, 2, 2, 11658, 11659, 7, 71, 2, 2, 11659, 11660, 7, 84, 2, 2, 11660,
-	11661, 7, 85, 2, 2, 11661, 11662, 7, 71, 2, 2, 11662, 11663, 7, 69, 2,
-	2, 11663, 11664, 7, 86, 2, 2, 11664, 11665, 7, 75, 2, 2, 11665, 11666,
-	7, 81, 2, 2, 11666, 11667, 7, 80, 2, 2, 11667, 1948, 3, 2, 2, 2, 11668,
-	11669, 7, 85, 2, 2, 11669, 11670, 7, 86, 2, 2, 11670, 11671, 7, 97, 2,
-	2, 11671, 11672, 7, 75, 2, 2, 11672, 11673, 7, 80, 2, 2, 11673, 11674,
-	7, 86, 2, 2, 11674, 11675, 7, 71, 2, 2, 11675, 11676, 7, 84, 2, 2, 11676,
-	11677, 7, 85, 2, 2, 11677, 11678, 7, 71, 2, 2, 11678, 11679, 7, 69, 2,
-	2, 11679, 11680, 7, 86, 2, 2, 11680, 11681, 7, 85, 2, 2, 11681, 1950, 3,
-	2, 2, 2, 11682, 11683, 7, 85, 2, 2, 11683, 11684, 7, 86, 2, 2, 11684, 11685,
-	7, 97, 2, 2, 11685, 11686, 7, 75, 2, 2, 11686, 11687, 7, 85, 2, 2, 11687,
-	11688, 7, 69, 2, 2, 11688, 11689, 7, 78, 2, 2, 11689, 11690, 7, 81, 2,
-	2, 11690, 11691, 7, 85, 2, 2, 11691, 11692, 7, 71, 2, 2, 11692, 11693,
-	7, 70, 2, 2, 11693, 1952, 3, 2, 2, 2, 11694, 11695, 7, 85, 2, 2, 11695,
-	11696, 7, 86, 2, 2, 11696, 11697, 7, 97, 2, 2, 11697, 11698, 7, 75, 2,
-	2, 11698, 11699, 7, 85, 2, 2, 11699, 11700, 7, 71, 2, 2, 11700, 11701,
-	7, 79, 2, 2, 11701, 11702, 7, 82, 2, 2, 11702, 11703, 7, 86, 2, 2, 11703,
-	11704, 7, 91, 2, 2, 11704, 1954, 3, 2, 2, 2, 11705, 11706, 7, 85, 2, 2,
-	11706, 11707, 7, 86, 2, 2, 11707, 11708, 7, 97, 2, 2, 11708, 11709, 7,
-	75, 2, 2, 11709, 11710, 7, 85, 2, 2, 11710, 11711, 7, 85, 2, 2, 11711,
-	11712, 7, 75, 2, 2, 11712, 11713, 7, 79, 2, 2, 11713, 11714, 7, 82, 2,
-	2, 11714, 11715, 7, 78, 2, 2, 11715, 11716, 7, 71, 2, 2, 11716, 1956, 3,
-	2, 2, 2, 11717, 11718, 7, 85, 2, 2, 11718, 11719, 7, 86, 2, 2, 11719, 11720,
-	7, 97, 2, 2, 11720, 11721, 7, 78, 2, 2, 11721, 11722, 7, 75, 2, 2, 11722,
-	11723, 7, 80, 2, 2, 11723, 11724, 7, 71, 2, 2, 11724, 11725, 7, 72, 2,
-	2, 11725, 11726, 7, 84, 2, 2, 11726, 11727, 7, 81, 2, 2, 11727, 11728,
-	7, 79, 2, 2, 11728, 11729, 7, 86, 2, 2, 11729, 11730, 7, 71, 2, 2, 11730,
-	11731, 7, 90, 2, 2, 11731, 11732, 7, 86, 2, 2, 11732, 1958, 3, 2, 2, 2,
-	11733, 11734, 7, 85, 2, 2, 11734, 11735, 7, 86, 2, 2, 11735, 11736, 7,
-	97, 2, 2, 11736, 11737, 7, 78, 2, 2, 11737, 11738, 7, 75, 2, 2, 11738,
-	11739, 7, 80, 2, 2, 11739, 11740, 7, 71, 2, 2, 11740, 11741, 7, 72, 2,
-	2, 11741, 11742, 7, 84, 2, 2, 11742, 11743, 7, 81, 2, 2, 11743, 11744,
-	7, 79, 2, 2, 11744, 11745, 7, 89, 2, 2, 11745, 11746, 7, 77, 2, 2, 11746,
-	11747, 7, 68, 2, 2, 11747, 1960, 3, 2, 2, 2, 11748, 11749, 7, 85, 2, 2,
-	11749, 11750, 7, 86, 2, 2, 11750, 11751, 7, 97, 2, 2, 11751, 11752, 7,
-	78, 2, 2, 11752, 11753, 7, 75, 2, 2, 11753, 11754, 7, 80, 2, 2, 11754,
-	11755, 7, 71, 2, 2, 11755, 11756, 7, 85, 2, 2, 11756, 11757, 7, 86, 2,
-	2, 11757, 11758, 7, 84, 2, 2, 11758, 11759, 7, 75, 2, 2, 11759, 11760,
-	7, 80, 2, 2, 11760, 11761, 7, 73, 2, 2, 11761, 11762, 7, 72, 2, 2, 11762,
-	11763, 7, 84, 2, 2, 11763, 11764, 7, 81, 2, 2, 11764, 11765, 7, 79, 2,
-	2, 11765, 11766, 7, 86, 2, 2, 11766, 11767, 7, 71, 2, 2, 11767, 11768,
-	7, 90, 2, 2, 11768, 11769, 7, 86, 2, 2, 11769, 1962, 3, 2, 2, 2, 11770,
-	11771, 7, 85, 2, 2, 11771, 11772, 7, 86, 2, 2, 11772, 11773, 7, 97, 2,
-	2, 11773, 11774, 7, 78, 2, 2, 11774, 11775, 7, 75, 2, 2, 11775, 11776,
-	7, 80, 2, 2, 11776, 11777, 7, 71, 2, 2, 11777, 11778, 7, 85, 2, 2, 11778,
-	11779, 7, 86, 2, 2, 11779, 11780, 7, 84, 2, 2, 11780, 11781, 7, 75, 2,
-	2, 11781, 11782, 7, 80, 2, 2, 11782, 11783, 7, 73, 2, 2, 11783, 11784,
-	7, 72, 2, 2, 11784, 11785, 7, 84, 2, 2, 11785, 11786, 7, 81, 2, 2, 11786,
-	11787, 7, 79, 2, 2, 11787, 11788, 7, 89, 2, 2, 11788, 11789, 7, 77, 2,
-	2, 11789, 11790, 7, 68, 2, 2, 11790, 1964, 3, 2, 2, 2, 11791, 11792, 7,
-	85, 2, 2, 11792, 11793, 7, 86, 2, 2, 11793, 11794, 7, 97, 2, 2, 11794,
-	11795, 7, 80, 2, 2, 11795, 11796, 7, 87, 2, 2, 11796, 11797, 7, 79, 2,
-	2, 11797, 11798, 7, 73, 2, 2, 11798, 11799, 7, 71, 2, 2, 11799, 11800,
-	7, 81, 2, 2, 11800, 11801, 7, 79, 2, 2, 11801, 11802, 7, 71, 2, 2, 11802,
-	11803, 7, 86, 2, 2, 11803, 11804, 7, 84, 2, 2, 11804, 11805, 7, 75, 2,
-	2, 11805, 11806, 7, 71, 2, 2, 11806, 11807, 7, 85, 2, 2, 11807, 1966, 3,
-	2, 2, 2, 11808, 11809, 7, 85, 2, 2, 11809, 11810, 7, 86, 2, 2, 11810, 11811,
-	7, 97, 2, 2, 11811, 11812, 7, 80, 2, 2, 11812, 11813, 7, 87, 2, 2, 11813,
-	11814, 7, 79, 2, 2, 11814, 11815, 7, 75, 2, 2, 11815, 11816, 7, 80, 2,
-	2, 11816, 11817, 7, 86, 2, 2, 11817, 11818, 7, 71, 2, 2, 11818, 11819,
-	7, 84, 2, 2, 11819, 11820, 7, 75, 2, 2, 11820, 11821, 7, 81, 2, 2, 11821,
-	11822, 7, 84, 2, 2, 11822, 11823, 7, 84, 2, 2, 11823, 11824, 7, 75, 2,
-	2, 11824, 11825, 7, 80, 2, 2, 11825, 11826, 7, 73, 2, 2, 11826, 1968, 3,
-	2, 2, 2, 11827, 11828, 7, 85, 2, 2, 11828, 11829, 7, 86, 2, 2, 11829, 11830,
-	7, 97, 2, 2, 11830, 11831, 7, 80, 2, 2, 11831, 11832, 7, 87, 2, 2, 11832,
-	11833, 7, 79, 2, 2, 11833, 11834, 7, 75, 2, 2, 11834, 11835, 7, 80, 2,
-	2, 11835, 11836, 7, 86, 2, 2, 11836, 11837, 7, 71, 2, 2, 11837, 11838,
-	7, 84, 2, 2, 11838, 11839, 7, 75, 2, 2, 11839, 11840, 7, 81, 2, 2, 11840,
-	11841, 7, 84, 2, 2, 11841, 11842, 7, 84, 2, 2, 11842, 11843, 7, 75, 2,
-	2, 11843, 11844, 7, 80, 2, 2, 11844, 11845, 7, 73, 2, 2, 11845, 11846,
-	7, 85, 2, 2, 11846, 1970, 3, 2, 2, 2, 11847, 11848, 7, 85, 2, 2, 11848,
-	11849, 7, 86, 2, 2, 11849, 11850, 7, 97, 2, 2, 11850, 11851, 7, 80, 2,
-	2, 11851, 11852, 7, 87, 2, 2, 11852, 11853, 7, 79, 2, 2, 11853, 11854,
-	7, 82, 2, 2, 11854, 11855, 7, 81, 2, 2, 11855, 11856, 7, 75, 2, 2, 11856,
-	11857, 7, 80, 2, 2, 11857, 11858, 7, 86, 2, 2, 11858, 11859, 7, 85, 2,
-	2, 11859, 1972, 3, 2, 2, 2, 11860, 11861, 7, 85, 2, 2, 11861, 11862, 7,
-	86, 2, 2, 11862, 11863, 7, 97, 2, 2, 11863, 11864, 7, 81, 2, 2, 11864,
-	11865, 7, 88, 2, 2, 11865, 11866, 7, 71, 2, 2, 11866, 11867, 7, 84, 2,
-	2, 11867, 11868, 7, 78, 2, 2, 11868, 11869, 7, 67, 2, 2, 11869, 11870,
-	7, 82, 2, 2, 11870, 11871, 7, 85, 2, 2, 11871, 1974, 3, 2, 2, 2, 11872,
-	11873, 7, 85, 2, 2, 11873, 11874, 7, 86, 2, 2, 11874, 11875, 7, 97, 2,
-	2, 11875, 11876, 7, 82, 2, 2, 11876, 11877, 7, 81, 2, 2, 11877, 11878,
-	7, 75, 2, 2, 11878, 11879, 7, 80, 2, 2, 11879, 11880, 7, 86, 2, 2, 11880,
-	11881, 7, 72, 2, 2, 11881, 11882, 7, 84, 2, 2, 11882, 11883, 7, 81, 2,
-	2, 11883, 11884, 7, 79, 2, 2, 11884, 11885, 7, 86, 2, 2, 11885, 11886,
-	7, 71, 2, 2, 11886, 11887, 7, 90, 2, 2, 11887, 11888, 7, 86, 2, 2, 11888,
-	1976, 3, 2, 2, 2, 11889, 11890, 7, 85, 2, 2, 11890, 11891, 7, 86, 2, 2,
-	11891, 11892, 7, 97, 2, 2, 11892, 11893, 7, 82, 2, 2, 11893, 11894, 7,
-	81, 2, 2, 11894, 11895, 7, 75, 2, 2, 11895, 11896, 7, 80, 2, 2, 11896,
-	11897, 7, 86, 2, 2, 11897, 11898, 7, 72, 2, 2, 11898, 11899, 7, 84, 2,
-	2, 11899, 11900, 7, 81, 2, 2, 11900, 11901, 7, 79, 2, 2, 11901, 11902,
-	7, 89, 2, 2, 11902, 11903, 7, 77, 2, 2, 11903, 11904, 7, 68, 2, 2, 11904,
-	1978, 3, 2, 2, 2, 11905, 11906, 7, 85, 2, 2, 11906, 11907, 7, 86, 2, 2,
-	11907, 11908, 7, 97, 2, 2, 11908, 11909, 7, 82, 2, 2, 11909, 11910, 7,
-	81, 2, 2, 11910, 11911, 7, 75, 2, 2, 11911, 11912, 7, 80, 2, 2, 11912,
-	11913, 7, 86, 2, 2, 11913, 11914, 7, 80, 2, 2, 11914, 1980, 3, 2, 2, 2,
-	11915, 11916, 7, 85, 2, 2, 11916, 11917, 7, 86, 2, 2, 11917, 11918, 7,
-	97, 2, 2, 11918, 11919, 7, 82, 2, 2, 11919, 11920, 7, 81, 2, 2, 11920,
-	11921, 7, 78, 2, 2, 11921, 11922, 7, 91, 2, 2, 11922, 11923, 7, 72, 2,
-	2, 11923, 11924, 7, 84, 2, 2, 11924, 11925, 7, 81, 2, 2, 11925, 11926,
-	7, 79, 2, 2, 11926, 11927, 7, 86, 2, 2, 11927, 11928, 7, 71, 2, 2, 11928,
-	11929, 7, 90, 2, 2, 11929, 11930, 7, 86, 2, 2, 11930, 1982, 3, 2, 2, 2,
-	11931, 11932, 7, 85, 2, 2, 11932, 11933, 7, 86, 2, 2, 11933, 11934, 7,
-	97, 2, 2, 11934, 11935, 7, 82, 2, 2, 11935, 11936, 7, 81, 2, 2, 11936,
-	11937, 7, 78, 2, 2, 11937, 11938, 7, 91, 2, 2, 11938, 11939, 7, 72, 2,
-	2, 11939, 11940, 7, 84, 2, 2, 11940, 11941, 7, 81, 2, 2, 11941, 11942,
-	7, 79, 2, 2, 11942, 11943, 7, 89, 2, 2, 11943, 11944, 7, 77, 2, 2, 11944,
-	11945, 7, 68, 2, 2, 11945, 1984, 3, 2, 2, 2, 11946, 11947, 7, 85, 2, 2,
-	11947, 11948, 7, 86, 2, 2, 11948, 11949, 7, 97, 2, 2, 11949, 11950, 7,
-	82, 2, 2, 11950, 11951, 7, 81, 2, 2, 11951, 11952, 7, 78, 2, 2, 11952,
-	11953, 7, 91, 2, 2, 11953, 11954, 7, 73, 2, 2, 11954, 11955, 7, 81, 2,
-	2, 11955, 11956, 7, 80, 2, 2, 11956, 11957, 7, 72, 2, 2, 11957, 11958,
-	7, 84, 2, 2, 11958, 11959, 7, 81, 2, 2, 11959, 11960, 7, 79, 2, 2, 11960,
-	11961, 7, 86, 2, 2, 11961, 11962, 7, 71, 2, 2, 11962, 11963, 7, 90, 2,
-	2, 11963, 11964, 7, 86, 2, 2, 11964, 1986, 3, 2, 2, 2, 11965, 11966, 7,
-	85, 2, 2, 11966, 11967, 7, 86, 2, 2, 11967, 11968, 7, 97, 2, 2, 11968,
-	11969, 7, 82, 2, 2, 11969, 11970, 7, 81, 2, 2, 11970, 11971, 7, 78, 2,
-	2, 11971, 11972, 7, 91, 2, 2, 11972, 11973, 7, 73, 2, 2, 11973, 11974,
-	7, 81, 2, 2, 11974, 11975, 7, 80, 2, 2, 11975, 11976, 7, 72, 2, 2, 11976,
-	11977, 7, 84, 2, 2, 11977, 11978, 7, 81, 2, 2, 11978, 11979, 7, 79, 2,
-	2, 11979, 11980, 7, 89, 2, 2, 11980, 11981, 7, 77, 2, 2, 11981, 11982,
-	7, 68, 2, 2, 11982, 1988, 3, 2, 2, 2, 11983, 11984, 7, 85, 2, 2, 11984,
-	11985, 7, 86, 2, 2, 11985, 11986, 7, 97, 2, 2, 11986, 11987, 7, 85, 2,
-	2, 11987, 11988, 7, 84, 2, 2, 11988, 11989, 7, 75, 2, 2, 11989, 11990,
-	7, 70, 2, 2, 11990, 1990, 3, 2, 2, 2, 11991, 11992, 7, 85, 2, 2, 11992,
-	11993, 7, 86, 2, 2, 11993, 11994, 7, 97, 2, 2, 11994, 11995, 7, 85, 2,
-	2, 11995, 11996, 7, 86, 2, 2, 11996, 11997, 7, 67, 2, 2, 11997, 11998,
-	7, 84, 2, 2, 11998, 11999, 7, 86, 2, 2, 11999, 12000, 7, 82, 2, 2, 12000,
-	12001, 7, 81, 2, 2, 12001, 12002, 7, 75, 2, 2, 12002, 12003, 7, 80, 2,
-	2, 12003, 12004, 7, 86, 2, 2, 12004, 1992, 3, 2, 2, 2, 12005, 12006, 7,
-	85, 2, 2, 12006, 12007, 7, 86, 2, 2, 12007, 12008, 7, 97, 2, 2, 12008,
-	12009, 7, 85, 2, 2, 12009, 12010, 7, 91, 2, 2, 12010, 12011, 7, 79, 2,
-	2, 12011, 12012, 7, 70, 2, 2, 12012, 12013, 7, 75, 2, 2, 12013, 12014,
-	7, 72, 2, 2, 12014, 12015, 7, 72, 2, 2, 12015, 12016, 7, 71, 2, 2, 12016,
-	12017, 7, 84, 2, 2, 12017, 12018, 7, 71, 2, 2, 12018, 12019, 7, 80, 2,
-	2, 12019, 12020, 7, 69, 2, 2, 12020, 12021, 7, 71, 2, 2, 12021, 1994, 3,
-	2, 2, 2, 12022, 12023, 7, 85, 2, 2, 12023, 12024, 7, 86, 2, 2, 12024, 12025,
-	7, 97, 2, 2, 12025, 12026, 7, 86, 2, 2, 12026, 12027, 7, 81, 2, 2, 12027,
-	12028, 7, 87, 2, 2, 12028, 12029, 7, 69, 2, 2, 12029, 12030, 7, 74, 2,
-	2, 12030, 12031, 7, 71, 2, 2, 12031, 12032, 7, 85, 2, 2, 12032, 1996, 3,
-	2, 2, 2, 12033, 12034, 7, 85, 2, 2, 12034, 12035, 7, 86, 2, 2, 12035, 12036,
-	7, 97, 2, 2, 12036, 12037, 7, 87, 2, 2, 12037, 12038, 7, 80, 2, 2, 12038,
-	12039, 7, 75, 2, 2, 12039, 12040, 7, 81, 2, 2, 12040, 12041, 7, 80, 2,
-	2, 12041, 1998, 3, 2, 2, 2, 12042, 12043, 7, 85, 2, 2, 12043, 12044, 7,
-	86, 2, 2, 12044, 12045, 7, 97, 2, 2, 12045, 12046, 7, 89, 2, 2, 12046,
-	12047, 7, 75, 2, 2, 12047, 12048, 7, 86, 2, 2, 12048, 12049, 7, 74, 2,
-	2, 12049, 12050, 7, 75, 2, 2, 12050, 12051, 7, 80, 2, 2, 12051, 2000, 3,
-	2, 2, 2, 12052, 12053, 7, 85, 2, 2, 12053, 12054, 7, 86, 2, 2, 12054, 12055,
-	7, 97, 2, 2, 12055, 12056, 7, 90, 2, 2, 12056, 2002, 3, 2, 2, 2, 12057,
-	12058, 7, 85, 2, 2, 12058, 12059, 7, 86, 2, 2, 12059, 12060, 7, 97, 2,
-	2, 12060, 12061, 7, 91, 2, 2, 12061, 2004, 3, 2, 2, 2, 12062, 12063, 7,
-	85, 2, 2, 12063, 12064, 7, 87, 2, 2, 12064, 12065, 7, 68, 2, 2, 12065,
-	12066, 7, 70, 2, 2, 12066, 12067, 7, 67, 2, 2, 12067, 12068, 7, 86, 2,
-	2, 12068, 12069, 7, 71, 2, 2, 12069, 2006, 3, 2, 2, 2, 12070, 12071, 7,
-	85, 2, 2, 12071, 12072, 7, 87, 2, 2, 12072, 12073, 7, 68, 2, 2, 12073,
-	12074, 7, 85, 2, 2, 12074, 12075, 7, 86, 2, 2, 12075, 12076, 7, 84, 2,
-	2, 12076, 12077, 7, 75, 2, 2, 12077, 12078, 7, 80, 2, 2, 12078, 12079,
-	7, 73, 2, 2, 12079, 12080, 7, 97, 2, 2, 12080, 12081, 7, 75, 2, 2, 12081,
-	12082, 7, 80, 2, 2, 12082, 12083, 7, 70, 2, 2, 12083, 12084, 7, 71, 2,
-	2, 12084, 12085, 7, 90, 2, 2, 12085, 2008, 3, 2, 2, 2, 12086, 12087, 7,
-	85, 2, 2, 12087, 12088, 7, 87, 2, 2, 12088, 12089, 7, 68, 2, 2, 12089,
-	12090, 7, 86, 2, 2, 12090, 12091, 7, 75, 2, 2, 12091, 12092, 7, 79, 2,
-	2, 12092, 12093, 7, 71, 2, 2, 12093, 2010, 3, 2, 2, 2, 12094, 12095, 7,
-	85, 2, 2, 12095, 12096, 7, 91, 2, 2, 12096, 12097, 7, 85, 2, 2, 12097,
-	12098, 7, 86, 2, 2, 12098, 12099, 7, 71, 2, 2, 12099, 12100, 7, 79, 2,
-	2, 12100, 12101, 7, 97, 2, 2, 12101, 12102, 7, 87, 2, 2, 12102, 12103,
-	7, 85, 2, 2, 12103, 12104, 7, 71, 2, 2, 12104, 12105, 7, 84, 2, 2, 12105,
-	2012, 3, 2, 2, 2, 12106, 12107, 7, 86, 2, 2, 12107, 12108, 7, 67, 2, 2,
-	12108, 12109, 7, 80, 2, 2, 12109, 2014, 3, 2, 2, 2, 12110, 12111, 7, 86,
-	2, 2, 12111, 12112, 7, 75, 2, 2, 12112, 12113, 7, 79, 2, 2, 12113, 12114,
-	7, 71, 2, 2, 12114, 12115, 7, 70, 2, 2, 12115, 12116, 7, 75, 2, 2, 12116,
-	12117, 7, 72, 2, 2, 12117, 12118, 7, 72, 2, 2, 12118, 2016, 3, 2, 2, 2,
-	12119, 12120, 7, 86, 2, 2, 12120, 12121, 7, 75, 2, 2, 12121, 12122, 7,
-	79, 2, 2, 12122, 12123, 7, 71, 2, 2, 12123, 12124, 7, 85, 2, 2, 12124,
-	12125, 7, 86, 2, 2, 12125, 12126, 7, 67, 2, 2, 12126, 12127, 7, 79, 2,
-	2, 12127, 12128, 7, 82, 2, 2, 12128, 12129, 7, 67, 2, 2, 12129, 12130,
-	7, 70, 2, 2, 12130, 12131, 7, 70, 2, 2, 12131, 2018, 3, 2, 2, 2, 12132,
-	12133, 7, 86, 2, 2, 12133, 12134, 7, 75, 2, 2, 12134, 12135, 7, 79, 2,
-	2, 12135, 12136, 7, 71, 2, 2, 12136, 12137, 7, 85, 2, 2, 12137, 12138,
-	7, 86, 2, 2, 12138, 12139, 7, 67, 2, 2, 12139, 12140, 7, 79, 2, 2, 12140,
-	12141, 7, 82, 2, 2, 12141, 12142, 7, 70, 2, 2, 12142, 12143, 7, 75, 2,
-	2, 12143, 12144, 7, 72, 2, 2, 12144, 12145, 7, 72, 2, 2, 12145, 2020, 3,
-	2, 2, 2, 12146, 12147, 7, 86, 2, 2, 12147, 12148, 7, 75, 2, 2, 12148, 12149,
-	7, 79, 2, 2, 12149, 12150, 7, 71, 2, 2, 12150, 12151, 7, 97, 2, 2, 12151,
-	12152, 7, 72, 2, 2, 12152, 12153, 7, 81, 2, 2, 12153, 12154, 7, 84, 2,
-	2, 12154, 12155, 7, 79, 2, 2, 12155, 12156, 7, 67, 2, 2, 12156, 12157,
-	7, 86, 2, 2, 12157, 2022, 3, 2, 2, 2, 12158, 12159, 7, 86, 2, 2, 12159,
-	12160, 7, 75, 2, 2, 12160, 12161, 7, 79, 2, 2, 12161, 12162, 7, 71, 2,
-	2, 12162, 12163, 7, 97, 2, 2, 12163, 12164, 7, 86, 2, 2, 12164, 12165,
-	7, 81, 2, 2, 12165, 12166, 7, 97, 2, 2, 12166, 12167, 7, 85, 2, 2, 12167,
-	12168, 7, 71, 2, 2, 12168, 12169, 7, 69, 2, 2, 12169, 2024, 3, 2, 2, 2,
-	12170, 12171, 7, 86, 2, 2, 12171, 12172, 7, 81, 2, 2, 12172, 12173, 7,
-	87, 2, 2, 12173, 12174, 7, 69, 2, 2, 12174, 12175, 7, 74, 2, 2, 12175,
-	12176, 7, 71, 2, 2, 12176, 12177, 7, 85, 2, 2, 12177, 2026, 3, 2, 2, 2,
-	12178, 12179, 7, 86, 2, 2, 12179, 12180, 7, 81, 2, 2, 12180, 12181, 7,
-	97, 2, 2, 12181, 12182, 7, 68, 2, 2, 12182, 12183, 7, 67, 2, 2, 12183,
-	12184, 7, 85, 2, 2, 12184, 12185, 7, 71, 2, 2, 12185, 12186, 7, 56, 2,
-	2, 12186, 12187, 7, 54, 2, 2, 12187, 2028, 3, 2, 2, 2, 12188, 12189, 7,
+	99, 3, 99, 3, 99, 3, 99, 3, 99, 3, 99, 3, 99, 3, 99, 3, 99, 3, 99, 3, 99,
+	3, 99, 3, 99, 3, 100, 3, 100, 3, 100, 3, 100, 3, 100, 3, 100, 3, 101, 3,
+	101, 3, 101, 3, 101, 3, 101, 3, 101, 3, 101, 3, 101, 3, 101, 3, 102, 3,
+	102, 3, 102, 3, 102, 3, 102, 3, 102, 3, 102, 3, 102, 3, 102, 3, 103, 3,
+	103, 3, 103, 3, 103, 3, 103, 3, 103, 3, 103, 3, 103, 3, 104, 3, 104, 3,
+	104, 3, 104, 3, 105, 3, 105, 3, 105, 3, 105, 3, 105, 3, 105, 3, 105, 3,
+	105, 3, 105, 3, 105, 3, 105, 3, 105, 3, 105, 3, 105, 3, 105, 3, 105, 3,
+	105, 3, 105, 3, 105, 3, 106, 3, 106, 3, 107, 3, 107, 3, 107, 3, 107, 3,
+	107, 3, 108, 3, 108, 3, 108, 3, 108, 3, 108, 3, 108, 3, 108, 3, 109, 3,
+	109, 3, 109, 3, 110, 3, 110, 3, 110, 3, 110, 3, 110, 3, 110, 3, 110, 3,
+	110, 3, 110, 3, 111, 3, 111, 3, 111, 3, 111, 3, 111, 3, 111, 3, 111, 3,
+	112, 3, 112, 3, 112, 3, 112, 3, 112, 3, 112, 3, 112, 3, 112, 3, 112, 3,
+	112, 3, 112, 3, 113, 3, 113, 3, 113, 3, 114, 3, 114, 3, 114, 3, 114, 3,
+	114, 3, 114, 3, 115, 3, 115, 3, 115, 3, 115, 3, 116, 3, 116, 3, 116, 3,
+	116, 3, 116, 3, 116, 3, 117, 3, 117, 3, 117, 3, 117, 3, 117, 3, 117, 3,
+	117, 3, 117, 3, 118, 3, 118, 3, 118, 3, 118, 3, 118, 3, 118, 3, 118, 3,
+	118, 3, 118, 3, 118, 3, 119, 3, 119, 3, 119, 3, 119, 3, 119, 3, 119, 3,
+	119, 3, 119, 3, 120, 3, 120, 3, 120, 3, 120, 3, 120, 3, 120, 3, 120, 3,
+	120, 3, 120, 3, 120, 3, 121, 3, 121, 3, 121, 3, 121, 3, 121, 3, 121, 3,
+	122, 3, 122, 3, 122, 3, 122, 3, 122, 3, 122, 3, 123, 3, 123, 3, 123, 3,
+	123, 3, 123, 3, 124, 3, 124, 3, 124, 3, 124, 3, 124, 3, 124, 3, 125, 3,
+	125, 3, 125, 3, 125, 3, 125, 3, 125, 3, 125, 3, 125, 3, 125, 3, 125, 3,
+	125, 3, 126, 3, 126, 3, 126, 3, 126, 3, 126, 3, 126, 3, 126, 3, 127, 3,
+	127, 3, 127, 3, 127, 3, 127, 3, 127, 3, 127, 3, 127, 3, 128, 3, 128, 3,
+	128, 3, 128, 3, 128, 3, 128, 3, 128, 3, 129, 3, 129, 3, 129, 3, 129, 3,
+	129, 3, 129, 3, 129, 3, 130, 3, 130, 3, 130, 3, 130, 3, 130, 3, 130, 3,
+	130, 3, 130, 3, 131, 3, 131, 3, 131, 3, 131, 3, 131, 3, 131, 3, 131, 3,
+	131, 3, 132, 3, 132, 3, 132, 3, 132, 3, 132, 3, 132, 3, 132, 3, 132, 3,
+	132, 3, 133, 3, 133, 3, 133, 3, 133, 3, 133, 3, 133, 3, 133, 3, 133, 3,
+	133, 3, 134, 3, 134, 3, 134, 3, 134, 3, 134, 3, 134, 3, 134, 3, 135, 3,
+	135, 3, 135, 3, 135, 3, 135, 3, 135, 3, 135, 3, 136, 3, 136, 3, 136, 3,
+	136, 3, 136, 3, 136, 3, 137, 3, 137, 3, 137, 3, 137, 3, 137, 3, 137, 3,
+	138, 3, 138, 3, 138, 3, 138, 3, 138, 3, 138, 3, 138, 3, 139, 3, 139, 3,
+	139, 3, 139, 3, 139, 3, 139, 3, 139, 3, 139, 3, 140, 3, 140, 3, 140, 3,
+	140, 3, 140, 3, 140, 3, 140, 3, 141, 3, 141, 3, 141, 3, 141, 3, 142, 3,
+	142, 3, 142, 3, 142, 3, 142, 3, 142, 3, 142, 3, 142, 3, 142, 3, 142, 3,
+	143, 3, 143, 3, 143, 3, 143, 3, 143, 3, 144, 3, 144, 3, 144, 3, 144, 3,
+	144, 3, 144, 3, 144, 3, 145, 3, 145, 3, 145, 3, 145, 3, 145, 3, 145, 3,
+	145, 3, 145, 3, 146, 3, 146, 3, 146, 3, 146, 3, 147, 3, 147, 3, 147, 3,
+	147, 3, 147, 3, 147, 3, 147, 3, 147, 3, 147, 3, 147, 3, 147, 3, 147, 3,
+	147, 3, 148, 3, 148, 3, 148, 3, 148, 3, 148, 3, 148, 3, 148, 3, 148, 3,
+	148, 3, 149, 3, 149, 3, 149, 3, 149, 3, 149, 3, 149, 3, 149, 3, 149, 3,
+	149, 3, 149, 3, 149, 3, 150, 3, 150, 3, 150, 3, 150, 3, 150, 3, 150, 3,
+	150, 3, 150, 3, 150, 3, 150, 3, 150, 3, 150, 3, 150, 3, 150, 3, 150, 3,
+	151, 3, 151, 3, 151, 3, 151, 3, 151, 3, 151, 3, 151, 3, 151, 3, 151, 3,
+	151, 3, 151, 3, 151, 3, 151, 3, 151, 3, 151, 3, 151, 3, 151, 3, 151, 3,
+	151, 3, 151, 3, 152, 3, 152, 3, 152, 3, 152, 3, 152, 3, 152, 3, 152, 3,
+	152, 3, 152, 3, 152, 3, 152, 3, 152, 3, 152, 3, 152, 3, 152, 3, 152, 3,
+	152, 3, 153, 3, 153, 3, 153, 3, 153, 3, 154, 3, 154, 3, 154, 3, 154, 3,
+	154, 3, 154, 3, 154, 3, 154, 3, 155, 3, 155, 3, 155, 3, 155, 3, 155, 3,
+	155, 3, 155, 3, 155, 3, 155, 3, 156, 3, 156, 3, 156, 3, 156, 3, 156, 3,
+	156, 3, 156, 3, 156, 3, 156, 3, 156, 3, 156, 3, 156, 3, 156, 3, 156, 3,
+	157, 3, 157, 3, 157, 3, 157, 3, 157, 3, 157, 3, 158, 3, 158, 3, 158, 3,
+	158, 3, 158, 3, 158, 3, 158, 3, 158, 3, 158, 3, 158, 3, 158, 3, 159, 3,
+	159, 3, 159, 3, 159, 3, 159, 3, 160, 3, 160, 3, 160, 3, 161, 3, 161, 3,
+	161, 3, 161, 3, 161, 3, 161, 3, 161, 3, 161, 3, 161, 3, 162, 3, 162, 3,
+	162, 3, 162, 3, 162, 3, 162, 3, 162, 3, 162, 3, 163, 3, 163, 3, 163, 3,
+	163, 3, 163, 3, 164, 3, 164, 3, 164, 3, 164, 3, 164, 3, 165, 3, 165, 3,
+	165, 3, 165, 3, 165, 3, 165, 3, 166, 3, 166, 3, 166, 3, 166, 3, 166, 3,
+	166, 3, 166, 3, 167, 3, 167, 3, 167, 3, 167, 3, 167, 3, 167, 3, 167, 3,
+	168, 3, 168, 3, 168, 3, 168, 3, 168, 3, 168, 3, 168, 3, 168, 3, 168, 3,
+	169, 3, 169, 3, 169, 3, 169, 3, 169, 3, 169, 3, 169, 3, 170, 3, 170, 3,
+	170, 3, 170, 3, 170, 3, 170, 3, 171, 3, 171, 3, 171, 3, 171, 3, 172, 3,
+	172, 3, 172, 3, 172, 3, 172, 3, 172, 3, 173, 3, 173, 3, 173, 3, 173, 3,
+	173, 3, 173, 3, 173, 3, 174, 3, 174, 3, 174, 3, 174, 3, 174, 3, 175, 3,
+	175, 3, 175, 3, 175, 3, 175, 3, 175, 3, 176, 3, 176, 3, 176, 3, 176, 3,
+	176, 3, 176, 3, 177, 3, 177, 3, 177, 3, 177, 3, 177, 3, 178, 3, 178, 3,
+	178, 3, 178, 3, 178, 3, 178, 3, 179, 3, 179, 3, 179, 3, 179, 3, 180, 3,
+	180, 3, 180, 3, 180, 3, 180, 3, 180, 3, 180, 3, 180, 3, 180, 3, 181, 3,
+	181, 3, 181, 3, 181, 3, 181, 3, 181, 3, 181, 3, 181, 3, 182, 3, 182, 3,
+	182, 3, 182, 3, 182, 3, 182, 3, 182, 3, 182, 3, 182, 3, 183, 3, 183, 3,
+	183, 3, 183, 3, 183, 3, 183, 3, 183, 3, 183, 3, 183, 3, 183, 3, 184, 3,
+	184, 3, 184, 3, 184, 3, 184, 3, 184, 3, 184, 3, 184, 3, 184, 3, 184, 3,
+	185, 3, 185, 3, 185, 3, 185, 3, 186, 3, 186, 3, 186, 3, 186, 3, 186, 3,
+	187, 3, 187, 3, 187, 3, 187, 3, 187, 3, 188, 3, 188, 3, 188, 3, 188, 3,
+	188, 3, 189, 3, 189, 3, 189, 3, 189, 3, 189, 3, 190, 3, 190, 3, 190, 3,
+	190, 3, 190, 3, 191, 3, 191, 3, 191, 3, 191, 3, 191, 3, 191, 3, 191, 3,
+	191, 3, 192, 3, 192, 3, 192, 3, 192, 3, 192, 3, 192, 3, 192, 3, 193, 3,
+	193, 3, 193, 3, 193, 3, 193, 3, 194, 3, 194, 3, 194, 3, 194, 3, 194, 3,
+	194, 3, 194, 3, 195, 3, 195, 3, 195, 3, 195, 3, 195, 3, 195, 3, 195, 3,
+	195, 3, 195, 3, 195, 3, 196, 3, 196, 3, 196, 3, 196, 3, 196, 3, 196, 3,
+	197, 3, 197, 3, 197, 3, 197, 3, 197, 3, 197, 3, 197, 3, 198, 3, 198, 3,
+	198, 3, 198, 3, 198, 3, 198, 3, 198, 3, 199, 3, 199, 3, 199, 3, 199, 3,
+	199, 3, 199, 3, 199, 3, 199, 3, 200, 3, 200, 3, 200, 3, 200, 3, 201, 3,
+	201, 3, 201, 3, 201, 3, 201, 3, 201, 3, 201, 3, 201, 3, 202, 3, 202, 3,
+	202, 3, 202, 3, 202, 3, 203, 3, 203, 3, 203, 3, 203, 3, 203, 3, 204, 3,
+	204, 3, 204, 3, 204, 3, 204, 3, 204, 3, 204, 3, 204, 3, 204, 3, 204, 3,
+	205, 3, 205, 3, 205, 3, 205, 3, 205, 3, 205, 3, 205, 3, 205, 3, 205, 3,
+	206, 3, 206, 3, 206, 3, 206, 3, 206, 3, 207, 3, 207, 3, 207, 3, 207, 3,
+	207, 3, 208, 3, 208, 3, 208, 3, 208, 3, 208, 3, 208, 3, 208, 3, 208, 3,
+	209, 3, 209, 3, 209, 3, 209, 3, 209, 3, 209, 3, 209, 3, 209, 3, 209, 3,
+	210, 3, 210, 3, 210, 3, 210, 3, 210, 3, 210, 3, 210, 3, 210, 3, 210, 3,
+	211, 3, 211, 3, 211, 3, 211, 3, 211, 3, 211, 3, 211, 3, 212, 3, 212, 3,
+	212, 3, 212, 3, 212, 3, 212, 3, 212, 3, 212, 3, 212, 3, 212, 3, 213, 3,
+	213, 3, 213, 3, 213, 3, 213, 3, 213, 3, 213, 3, 213, 3, 213, 3, 214, 3,
+	214, 3, 214, 3, 214, 3, 214, 3, 215, 3, 215, 3, 215, 3, 215, 3, 215, 3,
+	215, 3, 215, 3, 215, 3, 215, 3, 215, 3, 215, 3, 216, 3, 216, 3, 216, 3,
+	216, 3, 216, 3, 217, 3, 217, 3, 217, 3, 217, 3, 217, 3, 217, 3, 217, 3,
+	217, 3, 217, 3, 218, 3, 218, 3, 218, 3, 218, 3, 218, 3, 218, 3, 218, 3,
+	218, 3, 218, 3, 219, 3, 219, 3, 219, 3, 219, 3, 219, 3, 220, 3, 220, 3,
+	220, 3, 220, 3, 220, 3, 220, 3, 220, 3, 220, 3, 220, 3, 220, 3, 220, 3,
+	221, 3, 221, 3, 221, 3, 221, 3, 221, 3, 221, 3, 221, 3, 221, 3, 221, 3,
+	222, 3, 222, 3, 222, 3, 222, 3, 222, 3, 223, 3, 223, 3, 223, 3, 223, 3,
+	223, 3, 223, 3, 223, 3, 223, 3, 224, 3, 224, 3, 224, 3, 224, 3, 224, 3,
+	224, 3, 224, 3, 225, 3, 225, 3, 225, 3, 225, 3, 225, 3, 225, 3, 225, 3,
+	225, 3, 225, 3, 225, 3, 225, 3, 226, 3, 226, 3, 226, 3, 226, 3, 226, 3,
+	226, 3, 226, 3, 226, 3, 226, 3, 227, 3, 227, 3, 227, 3, 227, 3, 227, 3,
+	227, 3, 227, 3, 227, 3, 227, 3, 227, 3, 227, 3, 228, 3, 228, 3, 228, 3,
+	228, 3, 228, 3, 228, 3, 228, 3, 228, 3, 228, 3, 228, 3, 228, 3, 229, 3,
+	229, 3, 229, 3, 229, 3, 229, 3, 229, 3, 229, 3, 229, 3, 229, 3, 229, 3,
+	229, 3, 229, 3, 230, 3, 230, 3, 230, 3, 230, 3, 230, 3, 230, 3, 230, 3,
+	230, 3, 230, 3, 230, 3, 230, 3, 230, 3, 231, 3, 231, 3, 231, 3, 231, 3,
+	231, 3, 231, 3, 231, 3, 231, 3, 231, 3, 231, 3, 231, 3, 231, 3, 231, 3,
+	231, 3, 232, 3, 232, 3, 232, 3, 232, 3, 232, 3, 232, 3, 232, 3, 232, 3,
+	232, 3, 232, 3, 232, 3, 232, 3, 232, 3, 232, 3, 232, 3, 232, 3, 232, 3,
+	232, 3, 232, 3, 233, 3, 233, 3, 233, 3, 233, 3, 233, 3, 233, 3, 233, 3,
+	233, 3, 233, 3, 233, 3, 233, 3, 233, 3, 233, 3, 233, 3, 233, 3, 233, 3,
+	233, 3, 233, 3, 233, 3, 234, 3, 234, 3, 234, 3, 234, 3, 234, 3, 234, 3,
+	234, 3, 234, 3, 234, 3, 234, 3, 234, 3, 234, 3, 234, 3, 234, 3, 234, 3,
+	234, 3, 234, 3, 235, 3, 235, 3, 235, 3, 235, 3, 235, 3, 235, 3, 235, 3,
+	235, 3, 235, 3, 235, 3, 235, 3, 235, 3, 235, 3, 235, 3, 235, 3, 235, 3,
+	236, 3, 236, 3, 236, 3, 236, 3, 236, 3, 236, 3, 236, 3, 236, 3, 236, 3,
+	236, 3, 236, 3, 237, 3, 237, 3, 237, 3, 237, 3, 237, 3, 237, 3, 237, 3,
+	237, 3, 237, 3, 237, 3, 237, 3, 237, 3, 238, 3, 238, 3, 238, 3, 238, 3,
+	238, 3, 238, 3, 238, 3, 238, 3, 238, 3, 238, 3, 238, 3, 239, 3, 239, 3,
+	239, 3, 239, 3, 239, 3, 239, 3, 239, 3, 239, 3, 239, 3, 239, 3, 239, 3,
+	239, 3, 239, 3, 239, 3, 240, 3, 240, 3, 240, 3, 240, 3, 240, 3, 240, 3,
+	240, 3, 240, 3, 240, 3, 240, 3, 240, 3, 240, 3, 240, 3, 240, 3, 240, 3,
+	240, 3, 240, 3, 240, 3, 240, 3, 241, 3, 241, 3, 241, 3, 241, 3, 241, 3,
+	241, 3, 241, 3, 241, 3, 241, 3, 241, 3, 241, 3, 241, 3, 241, 3, 242, 3,
+	242, 3, 242, 3, 242, 3, 242, 3, 242, 3, 242, 3, 242, 3, 242, 3, 242, 3,
+	243, 3, 243, 3, 243, 3, 243, 3, 243, 3, 243, 3, 243, 3, 243, 3, 243, 3,
+	243, 3, 243, 3, 243, 3, 243, 3, 243, 3, 244, 3, 244, 3, 244, 3, 244, 3,
+	244, 3, 244, 3, 244, 3, 244, 3, 244, 3, 244, 3, 244, 3, 244, 3, 245, 3,
+	245, 3, 245, 3, 245, 3, 245, 3, 245, 3, 245, 3, 245, 3, 245, 3, 245, 3,
+	245, 3, 246, 3, 246, 3, 246, 3, 246, 3, 246, 3, 246, 3, 246, 3, 246, 3,
+	246, 3, 246, 3, 246, 3, 246, 3, 246, 3, 246, 3, 246, 3, 246, 3, 246, 3,
+	246, 3, 247, 3, 247, 3, 247, 3, 247, 3, 247, 3, 247, 3, 247, 3, 247, 3,
+	247, 3, 247, 3, 247, 3, 247, 3, 247, 3, 247, 3, 247, 3, 247, 3, 247, 3,
+	247, 3, 248, 3, 248, 3, 248, 3, 248, 3, 248, 3, 248, 3, 248, 3, 248, 3,
+	248, 3, 248, 3, 248, 3, 248, 3, 249, 3, 249, 3, 249, 3, 249, 3, 249, 3,
+	249, 3, 249, 3, 249, 3, 249, 3, 249, 3, 249, 3, 250, 3, 250, 3, 250, 3,
+	250, 3, 250, 3, 250, 3, 250, 3, 250, 3, 250, 3, 250, 3, 250, 3, 250, 3,
+	250, 3, 250, 3, 250, 3, 250, 3, 250, 3, 251, 3, 251, 3, 251, 3, 251, 3,
+	251, 3, 251, 3, 251, 3, 251, 3, 251, 3, 251, 3, 251, 3, 251, 3, 251, 3,
+	251, 3, 251, 3, 251, 3, 251, 3, 251, 3, 251, 3, 251, 3, 252, 3, 252, 3,
+	252, 3, 252, 3, 252, 3, 252, 3, 252, 3, 252, 3, 252, 3, 252, 3, 252, 3,
+	252, 3, 253, 3, 253, 3, 253, 3, 253, 3, 253, 3, 253, 3, 253, 3, 253, 3,
+	253, 3, 253, 3, 253, 3, 253, 3, 253, 3, 254, 3, 254, 3, 254, 3, 254, 3,
+	254, 3, 254, 3, 254, 3, 254, 3, 254, 3, 255, 3, 255, 3, 255, 3, 255, 3,
+	255, 3, 255, 3, 255, 3, 255, 3, 255, 3, 255, 3, 255, 3, 255, 3, 255, 3,
+	256, 3, 256, 3, 256, 3, 256, 3, 256, 3, 256, 3, 256, 3, 256, 3, 256, 3,
+	256, 3, 256, 3, 257, 3, 257, 3, 257, 3, 257, 3, 257, 3, 257, 3, 257, 3,
+	257, 3, 257, 3, 257, 3, 257, 3, 257, 3, 258, 3, 258, 3, 258, 3, 258, 3,
+	258, 3, 258, 3, 258, 3, 258, 3, 258, 3, 258, 3, 259, 3, 259, 3, 259, 3,
+	259, 3, 259, 3, 259, 3, 259, 3, 259, 3, 259, 3, 259, 3, 259, 3, 260, 3,
+	260, 3, 260, 3, 260, 3, 260, 3, 260, 3, 260, 3, 260, 3, 260, 3, 260, 3,
+	260, 3, 261, 3, 261, 3, 261, 3, 261, 3, 261, 3, 261, 3, 261, 3, 261, 3,
+	261, 3, 261, 3, 261, 3, 261, 3, 261, 3, 261, 3, 261, 3, 261, 3, 261, 3,
+	261, 3, 262, 3, 262, 3, 262, 3, 262, 3, 262, 3, 262, 3, 262, 3, 262, 3,
+	262, 3, 262, 3, 262, 3, 262, 3, 262, 3, 262, 3, 262, 3, 262, 3, 262, 3,
+	262, 3, 262, 3, 262, 3, 262, 3, 262, 3, 262, 3, 262, 3, 262, 3, 262, 3,
+	262, 3, 262, 3, 262, 3, 262, 3, 263, 3, 263, 3, 263, 3, 263, 3, 263, 3,
+	263, 3, 263, 3, 263, 3, 263, 3, 263, 3, 263, 3, 263, 3, 264, 3, 264, 3,
+	264, 3, 264, 3, 264, 3, 264, 3, 264, 3, 264, 3, 264, 3, 264, 3, 264, 3,
+	264, 3, 264, 3, 264, 3, 264, 3, 264, 3, 264, 3, 264, 3, 265, 3, 265, 3,
+	265, 3, 265, 3, 265, 3, 265, 3, 265, 3, 265, 3, 265, 3, 265, 3, 265, 3,
+	265, 3, 265, 3, 265, 3, 265, 3, 265, 3, 265, 3, 265, 3, 266, 3, 266, 3,
+	266, 3, 266, 3, 266, 3, 266, 3, 266, 3, 266, 3, 266, 3, 266, 3, 266, 3,
+	266, 3, 266, 3, 266, 3, 267, 3, 267, 3, 267, 3, 267, 3, 267, 3, 267, 3,
+	267, 3, 267, 3, 267, 3, 267, 3, 267, 3, 267, 3, 267, 3, 267, 3, 267, 3,
+	268, 3, 268, 3, 268, 3, 268, 3, 269, 3, 269, 3, 269, 3, 269, 3, 269, 3,
+	269, 3, 269, 3, 269, 3, 270, 3, 270, 3, 270, 3, 270, 3, 270, 3, 270, 3,
+	270, 3, 271, 3, 271, 3, 271, 3, 271, 3, 271, 3, 271, 3, 271, 3, 271, 3,
+	272, 3, 272, 3, 272, 3, 272, 3, 272, 3, 272, 3, 273, 3, 273, 3, 273, 3,
+	273, 3, 273, 3, 273, 3, 273, 3, 273, 3, 273, 3, 273, 3, 273, 3, 273, 3,
+	273, 3, 274, 3, 274, 3, 274, 3, 274, 3, 275, 3, 275, 3, 275, 3, 275, 3,
+	276, 3, 276, 3, 276, 3, 276, 3, 277, 3, 277, 3, 277, 3, 277, 3, 277, 3,
+	277, 3, 277, 3, 278, 3, 278, 3, 278, 3, 278, 3, 278, 3, 278, 3, 278, 3,
+	278, 3, 278, 3, 278, 3, 278, 3, 279, 3, 279, 3, 279, 3, 279, 3, 279, 3,
+	279, 3, 279, 3, 279, 3, 279, 3, 279, 3, 279, 3, 279, 3, 280, 3, 280, 3,
+	280, 3, 280, 3, 281, 3, 281, 3, 281, 3, 281, 3, 281, 3, 281, 3, 281, 3,
+	281, 3, 282, 3, 282, 3, 282, 3, 282, 3, 282, 3, 282, 3, 282, 3, 282, 3,
+	282, 3, 283, 3, 283, 3, 283, 3, 283, 3, 283, 3, 283, 3, 283, 3, 283, 3,
+	283, 3, 284, 3, 284, 3, 284, 3, 284, 3, 284, 3, 284, 3, 284, 3, 284, 3,
+	284, 3, 284, 3, 284, 3, 284, 3, 284, 3, 285, 3, 285, 3, 285, 3, 285, 3,
+	285, 3, 285, 3, 285, 3, 285, 3, 285, 3, 285, 3, 285, 3, 285, 3, 285, 3,
+	286, 3, 286, 3, 286, 3, 286, 3, 286, 3, 286, 3, 286, 3, 286, 3, 286, 3,
+	286, 3, 286, 3, 286, 3, 286, 3, 286, 3, 286, 3, 286, 3, 286, 3, 286, 3,
+	287, 3, 287, 3, 287, 3, 287, 3, 287, 3, 287, 3, 287, 3, 287, 3, 287, 3,
+	287, 3, 288, 3, 288, 3, 288, 3, 288, 3, 288, 3, 288, 3, 288, 3, 288, 3,
+	289, 3, 289, 3, 289, 3, 289, 3, 289, 3, 289, 3, 289, 3, 289, 3, 290, 3,
+	290, 3, 290, 3, 290, 3, 290, 3, 290, 3, 290, 3, 290, 3, 290, 3, 291, 3,
+	291, 3, 291, 3, 291, 3, 291, 3, 291, 3, 291, 3, 291, 3, 291, 3, 292, 3,
+	292, 3, 292, 3, 292, 3, 292, 3, 292, 3, 292, 3, 292, 3, 293, 3, 293, 3,
+	293, 3, 293, 3, 293, 3, 293, 3, 293, 3, 293, 3, 293, 3, 293, 3, 293, 3,
+	293, 3, 293, 3, 293, 3, 293, 3, 294, 3, 294, 3, 294, 3, 294, 3, 295, 3,
+	295, 3, 295, 3, 295, 3, 295, 3, 295, 3, 295, 3, 295, 3, 295, 3, 296, 3,
+	296, 3, 296, 3, 296, 3, 296, 3, 296, 3, 296, 3, 297, 3, 297, 3, 297, 3,
+	297, 3, 297, 3, 297, 3, 297, 3, 297, 3, 297, 3, 297, 3, 298, 3, 298, 3,
+	298, 3, 298, 3, 298, 3, 298, 3, 298, 3, 298, 3, 299, 3, 299, 3, 299, 3,
+	299, 3, 299, 3, 300, 3, 300, 3, 300, 3, 300, 3, 300, 3, 300, 3, 300, 3,
+	300, 3, 300, 3, 301, 3, 301, 3, 301, 3, 301, 3, 301, 3, 301, 3, 301, 3,
+	301, 3, 301, 3, 302, 3, 302, 3, 302, 3, 302, 3, 302, 3, 302, 3, 302, 3,
+	302, 3, 302, 3, 302, 3, 302, 3, 302, 3, 302, 3, 302, 3, 303, 3, 303, 3,
+	303, 3, 303, 3, 303, 3, 303, 3, 303, 3, 303, 3, 304, 3, 304, 3, 304, 3,
+	304, 3, 304, 3, 304, 3, 304, 3, 305, 3, 305, 3, 305, 3, 305, 3, 305, 3,
+	305, 3, 306, 3, 306, 3, 306, 3, 306, 3, 306, 3, 306, 3, 306, 3, 306, 3,
+	306, 3, 306, 3, 307, 3, 307, 3, 307, 3, 307, 3, 307, 3, 307, 3, 307, 3,
+	307, 3, 307, 3, 307, 3, 308, 3, 308, 3, 308, 3, 308, 3, 309, 3, 309, 3,
+	309, 3, 310, 3, 310, 3, 310, 3, 310, 3, 310, 3, 310, 3, 310, 3, 310, 3,
+	311, 3, 311, 3, 311, 3, 311, 3, 311, 3, 311, 3, 311, 3, 311, 3, 311, 3,
+	311, 3, 311, 3, 312, 3, 312, 3, 312, 3, 312, 3, 312, 3, 312, 3, 312, 3,
+	312, 3, 312, 3, 312, 3, 312, 3, 312, 3, 312, 3, 312, 3, 312, 3, 312, 3,
+	313, 3, 313, 3, 313, 3, 313, 3, 313, 3, 313, 3, 313, 3, 313, 3, 313, 3,
+	313, 3, 313, 3, 313, 3, 313, 3, 313, 3, 313, 3, 314, 3, 314, 3, 314, 3,
+	314, 3, 314, 3, 314, 3, 314, 3, 314, 3, 314, 3, 314, 3, 314, 3, 314, 3,
+	314, 3, 314, 3, 314, 3, 315, 3, 315, 3, 315, 3, 315, 3, 315, 3, 315, 3,
+	316, 3, 316, 3, 316, 3, 316, 3, 316, 3, 316, 3, 316, 3, 317, 3, 317, 3,
+	317, 3, 317, 3, 318, 3, 318, 3, 318, 3, 318, 3, 318, 3, 318, 3, 319, 3,
+	319, 3, 319, 3, 319, 3, 319, 3, 320, 3, 320, 3, 320, 3, 320, 3, 320, 3,
+	320, 3, 320, 3, 320, 3, 321, 3, 321, 3, 321, 3, 321, 3, 321, 3, 321, 3,
+	322, 3, 322, 3, 322, 3, 322, 3, 322, 3, 322, 3, 323, 3, 323, 3, 323, 3,
+	323, 3, 323, 3, 323, 3, 323, 3, 323, 3, 323, 3, 324, 3, 324, 3, 324, 3,
+	324, 3, 324, 3, 324, 3, 325, 3, 325, 3, 325, 3, 325, 3, 325, 3, 325, 3,
+	325, 3, 325, 3, 326, 3, 326, 3, 326, 3, 326, 3, 326, 3, 326, 3, 326, 3,
+	326, 3, 327, 3, 327, 3, 327, 3, 327, 3, 327, 3, 327, 3, 327, 3, 327, 3,
+	327, 3, 328, 3, 328, 3, 328, 3, 328, 3, 328, 3, 328, 3, 328, 3, 328, 3,
+	328, 3, 328, 3, 328, 3, 328, 3, 328, 3, 328, 3, 329, 3, 329, 3, 329, 3,
+	329, 3, 329, 3, 329, 3, 329, 3, 330, 3, 330, 3, 330, 3, 330, 3, 330, 3,
+	330, 3, 330, 3, 330, 3, 330, 3, 330, 3, 330, 3, 330, 3, 330, 3, 331, 3,
+	331, 3, 331, 3, 331, 3, 331, 3, 331, 3, 331, 3, 332, 3, 332, 3, 332, 3,
+	332, 3, 332, 3, 332, 3, 333, 3, 333, 3, 333, 3, 333, 3, 333, 3, 333, 3,
+	333, 3, 333, 3, 333, 3, 334, 3, 334, 3, 334, 3, 334, 3, 334, 3, 335, 3,
+	335, 3, 335, 3, 335, 3, 335, 3, 335, 3, 335, 3, 335, 3, 336, 3, 336, 3,
+	336, 3, 336, 3, 336, 3, 336, 3, 336, 3, 336, 3, 336, 3, 336, 3, 336, 3,
+	336, 3, 336, 3, 336, 3, 337, 3, 337, 3, 337, 3, 337, 3, 337, 3, 337, 3,
+	337, 3, 337, 3, 337, 3, 337, 3, 337, 3, 337, 3, 338, 3, 338, 3, 338, 3,
+	338, 3, 338, 3, 338, 3, 338, 3, 338, 3, 339, 3, 339, 3, 339, 3, 339, 3,
+	339, 3, 339, 3, 339, 3, 340, 3, 340, 3, 340, 3, 340, 3, 340, 3, 340, 3,
+	340, 3, 340, 3, 341, 3, 341, 3, 341, 3, 341, 3, 341, 3, 341, 3, 341, 3,
+	341, 3, 341, 3, 341, 3, 341, 3, 342, 3, 342, 3, 342, 3, 342, 3, 342, 3,
+	342, 3, 342, 3, 342, 3, 342, 3, 342, 3, 342, 3, 343, 3, 343, 3, 343, 3,
+	343, 3, 343, 3, 343, 3, 343, 3, 343, 3, 343, 3, 343, 3, 343, 3, 343, 3,
+	344, 3, 344, 3, 344, 3, 344, 3, 344, 3, 344, 3, 344, 3, 344, 3, 344, 3,
+	344, 3, 344, 3, 345, 3, 345, 3, 345, 3, 345, 3, 345, 3, 345, 3, 345, 3,
+	345, 3, 345, 3, 345, 3, 345, 3, 346, 3, 346, 3, 346, 3, 346, 3, 346, 3,
+	346, 3, 346, 3, 346, 3, 346, 3, 346, 3, 346, 3, 347, 3, 347, 3, 347, 3,
+	347, 3, 347, 3, 347, 3, 347, 3, 347, 3, 347, 3, 347, 3, 347, 3, 347, 3,
+	347, 3, 347, 3, 347, 3, 347, 3, 347, 3, 347, 3, 347, 3, 348, 3, 348, 3,
+	348, 3, 348, 3, 348, 3, 348, 3, 348, 3, 348, 3, 348, 3, 348, 3, 348, 3,
+	348, 3, 348, 3, 348, 3, 348, 3, 348, 3, 348, 3, 348, 3, 349, 3, 349, 3,
+	349, 3, 349, 3, 349, 3, 349, 3, 349, 3, 349, 3, 349, 3, 349, 3, 349, 3,
+	349, 3, 349, 3, 349, 3, 349, 3, 349, 3, 350, 3, 350, 3, 350, 3, 350, 3,
+	350, 3, 350, 3, 350, 3, 350, 3, 350, 3, 351, 3, 351, 3, 351, 3, 351, 3,
+	351, 3, 351, 3, 351, 3, 351, 3, 352, 3, 352, 3, 352, 3, 352, 3, 352, 3,
+	352, 3, 352, 3, 352, 3, 352, 3, 352, 3, 352, 3, 352, 3, 352, 3, 353, 3,
+	353, 3, 353, 3, 353, 3, 353, 3, 354, 3, 354, 3, 354, 3, 354, 3, 355, 3,
+	355, 3, 355, 3, 355, 3, 355, 3, 355, 3, 355, 3, 355, 3, 355, 3, 355, 3,
+	355, 3, 355, 3, 356, 3, 356, 3, 356, 3, 356, 3, 356, 3, 357, 3, 357, 3,
+	357, 3, 357, 3, 357, 3, 357, 3, 357, 3, 357, 3, 357, 3, 358, 3, 358, 3,
+	358, 3, 358, 3, 358, 3, 358, 3, 358, 3, 358, 3, 358, 3, 358, 3, 358, 3,
+	359, 3, 359, 3, 359, 3, 359, 3, 359, 3, 359, 3, 359, 3, 359, 3, 359, 3,
+	359, 3, 359, 3, 359, 3, 359, 3, 360, 3, 360, 3, 360, 3, 360, 3, 360, 3,
+	360, 3, 360, 3, 360, 3, 361, 3, 361, 3, 361, 3, 361, 3, 361, 3, 361, 3,
+	361, 3, 361, 3, 361, 3, 361, 3, 361, 3, 361, 3, 361, 3, 361, 3, 361, 3,
+	361, 3, 362, 3, 362, 3, 362, 3, 362, 3, 362, 3, 362, 3, 362, 3, 362, 3,
+	362, 3, 362, 3, 362, 3, 362, 3, 362, 3, 363, 3, 363, 3, 363, 3, 363, 3,
+	363, 3, 363, 3, 363, 3, 363, 3, 363, 3, 363, 3, 364, 3, 364, 3, 364, 3,
+	364, 3, 364, 3, 364, 3, 364, 3, 364, 3, 365, 3, 365, 3, 365, 3, 365, 3,
+	365, 3, 365, 3, 365, 3, 365, 3, 366, 3, 366, 3, 366, 3, 366, 3, 366, 3,
+	367, 3, 367, 3, 367, 3, 368, 3, 368, 3, 368, 3, 368, 3, 368, 3, 368, 3,
+	368, 3, 368, 3, 368, 3, 369, 3, 369, 3, 369, 3, 369, 3, 369, 3, 369, 3,
+	369, 3, 369, 3, 369, 3, 369, 3, 370, 3, 370, 3, 370, 3, 370, 3, 370, 3,
+	370, 3, 370, 3, 370, 3, 371, 3, 371, 3, 371, 3, 371, 3, 371, 3, 371, 3,
+	371, 3, 372, 3, 372, 3, 372, 3, 372, 3, 372, 3, 372, 3, 372, 3, 372, 3,
+	372, 3, 372, 3, 372, 3, 373, 3, 373, 3, 373, 3, 373, 3, 374, 3, 374, 3,
+	374, 3, 374, 3, 374, 3, 375, 3, 375, 3, 375, 3, 375, 3, 375, 3, 375, 3,
+	375, 3, 376, 3, 376, 3, 376, 3, 376, 3, 376, 3, 376, 3, 376, 3, 376, 3,
+	377, 3, 377, 3, 377, 3, 377, 3, 377, 3, 377, 3, 378, 3, 378, 3, 378, 3,
+	378, 3, 378, 3, 378, 3, 378, 3, 379, 3, 379, 3, 379, 3, 379, 3, 379, 3,
+	379, 3, 379, 3, 380, 3, 380, 3, 380, 3, 380, 3, 380, 3, 381, 3, 381, 3,
+	381, 3, 381, 3, 381, 3, 381, 3, 382, 3, 382, 3, 382, 3, 382, 3, 382, 3,
+	382, 3, 382, 3, 383, 3, 383, 3, 383, 3, 383, 3, 383, 3, 383, 3, 384, 3,
+	384, 3, 384, 3, 384, 3, 384, 3, 384, 3, 384, 3, 384, 3, 384, 3, 385, 3,
+	385, 3, 385, 3, 385, 3, 385, 3, 385, 3, 385, 3, 385, 3, 385, 3, 385, 3,
+	386, 3, 386, 3, 386, 3, 386, 3, 386, 3, 386, 3, 386, 3, 387, 3, 387, 3,
+	387, 3, 387, 3, 387, 3, 387, 3, 387, 3, 388, 3, 388, 3, 388, 3, 388, 3,
+	388, 3, 388, 3, 388, 3, 388, 3, 388, 3, 389, 3, 389, 3, 389, 3, 389, 3,
+	389, 3, 389, 3, 389, 3, 389, 3, 389, 3, 389, 3, 389, 3, 389, 3, 390, 3,
+	390, 3, 390, 3, 390, 3, 390, 3, 391, 3, 391, 3, 391, 3, 391, 3, 391, 3,
+	391, 3, 391, 3, 392, 3, 392, 3, 392, 3, 392, 3, 392, 3, 392, 3, 392, 3,
+	393, 3, 393, 3, 393, 3, 393, 3, 393, 3, 393, 3, 393, 3, 393, 3, 393, 3,
+	393, 3, 393, 3, 393, 3, 393, 3, 393, 3, 393, 3, 393, 3, 394, 3, 394, 3,
+	394, 3, 394, 3, 394, 3, 394, 3, 394, 3, 395, 3, 395, 3, 395, 3, 395, 3,
+	395, 3, 395, 3, 396, 3, 396, 3, 396, 3, 396, 3, 396, 3, 396, 3, 397, 3,
+	397, 3, 397, 3, 397, 3, 397, 3, 397, 3, 398, 3, 398, 3, 398, 3, 398, 3,
+	398, 3, 398, 3, 398, 3, 398, 3, 399, 3, 399, 3, 399, 3, 399, 3, 399, 3,
+	399, 3, 400, 3, 400, 3, 400, 3, 400, 3, 400, 3, 401, 3, 401, 3, 401, 3,
+	401, 3, 401, 3, 401, 3, 401, 3, 401, 3, 401, 3, 402, 3, 402, 3, 402, 3,
+	402, 3, 402, 3, 402, 3, 402, 3, 402, 3, 403, 3, 403, 3, 403, 3, 403, 3,
+	403, 3, 403, 3, 403, 3, 404, 3, 404, 3, 404, 3, 404, 3, 404, 3, 404, 3,
+	404, 3, 405, 3, 405, 3, 405, 3, 405, 3, 405, 3, 405, 3, 405, 3, 405, 3,
+	405, 3, 405, 3, 405, 3, 405, 3, 405, 3, 405, 3, 405, 3, 405, 3, 405, 3,
+	405, 3, 406, 3, 406, 3, 406, 3, 406, 3, 406, 3, 406, 3, 406, 3, 406, 3,
+	407, 3, 407, 3, 407, 3, 407, 3, 407, 3, 408, 3, 408, 3, 408, 3, 408, 3,
+	408, 3, 409, 3, 409, 3, 409, 3, 409, 3, 409, 3, 410, 3, 410, 3, 410, 3,
+	410, 3, 410, 3, 410, 3, 411, 3, 411, 3, 411, 3, 411, 3, 411, 3, 411, 3,
+	411, 3, 411, 3, 411, 3, 411, 3, 411, 3, 412, 3, 412, 3, 412, 3, 412, 3,
+	412, 3, 412, 3, 412, 3, 412, 3, 412, 3, 412, 3, 412, 3, 412, 3, 412, 3,
+	412, 3, 412, 3, 412, 3, 412, 3, 412, 3, 413, 3, 413, 3, 413, 3, 413, 3,
+	413, 3, 413, 3, 413, 3, 414, 3, 414, 3, 414, 3, 414, 3, 414, 3, 414, 3,
+	414, 3, 414, 3, 415, 3, 415, 3, 415, 3, 415, 3, 415, 3, 415, 3, 415, 3,
+	415, 3, 415, 3, 415, 3, 415, 3, 415, 3, 415, 3, 416, 3, 416, 3, 416, 3,
+	416, 3, 416, 3, 416, 3, 416, 3, 416, 3, 417, 3, 417, 3, 417, 3, 417, 3,
+	417, 3, 417, 3, 417, 3, 417, 3, 417, 3, 417, 3, 417, 3, 417, 3, 417, 3,
+	417, 3, 418, 3, 418, 3, 418, 3, 418, 3, 418, 3, 418, 3, 418, 3, 418, 3,
+	419, 3, 419, 3, 419, 3, 419, 3, 419, 3, 419, 3, 419, 3, 419, 3, 419, 3,
+	420, 3, 420, 3, 420, 3, 420, 3, 420, 3, 420, 3, 420, 3, 420, 3, 421, 3,
+	421, 3, 421, 3, 421, 3, 421, 3, 421, 3, 421, 3, 421, 3, 421, 3, 421, 3,
+	422, 3, 422, 3, 422, 3, 422, 3, 422, 3, 422, 3, 422, 3, 422, 3, 423, 3,
+	423, 3, 423, 3, 424, 3, 424, 3, 424, 3, 424, 3, 424, 3, 424, 3, 424, 3,
+	424, 3, 424, 3, 424, 3, 425, 3, 425, 3, 425, 3, 425, 3, 426, 3, 426, 3,
+	426, 3, 426, 3, 426, 3, 426, 3, 426, 3, 426, 3, 426, 3, 426, 3, 427, 3,
+	427, 3, 427, 3, 427, 3, 427, 3, 427, 3, 427, 3, 428, 3, 428, 3, 428, 3,
+	428, 3, 428, 3, 429, 3, 429, 3, 429, 3, 429, 3, 429, 3, 429, 3, 429, 3,
+	429, 3, 429, 3, 429, 3, 429, 3, 429, 3, 429, 3, 429, 3, 429, 3, 430, 3,
+	430, 3, 430, 3, 430, 3, 430, 3, 430, 3, 430, 3, 430, 3, 430, 3, 431, 3,
+	431, 3, 431, 3, 431, 3, 431, 3, 432, 3, 432, 3, 432, 3, 432, 3, 432, 3,
+	432, 3, 432, 3, 433, 3, 433, 3, 433, 3, 433, 3, 433, 3, 434, 3, 434, 3,
+	434, 3, 434, 3, 434, 3, 434, 3, 435, 3, 435, 3, 435, 3, 435, 3, 435, 3,
+	436, 3, 436, 3, 436, 3, 436, 3, 436, 3, 436, 3, 437, 3, 437, 3, 437, 3,
+	437, 3, 437, 3, 437, 3, 437, 3, 437, 3, 438, 3, 438, 3, 438, 3, 438, 3,
+	438, 3, 439, 3, 439, 3, 439, 3, 439, 3, 439, 3, 439, 3, 439, 3, 440, 3,
+	440, 3, 440, 3, 440, 3, 440, 3, 440, 3, 440, 3, 440, 3, 440, 3, 440, 3,
+	440, 3, 440, 3, 440, 3, 440, 3, 440, 3, 440, 3, 440, 3, 440, 3, 440, 3,
+	440, 3, 440, 3, 441, 3, 441, 3, 441, 3, 441, 3, 441, 3, 441, 3, 441, 3,
+	441, 3, 441, 3, 441, 3, 441, 3, 441, 3, 441, 3, 441, 3, 441, 3, 441, 3,
+	441, 3, 441, 3, 441, 3, 441, 3, 441, 3, 442, 3, 442, 3, 442, 3, 442, 3,
+	442, 3, 442, 3, 442, 3, 442, 3, 442, 3, 442, 3, 442, 3, 442, 3, 442, 3,
+	443, 3, 443, 3, 443, 3, 443, 3, 443, 3, 443, 3, 443, 3, 443, 3, 443, 3,
+	443, 3, 443, 3, 443, 3, 443, 3, 443, 3, 443, 3, 443, 3, 443, 3, 443, 3,
+	443, 3, 443, 3, 443, 3, 443, 3, 443, 3, 443, 3, 444, 3, 444, 3, 444, 3,
+	444, 3, 444, 3, 444, 3, 444, 3, 444, 3, 444, 3, 444, 3, 444, 3, 444, 3,
+	445, 3, 445, 3, 445, 3, 445, 3, 445, 3, 445, 3, 445, 3, 445, 3, 445, 3,
+	445, 3, 445, 3, 445, 3, 445, 3, 445, 3, 445, 3, 445, 3, 446, 3, 446, 3,
+	446, 3, 446, 3, 446, 3, 446, 3, 446, 3, 446, 3, 446, 3, 446, 3, 446, 3,
+	446, 3, 446, 3, 446, 3, 446, 3, 447, 3, 447, 3, 447, 3, 447, 3, 447, 3,
+	447, 3, 447, 3, 447, 3, 447, 3, 447, 3, 447, 3, 447, 3, 447, 3, 447, 3,
+	447, 3, 447, 3, 448, 3, 448, 3, 448, 3, 448, 3, 448, 3, 448, 3, 448, 3,
+	448, 3, 448, 3, 448, 3, 448, 3, 448, 3, 449, 3, 449, 3, 449, 3, 449, 3,
+	449, 3, 449, 3, 449, 3, 449, 3, 449, 3, 449, 3, 449, 3, 449, 3, 449, 3,
+	449, 3, 449, 3, 449, 3, 449, 3, 449, 3, 449, 3, 450, 3, 450, 3, 450, 3,
+	450, 3, 450, 3, 450, 3, 450, 3, 450, 3, 450, 3, 450, 3, 450, 3, 451, 3,
+	451, 3, 451, 3, 451, 3, 451, 3, 451, 3, 451, 3, 451, 3, 451, 3, 451, 3,
+	451, 3, 451, 3, 451, 3, 451, 3, 452, 3, 452, 3, 452, 3, 452, 3, 452, 3,
+	452, 3, 452, 3, 452, 3, 452, 3, 452, 3, 452, 3, 452, 3, 452, 3, 452, 3,
+	452, 3, 452, 3, 452, 3, 452, 3, 453, 3, 453, 3, 453, 3, 453, 3, 453, 3,
+	453, 3, 453, 3, 453, 3, 453, 3, 453, 3, 453, 3, 453, 3, 453, 3, 453, 3,
+	453, 3, 453, 3, 454, 3, 454, 3, 454, 3, 454, 3, 454, 3, 454, 3, 454, 3,
+	454, 3, 454, 3, 454, 3, 454, 3, 454, 3, 454, 3, 454, 3, 454, 3, 454, 3,
+	454, 3, 454, 3, 455, 3, 455, 3, 455, 3, 455, 3, 455, 3, 455, 3, 455, 3,
+	455, 3, 455, 3, 455, 3, 455, 3, 455, 3, 455, 3, 455, 3, 455, 3, 456, 3,
+	456, 3, 456, 3, 456, 3, 456, 3, 456, 3, 456, 3, 456, 3, 456, 3, 456, 3,
+	456, 3, 456, 3, 456, 3, 456, 3, 456, 3, 456, 3, 456, 3, 456, 3, 456, 3,
+	457, 3, 457, 3, 457, 3, 457, 3, 457, 3, 457, 3, 457, 3, 457, 3, 457, 3,
+	457, 3, 457, 3, 457, 3, 457, 3, 457, 3, 457, 3, 458, 3, 458, 3, 458, 3,
+	458, 3, 458, 3, 458, 3, 458, 3, 458, 3, 458, 3, 458, 3, 458, 3, 458, 3,
+	458, 3, 458, 3, 458, 3, 458, 3, 458, 3, 458, 3, 458, 3, 459, 3, 459, 3,
+	459, 3, 459, 3, 459, 3, 459, 3, 459, 3, 459, 3, 459, 3, 459, 3, 459, 3,
+	459, 3, 460, 3, 460, 3, 460, 3, 460, 3, 460, 3, 460, 3, 460, 3, 460, 3,
+	460, 3, 460, 3, 460, 3, 460, 3, 460, 3, 460, 3, 460, 3, 460, 3, 460, 3,
+	460, 3, 460, 3, 460, 3, 460, 3, 460, 3, 460, 3, 460, 3, 460, 3, 461, 3,
+	461, 3, 461, 3, 461, 3, 461, 3, 461, 3, 461, 3, 461, 3, 461, 3, 461, 3,
+	461, 3, 461, 3, 461, 3, 461, 3, 461, 3, 461, 3, 461, 3, 461, 3, 461, 3,
+	461, 3, 461, 3, 462, 3, 462, 3, 462, 3, 462, 3, 462, 3, 462, 3, 462, 3,
+	462, 3, 462, 3, 463, 3, 463, 3, 463, 3, 463, 3, 463, 3, 463, 3, 463, 3,
+	463, 3, 463, 3, 464, 3, 464, 3, 464, 3, 464, 3, 464, 3, 464, 3, 464, 3,
+	464, 3, 464, 3, 464, 3, 464, 3, 464, 3, 464, 3, 464, 3, 464, 3, 464, 3,
+	464, 3, 464, 3, 464, 3, 464, 3, 464, 3, 465, 3, 465, 3, 465, 3, 465, 3,
+	465, 3, 465, 3, 465, 3, 465, 3, 465, 3, 465, 3, 465, 3, 465, 3, 465, 3,
+	465, 3, 465, 3, 465, 3, 465, 3, 465, 3, 465, 3, 465, 3, 465, 3, 466, 3,
+	466, 3, 466, 3, 466, 3, 466, 3, 466, 3, 466, 3, 467, 3, 467, 3, 467, 3,
+	467, 3, 467, 3, 467, 3, 467, 3, 468, 3, 468, 3, 468, 3, 468, 3, 468, 3,
+	468, 3, 469, 3, 469, 3, 469, 3, 469, 3, 469, 3, 469, 3, 469, 3, 469, 3,
+	469, 3, 469, 3, 469, 3, 469, 3, 469, 3, 470, 3, 470, 3, 470, 3, 470, 3,
+	471, 3, 471, 3, 471, 3, 471, 3, 471, 3, 471, 3, 471, 3, 471, 3, 472, 3,
+	472, 3, 472, 3, 472, 3, 472, 3, 472, 3, 472, 3, 472, 3, 472, 3, 473, 3,
+	473, 3, 473, 3, 473, 3, 473, 3, 474, 3, 474, 3, 474, 3, 474, 3, 474, 3,
+	474, 3, 474, 3, 475, 3, 475, 3, 475, 3, 475, 3, 475, 3, 475, 3, 476, 3,
+	476, 3, 476, 3, 476, 3, 476, 3, 476, 3, 477, 3, 477, 3, 477, 3, 477, 3,
+	477, 3, 477, 3, 477, 3, 477, 3, 477, 3, 477, 3, 477, 3, 477, 3, 478, 3,
+	478, 3, 478, 3, 478, 3, 478, 3, 479, 3, 479, 3, 479, 3, 479, 3, 479, 3,
+	479, 3, 480, 3, 480, 3, 480, 3, 480, 3, 480, 3, 480, 3, 481, 3, 481, 3,
+	481, 3, 481, 3, 481, 3, 481, 3, 482, 3, 482, 3, 482, 3, 482, 3, 482, 3,
+	483, 3, 483, 3, 483, 3, 484, 3, 484, 3, 484, 3, 484, 3, 484, 3, 484, 3,
+	484, 3, 484, 3, 484, 3, 484, 3, 485, 3, 485, 3, 485, 3, 485, 3, 485, 3,
+	486, 3, 486, 3, 486, 3, 486, 3, 486, 3, 486, 3, 486, 3, 486, 3, 487, 3,
+	487, 3, 487, 3, 487, 3, 487, 3, 487, 3, 487, 3, 488, 3, 488, 3, 488, 3,
+	489, 3, 489, 3, 489, 3, 490, 3, 490, 3, 490, 3, 490, 3, 490, 3, 490, 3,
+	490, 3, 490, 3, 490, 3, 490, 3, 490, 3, 490, 3, 490, 3, 491, 3, 491, 3,
+	491, 3, 491, 3, 492, 3, 492, 3, 492, 3, 492, 3, 492, 3, 492, 3, 492, 3,
+	493, 3, 493, 3, 493, 3, 493, 3, 493, 3, 494, 3, 494, 3, 494, 3, 494, 3,
+	494, 3, 495, 3, 495, 3, 495, 3, 495, 3, 495, 3, 495, 3, 495, 3, 495, 3,
+	495, 3, 495, 3, 495, 3, 495, 3, 495, 3, 495, 3, 495, 3, 495, 3, 496, 3,
+	496, 3, 496, 3, 496, 3, 496, 3, 496, 3, 496, 3, 496, 3, 497, 3, 497, 3,
+	497, 3, 497, 3, 497, 3, 497, 3, 498, 3, 498, 3, 498, 3, 498, 3, 498, 3,
+	498, 3, 498, 3, 498, 3, 498, 3, 498, 3, 499, 3, 499, 3, 499, 3, 499, 3,
+	499, 3, 500, 3, 500, 3, 500, 3, 500, 3, 500, 3, 500, 3, 500, 3, 501, 3,
+	501, 3, 501, 3, 501, 3, 501, 3, 501, 3, 501, 3, 501, 3, 502, 3, 502, 3,
+	502, 3, 502, 3, 502, 3, 502, 3, 502, 3, 502, 3, 502, 3, 502, 3, 502, 3,
+	502, 3, 502, 3, 503, 3, 503, 3, 503, 3, 503, 3, 503, 3, 503, 3, 503, 3,
+	503, 3, 503, 3, 503, 3, 503, 3, 504, 3, 504, 3, 504, 3, 504, 3, 504, 3,
+	504, 3, 504, 3, 504, 3, 504, 3, 505, 3, 505, 3, 505, 3, 505, 3, 505, 3,
+	505, 3, 506, 3, 506, 3, 506, 3, 506, 3, 506, 3, 506, 3, 506, 3, 507, 3,
+	507, 3, 507, 3, 507, 3, 507, 3, 507, 3, 507, 3, 507, 3, 507, 3, 507, 3,
+	507, 3, 508, 3, 508, 3, 508, 3, 508, 3, 508, 3, 508, 3, 508, 3, 508, 3,
+	509, 3, 509, 3, 509, 3, 509, 3, 509, 3, 510, 3, 510, 3, 510, 3, 510, 3,
+	510, 3, 510, 3, 510, 3, 510, 3, 510, 3, 511, 3, 511, 3, 511, 3, 511, 3,
+	511, 3, 511, 3, 511, 3, 511, 3, 512, 3, 512, 3, 512, 3, 512, 3, 512, 3,
+	512, 3, 512, 3, 512, 3, 512, 3, 513, 3, 513, 3, 513, 3, 513, 3, 513, 3,
+	514, 3, 514, 3, 514, 3, 514, 3, 514, 3, 514, 3, 514, 3, 514, 3, 514, 3,
+	514, 3, 514, 3, 514, 3, 515, 3, 515, 3, 515, 3, 515, 3, 515, 3, 515, 3,
+	515, 3, 515, 3, 516, 3, 516, 3, 516, 3, 516, 3, 516, 3, 516, 3, 516, 3,
+	516, 3, 516, 3, 517, 3, 517, 3, 517, 3, 517, 3, 517, 3, 517, 3, 518, 3,
+	518, 3, 518, 3, 518, 3, 518, 3, 518, 3, 519, 3, 519, 3, 519, 3, 519, 3,
+	519, 3, 519, 3, 520, 3, 520, 3, 520, 3, 520, 3, 520, 3, 520, 3, 520, 3,
+	520, 3, 521, 3, 521, 3, 521, 3, 521, 3, 521, 3, 521, 3, 521, 3, 521, 3,
+	522, 3, 522, 3, 522, 3, 522, 3, 522, 3, 522, 3, 522, 3, 522, 3, 522, 3,
+	522, 3, 522, 3, 522, 3, 522, 3, 522, 3, 522, 3, 522, 3, 522, 3, 523, 3,
+	523, 3, 523, 3, 523, 3, 523, 3, 523, 3, 523, 3, 523, 3, 523, 3, 523, 3,
+	524, 3, 524, 3, 524, 3, 524, 3, 524, 3, 524, 3, 525, 3, 525, 3, 525, 3,
+	525, 3, 525, 3, 525, 3, 525, 3, 525, 3, 525, 3, 525, 3, 525, 3, 525, 3,
+	525, 3, 525, 3, 525, 3, 526, 3, 526, 3, 526, 3, 526, 3, 526, 3, 526, 3,
+	526, 3, 526, 3, 526, 3, 526, 3, 526, 3, 526, 3, 526, 3, 526, 3, 527, 3,
+	527, 3, 527, 3, 527, 3, 527, 3, 527, 3, 527, 3, 527, 3, 527, 3, 528, 3,
+	528, 3, 528, 3, 528, 3, 528, 3, 528, 3, 528, 3, 529, 3, 529, 3, 529, 3,
+	529, 3, 529, 3, 529, 3, 529, 3, 529, 3, 529, 3, 529, 3, 529, 3, 530, 3,
+	530, 3, 530, 3, 530, 3, 530, 3, 530, 3, 530, 3, 531, 3, 531, 3, 531, 3,
+	531, 3, 531, 3, 531, 3, 531, 3, 531, 3, 531, 3, 531, 3, 531, 3, 531, 3,
+	531, 3, 531, 3, 531, 3, 531, 3, 532, 3, 532, 3, 532, 3, 532, 3, 532, 3,
+	532, 3, 532, 3, 532, 3, 532, 3, 532, 3, 532, 3, 532, 3, 532, 3, 532, 3,
+	532, 3, 532, 3, 532, 3, 532, 3, 532, 3, 533, 3, 533, 3, 533, 3, 533, 3,
+	533, 3, 533, 3, 533, 3, 533, 3, 533, 3, 533, 3, 533, 3, 533, 3, 533, 3,
+	533, 3, 533, 3, 533, 3, 533, 3, 533, 3, 533, 3, 533, 3, 534, 3, 534, 3,
+	534, 3, 534, 3, 534, 3, 534, 3, 534, 3, 534, 3, 534, 3, 534, 3, 534, 3,
+	534, 3, 534, 3, 534, 3, 534, 3, 534, 3, 534, 3, 534, 3, 534, 3, 534, 3,
+	534, 3, 534, 3, 534, 3, 535, 3, 535, 3, 535, 3, 535, 3, 535, 3, 535, 3,
+	535, 3, 535, 3, 535, 3, 535, 3, 535, 3, 535, 3, 535, 3, 535, 3, 535, 3,
+	535, 3, 535, 3, 535, 3, 535, 3, 535, 3, 535, 3, 536, 3, 536, 3, 536, 3,
+	536, 3, 536, 3, 536, 3, 536, 3, 536, 3, 536, 3, 536, 3, 536, 3, 536, 3,
+	536, 3, 536, 3, 536, 3, 536, 3, 536, 3, 536, 3, 536, 3, 536, 3, 536, 3,
+	536, 3, 536, 3, 536, 3, 537, 3, 537, 3, 537, 3, 537, 3, 537, 3, 537, 3,
+	537, 3, 537, 3, 537, 3, 537, 3, 537, 3, 537, 3, 537, 3, 537, 3, 537, 3,
+	537, 3, 537, 3, 537, 3, 537, 3, 537, 3, 537, 3, 537, 3, 537, 3, 537, 3,
+	537, 3, 537, 3, 537, 3, 537, 3, 538, 3, 538, 3, 538, 3, 538, 3, 538, 3,
+	538, 3, 538, 3, 538, 3, 538, 3, 538, 3, 538, 3, 538, 3, 539, 3, 539, 3,
+	539, 3, 539, 3, 539, 3, 539, 3, 540, 3, 540, 3, 540, 3, 540, 3, 540, 3,
+	540, 3, 540, 3, 541, 3, 541, 3, 541, 3, 541, 3, 541, 3, 541, 3, 541, 3,
+	541, 3, 541, 3, 541, 3, 541, 3, 541, 3, 541, 3, 541, 3, 541, 3, 541, 3,
+	541, 3, 541, 3, 542, 3, 542, 3, 542, 3, 542, 3, 542, 3, 542, 3, 542, 3,
+	542, 3, 542, 3, 542, 3, 543, 3, 543, 3, 543, 3, 543, 3, 543, 3, 543, 3,
+	543, 3, 543, 3, 544, 3, 544, 3, 544, 3, 544, 3, 544, 3, 545, 3, 545, 3,
+	545, 3, 545, 3, 545, 3, 545, 3, 545, 3, 545, 3, 545, 3, 546, 3, 546, 3,
+	546, 3, 546, 3, 546, 3, 546, 3, 546, 3, 547, 3, 547, 3, 547, 3, 547, 3,
+	547, 3, 547, 3, 547, 3, 548, 3, 548, 3, 548, 3, 548, 3, 549, 3, 549, 3,
+	549, 3, 549, 3, 549, 3, 550, 3, 550, 3, 550, 3, 550, 3, 550, 3, 550, 3,
+	550, 3, 550, 3, 550, 3, 550, 3, 550, 3, 551, 3, 551, 3, 551, 3, 551, 3,
+	551, 3, 551, 3, 551, 3, 551, 3, 551, 3, 551, 3, 552, 3, 552, 3, 552, 3,
+	552, 3, 552, 3, 552, 3, 552, 3, 552, 3, 552, 3, 553, 3, 553, 3, 553, 3,
+	553, 3, 553, 3, 553, 3, 553, 3, 553, 3, 553, 3, 554, 3, 554, 3, 554, 3,
+	554, 3, 554, 3, 554, 3, 554, 3, 555, 3, 555, 3, 555, 3, 555, 3, 555, 3,
+	555, 3, 555, 3, 555, 3, 556, 3, 556, 3, 556, 3, 556, 3, 556, 3, 556, 3,
+	557, 3, 557, 3, 557, 3, 557, 3, 557, 3, 557, 3, 557, 3, 558, 3, 558, 3,
+	558, 3, 558, 3, 558, 3, 558, 3, 558, 3, 559, 3, 559, 3, 559, 3, 559, 3,
+	559, 3, 559, 3, 559, 3, 560, 3, 560, 3, 560, 3, 560, 3, 560, 3, 560, 3,
+	561, 3, 561, 3, 561, 3, 561, 3, 561, 3, 562, 3, 562, 3, 562, 3, 562, 3,
+	562, 3, 562, 3, 562, 3, 562, 3, 562, 3, 563, 3, 563, 3, 563, 3, 563, 3,
+	563, 3, 563, 3, 563, 3, 564, 3, 564, 3, 564, 3, 564, 3, 564, 3, 565, 3,
+	565, 3, 565, 3, 565, 3, 565, 3, 565, 3, 565, 3, 566, 3, 566, 3, 566, 3,
+	566, 3, 566, 3, 566, 3, 566, 3, 567, 3, 567, 3, 567, 3, 567, 3, 567, 3,
+	567, 3, 567, 3, 568, 3, 568, 3, 568, 3, 568, 3, 568, 3, 568, 3, 568, 3,
+	568, 3, 568, 3, 568, 3, 568, 3, 568, 3, 568, 3, 568, 3, 568, 3, 568, 3,
+	569, 3, 569, 3, 569, 3, 569, 3, 569, 3, 569, 3, 569, 3, 569, 3, 569, 3,
+	569, 3, 569, 3, 569, 3, 569, 3, 569, 3, 569, 3, 569, 3, 569, 3, 569, 3,
+	569, 3, 570, 3, 570, 3, 570, 3, 570, 3, 570, 3, 570, 3, 570, 3, 570, 3,
+	570, 3, 570, 3, 570, 3, 570, 3, 570, 3, 570, 3, 570, 3, 570, 3, 570, 3,
+	571, 3, 571, 3, 571, 3, 571, 3, 571, 3, 571, 3, 571, 3, 571, 3, 571, 3,
+	571, 3, 571, 3, 571, 3, 571, 3, 571, 3, 571, 3, 571, 3, 571, 3, 571, 3,
+	572, 3, 572, 3, 572, 3, 572, 3, 572, 3, 572, 3, 572, 3, 572, 3, 572, 3,
+	572, 3, 573, 3, 573, 3, 573, 3, 573, 3, 573, 3, 573, 3, 573, 3, 573, 3,
+	573, 3, 573, 3, 573, 3, 573, 3, 573, 3, 574, 3, 574, 3, 574, 3, 574, 3,
+	574, 3, 574, 3, 574, 3, 574, 3, 574, 3, 574, 3, 574, 3, 575, 3, 575, 3,
+	575, 3, 575, 3, 575, 3, 575, 3, 576, 3, 576, 3, 576, 3, 576, 3, 576, 3,
+	576, 3, 576, 3, 577, 3, 577, 3, 577, 3, 577, 3, 577, 3, 577, 3, 577, 3,
+	577, 3, 577, 3, 577, 3, 577, 3, 577, 3, 577, 3, 577, 3, 577, 3, 577, 3,
+	577, 3, 577, 3, 578, 3, 578, 3, 578, 3, 578, 3, 578, 3, 578, 3, 578, 3,
+	578, 3, 578, 3, 578, 3, 578, 3, 578, 3, 578, 3, 578, 3, 578, 3, 578, 3,
+	578, 3, 579, 3, 579, 3, 579, 3, 579, 3, 579, 3, 579, 3, 579, 3, 579, 3,
+	579, 3, 579, 3, 579, 3, 579, 3, 579, 3, 579, 3, 579, 3, 579, 3, 579, 3,
+	579, 3, 579, 3, 580, 3, 580, 3, 580, 3, 580, 3, 580, 3, 580, 3, 580, 3,
+	581, 3, 581, 3, 581, 3, 581, 3, 581, 3, 582, 3, 582, 3, 582, 3, 582, 3,
+	582, 3, 582, 3, 582, 3, 582, 3, 583, 3, 583, 3, 583, 3, 583, 3, 583, 3,
+	583, 3, 583, 3, 584, 3, 584, 3, 584, 3, 584, 3, 584, 3, 584, 3, 584, 3,
+	585, 3, 585, 3, 585, 3, 585, 3, 585, 3, 585, 3, 585, 3, 585, 3, 585, 3,
+	585, 3, 585, 3, 585, 3, 585, 3, 585, 3, 585, 3, 585, 3, 586, 3, 586, 3,
+	586, 3, 586, 3, 586, 3, 586, 3, 586, 3, 586, 3, 587, 3, 587, 3, 587, 3,
+	587, 3, 587, 3, 587, 3, 587, 3, 587, 3, 587, 3, 587, 3, 587, 3, 587, 3,
+	587, 3, 588, 3, 588, 3, 588, 3, 588, 3, 588, 3, 588, 3, 588, 3, 588, 3,
+	588, 3, 588, 3, 588, 3, 588, 3, 588, 3, 588, 3, 589, 3, 589, 3, 589, 3,
+	589, 3, 589, 3, 589, 3, 589, 3, 589, 3, 590, 3, 590, 3, 590, 3, 590, 3,
+	590, 3, 590, 3, 591, 3, 591, 3, 591, 3, 591, 3, 591, 3, 591, 3, 591, 3,
+	591, 3, 591, 3, 592, 3, 592, 3, 592, 3, 592, 3, 592, 3, 592, 3, 592, 3,
+	592, 3, 592, 3, 592, 3, 592, 3, 593, 3, 593, 3, 593, 3, 593, 3, 593, 3,
+	593, 3, 593, 3, 593, 3, 593, 3, 593, 3, 593, 3, 594, 3, 594, 3, 594, 3,
+	594, 3, 594, 3, 594, 3, 594, 3, 594, 3, 594, 3, 594, 3, 595, 3, 595, 3,
+	595, 3, 595, 3, 595, 3, 595, 3, 595, 3, 595, 3, 595, 3, 595, 3, 596, 3,
+	596, 3, 596, 3, 596, 3, 596, 3, 597, 3, 597, 3, 597, 3, 597, 3, 597, 3,
+	597, 3, 597, 3, 597, 3, 597, 3, 597, 3, 597, 3, 597, 3, 598, 3, 598, 3,
+	598, 3, 598, 3, 598, 3, 598, 3, 598, 3, 598, 3, 598, 3, 598, 3, 598, 3,
+	598, 3, 599, 3, 599, 3, 599, 3, 599, 3, 599, 3, 599, 3, 599, 3, 599, 3,
+	599, 3, 599, 3, 599, 3, 599, 3, 599, 3, 599, 3, 600, 3, 600, 3, 600, 3,
+	600, 3, 600, 3, 600, 3, 600, 3, 600, 3, 600, 3, 601, 3, 601, 3, 601, 3,
+	601, 3, 601, 3, 601, 3, 601, 3, 601, 3, 601, 3, 602, 3, 602, 3, 602, 3,
+	602, 3, 602, 3, 602, 3, 602, 3, 602, 3, 602, 3, 602, 3, 603, 3, 603, 3,
+	603, 3, 603, 3, 603, 3, 603, 3, 603, 3, 603, 3, 603, 3, 604, 3, 604, 3,
+	604, 3, 604, 3, 604, 3, 604, 3, 604, 3, 604, 3, 604, 3, 604, 3, 604, 3,
+	604, 3, 604, 3, 604, 3, 604, 3, 604, 3, 604, 3, 605, 3, 605, 3, 605, 3,
+	605, 3, 605, 3, 605, 3, 605, 3, 605, 3, 605, 3, 605, 3, 606, 3, 606, 3,
+	606, 3, 606, 3, 606, 3, 606, 3, 606, 3, 606, 3, 607, 3, 607, 3, 607, 3,
+	607, 3, 607, 3, 607, 3, 608, 3, 608, 3, 608, 3, 608, 3, 608, 3, 608, 3,
+	608, 3, 608, 3, 609, 3, 609, 3, 609, 3, 609, 3, 609, 3, 610, 3, 610, 3,
+	610, 3, 610, 3, 610, 3, 610, 3, 610, 3, 610, 3, 611, 3, 611, 3, 611, 3,
+	611, 3, 611, 3, 611, 3, 611, 3, 611, 3, 611, 3, 611, 3, 611, 3, 611, 3,
+	611, 3, 611, 3, 611, 3, 612, 3, 612, 3, 612, 3, 612, 3, 612, 3, 612, 3,
+	612, 3, 612, 3, 612, 3, 612, 3, 612, 3, 613, 3, 613, 3, 613, 3, 613, 3,
+	613, 3, 613, 3, 614, 3, 614, 3, 614, 3, 614, 3, 614, 3, 614, 3, 614, 3,
+	614, 3, 614, 3, 614, 3, 615, 3, 615, 3, 615, 3, 615, 3, 615, 3, 616, 3,
+	616, 3, 616, 3, 616, 3, 616, 3, 616, 3, 616, 3, 616, 3, 617, 3, 617, 3,
+	617, 3, 617, 3, 617, 3, 617, 3, 617, 3, 617, 3, 618, 3, 618, 3, 618, 3,
+	618, 3, 618, 3, 619, 3, 619, 3, 619, 3, 619, 3, 619, 3, 619, 3, 619, 3,
+	619, 3, 619, 3, 620, 3, 620, 3, 620, 3, 620, 3, 620, 3, 620, 3, 620, 3,
+	620, 3, 621, 3, 621, 3, 621, 3, 621, 3, 621, 3, 622, 3, 622, 3, 622, 3,
+	622, 3, 622, 3, 622, 3, 622, 3, 622, 3, 623, 3, 623, 3, 623, 3, 623, 3,
+	623, 3, 624, 3, 624, 3, 624, 3, 625, 3, 625, 3, 625, 3, 625, 3, 626, 3,
+	626, 3, 626, 3, 626, 3, 627, 3, 627, 3, 627, 3, 627, 3, 628, 3, 628, 3,
+	628, 3, 628, 3, 629, 3, 629, 3, 629, 3, 629, 3, 630, 3, 630, 3, 630, 3,
+	630, 3, 630, 3, 630, 3, 630, 3, 630, 3, 630, 3, 631, 3, 631, 3, 631, 3,
+	631, 3, 631, 3, 631, 3, 631, 3, 631, 3, 632, 3, 632, 3, 632, 3, 632, 3,
+	632, 3, 632, 3, 633, 3, 633, 3, 633, 3, 633, 3, 634, 3, 634, 3, 634, 3,
+	634, 3, 634, 3, 635, 3, 635, 3, 635, 3, 635, 3, 635, 3, 635, 3, 635, 3,
+	636, 3, 636, 3, 636, 3, 636, 3, 636, 3, 637, 3, 637, 3, 637, 3, 637, 3,
+	637, 3, 637, 3, 637, 3, 638, 3, 638, 3, 638, 3, 638, 3, 638, 3, 638, 3,
+	638, 3, 638, 3, 638, 3, 638, 3, 638, 3, 638, 3, 639, 3, 639, 3, 639, 3,
+	639, 3, 639, 3, 639, 3, 639, 3, 640, 3, 640, 3, 640, 3, 640, 3, 640, 3,
+	640, 3, 640, 3, 640, 3, 641, 3, 641, 3, 641, 3, 641, 3, 641, 3, 641, 3,
+	641, 3, 641, 3, 642, 3, 642, 3, 642, 3, 642, 3, 642, 3, 643, 3, 643, 3,
+	643, 3, 643, 3, 643, 3, 643, 3, 643, 3, 643, 3, 644, 3, 644, 3, 644, 3,
+	644, 3, 644, 3, 644, 3, 644, 3, 645, 3, 645, 3, 645, 3, 645, 3, 645, 3,
+	645, 3, 645, 3, 645, 3, 645, 3, 646, 3, 646, 3, 646, 3, 646, 3, 646, 3,
+	646, 3, 647, 3, 647, 3, 647, 3, 647, 3, 647, 3, 647, 3, 647, 3, 647, 3,
+	647, 3, 647, 3, 647, 3, 648, 3, 648, 3, 648, 3, 648, 3, 648, 3, 648, 3,
+	648, 3, 648, 3, 648, 3, 648, 3, 648, 3, 648, 3, 648, 3, 648, 3, 648, 3,
+	648, 3, 648, 3, 648, 3, 648, 3, 648, 3, 648, 3, 648, 3, 648, 3, 648, 3,
+	648, 3, 648, 3, 648, 3, 649, 3, 649, 3, 649, 3, 649, 3, 649, 3, 649, 3,
+	649, 3, 649, 3, 649, 3, 649, 3, 649, 3, 649, 3, 650, 3, 650, 3, 650, 3,
+	650, 3, 650, 3, 650, 3, 650, 3, 650, 3, 650, 3, 650, 3, 650, 3, 650, 3,
+	650, 3, 651, 3, 651, 3, 651, 3, 651, 3, 651, 3, 651, 3, 651, 3, 651, 3,
+	651, 3, 651, 3, 651, 3, 651, 3, 651, 3, 652, 3, 652, 3, 652, 3, 652, 3,
+	652, 3, 652, 3, 652, 3, 652, 3, 652, 3, 652, 3, 652, 3, 652, 3, 652, 3,
+	652, 3, 652, 3, 652, 3, 652, 3, 652, 3, 652, 3, 652, 3, 652, 3, 652, 3,
+	652, 3, 652, 3, 653, 3, 653, 3, 653, 3, 653, 3, 653, 3, 653, 3, 653, 3,
+	653, 3, 653, 3, 653, 3, 653, 3, 653, 3, 654, 3, 654, 3, 654, 3, 654, 3,
+	654, 3, 654, 3, 654, 3, 654, 3, 654, 3, 654, 3, 654, 3, 654, 3, 654, 3,
+	654, 3, 654, 3, 654, 3, 654, 3, 655, 3, 655, 3, 655, 3, 655, 3, 655, 3,
+	655, 3, 655, 3, 655, 3, 655, 3, 655, 3, 655, 3, 655, 3, 655, 3, 655, 3,
+	655, 3, 655, 3, 655, 3, 655, 3, 655, 3, 655, 3, 655, 3, 656, 3, 656, 3,
+	656, 3, 656, 3, 656, 3, 656, 3, 656, 3, 656, 3, 656, 3, 656, 3, 656, 3,
+	656, 3, 656, 3, 656, 3, 656, 3, 657, 3, 657, 3, 657, 3, 657, 3, 657, 3,
+	657, 3, 657, 3, 657, 3, 657, 3, 657, 3, 657, 3, 657, 3, 657, 3, 657, 3,
+	658, 3, 658, 3, 658, 3, 658, 3, 658, 3, 658, 3, 658, 3, 658, 3, 658, 3,
+	658, 3, 658, 3, 658, 3, 658, 3, 658, 3, 658, 3, 658, 3, 658, 3, 658, 3,
+	658, 3, 658, 3, 658, 3, 658, 3, 659, 3, 659, 3, 659, 3, 659, 3, 659, 3,
+	659, 3, 659, 3, 659, 3, 659, 3, 659, 3, 659, 3, 659, 3, 659, 3, 660, 3,
+	660, 3, 660, 3, 660, 3, 660, 3, 660, 3, 660, 3, 660, 3, 660, 3, 660, 3,
+	660, 3, 660, 3, 660, 3, 661, 3, 661, 3, 661, 3, 661, 3, 661, 3, 661, 3,
+	661, 3, 661, 3, 661, 3, 661, 3, 661, 3, 661, 3, 661, 3, 661, 3, 661, 3,
+	661, 3, 661, 3, 661, 3, 661, 3, 661, 3, 661, 3, 662, 3, 662, 3, 662, 3,
+	662, 3, 662, 3, 662, 3, 662, 3, 662, 3, 662, 3, 662, 3, 662, 3, 662, 3,
+	662, 3, 662, 3, 662, 3, 662, 3, 662, 3, 662, 3, 662, 3, 662, 3, 662, 3,
+	662, 3, 662, 3, 662, 3, 663, 3, 663, 3, 663, 3, 663, 3, 663, 3, 663, 3,
+	663, 3, 663, 3, 663, 3, 663, 3, 663, 3, 663, 3, 663, 3, 663, 3, 663, 3,
+	663, 3, 663, 3, 663, 3, 663, 3, 663, 3, 663, 3, 663, 3, 663, 3, 663, 3,
+	664, 3, 664, 3, 664, 3, 664, 3, 664, 3, 664, 3, 664, 3, 664, 3, 664, 3,
+	664, 3, 664, 3, 664, 3, 664, 3, 664, 3, 664, 3, 664, 3, 664, 3, 664, 3,
+	664, 3, 664, 3, 664, 3, 664, 3, 664, 3, 665, 3, 665, 3, 665, 3, 665, 3,
+	665, 3, 665, 3, 665, 3, 665, 3, 665, 3, 665, 3, 665, 3, 665, 3, 665, 3,
+	665, 3, 665, 3, 665, 3, 666, 3, 666, 3, 666, 3, 666, 3, 666, 3, 666, 3,
+	666, 3, 666, 3, 666, 3, 666, 3, 666, 3, 666, 3, 666, 3, 666, 3, 666, 3,
+	666, 3, 666, 3, 666, 3, 666, 3, 666, 3, 666, 3, 666, 3, 666, 3, 666, 3,
+	666, 3, 666, 3, 666, 3, 667, 3, 667, 3, 667, 3, 667, 3, 667, 3, 667, 3,
+	667, 3, 667, 3, 667, 3, 667, 3, 667, 3, 667, 3, 667, 3, 667, 3, 667, 3,
+	667, 3, 667, 3, 667, 3, 667, 3, 667, 3, 668, 3, 668, 3, 668, 3, 668, 3,
+	668, 3, 668, 3, 668, 3, 668, 3, 668, 3, 668, 3, 668, 3, 668, 3, 668, 3,
+	668, 3, 668, 3, 668, 3, 668, 3, 668, 3, 668, 3, 668, 3, 668, 3, 668, 3,
+	668, 3, 668, 3, 669, 3, 669, 3, 669, 3, 669, 3, 669, 3, 669, 3, 669, 3,
+	669, 3, 669, 3, 669, 3, 669, 3, 669, 3, 669, 3, 669, 3, 669, 3, 669, 3,
+	669, 3, 669, 3, 669, 3, 669, 3, 669, 3, 670, 3, 670, 3, 670, 3, 670, 3,
+	670, 3, 670, 3, 670, 3, 670, 3, 670, 3, 670, 3, 670, 3, 670, 3, 670, 3,
+	670, 3, 670, 3, 670, 3, 670, 3, 670, 3, 670, 3, 670, 3, 671, 3, 671, 3,
+	671, 3, 671, 3, 671, 3, 671, 3, 671, 3, 671, 3, 671, 3, 671, 3, 671, 3,
+	672, 3, 672, 3, 672, 3, 672, 3, 672, 3, 672, 3, 672, 3, 672, 3, 672, 3,
+	672, 3, 672, 3, 672, 3, 672, 3, 672, 3, 672, 3, 672, 3, 672, 3, 672, 3,
+	672, 3, 672, 3, 672, 3, 672, 3, 672, 3, 672, 3, 672, 3, 673, 5, 673, 8461,
+	10, 673, 3, 673, 3, 673, 3, 673, 3, 673, 3, 673, 3, 673, 3, 673, 3, 673,
+	3, 673, 3, 673, 3, 673, 3, 673, 3, 673, 3, 673, 3, 673, 3, 673, 3, 673,
+	3, 673, 3, 673, 3, 673, 3, 673, 3, 673, 3, 673, 3, 673, 3, 673, 5, 673,
+	8488, 10, 673, 3, 674, 3, 674, 3, 674, 3, 674, 3, 674, 3, 674, 3, 674,
+	3, 674, 3, 674, 3, 674, 3, 674, 3, 674, 3, 675, 3, 675, 3, 675, 3, 675,
+	3, 675, 3, 675, 3, 675, 3, 675, 3, 675, 3, 675, 3, 675, 3, 675, 3, 675,
+	3, 676, 3, 676, 3, 676, 3, 676, 3, 676, 3, 676, 3, 676, 3, 676, 3, 676,
+	3, 676, 3, 676, 3, 676, 3, 676, 3, 676, 3, 676, 3, 676, 3, 676, 3, 676,
+	3, 676, 3, 676, 3, 676, 3, 676, 3, 676, 3, 677, 3, 677, 3, 677, 3, 677,
+	3, 677, 3, 677, 3, 677, 3, 677, 3, 677, 3, 677, 3, 677, 3, 677, 3, 677,
+	3, 677, 3, 677, 3, 677, 3, 677, 3, 677, 3, 677, 3, 677, 3, 677, 3, 677,
+	3, 677, 3, 678, 3, 678, 3, 678, 3, 678, 3, 678, 3, 678, 3, 678, 3, 678,
+	3, 678, 3, 678, 3, 678, 3, 678, 3, 678, 3, 678, 3, 678, 3, 678, 3, 678,
+	3, 678, 3, 678, 3, 678, 3, 679, 3, 679, 3, 679, 3, 679, 3, 679, 3, 679,
+	3, 679, 3, 679, 3, 679, 3, 679, 3, 679, 3, 679, 3, 679, 3, 679, 3, 679,
+	3, 679, 3, 679, 3, 680, 3, 680, 3, 680, 3, 680, 3, 680, 3, 680, 3, 680,
+	3, 680, 3, 680, 3, 681, 3, 681, 3, 681, 3, 681, 3, 681, 3, 681, 3, 682,
+	3, 682, 3, 682, 3, 682, 3, 682, 3, 683, 3, 683, 3, 683, 3, 683, 3, 683,
+	3, 683, 3, 683, 3, 684, 3, 684, 3, 684, 3, 684, 3, 684, 3, 684, 3, 684,
+	3, 685, 3, 685, 3, 685, 3, 685, 3, 685, 3, 685, 3, 685, 3, 686, 3, 686,
+	3, 686, 3, 686, 3, 686, 3, 686, 3, 686, 3, 687, 3, 687, 3, 687, 3, 687,
+	3, 687, 3, 687, 3, 688, 3, 688, 3, 688, 3, 688, 3, 688, 3, 688, 3, 689,
+	3, 689, 3, 689, 3, 689, 3, 689, 3, 689, 3, 690, 3, 690, 3, 690, 3, 690,
+	3, 690, 3, 690, 3, 691, 3, 691, 3, 691, 3, 691, 3, 691, 3, 692, 3, 692,
+	3, 692, 3, 692, 3, 692, 3, 692, 3, 692, 3, 692, 3, 693, 3, 693, 3, 693,
+	3, 693, 3, 693, 3, 693, 3, 694, 3, 694, 3, 694, 3, 694, 3, 694, 3, 694,
+	3, 694, 3, 695, 3, 695, 3, 695, 3, 695, 3, 696, 3, 696, 3, 696, 3, 696,
+	3, 696, 3, 696, 3, 696, 3, 696, 3, 697, 3, 697, 3, 697, 3, 697, 3, 697,
+	3, 697, 3, 698, 3, 698, 3, 698, 3, 698, 3, 698, 3, 698, 3, 698, 3, 699,
+	3, 699, 3, 699, 3, 699, 3, 700, 3, 700, 3, 700, 3, 700, 3, 700, 3, 700,
+	3, 700, 3, 700, 3, 701, 3, 701, 3, 701, 3, 701, 3, 701, 3, 701, 3, 702,
+	3, 702, 3, 702, 3, 702, 3, 702, 3, 702, 3, 703, 3, 703, 3, 703, 3, 703,
+	3, 703, 3, 703, 3, 703, 3, 704, 3, 704, 3, 704, 3, 704, 3, 704, 3, 704,
+	3, 704, 3, 705, 3, 705, 3, 705, 3, 705, 3, 705, 3, 705, 3, 705, 3, 706,
+	3, 706, 3, 706, 3, 706, 3, 706, 3, 706, 3, 706, 3, 707, 3, 707, 3, 707,
+	3, 707, 3, 707, 3, 707, 3, 708, 3, 708, 3, 708, 3, 708, 3, 708, 3, 708,
+	3, 708, 3, 708, 3, 708, 3, 709, 3, 709, 3, 709, 3, 709, 3, 709, 3, 710,
+	3, 710, 3, 710, 3, 710, 3, 710, 3, 711, 3, 711, 3, 711, 3, 711, 3, 711,
+	3, 711, 3, 711, 3, 712, 3, 712, 3, 712, 3, 712, 3, 712, 3, 713, 3, 713,
+	3, 713, 3, 713, 3, 713, 3, 714, 3, 714, 3, 714, 3, 714, 3, 714, 3, 714,
+	3, 715, 3, 715, 3, 715, 3, 715, 3, 715, 3, 715, 3, 715, 3, 715, 3, 716,
+	3, 716, 3, 716, 3, 716, 3, 716, 3, 716, 3, 717, 3, 717, 3, 717, 3, 717,
+	3, 717, 3, 718, 3, 718, 3, 718, 3, 718, 3, 718, 3, 718, 3, 718, 3, 718,
+	3, 719, 3, 719, 3, 719, 3, 719, 3, 719, 3, 719, 3, 719, 3, 719, 3, 720,
+	3, 720, 3, 720, 3, 720, 3, 720, 3, 720, 3, 720, 3, 720, 3, 721, 3, 721,
+	3, 721, 3, 721, 3, 721, 3, 721, 3, 721, 3, 721, 3, 721, 3, 721, 3, 722,
+	3, 722, 3, 722, 3, 722, 3, 723, 3, 723, 3, 723, 3, 723, 3, 723, 3, 723,
+	3, 723, 3, 723, 3, 723, 3, 723, 3, 724, 3, 724, 3, 724, 3, 724, 3, 724,
+	3, 724, 3, 724, 3, 725, 3, 725, 3, 725, 3, 725, 3, 725, 3, 725, 3, 725,
+	3, 726, 3, 726, 3, 726, 3, 726, 3, 726, 3, 726, 3, 726, 3, 726, 3, 726,
+	3, 726, 3, 726, 3, 727, 3, 727, 3, 727, 3, 727, 3, 727, 3, 727, 3, 727,
+	3, 728, 3, 728, 3, 728, 3, 728, 3, 729, 3, 729, 3, 729, 3, 729, 3, 729,
+	3, 729, 3, 729, 3, 729, 3, 729, 3, 729, 3, 729, 3, 730, 3, 730, 3, 730,
+	3, 730, 3, 730, 3, 730, 3, 730, 3, 730, 3, 730, 3, 730, 3, 730, 3, 730,
+	3, 730, 3, 730, 3, 730, 3, 730, 3, 730, 3, 730, 3, 730, 3, 731, 3, 731,
+	3, 731, 3, 731, 3, 731, 3, 731, 3, 731, 3, 732, 3, 732, 3, 732, 3, 732,
+	3, 732, 3, 732, 3, 732, 3, 732, 3, 732, 3, 732, 3, 732, 3, 733, 3, 733,
+	3, 733, 3, 733, 3, 733, 3, 733, 3, 733, 3, 733, 3, 733, 3, 733, 3, 734,
+	3, 734, 3, 734, 3, 734, 3, 734, 3, 734, 3, 734, 3, 734, 3, 734, 3, 734,
+	3, 734, 3, 734, 3, 735, 3, 735, 3, 735, 3, 735, 3, 735, 3, 735, 3, 735,
+	3, 735, 3, 735, 3, 735, 3, 735, 3, 735, 3, 735, 3, 736, 3, 736, 3, 736,
+	3, 736, 3, 736, 3, 736, 3, 736, 3, 736, 3, 736, 3, 736, 3, 736, 3, 736,
+	3, 736, 3, 736, 3, 736, 3, 736, 3, 736, 3, 736, 3, 736, 3, 737, 3, 737,
+	3, 737, 3, 737, 3, 737, 3, 737, 3, 737, 3, 737, 3, 737, 3, 737, 3, 737,
+	3, 737, 3, 737, 3, 737, 3, 737, 3, 738, 3, 738, 3, 738, 3, 738, 3, 738,
+	3, 738, 3, 738, 3, 738, 3, 738, 3, 739, 3, 739, 3, 739, 3, 739, 3, 739,
+	3, 739, 3, 739, 3, 739, 3, 739, 3, 739, 3, 739, 3, 740, 3, 740, 3, 740,
+	3, 740, 3, 740, 3, 740, 3, 740, 3, 740, 3, 740, 3, 740, 3, 740, 3, 740,
+	3, 740, 3, 740, 3, 740, 3, 740, 3, 741, 3, 741, 3, 741, 3, 741, 3, 741,
+	3, 741, 3, 741, 3, 741, 3, 741, 3, 741, 3, 741, 3, 742, 3, 742, 3, 742,
+	3, 742, 3, 742, 3, 742, 3, 742, 3, 742, 3, 742, 3, 742, 3, 742, 3, 742,
+	3, 742, 3, 743, 3, 743, 3, 743, 3, 743, 3, 743, 3, 743, 3, 744, 3, 744,
+	3, 744, 3, 744, 3, 744, 3, 744, 3, 744, 3, 744, 3, 745, 3, 745, 3, 745,
+	3, 745, 3, 746, 3, 746, 3, 746, 3, 746, 3, 746, 3, 747, 3, 747, 3, 747,
+	3, 747, 3, 747, 3, 747, 3, 747, 3, 747, 3, 748, 3, 748, 3, 748, 3, 748,
+	3, 748, 3, 748, 3, 748, 3, 748, 3, 749, 3, 749, 3, 749, 3, 749, 3, 749,
+	3, 749, 3, 749, 3, 749, 3, 749, 3, 749, 3, 749, 3, 749, 3, 750, 3, 750,
+	3, 750, 3, 750, 3, 750, 3, 750, 3, 750, 3, 750, 3, 750, 3, 750, 3, 750,
+	3, 750, 3, 751, 3, 751, 3, 751, 3, 751, 3, 751, 3, 752, 3, 752, 3, 752,
+	3, 752, 3, 752, 3, 752, 3, 752, 3, 752, 3, 752, 3, 753, 3, 753, 3, 753,
+	3, 753, 3, 753, 3, 754, 3, 754, 3, 754, 3, 754, 3, 754, 3, 754, 3, 754,
+	3, 755, 3, 755, 3, 755, 3, 755, 3, 755, 3, 755, 3, 756, 3, 756, 3, 756,
+	3, 756, 3, 756, 3, 756, 3, 757, 3, 757, 3, 757, 3, 757, 3, 757, 3, 757,
+	3, 757, 3, 757, 3, 757, 3, 757, 3, 757, 3, 757, 3, 757, 3, 757, 3, 757,
+	3, 757, 3, 757, 3, 757, 3, 757, 3, 758, 3, 758, 3, 758, 3, 758, 3, 758,
+	3, 758, 3, 758, 3, 758, 3, 758, 3, 758, 3, 758, 3, 758, 3, 758, 3, 758,
+	3, 758, 3, 758, 3, 758, 3, 758, 3, 759, 3, 759, 3, 759, 3, 759, 3, 759,
+	3, 759, 3, 759, 3, 759, 3, 759, 3, 759, 3, 759, 3, 759, 3, 759, 3, 759,
+	3, 759, 3, 759, 3, 759, 3, 759, 3, 759, 3, 760, 3, 760, 3, 760, 3, 760,
+	3, 760, 3, 760, 3, 760, 3, 760, 3, 760, 3, 760, 3, 760, 3, 760, 3, 760,
+	3, 760, 3, 760, 3, 760, 3, 761, 3, 761, 3, 761, 3, 761, 3, 761, 3, 761,
+	3, 761, 3, 761, 3, 761, 3, 761, 3, 761, 3, 761, 3, 761, 3, 761, 3, 761,
+	3, 761, 3, 761, 3, 761, 3, 762, 3, 762, 3, 762, 3, 762, 3, 762, 3, 763,
+	3, 763, 3, 763, 3, 763, 3, 763, 3, 763, 3, 764, 3, 764, 3, 764, 3, 764,
+	3, 764, 3, 764, 3, 764, 3, 764, 3, 764, 3, 764, 3, 765, 3, 765, 3, 765,
+	3, 765, 3, 766, 3, 766, 3, 766, 3, 766, 3, 766, 3, 766, 3, 766, 3, 766,
+	3, 766, 3, 766, 3, 767, 3, 767, 3, 767, 3, 767, 3, 767, 3, 767, 3, 767,
+	3, 767, 3, 767, 3, 767, 3, 767, 3, 768, 3, 768, 3, 768, 3, 768, 3, 768,
+	3, 768, 3, 768, 3, 769, 3, 769, 3, 769, 3, 769, 3, 769, 3, 769, 3, 769,
+	3, 769, 3, 769, 3, 769, 3, 769, 3, 769, 3, 769, 3, 770, 3, 770, 3, 770,
+	3, 770, 3, 770, 3, 771, 3, 771, 3, 771, 3, 771, 3, 771, 3, 771, 3, 771,
+	3, 771, 3, 772, 3, 772, 3, 772, 3, 772, 3, 772, 3, 772, 3, 772, 3, 772,
+	3, 772, 3, 773, 3, 773, 3, 773, 3, 773, 3, 773, 3, 773, 3, 773, 3, 773,
+	3, 773, 3, 773, 3, 773, 3, 773, 3, 773, 3, 773, 3, 773, 3, 773, 3, 773,
+	3, 774, 3, 774, 3, 774, 3, 774, 3, 774, 3, 774, 3, 774, 3, 774, 3, 775,
+	3, 775, 3, 775, 3, 775, 3, 775, 3, 775, 3, 775, 3, 775, 3, 775, 3, 775,
+	3, 775, 3, 775, 3, 776, 3, 776, 3, 776, 3, 776, 3, 776, 3, 776, 3, 776,
+	3, 776, 3, 776, 3, 776, 3, 776, 3, 776, 3, 776, 3, 777, 3, 777, 3, 777,
+	3, 777, 3, 777, 3, 777, 3, 777, 3, 777, 3, 777, 3, 777, 3, 778, 3, 778,
+	3, 778, 3, 778, 3, 778, 3, 778, 3, 778, 3, 778, 3, 778, 3, 779, 3, 779,
+	3, 779, 3, 779, 3, 779, 3, 779, 3, 779, 3, 780, 3, 780, 3, 780, 3, 780,
+	3, 780, 3, 780, 3, 780, 3, 780, 3, 780, 3, 780, 3, 781, 3, 781, 3, 781,
+	3, 781, 3, 781, 3, 781, 3, 781, 3, 781, 3, 781, 3, 781, 3, 781, 3, 781,
+	3, 781, 3, 781, 3, 782, 3, 782, 3, 782, 3, 782, 3, 782, 3, 783, 3, 783,
+	3, 783, 3, 783, 3, 783, 3, 783, 3, 783, 3, 783, 3, 783, 3, 783, 3, 783,
+	3, 784, 3, 784, 3, 784, 3, 784, 3, 785, 3, 785, 3, 785, 3, 785, 3, 786,
+	3, 786, 3, 786, 3, 786, 3, 786, 3, 786, 3, 787, 3, 787, 3, 787, 3, 787,
+	3, 787, 3, 787, 3, 787, 3, 787, 3, 787, 3, 787, 3, 787, 3, 787, 3, 787,
+	3, 787, 3, 787, 3, 787, 3, 787, 3, 787, 3, 787, 3, 787, 3, 787, 3, 787,
+	3, 787, 3, 787, 3, 787, 3, 787, 3, 787, 3, 788, 3, 788, 3, 788, 3, 788,
+	3, 788, 3, 788, 3, 788, 3, 788, 3, 788, 3, 788, 3, 788, 3, 788, 3, 788,
+	3, 788, 3, 788, 3, 788, 3, 788, 3, 788, 3, 788, 3, 788, 3, 788, 3, 788,
+	3, 788, 3, 788, 3, 788, 3, 788, 3, 789, 3, 789, 3, 789, 3, 789, 3, 789,
+	3, 789, 3, 789, 3, 789, 3, 789, 3, 789, 3, 789, 3, 789, 3, 789, 3, 789,
+	3, 789, 3, 789, 3, 789, 3, 789, 3, 789, 3, 789, 3, 789, 3, 790, 3, 790,
+	3, 790, 3, 790, 3, 790, 3, 790, 3, 790, 3, 790, 3, 790, 3, 790, 3, 790,
+	3, 790, 3, 790, 3, 790, 3, 791, 3, 791, 3, 791, 3, 791, 3, 791, 3, 791,
+	3, 791, 3, 791, 3, 792, 3, 792, 3, 792, 3, 792, 3, 792, 3, 792, 3, 792,
+	3, 792, 3, 792, 3, 793, 3, 793, 3, 793, 3, 793, 3, 793, 3, 793, 3, 793,
+	3, 793, 3, 793, 3, 793, 3, 793, 3, 793, 3, 794, 3, 794, 3, 794, 3, 794,
+	3, 794, 3, 794, 3, 794, 3, 794, 3, 795, 3, 795, 3, 795, 3, 795, 3, 795,
+	3, 795, 3, 795, 3, 795, 3, 795, 3, 795, 3, 795, 3, 796, 3, 796, 3, 796,
+	3, 796, 3, 796, 3, 796, 3, 796, 3, 796, 3, 796, 3, 796, 3, 797, 3, 797,
+	3, 797, 3, 797, 3, 797, 3, 797, 3, 797, 3, 797, 3, 797, 3, 797, 3, 798,
+	3, 798, 3, 798, 3, 798, 3, 798, 3, 798, 3, 798, 3, 799, 3, 799, 3, 799,
+	3, 799, 3, 799, 3, 799, 3, 799, 3, 799, 3, 800, 3, 800, 3, 800, 3, 800,
+	3, 800, 3, 800, 3, 800, 3, 800, 3, 800, 3, 800, 3, 800, 3, 800, 3, 801,
+	3, 801, 3, 801, 3, 801, 3, 801, 3, 801, 3, 801, 3, 801, 3, 801, 3, 801,
+	3, 801, 3, 801, 3, 802, 3, 802, 3, 802, 3, 802, 3, 802, 3, 802, 3, 802,
+	3, 802, 3, 802, 3, 802, 3, 803, 3, 803, 3, 803, 3, 803, 3, 803, 3, 803,
+	3, 803, 3, 803, 3, 803, 3, 804, 3, 804, 3, 804, 3, 804, 3, 805, 3, 805,
+	3, 805, 3, 805, 3, 805, 3, 805, 3, 805, 3, 806, 3, 806, 3, 806, 3, 806,
+	3, 806, 3, 806, 3, 806, 3, 806, 3, 807, 3, 807, 3, 807, 3, 807, 3, 807,
+	3, 807, 3, 807, 3, 807, 3, 807, 3, 808, 3, 808, 3, 808, 3, 808, 3, 808,
+	3, 808, 3, 808, 3, 808, 3, 808, 3, 809, 3, 809, 3, 809, 3, 809, 3, 809,
+	3, 809, 3, 809, 3, 810, 3, 810, 3, 810, 3, 810, 3, 811, 3, 811, 3, 811,
+	3, 811, 3, 811, 3, 811, 3, 811, 3, 811, 3, 811, 3, 811, 3, 811, 3, 812,
+	3, 812, 3, 812, 3, 812, 3, 812, 3, 812, 3, 812, 3, 812, 3, 812, 3, 812,
+	3, 812, 3, 812, 3, 812, 3, 813, 3, 813, 3, 813, 3, 813, 3, 813, 3, 813,
+	3, 813, 3, 813, 3, 813, 3, 813, 3, 813, 3, 813, 3, 813, 3, 814, 3, 814,
+	3, 814, 3, 814, 3, 814, 3, 814, 3, 815, 3, 815, 3, 815, 3, 815, 3, 815,
+	3, 815, 3, 815, 3, 815, 3, 815, 3, 815, 3, 815, 3, 815, 3, 816, 3, 816,
+	3, 816, 3, 816, 3, 816, 3, 816, 3, 817, 3, 817, 3, 817, 3, 817, 3, 817,
+	3, 817, 3, 817, 3, 818, 3, 818, 3, 818, 3, 818, 3, 818, 3, 818, 3, 818,
+	3, 818, 3, 818, 3, 818, 3, 818, 3, 819, 3, 819, 3, 819, 3, 819, 3, 819,
+	3, 819, 3, 819, 3, 819, 3, 819, 3, 819, 3, 819, 3, 819, 3, 820, 3, 820,
+	3, 820, 3, 820, 3, 820, 3, 820, 3, 820, 3, 820, 3, 820, 3, 820, 3, 821,
+	3, 821, 3, 821, 3, 821, 3, 821, 3, 821, 3, 821, 3, 821, 3, 821, 3, 821,
+	3, 821, 3, 821, 3, 821, 3, 821, 3, 822, 3, 822, 3, 822, 3, 822, 3, 822,
+	3, 822, 3, 822, 3, 822, 3, 822, 3, 822, 3, 822, 3, 822, 3, 822, 3, 822,
+	3, 822, 3, 822, 3, 822, 3, 823, 3, 823, 3, 823, 3, 823, 3, 823, 3, 823,
+	3, 823, 3, 823, 3, 823, 3, 823, 3, 823, 3, 823, 3, 823, 3, 823, 3, 823,
+	3, 823, 3, 824, 3, 824, 3, 824, 3, 824, 3, 824, 3, 824, 3, 824, 3, 824,
+	3, 824, 3, 824, 3, 824, 3, 824, 3, 824, 3, 824, 3, 824, 3, 824, 3, 824,
+	3, 824, 3, 824, 3, 824, 3, 824, 3, 824, 3, 824, 3, 824, 3, 824, 3, 824,
+	3, 824, 3, 825, 3, 825, 3, 825, 3, 825, 3, 825, 3, 825, 3, 825, 3, 825,
+	3, 825, 3, 825, 3, 825, 3, 825, 3, 825, 3, 825, 3, 825, 3, 825, 3, 825,
+	3, 825, 3, 825, 3, 825, 3, 825, 3, 825, 3, 825, 3, 825, 3, 825, 3, 825,
+	3, 826, 3, 826, 3, 826, 3, 826, 3, 826, 3, 826, 3, 826, 3, 826, 3, 826,
+	3, 826, 3, 826, 3, 826, 3, 826, 3, 826, 3, 826, 3, 826, 3, 826, 3, 827,
+	3, 827, 3, 827, 3, 827, 3, 827, 3, 827, 3, 827, 3, 827, 3, 827, 3, 827,
+	3, 827, 3, 827, 3, 827, 3, 827, 3, 827, 3, 827, 3, 828, 3, 828, 3, 828,
+	3, 828, 3, 828, 3, 828, 3, 828, 3, 828, 3, 828, 3, 828, 3, 829, 3, 829,
+	3, 829, 3, 829, 3, 829, 3, 829, 3, 829, 3, 829, 3, 829, 3, 829, 3, 829,
+	3, 829, 3, 829, 3, 830, 3, 830, 3, 830, 3, 830, 3, 830, 3, 830, 3, 830,
+	3, 830, 3, 830, 3, 830, 3, 830, 3, 830, 3, 830, 3, 831, 3, 831, 3, 831,
+	3, 831, 3, 831, 3, 831, 3, 831, 3, 831, 3, 831, 3, 831, 3, 831, 3, 831,
+	3, 832, 3, 832, 3, 832, 3, 832, 3, 832, 3, 832, 3, 832, 3, 832, 3, 832,
+	3, 832, 3, 832, 3, 833, 3, 833, 3, 833, 3, 833, 3, 833, 3, 833, 3, 833,
+	3, 833, 3, 833, 3, 834, 3, 834, 3, 834, 3, 834, 3, 834, 3, 834, 3, 834,
+	3, 834, 3, 835, 3, 835, 3, 835, 3, 835, 3, 835, 3, 835, 3, 835, 3, 835,
+	3, 835, 3, 836, 3, 836, 3, 836, 3, 836, 3, 836, 3, 836, 3, 836, 3, 836,
+	3, 836, 3, 836, 3, 836, 3, 836, 3, 837, 3, 837, 3, 837, 3, 837, 3, 837,
+	3, 837, 3, 837, 3, 837, 3, 837, 3, 837, 3, 837, 3, 837, 3, 837, 3, 837,
+	3, 838, 3, 838, 3, 838, 3, 838, 3, 839, 3, 839, 3, 839, 3, 839, 3, 839,
+	3, 839, 3, 839, 3, 840, 3, 840, 3, 840, 3, 840, 3, 840, 3, 840, 3, 840,
+	3, 840, 3, 840, 3, 840, 3, 840, 3, 841, 3, 841, 3, 841, 3, 841, 3, 841,
+	3, 841, 3, 841, 3, 841, 3, 841, 3, 841, 3, 841, 3, 842, 3, 842, 3, 842,
+	3, 842, 3, 842, 3, 842, 3, 842, 3, 842, 3, 842, 3, 842, 3, 843, 3, 843,
+	3, 843, 3, 843, 3, 843, 3, 843, 3, 843, 3, 843, 3, 843, 3, 843, 3, 844,
+	3, 844, 3, 844, 3, 844, 3, 844, 3, 844, 3, 845, 3, 845, 3, 845, 3, 845,
+	3, 845, 3, 845, 3, 845, 3, 845, 3, 845, 3, 845, 3, 845, 3, 845, 3, 845,
+	3, 845, 3, 846, 3, 846, 3, 846, 3, 846, 3, 846, 3, 846, 3, 846, 3, 846,
+	3, 846, 3, 846, 3, 846, 3, 847, 3, 847, 3, 847, 3, 847, 3, 847, 3, 847,
+	3, 847, 3, 847, 3, 847, 3, 848, 3, 848, 3, 848, 3, 848, 3, 848, 3, 848,
+	3, 848, 3, 848, 3, 849, 3, 849, 3, 849, 3, 849, 3, 849, 3, 849, 3, 849,
+	3, 850, 3, 850, 3, 850, 3, 850, 3, 850, 3, 850, 3, 850, 3, 850, 3, 850,
+	3, 851, 3, 851, 3, 851, 3, 851, 3, 851, 3, 851, 3, 851, 3, 851, 3, 851,
+	3, 851, 3, 851, 3, 851, 3, 851, 3, 852, 3, 852, 3, 852, 3, 852, 3, 852,
+	3, 852, 3, 852, 3, 852, 3, 853, 3, 853, 3, 853, 3, 853, 3, 853, 3, 853,
+	3, 853, 3, 853, 3, 853, 3, 853, 3, 853, 3, 853, 3, 853, 3, 853, 3, 853,
+	3, 854, 3, 854, 3, 854, 3, 854, 3, 854, 3, 854, 3, 854, 3, 854, 3, 854,
+	3, 854, 3, 854, 3, 854, 3, 854, 3, 854, 3, 854, 3, 855, 3, 855, 3, 855,
+	3, 855, 3, 855, 3, 855, 3, 855, 3, 855, 3, 856, 3, 856, 3, 856, 3, 856,
+	3, 856, 3, 856, 3, 856, 3, 856, 3, 856, 3, 856, 3, 856, 3, 856, 3, 856,
+	3, 857, 3, 857, 3, 857, 3, 857, 3, 857, 3, 857, 3, 857, 3, 857, 3, 857,
+	3, 857, 3, 857, 3, 857, 3, 857, 3, 857, 3, 857, 3, 858, 3, 858, 3, 858,
+	3, 858, 3, 858, 3, 858, 3, 859, 3, 859, 3, 859, 3, 859, 3, 859, 3, 859,
+	3, 860, 3, 860, 3, 860, 3, 860, 3, 860, 3, 860, 3, 860, 3, 861, 3, 861,
+	3, 861, 3, 861, 3, 861, 3, 861, 3, 861, 3, 861, 3, 861, 3, 861, 3, 861,
+	3, 861, 3, 861, 3, 862, 3, 862, 3, 862, 3, 862, 3, 862, 3, 862, 3, 862,
+	3, 862, 3, 862, 3, 862, 3, 862, 3, 862, 3, 863, 3, 863, 3, 863, 3, 863,
+	3, 863, 3, 863, 3, 863, 3, 863, 3, 863, 3, 863, 3, 863, 3, 863, 3, 863,
+	3, 863, 3, 863, 3, 863, 3, 863, 3, 863, 3, 863, 3, 864, 3, 864, 3, 864,
+	3, 864, 3, 864, 3, 864, 3, 864, 3, 864, 3, 864, 3, 864, 3, 864, 3, 864,
+	3, 864, 3, 864, 3, 864, 3, 864, 3, 864, 3, 864, 3, 865, 3, 865, 3, 865,
+	3, 866, 3, 866, 3, 866, 3, 866, 3, 866, 3, 866, 3, 866, 3, 866, 3, 866,
+	3, 866, 3, 867, 3, 867, 3, 867, 3, 867, 3, 867, 3, 867, 3, 867, 3, 868,
+	3, 868, 3, 868, 3, 868, 3, 869, 3, 869, 3, 869, 3, 869, 3, 869, 3, 869,
+	3, 870, 3, 870, 3, 870, 3, 870, 3, 870, 3, 871, 3, 871, 3, 871, 3, 871,
+	3, 871, 3, 871, 3, 872, 3, 872, 3, 872, 3, 872, 3, 872, 3, 873, 3, 873,
+	3, 873, 3, 873, 3, 873, 3, 873, 3, 874, 3, 874, 3, 874, 3, 874, 3, 874,
+	3, 874, 3, 874, 3, 874, 3, 874, 3, 875, 3, 875, 3, 875, 3, 875, 3, 875,
+	3, 875, 3, 875, 3, 875, 3, 875, 3, 876, 3, 876, 3, 876, 3, 876, 3, 876,
+	3, 876, 3, 876, 3, 876, 3, 876, 3, 877, 3, 877, 3, 877, 3, 877, 3, 877,
+	3, 877, 3, 877, 3, 877, 3, 877, 3, 877, 3, 877, 3, 877, 3, 877, 3, 877,
+	3, 877, 3, 877, 3, 878, 3, 878, 3, 878, 3, 878, 3, 878, 3, 878, 3, 878,
+	3, 878, 3, 878, 3, 878, 3, 878, 3, 878, 3, 879, 3, 879, 3, 879, 3, 879,
+	3, 879, 3, 879, 3, 879, 3, 879, 3, 879, 3, 879, 3, 879, 3, 879, 3, 880,
+	3, 880, 3, 880, 3, 880, 3, 880, 3, 880, 3, 880, 3, 880, 3, 880, 3, 881,
+	3, 881, 3, 881, 3, 881, 3, 881, 3, 881, 3, 881, 3, 881, 3, 881, 3, 881,
+	3, 881, 3, 881, 3, 881, 3, 881, 3, 882, 3, 882, 3, 882, 3, 882, 3, 882,
+	3, 882, 3, 882, 3, 882, 3, 882, 3, 882, 3, 882, 3, 882, 3, 883, 3, 883,
+	3, 883, 3, 883, 3, 883, 3, 883, 3, 883, 3, 883, 3, 883, 3, 883, 3, 883,
+	3, 884, 3, 884, 3, 884, 3, 884, 3, 884, 3, 884, 3, 884, 3, 884, 3, 884,
+	3, 884, 3, 885, 3, 885, 3, 885, 3, 885, 3, 886, 3, 886, 3, 886, 3, 886,
+	3, 886, 3, 886, 3, 886, 3, 886, 3, 886, 3, 886, 3, 886, 3, 886, 3, 886,
+	3, 886, 3, 887, 3, 887, 3, 887, 3, 887, 3, 887, 3, 887, 3, 887, 3, 887,
+	3, 887, 3, 887, 3, 887, 3, 887, 3, 887, 3, 888, 3, 888, 3, 888, 3, 888,
+	3, 888, 3, 888, 3, 888, 3, 888, 3, 888, 3, 888, 3, 889, 3, 889, 3, 889,
+	3, 889, 3, 889, 3, 889, 3, 889, 3, 889, 3, 889, 3, 889, 3, 889, 3, 889,
+	3, 889, 3, 889, 3, 889, 3, 890, 3, 890, 3, 890, 3, 890, 3, 890, 3, 890,
+	3, 890, 3, 890, 3, 890, 3, 890, 3, 890, 3, 890, 3, 890, 3, 890, 3, 891,
+	3, 891, 3, 891, 3, 891, 3, 891, 3, 891, 3, 891, 3, 891, 3, 891, 3, 891,
+	3, 891, 3, 891, 3, 891, 3, 891, 3, 892, 3, 892, 3, 892, 3, 892, 3, 892,
+	3, 892, 3, 892, 3, 892, 3, 892, 3, 892, 3, 892, 3, 892, 3, 892, 3, 893,
+	3, 893, 3, 893, 3, 893, 3, 893, 3, 893, 3, 893, 3, 893, 3, 893, 3, 893,
+	3, 893, 3, 893, 3, 893, 3, 893, 3, 893, 3, 893, 3, 893, 3, 893, 3, 893,
+	3, 893, 3, 893, 3, 893, 3, 893, 3, 893, 3, 894, 3, 894, 3, 894, 3, 894,
+	3, 894, 3, 894, 3, 894, 3, 894, 3, 894, 3, 894, 3, 894, 3, 894, 3, 894,
+	3, 894, 3, 894, 3, 894, 3, 894, 3, 894, 3, 894, 3, 894, 3, 894, 3, 894,
+	3, 894, 3, 895, 3, 895, 3, 895, 3, 895, 3, 895, 3, 895, 3, 895, 3, 895,
+	3, 895, 3, 895, 3, 895, 3, 895, 3, 895, 3, 895, 3, 895, 3, 895, 3, 895,
+	3, 895, 3, 895, 3, 896, 3, 896, 3, 896, 3, 896, 3, 896, 3, 896, 3, 896,
+	3, 896, 3, 896, 3, 896, 3, 896, 3, 896, 3, 896, 3, 896, 3, 896, 3, 896,
+	3, 896, 3, 896, 3, 897, 3, 897, 3, 897, 3, 897, 3, 897, 3, 897, 3, 897,
+	3, 897, 3, 897, 3, 897, 3, 897, 3, 897, 3, 897, 3, 897, 3, 897, 3, 897,
+	3, 897, 3, 897, 3, 897, 3, 897, 3, 897, 3, 898, 3, 898, 3, 898, 3, 898,
+	3, 898, 3, 898, 3, 898, 3, 898, 3, 898, 3, 898, 3, 898, 3, 898, 3, 898,
+	3, 898, 3, 898, 3, 898, 3, 898, 3, 898, 3, 898, 3, 898, 3, 899, 3, 899,
+	3, 899, 3, 899, 3, 899, 3, 899, 3, 899, 3, 899, 3, 899, 3, 899, 3, 899,
+	3, 900, 3, 900, 3, 900, 3, 900, 3, 900, 3, 900, 3, 900, 3, 901, 3, 901,
+	3, 901, 3, 901, 3, 901, 3, 901, 3, 901, 3, 901, 3, 901, 3, 901, 3, 901,
+	3, 901, 3, 901, 3, 901, 3, 902, 3, 902, 3, 902, 3, 902, 3, 902, 3, 902,
+	3, 902, 3, 902, 3, 902, 3, 902, 3, 902, 3, 902, 3, 902, 3, 902, 3, 902,
+	3, 902, 3, 902, 3, 903, 3, 903, 3, 903, 3, 903, 3, 903, 3, 903, 3, 903,
+	3, 903, 3, 903, 3, 903, 3, 904, 3, 904, 3, 904, 3, 904, 3, 905, 3, 905,
+	3, 905, 3, 905, 3, 905, 3, 905, 3, 905, 3, 905, 3, 905, 3, 905, 3, 905,
+	3, 905, 3, 905, 3, 906, 3, 906, 3, 906, 3, 906, 3, 907, 3, 907, 3, 907,
+	3, 907, 3, 907, 3, 907, 3, 907, 3, 907, 3, 907, 3, 908, 3, 908, 3, 908,
+	3, 908, 3, 908, 3, 908, 3, 908, 3, 908, 3, 908, 3, 908, 3, 908, 3, 909,
+	3, 909, 3, 909, 3, 909, 3, 909, 3, 909, 3, 909, 3, 909, 3, 909, 3, 909,
+	3, 909, 3, 909, 3, 910, 3, 910, 3, 910, 3, 911, 3, 911, 3, 911, 3, 911,
+	3, 911, 3, 911, 3, 911, 3, 911, 3, 911, 3, 911, 3, 911, 3, 911, 3, 911,
+	3, 911, 3, 912, 3, 912, 3, 912, 3, 912, 3, 912, 3, 912, 3, 912, 3, 912,
+	3, 912, 3, 912, 3, 912, 3, 912, 3, 912, 3, 913, 3, 913, 3, 913, 3, 913,
+	3, 913, 3, 913, 3, 913, 3, 914, 3, 914, 3, 914, 3, 914, 3, 914, 3, 914,
+	3, 914, 3, 914, 3, 914, 3, 914, 3, 914, 3, 914, 3, 914, 3, 915, 3, 915,
+	3, 915, 3, 915, 3, 915, 3, 915, 3, 915, 3, 915, 3, 915, 3, 915, 3, 915,
+	3, 915, 3, 916, 3, 916, 3, 916, 3, 916, 3, 916, 3, 916, 3, 916, 3, 916,
+	3, 916, 3, 916, 3, 916, 3, 916, 3, 916, 3, 916, 3, 916, 3, 916, 3, 917,
+	3, 917, 3, 917, 3, 917, 3, 917, 3, 917, 3, 917, 3, 917, 3, 917, 3, 917,
+	3, 917, 3, 917, 3, 917, 3, 917, 3, 917, 3, 918, 3, 918, 3, 918, 3, 918,
+	3, 919, 3, 919, 3, 919, 3, 919, 3, 919, 3, 919, 3, 920, 3, 920, 3, 920,
+	3, 920, 3, 920, 3, 920, 3, 921, 3, 921, 3, 921, 3, 921, 3, 921, 3, 921,
+	3, 921, 3, 921, 3, 922, 3, 922, 3, 922, 3, 922, 3, 922, 3, 923, 3, 923,
+	3, 923, 3, 923, 3, 923, 3, 923, 3, 923, 3, 923, 3, 923, 3, 923, 3, 923,
+	3, 923, 3, 923, 3, 924, 3, 924, 3, 924, 3, 924, 3, 924, 3, 924, 3, 924,
+	3, 924, 3, 924, 3, 924, 3, 924, 3, 924, 3, 924, 3, 925, 3, 925, 3, 925,
+	3, 925, 3, 925, 3, 925, 3, 925, 3, 925, 3, 926, 3, 926, 3, 926, 3, 926,
+	3, 926, 3, 926, 3, 927, 3, 927, 3, 927, 3, 927, 3, 927, 3, 927, 3, 927,
+	3, 927, 3, 927, 3, 927, 3, 928, 3, 928, 3, 928, 3, 928, 3, 928, 3, 929,
+	3, 929, 3, 929, 3, 929, 3, 929, 3, 929, 3, 930, 3, 930, 3, 930, 3, 930,
+	3, 930, 3, 930, 3, 930, 3, 930, 3, 930, 3, 930, 3, 930, 3, 930, 3, 931,
+	3, 931, 3, 931, 3, 931, 3, 931, 3, 931, 3, 931, 3, 931, 3, 931, 3, 931,
+	3, 931, 3, 931, 3, 931, 3, 932, 3, 932, 3, 932, 3, 932, 3, 933, 3, 933,
+	3, 933, 3, 933, 3, 933, 3, 934, 3, 934, 3, 934, 3, 934, 3, 934, 3, 935,
+	3, 935, 3, 935, 3, 935, 3, 935, 3, 935, 3, 935, 3, 935, 3, 935, 3, 935,
+	3, 935, 3, 935, 3, 936, 3, 936, 3, 936, 3, 936, 3, 936, 3, 937, 3, 937,
+	3, 937, 3, 937, 3, 938, 3, 938, 3, 938, 3, 938, 3, 938, 3, 938, 3, 939,
+	3, 939, 3, 939, 3, 939, 3, 939, 3, 939, 3, 939, 3, 939, 3, 940, 3, 940,
+	3, 940, 3, 940, 3, 940, 3, 940, 3, 940, 3, 940, 3, 940, 3, 940, 3, 940,
+	3, 940, 3, 940, 3, 940, 3, 940, 3, 940, 3, 940, 3, 940, 3, 940, 3, 940,
+	3, 940, 3, 940, 3, 940, 3, 940, 3, 940, 3, 940, 3, 940, 3, 940, 3, 941,
+	3, 941, 3, 941, 3, 941, 3, 941, 3, 942, 3, 942, 3, 942, 3, 942, 3, 942,
+	3, 943, 3, 943, 3, 943, 3, 943, 3, 943, 3, 943, 3, 943, 3, 943, 3, 943,
+	3, 943, 3, 943, 3, 944, 3, 944, 3, 944, 3, 944, 3, 944, 3, 944, 3, 944,
+	3, 945, 3, 945, 3, 945, 3, 945, 3, 945, 3, 945, 3, 945, 3, 945, 3, 945,
+	3, 945, 3, 945, 3, 945, 3, 946, 3, 946, 3, 946, 3, 946, 3, 946, 3, 946,
+	3, 946, 3, 946, 3, 947, 3, 947, 3, 947, 3, 947, 3, 947, 3, 947, 3, 947,
+	3, 947, 3, 947, 3, 947, 3, 947, 3, 947, 3, 948, 3, 948, 3, 948, 3, 948,
+	3, 948, 3, 948, 3, 948, 3, 948, 3, 948, 3, 948, 3, 949, 3, 949, 3, 949,
+	3, 949, 3, 949, 3, 949, 3, 949, 3, 949, 3, 949, 3, 950, 3, 950, 3, 950,
+	3, 950, 3, 950, 3, 950, 3, 950, 3, 950, 3, 950, 3, 951, 3, 951, 3, 951,
+	3, 951, 3, 951, 3, 951, 3, 951, 3, 951, 3, 951, 3, 951, 3, 952, 3, 952,
+	3, 952, 3, 952, 3, 952, 3, 952, 3, 952, 3, 952, 3, 952, 3, 952, 3, 952,
+	3, 952, 3, 953, 3, 953, 3, 953, 3, 953, 3, 953, 3, 953, 3, 953, 3, 953,
+	3, 953, 3, 953, 3, 953, 3, 953, 3, 954, 3, 954, 3, 954, 3, 954, 3, 954,
+	3, 954, 3, 954, 3, 954, 3, 954, 3, 954, 3, 954, 3, 955, 3, 955, 3, 955,
+	3, 955, 3, 955, 3, 955, 3, 955, 3, 955, 3, 955, 3, 955, 3, 955, 3, 955,
+	3, 955, 3, 955, 3, 956, 3, 956, 3, 956, 3, 956, 3, 956, 3, 956, 3, 956,
+	3, 956, 3, 956, 3, 956, 3, 956, 3, 956, 3, 956, 3, 957, 3, 957, 3, 957,
+	3, 957, 3, 957, 3, 957, 3, 957, 3, 957, 3, 957, 3, 957, 3, 957, 3, 957,
+	3, 958, 3, 958, 3, 958, 3, 958, 3, 958, 3, 958, 3, 958, 3, 958, 3, 958,
+	3, 958, 3, 958, 3, 958, 3, 959, 3, 959, 3, 959, 3, 959, 3, 959, 3, 959,
+	3, 959, 3, 959, 3, 959, 3, 959, 3, 959, 3, 959, 3, 960, 3, 960, 3, 960,
+	3, 960, 3, 960, 3, 960, 3, 960, 3, 960, 3, 960, 3, 960, 3, 960, 3, 960,
+	3, 961, 3, 961, 3, 961, 3, 961, 3, 961, 3, 961, 3, 961, 3, 961, 3, 961,
+	3, 961, 3, 962, 3, 962, 3, 962, 3, 962, 3, 962, 3, 962, 3, 962, 3, 962,
+	3, 962, 3, 962, 3, 962, 3, 962, 3, 962, 3, 962, 3, 962, 3, 962, 3, 963,
+	3, 963, 3, 963, 3, 963, 3, 963, 3, 963, 3, 963, 3, 963, 3, 963, 3, 963,
+	3, 963, 3, 963, 3, 963, 3, 963, 3, 963, 3, 963, 3, 963, 3, 963, 3, 963,
+	3, 963, 3, 964, 3, 964, 3, 964, 3, 964, 3, 964, 3, 964, 3, 964, 3, 964,
+	3, 964, 3, 964, 3, 964, 3, 964, 3, 964, 3, 964, 3, 964, 3, 964, 3, 964,
+	3, 964, 3, 964, 3, 965, 3, 965, 3, 965, 3, 965, 3, 965, 3, 965, 3, 965,
+	3, 965, 3, 965, 3, 965, 3, 965, 3, 965, 3, 965, 3, 965, 3, 965, 3, 965,
+	3, 965, 3, 965, 3, 965, 3, 966, 3, 966, 3, 966, 3, 966, 3, 966, 3, 966,
+	3, 966, 3, 966, 3, 966, 3, 966, 3, 966, 3, 966, 3, 966, 3, 966, 3, 966,
+	3, 966, 3, 966, 3, 966, 3, 966, 3, 966, 3, 966, 3, 966, 3, 966, 3, 966,
+	3, 966, 3, 966, 3, 966, 3, 966, 3, 966, 3, 966, 3, 967, 3, 967, 3, 967,
+	3, 967, 3, 967, 3, 967, 3, 967, 3, 967, 3, 967, 3, 967, 3, 967, 3, 967,
+	3, 967, 3, 967, 3, 967, 3, 967, 3, 967, 3, 967, 3, 967, 3, 967, 3, 967,
+	3, 967, 3, 967, 3, 967, 3, 967, 3, 967, 3, 967, 3, 967, 3, 967, 3, 968,
+	3, 968, 3, 968, 3, 968, 3, 968, 3, 968, 3, 968, 3, 968, 3, 968, 3, 968,
+	3, 968, 3, 968, 3, 968, 3, 968, 3, 968, 3, 968, 3, 968, 3, 968, 3, 968,
+	3, 968, 3, 969, 3, 969, 3, 969, 3, 969, 3, 969, 3, 969, 3, 969, 3, 969,
+	3, 969, 3, 969, 3, 969, 3, 969, 3, 969, 3, 969, 3, 969, 3, 969, 3, 969,
+	3, 969, 3, 969, 3, 970, 3, 970, 3, 970, 3, 970, 3, 970, 3, 970, 3, 970,
+	3, 970, 3, 970, 3, 970, 3, 970, 3, 970, 3, 970, 3, 971, 3, 971, 3, 971,
+	3, 971, 3, 971, 3, 971, 3, 971, 3, 971, 3, 971, 3, 971, 3, 971, 3, 971,
+	3, 971, 3, 971, 3, 971, 3, 971, 3, 972, 3, 972, 3, 972, 3, 972, 3, 972,
+	3, 972, 3, 972, 3, 972, 3, 972, 3, 972, 3, 972, 3, 972, 3, 972, 3, 972,
+	3, 972, 3, 972, 3, 973, 3, 973, 3, 973, 3, 973, 3, 973, 3, 973, 3, 973,
+	3, 973, 3, 973, 3, 973, 3, 973, 3, 973, 3, 973, 3, 973, 3, 973, 3, 974,
+	3, 974, 3, 974, 3, 974, 3, 974, 3, 974, 3, 974, 3, 974, 3, 974, 3, 974,
+	3, 974, 3, 974, 3, 974, 3, 974, 3, 974, 3, 974, 3, 974, 3, 975, 3, 975,
+	3, 975, 3, 975, 3, 975, 3, 975, 3, 975, 3, 975, 3, 975, 3, 975, 3, 975,
+	3, 975, 3, 975, 3, 975, 3, 975, 3, 975, 3, 976, 3, 976, 3, 976, 3, 976,
+	3, 976, 3, 976, 3, 976, 3, 976, 3, 976, 3, 976, 3, 976, 3, 976, 3, 976,
+	3, 976, 3, 977, 3, 977, 3, 977, 3, 977, 3, 977, 3, 977, 3, 977, 3, 977,
+	3, 977, 3, 977, 3, 977, 3, 977, 3, 978, 3, 978, 3, 978, 3, 978, 3, 978,
+	3, 978, 3, 978, 3, 978, 3, 978, 3, 978, 3, 978, 3, 979, 3, 979, 3, 979,
+	3, 979, 3, 979, 3, 979, 3, 979, 3, 979, 3, 979, 3, 979, 3, 979, 3, 979,
+	3, 980, 3, 980, 3, 980, 3, 980, 3, 980, 3, 980, 3, 980, 3, 980, 3, 980,
+	3, 980, 3, 980, 3, 980, 3, 980, 3, 980, 3, 980, 3, 980, 3, 981, 3, 981,
+	3, 981, 3, 981, 3, 981, 3, 981, 3, 981, 3, 981, 3, 981, 3, 981, 3, 981,
+	3, 981, 3, 981, 3, 981, 3, 981, 3, 982, 3, 982, 3, 982, 3, 982, 3, 982,
+	3, 982, 3, 982, 3, 982, 3, 982, 3, 982, 3, 982, 3, 982, 3, 982, 3, 982,
+	3, 982, 3, 982, 3, 982, 3, 982, 3, 982, 3, 982, 3, 982, 3, 982, 3, 983,
+	3, 983, 3, 983, 3, 983, 3, 983, 3, 983, 3, 983, 3, 983, 3, 983, 3, 983,
+	3, 983, 3, 983, 3, 983, 3, 983, 3, 983, 3, 983, 3, 983, 3, 983, 3, 983,
+	3, 983, 3, 983, 3, 984, 3, 984, 3, 984, 3, 984, 3, 984, 3, 984, 3, 984,
+	3, 984, 3, 984, 3, 984, 3, 984, 3, 984, 3, 984, 3, 984, 3, 984, 3, 984,
+	3, 984, 3, 985, 3, 985, 3, 985, 3, 985, 3, 985, 3, 985, 3, 985, 3, 985,
+	3, 985, 3, 985, 3, 985, 3, 985, 3, 985, 3, 985, 3, 985, 3, 985, 3, 985,
+	3, 985, 3, 985, 3, 986, 3, 986, 3, 986, 3, 986, 3, 986, 3, 986, 3, 986,
+	3, 986, 3, 986, 3, 986, 3, 986, 3, 986, 3, 986, 3, 986, 3, 986, 3, 986,
+	3, 986, 3, 986, 3, 986, 3, 986, 3, 987, 3, 987, 3, 987, 3, 987, 3, 987,
+	3, 987, 3, 987, 3, 987, 3, 987, 3, 987, 3, 987, 3, 987, 3, 987, 3, 988,
+	3, 988, 3, 988, 3, 988, 3, 988, 3, 988, 3, 988, 3, 988, 3, 988, 3, 988,
+	3, 988, 3, 988, 3, 989, 3, 989, 3, 989, 3, 989, 3, 989, 3, 989, 3, 989,
+	3, 989, 3, 989, 3, 989, 3, 989, 3, 989, 3, 989, 3, 989, 3, 989, 3, 989,
+	3, 989, 3, 990, 3, 990, 3, 990, 3, 990, 3, 990, 3, 990, 3, 990, 3, 990,
+	3, 990, 3, 990, 3, 990, 3, 990, 3, 990, 3, 990, 3, 990, 3, 990, 3, 991,
+	3, 991, 3, 991, 3, 991, 3, 991, 3, 991, 3, 991, 3, 991, 3, 991, 3, 991,
+	3, 992, 3, 992, 3, 992, 3, 992, 3, 992, 3, 992, 3, 992, 3, 992, 3, 992,
+	3, 992, 3, 992, 3, 992, 3, 992, 3, 992, 3, 992, 3, 992, 3, 993, 3, 993,
+	3, 993, 3, 993, 3, 993, 3, 993, 3, 993, 3, 993, 3, 993, 3, 993, 3, 993,
+	3, 993, 3, 993, 3, 993, 3, 993, 3, 994, 3, 994, 3, 994, 3, 994, 3, 994,
+	3, 994, 3, 994, 3, 994, 3, 994, 3, 994, 3, 994, 3, 994, 3, 994, 3, 994,
+	3, 994, 3, 994, 3, 994, 3, 994, 3, 994, 3, 995, 3, 995, 3, 995, 3, 995,
+	3, 995, 3, 995, 3, 995, 3, 995, 3, 995, 3, 995, 3, 995, 3, 995, 3, 995,
+	3, 995, 3, 995, 3, 995, 3, 995, 3, 995, 3, 996, 3, 996, 3, 996, 3, 996,
+	3, 996, 3, 996, 3, 996, 3, 996, 3, 997, 3, 997, 3, 997, 3, 997, 3, 997,
+	3, 997, 3, 997, 3, 997, 3, 997, 3, 997, 3, 997, 3, 997, 3, 997, 3, 997,
+	3, 998, 3, 998, 3, 998, 3, 998, 3, 998, 3, 998, 3, 998, 3, 998, 3, 998,
+	3, 998, 3, 998, 3, 998, 3, 998, 3, 998, 3, 998, 3, 998, 3, 998, 3, 999,
+	3, 999, 3, 999, 3, 999, 3, 999, 3, 999, 3, 999, 3, 999, 3, 999, 3, 999,
+	3, 999, 3, 1000, 3, 1000, 3, 1000, 3, 1000, 3, 1000, 3, 1000, 3, 1000,
+	3, 1000, 3, 1000, 3, 1001, 3, 1001, 3, 1001, 3, 1001, 3, 1001, 3, 1001,
+	3, 1001, 3, 1001, 3, 1001, 3, 1001, 3, 1002, 3, 1002, 3, 1002, 3, 1002,
+	3, 1002, 3, 1003, 3, 1003, 3, 1003, 3, 1003, 3, 1003, 3, 1004, 3, 1004,
+	3, 1004, 3, 1004, 3, 1004, 3, 1004, 3, 1004, 3, 1004, 3, 1005, 3, 1005,
+	3, 1005, 3, 1005, 3, 1005, 3, 1005, 3, 1005, 3, 1005, 3, 1005, 3, 1005,
+	3, 1005, 3, 1005, 3, 1005, 3, 1005, 3, 1005, 3, 1005, 3, 1006, 3, 1006,
+	3, 1006, 3, 1006, 3, 1006, 3, 1006, 3, 1006, 3, 1006, 3, 1007, 3, 1007,
+	3, 1007, 3, 1007, 3, 1007, 3, 1007, 3, 1007, 3, 1007, 3, 1007, 3, 1007,
+	3, 1007, 3, 1007, 3, 1008, 3, 1008, 3, 1008, 3, 1008, 3, 1009, 3, 1009,
+	3, 1009, 3, 1009, 3, 1009, 3, 1009, 3, 1009, 3, 1009, 3, 1009, 3, 1010,
+	3, 1010, 3, 1010, 3, 1010, 3, 1010, 3, 1010, 3, 1010, 3, 1010, 3, 1010,
+	3, 1010, 3, 1010, 3, 1010, 3, 1010, 3, 1011, 3, 1011, 3, 1011, 3, 1011,
+	3, 1011, 3, 1011, 3, 1011, 3, 1011, 3, 1011, 3, 1011, 3, 1011, 3, 1011,
+	3, 1011, 3, 1011, 3, 1012, 3, 1012, 3, 1012, 3, 1012, 3, 1012, 3, 1012,
+	3, 1012, 3, 1012, 3, 1012, 3, 1012, 3, 1012, 3, 1012, 3, 1013, 3, 1013,
+	3, 1013, 3, 1013, 3, 1013, 3, 1013, 3, 1013, 3, 1013, 3, 1013, 3, 1013,
+	3, 1013, 3, 1013, 3, 1014, 3, 1014, 3, 1014, 3, 1014, 3, 1014, 3, 1014,
+	3, 1014, 3, 1014, 3, 1015, 3, 1015, 3, 1015, 3, 1015, 3, 1015, 3, 1015,
+	3, 1015, 3, 1015, 3, 1015, 3, 1015, 3, 1016, 3, 1016, 3, 1016, 3, 1016,
+	3, 1016, 3, 1016, 3, 1016, 3, 1016, 3, 1017, 3, 1017, 3, 1017, 3, 1017,
+	3, 1017, 3, 1017, 3, 1017, 3, 1017, 3, 1017, 3, 1017, 3, 1017, 3, 1018,
+	3, 1018, 3, 1018, 3, 1018, 3, 1018, 3, 1018, 3, 1019, 3, 1019, 3, 1019,
+	3, 1019, 3, 1019, 3, 1019, 3, 1019, 3, 1019, 3, 1019, 3, 1019, 3, 1019,
+	3, 1020, 3, 1020, 3, 1020, 3, 1020, 3, 1020, 3, 1020, 3, 1020, 3, 1020,
+	3, 1020, 3, 1020, 3, 1020, 3, 1020, 3, 1020, 3, 1020, 3, 1020, 3, 1020,
+	3, 1020, 3, 1020, 3, 1020, 3, 1020, 3, 1021, 3, 1021, 3, 1021, 3, 1021,
+	3, 1021, 3, 1021, 3, 1022, 3, 1022, 3, 1022, 3, 1022, 3, 1022, 3, 1022,
+	3, 1022, 3, 1022, 3, 1022, 3, 1022, 3, 1022, 3, 1022, 3, 1022, 3, 1022,
+	3, 1022, 3, 1023, 3, 1023, 3, 1023, 3, 1023, 3, 1023, 3, 1023, 3, 1023,
+	3, 1023, 3, 1023, 3, 1023, 3, 1024, 3, 1024, 3, 1024, 3, 1024, 3, 1024,
+	3, 1024, 3, 1025, 3, 1025, 3, 1025, 3, 1025, 3, 1025, 3, 1026, 3, 1026,
+	3, 1026, 3, 1026, 3, 1026, 3, 1026, 3, 1026, 3, 1026, 3, 1026, 3, 1026,
+	3, 1026, 3, 1027, 3, 1027, 3, 1027, 3, 1027, 3, 1027, 3, 1027, 3, 1027,
+	3, 1027, 3, 1027, 3, 1027, 3, 1027, 3, 1027, 3, 1027, 3, 1027, 3, 1027,
+	3, 1027, 3, 1027, 3, 1027, 3, 1027, 3, 1027, 3, 1027, 3, 1027, 3, 1027,
+	3, 1027, 3, 1027, 3, 1027, 3, 1027, 3, 1028, 3, 1028, 3, 1028, 3, 1028,
+	3, 1028, 3, 1028, 3, 1028, 3, 1028, 3, 1029, 3, 1029, 3, 1029, 3, 1029,
+	3, 1029, 3, 1029, 3, 1029, 3, 1029, 3, 1029, 3, 1029, 3, 1029, 3, 1029,
+	3, 1029, 3, 1029, 3, 1029, 3, 1029, 3, 1029, 3, 1029, 3, 1029, 3, 1029,
+	3, 1029, 3, 1029, 3, 1029, 3, 1029, 3, 1029, 3, 1029, 3, 1029, 3, 1029,
+	3, 1029, 3, 1029, 3, 1029, 3, 1029, 3, 1029, 3, 1029, 3, 1030, 3, 1030,
+	3, 1030, 3, 1030, 3, 1030, 3, 1030, 3, 1030, 3, 1030, 3, 1031, 3, 1031,
+	3, 1031, 3, 1031, 3, 1031, 3, 1031, 3, 1031, 3, 1031, 3, 1031, 3, 1031,
+	3, 1031, 3, 1032, 3, 1032, 3, 1032, 3, 1032, 3, 1032, 3, 1032, 3, 1032,
+	3, 1032, 3, 1032, 3, 1032, 3, 1032, 3, 1032, 3, 1032, 3, 1032, 3, 1033,
+	3, 1033, 3, 1033, 3, 1033, 3, 1033, 3, 1033, 3, 1033, 3, 1034, 3, 1034,
+	3, 1034, 3, 1034, 3, 1034, 3, 1034, 3, 1034, 3, 1034, 3, 1034, 3, 1035,
+	3, 1035, 3, 1036, 3, 1036, 3, 1037, 3, 1037, 3, 1037, 3, 1038, 3, 1038,
+	3, 1038, 3, 1039, 3, 1039, 3, 1039, 3, 1040, 3, 1040, 3, 1040, 3, 1041,
+	3, 1041, 3, 1041, 3, 1042, 3, 1042, 3, 1042, 3, 1043, 3, 1043, 3, 1043,
+	3, 1044, 3, 1044, 3, 1044, 3, 1045, 3, 1045, 3, 1045, 3, 1046, 3, 1046,
+	3, 1047, 3, 1047, 3, 1048, 3, 1048, 3, 1049, 3, 1049, 3, 1050, 3, 1050,
+	3, 1050, 3, 1051, 3, 1051, 3, 1052, 3, 1052, 3, 1052, 3, 1052, 3, 1053,
+	3, 1053, 3, 1053, 3, 1053, 3, 1054, 3, 1054, 3, 1055, 3, 1055, 3, 1056,
+	3, 1056, 3, 1057, 3, 1057, 3, 1058, 3, 1058, 3, 1059, 3, 1059, 3, 1060,
+	3, 1060, 3, 1061, 3, 1061, 3, 1062, 3, 1062, 3, 1063, 3, 1063, 3, 1064,
+	3, 1064, 3, 1065, 3, 1065, 3, 1066, 3, 1066, 3, 1067, 3, 1067, 3, 1068,
+	3, 1068, 3, 1069, 3, 1069, 3, 1070, 3, 1070, 3, 1071, 3, 1071, 3, 1072,
+	3, 1072, 3, 1073, 3, 1073, 3, 1074, 3, 1074, 3, 1075, 3, 1075, 3, 1075,
+	5, 1075, 12523, 10, 1075, 3, 1076, 3, 1076, 3, 1076, 3, 1076, 3, 1077,
+	6, 1077, 12530, 10, 1077, 13, 1077, 14, 1077, 12531, 3, 1077, 3, 1077,
+	3, 1078, 3, 1078, 3, 1078, 3, 1079, 3, 1079, 3, 1079, 5, 1079, 12542, 10,
+	1079, 3, 1080, 6, 1080, 12545, 10, 1080, 13, 1080, 14, 1080, 12546, 3,
+	1081, 3, 1081, 3, 1081, 3, 1081, 3, 1081, 6, 1081, 12554, 10, 1081, 13,
+	1081, 14, 1081, 12555, 3, 1081, 3, 1081, 3, 1081, 3, 1081, 3, 1081, 3,
+	1081, 6, 1081, 12564, 10, 1081, 13, 1081, 14, 1081, 12565, 5, 1081, 12568,
+	10, 1081, 3, 1082, 6, 1082, 12571, 10, 1082, 13, 1082, 14, 1082, 12572,
+	5, 1082, 12575, 10, 1082, 3, 1082, 3, 1082, 6, 1082, 12579, 10, 1082, 13,
+	1082, 14, 1082, 12580, 3, 1082, 6, 1082, 12584, 10, 1082, 13, 1082, 14,
+	1082, 12585, 3, 1082, 3, 1082, 3, 1082, 3, 1082, 6, 1082, 12592, 10, 1082,
+	13, 1082, 14, 1082, 12593, 5, 1082, 12596, 10, 1082, 3, 1082, 3, 1082,
+	6, 1082, 12600, 10, 1082, 13, 1082, 14, 1082, 12601, 3, 1082, 3, 1082,
+	3, 1082, 6, 1082, 12607, 10, 1082, 13, 1082, 14, 1082, 12608, 3, 1082,
+	3, 1082, 5, 1082, 12613, 10, 1082, 3, 1083, 3, 1083, 3, 1083, 3, 1084,
+	3, 1084, 3, 1085, 3, 1085, 3, 1085, 3, 1086, 3, 1086, 3, 1086, 3, 1087,
+	3, 1087, 3, 1088, 3, 1088, 6, 1088, 12630, 10, 1088, 13, 1088, 14, 1088,
+	12631, 3, 1088, 3, 1088, 3, 1089, 3, 1089, 3, 1089, 3, 1089, 5, 1089, 12640,
+	10, 1089, 3, 1089, 3, 1089, 3, 1089, 3, 1089, 3, 1089, 3, 1089, 5, 1089,
+	12648, 10, 1089, 3, 1090, 6, 1090, 12651, 10, 1090, 13, 1090, 14, 1090,
+	12652, 3, 1090, 3, 1090, 6, 1090, 12657, 10, 1090, 13, 1090, 14, 1090,
+	12658, 3, 1090, 6, 1090, 12662, 10, 1090, 13, 1090, 14, 1090, 12663, 3,
+	1090, 3, 1090, 6, 1090, 12668, 10, 1090, 13, 1090, 14, 1090, 12669, 5,
+	1090, 12672, 10, 1090, 3, 1091, 3, 1091, 6, 1091, 12676, 10, 1091, 13,
+	1091, 14, 1091, 12677, 3, 1091, 3, 1091, 3, 1091, 5, 1091, 12683, 10, 1091,
+	3, 1092, 3, 1092, 3, 1092, 6, 1092, 12688, 10, 1092, 13, 1092, 14, 1092,
+	12689, 3, 1092, 5, 1092, 12693, 10, 1092, 3, 1093, 3, 1093, 3, 1093, 3,
+	1093, 3, 1093, 3, 1093, 3, 1093, 3, 1093, 3, 1093, 3, 1093, 3, 1093, 3,
+	1093, 3, 1093, 3, 1093, 3, 1093, 3, 1093, 3, 1093, 3, 1093, 3, 1093, 3,
+	1093, 3, 1093, 3, 1093, 3, 1093, 3, 1093, 3, 1093, 3, 1093, 3, 1093, 3,
+	1093, 3, 1093, 3, 1093, 3, 1093, 3, 1093, 3, 1093, 3, 1093, 3, 1093, 3,
+	1093, 3, 1093, 3, 1093, 3, 1093, 3, 1093, 3, 1093, 5, 1093, 12736, 10,
+	1093, 3, 1094, 3, 1094, 5, 1094, 12740, 10, 1094, 3, 1094, 6, 1094, 12743,
+	10, 1094, 13, 1094, 14, 1094, 12744, 3, 1095, 7, 1095, 12748, 10, 1095,
+	12, 1095, 14, 1095, 12751, 11, 1095, 3, 1095, 6, 1095, 12754, 10, 1095,
+	13, 1095, 14, 1095, 12755, 3, 1095, 7, 1095, 12759, 10, 1095, 12, 1095,
+	14, 1095, 12762, 11, 1095, 3, 1096, 3, 1096, 3, 1096, 3, 1096, 3, 1096,
+	3, 1096, 7, 1096, 12770, 10, 1096, 12, 1096, 14, 1096, 12773, 11, 1096,
+	3, 1096, 3, 1096, 3, 1097, 3, 1097, 3, 1097, 3, 1097, 3, 1097, 3, 1097,
+	7, 1097, 12783, 10, 1097, 12, 1097, 14, 1097, 12786, 11, 1097, 3, 1097,
+	3, 1097, 3, 1098, 3, 1098, 3, 1098, 3, 1098, 3, 1098, 3, 1098, 7, 1098,
+	12796, 10, 1098, 12, 1098, 14, 1098, 12799, 11, 1098, 3, 1098, 3, 1098,
+	3, 1099, 3, 1099, 3, 1100, 3, 1100, 3, 1101, 3, 1101, 3, 1101, 6, 1101,
+	12810, 10, 1101, 13, 1101, 14, 1101, 12811, 3, 1101, 3, 1101, 3, 1102,
+	3, 1102, 3, 1102, 3, 1102, 6, 2219, 2232, 12749, 12755, 2, 1103, 3, 3,
+	5, 4, 7, 5, 9, 6, 11, 7, 13, 8, 15, 9, 17, 10, 19, 11, 21, 12, 23, 13,
+	25, 14, 27, 15, 29, 16, 31, 17, 33, 18, 35, 19, 37, 20, 39, 21, 41, 22,
+	43, 23, 45, 24, 47, 25, 49, 26, 51, 27, 53, 28, 55, 29, 57, 30, 59, 31,
+	61, 32, 63, 33, 65, 34, 67, 35, 69, 36, 71, 37, 73, 38, 75, 39, 77, 40,
+	79, 41, 81, 42, 83, 43, 85, 44, 87, 45, 89, 46, 91, 47, 93, 48, 95, 49,
+	97, 50, 99, 51, 101, 52, 103, 53, 105, 54, 107, 55, 109, 56, 111, 57, 113,
+	58, 115, 59, 117, 60, 119, 61, 121, 62, 123, 63, 125, 64, 127, 65, 129,
+	66, 131, 67, 133, 68, 135, 69, 137, 70, 139, 71, 141, 72, 143, 73, 145,
+	74, 147, 75, 149, 76, 151, 77, 153, 78, 155, 79, 157, 80, 159, 81, 161,
+	82, 163, 83, 165, 84, 167, 85, 169, 86, 171, 87, 173, 88, 175, 89, 177,
+	90, 179, 91, 181, 92, 183, 93, 185, 94, 187, 95, 189, 96, 191, 97, 193,
+	98, 195, 99, 197, 100, 199, 101, 201, 102, 203, 103, 205, 104, 207, 105,
+	209, 106, 211, 107, 213, 108, 215, 109, 217, 110, 219, 111, 221, 112, 223,
+	113, 225, 114, 227, 115, 229, 116, 231, 117, 233, 118, 235, 119, 237, 120,
+	239, 121, 241, 122, 243, 123, 245, 124, 247, 125, 249, 126, 251, 127, 253,
+	128, 255, 129, 257, 130, 259, 131, 261, 132, 263, 133, 265, 134, 267, 135,
+	269, 136, 271, 137, 273, 138, 275, 139, 277, 140, 279, 141, 281, 142, 283,
+	143, 285, 144, 287, 145, 289, 146, 291, 147, 293, 148, 295, 149, 297, 150,
+	299, 151, 301, 152, 303, 153, 305, 154, 307, 155, 309, 156, 311, 157, 313,
+	158, 315, 159, 317, 160, 319, 161, 321, 162, 323, 163, 325, 164, 327, 165,
+	329, 166, 331, 167, 333, 168, 335, 169, 337, 170, 339, 171, 341, 172, 343,
+	173, 345, 174, 347, 175, 349, 176, 351, 177, 353, 178, 355, 179, 357, 180,
+	359, 181, 361, 182, 363, 183, 365, 184, 367, 185, 369, 186, 371, 187, 373,
+	188, 375, 189, 377, 190, 379, 191, 381, 192, 383, 193, 385, 194, 387, 195,
+	389, 196, 391, 197, 393, 198, 395, 199, 397, 200, 399, 201, 401, 202, 403,
+	203, 405, 204, 407, 205, 409, 206, 411, 207, 413, 208, 415, 209, 417, 210,
+	419, 211, 421, 212, 423, 213, 425, 214, 427, 215, 429, 216, 431, 217, 433,
+	218, 435, 219, 437, 220, 439, 221, 441, 222, 443, 223, 445, 224, 447, 225,
+	449, 226, 451, 227, 453, 228, 455, 229, 457, 230, 459, 231, 461, 232, 463,
+	233, 465, 234, 467, 235, 469, 236, 471, 237, 473, 238, 475, 239, 477, 240,
+	479, 241, 481, 242, 483, 243, 485, 244, 487, 245, 489, 246, 491, 247, 493,
+	248, 495, 249, 497, 250, 499, 251, 501, 252, 503, 253, 505, 254, 507, 255,
+	509, 256, 511, 257, 513, 258, 515, 259, 517, 260, 519, 261, 521, 262, 523,
+	263, 525, 264, 527, 265, 529, 266, 531, 267, 533, 268, 535, 269, 537, 270,
+	539, 271, 541, 272, 543, 273, 545, 274, 547, 275, 549, 276, 551, 277, 553,
+	278, 555, 279, 557, 280, 559, 281, 561, 282, 563, 283, 565, 284, 567, 285,
+	569, 286, 571, 287, 573, 288, 575, 289, 577, 290, 579, 291, 581, 292, 583,
+	293, 585, 294, 587, 295, 589, 296, 591, 297, 593, 298, 595, 299, 597, 300,
+	599, 301, 601, 302, 603, 303, 605, 304, 607, 305, 609, 306, 611, 307, 613,
+	308, 615, 309, 617, 310, 619, 311, 621, 312, 623, 313, 625, 314, 627, 315,
+	629, 316, 631, 317, 633, 318, 635, 319, 637, 320, 639, 321, 641, 322, 643,
+	323, 645, 324, 647, 325, 649, 326, 651, 327, 653, 328, 655, 329, 657, 330,
+	659, 331, 661, 332, 663, 333, 665, 334, 667, 335, 669, 336, 671, 337, 673,
+	338, 675, 339, 677, 340, 679, 341, 681, 342, 683, 343, 685, 344, 687, 345,
+	689, 346, 691, 347, 693, 348, 695, 349, 697, 350, 699, 351, 701, 352, 703,
+	353, 705, 354, 707, 355, 709, 356, 711, 357, 713, 358, 715, 359, 717, 360,
+	719, 361, 721, 362, 723, 363, 725, 364, 727, 365, 729, 366, 731, 367, 733,
+	368, 735, 369, 737, 370, 739, 371, 741, 372, 743, 373, 745, 374, 747, 375,
+	749, 376, 751, 377, 753, 378, 755, 379, 757, 380, 759, 381, 761, 382, 763,
+	383, 765, 384, 767, 385, 769, 386, 771, 387, 773, 388, 775, 389, 777, 390,
+	779, 391, 781, 392, 783, 393, 785, 394, 787, 395, 789, 396, 791, 397, 793,
+	398, 795, 399, 797, 400, 799, 401, 801, 402, 803, 403, 805, 404, 807, 405,
+	809, 406, 811, 407, 813, 408, 815, 409, 817, 410, 819, 411, 821, 412, 823,
+	413, 825, 414, 827, 415, 829, 416, 831, 417, 833, 418, 835, 419, 837, 420,
+	839, 421, 841, 422, 843, 423, 845, 424, 847, 425, 849, 426, 851, 427, 853,
+	428, 855, 429, 857, 430, 859, 431, 861, 432, 863, 433, 865, 434, 867, 435,
+	869, 436, 871, 437, 873, 438, 875, 439, 877, 440, 879, 441, 881, 442, 883,
+	443, 885, 444, 887, 445, 889, 446, 891, 447, 893, 448, 895, 449, 897, 450,
+	899, 451, 901, 452, 903, 453, 905, 454, 907, 455, 909, 456, 911, 457, 913,
+	458, 915, 459, 917, 460, 919, 461, 921, 462, 923, 463, 925, 464, 927, 465,
+	929, 466, 931, 467, 933, 468, 935, 469, 937, 470, 939, 471, 941, 472, 943,
+	473, 945, 474, 947, 475, 949, 476, 951, 477, 953, 478, 955, 479, 957, 480,
+	959, 481, 961, 482, 963, 483, 965, 484, 967, 485, 969, 486, 971, 487, 973,
+	488, 975, 489, 977, 490, 979, 491, 981, 492, 983, 493, 985, 494, 987, 495,
+	989, 496, 991, 497, 993, 498, 995, 499, 997, 500, 999, 501, 1001, 502,
+	1003, 503, 1005, 504, 1007, 505, 1009, 506, 1011, 507, 1013, 508, 1015,
+	509, 1017, 510, 1019, 511, 1021, 512, 1023, 513, 1025, 514, 1027, 515,
+	1029, 516, 1031, 517, 1033, 518, 1035, 519, 1037, 520, 1039, 521, 1041,
+	522, 1043, 523, 1045, 524, 1047, 525, 1049, 526, 1051, 527, 1053, 528,
+	1055, 529, 1057, 530, 1059, 531, 1061, 532, 1063, 533, 1065, 534, 1067,
+	535, 1069, 536, 1071, 537, 1073, 538, 1075, 539, 1077, 540, 1079, 541,
+	1081, 542, 1083, 543, 1085, 544, 1087, 545, 1089, 546, 1091, 547, 1093,
+	548, 1095, 549, 1097, 550, 1099, 551, 1101, 552, 1103, 553, 1105, 554,
+	1107, 555, 1109, 556, 1111, 557, 1113, 558, 1115, 559, 1117, 560, 1119,
+	561, 1121, 562, 1123, 563, 1125, 564, 1127, 565, 1129, 566, 1131, 567,
+	1133, 568, 1135, 569, 1137, 570, 1139, 571, 1141, 572, 1143, 573, 1145,
+	574, 1147, 575, 1149, 576, 1151, 577, 1153, 578, 1155, 579, 1157, 580,
+	1159, 581, 1161, 582, 1163, 583, 1165, 584, 1167, 585, 1169, 586, 1171,
+	587, 1173, 588, 1175, 589, 1177, 590, 1179, 591, 1181, 592, 1183, 593,
+	1185, 594, 1187, 595, 1189, 596, 1191, 597, 1193, 598, 1195, 599, 1197,
+	600, 1199, 601, 1201, 602, 1203, 603, 1205, 604, 1207, 605, 1209, 606,
+	1211, 607, 1213, 608, 1215, 609, 1217, 610, 1219, 611, 1221, 612, 1223,
+	613, 1225, 614, 1227, 615, 1229, 616, 1231, 617, 1233, 618, 1235, 619,
+	1237, 620, 1239, 621, 1241, 622, 1243, 623, 1245, 624, 1247, 625, 1249,
+	626, 1251, 627, 1253, 628, 1255, 629, 1257, 630, 1259, 631, 1261, 632,
+	1263, 633, 1265, 634, 1267, 635, 1269, 636, 1271, 637, 1273, 638, 1275,
+	639, 1277, 640, 1279, 641, 1281, 642, 1283, 643, 1285, 644, 1287, 645,
+	1289, 646, 1291, 647, 1293, 648, 1295, 649, 1297, 650, 1299, 651, 1301,
+	652, 1303, 653, 1305, 654, 1307, 655, 1309, 656, 1311, 657, 1313, 658,
+	1315, 659, 1317, 660, 1319, 661, 1321, 662, 1323, 663, 1325, 664, 1327,
+	665, 1329, 666, 1331, 667, 1333, 668, 1335, 669, 1337, 670, 1339, 671,
+	1341, 672, 1343, 673, 1345, 674, 1347, 675, 1349, 676, 1351, 677, 1353,
+	678, 1355, 679, 1357, 680, 1359, 681, 1361, 682, 1363, 683, 1365, 684,
+	1367, 685, 1369, 686, 1371, 687, 1373, 688, 1375, 689, 1377, 690, 1379,
+	691, 1381, 692, 1383, 693, 1385, 694, 1387, 695, 1389, 696, 1391, 697,
+	1393, 698, 1395, 699, 1397, 700, 1399, 701, 1401, 702, 1403, 703, 1405,
+	704, 1407, 705, 1409, 706, 1411, 707, 1413, 708, 1415, 709, 1417, 710,
+	1419, 711, 1421, 712, 1423, 713, 1425, 714, 1427, 715, 1429, 716, 1431,
+	717, 1433, 718, 1435, 719, 1437, 720, 1439, 721, 1441, 722, 1443, 723,
+	1445, 724, 1447, 725, 1449, 726, 1451, 727, 1453, 728, 1455, 729, 1457,
+	730, 1459, 731, 1461, 732, 1463, 733, 1465, 734, 1467, 735, 1469, 736,
+	1471, 737, 1473, 738, 1475, 739, 1477, 740, 1479, 741, 1481, 742, 1483,
+	743, 1485, 744, 1487, 745, 1489, 746, 1491, 747, 1493, 748, 1495, 749,
+	1497, 750, 1499, 751, 1501, 752, 1503, 753, 1505, 754, 1507, 755, 1509,
+	756, 1511, 757, 1513, 758, 1515, 759, 1517, 760, 1519, 761, 1521, 762,
+	1523, 763, 1525, 764, 1527, 765, 1529, 766, 1531, 767, 1533, 768, 1535,
+	769, 1537, 770, 1539, 771, 1541, 772, 1543, 773, 1545, 774, 1547, 775,
+	1549, 776, 1551, 777, 1553, 778, 1555, 779, 1557, 780, 1559, 781, 1561,
+	782, 1563, 783, 1565, 784, 1567, 785, 1569, 786, 1571, 787, 1573, 788,
+	1575, 789, 1577, 790, 1579, 791, 1581, 792, 1583, 793, 1585, 794, 1587,
+	795, 1589, 796, 1591, 797, 1593, 798, 1595, 799, 1597, 800, 1599, 801,
+	1601, 802, 1603, 803, 1605, 804, 1607, 805, 1609, 806, 1611, 807, 1613,
+	808, 1615, 809, 1617, 810, 1619, 811, 1621, 812, 1623, 813, 1625, 814,
+	1627, 815, 1629, 816, 1631, 817, 1633, 818, 1635, 819, 1637, 820, 1639,
+	821, 1641, 822, 1643, 823, 1645, 824, 1647, 825, 1649, 826, 1651, 827,
+	1653, 828, 1655, 829, 1657, 830, 1659, 831, 1661, 832, 1663, 833, 1665,
+	834, 1667, 835, 1669, 836, 1671, 837, 1673, 838, 1675, 839, 1677, 840,
+	1679, 841, 1681, 842, 1683, 843, 1685, 844, 1687, 845, 1689, 846, 1691,
+	847, 1693, 848, 1695, 849, 1697, 850, 1699, 851, 1701, 852, 1703, 853,
+	1705, 854, 1707, 855, 1709, 856, 1711, 857, 1713, 858, 1715, 859, 1717,
+	860, 1719, 861, 1721, 862, 1723, 863, 1725, 864, 1727, 865, 1729, 866,
+	1731, 867, 1733, 868, 1735, 869, 1737, 870, 1739, 871, 1741, 872, 1743,
+	873, 1745, 874, 1747, 875, 1749, 876, 1751, 877, 1753, 878, 1755, 879,
+	1757, 880, 1759, 881, 1761, 882, 1763, 883, 1765, 884, 1767, 885, 1769,
+	886, 1771, 887, 1773, 888, 1775, 889, 1777, 890, 1779, 891, 1781, 892,
+	1783, 893, 1785, 894, 1787, 895, 1789, 896, 1791, 897, 1793, 898, 1795,
+	899, 1797, 900, 1799, 901, 1801, 902, 1803, 903, 1805, 904, 1807, 905,
+	1809, 906, 1811, 907, 1813, 908, 1815, 909, 1817, 910, 1819, 911, 1821,
+	912, 1823, 913, 1825, 914, 1827, 915, 1829, 916, 1831, 917, 1833, 918,
+	1835, 919, 1837, 920, 1839, 921, 1841, 922, 1843, 923, 1845, 924, 1847,
+	925, 1849, 926, 1851, 927, 1853, 928, 1855, 929, 1857, 930, 1859, 931,
+	1861, 932, 1863, 933, 1865, 934, 1867, 935, 1869, 936, 1871, 937, 1873,
+	938, 1875, 939, 1877, 940, 1879, 941, 1881, 942, 1883, 943, 1885, 944,
+	1887, 945, 1889, 946, 1891, 947, 1893, 948, 1895, 949, 1897, 950, 1899,
+	951, 1901, 952, 1903, 953, 1905, 954, 1907, 955, 1909, 956, 1911, 957,
+	1913, 958, 1915, 959, 1917, 960, 1919, 961, 1921, 962, 1923, 963, 1925,
+	964, 1927, 965, 1929, 966, 1931, 967, 1933, 968, 1935, 969, 1937, 970,
+	1939, 971, 1941, 972, 1943, 973, 1945, 974, 1947, 975, 1949, 976, 1951,
+	977, 1953, 978, 1955, 979, 1957, 980, 1959, 981, 1961, 982, 1963, 983,
+	1965, 984, 1967, 985, 1969, 986, 1971, 987, 1973, 988, 1975, 989, 1977,
+	990, 1979, 991, 1981, 992, 1983, 993, 1985, 994, 1987, 995, 1989, 996,
+	1991, 997, 1993, 998, 1995, 999, 1997, 1000, 1999, 1001, 2001, 1002, 2003,
+	1003, 2005, 1004, 2007, 1005, 2009, 1006, 2011, 1007, 2013, 1008, 2015,
+	1009, 2017, 1010, 2019, 1011, 2021, 1012, 2023, 1013, 2025, 1014, 2027,
+	1015, 2029, 1016, 2031, 1017, 2033, 1018, 2035, 1019, 2037, 1020, 2039,
+	1021, 2041, 1022, 2043, 1023, 2045, 1024, 2047, 1025, 2049, 1026, 2051,
+	1027, 2053, 1028, 2055, 1029, 2057, 1030, 2059, 1031, 2061, 1032, 2063,
+	1033, 2065, 1034, 2067, 1035, 2069, 1036, 2071, 1037, 2073, 1038, 2075,
+	1039, 2077, 1040, 2079, 1041, 2081, 1042, 2083, 1043, 2085, 1044, 2087,
+	1045, 2089, 1046, 2091, 1047, 2093, 1048, 2095, 1049, 2097, 1050, 2099,
+	1051, 2101, 1052, 2103, 1053, 2105, 1054, 2107, 1055, 2109, 1056, 2111,
+	1057, 2113, 1058, 2115, 1059, 2117, 1060, 2119, 1061, 2121, 1062, 2123,
+	1063, 2125, 1064, 2127, 1065, 2129, 1066, 2131, 1067, 2133, 1068, 2135,
+	1069, 2137, 1070, 2139, 1071, 2141, 1072, 2143, 1073, 2145, 1074, 2147,
+	1075, 2149, 2, 2151, 1076, 2153, 1077, 2155, 1078, 2157, 1079, 2159, 1080,
+	2161, 1081, 2163, 1082, 2165, 1083, 2167, 1084, 2169, 1085, 2171, 1086,
+	2173, 1087, 2175, 1088, 2177, 1089, 2179, 1090, 2181, 1091, 2183, 1092,
+	2185, 2, 2187, 2, 2189, 2, 2191, 2, 2193, 2, 2195, 2, 2197, 2, 2199, 2,
+	2201, 2, 2203, 1093, 3, 2, 19, 5, 2, 11, 12, 15, 15, 34, 34, 4, 2, 11,
+	11, 34, 34, 4, 2, 12, 12, 15, 15, 6, 2, 73, 73, 77, 77, 79, 79, 86, 86,
+	3, 2, 98, 98, 3, 2, 50, 59, 4, 2, 48, 48, 50, 59, 4, 2, 50, 60, 67, 72,
+	7, 2, 38, 38, 48, 48, 50, 59, 67, 92, 97, 97, 4, 2, 45, 45, 47, 47, 7,
+	2, 38, 38, 50, 59, 67, 92, 97, 97, 130, 1, 6, 2, 38, 38, 67, 92, 97, 97,
+	130, 1, 4, 2, 36, 36, 94, 94, 4, 2, 41, 41, 94, 94, 4, 2, 94, 94, 98, 98,
+	4, 2, 50, 59, 67, 72, 3, 2, 50, 51, 2, 12914, 2, 3, 3, 2, 2, 2, 2, 5, 3,
+	2, 2, 2, 2, 7, 3, 2, 2, 2, 2, 9, 3, 2, 2, 2, 2, 11, 3, 2, 2, 2, 2, 13,
+	3, 2, 2, 2, 2, 15, 3, 2, 2, 2, 2, 17, 3, 2, 2, 2, 2, 19, 3, 2, 2, 2, 2,
+	21, 3, 2, 2, 2, 2, 23, 3, 2, 2, 2, 2, 25, 3, 2, 2, 2, 2, 27, 3, 2, 2, 2,
+	2, 29, 3, 2, 2, 2, 2, 31, 3, 2, 2, 2, 2, 33, 3, 2, 2, 2, 2, 35, 3, 2, 2,
+	2, 2, 37, 3, 2, 2, 2, 2, 39, 3, 2, 2, 2, 2, 41, 3, 2, 2, 2, 2, 43, 3, 2,
+	2, 2, 2, 45, 3, 2, 2, 2, 2, 47, 3, 2, 2, 2, 2, 49, 3, 2, 2, 2, 2, 51, 3,
+	2, 2, 2, 2, 53, 3, 2, 2, 2, 2, 55, 3, 2, 2, 2, 2, 57, 3, 2, 2, 2, 2, 59,
+	3, 2, 2, 2, 2, 61, 3, 2, 2, 2, 2, 63, 3, 2, 2, 2, 2, 65, 3, 2, 2, 2, 2,
+	67, 3, 2, 2, 2, 2, 69, 3, 2, 2, 2, 2, 71, 3, 2, 2, 2, 2, 73, 3, 2, 2, 2,
+	2, 75, 3, 2, 2, 2, 2, 77, 3, 2, 2, 2, 2, 79, 3, 2, 2, 2, 2, 81, 3, 2, 2,
+	2, 2, 83, 3, 2, 2, 2, 2, 85, 3, 2, 2, 2, 2, 87, 3, 2, 2, 2, 2, 89, 3, 2,
+	2, 2, 2, 91, 3, 2, 2, 2, 2, 93, 3, 2, 2, 2, 2, 95, 3, 2, 2, 2, 2, 97, 3,
+	2, 2, 2, 2, 99, 3, 2, 2, 2, 2, 101, 3, 2, 2, 2, 2, 103, 3, 2, 2, 2, 2,
+	105, 3, 2, 2, 2, 2, 107, 3, 2, 2, 2, 2, 109, 3, 2, 2, 2, 2, 111, 3, 2,
+	2, 2, 2, 113, 3, 2, 2, 2, 2, 115, 3, 2, 2, 2, 2, 117, 3, 2, 2, 2, 2, 119,
+	3, 2, 2, 2, 2, 121, 3, 2, 2, 2, 2, 123, 3, 2, 2, 2, 2, 125, 3, 2, 2, 2,
+	2, 127, 3, 2, 2, 2, 2, 129, 3, 2, 2, 2, 2, 131, 3, 2, 2, 2, 2, 133, 3,
+	2, 2, 2, 2, 135, 3, 2, 2, 2, 2, 137, 3, 2, 2, 2, 2, 139, 3, 2, 2, 2, 2,
+	141, 3, 2, 2, 2, 2, 143, 3, 2, 2, 2, 2, 145, 3, 2, 2, 2, 2, 147, 3, 2,
+	2, 2, 2, 149, 3, 2, 2, 2, 2, 151, 3, 2, 2, 2, 2, 153, 3, 2, 2, 2, 2, 155,
+	3, 2, 2, 2, 2, 157, 3, 2, 2, 2, 2, 159, 3, 2, 2, 2, 2, 161, 3, 2, 2, 2,
+	2, 163, 3, 2, 2, 2, 2, 165, 3, 2, 2, 2, 2, 167, 3, 2, 2, 2, 2, 169, 3,
+	2, 2, 2, 2, 171, 3, 2, 2, 2, 2, 173, 3, 2, 2, 2, 2, 175, 3, 2, 2, 2, 2,
+	177, 3, 2, 2, 2, 2, 179, 3, 2, 2, 2, 2, 181, 3, 2, 2, 2, 2, 183, 3, 2,
+	2, 2, 2, 185, 3, 2, 2, 2, 2, 187, 3, 2, 2, 2, 2, 189, 3, 2, 2, 2, 2, 191,
+	3, 2, 2, 2, 2, 193, 3, 2, 2, 2, 2, 195, 3, 2, 2, 2, 2, 197, 3, 2, 2, 2,
+	2, 199, 3, 2, 2, 2, 2, 201, 3, 2, 2, 2, 2, 203, 3, 2, 2, 2, 2, 205, 3,
+	2, 2, 2, 2, 207, 3, 2, 2, 2, 2, 209, 3, 2, 2, 2, 2, 211, 3, 2, 2, 2, 2,
+	213, 3, 2, 2, 2, 2, 215, 3, 2, 2, 2, 2, 217, 3, 2, 2, 2, 2, 219, 3, 2,
+	2, 2, 2, 221, 3, 2, 2, 2, 2, 223, 3, 2, 2, 2, 2, 225, 3, 2, 2, 2, 2, 227,
+	3, 2, 2, 2, 2, 229, 3, 2, 2, 2, 2, 231, 3, 2, 2, 2, 2, 233, 3, 2, 2, 2,
+	2, 235, 3, 2, 2, 2, 2, 237, 3, 2, 2, 2, 2, 239, 3, 2, 2, 2, 2, 241, 3,
+	2, 2, 2, 2, 243, 3, 2, 2, 2, 2, 245, 3, 2, 2, 2, 2, 247, 3, 2, 2, 2, 2,
+	249, 3, 2, 2, 2, 2, 251, 3, 2, 2, 2, 2, 253, 3, 2, 2, 2, 2, 255, 3, 2,
+	2, 2, 2, 257, 3, 2, 2, 2, 2, 259, 3, 2, 2, 2, 2, 261, 3, 2, 2, 2, 2, 263,
+	3, 2, 2, 2, 2, 265, 3, 2, 2, 2, 2, 267, 3, 2, 2, 2, 2, 269, 3, 2, 2, 2,
+	2, 271, 3, 2, 2, 2, 2, 273, 3, 2, 2, 2, 2, 275, 3, 2, 2, 2, 2, 277, 3,
+	2, 2, 2, 2, 279, 3, 2, 2, 2, 2, 281, 3, 2, 2, 2, 2, 283, 3, 2, 2, 2, 2,
+	285, 3, 2, 2, 2, 2, 287, 3, 2, 2, 2, 2, 289, 3, 2, 2, 2, 2, 291, 3, 2,
+	2, 2, 2, 293, 3, 2, 2, 2, 2, 295, 3, 2, 2, 2, 2, 297, 3, 2, 2, 2, 2, 299,
+	3, 2, 2, 2, 2, 301, 3, 2, 2, 2, 2, 303, 3, 2, 2, 2, 2, 305, 3, 2, 2, 2,
+	2, 307, 3, 2, 2, 2, 2, 309, 3, 2, 2, 2, 2, 311, 3, 2, 2, 2, 2, 313, 3,
+	2, 2, 2, 2, 315, 3, 2, 2, 2, 2, 317, 3, 2, 2, 2, 2, 319, 3, 2, 2, 2, 2,
+	321, 3, 2, 2, 2, 2, 323, 3, 2, 2, 2, 2, 325, 3, 2, 2, 2, 2, 327, 3, 2,
+	2, 2, 2, 329, 3, 2, 2, 2, 2, 331, 3, 2, 2, 2, 2, 333, 3, 2, 2, 2, 2, 335,
+	3, 2, 2, 2, 2, 337, 3, 2, 2, 2, 2, 339, 3, 2, 2, 2, 2, 341, 3, 2, 2, 2,
+	2, 343, 3, 2, 2, 2, 2, 345, 3, 2, 2, 2, 2, 347, 3, 2, 2, 2, 2, 349, 3,
+	2, 2, 2, 2, 351, 3, 2, 2, 2, 2, 353, 3, 2, 2, 2, 2, 355, 3, 2, 2, 2, 2,
+	357, 3, 2, 2, 2, 2, 359, 3, 2, 2, 2, 2, 361, 3, 2, 2, 2, 2, 363, 3, 2,
+	2, 2, 2, 365, 3, 2, 2, 2, 2, 367, 3, 2, 2, 2, 2, 369, 3, 2, 2, 2, 2, 371,
+	3, 2, 2, 2, 2, 373, 3, 2, 2, 2, 2, 375, 3, 2, 2, 2, 2, 377, 3, 2, 2, 2,
+	2, 379, 3, 2, 2, 2, 2, 381, 3, 2, 2, 2, 2, 383, 3, 2, 2, 2, 2, 385, 3,
+	2, 2, 2, 2, 387, 3, 2, 2, 2, 2, 389, 3, 2, 2, 2, 2, 391, 3, 2, 2, 2, 2,
+	393, 3, 2, 2, 2, 2, 395, 3, 2, 2, 2, 2, 397, 3, 2, 2, 2, 2, 399, 3, 2,
+	2, 2, 2, 401, 3, 2, 2, 2, 2, 403, 3, 2, 2, 2, 2, 405, 3, 2, 2, 2, 2, 407,
+	3, 2, 2, 2, 2, 409, 3, 2, 2, 2, 2, 411, 3, 2, 2, 2, 2, 413, 3, 2, 2, 2,
+	2, 415, 3, 2, 2, 2, 2, 417, 3, 2, 2, 2, 2, 419, 3, 2, 2, 2, 2, 421, 3,
+	2, 2, 2, 2, 423, 3, 2, 2, 2, 2, 425, 3, 2, 2, 2, 2, 427, 3, 2, 2, 2, 2,
+	429, 3, 2, 2, 2, 2, 431, 3, 2, 2, 2, 2, 433, 3, 2, 2, 2, 2, 435, 3, 2,
+	2, 2, 2, 437, 3, 2, 2, 2, 2, 439, 3, 2, 2, 2, 2, 441, 3, 2, 2, 2, 2, 443,
+	3, 2, 2, 2, 2, 445, 3, 2, 2, 2, 2, 447, 3, 2, 2, 2, 2, 449, 3, 2, 2, 2,
+	2, 451, 3, 2, 2, 2, 2, 453, 3, 2, 2, 2, 2, 455, 3, 2, 2, 2, 2, 457, 3,
+	2, 2, 2, 2, 459, 3, 2, 2, 2, 2, 461, 3, 2, 2, 2, 2, 463, 3, 2, 2, 2, 2,
+	465, 3, 2, 2, 2, 2, 467, 3, 2, 2, 2, 2, 469, 3, 2, 2, 2, 2, 471, 3, 2,
+	2, 2, 2, 473, 3, 2, 2, 2, 2, 475, 3, 2, 2, 2, 2, 477, 3, 2, 2, 2, 2, 479,
+	3, 2, 2, 2, 2, 481, 3, 2, 2, 2, 2, 483, 3, 2, 2, 2, 2, 485, 3, 2, 2, 2,
+	2, 487, 3, 2, 2, 2, 2, 489, 3, 2, 2, 2, 2, 491, 3, 2, 2, 2, 2, 493, 3,
+	2, 2, 2, 2, 495, 3, 2, 2, 2, 2, 497, 3, 2, 2, 2, 2, 499, 3, 2, 2, 2, 2,
+	501, 3, 2, 2, 2, 2, 503, 3, 2, 2, 2, 2, 505, 3, 2, 2, 2, 2, 507, 3, 2,
+	2, 2, 2, 509, 3, 2, 2, 2, 2, 511, 3, 2, 2, 2, 2, 513, 3, 2, 2, 2, 2, 515,
+	3, 2, 2, 2, 2, 517, 3, 2, 2, 2, 2, 519, 3, 2, 2, 2, 2, 521, 3, 2, 2, 2,
+	2, 523, 3, 2, 2, 2, 2, 525, 3, 2, 2, 2, 2, 527, 3, 2, 2, 2, 2, 529, 3,
+	2, 2, 2, 2, 531, 3, 2, 2, 2, 2, 533, 3, 2, 2, 2, 2, 535, 3, 2, 2, 2, 2,
+	537, 3, 2, 2, 2, 2, 539, 3, 2, 2, 2, 2, 541, 3, 2, 2, 2, 2, 543, 3, 2,
+	2, 2, 2, 545, 3, 2, 2, 2, 2, 547, 3, 2, 2, 2, 2, 549, 3, 2, 2, 2, 2, 551,
+	3, 2, 2, 2, 2, 553, 3, 2, 2, 2, 2, 555, 3, 2, 2, 2, 2, 557, 3, 2, 2, 2,
+	2, 559, 3, 2, 2, 2, 2, 561, 3, 2, 2, 2, 2, 563, 3, 2, 2, 2, 2, 565, 3,
+	2, 2, 2, 2, 567, 3, 2, 2, 2, 2, 569, 3, 2, 2, 2, 2, 571, 3, 2, 2, 2, 2,
+	573, 3, 2, 2, 2, 2, 575, 3, 2, 2, 2, 2, 577, 3, 2, 2, 2, 2, 579, 3, 2,
+	2, 2, 2, 581, 3, 2, 2, 2, 2, 583, 3, 2, 2, 2, 2, 585, 3, 2, 2, 2, 2, 587,
+	3, 2, 2, 2, 2, 589, 3, 2, 2, 2, 2, 591, 3, 2, 2, 2, 2, 593, 3, 2, 2, 2,
+	2, 595, 3, 2, 2, 2, 2, 597, 3, 2, 2, 2, 2, 599, 3, 2, 2, 2, 2, 601, 3,
+	2, 2, 2, 2, 603, 3, 2, 2, 2, 2, 605, 3, 2, 2, 2, 2, 607, 3, 2, 2, 2, 2,
+	609, 3, 2, 2, 2, 2, 611, 3, 2, 2, 2, 2, 613, 3, 2, 2, 2, 2, 615, 3, 2,
+	2, 2, 2, 617, 3, 2, 2, 2, 2, 619, 3, 2, 2, 2, 2, 621, 3, 2, 2, 2, 2, 623,
+	3, 2, 2, 2, 2, 625, 3, 2, 2, 2, 2, 627, 3, 2, 2, 2, 2, 629, 3, 2, 2, 2,
+	2, 631, 3, 2, 2, 2, 2, 633, 3, 2, 2, 2, 2, 635, 3, 2, 2, 2, 2, 637, 3,
+	2, 2, 2, 2, 639, 3, 2, 2, 2, 2, 641, 3, 2, 2, 2, 2, 643, 3, 2, 2, 2, 2,
+	645, 3, 2, 2, 2, 2, 647, 3, 2, 2, 2, 2, 649, 3, 2, 2, 2, 2, 651, 3, 2,
+	2, 2, 2, 653, 3, 2, 2, 2, 2, 655, 3, 2, 2, 2, 2, 657, 3, 2, 2, 2, 2, 659,
+	3, 2, 2, 2, 2, 661, 3, 2, 2, 2, 2, 663, 3, 2, 2, 2, 2, 665, 3, 2, 2, 2,
+	2, 667, 3, 2, 2, 2, 2, 669, 3, 2, 2, 2, 2, 671, 3, 2, 2, 2, 2, 673, 3,
+	2, 2, 2, 2, 675, 3, 2, 2, 2, 2, 677, 3, 2, 2, 2, 2, 679, 3, 2, 2, 2, 2,
+	681, 3, 2, 2, 2, 2, 683, 3, 2, 2, 2, 2, 685, 3, 2, 2, 2, 2, 687, 3, 2,
+	2, 2, 2, 689, 3, 2, 2, 2, 2, 691, 3, 2, 2, 2, 2, 693, 3, 2, 2, 2, 2, 695,
+	3, 2, 2, 2, 2, 697, 3, 2, 2, 2, 2, 699, 3, 2, 2, 2, 2, 701, 3, 2, 2, 2,
+	2, 703, 3, 2, 2, 2, 2, 705, 3, 2, 2, 2, 2, 707, 3, 2, 2, 2, 2, 709, 3,
+	2, 2, 2, 2, 711, 3, 2, 2, 2, 2, 713, 3, 2, 2, 2, 2, 715, 3, 2, 2, 2, 2,
+	717, 3, 2, 2, 2, 2, 719, 3, 2, 2, 2, 2, 721, 3, 2, 2, 2, 2, 723, 3, 2,
+	2, 2, 2, 725, 3, 2, 2, 2, 2, 727, 3, 2, 2, 2, 2, 729, 3, 2, 2, 2, 2, 731,
+	3, 2, 2, 2, 2, 733, 3, 2, 2, 2, 2, 735, 3, 2, 2, 2, 2, 737, 3, 2, 2, 2,
+	2, 739, 3, 2, 2, 2, 2, 741, 3, 2, 2, 2, 2, 743, 3, 2, 2, 2, 2, 745, 3,
+	2, 2, 2, 2, 747, 3, 2, 2, 2, 2, 749, 3, 2, 2, 2, 2, 751, 3, 2, 2, 2, 2,
+	753, 3, 2, 2, 2, 2, 755, 3, 2, 2, 2, 2, 757, 3, 2, 2, 2, 2, 759, 3, 2,
+	2, 2, 2, 761, 3, 2, 2, 2, 2, 763, 3, 2, 2, 2, 2, 765, 3, 2, 2, 2, 2, 767,
+	3, 2, 2, 2, 2, 769, 3, 2, 2, 2, 2, 771, 3, 2, 2, 2, 2, 773, 3, 2, 2, 2,
+	2, 775, 3, 2, 2, 2, 2, 777, 3, 2, 2, 2, 2, 779, 3, 2, 2, 2, 2, 781, 3,
+	2, 2, 2, 2, 783, 3, 2, 2, 2, 2, 785, 3, 2, 2, 2, 2, 787, 3, 2, 2, 2, 2,
+	789, 3, 2, 2, 2, 2, 791, 3, 2, 2, 2, 2, 793, 3, 2, 2, 2, 2, 795, 3, 2,
+	2, 2, 2, 797, 3, 2, 2, 2, 2, 799, 3, 2, 2, 2, 2, 801, 3, 2, 2, 2, 2, 803,
+	3, 2, 2, 2, 2, 805, 3, 2, 2, 2, 2, 807, 3, 2, 2, 2, 2, 809, 3, 2, 2, 2,
+	2, 811, 3, 2, 2, 2, 2, 813, 3, 2, 2, 2, 2, 815, 3, 2, 2, 2, 2, 817, 3,
+	2, 2, 2, 2, 819, 3, 2, 2, 2, 2, 821, 3, 2, 2, 2, 2, 823, 3, 2, 2, 2, 2,
+	825, 3, 2, 2, 2, 2, 827, 3, 2, 2, 2, 2, 829, 3, 2, 2, 2, 2, 831, 3, 2,
+	2, 2, 2, 833, 3, 2, 2, 2, 2, 835, 3, 2, 2, 2, 2, 837, 3, 2, 2, 2, 2, 839,
+	3, 2, 2, 2, 2, 841, 3, 2, 2, 2, 2, 843, 3, 2, 2, 2, 2, 845, 3, 2, 2, 2,
+	2, 847, 3, 2, 2, 2, 2, 849, 3, 2, 2, 2, 2, 851, 3, 2, 2, 2, 2, 853, 3,
+	2, 2, 2, 2, 855, 3, 2, 2, 2, 2, 857, 3, 2, 2, 2, 2, 859, 3, 2, 2, 2, 2,
+	861, 3, 2, 2, 2, 2, 863, 3, 2, 2, 2, 2, 865, 3, 2, 2, 2, 2, 867, 3, 2,
+	2, 2, 2, 869, 3, 2, 2, 2, 2, 871, 3, 2, 2, 2, 2, 873, 3, 2, 2, 2, 2, 875,
+	3, 2, 2, 2, 2, 877, 3, 2, 2, 2, 2, 879, 3, 2, 2, 2, 2, 881, 3, 2, 2, 2,
+	2, 883, 3, 2, 2, 2, 2, 885, 3, 2, 2, 2, 2, 887, 3, 2, 2, 2, 2, 889, 3,
+	2, 2, 2, 2, 891, 3, 2, 2, 2, 2, 893, 3, 2, 2, 2, 2, 895, 3, 2, 2, 2, 2,
+	897, 3, 2, 2, 2, 2, 899, 3, 2, 2, 2, 2, 901, 3, 2, 2, 2, 2, 903, 3, 2,
+	2, 2, 2, 905, 3, 2, 2, 2, 2, 907, 3, 2, 2, 2, 2, 909, 3, 2, 2, 2, 2, 911,
+	3, 2, 2, 2, 2, 913, 3, 2, 2, 2, 2, 915, 3, 2, 2, 2, 2, 917, 3, 2, 2, 2,
+	2, 919, 3, 2, 2, 2, 2, 921, 3, 2, 2, 2, 2, 923, 3, 2, 2, 2, 2, 925, 3,
+	2, 2, 2, 2, 927, 3, 2, 2, 2, 2, 929, 3, 2, 2, 2, 2, 931, 3, 2, 2, 2, 2,
+	933, 3, 2, 2, 2, 2, 935, 3, 2, 2, 2, 2, 937, 3, 2, 2, 2, 2, 939, 3, 2,
+	2, 2, 2, 941, 3, 2, 2, 2, 2, 943, 3, 2, 2, 2, 2, 945, 3, 2, 2, 2, 2, 947,
+	3, 2, 2, 2, 2, 949, 3, 2, 2, 2, 2, 951, 3, 2, 2, 2, 2, 953, 3, 2, 2, 2,
+	2, 955, 3, 2, 2, 2, 2, 957, 3, 2, 2, 2, 2, 959, 3, 2, 2, 2, 2, 961, 3,
+	2, 2, 2, 2, 963, 3, 2, 2, 2, 2, 965, 3, 2, 2, 2, 2, 967, 3, 2, 2, 2, 2,
+	969, 3, 2, 2, 2, 2, 971, 3, 2, 2, 2, 2, 973, 3, 2, 2, 2, 2, 975, 3, 2,
+	2, 2, 2, 977, 3, 2, 2, 2, 2, 979, 3, 2, 2, 2, 2, 981, 3, 2, 2, 2, 2, 983,
+	3, 2, 2, 2, 2, 985, 3, 2, 2, 2, 2, 987, 3, 2, 2, 2, 2, 989, 3, 2, 2, 2,
+	2, 991, 3, 2, 2, 2, 2, 993, 3, 2, 2, 2, 2, 995, 3, 2, 2, 2, 2, 997, 3,
+	2, 2, 2, 2, 999, 3, 2, 2, 2, 2, 1001, 3, 2, 2, 2, 2, 1003, 3, 2, 2, 2,
+	2, 1005, 3, 2, 2, 2, 2, 1007, 3, 2, 2, 2, 2, 1009, 3, 2, 2, 2, 2, 1011,
+	3, 2, 2, 2, 2, 1013, 3, 2, 2, 2, 2, 1015, 3, 2, 2, 2, 2, 1017, 3, 2, 2,
+	2, 2, 1019, 3, 2, 2, 2, 2, 1021, 3, 2, 2, 2, 2, 1023, 3, 2, 2, 2, 2, 1025,
+	3, 2, 2, 2, 2, 1027, 3, 2, 2, 2, 2, 1029, 3, 2, 2, 2, 2, 1031, 3, 2, 2,
+	2, 2, 1033, 3, 2, 2, 2, 2, 1035, 3, 2, 2, 2, 2, 1037, 3, 2, 2, 2, 2, 1039,
+	3, 2, 2, 2, 2, 1041, 3, 2, 2, 2, 2, 1043, 3, 2, 2, 2, 2, 1045, 3, 2, 2,
+	2, 2, 1047, 3, 2, 2, 2, 2, 1049, 3, 2, 2, 2, 2, 1051, 3, 2, 2, 2, 2, 1053,
+	3, 2, 2, 2, 2, 1055, 3, 2, 2, 2, 2, 1057, 3, 2, 2, 2, 2, 1059, 3, 2, 2,
+	2, 2, 1061, 3, 2, 2, 2, 2, 1063, 3, 2, 2, 2, 2, 1065, 3, 2, 2, 2, 2, 1067,
+	3, 2, 2, 2, 2, 1069, 3, 2, 2, 2, 2, 1071, 3, 2, 2, 2, 2, 1073, 3, 2, 2,
+	2, 2, 1075, 3, 2, 2, 2, 2, 1077, 3, 2, 2, 2, 2, 1079, 3, 2, 2, 2, 2, 1081,
+	3, 2, 2, 2, 2, 1083, 3, 2, 2, 2, 2, 1085, 3, 2, 2, 2, 2, 1087, 3, 2, 2,
+	2, 2, 1089, 3, 2, 2, 2, 2, 1091, 3, 2, 2, 2, 2, 1093, 3, 2, 2, 2, 2, 1095,
+	3, 2, 2, 2, 2, 1097, 3, 2, 2, 2, 2, 1099, 3, 2, 2, 2, 2, 1101, 3, 2, 2,
+	2, 2, 1103, 3, 2, 2, 2, 2, 1105, 3, 2, 2, 2, 2, 1107, 3, 2, 2, 2, 2, 1109,
+	3, 2, 2, 2, 2, 1111, 3, 2, 2, 2, 2, 1113, 3, 2, 2, 2, 2, 1115, 3, 2, 2,
+	2, 2, 1117, 3, 2, 2, 2, 2, 1119, 3, 2, 2, 2, 2, 1121, 3, 2, 2, 2, 2, 1123,
+	3, 2, 2, 2, 2, 1125, 3, 2, 2, 2, 2, 1127, 3, 2, 2, 2, 2, 1129, 3, 2, 2,
+	2, 2, 1131, 3, 2, 2, 2, 2, 1133, 3, 2, 2, 2, 2, 1135, 3, 2, 2, 2, 2, 1137,
+	3, 2, 2, 2, 2, 1139, 3, 2, 2, 2, 2, 1141, 3, 2, 2, 2, 2, 1143, 3, 2, 2,
+	2, 2, 1145, 3, 2, 2, 2, 2, 1147, 3, 2, 2, 2, 2, 1149, 3, 2, 2, 2, 2, 1151,
+	3, 2, 2, 2, 2, 1153, 3, 2, 2, 2, 2, 1155, 3, 2, 2, 2, 2, 1157, 3, 2, 2,
+	2, 2, 1159, 3, 2, 2, 2, 2, 1161, 3, 2, 2, 2, 2, 1163, 3, 2, 2, 2, 2, 1165,
+	3, 2, 2, 2, 2, 1167, 3, 2, 2, 2, 2, 1169, 3, 2, 2, 2, 2, 1171, 3, 2, 2,
+	2, 2, 1173, 3, 2, 2, 2, 2, 1175, 3, 2, 2, 2, 2, 1177, 3, 2, 2, 2, 2, 1179,
+	3, 2, 2, 2, 2, 1181, 3, 2, 2, 2, 2, 1183, 3, 2, 2, 2, 2, 1185, 3, 2, 2,
+	2, 2, 1187, 3, 2, 2, 2, 2, 1189, 3, 2, 2, 2, 2, 1191, 3, 2, 2, 2, 2, 1193,
+	3, 2, 2, 2, 2, 1195, 3, 2, 2, 2, 2, 1197, 3, 2, 2, 2, 2, 1199, 3, 2, 2,
+	2, 2, 1201, 3, 2, 2, 2, 2, 1203, 3, 2, 2, 2, 2, 1205, 3, 2, 2, 2, 2, 1207,
+	3, 2, 2, 2, 2, 1209, 3, 2, 2, 2, 2, 1211, 3, 2, 2, 2, 2, 1213, 3, 2, 2,
+	2, 2, 1215, 3, 2, 2, 2, 2, 1217, 3, 2, 2, 2, 2, 1219, 3, 2, 2, 2, 2, 1221,
+	3, 2, 2, 2, 2, 1223, 3, 2, 2, 2, 2, 1225, 3, 2, 2, 2, 2, 1227, 3, 2, 2,
+	2, 2, 1229, 3, 2, 2, 2, 2, 1231, 3, 2, 2, 2, 2, 1233, 3, 2, 2, 2, 2, 1235,
+	3, 2, 2, 2, 2, 1237, 3, 2, 2, 2, 2, 1239, 3, 2, 2, 2, 2, 1241, 3, 2, 2,
+	2, 2, 1243, 3, 2, 2, 2, 2, 1245, 3, 2, 2, 2, 2, 1247, 3, 2, 2, 2, 2, 1249,
+	3, 2, 2, 2, 2, 1251, 3, 2, 2, 2, 2, 1253, 3, 2, 2, 2, 2, 1255, 3, 2, 2,
+	2, 2, 1257, 3, 2, 2, 2, 2, 1259, 3, 2, 2, 2, 2, 1261, 3, 2, 2, 2, 2, 1263,
+	3, 2, 2, 2, 2, 1265, 3, 2, 2, 2, 2, 1267, 3, 2, 2, 2, 2, 1269, 3, 2, 2,
+	2, 2, 1271, 3, 2, 2, 2, 2, 1273, 3, 2, 2, 2, 2, 1275, 3, 2, 2, 2, 2, 1277,
+	3, 2, 2, 2, 2, 1279, 3, 2, 2, 2, 2, 1281, 3, 2, 2, 2, 2, 1283, 3, 2, 2,
+	2, 2, 1285, 3, 2, 2, 2, 2, 1287, 3, 2, 2, 2, 2, 1289, 3, 2, 2, 2, 2, 1291,
+	3, 2, 2, 2, 2, 1293, 3, 2, 2, 2, 2, 1295, 3, 2, 2, 2, 2, 1297, 3, 2, 2,
+	2, 2, 1299, 3, 2, 2, 2, 2, 1301, 3, 2, 2, 2, 2, 1303, 3, 2, 2, 2, 2, 1305,
+	3, 2, 2, 2, 2, 1307, 3, 2, 2, 2, 2, 1309, 3, 2, 2, 2, 2, 1311, 3, 2, 2,
+	2, 2, 1313, 3, 2, 2, 2, 2, 1315, 3, 2, 2, 2, 2, 1317, 3, 2, 2, 2, 2, 1319,
+	3, 2, 2, 2, 2, 1321, 3, 2, 2, 2, 2, 1323, 3, 2, 2, 2, 2, 1325, 3, 2, 2,
+	2, 2, 1327, 3, 2, 2, 2, 2, 1329, 3, 2, 2, 2, 2, 1331, 3, 2, 2, 2, 2, 1333,
+	3, 2, 2, 2, 2, 1335, 3, 2, 2, 2, 2, 1337, 3, 2, 2, 2, 2, 1339, 3, 2, 2,
+	2, 2, 1341, 3, 2, 2, 2, 2, 1343, 3, 2, 2, 2, 2, 1345, 3, 2, 2, 2, 2, 1347,
+	3, 2, 2, 2, 2, 1349, 3, 2, 2, 2, 2, 1351, 3, 2, 2, 2, 2, 1353, 3, 2, 2,
+	2, 2, 1355, 3, 2, 2, 2, 2, 1357, 3, 2, 2, 2, 2, 1359, 3, 2, 2, 2, 2, 1361,
+	3, 2, 2, 2, 2, 1363, 3, 2, 2, 2, 2, 1365, 3, 2, 2, 2, 2, 1367, 3, 2, 2,
+	2, 2, 1369, 3, 2, 2, 2, 2, 1371, 3, 2, 2, 2, 2, 1373, 3, 2, 2, 2, 2, 1375,
+	3, 2, 2, 2, 2, 1377, 3, 2, 2, 2, 2, 1379, 3, 2, 2, 2, 2, 1381, 3, 2, 2,
+	2, 2, 1383, 3, 2, 2, 2, 2, 1385, 3, 2, 2, 2, 2, 1387, 3, 2, 2, 2, 2, 1389,
+	3, 2, 2, 2, 2, 1391, 3, 2, 2, 2, 2, 1393, 3, 2, 2, 2, 2, 1395, 3, 2, 2,
+	2, 2, 1397, 3, 2, 2, 2, 2, 1399, 3, 2, 2, 2, 2, 1401, 3, 2, 2, 2, 2, 1403,
+	3, 2, 2, 2, 2, 1405, 3, 2, 2, 2, 2, 1407, 3, 2, 2, 2, 2, 1409, 3, 2, 2,
+	2, 2, 1411, 3, 2, 2, 2, 2, 1413, 3, 2, 2, 2, 2, 1415, 3, 2, 2, 2, 2, 1417,
+	3, 2, 2, 2, 2, 1419, 3, 2, 2, 2, 2, 1421, 3, 2, 2, 2, 2, 1423, 3, 2, 2,
+	2, 2, 1425, 3, 2, 2, 2, 2, 1427, 3, 2, 2, 2, 2, 1429, 3, 2, 2, 2, 2, 1431,
+	3, 2, 2, 2, 2, 1433, 3, 2, 2, 2, 2, 1435, 3, 2, 2, 2, 2, 1437, 3, 2, 2,
+	2, 2, 1439, 3, 2, 2, 2, 2, 1441, 3, 2, 2, 2, 2, 1443, 3, 2, 2, 2, 2, 1445,
+	3, 2, 2, 2, 2, 1447, 3, 2, 2, 2, 2, 1449, 3, 2, 2, 2, 2, 1451, 3, 2, 2,
+	2, 2, 1453, 3, 2, 2, 2, 2, 1455, 3, 2, 2, 2, 2, 1457, 3, 2, 2, 2, 2, 1459,
+	3, 2, 2, 2, 2, 1461, 3, 2, 2, 2, 2, 1463, 3, 2, 2, 2, 2, 1465, 3, 2, 2,
+	2, 2, 1467, 3, 2, 2, 2, 2, 1469, 3, 2, 2, 2, 2, 1471, 3, 2, 2, 2, 2, 1473,
+	3, 2, 2, 2, 2, 1475, 3, 2, 2, 2, 2, 1477, 3, 2, 2, 2, 2, 1479, 3, 2, 2,
+	2, 2, 1481, 3, 2, 2, 2, 2, 1483, 3, 2, 2, 2, 2, 1485, 3, 2, 2, 2, 2, 1487,
+	3, 2, 2, 2, 2, 1489, 3, 2, 2, 2, 2, 1491, 3, 2, 2, 2, 2, 1493, 3, 2, 2,
+	2, 2, 1495, 3, 2, 2, 2, 2, 1497, 3, 2, 2, 2, 2, 1499, 3, 2, 2, 2, 2, 1501,
+	3, 2, 2, 2, 2, 1503, 3, 2, 2, 2, 2, 1505, 3, 2, 2, 2, 2, 1507, 3, 2, 2,
+	2, 2, 1509, 3, 2, 2, 2, 2, 1511, 3, 2, 2, 2, 2, 1513, 3, 2, 2, 2, 2, 1515,
+	3, 2, 2, 2, 2, 1517, 3, 2, 2, 2, 2, 1519, 3, 2, 2, 2, 2, 1521, 3, 2, 2,
+	2, 2, 1523, 3, 2, 2, 2, 2, 1525, 3, 2, 2, 2, 2, 1527, 3, 2, 2, 2, 2, 1529,
+	3, 2, 2, 2, 2, 1531, 3, 2, 2, 2, 2, 1533, 3, 2, 2, 2, 2, 1535, 3, 2, 2,
+	2, 2, 1537, 3, 2, 2, 2, 2, 1539, 3, 2, 2, 2, 2, 1541, 3, 2, 2, 2, 2, 1543,
+	3, 2, 2, 2, 2, 1545, 3, 2, 2, 2, 2, 1547, 3, 2, 2, 2, 2, 1549, 3, 2, 2,
+	2, 2, 1551, 3, 2, 2, 2, 2, 1553, 3, 2, 2, 2, 2, 1555, 3, 2, 2, 2, 2, 1557,
+	3, 2, 2, 2, 2, 1559, 3, 2, 2, 2, 2, 1561, 3, 2, 2, 2, 2, 1563, 3, 2, 2,
+	2, 2, 1565, 3, 2, 2, 2, 2, 1567, 3, 2, 2, 2, 2, 1569, 3, 2, 2, 2, 2, 1571,
+	3, 2, 2, 2, 2, 1573, 3, 2, 2, 2, 2, 1575, 3, 2, 2, 2, 2, 1577, 3, 2, 2,
+	2, 2, 1579, 3, 2, 2, 2, 2, 1581, 3, 2, 2, 2, 2, 1583, 3, 2, 2, 2, 2, 1585,
+	3, 2, 2, 2, 2, 1587, 3, 2, 2, 2, 2, 1589, 3, 2, 2, 2, 2, 1591, 3, 2, 2,
+	2, 2, 1593, 3, 2, 2, 2, 2, 1595, 3, 2, 2, 2, 2, 1597, 3, 2, 2, 2, 2, 1599,
+	3, 2, 2, 2, 2, 1601, 3, 2, 2, 2, 2, 1603, 3, 2, 2, 2, 2, 1605, 3, 2, 2,
+	2, 2, 1607, 3, 2, 2, 2, 2, 1609, 3, 2, 2, 2, 2, 1611, 3, 2, 2, 2, 2, 1613,
+	3, 2, 2, 2, 2, 1615, 3, 2, 2, 2, 2, 1617, 3, 2, 2, 2, 2, 1619, 3, 2, 2,
+	2, 2, 1621, 3, 2, 2, 2, 2, 1623, 3, 2, 2, 2, 2, 1625, 3, 2, 2, 2, 2, 1627,
+	3, 2, 2, 2, 2, 1629, 3, 2, 2, 2, 2, 1631, 3, 2, 2, 2, 2, 1633, 3, 2, 2,
+	2, 2, 1635, 3, 2, 2, 2, 2, 1637, 3, 2, 2, 2, 2, 1639, 3, 2, 2, 2, 2, 1641,
+	3, 2, 2, 2, 2, 1643, 3, 2, 2, 2, 2, 1645, 3, 2, 2, 2, 2, 1647, 3, 2, 2,
+	2, 2, 1649, 3, 2, 2, 2, 2, 1651, 3, 2, 2, 2, 2, 1653, 3, 2, 2, 2, 2, 1655,
+	3, 2, 2, 2, 2, 1657, 3, 2, 2, 2, 2, 1659, 3, 2, 2, 2, 2, 1661, 3, 2, 2,
+	2, 2, 1663, 3, 2, 2, 2, 2, 1665, 3, 2, 2, 2, 2, 1667, 3, 2, 2, 2, 2, 1669,
+	3, 2, 2, 2, 2, 1671, 3, 2, 2, 2, 2, 1673, 3, 2, 2, 2, 2, 1675, 3, 2, 2,
+	2, 2, 1677, 3, 2, 2, 2, 2, 1679, 3, 2, 2, 2, 2, 1681, 3, 2, 2, 2, 2, 1683,
+	3, 2, 2, 2, 2, 1685, 3, 2, 2, 2, 2, 1687, 3, 2, 2, 2, 2, 1689, 3, 2, 2,
+	2, 2, 1691, 3, 2, 2, 2, 2, 1693, 3, 2, 2, 2, 2, 1695, 3, 2, 2, 2, 2, 1697,
+	3, 2, 2, 2, 2, 1699, 3, 2, 2, 2, 2, 1701, 3, 2, 2, 2, 2, 1703, 3, 2, 2,
+	2, 2, 1705, 3, 2, 2, 2, 2, 1707, 3, 2, 2, 2, 2, 1709, 3, 2, 2, 2, 2, 1711,
+	3, 2, 2, 2, 2, 1713, 3, 2, 2, 2, 2, 1715, 3, 2, 2, 2, 2, 1717, 3, 2, 2,
+	2, 2, 1719, 3, 2, 2, 2, 2, 1721, 3, 2, 2, 2, 2, 1723, 3, 2, 2, 2, 2, 1725,
+	3, 2, 2, 2, 2, 1727, 3, 2, 2, 2, 2, 1729, 3, 2, 2, 2, 2, 1731, 3, 2, 2,
+	2, 2, 1733, 3, 2, 2, 2, 2, 1735, 3, 2, 2, 2, 2, 1737, 3, 2, 2, 2, 2, 1739,
+	3, 2, 2, 2, 2, 1741, 3, 2, 2, 2, 2, 1743, 3, 2, 2, 2, 2, 1745, 3, 2, 2,
+	2, 2, 1747, 3, 2, 2, 2, 2, 1749, 3, 2, 2, 2, 2, 1751, 3, 2, 2, 2, 2, 1753,
+	3, 2, 2, 2, 2, 1755, 3, 2, 2, 2, 2, 1757, 3, 2, 2, 2, 2, 1759, 3, 2, 2,
+	2, 2, 1761, 3, 2, 2, 2, 2, 1763, 3, 2, 2, 2, 2, 1765, 3, 2, 2, 2, 2, 1767,
+	3, 2, 2, 2, 2, 1769, 3, 2, 2, 2, 2, 1771, 3, 2, 2, 2, 2, 1773, 3, 2, 2,
+	2, 2, 1775, 3, 2, 2, 2, 2, 1777, 3, 2, 2, 2, 2, 1779, 3, 2, 2, 2, 2, 1781,
+	3, 2, 2, 2, 2, 1783, 3, 2, 2, 2, 2, 1785, 3, 2, 2, 2, 2, 1787, 3, 2, 2,
+	2, 2, 1789, 3, 2, 2, 2, 2, 1791, 3, 2, 2, 2, 2, 1793, 3, 2, 2, 2, 2, 1795,
+	3, 2, 2, 2, 2, 1797, 3, 2, 2, 2, 2, 1799, 3, 2, 2, 2, 2, 1801, 3, 2, 2,
+	2, 2, 1803, 3, 2, 2, 2, 2, 1805, 3, 2, 2, 2, 2, 1807, 3, 2, 2, 2, 2, 1809,
+	3, 2, 2, 2, 2, 1811, 3, 2, 2, 2, 2, 1813, 3, 2, 2, 2, 2, 1815, 3, 2, 2,
+	2, 2, 1817, 3, 2, 2, 2, 2, 1819, 3, 2, 2, 2, 2, 1821, 3, 2, 2, 2, 2, 1823,
+	3, 2, 2, 2, 2, 1825, 3, 2, 2, 2, 2, 1827, 3, 2, 2, 2, 2, 1829, 3, 2, 2,
+	2, 2, 1831, 3, 2, 2, 2, 2, 1833, 3, 2, 2, 2, 2, 1835, 3, 2, 2, 2, 2, 1837,
+	3, 2, 2, 2, 2, 1839, 3, 2, 2, 2, 2, 1841, 3, 2, 2, 2, 2, 1843, 3, 2, 2,
+	2, 2, 1845, 3, 2, 2, 2, 2, 1847, 3, 2, 2, 2, 2, 1849, 3, 2, 2, 2, 2, 1851,
+	3, 2, 2, 2, 2, 1853, 3, 2, 2, 2, 2, 1855, 3, 2, 2, 2, 2, 1857, 3, 2, 2,
+	2, 2, 1859, 3, 2, 2, 2, 2, 1861, 3, 2, 2, 2, 2, 1863, 3, 2, 2, 2, 2, 1865,
+	3, 2, 2, 2, 2, 1867, 3, 2, 2, 2, 2, 1869, 3, 2, 2, 2, 2, 1871, 3, 2, 2,
+	2, 2, 1873, 3, 2, 2, 2, 2, 1875, 3, 2, 2, 2, 2, 1877, 3, 2, 2, 2, 2, 1879,
+	3, 2, 2, 2, 2, 1881, 3, 2, 2, 2, 2, 1883, 3, 2, 2, 2, 2, 1885, 3, 2, 2,
+	2, 2, 1887, 3, 2, 2, 2, 2, 1889, 3, 2, 2, 2, 2, 1891, 3, 2, 2, 2, 2, 1893,
+	3, 2, 2, 2, 2, 1895, 3, 2, 2, 2, 2, 1897, 3, 2, 2, 2, 2, 1899, 3, 2, 2,
+	2, 2, 1901, 3, 2, 2, 2, 2, 1903, 3, 2, 2, 2, 2, 1905, 3, 2, 2, 2, 2, 1907,
+	3, 2, 2, 2, 2, 1909, 3, 2, 2, 2, 2, 1911, 3, 2, 2, 2, 2, 1913, 3, 2, 2,
+	2, 2, 1915, 3, 2, 2, 2, 2, 1917, 3, 2, 2, 2, 2, 1919, 3, 2, 2, 2, 2, 1921,
+	3, 2, 2, 2, 2, 1923, 3, 2, 2, 2, 2, 1925, 3, 2, 2, 2, 2, 1927, 3, 2, 2,
+	2, 2, 1929, 3, 2, 2, 2, 2, 1931, 3, 2, 2, 2, 2, 1933, 3, 2, 2, 2, 2, 1935,
+	3, 2, 2, 2, 2, 1937, 3, 2, 2, 2, 2, 1939, 3, 2, 2, 2, 2, 1941, 3, 2, 2,
+	2, 2, 1943, 3, 2, 2, 2, 2, 1945, 3, 2, 2, 2, 2, 1947, 3, 2, 2, 2, 2, 1949,
+	3, 2, 2, 2, 2, 1951, 3, 2, 2, 2, 2, 1953, 3, 2, 2, 2, 2, 1955, 3, 2, 2,
+	2, 2, 1957, 3, 2, 2, 2, 2, 1959, 3, 2, 2, 2, 2, 1961, 3, 2, 2, 2, 2, 1963,
+	3, 2, 2, 2, 2, 1965, 3, 2, 2, 2, 2, 1967, 3, 2, 2, 2, 2, 1969, 3, 2, 2,
+	2, 2, 1971, 3, 2, 2, 2, 2, 1973, 3, 2, 2, 2, 2, 1975, 3, 2, 2, 2, 2, 1977,
+	3, 2, 2, 2, 2, 1979, 3, 2, 2, 2, 2, 1981, 3, 2, 2, 2, 2, 1983, 3, 2, 2,
+	2, 2, 1985, 3, 2, 2, 2, 2, 1987, 3, 2, 2, 2, 2, 1989, 3, 2, 2, 2, 2, 1991,
+	3, 2, 2, 2, 2, 1993, 3, 2, 2, 2, 2, 1995, 3, 2, 2, 2, 2, 1997, 3, 2, 2,
+	2, 2, 1999, 3, 2, 2, 2, 2, 2001, 3, 2, 2, 2, 2, 2003, 3, 2, 2, 2, 2, 2005,
+	3, 2, 2, 2, 2, 2007, 3, 2, 2, 2, 2, 2009, 3, 2, 2, 2, 2, 2011, 3, 2, 2,
+	2, 2, 2013, 3, 2, 2, 2, 2, 2015, 3, 2, 2, 2, 2, 2017, 3, 2, 2, 2, 2, 2019,
+	3, 2, 2, 2, 2, 2021, 3, 2, 2, 2, 2, 2023, 3, 2, 2, 2, 2, 2025, 3, 2, 2,
+	2, 2, 2027, 3, 2, 2, 2, 2, 2029, 3, 2, 2, 2, 2, 2031, 3, 2, 2, 2, 2, 2033,
+	3, 2, 2, 2, 2, 2035, 3, 2, 2, 2, 2, 2037, 3, 2, 2, 2, 2, 2039, 3, 2, 2,
+	2, 2, 2041, 3, 2, 2, 2, 2, 2043, 3, 2, 2, 2, 2, 2045, 3, 2, 2, 2, 2, 2047,
+	3, 2, 2, 2, 2, 2049, 3, 2, 2, 2, 2, 2051, 3, 2, 2, 2, 2, 2053, 3, 2, 2,
+	2, 2, 2055, 3, 2, 2, 2, 2, 2057, 3, 2, 2, 2, 2, 2059, 3, 2, 2, 2, 2, 2061,
+	3, 2, 2, 2, 2, 2063, 3, 2, 2, 2, 2, 2065, 3, 2, 2, 2, 2, 2067, 3, 2, 2,
+	2, 2, 2069, 3, 2, 2, 2, 2, 2071, 3, 2, 2, 2, 2, 2073, 3, 2, 2, 2, 2, 2075,
+	3, 2, 2, 2, 2, 2077, 3, 2, 2, 2, 2, 2079, 3, 2, 2, 2, 2, 2081, 3, 2, 2,
+	2, 2, 2083, 3, 2, 2, 2, 2, 2085, 3, 2, 2, 2, 2, 2087, 3, 2, 2, 2, 2, 2089,
+	3, 2, 2, 2, 2, 2091, 3, 2, 2, 2, 2, 2093, 3, 2, 2, 2, 2, 2095, 3, 2, 2,
+	2, 2, 2097, 3, 2, 2, 2, 2, 2099, 3, 2, 2, 2, 2, 2101, 3, 2, 2, 2, 2, 2103,
+	3, 2, 2, 2, 2, 2105, 3, 2, 2, 2, 2, 2107, 3, 2, 2, 2, 2, 2109, 3, 2, 2,
+	2, 2, 2111, 3, 2, 2, 2, 2, 2113, 3, 2, 2, 2, 2, 2115, 3, 2, 2, 2, 2, 2117,
+	3, 2, 2, 2, 2, 2119, 3, 2, 2, 2, 2, 2121, 3, 2, 2, 2, 2, 2123, 3, 2, 2,
+	2, 2, 2125, 3, 2, 2, 2, 2, 2127, 3, 2, 2, 2, 2, 2129, 3, 2, 2, 2, 2, 2131,
+	3, 2, 2, 2, 2, 2133, 3, 2, 2, 2, 2, 2135, 3, 2, 2, 2, 2, 2137, 3, 2, 2,
+	2, 2, 2139, 3, 2, 2, 2, 2, 2141, 3, 2, 2, 2, 2, 2143, 3, 2, 2, 2, 2, 2145,
+	3, 2, 2, 2, 2, 2147, 3, 2, 2, 2, 2, 2151, 3, 2, 2, 2, 2, 2153, 3, 2, 2,
+	2, 2, 2155, 3, 2, 2, 2, 2, 2157, 3, 2, 2, 2, 2, 2159, 3, 2, 2, 2, 2, 2161,
+	3, 2, 2, 2, 2, 2163, 3, 2, 2, 2, 2, 2165, 3, 2, 2, 2, 2, 2167, 3, 2, 2,
+	2, 2, 2169, 3, 2, 2, 2, 2, 2171, 3, 2, 2, 2, 2, 2173, 3, 2, 2, 2, 2, 2175,
+	3, 2, 2, 2, 2, 2177, 3, 2, 2, 2, 2, 2179, 3, 2, 2, 2, 2, 2181, 3, 2, 2,
+	2, 2, 2183, 3, 2, 2, 2, 2, 2203, 3, 2, 2, 2, 3, 2206, 3, 2, 2, 2, 5, 2212,
+	3, 2, 2, 2, 7, 2226, 3, 2, 2, 2, 9, 2270, 3, 2, 2, 2, 11, 2274, 3, 2, 2,
+	2, 13, 2278, 3, 2, 2, 2, 15, 2282, 3, 2, 2, 2, 17, 2288, 3, 2, 2, 2, 19,
+	2295, 3, 2, 2, 2, 21, 2303, 3, 2, 2, 2, 23, 2307, 3, 2, 2, 2, 25, 2310,
+	3, 2, 2, 2, 27, 2314, 3, 2, 2, 2, 29, 2321, 3, 2, 2, 2, 31, 2329, 3, 2,
+	2, 2, 33, 2334, 3, 2, 2, 2, 35, 2337, 3, 2, 2, 2, 37, 2342, 3, 2, 2, 2,
+	39, 2350, 3, 2, 2, 2, 41, 2355, 3, 2, 2, 2, 43, 2360, 3, 2, 2, 2, 45, 2367,
+	3, 2, 2, 2, 47, 2377, 3, 2, 2, 2, 49, 2383, 3, 2, 2, 2, 51, 2391, 3, 2,
+	2, 2, 53, 2398, 3, 2, 2, 2, 55, 2408, 3, 2, 2, 2, 57, 2419, 3, 2, 2, 2,
+	59, 2428, 3, 2, 2, 2, 61, 2436, 3, 2, 2, 2, 63, 2443, 3, 2, 2, 2, 65, 2449,
+	3, 2, 2, 2, 67, 2457, 3, 2, 2, 2, 69, 2470, 3, 2, 2, 2, 71, 2477, 3, 2,
+	2, 2, 73, 2486, 3, 2, 2, 2, 75, 2496, 3, 2, 2, 2, 77, 2504, 3, 2, 2, 2,
+	79, 2512, 3, 2, 2, 2, 81, 2520, 3, 2, 2, 2, 83, 2527, 3, 2, 2, 2, 85, 2532,
+	3, 2, 2, 2, 87, 2541, 3, 2, 2, 2, 89, 2555, 3, 2, 2, 2, 91, 2567, 3, 2,
+	2, 2, 93, 2576, 3, 2, 2, 2, 95, 2588, 3, 2, 2, 2, 97, 2593, 3, 2, 2, 2,
+	99, 2598, 3, 2, 2, 2, 101, 2603, 3, 2, 2, 2, 103, 2610, 3, 2, 2, 2, 105,
+	2616, 3, 2, 2, 2, 107, 2625, 3, 2, 2, 2, 109, 2633, 3, 2, 2, 2, 111, 2640,
+	3, 2, 2, 2, 113, 2645, 3, 2, 2, 2, 115, 2653, 3, 2, 2, 2, 117, 2659, 3,
+	2, 2, 2, 119, 2665, 3, 2, 2, 2, 121, 2669, 3, 2, 2, 2, 123, 2675, 3, 2,
+	2, 2, 125, 2683, 3, 2, 2, 2, 127, 2688, 3, 2, 2, 2, 129, 2697, 3, 2, 2,
+	2, 131, 2707, 3, 2, 2, 2, 133, 2711, 3, 2, 2, 2, 135, 2717, 3, 2, 2, 2,
+	137, 2723, 3, 2, 2, 2, 139, 2730, 3, 2, 2, 2, 141, 2744, 3, 2, 2, 2, 143,
+	2747, 3, 2, 2, 2, 145, 2754, 3, 2, 2, 2, 147, 2757, 3, 2, 2, 2, 149, 2763,
+	3, 2, 2, 2, 151, 2770, 3, 2, 2, 2, 153, 2776, 3, 2, 2, 2, 155, 2782, 3,
+	2, 2, 2, 157, 2789, 3, 2, 2, 2, 159, 2798, 3, 2, 2, 2, 161, 2803, 3, 2,
+	2, 2, 163, 2806, 3, 2, 2, 2, 165, 2814, 3, 2, 2, 2, 167, 2819, 3, 2, 2,
+	2, 169, 2823, 3, 2, 2, 2, 171, 2828, 3, 2, 2, 2, 173, 2833, 3, 2, 2, 2,
+	175, 2841, 3, 2, 2, 2, 177, 2847, 3, 2, 2, 2, 179, 2852, 3, 2, 2, 2, 181,
+	2857, 3, 2, 2, 2, 183, 2863, 3, 2, 2, 2, 185, 2870, 3, 2, 2, 2, 187, 2876,
+	3, 2, 2, 2, 189, 2881, 3, 2, 2, 2, 191, 2886, 3, 2, 2, 2, 193, 2891, 3,
+	2, 2, 2, 195, 2904, 3, 2, 2, 2, 197, 2916, 3, 2, 2, 2, 199, 2946, 3, 2,
+	2, 2, 201, 2952, 3, 2, 2, 2, 203, 2961, 3, 2, 2, 2, 205, 2970, 3, 2, 2,
+	2, 207, 2978, 3, 2, 2, 2, 209, 2982, 3, 2, 2, 2, 211, 3001, 3, 2, 2, 2,
+	213, 3003, 3, 2, 2, 2, 215, 3008, 3, 2, 2, 2, 217, 3015, 3, 2, 2, 2, 219,
+	3018, 3, 2, 2, 2, 221, 3027, 3, 2, 2, 2, 223, 3034, 3, 2, 2, 2, 225, 3045,
+	3, 2, 2, 2, 227, 3048, 3, 2, 2, 2, 229, 3054, 3, 2, 2, 2, 231, 3058, 3,
+	2, 2, 2, 233, 3064, 3, 2, 2, 2, 235, 3072, 3, 2, 2, 2, 237, 3082, 3, 2,
+	2, 2, 239, 3090, 3, 2, 2, 2, 241, 3100, 3, 2, 2, 2, 243, 3106, 3, 2, 2,
+	2, 245, 3112, 3, 2, 2, 2, 247, 3117, 3, 2, 2, 2, 249, 3123, 3, 2, 2, 2,
+	251, 3134, 3, 2, 2, 2, 253, 3141, 3, 2, 2, 2, 255, 3149, 3, 2, 2, 2, 257,
+	3156, 3, 2, 2, 2, 259, 3163, 3, 2, 2, 2, 261, 3171, 3, 2, 2, 2, 263, 3179,
+	3, 2, 2, 2, 265, 3188, 3, 2, 2, 2, 267, 3197, 3, 2, 2, 2, 269, 3204, 3,
+	2, 2, 2, 271, 3211, 3, 2, 2, 2, 273, 3217, 3, 2, 2, 2, 275, 3223, 3, 2,
+	2, 2, 277, 3230, 3, 2, 2, 2, 279, 3238, 3, 2, 2, 2, 281, 3245, 3, 2, 2,
+	2, 283, 3249, 3, 2, 2, 2, 285, 3259, 3, 2, 2, 2, 287, 3264, 3, 2, 2, 2,
+	289, 3271, 3, 2, 2, 2, 291, 3279, 3, 2, 2, 2, 293, 3283, 3, 2, 2, 2, 295,
+	3296, 3, 2, 2, 2, 297, 3305, 3, 2, 2, 2, 299, 3316, 3, 2, 2, 2, 301, 3331,
+	3, 2, 2, 2, 303, 3351, 3, 2, 2, 2, 305, 3368, 3, 2, 2, 2, 307, 3372, 3,
+	2, 2, 2, 309, 3380, 3, 2, 2, 2, 311, 3389, 3, 2, 2, 2, 313, 3403, 3, 2,
+	2, 2, 315, 3409, 3, 2, 2, 2, 317, 3420, 3, 2, 2, 2, 319, 3425, 3, 2, 2,
+	2, 321, 3428, 3, 2, 2, 2, 323, 3437, 3, 2, 2, 2, 325, 3445, 3, 2, 2, 2,
+	327, 3450, 3, 2, 2, 2, 329, 3455, 3, 2, 2, 2, 331, 3461, 3, 2, 2, 2, 333,
+	3468, 3, 2, 2, 2, 335, 3475, 3, 2, 2, 2, 337, 3484, 3, 2, 2, 2, 339, 3491,
+	3, 2, 2, 2, 341, 3497, 3, 2, 2, 2, 343, 3501, 3, 2, 2, 2, 345, 3507, 3,
+	2, 2, 2, 347, 3514, 3, 2, 2, 2, 349, 3519, 3, 2, 2, 2, 351, 3525, 3, 2,
+	2, 2, 353, 3531, 3, 2, 2, 2, 355, 3536, 3, 2, 2, 2, 357, 3542, 3, 2, 2,
+	2, 359, 3546, 3, 2, 2, 2, 361, 3555, 3, 2, 2, 2, 363, 3563, 3, 2, 2, 2,
+	365, 3572, 3, 2, 2, 2, 367, 3582, 3, 2, 2, 2, 369, 3592, 3, 2, 2, 2, 371,
+	3596, 3, 2, 2, 2, 373, 3601, 3, 2, 2, 2, 375, 3606, 3, 2, 2, 2, 377, 3611,
+	3, 2, 2, 2, 379, 3616, 3, 2, 2, 2, 381, 3621, 3, 2, 2, 2, 383, 3629, 3,
+	2, 2, 2, 385, 3636, 3, 2, 2, 2, 387, 3641, 3, 2, 2, 2, 389, 3648, 3, 2,
+	2, 2, 391, 3658, 3, 2, 2, 2, 393, 3664, 3, 2, 2, 2, 395, 3671, 3, 2, 2,
+	2, 397, 3678, 3, 2, 2, 2, 399, 3686, 3, 2, 2, 2, 401, 3690, 3, 2, 2, 2,
+	403, 3698, 3, 2, 2, 2, 405, 3703, 3, 2, 2, 2, 407, 3708, 3, 2, 2, 2, 409,
+	3718, 3, 2, 2, 2, 411, 3727, 3, 2, 2, 2, 413, 3732, 3, 2, 2, 2, 415, 3737,
+	3, 2, 2, 2, 417, 3745, 3, 2, 2, 2, 419, 3754, 3, 2, 2, 2, 421, 3763, 3,
+	2, 2, 2, 423, 3770, 3, 2, 2, 2, 425, 3780, 3, 2, 2, 2, 427, 3789, 3, 2,
+	2, 2, 429, 3794, 3, 2, 2, 2, 431, 3805, 3, 2, 2, 2, 433, 3810, 3, 2, 2,
+	2, 435, 3819, 3, 2, 2, 2, 437, 3828, 3, 2, 2, 2, 439, 3833, 3, 2, 2, 2,
+	441, 3844, 3, 2, 2, 2, 443, 3853, 3, 2, 2, 2, 445, 3858, 3, 2, 2, 2, 447,
+	3866, 3, 2, 2, 2, 449, 3873, 3, 2, 2, 2, 451, 3884, 3, 2, 2, 2, 453, 3893,
+	3, 2, 2, 2, 455, 3904, 3, 2, 2, 2, 457, 3915, 3, 2, 2, 2, 459, 3927, 3,
+	2, 2, 2, 461, 3939, 3, 2, 2, 2, 463, 3953, 3, 2, 2, 2, 465, 3972, 3, 2,
+	2, 2, 467, 3991, 3, 2, 2, 2, 469, 4008, 3, 2, 2, 2, 471, 4024, 3, 2, 2,
+	2, 473, 4035, 3, 2, 2, 2, 475, 4047, 3, 2, 2, 2, 477, 4058, 3, 2, 2, 2,
+	479, 4072, 3, 2, 2, 2, 481, 4091, 3, 2, 2, 2, 483, 4104, 3, 2, 2, 2, 485,
+	4114, 3, 2, 2, 2, 487, 4128, 3, 2, 2, 2, 489, 4140, 3, 2, 2, 2, 491, 4151,
+	3, 2, 2, 2, 493, 4169, 3, 2, 2, 2, 495, 4187, 3, 2, 2, 2, 497, 4199, 3,
+	2, 2, 2, 499, 4210, 3, 2, 2, 2, 501, 4227, 3, 2, 2, 2, 503, 4247, 3, 2,
+	2, 2, 505, 4259, 3, 2, 2, 2, 507, 4272, 3, 2, 2, 2, 509, 4281, 3, 2, 2,
+	2, 511, 4294, 3, 2, 2, 2, 513, 4305, 3, 2, 2, 2, 515, 4317, 3, 2, 2, 2,
+	517, 4327, 3, 2, 2, 2, 519, 4338, 3, 2, 2, 2, 521, 4349, 3, 2, 2, 2, 523,
+	4367, 3, 2, 2, 2, 525, 4397, 3, 2, 2, 2, 527, 4409, 3, 2, 2, 2, 529, 4427,
+	3, 2, 2, 2, 531, 4445, 3, 2, 2, 2, 533, 4459, 3, 2, 2, 2, 535, 4474, 3,
+	2, 2, 2, 537, 4478, 3, 2, 2, 2, 539, 4486, 3, 2, 2, 2, 541, 4493, 3, 2,
+	2, 2, 543, 4501, 3, 2, 2, 2, 545, 4507, 3, 2, 2, 2, 547, 4520, 3, 2, 2,
+	2, 549, 4524, 3, 2, 2, 2, 551, 4528, 3, 2, 2, 2, 553, 4532, 3, 2, 2, 2,
+	555, 4539, 3, 2, 2, 2, 557, 4550, 3, 2, 2, 2, 559, 4562, 3, 2, 2, 2, 561,
+	4566, 3, 2, 2, 2, 563, 4574, 3, 2, 2, 2, 565, 4583, 3, 2, 2, 2, 567, 4592,
+	3, 2, 2, 2, 569, 4605, 3, 2, 2, 2, 571, 4618, 3, 2, 2, 2, 573, 4636, 3,
+	2, 2, 2, 575, 4646, 3, 2, 2, 2, 577, 4654, 3, 2, 2, 2, 579, 4662, 3, 2,
+	2, 2, 581, 4671, 3, 2, 2, 2, 583, 4680, 3, 2, 2, 2, 585, 4688, 3, 2, 2,
+	2, 587, 4703, 3, 2, 2, 2, 589, 4707, 3, 2, 2, 2, 591, 4716, 3, 2, 2, 2,
+	593, 4723, 3, 2, 2, 2, 595, 4733, 3, 2, 2, 2, 597, 4741, 3, 2, 2, 2, 599,
+	4746, 3, 2, 2, 2, 601, 4755, 3, 2, 2, 2, 603, 4764, 3, 2, 2, 2, 605, 4778,
+	3, 2, 2, 2, 607, 4786, 3, 2, 2, 2, 609, 4793, 3, 2, 2, 2, 611, 4799, 3,
+	2, 2, 2, 613, 4809, 3, 2, 2, 2, 615, 4819, 3, 2, 2, 2, 617, 4823, 3, 2,
+	2, 2, 619, 4826, 3, 2, 2, 2, 621, 4834, 3, 2, 2, 2, 623, 4845, 3, 2, 2,
+	2, 625, 4861, 3, 2, 2, 2, 627, 4876, 3, 2, 2, 2, 629, 4891, 3, 2, 2, 2,
+	631, 4897, 3, 2, 2, 2, 633, 4904, 3, 2, 2, 2, 635, 4908, 3, 2, 2, 2, 637,
+	4914, 3, 2, 2, 2, 639, 4919, 3, 2, 2, 2, 641, 4927, 3, 2, 2, 2, 643, 4933,
+	3, 2, 2, 2, 645, 4939, 3, 2, 2, 2, 647, 4948, 3, 2, 2, 2, 649, 4954, 3,
+	2, 2, 2, 651, 4962, 3, 2, 2, 2, 653, 4970, 3, 2, 2, 2, 655, 4979, 3, 2,
+	2, 2, 657, 4993, 3, 2, 2, 2, 659, 5000, 3, 2, 2, 2, 661, 5013, 3, 2, 2,
+	2, 663, 5020, 3, 2, 2, 2, 665, 5026, 3, 2, 2, 2, 667, 5035, 3, 2, 2, 2,
+	669, 5040, 3, 2, 2, 2, 671, 5048, 3, 2, 2, 2, 673, 5062, 3, 2, 2, 2, 675,
+	5074, 3, 2, 2, 2, 677, 5082, 3, 2, 2, 2, 679, 5089, 3, 2, 2, 2, 681, 5097,
+	3, 2, 2, 2, 683, 5108, 3, 2, 2, 2, 685, 5119, 3, 2, 2, 2, 687, 5131, 3,
+	2, 2, 2, 689, 5142, 3, 2, 2, 2, 691, 5153, 3, 2, 2, 2, 693, 5164, 3, 2,
+	2, 2, 695, 5183, 3, 2, 2, 2, 697, 5201, 3, 2, 2, 2, 699, 5217, 3, 2, 2,
+	2, 701, 5226, 3, 2, 2, 2, 703, 5234, 3, 2, 2, 2, 705, 5247, 3, 2, 2, 2,
+	707, 5252, 3, 2, 2, 2, 709, 5256, 3, 2, 2, 2, 711, 5268, 3, 2, 2, 2, 713,
+	5273, 3, 2, 2, 2, 715, 5282, 3, 2, 2, 2, 717, 5293, 3, 2, 2, 2, 719, 5306,
+	3, 2, 2, 2, 721, 5314, 3, 2, 2, 2, 723, 5330, 3, 2, 2, 2, 725, 5343, 3,
+	2, 2, 2, 727, 5353, 3, 2, 2, 2, 729, 5361, 3, 2, 2, 2, 731, 5369, 3, 2,
+	2, 2, 733, 5374, 3, 2, 2, 2, 735, 5377, 3, 2, 2, 2, 737, 5386, 3, 2, 2,
+	2, 739, 5396, 3, 2, 2, 2, 741, 5404, 3, 2, 2, 2, 743, 5411, 3, 2, 2, 2,
+	745, 5422, 3, 2, 2, 2, 747, 5426, 3, 2, 2, 2, 749, 5431, 3, 2, 2, 2, 751,
+	5438, 3, 2, 2, 2, 753, 5446, 3, 2, 2, 2, 755, 5452, 3, 2, 2, 2, 757, 5459,
+	3, 2, 2, 2, 759, 5466, 3, 2, 2, 2, 761, 5471, 3, 2, 2, 2, 763, 5477, 3,
+	2, 2, 2, 765, 5484, 3, 2, 2, 2, 767, 5490, 3, 2, 2, 2, 769, 5499, 3, 2,
+	2, 2, 771, 5509, 3, 2, 2, 2, 773, 5516, 3, 2, 2, 2, 775, 5523, 3, 2, 2,
+	2, 777, 5532, 3, 2, 2, 2, 779, 5544, 3, 2, 2, 2, 781, 5549, 3, 2, 2, 2,
+	783, 5556, 3, 2, 2, 2, 785, 5563, 3, 2, 2, 2, 787, 5579, 3, 2, 2, 2, 789,
+	5586, 3, 2, 2, 2, 791, 5592, 3, 2, 2, 2, 793, 5598, 3, 2, 2, 2, 795, 5604,
+	3, 2, 2, 2, 797, 5612, 3, 2, 2, 2, 799, 5618, 3, 2, 2, 2, 801, 5623, 3,
+	2, 2, 2, 803, 5632, 3, 2, 2, 2, 805, 5640, 3, 2, 2, 2, 807, 5647, 3, 2,
+	2, 2, 809, 5654, 3, 2, 2, 2, 811, 5672, 3, 2, 2, 2, 813, 5680, 3, 2, 2,
+	2, 815, 5685, 3, 2, 2, 2, 817, 5690, 3, 2, 2, 2, 819, 5695, 3, 2, 2, 2,
+	821, 5701, 3, 2, 2, 2, 823, 5712, 3, 2, 2, 2, 825, 5730, 3, 2, 2, 2, 827,
+	5737, 3, 2, 2, 2, 829, 5745, 3, 2, 2, 2, 831, 5758, 3, 2, 2, 2, 833, 5766,
+	3, 2, 2, 2, 835, 5780, 3, 2, 2, 2, 837, 5788, 3, 2, 2, 2, 839, 5797, 3,
+	2, 2, 2, 841, 5805, 3, 2, 2, 2, 843, 5815, 3, 2, 2, 2, 845, 5823, 3, 2,
+	2, 2, 847, 5826, 3, 2, 2, 2, 849, 5836, 3, 2, 2, 2, 851, 5840, 3, 2, 2,
+	2, 853, 5850, 3, 2, 2, 2, 855, 5857, 3, 2, 2, 2, 857, 5862, 3, 2, 2, 2,
+	859, 5877, 3, 2, 2, 2, 861, 5886, 3, 2, 2, 2, 863, 5891, 3, 2, 2, 2, 865,
+	5898, 3, 2, 2, 2, 867, 5903, 3, 2, 2, 2, 869, 5909, 3, 2, 2, 2, 871, 5914,
+	3, 2, 2, 2, 873, 5920, 3, 2, 2, 2, 875, 5928, 3, 2, 2, 2, 877, 5933, 3,
+	2, 2, 2, 879, 5940, 3, 2, 2, 2, 881, 5961, 3, 2, 2, 2, 883, 5982, 3, 2,
+	2, 2, 885, 5995, 3, 2, 2, 2, 887, 6019, 3, 2, 2, 2, 889, 6031, 3, 2, 2,
+	2, 891, 6047, 3, 2, 2, 2, 893, 6062, 3, 2, 2, 2, 895, 6078, 3, 2, 2, 2,
+	897, 6090, 3, 2, 2, 2, 899, 6109, 3, 2, 2, 2, 901, 6120, 3, 2, 2, 2, 903,
+	6134, 3, 2, 2, 2, 905, 6152, 3, 2, 2, 2, 907, 6168, 3, 2, 2, 2, 909, 6186,
+	3, 2, 2, 2, 911, 6201, 3, 2, 2, 2, 913, 6220, 3, 2, 2, 2, 915, 6235, 3,
+	2, 2, 2, 917, 6254, 3, 2, 2, 2, 919, 6266, 3, 2, 2, 2, 921, 6291, 3, 2,
+	2, 2, 923, 6312, 3, 2, 2, 2, 925, 6321, 3, 2, 2, 2, 927, 6330, 3, 2, 2,
+	2, 929, 6351, 3, 2, 2, 2, 931, 6372, 3, 2, 2, 2, 933, 6379, 3, 2, 2, 2,
+	935, 6386, 3, 2, 2, 2, 937, 6392, 3, 2, 2, 2, 939, 6405, 3, 2, 2, 2, 941,
+	6409, 3, 2, 2, 2, 943, 6417, 3, 2, 2, 2, 945, 6426, 3, 2, 2, 2, 947, 6431,
+	3, 2, 2, 2, 949, 6438, 3, 2, 2, 2, 951, 6444, 3, 2, 2, 2, 953, 6450, 3,
+	2, 2, 2, 955, 6462, 3, 2, 2, 2, 957, 6467, 3, 2, 2, 2, 959, 6473, 3, 2,
+	2, 2, 961, 6479, 3, 2, 2, 2, 963, 6485, 3, 2, 2, 2, 965, 6490, 3, 2, 2,
+	2, 967, 6493, 3, 2, 2, 2, 969, 6503, 3, 2, 2, 2, 971, 6508, 3, 2, 2, 2,
+	973, 6516, 3, 2, 2, 2, 975, 6523, 3, 2, 2, 2, 977, 6526, 3, 2, 2, 2, 979,
+	6529, 3, 2, 2, 2, 981, 6542, 3, 2, 2, 2, 983, 6546, 3, 2, 2, 2, 985, 6553,
+	3, 2, 2, 2, 987, 6558, 3, 2, 2, 2, 989, 6563, 3, 2, 2, 2, 991, 6579, 3,
+	2, 2, 2, 993, 6587, 3, 2, 2, 2, 995, 6593, 3, 2, 2, 2, 997, 6603, 3, 2,
+	2, 2, 999, 6608, 3, 2, 2, 2, 1001, 6615, 3, 2, 2, 2, 1003, 6623, 3, 2,
+	2, 2, 1005, 6636, 3, 2, 2, 2, 1007, 6647, 3, 2, 2, 2, 1009, 6656, 3, 2,
+	2, 2, 1011, 6662, 3, 2, 2, 2, 1013, 6669, 3, 2, 2, 2, 1015, 6680, 3, 2,
+	2, 2, 1017, 6688, 3, 2, 2, 2, 1019, 6693, 3, 2, 2, 2, 1021, 6702, 3, 2,
+	2, 2, 1023, 6710, 3, 2, 2, 2, 1025, 6719, 3, 2, 2, 2, 1027, 6724, 3, 2,
+	2, 2, 1029, 6736, 3, 2, 2, 2, 1031, 6744, 3, 2, 2, 2, 1033, 6753, 3, 2,
+	2, 2, 1035, 6759, 3, 2, 2, 2, 1037, 6765, 3, 2, 2, 2, 1039, 6771, 3, 2,
+	2, 2, 1041, 6779, 3, 2, 2, 2, 1043, 6787, 3, 2, 2, 2, 1045, 6804, 3, 2,
+	2, 2, 1047, 6814, 3, 2, 2, 2, 1049, 6820, 3, 2, 2, 2, 1051, 6835, 3, 2,
+	2, 2, 1053, 6849, 3, 2, 2, 2, 1055, 6858, 3, 2, 2, 2, 1057, 6865, 3, 2,
+	2, 2, 1059, 6876, 3, 2, 2, 2, 1061, 6883, 3, 2, 2, 2, 1063, 6899, 3, 2,
+	2, 2, 1065, 6918, 3, 2, 2, 2, 1067, 6938, 3, 2, 2, 2, 1069, 6961, 3, 2,
+	2, 2, 1071, 6982, 3, 2, 2, 2, 1073, 7006, 3, 2, 2, 2, 1075, 7034, 3, 2,
+	2, 2, 1077, 7046, 3, 2, 2, 2, 1079, 7052, 3, 2, 2, 2, 1081, 7059, 3, 2,
+	2, 2, 1083, 7077, 3, 2, 2, 2, 1085, 7087, 3, 2, 2, 2, 1087, 7095, 3, 2,
+	2, 2, 1089, 7100, 3, 2, 2, 2, 1091, 7109, 3, 2, 2, 2, 1093, 7116, 3, 2,
+	2, 2, 1095, 7123, 3, 2, 2, 2, 1097, 7127, 3, 2, 2, 2, 1099, 7132, 3, 2,
+	2, 2, 1101, 7143, 3, 2, 2, 2, 1103, 7153, 3, 2, 2, 2, 1105, 7162, 3, 2,
+	2, 2, 1107, 7171, 3, 2, 2, 2, 1109, 7178, 3, 2, 2, 2, 1111, 7186, 3, 2,
+	2, 2, 1113, 7192, 3, 2, 2, 2, 1115, 7199, 3, 2, 2, 2, 1117, 7206, 3, 2,
+	2, 2, 1119, 7213, 3, 2, 2, 2, 1121, 7219, 3, 2, 2, 2, 1123, 7224, 3, 2,
+	2, 2, 1125, 7233, 3, 2, 2, 2, 1127, 7240, 3, 2, 2, 2, 1129, 7245, 3, 2,
+	2, 2, 1131, 7252, 3, 2, 2, 2, 1133, 7259, 3, 2, 2, 2, 1135, 7266, 3, 2,
+	2, 2, 1137, 7282, 3, 2, 2, 2, 1139, 7301, 3, 2, 2, 2, 1141, 7318, 3, 2,
+	2, 2, 1143, 7336, 3, 2, 2, 2, 1145, 7346, 3, 2, 2, 2, 1147, 7359, 3, 2,
+	2, 2, 1149, 7370, 3, 2, 2, 2, 1151, 7376, 3, 2, 2, 2, 1153, 7383, 3, 2,
+	2, 2, 1155, 7401, 3, 2, 2, 2, 1157, 7418, 3, 2, 2, 2, 1159, 7437, 3, 2,
+	2, 2, 1161, 7444, 3, 2, 2, 2, 1163, 7449, 3, 2, 2, 2, 1165, 7457, 3, 2,
+	2, 2, 1167, 7464, 3, 2, 2, 2, 1169, 7471, 3, 2, 2, 2, 1171, 7487, 3, 2,
+	2, 2, 1173, 7495, 3, 2, 2, 2, 1175, 7508, 3, 2, 2, 2, 1177, 7522, 3, 2,
+	2, 2, 1179, 7530, 3, 2, 2, 2, 1181, 7536, 3, 2, 2, 2, 1183, 7545, 3, 2,
+	2, 2, 1185, 7556, 3, 2, 2, 2, 1187, 7567, 3, 2, 2, 2, 1189, 7577, 3, 2,
+	2, 2, 1191, 7587, 3, 2, 2, 2, 1193, 7592, 3, 2, 2, 2, 1195, 7604, 3, 2,
+	2, 2, 1197, 7616, 3, 2, 2, 2, 1199, 7630, 3, 2, 2, 2, 1201, 7639, 3, 2,
+	2, 2, 1203, 7648, 3, 2, 2, 2, 1205, 7658, 3, 2, 2, 2, 1207, 7667, 3, 2,
+	2, 2, 1209, 7684, 3, 2, 2, 2, 1211, 7694, 3, 2, 2, 2, 1213, 7702, 3, 2,
+	2, 2, 1215, 7708, 3, 2, 2, 2, 1217, 7716, 3, 2, 2, 2, 1219, 7721, 3, 2,
+	2, 2, 1221, 7729, 3, 2, 2, 2, 1223, 7744, 3, 2, 2, 2, 1225, 7755, 3, 2,
+	2, 2, 1227, 7761, 3, 2, 2, 2, 1229, 7771, 3, 2, 2, 2, 1231, 7776, 3, 2,
+	2, 2, 1233, 7784, 3, 2, 2, 2, 1235, 7792, 3, 2, 2, 2, 1237, 7797, 3, 2,
+	2, 2, 1239, 7806, 3, 2, 2, 2, 1241, 7814, 3, 2, 2, 2, 1243, 7819, 3, 2,
+	2, 2, 1245, 7827, 3, 2, 2, 2, 1247, 7832, 3, 2, 2, 2, 1249, 7835, 3, 2,
+	2, 2, 1251, 7839, 3, 2, 2, 2, 1253, 7843, 3, 2, 2, 2, 1255, 7847, 3, 2,
+	2, 2, 1257, 7851, 3, 2, 2, 2, 1259, 7855, 3, 2, 2, 2, 1261, 7864, 3, 2,
+	2, 2, 1263, 7872, 3, 2, 2, 2, 1265, 7878, 3, 2, 2, 2, 1267, 7882, 3, 2,
+	2, 2, 1269, 7887, 3, 2, 2, 2, 1271, 7894, 3, 2, 2, 2, 1273, 7899, 3, 2,
+	2, 2, 1275, 7906, 3, 2, 2, 2, 1277, 7918, 3, 2, 2, 2, 1279, 7925, 3, 2,
+	2, 2, 1281, 7933, 3, 2, 2, 2, 1283, 7941, 3, 2, 2, 2, 1285, 7946, 3, 2,
+	2, 2, 1287, 7954, 3, 2, 2, 2, 1289, 7961, 3, 2, 2, 2, 1291, 7970, 3, 2,
+	2, 2, 1293, 7976, 3, 2, 2, 2, 1295, 7987, 3, 2, 2, 2, 1297, 8014, 3, 2,
+	2, 2, 1299, 8026, 3, 2, 2, 2, 1301, 8039, 3, 2, 2, 2, 1303, 8052, 3, 2,
+	2, 2, 1305, 8076, 3, 2, 2, 2, 1307, 8088, 3, 2, 2, 2, 1309, 8105, 3, 2,
+	2, 2, 1311, 8126, 3, 2, 2, 2, 1313, 8141, 3, 2, 2, 2, 1315, 8155, 3, 2,
+	2, 2, 1317, 8177, 3, 2, 2, 2, 1319, 8190, 3, 2, 2, 2, 1321, 8203, 3, 2,
+	2, 2, 1323, 8224, 3, 2, 2, 2, 1325, 8248, 3, 2, 2, 2, 1327, 8272, 3, 2,
+	2, 2, 1329, 8295, 3, 2, 2, 2, 1331, 8311, 3, 2, 2, 2, 1333, 8338, 3, 2,
+	2, 2, 1335, 8358, 3, 2, 2, 2, 1337, 8382, 3, 2, 2, 2, 1339, 8403, 3, 2,
+	2, 2, 1341, 8423, 3, 2, 2, 2, 1343, 8434, 3, 2, 2, 2, 1345, 8460, 3, 2,
+	2, 2, 1347, 8489, 3, 2, 2, 2, 1349, 8501, 3, 2, 2, 2, 1351, 8514, 3, 2,
+	2, 2, 1353, 8537, 3, 2, 2, 2, 1355, 8560, 3, 2, 2, 2, 1357, 8580, 3, 2,
+	2, 2, 1359, 8597, 3, 2, 2, 2, 1361, 8606, 3, 2, 2, 2, 1363, 8612, 3, 2,
+	2, 2, 1365, 8617, 3, 2, 2, 2, 1367, 8624, 3, 2, 2, 2, 1369, 8631, 3, 2,
+	2, 2, 1371, 8638, 3, 2, 2, 2, 1373, 8645, 3, 2, 2, 2, 1375, 8651, 3, 2,
+	2, 2, 1377, 8657, 3, 2, 2, 2, 1379, 8663, 3, 2, 2, 2, 1381, 8669, 3, 2,
+	2, 2, 1383, 8674, 3, 2, 2, 2, 1385, 8682, 3, 2, 2, 2, 1387, 8688, 3, 2,
+	2, 2, 1389, 8695, 3, 2, 2, 2, 1391, 8699, 3, 2, 2, 2, 1393, 8707, 3, 2,
+	2, 2, 1395, 8713, 3, 2, 2, 2, 1397, 8720, 3, 2, 2, 2, 1399, 8724, 3, 2,
+	2, 2, 1401, 8732, 3, 2, 2, 2, 1403, 8738, 3, 2, 2, 2, 1405, 8744, 3, 2,
+	2, 2, 1407, 8751, 3, 2, 2, 2, 1409, 8758, 3, 2, 2, 2, 1411, 8765, 3, 2,
+	2, 2, 1413, 8772, 3, 2, 2, 2, 1415, 8778, 3, 2, 2, 2, 1417, 8787, 3, 2,
+	2, 2, 1419, 8792, 3, 2, 2, 2, 1421, 8797, 3, 2, 2, 2, 1423, 8804, 3, 2,
+	2, 2, 1425, 8809, 3, 2, 2, 2, 1427, 8814, 3, 2, 2, 2, 1429, 8820, 3, 2,
+	2, 2, 1431, 8828, 3, 2, 2, 2, 1433, 8834, 3, 2, 2, 2, 1435, 8839, 3, 2,
+	2, 2, 1437, 8847, 3, 2, 2, 2, 1439, 8855, 3, 2, 2, 2, 1441, 8863, 3, 2,
+	2, 2, 1443, 8873, 3, 2, 2, 2, 1445, 8877, 3, 2, 2, 2, 1447, 8887, 3, 2,
+	2, 2, 1449, 8894, 3, 2, 2, 2, 1451, 8901, 3, 2, 2, 2, 1453, 8912, 3, 2,
+	2, 2, 1455, 8919, 3, 2, 2, 2, 1457, 8923, 3, 2, 2, 2, 1459, 8934, 3, 2,
+	2, 2, 1461, 8953, 3, 2, 2, 2, 1463, 8960, 3, 2, 2, 2, 1465, 8971, 3, 2,
+	2, 2, 1467, 8981, 3, 2, 2, 2, 1469, 8993, 3, 2, 2, 2, 1471, 9006, 3, 2,
+	2, 2, 1473, 9025, 3, 2, 2, 2, 1475, 9040, 3, 2, 2, 2, 1477, 9049, 3, 2,
+	2, 2, 1479, 9060, 3, 2, 2, 2, 1481, 9076, 3, 2, 2, 2, 1483, 9087, 3, 2,
+	2, 2, 1485, 9100, 3, 2, 2, 2, 1487, 9106, 3, 2, 2, 2, 1489, 9114, 3, 2,
+	2, 2, 1491, 9118, 3, 2, 2, 2, 1493, 9123, 3, 2, 2, 2, 1495, 9131, 3, 2,
+	2, 2, 1497, 9139, 3, 2, 2, 2, 1499, 9151, 3, 2, 2, 2, 1501, 9163, 3, 2,
+	2, 2, 1503, 9168, 3, 2, 2, 2, 1505, 9177, 3, 2, 2, 2, 1507, 9182, 3, 2,
+	2, 2, 1509, 9189, 3, 2, 2, 2, 1511, 9195, 3, 2, 2, 2, 1513, 9201, 3, 2,
+	2, 2, 1515, 9220, 3, 2, 2, 2, 1517, 9238, 3, 2, 2, 2, 1519, 9257, 3, 2,
+	2, 2, 1521, 9273, 3, 2, 2, 2, 1523, 9291, 3, 2, 2, 2, 1525, 9296, 3, 2,
+	2, 2, 1527, 9302, 3, 2, 2, 2, 1529, 9312, 3, 2, 2, 2, 1531, 9316, 3, 2,
+	2, 2, 1533, 9326, 3, 2, 2, 2, 1535, 9337, 3, 2, 2, 2, 1537, 9344, 3, 2,
+	2, 2, 1539, 9357, 3, 2, 2, 2, 1541, 9362, 3, 2, 2, 2, 1543, 9370, 3, 2,
+	2, 2, 1545, 9379, 3, 2, 2, 2, 1547, 9396, 3, 2, 2, 2, 1549, 9404, 3, 2,
+	2, 2, 1551, 9416, 3, 2, 2, 2, 1553, 9429, 3, 2, 2, 2, 1555, 9439, 3, 2,
+	2, 2, 1557, 9448, 3, 2, 2, 2, 1559, 9455, 3, 2, 2, 2, 1561, 9465, 3, 2,
+	2, 2, 1563, 9479, 3, 2, 2, 2, 1565, 9484, 3, 2, 2, 2, 1567, 9495, 3, 2,
+	2, 2, 1569, 9499, 3, 2, 2, 2, 1571, 9503, 3, 2, 2, 2, 1573, 9509, 3, 2,
+	2, 2, 1575, 9536, 3, 2, 2, 2, 1577, 9562, 3, 2, 2, 2, 1579, 9583, 3, 2,
+	2, 2, 1581, 9597, 3, 2, 2, 2, 1583, 9605, 3, 2, 2, 2, 1585, 9614, 3, 2,
+	2, 2, 1587, 9626, 3, 2, 2, 2, 1589, 9634, 3, 2, 2, 2, 1591, 9645, 3, 2,
+	2, 2, 1593, 9655, 3, 2, 2, 2, 1595, 9665, 3, 2, 2, 2, 1597, 9672, 3, 2,
+	2, 2, 1599, 9680, 3, 2, 2, 2, 1601, 9692, 3, 2, 2, 2, 1603, 9704, 3, 2,
+	2, 2, 1605, 9714, 3, 2, 2, 2, 1607, 9723, 3, 2, 2, 2, 1609, 9727, 3, 2,
+	2, 2, 1611, 9734, 3, 2, 2, 2, 1613, 9742, 3, 2, 2, 2, 1615, 9751, 3, 2,
+	2, 2, 1617, 9760, 3, 2, 2, 2, 1619, 9767, 3, 2, 2, 2, 1621, 9771, 3, 2,
+	2, 2, 1623, 9782, 3, 2, 2, 2, 1625, 9795, 3, 2, 2, 2, 1627, 9808, 3, 2,
+	2, 2, 1629, 9814, 3, 2, 2, 2, 1631, 9826, 3, 2, 2, 2, 1633, 9832, 3, 2,
+	2, 2, 1635, 9839, 3, 2, 2, 2, 1637, 9850, 3, 2, 2, 2, 1639, 9862, 3, 2,
+	2, 2, 1641, 9872, 3, 2, 2, 2, 1643, 9886, 3, 2, 2, 2, 1645, 9903, 3, 2,
+	2, 2, 1647, 9919, 3, 2, 2, 2, 1649, 9946, 3, 2, 2, 2, 1651, 9972, 3, 2,
+	2, 2, 1653, 9989, 3, 2, 2, 2, 1655, 10005, 3, 2, 2, 2, 1657, 10015, 3,
+	2, 2, 2, 1659, 10028, 3, 2, 2, 2, 1661, 10041, 3, 2, 2, 2, 1663, 10053,
+	3, 2, 2, 2, 1665, 10064, 3, 2, 2, 2, 1667, 10073, 3, 2, 2, 2, 1669, 10081,
+	3, 2, 2, 2, 1671, 10090, 3, 2, 2, 2, 1673, 10102, 3, 2, 2, 2, 1675, 10116,
+	3, 2, 2, 2, 1677, 10120, 3, 2, 2, 2, 1679, 10127, 3, 2, 2, 2, 1681, 10138,
+	3, 2, 2, 2, 1683, 10149, 3, 2, 2, 2, 1685, 10159, 3, 2, 2, 2, 1687, 10169,
+	3, 2, 2, 2, 1689, 10175, 3, 2, 2, 2, 1691, 10189, 3, 2, 2, 2, 1693, 10200,
+	3, 2, 2, 2, 1695, 10209, 3, 2, 2, 2, 1697, 10217, 3, 2, 2, 2, 1699, 10224,
+	3, 2, 2, 2, 1701, 10233, 3, 2, 2, 2, 1703, 10246, 3, 2, 2, 2, 1705, 10254,
+	3, 2, 2, 2, 1707, 10269, 3, 2, 2, 2, 1709, 10284, 3, 2, 2, 2, 1711, 10292,
+	3, 2, 2, 2, 1713, 10305, 3, 2, 2, 2, 1715, 10320, 3, 2, 2, 2, 1717, 10326,
+	3, 2, 2, 2, 1719, 10332, 3, 2, 2, 2, 1721, 10339, 3, 2, 2, 2, 1723, 10352,
+	3, 2, 2, 2, 1725, 10364, 3, 2, 2, 2, 1727, 10383, 3, 2, 2, 2, 1729, 10401,
+	3, 2, 2, 2, 1731, 10404, 3, 2, 2, 2, 1733, 10414, 3, 2, 2, 2, 1735, 10421,
+	3, 2, 2, 2, 1737, 10425, 3, 2, 2, 2, 1739, 10431, 3, 2, 2, 2, 1741, 10436,
+	3, 2, 2, 2, 1743, 10442, 3, 2, 2, 2, 1745, 10447, 3, 2, 2, 2, 1747, 10453,
+	3, 2, 2, 2, 1749, 10462, 3, 2, 2, 2, 1751, 10471, 3, 2, 2, 2, 1753, 10480,
+	3, 2, 2, 2, 1755, 10496, 3, 2, 2, 2, 1757, 10508, 3, 2, 2, 2, 1759, 10520,
+	3, 2, 2, 2, 1761, 10529, 3, 2, 2, 2, 1763, 10543, 3, 2, 2, 2, 1765, 10555,
+	3, 2, 2, 2, 1767, 10566, 3, 2, 2, 2, 1769, 10576, 3, 2, 2, 2, 1771, 10580,
+	3, 2, 2, 2, 1773, 10594, 3, 2, 2, 2, 1775, 10607, 3, 2, 2, 2, 1777, 10617,
+	3, 2, 2, 2, 1779, 10632, 3, 2, 2, 2, 1781, 10646, 3, 2, 2, 2, 1783, 10660,
+	3, 2, 2, 2, 1785, 10673, 3, 2, 2, 2, 1787, 10697, 3, 2, 2, 2, 1789, 10720,
+	3, 2, 2, 2, 1791, 10739, 3, 2, 2, 2, 1793, 10757, 3, 2, 2, 2, 1795, 10778,
+	3, 2, 2, 2, 1797, 10798, 3, 2, 2, 2, 1799, 10809, 3, 2, 2, 2, 1801, 10816,
+	3, 2, 2, 2, 1803, 10830, 3, 2, 2, 2, 1805, 10847, 3, 2, 2, 2, 1807, 10857,
+	3, 2, 2, 2, 1809, 10861, 3, 2, 2, 2, 1811, 10874, 3, 2, 2, 2, 1813, 10878,
+	3, 2, 2, 2, 1815, 10887, 3, 2, 2, 2, 1817, 10898, 3, 2, 2, 2, 1819, 10910,
+	3, 2, 2, 2, 1821, 10913, 3, 2, 2, 2, 1823, 10927, 3, 2, 2, 2, 1825, 10940,
+	3, 2, 2, 2, 1827, 10947, 3, 2, 2, 2, 1829, 10960, 3, 2, 2, 2, 1831, 10972,
+	3, 2, 2, 2, 1833, 10988, 3, 2, 2, 2, 1835, 11003, 3, 2, 2, 2, 1837, 11007,
+	3, 2, 2, 2, 1839, 11013, 3, 2, 2, 2, 1841, 11019, 3, 2, 2, 2, 1843, 11027,
+	3, 2, 2, 2, 1845, 11032, 3, 2, 2, 2, 1847, 11045, 3, 2, 2, 2, 1849, 11058,
+	3, 2, 2, 2, 1851, 11066, 3, 2, 2, 2, 1853, 11072, 3, 2, 2, 2, 1855, 11082,
+	3, 2, 2, 2, 1857, 11087, 3, 2, 2, 2, 1859, 11093, 3, 2, 2, 2, 1861, 11105,
+	3, 2, 2, 2, 1863, 11118, 3, 2, 2, 2, 1865, 11122, 3, 2, 2, 2, 1867, 11127,
+	3, 2, 2, 2, 1869, 11132, 3, 2, 2, 2, 1871, 11144, 3, 2, 2, 2, 1873, 11149,
+	3, 2, 2, 2, 1875, 11153, 3, 2, 2, 2, 1877, 11159, 3, 2, 2, 2, 1879, 11167,
+	3, 2, 2, 2, 1881, 11195, 3, 2, 2, 2, 1883, 11200, 3, 2, 2, 2, 1885, 11205,
+	3, 2, 2, 2, 1887, 11216, 3, 2, 2, 2, 1889, 11223, 3, 2, 2, 2, 1891, 11235,
+	3, 2, 2, 2, 1893, 11243, 3, 2, 2, 2, 1895, 11255, 3, 2, 2, 2, 1897, 11265,
+	3, 2, 2, 2, 1899, 11274, 3, 2, 2, 2, 1901, 11283, 3, 2, 2, 2, 1903, 11293,
+	3, 2, 2, 2, 1905, 11305, 3, 2, 2, 2, 1907, 11317, 3, 2, 2, 2, 1909, 11328,
+	3, 2, 2, 2, 1911, 11342, 3, 2, 2, 2, 1913, 11355, 3, 2, 2, 2, 1915, 11367,
+	3, 2, 2, 2, 1917, 11379, 3, 2, 2, 2, 1919, 11391, 3, 2, 2, 2, 1921, 11403,
+	3, 2, 2, 2, 1923, 11413, 3, 2, 2, 2, 1925, 11429, 3, 2, 2, 2, 1927, 11449,
+	3, 2, 2, 2, 1929, 11468, 3, 2, 2, 2, 1931, 11487, 3, 2, 2, 2, 1933, 11517,
+	3, 2, 2, 2, 1935, 11546, 3, 2, 2, 2, 1937, 11566, 3, 2, 2, 2, 1939, 11585,
+	3, 2, 2, 2, 1941, 11598, 3, 2, 2, 2, 1943, 11614, 3, 2, 2, 2, 1945, 11630,
+	3, 2, 2, 2, 1947, 11645, 3, 2, 2, 2, 1949, 11662, 3, 2, 2, 2, 1951, 11678,
+	3, 2, 2, 2, 1953, 11692, 3, 2, 2, 2, 1955, 11704, 3, 2, 2, 2, 1957, 11715,
+	3, 2, 2, 2, 1959, 11727, 3, 2, 2, 2, 1961, 11743, 3, 2, 2, 2, 1963, 11758,
+	3, 2, 2, 2, 1965, 11780, 3, 2, 2, 2, 1967, 11801, 3, 2, 2, 2, 1969, 11818,
+	3, 2, 2, 2, 1971, 11837, 3, 2, 2, 2, 1973, 11857, 3, 2, 2, 2, 1975, 11870,
+	3, 2, 2, 2, 1977, 11882, 3, 2, 2, 2, 1979, 11899, 3, 2, 2, 2, 1981, 11915,
+	3, 2, 2, 2, 1983, 11925, 3, 2, 2, 2, 1985, 11941, 3, 2, 2, 2, 1987, 11956,
+	3, 2, 2, 2, 1989, 11975, 3, 2, 2, 2, 1991, 11993, 3, 2, 2, 2, 1993, 12001,
+	3, 2, 2, 2, 1995, 12015, 3, 2, 2, 2, 1997, 12032, 3, 2, 2, 2, 1999, 12043,
+	3, 2, 2, 2, 2001, 12052, 3, 2, 2, 2, 2003, 12062, 3, 2, 2, 2, 2005, 12067,
+	3, 2, 2, 2, 2007, 12072, 3, 2, 2, 2, 2009, 12080, 3, 2, 2, 2, 2011, 12096,
+	3, 2, 2, 2, 2013, 12104, 3, 2, 2, 2, 2015, 12116, 3, 2, 2, 2, 2017, 12120,
+	3, 2, 2, 2, 2019, 12129, 3, 2, 2, 2, 2021, 12142, 3, 2, 2, 2, 2023, 12156,
+	3, 2, 2, 2, 2025, 12168, 3, 2, 2, 2, 2027, 12180, 3, 2, 2, 2, 2029, 12188,
+	3, 2, 2, 2, 2031, 12198, 3, 2, 2, 2, 2033, 12206, 3, 2, 2, 2, 2035, 12217,
+	3, 2, 2, 2, 2037, 12223, 3, 2, 2, 2, 2039, 12234, 3, 2, 2, 2, 2041, 12254,
+	3, 2, 2, 2, 2043, 12260, 3, 2, 2, 2, 2045, 12275, 3, 2, 2, 2, 2047, 12285,
+	3, 2, 2, 2, 2049, 12291, 3, 2, 2, 2, 2051, 12296, 3, 2, 2, 2, 2053, 12307,
+	3, 2, 2, 2, 2055, 12334, 3, 2, 2, 2, 2057, 12342, 3, 2, 2, 2, 2059, 12376,
+	3, 2, 2, 2, 2061, 12384, 3, 2, 2, 2, 2063, 12395, 3, 2, 2, 2, 2065, 12409,
+	3, 2, 2, 2, 2067, 12416, 3, 2, 2, 2, 2069, 12425, 3, 2, 2, 2, 2071, 12427,
+	3, 2, 2, 2, 2073, 12429, 3, 2, 2, 2, 2075, 12432, 3, 2, 2, 2, 2077, 12435,
+	3, 2, 2, 2, 2079, 12438, 3, 2, 2, 2, 2081, 12441, 3, 2, 2, 2, 2083, 12444,
+	3, 2, 2, 2, 2085, 12447, 3, 2, 2, 2, 2087, 12450, 3, 2, 2, 2, 2089, 12453,
+	3, 2, 2, 2, 2091, 12456, 3, 2, 2, 2, 2093, 12458, 3, 2, 2, 2, 2095, 12460,
+	3, 2, 2, 2, 2097, 12462, 3, 2, 2, 2, 2099, 12464, 3, 2, 2, 2, 2101, 12467,
+	3, 2, 2, 2, 2103, 12469, 3, 2, 2, 2, 2105, 12473, 3, 2, 2, 2, 2107, 12477,
+	3, 2, 2, 2, 2109, 12479, 3, 2, 2, 2, 2111, 12481, 3, 2, 2, 2, 2113, 12483,
+	3, 2, 2, 2, 2115, 12485, 3, 2, 2, 2, 2117, 12487, 3, 2, 2, 2, 2119, 12489,
+	3, 2, 2, 2, 2121, 12491, 3, 2, 2, 2, 2123, 12493, 3, 2, 2, 2, 2125, 12495,
+	3, 2, 2, 2, 2127, 12497, 3, 2, 2, 2, 2129, 12499, 3, 2, 2, 2, 2131, 12501,
+	3, 2, 2, 2, 2133, 12503, 3, 2, 2, 2, 2135, 12505, 3, 2, 2, 2, 2137, 12507,
+	3, 2, 2, 2, 2139, 12509, 3, 2, 2, 2, 2141, 12511, 3, 2, 2, 2, 2143, 12513,
+	3, 2, 2, 2, 2145, 12515, 3, 2, 2, 2, 2147, 12517, 3, 2, 2, 2, 2149, 12522,
+	3, 2, 2, 2, 2151, 12524, 3, 2, 2, 2, 2153, 12529, 3, 2, 2, 2, 2155, 12535,
+	3, 2, 2, 2, 2157, 12541, 3, 2, 2, 2, 2159, 12544, 3, 2, 2, 2, 2161, 12567,
+	3, 2, 2, 2, 2163, 12612, 3, 2, 2, 2, 2165, 12614, 3, 2, 2, 2, 2167, 12617,
+	3, 2, 2, 2, 2169, 12619, 3, 2, 2, 2, 2171, 12622, 3, 2, 2, 2, 2173, 12625,
+	3, 2, 2, 2, 2175, 12627, 3, 2, 2, 2, 2177, 12639, 3, 2, 2, 2, 2179, 12671,
+	3, 2, 2, 2, 2181, 12673, 3, 2, 2, 2, 2183, 12684, 3, 2, 2, 2, 2185, 12735,
+	3, 2, 2, 2, 2187, 12737, 3, 2, 2, 2, 2189, 12749, 3, 2, 2, 2, 2191, 12763,
+	3, 2, 2, 2, 2193, 12776, 3, 2, 2, 2, 2195, 12789, 3, 2, 2, 2, 2197, 12802,
+	3, 2, 2, 2, 2199, 12804, 3, 2, 2, 2, 2201, 12806, 3, 2, 2, 2, 2203, 12815,
+	3, 2, 2, 2, 2205, 2207, 9, 2, 2, 2, 2206, 2205, 3, 2, 2, 2, 2207, 2208,
+	3, 2, 2, 2, 2208, 2206, 3, 2, 2, 2, 2208, 2209, 3, 2, 2, 2, 2209, 2210,
+	3, 2, 2, 2, 2210, 2211, 8, 2, 2, 2, 2211, 4, 3, 2, 2, 2, 2212, 2213, 7,
+	49, 2, 2, 2213, 2214, 7, 44, 2, 2, 2214, 2215, 7, 35, 2, 2, 2215, 2217,
+	3, 2, 2, 2, 2216, 2218, 11, 2, 2, 2, 2217, 2216, 3, 2, 2, 2, 2218, 2219,
+	3, 2, 2, 2, 2219, 2220, 3, 2, 2, 2, 2219, 2217, 3, 2, 2, 2, 2220, 2221,
+	3, 2, 2, 2, 2221, 2222, 7, 44, 2, 2, 2222, 2223, 7, 49, 2, 2, 2223, 2224,
+	3, 2, 2, 2, 2224, 2225, 8, 3, 3, 2, 2225, 6, 3, 2, 2, 2, 2226, 2227, 7,
+	49, 2, 2, 2227, 2228, 7, 44, 2, 2, 2228, 2232, 3, 2, 2, 2, 2229, 2231,
+	11, 2, 2, 2, 2230, 2229, 3, 2, 2, 2, 2231, 2234, 3, 2, 2, 2, 2232, 2233,
+	3, 2, 2, 2, 2232, 2230, 3, 2, 2, 2, 2233, 2235, 3, 2, 2, 2, 2234, 2232,
+	3, 2, 2, 2, 2235, 2236, 7, 44, 2, 2, 2236, 2237, 7, 49, 2, 2, 2237, 2238,
+	3, 2, 2, 2, 2238, 2239, 8, 4, 2, 2, 2239, 8, 3, 2, 2, 2, 2240, 2241, 7,
+	47, 2, 2, 2241, 2242, 7, 47, 2, 2, 2242, 2243, 3, 2, 2, 2, 2243, 2246,
+	9, 3, 2, 2, 2244, 2246, 7, 37, 2, 2, 2245, 2240, 3, 2, 2, 2, 2245, 2244,
+	3, 2, 2, 2, 2246, 2250, 3, 2, 2, 2, 2247, 2249, 10, 4, 2, 2, 2248, 2247,
+	3, 2, 2, 2, 2249, 2252, 3, 2, 2, 2, 2250, 2248, 3, 2, 2, 2, 2250, 2251,
+	3, 2, 2, 2, 2251, 2258, 3, 2, 2, 2, 2252, 2250, 3, 2, 2, 2, 2253, 2255,
+	7, 15, 2, 2, 2254, 2253, 3, 2, 2, 2, 2254, 2255, 3, 2, 2, 2, 2255, 2256,
+	3, 2, 2, 2, 2256, 2259, 7, 12, 2, 2, 2257, 2259, 7, 2, 2, 3, 2258, 2254,
+	3, 2, 2, 2, 2258, 2257, 3, 2, 2, 2, 2259, 2271, 3, 2, 2, 2, 2260, 2261,
+	7, 47, 2, 2, 2261, 2262, 7, 47, 2, 2, 2262, 2268, 3, 2, 2, 2, 2263, 2265,
+	7, 15, 2, 2, 2264, 2263, 3, 2, 2, 2, 2264, 2265, 3, 2, 2, 2, 2265, 2266,
+	3, 2, 2, 2, 2266, 2269, 7, 12, 2, 2, 2267, 2269, 7, 2, 2, 3, 2268, 2264,
+	3, 2, 2, 2, 2268, 2267, 3, 2, 2, 2, 2269, 2271, 3, 2, 2, 2, 2270, 2245,
+	3, 2, 2, 2, 2270, 2260, 3, 2, 2, 2, 2271, 2272, 3, 2, 2, 2, 2272, 2273,
+	8, 5, 2, 2, 2273, 10, 3, 2, 2, 2, 2274, 2275, 7, 67, 2, 2, 2275, 2276,
+	7, 70, 2, 2, 2276, 2277, 7, 70, 2, 2, 2277, 12, 3, 2, 2, 2, 2278, 2279,
+	7, 67, 2, 2, 2279, 2280, 7, 78, 2, 2, 2280, 2281, 7, 78, 2, 2, 2281, 14,
+	3, 2, 2, 2, 2282, 2283, 7, 67, 2, 2, 2283, 2284, 7, 78, 2, 2, 2284, 2285,
+	7, 86, 2, 2, 2285, 2286, 7, 71, 2, 2, 2286, 2287, 7, 84, 2, 2, 2287, 16,
+	3, 2, 2, 2, 2288, 2289, 7, 67, 2, 2, 2289, 2290, 7, 78, 2, 2, 2290, 2291,
+	7, 89, 2, 2, 2291, 2292, 7, 67, 2, 2, 2292, 2293, 7, 91, 2, 2, 2293, 2294,
+	7, 85, 2, 2, 2294, 18, 3, 2, 2, 2, 2295, 2296, 7, 67, 2, 2, 2296, 2297,
+	7, 80, 2, 2, 2297, 2298, 7, 67, 2, 2, 2298, 2299, 7, 78, 2, 2, 2299, 2300,
+	7, 91, 2, 2, 2300, 2301, 7, 92, 2, 2, 2301, 2302, 7, 71, 2, 2, 2302, 20,
+	3, 2, 2, 2, 2303, 2304, 7, 67, 2, 2, 2304, 2305, 7, 80, 2, 2, 2305, 2306,
+	7, 70, 2, 2, 2306, 22, 3, 2, 2, 2, 2307, 2308, 7, 67, 2, 2, 2308, 2309,
+	7, 85, 2, 2, 2309, 24, 3, 2, 2, 2, 2310, 2311, 7, 67, 2, 2, 2311, 2312,
+	7, 85, 2, 2, 2312, 2313, 7, 69, 2, 2, 2313, 26, 3, 2, 2, 2, 2314, 2315,
+	7, 68, 2, 2, 2315, 2316, 7, 71, 2, 2, 2316, 2317, 7, 72, 2, 2, 2317, 2318,
+	7, 81, 2, 2, 2318, 2319, 7, 84, 2, 2, 2319, 2320, 7, 71, 2, 2, 2320, 28,
+	3, 2, 2, 2, 2321, 2322, 7, 68, 2, 2, 2322, 2323, 7, 71, 2, 2, 2323, 2324,
+	7, 86, 2, 2, 2324, 2325, 7, 89, 2, 2, 2325, 2326, 7, 71, 2, 2, 2326, 2327,
+	7, 71, 2, 2, 2327, 2328, 7, 80, 2, 2, 2328, 30, 3, 2, 2, 2, 2329, 2330,
+	7, 68, 2, 2, 2330, 2331, 7, 81, 2, 2, 2331, 2332, 7, 86, 2, 2, 2332, 2333,
+	7, 74, 2, 2, 2333, 32, 3, 2, 2, 2, 2334, 2335, 7, 68, 2, 2, 2335, 2336,
+	7, 91, 2, 2, 2336, 34, 3, 2, 2, 2, 2337, 2338, 7, 69, 2, 2, 2338, 2339,
+	7, 67, 2, 2, 2339, 2340, 7, 78, 2, 2, 2340, 2341, 7, 78, 2, 2, 2341, 36,
+	3, 2, 2, 2, 2342, 2343, 7, 69, 2, 2, 2343, 2344, 7, 67, 2, 2, 2344, 2345,
+	7, 85, 2, 2, 2345, 2346, 7, 69, 2, 2, 2346, 2347, 7, 67, 2, 2, 2347, 2348,
+	7, 70, 2, 2, 2348, 2349, 7, 71, 2, 2, 2349, 38, 3, 2, 2, 2, 2350, 2351,
+	7, 69, 2, 2, 2351, 2352, 7, 67, 2, 2, 2352, 2353, 7, 85, 2, 2, 2353, 2354,
+	7, 71, 2, 2, 2354, 40, 3, 2, 2, 2, 2355, 2356, 7, 69, 2, 2, 2356, 2357,
+	7, 67, 2, 2, 2357, 2358, 7, 85, 2, 2, 2358, 2359, 7, 86, 2, 2, 2359, 42,
+	3, 2, 2, 2, 2360, 2361, 7, 69, 2, 2, 2361, 2362, 7, 74, 2, 2, 2362, 2363,
+	7, 67, 2, 2, 2363, 2364, 7, 80, 2, 2, 2364, 2365, 7, 73, 2, 2, 2365, 2366,
+	7, 71, 2, 2, 2366, 44, 3, 2, 2, 2, 2367, 2368, 7, 69, 2, 2, 2368, 2369,
+	7, 74, 2, 2, 2369, 2370, 7, 67, 2, 2, 2370, 2371, 7, 84, 2, 2, 2371, 2372,
+	7, 67, 2, 2, 2372, 2373, 7, 69, 2, 2, 2373, 2374, 7, 86, 2, 2, 2374, 2375,
+	7, 71, 2, 2, 2375, 2376, 7, 84, 2, 2, 2376, 46, 3, 2, 2, 2, 2377, 2378,
+	7, 69, 2, 2, 2378, 2379, 7, 74, 2, 2, 2379, 2380, 7, 71, 2, 2, 2380, 2381,
+	7, 69, 2, 2, 2381, 2382, 7, 77, 2, 2, 2382, 48, 3, 2, 2, 2, 2383, 2384,
+	7, 69, 2, 2, 2384, 2385, 7, 81, 2, 2, 2385, 2386, 7, 78, 2, 2, 2386, 2387,
+	7, 78, 2, 2, 2387, 2388, 7, 67, 2, 2, 2388, 2389, 7, 86, 2, 2, 2389, 2390,
+	7, 71, 2, 2, 2390, 50, 3, 2, 2, 2, 2391, 2392, 7, 69, 2, 2, 2392, 2393,
+	7, 81, 2, 2, 2393, 2394, 7, 78, 2, 2, 2394, 2395, 7, 87, 2, 2, 2395, 2396,
+	7, 79, 2, 2, 2396, 2397, 7, 80, 2, 2, 2397, 52, 3, 2, 2, 2, 2398, 2399,
+	7, 69, 2, 2, 2399, 2400, 7, 81, 2, 2, 2400, 2401, 7, 80, 2, 2, 2401, 2402,
+	7, 70, 2, 2, 2402, 2403, 7, 75, 2, 2, 2403, 2404, 7, 86, 2, 2, 2404, 2405,
+	7, 75, 2, 2, 2405, 2406, 7, 81, 2, 2, 2406, 2407, 7, 80, 2, 2, 2407, 54,
+	3, 2, 2, 2, 2408, 2409, 7, 69, 2, 2, 2409, 2410, 7, 81, 2, 2, 2410, 2411,
+	7, 80, 2, 2, 2411, 2412, 7, 85, 2, 2, 2412, 2413, 7, 86, 2, 2, 2413, 2414,
+	7, 84, 2, 2, 2414, 2415, 7, 67, 2, 2, 2415, 2416, 7, 75, 2, 2, 2416, 2417,
+	7, 80, 2, 2, 2417, 2418, 7, 86, 2, 2, 2418, 56, 3, 2, 2, 2, 2419, 2420,
+	7, 69, 2, 2, 2420, 2421, 7, 81, 2, 2, 2421, 2422, 7, 80, 2, 2, 2422, 2423,
+	7, 86, 2, 2, 2423, 2424, 7, 75, 2, 2, 2424, 2425, 7, 80, 2, 2, 2425, 2426,
+	7, 87, 2, 2, 2426, 2427, 7, 71, 2, 2, 2427, 58, 3, 2, 2, 2, 2428, 2429,
+	7, 69, 2, 2, 2429, 2430, 7, 81, 2, 2, 2430, 2431, 7, 80, 2, 2, 2431, 2432,
+	7, 88, 2, 2, 2432, 2433, 7, 71, 2, 2, 2433, 2434, 7, 84, 2, 2, 2434, 2435,
+	7, 86, 2, 2, 2435, 60, 3, 2, 2, 2, 2436, 2437, 7, 69, 2, 2, 2437, 2438,
+	7, 84, 2, 2, 2438, 2439, 7, 71, 2, 2, 2439, 2440, 7, 67, 2, 2, 2440, 2441,
+	7, 86, 2, 2, 2441, 2442, 7, 71, 2, 2, 2442, 62, 3, 2, 2, 2, 2443, 2444,
+	7, 69, 2, 2, 2444, 2445, 7, 84, 2, 2, 2445, 2446, 7, 81, 2, 2, 2446, 2447,
+	7, 85, 2, 2, 2447, 2448, 7, 85, 2, 2, 2448, 64, 3, 2, 2, 2, 2449, 2450,
+	7, 69, 2, 2, 2450, 2451, 7, 87, 2, 2, 2451, 2452, 7, 84, 2, 2, 2452, 2453,
+	7, 84, 2, 2, 2453, 2454, 7, 71, 2, 2, 2454, 2455, 7, 80, 2, 2, 2455, 2456,
+	7, 86, 2, 2, 2456, 66, 3, 2, 2, 2, 2457, 2458, 7, 69, 2, 2, 2458, 2459,
+	7, 87, 2, 2, 2459, 2460, 7, 84, 2, 2, 2460, 2461, 7, 84, 2, 2, 2461, 2462,
+	7, 71, 2, 2, 2462, 2463, 7, 80, 2, 2, 2463, 2464, 7, 86, 2, 2, 2464, 2465,
+	7, 97, 2, 2, 2465, 2466, 7, 87, 2, 2, 2466, 2467, 7, 85, 2, 2, 2467, 2468,
+	7, 71, 2, 2, 2468, 2469, 7, 84, 2, 2, 2469, 68, 3, 2, 2, 2, 2470, 2471,
+	7, 69, 2, 2, 2471, 2472, 7, 87, 2, 2, 2472, 2473, 7, 84, 2, 2, 2473, 2474,
+	7, 85, 2, 2, 2474, 2475, 7, 81, 2, 2, 2475, 2476, 7, 84, 2, 2, 2476, 70,
+	3, 2, 2, 2, 2477, 2478, 7, 70, 2, 2, 2478, 2479, 7, 67, 2, 2, 2479, 2480,
+	7, 86, 2, 2, 2480, 2481, 7, 67, 2, 2, 2481, 2482, 7, 68, 2, 2, 2482, 2483,
+	7, 67, 2, 2, 2483, 2484, 7, 85, 2, 2, 2484, 2485, 7, 71, 2, 2, 2485, 72,
+	3, 2, 2, 2, 2486, 2487, 7, 70, 2, 2, 2487, 2488, 7, 67, 2, 2, 2488, 2489,
+	7, 86, 2, 2, 2489, 2490, 7, 67, 2, 2, 2490, 2491, 7, 68, 2, 2, 2491, 2492,
+	7, 67, 2, 2, 2492, 2493, 7, 85, 2, 2, 2493, 2494, 7, 71, 2, 2, 2494, 2495,
+	7, 85, 2, 2, 2495, 74, 3, 2, 2, 2, 2496, 2497, 7, 70, 2, 2, 2497, 2498,
+	7, 71, 2, 2, 2498, 2499, 7, 69, 2, 2, 2499, 2500, 7, 78, 2, 2, 2500, 2501,
+	7, 67, 2, 2, 2501, 2502, 7, 84, 2, 2, 2502, 2503, 7, 71, 2, 2, 2503, 76,
+	3, 2, 2, 2, 2504, 2505, 7, 70, 2, 2, 2505, 2506, 7, 71, 2, 2, 2506, 2507,
+	7, 72, 2, 2, 2507, 2508, 7, 67, 2, 2, 2508, 2509, 7, 87, 2, 2, 2509, 2510,
+	7, 78, 2, 2, 2510, 2511, 7, 86, 2, 2, 2511, 78, 3, 2, 2, 2, 2512, 2513,
+	7, 70, 2, 2, 2513, 2514, 7, 71, 2, 2, 2514, 2515, 7, 78, 2, 2, 2515, 2516,
+	7, 67, 2, 2, 2516, 2517, 7, 91, 2, 2, 2517, 2518, 7, 71, 2, 2, 2518, 2519,
+	7, 70, 2, 2, 2519, 80, 3, 2, 2, 2, 2520, 2521, 7, 70, 2, 2, 2521, 2522,
+	7, 71, 2, 2, 2522, 2523, 7, 78, 2, 2, 2523, 2524, 7, 71, 2, 2, 2524, 2525,
+	7, 86, 2, 2, 2525, 2526, 7, 71, 2, 2, 2526, 82, 3, 2, 2, 2, 2527, 2528,
+	7, 70, 2, 2, 2528, 2529, 7, 71, 2, 2, 2529, 2530, 7, 85, 2, 2, 2530, 2531,
+	7, 69, 2, 2, 2531, 84, 3, 2, 2, 2, 2532, 2533, 7, 70, 2, 2, 2533, 2534,
+	7, 71, 2, 2, 2534, 2535, 7, 85, 2, 2, 2535, 2536, 7, 69, 2, 2, 2536, 2537,
+	7, 84, 2, 2, 2537, 2538, 7, 75, 2, 2, 2538, 2539, 7, 68, 2, 2, 2539, 2540,
+	7, 71, 2, 2, 2540, 86, 3, 2, 2, 2, 2541, 2542, 7, 70, 2, 2, 2542, 2543,
+	7, 71, 2, 2, 2543, 2544, 7, 86, 2, 2, 2544, 2545, 7, 71, 2, 2, 2545, 2546,
+	7, 84, 2, 2, 2546, 2547, 7, 79, 2, 2, 2547, 2548, 7, 75, 2, 2, 2548, 2549,
+	7, 80, 2, 2, 2549, 2550, 7, 75, 2, 2, 2550, 2551, 7, 85, 2, 2, 2551, 2552,
+	7, 86, 2, 2, 2552, 2553, 7, 75, 2, 2, 2553, 2554, 7, 69, 2, 2, 2554, 88,
+	3, 2, 2, 2, 2555, 2556, 7, 70, 2, 2, 2556, 2557, 7, 75, 2, 2, 2557, 2558,
+	7, 67, 2, 2, 2558, 2559, 7, 73, 2, 2, 2559, 2560, 7, 80, 2, 2, 2560, 2561,
+	7, 81, 2, 2, 2561, 2562, 7, 85, 2, 2, 2562, 2563, 7, 86, 2, 2, 2563, 2564,
+	7, 75, 2, 2, 2564, 2565, 7, 69, 2, 2, 2565, 2566, 7, 85, 2, 2, 2566, 90,
+	3, 2, 2, 2, 2567, 2568, 7, 70, 2, 2, 2568, 2569, 7, 75, 2, 2, 2569, 2570,
+	7, 85, 2, 2, 2570, 2571, 7, 86, 2, 2, 2571, 2572, 7, 75, 2, 2, 2572, 2573,
+	7, 80, 2, 2, 2573, 2574, 7, 69, 2, 2, 2574, 2575, 7, 86, 2, 2, 2575, 92,
+	3, 2, 2, 2, 2576, 2577, 7, 70, 2, 2, 2577, 2578, 7, 75, 2, 2, 2578, 2579,
+	7, 85, 2, 2, 2579, 2580, 7, 86, 2, 2, 2580, 2581, 7, 75, 2, 2, 2581, 2582,
+	7, 80, 2, 2, 2582, 2583, 7, 69, 2, 2, 2583, 2584, 7, 86, 2, 2, 2584, 2585,
+	7, 84, 2, 2, 2585, 2586, 7, 81, 2, 2, 2586, 2587, 7, 89, 2, 2, 2587, 94,
+	3, 2, 2, 2, 2588, 2589, 7, 70, 2, 2, 2589, 2590, 7, 84, 2, 2, 2590, 2591,
+	7, 81, 2, 2, 2591, 2592, 7, 82, 2, 2, 2592, 96, 3, 2, 2, 2, 2593, 2594,
+	7, 71, 2, 2, 2594, 2595, 7, 67, 2, 2, 2595, 2596, 7, 69, 2, 2, 2596, 2597,
+	7, 74, 2, 2, 2597, 98, 3, 2, 2, 2, 2598, 2599, 7, 71, 2, 2, 2599, 2600,
+	7, 78, 2, 2, 2600, 2601, 7, 85, 2, 2, 2601, 2602, 7, 71, 2, 2, 2602, 100,
+	3, 2, 2, 2, 2603, 2604, 7, 71, 2, 2, 2604, 2605, 7, 78, 2, 2, 2605, 2606,
+	7, 85, 2, 2, 2606, 2607, 7, 71, 2, 2, 2607, 2608, 7, 75, 2, 2, 2608, 2609,
+	7, 72, 2, 2, 2609, 102, 3, 2, 2, 2, 2610, 2611, 7, 71, 2, 2, 2611, 2612,
+	7, 79, 2, 2, 2612, 2613, 7, 82, 2, 2, 2613, 2614, 7, 86, 2, 2, 2614, 2615,
+	7, 91, 2, 2, 2615, 104, 3, 2, 2, 2, 2616, 2617, 7, 71, 2, 2, 2617, 2618,
+	7, 80, 2, 2, 2618, 2619, 7, 69, 2, 2, 2619, 2620, 7, 78, 2, 2, 2620, 2621,
+	7, 81, 2, 2, 2621, 2622, 7, 85, 2, 2, 2622, 2623, 7, 71, 2, 2, 2623, 2624,
+	7, 70, 2, 2, 2624, 106, 3, 2, 2, 2, 2625, 2626, 7, 71, 2, 2, 2626, 2627,
+	7, 85, 2, 2, 2627, 2628, 7, 69, 2, 2, 2628, 2629, 7, 67, 2, 2, 2629, 2630,
+	7, 82, 2, 2, 2630, 2631, 7, 71, 2, 2, 2631, 2632, 7, 70, 2, 2, 2632, 108,
+	3, 2, 2, 2, 2633, 2634, 7, 71, 2, 2, 2634, 2635, 7, 90, 2, 2, 2635, 2636,
+	7, 75, 2, 2, 2636, 2637, 7, 85, 2, 2, 2637, 2638, 7, 86, 2, 2, 2638, 2639,
+	7, 85, 2, 2, 2639, 110, 3, 2, 2, 2, 2640, 2641, 7, 71, 2, 2, 2641, 2642,
+	7, 90, 2, 2, 2642, 2643, 7, 75, 2, 2, 2643, 2644, 7, 86, 2, 2, 2644, 112,
+	3, 2, 2, 2, 2645, 2646, 7, 71, 2, 2, 2646, 2647, 7, 90, 2, 2, 2647, 2648,
+	7, 82, 2, 2, 2648, 2649, 7, 78, 2, 2, 2649, 2650, 7, 67, 2, 2, 2650, 2651,
+	7, 75, 2, 2, 2651, 2652, 7, 80, 2, 2, 2652, 114, 3, 2, 2, 2, 2653, 2654,
+	7, 72, 2, 2, 2654, 2655, 7, 67, 2, 2, 2655, 2656, 7, 78, 2, 2, 2656, 2657,
+	7, 85, 2, 2, 2657, 2658, 7, 71, 2, 2, 2658, 116, 3, 2, 2, 2, 2659, 2660,
+	7, 72, 2, 2, 2660, 2661, 7, 71, 2, 2, 2661, 2662, 7, 86, 2, 2, 2662, 2663,
+	7, 69, 2, 2, 2663, 2664, 7, 74, 2, 2, 2664, 118, 3, 2, 2, 2, 2665, 2666,
+	7, 72, 2, 2, 2666, 2667, 7, 81, 2, 2, 2667, 2668, 7, 84, 2, 2, 2668, 120,
+	3, 2, 2, 2, 2669, 2670, 7, 72, 2, 2, 2670, 2671, 7, 81, 2, 2, 2671, 2672,
+	7, 84, 2, 2, 2672, 2673, 7, 69, 2, 2, 2673, 2674, 7, 71, 2, 2, 2674, 122,
+	3, 2, 2, 2, 2675, 2676, 7, 72, 2, 2, 2676, 2677, 7, 81, 2, 2, 2677, 2678,
+	7, 84, 2, 2, 2678, 2679, 7, 71, 2, 2, 2679, 2680, 7, 75, 2, 2, 2680, 2681,
+	7, 73, 2, 2, 2681, 2682, 7, 80, 2, 2, 2682, 124, 3, 2, 2, 2, 2683, 2684,
+	7, 72, 2, 2, 2684, 2685, 7, 84, 2, 2, 2685, 2686, 7, 81, 2, 2, 2686, 2687,
+	7, 79, 2, 2, 2687, 126, 3, 2, 2, 2, 2688, 2689, 7, 72, 2, 2, 2689, 2690,
+	7, 87, 2, 2, 2690, 2691, 7, 78, 2, 2, 2691, 2692, 7, 78, 2, 2, 2692, 2693,
+	7, 86, 2, 2, 2693, 2694, 7, 71, 2, 2, 2694, 2695, 7, 90, 2, 2, 2695, 2696,
+	7, 86, 2, 2, 2696, 128, 3, 2, 2, 2, 2697, 2698, 7, 73, 2, 2, 2698, 2699,
+	7, 71, 2, 2, 2699, 2700, 7, 80, 2, 2, 2700, 2701, 7, 71, 2, 2, 2701, 2702,
+	7, 84, 2, 2, 2702, 2703, 7, 67, 2, 2, 2703, 2704, 7, 86, 2, 2, 2704, 2705,
+	7, 71, 2, 2, 2705, 2706, 7, 70, 2, 2, 2706, 130, 3, 2, 2, 2, 2707, 2708,
+	7, 73, 2, 2, 2708, 2709, 7, 71, 2, 2, 2709, 2710, 7, 86, 2, 2, 2710, 132,
+	3, 2, 2, 2, 2711, 2712, 7, 73, 2, 2, 2712, 2713, 7, 84, 2, 2, 2713, 2714,
+	7, 67, 2, 2, 2714, 2715, 7, 80, 2, 2, 2715, 2716, 7, 86, 2, 2, 2716, 134,
+	3, 2, 2, 2, 2717, 2718, 7, 73, 2, 2, 2718, 2719, 7, 84, 2, 2, 2719, 2720,
+	7, 81, 2, 2, 2720, 2721, 7, 87, 2, 2, 2721, 2722, 7, 82, 2, 2, 2722, 136,
+	3, 2, 2, 2, 2723, 2724, 7, 74, 2, 2, 2724, 2725, 7, 67, 2, 2, 2725, 2726,
+	7, 88, 2, 2, 2726, 2727, 7, 75, 2, 2, 2727, 2728, 7, 80, 2, 2, 2728, 2729,
+	7, 73, 2, 2, 2729, 138, 3, 2, 2, 2, 2730, 2731, 7, 74, 2, 2, 2731, 2732,
+	7, 75, 2, 2, 2732, 2733, 7, 73, 2, 2, 2733, 2734, 7, 74, 2, 2, 2734, 2735,
+	7, 97, 2, 2, 2735, 2736, 7, 82, 2, 2, 2736, 2737, 7, 84, 2, 2, 2737, 2738,
+	7, 75, 2, 2, 2738, 2739, 7, 81, 2, 2, 2739, 2740, 7, 84, 2, 2, 2740, 2741,
+	7, 75, 2, 2, 2741, 2742, 7, 86, 2, 2, 2742, 2743, 7, 91, 2, 2, 2743, 140,
+	3, 2, 2, 2, 2744, 2745, 7, 75, 2, 2, 2745, 2746, 7, 72, 2, 2, 2746, 142,
+	3, 2, 2, 2, 2747, 2748, 7, 75, 2, 2, 2748, 2749, 7, 73, 2, 2, 2749, 2750,
+	7, 80, 2, 2, 2750, 2751, 7, 81, 2, 2, 2751, 2752, 7, 84, 2, 2, 2752, 2753,
+	7, 71, 2, 2, 2753, 144, 3, 2, 2, 2, 2754, 2755, 7, 75, 2, 2, 2755, 2756,
+	7, 80, 2, 2, 2756, 146, 3, 2, 2, 2, 2757, 2758, 7, 75, 2, 2, 2758, 2759,
+	7, 80, 2, 2, 2759, 2760, 7, 70, 2, 2, 2760, 2761, 7, 71, 2, 2, 2761, 2762,
+	7, 90, 2, 2, 2762, 148, 3, 2, 2, 2, 2763, 2764, 7, 75, 2, 2, 2764, 2765,
+	7, 80, 2, 2, 2765, 2766, 7, 72, 2, 2, 2766, 2767, 7, 75, 2, 2, 2767, 2768,
+	7, 78, 2, 2, 2768, 2769, 7, 71, 2, 2, 2769, 150, 3, 2, 2, 2, 2770, 2771,
+	7, 75, 2, 2, 2771, 2772, 7, 80, 2, 2, 2772, 2773, 7, 80, 2, 2, 2773, 2774,
+	7, 71, 2, 2, 2774, 2775, 7, 84, 2, 2, 2775, 152, 3, 2, 2, 2, 2776, 2777,
+	7, 75, 2, 2, 2777, 2778, 7, 80, 2, 2, 2778, 2779, 7, 81, 2, 2, 2779, 2780,
+	7, 87, 2, 2, 2780, 2781, 7, 86, 2, 2, 2781, 154, 3, 2, 2, 2, 2782, 2783,
+	7, 75, 2, 2, 2783, 2784, 7, 80, 2, 2, 2784, 2785, 7, 85, 2, 2, 2785, 2786,
+	7, 71, 2, 2, 2786, 2787, 7, 84, 2, 2, 2787, 2788, 7, 86, 2, 2, 2788, 156,
+	3, 2, 2, 2, 2789, 2790, 7, 75, 2, 2, 2790, 2791, 7, 80, 2, 2, 2791, 2792,
+	7, 86, 2, 2, 2792, 2793, 7, 71, 2, 2, 2793, 2794, 7, 84, 2, 2, 2794, 2795,
+	7, 88, 2, 2, 2795, 2796, 7, 67, 2, 2, 2796, 2797, 7, 78, 2, 2, 2797, 158,
+	3, 2, 2, 2, 2798, 2799, 7, 75, 2, 2, 2799, 2800, 7, 80, 2, 2, 2800, 2801,
+	7, 86, 2, 2, 2801, 2802, 7, 81, 2, 2, 2802, 160, 3, 2, 2, 2, 2803, 2804,
+	7, 75, 2, 2, 2804, 2805, 7, 85, 2, 2, 2805, 162, 3, 2, 2, 2, 2806, 2807,
+	7, 75, 2, 2, 2807, 2808, 7, 86, 2, 2, 2808, 2809, 7, 71, 2, 2, 2809, 2810,
+	7, 84, 2, 2, 2810, 2811, 7, 67, 2, 2, 2811, 2812, 7, 86, 2, 2, 2812, 2813,
+	7, 71, 2, 2, 2813, 164, 3, 2, 2, 2, 2814, 2815, 7, 76, 2, 2, 2815, 2816,
+	7, 81, 2, 2, 2816, 2817, 7, 75, 2, 2, 2817, 2818, 7, 80, 2, 2, 2818, 166,
+	3, 2, 2, 2, 2819, 2820, 7, 77, 2, 2, 2820, 2821, 7, 71, 2, 2, 2821, 2822,
+	7, 91, 2, 2, 2822, 168, 3, 2, 2, 2, 2823, 2824, 7, 77, 2, 2, 2824, 2825,
+	7, 71, 2, 2, 2825, 2826, 7, 91, 2, 2, 2826, 2827, 7, 85, 2, 2, 2827, 170,
+	3, 2, 2, 2, 2828, 2829, 7, 77, 2, 2, 2829, 2830, 7, 75, 2, 2, 2830, 2831,
+	7, 78, 2, 2, 2831, 2832, 7, 78, 2, 2, 2832, 172, 3, 2, 2, 2, 2833, 2834,
+	7, 78, 2, 2, 2834, 2835, 7, 71, 2, 2, 2835, 2836, 7, 67, 2, 2, 2836, 2837,
+	7, 70, 2, 2, 2837, 2838, 7, 75, 2, 2, 2838, 2839, 7, 80, 2, 2, 2839, 2840,
+	7, 73, 2, 2, 2840, 174, 3, 2, 2, 2, 2841, 2842, 7, 78, 2, 2, 2842, 2843,
+	7, 71, 2, 2, 2843, 2844, 7, 67, 2, 2, 2844, 2845, 7, 88, 2, 2, 2845, 2846,
+	7, 71, 2, 2, 2846, 176, 3, 2, 2, 2, 2847, 2848, 7, 78, 2, 2, 2848, 2849,
+	7, 71, 2, 2, 2849, 2850, 7, 72, 2, 2, 2850, 2851, 7, 86, 2, 2, 2851, 178,
+	3, 2, 2, 2, 2852, 2853, 7, 78, 2, 2, 2853, 2854, 7, 75, 2, 2, 2854, 2855,
+	7, 77, 2, 2, 2855, 2856, 7, 71, 2, 2, 2856, 180, 3, 2, 2, 2, 2857, 2858,
+	7, 78, 2, 2, 2858, 2859, 7, 75, 2, 2, 2859, 2860, 7, 79, 2, 2, 2860, 2861,
+	7, 75, 2, 2, 2861, 2862, 7, 86, 2, 2, 2862, 182, 3, 2, 2, 2, 2863, 2864,
+	7, 78, 2, 2, 2864, 2865, 7, 75, 2, 2, 2865, 2866, 7, 80, 2, 2, 2866, 2867,
+	7, 71, 2, 2, 2867, 2868, 7, 67, 2, 2, 2868, 2869, 7, 84, 2, 2, 2869, 184,
+	3, 2, 2, 2, 2870, 2871, 7, 78, 2, 2, 2871, 2872, 7, 75, 2, 2, 2872, 2873,
+	7, 80, 2, 2, 2873, 2874, 7, 71, 2, 2, 2874, 2875, 7, 85, 2, 2, 2875, 186,
+	3, 2, 2, 2, 2876, 2877, 7, 78, 2, 2, 2877, 2878, 7, 81, 2, 2, 2878, 2879,
+	7, 67, 2, 2, 2879, 2880, 7, 70, 2, 2, 2880, 188, 3, 2, 2, 2, 2881, 2882,
+	7, 78, 2, 2, 2882, 2883, 7, 81, 2, 2, 2883, 2884, 7, 69, 2, 2, 2884, 2885,
+	7, 77, 2, 2, 2885, 190, 3, 2, 2, 2, 2886, 2887, 7, 78, 2, 2, 2887, 2888,
+	7, 81, 2, 2, 2888, 2889, 7, 81, 2, 2, 2889, 2890, 7, 82, 2, 2, 2890, 192,
+	3, 2, 2, 2, 2891, 2892, 7, 78, 2, 2, 2892, 2893, 7, 81, 2, 2, 2893, 2894,
+	7, 89, 2, 2, 2894, 2895, 7, 97, 2, 2, 2895, 2896, 7, 82, 2, 2, 2896, 2897,
+	7, 84, 2, 2, 2897, 2898, 7, 75, 2, 2, 2898, 2899, 7, 81, 2, 2, 2899, 2900,
+	7, 84, 2, 2, 2900, 2901, 7, 75, 2, 2, 2901, 2902, 7, 86, 2, 2, 2902, 2903,
+	7, 91, 2, 2, 2903, 194, 3, 2, 2, 2, 2904, 2905, 7, 79, 2, 2, 2905, 2906,
+	7, 67, 2, 2, 2906, 2907, 7, 85, 2, 2, 2907, 2908, 7, 86, 2, 2, 2908, 2909,
+	7, 71, 2, 2, 2909, 2910, 7, 84, 2, 2, 2910, 2911, 7, 97, 2, 2, 2911, 2912,
+	7, 68, 2, 2, 2912, 2913, 7, 75, 2, 2, 2913, 2914, 7, 80, 2, 2, 2914, 2915,
+	7, 70, 2, 2, 2915, 196, 3, 2, 2, 2, 2916, 2917, 7, 79, 2, 2, 2917, 2918,
+	7, 67, 2, 2, 2918, 2919, 7, 85, 2, 2, 2919, 2920, 7, 86, 2, 2, 2920, 2921,
+	7, 71, 2, 2, 2921, 2922, 7, 84, 2, 2, 2922, 2923, 7, 97, 2, 2, 2923, 2924,
+	7, 85, 2, 2, 2924, 2925, 7, 85, 2, 2, 2925, 2926, 7, 78, 2, 2, 2926, 2927,
+	7, 97, 2, 2, 2927, 2928, 7, 88, 2, 2, 2928, 2929, 7, 71, 2, 2, 2929, 2930,
+	7, 84, 2, 2, 2930, 2931, 7, 75, 2, 2, 2931, 2932, 7, 72, 2, 2, 2932, 2933,
+	7, 91, 2, 2, 2933, 2934, 7, 97, 2, 2, 2934, 2935, 7, 85, 2, 2, 2935, 2936,
+	7, 71, 2, 2, 2936, 2937, 7, 84, 2, 2, 2937, 2938, 7, 88, 2, 2, 2938, 2939,
+	7, 71, 2, 2, 2939, 2940, 7, 84, 2, 2, 2940, 2941, 7, 97, 2, 2, 2941, 2942,
+	7, 69, 2, 2, 2942, 2943, 7, 71, 2, 2, 2943, 2944, 7, 84, 2, 2, 2944, 2945,
+	7, 86, 2, 2, 2945, 198, 3, 2, 2, 2, 2946, 2947, 7, 79, 2, 2, 2947, 2948,
+	7, 67, 2, 2, 2948, 2949, 7, 86, 2, 2, 2949, 2950, 7, 69, 2, 2, 2950, 2951,
+	7, 74, 2, 2, 2951, 200, 3, 2, 2, 2, 2952, 2953, 7, 79, 2, 2, 2953, 2954,
+	7, 67, 2, 2, 2954, 2955, 7, 90, 2, 2, 2955, 2956, 7, 88, 2, 2, 2956, 2957,
+	7, 67, 2, 2, 2957, 2958, 7, 78, 2, 2, 2958, 2959, 7, 87, 2, 2, 2959, 2960,
+	7, 71, 2, 2, 2960, 202, 3, 2, 2, 2, 2961, 2962, 7, 79, 2, 2, 2962, 2963,
+	7, 81, 2, 2, 2963, 2964, 7, 70, 2, 2, 2964, 2965, 7, 75, 2, 2, 2965, 2966,
+	7, 72, 2, 2, 2966, 2967, 7, 75, 2, 2, 2967, 2968, 7, 71, 2, 2, 2968, 2969,
+	7, 85, 2, 2, 2969, 204, 3, 2, 2, 2, 2970, 2971, 7, 80, 2, 2, 2971, 2972,
+	7, 67, 2, 2, 2972, 2973, 7, 86, 2, 2, 2973, 2974, 7, 87, 2, 2, 2974, 2975,
+	7, 84, 2, 2, 2975, 2976, 7, 67, 2, 2, 2976, 2977, 7, 78, 2, 2, 2977, 206,
+	3, 2, 2, 2, 2978, 2979, 7, 80, 2, 2, 2979, 2980, 7, 81, 2, 2, 2980, 2981,
+	7, 86, 2, 2, 2981, 208, 3, 2, 2, 2, 2982, 2983, 7, 80, 2, 2, 2983, 2984,
+	7, 81, 2, 2, 2984, 2985, 7, 97, 2, 2, 2985, 2986, 7, 89, 2, 2, 2986, 2987,
+	7, 84, 2, 2, 2987, 2988, 7, 75, 2, 2, 2988, 2989, 7, 86, 2, 2, 2989, 2990,
+	7, 71, 2, 2, 2990, 2991, 7, 97, 2, 2, 2991, 2992, 7, 86, 2, 2, 2992, 2993,
+	7, 81, 2, 2, 2993, 2994, 7, 97, 2, 2, 2994, 2995, 7, 68, 2, 2, 2995, 2996,
+	7, 75, 2, 2, 2996, 2997, 7, 80, 2, 2, 2997, 2998, 7, 78, 2, 2, 2998, 2999,
+	7, 81, 2, 2, 2999, 3000, 7, 73, 2, 2, 3000, 210, 3, 2, 2, 2, 3001, 3002,
+	5, 213, 107, 2, 3002, 212, 3, 2, 2, 2, 3003, 3004, 7, 80, 2, 2, 3004, 3005,
+	7, 87, 2, 2, 3005, 3006, 7, 78, 2, 2, 3006, 3007, 7, 78, 2, 2, 3007, 214,
+	3, 2, 2, 2, 3008, 3009, 7, 80, 2, 2, 3009, 3010, 7, 87, 2, 2, 3010, 3011,
+	7, 79, 2, 2, 3011, 3012, 7, 68, 2, 2, 3012, 3013, 7, 71, 2, 2, 3013, 3014,
+	7, 84, 2, 2, 3014, 216, 3, 2, 2, 2, 3015, 3016, 7, 81, 2, 2, 3016, 3017,
+	7, 80, 2, 2, 3017, 218, 3, 2, 2, 2, 3018, 3019, 7, 81, 2, 2, 3019, 3020,
+	7, 82, 2, 2, 3020, 3021, 7, 86, 2, 2, 3021, 3022, 7, 75, 2, 2, 3022, 3023,
+	7, 79, 2, 2, 3023, 3024, 7, 75, 2, 2, 3024, 3025, 7, 92, 2, 2, 3025, 3026,
+	7, 71, 2, 2, 3026, 220, 3, 2, 2, 2, 3027, 3028, 7, 81, 2, 2, 3028, 3029,
+	7, 82, 2, 2, 3029, 3030, 7, 86, 2, 2, 3030, 3031, 7, 75, 2, 2, 3031, 3032,
+	7, 81, 2, 2, 3032, 3033, 7, 80, 2, 2, 3033, 222, 3, 2, 2, 2, 3034, 3035,
+	7, 81, 2, 2, 3035, 3036, 7, 82, 2, 2, 3036, 3037, 7, 86, 2, 2, 3037, 3038,
+	7, 75, 2, 2, 3038, 3039, 7, 81, 2, 2, 3039, 3040, 7, 80, 2, 2, 3040, 3041,
+	7, 67, 2, 2, 3041, 3042, 7, 78, 2, 2, 3042, 3043, 7, 78, 2, 2, 3043, 3044,
+	7, 91, 2, 2, 3044, 224, 3, 2, 2, 2, 3045, 3046, 7, 81, 2, 2, 3046, 3047,
+	7, 84, 2, 2, 3047, 226, 3, 2, 2, 2, 3048, 3049, 7, 81, 2, 2, 3049, 3050,
+	7, 84, 2, 2, 3050, 3051, 7, 70, 2, 2, 3051, 3052, 7, 71, 2, 2, 3052, 3053,
+	7, 84, 2, 2, 3053, 228, 3, 2, 2, 2, 3054, 3055, 7, 81, 2, 2, 3055, 3056,
+	7, 87, 2, 2, 3056, 3057, 7, 86, 2, 2, 3057, 230, 3, 2, 2, 2, 3058, 3059,
+	7, 81, 2, 2, 3059, 3060, 7, 87, 2, 2, 3060, 3061, 7, 86, 2, 2, 3061, 3062,
+	7, 71, 2, 2, 3062, 3063, 7, 84, 2, 2, 3063, 232, 3, 2, 2, 2, 3064, 3065,
+	7, 81, 2, 2, 3065, 3066, 7, 87, 2, 2, 3066, 3067, 7, 86, 2, 2, 3067, 3068,
+	7, 72, 2, 2, 3068, 3069, 7, 75, 2, 2, 3069, 3070, 7, 78, 2, 2, 3070, 3071,
+	7, 71, 2, 2, 3071, 234, 3, 2, 2, 2, 3072, 3073, 7, 82, 2, 2, 3073, 3074,
+	7, 67, 2, 2, 3074, 3075, 7, 84, 2, 2, 3075, 3076, 7, 86, 2, 2, 3076, 3077,
+	7, 75, 2, 2, 3077, 3078, 7, 86, 2, 2, 3078, 3079, 7, 75, 2, 2, 3079, 3080,
+	7, 81, 2, 2, 3080, 3081, 7, 80, 2, 2, 3081, 236, 3, 2, 2, 2, 3082, 3083,
+	7, 82, 2, 2, 3083, 3084, 7, 84, 2, 2, 3084, 3085, 7, 75, 2, 2, 3085, 3086,
+	7, 79, 2, 2, 3086, 3087, 7, 67, 2, 2, 3087, 3088, 7, 84, 2, 2, 3088, 3089,
+	7, 91, 2, 2, 3089, 238, 3, 2, 2, 2, 3090, 3091, 7, 82, 2, 2, 3091, 3092,
+	7, 84, 2, 2, 3092, 3093, 7, 81, 2, 2, 3093, 3094, 7, 69, 2, 2, 3094, 3095,
+	7, 71, 2, 2, 3095, 3096, 7, 70, 2, 2, 3096, 3097, 7, 87, 2, 2, 3097, 3098,
+	7, 84, 2, 2, 3098, 3099, 7, 71, 2, 2, 3099, 240, 3, 2, 2, 2, 3100, 3101,
+	7, 82, 2, 2, 3101, 3102, 7, 87, 2, 2, 3102, 3103, 7, 84, 2, 2, 3103, 3104,
+	7, 73, 2, 2, 3104, 3105, 7, 71, 2, 2, 3105, 242, 3, 2, 2, 2, 3106, 3107,
+	7, 84, 2, 2, 3107, 3108, 7, 67, 2, 2, 3108, 3109, 7, 80, 2, 2, 3109, 3110,
+	7, 73, 2, 2, 3110, 3111, 7, 71, 2, 2, 3111, 244, 3, 2, 2, 2, 3112, 3113,
+	7, 84, 2, 2, 3113, 3114, 7, 71, 2, 2, 3114, 3115, 7, 67, 2, 2, 3115, 3116,
+	7, 70, 2, 2, 3116, 246, 3, 2, 2, 2, 3117, 3118, 7, 84, 2, 2, 3118, 3119,
+	7, 71, 2, 2, 3119, 3120, 7, 67, 2, 2, 3120, 3121, 7, 70, 2, 2, 3121, 3122,
+	7, 85, 2, 2, 3122, 248, 3, 2, 2, 2, 3123, 3124, 7, 84, 2, 2, 3124, 3125,
+	7, 71, 2, 2, 3125, 3126, 7, 72, 2, 2, 3126, 3127, 7, 71, 2, 2, 3127, 3128,
+	7, 84, 2, 2, 3128, 3129, 7, 71, 2, 2, 3129, 3130, 7, 80, 2, 2, 3130, 3131,
+	7, 69, 2, 2, 3131, 3132, 7, 71, 2, 2, 3132, 3133, 7, 85, 2, 2, 3133, 250,
+	3, 2, 2, 2, 3134, 3135, 7, 84, 2, 2, 3135, 3136, 7, 71, 2, 2, 3136, 3137,
+	7, 73, 2, 2, 3137, 3138, 7, 71, 2, 2, 3138, 3139, 7, 90, 2, 2, 3139, 3140,
+	7, 82, 2, 2, 3140, 252, 3, 2, 2, 2, 3141, 3142, 7, 84, 2, 2, 3142, 3143,
+	7, 71, 2, 2, 3143, 3144, 7, 78, 2, 2, 3144, 3145, 7, 71, 2, 2, 3145, 3146,
+	7, 67, 2, 2, 3146, 3147, 7, 85, 2, 2, 3147, 3148, 7, 71, 2, 2, 3148, 254,
+	3, 2, 2, 2, 3149, 3150, 7, 84, 2, 2, 3150, 3151, 7, 71, 2, 2, 3151, 3152,
+	7, 80, 2, 2, 3152, 3153, 7, 67, 2, 2, 3153, 3154, 7, 79, 2, 2, 3154, 3155,
+	7, 71, 2, 2, 3155, 256, 3, 2, 2, 2, 3156, 3157, 7, 84, 2, 2, 3157, 3158,
+	7, 71, 2, 2, 3158, 3159, 7, 82, 2, 2, 3159, 3160, 7, 71, 2, 2, 3160, 3161,
+	7, 67, 2, 2, 3161, 3162, 7, 86, 2, 2, 3162, 258, 3, 2, 2, 2, 3163, 3164,
+	7, 84, 2, 2, 3164, 3165, 7, 71, 2, 2, 3165, 3166, 7, 82, 2, 2, 3166, 3167,
+	7, 78, 2, 2, 3167, 3168, 7, 67, 2, 2, 3168, 3169, 7, 69, 2, 2, 3169, 3170,
+	7, 71, 2, 2, 3170, 260, 3, 2, 2, 2, 3171, 3172, 7, 84, 2, 2, 3172, 3173,
+	7, 71, 2, 2, 3173, 3174, 7, 83, 2, 2, 3174, 3175, 7, 87, 2, 2, 3175, 3176,
+	7, 75, 2, 2, 3176, 3177, 7, 84, 2, 2, 3177, 3178, 7, 71, 2, 2, 3178, 262,
+	3, 2, 2, 2, 3179, 3180, 7, 84, 2, 2, 3180, 3181, 7, 71, 2, 2, 3181, 3182,
+	7, 85, 2, 2, 3182, 3183, 7, 75, 2, 2, 3183, 3184, 7, 73, 2, 2, 3184, 3185,
+	7, 80, 2, 2, 3185, 3186, 7, 67, 2, 2, 3186, 3187, 7, 78, 2, 2, 3187, 264,
+	3, 2, 2, 2, 3188, 3189, 7, 84, 2, 2, 3189, 3190, 7, 71, 2, 2, 3190, 3191,
+	7, 85, 2, 2, 3191, 3192, 7, 86, 2, 2, 3192, 3193, 7, 84, 2, 2, 3193, 3194,
+	7, 75, 2, 2, 3194, 3195, 7, 69, 2, 2, 3195, 3196, 7, 86, 2, 2, 3196, 266,
+	3, 2, 2, 2, 3197, 3198, 7, 84, 2, 2, 3198, 3199, 7, 71, 2, 2, 3199, 3200,
+	7, 86, 2, 2, 3200, 3201, 7, 87, 2, 2, 3201, 3202, 7, 84, 2, 2, 3202, 3203,
+	7, 80, 2, 2, 3203, 268, 3, 2, 2, 2, 3204, 3205, 7, 84, 2, 2, 3205, 3206,
+	7, 71, 2, 2, 3206, 3207, 7, 88, 2, 2, 3207, 3208, 7, 81, 2, 2, 3208, 3209,
+	7, 77, 2, 2, 3209, 3210, 7, 71, 2, 2, 3210, 270, 3, 2, 2, 2, 3211, 3212,
+	7, 84, 2, 2, 3212, 3213, 7, 75, 2, 2, 3213, 3214, 7, 73, 2, 2, 3214, 3215,
+	7, 74, 2, 2, 3215, 3216, 7, 86, 2, 2, 3216, 272, 3, 2, 2, 2, 3217, 3218,
+	7, 84, 2, 2, 3218, 3219, 7, 78, 2, 2, 3219, 3220, 7, 75, 2, 2, 3220, 3221,
+	7, 77, 2, 2, 3221, 3222, 7, 71, 2, 2, 3222, 274, 3, 2, 2, 2, 3223, 3224,
+	7, 85, 2, 2, 3224, 3225, 7, 69, 2, 2, 3225, 3226, 7, 74, 2, 2, 3226, 3227,
+	7, 71, 2, 2, 3227, 3228, 7, 79, 2, 2, 3228, 3229, 7, 67, 2, 2, 3229, 276,
+	3, 2, 2, 2, 3230, 3231, 7, 85, 2, 2, 3231, 3232, 7, 69, 2, 2, 3232, 3233,
+	7, 74, 2, 2, 3233, 3234, 7, 71, 2, 2, 3234, 3235, 7, 79, 2, 2, 3235, 3236,
+	7, 67, 2, 2, 3236, 3237, 7, 85, 2, 2, 3237, 278, 3, 2, 2, 2, 3238, 3239,
+	7, 85, 2, 2, 3239, 3240, 7, 71, 2, 2, 3240, 3241, 7, 78, 2, 2, 3241, 3242,
+	7, 71, 2, 2, 3242, 3243, 7, 69, 2, 2, 3243, 3244, 7, 86, 2, 2, 3244, 280,
+	3, 2, 2, 2, 3245, 3246, 7, 85, 2, 2, 3246, 3247, 7, 71, 2, 2, 3247, 3248,
+	7, 86, 2, 2, 3248, 282, 3, 2, 2, 2, 3249, 3250, 7, 85, 2, 2, 3250, 3251,
+	7, 71, 2, 2, 3251, 3252, 7, 82, 2, 2, 3252, 3253, 7, 67, 2, 2, 3253, 3254,
+	7, 84, 2, 2, 3254, 3255, 7, 67, 2, 2, 3255, 3256, 7, 86, 2, 2, 3256, 3257,
+	7, 81, 2, 2, 3257, 3258, 7, 84, 2, 2, 3258, 284, 3, 2, 2, 2, 3259, 3260,
+	7, 85, 2, 2, 3260, 3261, 7, 74, 2, 2, 3261, 3262, 7, 81, 2, 2, 3262, 3263,
+	7, 89, 2, 2, 3263, 286, 3, 2, 2, 2, 3264, 3265, 7, 85, 2, 2, 3265, 3266,
+	7, 75, 2, 2, 3266, 3267, 7, 73, 2, 2, 3267, 3268, 7, 80, 2, 2, 3268, 3269,
+	7, 67, 2, 2, 3269, 3270, 7, 78, 2, 2, 3270, 288, 3, 2, 2, 2, 3271, 3272,
+	7, 85, 2, 2, 3272, 3273, 7, 82, 2, 2, 3273, 3274, 7, 67, 2, 2, 3274, 3275,
+	7, 86, 2, 2, 3275, 3276, 7, 75, 2, 2, 3276, 3277, 7, 67, 2, 2, 3277, 3278,
+	7, 78, 2, 2, 3278, 290, 3, 2, 2, 2, 3279, 3280, 7, 85, 2, 2, 3280, 3281,
+	7, 83, 2, 2, 3281, 3282, 7, 78, 2, 2, 3282, 292, 3, 2, 2, 2, 3283, 3284,
+	7, 85, 2, 2, 3284, 3285, 7, 83, 2, 2, 3285, 3286, 7, 78, 2, 2, 3286, 3287,
+	7, 71, 2, 2, 3287, 3288, 7, 90, 2, 2, 3288, 3289, 7, 69, 2, 2, 3289, 3290,
+	7, 71, 2, 2, 3290, 3291, 7, 82, 2, 2, 3291, 3292, 7, 86, 2, 2, 3292, 3293,
+	7, 75, 2, 2, 3293, 3294, 7, 81, 2, 2, 3294, 3295, 7, 80, 2, 2, 3295, 294,
+	3, 2, 2, 2, 3296, 3297, 7, 85, 2, 2, 3297, 3298, 7, 83, 2, 2, 3298, 3299,
+	7, 78, 2, 2, 3299, 3300, 7, 85, 2, 2, 3300, 3301, 7, 86, 2, 2, 3301, 3302,
+	7, 67, 2, 2, 3302, 3303, 7, 86, 2, 2, 3303, 3304, 7, 71, 2, 2, 3304, 296,
+	3, 2, 2, 2, 3305, 3306, 7, 85, 2, 2, 3306, 3307, 7, 83, 2, 2, 3307, 3308,
+	7, 78, 2, 2, 3308, 3309, 7, 89, 2, 2, 3309, 3310, 7, 67, 2, 2, 3310, 3311,
+	7, 84, 2, 2, 3311, 3312, 7, 80, 2, 2, 3312, 3313, 7, 75, 2, 2, 3313, 3314,
+	7, 80, 2, 2, 3314, 3315, 7, 73, 2, 2, 3315, 298, 3, 2, 2, 2, 3316, 3317,
+	7, 85, 2, 2, 3317, 3318, 7, 83, 2, 2, 3318, 3319, 7, 78, 2, 2, 3319, 3320,
+	7, 97, 2, 2, 3320, 3321, 7, 68, 2, 2, 3321, 3322, 7, 75, 2, 2, 3322, 3323,
+	7, 73, 2, 2, 3323, 3324, 7, 97, 2, 2, 3324, 3325, 7, 84, 2, 2, 3325, 3326,
+	7, 71, 2, 2, 3326, 3327, 7, 85, 2, 2, 3327, 3328, 7, 87, 2, 2, 3328, 3329,
+	7, 78, 2, 2, 3329, 3330, 7, 86, 2, 2, 3330, 300, 3, 2, 2, 2, 3331, 3332,
+	7, 85, 2, 2, 3332, 3333, 7, 83, 2, 2, 3333, 3334, 7, 78, 2, 2, 3334, 3335,
+	7, 97, 2, 2, 3335, 3336, 7, 69, 2, 2, 3336, 3337, 7, 67, 2, 2, 3337, 3338,
+	7, 78, 2, 2, 3338, 3339, 7, 69, 2, 2, 3339, 3340, 7, 97, 2, 2, 3340, 3341,
+	7, 72, 2, 2, 3341, 3342, 7, 81, 2, 2, 3342, 3343, 7, 87, 2, 2, 3343, 3344,
+	7, 80, 2, 2, 3344, 3345, 7, 70, 2, 2, 3345, 3346, 7, 97, 2, 2, 3346, 3347,
+	7, 84, 2, 2, 3347, 3348, 7, 81, 2, 2, 3348, 3349, 7, 89, 2, 2, 3349, 3350,
+	7, 85, 2, 2, 3350, 302, 3, 2, 2, 2, 3351, 3352, 7, 85, 2, 2, 3352, 3353,
+	7, 83, 2, 2, 3353, 3354, 7, 78, 2, 2, 3354, 3355, 7, 97, 2, 2, 3355, 3356,
+	7, 85, 2, 2, 3356, 3357, 7, 79, 2, 2, 3357, 3358, 7, 67, 2, 2, 3358, 3359,
+	7, 78, 2, 2, 3359, 3360, 7, 78, 2, 2, 3360, 3361, 7, 97, 2, 2, 3361, 3362,
+	7, 84, 2, 2, 3362, 3363, 7, 71, 2, 2, 3363, 3364, 7, 85, 2, 2, 3364, 3365,
+	7, 87, 2, 2, 3365, 3366, 7, 78, 2, 2, 3366, 3367, 7, 86, 2, 2, 3367, 304,
+	3, 2, 2, 2, 3368, 3369, 7, 85, 2, 2, 3369, 3370, 7, 85, 2, 2, 3370, 3371,
+	7, 78, 2, 2, 3371, 306, 3, 2, 2, 2, 3372, 3373, 7, 85, 2, 2, 3373, 3374,
+	7, 86, 2, 2, 3374, 3375, 7, 67, 2, 2, 3375, 3376, 7, 69, 2, 2, 3376, 3377,
+	7, 77, 2, 2, 3377, 3378, 7, 71, 2, 2, 3378, 3379, 7, 70, 2, 2, 3379, 308,
+	3, 2, 2, 2, 3380, 3381, 7, 85, 2, 2, 3381, 3382, 7, 86, 2, 2, 3382, 3383,
+	7, 67, 2, 2, 3383, 3384, 7, 84, 2, 2, 3384, 3385, 7, 86, 2, 2, 3385, 3386,
+	7, 75, 2, 2, 3386, 3387, 7, 80, 2, 2, 3387, 3388, 7, 73, 2, 2, 3388, 310,
+	3, 2, 2, 2, 3389, 3390, 7, 85, 2, 2, 3390, 3391, 7, 86, 2, 2, 3391, 3392,
+	7, 84, 2, 2, 3392, 3393, 7, 67, 2, 2, 3393, 3394, 7, 75, 2, 2, 3394, 3395,
+	7, 73, 2, 2, 3395, 3396, 7, 74, 2, 2, 3396, 3397, 7, 86, 2, 2, 3397, 3398,
+	7, 97, 2, 2, 3398, 3399, 7, 76, 2, 2, 3399, 3400, 7, 81, 2, 2, 3400, 3401,
+	7, 75, 2, 2, 3401, 3402, 7, 80, 2, 2, 3402, 312, 3, 2, 2, 2, 3403, 3404,
+	7, 86, 2, 2, 3404, 3405, 7, 67, 2, 2, 3405, 3406, 7, 68, 2, 2, 3406, 3407,
+	7, 78, 2, 2, 3407, 3408, 7, 71, 2, 2, 3408, 314, 3, 2, 2, 2, 3409, 3410,
+	7, 86, 2, 2, 3410, 3411, 7, 71, 2, 2, 3411, 3412, 7, 84, 2, 2, 3412, 3413,
+	7, 79, 2, 2, 3413, 3414, 7, 75, 2, 2, 3414, 3415, 7, 80, 2, 2, 3415, 3416,
+	7, 67, 2, 2, 3416, 3417, 7, 86, 2, 2, 3417, 3418, 7, 71, 2, 2, 3418, 3419,
+	7, 70, 2, 2, 3419, 316, 3, 2, 2, 2, 3420, 3421, 7, 86, 2, 2, 3421, 3422,
+	7, 74, 2, 2, 3422, 3423, 7, 71, 2, 2, 3423, 3424, 7, 80, 2, 2, 3424, 318,
+	3, 2, 2, 2, 3425, 3426, 7, 86, 2, 2, 3426, 3427, 7, 81, 2, 2, 3427, 320,
+	3, 2, 2, 2, 3428, 3429, 7, 86, 2, 2, 3429, 3430, 7, 84, 2, 2, 3430, 3431,
+	7, 67, 2, 2, 3431, 3432, 7, 75, 2, 2, 3432, 3433, 7, 78, 2, 2, 3433, 3434,
+	7, 75, 2, 2, 3434, 3435, 7, 80, 2, 2, 3435, 3436, 7, 73, 2, 2, 3436, 322,
+	3, 2, 2, 2, 3437, 3438, 7, 86, 2, 2, 3438, 3439, 7, 84, 2, 2, 3439, 3440,
+	7, 75, 2, 2, 3440, 3441, 7, 73, 2, 2, 3441, 3442, 7, 73, 2, 2, 3442, 3443,
+	7, 71, 2, 2, 3443, 3444, 7, 84, 2, 2, 3444, 324, 3, 2, 2, 2, 3445, 3446,
+	7, 86, 2, 2, 3446, 3447, 7, 84, 2, 2, 3447, 3448, 7, 87, 2, 2, 3448, 3449,
+	7, 71, 2, 2, 3449, 326, 3, 2, 2, 2, 3450, 3451, 7, 87, 2, 2, 3451, 3452,
+	7, 80, 2, 2, 3452, 3453, 7, 70, 2, 2, 3453, 3454, 7, 81, 2, 2, 3454, 328,
+	3, 2, 2, 2, 3455, 3456, 7, 87, 2, 2, 3456, 3457, 7, 80, 2, 2, 3457, 3458,
+	7, 75, 2, 2, 3458, 3459, 7, 81, 2, 2, 3459, 3460, 7, 80, 2, 2, 3460, 330,
+	3, 2, 2, 2, 3461, 3462, 7, 87, 2, 2, 3462, 3463, 7, 80, 2, 2, 3463, 3464,
+	7, 75, 2, 2, 3464, 3465, 7, 83, 2, 2, 3465, 3466, 7, 87, 2, 2, 3466, 3467,
+	7, 71, 2, 2, 3467, 332, 3, 2, 2, 2, 3468, 3469, 7, 87, 2, 2, 3469, 3470,
+	7, 80, 2, 2, 3470, 3471, 7, 78, 2, 2, 3471, 3472, 7, 81, 2, 2, 3472, 3473,
+	7, 69, 2, 2, 3473, 3474, 7, 77, 2, 2, 3474, 334, 3, 2, 2, 2, 3475, 3476,
+	7, 87, 2, 2, 3476, 3477, 7, 80, 2, 2, 3477, 3478, 7, 85, 2, 2, 3478, 3479,
+	7, 75, 2, 2, 3479, 3480, 7, 73, 2, 2, 3480, 3481, 7, 80, 2, 2, 3481, 3482,
+	7, 71, 2, 2, 3482, 3483, 7, 70, 2, 2, 3483, 336, 3, 2, 2, 2, 3484, 3485,
+	7, 87, 2, 2, 3485, 3486, 7, 82, 2, 2, 3486, 3487, 7, 70, 2, 2, 3487, 3488,
+	7, 67, 2, 2, 3488, 3489, 7, 86, 2, 2, 3489, 3490, 7, 71, 2, 2, 3490, 338,
+	3, 2, 2, 2, 3491, 3492, 7, 87, 2, 2, 3492, 3493, 7, 85, 2, 2, 3493, 3494,
+	7, 67, 2, 2, 3494, 3495, 7, 73, 2, 2, 3495, 3496, 7, 71, 2, 2, 3496, 340,
+	3, 2, 2, 2, 3497, 3498, 7, 87, 2, 2, 3498, 3499, 7, 85, 2, 2, 3499, 3500,
+	7, 71, 2, 2, 3500, 342, 3, 2, 2, 2, 3501, 3502, 7, 87, 2, 2, 3502, 3503,
+	7, 85, 2, 2, 3503, 3504, 7, 75, 2, 2, 3504, 3505, 7, 80, 2, 2, 3505, 3506,
+	7, 73, 2, 2, 3506, 344, 3, 2, 2, 2, 3507, 3508, 7, 88, 2, 2, 3508, 3509,
+	7, 67, 2, 2, 3509, 3510, 7, 78, 2, 2, 3510, 3511, 7, 87, 2, 2, 3511, 3512,
+	7, 71, 2, 2, 3512, 3513, 7, 85, 2, 2, 3513, 346, 3, 2, 2, 2, 3514, 3515,
+	7, 89, 2, 2, 3515, 3516, 7, 74, 2, 2, 3516, 3517, 7, 71, 2, 2, 3517, 3518,
+	7, 80, 2, 2, 3518, 348, 3, 2, 2, 2, 3519, 3520, 7, 89, 2, 2, 3520, 3521,
+	7, 74, 2, 2, 3521, 3522, 7, 71, 2, 2, 3522, 3523, 7, 84, 2, 2, 3523, 3524,
+	7, 71, 2, 2, 3524, 350, 3, 2, 2, 2, 3525, 3526, 7, 89, 2, 2, 3526, 3527,
+	7, 74, 2, 2, 3527, 3528, 7, 75, 2, 2, 3528, 3529, 7, 78, 2, 2, 3529, 3530,
+	7, 71, 2, 2, 3530, 352, 3, 2, 2, 2, 3531, 3532, 7, 89, 2, 2, 3532, 3533,
+	7, 75, 2, 2, 3533, 3534, 7, 86, 2, 2, 3534, 3535, 7, 74, 2, 2, 3535, 354,
+	3, 2, 2, 2, 3536, 3537, 7, 89, 2, 2, 3537, 3538, 7, 84, 2, 2, 3538, 3539,
+	7, 75, 2, 2, 3539, 3540, 7, 86, 2, 2, 3540, 3541, 7, 71, 2, 2, 3541, 356,
+	3, 2, 2, 2, 3542, 3543, 7, 90, 2, 2, 3543, 3544, 7, 81, 2, 2, 3544, 3545,
+	7, 84, 2, 2, 3545, 358, 3, 2, 2, 2, 3546, 3547, 7, 92, 2, 2, 3547, 3548,
+	7, 71, 2, 2, 3548, 3549, 7, 84, 2, 2, 3549, 3550, 7, 81, 2, 2, 3550, 3551,
+	7, 72, 2, 2, 3551, 3552, 7, 75, 2, 2, 3552, 3553, 7, 78, 2, 2, 3553, 3554,
+	7, 78, 2, 2, 3554, 360, 3, 2, 2, 2, 3555, 3556, 7, 86, 2, 2, 3556, 3557,
+	7, 75, 2, 2, 3557, 3558, 7, 80, 2, 2, 3558, 3559, 7, 91, 2, 2, 3559, 3560,
+	7, 75, 2, 2, 3560, 3561, 7, 80, 2, 2, 3561, 3562, 7, 86, 2, 2, 3562, 362,
+	3, 2, 2, 2, 3563, 3564, 7, 85, 2, 2, 3564, 3565, 7, 79, 2, 2, 3565, 3566,
+	7, 67, 2, 2, 3566, 3567, 7, 78, 2, 2, 3567, 3568, 7, 78, 2, 2, 3568, 3569,
+	7, 75, 2, 2, 3569, 3570, 7, 80, 2, 2, 3570, 3571, 7, 86, 2, 2, 3571, 364,
+	3, 2, 2, 2, 3572, 3573, 7, 79, 2, 2, 3573, 3574, 7, 71, 2, 2, 3574, 3575,
+	7, 70, 2, 2, 3575, 3576, 7, 75, 2, 2, 3576, 3577, 7, 87, 2, 2, 3577, 3578,
+	7, 79, 2, 2, 3578, 3579, 7, 75, 2, 2, 3579, 3580, 7, 80, 2, 2, 3580, 3581,
+	7, 86, 2, 2, 3581, 366, 3, 2, 2, 2, 3582, 3583, 7, 79, 2, 2, 3583, 3584,
+	7, 75, 2, 2, 3584, 3585, 7, 70, 2, 2, 3585, 3586, 7, 70, 2, 2, 3586, 3587,
+	7, 78, 2, 2, 3587, 3588, 7, 71, 2, 2, 3588, 3589, 7, 75, 2, 2, 3589, 3590,
+	7, 80, 2, 2, 3590, 3591, 7, 86, 2, 2, 3591, 368, 3, 2, 2, 2, 3592, 3593,
+	7, 75, 2, 2, 3593, 3594, 7, 80, 2, 2, 3594, 3595, 7, 86, 2, 2, 3595, 370,
+	3, 2, 2, 2, 3596, 3597, 7, 75, 2, 2, 3597, 3598, 7, 80, 2, 2, 3598, 3599,
+	7, 86, 2, 2, 3599, 3600, 7, 51, 2, 2, 3600, 372, 3, 2, 2, 2, 3601, 3602,
+	7, 75, 2, 2, 3602, 3603, 7, 80, 2, 2, 3603, 3604, 7, 86, 2, 2, 3604, 3605,
+	7, 52, 2, 2, 3605, 374, 3, 2, 2, 2, 3606, 3607, 7, 75, 2, 2, 3607, 3608,
+	7, 80, 2, 2, 3608, 3609, 7, 86, 2, 2, 3609, 3610, 7, 53, 2, 2, 3610, 376,
+	3, 2, 2, 2, 3611, 3612, 7, 75, 2, 2, 3612, 3613, 7, 80, 2, 2, 3613, 3614,
+	7, 86, 2, 2, 3614, 3615, 7, 54, 2, 2, 3615, 378, 3, 2, 2, 2, 3616, 3617,
+	7, 75, 2, 2, 3617, 3618, 7, 80, 2, 2, 3618, 3619, 7, 86, 2, 2, 3619, 3620,
+	7, 58, 2, 2, 3620, 380, 3, 2, 2, 2, 3621, 3622, 7, 75, 2, 2, 3622, 3623,
+	7, 80, 2, 2, 3623, 3624, 7, 86, 2, 2, 3624, 3625, 7, 71, 2, 2, 3625, 3626,
+	7, 73, 2, 2, 3626, 3627, 7, 71, 2, 2, 3627, 3628, 7, 84, 2, 2, 3628, 382,
+	3, 2, 2, 2, 3629, 3630, 7, 68, 2, 2, 3630, 3631, 7, 75, 2, 2, 3631, 3632,
+	7, 73, 2, 2, 3632, 3633, 7, 75, 2, 2, 3633, 3634, 7, 80, 2, 2, 3634, 3635,
+	7, 86, 2, 2, 3635, 384, 3, 2, 2, 2, 3636, 3637, 7, 84, 2, 2, 3637, 3638,
+	7, 71, 2, 2, 3638, 3639, 7, 67, 2, 2, 3639, 3640, 7, 78, 2, 2, 3640, 386,
+	3, 2, 2, 2, 3641, 3642, 7, 70, 2, 2, 3642, 3643, 7, 81, 2, 2, 3643, 3644,
+	7, 87, 2, 2, 3644, 3645, 7, 68, 2, 2, 3645, 3646, 7, 78, 2, 2, 3646, 3647,
+	7, 71, 2, 2, 3647, 388, 3, 2, 2, 2, 3648, 3649, 7, 82, 2, 2, 3649, 3650,
+	7, 84, 2, 2, 3650, 3651, 7, 71, 2, 2, 3651, 3652, 7, 69, 2, 2, 3652, 3653,
+	7, 75, 2, 2, 3653, 3654, 7, 85, 2, 2, 3654, 3655, 7, 75, 2, 2, 3655, 3656,
+	7, 81, 2, 2, 3656, 3657, 7, 80, 2, 2, 3657, 390, 3, 2, 2, 2, 3658, 3659,
+	7, 72, 2, 2, 3659, 3660, 7, 78, 2, 2, 3660, 3661, 7, 81, 2, 2, 3661, 3662,
+	7, 67, 2, 2, 3662, 3663, 7, 86, 2, 2, 3663, 392, 3, 2, 2, 2, 3664, 3665,
+	7, 72, 2, 2, 3665, 3666, 7, 78, 2, 2, 3666, 3667, 7, 81, 2, 2, 3667, 3668,
+	7, 67, 2, 2, 3668, 3669, 7, 86, 2, 2, 3669, 3670, 7, 54, 2, 2, 3670, 394,
+	3, 2, 2, 2, 3671, 3672, 7, 72, 2, 2, 3672, 3673, 7, 78, 2, 2, 3673, 3674,
+	7, 81, 2, 2, 3674, 3675, 7, 67, 2, 2, 3675, 3676, 7, 86, 2, 2, 3676, 3677,
+	7, 58, 2, 2, 3677, 396, 3, 2, 2, 2, 3678, 3679, 7, 70, 2, 2, 3679, 3680,
+	7, 71, 2, 2, 3680, 3681, 7, 69, 2, 2, 3681, 3682, 7, 75, 2, 2, 3682, 3683,
+	7, 79, 2, 2, 3683, 3684, 7, 67, 2, 2, 3684, 3685, 7, 78, 2, 2, 3685, 398,
+	3, 2, 2, 2, 3686, 3687, 7, 70, 2, 2, 3687, 3688, 7, 71, 2, 2, 3688, 3689,
+	7, 69, 2, 2, 3689, 400, 3, 2, 2, 2, 3690, 3691, 7, 80, 2, 2, 3691, 3692,
+	7, 87, 2, 2, 3692, 3693, 7, 79, 2, 2, 3693, 3694, 7, 71, 2, 2, 3694, 3695,
+	7, 84, 2, 2, 3695, 3696, 7, 75, 2, 2, 3696, 3697, 7, 69, 2, 2, 3697, 402,
+	3, 2, 2, 2, 3698, 3699, 7, 70, 2, 2, 3699, 3700, 7, 67, 2, 2, 3700, 3701,
+	7, 86, 2, 2, 3701, 3702, 7, 71, 2, 2, 3702, 404, 3, 2, 2, 2, 3703, 3704,
+	7, 86, 2, 2, 3704, 3705, 7, 75, 2, 2, 3705, 3706, 7, 79, 2, 2, 3706, 3707,
+	7, 71, 2, 2, 3707, 406, 3, 2, 2, 2, 3708, 3709, 7, 86, 2, 2, 3709, 3710,
+	7, 75, 2, 2, 3710, 3711, 7, 79, 2, 2, 3711, 3712, 7, 71, 2, 2, 3712, 3713,
+	7, 85, 2, 2, 3713, 3714, 7, 86, 2, 2, 3714, 3715, 7, 67, 2, 2, 3715, 3716,
+	7, 79, 2, 2, 3716, 3717, 7, 82, 2, 2, 3717, 408, 3, 2, 2, 2, 3718, 3719,
+	7, 70, 2, 2, 3719, 3720, 7, 67, 2, 2, 3720, 3721, 7, 86, 2, 2, 3721, 3722,
+	7, 71, 2, 2, 3722, 3723, 7, 86, 2, 2, 3723, 3724, 7, 75, 2, 2, 3724, 3725,
+	7, 79, 2, 2, 3725, 3726, 7, 71, 2, 2, 3726, 410, 3, 2, 2, 2, 3727, 3728,
+	7, 91, 2, 2, 3728, 3729, 7, 71, 2, 2, 3729, 3730, 7, 67, 2, 2, 3730, 3731,
+	7, 84, 2, 2, 3731, 412, 3, 2, 2, 2, 3732, 3733, 7, 69, 2, 2, 3733, 3734,
+	7, 74, 2, 2, 3734, 3735, 7, 67, 2, 2, 3735, 3736, 7, 84, 2, 2, 3736, 414,
+	3, 2, 2, 2, 3737, 3738, 7, 88, 2, 2, 3738, 3739, 7, 67, 2, 2, 3739, 3740,
+	7, 84, 2, 2, 3740, 3741, 7, 69, 2, 2, 3741, 3742, 7, 74, 2, 2, 3742, 3743,
+	7, 67, 2, 2, 3743, 3744, 7, 84, 2, 2, 3744, 416, 3, 2, 2, 2, 3745, 3746,
+	7, 80, 2, 2, 3746, 3747, 7, 88, 2, 2, 3747, 3748, 7, 67, 2, 2, 3748, 3749,
+	7, 84, 2, 2, 3749, 3750, 7, 69, 2, 2, 3750, 3751, 7, 74, 2, 2, 3751, 3752,
+	7, 67, 2, 2, 3752, 3753, 7, 84, 2, 2, 3753, 418, 3, 2, 2, 2, 3754, 3755,
+	7, 80, 2, 2, 3755, 3756, 7, 67, 2, 2, 3756, 3757, 7, 86, 2, 2, 3757, 3758,
+	7, 75, 2, 2, 3758, 3759, 7, 81, 2, 2, 3759, 3760, 7, 80, 2, 2, 3760, 3761,
+	7, 67, 2, 2, 3761, 3762, 7, 78, 2, 2, 3762, 420, 3, 2, 2, 2, 3763, 3764,
+	7, 68, 2, 2, 3764, 3765, 7, 75, 2, 2, 3765, 3766, 7, 80, 2, 2, 3766, 3767,
+	7, 67, 2, 2, 3767, 3768, 7, 84, 2, 2, 3768, 3769, 7, 91, 2, 2, 3769, 422,
+	3, 2, 2, 2, 3770, 3771, 7, 88, 2, 2, 3771, 3772, 7, 67, 2, 2, 3772, 3773,
+	7, 84, 2, 2, 3773, 3774, 7, 68, 2, 2, 3774, 3775, 7, 75, 2, 2, 3775, 3776,
+	7, 80, 2, 2, 3776, 3777, 7, 67, 2, 2, 3777, 3778, 7, 84, 2, 2, 3778, 3779,
+	7, 91, 2, 2, 3779, 424, 3, 2, 2, 2, 3780, 3781, 7, 86, 2, 2, 3781, 3782,
+	7, 75, 2, 2, 3782, 3783, 7, 80, 2, 2, 3783, 3784, 7, 91, 2, 2, 3784, 3785,
+	7, 68, 2, 2, 3785, 3786, 7, 78, 2, 2, 3786, 3787, 7, 81, 2, 2, 3787, 3788,
+	7, 68, 2, 2, 3788, 426, 3, 2, 2, 2, 3789, 3790, 7, 68, 2, 2, 3790, 3791,
+	7, 78, 2, 2, 3791, 3792, 7, 81, 2, 2, 3792, 3793, 7, 68, 2, 2, 3793, 428,
+	3, 2, 2, 2, 3794, 3795, 7, 79, 2, 2, 3795, 3796, 7, 71, 2, 2, 3796, 3797,
+	7, 70, 2, 2, 3797, 3798, 7, 75, 2, 2, 3798, 3799, 7, 87, 2, 2, 3799, 3800,
+	7, 79, 2, 2, 3800, 3801, 7, 68, 2, 2, 3801, 3802, 7, 78, 2, 2, 3802, 3803,
+	7, 81, 2, 2, 3803, 3804, 7, 68, 2, 2, 3804, 430, 3, 2, 2, 2, 3805, 3806,
+	7, 78, 2, 2, 3806, 3807, 7, 81, 2, 2, 3807, 3808, 7, 80, 2, 2, 3808, 3809,
+	7, 73, 2, 2, 3809, 432, 3, 2, 2, 2, 3810, 3811, 7, 78, 2, 2, 3811, 3812,
+	7, 81, 2, 2, 3812, 3813, 7, 80, 2, 2, 3813, 3814, 7, 73, 2, 2, 3814, 3815,
+	7, 68, 2, 2, 3815, 3816, 7, 78, 2, 2, 3816, 3817, 7, 81, 2, 2, 3817, 3818,
+	7, 68, 2, 2, 3818, 434, 3, 2, 2, 2, 3819, 3820, 7, 86, 2, 2, 3820, 3821,
+	7, 75, 2, 2, 3821, 3822, 7, 80, 2, 2, 3822, 3823, 7, 91, 2, 2, 3823, 3824,
+	7, 86, 2, 2, 3824, 3825, 7, 71, 2, 2, 3825, 3826, 7, 90, 2, 2, 3826, 3827,
+	7, 86, 2, 2, 3827, 436, 3, 2, 2, 2, 3828, 3829, 7, 86, 2, 2, 3829, 3830,
+	7, 71, 2, 2, 3830, 3831, 7, 90, 2, 2, 3831, 3832, 7, 86, 2, 2, 3832, 438,
+	3, 2, 2, 2, 3833, 3834, 7, 79, 2, 2, 3834, 3835, 7, 71, 2, 2, 3835, 3836,
+	7, 70, 2, 2, 3836, 3837, 7, 75, 2, 2, 3837, 3838, 7, 87, 2, 2, 3838, 3839,
+	7, 79, 2, 2, 3839, 3840, 7, 86, 2, 2, 3840, 3841, 7, 71, 2, 2, 3841, 3842,
+	7, 90, 2, 2, 3842, 3843, 7, 86, 2, 2, 3843, 440, 3, 2, 2, 2, 3844, 3845,
+	7, 78, 2, 2, 3845, 3846, 7, 81, 2, 2, 3846, 3847, 7, 80, 2, 2, 3847, 3848,
+	7, 73, 2, 2, 3848, 3849, 7, 86, 2, 2, 3849, 3850, 7, 71, 2, 2, 3850, 3851,
+	7, 90, 2, 2, 3851, 3852, 7, 86, 2, 2, 3852, 442, 3, 2, 2, 2, 3853, 3854,
+	7, 71, 2, 2, 3854, 3855, 7, 80, 2, 2, 3855, 3856, 7, 87, 2, 2, 3856, 3857,
+	7, 79, 2, 2, 3857, 444, 3, 2, 2, 2, 3858, 3859, 7, 88, 2, 2, 3859, 3860,
+	7, 67, 2, 2, 3860, 3861, 7, 84, 2, 2, 3861, 3862, 7, 91, 2, 2, 3862, 3863,
+	7, 75, 2, 2, 3863, 3864, 7, 80, 2, 2, 3864, 3865, 7, 73, 2, 2, 3865, 446,
+	3, 2, 2, 2, 3866, 3867, 7, 85, 2, 2, 3867, 3868, 7, 71, 2, 2, 3868, 3869,
+	7, 84, 2, 2, 3869, 3870, 7, 75, 2, 2, 3870, 3871, 7, 67, 2, 2, 3871, 3872,
+	7, 78, 2, 2, 3872, 448, 3, 2, 2, 2, 3873, 3874, 7, 91, 2, 2, 3874, 3875,
+	7, 71, 2, 2, 3875, 3876, 7, 67, 2, 2, 3876, 3877, 7, 84, 2, 2, 3877, 3878,
+	7, 97, 2, 2, 3878, 3879, 7, 79, 2, 2, 3879, 3880, 7, 81, 2, 2, 3880, 3881,
+	7, 80, 2, 2, 3881, 3882, 7, 86, 2, 2, 3882, 3883, 7, 74, 2, 2, 3883, 450,
+	3, 2, 2, 2, 3884, 3885, 7, 70, 2, 2, 3885, 3886, 7, 67, 2, 2, 3886, 3887,
+	7, 91, 2, 2, 3887, 3888, 7, 97, 2, 2, 3888, 3889, 7, 74, 2, 2, 3889, 3890,
+	7, 81, 2, 2, 3890, 3891, 7, 87, 2, 2, 3891, 3892, 7, 84, 2, 2, 3892, 452,
+	3, 2, 2, 2, 3893, 3894, 7, 70, 2, 2, 3894, 3895, 7, 67, 2, 2, 3895, 3896,
+	7, 91, 2, 2, 3896, 3897, 7, 97, 2, 2, 3897, 3898, 7, 79, 2, 2, 3898, 3899,
+	7, 75, 2, 2, 3899, 3900, 7, 80, 2, 2, 3900, 3901, 7, 87, 2, 2, 3901, 3902,
+	7, 86, 2, 2, 3902, 3903, 7, 71, 2, 2, 3903, 454, 3, 2, 2, 2, 3904, 3905,
+	7, 70, 2, 2, 3905, 3906, 7, 67, 2, 2, 3906, 3907, 7, 91, 2, 2, 3907, 3908,
+	7, 97, 2, 2, 3908, 3909, 7, 85, 2, 2, 3909, 3910, 7, 71, 2, 2, 3910, 3911,
+	7, 69, 2, 2, 3911, 3912, 7, 81, 2, 2, 3912, 3913, 7, 80, 2, 2, 3913, 3914,
+	7, 70, 2, 2, 3914, 456, 3, 2, 2, 2, 3915, 3916, 7, 74, 2, 2, 3916, 3917,
+	7, 81, 2, 2, 3917, 3918, 7, 87, 2, 2, 3918, 3919, 7, 84, 2, 2, 3919, 3920,
+	7, 97, 2, 2, 3920, 3921, 7, 79, 2, 2, 3921, 3922, 7, 75, 2, 2, 3922, 3923,
+	7, 80, 2, 2, 3923, 3924, 7, 87, 2, 2, 3924, 3925, 7, 86, 2, 2, 3925, 3926,
+	7, 71, 2, 2, 3926, 458, 3, 2, 2, 2, 3927, 3928, 7, 74, 2, 2, 3928, 3929,
+	7, 81, 2, 2, 3929, 3930, 7, 87, 2, 2, 3930, 3931, 7, 84, 2, 2, 3931, 3932,
+	7, 97, 2, 2, 3932, 3933, 7, 85, 2, 2, 3933, 3934, 7, 71, 2, 2, 3934, 3935,
+	7, 69, 2, 2, 3935, 3936, 7, 81, 2, 2, 3936, 3937, 7, 80, 2, 2, 3937, 3938,
+	7, 70, 2, 2, 3938, 460, 3, 2, 2, 2, 3939, 3940, 7, 79, 2, 2, 3940, 3941,
+	7, 75, 2, 2, 3941, 3942, 7, 80, 2, 2, 3942, 3943, 7, 87, 2, 2, 3943, 3944,
+	7, 86, 2, 2, 3944, 3945, 7, 71, 2, 2, 3945, 3946, 7, 97, 2, 2, 3946, 3947,
+	7, 85, 2, 2, 3947, 3948, 7, 71, 2, 2, 3948, 3949, 7, 69, 2, 2, 3949, 3950,
+	7, 81, 2, 2, 3950, 3951, 7, 80, 2, 2, 3951, 3952, 7, 70, 2, 2, 3952, 462,
+	3, 2, 2, 2, 3953, 3954, 7, 85, 2, 2, 3954, 3955, 7, 71, 2, 2, 3955, 3956,
+	7, 69, 2, 2, 3956, 3957, 7, 81, 2, 2, 3957, 3958, 7, 80, 2, 2, 3958, 3959,
+	7, 70, 2, 2, 3959, 3960, 7, 97, 2, 2, 3960, 3961, 7, 79, 2, 2, 3961, 3962,
+	7, 75, 2, 2, 3962, 3963, 7, 69, 2, 2, 3963, 3964, 7, 84, 2, 2, 3964, 3965,
+	7, 81, 2, 2, 3965, 3966, 7, 85, 2, 2, 3966, 3967, 7, 71, 2, 2, 3967, 3968,
+	7, 69, 2, 2, 3968, 3969, 7, 81, 2, 2, 3969, 3970, 7, 80, 2, 2, 3970, 3971,
+	7, 70, 2, 2, 3971, 464, 3, 2, 2, 2, 3972, 3973, 7, 79, 2, 2, 3973, 3974,
+	7, 75, 2, 2, 3974, 3975, 7, 80, 2, 2, 3975, 3976, 7, 87, 2, 2, 3976, 3977,
+	7, 86, 2, 2, 3977, 3978, 7, 71, 2, 2, 3978, 3979, 7, 97, 2, 2, 3979, 3980,
+	7, 79, 2, 2, 3980, 3981, 7, 75, 2, 2, 3981, 3982, 7, 69, 2, 2, 3982, 3983,
+	7, 84, 2, 2, 3983, 3984, 7, 81, 2, 2, 3984, 3985, 7, 85, 2, 2, 3985, 3986,
+	7, 71, 2, 2, 3986, 3987, 7, 69, 2, 2, 3987, 3988, 7, 81, 2, 2, 3988, 3989,
+	7, 80, 2, 2, 3989, 3990, 7, 70, 2, 2, 3990, 466, 3, 2, 2, 2, 3991, 3992,
+	7, 74, 2, 2, 3992, 3993, 7, 81, 2, 2, 3993, 3994, 7, 87, 2, 2, 3994, 3995,
+	7, 84, 2, 2, 3995, 3996, 7, 97, 2, 2, 3996, 3997, 7, 79, 2, 2, 3997, 3998,
+	7, 75, 2, 2, 3998, 3999, 7, 69, 2, 2, 3999, 4000, 7, 84, 2, 2, 4000, 4001,
+	7, 81, 2, 2, 4001, 4002, 7, 85, 2, 2, 4002, 4003, 7, 71, 2, 2, 4003, 4004,
+	7, 69, 2, 2, 4004, 4005, 7, 81, 2, 2, 4005, 4006, 7, 80, 2, 2, 4006, 4007,
+	7, 70, 2, 2, 4007, 468, 3, 2, 2, 2, 4008, 4009, 7, 70, 2, 2, 4009, 4010,
+	7, 67, 2, 2, 4010, 4011, 7, 91, 2, 2, 4011, 4012, 7, 97, 2, 2, 4012, 4013,
+	7, 79, 2, 2, 4013, 4014, 7, 75, 2, 2, 4014, 4015, 7, 69, 2, 2, 4015, 4016,
+	7, 84, 2, 2, 4016, 4017, 7, 81, 2, 2, 4017, 4018, 7, 85, 2, 2, 4018, 4019,
+	7, 71, 2, 2, 4019, 4020, 7, 69, 2, 2, 4020, 4021, 7, 81, 2, 2, 4021, 4022,
+	7, 80, 2, 2, 4022, 4023, 7, 70, 2, 2, 4023, 470, 3, 2, 2, 2, 4024, 4025,
+	7, 76, 2, 2, 4025, 4026, 7, 85, 2, 2, 4026, 4027, 7, 81, 2, 2, 4027, 4028,
+	7, 80, 2, 2, 4028, 4029, 7, 97, 2, 2, 4029, 4030, 7, 67, 2, 2, 4030, 4031,
+	7, 84, 2, 2, 4031, 4032, 7, 84, 2, 2, 4032, 4033, 7, 67, 2, 2, 4033, 4034,
+	7, 91, 2, 2, 4034, 472, 3, 2, 2, 2, 4035, 4036, 7, 76, 2, 2, 4036, 4037,
+	7, 85, 2, 2, 4037, 4038, 7, 81, 2, 2, 4038, 4039, 7, 80, 2, 2, 4039, 4040,
+	7, 97, 2, 2, 4040, 4041, 7, 81, 2, 2, 4041, 4042, 7, 68, 2, 2, 4042, 4043,
+	7, 76, 2, 2, 4043, 4044, 7, 71, 2, 2, 4044, 4045, 7, 69, 2, 2, 4045, 4046,
+	7, 86, 2, 2, 4046, 474, 3, 2, 2, 2, 4047, 4048, 7, 76, 2, 2, 4048, 4049,
+	7, 85, 2, 2, 4049, 4050, 7, 81, 2, 2, 4050, 4051, 7, 80, 2, 2, 4051, 4052,
+	7, 97, 2, 2, 4052, 4053, 7, 83, 2, 2, 4053, 4054, 7, 87, 2, 2, 4054, 4055,
+	7, 81, 2, 2, 4055, 4056, 7, 86, 2, 2, 4056, 4057, 7, 71, 2, 2, 4057, 476,
+	3, 2, 2, 2, 4058, 4059, 7, 76, 2, 2, 4059, 4060, 7, 85, 2, 2, 4060, 4061,
+	7, 81, 2, 2, 4061, 4062, 7, 80, 2, 2, 4062, 4063, 7, 97, 2, 2, 4063, 4064,
+	7, 69, 2, 2, 4064, 4065, 7, 81, 2, 2, 4065, 4066, 7, 80, 2, 2, 4066, 4067,
+	7, 86, 2, 2, 4067, 4068, 7, 67, 2, 2, 4068, 4069, 7, 75, 2, 2, 4069, 4070,
+	7, 80, 2, 2, 4070, 4071, 7, 85, 2, 2, 4071, 478, 3, 2, 2, 2, 4072, 4073,
+	7, 76, 2, 2, 4073, 4074, 7, 85, 2, 2, 4074, 4075, 7, 81, 2, 2, 4075, 4076,
+	7, 80, 2, 2, 4076, 4077, 7, 97, 2, 2, 4077, 4078, 7, 69, 2, 2, 4078, 4079,
+	7, 81, 2, 2, 4079, 4080, 7, 80, 2, 2, 4080, 4081, 7, 86, 2, 2, 4081, 4082,
+	7, 67, 2, 2, 4082, 4083, 7, 75, 2, 2, 4083, 4084, 7, 80, 2, 2, 4084, 4085,
+	7, 85, 2, 2, 4085, 4086, 7, 97, 2, 2, 4086, 4087, 7, 82, 2, 2, 4087, 4088,
+	7, 67, 2, 2, 4088, 4089, 7, 86, 2, 2, 4089, 4090, 7, 74, 2, 2, 4090, 480,
+	3, 2, 2, 2, 4091, 4092, 7, 76, 2, 2, 4092, 4093, 7, 85, 2, 2, 4093, 4094,
+	7, 81, 2, 2, 4094, 4095, 7, 80, 2, 2, 4095, 4096, 7, 97, 2, 2, 4096, 4097,
+	7, 71, 2, 2, 4097, 4098, 7, 90, 2, 2, 4098, 4099, 7, 86, 2, 2, 4099, 4100,
+	7, 84, 2, 2, 4100, 4101, 7, 67, 2, 2, 4101, 4102, 7, 69, 2, 2, 4102, 4103,
+	7, 86, 2, 2, 4103, 482, 3, 2, 2, 2, 4104, 4105, 7, 76, 2, 2, 4105, 4106,
+	7, 85, 2, 2, 4106, 4107, 7, 81, 2, 2, 4107, 4108, 7, 80, 2, 2, 4108, 4109,
+	7, 97, 2, 2, 4109, 4110, 7, 77, 2, 2, 4110, 4111, 7, 71, 2, 2, 4111, 4112,
+	7, 91, 2, 2, 4112, 4113, 7, 85, 2, 2, 4113, 484, 3, 2, 2, 2, 4114, 4115,
+	7, 76, 2, 2, 4115, 4116, 7, 85, 2, 2, 4116, 4117, 7, 81, 2, 2, 4117, 4118,
+	7, 80, 2, 2, 4118, 4119, 7, 97, 2, 2, 4119, 4120, 7, 81, 2, 2, 4120, 4121,
+	7, 88, 2, 2, 4121, 4122, 7, 71, 2, 2, 4122, 4123, 7, 84, 2, 2, 4123, 4124,
+	7, 78, 2, 2, 4124, 4125, 7, 67, 2, 2, 4125, 4126, 7, 82, 2, 2, 4126, 4127,
+	7, 85, 2, 2, 4127, 486, 3, 2, 2, 2, 4128, 4129, 7, 76, 2, 2, 4129, 4130,
+	7, 85, 2, 2, 4130, 4131, 7, 81, 2, 2, 4131, 4132, 7, 80, 2, 2, 4132, 4133,
+	7, 97, 2, 2, 4133, 4134, 7, 85, 2, 2, 4134, 4135, 7, 71, 2, 2, 4135, 4136,
+	7, 67, 2, 2, 4136, 4137, 7, 84, 2, 2, 4137, 4138, 7, 69, 2, 2, 4138, 4139,
+	7, 74, 2, 2, 4139, 488, 3, 2, 2, 2, 4140, 4141, 7, 76, 2, 2, 4141, 4142,
+	7, 85, 2, 2, 4142, 4143, 7, 81, 2, 2, 4143, 4144, 7, 80, 2, 2, 4144, 4145,
+	7, 97, 2, 2, 4145, 4146, 7, 88, 2, 2, 4146, 4147, 7, 67, 2, 2, 4147, 4148,
+	7, 78, 2, 2, 4148, 4149, 7, 87, 2, 2, 4149, 4150, 7, 71, 2, 2, 4150, 490,
+	3, 2, 2, 2, 4151, 4152, 7, 76, 2, 2, 4152, 4153, 7, 85, 2, 2, 4153, 4154,
+	7, 81, 2, 2, 4154, 4155, 7, 80, 2, 2, 4155, 4156, 7, 97, 2, 2, 4156, 4157,
+	7, 67, 2, 2, 4157, 4158, 7, 84, 2, 2, 4158, 4159, 7, 84, 2, 2, 4159, 4160,
+	7, 67, 2, 2, 4160, 4161, 7, 91, 2, 2, 4161, 4162, 7, 97, 2, 2, 4162, 4163,
+	7, 67, 2, 2, 4163, 4164, 7, 82, 2, 2, 4164, 4165, 7, 82, 2, 2, 4165, 4166,
+	7, 71, 2, 2, 4166, 4167, 7, 80, 2, 2, 4167, 4168, 7, 70, 2, 2, 4168, 492,
+	3, 2, 2, 2, 4169, 4170, 7, 76, 2, 2, 4170, 4171, 7, 85, 2, 2, 4171, 4172,
+	7, 81, 2, 2, 4172, 4173, 7, 80, 2, 2, 4173, 4174, 7, 97, 2, 2, 4174, 4175,
+	7, 67, 2, 2, 4175, 4176, 7, 84, 2, 2, 4176, 4177, 7, 84, 2, 2, 4177, 4178,
+	7, 67, 2, 2, 4178, 4179, 7, 91, 2, 2, 4179, 4180, 7, 97, 2, 2, 4180, 4181,
+	7, 75, 2, 2, 4181, 4182, 7, 80, 2, 2, 4182, 4183, 7, 85, 2, 2, 4183, 4184,
+	7, 71, 2, 2, 4184, 4185, 7, 84, 2, 2, 4185, 4186, 7, 86, 2, 2, 4186, 494,
+	3, 2, 2, 2, 4187, 4188, 7, 76, 2, 2, 4188, 4189, 7, 85, 2, 2, 4189, 4190,
+	7, 81, 2, 2, 4190, 4191, 7, 80, 2, 2, 4191, 4192, 7, 97, 2, 2, 4192, 4193,
+	7, 75, 2, 2, 4193, 4194, 7, 80, 2, 2, 4194, 4195, 7, 85, 2, 2, 4195, 4196,
+	7, 71, 2, 2, 4196, 4197, 7, 84, 2, 2, 4197, 4198, 7, 86, 2, 2, 4198, 496,
+	3, 2, 2, 2, 4199, 4200, 7, 76, 2, 2, 4200, 4201, 7, 85, 2, 2, 4201, 4202,
+	7, 81, 2, 2, 4202, 4203, 7, 80, 2, 2, 4203, 4204, 7, 97, 2, 2, 4204, 4205,
+	7, 79, 2, 2, 4205, 4206, 7, 71, 2, 2, 4206, 4207, 7, 84, 2, 2, 4207, 4208,
+	7, 73, 2, 2, 4208, 4209, 7, 71, 2, 2, 4209, 498, 3, 2, 2, 2, 4210, 4211,
+	7, 76, 2, 2, 4211, 4212, 7, 85, 2, 2, 4212, 4213, 7, 81, 2, 2, 4213, 4214,
+	7, 80, 2, 2, 4214, 4215, 7, 97, 2, 2, 4215, 4216, 7, 79, 2, 2, 4216, 4217,
+	7, 71, 2, 2, 4217, 4218, 7, 84, 2, 2, 4218, 4219, 7, 73, 2, 2, 4219, 4220,
+	7, 71, 2, 2, 4220, 4221, 7, 97, 2, 2, 4221, 4222, 7, 82, 2, 2, 4222, 4223,
+	7, 67, 2, 2, 4223, 4224, 7, 86, 2, 2, 4224, 4225, 7, 69, 2, 2, 4225, 4226,
+	7, 74, 2, 2, 4226, 500, 3, 2, 2, 2, 4227, 4228, 7, 76, 2, 2, 4228, 4229,
+	7, 85, 2, 2, 4229, 4230, 7, 81, 2, 2, 4230, 4231, 7, 80, 2, 2, 4231, 4232,
+	7, 97, 2, 2, 4232, 4233, 7, 79, 2, 2, 4233, 4234, 7, 71, 2, 2, 4234, 4235,
+	7, 84, 2, 2, 4235, 4236, 7, 73, 2, 2, 4236, 4237, 7, 71, 2, 2, 4237, 4238,
+	7, 97, 2, 2, 4238, 4239, 7, 82, 2, 2, 4239, 4240, 7, 84, 2, 2, 4240, 4241,
+	7, 71, 2, 2, 4241, 4242, 7, 85, 2, 2, 4242, 4243, 7, 71, 2, 2, 4243, 4244,
+	7, 84, 2, 2, 4244, 4245, 7, 88, 2, 2, 4245, 4246, 7, 71, 2, 2, 4246, 502,
+	3, 2, 2, 2, 4247, 4248, 7, 76, 2, 2, 4248, 4249, 7, 85, 2, 2, 4249, 4250,
+	7, 81, 2, 2, 4250, 4251, 7, 80, 2, 2, 4251, 4252, 7, 97, 2, 2, 4252, 4253,
+	7, 84, 2, 2, 4253, 4254, 7, 71, 2, 2, 4254, 4255, 7, 79, 2, 2, 4255, 4256,
+	7, 81, 2, 2, 4256, 4257, 7, 88, 2, 2, 4257, 4258, 7, 71, 2, 2, 4258, 504,
+	3, 2, 2, 2, 4259, 4260, 7, 76, 2, 2, 4260, 4261, 7, 85, 2, 2, 4261, 4262,
+	7, 81, 2, 2, 4262, 4263, 7, 80, 2, 2, 4263, 4264, 7, 97, 2, 2, 4264, 4265,
+	7, 84, 2, 2, 4265, 4266, 7, 71, 2, 2, 4266, 4267, 7, 82, 2, 2, 4267, 4268,
+	7, 78, 2, 2, 4268, 4269, 7, 67, 2, 2, 4269, 4270, 7, 69, 2, 2, 4270, 4271,
+	7, 71, 2, 2, 4271, 506, 3, 2, 2, 2, 4272, 4273, 7, 76, 2, 2, 4273, 4274,
+	7, 85, 2, 2, 4274, 4275, 7, 81, 2, 2, 4275, 4276, 7, 80, 2, 2, 4276, 4277,
+	7, 97, 2, 2, 4277, 4278, 7, 85, 2, 2, 4278, 4279, 7, 71, 2, 2, 4279, 4280,
+	7, 86, 2, 2, 4280, 508, 3, 2, 2, 2, 4281, 4282, 7, 76, 2, 2, 4282, 4283,
+	7, 85, 2, 2, 4283, 4284, 7, 81, 2, 2, 4284, 4285, 7, 80, 2, 2, 4285, 4286,
+	7, 97, 2, 2, 4286, 4287, 7, 87, 2, 2, 4287, 4288, 7, 80, 2, 2, 4288, 4289,
+	7, 83, 2, 2, 4289, 4290, 7, 87, 2, 2, 4290, 4291, 7, 81, 2, 2, 4291, 4292,
+	7, 86, 2, 2, 4292, 4293, 7, 71, 2, 2, 4293, 510, 3, 2, 2, 2, 4294, 4295,
+	7, 76, 2, 2, 4295, 4296, 7, 85, 2, 2, 4296, 4297, 7, 81, 2, 2, 4297, 4298,
+	7, 80, 2, 2, 4298, 4299, 7, 97, 2, 2, 4299, 4300, 7, 70, 2, 2, 4300, 4301,
+	7, 71, 2, 2, 4301, 4302, 7, 82, 2, 2, 4302, 4303, 7, 86, 2, 2, 4303, 4304,
+	7, 74, 2, 2, 4304, 512, 3, 2, 2, 2, 4305, 4306, 7, 76, 2, 2, 4306, 4307,
+	7, 85, 2, 2, 4307, 4308, 7, 81, 2, 2, 4308, 4309, 7, 80, 2, 2, 4309, 4310,
+	7, 97, 2, 2, 4310, 4311, 7, 78, 2, 2, 4311, 4312, 7, 71, 2, 2, 4312, 4313,
+	7, 80, 2, 2, 4313, 4314, 7, 73, 2, 2, 4314, 4315, 7, 86, 2, 2, 4315, 4316,
+	7, 74, 2, 2, 4316, 514, 3, 2, 2, 2, 4317, 4318, 7, 76, 2, 2, 4318, 4319,
+	7, 85, 2, 2, 4319, 4320, 7, 81, 2, 2, 4320, 4321, 7, 80, 2, 2, 4321, 4322,
+	7, 97, 2, 2, 4322, 4323, 7, 86, 2, 2, 4323, 4324, 7, 91, 2, 2, 4324, 4325,
+	7, 82, 2, 2, 4325, 4326, 7, 71, 2, 2, 4326, 516, 3, 2, 2, 2, 4327, 4328,
+	7, 76, 2, 2, 4328, 4329, 7, 85, 2, 2, 4329, 4330, 7, 81, 2, 2, 4330, 4331,
+	7, 80, 2, 2, 4331, 4332, 7, 97, 2, 2, 4332, 4333, 7, 88, 2, 2, 4333, 4334,
+	7, 67, 2, 2, 4334, 4335, 7, 78, 2, 2, 4335, 4336, 7, 75, 2, 2, 4336, 4337,
+	7, 70, 2, 2, 4337, 518, 3, 2, 2, 2, 4338, 4339, 7, 76, 2, 2, 4339, 4340,
+	7, 85, 2, 2, 4340, 4341, 7, 81, 2, 2, 4341, 4342, 7, 80, 2, 2, 4342, 4343,
+	7, 97, 2, 2, 4343, 4344, 7, 86, 2, 2, 4344, 4345, 7, 67, 2, 2, 4345, 4346,
+	7, 68, 2, 2, 4346, 4347, 7, 78, 2, 2, 4347, 4348, 7, 71, 2, 2, 4348, 520,
+	3, 2, 2, 2, 4349, 4350, 7, 76, 2, 2, 4350, 4351, 7, 85, 2, 2, 4351, 4352,
+	7, 81, 2, 2, 4352, 4353, 7, 80, 2, 2, 4353, 4354, 7, 97, 2, 2, 4354, 4355,
+	7, 85, 2, 2, 4355, 4356, 7, 69, 2, 2, 4356, 4357, 7, 74, 2, 2, 4357, 4358,
+	7, 71, 2, 2, 4358, 4359, 7, 79, 2, 2, 4359, 4360, 7, 67, 2, 2, 4360, 4361,
+	7, 97, 2, 2, 4361, 4362, 7, 88, 2, 2, 4362, 4363, 7, 67, 2, 2, 4363, 4364,
+	7, 78, 2, 2, 4364, 4365, 7, 75, 2, 2, 4365, 4366, 7, 70, 2, 2, 4366, 522,
+	3, 2, 2, 2, 4367, 4368, 7, 76, 2, 2, 4368, 4369, 7, 85, 2, 2, 4369, 4370,
+	7, 81, 2, 2, 4370, 4371, 7, 80, 2, 2, 4371, 4372, 7, 97, 2, 2, 4372, 4373,
+	7, 85, 2, 2, 4373, 4374, 7, 69, 2, 2, 4374, 4375, 7, 74, 2, 2, 4375, 4376,
+	7, 71, 2, 2, 4376, 4377, 7, 79, 2, 2, 4377, 4378, 7, 67, 2, 2, 4378, 4379,
+	7, 97, 2, 2, 4379, 4380, 7, 88, 2, 2, 4380, 4381, 7, 67, 2, 2, 4381, 4382,
+	7, 78, 2, 2, 4382, 4383, 7, 75, 2, 2, 4383, 4384, 7, 70, 2, 2, 4384, 4385,
+	7, 67, 2, 2, 4385, 4386, 7, 86, 2, 2, 4386, 4387, 7, 75, 2, 2, 4387, 4388,
+	7, 81, 2, 2, 4388, 4389, 7, 80, 2, 2, 4389, 4390, 7, 97, 2, 2, 4390, 4391,
+	7, 84, 2, 2, 4391, 4392, 7, 71, 2, 2, 4392, 4393, 7, 82, 2, 2, 4393, 4394,
+	7, 81, 2, 2, 4394, 4395, 7, 84, 2, 2, 4395, 4396, 7, 86, 2, 2, 4396, 524,
+	3, 2, 2, 2, 4397, 4398, 7, 76, 2, 2, 4398, 4399, 7, 85, 2, 2, 4399, 4400,
+	7, 81, 2, 2, 4400, 4401, 7, 80, 2, 2, 4401, 4402, 7, 97, 2, 2, 4402, 4403,
+	7, 82, 2, 2, 4403, 4404, 7, 84, 2, 2, 4404, 4405, 7, 71, 2, 2, 4405, 4406,
+	7, 86, 2, 2, 4406, 4407, 7, 86, 2, 2, 4407, 4408, 7, 91, 2, 2, 4408, 526,
+	3, 2, 2, 2, 4409, 4410, 7, 76, 2, 2, 4410, 4411, 7, 85, 2, 2, 4411, 4412,
+	7, 81, 2, 2, 4412, 4413, 7, 80, 2, 2, 4413, 4414, 7, 97, 2, 2, 4414, 4415,
+	7, 85, 2, 2, 4415, 4416, 7, 86, 2, 2, 4416, 4417, 7, 81, 2, 2, 4417, 4418,
+	7, 84, 2, 2, 4418, 4419, 7, 67, 2, 2, 4419, 4420, 7, 73, 2, 2, 4420, 4421,
+	7, 71, 2, 2, 4421, 4422, 7, 97, 2, 2, 4422, 4423, 7, 72, 2, 2, 4423, 4424,
+	7, 84, 2, 2, 4424, 4425, 7, 71, 2, 2, 4425, 4426, 7, 71, 2, 2, 4426, 528,
+	3, 2, 2, 2, 4427, 4428, 7, 76, 2, 2, 4428, 4429, 7, 85, 2, 2, 4429, 4430,
+	7, 81, 2, 2, 4430, 4431, 7, 80, 2, 2, 4431, 4432, 7, 97, 2, 2, 4432, 4433,
+	7, 85, 2, 2, 4433, 4434, 7, 86, 2, 2, 4434, 4435, 7, 81, 2, 2, 4435, 4436,
+	7, 84, 2, 2, 4436, 4437, 7, 67, 2, 2, 4437, 4438, 7, 73, 2, 2, 4438, 4439,
+	7, 71, 2, 2, 4439, 4440, 7, 97, 2, 2, 4440, 4441, 7, 85, 2, 2, 4441, 4442,
+	7, 75, 2, 2, 4442, 4443, 7, 92, 2, 2, 4443, 4444, 7, 71, 2, 2, 4444, 530,
+	3, 2, 2, 2, 4445, 4446, 7, 76, 2, 2, 4446, 4447, 7, 85, 2, 2, 4447, 4448,
+	7, 81, 2, 2, 4448, 4449, 7, 80, 2, 2, 4449, 4450, 7, 97, 2, 2, 4450, 4451,
+	7, 67, 2, 2, 4451, 4452, 7, 84, 2, 2, 4452, 4453, 7, 84, 2, 2, 4453, 4454,
+	7, 67, 2, 2, 4454, 4455, 7, 91, 2, 2, 4455, 4456, 7, 67, 2, 2, 4456, 4457,
+	7, 73, 2, 2, 4457, 4458, 7, 73, 2, 2, 4458, 532, 3, 2, 2, 2, 4459, 4460,
+	7, 76, 2, 2, 4460, 4461, 7, 85, 2, 2, 4461, 4462, 7, 81, 2, 2, 4462, 4463,
+	7, 80, 2, 2, 4463, 4464, 7, 97, 2, 2, 4464, 4465, 7, 81, 2, 2, 4465, 4466,
+	7, 68, 2, 2, 4466, 4467, 7, 76, 2, 2, 4467, 4468, 7, 71, 2, 2, 4468, 4469,
+	7, 69, 2, 2, 4469, 4470, 7, 86, 2, 2, 4470, 4471, 7, 67, 2, 2, 4471, 4472,
+	7, 73, 2, 2, 4472, 4473, 7, 73, 2, 2, 4473, 534, 3, 2, 2, 2, 4474, 4475,
+	7, 67, 2, 2, 4475, 4476, 7, 88, 2, 2, 4476, 4477, 7, 73, 2, 2, 4477, 536,
+	3, 2, 2, 2, 4478, 4479, 7, 68, 2, 2, 4479, 4480, 7, 75, 2, 2, 4480, 4481,
+	7, 86, 2, 2, 4481, 4482, 7, 97, 2, 2, 4482, 4483, 7, 67, 2, 2, 4483, 4484,
+	7, 80, 2, 2, 4484, 4485, 7, 70, 2, 2, 4485, 538, 3, 2, 2, 2, 4486, 4487,
+	7, 68, 2, 2, 4487, 4488, 7, 75, 2, 2, 4488, 4489, 7, 86, 2, 2, 4489, 4490,
+	7, 97, 2, 2, 4490, 4491, 7, 81, 2, 2, 4491, 4492, 7, 84, 2, 2, 4492, 540,
+	3, 2, 2, 2, 4493, 4494, 7, 68, 2, 2, 4494, 4495, 7, 75, 2, 2, 4495, 4496,
+	7, 86, 2, 2, 4496, 4497, 7, 97, 2, 2, 4497, 4498, 7, 90, 2, 2, 4498, 4499,
+	7, 81, 2, 2, 4499, 4500, 7, 84, 2, 2, 4500, 542, 3, 2, 2, 2, 4501, 4502,
+	7, 69, 2, 2, 4502, 4503, 7, 81, 2, 2, 4503, 4504, 7, 87, 2, 2, 4504, 4505,
+	7, 80, 2, 2, 4505, 4506, 7, 86, 2, 2, 4506, 544, 3, 2, 2, 2, 4507, 4508,
+	7, 73, 2, 2, 4508, 4509, 7, 84, 2, 2, 4509, 4510, 7, 81, 2, 2, 4510, 4511,
+	7, 87, 2, 2, 4511, 4512, 7, 82, 2, 2, 4512, 4513, 7, 97, 2, 2, 4513, 4514,
+	7, 69, 2, 2, 4514, 4515, 7, 81, 2, 2, 4515, 4516, 7, 80, 2, 2, 4516, 4517,
+	7, 69, 2, 2, 4517, 4518, 7, 67, 2, 2, 4518, 4519, 7, 86, 2, 2, 4519, 546,
+	3, 2, 2, 2, 4520, 4521, 7, 79, 2, 2, 4521, 4522, 7, 67, 2, 2, 4522, 4523,
+	7, 90, 2, 2, 4523, 548, 3, 2, 2, 2, 4524, 4525, 7, 79, 2, 2, 4525, 4526,
+	7, 75, 2, 2, 4526, 4527, 7, 80, 2, 2, 4527, 550, 3, 2, 2, 2, 4528, 4529,
+	7, 85, 2, 2, 4529, 4530, 7, 86, 2, 2, 4530, 4531, 7, 70, 2, 2, 4531, 552,
+	3, 2, 2, 2, 4532, 4533, 7, 85, 2, 2, 4533, 4534, 7, 86, 2, 2, 4534, 4535,
+	7, 70, 2, 2, 4535, 4536, 7, 70, 2, 2, 4536, 4537, 7, 71, 2, 2, 4537, 4538,
+	7, 88, 2, 2, 4538, 554, 3, 2, 2, 2, 4539, 4540, 7, 85, 2, 2, 4540, 4541,
+	7, 86, 2, 2, 4541, 4542, 7, 70, 2, 2, 4542, 4543, 7, 70, 2, 2, 4543, 4544,
+	7, 71, 2, 2, 4544, 4545, 7, 88, 2, 2, 4545, 4546, 7, 97, 2, 2, 4546, 4547,
+	7, 82, 2, 2, 4547, 4548, 7, 81, 2, 2, 4548, 4549, 7, 82, 2, 2, 4549, 556,
+	3, 2, 2, 2, 4550, 4551, 7, 85, 2, 2, 4551, 4552, 7, 86, 2, 2, 4552, 4553,
+	7, 70, 2, 2, 4553, 4554, 7, 70, 2, 2, 4554, 4555, 7, 71, 2, 2, 4555, 4556,
+	7, 88, 2, 2, 4556, 4557, 7, 97, 2, 2, 4557, 4558, 7, 85, 2, 2, 4558, 4559,
+	7, 67, 2, 2, 4559, 4560, 7, 79, 2, 2, 4560, 4561, 7, 82, 2, 2, 4561, 558,
+	3, 2, 2, 2, 4562, 4563, 7, 85, 2, 2, 4563, 4564, 7, 87, 2, 2, 4564, 4565,
+	7, 79, 2, 2, 4565, 560, 3, 2, 2, 2, 4566, 4567, 7, 88, 2, 2, 4567, 4568,
+	7, 67, 2, 2, 4568, 4569, 7, 84, 2, 2, 4569, 4570, 7, 97, 2, 2, 4570, 4571,
+	7, 82, 2, 2, 4571, 4572, 7, 81, 2, 2, 4572, 4573, 7, 82, 2, 2, 4573, 562,
+	3, 2, 2, 2, 4574, 4575, 7, 88, 2, 2, 4575, 4576, 7, 67, 2, 2, 4576, 4577,
+	7, 84, 2, 2, 4577, 4578, 7, 97, 2, 2, 4578, 4579, 7, 85, 2, 2, 4579, 4580,
+	7, 67, 2, 2, 4580, 4581, 7, 79, 2, 2, 4581, 4582, 7, 82, 2, 2, 4582, 564,
+	3, 2, 2, 2, 4583, 4584, 7, 88, 2, 2, 4584, 4585, 7, 67, 2, 2, 4585, 4586,
+	7, 84, 2, 2, 4586, 4587, 7, 75, 2, 2, 4587, 4588, 7, 67, 2, 2, 4588, 4589,
+	7, 80, 2, 2, 4589, 4590, 7, 69, 2, 2, 4590, 4591, 7, 71, 2, 2, 4591, 566,
+	3, 2, 2, 2, 4592, 4593, 7, 69, 2, 2, 4593, 4594, 7, 87, 2, 2, 4594, 4595,
+	7, 84, 2, 2, 4595, 4596, 7, 84, 2, 2, 4596, 4597, 7, 71, 2, 2, 4597, 4598,
+	7, 80, 2, 2, 4598, 4599, 7, 86, 2, 2, 4599, 4600, 7, 97, 2, 2, 4600, 4601,
+	7, 70, 2, 2, 4601, 4602, 7, 67, 2, 2, 4602, 4603, 7, 86, 2, 2, 4603, 4604,
+	7, 71, 2, 2, 4604, 568, 3, 2, 2, 2, 4605, 4606, 7, 69, 2, 2, 4606, 4607,
+	7, 87, 2, 2, 4607, 4608, 7, 84, 2, 2, 4608, 4609, 7, 84, 2, 2, 4609, 4610,
+	7, 71, 2, 2, 4610, 4611, 7, 80, 2, 2, 4611, 4612, 7, 86, 2, 2, 4612, 4613,
+	7, 97, 2, 2, 4613, 4614, 7, 86, 2, 2, 4614, 4615, 7, 75, 2, 2, 4615, 4616,
+	7, 79, 2, 2, 4616, 4617, 7, 71, 2, 2, 4617, 570, 3, 2, 2, 2, 4618, 4619,
+	7, 69, 2, 2, 4619, 4620, 7, 87, 2, 2, 4620, 4621, 7, 84, 2, 2, 4621, 4622,
+	7, 84, 2, 2, 4622, 4623, 7, 71, 2, 2, 4623, 4624, 7, 80, 2, 2, 4624, 4625,
+	7, 86, 2, 2, 4625, 4626, 7, 97, 2, 2, 4626, 4627, 7, 86, 2, 2, 4627, 4628,
+	7, 75, 2, 2, 4628, 4629, 7, 79, 2, 2, 4629, 4630, 7, 71, 2, 2, 4630, 4631,
+	7, 85, 2, 2, 4631, 4632, 7, 86, 2, 2, 4632, 4633, 7, 67, 2, 2, 4633, 4634,
+	7, 79, 2, 2, 4634, 4635, 7, 82, 2, 2, 4635, 572, 3, 2, 2, 2, 4636, 4637,
+	7, 78, 2, 2, 4637, 4638, 7, 81, 2, 2, 4638, 4639, 7, 69, 2, 2, 4639, 4640,
+	7, 67, 2, 2, 4640, 4641, 7, 78, 2, 2, 4641, 4642, 7, 86, 2, 2, 4642, 4643,
+	7, 75, 2, 2, 4643, 4644, 7, 79, 2, 2, 4644, 4645, 7, 71, 2, 2, 4645, 574,
+	3, 2, 2, 2, 4646, 4647, 7, 69, 2, 2, 4647, 4648, 7, 87, 2, 2, 4648, 4649,
+	7, 84, 2, 2, 4649, 4650, 7, 70, 2, 2, 4650, 4651, 7, 67, 2, 2, 4651, 4652,
+	7, 86, 2, 2, 4652, 4653, 7, 71, 2, 2, 4653, 576, 3, 2, 2, 2, 4654, 4655,
+	7, 69, 2, 2, 4655, 4656, 7, 87, 2, 2, 4656, 4657, 7, 84, 2, 2, 4657, 4658,
+	7, 86, 2, 2, 4658, 4659, 7, 75, 2, 2, 4659, 4660, 7, 79, 2, 2, 4660, 4661,
+	7, 71, 2, 2, 4661, 578, 3, 2, 2, 2, 4662, 4663, 7, 70, 2, 2, 4663, 4664,
+	7, 67, 2, 2, 4664, 4665, 7, 86, 2, 2, 4665, 4666, 7, 71, 2, 2, 4666, 4667,
+	7, 97, 2, 2, 4667, 4668, 7, 67, 2, 2, 4668, 4669, 7, 70, 2, 2, 4669, 4670,
+	7, 70, 2, 2, 4670, 580, 3, 2, 2, 2, 4671, 4672, 7, 70, 2, 2, 4672, 4673,
+	7, 67, 2, 2, 4673, 4674, 7, 86, 2, 2, 4674, 4675, 7, 71, 2, 2, 4675, 4676,
+	7, 97, 2, 2, 4676, 4677, 7, 85, 2, 2, 4677, 4678, 7, 87, 2, 2, 4678, 4679,
+	7, 68, 2, 2, 4679, 582, 3, 2, 2, 2, 4680, 4681, 7, 71, 2, 2, 4681, 4682,
+	7, 90, 2, 2, 4682, 4683, 7, 86, 2, 2, 4683, 4684, 7, 84, 2, 2, 4684, 4685,
+	7, 67, 2, 2, 4685, 4686, 7, 69, 2, 2, 4686, 4687, 7, 86, 2, 2, 4687, 584,
+	3, 2, 2, 2, 4688, 4689, 7, 78, 2, 2, 4689, 4690, 7, 81, 2, 2, 4690, 4691,
+	7, 69, 2, 2, 4691, 4692, 7, 67, 2, 2, 4692, 4693, 7, 78, 2, 2, 4693, 4694,
+	7, 86, 2, 2, 4694, 4695, 7, 75, 2, 2, 4695, 4696, 7, 79, 2, 2, 4696, 4697,
+	7, 71, 2, 2, 4697, 4698, 7, 85, 2, 2, 4698, 4699, 7, 86, 2, 2, 4699, 4700,
+	7, 67, 2, 2, 4700, 4701, 7, 79, 2, 2, 4701, 4702, 7, 82, 2, 2, 4702, 586,
+	3, 2, 2, 2, 4703, 4704, 7, 80, 2, 2, 4704, 4705, 7, 81, 2, 2, 4705, 4706,
+	7, 89, 2, 2, 4706, 588, 3, 2, 2, 2, 4707, 4708, 7, 82, 2, 2, 4708, 4709,
+	7, 81, 2, 2, 4709, 4710, 7, 85, 2, 2, 4710, 4711, 7, 75, 2, 2, 4711, 4712,
+	7, 86, 2, 2, 4712, 4713, 7, 75, 2, 2, 4713, 4714, 7, 81, 2, 2, 4714, 4715,
+	7, 80, 2, 2, 4715, 590, 3, 2, 2, 2, 4716, 4717, 7, 85, 2, 2, 4717, 4718,
+	7, 87, 2, 2, 4718, 4719, 7, 68, 2, 2, 4719, 4720, 7, 85, 2, 2, 4720, 4721,
+	7, 86, 2, 2, 4721, 4722, 7, 84, 2, 2, 4722, 592, 3, 2, 2, 2, 4723, 4724,
+	7, 85, 2, 2, 4724, 4725, 7, 87, 2, 2, 4725, 4726, 7, 68, 2, 2, 4726, 4727,
+	7, 85, 2, 2, 4727, 4728, 7, 86, 2, 2, 4728, 4729, 7, 84, 2, 2, 4729, 4730,
+	7, 75, 2, 2, 4730, 4731, 7, 80, 2, 2, 4731, 4732, 7, 73, 2, 2, 4732, 594,
+	3, 2, 2, 2, 4733, 4734, 7, 85, 2, 2, 4734, 4735, 7, 91, 2, 2, 4735, 4736,
+	7, 85, 2, 2, 4736, 4737, 7, 70, 2, 2, 4737, 4738, 7, 67, 2, 2, 4738, 4739,
+	7, 86, 2, 2, 4739, 4740, 7, 71, 2, 2, 4740, 596, 3, 2, 2, 2, 4741, 4742,
+	7, 86, 2, 2, 4742, 4743, 7, 84, 2, 2, 4743, 4744, 7, 75, 2, 2, 4744, 4745,
+	7, 79, 2, 2, 4745, 598, 3, 2, 2, 2, 4746, 4747, 7, 87, 2, 2, 4747, 4748,
+	7, 86, 2, 2, 4748, 4749, 7, 69, 2, 2, 4749, 4750, 7, 97, 2, 2, 4750, 4751,
+	7, 70, 2, 2, 4751, 4752, 7, 67, 2, 2, 4752, 4753, 7, 86, 2, 2, 4753, 4754,
+	7, 71, 2, 2, 4754, 600, 3, 2, 2, 2, 4755, 4756, 7, 87, 2, 2, 4756, 4757,
+	7, 86, 2, 2, 4757, 4758, 7, 69, 2, 2, 4758, 4759, 7, 97, 2, 2, 4759, 4760,
+	7, 86, 2, 2, 4760, 4761, 7, 75, 2, 2, 4761, 4762, 7, 79, 2, 2, 4762, 4763,
+	7, 71, 2, 2, 4763, 602, 3, 2, 2, 2, 4764, 4765, 7, 87, 2, 2, 4765, 4766,
+	7, 86, 2, 2, 4766, 4767, 7, 69, 2, 2, 4767, 4768, 7, 97, 2, 2, 4768, 4769,
+	7, 86, 2, 2, 4769, 4770, 7, 75, 2, 2, 4770, 4771, 7, 79, 2, 2, 4771, 4772,
+	7, 71, 2, 2, 4772, 4773, 7, 85, 2, 2, 4773, 4774, 7, 86, 2, 2, 4774, 4775,
+	7, 67, 2, 2, 4775, 4776, 7, 79, 2, 2, 4776, 4777, 7, 82, 2, 2, 4777, 604,
+	3, 2, 2, 2, 4778, 4779, 7, 67, 2, 2, 4779, 4780, 7, 69, 2, 2, 4780, 4781,
+	7, 69, 2, 2, 4781, 4782, 7, 81, 2, 2, 4782, 4783, 7, 87, 2, 2, 4783, 4784,
+	7, 80, 2, 2, 4784, 4785, 7, 86, 2, 2, 4785, 606, 3, 2, 2, 2, 4786, 4787,
+	7, 67, 2, 2, 4787, 4788, 7, 69, 2, 2, 4788, 4789, 7, 86, 2, 2, 4789, 4790,
+	7, 75, 2, 2, 4790, 4791, 7, 81, 2, 2, 4791, 4792, 7, 80, 2, 2, 4792, 608,
+	3, 2, 2, 2, 4793, 4794, 7, 67, 2, 2, 4794, 4795, 7, 72, 2, 2, 4795, 4796,
+	7, 86, 2, 2, 4796, 4797, 7, 71, 2, 2, 4797, 4798, 7, 84, 2, 2, 4798, 610,
+	3, 2, 2, 2, 4799, 4800, 7, 67, 2, 2, 4800, 4801, 7, 73, 2, 2, 4801, 4802,
+	7, 73, 2, 2, 4802, 4803, 7, 84, 2, 2, 4803, 4804, 7, 71, 2, 2, 4804, 4805,
+	7, 73, 2, 2, 4805, 4806, 7, 67, 2, 2, 4806, 4807, 7, 86, 2, 2, 4807, 4808,
+	7, 71, 2, 2, 4808, 612, 3, 2, 2, 2, 4809, 4810, 7, 67, 2, 2, 4810, 4811,
+	7, 78, 2, 2, 4811, 4812, 7, 73, 2, 2, 4812, 4813, 7, 81, 2, 2, 4813, 4814,
+	7, 84, 2, 2, 4814, 4815, 7, 75, 2, 2, 4815, 4816, 7, 86, 2, 2, 4816, 4817,
+	7, 74, 2, 2, 4817, 4818, 7, 79, 2, 2, 4818, 614, 3, 2, 2, 2, 4819, 4820,
+	7, 67, 2, 2, 4820, 4821, 7, 80, 2, 2, 4821, 4822, 7, 91, 2, 2, 4822, 616,
+	3, 2, 2, 2, 4823, 4824, 7, 67, 2, 2, 4824, 4825, 7, 86, 2, 2, 4825, 618,
+	3, 2, 2, 2, 4826, 4827, 7, 67, 2, 2, 4827, 4828, 7, 87, 2, 2, 4828, 4829,
+	7, 86, 2, 2, 4829, 4830, 7, 74, 2, 2, 4830, 4831, 7, 81, 2, 2, 4831, 4832,
+	7, 84, 2, 2, 4832, 4833, 7, 85, 2, 2, 4833, 620, 3, 2, 2, 2, 4834, 4835,
+	7, 67, 2, 2, 4835, 4836, 7, 87, 2, 2, 4836, 4837, 7, 86, 2, 2, 4837, 4838,
+	7, 81, 2, 2, 4838, 4839, 7, 69, 2, 2, 4839, 4840, 7, 81, 2, 2, 4840, 4841,
+	7, 79, 2, 2, 4841, 4842, 7, 79, 2, 2, 4842, 4843, 7, 75, 2, 2, 4843, 4844,
+	7, 86, 2, 2, 4844, 622, 3, 2, 2, 2, 4845, 4846, 7, 67, 2, 2, 4846, 4847,
+	7, 87, 2, 2, 4847, 4848, 7, 86, 2, 2, 4848, 4849, 7, 81, 2, 2, 4849, 4850,
+	7, 71, 2, 2, 4850, 4851, 7, 90, 2, 2, 4851, 4852, 7, 86, 2, 2, 4852, 4853,
+	7, 71, 2, 2, 4853, 4854, 7, 80, 2, 2, 4854, 4855, 7, 70, 2, 2, 4855, 4856,
+	7, 97, 2, 2, 4856, 4857, 7, 85, 2, 2, 4857, 4858, 7, 75, 2, 2, 4858, 4859,
+	7, 92, 2, 2, 4859, 4860, 7, 71, 2, 2, 4860, 624, 3, 2, 2, 2, 4861, 4862,
+	7, 67, 2, 2, 4862, 4863, 7, 87, 2, 2, 4863, 4864, 7, 86, 2, 2, 4864, 4865,
+	7, 81, 2, 2, 4865, 4866, 7, 97, 2, 2, 4866, 4867, 7, 75, 2, 2, 4867, 4868,
+	7, 80, 2, 2, 4868, 4869, 7, 69, 2, 2, 4869, 4870, 7, 84, 2, 2, 4870, 4871,
+	7, 71, 2, 2, 4871, 4872, 7, 79, 2, 2, 4872, 4873, 7, 71, 2, 2, 4873, 4874,
+	7, 80, 2, 2, 4874, 4875, 7, 86, 2, 2, 4875, 626, 3, 2, 2, 2, 4876, 4877,
+	7, 67, 2, 2, 4877, 4878, 7, 88, 2, 2, 4878, 4879, 7, 73, 2, 2, 4879, 4880,
+	7, 97, 2, 2, 4880, 4881, 7, 84, 2, 2, 4881, 4882, 7, 81, 2, 2, 4882, 4883,
+	7, 89, 2, 2, 4883, 4884, 7, 97, 2, 2, 4884, 4885, 7, 78, 2, 2, 4885, 4886,
+	7, 71, 2, 2, 4886, 4887, 7, 80, 2, 2, 4887, 4888, 7, 73, 2, 2, 4888, 4889,
+	7, 86, 2, 2, 4889, 4890, 7, 74, 2, 2, 4890, 628, 3, 2, 2, 2, 4891, 4892,
+	7, 68, 2, 2, 4892, 4893, 7, 71, 2, 2, 4893, 4894, 7, 73, 2, 2, 4894, 4895,
+	7, 75, 2, 2, 4895, 4896, 7, 80, 2, 2, 4896, 630, 3, 2, 2, 2, 4897, 4898,
+	7, 68, 2, 2, 4898, 4899, 7, 75, 2, 2, 4899, 4900, 7, 80, 2, 2, 4900, 4901,
+	7, 78, 2, 2, 4901, 4902, 7, 81, 2, 2, 4902, 4903, 7, 73, 2, 2, 4903, 632,
+	3, 2, 2, 2, 4904, 4905, 7, 68, 2, 2, 4905, 4906, 7, 75, 2, 2, 4906, 4907,
+	7, 86, 2, 2, 4907, 634, 3, 2, 2, 2, 4908, 4909, 7, 68, 2, 2, 4909, 4910,
+	7, 78, 2, 2, 4910, 4911, 7, 81, 2, 2, 4911, 4912, 7, 69, 2, 2, 4912, 4913,
+	7, 77, 2, 2, 4913, 636, 3, 2, 2, 2, 4914, 4915, 7, 68, 2, 2, 4915, 4916,
+	7, 81, 2, 2, 4916, 4917, 7, 81, 2, 2, 4917, 4918, 7, 78, 2, 2, 4918, 638,
+	3, 2, 2, 2, 4919, 4920, 7, 68, 2, 2, 4920, 4921, 7, 81, 2, 2, 4921, 4922,
+	7, 81, 2, 2, 4922, 4923, 7, 78, 2, 2, 4923, 4924, 7, 71, 2, 2, 4924, 4925,
+	7, 67, 2, 2, 4925, 4926, 7, 80, 2, 2, 4926, 640, 3, 2, 2, 2, 4927, 4928,
+	7, 68, 2, 2, 4928, 4929, 7, 86, 2, 2, 4929, 4930, 7, 84, 2, 2, 4930, 4931,
+	7, 71, 2, 2, 4931, 4932, 7, 71, 2, 2, 4932, 642, 3, 2, 2, 2, 4933, 4934,
+	7, 69, 2, 2, 4934, 4935, 7, 67, 2, 2, 4935, 4936, 7, 69, 2, 2, 4936, 4937,
+	7, 74, 2, 2, 4937, 4938, 7, 71, 2, 2, 4938, 644, 3, 2, 2, 2, 4939, 4940,
+	7, 69, 2, 2, 4940, 4941, 7, 67, 2, 2, 4941, 4942, 7, 85, 2, 2, 4942, 4943,
+	7, 69, 2, 2, 4943, 4944, 7, 67, 2, 2, 4944, 4945, 7, 70, 2, 2, 4945, 4946,
+	7, 71, 2, 2, 4946, 4947, 7, 70, 2, 2, 4947, 646, 3, 2, 2, 2, 4948, 4949,
+	7, 69, 2, 2, 4949, 4950, 7, 74, 2, 2, 4950, 4951, 7, 67, 2, 2, 4951, 4952,
+	7, 75, 2, 2, 4952, 4953, 7, 80, 2, 2, 4953, 648, 3, 2, 2, 2, 4954, 4955,
+	7, 69, 2, 2, 4955, 4956, 7, 74, 2, 2, 4956, 4957, 7, 67, 2, 2, 4957, 4958,
+	7, 80, 2, 2, 4958, 4959, 7, 73, 2, 2, 4959, 4960, 7, 71, 2, 2, 4960, 4961,
+	7, 70, 2, 2, 4961, 650, 3, 2, 2, 2, 4962, 4963, 7, 69, 2, 2, 4963, 4964,
+	7, 74, 2, 2, 4964, 4965, 7, 67, 2, 2, 4965, 4966, 7, 80, 2, 2, 4966, 4967,
+	7, 80, 2, 2, 4967, 4968, 7, 71, 2, 2, 4968, 4969, 7, 78, 2, 2, 4969, 652,
+	3, 2, 2, 2, 4970, 4971, 7, 69, 2, 2, 4971, 4972, 7, 74, 2, 2, 4972, 4973,
+	7, 71, 2, 2, 4973, 4974, 7, 69, 2, 2, 4974, 4975, 7, 77, 2, 2, 4975, 4976,
+	7, 85, 2, 2, 4976, 4977, 7, 87, 2, 2, 4977, 4978, 7, 79, 2, 2, 4978, 654,
+	3, 2, 2, 2, 4979, 4980, 7, 82, 2, 2, 4980, 4981, 7, 67, 2, 2, 4981, 4982,
+	7, 73, 2, 2, 4982, 4983, 7, 71, 2, 2, 4983, 4984, 7, 97, 2, 2, 4984, 4985,
+	7, 69, 2, 2, 4985, 4986, 7, 74, 2, 2, 4986, 4987, 7, 71, 2, 2, 4987, 4988,
+	7, 69, 2, 2, 4988, 4989, 7, 77, 2, 2, 4989, 4990, 7, 85, 2, 2, 4990, 4991,
+	7, 87, 2, 2, 4991, 4992, 7, 79, 2, 2, 4992, 656, 3, 2, 2, 2, 4993, 4994,
+	7, 69, 2, 2, 4994, 4995, 7, 75, 2, 2, 4995, 4996, 7, 82, 2, 2, 4996, 4997,
+	7, 74, 2, 2, 4997, 4998, 7, 71, 2, 2, 4998, 4999, 7, 84, 2, 2, 4999, 658,
+	3, 2, 2, 2, 5000, 5001, 7, 69, 2, 2, 5001, 5002, 7, 78, 2, 2, 5002, 5003,
+	7, 67, 2, 2, 5003, 5004, 7, 85, 2, 2, 5004, 5005, 7, 85, 2, 2, 5005, 5006,
+	7, 97, 2, 2, 5006, 5007, 7, 81, 2, 2, 5007, 5008, 7, 84, 2, 2, 5008, 5009,
+	7, 75, 2, 2, 5009, 5010, 7, 73, 2, 2, 5010, 5011, 7, 75, 2, 2, 5011, 5012,
+	7, 80, 2, 2, 5012, 660, 3, 2, 2, 2, 5013, 5014, 7, 69, 2, 2, 5014, 5015,
+	7, 78, 2, 2, 5015, 5016, 7, 75, 2, 2, 5016, 5017, 7, 71, 2, 2, 5017, 5018,
+	7, 80, 2, 2, 5018, 5019, 7, 86, 2, 2, 5019, 662, 3, 2, 2, 2, 5020, 5021,
+	7, 69, 2, 2, 5021, 5022, 7, 78, 2, 2, 5022, 5023, 7, 81, 2, 2, 5023, 5024,
+	7, 85, 2, 2, 5024, 5025, 7, 71, 2, 2, 5025, 664, 3, 2, 2, 2, 5026, 5027,
+	7, 69, 2, 2, 5027, 5028, 7, 81, 2, 2, 5028, 5029, 7, 67, 2, 2, 5029, 5030,
+	7, 78, 2, 2, 5030, 5031, 7, 71, 2, 2, 5031, 5032, 7, 85, 2, 2, 5032, 5033,
+	7, 69, 2, 2, 5033, 5034, 7, 71, 2, 2, 5034, 666, 3, 2, 2, 2, 5035, 5036,
+	7, 69, 2, 2, 5036, 5037, 7, 81, 2, 2, 5037, 5038, 7, 70, 2, 2, 5038, 5039,
+	7, 71, 2, 2, 5039, 668, 3, 2, 2, 2, 5040, 5041, 7, 69, 2, 2, 5041, 5042,
+	7, 81, 2, 2, 5042, 5043, 7, 78, 2, 2, 5043, 5044, 7, 87, 2, 2, 5044, 5045,
+	7, 79, 2, 2, 5045, 5046, 7, 80, 2, 2, 5046, 5047, 7, 85, 2, 2, 5047, 670,
+	3, 2, 2, 2, 5048, 5049, 7, 69, 2, 2, 5049, 5050, 7, 81, 2, 2, 5050, 5051,
+	7, 78, 2, 2, 5051, 5052, 7, 87, 2, 2, 5052, 5053, 7, 79, 2, 2, 5053, 5054,
+	7, 80, 2, 2, 5054, 5055, 7, 97, 2, 2, 5055, 5056, 7, 72, 2, 2, 5056, 5057,
+	7, 81, 2, 2, 5057, 5058, 7, 84, 2, 2, 5058, 5059, 7, 79, 2, 2, 5059, 5060,
+	7, 67, 2, 2, 5060, 5061, 7, 86, 2, 2, 5061, 672, 3, 2, 2, 2, 5062, 5063,
+	7, 69, 2, 2, 5063, 5064, 7, 81, 2, 2, 5064, 5065, 7, 78, 2, 2, 5065, 5066,
+	7, 87, 2, 2, 5066, 5067, 7, 79, 2, 2, 5067, 5068, 7, 80, 2, 2, 5068, 5069,
+	7, 97, 2, 2, 5069, 5070, 7, 80, 2, 2, 5070, 5071, 7, 67, 2, 2, 5071, 5072,
+	7, 79, 2, 2, 5072, 5073, 7, 71, 2, 2, 5073, 674, 3, 2, 2, 2, 5074, 5075,
+	7, 69, 2, 2, 5075, 5076, 7, 81, 2, 2, 5076, 5077, 7, 79, 2, 2, 5077, 5078,
+	7, 79, 2, 2, 5078, 5079, 7, 71, 2, 2, 5079, 5080, 7, 80, 2, 2, 5080, 5081,
+	7, 86, 2, 2, 5081, 676, 3, 2, 2, 2, 5082, 5083, 7, 69, 2, 2, 5083, 5084,
+	7, 81, 2, 2, 5084, 5085, 7, 79, 2, 2, 5085, 5086, 7, 79, 2, 2, 5086, 5087,
+	7, 75, 2, 2, 5087, 5088, 7, 86, 2, 2, 5088, 678, 3, 2, 2, 2, 5089, 5090,
+	7, 69, 2, 2, 5090, 5091, 7, 81, 2, 2, 5091, 5092, 7, 79, 2, 2, 5092, 5093,
+	7, 82, 2, 2, 5093, 5094, 7, 67, 2, 2, 5094, 5095, 7, 69, 2, 2, 5095, 5096,
+	7, 86, 2, 2, 5096, 680, 3, 2, 2, 2, 5097, 5098, 7, 69, 2, 2, 5098, 5099,
+	7, 81, 2, 2, 5099, 5100, 7, 79, 2, 2, 5100, 5101, 7, 82, 2, 2, 5101, 5102,
+	7, 78, 2, 2, 5102, 5103, 7, 71, 2, 2, 5103, 5104, 7, 86, 2, 2, 5104, 5105,
+	7, 75, 2, 2, 5105, 5106, 7, 81, 2, 2, 5106, 5107, 7, 80, 2, 2, 5107, 682,
+	3, 2, 2, 2, 5108, 5109, 7, 69, 2, 2, 5109, 5110, 7, 81, 2, 2, 5110, 5111,
+	7, 79, 2, 2, 5111, 5112, 7, 82, 2, 2, 5112, 5113, 7, 84, 2, 2, 5113, 5114,
+	7, 71, 2, 2, 5114, 5115, 7, 85, 2, 2, 5115, 5116, 7, 85, 2, 2, 5116, 5117,
+	7, 71, 2, 2, 5117, 5118, 7, 70, 2, 2, 5118, 684, 3, 2, 2, 2, 5119, 5120,
+	7, 69, 2, 2, 5120, 5121, 7, 81, 2, 2, 5121, 5122, 7, 79, 2, 2, 5122, 5123,
+	7, 82, 2, 2, 5123, 5124, 7, 84, 2, 2, 5124, 5125, 7, 71, 2, 2, 5125, 5126,
+	7, 85, 2, 2, 5126, 5127, 7, 85, 2, 2, 5127, 5128, 7, 75, 2, 2, 5128, 5129,
+	7, 81, 2, 2, 5129, 5130, 7, 80, 2, 2, 5130, 686, 3, 2, 2, 2, 5131, 5132,
+	7, 69, 2, 2, 5132, 5133, 7, 81, 2, 2, 5133, 5134, 7, 80, 2, 2, 5134, 5135,
+	7, 69, 2, 2, 5135, 5136, 7, 87, 2, 2, 5136, 5137, 7, 84, 2, 2, 5137, 5138,
+	7, 84, 2, 2, 5138, 5139, 7, 71, 2, 2, 5139, 5140, 7, 80, 2, 2, 5140, 5141,
+	7, 86, 2, 2, 5141, 688, 3, 2, 2, 2, 5142, 5143, 7, 69, 2, 2, 5143, 5144,
+	7, 81, 2, 2, 5144, 5145, 7, 80, 2, 2, 5145, 5146, 7, 80, 2, 2, 5146, 5147,
+	7, 71, 2, 2, 5147, 5148, 7, 69, 2, 2, 5148, 5149, 7, 86, 2, 2, 5149, 5150,
+	7, 75, 2, 2, 5150, 5151, 7, 81, 2, 2, 5151, 5152, 7, 80, 2, 2, 5152, 690,
+	3, 2, 2, 2, 5153, 5154, 7, 69, 2, 2, 5154, 5155, 7, 81, 2, 2, 5155, 5156,
+	7, 80, 2, 2, 5156, 5157, 7, 85, 2, 2, 5157, 5158, 7, 75, 2, 2, 5158, 5159,
+	7, 85, 2, 2, 5159, 5160, 7, 86, 2, 2, 5160, 5161, 7, 71, 2, 2, 5161, 5162,
+	7, 80, 2, 2, 5162, 5163, 7, 86, 2, 2, 5163, 692, 3, 2, 2, 2, 5164, 5165,
+	7, 69, 2, 2, 5165, 5166, 7, 81, 2, 2, 5166, 5167, 7, 80, 2, 2, 5167, 5168,
+	7, 85, 2, 2, 5168, 5169, 7, 86, 2, 2, 5169, 5170, 7, 84, 2, 2, 5170, 5171,
+	7, 67, 2, 2, 5171, 5172, 7, 75, 2, 2, 5172, 5173, 7, 80, 2, 2, 5173, 5174,
+	7, 86, 2, 2, 5174, 5175, 7, 97, 2, 2, 5175, 5176, 7, 69, 2, 2, 5176, 5177,
+	7, 67, 2, 2, 5177, 5178, 7, 86, 2, 2, 5178, 5179, 7, 67, 2, 2, 5179, 5180,
+	7, 78, 2, 2, 5180, 5181, 7, 81, 2, 2, 5181, 5182, 7, 73, 2, 2, 5182, 694,
+	3, 2, 2, 2, 5183, 5184, 7, 69, 2, 2, 5184, 5185, 7, 81, 2, 2, 5185, 5186,
+	7, 80, 2, 2, 5186, 5187, 7, 85, 2, 2, 5187, 5188, 7, 86, 2, 2, 5188, 5189,
+	7, 84, 2, 2, 5189, 5190, 7, 67, 2, 2, 5190, 5191, 7, 75, 2, 2, 5191, 5192,
+	7, 80, 2, 2, 5192, 5193, 7, 86, 2, 2, 5193, 5194, 7, 97, 2, 2, 5194, 5195,
+	7, 85, 2, 2, 5195, 5196, 7, 69, 2, 2, 5196, 5197, 7, 74, 2, 2, 5197, 5198,
+	7, 71, 2, 2, 5198, 5199, 7, 79, 2, 2, 5199, 5200, 7, 67, 2, 2, 5200, 696,
+	3, 2, 2, 2, 5201, 5202, 7, 69, 2, 2, 5202, 5203, 7, 81, 2, 2, 5203, 5204,
+	7, 80, 2, 2, 5204, 5205, 7, 85, 2, 2, 5205, 5206, 7, 86, 2, 2, 5206, 5207,
+	7, 84, 2, 2, 5207, 5208, 7, 67, 2, 2, 5208, 5209, 7, 75, 2, 2, 5209, 5210,
+	7, 80, 2, 2, 5210, 5211, 7, 86, 2, 2, 5211, 5212, 7, 97, 2, 2, 5212, 5213,
+	7, 80, 2, 2, 5213, 5214, 7, 67, 2, 2, 5214, 5215, 7, 79, 2, 2, 5215, 5216,
+	7, 71, 2, 2, 5216, 698, 3, 2, 2, 2, 5217, 5218, 7, 69, 2, 2, 5218, 5219,
+	7, 81, 2, 2, 5219, 5220, 7, 80, 2, 2, 5220, 5221, 7, 86, 2, 2, 5221, 5222,
+	7, 67, 2, 2, 5222, 5223, 7, 75, 2, 2, 5223, 5224, 7, 80, 2, 2, 5224, 5225,
+	7, 85, 2, 2, 5225, 700, 3, 2, 2, 2, 5226, 5227, 7, 69, 2, 2, 5227, 5228,
+	7, 81, 2, 2, 5228, 5229, 7, 80, 2, 2, 5229, 5230, 7, 86, 2, 2, 5230, 5231,
+	7, 71, 2, 2, 5231, 5232, 7, 90, 2, 2, 5232, 5233, 7, 86, 2, 2, 5233, 702,
+	3, 2, 2, 2, 5234, 5235, 7, 69, 2, 2, 5235, 5236, 7, 81, 2, 2, 5236, 5237,
+	7, 80, 2, 2, 5237, 5238, 7, 86, 2, 2, 5238, 5239, 7, 84, 2, 2, 5239, 5240,
+	7, 75, 2, 2, 5240, 5241, 7, 68, 2, 2, 5241, 5242, 7, 87, 2, 2, 5242, 5243,
+	7, 86, 2, 2, 5243, 5244, 7, 81, 2, 2, 5244, 5245, 7, 84, 2, 2, 5245, 5246,
+	7, 85, 2, 2, 5246, 704, 3, 2, 2, 2, 5247, 5248, 7, 69, 2, 2, 5248, 5249,
+	7, 81, 2, 2, 5249, 5250, 7, 82, 2, 2, 5250, 5251, 7, 91, 2, 2, 5251, 706,
+	3, 2, 2, 2, 5252, 5253, 7, 69, 2, 2, 5253, 5254, 7, 82, 2, 2, 5254, 5255,
+	7, 87, 2, 2, 5255, 708, 3, 2, 2, 2, 5256, 5257, 7, 69, 2, 2, 5257, 5258,
+	7, 87, 2, 2, 5258, 5259, 7, 84, 2, 2, 5259, 5260, 7, 85, 2, 2, 5260, 5261,
+	7, 81, 2, 2, 5261, 5262, 7, 84, 2, 2, 5262, 5263, 7, 97, 2, 2, 5263, 5264,
+	7, 80, 2, 2, 5264, 5265, 7, 67, 2, 2, 5265, 5266, 7, 79, 2, 2, 5266, 5267,
+	7, 71, 2, 2, 5267, 710, 3, 2, 2, 2, 5268, 5269, 7, 70, 2, 2, 5269, 5270,
+	7, 67, 2, 2, 5270, 5271, 7, 86, 2, 2, 5271, 5272, 7, 67, 2, 2, 5272, 712,
+	3, 2, 2, 2, 5273, 5274, 7, 70, 2, 2, 5274, 5275, 7, 67, 2, 2, 5275, 5276,
+	7, 86, 2, 2, 5276, 5277, 7, 67, 2, 2, 5277, 5278, 7, 72, 2, 2, 5278, 5279,
+	7, 75, 2, 2, 5279, 5280, 7, 78, 2, 2, 5280, 5281, 7, 71, 2, 2, 5281, 714,
+	3, 2, 2, 2, 5282, 5283, 7, 70, 2, 2, 5283, 5284, 7, 71, 2, 2, 5284, 5285,
+	7, 67, 2, 2, 5285, 5286, 7, 78, 2, 2, 5286, 5287, 7, 78, 2, 2, 5287, 5288,
+	7, 81, 2, 2, 5288, 5289, 7, 69, 2, 2, 5289, 5290, 7, 67, 2, 2, 5290, 5291,
+	7, 86, 2, 2, 5291, 5292, 7, 71, 2, 2, 5292, 716, 3, 2, 2, 2, 5293, 5294,
+	7, 70, 2, 2, 5294, 5295, 7, 71, 2, 2, 5295, 5296, 7, 72, 2, 2, 5296, 5297,
+	7, 67, 2, 2, 5297, 5298, 7, 87, 2, 2, 5298, 5299, 7, 78, 2, 2, 5299, 5300,
+	7, 86, 2, 2, 5300, 5301, 7, 97, 2, 2, 5301, 5302, 7, 67, 2, 2, 5302, 5303,
+	7, 87, 2, 2, 5303, 5304, 7, 86, 2, 2, 5304, 5305, 7, 74, 2, 2, 5305, 718,
+	3, 2, 2, 2, 5306, 5307, 7, 70, 2, 2, 5307, 5308, 7, 71, 2, 2, 5308, 5309,
+	7, 72, 2, 2, 5309, 5310, 7, 75, 2, 2, 5310, 5311, 7, 80, 2, 2, 5311, 5312,
+	7, 71, 2, 2, 5312, 5313, 7, 84, 2, 2, 5313, 720, 3, 2, 2, 2, 5314, 5315,
+	7, 70, 2, 2, 5315, 5316, 7, 71, 2, 2, 5316, 5317, 7, 78, 2, 2, 5317, 5318,
+	7, 67, 2, 2, 5318, 5319, 7, 91, 2, 2, 5319, 5320, 7, 97, 2, 2, 5320, 5321,
+	7, 77, 2, 2, 5321, 5322, 7, 71, 2, 2, 5322, 5323, 7, 91, 2, 2, 5323, 5324,
+	7, 97, 2, 2, 5324, 5325, 7, 89, 2, 2, 5325, 5326, 7, 84, 2, 2, 5326, 5327,
+	7, 75, 2, 2, 5327, 5328, 7, 86, 2, 2, 5328, 5329, 7, 71, 2, 2, 5329, 722,
+	3, 2, 2, 2, 5330, 5331, 7, 70, 2, 2, 5331, 5332, 7, 71, 2, 2, 5332, 5333,
+	7, 85, 2, 2, 5333, 5334, 7, 97, 2, 2, 5334, 5335, 7, 77, 2, 2, 5335, 5336,
+	7, 71, 2, 2, 5336, 5337, 7, 91, 2, 2, 5337, 5338, 7, 97, 2, 2, 5338, 5339,
+	7, 72, 2, 2, 5339, 5340, 7, 75, 2, 2, 5340, 5341, 7, 78, 2, 2, 5341, 5342,
+	7, 71, 2, 2, 5342, 724, 3, 2, 2, 2, 5343, 5344, 7, 70, 2, 2, 5344, 5345,
+	7, 75, 2, 2, 5345, 5346, 7, 84, 2, 2, 5346, 5347, 7, 71, 2, 2, 5347, 5348,
+	7, 69, 2, 2, 5348, 5349, 7, 86, 2, 2, 5349, 5350, 7, 81, 2, 2, 5350, 5351,
+	7, 84, 2, 2, 5351, 5352, 7, 91, 2, 2, 5352, 726, 3, 2, 2, 2, 5353, 5354,
+	7, 70, 2, 2, 5354, 5355, 7, 75, 2, 2, 5355, 5356, 7, 85, 2, 2, 5356, 5357,
+	7, 67, 2, 2, 5357, 5358, 7, 68, 2, 2, 5358, 5359, 7, 78, 2, 2, 5359, 5360,
+	7, 71, 2, 2, 5360, 728, 3, 2, 2, 2, 5361, 5362, 7, 70, 2, 2, 5362, 5363,
+	7, 75, 2, 2, 5363, 5364, 7, 85, 2, 2, 5364, 5365, 7, 69, 2, 2, 5365, 5366,
+	7, 67, 2, 2, 5366, 5367, 7, 84, 2, 2, 5367, 5368, 7, 70, 2, 2, 5368, 730,
+	3, 2, 2, 2, 5369, 5370, 7, 70, 2, 2, 5370, 5371, 7, 75, 2, 2, 5371, 5372,
+	7, 85, 2, 2, 5372, 5373, 7, 77, 2, 2, 5373, 732, 3, 2, 2, 2, 5374, 5375,
+	7, 70, 2, 2, 5375, 5376, 7, 81, 2, 2, 5376, 734, 3, 2, 2, 2, 5377, 5378,
+	7, 70, 2, 2, 5378, 5379, 7, 87, 2, 2, 5379, 5380, 7, 79, 2, 2, 5380, 5381,
+	7, 82, 2, 2, 5381, 5382, 7, 72, 2, 2, 5382, 5383, 7, 75, 2, 2, 5383, 5384,
+	7, 78, 2, 2, 5384, 5385, 7, 71, 2, 2, 5385, 736, 3, 2, 2, 2, 5386, 5387,
+	7, 70, 2, 2, 5387, 5388, 7, 87, 2, 2, 5388, 5389, 7, 82, 2, 2, 5389, 5390,
+	7, 78, 2, 2, 5390, 5391, 7, 75, 2, 2, 5391, 5392, 7, 69, 2, 2, 5392, 5393,
+	7, 67, 2, 2, 5393, 5394, 7, 86, 2, 2, 5394, 5395, 7, 71, 2, 2, 5395, 738,
+	3, 2, 2, 2, 5396, 5397, 7, 70, 2, 2, 5397, 5398, 7, 91, 2, 2, 5398, 5399,
+	7, 80, 2, 2, 5399, 5400, 7, 67, 2, 2, 5400, 5401, 7, 79, 2, 2, 5401, 5402,
+	7, 75, 2, 2, 5402, 5403, 7, 69, 2, 2, 5403, 740, 3, 2, 2, 2, 5404, 5405,
+	7, 71, 2, 2, 5405, 5406, 7, 80, 2, 2, 5406, 5407, 7, 67, 2, 2, 5407, 5408,
+	7, 68, 2, 2, 5408, 5409, 7, 78, 2, 2, 5409, 5410, 7, 71, 2, 2, 5410, 742,
+	3, 2, 2, 2, 5411, 5412, 7, 71, 2, 2, 5412, 5413, 7, 80, 2, 2, 5413, 5414,
+	7, 69, 2, 2, 5414, 5415, 7, 84, 2, 2, 5415, 5416, 7, 91, 2, 2, 5416, 5417,
+	7, 82, 2, 2, 5417, 5418, 7, 86, 2, 2, 5418, 5419, 7, 75, 2, 2, 5419, 5420,
+	7, 81, 2, 2, 5420, 5421, 7, 80, 2, 2, 5421, 744, 3, 2, 2, 2, 5422, 5423,
+	7, 71, 2, 2, 5423, 5424, 7, 80, 2, 2, 5424, 5425, 7, 70, 2, 2, 5425, 746,
+	3, 2, 2, 2, 5426, 5427, 7, 71, 2, 2, 5427, 5428, 7, 80, 2, 2, 5428, 5429,
+	7, 70, 2, 2, 5429, 5430, 7, 85, 2, 2, 5430, 748, 3, 2, 2, 2, 5431, 5432,
+	7, 71, 2, 2, 5432, 5433, 7, 80, 2, 2, 5433, 5434, 7, 73, 2, 2, 5434, 5435,
+	7, 75, 2, 2, 5435, 5436, 7, 80, 2, 2, 5436, 5437, 7, 71, 2, 2, 5437, 750,
+	3, 2, 2, 2, 5438, 5439, 7, 71, 2, 2, 5439, 5440, 7, 80, 2, 2, 5440, 5441,
+	7, 73, 2, 2, 5441, 5442, 7, 75, 2, 2, 5442, 5443, 7, 80, 2, 2, 5443, 5444,
+	7, 71, 2, 2, 5444, 5445, 7, 85, 2, 2, 5445, 752, 3, 2, 2, 2, 5446, 5447,
+	7, 71, 2, 2, 5447, 5448, 7, 84, 2, 2, 5448, 5449, 7, 84, 2, 2, 5449, 5450,
+	7, 81, 2, 2, 5450, 5451, 7, 84, 2, 2, 5451, 754, 3, 2, 2, 2, 5452, 5453,
+	7, 71, 2, 2, 5453, 5454, 7, 84, 2, 2, 5454, 5455, 7, 84, 2, 2, 5455, 5456,
+	7, 81, 2, 2, 5456, 5457, 7, 84, 2, 2, 5457, 5458, 7, 85, 2, 2, 5458, 756,
+	3, 2, 2, 2, 5459, 5460, 7, 71, 2, 2, 5460, 5461, 7, 85, 2, 2, 5461, 5462,
+	7, 69, 2, 2, 5462, 5463, 7, 67, 2, 2, 5463, 5464, 7, 82, 2, 2, 5464, 5465,
+	7, 71, 2, 2, 5465, 758, 3, 2, 2, 2, 5466, 5467, 7, 71, 2, 2, 5467, 5468,
+	7, 88, 2, 2, 5468, 5469, 7, 71, 2, 2, 5469, 5470, 7, 80, 2, 2, 5470, 760,
+	3, 2, 2, 2, 5471, 5472, 7, 71, 2, 2, 5472, 5473, 7, 88, 2, 2, 5473, 5474,
+	7, 71, 2, 2, 5474, 5475, 7, 80, 2, 2, 5475, 5476, 7, 86, 2, 2, 5476, 762,
+	3, 2, 2, 2, 5477, 5478, 7, 71, 2, 2, 5478, 5479, 7, 88, 2, 2, 5479, 5480,
+	7, 71, 2, 2, 5480, 5481, 7, 80, 2, 2, 5481, 5482, 7, 86, 2, 2, 5482, 5483,
+	7, 85, 2, 2, 5483, 764, 3, 2, 2, 2, 5484, 5485, 7, 71, 2, 2, 5485, 5486,
+	7, 88, 2, 2, 5486, 5487, 7, 71, 2, 2, 5487, 5488, 7, 84, 2, 2, 5488, 5489,
+	7, 91, 2, 2, 5489, 766, 3, 2, 2, 2, 5490, 5491, 7, 71, 2, 2, 5491, 5492,
+	7, 90, 2, 2, 5492, 5493, 7, 69, 2, 2, 5493, 5494, 7, 74, 2, 2, 5494, 5495,
+	7, 67, 2, 2, 5495, 5496, 7, 80, 2, 2, 5496, 5497, 7, 73, 2, 2, 5497, 5498,
+	7, 71, 2, 2, 5498, 768, 3, 2, 2, 2, 5499, 5500, 7, 71, 2, 2, 5500, 5501,
+	7, 90, 2, 2, 5501, 5502, 7, 69, 2, 2, 5502, 5503, 7, 78, 2, 2, 5503, 5504,
+	7, 87, 2, 2, 5504, 5505, 7, 85, 2, 2, 5505, 5506, 7, 75, 2, 2, 5506, 5507,
+	7, 88, 2, 2, 5507, 5508, 7, 71, 2, 2, 5508, 770, 3, 2, 2, 2, 5509, 5510,
+	7, 71, 2, 2, 5510, 5511, 7, 90, 2, 2, 5511, 5512, 7, 82, 2, 2, 5512, 5513,
+	7, 75, 2, 2, 5513, 5514, 7, 84, 2, 2, 5514, 5515, 7, 71, 2, 2, 5515, 772,
+	3, 2, 2, 2, 5516, 5517, 7, 71, 2, 2, 5517, 5518, 7, 90, 2, 2, 5518, 5519,
+	7, 82, 2, 2, 5519, 5520, 7, 81, 2, 2, 5520, 5521, 7, 84, 2, 2, 5521, 5522,
+	7, 86, 2, 2, 5522, 774, 3, 2, 2, 2, 5523, 5524, 7, 71, 2, 2, 5524, 5525,
+	7, 90, 2, 2, 5525, 5526, 7, 86, 2, 2, 5526, 5527, 7, 71, 2, 2, 5527, 5528,
+	7, 80, 2, 2, 5528, 5529, 7, 70, 2, 2, 5529, 5530, 7, 71, 2, 2, 5530, 5531,
+	7, 70, 2, 2, 5531, 776, 3, 2, 2, 2, 5532, 5533, 7, 71, 2, 2, 5533, 5534,
+	7, 90, 2, 2, 5534, 5535, 7, 86, 2, 2, 5535, 5536, 7, 71, 2, 2, 5536, 5537,
+	7, 80, 2, 2, 5537, 5538, 7, 86, 2, 2, 5538, 5539, 7, 97, 2, 2, 5539, 5540,
+	7, 85, 2, 2, 5540, 5541, 7, 75, 2, 2, 5541, 5542, 7, 92, 2, 2, 5542, 5543,
+	7, 71, 2, 2, 5543, 778, 3, 2, 2, 2, 5544, 5545, 7, 72, 2, 2, 5545, 5546,
+	7, 67, 2, 2, 5546, 5547, 7, 85, 2, 2, 5547, 5548, 7, 86, 2, 2, 5548, 780,
+	3, 2, 2, 2, 5549, 5550, 7, 72, 2, 2, 5550, 5551, 7, 67, 2, 2, 5551, 5552,
+	7, 87, 2, 2, 5552, 5553, 7, 78, 2, 2, 5553, 5554, 7, 86, 2, 2, 5554, 5555,
+	7, 85, 2, 2, 5555, 782, 3, 2, 2, 2, 5556, 5557, 7, 72, 2, 2, 5557, 5558,
+	7, 75, 2, 2, 5558, 5559, 7, 71, 2, 2, 5559, 5560, 7, 78, 2, 2, 5560, 5561,
+	7, 70, 2, 2, 5561, 5562, 7, 85, 2, 2, 5562, 784, 3, 2, 2, 2, 5563, 5564,
+	7, 72, 2, 2, 5564, 5565, 7, 75, 2, 2, 5565, 5566, 7, 78, 2, 2, 5566, 5567,
+	7, 71, 2, 2, 5567, 5568, 7, 97, 2, 2, 5568, 5569, 7, 68, 2, 2, 5569, 5570,
+	7, 78, 2, 2, 5570, 5571, 7, 81, 2, 2, 5571, 5572, 7, 69, 2, 2, 5572, 5573,
+	7, 77, 2, 2, 5573, 5574, 7, 97, 2, 2, 5574, 5575, 7, 85, 2, 2, 5575, 5576,
+	7, 75, 2, 2, 5576, 5577, 7, 92, 2, 2, 5577, 5578, 7, 71, 2, 2, 5578, 786,
+	3, 2, 2, 2, 5579, 5580, 7, 72, 2, 2, 5580, 5581, 7, 75, 2, 2, 5581, 5582,
+	7, 78, 2, 2, 5582, 5583, 7, 86, 2, 2, 5583, 5584, 7, 71, 2, 2, 5584, 5585,
+	7, 84, 2, 2, 5585, 788, 3, 2, 2, 2, 5586, 5587, 7, 72, 2, 2, 5587, 5588,
+	7, 75, 2, 2, 5588, 5589, 7, 84, 2, 2, 5589, 5590, 7, 85, 2, 2, 5590, 5591,
+	7, 86, 2, 2, 5591, 790, 3, 2, 2, 2, 5592, 5593, 7, 72, 2, 2, 5593, 5594,
+	7, 75, 2, 2, 5594, 5595, 7, 90, 2, 2, 5595, 5596, 7, 71, 2, 2, 5596, 5597,
+	7, 70, 2, 2, 5597, 792, 3, 2, 2, 2, 5598, 5599, 7, 72, 2, 2, 5599, 5600,
+	7, 78, 2, 2, 5600, 5601, 7, 87, 2, 2, 5601, 5602, 7, 85, 2, 2, 5602, 5603,
+	7, 74, 2, 2, 5603, 794, 3, 2, 2, 2, 5604, 5605, 7, 72, 2, 2, 5605, 5606,
+	7, 81, 2, 2, 5606, 5607, 7, 78, 2, 2, 5607, 5608, 7, 78, 2, 2, 5608, 5609,
+	7, 81, 2, 2, 5609, 5610, 7, 89, 2, 2, 5610, 5611, 7, 85, 2, 2, 5611, 796,
+	3, 2, 2, 2, 5612, 5613, 7, 72, 2, 2, 5613, 5614, 7, 81, 2, 2, 5614, 5615,
+	7, 87, 2, 2, 5615, 5616, 7, 80, 2, 2, 5616, 5617, 7, 70, 2, 2, 5617, 798,
+	3, 2, 2, 2, 5618, 5619, 7, 72, 2, 2, 5619, 5620, 7, 87, 2, 2, 5620, 5621,
+	7, 78, 2, 2, 5621, 5622, 7, 78, 2, 2, 5622, 800, 3, 2, 2, 2, 5623, 5624,
+	7, 72, 2, 2, 5624, 5625, 7, 87, 2, 2, 5625, 5626, 7, 80, 2, 2, 5626, 5627,
+	7, 69, 2, 2, 5627, 5628, 7, 86, 2, 2, 5628, 5629, 7, 75, 2, 2, 5629, 5630,
+	7, 81, 2, 2, 5630, 5631, 7, 80, 2, 2, 5631, 802, 3, 2, 2, 2, 5632, 5633,
+	7, 73, 2, 2, 5633, 5634, 7, 71, 2, 2, 5634, 5635, 7, 80, 2, 2, 5635, 5636,
+	7, 71, 2, 2, 5636, 5637, 7, 84, 2, 2, 5637, 5638, 7, 67, 2, 2, 5638, 5639,
+	7, 78, 2, 2, 5639, 804, 3, 2, 2, 2, 5640, 5641, 7, 73, 2, 2, 5641, 5642,
+	7, 78, 2, 2, 5642, 5643, 7, 81, 2, 2, 5643, 5644, 7, 68, 2, 2, 5644, 5645,
+	7, 67, 2, 2, 5645, 5646, 7, 78, 2, 2, 5646, 806, 3, 2, 2, 2, 5647, 5648,
+	7, 73, 2, 2, 5648, 5649, 7, 84, 2, 2, 5649, 5650, 7, 67, 2, 2, 5650, 5651,
+	7, 80, 2, 2, 5651, 5652, 7, 86, 2, 2, 5652, 5653, 7, 85, 2, 2, 5653, 808,
+	3, 2, 2, 2, 5654, 5655, 7, 73, 2, 2, 5655, 5656, 7, 84, 2, 2, 5656, 5657,
+	7, 81, 2, 2, 5657, 5658, 7, 87, 2, 2, 5658, 5659, 7, 82, 2, 2, 5659, 5660,
+	7, 97, 2, 2, 5660, 5661, 7, 84, 2, 2, 5661, 5662, 7, 71, 2, 2, 5662, 5663,
+	7, 82, 2, 2, 5663, 5664, 7, 78, 2, 2, 5664, 5665, 7, 75, 2, 2, 5665, 5666,
+	7, 69, 2, 2, 5666, 5667, 7, 67, 2, 2, 5667, 5668, 7, 86, 2, 2, 5668, 5669,
+	7, 75, 2, 2, 5669, 5670, 7, 81, 2, 2, 5670, 5671, 7, 80, 2, 2, 5671, 810,
+	3, 2, 2, 2, 5672, 5673, 7, 74, 2, 2, 5673, 5674, 7, 67, 2, 2, 5674, 5675,
+	7, 80, 2, 2, 5675, 5676, 7, 70, 2, 2, 5676, 5677, 7, 78, 2, 2, 5677, 5678,
+	7, 71, 2, 2, 5678, 5679, 7, 84, 2, 2, 5679, 812, 3, 2, 2, 2, 5680, 5681,
+	7, 74, 2, 2, 5681, 5682, 7, 67, 2, 2, 5682, 5683, 7, 85, 2, 2, 5683, 5684,
+	7, 74, 2, 2, 5684, 814, 3, 2, 2, 2, 5685, 5686, 7, 74, 2, 2, 5686, 5687,
+	7, 71, 2, 2, 5687, 5688, 7, 78, 2, 2, 5688, 5689, 7, 82, 2, 2, 5689, 816,
+	3, 2, 2, 2, 5690, 5691, 7, 74, 2, 2, 5691, 5692, 7, 81, 2, 2, 5692, 5693,
+	7, 85, 2, 2, 5693, 5694, 7, 86, 2, 2, 5694, 818, 3, 2, 2, 2, 5695, 5696,
+	7, 74, 2, 2, 5696, 5697, 7, 81, 2, 2, 5697, 5698, 7, 85, 2, 2, 5698, 5699,
+	7, 86, 2, 2, 5699, 5700, 7, 85, 2, 2, 5700, 820, 3, 2, 2, 2, 5701, 5702,
+	7, 75, 2, 2, 5702, 5703, 7, 70, 2, 2, 5703, 5704, 7, 71, 2, 2, 5704, 5705,
+	7, 80, 2, 2, 5705, 5706, 7, 86, 2, 2, 5706, 5707, 7, 75, 2, 2, 5707, 5708,
+	7, 72, 2, 2, 5708, 5709, 7, 75, 2, 2, 5709, 5710, 7, 71, 2, 2, 5710, 5711,
+	7, 70, 2, 2, 5711, 822, 3, 2, 2, 2, 5712, 5713, 7, 75, 2, 2, 5713, 5714,
+	7, 73, 2, 2, 5714, 5715, 7, 80, 2, 2, 5715, 5716, 7, 81, 2, 2, 5716, 5717,
+	7, 84, 2, 2, 5717, 5718, 7, 71, 2, 2, 5718, 5719, 7, 97, 2, 2, 5719, 5720,
+	7, 85, 2, 2, 5720, 5721, 7, 71, 2, 2, 5721, 5722, 7, 84, 2, 2, 5722, 5723,
+	7, 88, 2, 2, 5723, 5724, 7, 71, 2, 2, 5724, 5725, 7, 84, 2, 2, 5725, 5726,
+	7, 97, 2, 2, 5726, 5727, 7, 75, 2, 2, 5727, 5728, 7, 70, 2, 2, 5728, 5729,
+	7, 85, 2, 2, 5729, 824, 3, 2, 2, 2, 5730, 5731, 7, 75, 2, 2, 5731, 5732,
+	7, 79, 2, 2, 5732, 5733, 7, 82, 2, 2, 5733, 5734, 7, 81, 2, 2, 5734, 5735,
+	7, 84, 2, 2, 5735, 5736, 7, 86, 2, 2, 5736, 826, 3, 2, 2, 2, 5737, 5738,
+	7, 75, 2, 2, 5738, 5739, 7, 80, 2, 2, 5739, 5740, 7, 70, 2, 2, 5740, 5741,
+	7, 71, 2, 2, 5741, 5742, 7, 90, 2, 2, 5742, 5743, 7, 71, 2, 2, 5743, 5744,
+	7, 85, 2, 2, 5744, 828, 3, 2, 2, 2, 5745, 5746, 7, 75, 2, 2, 5746, 5747,
+	7, 80, 2, 2, 5747, 5748, 7, 75, 2, 2, 5748, 5749, 7, 86, 2, 2, 5749, 5750,
+	7, 75, 2, 2, 5750, 5751, 7, 67, 2, 2, 5751, 5752, 7, 78, 2, 2, 5752, 5753,
+	7, 97, 2, 2, 5753, 5754, 7, 85, 2, 2, 5754, 5755, 7, 75, 2, 2, 5755, 5756,
+	7, 92, 2, 2, 5756, 5757, 7, 71, 2, 2, 5757, 830, 3, 2, 2, 2, 5758, 5759,
+	7, 75, 2, 2, 5759, 5760, 7, 80, 2, 2, 5760, 5761, 7, 82, 2, 2, 5761, 5762,
+	7, 78, 2, 2, 5762, 5763, 7, 67, 2, 2, 5763, 5764, 7, 69, 2, 2, 5764, 5765,
+	7, 71, 2, 2, 5765, 832, 3, 2, 2, 2, 5766, 5767, 7, 75, 2, 2, 5767, 5768,
+	7, 80, 2, 2, 5768, 5769, 7, 85, 2, 2, 5769, 5770, 7, 71, 2, 2, 5770, 5771,
+	7, 84, 2, 2, 5771, 5772, 7, 86, 2, 2, 5772, 5773, 7, 97, 2, 2, 5773, 5774,
+	7, 79, 2, 2, 5774, 5775, 7, 71, 2, 2, 5775, 5776, 7, 86, 2, 2, 5776, 5777,
+	7, 74, 2, 2, 5777, 5778, 7, 81, 2, 2, 5778, 5779, 7, 70, 2, 2, 5779, 834,
+	3, 2, 2, 2, 5780, 5781, 7, 75, 2, 2, 5781, 5782, 7, 80, 2, 2, 5782, 5783,
+	7, 85, 2, 2, 5783, 5784, 7, 86, 2, 2, 5784, 5785, 7, 67, 2, 2, 5785, 5786,
+	7, 78, 2, 2, 5786, 5787, 7, 78, 2, 2, 5787, 836, 3, 2, 2, 2, 5788, 5789,
+	7, 75, 2, 2, 5789, 5790, 7, 80, 2, 2, 5790, 5791, 7, 85, 2, 2, 5791, 5792,
+	7, 86, 2, 2, 5792, 5793, 7, 67, 2, 2, 5793, 5794, 7, 80, 2, 2, 5794, 5795,
+	7, 69, 2, 2, 5795, 5796, 7, 71, 2, 2, 5796, 838, 3, 2, 2, 2, 5797, 5798,
+	7, 75, 2, 2, 5798, 5799, 7, 80, 2, 2, 5799, 5800, 7, 85, 2, 2, 5800, 5801,
+	7, 86, 2, 2, 5801, 5802, 7, 67, 2, 2, 5802, 5803, 7, 80, 2, 2, 5803, 5804,
+	7, 86, 2, 2, 5804, 840, 3, 2, 2, 2, 5805, 5806, 7, 75, 2, 2, 5806, 5807,
+	7, 80, 2, 2, 5807, 5808, 7, 88, 2, 2, 5808, 5809, 7, 75, 2, 2, 5809, 5810,
+	7, 85, 2, 2, 5810, 5811, 7, 75, 2, 2, 5811, 5812, 7, 68, 2, 2, 5812, 5813,
+	7, 78, 2, 2, 5813, 5814, 7, 71, 2, 2, 5814, 842, 3, 2, 2, 2, 5815, 5816,
+	7, 75, 2, 2, 5816, 5817, 7, 80, 2, 2, 5817, 5818, 7, 88, 2, 2, 5818, 5819,
+	7, 81, 2, 2, 5819, 5820, 7, 77, 2, 2, 5820, 5821, 7, 71, 2, 2, 5821, 5822,
+	7, 84, 2, 2, 5822, 844, 3, 2, 2, 2, 5823, 5824, 7, 75, 2, 2, 5824, 5825,
+	7, 81, 2, 2, 5825, 846, 3, 2, 2, 2, 5826, 5827, 7, 75, 2, 2, 5827, 5828,
+	7, 81, 2, 2, 5828, 5829, 7, 97, 2, 2, 5829, 5830, 7, 86, 2, 2, 5830, 5831,
+	7, 74, 2, 2, 5831, 5832, 7, 84, 2, 2, 5832, 5833, 7, 71, 2, 2, 5833, 5834,
+	7, 67, 2, 2, 5834, 5835, 7, 70, 2, 2, 5835, 848, 3, 2, 2, 2, 5836, 5837,
+	7, 75, 2, 2, 5837, 5838, 7, 82, 2, 2, 5838, 5839, 7, 69, 2, 2, 5839, 850,
+	3, 2, 2, 2, 5840, 5841, 7, 75, 2, 2, 5841, 5842, 7, 85, 2, 2, 5842, 5843,
+	7, 81, 2, 2, 5843, 5844, 7, 78, 2, 2, 5844, 5845, 7, 67, 2, 2, 5845, 5846,
+	7, 86, 2, 2, 5846, 5847, 7, 75, 2, 2, 5847, 5848, 7, 81, 2, 2, 5848, 5849,
+	7, 80, 2, 2, 5849, 852, 3, 2, 2, 2, 5850, 5851, 7, 75, 2, 2, 5851, 5852,
+	7, 85, 2, 2, 5852, 5853, 7, 85, 2, 2, 5853, 5854, 7, 87, 2, 2, 5854, 5855,
+	7, 71, 2, 2, 5855, 5856, 7, 84, 2, 2, 5856, 854, 3, 2, 2, 2, 5857, 5858,
+	7, 76, 2, 2, 5858, 5859, 7, 85, 2, 2, 5859, 5860, 7, 81, 2, 2, 5860, 5861,
+	7, 80, 2, 2, 5861, 856, 3, 2, 2, 2, 5862, 5863, 7, 77, 2, 2, 5863, 5864,
+	7, 71, 2, 2, 5864, 5865, 7, 91, 2, 2, 5865, 5866, 7, 97, 2, 2, 5866, 5867,
+	7, 68, 2, 2, 5867, 5868, 7, 78, 2, 2, 5868, 5869, 7, 81, 2, 2, 5869, 5870,
+	7, 69, 2, 2, 5870, 5871, 7, 77, 2, 2, 5871, 5872, 7, 97, 2, 2, 5872, 5873,
+	7, 85, 2, 2, 5873, 5874, 7, 75, 2, 2, 5874, 5875, 7, 92, 2, 2, 5875, 5876,
+	7, 71, 2, 2, 5876, 858, 3, 2, 2, 2, 5877, 5878, 7, 78, 2, 2, 5878, 5879,
+	7, 67, 2, 2, 5879, 5880, 7, 80, 2, 2, 5880, 5881, 7, 73, 2, 2, 5881, 5882,
+	7, 87, 2, 2, 5882, 5883, 7, 67, 2, 2, 5883, 5884, 7, 73, 2, 2, 5884, 5885,
+	7, 71, 2, 2, 5885, 860, 3, 2, 2, 2, 5886, 5887, 7, 78, 2, 2, 5887, 5888,
+	7, 67, 2, 2, 5888, 5889, 7, 85, 2, 2, 5889, 5890, 7, 86, 2, 2, 5890, 862,
+	3, 2, 2, 2, 5891, 5892, 7, 78, 2, 2, 5892, 5893, 7, 71, 2, 2, 5893, 5894,
+	7, 67, 2, 2, 5894, 5895, 7, 88, 2, 2, 5895, 5896, 7, 71, 2, 2, 5896, 5897,
+	7, 85, 2, 2, 5897, 864, 3, 2, 2, 2, 5898, 5899, 7, 78, 2, 2, 5899, 5900,
+	7, 71, 2, 2, 5900, 5901, 7, 85, 2, 2, 5901, 5902, 7, 85, 2, 2, 5902, 866,
+	3, 2, 2, 2, 5903, 5904, 7, 78, 2, 2, 5904, 5905, 7, 71, 2, 2, 5905, 5906,
+	7, 88, 2, 2, 5906, 5907, 7, 71, 2, 2, 5907, 5908, 7, 78, 2, 2, 5908, 868,
+	3, 2, 2, 2, 5909, 5910, 7, 78, 2, 2, 5910, 5911, 7, 75, 2, 2, 5911, 5912,
+	7, 85, 2, 2, 5912, 5913, 7, 86, 2, 2, 5913, 870, 3, 2, 2, 2, 5914, 5915,
+	7, 78, 2, 2, 5915, 5916, 7, 81, 2, 2, 5916, 5917, 7, 69, 2, 2, 5917, 5918,
+	7, 67, 2, 2, 5918, 5919, 7, 78, 2, 2, 5919, 872, 3, 2, 2, 2, 5920, 5921,
+	7, 78, 2, 2, 5921, 5922, 7, 81, 2, 2, 5922, 5923, 7, 73, 2, 2, 5923, 5924,
+	7, 72, 2, 2, 5924, 5925, 7, 75, 2, 2, 5925, 5926, 7, 78, 2, 2, 5926, 5927,
+	7, 71, 2, 2, 5927, 874, 3, 2, 2, 2, 5928, 5929, 7, 78, 2, 2, 5929, 5930,
+	7, 81, 2, 2, 5930, 5931, 7, 73, 2, 2, 5931, 5932, 7, 85, 2, 2, 5932, 876,
+	3, 2, 2, 2, 5933, 5934, 7, 79, 2, 2, 5934, 5935, 7, 67, 2, 2, 5935, 5936,
+	7, 85, 2, 2, 5936, 5937, 7, 86, 2, 2, 5937, 5938, 7, 71, 2, 2, 5938, 5939,
+	7, 84, 2, 2, 5939, 878, 3, 2, 2, 2, 5940, 5941, 7, 79, 2, 2, 5941, 5942,
+	7, 67, 2, 2, 5942, 5943, 7, 85, 2, 2, 5943, 5944, 7, 86, 2, 2, 5944, 5945,
+	7, 71, 2, 2, 5945, 5946, 7, 84, 2, 2, 5946, 5947, 7, 97, 2, 2, 5947, 5948,
+	7, 67, 2, 2, 5948, 5949, 7, 87, 2, 2, 5949, 5950, 7, 86, 2, 2, 5950, 5951,
+	7, 81, 2, 2, 5951, 5952, 7, 97, 2, 2, 5952, 5953, 7, 82, 2, 2, 5953, 5954,
+	7, 81, 2, 2, 5954, 5955, 7, 85, 2, 2, 5955, 5956, 7, 75, 2, 2, 5956, 5957,
+	7, 86, 2, 2, 5957, 5958, 7, 75, 2, 2, 5958, 5959, 7, 81, 2, 2, 5959, 5960,
+	7, 80, 2, 2, 5960, 880, 3, 2, 2, 2, 5961, 5962, 7, 79, 2, 2, 5962, 5963,
+	7, 67, 2, 2, 5963, 5964, 7, 85, 2, 2, 5964, 5965, 7, 86, 2, 2, 5965, 5966,
+	7, 71, 2, 2, 5966, 5967, 7, 84, 2, 2, 5967, 5968, 7, 97, 2, 2, 5968, 5969,
+	7, 69, 2, 2, 5969, 5970, 7, 81, 2, 2, 5970, 5971, 7, 80, 2, 2, 5971, 5972,
+	7, 80, 2, 2, 5972, 5973, 7, 71, 2, 2, 5973, 5974, 7, 69, 2, 2, 5974, 5975,
+	7, 86, 2, 2, 5975, 5976, 7, 97, 2, 2, 5976, 5977, 7, 84, 2, 2, 5977, 5978,
+	7, 71, 2, 2, 5978, 5979, 7, 86, 2, 2, 5979, 5980, 7, 84, 2, 2, 5980, 5981,
+	7, 91, 2, 2, 5981, 882, 3, 2, 2, 2, 5982, 5983, 7, 79, 2, 2, 5983, 5984,
+	7, 67, 2, 2, 5984, 5985, 7, 85, 2, 2, 5985, 5986, 7, 86, 2, 2, 5986, 5987,
+	7, 71, 2, 2, 5987, 5988, 7, 84, 2, 2, 5988, 5989, 7, 97, 2, 2, 5989, 5990,
+	7, 70, 2, 2, 5990, 5991, 7, 71, 2, 2, 5991, 5992, 7, 78, 2, 2, 5992, 5993,
+	7, 67, 2, 2, 5993, 5994, 7, 91, 2, 2, 5994, 884, 3, 2, 2, 2, 5995, 5996,
+	7, 79, 2, 2, 5996, 5997, 7, 67, 2, 2, 5997, 5998, 7, 85, 2, 2, 5998, 5999,
+	7, 86, 2, 2, 5999, 6000, 7, 71, 2, 2, 6000, 6001, 7, 84, 2, 2, 6001, 6002,
+	7, 97, 2, 2, 6002, 6003, 7, 74, 2, 2, 6003, 6004, 7, 71, 2, 2, 6004, 6005,
+	7, 67, 2, 2, 6005, 6006, 7, 84, 2, 2, 6006, 6007, 7, 86, 2, 2, 6007, 6008,
+	7, 68, 2, 2, 6008, 6009, 7, 71, 2, 2, 6009, 6010, 7, 67, 2, 2, 6010, 6011,
+	7, 86, 2, 2, 6011, 6012, 7, 97, 2, 2, 6012, 6013, 7, 82, 2, 2, 6013, 6014,
+	7, 71, 2, 2, 6014, 6015, 7, 84, 2, 2, 6015, 6016, 7, 75, 2, 2, 6016, 6017,
+	7, 81, 2, 2, 6017, 6018, 7, 70, 2, 2, 6018, 886, 3, 2, 2, 2, 6019, 6020,
+	7, 79, 2, 2, 6020, 6021, 7, 67, 2, 2, 6021, 6022, 7, 85, 2, 2, 6022, 6023,
+	7, 86, 2, 2, 6023, 6024, 7, 71, 2, 2, 6024, 6025, 7, 84, 2, 2, 6025, 6026,
+	7, 97, 2, 2, 6026, 6027, 7, 74, 2, 2, 6027, 6028, 7, 81, 2, 2, 6028, 6029,
+	7, 85, 2, 2, 6029, 6030, 7, 86, 2, 2, 6030, 888, 3, 2, 2, 2, 6031, 6032,
+	7, 79, 2, 2, 6032, 6033, 7, 67, 2, 2, 6033, 6034, 7, 85, 2, 2, 6034, 6035,
+	7, 86, 2, 2, 6035, 6036, 7, 71, 2, 2, 6036, 6037, 7, 84, 2, 2, 6037, 6038,
+	7, 97, 2, 2, 6038, 6039, 7, 78, 2, 2, 6039, 6040, 7, 81, 2, 2, 6040, 6041,
+	7, 73, 2, 2, 6041, 6042, 7, 97, 2, 2, 6042, 6043, 7, 72, 2, 2, 6043, 6044,
+	7, 75, 2, 2, 6044, 6045, 7, 78, 2, 2, 6045, 6046, 7, 71, 2, 2, 6046, 890,
+	3, 2, 2, 2, 6047, 6048, 7, 79, 2, 2, 6048, 6049, 7, 67, 2, 2, 6049, 6050,
+	7, 85, 2, 2, 6050, 6051, 7, 86, 2, 2, 6051, 6052, 7, 71, 2, 2, 6052, 6053,
+	7, 84, 2, 2, 6053, 6054, 7, 97, 2, 2, 6054, 6055, 7, 78, 2, 2, 6055, 6056,
+	7, 81, 2, 2, 6056, 6057, 7, 73, 2, 2, 6057, 6058, 7, 97, 2, 2, 6058, 6059,
+	7, 82, 2, 2, 6059, 6060, 7, 81, 2, 2, 6060, 6061, 7, 85, 2, 2, 6061, 892,
+	3, 2, 2, 2, 6062, 6063, 7, 79, 2, 2, 6063, 6064, 7, 67, 2, 2, 6064, 6065,
+	7, 85, 2, 2, 6065, 6066, 7, 86, 2, 2, 6066, 6067, 7, 71, 2, 2, 6067, 6068,
+	7, 84, 2, 2, 6068, 6069, 7, 97, 2, 2, 6069, 6070, 7, 82, 2, 2, 6070, 6071,
+	7, 67, 2, 2, 6071, 6072, 7, 85, 2, 2, 6072, 6073, 7, 85, 2, 2, 6073, 6074,
+	7, 89, 2, 2, 6074, 6075, 7, 81, 2, 2, 6075, 6076, 7, 84, 2, 2, 6076, 6077,
+	7, 70, 2, 2, 6077, 894, 3, 2, 2, 2, 6078, 6079, 7, 79, 2, 2, 6079, 6080,
+	7, 67, 2, 2, 6080, 6081, 7, 85, 2, 2, 6081, 6082, 7, 86, 2, 2, 6082, 6083,
+	7, 71, 2, 2, 6083, 6084, 7, 84, 2, 2, 6084, 6085, 7, 97, 2, 2, 6085, 6086,
+	7, 82, 2, 2, 6086, 6087, 7, 81, 2, 2, 6087, 6088, 7, 84, 2, 2, 6088, 6089,
+	7, 86, 2, 2, 6089, 896, 3, 2, 2, 2, 6090, 6091, 7, 79, 2, 2, 6091, 6092,
+	7, 67, 2, 2, 6092, 6093, 7, 85, 2, 2, 6093, 6094, 7, 86, 2, 2, 6094, 6095,
+	7, 71, 2, 2, 6095, 6096, 7, 84, 2, 2, 6096, 6097, 7, 97, 2, 2, 6097, 6098,
+	7, 84, 2, 2, 6098, 6099, 7, 71, 2, 2, 6099, 6100, 7, 86, 2, 2, 6100, 6101,
+	7, 84, 2, 2, 6101, 6102, 7, 91, 2, 2, 6102, 6103, 7, 97, 2, 2, 6103, 6104,
+	7, 69, 2, 2, 6104, 6105, 7, 81, 2, 2, 6105, 6106, 7, 87, 2, 2, 6106, 6107,
+	7, 80, 2, 2, 6107, 6108, 7, 86, 2, 2, 6108, 898, 3, 2, 2, 2, 6109, 6110,
+	7, 79, 2, 2, 6110, 6111, 7, 67, 2, 2, 6111, 6112, 7, 85, 2, 2, 6112, 6113,
+	7, 86, 2, 2, 6113, 6114, 7, 71, 2, 2, 6114, 6115, 7, 84, 2, 2, 6115, 6116,
+	7, 97, 2, 2, 6116, 6117, 7, 85, 2, 2, 6117, 6118, 7, 85, 2, 2, 6118, 6119,
+	7, 78, 2, 2, 6119, 900, 3, 2, 2, 2, 6120, 6121, 7, 79, 2, 2, 6121, 6122,
+	7, 67, 2, 2, 6122, 6123, 7, 85, 2, 2, 6123, 6124, 7, 86, 2, 2, 6124, 6125,
+	7, 71, 2, 2, 6125, 6126, 7, 84, 2, 2, 6126, 6127, 7, 97, 2, 2, 6127, 6128,
+	7, 85, 2, 2, 6128, 6129, 7, 85, 2, 2, 6129, 6130, 7, 78, 2, 2, 6130, 6131,
+	7, 97, 2, 2, 6131, 6132, 7, 69, 2, 2, 6132, 6133, 7, 67, 2, 2, 6133, 902,
+	3, 2, 2, 2, 6134, 6135, 7, 79, 2, 2, 6135, 6136, 7, 67, 2, 2, 6136, 6137,
+	7, 85, 2, 2, 6137, 6138, 7, 86, 2, 2, 6138, 6139, 7, 71, 2, 2, 6139, 6140,
+	7, 84, 2, 2, 6140, 6141, 7, 97, 2, 2, 6141, 6142, 7, 85, 2, 2, 6142, 6143,
+	7, 85, 2, 2, 6143, 6144, 7, 78, 2, 2, 6144, 6145, 7, 97, 2, 2, 6145, 6146,
+	7, 69, 2, 2, 6146, 6147, 7, 67, 2, 2, 6147, 6148, 7, 82, 2, 2, 6148, 6149,
+	7, 67, 2, 2, 6149, 6150, 7, 86, 2, 2, 6150, 6151, 7, 74, 2, 2, 6151, 904,
+	3, 2, 2, 2, 6152, 6153, 7, 79, 2, 2, 6153, 6154, 7, 67, 2, 2, 6154, 6155,
+	7, 85, 2, 2, 6155, 6156, 7, 86, 2, 2, 6156, 6157, 7, 71, 2, 2, 6157, 6158,
+	7, 84, 2, 2, 6158, 6159, 7, 97, 2, 2, 6159, 6160, 7, 85, 2, 2, 6160, 6161,
+	7, 85, 2, 2, 6161, 6162, 7, 78, 2, 2, 6162, 6163, 7, 97, 2, 2, 6163, 6164,
+	7, 69, 2, 2, 6164, 6165, 7, 71, 2, 2, 6165, 6166, 7, 84, 2, 2, 6166, 6167,
+	7, 86, 2, 2, 6167, 906, 3, 2, 2, 2, 6168, 6169, 7, 79, 2, 2, 6169, 6170,
+	7, 67, 2, 2, 6170, 6171, 7, 85, 2, 2, 6171, 6172, 7, 86, 2, 2, 6172, 6173,
+	7, 71, 2, 2, 6173, 6174, 7, 84, 2, 2, 6174, 6175, 7, 97, 2, 2, 6175, 6176,
+	7, 85, 2, 2, 6176, 6177, 7, 85, 2, 2, 6177, 6178, 7, 78, 2, 2, 6178, 6179,
+	7, 97, 2, 2, 6179, 6180, 7, 69, 2, 2, 6180, 6181, 7, 75, 2, 2, 6181, 6182,
+	7, 82, 2, 2, 6182, 6183, 7, 74, 2, 2, 6183, 6184, 7, 71, 2, 2, 6184, 6185,
+	7, 84, 2, 2, 6185, 908, 3, 2, 2, 2, 6186, 6187, 7, 79, 2, 2, 6187, 6188,
+	7, 67, 2, 2, 6188, 6189, 7, 85, 2, 2, 6189, 6190, 7, 86, 2, 2, 6190, 6191,
+	7, 71, 2, 2, 6191, 6192, 7, 84, 2, 2, 6192, 6193, 7, 97, 2, 2, 6193, 6194,
+	7, 85, 2, 2, 6194, 6195, 7, 85, 2, 2, 6195, 6196, 7, 78, 2, 2, 6196, 6197,
+	7, 97, 2, 2, 6197, 6198, 7, 69, 2, 2, 6198, 6199, 7, 84, 2, 2, 6199, 6200,
+	7, 78, 2, 2, 6200, 910, 3, 2, 2, 2, 6201, 6202, 7, 79, 2, 2, 6202, 6203,
+	7, 67, 2, 2, 6203, 6204, 7, 85, 2, 2, 6204, 6205, 7, 86, 2, 2, 6205, 6206,
+	7, 71, 2, 2, 6206, 6207, 7, 84, 2, 2, 6207, 6208, 7, 97, 2, 2, 6208, 6209,
+	7, 85, 2, 2, 6209, 6210, 7, 85, 2, 2, 6210, 6211, 7, 78, 2, 2, 6211, 6212,
+	7, 97, 2, 2, 6212, 6213, 7, 69, 2, 2, 6213, 6214, 7, 84, 2, 2, 6214, 6215,
+	7, 78, 2, 2, 6215, 6216, 7, 82, 2, 2, 6216, 6217, 7, 67, 2, 2, 6217, 6218,
+	7, 86, 2, 2, 6218, 6219, 7, 74, 2, 2, 6219, 912, 3, 2, 2, 2, 6220, 6221,
+	7, 79, 2, 2, 6221, 6222, 7, 67, 2, 2, 6222, 6223, 7, 85, 2, 2, 6223, 6224,
+	7, 86, 2, 2, 6224, 6225, 7, 71, 2, 2, 6225, 6226, 7, 84, 2, 2, 6226, 6227,
+	7, 97, 2, 2, 6227, 6228, 7, 85, 2, 2, 6228, 6229, 7, 85, 2, 2, 6229, 6230,
+	7, 78, 2, 2, 6230, 6231, 7, 97, 2, 2, 6231, 6232, 7, 77, 2, 2, 6232, 6233,
+	7, 71, 2, 2, 6233, 6234, 7, 91, 2, 2, 6234, 914, 3, 2, 2, 2, 6235, 6236,
+	7, 79, 2, 2, 6236, 6237, 7, 67, 2, 2, 6237, 6238, 7, 85, 2, 2, 6238, 6239,
+	7, 86, 2, 2, 6239, 6240, 7, 71, 2, 2, 6240, 6241, 7, 84, 2, 2, 6241, 6242,
+	7, 97, 2, 2, 6242, 6243, 7, 86, 2, 2, 6243, 6244, 7, 78, 2, 2, 6244, 6245,
+	7, 85, 2, 2, 6245, 6246, 7, 97, 2, 2, 6246, 6247, 7, 88, 2, 2, 6247, 6248,
+	7, 71, 2, 2, 6248, 6249, 7, 84, 2, 2, 6249, 6250, 7, 85, 2, 2, 6250, 6251,
+	7, 75, 2, 2, 6251, 6252, 7, 81, 2, 2, 6252, 6253, 7, 80, 2, 2, 6253, 916,
+	3, 2, 2, 2, 6254, 6255, 7, 79, 2, 2, 6255, 6256, 7, 67, 2, 2, 6256, 6257,
+	7, 85, 2, 2, 6257, 6258, 7, 86, 2, 2, 6258, 6259, 7, 71, 2, 2, 6259, 6260,
+	7, 84, 2, 2, 6260, 6261, 7, 97, 2, 2, 6261, 6262, 7, 87, 2, 2, 6262, 6263,
+	7, 85, 2, 2, 6263, 6264, 7, 71, 2, 2, 6264, 6265, 7, 84, 2, 2, 6265, 918,
+	3, 2, 2, 2, 6266, 6267, 7, 79, 2, 2, 6267, 6268, 7, 67, 2, 2, 6268, 6269,
+	7, 90, 2, 2, 6269, 6270, 7, 97, 2, 2, 6270, 6271, 7, 69, 2, 2, 6271, 6272,
+	7, 81, 2, 2, 6272, 6273, 7, 80, 2, 2, 6273, 6274, 7, 80, 2, 2, 6274, 6275,
+	7, 71, 2, 2, 6275, 6276, 7, 69, 2, 2, 6276, 6277, 7, 86, 2, 2, 6277, 6278,
+	7, 75, 2, 2, 6278, 6279, 7, 81, 2, 2, 6279, 6280, 7, 80, 2, 2, 6280, 6281,
+	7, 85, 2, 2, 6281, 6282, 7, 97, 2, 2, 6282, 6283, 7, 82, 2, 2, 6283, 6284,
+	7, 71, 2, 2, 6284, 6285, 7, 84, 2, 2, 6285, 6286, 7, 97, 2, 2, 6286, 6287,
+	7, 74, 2, 2, 6287, 6288, 7, 81, 2, 2, 6288, 6289, 7, 87, 2, 2, 6289, 6290,
+	7, 84, 2, 2, 6290, 920, 3, 2, 2, 2, 6291, 6292, 7, 79, 2, 2, 6292, 6293,
+	7, 67, 2, 2, 6293, 6294, 7, 90, 2, 2, 6294, 6295, 7, 97, 2, 2, 6295, 6296,
+	7, 83, 2, 2, 6296, 6297, 7, 87, 2, 2, 6297, 6298, 7, 71, 2, 2, 6298, 6299,
+	7, 84, 2, 2, 6299, 6300, 7, 75, 2, 2, 6300, 6301, 7, 71, 2, 2, 6301, 6302,
+	7, 85, 2, 2, 6302, 6303, 7, 97, 2, 2, 6303, 6304, 7, 82, 2, 2, 6304, 6305,
+	7, 71, 2, 2, 6305, 6306, 7, 84, 2, 2, 6306, 6307, 7, 97, 2, 2, 6307, 6308,
+	7, 74, 2, 2, 6308, 6309, 7, 81, 2, 2, 6309, 6310, 7, 87, 2, 2, 6310, 6311,
+	7, 84, 2, 2, 6311, 922, 3, 2, 2, 2, 6312, 6313, 7, 79, 2, 2, 6313, 6314,
+	7, 67, 2, 2, 6314, 6315, 7, 90, 2, 2, 6315, 6316, 7, 97, 2, 2, 6316, 6317,
+	7, 84, 2, 2, 6317, 6318, 7, 81, 2, 2, 6318, 6319, 7, 89, 2, 2, 6319, 6320,
+	7, 85, 2, 2, 6320, 924, 3, 2, 2, 2, 6321, 6322, 7, 79, 2, 2, 6322, 6323,
+	7, 67, 2, 2, 6323, 6324, 7, 90, 2, 2, 6324, 6325, 7, 97, 2, 2, 6325, 6326,
+	7, 85, 2, 2, 6326, 6327, 7, 75, 2, 2, 6327, 6328, 7, 92, 2, 2, 6328, 6329,
+	7, 71, 2, 2, 6329, 926, 3, 2, 2, 2, 6330, 6331, 7, 79, 2, 2, 6331, 6332,
+	7, 67, 2, 2, 6332, 6333, 7, 90, 2, 2, 6333, 6334, 7, 97, 2, 2, 6334, 6335,
+	7, 87, 2, 2, 6335, 6336, 7, 82, 2, 2, 6336, 6337, 7, 70, 2, 2, 6337, 6338,
+	7, 67, 2, 2, 6338, 6339, 7, 86, 2, 2, 6339, 6340, 7, 71, 2, 2, 6340, 6341,
+	7, 85, 2, 2, 6341, 6342, 7, 97, 2, 2, 6342, 6343, 7, 82, 2, 2, 6343, 6344,
+	7, 71, 2, 2, 6344, 6345, 7, 84, 2, 2, 6345, 6346, 7, 97, 2, 2, 6346, 6347,
+	7, 74, 2, 2, 6347, 6348, 7, 81, 2, 2, 6348, 6349, 7, 87, 2, 2, 6349, 6350,
+	7, 84, 2, 2, 6350, 928, 3, 2, 2, 2, 6351, 6352, 7, 79, 2, 2, 6352, 6353,
+	7, 67, 2, 2, 6353, 6354, 7, 90, 2, 2, 6354, 6355, 7, 97, 2, 2, 6355, 6356,
+	7, 87, 2, 2, 6356, 6357, 7, 85, 2, 2, 6357, 6358, 7, 71, 2, 2, 6358, 6359,
+	7, 84, 2, 2, 6359, 6360, 7, 97, 2, 2, 6360, 6361, 7, 69, 2, 2, 6361, 6362,
+	7, 81, 2, 2, 6362, 6363, 7, 80, 2, 2, 6363, 6364, 7, 80, 2, 2, 6364, 6365,
+	7, 71, 2, 2, 6365, 6366, 7, 69, 2, 2, 6366, 6367, 7, 86, 2, 2, 6367, 6368,
+	7, 75, 2, 2, 6368, 6369, 7, 81, 2, 2, 6369, 6370, 7, 80, 2, 2, 6370, 6371,
+	7, 85, 2, 2, 6371, 930, 3, 2, 2, 2, 6372, 6373, 7, 79, 2, 2, 6373, 6374,
+	7, 71, 2, 2, 6374, 6375, 7, 70, 2, 2, 6375, 6376, 7, 75, 2, 2, 6376, 6377,
+	7, 87, 2, 2, 6377, 6378, 7, 79, 2, 2, 6378, 932, 3, 2, 2, 2, 6379, 6380,
+	7, 79, 2, 2, 6380, 6381, 7, 71, 2, 2, 6381, 6382, 7, 79, 2, 2, 6382, 6383,
+	7, 68, 2, 2, 6383, 6384, 7, 71, 2, 2, 6384, 6385, 7, 84, 2, 2, 6385, 934,
+	3, 2, 2, 2, 6386, 6387, 7, 79, 2, 2, 6387, 6388, 7, 71, 2, 2, 6388, 6389,
+	7, 84, 2, 2, 6389, 6390, 7, 73, 2, 2, 6390, 6391, 7, 71, 2, 2, 6391, 936,
+	3, 2, 2, 2, 6392, 6393, 7, 79, 2, 2, 6393, 6394, 7, 71, 2, 2, 6394, 6395,
+	7, 85, 2, 2, 6395, 6396, 7, 85, 2, 2, 6396, 6397, 7, 67, 2, 2, 6397, 6398,
+	7, 73, 2, 2, 6398, 6399, 7, 71, 2, 2, 6399, 6400, 7, 97, 2, 2, 6400, 6401,
+	7, 86, 2, 2, 6401, 6402, 7, 71, 2, 2, 6402, 6403, 7, 90, 2, 2, 6403, 6404,
+	7, 86, 2, 2, 6404, 938, 3, 2, 2, 2, 6405, 6406, 7, 79, 2, 2, 6406, 6407,
+	7, 75, 2, 2, 6407, 6408, 7, 70, 2, 2, 6408, 940, 3, 2, 2, 2, 6409, 6410,
+	7, 79, 2, 2, 6410, 6411, 7, 75, 2, 2, 6411, 6412, 7, 73, 2, 2, 6412, 6413,
+	7, 84, 2, 2, 6413, 6414, 7, 67, 2, 2, 6414, 6415, 7, 86, 2, 2, 6415, 6416,
+	7, 71, 2, 2, 6416, 942, 3, 2, 2, 2, 6417, 6418, 7, 79, 2, 2, 6418, 6419,
+	7, 75, 2, 2, 6419, 6420, 7, 80, 2, 2, 6420, 6421, 7, 97, 2, 2, 6421, 6422,
+	7, 84, 2, 2, 6422, 6423, 7, 81, 2, 2, 6423, 6424, 7, 89, 2, 2, 6424, 6425,
+	7, 85, 2, 2, 6425, 944, 3, 2, 2, 2, 6426, 6427, 7, 79, 2, 2, 6427, 6428,
+	7, 81, 2, 2, 6428, 6429, 7, 70, 2, 2, 6429, 6430, 7, 71, 2, 2, 6430, 946,
+	3, 2, 2, 2, 6431, 6432, 7, 79, 2, 2, 6432, 6433, 7, 81, 2, 2, 6433, 6434,
+	7, 70, 2, 2, 6434, 6435, 7, 75, 2, 2, 6435, 6436, 7, 72, 2, 2, 6436, 6437,
+	7, 91, 2, 2, 6437, 948, 3, 2, 2, 2, 6438, 6439, 7, 79, 2, 2, 6439, 6440,
+	7, 87, 2, 2, 6440, 6441, 7, 86, 2, 2, 6441, 6442, 7, 71, 2, 2, 6442, 6443,
+	7, 90, 2, 2, 6443, 950, 3, 2, 2, 2, 6444, 6445, 7, 79, 2, 2, 6445, 6446,
+	7, 91, 2, 2, 6446, 6447, 7, 85, 2, 2, 6447, 6448, 7, 83, 2, 2, 6448, 6449,
+	7, 78, 2, 2, 6449, 952, 3, 2, 2, 2, 6450, 6451, 7, 79, 2, 2, 6451, 6452,
+	7, 91, 2, 2, 6452, 6453, 7, 85, 2, 2, 6453, 6454, 7, 83, 2, 2, 6454, 6455,
+	7, 78, 2, 2, 6455, 6456, 7, 97, 2, 2, 6456, 6457, 7, 71, 2, 2, 6457, 6458,
+	7, 84, 2, 2, 6458, 6459, 7, 84, 2, 2, 6459, 6460, 7, 80, 2, 2, 6460, 6461,
+	7, 81, 2, 2, 6461, 954, 3, 2, 2, 2, 6462, 6463, 7, 80, 2, 2, 6463, 6464,
+	7, 67, 2, 2, 6464, 6465, 7, 79, 2, 2, 6465, 6466, 7, 71, 2, 2, 6466, 956,
+	3, 2, 2, 2, 6467, 6468, 7, 80, 2, 2, 6468, 6469, 7, 67, 2, 2, 6469, 6470,
+	7, 79, 2, 2, 6470, 6471, 7, 71, 2, 2, 6471, 6472, 7, 85, 2, 2, 6472, 958,
+	3, 2, 2, 2, 6473, 6474, 7, 80, 2, 2, 6474, 6475, 7, 69, 2, 2, 6475, 6476,
+	7, 74, 2, 2, 6476, 6477, 7, 67, 2, 2, 6477, 6478, 7, 84, 2, 2, 6478, 960,
+	3, 2, 2, 2, 6479, 6480, 7, 80, 2, 2, 6480, 6481, 7, 71, 2, 2, 6481, 6482,
+	7, 88, 2, 2, 6482, 6483, 7, 71, 2, 2, 6483, 6484, 7, 84, 2, 2, 6484, 962,
+	3, 2, 2, 2, 6485, 6486, 7, 80, 2, 2, 6486, 6487, 7, 71, 2, 2, 6487, 6488,
+	7, 90, 2, 2, 6488, 6489, 7, 86, 2, 2, 6489, 964, 3, 2, 2, 2, 6490, 6491,
+	7, 80, 2, 2, 6491, 6492, 7, 81, 2, 2, 6492, 966, 3, 2, 2, 2, 6493, 6494,
+	7, 80, 2, 2, 6494, 6495, 7, 81, 2, 2, 6495, 6496, 7, 70, 2, 2, 6496, 6497,
+	7, 71, 2, 2, 6497, 6498, 7, 73, 2, 2, 6498, 6499, 7, 84, 2, 2, 6499, 6500,
+	7, 81, 2, 2, 6500, 6501, 7, 87, 2, 2, 6501, 6502, 7, 82, 2, 2, 6502, 968,
+	3, 2, 2, 2, 6503, 6504, 7, 80, 2, 2, 6504, 6505, 7, 81, 2, 2, 6505, 6506,
+	7, 80, 2, 2, 6506, 6507, 7, 71, 2, 2, 6507, 970, 3, 2, 2, 2, 6508, 6509,
+	7, 81, 2, 2, 6509, 6510, 7, 72, 2, 2, 6510, 6511, 7, 72, 2, 2, 6511, 6512,
+	7, 78, 2, 2, 6512, 6513, 7, 75, 2, 2, 6513, 6514, 7, 80, 2, 2, 6514, 6515,
+	7, 71, 2, 2, 6515, 972, 3, 2, 2, 2, 6516, 6517, 7, 81, 2, 2, 6517, 6518,
+	7, 72, 2, 2, 6518, 6519, 7, 72, 2, 2, 6519, 6520, 7, 85, 2, 2, 6520, 6521,
+	7, 71, 2, 2, 6521, 6522, 7, 86, 2, 2, 6522, 974, 3, 2, 2, 2, 6523, 6524,
+	7, 81, 2, 2, 6524, 6525, 7, 72, 2, 2, 6525, 976, 3, 2, 2, 2, 6526, 6527,
+	7, 81, 2, 2, 6527, 6528, 7, 76, 2, 2, 6528, 978, 3, 2, 2, 2, 6529, 6530,
+	7, 81, 2, 2, 6530, 6531, 7, 78, 2, 2, 6531, 6532, 7, 70, 2, 2, 6532, 6533,
+	7, 97, 2, 2, 6533, 6534, 7, 82, 2, 2, 6534, 6535, 7, 67, 2, 2, 6535, 6536,
+	7, 85, 2, 2, 6536, 6537, 7, 85, 2, 2, 6537, 6538, 7, 89, 2, 2, 6538, 6539,
+	7, 81, 2, 2, 6539, 6540, 7, 84, 2, 2, 6540, 6541, 7, 70, 2, 2, 6541, 980,
+	3, 2, 2, 2, 6542, 6543, 7, 81, 2, 2, 6543, 6544, 7, 80, 2, 2, 6544, 6545,
+	7, 71, 2, 2, 6545, 982, 3, 2, 2, 2, 6546, 6547, 7, 81, 2, 2, 6547, 6548,
+	7, 80, 2, 2, 6548, 6549, 7, 78, 2, 2, 6549, 6550, 7, 75, 2, 2, 6550, 6551,
+	7, 80, 2, 2, 6551, 6552, 7, 71, 2, 2, 6552, 984, 3, 2, 2, 2, 6553, 6554,
+	7, 81, 2, 2, 6554, 6555, 7, 80, 2, 2, 6555, 6556, 7, 78, 2, 2, 6556, 6557,
+	7, 91, 2, 2, 6557, 986, 3, 2, 2, 2, 6558, 6559, 7, 81, 2, 2, 6559, 6560,
+	7, 82, 2, 2, 6560, 6561, 7, 71, 2, 2, 6561, 6562, 7, 80, 2, 2, 6562, 988,
+	3, 2, 2, 2, 6563, 6564, 7, 81, 2, 2, 6564, 6565, 7, 82, 2, 2, 6565, 6566,
+	7, 86, 2, 2, 6566, 6567, 7, 75, 2, 2, 6567, 6568, 7, 79, 2, 2, 6568, 6569,
+	7, 75, 2, 2, 6569, 6570, 7, 92, 2, 2, 6570, 6571, 7, 71, 2, 2, 6571, 6572,
+	7, 84, 2, 2, 6572, 6573, 7, 97, 2, 2, 6573, 6574, 7, 69, 2, 2, 6574, 6575,
+	7, 81, 2, 2, 6575, 6576, 7, 85, 2, 2, 6576, 6577, 7, 86, 2, 2, 6577, 6578,
+	7, 85, 2, 2, 6578, 990, 3, 2, 2, 2, 6579, 6580, 7, 81, 2, 2, 6580, 6581,
+	7, 82, 2, 2, 6581, 6582, 7, 86, 2, 2, 6582, 6583, 7, 75, 2, 2, 6583, 6584,
+	7, 81, 2, 2, 6584, 6585, 7, 80, 2, 2, 6585, 6586, 7, 85, 2, 2, 6586, 992,
+	3, 2, 2, 2, 6587, 6588, 7, 81, 2, 2, 6588, 6589, 7, 89, 2, 2, 6589, 6590,
+	7, 80, 2, 2, 6590, 6591, 7, 71, 2, 2, 6591, 6592, 7, 84, 2, 2, 6592, 994,
+	3, 2, 2, 2, 6593, 6594, 7, 82, 2, 2, 6594, 6595, 7, 67, 2, 2, 6595, 6596,
+	7, 69, 2, 2, 6596, 6597, 7, 77, 2, 2, 6597, 6598, 7, 97, 2, 2, 6598, 6599,
+	7, 77, 2, 2, 6599, 6600, 7, 71, 2, 2, 6600, 6601, 7, 91, 2, 2, 6601, 6602,
+	7, 85, 2, 2, 6602, 996, 3, 2, 2, 2, 6603, 6604, 7, 82, 2, 2, 6604, 6605,
+	7, 67, 2, 2, 6605, 6606, 7, 73, 2, 2, 6606, 6607, 7, 71, 2, 2, 6607, 998,
+	3, 2, 2, 2, 6608, 6609, 7, 82, 2, 2, 6609, 6610, 7, 67, 2, 2, 6610, 6611,
+	7, 84, 2, 2, 6611, 6612, 7, 85, 2, 2, 6612, 6613, 7, 71, 2, 2, 6613, 6614,
+	7, 84, 2, 2, 6614, 1000, 3, 2, 2, 2, 6615, 6616, 7, 82, 2, 2, 6616, 6617,
+	7, 67, 2, 2, 6617, 6618, 7, 84, 2, 2, 6618, 6619, 7, 86, 2, 2, 6619, 6620,
+	7, 75, 2, 2, 6620, 6621, 7, 67, 2, 2, 6621, 6622, 7, 78, 2, 2, 6622, 1002,
+	3, 2, 2, 2, 6623, 6624, 7, 82, 2, 2, 6624, 6625, 7, 67, 2, 2, 6625, 6626,
+	7, 84, 2, 2, 6626, 6627, 7, 86, 2, 2, 6627, 6628, 7, 75, 2, 2, 6628, 6629,
+	7, 86, 2, 2, 6629, 6630, 7, 75, 2, 2, 6630, 6631, 7, 81, 2, 2, 6631, 6632,
+	7, 80, 2, 2, 6632, 6633, 7, 75, 2, 2, 6633, 6634, 7, 80, 2, 2, 6634, 6635,
+	7, 73, 2, 2, 6635, 1004, 3, 2, 2, 2, 6636, 6637, 7, 82, 2, 2, 6637, 6638,
+	7, 67, 2, 2, 6638, 6639, 7, 84, 2, 2, 6639, 6640, 7, 86, 2, 2, 6640, 6641,
+	7, 75, 2, 2, 6641, 6642, 7, 86, 2, 2, 6642, 6643, 7, 75, 2, 2, 6643, 6644,
+	7, 81, 2, 2, 6644, 6645, 7, 80, 2, 2, 6645, 6646, 7, 85, 2, 2, 6646, 1006,
+	3, 2, 2, 2, 6647, 6648, 7, 82, 2, 2, 6648, 6649, 7, 67, 2, 2, 6649, 6650,
+	7, 85, 2, 2, 6650, 6651, 7, 85, 2, 2, 6651, 6652, 7, 89, 2, 2, 6652, 6653,
+	7, 81, 2, 2, 6653, 6654, 7, 84, 2, 2, 6654, 6655, 7, 70, 2, 2, 6655, 1008,
+	3, 2, 2, 2, 6656, 6657, 7, 82, 2, 2, 6657, 6658, 7, 74, 2, 2, 6658, 6659,
+	7, 67, 2, 2, 6659, 6660, 7, 85, 2, 2, 6660, 6661, 7, 71, 2, 2, 6661, 1010,
+	3, 2, 2, 2, 6662, 6663, 7, 82, 2, 2, 6663, 6664, 7, 78, 2, 2, 6664, 6665,
+	7, 87, 2, 2, 6665, 6666, 7, 73, 2, 2, 6666, 6667, 7, 75, 2, 2, 6667, 6668,
+	7, 80, 2, 2, 6668, 1012, 3, 2, 2, 2, 6669, 6670, 7, 82, 2, 2, 6670, 6671,
+	7, 78, 2, 2, 6671, 6672, 7, 87, 2, 2, 6672, 6673, 7, 73, 2, 2, 6673, 6674,
+	7, 75, 2, 2, 6674, 6675, 7, 80, 2, 2, 6675, 6676, 7, 97, 2, 2, 6676, 6677,
+	7, 70, 2, 2, 6677, 6678, 7, 75, 2, 2, 6678, 6679, 7, 84, 2, 2, 6679, 1014,
+	3, 2, 2, 2, 6680, 6681, 7, 82, 2, 2, 6681, 6682, 7, 78, 2, 2, 6682, 6683,
+	7, 87, 2, 2, 6683, 6684, 7, 73, 2, 2, 6684, 6685, 7, 75, 2, 2, 6685, 6686,
+	7, 80, 2, 2, 6686, 6687, 7, 85, 2, 2, 6687, 1016, 3, 2, 2, 2, 6688, 6689,
+	7, 82, 2, 2, 6689, 6690, 7, 81, 2, 2, 6690, 6691, 7, 84, 2, 2, 6691, 6692,
+	7, 86, 2, 2, 6692, 1018, 3, 2, 2, 2, 6693, 6694, 7, 82, 2, 2, 6694, 6695,
+	7, 84, 2, 2, 6695, 6696, 7, 71, 2, 2, 6696, 6697, 7, 69, 2, 2, 6697, 6698,
+	7, 71, 2, 2, 6698, 6699, 7, 70, 2, 2, 6699, 6700, 7, 71, 2, 2, 6700, 6701,
+	7, 85, 2, 2, 6701, 1020, 3, 2, 2, 2, 6702, 6703, 7, 82, 2, 2, 6703, 6704,
+	7, 84, 2, 2, 6704, 6705, 7, 71, 2, 2, 6705, 6706, 7, 82, 2, 2, 6706, 6707,
+	7, 67, 2, 2, 6707, 6708, 7, 84, 2, 2, 6708, 6709, 7, 71, 2, 2, 6709, 1022,
+	3, 2, 2, 2, 6710, 6711, 7, 82, 2, 2, 6711, 6712, 7, 84, 2, 2, 6712, 6713,
+	7, 71, 2, 2, 6713, 6714, 7, 85, 2, 2, 6714, 6715, 7, 71, 2, 2, 6715, 6716,
+	7, 84, 2, 2, 6716, 6717, 7, 88, 2, 2, 6717, 6718, 7, 71, 2, 2, 6718, 1024,
+	3, 2, 2, 2, 6719, 6720, 7, 82, 2, 2, 6720, 6721, 7, 84, 2, 2, 6721, 6722,
+	7, 71, 2, 2, 6722, 6723, 7, 88, 2, 2, 6723, 1026, 3, 2, 2, 2, 6724, 6725,
+	7, 82, 2, 2, 6725, 6726, 7, 84, 2, 2, 6726, 6727, 7, 81, 2, 2, 6727, 6728,
+	7, 69, 2, 2, 6728, 6729, 7, 71, 2, 2, 6729, 6730, 7, 85, 2, 2, 6730, 6731,
+	7, 85, 2, 2, 6731, 6732, 7, 78, 2, 2, 6732, 6733, 7, 75, 2, 2, 6733, 6734,
+	7, 85, 2, 2, 6734, 6735, 7, 86, 2, 2, 6735, 1028, 3, 2, 2, 2, 6736, 6737,
+	7, 82, 2, 2, 6737, 6738, 7, 84, 2, 2, 6738, 6739, 7, 81, 2, 2, 6739, 6740,
+	7, 72, 2, 2, 6740, 6741, 7, 75, 2, 2, 6741, 6742, 7, 78, 2, 2, 6742, 6743,
+	7, 71, 2, 2, 6743, 1030, 3, 2, 2, 2, 6744, 6745, 7, 82, 2, 2, 6745, 6746,
+	7, 84, 2, 2, 6746, 6747, 7, 81, 2, 2, 6747, 6748, 7, 72, 2, 2, 6748, 6749,
+	7, 75, 2, 2, 6749, 6750, 7, 78, 2, 2, 6750, 6751, 7, 71, 2, 2, 6751, 6752,
+	7, 85, 2, 2, 6752, 1032, 3, 2, 2, 2, 6753, 6754, 7, 82, 2, 2, 6754, 6755,
+	7, 84, 2, 2, 6755, 6756, 7, 81, 2, 2, 6756, 6757, 7, 90, 2, 2, 6757, 6758,
+	7, 91, 2, 2, 6758, 1034, 3, 2, 2, 2, 6759, 6760, 7, 83, 2, 2, 6760, 6761,
+	7, 87, 2, 2, 6761, 6762, 7, 71, 2, 2, 6762, 6763, 7, 84, 2, 2, 6763, 6764,
+	7, 91, 2, 2, 6764, 1036, 3, 2, 2, 2, 6765, 6766, 7, 83, 2, 2, 6766, 6767,
+	7, 87, 2, 2, 6767, 6768, 7, 75, 2, 2, 6768, 6769, 7, 69, 2, 2, 6769, 6770,
+	7, 77, 2, 2, 6770, 1038, 3, 2, 2, 2, 6771, 6772, 7, 84, 2, 2, 6772, 6773,
+	7, 71, 2, 2, 6773, 6774, 7, 68, 2, 2, 6774, 6775, 7, 87, 2, 2, 6775, 6776,
+	7, 75, 2, 2, 6776, 6777, 7, 78, 2, 2, 6777, 6778, 7, 70, 2, 2, 6778, 1040,
+	3, 2, 2, 2, 6779, 6780, 7, 84, 2, 2, 6780, 6781, 7, 71, 2, 2, 6781, 6782,
+	7, 69, 2, 2, 6782, 6783, 7, 81, 2, 2, 6783, 6784, 7, 88, 2, 2, 6784, 6785,
+	7, 71, 2, 2, 6785, 6786, 7, 84, 2, 2, 6786, 1042, 3, 2, 2, 2, 6787, 6788,
+	7, 84, 2, 2, 6788, 6789, 7, 71, 2, 2, 6789, 6790, 7, 70, 2, 2, 6790, 6791,
+	7, 81, 2, 2, 6791, 6792, 7, 97, 2, 2, 6792, 6793, 7, 68, 2, 2, 6793, 6794,
+	7, 87, 2, 2, 6794, 6795, 7, 72, 2, 2, 6795, 6796, 7, 72, 2, 2, 6796, 6797,
+	7, 71, 2, 2, 6797, 6798, 7, 84, 2, 2, 6798, 6799, 7, 97, 2, 2, 6799, 6800,
+	7, 85, 2, 2, 6800, 6801, 7, 75, 2, 2, 6801, 6802, 7, 92, 2, 2, 6802, 6803,
+	7, 71, 2, 2, 6803, 1044, 3, 2, 2, 2, 6804, 6805, 7, 84, 2, 2, 6805, 6806,
+	7, 71, 2, 2, 6806, 6807, 7, 70, 2, 2, 6807, 6808, 7, 87, 2, 2, 6808, 6809,
+	7, 80, 2, 2, 6809, 6810, 7, 70, 2, 2, 6810, 6811, 7, 67, 2, 2, 6811, 6812,
+	7, 80, 2, 2, 6812, 6813, 7, 86, 2, 2, 6813, 1046, 3, 2, 2, 2, 6814, 6815,
+	7, 84, 2, 2, 6815, 6816, 7, 71, 2, 2, 6816, 6817, 7, 78, 2, 2, 6817, 6818,
+	7, 67, 2, 2, 6818, 6819, 7, 91, 2, 2, 6819, 1048, 3, 2, 2, 2, 6820, 6821,
+	7, 84, 2, 2, 6821, 6822, 7, 71, 2, 2, 6822, 6823, 7, 78, 2, 2, 6823, 6824,
+	7, 67, 2, 2, 6824, 6825, 7, 91, 2, 2, 6825, 6826, 7, 97, 2, 2, 6826, 6827,
+	7, 78, 2, 2, 6827, 6828, 7, 81, 2, 2, 6828, 6829, 7, 73, 2, 2, 6829, 6830,
+	7, 97, 2, 2, 6830, 6831, 7, 72, 2, 2, 6831, 6832, 7, 75, 2, 2, 6832, 6833,
+	7, 78, 2, 2, 6833, 6834, 7, 71, 2, 2, 6834, 1050, 3, 2, 2, 2, 6835, 6836,
+	7, 84, 2, 2, 6836, 6837, 7, 71, 2, 2, 6837, 6838, 7, 78, 2, 2, 6838, 6839,
+	7, 67, 2, 2, 6839, 6840, 7, 91, 2, 2, 6840, 6841, 7, 97, 2, 2, 6841, 6842,
+	7, 78, 2, 2, 6842, 6843, 7, 81, 2, 2, 6843, 6844, 7, 73, 2, 2, 6844, 6845,
+	7, 97, 2, 2, 6845, 6846, 7, 82, 2, 2, 6846, 6847, 7, 81, 2, 2, 6847, 6848,
+	7, 85, 2, 2, 6848, 1052, 3, 2, 2, 2, 6849, 6850, 7, 84, 2, 2, 6850, 6851,
+	7, 71, 2, 2, 6851, 6852, 7, 78, 2, 2, 6852, 6853, 7, 67, 2, 2, 6853, 6854,
+	7, 91, 2, 2, 6854, 6855, 7, 78, 2, 2, 6855, 6856, 7, 81, 2, 2, 6856, 6857,
+	7, 73, 2, 2, 6857, 1054, 3, 2, 2, 2, 6858, 6859, 7, 84, 2, 2, 6859, 6860,
+	7, 71, 2, 2, 6860, 6861, 7, 79, 2, 2, 6861, 6862, 7, 81, 2, 2, 6862, 6863,
+	7, 88, 2, 2, 6863, 6864, 7, 71, 2, 2, 6864, 1056, 3, 2, 2, 2, 6865, 6866,
+	7, 84, 2, 2, 6866, 6867, 7, 71, 2, 2, 6867, 6868, 7, 81, 2, 2, 6868, 6869,
+	7, 84, 2, 2, 6869, 6870, 7, 73, 2, 2, 6870, 6871, 7, 67, 2, 2, 6871, 6872,
+	7, 80, 2, 2, 6872, 6873, 7, 75, 2, 2, 6873, 6874, 7, 92, 2, 2, 6874, 6875,
+	7, 71, 2, 2, 6875, 1058, 3, 2, 2, 2, 6876, 6877, 7, 84, 2, 2, 6877, 6878,
+	7, 71, 2, 2, 6878, 6879, 7, 82, 2, 2, 6879, 6880, 7, 67, 2, 2, 6880, 6881,
+	7, 75, 2, 2, 6881, 6882, 7, 84, 2, 2, 6882, 1060, 3, 2, 2, 2, 6883, 6884,
+	7, 84, 2, 2, 6884, 6885, 7, 71, 2, 2, 6885, 6886, 7, 82, 2, 2, 6886, 6887,
+	7, 78, 2, 2, 6887, 6888, 7, 75, 2, 2, 6888, 6889, 7, 69, 2, 2, 6889, 6890,
+	7, 67, 2, 2, 6890, 6891, 7, 86, 2, 2, 6891, 6892, 7, 71, 2, 2, 6892, 6893,
+	7, 97, 2, 2, 6893, 6894, 7, 70, 2, 2, 6894, 6895, 7, 81, 2, 2, 6895, 6896,
+	7, 97, 2, 2, 6896, 6897, 7, 70, 2, 2, 6897, 6898, 7, 68, 2, 2, 6898, 1062,
+	3, 2, 2, 2, 6899, 6900, 7, 84, 2, 2, 6900, 6901, 7, 71, 2, 2, 6901, 6902,
+	7, 82, 2, 2, 6902, 6903, 7, 78, 2, 2, 6903, 6904, 7, 75, 2, 2, 6904, 6905,
+	7, 69, 2, 2, 6905, 6906, 7, 67, 2, 2, 6906, 6907, 7, 86, 2, 2, 6907, 6908,
+	7, 71, 2, 2, 6908, 6909, 7, 97, 2, 2, 6909, 6910, 7, 70, 2, 2, 6910, 6911,
+	7, 81, 2, 2, 6911, 6912, 7, 97, 2, 2, 6912, 6913, 7, 86, 2, 2, 6913, 6914,
+	7, 67, 2, 2, 6914, 6915, 7, 68, 2, 2, 6915, 6916, 7, 78, 2, 2, 6916, 6917,
+	7, 71, 2, 2, 6917, 1064, 3, 2, 2, 2, 6918, 6919, 7, 84, 2, 2, 6919, 6920,
+	7, 71, 2, 2, 6920, 6921, 7, 82, 2, 2, 6921, 6922, 7, 78, 2, 2, 6922, 6923,
+	7, 75, 2, 2, 6923, 6924, 7, 69, 2, 2, 6924, 6925, 7, 67, 2, 2, 6925, 6926,
+	7, 86, 2, 2, 6926, 6927, 7, 71, 2, 2, 6927, 6928, 7, 97, 2, 2, 6928, 6929,
+	7, 75, 2, 2, 6929, 6930, 7, 73, 2, 2, 6930, 6931, 7, 80, 2, 2, 6931, 6932,
+	7, 81, 2, 2, 6932, 6933, 7, 84, 2, 2, 6933, 6934, 7, 71, 2, 2, 6934, 6935,
+	7, 97, 2, 2, 6935, 6936, 7, 70, 2, 2, 6936, 6937, 7, 68, 2, 2, 6937, 1066,
+	3, 2, 2, 2, 6938, 6939, 7, 84, 2, 2, 6939, 6940, 7, 71, 2, 2, 6940, 6941,
+	7, 82, 2, 2, 6941, 6942, 7, 78, 2, 2, 6942, 6943, 7, 75, 2, 2, 6943, 6944,
+	7, 69, 2, 2, 6944, 6945, 7, 67, 2, 2, 6945, 6946, 7, 86, 2, 2, 6946, 6947,
+	7, 71, 2, 2, 6947, 6948, 7, 97, 2, 2, 6948, 6949, 7, 75, 2, 2, 6949, 6950,
+	7, 73, 2, 2, 6950, 6951, 7, 80, 2, 2, 6951, 6952, 7, 81, 2, 2, 6952, 6953,
+	7, 84, 2, 2, 6953, 6954, 7, 71, 2, 2, 6954, 6955, 7, 97, 2, 2, 6955, 6956,
+	7, 86, 2, 2, 6956, 6957, 7, 67, 2, 2, 6957, 6958, 7, 68, 2, 2, 6958, 6959,
+	7, 78, 2, 2, 6959, 6960, 7, 71, 2, 2, 6960, 1068, 3, 2, 2, 2, 6961, 6962,
+	7, 84, 2, 2, 6962, 6963, 7, 71, 2, 2, 6963, 6964, 7, 82, 2, 2, 6964, 6965,
+	7, 78, 2, 2, 6965, 6966, 7, 75, 2, 2, 6966, 6967, 7, 69, 2, 2, 6967, 6968,
+	7, 67, 2, 2, 6968, 6969, 7, 86, 2, 2, 6969, 6970, 7, 71, 2, 2, 6970, 6971,
+	7, 97, 2, 2, 6971, 6972, 7, 84, 2, 2, 6972, 6973, 7, 71, 2, 2, 6973, 6974,
+	7, 89, 2, 2, 6974, 6975, 7, 84, 2, 2, 6975, 6976, 7, 75, 2, 2, 6976, 6977,
+	7, 86, 2, 2, 6977, 6978, 7, 71, 2, 2, 6978, 6979, 7, 97, 2, 2, 6979, 6980,
+	7, 70, 2, 2, 6980, 6981, 7, 68, 2, 2, 6981, 1070, 3, 2, 2, 2, 6982, 6983,
+	7, 84, 2, 2, 6983, 6984, 7, 71, 2, 2, 6984, 6985, 7, 82, 2, 2, 6985, 6986,
+	7, 78, 2, 2, 6986, 6987, 7, 75, 2, 2, 6987, 6988, 7, 69, 2, 2, 6988, 6989,
+	7, 67, 2, 2, 6989, 6990, 7, 86, 2, 2, 6990, 6991, 7, 71, 2, 2, 6991, 6992,
+	7, 97, 2, 2, 6992, 6993, 7, 89, 2, 2, 6993, 6994, 7, 75, 2, 2, 6994, 6995,
+	7, 78, 2, 2, 6995, 6996, 7, 70, 2, 2, 6996, 6997, 7, 97, 2, 2, 6997, 6998,
+	7, 70, 2, 2, 6998, 6999, 7, 81, 2, 2, 6999, 7000, 7, 97, 2, 2, 7000, 7001,
+	7, 86, 2, 2, 7001, 7002, 7, 67, 2, 2, 7002, 7003, 7, 68, 2, 2, 7003, 7004,
+	7, 78, 2, 2, 7004, 7005, 7, 71, 2, 2, 7005, 1072, 3, 2, 2, 2, 7006, 7007,
+	7, 84, 2, 2, 7007, 7008, 7, 71, 2, 2, 7008, 7009, 7, 82, 2, 2, 7009, 7010,
+	7, 78, 2, 2, 7010, 7011, 7, 75, 2, 2, 7011, 7012, 7, 69, 2, 2, 7012, 7013,
+	7, 67, 2, 2, 7013, 7014, 7, 86, 2, 2, 7014, 7015, 7, 71, 2, 2, 7015, 7016,
+	7, 97, 2, 2, 7016, 7017, 7, 89, 2, 2, 7017, 7018, 7, 75, 2, 2, 7018, 7019,
+	7, 78, 2, 2, 7019, 7020, 7, 70, 2, 2, 7020, 7021, 7, 97, 2, 2, 7021, 7022,
+	7, 75, 2, 2, 7022, 7023, 7, 73, 2, 2, 7023, 7024, 7, 80, 2, 2, 7024, 7025,
+	7, 81, 2, 2, 7025, 7026, 7, 84, 2, 2, 7026, 7027, 7, 71, 2, 2, 7027, 7028,
+	7, 97, 2, 2, 7028, 7029, 7, 86, 2, 2, 7029, 7030, 7, 67, 2, 2, 7030, 7031,
+	7, 68, 2, 2, 7031, 7032, 7, 78, 2, 2, 7032, 7033, 7, 71, 2, 2, 7033, 1074,
+	3, 2, 2, 2, 7034, 7035, 7, 84, 2, 2, 7035, 7036, 7, 71, 2, 2, 7036, 7037,
+	7, 82, 2, 2, 7037, 7038, 7, 78, 2, 2, 7038, 7039, 7, 75, 2, 2, 7039, 7040,
+	7, 69, 2, 2, 7040, 7041, 7, 67, 2, 2, 7041, 7042, 7, 86, 2, 2, 7042, 7043,
+	7, 75, 2, 2, 7043, 7044, 7, 81, 2, 2, 7044, 7045, 7, 80, 2, 2, 7045, 1076,
+	3, 2, 2, 2, 7046, 7047, 7, 84, 2, 2, 7047, 7048, 7, 71, 2, 2, 7048, 7049,
+	7, 85, 2, 2, 7049, 7050, 7, 71, 2, 2, 7050, 7051, 7, 86, 2, 2, 7051, 1078,
+	3, 2, 2, 2, 7052, 7053, 7, 84, 2, 2, 7053, 7054, 7, 71, 2, 2, 7054, 7055,
+	7, 85, 2, 2, 7055, 7056, 7, 87, 2, 2, 7056, 7057, 7, 79, 2, 2, 7057, 7058,
+	7, 71, 2, 2, 7058, 1080, 3, 2, 2, 2, 7059, 7060, 7, 84, 2, 2, 7060, 7061,
+	7, 71, 2, 2, 7061, 7062, 7, 86, 2, 2, 7062, 7063, 7, 87, 2, 2, 7063, 7064,
+	7, 84, 2, 2, 7064, 7065, 7, 80, 2, 2, 7065, 7066, 7, 71, 2, 2, 7066, 7067,
+	7, 70, 2, 2, 7067, 7068, 7, 97, 2, 2, 7068, 7069, 7, 85, 2, 2, 7069, 7070,
+	7, 83, 2, 2, 7070, 7071, 7, 78, 2, 2, 7071, 7072, 7, 85, 2, 2, 7072, 7073,
+	7, 86, 2, 2, 7073, 7074, 7, 67, 2, 2, 7074, 7075, 7, 86, 2, 2, 7075, 7076,
+	7, 71, 2, 2, 7076, 1082, 3, 2, 2, 2, 7077, 7078, 7, 84, 2, 2, 7078, 7079,
+	7, 71, 2, 2, 7079, 7080, 7, 86, 2, 2, 7080, 7081, 7, 87, 2, 2, 7081, 7082,
+	7, 84, 2, 2, 7082, 7083, 7, 80, 2, 2, 7083, 7084, 7, 75, 2, 2, 7084, 7085,
+	7, 80, 2, 2, 7085, 7086, 7, 73, 2, 2, 7086, 1084, 3, 2, 2, 2, 7087, 7088,
+	7, 84, 2, 2, 7088, 7089, 7, 71, 2, 2, 7089, 7090, 7, 86, 2, 2, 7090, 7091,
+	7, 87, 2, 2, 7091, 7092, 7, 84, 2, 2, 7092, 7093, 7, 80, 2, 2, 7093, 7094,
+	7, 85, 2, 2, 7094, 1086, 3, 2, 2, 2, 7095, 7096, 7, 84, 2, 2, 7096, 7097,
+	7, 81, 2, 2, 7097, 7098, 7, 78, 2, 2, 7098, 7099, 7, 71, 2, 2, 7099, 1088,
+	3, 2, 2, 2, 7100, 7101, 7, 84, 2, 2, 7101, 7102, 7, 81, 2, 2, 7102, 7103,
+	7, 78, 2, 2, 7103, 7104, 7, 78, 2, 2, 7104, 7105, 7, 68, 2, 2, 7105, 7106,
+	7, 67, 2, 2, 7106, 7107, 7, 69, 2, 2, 7107, 7108, 7, 77, 2, 2, 7108, 1090,
+	3, 2, 2, 2, 7109, 7110, 7, 84, 2, 2, 7110, 7111, 7, 81, 2, 2, 7111, 7112,
+	7, 78, 2, 2, 7112, 7113, 7, 78, 2, 2, 7113, 7114, 7, 87, 2, 2, 7114, 7115,
+	7, 82, 2, 2, 7115, 1092, 3, 2, 2, 2, 7116, 7117, 7, 84, 2, 2, 7117, 7118,
+	7, 81, 2, 2, 7118, 7119, 7, 86, 2, 2, 7119, 7120, 7, 67, 2, 2, 7120, 7121,
+	7, 86, 2, 2, 7121, 7122, 7, 71, 2, 2, 7122, 1094, 3, 2, 2, 2, 7123, 7124,
+	7, 84, 2, 2, 7124, 7125, 7, 81, 2, 2, 7125, 7126, 7, 89, 2, 2, 7126, 1096,
+	3, 2, 2, 2, 7127, 7128, 7, 84, 2, 2, 7128, 7129, 7, 81, 2, 2, 7129, 7130,
+	7, 89, 2, 2, 7130, 7131, 7, 85, 2, 2, 7131, 1098, 3, 2, 2, 2, 7132, 7133,
+	7, 84, 2, 2, 7133, 7134, 7, 81, 2, 2, 7134, 7135, 7, 89, 2, 2, 7135, 7136,
+	7, 97, 2, 2, 7136, 7137, 7, 72, 2, 2, 7137, 7138, 7, 81, 2, 2, 7138, 7139,
+	7, 84, 2, 2, 7139, 7140, 7, 79, 2, 2, 7140, 7141, 7, 67, 2, 2, 7141, 7142,
+	7, 86, 2, 2, 7142, 1100, 3, 2, 2, 2, 7143, 7144, 7, 85, 2, 2, 7144, 7145,
+	7, 67, 2, 2, 7145, 7146, 7, 88, 2, 2, 7146, 7147, 7, 71, 2, 2, 7147, 7148,
+	7, 82, 2, 2, 7148, 7149, 7, 81, 2, 2, 7149, 7150, 7, 75, 2, 2, 7150, 7151,
+	7, 80, 2, 2, 7151, 7152, 7, 86, 2, 2, 7152, 1102, 3, 2, 2, 2, 7153, 7154,
+	7, 85, 2, 2, 7154, 7155, 7, 69, 2, 2, 7155, 7156, 7, 74, 2, 2, 7156, 7157,
+	7, 71, 2, 2, 7157, 7158, 7, 70, 2, 2, 7158, 7159, 7, 87, 2, 2, 7159, 7160,
+	7, 78, 2, 2, 7160, 7161, 7, 71, 2, 2, 7161, 1104, 3, 2, 2, 2, 7162, 7163,
+	7, 85, 2, 2, 7163, 7164, 7, 71, 2, 2, 7164, 7165, 7, 69, 2, 2, 7165, 7166,
+	7, 87, 2, 2, 7166, 7167, 7, 84, 2, 2, 7167, 7168, 7, 75, 2, 2, 7168, 7169,
+	7, 86, 2, 2, 7169, 7170, 7, 91, 2, 2, 7170, 1106, 3, 2, 2, 2, 7171, 7172,
+	7, 85, 2, 2, 7172, 7173, 7, 71, 2, 2, 7173, 7174, 7, 84, 2, 2, 7174, 7175,
+	7, 88, 2, 2, 7175, 7176, 7, 71, 2, 2, 7176, 7177, 7, 84, 2, 2, 7177, 1108,
+	3, 2, 2, 2, 7178, 7179, 7, 85, 2, 2, 7179, 7180, 7, 71, 2, 2, 7180, 7181,
+	7, 85, 2, 2, 7181, 7182, 7, 85, 2, 2, 7182, 7183, 7, 75, 2, 2, 7183, 7184,
+	7, 81, 2, 2, 7184, 7185, 7, 80, 2, 2, 7185, 1110, 3, 2, 2, 2, 7186, 7187,
+	7, 85, 2, 2, 7187, 7188, 7, 74, 2, 2, 7188, 7189, 7, 67, 2, 2, 7189, 7190,
+	7, 84, 2, 2, 7190, 7191, 7, 71, 2, 2, 7191, 1112, 3, 2, 2, 2, 7192, 7193,
+	7, 85, 2, 2, 7193, 7194, 7, 74, 2, 2, 7194, 7195, 7, 67, 2, 2, 7195, 7196,
+	7, 84, 2, 2, 7196, 7197, 7, 71, 2, 2, 7197, 7198, 7, 70, 2, 2, 7198, 1114,
+	3, 2, 2, 2, 7199, 7200, 7, 85, 2, 2, 7200, 7201, 7, 75, 2, 2, 7201, 7202,
+	7, 73, 2, 2, 7202, 7203, 7, 80, 2, 2, 7203, 7204, 7, 71, 2, 2, 7204, 7205,
+	7, 70, 2, 2, 7205, 1116, 3, 2, 2, 2, 7206, 7207, 7, 85, 2, 2, 7207, 7208,
+	7, 75, 2, 2, 7208, 7209, 7, 79, 2, 2, 7209, 7210, 7, 82, 2, 2, 7210, 7211,
+	7, 78, 2, 2, 7211, 7212, 7, 71, 2, 2, 7212, 1118, 3, 2, 2, 2, 7213, 7214,
+	7, 85, 2, 2, 7214, 7215, 7, 78, 2, 2, 7215, 7216, 7, 67, 2, 2, 7216, 7217,
+	7, 88, 2, 2, 7217, 7218, 7, 71, 2, 2, 7218, 1120, 3, 2, 2, 2, 7219, 7220,
+	7, 85, 2, 2, 7220, 7221, 7, 78, 2, 2, 7221, 7222, 7, 81, 2, 2, 7222, 7223,
+	7, 89, 2, 2, 7223, 1122, 3, 2, 2, 2, 7224, 7225, 7, 85, 2, 2, 7225, 7226,
+	7, 80, 2, 2, 7226, 7227, 7, 67, 2, 2, 7227, 7228, 7, 82, 2, 2, 7228, 7229,
+	7, 85, 2, 2, 7229, 7230, 7, 74, 2, 2, 7230, 7231, 7, 81, 2, 2, 7231, 7232,
+	7, 86, 2, 2, 7232, 1124, 3, 2, 2, 2, 7233, 7234, 7, 85, 2, 2, 7234, 7235,
+	7, 81, 2, 2, 7235, 7236, 7, 69, 2, 2, 7236, 7237, 7, 77, 2, 2, 7237, 7238,
+	7, 71, 2, 2, 7238, 7239, 7, 86, 2, 2, 7239, 1126, 3, 2, 2, 2, 7240, 7241,
+	7, 85, 2, 2, 7241, 7242, 7, 81, 2, 2, 7242, 7243, 7, 79, 2, 2, 7243, 7244,
+	7, 71, 2, 2, 7244, 1128, 3, 2, 2, 2, 7245, 7246, 7, 85, 2, 2, 7246, 7247,
+	7, 81, 2, 2, 7247, 7248, 7, 80, 2, 2, 7248, 7249, 7, 67, 2, 2, 7249, 7250,
+	7, 79, 2, 2, 7250, 7251, 7, 71, 2, 2, 7251, 1130, 3, 2, 2, 2, 7252, 7253,
+	7, 85, 2, 2, 7253, 7254, 7, 81, 2, 2, 7254, 7255, 7, 87, 2, 2, 7255, 7256,
+	7, 80, 2, 2, 7256, 7257, 7, 70, 2, 2, 7257, 7258, 7, 85, 2, 2, 7258, 1132,
+	3, 2, 2, 2, 7259, 7260, 7, 85, 2, 2, 7260, 7261, 7, 81, 2, 2, 7261, 7262,
+	7, 87, 2, 2, 7262, 7263, 7, 84, 2, 2, 7263, 7264, 7, 69, 2, 2, 7264, 7265,
+	7, 71, 2, 2, 7265, 1134, 3, 2, 2, 2, 7266, 7267, 7, 85, 2, 2, 7267, 7268,
+	7, 83, 2, 2, 7268, 7269, 7, 78, 2, 2, 7269, 7270, 7, 97, 2, 2, 7270, 7271,
+	7, 67, 2, 2, 7271, 7272, 7, 72, 2, 2, 7272, 7273, 7, 86, 2, 2, 7273, 7274,
+	7, 71, 2, 2, 7274, 7275, 7, 84, 2, 2, 7275, 7276, 7, 97, 2, 2, 7276, 7277,
+	7, 73, 2, 2, 7277, 7278, 7, 86, 2, 2, 7278, 7279, 7, 75, 2, 2, 7279, 7280,
+	7, 70, 2, 2, 7280, 7281, 7, 85, 2, 2, 7281, 1136, 3, 2, 2, 2, 7282, 7283,
+	7, 85, 2, 2, 7283, 7284, 7, 83, 2, 2, 7284, 7285, 7, 78, 2, 2, 7285, 7286,
+	7, 97, 2, 2, 7286, 7287, 7, 67, 2, 2, 7287, 7288, 7, 72, 2, 2, 7288, 7289,
+	7, 86, 2, 2, 7289, 7290, 7, 71, 2, 2, 7290, 7291, 7, 84, 2, 2, 7291, 7292,
+	7, 97, 2, 2, 7292, 7293, 7, 79, 2, 2, 7293, 7294, 7, 86, 2, 2, 7294, 7295,
+	7, 85, 2, 2, 7295, 7296, 7, 97, 2, 2, 7296, 7297, 7, 73, 2, 2, 7297, 7298,
+	7, 67, 2, 2, 7298, 7299, 7, 82, 2, 2, 7299, 7300, 7, 85, 2, 2, 7300, 1138,
+	3, 2, 2, 2, 7301, 7302, 7, 85, 2, 2, 7302, 7303, 7, 83, 2, 2, 7303, 7304,
+	7, 78, 2, 2, 7304, 7305, 7, 97, 2, 2, 7305, 7306, 7, 68, 2, 2, 7306, 7307,
+	7, 71, 2, 2, 7307, 7308, 7, 72, 2, 2, 7308, 7309, 7, 81, 2, 2, 7309, 7310,
+	7, 84, 2, 2, 7310, 7311, 7, 71, 2, 2, 7311, 7312, 7, 97, 2, 2, 7312, 7313,
+	7, 73, 2, 2, 7313, 7314, 7, 86, 2, 2, 7314, 7315, 7, 75, 2, 2, 7315, 7316,
+	7, 70, 2, 2, 7316, 7317, 7, 85, 2, 2, 7317, 1140, 3, 2, 2, 2, 7318, 7319,
+	7, 85, 2, 2, 7319, 7320, 7, 83, 2, 2, 7320, 7321, 7, 78, 2, 2, 7321, 7322,
+	7, 97, 2, 2, 7322, 7323, 7, 68, 2, 2, 7323, 7324, 7, 87, 2, 2, 7324, 7325,
+	7, 72, 2, 2, 7325, 7326, 7, 72, 2, 2, 7326, 7327, 7, 71, 2, 2, 7327, 7328,
+	7, 84, 2, 2, 7328, 7329, 7, 97, 2, 2, 7329, 7330, 7, 84, 2, 2, 7330, 7331,
+	7, 71, 2, 2, 7331, 7332, 7, 85, 2, 2, 7332, 7333, 7, 87, 2, 2, 7333, 7334,
+	7, 78, 2, 2, 7334, 7335, 7, 86, 2, 2, 7335, 1142, 3, 2, 2, 2, 7336, 7337,
+	7, 85, 2, 2, 7337, 7338, 7, 83, 2, 2, 7338, 7339, 7, 78, 2, 2, 7339, 7340,
+	7, 97, 2, 2, 7340, 7341, 7, 69, 2, 2, 7341, 7342, 7, 67, 2, 2, 7342, 7343,
+	7, 69, 2, 2, 7343, 7344, 7, 74, 2, 2, 7344, 7345, 7, 71, 2, 2, 7345, 1144,
+	3, 2, 2, 2, 7346, 7347, 7, 85, 2, 2, 7347, 7348, 7, 83, 2, 2, 7348, 7349,
+	7, 78, 2, 2, 7349, 7350, 7, 97, 2, 2, 7350, 7351, 7, 80, 2, 2, 7351, 7352,
+	7, 81, 2, 2, 7352, 7353, 7, 97, 2, 2, 7353, 7354, 7, 69, 2, 2, 7354, 7355,
+	7, 67, 2, 2, 7355, 7356, 7, 69, 2, 2, 7356, 7357, 7, 74, 2, 2, 7357, 7358,
+	7, 71, 2, 2, 7358, 1146, 3, 2, 2, 2, 7359, 7360, 7, 85, 2, 2, 7360, 7361,
+	7, 83, 2, 2, 7361, 7362, 7, 78, 2, 2, 7362, 7363, 7, 97, 2, 2, 7363, 7364,
+	7, 86, 2, 2, 7364, 7365, 7, 74, 2, 2, 7365, 7366, 7, 84, 2, 2, 7366, 7367,
+	7, 71, 2, 2, 7367, 7368, 7, 67, 2, 2, 7368, 7369, 7, 70, 2, 2, 7369, 1148,
+	3, 2, 2, 2, 7370, 7371, 7, 85, 2, 2, 7371, 7372, 7, 86, 2, 2, 7372, 7373,
+	7, 67, 2, 2, 7373, 7374, 7, 84, 2, 2, 7374, 7375, 7, 86, 2, 2, 7375, 1150,
+	3, 2, 2, 2, 7376, 7377, 7, 85, 2, 2, 7377, 7378, 7, 86, 2, 2, 7378, 7379,
+	7, 67, 2, 2, 7379, 7380, 7, 84, 2, 2, 7380, 7381, 7, 86, 2, 2, 7381, 7382,
+	7, 85, 2, 2, 7382, 1152, 3, 2, 2, 2, 7383, 7384, 7, 85, 2, 2, 7384, 7385,
+	7, 86, 2, 2, 7385, 7386, 7, 67, 2, 2, 7386, 7387, 7, 86, 2, 2, 7387, 7388,
+	7, 85, 2, 2, 7388, 7389, 7, 97, 2, 2, 7389, 7390, 7, 67, 2, 2, 7390, 7391,
+	7, 87, 2, 2, 7391, 7392, 7, 86, 2, 2, 7392, 7393, 7, 81, 2, 2, 7393, 7394,
+	7, 97, 2, 2, 7394, 7395, 7, 84, 2, 2, 7395, 7396, 7, 71, 2, 2, 7396, 7397,
+	7, 69, 2, 2, 7397, 7398, 7, 67, 2, 2, 7398, 7399, 7, 78, 2, 2, 7399, 7400,
+	7, 69, 2, 2, 7400, 1154, 3, 2, 2, 2, 7401, 7402, 7, 85, 2, 2, 7402, 7403,
+	7, 86, 2, 2, 7403, 7404, 7, 67, 2, 2, 7404, 7405, 7, 86, 2, 2, 7405, 7406,
+	7, 85, 2, 2, 7406, 7407, 7, 97, 2, 2, 7407, 7408, 7, 82, 2, 2, 7408, 7409,
+	7, 71, 2, 2, 7409, 7410, 7, 84, 2, 2, 7410, 7411, 7, 85, 2, 2, 7411, 7412,
+	7, 75, 2, 2, 7412, 7413, 7, 85, 2, 2, 7413, 7414, 7, 86, 2, 2, 7414, 7415,
+	7, 71, 2, 2, 7415, 7416, 7, 80, 2, 2, 7416, 7417, 7, 86, 2, 2, 7417, 1156,
+	3, 2, 2, 2, 7418, 7419, 7, 85, 2, 2, 7419, 7420, 7, 86, 2, 2, 7420, 7421,
+	7, 67, 2, 2, 7421, 7422, 7, 86, 2, 2, 7422, 7423, 7, 85, 2, 2, 7423, 7424,
+	7, 97, 2, 2, 7424, 7425, 7, 85, 2, 2, 7425, 7426, 7, 67, 2, 2, 7426, 7427,
+	7, 79, 2, 2, 7427, 7428, 7, 82, 2, 2, 7428, 7429, 7, 78, 2, 2, 7429, 7430,
+	7, 71, 2, 2, 7430, 7431, 7, 97, 2, 2, 7431, 7432, 7, 82, 2, 2, 7432, 7433,
+	7, 67, 2, 2, 7433, 7434, 7, 73, 2, 2, 7434, 7435, 7, 71, 2, 2, 7435, 7436,
+	7, 85, 2, 2, 7436, 1158, 3, 2, 2, 2, 7437, 7438, 7, 85, 2, 2, 7438, 7439,
+	7, 86, 2, 2, 7439, 7440, 7, 67, 2, 2, 7440, 7441, 7, 86, 2, 2, 7441, 7442,
+	7, 87, 2, 2, 7442, 7443, 7, 85, 2, 2, 7443, 1160, 3, 2, 2, 2, 7444, 7445,
+	7, 85, 2, 2, 7445, 7446, 7, 86, 2, 2, 7446, 7447, 7, 81, 2, 2, 7447, 7448,
+	7, 82, 2, 2, 7448, 1162, 3, 2, 2, 2, 7449, 7450, 7, 85, 2, 2, 7450, 7451,
+	7, 86, 2, 2, 7451, 7452, 7, 81, 2, 2, 7452, 7453, 7, 84, 2, 2, 7453, 7454,
+	7, 67, 2, 2, 7454, 7455, 7, 73, 2, 2, 7455, 7456, 7, 71, 2, 2, 7456, 1164,
+	3, 2, 2, 2, 7457, 7458, 7, 85, 2, 2, 7458, 7459, 7, 86, 2, 2, 7459, 7460,
+	7, 81, 2, 2, 7460, 7461, 7, 84, 2, 2, 7461, 7462, 7, 71, 2, 2, 7462, 7463,
+	7, 70, 2, 2, 7463, 1166, 3, 2, 2, 2, 7464, 7465, 7, 85, 2, 2, 7465, 7466,
+	7, 86, 2, 2, 7466, 7467, 7, 84, 2, 2, 7467, 7468, 7, 75, 2, 2, 7468, 7469,
+	7, 80, 2, 2, 7469, 7470, 7, 73, 2, 2, 7470, 1168, 3, 2, 2, 2, 7471, 7472,
+	7, 85, 2, 2, 7472, 7473, 7, 87, 2, 2, 7473, 7474, 7, 68, 2, 2, 7474, 7475,
+	7, 69, 2, 2, 7475, 7476, 7, 78, 2, 2, 7476, 7477, 7, 67, 2, 2, 7477, 7478,
+	7, 85, 2, 2, 7478, 7479, 7, 85, 2, 2, 7479, 7480, 7, 97, 2, 2, 7480, 7481,
+	7, 81, 2, 2, 7481, 7482, 7, 84, 2, 2, 7482, 7483, 7, 75, 2, 2, 7483, 7484,
+	7, 73, 2, 2, 7484, 7485, 7, 75, 2, 2, 7485, 7486, 7, 80, 2, 2, 7486, 1170,
+	3, 2, 2, 2, 7487, 7488, 7, 85, 2, 2, 7488, 7489, 7, 87, 2, 2, 7489, 7490,
+	7, 68, 2, 2, 7490, 7491, 7, 76, 2, 2, 7491, 7492, 7, 71, 2, 2, 7492, 7493,
+	7, 69, 2, 2, 7493, 7494, 7, 86, 2, 2, 7494, 1172, 3, 2, 2, 2, 7495, 7496,
+	7, 85, 2, 2, 7496, 7497, 7, 87, 2, 2, 7497, 7498, 7, 68, 2, 2, 7498, 7499,
+	7, 82, 2, 2, 7499, 7500, 7, 67, 2, 2, 7500, 7501, 7, 84, 2, 2, 7501, 7502,
+	7, 86, 2, 2, 7502, 7503, 7, 75, 2, 2, 7503, 7504, 7, 86, 2, 2, 7504, 7505,
+	7, 75, 2, 2, 7505, 7506, 7, 81, 2, 2, 7506, 7507, 7, 80, 2, 2, 7507, 1174,
+	3, 2, 2, 2, 7508, 7509, 7, 85, 2, 2, 7509, 7510, 7, 87, 2, 2, 7510, 7511,
+	7, 68, 2, 2, 7511, 7512, 7, 82, 2, 2, 7512, 7513, 7, 67, 2, 2, 7513, 7514,
+	7, 84, 2, 2, 7514, 7515, 7, 86, 2, 2, 7515, 7516, 7, 75, 2, 2, 7516, 7517,
+	7, 86, 2, 2, 7517, 7518, 7, 75, 2, 2, 7518, 7519, 7, 81, 2, 2, 7519, 7520,
+	7, 80, 2, 2, 7520, 7521, 7, 85, 2, 2, 7521, 1176, 3, 2, 2, 2, 7522, 7523,
+	7, 85, 2, 2, 7523, 7524, 7, 87, 2, 2, 7524, 7525, 7, 85, 2, 2, 7525, 7526,
+	7, 82, 2, 2, 7526, 7527, 7, 71, 2, 2, 7527, 7528, 7, 80, 2, 2, 7528, 7529,
+	7, 70, 2, 2, 7529, 1178, 3, 2, 2, 2, 7530, 7531, 7, 85, 2, 2, 7531, 7532,
+	7, 89, 2, 2, 7532, 7533, 7, 67, 2, 2, 7533, 7534, 7, 82, 2, 2, 7534, 7535,
+	7, 85, 2, 2, 7535, 1180, 3, 2, 2, 2, 7536, 7537, 7, 85, 2, 2, 7537, 7538,
+	7, 89, 2, 2, 7538, 7539, 7, 75, 2, 2, 7539, 7540, 7, 86, 2, 2, 7540, 7541,
+	7, 69, 2, 2, 7541, 7542, 7, 74, 2, 2, 7542, 7543, 7, 71, 2, 2, 7543, 7544,
+	7, 85, 2, 2, 7544, 1182, 3, 2, 2, 2, 7545, 7546, 7, 86, 2, 2, 7546, 7547,
+	7, 67, 2, 2, 7547, 7548, 7, 68, 2, 2, 7548, 7549, 7, 78, 2, 2, 7549, 7550,
+	7, 71, 2, 2, 7550, 7551, 7, 97, 2, 2, 7551, 7552, 7, 80, 2, 2, 7552, 7553,
+	7, 67, 2, 2, 7553, 7554, 7, 79, 2, 2, 7554, 7555, 7, 71, 2, 2, 7555, 1184,
+	3, 2, 2, 2, 7556, 7557, 7, 86, 2, 2, 7557, 7558, 7, 67, 2, 2, 7558, 7559,
+	7, 68, 2, 2, 7559, 7560, 7, 78, 2, 2, 7560, 7561, 7, 71, 2, 2, 7561, 7562,
+	7, 85, 2, 2, 7562, 7563, 7, 82, 2, 2, 7563, 7564, 7, 67, 2, 2, 7564, 7565,
+	7, 69, 2, 2, 7565, 7566, 7, 71, 2, 2, 7566, 1186, 3, 2, 2, 2, 7567, 7568,
+	7, 86, 2, 2, 7568, 7569, 7, 71, 2, 2, 7569, 7570, 7, 79, 2, 2, 7570, 7571,
+	7, 82, 2, 2, 7571, 7572, 7, 81, 2, 2, 7572, 7573, 7, 84, 2, 2, 7573, 7574,
+	7, 67, 2, 2, 7574, 7575, 7, 84, 2, 2, 7575, 7576, 7, 91, 2, 2, 7576, 1188,
+	3, 2, 2, 2, 7577, 7578, 7, 86, 2, 2, 7578, 7579, 7, 71, 2, 2, 7579, 7580,
+	7, 79, 2, 2, 7580, 7581, 7, 82, 2, 2, 7581, 7582, 7, 86, 2, 2, 7582, 7583,
+	7, 67, 2, 2, 7583, 7584, 7, 68, 2, 2, 7584, 7585, 7, 78, 2, 2, 7585, 7586,
+	7, 71, 2, 2, 7586, 1190, 3, 2, 2, 2, 7587, 7588, 7, 86, 2, 2, 7588, 7589,
+	7, 74, 2, 2, 7589, 7590, 7, 67, 2, 2, 7590, 7591, 7, 80, 2, 2, 7591, 1192,
+	3, 2, 2, 2, 7592, 7593, 7, 86, 2, 2, 7593, 7594, 7, 84, 2, 2, 7594, 7595,
+	7, 67, 2, 2, 7595, 7596, 7, 70, 2, 2, 7596, 7597, 7, 75, 2, 2, 7597, 7598,
+	7, 86, 2, 2, 7598, 7599, 7, 75, 2, 2, 7599, 7600, 7, 81, 2, 2, 7600, 7601,
+	7, 80, 2, 2, 7601, 7602, 7, 67, 2, 2, 7602, 7603, 7, 78, 2, 2, 7603, 1194,
+	3, 2, 2, 2, 7604, 7605, 7, 86, 2, 2, 7605, 7606, 7, 84, 2, 2, 7606, 7607,
+	7, 67, 2, 2, 7607, 7608, 7, 80, 2, 2, 7608, 7609, 7, 85, 2, 2, 7609, 7610,
+	7, 67, 2, 2, 7610, 7611, 7, 69, 2, 2, 7611, 7612, 7, 86, 2, 2, 7612, 7613,
+	7, 75, 2, 2, 7613, 7614, 7, 81, 2, 2, 7614, 7615, 7, 80, 2, 2, 7615, 1196,
+	3, 2, 2, 2, 7616, 7617, 7, 86, 2, 2, 7617, 7618, 7, 84, 2, 2, 7618, 7619,
+	7, 67, 2, 2, 7619, 7620, 7, 80, 2, 2, 7620, 7621, 7, 85, 2, 2, 7621, 7622,
+	7, 67, 2, 2, 7622, 7623, 7, 69, 2, 2, 7623, 7624, 7, 86, 2, 2, 7624, 7625,
+	7, 75, 2, 2, 7625, 7626, 7, 81, 2, 2, 7626, 7627, 7, 80, 2, 2, 7627, 7628,
+	7, 67, 2, 2, 7628, 7629, 7, 78, 2, 2, 7629, 1198, 3, 2, 2, 2, 7630, 7631,
+	7, 86, 2, 2, 7631, 7632, 7, 84, 2, 2, 7632, 7633, 7, 75, 2, 2, 7633, 7634,
+	7, 73, 2, 2, 7634, 7635, 7, 73, 2, 2, 7635, 7636, 7, 71, 2, 2, 7636, 7637,
+	7, 84, 2, 2, 7637, 7638, 7, 85, 2, 2, 7638, 1200, 3, 2, 2, 2, 7639, 7640,
+	7, 86, 2, 2, 7640, 7641, 7, 84, 2, 2, 7641, 7642, 7, 87, 2, 2, 7642, 7643,
+	7, 80, 2, 2, 7643, 7644, 7, 69, 2, 2, 7644, 7645, 7, 67, 2, 2, 7645, 7646,
+	7, 86, 2, 2, 7646, 7647, 7, 71, 2, 2, 7647, 1202, 3, 2, 2, 2, 7648, 7649,
+	7, 87, 2, 2, 7649, 7650, 7, 80, 2, 2, 7650, 7651, 7, 70, 2, 2, 7651, 7652,
+	7, 71, 2, 2, 7652, 7653, 7, 72, 2, 2, 7653, 7654, 7, 75, 2, 2, 7654, 7655,
+	7, 80, 2, 2, 7655, 7656, 7, 71, 2, 2, 7656, 7657, 7, 70, 2, 2, 7657, 1204,
+	3, 2, 2, 2, 7658, 7659, 7, 87, 2, 2, 7659, 7660, 7, 80, 2, 2, 7660, 7661,
+	7, 70, 2, 2, 7661, 7662, 7, 81, 2, 2, 7662, 7663, 7, 72, 2, 2, 7663, 7664,
+	7, 75, 2, 2, 7664, 7665, 7, 78, 2, 2, 7665, 7666, 7, 71, 2, 2, 7666, 1206,
+	3, 2, 2, 2, 7667, 7668, 7, 87, 2, 2, 7668, 7669, 7, 80, 2, 2, 7669, 7670,
+	7, 70, 2, 2, 7670, 7671, 7, 81, 2, 2, 7671, 7672, 7, 97, 2, 2, 7672, 7673,
+	7, 68, 2, 2, 7673, 7674, 7, 87, 2, 2, 7674, 7675, 7, 72, 2, 2, 7675, 7676,
+	7, 72, 2, 2, 7676, 7677, 7, 71, 2, 2, 7677, 7678, 7, 84, 2, 2, 7678, 7679,
+	7, 97, 2, 2, 7679, 7680, 7, 85, 2, 2, 7680, 7681, 7, 75, 2, 2, 7681, 7682,
+	7, 92, 2, 2, 7682, 7683, 7, 71, 2, 2, 7683, 1208, 3, 2, 2, 2, 7684, 7685,
+	7, 87, 2, 2, 7685, 7686, 7, 80, 2, 2, 7686, 7687, 7, 75, 2, 2, 7687, 7688,
+	7, 80, 2, 2, 7688, 7689, 7, 85, 2, 2, 7689, 7690, 7, 86, 2, 2, 7690, 7691,
+	7, 67, 2, 2, 7691, 7692, 7, 78, 2, 2, 7692, 7693, 7, 78, 2, 2, 7693, 1210,
+	3, 2, 2, 2, 7694, 7695, 7, 87, 2, 2, 7695, 7696, 7, 80, 2, 2, 7696, 7697,
+	7, 77, 2, 2, 7697, 7698, 7, 80, 2, 2, 7698, 7699, 7, 81, 2, 2, 7699, 7700,
+	7, 89, 2, 2, 7700, 7701, 7, 80, 2, 2, 7701, 1212, 3, 2, 2, 2, 7702, 7703,
+	7, 87, 2, 2, 7703, 7704, 7, 80, 2, 2, 7704, 7705, 7, 86, 2, 2, 7705, 7706,
+	7, 75, 2, 2, 7706, 7707, 7, 78, 2, 2, 7707, 1214, 3, 2, 2, 2, 7708, 7709,
+	7, 87, 2, 2, 7709, 7710, 7, 82, 2, 2, 7710, 7711, 7, 73, 2, 2, 7711, 7712,
+	7, 84, 2, 2, 7712, 7713, 7, 67, 2, 2, 7713, 7714, 7, 70, 2, 2, 7714, 7715,
+	7, 71, 2, 2, 7715, 1216, 3, 2, 2, 2, 7716, 7717, 7, 87, 2, 2, 7717, 7718,
+	7, 85, 2, 2, 7718, 7719, 7, 71, 2, 2, 7719, 7720, 7, 84, 2, 2, 7720, 1218,
+	3, 2, 2, 2, 7721, 7722, 7, 87, 2, 2, 7722, 7723, 7, 85, 2, 2, 7723, 7724,
+	7, 71, 2, 2, 7724, 7725, 7, 97, 2, 2, 7725, 7726, 7, 72, 2, 2, 7726, 7727,
+	7, 84, 2, 2, 7727, 7728, 7, 79, 2, 2, 7728, 1220, 3, 2, 2, 2, 7729, 7730,
+	7, 87, 2, 2, 7730, 7731, 7, 85, 2, 2, 7731, 7732, 7, 71, 2, 2, 7732, 7733,
+	7, 84, 2, 2, 7733, 7734, 7, 97, 2, 2, 7734, 7735, 7, 84, 2, 2, 7735, 7736,
+	7, 71, 2, 2, 7736, 7737, 7, 85, 2, 2, 7737, 7738, 7, 81, 2, 2, 7738, 7739,
+	7, 87, 2, 2, 7739, 7740, 7, 84, 2, 2, 7740, 7741, 7, 69, 2, 2, 7741, 7742,
+	7, 71, 2, 2, 7742, 7743, 7, 85, 2, 2, 7743, 1222, 3, 2, 2, 2, 7744, 7745,
+	7, 88, 2, 2, 7745, 7746, 7, 67, 2, 2, 7746, 7747, 7, 78, 2, 2, 7747, 7748,
+	7, 75, 2, 2, 7748, 7749, 7, 70, 2, 2, 7749, 7750, 7, 67, 2, 2, 7750, 7751,
+	7, 86, 2, 2, 7751, 7752, 7, 75, 2, 2, 7752, 7753, 7, 81, 2, 2, 7753, 7754,
+	7, 80, 2, 2, 7754, 1224, 3, 2, 2, 2, 7755, 7756, 7, 88, 2, 2, 7756, 7757,
+	7, 67, 2, 2, 7757, 7758, 7, 78, 2, 2, 7758, 7759, 7, 87, 2, 2, 7759, 7760,
+	7, 71, 2, 2, 7760, 1226, 3, 2, 2, 2, 7761, 7762, 7, 88, 2, 2, 7762, 7763,
+	7, 67, 2, 2, 7763, 7764, 7, 84, 2, 2, 7764, 7765, 7, 75, 2, 2, 7765, 7766,
+	7, 67, 2, 2, 7766, 7767, 7, 68, 2, 2, 7767, 7768, 7, 78, 2, 2, 7768, 7769,
+	7, 71, 2, 2, 7769, 7770, 7, 85, 2, 2, 7770, 1228, 3, 2, 2, 2, 7771, 7772,
+	7, 88, 2, 2, 7772, 7773, 7, 75, 2, 2, 7773, 7774, 7, 71, 2, 2, 7774, 7775,
+	7, 89, 2, 2, 7775, 1230, 3, 2, 2, 2, 7776, 7777, 7, 88, 2, 2, 7777, 7778,
+	7, 75, 2, 2, 7778, 7779, 7, 84, 2, 2, 7779, 7780, 7, 86, 2, 2, 7780, 7781,
+	7, 87, 2, 2, 7781, 7782, 7, 67, 2, 2, 7782, 7783, 7, 78, 2, 2, 7783, 1232,
+	3, 2, 2, 2, 7784, 7785, 7, 88, 2, 2, 7785, 7786, 7, 75, 2, 2, 7786, 7787,
+	7, 85, 2, 2, 7787, 7788, 7, 75, 2, 2, 7788, 7789, 7, 68, 2, 2, 7789, 7790,
+	7, 78, 2, 2, 7790, 7791, 7, 71, 2, 2, 7791, 1234, 3, 2, 2, 2, 7792, 7793,
+	7, 89, 2, 2, 7793, 7794, 7, 67, 2, 2, 7794, 7795, 7, 75, 2, 2, 7795, 7796,
+	7, 86, 2, 2, 7796, 1236, 3, 2, 2, 2, 7797, 7798, 7, 89, 2, 2, 7798, 7799,
+	7, 67, 2, 2, 7799, 7800, 7, 84, 2, 2, 7800, 7801, 7, 80, 2, 2, 7801, 7802,
+	7, 75, 2, 2, 7802, 7803, 7, 80, 2, 2, 7803, 7804, 7, 73, 2, 2, 7804, 7805,
+	7, 85, 2, 2, 7805, 1238, 3, 2, 2, 2, 7806, 7807, 7, 89, 2, 2, 7807, 7808,
+	7, 75, 2, 2, 7808, 7809, 7, 86, 2, 2, 7809, 7810, 7, 74, 2, 2, 7810, 7811,
+	7, 81, 2, 2, 7811, 7812, 7, 87, 2, 2, 7812, 7813, 7, 86, 2, 2, 7813, 1240,
+	3, 2, 2, 2, 7814, 7815, 7, 89, 2, 2, 7815, 7816, 7, 81, 2, 2, 7816, 7817,
+	7, 84, 2, 2, 7817, 7818, 7, 77, 2, 2, 7818, 1242, 3, 2, 2, 2, 7819, 7820,
+	7, 89, 2, 2, 7820, 7821, 7, 84, 2, 2, 7821, 7822, 7, 67, 2, 2, 7822, 7823,
+	7, 82, 2, 2, 7823, 7824, 7, 82, 2, 2, 7824, 7825, 7, 71, 2, 2, 7825, 7826,
+	7, 84, 2, 2, 7826, 1244, 3, 2, 2, 2, 7827, 7828, 7, 90, 2, 2, 7828, 7829,
+	7, 55, 2, 2, 7829, 7830, 7, 50, 2, 2, 7830, 7831, 7, 59, 2, 2, 7831, 1246,
+	3, 2, 2, 2, 7832, 7833, 7, 90, 2, 2, 7833, 7834, 7, 67, 2, 2, 7834, 1248,
+	3, 2, 2, 2, 7835, 7836, 7, 90, 2, 2, 7836, 7837, 7, 79, 2, 2, 7837, 7838,
+	7, 78, 2, 2, 7838, 1250, 3, 2, 2, 2, 7839, 7840, 7, 71, 2, 2, 7840, 7841,
+	7, 87, 2, 2, 7841, 7842, 7, 84, 2, 2, 7842, 1252, 3, 2, 2, 2, 7843, 7844,
+	7, 87, 2, 2, 7844, 7845, 7, 85, 2, 2, 7845, 7846, 7, 67, 2, 2, 7846, 1254,
+	3, 2, 2, 2, 7847, 7848, 7, 76, 2, 2, 7848, 7849, 7, 75, 2, 2, 7849, 7850,
+	7, 85, 2, 2, 7850, 1256, 3, 2, 2, 2, 7851, 7852, 7, 75, 2, 2, 7852, 7853,
+	7, 85, 2, 2, 7853, 7854, 7, 81, 2, 2, 7854, 1258, 3, 2, 2, 2, 7855, 7856,
+	7, 75, 2, 2, 7856, 7857, 7, 80, 2, 2, 7857, 7858, 7, 86, 2, 2, 7858, 7859,
+	7, 71, 2, 2, 7859, 7860, 7, 84, 2, 2, 7860, 7861, 7, 80, 2, 2, 7861, 7862,
+	7, 67, 2, 2, 7862, 7863, 7, 78, 2, 2, 7863, 1260, 3, 2, 2, 2, 7864, 7865,
+	7, 83, 2, 2, 7865, 7866, 7, 87, 2, 2, 7866, 7867, 7, 67, 2, 2, 7867, 7868,
+	7, 84, 2, 2, 7868, 7869, 7, 86, 2, 2, 7869, 7870, 7, 71, 2, 2, 7870, 7871,
+	7, 84, 2, 2, 7871, 1262, 3, 2, 2, 2, 7872, 7873, 7, 79, 2, 2, 7873, 7874,
+	7, 81, 2, 2, 7874, 7875, 7, 80, 2, 2, 7875, 7876, 7, 86, 2, 2, 7876, 7877,
+	7, 74, 2, 2, 7877, 1264, 3, 2, 2, 2, 7878, 7879, 7, 70, 2, 2, 7879, 7880,
+	7, 67, 2, 2, 7880, 7881, 7, 91, 2, 2, 7881, 1266, 3, 2, 2, 2, 7882, 7883,
+	7, 74, 2, 2, 7883, 7884, 7, 81, 2, 2, 7884, 7885, 7, 87, 2, 2, 7885, 7886,
+	7, 84, 2, 2, 7886, 1268, 3, 2, 2, 2, 7887, 7888, 7, 79, 2, 2, 7888, 7889,
+	7, 75, 2, 2, 7889, 7890, 7, 80, 2, 2, 7890, 7891, 7, 87, 2, 2, 7891, 7892,
+	7, 86, 2, 2, 7892, 7893, 7, 71, 2, 2, 7893, 1270, 3, 2, 2, 2, 7894, 7895,
+	7, 89, 2, 2, 7895, 7896, 7, 71, 2, 2, 7896, 7897, 7, 71, 2, 2, 7897, 7898,
+	7, 77, 2, 2, 7898, 1272, 3, 2, 2, 2, 7899, 7900, 7, 85, 2, 2, 7900, 7901,
+	7, 71, 2, 2, 7901, 7902, 7, 69, 2, 2, 7902, 7903, 7, 81, 2, 2, 7903, 7904,
+	7, 80, 2, 2, 7904, 7905, 7, 70, 2, 2, 7905, 1274, 3, 2, 2, 2, 7906, 7907,
+	7, 79, 2, 2, 7907, 7908, 7, 75, 2, 2, 7908, 7909, 7, 69, 2, 2, 7909, 7910,
+	7, 84, 2, 2, 7910, 7911, 7, 81, 2, 2, 7911, 7912, 7, 85, 2, 2, 7912, 7913,
+	7, 71, 2, 2, 7913, 7914, 7, 69, 2, 2, 7914, 7915, 7, 81, 2, 2, 7915, 7916,
+	7, 80, 2, 2, 7916, 7917, 7, 70, 2, 2, 7917, 1276, 3, 2, 2, 2, 7918, 7919,
+	7, 86, 2, 2, 7919, 7920, 7, 67, 2, 2, 7920, 7921, 7, 68, 2, 2, 7921, 7922,
+	7, 78, 2, 2, 7922, 7923, 7, 71, 2, 2, 7923, 7924, 7, 85, 2, 2, 7924, 1278,
+	3, 2, 2, 2, 7925, 7926, 7, 84, 2, 2, 7926, 7927, 7, 81, 2, 2, 7927, 7928,
+	7, 87, 2, 2, 7928, 7929, 7, 86, 2, 2, 7929, 7930, 7, 75, 2, 2, 7930, 7931,
+	7, 80, 2, 2, 7931, 7932, 7, 71, 2, 2, 7932, 1280, 3, 2, 2, 2, 7933, 7934,
+	7, 71, 2, 2, 7934, 7935, 7, 90, 2, 2, 7935, 7936, 7, 71, 2, 2, 7936, 7937,
+	7, 69, 2, 2, 7937, 7938, 7, 87, 2, 2, 7938, 7939, 7, 86, 2, 2, 7939, 7940,
+	7, 71, 2, 2, 7940, 1282, 3, 2, 2, 2, 7941, 7942, 7, 72, 2, 2, 7942, 7943,
+	7, 75, 2, 2, 7943, 7944, 7, 78, 2, 2, 7944, 7945, 7, 71, 2, 2, 7945, 1284,
+	3, 2, 2, 2, 7946, 7947, 7, 82, 2, 2, 7947, 7948, 7, 84, 2, 2, 7948, 7949,
+	7, 81, 2, 2, 7949, 7950, 7, 69, 2, 2, 7950, 7951, 7, 71, 2, 2, 7951, 7952,
+	7, 85, 2, 2, 7952, 7953, 7, 85, 2, 2, 7953, 1286, 3, 2, 2, 2, 7954, 7955,
+	7, 84, 2, 2, 7955, 7956, 7, 71, 2, 2, 7956, 7957, 7, 78, 2, 2, 7957, 7958,
+	7, 81, 2, 2, 7958, 7959, 7, 67, 2, 2, 7959, 7960, 7, 70, 2, 2, 7960, 1288,
+	3, 2, 2, 2, 7961, 7962, 7, 85, 2, 2, 7962, 7963, 7, 74, 2, 2, 7963, 7964,
+	7, 87, 2, 2, 7964, 7965, 7, 86, 2, 2, 7965, 7966, 7, 70, 2, 2, 7966, 7967,
+	7, 81, 2, 2, 7967, 7968, 7, 89, 2, 2, 7968, 7969, 7, 80, 2, 2, 7969, 1290,
+	3, 2, 2, 2, 7970, 7971, 7, 85, 2, 2, 7971, 7972, 7, 87, 2, 2, 7972, 7973,
+	7, 82, 2, 2, 7973, 7974, 7, 71, 2, 2, 7974, 7975, 7, 84, 2, 2, 7975, 1292,
+	3, 2, 2, 2, 7976, 7977, 7, 82, 2, 2, 7977, 7978, 7, 84, 2, 2, 7978, 7979,
+	7, 75, 2, 2, 7979, 7980, 7, 88, 2, 2, 7980, 7981, 7, 75, 2, 2, 7981, 7982,
+	7, 78, 2, 2, 7982, 7983, 7, 71, 2, 2, 7983, 7984, 7, 73, 2, 2, 7984, 7985,
+	7, 71, 2, 2, 7985, 7986, 7, 85, 2, 2, 7986, 1294, 3, 2, 2, 2, 7987, 7988,
+	7, 67, 2, 2, 7988, 7989, 7, 82, 2, 2, 7989, 7990, 7, 82, 2, 2, 7990, 7991,
+	7, 78, 2, 2, 7991, 7992, 7, 75, 2, 2, 7992, 7993, 7, 69, 2, 2, 7993, 7994,
+	7, 67, 2, 2, 7994, 7995, 7, 86, 2, 2, 7995, 7996, 7, 75, 2, 2, 7996, 7997,
+	7, 81, 2, 2, 7997, 7998, 7, 80, 2, 2, 7998, 7999, 7, 97, 2, 2, 7999, 8000,
+	7, 82, 2, 2, 8000, 8001, 7, 67, 2, 2, 8001, 8002, 7, 85, 2, 2, 8002, 8003,
+	7, 85, 2, 2, 8003, 8004, 7, 89, 2, 2, 8004, 8005, 7, 81, 2, 2, 8005, 8006,
+	7, 84, 2, 2, 8006, 8007, 7, 70, 2, 2, 8007, 8008, 7, 97, 2, 2, 8008, 8009,
+	7, 67, 2, 2, 8009, 8010, 7, 70, 2, 2, 8010, 8011, 7, 79, 2, 2, 8011, 8012,
+	7, 75, 2, 2, 8012, 8013, 7, 80, 2, 2, 8013, 1296, 3, 2, 2, 2, 8014, 8015,
+	7, 67, 2, 2, 8015, 8016, 7, 87, 2, 2, 8016, 8017, 7, 70, 2, 2, 8017, 8018,
+	7, 75, 2, 2, 8018, 8019, 7, 86, 2, 2, 8019, 8020, 7, 97, 2, 2, 8020, 8021,
+	7, 67, 2, 2, 8021, 8022, 7, 70, 2, 2, 8022, 8023, 7, 79, 2, 2, 8023, 8024,
+	7, 75, 2, 2, 8024, 8025, 7, 80, 2, 2, 8025, 1298, 3, 2, 2, 2, 8026, 8027,
+	7, 68, 2, 2, 8027, 8028, 7, 67, 2, 2, 8028, 8029, 7, 69, 2, 2, 8029, 8030,
+	7, 77, 2, 2, 8030, 8031, 7, 87, 2, 2, 8031, 8032, 7, 82, 2, 2, 8032, 8033,
+	7, 97, 2, 2, 8033, 8034, 7, 67, 2, 2, 8034, 8035, 7, 70, 2, 2, 8035, 8036,
+	7, 79, 2, 2, 8036, 8037, 7, 75, 2, 2, 8037, 8038, 7, 80, 2, 2, 8038, 1300,
+	3, 2, 2, 2, 8039, 8040, 7, 68, 2, 2, 8040, 8041, 7, 75, 2, 2, 8041, 8042,
+	7, 80, 2, 2, 8042, 8043, 7, 78, 2, 2, 8043, 8044, 7, 81, 2, 2, 8044, 8045,
+	7, 73, 2, 2, 8045, 8046, 7, 97, 2, 2, 8046, 8047, 7, 67, 2, 2, 8047, 8048,
+	7, 70, 2, 2, 8048, 8049, 7, 79, 2, 2, 8049, 8050, 7, 75, 2, 2, 8050, 8051,
+	7, 80, 2, 2, 8051, 1302, 3, 2, 2, 2, 8052, 8053, 7, 68, 2, 2, 8053, 8054,
+	7, 75, 2, 2, 8054, 8055, 7, 80, 2, 2, 8055, 8056, 7, 78, 2, 2, 8056, 8057,
+	7, 81, 2, 2, 8057, 8058, 7, 73, 2, 2, 8058, 8059, 7, 97, 2, 2, 8059, 8060,
+	7, 71, 2, 2, 8060, 8061, 7, 80, 2, 2, 8061, 8062, 7, 69, 2, 2, 8062, 8063,
+	7, 84, 2, 2, 8063, 8064, 7, 91, 2, 2, 8064, 8065, 7, 82, 2, 2, 8065, 8066,
+	7, 86, 2, 2, 8066, 8067, 7, 75, 2, 2, 8067, 8068, 7, 81, 2, 2, 8068, 8069,
+	7, 80, 2, 2, 8069, 8070, 7, 97, 2, 2, 8070, 8071, 7, 67, 2, 2, 8071, 8072,
+	7, 70, 2, 2, 8072, 8073, 7, 79, 2, 2, 8073, 8074, 7, 75, 2, 2, 8074, 8075,
+	7, 80, 2, 2, 8075, 1304, 3, 2, 2, 2, 8076, 8077, 7, 69, 2, 2, 8077, 8078,
+	7, 78, 2, 2, 8078, 8079, 7, 81, 2, 2, 8079, 8080, 7, 80, 2, 2, 8080, 8081,
+	7, 71, 2, 2, 8081, 8082, 7, 97, 2, 2, 8082, 8083, 7, 67, 2, 2, 8083, 8084,
+	7, 70, 2, 2, 8084, 8085, 7, 79, 2, 2, 8085, 8086, 7, 75, 2, 2, 8086, 8087,
+	7, 80, 2, 2, 8087, 1306, 3, 2, 2, 2, 8088, 8089, 7, 69, 2, 2, 8089, 8090,
+	7, 81, 2, 2, 8090, 8091, 7, 80, 2, 2, 8091, 8092, 7, 80, 2, 2, 8092, 8093,
+	7, 71, 2, 2, 8093, 8094, 7, 69, 2, 2, 8094, 8095, 7, 86, 2, 2, 8095, 8096,
+	7, 75, 2, 2, 8096, 8097, 7, 81, 2, 2, 8097, 8098, 7, 80, 2, 2, 8098, 8099,
+	7, 97, 2, 2, 8099, 8100, 7, 67, 2, 2, 8100, 8101, 7, 70, 2, 2, 8101, 8102,
+	7, 79, 2, 2, 8102, 8103, 7, 75, 2, 2, 8103, 8104, 7, 80, 2, 2, 8104, 1308,
+	3, 2, 2, 2, 8105, 8106, 7, 71, 2, 2, 8106, 8107, 7, 80, 2, 2, 8107, 8108,
+	7, 69, 2, 2, 8108, 8109, 7, 84, 2, 2, 8109, 8110, 7, 91, 2, 2, 8110, 8111,
+	7, 82, 2, 2, 8111, 8112, 7, 86, 2, 2, 8112, 8113, 7, 75, 2, 2, 8113, 8114,
+	7, 81, 2, 2, 8114, 8115, 7, 80, 2, 2, 8115, 8116, 7, 97, 2, 2, 8116, 8117,
+	7, 77, 2, 2, 8117, 8118, 7, 71, 2, 2, 8118, 8119, 7, 91, 2, 2, 8119, 8120,
+	7, 97, 2, 2, 8120, 8121, 7, 67, 2, 2, 8121, 8122, 7, 70, 2, 2, 8122, 8123,
+	7, 79, 2, 2, 8123, 8124, 7, 75, 2, 2, 8124, 8125, 7, 80, 2, 2, 8125, 1310,
+	3, 2, 2, 2, 8126, 8127, 7, 72, 2, 2, 8127, 8128, 7, 75, 2, 2, 8128, 8129,
+	7, 84, 2, 2, 8129, 8130, 7, 71, 2, 2, 8130, 8131, 7, 89, 2, 2, 8131, 8132,
+	7, 67, 2, 2, 8132, 8133, 7, 78, 2, 2, 8133, 8134, 7, 78, 2, 2, 8134, 8135,
+	7, 97, 2, 2, 8135, 8136, 7, 67, 2, 2, 8136, 8137, 7, 70, 2, 2, 8137, 8138,
+	7, 79, 2, 2, 8138, 8139, 7, 75, 2, 2, 8139, 8140, 7, 80, 2, 2, 8140, 1312,
+	3, 2, 2, 2, 8141, 8142, 7, 72, 2, 2, 8142, 8143, 7, 75, 2, 2, 8143, 8144,
+	7, 84, 2, 2, 8144, 8145, 7, 71, 2, 2, 8145, 8146, 7, 89, 2, 2, 8146, 8147,
+	7, 67, 2, 2, 8147, 8148, 7, 78, 2, 2, 8148, 8149, 7, 78, 2, 2, 8149, 8150,
+	7, 97, 2, 2, 8150, 8151, 7, 87, 2, 2, 8151, 8152, 7, 85, 2, 2, 8152, 8153,
+	7, 71, 2, 2, 8153, 8154, 7, 84, 2, 2, 8154, 1314, 3, 2, 2, 2, 8155, 8156,
+	7, 72, 2, 2, 8156, 8157, 7, 78, 2, 2, 8157, 8158, 7, 87, 2, 2, 8158, 8159,
+	7, 85, 2, 2, 8159, 8160, 7, 74, 2, 2, 8160, 8161, 7, 97, 2, 2, 8161, 8162,
+	7, 81, 2, 2, 8162, 8163, 7, 82, 2, 2, 8163, 8164, 7, 86, 2, 2, 8164, 8165,
+	7, 75, 2, 2, 8165, 8166, 7, 79, 2, 2, 8166, 8167, 7, 75, 2, 2, 8167, 8168,
+	7, 92, 2, 2, 8168, 8169, 7, 71, 2, 2, 8169, 8170, 7, 84, 2, 2, 8170, 8171,
+	7, 97, 2, 2, 8171, 8172, 7, 69, 2, 2, 8172, 8173, 7, 81, 2, 2, 8173, 8174,
+	7, 85, 2, 2, 8174, 8175, 7, 86, 2, 2, 8175, 8176, 7, 85, 2, 2, 8176, 1316,
+	3, 2, 2, 2, 8177, 8178, 7, 72, 2, 2, 8178, 8179, 7, 78, 2, 2, 8179, 8180,
+	7, 87, 2, 2, 8180, 8181, 7, 85, 2, 2, 8181, 8182, 7, 74, 2, 2, 8182, 8183,
+	7, 97, 2, 2, 8183, 8184, 7, 85, 2, 2, 8184, 8185, 7, 86, 2, 2, 8185, 8186,
+	7, 67, 2, 2, 8186, 8187, 7, 86, 2, 2, 8187, 8188, 7, 87, 2, 2, 8188, 8189,
+	7, 85, 2, 2, 8189, 1318, 3, 2, 2, 2, 8190, 8191, 7, 72, 2, 2, 8191, 8192,
+	7, 78, 2, 2, 8192, 8193, 7, 87, 2, 2, 8193, 8194, 7, 85, 2, 2, 8194, 8195,
+	7, 74, 2, 2, 8195, 8196, 7, 97, 2, 2, 8196, 8197, 7, 86, 2, 2, 8197, 8198,
+	7, 67, 2, 2, 8198, 8199, 7, 68, 2, 2, 8199, 8200, 7, 78, 2, 2, 8200, 8201,
+	7, 71, 2, 2, 8201, 8202, 7, 85, 2, 2, 8202, 1320, 3, 2, 2, 2, 8203, 8204,
+	7, 72, 2, 2, 8204, 8205, 7, 78, 2, 2, 8205, 8206, 7, 87, 2, 2, 8206, 8207,
+	7, 85, 2, 2, 8207, 8208, 7, 74, 2, 2, 8208, 8209, 7, 97, 2, 2, 8209, 8210,
+	7, 87, 2, 2, 8210, 8211, 7, 85, 2, 2, 8211, 8212, 7, 71, 2, 2, 8212, 8213,
+	7, 84, 2, 2, 8213, 8214, 7, 97, 2, 2, 8214, 8215, 7, 84, 2, 2, 8215, 8216,
+	7, 71, 2, 2, 8216, 8217, 7, 85, 2, 2, 8217, 8218, 7, 81, 2, 2, 8218, 8219,
+	7, 87, 2, 2, 8219, 8220, 7, 84, 2, 2, 8220, 8221, 7, 69, 2, 2, 8221, 8222,
+	7, 71, 2, 2, 8222, 8223, 7, 85, 2, 2, 8223, 1322, 3, 2, 2, 2, 8224, 8225,
+	7, 73, 2, 2, 8225, 8226, 7, 84, 2, 2, 8226, 8227, 7, 81, 2, 2, 8227, 8228,
+	7, 87, 2, 2, 8228, 8229, 7, 82, 2, 2, 8229, 8230, 7, 97, 2, 2, 8230, 8231,
+	7, 84, 2, 2, 8231, 8232, 7, 71, 2, 2, 8232, 8233, 7, 82, 2, 2, 8233, 8234,
+	7, 78, 2, 2, 8234, 8235, 7, 75, 2, 2, 8235, 8236, 7, 69, 2, 2, 8236, 8237,
+	7, 67, 2, 2, 8237, 8238, 7, 86, 2, 2, 8238, 8239, 7, 75, 2, 2, 8239, 8240,
+	7, 81, 2, 2, 8240, 8241, 7, 80, 2, 2, 8241, 8242, 7, 97, 2, 2, 8242, 8243,
+	7, 67, 2, 2, 8243, 8244, 7, 70, 2, 2, 8244, 8245, 7, 79, 2, 2, 8245, 8246,
+	7, 75, 2, 2, 8246, 8247, 7, 80, 2, 2, 8247, 1324, 3, 2, 2, 2, 8248, 8249,
+	7, 75, 2, 2, 8249, 8250, 7, 80, 2, 2, 8250, 8251, 7, 80, 2, 2, 8251, 8252,
+	7, 81, 2, 2, 8252, 8253, 7, 70, 2, 2, 8253, 8254, 7, 68, 2, 2, 8254, 8255,
+	7, 97, 2, 2, 8255, 8256, 7, 84, 2, 2, 8256, 8257, 7, 71, 2, 2, 8257, 8258,
+	7, 70, 2, 2, 8258, 8259, 7, 81, 2, 2, 8259, 8260, 7, 97, 2, 2, 8260, 8261,
+	7, 78, 2, 2, 8261, 8262, 7, 81, 2, 2, 8262, 8263, 7, 73, 2, 2, 8263, 8264,
+	7, 97, 2, 2, 8264, 8265, 7, 67, 2, 2, 8265, 8266, 7, 84, 2, 2, 8266, 8267,
+	7, 69, 2, 2, 8267, 8268, 7, 74, 2, 2, 8268, 8269, 7, 75, 2, 2, 8269, 8270,
+	7, 88, 2, 2, 8270, 8271, 7, 71, 2, 2, 8271, 1326, 3, 2, 2, 2, 8272, 8273,
+	7, 75, 2, 2, 8273, 8274, 7, 80, 2, 2, 8274, 8275, 7, 80, 2, 2, 8275, 8276,
+	7, 81, 2, 2, 8276, 8277, 7, 70, 2, 2, 8277, 8278, 7, 68, 2, 2, 8278, 8279,
+	7, 97, 2, 2, 8279, 8280, 7, 84, 2, 2, 8280, 8281, 7, 71, 2, 2, 8281, 8282,
+	7, 70, 2, 2, 8282, 8283, 7, 81, 2, 2, 8283, 8284, 7, 97, 2, 2, 8284, 8285,
+	7, 78, 2, 2, 8285, 8286, 7, 81, 2, 2, 8286, 8287, 7, 73, 2, 2, 8287, 8288,
+	7, 97, 2, 2, 8288, 8289, 7, 71, 2, 2, 8289, 8290, 7, 80, 2, 2, 8290, 8291,
+	7, 67, 2, 2, 8291, 8292, 7, 68, 2, 2, 8292, 8293, 7, 78, 2, 2, 8293, 8294,
+	7, 71, 2, 2, 8294, 1328, 3, 2, 2, 2, 8295, 8296, 7, 80, 2, 2, 8296, 8297,
+	7, 70, 2, 2, 8297, 8298, 7, 68, 2, 2, 8298, 8299, 7, 97, 2, 2, 8299, 8300,
+	7, 85, 2, 2, 8300, 8301, 7, 86, 2, 2, 8301, 8302, 7, 81, 2, 2, 8302, 8303,
+	7, 84, 2, 2, 8303, 8304, 7, 71, 2, 2, 8304, 8305, 7, 70, 2, 2, 8305, 8306,
+	7, 97, 2, 2, 8306, 8307, 7, 87, 2, 2, 8307, 8308, 7, 85, 2, 2, 8308, 8309,
+	7, 71, 2, 2, 8309, 8310, 7, 84, 2, 2, 8310, 1330, 3, 2, 2, 2, 8311, 8312,
+	7, 82, 2, 2, 8312, 8313, 7, 71, 2, 2, 8313, 8314, 7, 84, 2, 2, 8314, 8315,
+	7, 85, 2, 2, 8315, 8316, 7, 75, 2, 2, 8316, 8317, 7, 85, 2, 2, 8317, 8318,
+	7, 86, 2, 2, 8318, 8319, 7, 97, 2, 2, 8319, 8320, 7, 84, 2, 2, 8320, 8321,
+	7, 81, 2, 2, 8321, 8322, 7, 97, 2, 2, 8322, 8323, 7, 88, 2, 2, 8323, 8324,
+	7, 67, 2, 2, 8324, 8325, 7, 84, 2, 2, 8325, 8326, 7, 75, 2, 2, 8326, 8327,
+	7, 67, 2, 2, 8327, 8328, 7, 68, 2, 2, 8328, 8329, 7, 78, 2, 2, 8329, 8330,
+	7, 71, 2, 2, 8330, 8331, 7, 85, 2, 2, 8331, 8332, 7, 97, 2, 2, 8332, 8333,
+	7, 67, 2, 2, 8333, 8334, 7, 70, 2, 2, 8334, 8335, 7, 79, 2, 2, 8335, 8336,
+	7, 75, 2, 2, 8336, 8337, 7, 80, 2, 2, 8337, 1332, 3, 2, 2, 2, 8338, 8339,
+	7, 84, 2, 2, 8339, 8340, 7, 71, 2, 2, 8340, 8341, 7, 82, 2, 2, 8341, 8342,
+	7, 78, 2, 2, 8342, 8343, 7, 75, 2, 2, 8343, 8344, 7, 69, 2, 2, 8344, 8345,
+	7, 67, 2, 2, 8345, 8346, 7, 86, 2, 2, 8346, 8347, 7, 75, 2, 2, 8347, 8348,
+	7, 81, 2, 2, 8348, 8349, 7, 80, 2, 2, 8349, 8350, 7, 97, 2, 2, 8350, 8351,
+	7, 67, 2, 2, 8351, 8352, 7, 82, 2, 2, 8352, 8353, 7, 82, 2, 2, 8353, 8354,
+	7, 78, 2, 2, 8354, 8355, 7, 75, 2, 2, 8355, 8356, 7, 71, 2, 2, 8356, 8357,
+	7, 84, 2, 2, 8357, 1334, 3, 2, 2, 2, 8358, 8359, 7, 84, 2, 2, 8359, 8360,
+	7, 71, 2, 2, 8360, 8361, 7, 82, 2, 2, 8361, 8362, 7, 78, 2, 2, 8362, 8363,
+	7, 75, 2, 2, 8363, 8364, 7, 69, 2, 2, 8364, 8365, 7, 67, 2, 2, 8365, 8366,
+	7, 86, 2, 2, 8366, 8367, 7, 75, 2, 2, 8367, 8368, 7, 81, 2, 2, 8368, 8369,
+	7, 80, 2, 2, 8369, 8370, 7, 97, 2, 2, 8370, 8371, 7, 85, 2, 2, 8371, 8372,
+	7, 78, 2, 2, 8372, 8373, 7, 67, 2, 2, 8373, 8374, 7, 88, 2, 2, 8374, 8375,
+	7, 71, 2, 2, 8375, 8376, 7, 97, 2, 2, 8376, 8377, 7, 67, 2, 2, 8377, 8378,
+	7, 70, 2, 2, 8378, 8379, 7, 79, 2, 2, 8379, 8380, 7, 75, 2, 2, 8380, 8381,
+	7, 80, 2, 2, 8381, 1336, 3, 2, 2, 2, 8382, 8383, 7, 84, 2, 2, 8383, 8384,
+	7, 71, 2, 2, 8384, 8385, 7, 85, 2, 2, 8385, 8386, 7, 81, 2, 2, 8386, 8387,
+	7, 87, 2, 2, 8387, 8388, 7, 84, 2, 2, 8388, 8389, 7, 69, 2, 2, 8389, 8390,
+	7, 71, 2, 2, 8390, 8391, 7, 97, 2, 2, 8391, 8392, 7, 73, 2, 2, 8392, 8393,
+	7, 84, 2, 2, 8393, 8394, 7, 81, 2, 2, 8394, 8395, 7, 87, 2, 2, 8395, 8396,
+	7, 82, 2, 2, 8396, 8397, 7, 97, 2, 2, 8397, 8398, 7, 67, 2, 2, 8398, 8399,
+	7, 70, 2, 2, 8399, 8400, 7, 79, 2, 2, 8400, 8401, 7, 75, 2, 2, 8401, 8402,
+	7, 80, 2, 2, 8402, 1338, 3, 2, 2, 2, 8403, 8404, 7, 84, 2, 2, 8404, 8405,
+	7, 71, 2, 2, 8405, 8406, 7, 85, 2, 2, 8406, 8407, 7, 81, 2, 2, 8407, 8408,
+	7, 87, 2, 2, 8408, 8409, 7, 84, 2, 2, 8409, 8410, 7, 69, 2, 2, 8410, 8411,
+	7, 71, 2, 2, 8411, 8412, 7, 97, 2, 2, 8412, 8413, 7, 73, 2, 2, 8413, 8414,
+	7, 84, 2, 2, 8414, 8415, 7, 81, 2, 2, 8415, 8416, 7, 87, 2, 2, 8416, 8417,
+	7, 82, 2, 2, 8417, 8418, 7, 97, 2, 2, 8418, 8419, 7, 87, 2, 2, 8419, 8420,
+	7, 85, 2, 2, 8420, 8421, 7, 71, 2, 2, 8421, 8422, 7, 84, 2, 2, 8422, 1340,
+	3, 2, 2, 2, 8423, 8424, 7, 84, 2, 2, 8424, 8425, 7, 81, 2, 2, 8425, 8426,
+	7, 78, 2, 2, 8426, 8427, 7, 71, 2, 2, 8427, 8428, 7, 97, 2, 2, 8428, 8429,
+	7, 67, 2, 2, 8429, 8430, 7, 70, 2, 2, 8430, 8431, 7, 79, 2, 2, 8431, 8432,
+	7, 75, 2, 2, 8432, 8433, 7, 80, 2, 2, 8433, 1342, 3, 2, 2, 2, 8434, 8435,
+	7, 85, 2, 2, 8435, 8436, 7, 71, 2, 2, 8436, 8437, 7, 84, 2, 2, 8437, 8438,
+	7, 88, 2, 2, 8438, 8439, 7, 75, 2, 2, 8439, 8440, 7, 69, 2, 2, 8440, 8441,
+	7, 71, 2, 2, 8441, 8442, 7, 97, 2, 2, 8442, 8443, 7, 69, 2, 2, 8443, 8444,
+	7, 81, 2, 2, 8444, 8445, 7, 80, 2, 2, 8445, 8446, 7, 80, 2, 2, 8446, 8447,
+	7, 71, 2, 2, 8447, 8448, 7, 69, 2, 2, 8448, 8449, 7, 86, 2, 2, 8449, 8450,
+	7, 75, 2, 2, 8450, 8451, 7, 81, 2, 2, 8451, 8452, 7, 80, 2, 2, 8452, 8453,
+	7, 97, 2, 2, 8453, 8454, 7, 67, 2, 2, 8454, 8455, 7, 70, 2, 2, 8455, 8456,
+	7, 79, 2, 2, 8456, 8457, 7, 75, 2, 2, 8457, 8458, 7, 80, 2, 2, 8458, 1344,
+	3, 2, 2, 2, 8459, 8461, 5, 2149, 1075, 2, 8460, 8459, 3, 2, 2, 2, 8460,
+	8461, 3, 2, 2, 2, 8461, 8462, 3, 2, 2, 2, 8462, 8463, 7, 85, 2, 2, 8463,
+	8464, 7, 71, 2, 2, 8464, 8465, 7, 85, 2, 2, 8465, 8466, 7, 85, 2, 2, 8466,
+	8467, 7, 75, 2, 2, 8467, 8468, 7, 81, 2, 2, 8468, 8469, 7, 80, 2, 2, 8469,
+	8470, 7, 97, 2, 2, 8470, 8471, 7, 88, 2, 2, 8471, 8472, 7, 67, 2, 2, 8472,
+	8473, 7, 84, 2, 2, 8473, 8474, 7, 75, 2, 2, 8474, 8475, 7, 67, 2, 2, 8475,
+	8476, 7, 68, 2, 2, 8476, 8477, 7, 78, 2, 2, 8477, 8478, 7, 71, 2, 2, 8478,
+	8479, 7, 85, 2, 2, 8479, 8480, 7, 97, 2, 2, 8480, 8481, 7, 67, 2, 2, 8481,
+	8482, 7, 70, 2, 2, 8482, 8483, 7, 79, 2, 2, 8483, 8484, 7, 75, 2, 2, 8484,
+	8485, 7, 80, 2, 2, 8485, 8487, 3, 2, 2, 2, 8486, 8488, 5, 2149, 1075, 2,
+	8487, 8486, 3, 2, 2, 2, 8487, 8488, 3, 2, 2, 2, 8488, 1346, 3, 2, 2, 2,
+	8489, 8490, 7, 85, 2, 2, 8490, 8491, 7, 71, 2, 2, 8491, 8492, 7, 86, 2,
+	2, 8492, 8493, 7, 97, 2, 2, 8493, 8494, 7, 87, 2, 2, 8494, 8495, 7, 85,
+	2, 2, 8495, 8496, 7, 71, 2, 2, 8496, 8497, 7, 84, 2, 2, 8497, 8498, 7,
+	97, 2, 2, 8498, 8499, 7, 75, 2, 2, 8499, 8500, 7, 70, 2, 2, 8500, 1348,
+	3, 2, 2, 2, 8501, 8502, 7, 85, 2, 2, 8502, 8503, 7, 74, 2, 2, 8503, 8504,
+	7, 81, 2, 2, 8504, 8505, 7, 89, 2, 2, 8505, 8506, 7, 97, 2, 2, 8506, 8507,
+	7, 84, 2, 2, 8507, 8508, 7, 81, 2, 2, 8508, 8509, 7, 87, 2, 2, 8509, 8510,
+	7, 86, 2, 2, 8510, 8511, 7, 75, 2, 2, 8511, 8512, 7, 80, 2, 2, 8512, 8513,
+	7, 71, 2, 2, 8513, 1350, 3, 2, 2, 2, 8514, 8515, 7, 85, 2, 2, 8515, 8516,
+	7, 91, 2, 2, 8516, 8517, 7, 85, 2, 2, 8517, 8518, 7, 86, 2, 2, 8518, 8519,
+	7, 71, 2, 2, 8519, 8520, 7, 79, 2, 2, 8520, 8521, 7, 97, 2, 2, 8521, 8522,
+	7, 88, 2, 2, 8522, 8523, 7, 67, 2, 2, 8523, 8524, 7, 84, 2, 2, 8524, 8525,
+	7, 75, 2, 2, 8525, 8526, 7, 67, 2, 2, 8526, 8527, 7, 68, 2, 2, 8527, 8528,
+	7, 78, 2, 2, 8528, 8529, 7, 71, 2, 2, 8529, 8530, 7, 85, 2, 2, 8530, 8531,
+	7, 97, 2, 2, 8531, 8532, 7, 67, 2, 2, 8532, 8533, 7, 70, 2, 2, 8533, 8534,
+	7, 79, 2, 2, 8534, 8535, 7, 75, 2, 2, 8535, 8536, 7, 80, 2, 2, 8536, 1352,
+	3, 2, 2, 2, 8537, 8538, 7, 86, 2, 2, 8538, 8539, 7, 67, 2, 2, 8539, 8540,
+	7, 68, 2, 2, 8540, 8541, 7, 78, 2, 2, 8541, 8542, 7, 71, 2, 2, 8542, 8543,
+	7, 97, 2, 2, 8543, 8544, 7, 71, 2, 2, 8544, 8545, 7, 80, 2, 2, 8545, 8546,
+	7, 69, 2, 2, 8546, 8547, 7, 84, 2, 2, 8547, 8548, 7, 91, 2, 2, 8548, 8549,
+	7, 82, 2, 2, 8549, 8550, 7, 86, 2, 2, 8550, 8551, 7, 75, 2, 2, 8551, 8552,
+	7, 81, 2, 2, 8552, 8553, 7, 80, 2, 2, 8553, 8554, 7, 97, 2, 2, 8554, 8555,
+	7, 67, 2, 2, 8555, 8556, 7, 70, 2, 2, 8556, 8557, 7, 79, 2, 2, 8557, 8558,
+	7, 75, 2, 2, 8558, 8559, 7, 80, 2, 2, 8559, 1354, 3, 2, 2, 2, 8560, 8561,
+	7, 88, 2, 2, 8561, 8562, 7, 71, 2, 2, 8562, 8563, 7, 84, 2, 2, 8563, 8564,
+	7, 85, 2, 2, 8564, 8565, 7, 75, 2, 2, 8565, 8566, 7, 81, 2, 2, 8566, 8567,
+	7, 80, 2, 2, 8567, 8568, 7, 97, 2, 2, 8568, 8569, 7, 86, 2, 2, 8569, 8570,
+	7, 81, 2, 2, 8570, 8571, 7, 77, 2, 2, 8571, 8572, 7, 71, 2, 2, 8572, 8573,
+	7, 80, 2, 2, 8573, 8574, 7, 97, 2, 2, 8574, 8575, 7, 67, 2, 2, 8575, 8576,
+	7, 70, 2, 2, 8576, 8577, 7, 79, 2, 2, 8577, 8578, 7, 75, 2, 2, 8578, 8579,
+	7, 80, 2, 2, 8579, 1356, 3, 2, 2, 2, 8580, 8581, 7, 90, 2, 2, 8581, 8582,
+	7, 67, 2, 2, 8582, 8583, 7, 97, 2, 2, 8583, 8584, 7, 84, 2, 2, 8584, 8585,
+	7, 71, 2, 2, 8585, 8586, 7, 69, 2, 2, 8586, 8587, 7, 81, 2, 2, 8587, 8588,
+	7, 88, 2, 2, 8588, 8589, 7, 71, 2, 2, 8589, 8590, 7, 84, 2, 2, 8590, 8591,
+	7, 97, 2, 2, 8591, 8592, 7, 67, 2, 2, 8592, 8593, 7, 70, 2, 2, 8593, 8594,
+	7, 79, 2, 2, 8594, 8595, 7, 75, 2, 2, 8595, 8596, 7, 80, 2, 2, 8596, 1358,
+	3, 2, 2, 2, 8597, 8598, 7, 67, 2, 2, 8598, 8599, 7, 84, 2, 2, 8599, 8600,
+	7, 79, 2, 2, 8600, 8601, 7, 85, 2, 2, 8601, 8602, 7, 69, 2, 2, 8602, 8603,
+	7, 75, 2, 2, 8603, 8604, 7, 75, 2, 2, 8604, 8605, 7, 58, 2, 2, 8605, 1360,
+	3, 2, 2, 2, 8606, 8607, 7, 67, 2, 2, 8607, 8608, 7, 85, 2, 2, 8608, 8609,
+	7, 69, 2, 2, 8609, 8610, 7, 75, 2, 2, 8610, 8611, 7, 75, 2, 2, 8611, 1362,
+	3, 2, 2, 2, 8612, 8613, 7, 68, 2, 2, 8613, 8614, 7, 75, 2, 2, 8614, 8615,
+	7, 73, 2, 2, 8615, 8616, 7, 55, 2, 2, 8616, 1364, 3, 2, 2, 2, 8617, 8618,
+	7, 69, 2, 2, 8618, 8619, 7, 82, 2, 2, 8619, 8620, 7, 51, 2, 2, 8620, 8621,
+	7, 52, 2, 2, 8621, 8622, 7, 55, 2, 2, 8622, 8623, 7, 50, 2, 2, 8623, 1366,
+	3, 2, 2, 2, 8624, 8625, 7, 69, 2, 2, 8625, 8626, 7, 82, 2, 2, 8626, 8627,
+	7, 51, 2, 2, 8627, 8628, 7, 52, 2, 2, 8628, 8629, 7, 55, 2, 2, 8629, 8630,
+	7, 51, 2, 2, 8630, 1368, 3, 2, 2, 2, 8631, 8632, 7, 69, 2, 2, 8632, 8633,
+	7, 82, 2, 2, 8633, 8634, 7, 51, 2, 2, 8634, 8635, 7, 52, 2, 2, 8635, 8636,
+	7, 55, 2, 2, 8636, 8637, 7, 56, 2, 2, 8637, 1370, 3, 2, 2, 2, 8638, 8639,
+	7, 69, 2, 2, 8639, 8640, 7, 82, 2, 2, 8640, 8641, 7, 51, 2, 2, 8641, 8642,
+	7, 52, 2, 2, 8642, 8643, 7, 55, 2, 2, 8643, 8644, 7, 57, 2, 2, 8644, 1372,
+	3, 2, 2, 2, 8645, 8646, 7, 69, 2, 2, 8646, 8647, 7, 82, 2, 2, 8647, 8648,
+	7, 58, 2, 2, 8648, 8649, 7, 55, 2, 2, 8649, 8650, 7, 50, 2, 2, 8650, 1374,
+	3, 2, 2, 2, 8651, 8652, 7, 69, 2, 2, 8652, 8653, 7, 82, 2, 2, 8653, 8654,
+	7, 58, 2, 2, 8654, 8655, 7, 55, 2, 2, 8655, 8656, 7, 52, 2, 2, 8656, 1376,
+	3, 2, 2, 2, 8657, 8658, 7, 69, 2, 2, 8658, 8659, 7, 82, 2, 2, 8659, 8660,
+	7, 58, 2, 2, 8660, 8661, 7, 56, 2, 2, 8661, 8662, 7, 56, 2, 2, 8662, 1378,
+	3, 2, 2, 2, 8663, 8664, 7, 69, 2, 2, 8664, 8665, 7, 82, 2, 2, 8665, 8666,
+	7, 59, 2, 2, 8666, 8667, 7, 53, 2, 2, 8667, 8668, 7, 52, 2, 2, 8668, 1380,
+	3, 2, 2, 2, 8669, 8670, 7, 70, 2, 2, 8670, 8671, 7, 71, 2, 2, 8671, 8672,
+	7, 69, 2, 2, 8672, 8673, 7, 58, 2, 2, 8673, 1382, 3, 2, 2, 2, 8674, 8675,
+	7, 71, 2, 2, 8675, 8676, 7, 87, 2, 2, 8676, 8677, 7, 69, 2, 2, 8677, 8678,
+	7, 76, 2, 2, 8678, 8679, 7, 82, 2, 2, 8679, 8680, 7, 79, 2, 2, 8680, 8681,
+	7, 85, 2, 2, 8681, 1384, 3, 2, 2, 2, 8682, 8683, 7, 71, 2, 2, 8683, 8684,
+	7, 87, 2, 2, 8684, 8685, 7, 69, 2, 2, 8685, 8686, 7, 77, 2, 2, 8686, 8687,
+	7, 84, 2, 2, 8687, 1386, 3, 2, 2, 2, 8688, 8689, 7, 73, 2, 2, 8689, 8690,
+	7, 68, 2, 2, 8690, 8691, 7, 52, 2, 2, 8691, 8692, 7, 53, 2, 2, 8692, 8693,
+	7, 51, 2, 2, 8693, 8694, 7, 52, 2, 2, 8694, 1388, 3, 2, 2, 2, 8695, 8696,
+	7, 73, 2, 2, 8696, 8697, 7, 68, 2, 2, 8697, 8698, 7, 77, 2, 2, 8698, 1390,
+	3, 2, 2, 2, 8699, 8700, 7, 73, 2, 2, 8700, 8701, 7, 71, 2, 2, 8701, 8702,
+	7, 81, 2, 2, 8702, 8703, 7, 85, 2, 2, 8703, 8704, 7, 86, 2, 2, 8704, 8705,
+	7, 70, 2, 2, 8705, 8706, 7, 58, 2, 2, 8706, 1392, 3, 2, 2, 2, 8707, 8708,
+	7, 73, 2, 2, 8708, 8709, 7, 84, 2, 2, 8709, 8710, 7, 71, 2, 2, 8710, 8711,
+	7, 71, 2, 2, 8711, 8712, 7, 77, 2, 2, 8712, 1394, 3, 2, 2, 2, 8713, 8714,
+	7, 74, 2, 2, 8714, 8715, 7, 71, 2, 2, 8715, 8716, 7, 68, 2, 2, 8716, 8717,
+	7, 84, 2, 2, 8717, 8718, 7, 71, 2, 2, 8718, 8719, 7, 89, 2, 2, 8719, 1396,
+	3, 2, 2, 2, 8720, 8721, 7, 74, 2, 2, 8721, 8722, 7, 82, 2, 2, 8722, 8723,
+	7, 58, 2, 2, 8723, 1398, 3, 2, 2, 2, 8724, 8725, 7, 77, 2, 2, 8725, 8726,
+	7, 71, 2, 2, 8726, 8727, 7, 91, 2, 2, 8727, 8728, 7, 68, 2, 2, 8728, 8729,
+	7, 69, 2, 2, 8729, 8730, 7, 85, 2, 2, 8730, 8731, 7, 52, 2, 2, 8731, 1400,
+	3, 2, 2, 2, 8732, 8733, 7, 77, 2, 2, 8733, 8734, 7, 81, 2, 2, 8734, 8735,
+	7, 75, 2, 2, 8735, 8736, 7, 58, 2, 2, 8736, 8737, 7, 84, 2, 2, 8737, 1402,
+	3, 2, 2, 2, 8738, 8739, 7, 77, 2, 2, 8739, 8740, 7, 81, 2, 2, 8740, 8741,
+	7, 75, 2, 2, 8741, 8742, 7, 58, 2, 2, 8742, 8743, 7, 87, 2, 2, 8743, 1404,
+	3, 2, 2, 2, 8744, 8745, 7, 78, 2, 2, 8745, 8746, 7, 67, 2, 2, 8746, 8747,
+	7, 86, 2, 2, 8747, 8748, 7, 75, 2, 2, 8748, 8749, 7, 80, 2, 2, 8749, 8750,
+	7, 51, 2, 2, 8750, 1406, 3, 2, 2, 2, 8751, 8752, 7, 78, 2, 2, 8752, 8753,
+	7, 67, 2, 2, 8753, 8754, 7, 86, 2, 2, 8754, 8755, 7, 75, 2, 2, 8755, 8756,
+	7, 80, 2, 2, 8756, 8757, 7, 52, 2, 2, 8757, 1408, 3, 2, 2, 2, 8758, 8759,
+	7, 78, 2, 2, 8759, 8760, 7, 67, 2, 2, 8760, 8761, 7, 86, 2, 2, 8761, 8762,
+	7, 75, 2, 2, 8762, 8763, 7, 80, 2, 2, 8763, 8764, 7, 55, 2, 2, 8764, 1410,
+	3, 2, 2, 2, 8765, 8766, 7, 78, 2, 2, 8766, 8767, 7, 67, 2, 2, 8767, 8768,
+	7, 86, 2, 2, 8768, 8769, 7, 75, 2, 2, 8769, 8770, 7, 80, 2, 2, 8770, 8771,
+	7, 57, 2, 2, 8771, 1412, 3, 2, 2, 2, 8772, 8773, 7, 79, 2, 2, 8773, 8774,
+	7, 67, 2, 2, 8774, 8775, 7, 69, 2, 2, 8775, 8776, 7, 69, 2, 2, 8776, 8777,
+	7, 71, 2, 2, 8777, 1414, 3, 2, 2, 2, 8778, 8779, 7, 79, 2, 2, 8779, 8780,
+	7, 67, 2, 2, 8780, 8781, 7, 69, 2, 2, 8781, 8782, 7, 84, 2, 2, 8782, 8783,
+	7, 81, 2, 2, 8783, 8784, 7, 79, 2, 2, 8784, 8785, 7, 67, 2, 2, 8785, 8786,
+	7, 80, 2, 2, 8786, 1416, 3, 2, 2, 2, 8787, 8788, 7, 85, 2, 2, 8788, 8789,
+	7, 76, 2, 2, 8789, 8790, 7, 75, 2, 2, 8790, 8791, 7, 85, 2, 2, 8791, 1418,
+	3, 2, 2, 2, 8792, 8793, 7, 85, 2, 2, 8793, 8794, 7, 89, 2, 2, 8794, 8795,
+	7, 71, 2, 2, 8795, 8796, 7, 57, 2, 2, 8796, 1420, 3, 2, 2, 2, 8797, 8798,
+	7, 86, 2, 2, 8798, 8799, 7, 75, 2, 2, 8799, 8800, 7, 85, 2, 2, 8800, 8801,
+	7, 56, 2, 2, 8801, 8802, 7, 52, 2, 2, 8802, 8803, 7, 50, 2, 2, 8803, 1422,
+	3, 2, 2, 2, 8804, 8805, 7, 87, 2, 2, 8805, 8806, 7, 69, 2, 2, 8806, 8807,
+	7, 85, 2, 2, 8807, 8808, 7, 52, 2, 2, 8808, 1424, 3, 2, 2, 2, 8809, 8810,
+	7, 87, 2, 2, 8810, 8811, 7, 76, 2, 2, 8811, 8812, 7, 75, 2, 2, 8812, 8813,
+	7, 85, 2, 2, 8813, 1426, 3, 2, 2, 2, 8814, 8815, 7, 87, 2, 2, 8815, 8816,
+	7, 86, 2, 2, 8816, 8817, 7, 72, 2, 2, 8817, 8818, 7, 51, 2, 2, 8818, 8819,
+	7, 56, 2, 2, 8819, 1428, 3, 2, 2, 2, 8820, 8821, 7, 87, 2, 2, 8821, 8822,
+	7, 86, 2, 2, 8822, 8823, 7, 72, 2, 2, 8823, 8824, 7, 51, 2, 2, 8824, 8825,
+	7, 56, 2, 2, 8825, 8826, 7, 78, 2, 2, 8826, 8827, 7, 71, 2, 2, 8827, 1430,
+	3, 2, 2, 2, 8828, 8829, 7, 87, 2, 2, 8829, 8830, 7, 86, 2, 2, 8830, 8831,
+	7, 72, 2, 2, 8831, 8832, 7, 53, 2, 2, 8832, 8833, 7, 52, 2, 2, 8833, 1432,
+	3, 2, 2, 2, 8834, 8835, 7, 87, 2, 2, 8835, 8836, 7, 86, 2, 2, 8836, 8837,
+	7, 72, 2, 2, 8837, 8838, 7, 58, 2, 2, 8838, 1434, 3, 2, 2, 2, 8839, 8840,
+	7, 87, 2, 2, 8840, 8841, 7, 86, 2, 2, 8841, 8842, 7, 72, 2, 2, 8842, 8843,
+	7, 58, 2, 2, 8843, 8844, 7, 79, 2, 2, 8844, 8845, 7, 68, 2, 2, 8845, 8846,
+	7, 53, 2, 2, 8846, 1436, 3, 2, 2, 2, 8847, 8848, 7, 87, 2, 2, 8848, 8849,
+	7, 86, 2, 2, 8849, 8850, 7, 72, 2, 2, 8850, 8851, 7, 58, 2, 2, 8851, 8852,
+	7, 79, 2, 2, 8852, 8853, 7, 68, 2, 2, 8853, 8854, 7, 54, 2, 2, 8854, 1438,
+	3, 2, 2, 2, 8855, 8856, 7, 67, 2, 2, 8856, 8857, 7, 84, 2, 2, 8857, 8858,
+	7, 69, 2, 2, 8858, 8859, 7, 74, 2, 2, 8859, 8860, 7, 75, 2, 2, 8860, 8861,
+	7, 88, 2, 2, 8861, 8862, 7, 71, 2, 2, 8862, 1440, 3, 2, 2, 2, 8863, 8864,
+	7, 68, 2, 2, 8864, 8865, 7, 78, 2, 2, 8865, 8866, 7, 67, 2, 2, 8866, 8867,
+	7, 69, 2, 2, 8867, 8868, 7, 77, 2, 2, 8868, 8869, 7, 74, 2, 2, 8869, 8870,
+	7, 81, 2, 2, 8870, 8871, 7, 78, 2, 2, 8871, 8872, 7, 71, 2, 2, 8872, 1442,
+	3, 2, 2, 2, 8873, 8874, 7, 69, 2, 2, 8874, 8875, 7, 85, 2, 2, 8875, 8876,
+	7, 88, 2, 2, 8876, 1444, 3, 2, 2, 2, 8877, 8878, 7, 72, 2, 2, 8878, 8879,
+	7, 71, 2, 2, 8879, 8880, 7, 70, 2, 2, 8880, 8881, 7, 71, 2, 2, 8881, 8882,
+	7, 84, 2, 2, 8882, 8883, 7, 67, 2, 2, 8883, 8884, 7, 86, 2, 2, 8884, 8885,
+	7, 71, 2, 2, 8885, 8886, 7, 70, 2, 2, 8886, 1446, 3, 2, 2, 2, 8887, 8888,
+	7, 75, 2, 2, 8888, 8889, 7, 80, 2, 2, 8889, 8890, 7, 80, 2, 2, 8890, 8891,
+	7, 81, 2, 2, 8891, 8892, 7, 70, 2, 2, 8892, 8893, 7, 68, 2, 2, 8893, 1448,
+	3, 2, 2, 2, 8894, 8895, 7, 79, 2, 2, 8895, 8896, 7, 71, 2, 2, 8896, 8897,
+	7, 79, 2, 2, 8897, 8898, 7, 81, 2, 2, 8898, 8899, 7, 84, 2, 2, 8899, 8900,
+	7, 91, 2, 2, 8900, 1450, 3, 2, 2, 2, 8901, 8902, 7, 79, 2, 2, 8902, 8903,
+	7, 84, 2, 2, 8903, 8904, 7, 73, 2, 2, 8904, 8905, 7, 97, 2, 2, 8905, 8906,
+	7, 79, 2, 2, 8906, 8907, 7, 91, 2, 2, 8907, 8908, 7, 75, 2, 2, 8908, 8909,
+	7, 85, 2, 2, 8909, 8910, 7, 67, 2, 2, 8910, 8911, 7, 79, 2, 2, 8911, 1452,
+	3, 2, 2, 2, 8912, 8913, 7, 79, 2, 2, 8913, 8914, 7, 91, 2, 2, 8914, 8915,
+	7, 75, 2, 2, 8915, 8916, 7, 85, 2, 2, 8916, 8917, 7, 67, 2, 2, 8917, 8918,
+	7, 79, 2, 2, 8918, 1454, 3, 2, 2, 2, 8919, 8920, 7, 80, 2, 2, 8920, 8921,
+	7, 70, 2, 2, 8921, 8922, 7, 68, 2, 2, 8922, 1456, 3, 2, 2, 2, 8923, 8924,
+	7, 80, 2, 2, 8924, 8925, 7, 70, 2, 2, 8925, 8926, 7, 68, 2, 2, 8926, 8927,
+	7, 69, 2, 2, 8927, 8928, 7, 78, 2, 2, 8928, 8929, 7, 87, 2, 2, 8929, 8930,
+	7, 85, 2, 2, 8930, 8931, 7, 86, 2, 2, 8931, 8932, 7, 71, 2, 2, 8932, 8933,
+	7, 84, 2, 2, 8933, 1458, 3, 2, 2, 2, 8934, 8935, 7, 82, 2, 2, 8935, 8936,
+	7, 71, 2, 2, 8936, 8937, 7, 84, 2, 2, 8937, 8938, 7, 72, 2, 2, 8938, 8939,
+	7, 81, 2, 2, 8939, 8940, 7, 84, 2, 2, 8940, 8941, 7, 79, 2, 2, 8941, 8942,
+	7, 67, 2, 2, 8942, 8943, 7, 80, 2, 2, 8943, 8944, 7, 69, 2, 2, 8944, 8945,
+	7, 71, 2, 2, 8945, 8946, 7, 97, 2, 2, 8946, 8947, 7, 85, 2, 2, 8947, 8948,
+	7, 69, 2, 2, 8948, 8949, 7, 74, 2, 2, 8949, 8950, 7, 71, 2, 2, 8950, 8951,
+	7, 79, 2, 2, 8951, 8952, 7, 67, 2, 2, 8952, 1460, 3, 2, 2, 2, 8953, 8954,
+	7, 86, 2, 2, 8954, 8955, 7, 81, 2, 2, 8955, 8956, 7, 77, 2, 2, 8956, 8957,
+	7, 87, 2, 2, 8957, 8958, 7, 70, 2, 2, 8958, 8959, 7, 68, 2, 2, 8959, 1462,
+	3, 2, 2, 2, 8960, 8961, 7, 84, 2, 2, 8961, 8962, 7, 71, 2, 2, 8962, 8963,
+	7, 82, 2, 2, 8963, 8964, 7, 71, 2, 2, 8964, 8965, 7, 67, 2, 2, 8965, 8966,
+	7, 86, 2, 2, 8966, 8967, 7, 67, 2, 2, 8967, 8968, 7, 68, 2, 2, 8968, 8969,
+	7, 78, 2, 2, 8969, 8970, 7, 71, 2, 2, 8970, 1464, 3, 2, 2, 2, 8971, 8972,
+	7, 69, 2, 2, 8972, 8973, 7, 81, 2, 2, 8973, 8974, 7, 79, 2, 2, 8974, 8975,
+	7, 79, 2, 2, 8975, 8976, 7, 75, 2, 2, 8976, 8977, 7, 86, 2, 2, 8977, 8978,
+	7, 86, 2, 2, 8978, 8979, 7, 71, 2, 2, 8979, 8980, 7, 70, 2, 2, 8980, 1466,
+	3, 2, 2, 2, 8981, 8982, 7, 87, 2, 2, 8982, 8983, 7, 80, 2, 2, 8983, 8984,
+	7, 69, 2, 2, 8984, 8985, 7, 81, 2, 2, 8985, 8986, 7, 79, 2, 2, 8986, 8987,
+	7, 79, 2, 2, 8987, 8988, 7, 75, 2, 2, 8988, 8989, 7, 86, 2, 2, 8989, 8990,
+	7, 86, 2, 2, 8990, 8991, 7, 71, 2, 2, 8991, 8992, 7, 70, 2, 2, 8992, 1468,
+	3, 2, 2, 2, 8993, 8994, 7, 85, 2, 2, 8994, 8995, 7, 71, 2, 2, 8995, 8996,
+	7, 84, 2, 2, 8996, 8997, 7, 75, 2, 2, 8997, 8998, 7, 67, 2, 2, 8998, 8999,
+	7, 78, 2, 2, 8999, 9000, 7, 75, 2, 2, 9000, 9001, 7, 92, 2, 2, 9001, 9002,
+	7, 67, 2, 2, 9002, 9003, 7, 68, 2, 2, 9003, 9004, 7, 78, 2, 2, 9004, 9005,
+	7, 71, 2, 2, 9005, 1470, 3, 2, 2, 2, 9006, 9007, 7, 73, 2, 2, 9007, 9008,
+	7, 71, 2, 2, 9008, 9009, 7, 81, 2, 2, 9009, 9010, 7, 79, 2, 2, 9010, 9011,
+	7, 71, 2, 2, 9011, 9012, 7, 86, 2, 2, 9012, 9013, 7, 84, 2, 2, 9013, 9014,
+	7, 91, 2, 2, 9014, 9015, 7, 69, 2, 2, 9015, 9016, 7, 81, 2, 2, 9016, 9017,
+	7, 78, 2, 2, 9017, 9018, 7, 78, 2, 2, 9018, 9019, 7, 71, 2, 2, 9019, 9020,
+	7, 69, 2, 2, 9020, 9021, 7, 86, 2, 2, 9021, 9022, 7, 75, 2, 2, 9022, 9023,
+	7, 81, 2, 2, 9023, 9024, 7, 80, 2, 2, 9024, 1472, 3, 2, 2, 2, 9025, 9026,
+	7, 73, 2, 2, 9026, 9027, 7, 71, 2, 2, 9027, 9028, 7, 81, 2, 2, 9028, 9029,
+	7, 79, 2, 2, 9029, 9030, 7, 69, 2, 2, 9030, 9031, 7, 81, 2, 2, 9031, 9032,
+	7, 78, 2, 2, 9032, 9033, 7, 78, 2, 2, 9033, 9034, 7, 71, 2, 2, 9034, 9035,
+	7, 69, 2, 2, 9035, 9036, 7, 86, 2, 2, 9036, 9037, 7, 75, 2, 2, 9037, 9038,
+	7, 81, 2, 2, 9038, 9039, 7, 80, 2, 2, 9039, 1474, 3, 2, 2, 2, 9040, 9041,
+	7, 73, 2, 2, 9041, 9042, 7, 71, 2, 2, 9042, 9043, 7, 81, 2, 2, 9043, 9044,
+	7, 79, 2, 2, 9044, 9045, 7, 71, 2, 2, 9045, 9046, 7, 86, 2, 2, 9046, 9047,
+	7, 84, 2, 2, 9047, 9048, 7, 91, 2, 2, 9048, 1476, 3, 2, 2, 2, 9049, 9050,
+	7, 78, 2, 2, 9050, 9051, 7, 75, 2, 2, 9051, 9052, 7, 80, 2, 2, 9052, 9053,
+	7, 71, 2, 2, 9053, 9054, 7, 85, 2, 2, 9054, 9055, 7, 86, 2, 2, 9055, 9056,
+	7, 84, 2, 2, 9056, 9057, 7, 75, 2, 2, 9057, 9058, 7, 80, 2, 2, 9058, 9059,
+	7, 73, 2, 2, 9059, 1478, 3, 2, 2, 2, 9060, 9061, 7, 79, 2, 2, 9061, 9062,
+	7, 87, 2, 2, 9062, 9063, 7, 78, 2, 2, 9063, 9064, 7, 86, 2, 2, 9064, 9065,
+	7, 75, 2, 2, 9065, 9066, 7, 78, 2, 2, 9066, 9067, 7, 75, 2, 2, 9067, 9068,
+	7, 80, 2, 2, 9068, 9069, 7, 71, 2, 2, 9069, 9070, 7, 85, 2, 2, 9070, 9071,
+	7, 86, 2, 2, 9071, 9072, 7, 84, 2, 2, 9072, 9073, 7, 75, 2, 2, 9073, 9074,
+	7, 80, 2, 2, 9074, 9075, 7, 73, 2, 2, 9075, 1480, 3, 2, 2, 2, 9076, 9077,
+	7, 79, 2, 2, 9077, 9078, 7, 87, 2, 2, 9078, 9079, 7, 78, 2, 2, 9079, 9080,
+	7, 86, 2, 2, 9080, 9081, 7, 75, 2, 2, 9081, 9082, 7, 82, 2, 2, 9082, 9083,
+	7, 81, 2, 2, 9083, 9084, 7, 75, 2, 2, 9084, 9085, 7, 80, 2, 2, 9085, 9086,
+	7, 86, 2, 2, 9086, 1482, 3, 2, 2, 2, 9087, 9088, 7, 79, 2, 2, 9088, 9089,
+	7, 87, 2, 2, 9089, 9090, 7, 78, 2, 2, 9090, 9091, 7, 86, 2, 2, 9091, 9092,
+	7, 75, 2, 2, 9092, 9093, 7, 82, 2, 2, 9093, 9094, 7, 81, 2, 2, 9094, 9095,
+	7, 78, 2, 2, 9095, 9096, 7, 91, 2, 2, 9096, 9097, 7, 73, 2, 2, 9097, 9098,
+	7, 81, 2, 2, 9098, 9099, 7, 80, 2, 2, 9099, 1484, 3, 2, 2, 2, 9100, 9101,
+	7, 82, 2, 2, 9101, 9102, 7, 81, 2, 2, 9102, 9103, 7, 75, 2, 2, 9103, 9104,
+	7, 80, 2, 2, 9104, 9105, 7, 86, 2, 2, 9105, 1486, 3, 2, 2, 2, 9106, 9107,
+	7, 82, 2, 2, 9107, 9108, 7, 81, 2, 2, 9108, 9109, 7, 78, 2, 2, 9109, 9110,
+	7, 91, 2, 2, 9110, 9111, 7, 73, 2, 2, 9111, 9112, 7, 81, 2, 2, 9112, 9113,
+	7, 80, 2, 2, 9113, 1488, 3, 2, 2, 2, 9114, 9115, 7, 67, 2, 2, 9115, 9116,
+	7, 68, 2, 2, 9116, 9117, 7, 85, 2, 2, 9117, 1490, 3, 2, 2, 2, 9118, 9119,
+	7, 67, 2, 2, 9119, 9120, 7, 69, 2, 2, 9120, 9121, 7, 81, 2, 2, 9121, 9122,
+	7, 85, 2, 2, 9122, 1492, 3, 2, 2, 2, 9123, 9124, 7, 67, 2, 2, 9124, 9125,
+	7, 70, 2, 2, 9125, 9126, 7, 70, 2, 2, 9126, 9127, 7, 70, 2, 2, 9127, 9128,
+	7, 67, 2, 2, 9128, 9129, 7, 86, 2, 2, 9129, 9130, 7, 71, 2, 2, 9130, 1494,
+	3, 2, 2, 2, 9131, 9132, 7, 67, 2, 2, 9132, 9133, 7, 70, 2, 2, 9133, 9134,
+	7, 70, 2, 2, 9134, 9135, 7, 86, 2, 2, 9135, 9136, 7, 75, 2, 2, 9136, 9137,
+	7, 79, 2, 2, 9137, 9138, 7, 71, 2, 2, 9138, 1496, 3, 2, 2, 2, 9139, 9140,
+	7, 67, 2, 2, 9140, 9141, 7, 71, 2, 2, 9141, 9142, 7, 85, 2, 2, 9142, 9143,
+	7, 97, 2, 2, 9143, 9144, 7, 70, 2, 2, 9144, 9145, 7, 71, 2, 2, 9145, 9146,
+	7, 69, 2, 2, 9146, 9147, 7, 84, 2, 2, 9147, 9148, 7, 91, 2, 2, 9148, 9149,
+	7, 82, 2, 2, 9149, 9150, 7, 86, 2, 2, 9150, 1498, 3, 2, 2, 2, 9151, 9152,
+	7, 67, 2, 2, 9152, 9153, 7, 71, 2, 2, 9153, 9154, 7, 85, 2, 2, 9154, 9155,
+	7, 97, 2, 2, 9155, 9156, 7, 71, 2, 2, 9156, 9157, 7, 80, 2, 2, 9157, 9158,
+	7, 69, 2, 2, 9158, 9159, 7, 84, 2, 2, 9159, 9160, 7, 91, 2, 2, 9160, 9161,
+	7, 82, 2, 2, 9161, 9162, 7, 86, 2, 2, 9162, 1500, 3, 2, 2, 2, 9163, 9164,
+	7, 67, 2, 2, 9164, 9165, 7, 84, 2, 2, 9165, 9166, 7, 71, 2, 2, 9166, 9167,
+	7, 67, 2, 2, 9167, 1502, 3, 2, 2, 2, 9168, 9169, 7, 67, 2, 2, 9169, 9170,
+	7, 85, 2, 2, 9170, 9171, 7, 68, 2, 2, 9171, 9172, 7, 75, 2, 2, 9172, 9173,
+	7, 80, 2, 2, 9173, 9174, 7, 67, 2, 2, 9174, 9175, 7, 84, 2, 2, 9175, 9176,
+	7, 91, 2, 2, 9176, 1504, 3, 2, 2, 2, 9177, 9178, 7, 67, 2, 2, 9178, 9179,
+	7, 85, 2, 2, 9179, 9180, 7, 75, 2, 2, 9180, 9181, 7, 80, 2, 2, 9181, 1506,
+	3, 2, 2, 2, 9182, 9183, 7, 67, 2, 2, 9183, 9184, 7, 85, 2, 2, 9184, 9185,
+	7, 86, 2, 2, 9185, 9186, 7, 71, 2, 2, 9186, 9187, 7, 90, 2, 2, 9187, 9188,
+	7, 86, 2, 2, 9188, 1508, 3, 2, 2, 2, 9189, 9190, 7, 67, 2, 2, 9190, 9191,
+	7, 85, 2, 2, 9191, 9192, 7, 89, 2, 2, 9192, 9193, 7, 77, 2, 2, 9193, 9194,
+	7, 68, 2, 2, 9194, 1510, 3, 2, 2, 2, 9195, 9196, 7, 67, 2, 2, 9196, 9197,
+	7, 85, 2, 2, 9197, 9198, 7, 89, 2, 2, 9198, 9199, 7, 77, 2, 2, 9199, 9200,
+	7, 86, 2, 2, 9200, 1512, 3, 2, 2, 2, 9201, 9202, 7, 67, 2, 2, 9202, 9203,
+	7, 85, 2, 2, 9203, 9204, 7, 91, 2, 2, 9204, 9205, 7, 79, 2, 2, 9205, 9206,
+	7, 79, 2, 2, 9206, 9207, 7, 71, 2, 2, 9207, 9208, 7, 86, 2, 2, 9208, 9209,
+	7, 84, 2, 2, 9209, 9210, 7, 75, 2, 2, 9210, 9211, 7, 69, 2, 2, 9211, 9212,
+	7, 97, 2, 2, 9212, 9213, 7, 70, 2, 2, 9213, 9214, 7, 71, 2, 2, 9214, 9215,
+	7, 69, 2, 2, 9215, 9216, 7, 84, 2, 2, 9216, 9217, 7, 91, 2, 2, 9217, 9218,
+	7, 82, 2, 2, 9218, 9219, 7, 86, 2, 2, 9219, 1514, 3, 2, 2, 2, 9220, 9221,
+	7, 67, 2, 2, 9221, 9222, 7, 85, 2, 2, 9222, 9223, 7, 91, 2, 2, 9223, 9224,
+	7, 79, 2, 2, 9224, 9225, 7, 79, 2, 2, 9225, 9226, 7, 71, 2, 2, 9226, 9227,
+	7, 86, 2, 2, 9227, 9228, 7, 84, 2, 2, 9228, 9229, 7, 75, 2, 2, 9229, 9230,
+	7, 69, 2, 2, 9230, 9231, 7, 97, 2, 2, 9231, 9232, 7, 70, 2, 2, 9232, 9233,
+	7, 71, 2, 2, 9233, 9234, 7, 84, 2, 2, 9234, 9235, 7, 75, 2, 2, 9235, 9236,
+	7, 88, 2, 2, 9236, 9237, 7, 71, 2, 2, 9237, 1516, 3, 2, 2, 2, 9238, 9239,
+	7, 67, 2, 2, 9239, 9240, 7, 85, 2, 2, 9240, 9241, 7, 91, 2, 2, 9241, 9242,
+	7, 79, 2, 2, 9242, 9243, 7, 79, 2, 2, 9243, 9244, 7, 71, 2, 2, 9244, 9245,
+	7, 86, 2, 2, 9245, 9246, 7, 84, 2, 2, 9246, 9247, 7, 75, 2, 2, 9247, 9248,
+	7, 69, 2, 2, 9248, 9249, 7, 97, 2, 2, 9249, 9250, 7, 71, 2, 2, 9250, 9251,
+	7, 80, 2, 2, 9251, 9252, 7, 69, 2, 2, 9252, 9253, 7, 84, 2, 2, 9253, 9254,
+	7, 91, 2, 2, 9254, 9255, 7, 82, 2, 2, 9255, 9256, 7, 86, 2, 2, 9256, 1518,
+	3, 2, 2, 2, 9257, 9258, 7, 67, 2, 2, 9258, 9259, 7, 85, 2, 2, 9259, 9260,
+	7, 91, 2, 2, 9260, 9261, 7, 79, 2, 2, 9261, 9262, 7, 79, 2, 2, 9262, 9263,
+	7, 71, 2, 2, 9263, 9264, 7, 86, 2, 2, 9264, 9265, 7, 84, 2, 2, 9265, 9266,
+	7, 75, 2, 2, 9266, 9267, 7, 69, 2, 2, 9267, 9268, 7, 97, 2, 2, 9268, 9269,
+	7, 85, 2, 2, 9269, 9270, 7, 75, 2, 2, 9270, 9271, 7, 73, 2, 2, 9271, 9272,
+	7, 80, 2, 2, 9272, 1520, 3, 2, 2, 2, 9273, 9274, 7, 67, 2, 2, 9274, 9275,
+	7, 85, 2, 2, 9275, 9276, 7, 91, 2, 2, 9276, 9277, 7, 79, 2, 2, 9277, 9278,
+	7, 79, 2, 2, 9278, 9279, 7, 71, 2, 2, 9279, 9280, 7, 86, 2, 2, 9280, 9281,
+	7, 84, 2, 2, 9281, 9282, 7, 75, 2, 2, 9282, 9283, 7, 69, 2, 2, 9283, 9284,
+	7, 97, 2, 2, 9284, 9285, 7, 88, 2, 2, 9285, 9286, 7, 71, 2, 2, 9286, 9287,
+	7, 84, 2, 2, 9287, 9288, 7, 75, 2, 2, 9288, 9289, 7, 72, 2, 2, 9289, 9290,
+	7, 91, 2, 2, 9290, 1522, 3, 2, 2, 2, 9291, 9292, 7, 67, 2, 2, 9292, 9293,
+	7, 86, 2, 2, 9293, 9294, 7, 67, 2, 2, 9294, 9295, 7, 80, 2, 2, 9295, 1524,
+	3, 2, 2, 2, 9296, 9297, 7, 67, 2, 2, 9297, 9298, 7, 86, 2, 2, 9298, 9299,
+	7, 67, 2, 2, 9299, 9300, 7, 80, 2, 2, 9300, 9301, 7, 52, 2, 2, 9301, 1526,
+	3, 2, 2, 2, 9302, 9303, 7, 68, 2, 2, 9303, 9304, 7, 71, 2, 2, 9304, 9305,
+	7, 80, 2, 2, 9305, 9306, 7, 69, 2, 2, 9306, 9307, 7, 74, 2, 2, 9307, 9308,
+	7, 79, 2, 2, 9308, 9309, 7, 67, 2, 2, 9309, 9310, 7, 84, 2, 2, 9310, 9311,
+	7, 77, 2, 2, 9311, 1528, 3, 2, 2, 2, 9312, 9313, 7, 68, 2, 2, 9313, 9314,
+	7, 75, 2, 2, 9314, 9315, 7, 80, 2, 2, 9315, 1530, 3, 2, 2, 2, 9316, 9317,
+	7, 68, 2, 2, 9317, 9318, 7, 75, 2, 2, 9318, 9319, 7, 86, 2, 2, 9319, 9320,
+	7, 97, 2, 2, 9320, 9321, 7, 69, 2, 2, 9321, 9322, 7, 81, 2, 2, 9322, 9323,
+	7, 87, 2, 2, 9323, 9324, 7, 80, 2, 2, 9324, 9325, 7, 86, 2, 2, 9325, 1532,
+	3, 2, 2, 2, 9326, 9327, 7, 68, 2, 2, 9327, 9328, 7, 75, 2, 2, 9328, 9329,
+	7, 86, 2, 2, 9329, 9330, 7, 97, 2, 2, 9330, 9331, 7, 78, 2, 2, 9331, 9332,
+	7, 71, 2, 2, 9332, 9333, 7, 80, 2, 2, 9333, 9334, 7, 73, 2, 2, 9334, 9335,
+	7, 86, 2, 2, 9335, 9336, 7, 74, 2, 2, 9336, 1534, 3, 2, 2, 2, 9337, 9338,
+	7, 68, 2, 2, 9338, 9339, 7, 87, 2, 2, 9339, 9340, 7, 72, 2, 2, 9340, 9341,
+	7, 72, 2, 2, 9341, 9342, 7, 71, 2, 2, 9342, 9343, 7, 84, 2, 2, 9343, 1536,
+	3, 2, 2, 2, 9344, 9345, 7, 69, 2, 2, 9345, 9346, 7, 67, 2, 2, 9346, 9347,
+	7, 86, 2, 2, 9347, 9348, 7, 67, 2, 2, 9348, 9349, 7, 78, 2, 2, 9349, 9350,
+	7, 81, 2, 2, 9350, 9351, 7, 73, 2, 2, 9351, 9352, 7, 97, 2, 2, 9352, 9353,
+	7, 80, 2, 2, 9353, 9354, 7, 67, 2, 2, 9354, 9355, 7, 79, 2, 2, 9355, 9356,
+	7, 71, 2, 2, 9356, 1538, 3, 2, 2, 2, 9357, 9358, 7, 69, 2, 2, 9358, 9359,
+	7, 71, 2, 2, 9359, 9360, 7, 75, 2, 2, 9360, 9361, 7, 78, 2, 2, 9361, 1540,
+	3, 2, 2, 2, 9362, 9363, 7, 69, 2, 2, 9363, 9364, 7, 71, 2, 2, 9364, 9365,
+	7, 75, 2, 2, 9365, 9366, 7, 78, 2, 2, 9366, 9367, 7, 75, 2, 2, 9367, 9368,
+	7, 80, 2, 2, 9368, 9369, 7, 73, 2, 2, 9369, 1542, 3, 2, 2, 2, 9370, 9371,
+	7, 69, 2, 2, 9371, 9372, 7, 71, 2, 2, 9372, 9373, 7, 80, 2, 2, 9373, 9374,
+	7, 86, 2, 2, 9374, 9375, 7, 84, 2, 2, 9375, 9376, 7, 81, 2, 2, 9376, 9377,
+	7, 75, 2, 2, 9377, 9378, 7, 70, 2, 2, 9378, 1544, 3, 2, 2, 2, 9379, 9380,
+	7, 69, 2, 2, 9380, 9381, 7, 74, 2, 2, 9381, 9382, 7, 67, 2, 2, 9382, 9383,
+	7, 84, 2, 2, 9383, 9384, 7, 67, 2, 2, 9384, 9385, 7, 69, 2, 2, 9385, 9386,
+	7, 86, 2, 2, 9386, 9387, 7, 71, 2, 2, 9387, 9388, 7, 84, 2, 2, 9388, 9389,
+	7, 97, 2, 2, 9389, 9390, 7, 78, 2, 2, 9390, 9391, 7, 71, 2, 2, 9391, 9392,
+	7, 80, 2, 2, 9392, 9393, 7, 73, 2, 2, 9393, 9394, 7, 86, 2, 2, 9394, 9395,
+	7, 74, 2, 2, 9395, 1546, 3, 2, 2, 2, 9396, 9397, 7, 69, 2, 2, 9397, 9398,
+	7, 74, 2, 2, 9398, 9399, 7, 67, 2, 2, 9399, 9400, 7, 84, 2, 2, 9400, 9401,
+	7, 85, 2, 2, 9401, 9402, 7, 71, 2, 2, 9402, 9403, 7, 86, 2, 2, 9403, 1548,
+	3, 2, 2, 2, 9404, 9405, 7, 69, 2, 2, 9405, 9406, 7, 74, 2, 2, 9406, 9407,
+	7, 67, 2, 2, 9407, 9408, 7, 84, 2, 2, 9408, 9409, 7, 97, 2, 2, 9409, 9410,
+	7, 78, 2, 2, 9410, 9411, 7, 71, 2, 2, 9411, 9412, 7, 80, 2, 2, 9412, 9413,
+	7, 73, 2, 2, 9413, 9414, 7, 86, 2, 2, 9414, 9415, 7, 74, 2, 2, 9415, 1550,
+	3, 2, 2, 2, 9416, 9417, 7, 69, 2, 2, 9417, 9418, 7, 81, 2, 2, 9418, 9419,
+	7, 71, 2, 2, 9419, 9420, 7, 84, 2, 2, 9420, 9421, 7, 69, 2, 2, 9421, 9422,
+	7, 75, 2, 2, 9422, 9423, 7, 68, 2, 2, 9423, 9424, 7, 75, 2, 2, 9424, 9425,
+	7, 78, 2, 2, 9425, 9426, 7, 75, 2, 2, 9426, 9427, 7, 86, 2, 2, 9427, 9428,
+	7, 91, 2, 2, 9428, 1552, 3, 2, 2, 2, 9429, 9430, 7, 69, 2, 2, 9430, 9431,
+	7, 81, 2, 2, 9431, 9432, 7, 78, 2, 2, 9432, 9433, 7, 78, 2, 2, 9433, 9434,
+	7, 67, 2, 2, 9434, 9435, 7, 86, 2, 2, 9435, 9436, 7, 75, 2, 2, 9436, 9437,
+	7, 81, 2, 2, 9437, 9438, 7, 80, 2, 2, 9438, 1554, 3, 2, 2, 2, 9439, 9440,
+	7, 69, 2, 2, 9440, 9441, 7, 81, 2, 2, 9441, 9442, 7, 79, 2, 2, 9442, 9443,
+	7, 82, 2, 2, 9443, 9444, 7, 84, 2, 2, 9444, 9445, 7, 71, 2, 2, 9445, 9446,
+	7, 85, 2, 2, 9446, 9447, 7, 85, 2, 2, 9447, 1556, 3, 2, 2, 2, 9448, 9449,
+	7, 69, 2, 2, 9449, 9450, 7, 81, 2, 2, 9450, 9451, 7, 80, 2, 2, 9451, 9452,
+	7, 69, 2, 2, 9452, 9453, 7, 67, 2, 2, 9453, 9454, 7, 86, 2, 2, 9454, 1558,
+	3, 2, 2, 2, 9455, 9456, 7, 69, 2, 2, 9456, 9457, 7, 81, 2, 2, 9457, 9458,
+	7, 80, 2, 2, 9458, 9459, 7, 69, 2, 2, 9459, 9460, 7, 67, 2, 2, 9460, 9461,
+	7, 86, 2, 2, 9461, 9462, 7, 97, 2, 2, 9462, 9463, 7, 89, 2, 2, 9463, 9464,
+	7, 85, 2, 2, 9464, 1560, 3, 2, 2, 2, 9465, 9466, 7, 69, 2, 2, 9466, 9467,
+	7, 81, 2, 2, 9467, 9468, 7, 80, 2, 2, 9468, 9469, 7, 80, 2, 2, 9469, 9470,
+	7, 71, 2, 2, 9470, 9471, 7, 69, 2, 2, 9471, 9472, 7, 86, 2, 2, 9472, 9473,
+	7, 75, 2, 2, 9473, 9474, 7, 81, 2, 2, 9474, 9475, 7, 80, 2, 2, 9475, 9476,
+	7, 97, 2, 2, 9476, 9477, 7, 75, 2, 2, 9477, 9478, 7, 70, 2, 2, 9478, 1562,
+	3, 2, 2, 2, 9479, 9480, 7, 69, 2, 2, 9480, 9481, 7, 81, 2, 2, 9481, 9482,
+	7, 80, 2, 2, 9482, 9483, 7, 88, 2, 2, 9483, 1564, 3, 2, 2, 2, 9484, 9485,
+	7, 69, 2, 2, 9485, 9486, 7, 81, 2, 2, 9486, 9487, 7, 80, 2, 2, 9487, 9488,
+	7, 88, 2, 2, 9488, 9489, 7, 71, 2, 2, 9489, 9490, 7, 84, 2, 2, 9490, 9491,
+	7, 86, 2, 2, 9491, 9492, 7, 97, 2, 2, 9492, 9493, 7, 86, 2, 2, 9493, 9494,
+	7, 92, 2, 2, 9494, 1566, 3, 2, 2, 2, 9495, 9496, 7, 69, 2, 2, 9496, 9497,
+	7, 81, 2, 2, 9497, 9498, 7, 85, 2, 2, 9498, 1568, 3, 2, 2, 2, 9499, 9500,
+	7, 69, 2, 2, 9500, 9501, 7, 81, 2, 2, 9501, 9502, 7, 86, 2, 2, 9502, 1570,
+	3, 2, 2, 2, 9503, 9504, 7, 69, 2, 2, 9504, 9505, 7, 84, 2, 2, 9505, 9506,
+	7, 69, 2, 2, 9506, 9507, 7, 53, 2, 2, 9507, 9508, 7, 52, 2, 2, 9508, 1572,
+	3, 2, 2, 2, 9509, 9510, 7, 69, 2, 2, 9510, 9511, 7, 84, 2, 2, 9511, 9512,
+	7, 71, 2, 2, 9512, 9513, 7, 67, 2, 2, 9513, 9514, 7, 86, 2, 2, 9514, 9515,
+	7, 71, 2, 2, 9515, 9516, 7, 97, 2, 2, 9516, 9517, 7, 67, 2, 2, 9517, 9518,
+	7, 85, 2, 2, 9518, 9519, 7, 91, 2, 2, 9519, 9520, 7, 79, 2, 2, 9520, 9521,
+	7, 79, 2, 2, 9521, 9522, 7, 71, 2, 2, 9522, 9523, 7, 86, 2, 2, 9523, 9524,
+	7, 84, 2, 2, 9524, 9525, 7, 75, 2, 2, 9525, 9526, 7, 69, 2, 2, 9526, 9527,
+	7, 97, 2, 2, 9527, 9528, 7, 82, 2, 2, 9528, 9529, 7, 84, 2, 2, 9529, 9530,
+	7, 75, 2, 2, 9530, 9531, 7, 88, 2, 2, 9531, 9532, 7, 97, 2, 2, 9532, 9533,
+	7, 77, 2, 2, 9533, 9534, 7, 71, 2, 2, 9534, 9535, 7, 91, 2, 2, 9535, 1574,
+	3, 2, 2, 2, 9536, 9537, 7, 69, 2, 2, 9537, 9538, 7, 84, 2, 2, 9538, 9539,
+	7, 71, 2, 2, 9539, 9540, 7, 67, 2, 2, 9540, 9541, 7, 86, 2, 2, 9541, 9542,
+	7, 71, 2, 2, 9542, 9543, 7, 97, 2, 2, 9543, 9544, 7, 67, 2, 2, 9544, 9545,
+	7, 85, 2, 2, 9545, 9546, 7, 91, 2, 2, 9546, 9547, 7, 79, 2, 2, 9547, 9548,
+	7, 79, 2, 2, 9548, 9549, 7, 71, 2, 2, 9549, 9550, 7, 86, 2, 2, 9550, 9551,
+	7, 84, 2, 2, 9551, 9552, 7, 75, 2, 2, 9552, 9553, 7, 69, 2, 2, 9553, 9554,
+	7, 97, 2, 2, 9554, 9555, 7, 82, 2, 2, 9555, 9556, 7, 87, 2, 2, 9556, 9557,
+	7, 68, 2, 2, 9557, 9558, 7, 97, 2, 2, 9558, 9559, 7, 77, 2, 2, 9559, 9560,
+	7, 71, 2, 2, 9560, 9561, 7, 91, 2, 2, 9561, 1576, 3, 2, 2, 2, 9562, 9563,
+	7, 69, 2, 2, 9563, 9564, 7, 84, 2, 2, 9564, 9565, 7, 71, 2, 2, 9565, 9566,
+	7, 67, 2, 2, 9566, 9567, 7, 86, 2, 2, 9567, 9568, 7, 71, 2, 2, 9568, 9569,
+	7, 97, 2, 2, 9569, 9570, 7, 70, 2, 2, 9570, 9571, 7, 74, 2, 2, 9571, 9572,
+	7, 97, 2, 2, 9572, 9573, 7, 82, 2, 2, 9573, 9574, 7, 67, 2, 2, 9574, 9575,
+	7, 84, 2, 2, 9575, 9576, 7, 67, 2, 2, 9576, 9577, 7, 79, 2, 2, 9577, 9578,
+	7, 71, 2, 2, 9578, 9579, 7, 86, 2, 2, 9579, 9580, 7, 71, 2, 2, 9580, 9581,
+	7, 84, 2, 2, 9581, 9582, 7, 85, 2, 2, 9582, 1578, 3, 2, 2, 2, 9583, 9584,
+	7, 69, 2, 2, 9584, 9585, 7, 84, 2, 2, 9585, 9586, 7, 71, 2, 2, 9586, 9587,
+	7, 67, 2, 2, 9587, 9588, 7, 86, 2, 2, 9588, 9589, 7, 71, 2, 2, 9589, 9590,
+	7, 97, 2, 2, 9590, 9591, 7, 70, 2, 2, 9591, 9592, 7, 75, 2, 2, 9592, 9593,
+	7, 73, 2, 2, 9593, 9594, 7, 71, 2, 2, 9594, 9595, 7, 85, 2, 2, 9595, 9596,
+	7, 86, 2, 2, 9596, 1580, 3, 2, 2, 2, 9597, 9598, 7, 69, 2, 2, 9598, 9599,
+	7, 84, 2, 2, 9599, 9600, 7, 81, 2, 2, 9600, 9601, 7, 85, 2, 2, 9601, 9602,
+	7, 85, 2, 2, 9602, 9603, 7, 71, 2, 2, 9603, 9604, 7, 85, 2, 2, 9604, 1582,
+	3, 2, 2, 2, 9605, 9606, 7, 70, 2, 2, 9606, 9607, 7, 67, 2, 2, 9607, 9608,
+	7, 86, 2, 2, 9608, 9609, 7, 71, 2, 2, 9609, 9610, 7, 70, 2, 2, 9610, 9611,
+	7, 75, 2, 2, 9611, 9612, 7, 72, 2, 2, 9612, 9613, 7, 72, 2, 2, 9613, 1584,
+	3, 2, 2, 2, 9614, 9615, 7, 70, 2, 2, 9615, 9616, 7, 67, 2, 2, 9616, 9617,
+	7, 86, 2, 2, 9617, 9618, 7, 71, 2, 2, 9618, 9619, 7, 97, 2, 2, 9619, 9620,
+	7, 72, 2, 2, 9620, 9621, 7, 81, 2, 2, 9621, 9622, 7, 84, 2, 2, 9622, 9623,
+	7, 79, 2, 2, 9623, 9624, 7, 67, 2, 2, 9624, 9625, 7, 86, 2, 2, 9625, 1586,
+	3, 2, 2, 2, 9626, 9627, 7, 70, 2, 2, 9627, 9628, 7, 67, 2, 2, 9628, 9629,
+	7, 91, 2, 2, 9629, 9630, 7, 80, 2, 2, 9630, 9631, 7, 67, 2, 2, 9631, 9632,
+	7, 79, 2, 2, 9632, 9633, 7, 71, 2, 2, 9633, 1588, 3, 2, 2, 2, 9634, 9635,
+	7, 70, 2, 2, 9635, 9636, 7, 67, 2, 2, 9636, 9637, 7, 91, 2, 2, 9637, 9638,
+	7, 81, 2, 2, 9638, 9639, 7, 72, 2, 2, 9639, 9640, 7, 79, 2, 2, 9640, 9641,
+	7, 81, 2, 2, 9641, 9642, 7, 80, 2, 2, 9642, 9643, 7, 86, 2, 2, 9643, 9644,
+	7, 74, 2, 2, 9644, 1590, 3, 2, 2, 2, 9645, 9646, 7, 70, 2, 2, 9646, 9647,
+	7, 67, 2, 2, 9647, 9648, 7, 91, 2, 2, 9648, 9649, 7, 81, 2, 2, 9649, 9650,
+	7, 72, 2, 2, 9650, 9651, 7, 89, 2, 2, 9651, 9652, 7, 71, 2, 2, 9652, 9653,
+	7, 71, 2, 2, 9653, 9654, 7, 77, 2, 2, 9654, 1592, 3, 2, 2, 2, 9655, 9656,
+	7, 70, 2, 2, 9656, 9657, 7, 67, 2, 2, 9657, 9658, 7, 91, 2, 2, 9658, 9659,
+	7, 81, 2, 2, 9659, 9660, 7, 72, 2, 2, 9660, 9661, 7, 91, 2, 2, 9661, 9662,
+	7, 71, 2, 2, 9662, 9663, 7, 67, 2, 2, 9663, 9664, 7, 84, 2, 2, 9664, 1594,
+	3, 2, 2, 2, 9665, 9666, 7, 70, 2, 2, 9666, 9667, 7, 71, 2, 2, 9667, 9668,
+	7, 69, 2, 2, 9668, 9669, 7, 81, 2, 2, 9669, 9670, 7, 70, 2, 2, 9670, 9671,
+	7, 71, 2, 2, 9671, 1596, 3, 2, 2, 2, 9672, 9673, 7, 70, 2, 2, 9673, 9674,
+	7, 71, 2, 2, 9674, 9675, 7, 73, 2, 2, 9675, 9676, 7, 84, 2, 2, 9676, 9677,
+	7, 71, 2, 2, 9677, 9678, 7, 71, 2, 2, 9678, 9679, 7, 85, 2, 2, 9679, 1598,
+	3, 2, 2, 2, 9680, 9681, 7, 70, 2, 2, 9681, 9682, 7, 71, 2, 2, 9682, 9683,
+	7, 85, 2, 2, 9683, 9684, 7, 97, 2, 2, 9684, 9685, 7, 70, 2, 2, 9685, 9686,
+	7, 71, 2, 2, 9686, 9687, 7, 69, 2, 2, 9687, 9688, 7, 84, 2, 2, 9688, 9689,
+	7, 91, 2, 2, 9689, 9690, 7, 82, 2, 2, 9690, 9691, 7, 86, 2, 2, 9691, 1600,
+	3, 2, 2, 2, 9692, 9693, 7, 70, 2, 2, 9693, 9694, 7, 71, 2, 2, 9694, 9695,
+	7, 85, 2, 2, 9695, 9696, 7, 97, 2, 2, 9696, 9697, 7, 71, 2, 2, 9697, 9698,
+	7, 80, 2, 2, 9698, 9699, 7, 69, 2, 2, 9699, 9700, 7, 84, 2, 2, 9700, 9701,
+	7, 91, 2, 2, 9701, 9702, 7, 82, 2, 2, 9702, 9703, 7, 86, 2, 2, 9703, 1602,
+	3, 2, 2, 2, 9704, 9705, 7, 70, 2, 2, 9705, 9706, 7, 75, 2, 2, 9706, 9707,
+	7, 79, 2, 2, 9707, 9708, 7, 71, 2, 2, 9708, 9709, 7, 80, 2, 2, 9709, 9710,
+	7, 85, 2, 2, 9710, 9711, 7, 75, 2, 2, 9711, 9712, 7, 81, 2, 2, 9712, 9713,
+	7, 80, 2, 2, 9713, 1604, 3, 2, 2, 2, 9714, 9715, 7, 70, 2, 2, 9715, 9716,
+	7, 75, 2, 2, 9716, 9717, 7, 85, 2, 2, 9717, 9718, 7, 76, 2, 2, 9718, 9719,
+	7, 81, 2, 2, 9719, 9720, 7, 75, 2, 2, 9720, 9721, 7, 80, 2, 2, 9721, 9722,
+	7, 86, 2, 2, 9722, 1606, 3, 2, 2, 2, 9723, 9724, 7, 71, 2, 2, 9724, 9725,
+	7, 78, 2, 2, 9725, 9726, 7, 86, 2, 2, 9726, 1608, 3, 2, 2, 2, 9727, 9728,
+	7, 71, 2, 2, 9728, 9729, 7, 80, 2, 2, 9729, 9730, 7, 69, 2, 2, 9730, 9731,
+	7, 81, 2, 2, 9731, 9732, 7, 70, 2, 2, 9732, 9733, 7, 71, 2, 2, 9733, 1610,
+	3, 2, 2, 2, 9734, 9735, 7, 71, 2, 2, 9735, 9736, 7, 80, 2, 2, 9736, 9737,
+	7, 69, 2, 2, 9737, 9738, 7, 84, 2, 2, 9738, 9739, 7, 91, 2, 2, 9739, 9740,
+	7, 82, 2, 2, 9740, 9741, 7, 86, 2, 2, 9741, 1612, 3, 2, 2, 2, 9742, 9743,
+	7, 71, 2, 2, 9743, 9744, 7, 80, 2, 2, 9744, 9745, 7, 70, 2, 2, 9745, 9746,
+	7, 82, 2, 2, 9746, 9747, 7, 81, 2, 2, 9747, 9748, 7, 75, 2, 2, 9748, 9749,
+	7, 80, 2, 2, 9749, 9750, 7, 86, 2, 2, 9750, 1614, 3, 2, 2, 2, 9751, 9752,
+	7, 71, 2, 2, 9752, 9753, 7, 80, 2, 2, 9753, 9754, 7, 88, 2, 2, 9754, 9755,
+	7, 71, 2, 2, 9755, 9756, 7, 78, 2, 2, 9756, 9757, 7, 81, 2, 2, 9757, 9758,
+	7, 82, 2, 2, 9758, 9759, 7, 71, 2, 2, 9759, 1616, 3, 2, 2, 2, 9760, 9761,
+	7, 71, 2, 2, 9761, 9762, 7, 83, 2, 2, 9762, 9763, 7, 87, 2, 2, 9763, 9764,
+	7, 67, 2, 2, 9764, 9765, 7, 78, 2, 2, 9765, 9766, 7, 85, 2, 2, 9766, 1618,
+	3, 2, 2, 2, 9767, 9768, 7, 71, 2, 2, 9768, 9769, 7, 90, 2, 2, 9769, 9770,
+	7, 82, 2, 2, 9770, 1620, 3, 2, 2, 2, 9771, 9772, 7, 71, 2, 2, 9772, 9773,
+	7, 90, 2, 2, 9773, 9774, 7, 82, 2, 2, 9774, 9775, 7, 81, 2, 2, 9775, 9776,
+	7, 84, 2, 2, 9776, 9777, 7, 86, 2, 2, 9777, 9778, 7, 97, 2, 2, 9778, 9779,
+	7, 85, 2, 2, 9779, 9780, 7, 71, 2, 2, 9780, 9781, 7, 86, 2, 2, 9781, 1622,
+	3, 2, 2, 2, 9782, 9783, 7, 71, 2, 2, 9783, 9784, 7, 90, 2, 2, 9784, 9785,
+	7, 86, 2, 2, 9785, 9786, 7, 71, 2, 2, 9786, 9787, 7, 84, 2, 2, 9787, 9788,
+	7, 75, 2, 2, 9788, 9789, 7, 81, 2, 2, 9789, 9790, 7, 84, 2, 2, 9790, 9791,
+	7, 84, 2, 2, 9791, 9792, 7, 75, 2, 2, 9792, 9793, 7, 80, 2, 2, 9793, 9794,
+	7, 73, 2, 2, 9794, 1624, 3, 2, 2, 2, 9795, 9796, 7, 71, 2, 2, 9796, 9797,
+	7, 90, 2, 2, 9797, 9798, 7, 86, 2, 2, 9798, 9799, 7, 84, 2, 2, 9799, 9800,
+	7, 67, 2, 2, 9800, 9801, 7, 69, 2, 2, 9801, 9802, 7, 86, 2, 2, 9802, 9803,
+	7, 88, 2, 2, 9803, 9804, 7, 67, 2, 2, 9804, 9805, 7, 78, 2, 2, 9805, 9806,
+	7, 87, 2, 2, 9806, 9807, 7, 71, 2, 2, 9807, 1626, 3, 2, 2, 2, 9808, 9809,
+	7, 72, 2, 2, 9809, 9810, 7, 75, 2, 2, 9810, 9811, 7, 71, 2, 2, 9811, 9812,
+	7, 78, 2, 2, 9812, 9813, 7, 70, 2, 2, 9813, 1628, 3, 2, 2, 2, 9814, 9815,
+	7, 72, 2, 2, 9815, 9816, 7, 75, 2, 2, 9816, 9817, 7, 80, 2, 2, 9817, 9818,
+	7, 70, 2, 2, 9818, 9819, 7, 97, 2, 2, 9819, 9820, 7, 75, 2, 2, 9820, 9821,
+	7, 80, 2, 2, 9821, 9822, 7, 97, 2, 2, 9822, 9823, 7, 85, 2, 2, 9823, 9824,
+	7, 71, 2, 2, 9824, 9825, 7, 86, 2, 2, 9825, 1630, 3, 2, 2, 2, 9826, 9827,
+	7, 72, 2, 2, 9827, 9828, 7, 78, 2, 2, 9828, 9829, 7, 81, 2, 2, 9829, 9830,
+	7, 81, 2, 2, 9830, 9831, 7, 84, 2, 2, 9831, 1632, 3, 2, 2, 2, 9832, 9833,
+	7, 72, 2, 2, 9833, 9834, 7, 81, 2, 2, 9834, 9835, 7, 84, 2, 2, 9835, 9836,
+	7, 79, 2, 2, 9836, 9837, 7, 67, 2, 2, 9837, 9838, 7, 86, 2, 2, 9838, 1634,
+	3, 2, 2, 2, 9839, 9840, 7, 72, 2, 2, 9840, 9841, 7, 81, 2, 2, 9841, 9842,
+	7, 87, 2, 2, 9842, 9843, 7, 80, 2, 2, 9843, 9844, 7, 70, 2, 2, 9844, 9845,
+	7, 97, 2, 2, 9845, 9846, 7, 84, 2, 2, 9846, 9847, 7, 81, 2, 2, 9847, 9848,
+	7, 89, 2, 2, 9848, 9849, 7, 85, 2, 2, 9849, 1636, 3, 2, 2, 2, 9850, 9851,
+	7, 72, 2, 2, 9851, 9852, 7, 84, 2, 2, 9852, 9853, 7, 81, 2, 2, 9853, 9854,
+	7, 79, 2, 2, 9854, 9855, 7, 97, 2, 2, 9855, 9856, 7, 68, 2, 2, 9856, 9857,
+	7, 67, 2, 2, 9857, 9858, 7, 85, 2, 2, 9858, 9859, 7, 71, 2, 2, 9859, 9860,
+	7, 56, 2, 2, 9860, 9861, 7, 54, 2, 2, 9861, 1638, 3, 2, 2, 2, 9862, 9863,
+	7, 72, 2, 2, 9863, 9864, 7, 84, 2, 2, 9864, 9865, 7, 81, 2, 2, 9865, 9866,
+	7, 79, 2, 2, 9866, 9867, 7, 97, 2, 2, 9867, 9868, 7, 70, 2, 2, 9868, 9869,
+	7, 67, 2, 2, 9869, 9870, 7, 91, 2, 2, 9870, 9871, 7, 85, 2, 2, 9871, 1640,
+	3, 2, 2, 2, 9872, 9873, 7, 72, 2, 2, 9873, 9874, 7, 84, 2, 2, 9874, 9875,
+	7, 81, 2, 2, 9875, 9876, 7, 79, 2, 2, 9876, 9877, 7, 97, 2, 2, 9877, 9878,
+	7, 87, 2, 2, 9878, 9879, 7, 80, 2, 2, 9879, 9880, 7, 75, 2, 2, 9880, 9881,
+	7, 90, 2, 2, 9881, 9882, 7, 86, 2, 2, 9882, 9883, 7, 75, 2, 2, 9883, 9884,
+	7, 79, 2, 2, 9884, 9885, 7, 71, 2, 2, 9885, 1642, 3, 2, 2, 2, 9886, 9887,
+	7, 73, 2, 2, 9887, 9888, 7, 71, 2, 2, 9888, 9889, 7, 81, 2, 2, 9889, 9890,
+	7, 79, 2, 2, 9890, 9891, 7, 69, 2, 2, 9891, 9892, 7, 81, 2, 2, 9892, 9893,
+	7, 78, 2, 2, 9893, 9894, 7, 78, 2, 2, 9894, 9895, 7, 72, 2, 2, 9895, 9896,
+	7, 84, 2, 2, 9896, 9897, 7, 81, 2, 2, 9897, 9898, 7, 79, 2, 2, 9898, 9899,
+	7, 86, 2, 2, 9899, 9900, 7, 71, 2, 2, 9900, 9901, 7, 90, 2, 2, 9901, 9902,
+	7, 86, 2, 2, 9902, 1644, 3, 2, 2, 2, 9903, 9904, 7, 73, 2, 2, 9904, 9905,
+	7, 71, 2, 2, 9905, 9906, 7, 81, 2, 2, 9906, 9907, 7, 79, 2, 2, 9907, 9908,
+	7, 69, 2, 2, 9908, 9909, 7, 81, 2, 2, 9909, 9910, 7, 78, 2, 2, 9910, 9911,
+	7, 78, 2, 2, 9911, 9912, 7, 72, 2, 2, 9912, 9913, 7, 84, 2, 2, 9913, 9914,
+	7, 81, 2, 2, 9914, 9915, 7, 79, 2, 2, 9915, 9916, 7, 89, 2, 2, 9916, 9917,
+	7, 77, 2, 2, 9917, 9918, 7, 68, 2, 2, 9918, 1646, 3, 2, 2, 2, 9919, 9920,
+	7, 73, 2, 2, 9920, 9921, 7, 71, 2, 2, 9921, 9922, 7, 81, 2, 2, 9922, 9923,
+	7, 79, 2, 2, 9923, 9924, 7, 71, 2, 2, 9924, 9925, 7, 86, 2, 2, 9925, 9926,
+	7, 84, 2, 2, 9926, 9927, 7, 91, 2, 2, 9927, 9928, 7, 69, 2, 2, 9928, 9929,
+	7, 81, 2, 2, 9929, 9930, 7, 78, 2, 2, 9930, 9931, 7, 78, 2, 2, 9931, 9932,
+	7, 71, 2, 2, 9932, 9933, 7, 69, 2, 2, 9933, 9934, 7, 86, 2, 2, 9934, 9935,
+	7, 75, 2, 2, 9935, 9936, 7, 81, 2, 2, 9936, 9937, 7, 80, 2, 2, 9937, 9938,
+	7, 72, 2, 2, 9938, 9939, 7, 84, 2, 2, 9939, 9940, 7, 81, 2, 2, 9940, 9941,
+	7, 79, 2, 2, 9941, 9942, 7, 86, 2, 2, 9942, 9943, 7, 71, 2, 2, 9943, 9944,
+	7, 90, 2, 2, 9944, 9945, 7, 86, 2, 2, 9945, 1648, 3, 2, 2, 2, 9946, 9947,
+	7, 73, 2, 2, 9947, 9948, 7, 71, 2, 2, 9948, 9949, 7, 81, 2, 2, 9949, 9950,
+	7, 79, 2, 2, 9950, 9951, 7, 71, 2, 2, 9951, 9952, 7, 86, 2, 2, 9952, 9953,
+	7, 84, 2, 2, 9953, 9954, 7, 91, 2, 2, 9954, 9955, 7, 69, 2, 2, 9955, 9956,
+	7, 81, 2, 2, 9956, 9957, 7, 78, 2, 2, 9957, 9958, 7, 78, 2, 2, 9958, 9959,
+	7, 71, 2, 2, 9959, 9960, 7, 69, 2, 2, 9960, 9961, 7, 86, 2, 2, 9961, 9962,
+	7, 75, 2, 2, 9962, 9963, 7, 81, 2, 2, 9963, 9964, 7, 80, 2, 2, 9964, 9965,
+	7, 72, 2, 2, 9965, 9966, 7, 84, 2, 2, 9966, 9967, 7, 81, 2, 2, 9967, 9968,
+	7, 79, 2, 2, 9968, 9969, 7, 89, 2, 2, 9969, 9970, 7, 77, 2, 2, 9970, 9971,
+	7, 68, 2, 2, 9971, 1650, 3, 2, 2, 2, 9972, 9973, 7, 73, 2, 2, 9973, 9974,
+	7, 71, 2, 2, 9974, 9975, 7, 81, 2, 2, 9975, 9976, 7, 79, 2, 2, 9976, 9977,
+	7, 71, 2, 2, 9977, 9978, 7, 86, 2, 2, 9978, 9979, 7, 84, 2, 2, 9979, 9980,
+	7, 91, 2, 2, 9980, 9981, 7, 72, 2, 2, 9981, 9982, 7, 84, 2, 2, 9982, 9983,
+	7, 81, 2, 2, 9983, 9984, 7, 79, 2, 2, 9984, 9985, 7, 86, 2, 2, 9985, 9986,
+	7, 71, 2, 2, 9986, 9987, 7, 90, 2, 2, 9987, 9988, 7, 86, 2, 2, 9988, 1652,
+	3, 2, 2, 2, 9989, 9990, 7, 73, 2, 2, 9990, 9991, 7, 71, 2, 2, 9991, 9992,
+	7, 81, 2, 2, 9992, 9993, 7, 79, 2, 2, 9993, 9994, 7, 71, 2, 2, 9994, 9995,
+	7, 86, 2, 2, 9995, 9996, 7, 84, 2, 2, 9996, 9997, 7, 91, 2, 2, 9997, 9998,
+	7, 72, 2, 2, 9998, 9999, 7, 84, 2, 2, 9999, 10000, 7, 81, 2, 2, 10000,
+	10001, 7, 79, 2, 2, 10001, 10002, 7, 89, 2, 2, 10002, 10003, 7, 77, 2,
+	2, 10003, 10004, 7, 68, 2, 2, 10004, 1654, 3, 2, 2, 2, 10005, 10006, 7,
+	73, 2, 2, 10006, 10007, 7, 71, 2, 2, 10007, 10008, 7, 81, 2, 2, 10008,
+	10009, 7, 79, 2, 2, 10009, 10010, 7, 71, 2, 2, 10010, 10011, 7, 86, 2,
+	2, 10011, 10012, 7, 84, 2, 2, 10012, 10013, 7, 91, 2, 2, 10013, 10014,
+	7, 80, 2, 2, 10014, 1656, 3, 2, 2, 2, 10015, 10016, 7, 73, 2, 2, 10016,
+	10017, 7, 71, 2, 2, 10017, 10018, 7, 81, 2, 2, 10018, 10019, 7, 79, 2,
+	2, 10019, 10020, 7, 71, 2, 2, 10020, 10021, 7, 86, 2, 2, 10021, 10022,
+	7, 84, 2, 2, 10022, 10023, 7, 91, 2, 2, 10023, 10024, 7, 86, 2, 2, 10024,
+	10025, 7, 91, 2, 2, 10025, 10026, 7, 82, 2, 2, 10026, 10027, 7, 71, 2,
+	2, 10027, 1658, 3, 2, 2, 2, 10028, 10029, 7, 73, 2, 2, 10029, 10030, 7,
+	71, 2, 2, 10030, 10031, 7, 81, 2, 2, 10031, 10032, 7, 79, 2, 2, 10032,
+	10033, 7, 72, 2, 2, 10033, 10034, 7, 84, 2, 2, 10034, 10035, 7, 81, 2,
+	2, 10035, 10036, 7, 79, 2, 2, 10036, 10037, 7, 86, 2, 2, 10037, 10038,
+	7, 71, 2, 2, 10038, 10039, 7, 90, 2, 2, 10039, 10040, 7, 86, 2, 2, 10040,
+	1660, 3, 2, 2, 2, 10041, 10042, 7, 73, 2, 2, 10042, 10043, 7, 71, 2, 2,
+	10043, 10044, 7, 81, 2, 2, 10044, 10045, 7, 79, 2, 2, 10045, 10046, 7,
+	72, 2, 2, 10046, 10047, 7, 84, 2, 2, 10047, 10048, 7, 81, 2, 2, 10048,
+	10049, 7, 79, 2, 2, 10049, 10050, 7, 89, 2, 2, 10050, 10051, 7, 77, 2,
+	2, 10051, 10052, 7, 68, 2, 2, 10052, 1662, 3, 2, 2, 2, 10053, 10054, 7,
+	73, 2, 2, 10054, 10055, 7, 71, 2, 2, 10055, 10056, 7, 86, 2, 2, 10056,
+	10057, 7, 97, 2, 2, 10057, 10058, 7, 72, 2, 2, 10058, 10059, 7, 81, 2,
+	2, 10059, 10060, 7, 84, 2, 2, 10060, 10061, 7, 79, 2, 2, 10061, 10062,
+	7, 67, 2, 2, 10062, 10063, 7, 86, 2, 2, 10063, 1664, 3, 2, 2, 2, 10064,
+	10065, 7, 73, 2, 2, 10065, 10066, 7, 71, 2, 2, 10066, 10067, 7, 86, 2,
+	2, 10067, 10068, 7, 97, 2, 2, 10068, 10069, 7, 78, 2, 2, 10069, 10070,
+	7, 81, 2, 2, 10070, 10071, 7, 69, 2, 2, 10071, 10072, 7, 77, 2, 2, 10072,
+	1666, 3, 2, 2, 2, 10073, 10074, 7, 73, 2, 2, 10074, 10075, 7, 78, 2, 2,
+	10075, 10076, 7, 71, 2, 2, 10076, 10077, 7, 80, 2, 2, 10077, 10078, 7,
+	73, 2, 2, 10078, 10079, 7, 86, 2, 2, 10079, 10080, 7, 74, 2, 2, 10080,
+	1668, 3, 2, 2, 2, 10081, 10082, 7, 73, 2, 2, 10082, 10083, 7, 84, 2, 2,
+	10083, 10084, 7, 71, 2, 2, 10084, 10085, 7, 67, 2, 2, 10085, 10086, 7,
+	86, 2, 2, 10086, 10087, 7, 71, 2, 2, 10087, 10088, 7, 85, 2, 2, 10088,
+	10089, 7, 86, 2, 2, 10089, 1670, 3, 2, 2, 2, 10090, 10091, 7, 73, 2, 2,
+	10091, 10092, 7, 86, 2, 2, 10092, 10093, 7, 75, 2, 2, 10093, 10094, 7,
+	70, 2, 2, 10094, 10095, 7, 97, 2, 2, 10095, 10096, 7, 85, 2, 2, 10096,
+	10097, 7, 87, 2, 2, 10097, 10098, 7, 68, 2, 2, 10098, 10099, 7, 85, 2,
+	2, 10099, 10100, 7, 71, 2, 2, 10100, 10101, 7, 86, 2, 2, 10101, 1672, 3,
+	2, 2, 2, 10102, 10103, 7, 73, 2, 2, 10103, 10104, 7, 86, 2, 2, 10104, 10105,
+	7, 75, 2, 2, 10105, 10106, 7, 70, 2, 2, 10106, 10107, 7, 97, 2, 2, 10107,
+	10108, 7, 85, 2, 2, 10108, 10109, 7, 87, 2, 2, 10109, 10110, 7, 68, 2,
+	2, 10110, 10111, 7, 86, 2, 2, 10111, 10112, 7, 84, 2, 2, 10112, 10113,
+	7, 67, 2, 2, 10113, 10114, 7, 69, 2, 2, 10114, 10115, 7, 86, 2, 2, 10115,
+	1674, 3, 2, 2, 2, 10116, 10117, 7, 74, 2, 2, 10117, 10118, 7, 71, 2, 2,
+	10118, 10119, 7, 90, 2, 2, 10119, 1676, 3, 2, 2, 2, 10120, 10121, 7, 75,
+	2, 2, 10121, 10122, 7, 72, 2, 2, 10122, 10123, 7, 80, 2, 2, 10123, 10124,
+	7, 87, 2, 2, 10124, 10125, 7, 78, 2, 2, 10125, 10126, 7, 78, 2, 2, 10126,
+	1678, 3, 2, 2, 2, 10127, 10128, 7, 75, 2, 2, 10128, 10129, 7, 80, 2, 2,
+	10129, 10130, 7, 71, 2, 2, 10130, 10131, 7, 86, 2, 2, 10131, 10132, 7,
+	56, 2, 2, 10132, 10133, 7, 97, 2, 2, 10133, 10134, 7, 67, 2, 2, 10134,
+	10135, 7, 86, 2, 2, 10135, 10136, 7, 81, 2, 2, 10136, 10137, 7, 80, 2,
+	2, 10137, 1680, 3, 2, 2, 2, 10138, 10139, 7, 75, 2, 2, 10139, 10140, 7,
+	80, 2, 2, 10140, 10141, 7, 71, 2, 2, 10141, 10142, 7, 86, 2, 2, 10142,
+	10143, 7, 56, 2, 2, 10143, 10144, 7, 97, 2, 2, 10144, 10145, 7, 80, 2,
+	2, 10145, 10146, 7, 86, 2, 2, 10146, 10147, 7, 81, 2, 2, 10147, 10148,
+	7, 67, 2, 2, 10148, 1682, 3, 2, 2, 2, 10149, 10150, 7, 75, 2, 2, 10150,
+	10151, 7, 80, 2, 2, 10151, 10152, 7, 71, 2, 2, 10152, 10153, 7, 86, 2,
+	2, 10153, 10154, 7, 97, 2, 2, 10154, 10155, 7, 67, 2, 2, 10155, 10156,
+	7, 86, 2, 2, 10156, 10157, 7, 81, 2, 2, 10157, 10158, 7, 80, 2, 2, 10158,
+	1684, 3, 2, 2, 2, 10159, 10160, 7, 75, 2, 2, 10160, 10161, 7, 80, 2, 2,
+	10161, 10162, 7, 71, 2, 2, 10162, 10163, 7, 86, 2, 2, 10163, 10164, 7,
+	97, 2, 2, 10164, 10165, 7, 80, 2, 2, 10165, 10166, 7, 86, 2, 2, 10166,
+	10167, 7, 81, 2, 2, 10167, 10168, 7, 67, 2, 2, 10168, 1686, 3, 2, 2, 2,
+	10169, 10170, 7, 75, 2, 2, 10170, 10171, 7, 80, 2, 2, 10171, 10172, 7,
+	85, 2, 2, 10172, 10173, 7, 86, 2, 2, 10173, 10174, 7, 84, 2, 2, 10174,
+	1688, 3, 2, 2, 2, 10175, 10176, 7, 75, 2, 2, 10176, 10177, 7, 80, 2, 2,
+	10177, 10178, 7, 86, 2, 2, 10178, 10179, 7, 71, 2, 2, 10179, 10180, 7,
+	84, 2, 2, 10180, 10181, 7, 75, 2, 2, 10181, 10182, 7, 81, 2, 2, 10182,
+	10183, 7, 84, 2, 2, 10183, 10184, 7, 84, 2, 2, 10184, 10185, 7, 75, 2,
+	2, 10185, 10186, 7, 80, 2, 2, 10186, 10187, 7, 73, 2, 2, 10187, 10188,
+	7, 80, 2, 2, 10188, 1690, 3, 2, 2, 2, 10189, 10190, 7, 75, 2, 2, 10190,
+	10191, 7, 80, 2, 2, 10191, 10192, 7, 86, 2, 2, 10192, 10193, 7, 71, 2,
+	2, 10193, 10194, 7, 84, 2, 2, 10194, 10195, 7, 85, 2, 2, 10195, 10196,
+	7, 71, 2, 2, 10196, 10197, 7, 69, 2, 2, 10197, 10198, 7, 86, 2, 2, 10198,
+	10199, 7, 85, 2, 2, 10199, 1692, 3, 2, 2, 2, 10200, 10201, 7, 75, 2, 2,
+	10201, 10202, 7, 85, 2, 2, 10202, 10203, 7, 69, 2, 2, 10203, 10204, 7,
+	78, 2, 2, 10204, 10205, 7, 81, 2, 2, 10205, 10206, 7, 85, 2, 2, 10206,
+	10207, 7, 71, 2, 2, 10207, 10208, 7, 70, 2, 2, 10208, 1694, 3, 2, 2, 2,
+	10209, 10210, 7, 75, 2, 2, 10210, 10211, 7, 85, 2, 2, 10211, 10212, 7,
+	71, 2, 2, 10212, 10213, 7, 79, 2, 2, 10213, 10214, 7, 82, 2, 2, 10214,
+	10215, 7, 86, 2, 2, 10215, 10216, 7, 91, 2, 2, 10216, 1696, 3, 2, 2, 2,
+	10217, 10218, 7, 75, 2, 2, 10218, 10219, 7, 85, 2, 2, 10219, 10220, 7,
+	80, 2, 2, 10220, 10221, 7, 87, 2, 2, 10221, 10222, 7, 78, 2, 2, 10222,
+	10223, 7, 78, 2, 2, 10223, 1698, 3, 2, 2, 2, 10224, 10225, 7, 75, 2, 2,
+	10225, 10226, 7, 85, 2, 2, 10226, 10227, 7, 85, 2, 2, 10227, 10228, 7,
+	75, 2, 2, 10228, 10229, 7, 79, 2, 2, 10229, 10230, 7, 82, 2, 2, 10230,
+	10231, 7, 78, 2, 2, 10231, 10232, 7, 71, 2, 2, 10232, 1700, 3, 2, 2, 2,
+	10233, 10234, 7, 75, 2, 2, 10234, 10235, 7, 85, 2, 2, 10235, 10236, 7,
+	97, 2, 2, 10236, 10237, 7, 72, 2, 2, 10237, 10238, 7, 84, 2, 2, 10238,
+	10239, 7, 71, 2, 2, 10239, 10240, 7, 71, 2, 2, 10240, 10241, 7, 97, 2,
+	2, 10241, 10242, 7, 78, 2, 2, 10242, 10243, 7, 81, 2, 2, 10243, 10244,
+	7, 69, 2, 2, 10244, 10245, 7, 77, 2, 2, 10245, 1702, 3, 2, 2, 2, 10246,
+	10247, 7, 75, 2, 2, 10247, 10248, 7, 85, 2, 2, 10248, 10249, 7, 97, 2,
+	2, 10249, 10250, 7, 75, 2, 2, 10250, 10251, 7, 82, 2, 2, 10251, 10252,
+	7, 88, 2, 2, 10252, 10253, 7, 54, 2, 2, 10253, 1704, 3, 2, 2, 2, 10254,
+	10255, 7, 75, 2, 2, 10255, 10256, 7, 85, 2, 2, 10256, 10257, 7, 97, 2,
+	2, 10257, 10258, 7, 75, 2, 2, 10258, 10259, 7, 82, 2, 2, 10259, 10260,
+	7, 88, 2, 2, 10260, 10261, 7, 54, 2, 2, 10261, 10262, 7, 97, 2, 2, 10262,
+	10263, 7, 69, 2, 2, 10263, 10264, 7, 81, 2, 2, 10264, 10265, 7, 79, 2,
+	2, 10265, 10266, 7, 82, 2, 2, 10266, 10267, 7, 67, 2, 2, 10267, 10268,
+	7, 86, 2, 2, 10268, 1706, 3, 2, 2, 2, 10269, 10270, 7, 75, 2, 2, 10270,
+	10271, 7, 85, 2, 2, 10271, 10272, 7, 97, 2, 2, 10272, 10273, 7, 75, 2,
+	2, 10273, 10274, 7, 82, 2, 2, 10274, 10275, 7, 88, 2, 2, 10275, 10276,
+	7, 54, 2, 2, 10276, 10277, 7, 97, 2, 2, 10277, 10278, 7, 79, 2, 2, 10278,
+	10279, 7, 67, 2, 2, 10279, 10280, 7, 82, 2, 2, 10280, 10281, 7, 82, 2,
+	2, 10281, 10282, 7, 71, 2, 2, 10282, 10283, 7, 70, 2, 2, 10283, 1708, 3,
+	2, 2, 2, 10284, 10285, 7, 75, 2, 2, 10285, 10286, 7, 85, 2, 2, 10286, 10287,
+	7, 97, 2, 2, 10287, 10288, 7, 75, 2, 2, 10288, 10289, 7, 82, 2, 2, 10289,
+	10290, 7, 88, 2, 2, 10290, 10291, 7, 56, 2, 2, 10291, 1710, 3, 2, 2, 2,
+	10292, 10293, 7, 75, 2, 2, 10293, 10294, 7, 85, 2, 2, 10294, 10295, 7,
+	97, 2, 2, 10295, 10296, 7, 87, 2, 2, 10296, 10297, 7, 85, 2, 2, 10297,
+	10298, 7, 71, 2, 2, 10298, 10299, 7, 70, 2, 2, 10299, 10300, 7, 97, 2,
+	2, 10300, 10301, 7, 78, 2, 2, 10301, 10302, 7, 81, 2, 2, 10302, 10303,
+	7, 69, 2, 2, 10303, 10304, 7, 77, 2, 2, 10304, 1712, 3, 2, 2, 2, 10305,
+	10306, 7, 78, 2, 2, 10306, 10307, 7, 67, 2, 2, 10307, 10308, 7, 85, 2,
+	2, 10308, 10309, 7, 86, 2, 2, 10309, 10310, 7, 97, 2, 2, 10310, 10311,
+	7, 75, 2, 2, 10311, 10312, 7, 80, 2, 2, 10312, 10313, 7, 85, 2, 2, 10313,
+	10314, 7, 71, 2, 2, 10314, 10315, 7, 84, 2, 2, 10315, 10316, 7, 86, 2,
+	2, 10316, 10317, 7, 97, 2, 2, 10317, 10318, 7, 75, 2, 2, 10318, 10319,
+	7, 70, 2, 2, 10319, 1714, 3, 2, 2, 2, 10320, 10321, 7, 78, 2, 2, 10321,
+	10322, 7, 69, 2, 2, 10322, 10323, 7, 67, 2, 2, 10323, 10324, 7, 85, 2,
+	2, 10324, 10325, 7, 71, 2, 2, 10325, 1716, 3, 2, 2, 2, 10326, 10327, 7,
+	78, 2, 2, 10327, 10328, 7, 71, 2, 2, 10328, 10329, 7, 67, 2, 2, 10329,
+	10330, 7, 85, 2, 2, 10330, 10331, 7, 86, 2, 2, 10331, 1718, 3, 2, 2, 2,
+	10332, 10333, 7, 78, 2, 2, 10333, 10334, 7, 71, 2, 2, 10334, 10335, 7,
+	80, 2, 2, 10335, 10336, 7, 73, 2, 2, 10336, 10337, 7, 86, 2, 2, 10337,
+	10338, 7, 74, 2, 2, 10338, 1720, 3, 2, 2, 2, 10339, 10340, 7, 78, 2, 2,
+	10340, 10341, 7, 75, 2, 2, 10341, 10342, 7, 80, 2, 2, 10342, 10343, 7,
+	71, 2, 2, 10343, 10344, 7, 72, 2, 2, 10344, 10345, 7, 84, 2, 2, 10345,
+	10346, 7, 81, 2, 2, 10346, 10347, 7, 79, 2, 2, 10347, 10348, 7, 86, 2,
+	2, 10348, 10349, 7, 71, 2, 2, 10349, 10350, 7, 90, 2, 2, 10350, 10351,
+	7, 86, 2, 2, 10351, 1722, 3, 2, 2, 2, 10352, 10353, 7, 78, 2, 2, 10353,
+	10354, 7, 75, 2, 2, 10354, 10355, 7, 80, 2, 2, 10355, 10356, 7, 71, 2,
+	2, 10356, 10357, 7, 72, 2, 2, 10357, 10358, 7, 84, 2, 2, 10358, 10359,
+	7, 81, 2, 2, 10359, 10360, 7, 79, 2, 2, 10360, 10361, 7, 89, 2, 2, 10361,
+	10362, 7, 77, 2, 2, 10362, 10363, 7, 68, 2, 2, 10363, 1724, 3, 2, 2, 2,
+	10364, 10365, 7, 78, 2, 2, 10365, 10366, 7, 75, 2, 2, 10366, 10367, 7,
+	80, 2, 2, 10367, 10368, 7, 71, 2, 2, 10368, 10369, 7, 85, 2, 2, 10369,
+	10370, 7, 86, 2, 2, 10370, 10371, 7, 84, 2, 2, 10371, 10372, 7, 75, 2,
+	2, 10372, 10373, 7, 80, 2, 2, 10373, 10374, 7, 73, 2, 2, 10374, 10375,
+	7, 72, 2, 2, 10375, 10376, 7, 84, 2, 2, 10376, 10377, 7, 81, 2, 2, 10377,
+	10378, 7, 79, 2, 2, 10378, 10379, 7, 86, 2, 2, 10379, 10380, 7, 71, 2,
+	2, 10380, 10381, 7, 90, 2, 2, 10381, 10382, 7, 86, 2, 2, 10382, 1726, 3,
+	2, 2, 2, 10383, 10384, 7, 78, 2, 2, 10384, 10385, 7, 75, 2, 2, 10385, 10386,
+	7, 80, 2, 2, 10386, 10387, 7, 71, 2, 2, 10387, 10388, 7, 85, 2, 2, 10388,
+	10389, 7, 86, 2, 2, 10389, 10390, 7, 84, 2, 2, 10390, 10391, 7, 75, 2,
+	2, 10391, 10392, 7, 80, 2, 2, 10392, 10393, 7, 73, 2, 2, 10393, 10394,
+	7, 72, 2, 2, 10394, 10395, 7, 84, 2, 2, 10395, 10396, 7, 81, 2, 2, 10396,
+	10397, 7, 79, 2, 2, 10397, 10398, 7, 89, 2, 2, 10398, 10399, 7, 77, 2,
+	2, 10399, 10400, 7, 68, 2, 2, 10400, 1728, 3, 2, 2, 2, 10401, 10402, 7,
+	78, 2, 2, 10402, 10403, 7, 80, 2, 2, 10403, 1730, 3, 2, 2, 2, 10404, 10405,
+	7, 78, 2, 2, 10405, 10406, 7, 81, 2, 2, 10406, 10407, 7, 67, 2, 2, 10407,
+	10408, 7, 70, 2, 2, 10408, 10409, 7, 97, 2, 2, 10409, 10410, 7, 72, 2,
+	2, 10410, 10411, 7, 75, 2, 2, 10411, 10412, 7, 78, 2, 2, 10412, 10413,
+	7, 71, 2, 2, 10413, 1732, 3, 2, 2, 2, 10414, 10415, 7, 78, 2, 2, 10415,
+	10416, 7, 81, 2, 2, 10416, 10417, 7, 69, 2, 2, 10417, 10418, 7, 67, 2,
+	2, 10418, 10419, 7, 86, 2, 2, 10419, 10420, 7, 71, 2, 2, 10420, 1734, 3,
+	2, 2, 2, 10421, 10422, 7, 78, 2, 2, 10422, 10423, 7, 81, 2, 2, 10423, 10424,
+	7, 73, 2, 2, 10424, 1736, 3, 2, 2, 2, 10425, 10426, 7, 78, 2, 2, 10426,
+	10427, 7, 81, 2, 2, 10427, 10428, 7, 73, 2, 2, 10428, 10429, 7, 51, 2,
+	2, 10429, 10430, 7, 50, 2, 2, 10430, 1738, 3, 2, 2, 2, 10431, 10432, 7,
+	78, 2, 2, 10432, 10433, 7, 81, 2, 2, 10433, 10434, 7, 73, 2, 2, 10434,
+	10435, 7, 52, 2, 2, 10435, 1740, 3, 2, 2, 2, 10436, 10437, 7, 78, 2, 2,
+	10437, 10438, 7, 81, 2, 2, 10438, 10439, 7, 89, 2, 2, 10439, 10440, 7,
+	71, 2, 2, 10440, 10441, 7, 84, 2, 2, 10441, 1742, 3, 2, 2, 2, 10442, 10443,
+	7, 78, 2, 2, 10443, 10444, 7, 82, 2, 2, 10444, 10445, 7, 67, 2, 2, 10445,
+	10446, 7, 70, 2, 2, 10446, 1744, 3, 2, 2, 2, 10447, 10448, 7, 78, 2, 2,
+	10448, 10449, 7, 86, 2, 2, 10449, 10450, 7, 84, 2, 2, 10450, 10451, 7,
+	75, 2, 2, 10451, 10452, 7, 79, 2, 2, 10452, 1746, 3, 2, 2, 2, 10453, 10454,
+	7, 79, 2, 2, 10454, 10455, 7, 67, 2, 2, 10455, 10456, 7, 77, 2, 2, 10456,
+	10457, 7, 71, 2, 2, 10457, 10458, 7, 70, 2, 2, 10458, 10459, 7, 67, 2,
+	2, 10459, 10460, 7, 86, 2, 2, 10460, 10461, 7, 71, 2, 2, 10461, 1748, 3,
+	2, 2, 2, 10462, 10463, 7, 79, 2, 2, 10463, 10464, 7, 67, 2, 2, 10464, 10465,
+	7, 77, 2, 2, 10465, 10466, 7, 71, 2, 2, 10466, 10467, 7, 86, 2, 2, 10467,
+	10468, 7, 75, 2, 2, 10468, 10469, 7, 79, 2, 2, 10469, 10470, 7, 71, 2,
+	2, 10470, 1750, 3, 2, 2, 2, 10471, 10472, 7, 79, 2, 2, 10472, 10473, 7,
+	67, 2, 2, 10473, 10474, 7, 77, 2, 2, 10474, 10475, 7, 71, 2, 2, 10475,
+	10476, 7, 97, 2, 2, 10476, 10477, 7, 85, 2, 2, 10477, 10478, 7, 71, 2,
+	2, 10478, 10479, 7, 86, 2, 2, 10479, 1752, 3, 2, 2, 2, 10480, 10481, 7,
+	79, 2, 2, 10481, 10482, 7, 67, 2, 2, 10482, 10483, 7, 85, 2, 2, 10483,
+	10484, 7, 86, 2, 2, 10484, 10485, 7, 71, 2, 2, 10485, 10486, 7, 84, 2,
+	2, 10486, 10487, 7, 97, 2, 2, 10487, 10488, 7, 82, 2, 2, 10488, 10489,
+	7, 81, 2, 2, 10489, 10490, 7, 85, 2, 2, 10490, 10491, 7, 97, 2, 2, 10491,
+	10492, 7, 89, 2, 2, 10492, 10493, 7, 67, 2, 2, 10493, 10494, 7, 75, 2,
+	2, 10494, 10495, 7, 86, 2, 2, 10495, 1754, 3, 2, 2, 2, 10496, 10497, 7,
+	79, 2, 2, 10497, 10498, 7, 68, 2, 2, 10498, 10499, 7, 84, 2, 2, 10499,
+	10500, 7, 69, 2, 2, 10500, 10501, 7, 81, 2, 2, 10501, 10502, 7, 80, 2,
+	2, 10502, 10503, 7, 86, 2, 2, 10503, 10504, 7, 67, 2, 2, 10504, 10505,
+	7, 75, 2, 2, 10505, 10506, 7, 80, 2, 2, 10506, 10507, 7, 85, 2, 2, 10507,
+	1756, 3, 2, 2, 2, 10508, 10509, 7, 79, 2, 2, 10509, 10510, 7, 68, 2, 2,
+	10510, 10511, 7, 84, 2, 2, 10511, 10512, 7, 70, 2, 2, 10512, 10513, 7,
+	75, 2, 2, 10513, 10514, 7, 85, 2, 2, 10514, 10515, 7, 76, 2, 2, 10515,
+	10516, 7, 81, 2, 2, 10516, 10517, 7, 75, 2, 2, 10517, 10518, 7, 80, 2,
+	2, 10518, 10519, 7, 86, 2, 2, 10519, 1758, 3, 2, 2, 2, 10520, 10521, 7,
+	79, 2, 2, 10521, 10522, 7, 68, 2, 2, 10522, 10523, 7, 84, 2, 2, 10523,
+	10524, 7, 71, 2, 2, 10524, 10525, 7, 83, 2, 2, 10525, 10526, 7, 87, 2,
+	2, 10526, 10527, 7, 67, 2, 2, 10527, 10528, 7, 78, 2, 2, 10528, 1760, 3,
+	2, 2, 2, 10529, 10530, 7, 79, 2, 2, 10530, 10531, 7, 68, 2, 2, 10531, 10532,
+	7, 84, 2, 2, 10532, 10533, 7, 75, 2, 2, 10533, 10534, 7, 80, 2, 2, 10534,
+	10535, 7, 86, 2, 2, 10535, 10536, 7, 71, 2, 2, 10536, 10537, 7, 84, 2,
+	2, 10537, 10538, 7, 85, 2, 2, 10538, 10539, 7, 71, 2, 2, 10539, 10540,
+	7, 69, 2, 2, 10540, 10541, 7, 86, 2, 2, 10541, 10542, 7, 85, 2, 2, 10542,
+	1762, 3, 2, 2, 2, 10543, 10544, 7, 79, 2, 2, 10544, 10545, 7, 68, 2, 2,
+	10545, 10546, 7, 84, 2, 2, 10546, 10547, 7, 81, 2, 2, 10547, 10548, 7,
+	88, 2, 2, 10548, 10549, 7, 71, 2, 2, 10549, 10550, 7, 84, 2, 2, 10550,
+	10551, 7, 78, 2, 2, 10551, 10552, 7, 67, 2, 2, 10552, 10553, 7, 82, 2,
+	2, 10553, 10554, 7, 85, 2, 2, 10554, 1764, 3, 2, 2, 2, 10555, 10556, 7,
+	79, 2, 2, 10556, 10557, 7, 68, 2, 2, 10557, 10558, 7, 84, 2, 2, 10558,
+	10559, 7, 86, 2, 2, 10559, 10560, 7, 81, 2, 2, 10560, 10561, 7, 87, 2,
+	2, 10561, 10562, 7, 69, 2, 2, 10562, 10563, 7, 74, 2, 2, 10563, 10564,
+	7, 71, 2, 2, 10564, 10565, 7, 85, 2, 2, 10565, 1766, 3, 2, 2, 2, 10566,
+	10567, 7, 79, 2, 2, 10567, 10568, 7, 68, 2, 2, 10568, 10569, 7, 84, 2,
+	2, 10569, 10570, 7, 89, 2, 2, 10570, 10571, 7, 75, 2, 2, 10571, 10572,
+	7, 86, 2, 2, 10572, 10573, 7, 74, 2, 2, 10573, 10574, 7, 75, 2, 2, 10574,
+	10575, 7, 80, 2, 2, 10575, 1768, 3, 2, 2, 2, 10576, 10577, 7, 79, 2, 2,
+	10577, 10578, 7, 70, 2, 2, 10578, 10579, 7, 55, 2, 2, 10579, 1770, 3, 2,
+	2, 2, 10580, 10581, 7, 79, 2, 2, 10581, 10582, 7, 78, 2, 2, 10582, 10583,
+	7, 75, 2, 2, 10583, 10584, 7, 80, 2, 2, 10584, 10585, 7, 71, 2, 2, 10585,
+	10586, 7, 72, 2, 2, 10586, 10587, 7, 84, 2, 2, 10587, 10588, 7, 81, 2,
+	2, 10588, 10589, 7, 79, 2, 2, 10589, 10590, 7, 86, 2, 2, 10590, 10591,
+	7, 71, 2, 2, 10591, 10592, 7, 90, 2, 2, 10592, 10593, 7, 86, 2, 2, 10593,
+	1772, 3, 2, 2, 2, 10594, 10595, 7, 79, 2, 2, 10595, 10596, 7, 78, 2, 2,
+	10596, 10597, 7, 75, 2, 2, 10597, 10598, 7, 80, 2, 2, 10598, 10599, 7,
+	71, 2, 2, 10599, 10600, 7, 72, 2, 2, 10600, 10601, 7, 84, 2, 2, 10601,
+	10602, 7, 81, 2, 2, 10602, 10603, 7, 79, 2, 2, 10603, 10604, 7, 89, 2,
+	2, 10604, 10605, 7, 77, 2, 2, 10605, 10606, 7, 68, 2, 2, 10606, 1774, 3,
+	2, 2, 2, 10607, 10608, 7, 79, 2, 2, 10608, 10609, 7, 81, 2, 2, 10609, 10610,
+	7, 80, 2, 2, 10610, 10611, 7, 86, 2, 2, 10611, 10612, 7, 74, 2, 2, 10612,
+	10613, 7, 80, 2, 2, 10613, 10614, 7, 67, 2, 2, 10614, 10615, 7, 79, 2,
+	2, 10615, 10616, 7, 71, 2, 2, 10616, 1776, 3, 2, 2, 2, 10617, 10618, 7,
+	79, 2, 2, 10618, 10619, 7, 82, 2, 2, 10619, 10620, 7, 81, 2, 2, 10620,
+	10621, 7, 75, 2, 2, 10621, 10622, 7, 80, 2, 2, 10622, 10623, 7, 86, 2,
+	2, 10623, 10624, 7, 72, 2, 2, 10624, 10625, 7, 84, 2, 2, 10625, 10626,
+	7, 81, 2, 2, 10626, 10627, 7, 79, 2, 2, 10627, 10628, 7, 86, 2, 2, 10628,
+	10629, 7, 71, 2, 2, 10629, 10630, 7, 90, 2, 2, 10630, 10631, 7, 86, 2,
+	2, 10631, 1778, 3, 2, 2, 2, 10632, 10633, 7, 79, 2, 2, 10633, 10634, 7,
+	82, 2, 2, 10634, 10635, 7, 81, 2, 2, 10635, 10636, 7, 75, 2, 2, 10636,
+	10637, 7, 80, 2, 2, 10637, 10638, 7, 86, 2, 2, 10638, 10639, 7, 72, 2,
+	2, 10639, 10640, 7, 84, 2, 2, 10640, 10641, 7, 81, 2, 2, 10641, 10642,
+	7, 79, 2, 2, 10642, 10643, 7, 89, 2, 2, 10643, 10644, 7, 77, 2, 2, 10644,
+	10645, 7, 68, 2, 2, 10645, 1780, 3, 2, 2, 2, 10646, 10647, 7, 79, 2, 2,
+	10647, 10648, 7, 82, 2, 2, 10648, 10649, 7, 81, 2, 2, 10649, 10650, 7,
+	78, 2, 2, 10650, 10651, 7, 91, 2, 2, 10651, 10652, 7, 72, 2, 2, 10652,
+	10653, 7, 84, 2, 2, 10653, 10654, 7, 81, 2, 2, 10654, 10655, 7, 79, 2,
+	2, 10655, 10656, 7, 86, 2, 2, 10656, 10657, 7, 71, 2, 2, 10657, 10658,
+	7, 90, 2, 2, 10658, 10659, 7, 86, 2, 2, 10659, 1782, 3, 2, 2, 2, 10660,
+	10661, 7, 79, 2, 2, 10661, 10662, 7, 82, 2, 2, 10662, 10663, 7, 81, 2,
+	2, 10663, 10664, 7, 78, 2, 2, 10664, 10665, 7, 91, 2, 2, 10665, 10666,
+	7, 72, 2, 2, 10666, 10667, 7, 84, 2, 2, 10667, 10668, 7, 81, 2, 2, 10668,
+	10669, 7, 79, 2, 2, 10669, 10670, 7, 89, 2, 2, 10670, 10671, 7, 77, 2,
+	2, 10671, 10672, 7, 68, 2, 2, 10672, 1784, 3, 2, 2, 2, 10673, 10674, 7,
+	79, 2, 2, 10674, 10675, 7, 87, 2, 2, 10675, 10676, 7, 78, 2, 2, 10676,
+	10677, 7, 86, 2, 2, 10677, 10678, 7, 75, 2, 2, 10678, 10679, 7, 78, 2,
+	2, 10679, 10680, 7, 75, 2, 2, 10680, 10681, 7, 80, 2, 2, 10681, 10682,
+	7, 71, 2, 2, 10682, 10683, 7, 85, 2, 2, 10683, 10684, 7, 86, 2, 2, 10684,
+	10685, 7, 84, 2, 2, 10685, 10686, 7, 75, 2, 2, 10686, 10687, 7, 80, 2,
+	2, 10687, 10688, 7, 73, 2, 2, 10688, 10689, 7, 72, 2, 2, 10689, 10690,
+	7, 84, 2, 2, 10690, 10691, 7, 81, 2, 2, 10691, 10692, 7, 79, 2, 2, 10692,
+	10693, 7, 86, 2, 2, 10693, 10694, 7, 71, 2, 2, 10694, 10695, 7, 90, 2,
+	2, 10695, 10696, 7, 86, 2, 2, 10696, 1786, 3, 2, 2, 2, 10697, 10698, 7,
+	79, 2, 2, 10698, 10699, 7, 87, 2, 2, 10699, 10700, 7, 78, 2, 2, 10700,
+	10701, 7, 86, 2, 2, 10701, 10702, 7, 75, 2, 2, 10702, 10703, 7, 78, 2,
+	2, 10703, 10704, 7, 75, 2, 2, 10704, 10705, 7, 80, 2, 2, 10705, 10706,
+	7, 71, 2, 2, 10706, 10707, 7, 85, 2, 2, 10707, 10708, 7, 86, 2, 2, 10708,
+	10709, 7, 84, 2, 2, 10709, 10710, 7, 75, 2, 2, 10710, 10711, 7, 80, 2,
+	2, 10711, 10712, 7, 73, 2, 2, 10712, 10713, 7, 72, 2, 2, 10713, 10714,
+	7, 84, 2, 2, 10714, 10715, 7, 81, 2, 2, 10715, 10716, 7, 79, 2, 2, 10716,
+	10717, 7, 89, 2, 2, 10717, 10718, 7, 77, 2, 2, 10718, 10719, 7, 68, 2,
+	2, 10719, 1788, 3, 2, 2, 2, 10720, 10721, 7, 79, 2, 2, 10721, 10722, 7,
+	87, 2, 2, 10722, 10723, 7, 78, 2, 2, 10723, 10724, 7, 86, 2, 2, 10724,
+	10725, 7, 75, 2, 2, 10725, 10726, 7, 82, 2, 2, 10726, 10727, 7, 81, 2,
+	2, 10727, 10728, 7, 75, 2, 2, 10728, 10729, 7, 80, 2, 2, 10729, 10730,
+	7, 86, 2, 2, 10730, 10731, 7, 72, 2, 2, 10731, 10732, 7, 84, 2, 2, 10732,
+	10733, 7, 81, 2, 2, 10733, 10734, 7, 79, 2, 2, 10734, 10735, 7, 86, 2,
+	2, 10735, 10736, 7, 71, 2, 2, 10736, 10737, 7, 90, 2, 2, 10737, 10738,
+	7, 86, 2, 2, 10738, 1790, 3, 2, 2, 2, 10739, 10740, 7, 79, 2, 2, 10740,
+	10741, 7, 87, 2, 2, 10741, 10742, 7, 78, 2, 2, 10742, 10743, 7, 86, 2,
+	2, 10743, 10744, 7, 75, 2, 2, 10744, 10745, 7, 82, 2, 2, 10745, 10746,
+	7, 81, 2, 2, 10746, 10747, 7, 75, 2, 2, 10747, 10748, 7, 80, 2, 2, 10748,
+	10749, 7, 86, 2, 2, 10749, 10750, 7, 72, 2, 2, 10750, 10751, 7, 84, 2,
+	2, 10751, 10752, 7, 81, 2, 2, 10752, 10753, 7, 79, 2, 2, 10753, 10754,
+	7, 89, 2, 2, 10754, 10755, 7, 77, 2, 2, 10755, 10756, 7, 68, 2, 2, 10756,
+	1792, 3, 2, 2, 2, 10757, 10758, 7, 79, 2, 2, 10758, 10759, 7, 87, 2, 2,
+	10759, 10760, 7, 78, 2, 2, 10760, 10761, 7, 86, 2, 2, 10761, 10762, 7,
+	75, 2, 2, 10762, 10763, 7, 82, 2, 2, 10763, 10764, 7, 81, 2, 2, 10764,
+	10765, 7, 78, 2, 2, 10765, 10766, 7, 91, 2, 2, 10766, 10767, 7, 73, 2,
+	2, 10767, 10768, 7, 81, 2, 2, 10768, 10769, 7, 80, 2, 2, 10769, 10770,
+	7, 72, 2, 2, 10770, 10771, 7, 84, 2, 2, 10771, 10772, 7, 81, 2, 2, 10772,
+	10773, 7, 79, 2, 2, 10773, 10774, 7, 86, 2, 2, 10774, 10775, 7, 71, 2,
+	2, 10775, 10776, 7, 90, 2, 2, 10776, 10777, 7, 86, 2, 2, 10777, 1794, 3,
+	2, 2, 2, 10778, 10779, 7, 79, 2, 2, 10779, 10780, 7, 87, 2, 2, 10780, 10781,
+	7, 78, 2, 2, 10781, 10782, 7, 86, 2, 2, 10782, 10783, 7, 75, 2, 2, 10783,
+	10784, 7, 82, 2, 2, 10784, 10785, 7, 81, 2, 2, 10785, 10786, 7, 78, 2,
+	2, 10786, 10787, 7, 91, 2, 2, 10787, 10788, 7, 73, 2, 2, 10788, 10789,
+	7, 81, 2, 2, 10789, 10790, 7, 80, 2, 2, 10790, 10791, 7, 72, 2, 2, 10791,
+	10792, 7, 84, 2, 2, 10792, 10793, 7, 81, 2, 2, 10793, 10794, 7, 79, 2,
+	2, 10794, 10795, 7, 89, 2, 2, 10795, 10796, 7, 77, 2, 2, 10796, 10797,
+	7, 68, 2, 2, 10797, 1796, 3, 2, 2, 2, 10798, 10799, 7, 80, 2, 2, 10799,
+	10800, 7, 67, 2, 2, 10800, 10801, 7, 79, 2, 2, 10801, 10802, 7, 71, 2,
+	2, 10802, 10803, 7, 97, 2, 2, 10803, 10804, 7, 69, 2, 2, 10804, 10805,
+	7, 81, 2, 2, 10805, 10806, 7, 80, 2, 2, 10806, 10807, 7, 85, 2, 2, 10807,
+	10808, 7, 86, 2, 2, 10808, 1798, 3, 2, 2, 2, 10809, 10810, 7, 80, 2, 2,
+	10810, 10811, 7, 87, 2, 2, 10811, 10812, 7, 78, 2, 2, 10812, 10813, 7,
+	78, 2, 2, 10813, 10814, 7, 75, 2, 2, 10814, 10815, 7, 72, 2, 2, 10815,
+	1800, 3, 2, 2, 2, 10816, 10817, 7, 80, 2, 2, 10817, 10818, 7, 87, 2, 2,
+	10818, 10819, 7, 79, 2, 2, 10819, 10820, 7, 73, 2, 2, 10820, 10821, 7,
+	71, 2, 2, 10821, 10822, 7, 81, 2, 2, 10822, 10823, 7, 79, 2, 2, 10823,
+	10824, 7, 71, 2, 2, 10824, 10825, 7, 86, 2, 2, 10825, 10826, 7, 84, 2,
+	2, 10826, 10827, 7, 75, 2, 2, 10827, 10828, 7, 71, 2, 2, 10828, 10829,
+	7, 85, 2, 2, 10829, 1802, 3, 2, 2, 2, 10830, 10831, 7, 80, 2, 2, 10831,
+	10832, 7, 87, 2, 2, 10832, 10833, 7, 79, 2, 2, 10833, 10834, 7, 75, 2,
+	2, 10834, 10835, 7, 80, 2, 2, 10835, 10836, 7, 86, 2, 2, 10836, 10837,
+	7, 71, 2, 2, 10837, 10838, 7, 84, 2, 2, 10838, 10839, 7, 75, 2, 2, 10839,
+	10840, 7, 81, 2, 2, 10840, 10841, 7, 84, 2, 2, 10841, 10842, 7, 84, 2,
+	2, 10842, 10843, 7, 75, 2, 2, 10843, 10844, 7, 80, 2, 2, 10844, 10845,
+	7, 73, 2, 2, 10845, 10846, 7, 85, 2, 2, 10846, 1804, 3, 2, 2, 2, 10847,
+	10848, 7, 80, 2, 2, 10848, 10849, 7, 87, 2, 2, 10849, 10850, 7, 79, 2,
+	2, 10850, 10851, 7, 82, 2, 2, 10851, 10852, 7, 81, 2, 2, 10852, 10853,
+	7, 75, 2, 2, 10853, 10854, 7, 80, 2, 2, 10854, 10855, 7, 86, 2, 2, 10855,
+	10856, 7, 85, 2, 2, 10856, 1806, 3, 2, 2, 2, 10857, 10858, 7, 81, 2, 2,
+	10858, 10859, 7, 69, 2, 2, 10859, 10860, 7, 86, 2, 2, 10860, 1808, 3, 2,
+	2, 2, 10861, 10862, 7, 81, 2, 2, 10862, 10863, 7, 69, 2, 2, 10863, 10864,
+	7, 86, 2, 2, 10864, 10865, 7, 71, 2, 2, 10865, 10866, 7, 86, 2, 2, 10866,
+	10867, 7, 97, 2, 2, 10867, 10868, 7, 78, 2, 2, 10868, 10869, 7, 71, 2,
+	2, 10869, 10870, 7, 80, 2, 2, 10870, 10871, 7, 73, 2, 2, 10871, 10872,
+	7, 86, 2, 2, 10872, 10873, 7, 74, 2, 2, 10873, 1810, 3, 2, 2, 2, 10874,
+	10875, 7, 81, 2, 2, 10875, 10876, 7, 84, 2, 2, 10876, 10877, 7, 70, 2,
+	2, 10877, 1812, 3, 2, 2, 2, 10878, 10879, 7, 81, 2, 2, 10879, 10880, 7,
+	88, 2, 2, 10880, 10881, 7, 71, 2, 2, 10881, 10882, 7, 84, 2, 2, 10882,
+	10883, 7, 78, 2, 2, 10883, 10884, 7, 67, 2, 2, 10884, 10885, 7, 82, 2,
+	2, 10885, 10886, 7, 85, 2, 2, 10886, 1814, 3, 2, 2, 2, 10887, 10888, 7,
+	82, 2, 2, 10888, 10889, 7, 71, 2, 2, 10889, 10890, 7, 84, 2, 2, 10890,
+	10891, 7, 75, 2, 2, 10891, 10892, 7, 81, 2, 2, 10892, 10893, 7, 70, 2,
+	2, 10893, 10894, 7, 97, 2, 2, 10894, 10895, 7, 67, 2, 2, 10895, 10896,
+	7, 70, 2, 2, 10896, 10897, 7, 70, 2, 2, 10897, 1816, 3, 2, 2, 2, 10898,
+	10899, 7, 82, 2, 2, 10899, 10900, 7, 71, 2, 2, 10900, 10901, 7, 84, 2,
+	2, 10901, 10902, 7, 75, 2, 2, 10902, 10903, 7, 81, 2, 2, 10903, 10904,
+	7, 70, 2, 2, 10904, 10905, 7, 97, 2, 2, 10905, 10906, 7, 70, 2, 2, 10906,
+	10907, 7, 75, 2, 2, 10907, 10908, 7, 72, 2, 2, 10908, 10909, 7, 72, 2,
+	2, 10909, 1818, 3, 2, 2, 2, 10910, 10911, 7, 82, 2, 2, 10911, 10912, 7,
+	75, 2, 2, 10912, 1820, 3, 2, 2, 2, 10913, 10914, 7, 82, 2, 2, 10914, 10915,
+	7, 81, 2, 2, 10915, 10916, 7, 75, 2, 2, 10916, 10917, 7, 80, 2, 2, 10917,
+	10918, 7, 86, 2, 2, 10918, 10919, 7, 72, 2, 2, 10919, 10920, 7, 84, 2,
+	2, 10920, 10921, 7, 81, 2, 2, 10921, 10922, 7, 79, 2, 2, 10922, 10923,
+	7, 86, 2, 2, 10923, 10924, 7, 71, 2, 2, 10924, 10925, 7, 90, 2, 2, 10925,
+	10926, 7, 86, 2, 2, 10926, 1822, 3, 2, 2, 2, 10927, 10928, 7, 82, 2, 2,
+	10928, 10929, 7, 81, 2, 2, 10929, 10930, 7, 75, 2, 2, 10930, 10931, 7,
+	80, 2, 2, 10931, 10932, 7, 86, 2, 2, 10932, 10933, 7, 72, 2, 2, 10933,
+	10934, 7, 84, 2, 2, 10934, 10935, 7, 81, 2, 2, 10935, 10936, 7, 79, 2,
+	2, 10936, 10937, 7, 89, 2, 2, 10937, 10938, 7, 77, 2, 2, 10938, 10939,
+	7, 68, 2, 2, 10939, 1824, 3, 2, 2, 2, 10940, 10941, 7, 82, 2, 2, 10941,
+	10942, 7, 81, 2, 2, 10942, 10943, 7, 75, 2, 2, 10943, 10944, 7, 80, 2,
+	2, 10944, 10945, 7, 86, 2, 2, 10945, 10946, 7, 80, 2, 2, 10946, 1826, 3,
+	2, 2, 2, 10947, 10948, 7, 82, 2, 2, 10948, 10949, 7, 81, 2, 2, 10949, 10950,
+	7, 78, 2, 2, 10950, 10951, 7, 91, 2, 2, 10951, 10952, 7, 72, 2, 2, 10952,
+	10953, 7, 84, 2, 2, 10953, 10954, 7, 81, 2, 2, 10954, 10955, 7, 79, 2,
+	2, 10955, 10956, 7, 86, 2, 2, 10956, 10957, 7, 71, 2, 2, 10957, 10958,
+	7, 90, 2, 2, 10958, 10959, 7, 86, 2, 2, 10959, 1828, 3, 2, 2, 2, 10960,
+	10961, 7, 82, 2, 2, 10961, 10962, 7, 81, 2, 2, 10962, 10963, 7, 78, 2,
+	2, 10963, 10964, 7, 91, 2, 2, 10964, 10965, 7, 72, 2, 2, 10965, 10966,
+	7, 84, 2, 2, 10966, 10967, 7, 81, 2, 2, 10967, 10968, 7, 79, 2, 2, 10968,
+	10969, 7, 89, 2, 2, 10969, 10970, 7, 77, 2, 2, 10970, 10971, 7, 68, 2,
+	2, 10971, 1830, 3, 2, 2, 2, 10972, 10973, 7, 82, 2, 2, 10973, 10974, 7,
+	81, 2, 2, 10974, 10975, 7, 78, 2, 2, 10975, 10976, 7, 91, 2, 2, 10976,
+	10977, 7, 73, 2, 2, 10977, 10978, 7, 81, 2, 2, 10978, 10979, 7, 80, 2,
+	2, 10979, 10980, 7, 72, 2, 2, 10980, 10981, 7, 84, 2, 2, 10981, 10982,
+	7, 81, 2, 2, 10982, 10983, 7, 79, 2, 2, 10983, 10984, 7, 86, 2, 2, 10984,
+	10985, 7, 71, 2, 2, 10985, 10986, 7, 90, 2, 2, 10986, 10987, 7, 86, 2,
+	2, 10987, 1832, 3, 2, 2, 2, 10988, 10989, 7, 82, 2, 2, 10989, 10990, 7,
+	81, 2, 2, 10990, 10991, 7, 78, 2, 2, 10991, 10992, 7, 91, 2, 2, 10992,
+	10993, 7, 73, 2, 2, 10993, 10994, 7, 81, 2, 2, 10994, 10995, 7, 80, 2,
+	2, 10995, 10996, 7, 72, 2, 2, 10996, 10997, 7, 84, 2, 2, 10997, 10998,
+	7, 81, 2, 2, 10998, 10999, 7, 79, 2, 2, 10999, 11000, 7, 89, 2, 2, 11000,
+	11001, 7, 77, 2, 2, 11001, 11002, 7, 68, 2, 2, 11002, 1834, 3, 2, 2, 2,
+	11003, 11004, 7, 82, 2, 2, 11004, 11005, 7, 81, 2, 2, 11005, 11006, 7,
+	89, 2, 2, 11006, 1836, 3, 2, 2, 2, 11007, 11008, 7, 82, 2, 2, 11008, 11009,
+	7, 81, 2, 2, 11009, 11010, 7, 89, 2, 2, 11010, 11011, 7, 71, 2, 2, 11011,
+	11012, 7, 84, 2, 2, 11012, 1838, 3, 2, 2, 2, 11013, 11014, 7, 83, 2, 2,
+	11014, 11015, 7, 87, 2, 2, 11015, 11016, 7, 81, 2, 2, 11016, 11017, 7,
+	86, 2, 2, 11017, 11018, 7, 71, 2, 2, 11018, 1840, 3, 2, 2, 2, 11019, 11020,
+	7, 84, 2, 2, 11020, 11021, 7, 67, 2, 2, 11021, 11022, 7, 70, 2, 2, 11022,
+	11023, 7, 75, 2, 2, 11023, 11024, 7, 67, 2, 2, 11024, 11025, 7, 80, 2,
+	2, 11025, 11026, 7, 85, 2, 2, 11026, 1842, 3, 2, 2, 2, 11027, 11028, 7,
+	84, 2, 2, 11028, 11029, 7, 67, 2, 2, 11029, 11030, 7, 80, 2, 2, 11030,
+	11031, 7, 70, 2, 2, 11031, 1844, 3, 2, 2, 2, 11032, 11033, 7, 84, 2, 2,
+	11033, 11034, 7, 67, 2, 2, 11034, 11035, 7, 80, 2, 2, 11035, 11036, 7,
+	70, 2, 2, 11036, 11037, 7, 81, 2, 2, 11037, 11038, 7, 79, 2, 2, 11038,
+	11039, 7, 97, 2, 2, 11039, 11040, 7, 68, 2, 2, 11040, 11041, 7, 91, 2,
+	2, 11041, 11042, 7, 86, 2, 2, 11042, 11043, 7, 71, 2, 2, 11043, 11044,
+	7, 85, 2, 2, 11044, 1846, 3, 2, 2, 2, 11045, 11046, 7, 84, 2, 2, 11046,
+	11047, 7, 71, 2, 2, 11047, 11048, 7, 78, 2, 2, 11048, 11049, 7, 71, 2,
+	2, 11049, 11050, 7, 67, 2, 2, 11050, 11051, 7, 85, 2, 2, 11051, 11052,
+	7, 71, 2, 2, 11052, 11053, 7, 97, 2, 2, 11053, 11054, 7, 78, 2, 2, 11054,
+	11055, 7, 81, 2, 2, 11055, 11056, 7, 69, 2, 2, 11056, 11057, 7, 77, 2,
+	2, 11057, 1848, 3, 2, 2, 2, 11058, 11059, 7, 84, 2, 2, 11059, 11060, 7,
+	71, 2, 2, 11060, 11061, 7, 88, 2, 2, 11061, 11062, 7, 71, 2, 2, 11062,
+	11063, 7, 84, 2, 2, 11063, 11064, 7, 85, 2, 2, 11064, 11065, 7, 71, 2,
+	2, 11065, 1850, 3, 2, 2, 2, 11066, 11067, 7, 84, 2, 2, 11067, 11068, 7,
+	81, 2, 2, 11068, 11069, 7, 87, 2, 2, 11069, 11070, 7, 80, 2, 2, 11070,
+	11071, 7, 70, 2, 2, 11071, 1852, 3, 2, 2, 2, 11072, 11073, 7, 84, 2, 2,
+	11073, 11074, 7, 81, 2, 2, 11074, 11075, 7, 89, 2, 2, 11075, 11076, 7,
+	97, 2, 2, 11076, 11077, 7, 69, 2, 2, 11077, 11078, 7, 81, 2, 2, 11078,
+	11079, 7, 87, 2, 2, 11079, 11080, 7, 80, 2, 2, 11080, 11081, 7, 86, 2,
+	2, 11081, 1854, 3, 2, 2, 2, 11082, 11083, 7, 84, 2, 2, 11083, 11084, 7,
+	82, 2, 2, 11084, 11085, 7, 67, 2, 2, 11085, 11086, 7, 70, 2, 2, 11086,
+	1856, 3, 2, 2, 2, 11087, 11088, 7, 84, 2, 2, 11088, 11089, 7, 86, 2, 2,
+	11089, 11090, 7, 84, 2, 2, 11090, 11091, 7, 75, 2, 2, 11091, 11092, 7,
+	79, 2, 2, 11092, 1858, 3, 2, 2, 2, 11093, 11094, 7, 85, 2, 2, 11094, 11095,
+	7, 71, 2, 2, 11095, 11096, 7, 69, 2, 2, 11096, 11097, 7, 97, 2, 2, 11097,
+	11098, 7, 86, 2, 2, 11098, 11099, 7, 81, 2, 2, 11099, 11100, 7, 97, 2,
+	2, 11100, 11101, 7, 86, 2, 2, 11101, 11102, 7, 75, 2, 2, 11102, 11103,
+	7, 79, 2, 2, 11103, 11104, 7, 71, 2, 2, 11104, 1860, 3, 2, 2, 2, 11105,
+	11106, 7, 85, 2, 2, 11106, 11107, 7, 71, 2, 2, 11107, 11108, 7, 85, 2,
+	2, 11108, 11109, 7, 85, 2, 2, 11109, 11110, 7, 75, 2, 2, 11110, 11111,
+	7, 81, 2, 2, 11111, 11112, 7, 80, 2, 2, 11112, 11113, 7, 97, 2, 2, 11113,
+	11114, 7, 87, 2, 2, 11114, 11115, 7, 85, 2, 2, 11115, 11116, 7, 71, 2,
+	2, 11116, 11117, 7, 84, 2, 2, 11117, 1862, 3, 2, 2, 2, 11118, 11119, 7,
+	85, 2, 2, 11119, 11120, 7, 74, 2, 2, 11120, 11121, 7, 67, 2, 2, 11121,
+	1864, 3, 2, 2, 2, 11122, 11123, 7, 85, 2, 2, 11123, 11124, 7, 74, 2, 2,
+	11124, 11125, 7, 67, 2, 2, 11125, 11126, 7, 51, 2, 2, 11126, 1866, 3, 2,
+	2, 2, 11127, 11128, 7, 85, 2, 2, 11128, 11129, 7, 74, 2, 2, 11129, 11130,
+	7, 67, 2, 2, 11130, 11131, 7, 52, 2, 2, 11131, 1868, 3, 2, 2, 2, 11132,
+	11133, 7, 85, 2, 2, 11133, 11134, 7, 69, 2, 2, 11134, 11135, 7, 74, 2,
+	2, 11135, 11136, 7, 71, 2, 2, 11136, 11137, 7, 79, 2, 2, 11137, 11138,
+	7, 67, 2, 2, 11138, 11139, 7, 97, 2, 2, 11139, 11140, 7, 80, 2, 2, 11140,
+	11141, 7, 67, 2, 2, 11141, 11142, 7, 79, 2, 2, 11142, 11143, 7, 71, 2,
+	2, 11143, 1870, 3, 2, 2, 2, 11144, 11145, 7, 85, 2, 2, 11145, 11146, 7,
+	75, 2, 2, 11146, 11147, 7, 73, 2, 2, 11147, 11148, 7, 80, 2, 2, 11148,
+	1872, 3, 2, 2, 2, 11149, 11150, 7, 85, 2, 2, 11150, 11151, 7, 75, 2, 2,
+	11151, 11152, 7, 80, 2, 2, 11152, 1874, 3, 2, 2, 2, 11153, 11154, 7, 85,
+	2, 2, 11154, 11155, 7, 78, 2, 2, 11155, 11156, 7, 71, 2, 2, 11156, 11157,
+	7, 71, 2, 2, 11157, 11158, 7, 82, 2, 2, 11158, 1876, 3, 2, 2, 2, 11159,
+	11160, 7, 85, 2, 2, 11160, 11161, 7, 81, 2, 2, 11161, 11162, 7, 87, 2,
+	2, 11162, 11163, 7, 80, 2, 2, 11163, 11164, 7, 70, 2, 2, 11164, 11165,
+	7, 71, 2, 2, 11165, 11166, 7, 90, 2, 2, 11166, 1878, 3, 2, 2, 2, 11167,
+	11168, 7, 85, 2, 2, 11168, 11169, 7, 83, 2, 2, 11169, 11170, 7, 78, 2,
+	2, 11170, 11171, 7, 97, 2, 2, 11171, 11172, 7, 86, 2, 2, 11172, 11173,
+	7, 74, 2, 2, 11173, 11174, 7, 84, 2, 2, 11174, 11175, 7, 71, 2, 2, 11175,
+	11176, 7, 67, 2, 2, 11176, 11177, 7, 70, 2, 2, 11177, 11178, 7, 97, 2,
+	2, 11178, 11179, 7, 89, 2, 2, 11179, 11180, 7, 67, 2, 2, 11180, 11181,
+	7, 75, 2, 2, 11181, 11182, 7, 86, 2, 2, 11182, 11183, 7, 97, 2, 2, 11183,
+	11184, 7, 67, 2, 2, 11184, 11185, 7, 72, 2, 2, 11185, 11186, 7, 86, 2,
+	2, 11186, 11187, 7, 71, 2, 2, 11187, 11188, 7, 84, 2, 2, 11188, 11189,
+	7, 97, 2, 2, 11189, 11190, 7, 73, 2, 2, 11190, 11191, 7, 86, 2, 2, 11191,
+	11192, 7, 75, 2, 2, 11192, 11193, 7, 70, 2, 2, 11193, 11194, 7, 85, 2,
+	2, 11194, 1880, 3, 2, 2, 2, 11195, 11196, 7, 85, 2, 2, 11196, 11197, 7,
+	83, 2, 2, 11197, 11198, 7, 84, 2, 2, 11198, 11199, 7, 86, 2, 2, 11199,
+	1882, 3, 2, 2, 2, 11200, 11201, 7, 85, 2, 2, 11201, 11202, 7, 84, 2, 2,
+	11202, 11203, 7, 75, 2, 2, 11203, 11204, 7, 70, 2, 2, 11204, 1884, 3, 2,
+	2, 2, 11205, 11206, 7, 85, 2, 2, 11206, 11207, 7, 86, 2, 2, 11207, 11208,
+	7, 67, 2, 2, 11208, 11209, 7, 84, 2, 2, 11209, 11210, 7, 86, 2, 2, 11210,
+	11211, 7, 82, 2, 2, 11211, 11212, 7, 81, 2, 2, 11212, 11213, 7, 75, 2,
+	2, 11213, 11214, 7, 80, 2, 2, 11214, 11215, 7, 86, 2, 2, 11215, 1886, 3,
+	2, 2, 2, 11216, 11217, 7, 85, 2, 2, 11217, 11218, 7, 86, 2, 2, 11218, 11219,
+	7, 84, 2, 2, 11219, 11220, 7, 69, 2, 2, 11220, 11221, 7, 79, 2, 2, 11221,
+	11222, 7, 82, 2, 2, 11222, 1888, 3, 2, 2, 2, 11223, 11224, 7, 85, 2, 2,
+	11224, 11225, 7, 86, 2, 2, 11225, 11226, 7, 84, 2, 2, 11226, 11227, 7,
+	97, 2, 2, 11227, 11228, 7, 86, 2, 2, 11228, 11229, 7, 81, 2, 2, 11229,
+	11230, 7, 97, 2, 2, 11230, 11231, 7, 70, 2, 2, 11231, 11232, 7, 67, 2,
+	2, 11232, 11233, 7, 86, 2, 2, 11233, 11234, 7, 71, 2, 2, 11234, 1890, 3,
+	2, 2, 2, 11235, 11236, 7, 85, 2, 2, 11236, 11237, 7, 86, 2, 2, 11237, 11238,
+	7, 97, 2, 2, 11238, 11239, 7, 67, 2, 2, 11239, 11240, 7, 84, 2, 2, 11240,
+	11241, 7, 71, 2, 2, 11241, 11242, 7, 67, 2, 2, 11242, 1892, 3, 2, 2, 2,
+	11243, 11244, 7, 85, 2, 2, 11244, 11245, 7, 86, 2, 2, 11245, 11246, 7,
+	97, 2, 2, 11246, 11247, 7, 67, 2, 2, 11247, 11248, 7, 85, 2, 2, 11248,
+	11249, 7, 68, 2, 2, 11249, 11250, 7, 75, 2, 2, 11250, 11251, 7, 80, 2,
+	2, 11251, 11252, 7, 67, 2, 2, 11252, 11253, 7, 84, 2, 2, 11253, 11254,
+	7, 91, 2, 2, 11254, 1894, 3, 2, 2, 2, 11255, 11256, 7, 85, 2, 2, 11256,
+	11257, 7, 86, 2, 2, 11257, 11258, 7, 97, 2, 2, 11258, 11259, 7, 67, 2,
+	2, 11259, 11260, 7, 85, 2, 2, 11260, 11261, 7, 86, 2, 2, 11261, 11262,
+	7, 71, 2, 2, 11262, 11263, 7, 90, 2, 2, 11263, 11264, 7, 86, 2, 2, 11264,
+	1896, 3, 2, 2, 2, 11265, 11266, 7, 85, 2, 2, 11266, 11267, 7, 86, 2, 2,
+	11267, 11268, 7, 97, 2, 2, 11268, 11269, 7, 67, 2, 2, 11269, 11270, 7,
+	85, 2, 2, 11270, 11271, 7, 89, 2, 2, 11271, 11272, 7, 77, 2, 2, 11272,
+	11273, 7, 68, 2, 2, 11273, 1898, 3, 2, 2, 2, 11274, 11275, 7, 85, 2, 2,
+	11275, 11276, 7, 86, 2, 2, 11276, 11277, 7, 97, 2, 2, 11277, 11278, 7,
+	67, 2, 2, 11278, 11279, 7, 85, 2, 2, 11279, 11280, 7, 89, 2, 2, 11280,
+	11281, 7, 77, 2, 2, 11281, 11282, 7, 86, 2, 2, 11282, 1900, 3, 2, 2, 2,
+	11283, 11284, 7, 85, 2, 2, 11284, 11285, 7, 86, 2, 2, 11285, 11286, 7,
+	97, 2, 2, 11286, 11287, 7, 68, 2, 2, 11287, 11288, 7, 87, 2, 2, 11288,
+	11289, 7, 72, 2, 2, 11289, 11290, 7, 72, 2, 2, 11290, 11291, 7, 71, 2,
+	2, 11291, 11292, 7, 84, 2, 2, 11292, 1902, 3, 2, 2, 2, 11293, 11294, 7,
+	85, 2, 2, 11294, 11295, 7, 86, 2, 2, 11295, 11296, 7, 97, 2, 2, 11296,
+	11297, 7, 69, 2, 2, 11297, 11298, 7, 71, 2, 2, 11298, 11299, 7, 80, 2,
+	2, 11299, 11300, 7, 86, 2, 2, 11300, 11301, 7, 84, 2, 2, 11301, 11302,
+	7, 81, 2, 2, 11302, 11303, 7, 75, 2, 2, 11303, 11304, 7, 70, 2, 2, 11304,
+	1904, 3, 2, 2, 2, 11305, 11306, 7, 85, 2, 2, 11306, 11307, 7, 86, 2, 2,
+	11307, 11308, 7, 97, 2, 2, 11308, 11309, 7, 69, 2, 2, 11309, 11310, 7,
+	81, 2, 2, 11310, 11311, 7, 80, 2, 2, 11311, 11312, 7, 86, 2, 2, 11312,
+	11313, 7, 67, 2, 2, 11313, 11314, 7, 75, 2, 2, 11314, 11315, 7, 80, 2,
+	2, 11315, 11316, 7, 85, 2, 2, 11316, 1906, 3, 2, 2, 2, 11317, 11318, 7,
+	85, 2, 2, 11318, 11319, 7, 86, 2, 2, 11319, 11320, 7, 97, 2, 2, 11320,
+	11321, 7, 69, 2, 2, 11321, 11322, 7, 84, 2, 2, 11322, 11323, 7, 81, 2,
+	2, 11323, 11324, 7, 85, 2, 2, 11324, 11325, 7, 85, 2, 2, 11325, 11326,
+	7, 71, 2, 2, 11326, 11327, 7, 85, 2, 2, 11327, 1908, 3, 2, 2, 2, 11328,
+	11329, 7, 85, 2, 2, 11329, 11330, 7, 86, 2, 2, 11330, 11331, 7, 97, 2,
+	2, 11331, 11332, 7, 70, 2, 2, 11332, 11333, 7, 75, 2, 2, 11333, 11334,
+	7, 72, 2, 2, 11334, 11335, 7, 72, 2, 2, 11335, 11336, 7, 71, 2, 2, 11336,
+	11337, 7, 84, 2, 2, 11337, 11338, 7, 71, 2, 2, 11338, 11339, 7, 80, 2,
+	2, 11339, 11340, 7, 69, 2, 2, 11340, 11341, 7, 71, 2, 2, 11341, 1910, 3,
+	2, 2, 2, 11342, 11343, 7, 85, 2, 2, 11343, 11344, 7, 86, 2, 2, 11344, 11345,
+	7, 97, 2, 2, 11345, 11346, 7, 70, 2, 2, 11346, 11347, 7, 75, 2, 2, 11347,
+	11348, 7, 79, 2, 2, 11348, 11349, 7, 71, 2, 2, 11349, 11350, 7, 80, 2,
+	2, 11350, 11351, 7, 85, 2, 2, 11351, 11352, 7, 75, 2, 2, 11352, 11353,
+	7, 81, 2, 2, 11353, 11354, 7, 80, 2, 2, 11354, 1912, 3, 2, 2, 2, 11355,
+	11356, 7, 85, 2, 2, 11356, 11357, 7, 86, 2, 2, 11357, 11358, 7, 97, 2,
+	2, 11358, 11359, 7, 70, 2, 2, 11359, 11360, 7, 75, 2, 2, 11360, 11361,
+	7, 85, 2, 2, 11361, 11362, 7, 76, 2, 2, 11362, 11363, 7, 81, 2, 2, 11363,
+	11364, 7, 75, 2, 2, 11364, 11365, 7, 80, 2, 2, 11365, 11366, 7, 86, 2,
+	2, 11366, 1914, 3, 2, 2, 2, 11367, 11368, 7, 85, 2, 2, 11368, 11369, 7,
+	86, 2, 2, 11369, 11370, 7, 97, 2, 2, 11370, 11371, 7, 70, 2, 2, 11371,
+	11372, 7, 75, 2, 2, 11372, 11373, 7, 85, 2, 2, 11373, 11374, 7, 86, 2,
+	2, 11374, 11375, 7, 67, 2, 2, 11375, 11376, 7, 80, 2, 2, 11376, 11377,
+	7, 69, 2, 2, 11377, 11378, 7, 71, 2, 2, 11378, 1916, 3, 2, 2, 2, 11379,
+	11380, 7, 85, 2, 2, 11380, 11381, 7, 86, 2, 2, 11381, 11382, 7, 97, 2,
+	2, 11382, 11383, 7, 71, 2, 2, 11383, 11384, 7, 80, 2, 2, 11384, 11385,
+	7, 70, 2, 2, 11385, 11386, 7, 82, 2, 2, 11386, 11387, 7, 81, 2, 2, 11387,
+	11388, 7, 75, 2, 2, 11388, 11389, 7, 80, 2, 2, 11389, 11390, 7, 86, 2,
+	2, 11390, 1918, 3, 2, 2, 2, 11391, 11392, 7, 85, 2, 2, 11392, 11393, 7,
+	86, 2, 2, 11393, 11394, 7, 97, 2, 2, 11394, 11395, 7, 71, 2, 2, 11395,
+	11396, 7, 80, 2, 2, 11396, 11397, 7, 88, 2, 2, 11397, 11398, 7, 71, 2,
+	2, 11398, 11399, 7, 78, 2, 2, 11399, 11400, 7, 81, 2, 2, 11400, 11401,
+	7, 82, 2, 2, 11401, 11402, 7, 71, 2, 2, 11402, 1920, 3, 2, 2, 2, 11403,
+	11404, 7, 85, 2, 2, 11404, 11405, 7, 86, 2, 2, 11405, 11406, 7, 97, 2,
+	2, 11406, 11407, 7, 71, 2, 2, 11407, 11408, 7, 83, 2, 2, 11408, 11409,
+	7, 87, 2, 2, 11409, 11410, 7, 67, 2, 2, 11410, 11411, 7, 78, 2, 2, 11411,
+	11412, 7, 85, 2, 2, 11412, 1922, 3, 2, 2, 2, 11413, 11414, 7, 85, 2, 2,
+	11414, 11415, 7, 86, 2, 2, 11415, 11416, 7, 97, 2, 2, 11416, 11417, 7,
+	71, 2, 2, 11417, 11418, 7, 90, 2, 2, 11418, 11419, 7, 86, 2, 2, 11419,
+	11420, 7, 71, 2, 2, 11420, 11421, 7, 84, 2, 2, 11421, 11422, 7, 75, 2,
+	2, 11422, 11423, 7, 81, 2, 2, 11423, 11424, 7, 84, 2, 2, 11424, 11425,
+	7, 84, 2, 2, 11425, 11426, 7, 75, 2, 2, 11426, 11427, 7, 80, 2, 2, 11427,
+	11428, 7, 73, 2, 2, 11428, 1924, 3, 2, 2, 2, 11429, 11430, 7, 85, 2, 2,
+	11430, 11431, 7, 86, 2, 2, 11431, 11432, 7, 97, 2, 2, 11432, 11433, 7,
+	73, 2, 2, 11433, 11434, 7, 71, 2, 2, 11434, 11435, 7, 81, 2, 2, 11435,
+	11436, 7, 79, 2, 2, 11436, 11437, 7, 69, 2, 2, 11437, 11438, 7, 81, 2,
+	2, 11438, 11439, 7, 78, 2, 2, 11439, 11440, 7, 78, 2, 2, 11440, 11441,
+	7, 72, 2, 2, 11441, 11442, 7, 84, 2, 2, 11442, 11443, 7, 81, 2, 2, 11443,
+	11444, 7, 79, 2, 2, 11444, 11445, 7, 86, 2, 2, 11445, 11446, 7, 71, 2,
+	2, 11446, 11447, 7, 90, 2, 2, 11447, 11448, 7, 86, 2, 2, 11448, 1926, 3,
+	2, 2, 2, 11449, 11450, 7, 85, 2, 2, 11450, 11451, 7, 86, 2, 2, 11451, 11452,
+	7, 97, 2, 2, 11452, 11453, 7, 73, 2, 2, 11453, 11454, 7, 71, 2, 2, 11454,
+	11455, 7, 81, 2, 2, 11455, 11456, 7, 79, 2, 2, 11456, 11457, 7, 69, 2,
+	2, 11457, 11458, 7, 81, 2, 2, 11458, 11459, 7, 78, 2, 2, 11459, 11460,
+	7, 78, 2, 2, 11460, 11461, 7, 72, 2, 2, 11461, 11462, 7, 84, 2, 2, 11462,
+	11463, 7, 81, 2, 2, 11463, 11464, 7, 79, 2, 2, 11464, 11465, 7, 86, 2,
+	2, 11465, 11466, 7, 90, 2, 2, 11466, 11467, 7, 86, 2, 2, 11467, 1928, 3,
+	2, 2, 2, 11468, 11469, 7, 85, 2, 2, 11469, 11470, 7, 86, 2, 2, 11470, 11471,
+	7, 97, 2, 2, 11471, 11472, 7, 73, 2, 2, 11472, 11473, 7, 71, 2, 2, 11473,
+	11474, 7, 81, 2, 2, 11474, 11475, 7, 79, 2, 2, 11475, 11476, 7, 69, 2,
+	2, 11476, 11477, 7, 81, 2, 2, 11477, 11478, 7, 78, 2, 2, 11478, 11479,
+	7, 78, 2, 2, 11479, 11480, 7, 72, 2, 2, 11480, 11481, 7, 84, 2, 2, 11481,
+	11482, 7, 81, 2, 2, 11482, 11483, 7, 79, 2, 2, 11483, 11484, 7, 89, 2,
+	2, 11484, 11485, 7, 77, 2, 2, 11485, 11486, 7, 68, 2, 2, 11486, 1930, 3,
+	2, 2, 2, 11487, 11488, 7, 85, 2, 2, 11488, 11489, 7, 86, 2, 2, 11489, 11490,
+	7, 97, 2, 2, 11490, 11491, 7, 73, 2, 2, 11491, 11492, 7, 71, 2, 2, 11492,
+	11493, 7, 81, 2, 2, 11493, 11494, 7, 79, 2, 2, 11494, 11495, 7, 71, 2,
+	2, 11495, 11496, 7, 86, 2, 2, 11496, 11497, 7, 84, 2, 2, 11497, 11498,
+	7, 91, 2, 2, 11498, 11499, 7, 69, 2, 2, 11499, 11500, 7, 81, 2, 2, 11500,
+	11501, 7, 78, 2, 2, 11501, 11502, 7, 78, 2, 2, 11502, 11503, 7, 71, 2,
+	2, 11503, 11504, 7, 69, 2, 2, 11504, 11505, 7, 86, 2, 2, 11505, 11506,
+	7, 75, 2, 2, 11506, 11507, 7, 81, 2, 2, 11507, 11508, 7, 80, 2, 2, 11508,
+	11509, 7, 72, 2, 2, 11509, 11510, 7, 84, 2, 2, 11510, 11511, 7, 81, 2,
+	2, 11511, 11512, 7, 79, 2, 2, 11512, 11513, 7, 86, 2, 2, 11513, 11514,
+	7, 71, 2, 2, 11514, 11515, 7, 90, 2, 2, 11515, 11516, 7, 86, 2, 2, 11516,
+	1932, 3, 2, 2, 2, 11517, 11518, 7, 85, 2, 2, 11518, 11519, 7, 86, 2, 2,
+	11519, 11520, 7, 97, 2, 2, 11520, 11521, 7, 73, 2, 2, 11521, 11522, 7,
+	71, 2, 2, 11522, 11523, 7, 81, 2, 2, 11523, 11524, 7, 79, 2, 2, 11524,
+	11525, 7, 71, 2, 2, 11525, 11526, 7, 86, 2, 2, 11526, 11527, 7, 84, 2,
+	2, 11527, 11528, 7, 91, 2, 2, 11528, 11529, 7, 69, 2, 2, 11529, 11530,
+	7, 81, 2, 2, 11530, 11531, 7, 78, 2, 2, 11531, 11532, 7, 78, 2, 2, 11532,
+	11533, 7, 71, 2, 2, 11533, 11534, 7, 69, 2, 2, 11534, 11535, 7, 86, 2,
+	2, 11535, 11536, 7, 75, 2, 2, 11536, 11537, 7, 81, 2, 2, 11537, 11538,
+	7, 80, 2, 2, 11538, 11539, 7, 72, 2, 2, 11539, 11540, 7, 84, 2, 2, 11540,
+	11541, 7, 81, 2, 2, 11541, 11542, 7, 79, 2, 2, 11542, 11543, 7, 89, 2,
+	2, 11543, 11544, 7, 77, 2, 2, 11544, 11545, 7, 68, 2, 2, 11545, 1934, 3,
+	2, 2, 2, 11546, 11547, 7, 85, 2, 2, 11547, 11548, 7, 86, 2, 2, 11548, 11549,
+	7, 97, 2, 2, 11549, 11550, 7, 73, 2, 2, 11550, 11551, 7, 71, 2, 2, 11551,
+	11552, 7, 81, 2, 2, 11552, 11553, 7, 79, 2, 2, 11553, 11554, 7, 71, 2,
+	2, 11554, 11555, 7, 86, 2, 2, 11555, 11556, 7, 84, 2, 2, 11556, 11557,
+	7, 91, 2, 2, 11557, 11558, 7, 72, 2, 2, 11558, 11559, 7, 84, 2, 2, 11559,
+	11560, 7, 81, 2, 2, 11560, 11561, 7, 79, 2, 2, 11561, 11562, 7, 86, 2,
+	2, 11562, 11563, 7, 71, 2, 2, 11563, 11564, 7, 90, 2, 2, 11564, 11565,
+	7, 86, 2, 2, 11565, 1936, 3, 2, 2, 2, 11566, 11567, 7, 85, 2, 2, 11567,
+	11568, 7, 86, 2, 2, 11568, 11569, 7, 97, 2, 2, 11569, 11570, 7, 73, 2,
+	2, 11570, 11571, 7, 71, 2, 2, 11571, 11572, 7, 81, 2, 2, 11572, 11573,
+	7, 79, 2, 2, 11573, 11574, 7, 71, 2, 2, 11574, 11575, 7, 86, 2, 2, 11575,
+	11576, 7, 84, 2, 2, 11576, 11577, 7, 91, 2, 2, 11577, 11578, 7, 72, 2,
+	2, 11578, 11579, 7, 84, 2, 2, 11579, 11580, 7, 81, 2, 2, 11580, 11581,
+	7, 79, 2, 2, 11581, 11582, 7, 89, 2, 2, 11582, 11583, 7, 77, 2, 2, 11583,
+	11584, 7, 68, 2, 2, 11584, 1938, 3, 2, 2, 2, 11585, 11586, 7, 85, 2, 2,
+	11586, 11587, 7, 86, 2, 2, 11587, 11588, 7, 97, 2, 2, 11588, 11589, 7,
+	73, 2, 2, 11589, 11590, 7, 71, 2, 2, 11590, 11591, 7, 81, 2, 2, 11591,
+	11592, 7, 79, 2, 2, 11592, 11593, 7, 71, 2, 2, 11593, 11594, 7, 86, 2,
+	2, 11594, 11595, 7, 84, 2, 2, 11595, 11596, 7, 91, 2, 2, 11596, 11597,
+	7, 80, 2, 2, 11597, 1940, 3, 2, 2, 2, 11598, 11599, 7, 85, 2, 2, 11599,
+	11600, 7, 86, 2, 2, 11600, 11601, 7, 97, 2, 2, 11601, 11602, 7, 73, 2,
+	2, 11602, 11603, 7, 71, 2, 2, 11603, 11604, 7, 81, 2, 2, 11604, 11605,
+	7, 79, 2, 2, 11605, 11606, 7, 71, 2, 2, 11606, 11607, 7, 86, 2, 2, 11607,
+	11608, 7, 84, 2, 2, 11608, 11609, 7, 91, 2, 2, 11609, 11610, 7, 86, 2,
+	2, 11610, 11611, 7, 91, 2, 2, 11611, 11612, 7, 82, 2, 2, 11612, 11613,
+	7, 71, 2, 2, 11613, 1942, 3, 2, 2, 2, 11614, 11615, 7, 85, 2, 2, 11615,
+	11616, 7, 86, 2, 2, 11616, 11617, 7, 97, 2, 2, 11617, 11618, 7, 73, 2,
+	2, 11618, 11619, 7, 71, 2, 2, 11619, 11620, 7, 81, 2, 2, 11620, 11621,
+	7, 79, 2, 2, 11621, 11622, 7, 72, 2, 2, 11622, 11623, 7, 84, 2, 2, 11623,
+	11624, 7, 81, 2, 2, 11624, 11625, 7, 79, 2, 2, 11625, 11626, 7, 86, 2,
+	2, 11626, 11627, 7, 71, 2, 2, 11627, 11628, 7, 90, 2, 2, 11628, 11629,
+	7, 86, 2, 2, 11629, 1944, 3, 2, 2, 2, 11630, 11631, 7, 85, 2, 2, 11631,
+	11632, 7, 86, 2, 2, 11632, 11633, 7, 97, 2, 2, 11633, 11634, 7, 73, 2,
+	2, 11634, 11635, 7, 71, 2, 2, 11635, 11636, 7, 81, 2, 2, 11636, 11637,
+	7, 79, 2, 2, 11637, 11638, 7, 72, 2, 2, 11638, 11639, 7, 84, 2, 2, 11639,
+	11640, 7, 81, 2, 2, 11640, 11641, 7, 79, 2, 2, 11641, 11642, 7, 89, 2,
+	2, 11642, 11643, 7, 77, 2, 2, 11643, 11644, 7, 68, 2, 2, 11644, 1946, 3,
+	2, 2, 2, 11645, 11646, 7, 85, 2, 2, 11646, 11647, 7, 86, 2, 2, 11647, 11648,
+	7, 97, 2, 2, 11648, 11649, 7, 75, 2, 2, 11649, 11650, 7, 80, 2, 2, 11650,
+	11651, 7, 86, 2, 2, 11651, 11652, 7, 71, 2, 2, 11652, 11653, 7, 84, 2,
+	2, 11653, 11654, 7, 75, 2, 2, 11654, 11655, 7, 81, 2, 2, 11655, 11656,
+	7, 84, 2, 2, 11656, 11657, 7, 84, 2, 2, 11657, 11658, 7, 75, 2, 2, 11658,
+	11659, 7, 80, 2, 2, 11659, 11660, 7, 73, 2, 2, 11660, 11661, 7, 80, 2,
+	2, 11661, 1948, 3, 2, 2, 2, 11662, 11663, 7, 85, 2, 2, 11663, 11664, 7,
+	86, 2, 2, 11664, 11665, 7, 97, 2, 2, 11665, 11666, 7, 75, 2, 2, 11666,
+	11667, 7, 80, 2, 2, 11667, 11668, 7, 86, 2, 2, 11668, 11669, 7, 71, 2,
+	2, 11669, 11670, 7, 84, 2, 2, 11670, 11671, 7, 85, 2, 2, 11671, 11672,
+	7, 71, 2, 2, 11672, 11673, 7, 69, 2, 2, 11673, 11674, 7, 86, 2, 2, 11674,
+	11675, 7, 75, 2, 2, 11675, 11676, 7, 81, 2, 2, 11676, 11677, 7, 80, 2,
+	2, 11677, 1950, 3, 2, 2, 2, 11678, 11679, 7, 85, 2, 2, 11679, 11680, 7,
+	86, 2, 2, 11680, 11681, 7, 97, 2, 2, 11681, 11682, 7, 75, 2, 2, 11682,
+	11683, 7, 80, 2, 2, 11683, 11684, 7, 86, 2, 2, 11684, 11685, 7, 71, 2,
+	2, 11685, 11686, 7, 84, 2, 2, 11686, 11687, 7, 85, 2, 2, 11687, 11688,
+	7, 71, 2, 2, 11688, 11689, 7, 69, 2, 2, 11689, 11690, 7, 86, 2, 2, 11690,
+	11691, 7, 85, 2, 2, 11691, 1952, 3, 2, 2, 2, 11692, 11693, 7, 85, 2, 2,
+	11693, 11694, 7, 86, 2, 2, 11694, 11695, 7, 97, 2, 2, 11695, 11696, 7,
+	75, 2, 2, 11696, 11697, 7, 85, 2, 2, 11697, 11698, 7, 69, 2, 2, 11698,
+	11699, 7, 78, 2, 2, 11699, 11700, 7, 81, 2, 2, 11700, 11701, 7, 85, 2,
+	2, 11701, 11702, 7, 71, 2, 2, 11702, 11703, 7, 70, 2, 2, 11703, 1954, 3,
+	2, 2, 2, 11704, 11705, 7, 85, 2, 2, 11705, 11706, 7, 86, 2, 2, 11706, 11707,
+	7, 97, 2, 2, 11707, 11708, 7, 75, 2, 2, 11708, 11709, 7, 85, 2, 2, 11709,
+	11710, 7, 71, 2, 2, 11710, 11711, 7, 79, 2, 2, 11711, 11712, 7, 82, 2,
+	2, 11712, 11713, 7, 86, 2, 2, 11713, 11714, 7, 91, 2, 2, 11714, 1956, 3,
+	2, 2, 2, 11715, 11716, 7, 85, 2, 2, 11716, 11717, 7, 86, 2, 2, 11717, 11718,
+	7, 97, 2, 2, 11718, 11719, 7, 75, 2, 2, 11719, 11720, 7, 85, 2, 2, 11720,
+	11721, 7, 85, 2, 2, 11721, 11722, 7, 75, 2, 2, 11722, 11723, 7, 79, 2,
+	2, 11723, 11724, 7, 82, 2, 2, 11724, 11725, 7, 78, 2, 2, 11725, 11726,
+	7, 71, 2, 2, 11726, 1958, 3, 2, 2, 2, 11727, 11728, 7, 85, 2, 2, 11728,
+	11729, 7, 86, 2, 2, 11729, 11730, 7, 97, 2, 2, 11730, 11731, 7, 78, 2,
+	2, 11731, 11732, 7, 75, 2, 2, 11732, 11733, 7, 80, 2, 2, 11733, 11734,
+	7, 71, 2, 2, 11734, 11735, 7, 72, 2, 2, 11735, 11736, 7, 84, 2, 2, 11736,
+	11737, 7, 81, 2, 2, 11737, 11738, 7, 79, 2, 2, 11738, 11739, 7, 86, 2,
+	2, 11739, 11740, 7, 71, 2, 2, 11740, 11741, 7, 90, 2, 2, 11741, 11742,
+	7, 86, 2, 2, 11742, 1960, 3, 2, 2, 2, 11743, 11744, 7, 85, 2, 2, 11744,
+	11745, 7, 86, 2, 2, 11745, 11746, 7, 97, 2, 2, 11746, 11747, 7, 78, 2,
+	2, 11747, 11748, 7, 75, 2, 2, 11748, 11749, 7, 80, 2, 2, 11749, 11750,
+	7, 71, 2, 2, 11750, 11751, 7, 72, 2, 2, 11751, 11752, 7, 84, 2, 2, 11752,
+	11753, 7, 81, 2, 2, 11753, 11754, 7, 79, 2, 2, 11754, 11755, 7, 89, 2,
+	2, 11755, 11756, 7, 77, 2, 2, 11756, 11757, 7, 68, 2, 2, 11757, 1962, 3,
+	2, 2, 2, 11758, 11759, 7, 85, 2, 2, 11759, 11760, 7, 86, 2, 2, 11760, 11761,
+	7, 97, 2, 2, 11761, 11762, 7, 78, 2, 2, 11762, 11763, 7, 75, 2, 2, 11763,
+	11764, 7, 80, 2, 2, 11764, 11765, 7, 71, 2, 2, 11765, 11766, 7, 85, 2,
+	2, 11766, 11767, 7, 86, 2, 2, 11767, 11768, 7, 84, 2, 2, 11768, 11769,
+	7, 75, 2, 2, 11769, 11770, 7, 80, 2, 2, 11770, 11771, 7, 73, 2, 2, 11771,
+	11772, 7, 72, 2, 2, 11772, 11773, 7, 84, 2, 2, 11773, 11774, 7, 81, 2,
+	2, 11774, 11775, 7, 79, 2, 2, 11775, 11776, 7, 86, 2, 2, 11776, 11777,
+	7, 71, 2, 2, 11777, 11778, 7, 90, 2, 2, 11778, 11779, 7, 86, 2, 2, 11779,
+	1964, 3, 2, 2, 2, 11780, 11781, 7, 85, 2, 2, 11781, 11782, 7, 86, 2, 2,
+	11782, 11783, 7, 97, 2, 2, 11783, 11784, 7, 78, 2, 2, 11784, 11785, 7,
+	75, 2, 2, 11785, 11786, 7, 80, 2, 2, 11786, 11787, 7, 71, 2, 2, 11787,
+	11788, 7, 85, 2, 2, 11788, 11789, 7, 86, 2, 2, 11789, 11790, 7, 84, 2,
+	2, 11790, 11791, 7, 75, 2, 2, 11791, 11792, 7, 80, 2, 2, 11792, 11793,
+	7, 73, 2, 2, 11793, 11794, 7, 72, 2, 2, 11794, 11795, 7, 84, 2, 2, 11795,
+	11796, 7, 81, 2, 2, 11796, 11797, 7, 79, 2, 2, 11797, 11798, 7, 89, 2,
+	2, 11798, 11799, 7, 77, 2, 2, 11799, 11800, 7, 68, 2, 2, 11800, 1966, 3,
+	2, 2, 2, 11801, 11802, 7, 85, 2, 2, 11802, 11803, 7, 86, 2, 2, 11803, 11804,
+	7, 97, 2, 2, 11804, 11805, 7, 80, 2, 2, 11805, 11806, 7, 87, 2, 2, 11806,
+	11807, 7, 79, 2, 2, 11807, 11808, 7, 73, 2, 2, 11808, 11809, 7, 71, 2,
+	2, 11809, 11810, 7, 81, 2, 2, 11810, 11811, 7, 79, 2, 2, 11811, 11812,
+	7, 71, 2, 2, 11812, 11813, 7, 86, 2, 2, 11813, 11814, 7, 84, 2, 2, 11814,
+	11815, 7, 75, 2, 2, 11815, 11816, 7, 71, 2, 2, 11816, 11817, 7, 85, 2,
+	2, 11817, 1968, 3, 2, 2, 2, 11818, 11819, 7, 85, 2, 2, 11819, 11820, 7,
+	86, 2, 2, 11820, 11821, 7, 97, 2, 2, 11821, 11822, 7, 80, 2, 2, 11822,
+	11823, 7, 87, 2, 2, 11823, 11824, 7, 79, 2, 2, 11824, 11825, 7, 75, 2,
+	2, 11825, 11826, 7, 80, 2, 2, 11826, 11827, 7, 86, 2, 2, 11827, 11828,
+	7, 71, 2, 2, 11828, 11829, 7, 84, 2, 2, 11829, 11830, 7, 75, 2, 2, 11830,
+	11831, 7, 81, 2, 2, 11831, 11832, 7, 84, 2, 2, 11832, 11833, 7, 84, 2,
+	2, 11833, 11834, 7, 75, 2, 2, 11834, 11835, 7, 80, 2, 2, 11835, 11836,
+	7, 73, 2, 2, 11836, 1970, 3, 2, 2, 2, 11837, 11838, 7, 85, 2, 2, 11838,
+	11839, 7, 86, 2, 2, 11839, 11840, 7, 97, 2, 2, 11840, 11841, 7, 80, 2,
+	2, 11841, 11842, 7, 87, 2, 2, 11842, 11843, 7, 79, 2, 2, 11843, 11844,
+	7, 75, 2, 2, 11844, 11845, 7, 80, 2, 2, 11845, 11846, 7, 86, 2, 2, 11846,
+	11847, 7, 71, 2, 2, 11847, 11848, 7, 84, 2, 2, 11848, 11849, 7, 75, 2,
+	2, 11849, 11850, 7, 81, 2, 2, 11850, 11851, 7, 84, 2, 2, 11851, 11852,
+	7, 84, 2, 2, 11852, 11853, 7, 75, 2, 2, 11853, 11854, 7, 80, 2, 2, 11854,
+	11855, 7, 73, 2, 2, 11855, 11856, 7, 85, 2, 2, 11856, 1972, 3, 2, 2, 2,
+	11857, 11858, 7, 85, 2, 2, 11858, 11859, 7, 86, 2, 2, 11859, 11860, 7,
+	97, 2, 2, 11860, 11861, 7, 80, 2, 2, 11861, 11862, 7, 87, 2, 2, 11862,
+	11863, 7, 79, 2, 2, 11863, 11864, 7, 82, 2, 2, 11864, 11865, 7, 81, 2,
+	2, 11865, 11866, 7, 75, 2, 2, 11866, 11867, 7, 80, 2, 2, 11867, 11868,
+	7, 86, 2, 2, 11868, 11869, 7, 85, 2, 2, 11869, 1974, 3, 2, 2, 2, 11870,
+	11871, 7, 85, 2, 2, 11871, 11872, 7, 86, 2, 2, 11872, 11873, 7, 97, 2,
+	2, 11873, 11874, 7, 81, 2, 2, 11874, 11875, 7, 88, 2, 2, 11875, 11876,
+	7, 71, 2, 2, 11876, 11877, 7, 84, 2, 2, 11877, 11878, 7, 78, 2, 2, 11878,
+	11879, 7, 67, 2, 2, 11879, 11880, 7, 82, 2, 2, 11880, 11881, 7, 85, 2,
+	2, 11881, 1976, 3, 2, 2, 2, 11882, 11883, 7, 85, 2, 2, 11883, 11884, 7,
+	86, 2, 2, 11884, 11885, 7, 97, 2, 2, 11885, 11886, 7, 82, 2, 2, 11886,
+	11887, 7, 81, 2, 2, 11887, 11888, 7, 75, 2, 2, 11888, 11889, 7, 80, 2,
+	2, 11889, 11890, 7, 86, 2, 2, 11890, 11891, 7, 72, 2, 2, 11891, 11892,
+	7, 84, 2, 2, 11892, 11893, 7, 81, 2, 2, 11893, 11894, 7, 79, 2, 2, 11894,
+	11895, 7, 86, 2, 2, 11895, 11896, 7, 71, 2, 2, 11896, 11897, 7, 90, 2,
+	2, 11897, 11898, 7, 86, 2, 2, 11898, 1978, 3, 2, 2, 2, 11899, 11900, 7,
+	85, 2, 2, 11900, 11901, 7, 86, 2, 2, 11901, 11902, 7, 97, 2, 2, 11902,
+	11903, 7, 82, 2, 2, 11903, 11904, 7, 81, 2, 2, 11904, 11905, 7, 75, 2,
+	2, 11905, 11906, 7, 80, 2, 2, 11906, 11907, 7, 86, 2, 2, 11907, 11908,
+	7, 72, 2, 2, 11908, 11909, 7, 84, 2, 2, 11909, 11910, 7, 81, 2, 2, 11910,
+	11911, 7, 79, 2, 2, 11911, 11912, 7, 89, 2, 2, 11912, 11913, 7, 77, 2,
+	2, 11913, 11914, 7, 68, 2, 2, 11914, 1980, 3, 2, 2, 2, 11915, 11916, 7,
+	85, 2, 2, 11916, 11917, 7, 86, 2, 2, 11917, 11918, 7, 97, 2, 2, 11918,
+	11919, 7, 82, 2, 2, 11919, 11920, 7, 81, 2, 2, 11920, 11921, 7, 75, 2,
+	2, 11921, 11922, 7, 80, 2, 2, 11922, 11923, 7, 86, 2, 2, 11923, 11924,
+	7, 80, 2, 2, 11924, 1982, 3, 2, 2, 2, 11925, 11926, 7, 85, 2, 2, 11926,
+	11927, 7, 86, 2, 2, 11927, 11928, 7, 97, 2, 2, 11928, 11929, 7, 82, 2,
+	2, 11929, 11930, 7, 81, 2, 2, 11930, 11931, 7, 78, 2, 2, 11931, 11932,
+	7, 91, 2, 2, 11932, 11933, 7, 72, 2, 2, 11933, 11934, 7, 84, 2, 2, 11934,
+	11935, 7, 81, 2, 2, 11935, 11936, 7, 79, 2, 2, 11936, 11937, 7, 86, 2,
+	2, 11937, 11938, 7, 71, 2, 2, 11938, 11939, 7, 90, 2, 2, 11939, 11940,
+	7, 86, 2, 2, 11940, 1984, 3, 2, 2, 2, 11941, 11942, 7, 85, 2, 2, 11942,
+	11943, 7, 86, 2, 2, 11943, 11944, 7, 97, 2, 2, 11944, 11945, 7, 82, 2,
+	2, 11945, 11946, 7, 81, 2, 2, 11946, 11947, 7, 78, 2, 2, 11947, 11948,
+	7, 91, 2, 2, 11948, 11949, 7, 72, 2, 2, 11949, 11950, 7, 84, 2, 2, 11950,
+	11951, 7, 81, 2, 2, 11951, 11952, 7, 79, 2, 2, 11952, 11953, 7, 89, 2,
+	2, 11953, 11954, 7, 77, 2, 2, 11954, 11955, 7, 68, 2, 2, 11955, 1986, 3,
+	2, 2, 2, 11956, 11957, 7, 85, 2, 2, 11957, 11958, 7, 86, 2, 2, 11958, 11959,
+	7, 97, 2, 2, 11959, 11960, 7, 82, 2, 2, 11960, 11961, 7, 81, 2, 2, 11961,
+	11962, 7, 78, 2, 2, 11962, 11963, 7, 91, 2, 2, 11963, 11964, 7, 73, 2,
+	2, 11964, 11965, 7, 81, 2, 2, 11965, 11966, 7, 80, 2, 2, 11966, 11967,
+	7, 72, 2, 2, 11967, 11968, 7, 84, 2, 2, 11968, 11969, 7, 81, 2, 2, 11969,
+	11970, 7, 79, 2, 2, 11970, 11971, 7, 86, 2, 2, 11971, 11972, 7, 71, 2,
+	2, 11972, 11973, 7, 90, 2, 2, 11973, 11974, 7, 86, 2, 2, 11974, 1988, 3,
+	2, 2, 2, 11975, 11976, 7, 85, 2, 2, 11976, 11977, 7, 86, 2, 2, 11977, 11978,
+	7, 97, 2, 2, 11978, 11979, 7, 82, 2, 2, 11979, 11980, 7, 81, 2, 2, 11980,
+	11981, 7, 78, 2, 2, 11981, 11982, 7, 91, 2, 2, 11982, 11983, 7, 73, 2,
+	2, 11983, 11984, 7, 81, 2, 2, 11984, 11985, 7, 80, 2, 2, 11985, 11986,
+	7, 72, 2, 2, 11986, 11987, 7, 84, 2, 2, 11987, 11988, 7, 81, 2, 2, 11988,
+	11989, 7, 79, 2, 2, 11989, 11990, 7, 89, 2, 2, 11990, 11991, 7, 77, 2,
+	2, 11991, 11992, 7, 68, 2, 2, 11992, 1990, 3, 2, 2, 2, 11993, 11994, 7,
+	85, 2, 2, 11994, 11995, 7, 86, 2, 2, 11995, 11996, 7, 97, 2, 2, 11996,
+	11997, 7, 85, 2, 2, 11997, 11998, 7, 84, 2, 2, 11998, 11999, 7, 75, 2,
+	2, 11999, 12000, 7, 70, 2, 2, 12000, 1992, 3, 2, 2, 2, 12001, 12002, 7,
+	85, 2, 2, 12002, 12003, 7, 86, 2, 2, 12003, 12004, 7, 97, 2, 2, 12004,
+	12005, 7, 85, 2, 2, 12005, 12006, 7, 86, 2, 2, 12006, 12007, 7, 67, 2,
+	2, 12007, 12008, 7, 84, 2, 2, 12008, 12009, 7, 86, 2, 2, 12009, 12010,
+	7, 82, 2, 2, 12010, 12011, 7, 81, 2, 2, 12011, 12012, 7, 75, 2, 2, 12012,
+	12013, 7, 80, 2, 2, 12013, 12014, 7, 86, 2, 2, 12014, 1994, 3, 2, 2, 2,
+	12015, 12016, 7, 85, 2, 2, 12016, 12017, 7, 86, 2, 2, 12017, 12018, 7,
+	97, 2, 2, 12018, 12019, 7, 85, 2, 2, 12019, 12020, 7, 91, 2, 2, 12020,
+	12021, 7, 79, 2, 2, 12021, 12022, 7, 70, 2, 2, 12022, 12023, 7, 75, 2,
+	2, 12023, 12024, 7, 72, 2, 2, 12024, 12025, 7, 72, 2, 2, 12025, 12026,
+	7, 71, 2, 2, 12026, 12027, 7, 84, 2, 2, 12027, 12028, 7, 71, 2, 2, 12028,
+	12029, 7, 80, 2, 2, 12029, 12030, 7, 69, 2, 2, 12030, 12031, 7, 71, 2,
+	2, 12031, 1996, 3, 2, 2, 2, 12032, 12033, 7, 85, 2, 2, 12033, 12034, 7,
+	86, 2, 2, 12034, 12035, 7, 97, 2, 2, 12035, 12036, 7, 86, 2, 2, 12036,
+	12037, 7, 81, 2, 2, 12037, 12038, 7, 87, 2, 2, 12038, 12039, 7, 69, 2,
+	2, 12039, 12040, 7, 74, 2, 2, 12040, 12041, 7, 71, 2, 2, 12041, 12042,
+	7, 85, 2, 2, 12042, 1998, 3, 2, 2, 2, 12043, 12044, 7, 85, 2, 2, 12044,
+	12045, 7, 86, 2, 2, 12045, 12046, 7, 97, 2, 2, 12046, 12047, 7, 87, 2,
+	2, 12047, 12048, 7, 80, 2, 2, 12048, 12049, 7, 75, 2, 2, 12049, 12050,
+	7, 81, 2, 2, 12050, 12051, 7, 80, 2, 2, 12051, 2000, 3, 2, 2, 2, 12052,
+	12053, 7, 85, 2, 2, 12053, 12054, 7, 86, 2, 2, 12054, 12055, 7, 97, 2,
+	2, 12055, 12056, 7, 89, 2, 2, 12056, 12057, 7, 75, 2, 2, 12057, 12058,
+	7, 86, 2, 2, 12058, 12059, 7, 74, 2, 2, 12059, 12060, 7, 75, 2, 2, 12060,
+	12061, 7, 80, 2, 2, 12061, 2002, 3, 2, 2, 2, 12062, 12063, 7, 85, 2, 2,
+	12063, 12064, 7, 86, 2, 2, 12064, 12065, 7, 97, 2, 2, 12065, 12066, 7,
+	90, 2, 2, 12066, 2004, 3, 2, 2, 2, 12067, 12068, 7, 85, 2, 2, 12068, 12069,
+	7, 86, 2, 2, 12069, 12070, 7, 97, 2, 2, 12070, 12071, 7, 91, 2, 2, 12071,
+	2006, 3, 2, 2, 2, 12072, 12073, 7, 85, 2, 2, 12073, 12074, 7, 87, 2, 2,
+	12074, 12075, 7, 68, 2, 2, 12075, 12076, 7, 70, 2, 2, 12076, 12077, 7,
+	67, 2, 2, 12077, 12078, 7, 86, 2, 2, 12078, 12079, 7, 71, 2, 2, 12079,
+	2008, 3, 2, 2, 2, 12080, 12081, 7, 85, 2, 2, 12081, 12082, 7, 87, 2, 2,
+	12082, 12083, 7, 68, 2, 2, 12083, 12084, 7, 85, 2, 2, 12084, 12085, 7,
+	86, 2, 2, 12085, 12086, 7, 84, 2, 2, 12086, 12087, 7, 75, 2, 2, 12087,
+	12088, 7, 80, 2, 2, 12088, 12089, 7, 73, 2, 2, 12089, 12090, 7, 97, 2,
+	2, 12090, 12091, 7, 75, 2, 2, 12091, 12092, 7, 80, 2, 2, 12092, 12093,
+	7, 70, 2, 2, 12093, 12094, 7, 71, 2, 2, 12094, 12095, 7, 90, 2, 2, 12095,
+	2010, 3, 2, 2, 2, 12096, 12097, 7, 85, 2, 2, 12097, 12098, 7, 87, 2, 2,
+	12098, 12099, 7, 68, 2, 2, 12099, 12100, 7, 86, 2, 2, 12100, 12101, 7,
+	75, 2, 2, 12101, 12102, 7, 79, 2, 2, 12102, 12103, 7, 71, 2, 2, 12103,
+	2012, 3, 2, 2, 2, 12104, 12105, 7, 85, 2, 2, 12105, 12106, 7, 91, 2, 2,
+	12106, 12107, 7, 85, 2, 2, 12107, 12108, 7, 86, 2, 2, 12108, 12109, 7,
+	71, 2, 2, 12109, 12110, 7, 79, 2, 2, 12110, 12111, 7, 97, 2, 2, 12111,
+	12112, 7, 87, 2, 2, 12112, 12113, 7, 85, 2, 2, 12113, 12114, 7, 71, 2,
+	2, 12114, 12115, 7, 84, 2, 2, 12115, 2014, 3, 2, 2, 2, 12116, 12117, 7,
+	86, 2, 2, 12117, 12118, 7, 67, 2, 2, 12118, 12119, 7, 80, 2, 2, 12119,
+	2016, 3, 2, 2, 2, 12120, 12121, 7, 86, 2, 2, 12121, 12122, 7, 75, 2, 2,
+	12122, 12123, 7, 79, 2, 2, 12123, 12124, 7, 71, 2, 2, 12124, 12125, 7,
+	70, 2, 2, 12125, 12126, 7, 75, 2, 2, 12126, 12127, 7, 72, 2, 2, 12127,
+	12128, 7, 72, 2, 2, 12128, 2018, 3, 2, 2, 2, 12129, 12130, 7, 86, 2, 2,
+	12130, 12131, 7, 75, 2, 2, 12131, 12132, 7, 79, 2, 2, 12132, 12133, 7,
+	71, 2, 2, 12133, 12134, 7, 85, 2, 2, 12134, 12135, 7, 86, 2, 2, 12135,
+	12136, 7, 67, 2, 2, 12136, 12137, 7, 79, 2, 2, 12137, 12138, 7, 82, 2,
+	2, 12138, 12139, 7, 67, 2, 2, 12139, 12140, 7, 70, 2, 2, 12140, 12141,
+	7, 70, 2, 2, 12141, 2020, 3, 2, 2, 2, 12142, 12143, 7, 86, 2, 2, 12143,
+	12144, 7, 75, 2, 2, 12144, 12145, 7, 79, 2, 2, 12145, 12146, 7, 71, 2,
+	2, 12146, 12147, 7, 85, 2, 2, 12147, 12148, 7, 86, 2, 2, 12148, 12149,
+	7, 67, 2, 2, 12149, 12150, 7, 79, 2, 2, 12150, 12151, 7, 82, 2, 2, 12151,
+	12152, 7, 70, 2, 2, 12152, 12153, 7, 75, 2, 2, 12153, 12154, 7, 72, 2,
+	2, 12154, 12155, 7, 72, 2, 2, 12155, 2022, 3, 2, 2, 2, 12156, 12157, 7,
+	86, 2, 2, 12157, 12158, 7, 75, 2, 2, 12158, 12159, 7, 79, 2, 2, 12159,
+	12160, 7, 71, 2, 2, 12160, 12161, 7, 97, 2, 2, 12161, 12162, 7, 72, 2,
+	2, 12162, 12163, 7, 81, 2, 2, 12163, 12164, 7, 84, 2, 2, 12164, 12165,
+	7, 79, 2, 2, 12165, 12166, 7, 67, 2, 2, 12166, 12167, 7, 86, 2, 2, 12167,
+	2024, 3, 2, 2, 2, 12168, 12169, 7, 86, 2, 2, 12169, 12170, 7, 75, 2, 2,
+	12170, 12171, 7, 79, 2, 2, 12171, 12172, 7, 71, 2, 2, 12172, 12173, 7,
+	97, 2, 2, 12173, 12174, 7, 86, 2, 2, 12174, 12175, 7, 81, 2, 2, 12175,
+	12176, 7, 97, 2, 2, 12176, 12177, 7, 85, 2, 2, 12177, 12178, 7, 71, 2,
+	2, 12178, 12179, 7, 69, 2, 2, 12179, 2026, 3, 2, 2, 2, 12180, 12181, 7,
+	86, 2, 2, 12181, 12182, 7, 81, 2, 2, 12182, 12183, 7, 87, 2, 2, 12183,
+	12184, 7, 69, 2, 2, 12184, 12185, 7, 74, 2, 2, 12185, 12186, 7, 71, 2,
+	2, 12186, 12187, 7, 85, 2, 2, 12187, 2028, 3, 2, 2, 2, 12188, 12189, 7,
 	86, 2, 2, 12189, 12190, 7, 81, 2, 2, 12190, 12191, 7, 97, 2, 2, 12191,
-	12192, 7, 70, 2, 2, 12192, 12193, 7, 67, 2, 2, 12193, 12194, 7, 91, 2,
-	2, 12194, 12195, 7, 85, 2, 2, 12195, 2030, 3, 2, 2, 2, 12196, 12197, 7,
-	86, 2, 2, 12197, 12198, 7, 81, 2, 2, 12198, 12199, 7, 97, 2, 2, 12199,
-	12200, 7, 85, 2, 2, 12200, 12201, 7, 71, 2, 2, 12201, 12202, 7, 69, 2,
-	2, 12202, 12203, 7, 81, 2, 2, 12203, 12204, 7, 80, 2, 2, 12204, 12205,
-	7, 70, 2, 2, 12205, 12206, 7, 85, 2, 2, 12206, 2032, 3, 2, 2, 2, 12207,
-	12208, 7, 87, 2, 2, 12208, 12209, 7, 69, 2, 2, 12209, 12210, 7, 67, 2,
-	2, 12210, 12211, 7, 85, 2, 2, 12211, 12212, 7, 71, 2, 2, 12212, 2034, 3,
-	2, 2, 2, 12213, 12214, 7, 87, 2, 2, 12214, 12215, 7, 80, 2, 2, 12215, 12216,
-	7, 69, 2, 2, 12216, 12217, 7, 81, 2, 2, 12217, 12218, 7, 79, 2, 2, 12218,
-	12219, 7, 82, 2, 2, 12219, 12220, 7, 84, 2, 2, 12220, 12221, 7, 71, 2,
-	2, 12221, 12222, 7, 85, 2, 2, 12222, 12223, 7, 85, 2, 2, 12223, 2036, 3,
-	2, 2, 2, 12224, 12225, 7, 87, 2, 2, 12225, 12226, 7, 80, 2, 2, 12226, 12227,
-	7, 69, 2, 2, 12227, 12228, 7, 81, 2, 2, 12228, 12229, 7, 79, 2, 2, 12229,
-	12230, 7, 82, 2, 2, 12230, 12231, 7, 84, 2, 2, 12231, 12232, 7, 71, 2,
-	2, 12232, 12233, 7, 85, 2, 2, 12233, 12234, 7, 85, 2, 2, 12234, 12235,
-	7, 71, 2, 2, 12235, 12236, 7, 70, 2, 2, 12236, 12237, 7, 97, 2, 2, 12237,
-	12238, 7, 78, 2, 2, 12238, 12239, 7, 71, 2, 2, 12239, 12240, 7, 80, 2,
-	2, 12240, 12241, 7, 73, 2, 2, 12241, 12242, 7, 86, 2, 2, 12242, 12243,
-	7, 74, 2, 2, 12243, 2038, 3, 2, 2, 2, 12244, 12245, 7, 87, 2, 2, 12245,
-	12246, 7, 80, 2, 2, 12246, 12247, 7, 74, 2, 2, 12247, 12248, 7, 71, 2,
-	2, 12248, 12249, 7, 90, 2, 2, 12249, 2040, 3, 2, 2, 2, 12250, 12251, 7,
-	87, 2, 2, 12251, 12252, 7, 80, 2, 2, 12252, 12253, 7, 75, 2, 2, 12253,
-	12254, 7, 90, 2, 2, 12254, 12255, 7, 97, 2, 2, 12255, 12256, 7, 86, 2,
-	2, 12256, 12257, 7, 75, 2, 2, 12257, 12258, 7, 79, 2, 2, 12258, 12259,
-	7, 71, 2, 2, 12259, 12260, 7, 85, 2, 2, 12260, 12261, 7, 86, 2, 2, 12261,
-	12262, 7, 67, 2, 2, 12262, 12263, 7, 79, 2, 2, 12263, 12264, 7, 82, 2,
-	2, 12264, 2042, 3, 2, 2, 2, 12265, 12266, 7, 87, 2, 2, 12266, 12267, 7,
-	82, 2, 2, 12267, 12268, 7, 70, 2, 2, 12268, 12269, 7, 67, 2, 2, 12269,
-	12270, 7, 86, 2, 2, 12270, 12271, 7, 71, 2, 2, 12271, 12272, 7, 90, 2,
-	2, 12272, 12273, 7, 79, 2, 2, 12273, 12274, 7, 78, 2, 2, 12274, 2044, 3,
-	2, 2, 2, 12275, 12276, 7, 87, 2, 2, 12276, 12277, 7, 82, 2, 2, 12277, 12278,
-	7, 82, 2, 2, 12278, 12279, 7, 71, 2, 2, 12279, 12280, 7, 84, 2, 2, 12280,
-	2046, 3, 2, 2, 2, 12281, 12282, 7, 87, 2, 2, 12282, 12283, 7, 87, 2, 2,
-	12283, 12284, 7, 75, 2, 2, 12284, 12285, 7, 70, 2, 2, 12285, 2048, 3, 2,
-	2, 2, 12286, 12287, 7, 87, 2, 2, 12287, 12288, 7, 87, 2, 2, 12288, 12289,
-	7, 75, 2, 2, 12289, 12290, 7, 70, 2, 2, 12290, 12291, 7, 97, 2, 2, 12291,
-	12292, 7, 85, 2, 2, 12292, 12293, 7, 74, 2, 2, 12293, 12294, 7, 81, 2,
-	2, 12294, 12295, 7, 84, 2, 2, 12295, 12296, 7, 86, 2, 2, 12296, 2050, 3,
-	2, 2, 2, 12297, 12298, 7, 88, 2, 2, 12298, 12299, 7, 67, 2, 2, 12299, 12300,
-	7, 78, 2, 2, 12300, 12301, 7, 75, 2, 2, 12301, 12302, 7, 70, 2, 2, 12302,
-	12303, 7, 67, 2, 2, 12303, 12304, 7, 86, 2, 2, 12304, 12305, 7, 71, 2,
-	2, 12305, 12306, 7, 97, 2, 2, 12306, 12307, 7, 82, 2, 2, 12307, 12308,
-	7, 67, 2, 2, 12308, 12309, 7, 85, 2, 2, 12309, 12310, 7, 85, 2, 2, 12310,
-	12311, 7, 89, 2, 2, 12311, 12312, 7, 81, 2, 2, 12312, 12313, 7, 84, 2,
-	2, 12313, 12314, 7, 70, 2, 2, 12314, 12315, 7, 97, 2, 2, 12315, 12316,
-	7, 85, 2, 2, 12316, 12317, 7, 86, 2, 2, 12317, 12318, 7, 84, 2, 2, 12318,
-	12319, 7, 71, 2, 2, 12319, 12320, 7, 80, 2, 2, 12320, 12321, 7, 73, 2,
-	2, 12321, 12322, 7, 86, 2, 2, 12322, 12323, 7, 74, 2, 2, 12323, 2052, 3,
-	2, 2, 2, 12324, 12325, 7, 88, 2, 2, 12325, 12326, 7, 71, 2, 2, 12326, 12327,
-	7, 84, 2, 2, 12327, 12328, 7, 85, 2, 2, 12328, 12329, 7, 75, 2, 2, 12329,
-	12330, 7, 81, 2, 2, 12330, 12331, 7, 80, 2, 2, 12331, 2054, 3, 2, 2, 2,
-	12332, 12333, 7, 89, 2, 2, 12333, 12334, 7, 67, 2, 2, 12334, 12335, 7,
-	75, 2, 2, 12335, 12336, 7, 86, 2, 2, 12336, 12337, 7, 97, 2, 2, 12337,
-	12338, 7, 87, 2, 2, 12338, 12339, 7, 80, 2, 2, 12339, 12340, 7, 86, 2,
-	2, 12340, 12341, 7, 75, 2, 2, 12341, 12342, 7, 78, 2, 2, 12342, 12343,
-	7, 97, 2, 2, 12343, 12344, 7, 85, 2, 2, 12344, 12345, 7, 83, 2, 2, 12345,
-	12346, 7, 78, 2, 2, 12346, 12347, 7, 97, 2, 2, 12347, 12348, 7, 86, 2,
-	2, 12348, 12349, 7, 74, 2, 2, 12349, 12350, 7, 84, 2, 2, 12350, 12351,
-	7, 71, 2, 2, 12351, 12352, 7, 67, 2, 2, 12352, 12353, 7, 70, 2, 2, 12353,
-	12354, 7, 97, 2, 2, 12354, 12355, 7, 67, 2, 2, 12355, 12356, 7, 72, 2,
-	2, 12356, 12357, 7, 86, 2, 2, 12357, 12358, 7, 71, 2, 2, 12358, 12359,
-	7, 84, 2, 2, 12359, 12360, 7, 97, 2, 2, 12360, 12361, 7, 73, 2, 2, 12361,
-	12362, 7, 86, 2, 2, 12362, 12363, 7, 75, 2, 2, 12363, 12364, 7, 70, 2,
-	2, 12364, 12365, 7, 85, 2, 2, 12365, 2056, 3, 2, 2, 2, 12366, 12367, 7,
-	89, 2, 2, 12367, 12368, 7, 71, 2, 2, 12368, 12369, 7, 71, 2, 2, 12369,
-	12370, 7, 77, 2, 2, 12370, 12371, 7, 70, 2, 2, 12371, 12372, 7, 67, 2,
-	2, 12372, 12373, 7, 91, 2, 2, 12373, 2058, 3, 2, 2, 2, 12374, 12375, 7,
-	89, 2, 2, 12375, 12376, 7, 71, 2, 2, 12376, 12377, 7, 71, 2, 2, 12377,
-	12378, 7, 77, 2, 2, 12378, 12379, 7, 81, 2, 2, 12379, 12380, 7, 72, 2,
-	2, 12380, 12381, 7, 91, 2, 2, 12381, 12382, 7, 71, 2, 2, 12382, 12383,
-	7, 67, 2, 2, 12383, 12384, 7, 84, 2, 2, 12384, 2060, 3, 2, 2, 2, 12385,
-	12386, 7, 89, 2, 2, 12386, 12387, 7, 71, 2, 2, 12387, 12388, 7, 75, 2,
-	2, 12388, 12389, 7, 73, 2, 2, 12389, 12390, 7, 74, 2, 2, 12390, 12391,
-	7, 86, 2, 2, 12391, 12392, 7, 97, 2, 2, 12392, 12393, 7, 85, 2, 2, 12393,
-	12394, 7, 86, 2, 2, 12394, 12395, 7, 84, 2, 2, 12395, 12396, 7, 75, 2,
-	2, 12396, 12397, 7, 80, 2, 2, 12397, 12398, 7, 73, 2, 2, 12398, 2062, 3,
-	2, 2, 2, 12399, 12400, 7, 89, 2, 2, 12400, 12401, 7, 75, 2, 2, 12401, 12402,
-	7, 86, 2, 2, 12402, 12403, 7, 74, 2, 2, 12403, 12404, 7, 75, 2, 2, 12404,
-	12405, 7, 80, 2, 2, 12405, 2064, 3, 2, 2, 2, 12406, 12407, 7, 91, 2, 2,
-	12407, 12408, 7, 71, 2, 2, 12408, 12409, 7, 67, 2, 2, 12409, 12410, 7,
-	84, 2, 2, 12410, 12411, 7, 89, 2, 2, 12411, 12412, 7, 71, 2, 2, 12412,
-	12413, 7, 71, 2, 2, 12413, 12414, 7, 77, 2, 2, 12414, 2066, 3, 2, 2, 2,
-	12415, 12416, 7, 91, 2, 2, 12416, 2068, 3, 2, 2, 2, 12417, 12418, 7, 90,
-	2, 2, 12418, 2070, 3, 2, 2, 2, 12419, 12420, 7, 60, 2, 2, 12420, 12421,
-	7, 63, 2, 2, 12421, 2072, 3, 2, 2, 2, 12422, 12423, 7, 45, 2, 2, 12423,
-	12424, 7, 63, 2, 2, 12424, 2074, 3, 2, 2, 2, 12425, 12426, 7, 47, 2, 2,
-	12426, 12427, 7, 63, 2, 2, 12427, 2076, 3, 2, 2, 2, 12428, 12429, 7, 44,
-	2, 2, 12429, 12430, 7, 63, 2, 2, 12430, 2078, 3, 2, 2, 2, 12431, 12432,
-	7, 49, 2, 2, 12432, 12433, 7, 63, 2, 2, 12433, 2080, 3, 2, 2, 2, 12434,
-	12435, 7, 39, 2, 2, 12435, 12436, 7, 63, 2, 2, 12436, 2082, 3, 2, 2, 2,
-	12437, 12438, 7, 40, 2, 2, 12438, 12439, 7, 63, 2, 2, 12439, 2084, 3, 2,
-	2, 2, 12440, 12441, 7, 96, 2, 2, 12441, 12442, 7, 63, 2, 2, 12442, 2086,
-	3, 2, 2, 2, 12443, 12444, 7, 126, 2, 2, 12444, 12445, 7, 63, 2, 2, 12445,
-	2088, 3, 2, 2, 2, 12446, 12447, 7, 44, 2, 2, 12447, 2090, 3, 2, 2, 2, 12448,
-	12449, 7, 49, 2, 2, 12449, 2092, 3, 2, 2, 2, 12450, 12451, 7, 39, 2, 2,
-	12451, 2094, 3, 2, 2, 2, 12452, 12453, 7, 45, 2, 2, 12453, 2096, 3, 2,
-	2, 2, 12454, 12455, 7, 47, 2, 2, 12455, 12456, 7, 47, 2, 2, 12456, 2098,
-	3, 2, 2, 2, 12457, 12458, 7, 47, 2, 2, 12458, 2100, 3, 2, 2, 2, 12459,
-	12460, 7, 70, 2, 2, 12460, 12461, 7, 75, 2, 2, 12461, 12462, 7, 88, 2,
-	2, 12462, 2102, 3, 2, 2, 2, 12463, 12464, 7, 79, 2, 2, 12464, 12465, 7,
-	81, 2, 2, 12465, 12466, 7, 70, 2, 2, 12466, 2104, 3, 2, 2, 2, 12467, 12468,
-	7, 63, 2, 2, 12468, 2106, 3, 2, 2, 2, 12469, 12470, 7, 64, 2, 2, 12470,
-	2108, 3, 2, 2, 2, 12471, 12472, 7, 62, 2, 2, 12472, 2110, 3, 2, 2, 2, 12473,
-	12474, 7, 35, 2, 2, 12474, 2112, 3, 2, 2, 2, 12475, 12476, 7, 128, 2, 2,
-	12476, 2114, 3, 2, 2, 2, 12477, 12478, 7, 126, 2, 2, 12478, 2116, 3, 2,
-	2, 2, 12479, 12480, 7, 40, 2, 2, 12480, 2118, 3, 2, 2, 2, 12481, 12482,
-	7, 96, 2, 2, 12482, 2120, 3, 2, 2, 2, 12483, 12484, 7, 48, 2, 2, 12484,
-	2122, 3, 2, 2, 2, 12485, 12486, 7, 42, 2, 2, 12486, 2124, 3, 2, 2, 2, 12487,
-	12488, 7, 43, 2, 2, 12488, 2126, 3, 2, 2, 2, 12489, 12490, 7, 46, 2, 2,
-	12490, 2128, 3, 2, 2, 2, 12491, 12492, 7, 61, 2, 2, 12492, 2130, 3, 2,
-	2, 2, 12493, 12494, 7, 66, 2, 2, 12494, 2132, 3, 2, 2, 2, 12495, 12496,
-	7, 50, 2, 2, 12496, 2134, 3, 2, 2, 2, 12497, 12498, 7, 51, 2, 2, 12498,
-	2136, 3, 2, 2, 2, 12499, 12500, 7, 52, 2, 2, 12500, 2138, 3, 2, 2, 2, 12501,
-	12502, 7, 41, 2, 2, 12502, 2140, 3, 2, 2, 2, 12503, 12504, 7, 36, 2, 2,
-	12504, 2142, 3, 2, 2, 2, 12505, 12506, 7, 98, 2, 2, 12506, 2144, 3, 2,
-	2, 2, 12507, 12508, 7, 60, 2, 2, 12508, 2146, 3, 2, 2, 2, 12509, 12513,
-	5, 2139, 1070, 2, 12510, 12513, 5, 2141, 1071, 2, 12511, 12513, 5, 2143,
-	1072, 2, 12512, 12509, 3, 2, 2, 2, 12512, 12510, 3, 2, 2, 2, 12512, 12511,
-	3, 2, 2, 2, 12513, 2148, 3, 2, 2, 2, 12514, 12515, 7, 98, 2, 2, 12515,
-	12516, 5, 2183, 1092, 2, 12516, 12517, 7, 98, 2, 2, 12517, 2150, 3, 2,
-	2, 2, 12518, 12520, 5, 2197, 1099, 2, 12519, 12518, 3, 2, 2, 2, 12520,
-	12521, 3, 2, 2, 2, 12521, 12519, 3, 2, 2, 2, 12521, 12522, 3, 2, 2, 2,
-	12522, 12523, 3, 2, 2, 2, 12523, 12524, 9, 5, 2, 2, 12524, 2152, 3, 2,
-	2, 2, 12525, 12526, 7, 80, 2, 2, 12526, 12527, 5, 2191, 1096, 2, 12527,
-	2154, 3, 2, 2, 2, 12528, 12532, 5, 2189, 1095, 2, 12529, 12532, 5, 2191,
-	1096, 2, 12530, 12532, 5, 2193, 1097, 2, 12531, 12528, 3, 2, 2, 2, 12531,
-	12529, 3, 2, 2, 2, 12531, 12530, 3, 2, 2, 2, 12532, 2156, 3, 2, 2, 2, 12533,
-	12535, 5, 2197, 1099, 2, 12534, 12533, 3, 2, 2, 2, 12535, 12536, 3, 2,
-	2, 2, 12536, 12534, 3, 2, 2, 2, 12536, 12537, 3, 2, 2, 2, 12537, 2158,
-	3, 2, 2, 2, 12538, 12539, 7, 90, 2, 2, 12539, 12543, 7, 41, 2, 2, 12540,
-	12541, 5, 2195, 1098, 2, 12541, 12542, 5, 2195, 1098, 2, 12542, 12544,
-	3, 2, 2, 2, 12543, 12540, 3, 2, 2, 2, 12544, 12545, 3, 2, 2, 2, 12545,
-	12543, 3, 2, 2, 2, 12545, 12546, 3, 2, 2, 2, 12546, 12547, 3, 2, 2, 2,
-	12547, 12548, 7, 41, 2, 2, 12548, 12558, 3, 2, 2, 2, 12549, 12550, 7, 50,
-	2, 2, 12550, 12551, 7, 90, 2, 2, 12551, 12553, 3, 2, 2, 2, 12552, 12554,
-	5, 2195, 1098, 2, 12553, 12552, 3, 2, 2, 2, 12554, 12555, 3, 2, 2, 2, 12555,
-	12553, 3, 2, 2, 2, 12555, 12556, 3, 2, 2, 2, 12556, 12558, 3, 2, 2, 2,
-	12557, 12538, 3, 2, 2, 2, 12557, 12549, 3, 2, 2, 2, 12558, 2160, 3, 2,
-	2, 2, 12559, 12561, 5, 2197, 1099, 2, 12560, 12559, 3, 2, 2, 2, 12561,
-	12562, 3, 2, 2, 2, 12562, 12560, 3, 2, 2, 2, 12562, 12563, 3, 2, 2, 2,
-	12563, 12565, 3, 2, 2, 2, 12564, 12560, 3, 2, 2, 2, 12564, 12565, 3, 2,
-	2, 2, 12565, 12566, 3, 2, 2, 2, 12566, 12568, 7, 48, 2, 2, 12567, 12569,
-	5, 2197, 1099, 2, 12568, 12567, 3, 2, 2, 2, 12569, 12570, 3, 2, 2, 2, 12570,
-	12568, 3, 2, 2, 2, 12570, 12571, 3, 2, 2, 2, 12571, 12603, 3, 2, 2, 2,
-	12572, 12574, 5, 2197, 1099, 2, 12573, 12572, 3, 2, 2, 2, 12574, 12575,
-	3, 2, 2, 2, 12575, 12573, 3, 2, 2, 2, 12575, 12576, 3, 2, 2, 2, 12576,
-	12577, 3, 2, 2, 2, 12577, 12578, 7, 48, 2, 2, 12578, 12579, 5, 2185, 1093,
-	2, 12579, 12603, 3, 2, 2, 2, 12580, 12582, 5, 2197, 1099, 2, 12581, 12580,
-	3, 2, 2, 2, 12582, 12583, 3, 2, 2, 2, 12583, 12581, 3, 2, 2, 2, 12583,
-	12584, 3, 2, 2, 2, 12584, 12586, 3, 2, 2, 2, 12585, 12581, 3, 2, 2, 2,
-	12585, 12586, 3, 2, 2, 2, 12586, 12587, 3, 2, 2, 2, 12587, 12589, 7, 48,
-	2, 2, 12588, 12590, 5, 2197, 1099, 2, 12589, 12588, 3, 2, 2, 2, 12590,
-	12591, 3, 2, 2, 2, 12591, 12589, 3, 2, 2, 2, 12591, 12592, 3, 2, 2, 2,
-	12592, 12593, 3, 2, 2, 2, 12593, 12594, 5, 2185, 1093, 2, 12594, 12603,
-	3, 2, 2, 2, 12595, 12597, 5, 2197, 1099, 2, 12596, 12595, 3, 2, 2, 2, 12597,
-	12598, 3, 2, 2, 2, 12598, 12596, 3, 2, 2, 2, 12598, 12599, 3, 2, 2, 2,
-	12599, 12600, 3, 2, 2, 2, 12600, 12601, 5, 2185, 1093, 2, 12601, 12603,
-	3, 2, 2, 2, 12602, 12564, 3, 2, 2, 2, 12602, 12573, 3, 2, 2, 2, 12602,
-	12585, 3, 2, 2, 2, 12602, 12596, 3, 2, 2, 2, 12603, 2162, 3, 2, 2, 2, 12604,
-	12605, 7, 94, 2, 2, 12605, 12606, 7, 80, 2, 2, 12606, 2164, 3, 2, 2, 2,
-	12607, 12608, 5, 2199, 1100, 2, 12608, 2166, 3, 2, 2, 2, 12609, 12610,
-	7, 97, 2, 2, 12610, 12611, 5, 2183, 1092, 2, 12611, 2168, 3, 2, 2, 2, 12612,
-	12613, 7, 48, 2, 2, 12613, 12614, 5, 2187, 1094, 2, 12614, 2170, 3, 2,
-	2, 2, 12615, 12616, 5, 2187, 1094, 2, 12616, 2172, 3, 2, 2, 2, 12617, 12619,
-	7, 98, 2, 2, 12618, 12620, 10, 6, 2, 2, 12619, 12618, 3, 2, 2, 2, 12620,
-	12621, 3, 2, 2, 2, 12621, 12619, 3, 2, 2, 2, 12621, 12622, 3, 2, 2, 2,
-	12622, 12623, 3, 2, 2, 2, 12623, 12624, 7, 98, 2, 2, 12624, 2174, 3, 2,
-	2, 2, 12625, 12630, 5, 2191, 1096, 2, 12626, 12630, 5, 2189, 1095, 2, 12627,
-	12630, 5, 2193, 1097, 2, 12628, 12630, 5, 2187, 1094, 2, 12629, 12625,
-	3, 2, 2, 2, 12629, 12626, 3, 2, 2, 2, 12629, 12627, 3, 2, 2, 2, 12629,
-	12628, 3, 2, 2, 2, 12630, 12631, 3, 2, 2, 2, 12631, 12637, 7, 66, 2, 2,
-	12632, 12638, 5, 2191, 1096, 2, 12633, 12638, 5, 2189, 1095, 2, 12634,
-	12638, 5, 2193, 1097, 2, 12635, 12638, 5, 2187, 1094, 2, 12636, 12638,
-	5, 2177, 1089, 2, 12637, 12632, 3, 2, 2, 2, 12637, 12633, 3, 2, 2, 2, 12637,
-	12634, 3, 2, 2, 2, 12637, 12635, 3, 2, 2, 2, 12637, 12636, 3, 2, 2, 2,
-	12638, 2176, 3, 2, 2, 2, 12639, 12641, 9, 7, 2, 2, 12640, 12639, 3, 2,
-	2, 2, 12641, 12642, 3, 2, 2, 2, 12642, 12640, 3, 2, 2, 2, 12642, 12643,
-	3, 2, 2, 2, 12643, 12644, 3, 2, 2, 2, 12644, 12646, 7, 48, 2, 2, 12645,
-	12647, 9, 8, 2, 2, 12646, 12645, 3, 2, 2, 2, 12647, 12648, 3, 2, 2, 2,
-	12648, 12646, 3, 2, 2, 2, 12648, 12649, 3, 2, 2, 2, 12649, 12662, 3, 2,
-	2, 2, 12650, 12652, 9, 9, 2, 2, 12651, 12650, 3, 2, 2, 2, 12652, 12653,
-	3, 2, 2, 2, 12653, 12651, 3, 2, 2, 2, 12653, 12654, 3, 2, 2, 2, 12654,
-	12655, 3, 2, 2, 2, 12655, 12657, 7, 60, 2, 2, 12656, 12658, 9, 9, 2, 2,
-	12657, 12656, 3, 2, 2, 2, 12658, 12659, 3, 2, 2, 2, 12659, 12657, 3, 2,
-	2, 2, 12659, 12660, 3, 2, 2, 2, 12660, 12662, 3, 2, 2, 2, 12661, 12640,
-	3, 2, 2, 2, 12661, 12651, 3, 2, 2, 2, 12662, 2178, 3, 2, 2, 2, 12663, 12672,
-	7, 66, 2, 2, 12664, 12666, 9, 10, 2, 2, 12665, 12664, 3, 2, 2, 2, 12666,
-	12667, 3, 2, 2, 2, 12667, 12665, 3, 2, 2, 2, 12667, 12668, 3, 2, 2, 2,
-	12668, 12673, 3, 2, 2, 2, 12669, 12673, 5, 2191, 1096, 2, 12670, 12673,
-	5, 2189, 1095, 2, 12671, 12673, 5, 2193, 1097, 2, 12672, 12665, 3, 2, 2,
-	2, 12672, 12669, 3, 2, 2, 2, 12672, 12670, 3, 2, 2, 2, 12672, 12671, 3,
-	2, 2, 2, 12673, 2180, 3, 2, 2, 2, 12674, 12675, 7, 66, 2, 2, 12675, 12682,
-	7, 66, 2, 2, 12676, 12678, 9, 10, 2, 2, 12677, 12676, 3, 2, 2, 2, 12678,
-	12679, 3, 2, 2, 2, 12679, 12677, 3, 2, 2, 2, 12679, 12680, 3, 2, 2, 2,
-	12680, 12683, 3, 2, 2, 2, 12681, 12683, 5, 2193, 1097, 2, 12682, 12677,
-	3, 2, 2, 2, 12682, 12681, 3, 2, 2, 2, 12683, 2182, 3, 2, 2, 2, 12684, 12726,
-	5, 1357, 679, 2, 12685, 12726, 5, 1359, 680, 2, 12686, 12726, 5, 1361,
-	681, 2, 12687, 12726, 5, 421, 211, 2, 12688, 12726, 5, 1363, 682, 2, 12689,
-	12726, 5, 1365, 683, 2, 12690, 12726, 5, 1367, 684, 2, 12691, 12726, 5,
-	1369, 685, 2, 12692, 12726, 5, 1371, 686, 2, 12693, 12726, 5, 1373, 687,
-	2, 12694, 12726, 5, 1375, 688, 2, 12695, 12726, 5, 1377, 689, 2, 12696,
-	12726, 5, 1379, 690, 2, 12697, 12726, 5, 1381, 691, 2, 12698, 12726, 5,
-	1383, 692, 2, 12699, 12726, 5, 1385, 693, 2, 12700, 12726, 5, 1387, 694,
-	2, 12701, 12726, 5, 1389, 695, 2, 12702, 12726, 5, 1391, 696, 2, 12703,
-	12726, 5, 1393, 697, 2, 12704, 12726, 5, 1395, 698, 2, 12705, 12726, 5,
-	1397, 699, 2, 12706, 12726, 5, 1399, 700, 2, 12707, 12726, 5, 1401, 701,
-	2, 12708, 12726, 5, 1403, 702, 2, 12709, 12726, 5, 1405, 703, 2, 12710,
-	12726, 5, 1407, 704, 2, 12711, 12726, 5, 1409, 705, 2, 12712, 12726, 5,
-	1411, 706, 2, 12713, 12726, 5, 1413, 707, 2, 12714, 12726, 5, 1415, 708,
-	2, 12715, 12726, 5, 1417, 709, 2, 12716, 12726, 5, 1419, 710, 2, 12717,
-	12726, 5, 1421, 711, 2, 12718, 12726, 5, 1423, 712, 2, 12719, 12726, 5,
-	1425, 713, 2, 12720, 12726, 5, 1427, 714, 2, 12721, 12726, 5, 1429, 715,
-	2, 12722, 12726, 5, 1431, 716, 2, 12723, 12726, 5, 1433, 717, 2, 12724,
-	12726, 5, 1435, 718, 2, 12725, 12684, 3, 2, 2, 2, 12725, 12685, 3, 2, 2,
-	2, 12725, 12686, 3, 2, 2, 2, 12725, 12687, 3, 2, 2, 2, 12725, 12688, 3,
-	2, 2, 2, 12725, 12689, 3, 2, 2, 2, 12725, 12690, 3, 2, 2, 2, 12725, 12691,
-	3, 2, 2, 2, 12725, 12692, 3, 2, 2, 2, 12725, 12693, 3, 2, 2, 2, 12725,
-	12694, 3, 2, 2, 2, 12725, 12695, 3, 2, 2, 2, 12725, 12696, 3, 2, 2, 2,
-	12725, 12697, 3, 2, 2, 2, 12725, 12698, 3, 2, 2, 2, 12725, 12699, 3, 2,
-	2, 2, 12725, 12700, 3, 2, 2, 2, 12725, 12701, 3, 2, 2, 2, 12725, 12702,
-	3, 2, 2, 2, 12725, 12703, 3, 2, 2, 2, 12725, 12704, 3, 2, 2, 2, 12725,
-	12705, 3, 2, 2, 2, 12725, 12706, 3, 2, 2, 2, 12725, 12707, 3, 2, 2, 2,
-	12725, 12708, 3, 2, 2, 2, 12725, 12709, 3, 2, 2, 2, 12725, 12710, 3, 2,
-	2, 2, 12725, 12711, 3, 2, 2, 2, 12725, 12712, 3, 2, 2, 2, 12725, 12713,
-	3, 2, 2, 2, 12725, 12714, 3, 2, 2, 2, 12725, 12715, 3, 2, 2, 2, 12725,
-	12716, 3, 2, 2, 2, 12725, 12717, 3, 2, 2, 2, 12725, 12718, 3, 2, 2, 2,
-	12725, 12719, 3, 2, 2, 2, 12725, 12720, 3, 2, 2, 2, 12725, 12721, 3, 2,
-	2, 2, 12725, 12722, 3, 2, 2, 2, 12725, 12723, 3, 2, 2, 2, 12725, 12724,
-	3, 2, 2, 2, 12726, 2184, 3, 2, 2, 2, 12727, 12729, 7, 71, 2, 2, 12728,
-	12730, 9, 11, 2, 2, 12729, 12728, 3, 2, 2, 2, 12729, 12730, 3, 2, 2, 2,
-	12730, 12732, 3, 2, 2, 2, 12731, 12733, 5, 2197, 1099, 2, 12732, 12731,
-	3, 2, 2, 2, 12733, 12734, 3, 2, 2, 2, 12734, 12732, 3, 2, 2, 2, 12734,
-	12735, 3, 2, 2, 2, 12735, 2186, 3, 2, 2, 2, 12736, 12738, 9, 12, 2, 2,
-	12737, 12736, 3, 2, 2, 2, 12738, 12741, 3, 2, 2, 2, 12739, 12740, 3, 2,
-	2, 2, 12739, 12737, 3, 2, 2, 2, 12740, 12743, 3, 2, 2, 2, 12741, 12739,
-	3, 2, 2, 2, 12742, 12744, 9, 13, 2, 2, 12743, 12742, 3, 2, 2, 2, 12744,
-	12745, 3, 2, 2, 2, 12745, 12746, 3, 2, 2, 2, 12745, 12743, 3, 2, 2, 2,
-	12746, 12750, 3, 2, 2, 2, 12747, 12749, 9, 12, 2, 2, 12748, 12747, 3, 2,
-	2, 2, 12749, 12752, 3, 2, 2, 2, 12750, 12748, 3, 2, 2, 2, 12750, 12751,
-	3, 2, 2, 2, 12751, 2188, 3, 2, 2, 2, 12752, 12750, 3, 2, 2, 2, 12753, 12761,
-	7, 36, 2, 2, 12754, 12755, 7, 94, 2, 2, 12755, 12760, 11, 2, 2, 2, 12756,
-	12757, 7, 36, 2, 2, 12757, 12760, 7, 36, 2, 2, 12758, 12760, 10, 14, 2,
-	2, 12759, 12754, 3, 2, 2, 2, 12759, 12756, 3, 2, 2, 2, 12759, 12758, 3,
-	2, 2, 2, 12760, 12763, 3, 2, 2, 2, 12761, 12759, 3, 2, 2, 2, 12761, 12762,
-	3, 2, 2, 2, 12762, 12764, 3, 2, 2, 2, 12763, 12761, 3, 2, 2, 2, 12764,
-	12765, 7, 36, 2, 2, 12765, 2190, 3, 2, 2, 2, 12766, 12774, 7, 41, 2, 2,
-	12767, 12768, 7, 94, 2, 2, 12768, 12773, 11, 2, 2, 2, 12769, 12770, 7,
-	41, 2, 2, 12770, 12773, 7, 41, 2, 2, 12771, 12773, 10, 15, 2, 2, 12772,
-	12767, 3, 2, 2, 2, 12772, 12769, 3, 2, 2, 2, 12772, 12771, 3, 2, 2, 2,
-	12773, 12776, 3, 2, 2, 2, 12774, 12772, 3, 2, 2, 2, 12774, 12775, 3, 2,
-	2, 2, 12775, 12777, 3, 2, 2, 2, 12776, 12774, 3, 2, 2, 2, 12777, 12778,
-	7, 41, 2, 2, 12778, 2192, 3, 2, 2, 2, 12779, 12787, 7, 98, 2, 2, 12780,
-	12781, 7, 94, 2, 2, 12781, 12786, 11, 2, 2, 2, 12782, 12783, 7, 98, 2,
-	2, 12783, 12786, 7, 98, 2, 2, 12784, 12786, 10, 16, 2, 2, 12785, 12780,
-	3, 2, 2, 2, 12785, 12782, 3, 2, 2, 2, 12785, 12784, 3, 2, 2, 2, 12786,
-	12789, 3, 2, 2, 2, 12787, 12785, 3, 2, 2, 2, 12787, 12788, 3, 2, 2, 2,
-	12788, 12790, 3, 2, 2, 2, 12789, 12787, 3, 2, 2, 2, 12790, 12791, 7, 98,
-	2, 2, 12791, 2194, 3, 2, 2, 2, 12792, 12793, 9, 17, 2, 2, 12793, 2196,
-	3, 2, 2, 2, 12794, 12795, 9, 7, 2, 2, 12795, 2198, 3, 2, 2, 2, 12796, 12797,
-	7, 68, 2, 2, 12797, 12799, 7, 41, 2, 2, 12798, 12800, 9, 18, 2, 2, 12799,
-	12798, 3, 2, 2, 2, 12800, 12801, 3, 2, 2, 2, 12801, 12799, 3, 2, 2, 2,
-	12801, 12802, 3, 2, 2, 2, 12802, 12803, 3, 2, 2, 2, 12803, 12804, 7, 41,
-	2, 2, 12804, 2200, 3, 2, 2, 2, 12805, 12806, 11, 2, 2, 2, 12806, 12807,
-	3, 2, 2, 2, 12807, 12808, 8, 1101, 4, 2, 12808, 2202, 3, 2, 2, 2, 56, 2,
-	2206, 2217, 2230, 2243, 2248, 2252, 2256, 2262, 2266, 2268, 8450, 8477,
-	12512, 12521, 12531, 12536, 12545, 12555, 12557, 12562, 12564, 12570, 12575,
-	12583, 12585, 12591, 12598, 12602, 12621, 12629, 12637, 12642, 12648, 12653,
-	12659, 12661, 12667, 12672, 12679, 12682, 12725, 12729, 12734, 12739, 12745,
-	12750, 12759, 12761, 12772, 12774, 12785, 12787, 12801, 5, 2, 3, 2, 2,
+	12192, 7, 68, 2, 2, 12192, 12193, 7, 67, 2, 2, 12193, 12194, 7, 85, 2,
+	2, 12194, 12195, 7, 71, 2, 2, 12195, 12196, 7, 56, 2, 2, 12196, 12197,
+	7, 54, 2, 2, 12197, 2030, 3, 2, 2, 2, 12198, 12199, 7, 86, 2, 2, 12199,
+	12200, 7, 81, 2, 2, 12200, 12201, 7, 97, 2, 2, 12201, 12202, 7, 70, 2,
+	2, 12202, 12203, 7, 67, 2, 2, 12203, 12204, 7, 91, 2, 2, 12204, 12205,
+	7, 85, 2, 2, 12205, 2032, 3, 2, 2, 2, 12206, 12207, 7, 86, 2, 2, 12207,
+	12208, 7, 81, 2, 2, 12208, 12209, 7, 97, 2, 2, 12209, 12210, 7, 85, 2,
+	2, 12210, 12211, 7, 71, 2, 2, 12211, 12212, 7, 69, 2, 2, 12212, 12213,
+	7, 81, 2, 2, 12213, 12214, 7, 80, 2, 2, 12214, 12215, 7, 70, 2, 2, 12215,
+	12216, 7, 85, 2, 2, 12216, 2034, 3, 2, 2, 2, 12217, 12218, 7, 87, 2, 2,
+	12218, 12219, 7, 69, 2, 2, 12219, 12220, 7, 67, 2, 2, 12220, 12221, 7,
+	85, 2, 2, 12221, 12222, 7, 71, 2, 2, 12222, 2036, 3, 2, 2, 2, 12223, 12224,
+	7, 87, 2, 2, 12224, 12225, 7, 80, 2, 2, 12225, 12226, 7, 69, 2, 2, 12226,
+	12227, 7, 81, 2, 2, 12227, 12228, 7, 79, 2, 2, 12228, 12229, 7, 82, 2,
+	2, 12229, 12230, 7, 84, 2, 2, 12230, 12231, 7, 71, 2, 2, 12231, 12232,
+	7, 85, 2, 2, 12232, 12233, 7, 85, 2, 2, 12233, 2038, 3, 2, 2, 2, 12234,
+	12235, 7, 87, 2, 2, 12235, 12236, 7, 80, 2, 2, 12236, 12237, 7, 69, 2,
+	2, 12237, 12238, 7, 81, 2, 2, 12238, 12239, 7, 79, 2, 2, 12239, 12240,
+	7, 82, 2, 2, 12240, 12241, 7, 84, 2, 2, 12241, 12242, 7, 71, 2, 2, 12242,
+	12243, 7, 85, 2, 2, 12243, 12244, 7, 85, 2, 2, 12244, 12245, 7, 71, 2,
+	2, 12245, 12246, 7, 70, 2, 2, 12246, 12247, 7, 97, 2, 2, 12247, 12248,
+	7, 78, 2, 2, 12248, 12249, 7, 71, 2, 2, 12249, 12250, 7, 80, 2, 2, 12250,
+	12251, 7, 73, 2, 2, 12251, 12252, 7, 86, 2, 2, 12252, 12253, 7, 74, 2,
+	2, 12253, 2040, 3, 2, 2, 2, 12254, 12255, 7, 87, 2, 2, 12255, 12256, 7,
+	80, 2, 2, 12256, 12257, 7, 74, 2, 2, 12257, 12258, 7, 71, 2, 2, 12258,
+	12259, 7, 90, 2, 2, 12259, 2042, 3, 2, 2, 2, 12260, 12261, 7, 87, 2, 2,
+	12261, 12262, 7, 80, 2, 2, 12262, 12263, 7, 75, 2, 2, 12263, 12264, 7,
+	90, 2, 2, 12264, 12265, 7, 97, 2, 2, 12265, 12266, 7, 86, 2, 2, 12266,
+	12267, 7, 75, 2, 2, 12267, 12268, 7, 79, 2, 2, 12268, 12269, 7, 71, 2,
+	2, 12269, 12270, 7, 85, 2, 2, 12270, 12271, 7, 86, 2, 2, 12271, 12272,
+	7, 67, 2, 2, 12272, 12273, 7, 79, 2, 2, 12273, 12274, 7, 82, 2, 2, 12274,
+	2044, 3, 2, 2, 2, 12275, 12276, 7, 87, 2, 2, 12276, 12277, 7, 82, 2, 2,
+	12277, 12278, 7, 70, 2, 2, 12278, 12279, 7, 67, 2, 2, 12279, 12280, 7,
+	86, 2, 2, 12280, 12281, 7, 71, 2, 2, 12281, 12282, 7, 90, 2, 2, 12282,
+	12283, 7, 79, 2, 2, 12283, 12284, 7, 78, 2, 2, 12284, 2046, 3, 2, 2, 2,
+	12285, 12286, 7, 87, 2, 2, 12286, 12287, 7, 82, 2, 2, 12287, 12288, 7,
+	82, 2, 2, 12288, 12289, 7, 71, 2, 2, 12289, 12290, 7, 84, 2, 2, 12290,
+	2048, 3, 2, 2, 2, 12291, 12292, 7, 87, 2, 2, 12292, 12293, 7, 87, 2, 2,
+	12293, 12294, 7, 75, 2, 2, 12294, 12295, 7, 70, 2, 2, 12295, 2050, 3, 2,
+	2, 2, 12296, 12297, 7, 87, 2, 2, 12297, 12298, 7, 87, 2, 2, 12298, 12299,
+	7, 75, 2, 2, 12299, 12300, 7, 70, 2, 2, 12300, 12301, 7, 97, 2, 2, 12301,
+	12302, 7, 85, 2, 2, 12302, 12303, 7, 74, 2, 2, 12303, 12304, 7, 81, 2,
+	2, 12304, 12305, 7, 84, 2, 2, 12305, 12306, 7, 86, 2, 2, 12306, 2052, 3,
+	2, 2, 2, 12307, 12308, 7, 88, 2, 2, 12308, 12309, 7, 67, 2, 2, 12309, 12310,
+	7, 78, 2, 2, 12310, 12311, 7, 75, 2, 2, 12311, 12312, 7, 70, 2, 2, 12312,
+	12313, 7, 67, 2, 2, 12313, 12314, 7, 86, 2, 2, 12314, 12315, 7, 71, 2,
+	2, 12315, 12316, 7, 97, 2, 2, 12316, 12317, 7, 82, 2, 2, 12317, 12318,
+	7, 67, 2, 2, 12318, 12319, 7, 85, 2, 2, 12319, 12320, 7, 85, 2, 2, 12320,
+	12321, 7, 89, 2, 2, 12321, 12322, 7, 81, 2, 2, 12322, 12323, 7, 84, 2,
+	2, 12323, 12324, 7, 70, 2, 2, 12324, 12325, 7, 97, 2, 2, 12325, 12326,
+	7, 85, 2, 2, 12326, 12327, 7, 86, 2, 2, 12327, 12328, 7, 84, 2, 2, 12328,
+	12329, 7, 71, 2, 2, 12329, 12330, 7, 80, 2, 2, 12330, 12331, 7, 73, 2,
+	2, 12331, 12332, 7, 86, 2, 2, 12332, 12333, 7, 74, 2, 2, 12333, 2054, 3,
+	2, 2, 2, 12334, 12335, 7, 88, 2, 2, 12335, 12336, 7, 71, 2, 2, 12336, 12337,
+	7, 84, 2, 2, 12337, 12338, 7, 85, 2, 2, 12338, 12339, 7, 75, 2, 2, 12339,
+	12340, 7, 81, 2, 2, 12340, 12341, 7, 80, 2, 2, 12341, 2056, 3, 2, 2, 2,
+	12342, 12343, 7, 89, 2, 2, 12343, 12344, 7, 67, 2, 2, 12344, 12345, 7,
+	75, 2, 2, 12345, 12346, 7, 86, 2, 2, 12346, 12347, 7, 97, 2, 2, 12347,
+	12348, 7, 87, 2, 2, 12348, 12349, 7, 80, 2, 2, 12349, 12350, 7, 86, 2,
+	2, 12350, 12351, 7, 75, 2, 2, 12351, 12352, 7, 78, 2, 2, 12352, 12353,
+	7, 97, 2, 2, 12353, 12354, 7, 85, 2, 2, 12354, 12355, 7, 83, 2, 2, 12355,
+	12356, 7, 78, 2, 2, 12356, 12357, 7, 97, 2, 2, 12357, 12358, 7, 86, 2,
+	2, 12358, 12359, 7, 74, 2, 2, 12359, 12360, 7, 84, 2, 2, 12360, 12361,
+	7, 71, 2, 2, 12361, 12362, 7, 67, 2, 2, 12362, 12363, 7, 70, 2, 2, 12363,
+	12364, 7, 97, 2, 2, 12364, 12365, 7, 67, 2, 2, 12365, 12366, 7, 72, 2,
+	2, 12366, 12367, 7, 86, 2, 2, 12367, 12368, 7, 71, 2, 2, 12368, 12369,
+	7, 84, 2, 2, 12369, 12370, 7, 97, 2, 2, 12370, 12371, 7, 73, 2, 2, 12371,
+	12372, 7, 86, 2, 2, 12372, 12373, 7, 75, 2, 2, 12373, 12374, 7, 70, 2,
+	2, 12374, 12375, 7, 85, 2, 2, 12375, 2058, 3, 2, 2, 2, 12376, 12377, 7,
+	89, 2, 2, 12377, 12378, 7, 71, 2, 2, 12378, 12379, 7, 71, 2, 2, 12379,
+	12380, 7, 77, 2, 2, 12380, 12381, 7, 70, 2, 2, 12381, 12382, 7, 67, 2,
+	2, 12382, 12383, 7, 91, 2, 2, 12383, 2060, 3, 2, 2, 2, 12384, 12385, 7,
+	89, 2, 2, 12385, 12386, 7, 71, 2, 2, 12386, 12387, 7, 71, 2, 2, 12387,
+	12388, 7, 77, 2, 2, 12388, 12389, 7, 81, 2, 2, 12389, 12390, 7, 72, 2,
+	2, 12390, 12391, 7, 91, 2, 2, 12391, 12392, 7, 71, 2, 2, 12392, 12393,
+	7, 67, 2, 2, 12393, 12394, 7, 84, 2, 2, 12394, 2062, 3, 2, 2, 2, 12395,
+	12396, 7, 89, 2, 2, 12396, 12397, 7, 71, 2, 2, 12397, 12398, 7, 75, 2,
+	2, 12398, 12399, 7, 73, 2, 2, 12399, 12400, 7, 74, 2, 2, 12400, 12401,
+	7, 86, 2, 2, 12401, 12402, 7, 97, 2, 2, 12402, 12403, 7, 85, 2, 2, 12403,
+	12404, 7, 86, 2, 2, 12404, 12405, 7, 84, 2, 2, 12405, 12406, 7, 75, 2,
+	2, 12406, 12407, 7, 80, 2, 2, 12407, 12408, 7, 73, 2, 2, 12408, 2064, 3,
+	2, 2, 2, 12409, 12410, 7, 89, 2, 2, 12410, 12411, 7, 75, 2, 2, 12411, 12412,
+	7, 86, 2, 2, 12412, 12413, 7, 74, 2, 2, 12413, 12414, 7, 75, 2, 2, 12414,
+	12415, 7, 80, 2, 2, 12415, 2066, 3, 2, 2, 2, 12416, 12417, 7, 91, 2, 2,
+	12417, 12418, 7, 71, 2, 2, 12418, 12419, 7, 67, 2, 2, 12419, 12420, 7,
+	84, 2, 2, 12420, 12421, 7, 89, 2, 2, 12421, 12422, 7, 71, 2, 2, 12422,
+	12423, 7, 71, 2, 2, 12423, 12424, 7, 77, 2, 2, 12424, 2068, 3, 2, 2, 2,
+	12425, 12426, 7, 91, 2, 2, 12426, 2070, 3, 2, 2, 2, 12427, 12428, 7, 90,
+	2, 2, 12428, 2072, 3, 2, 2, 2, 12429, 12430, 7, 60, 2, 2, 12430, 12431,
+	7, 63, 2, 2, 12431, 2074, 3, 2, 2, 2, 12432, 12433, 7, 45, 2, 2, 12433,
+	12434, 7, 63, 2, 2, 12434, 2076, 3, 2, 2, 2, 12435, 12436, 7, 47, 2, 2,
+	12436, 12437, 7, 63, 2, 2, 12437, 2078, 3, 2, 2, 2, 12438, 12439, 7, 44,
+	2, 2, 12439, 12440, 7, 63, 2, 2, 12440, 2080, 3, 2, 2, 2, 12441, 12442,
+	7, 49, 2, 2, 12442, 12443, 7, 63, 2, 2, 12443, 2082, 3, 2, 2, 2, 12444,
+	12445, 7, 39, 2, 2, 12445, 12446, 7, 63, 2, 2, 12446, 2084, 3, 2, 2, 2,
+	12447, 12448, 7, 40, 2, 2, 12448, 12449, 7, 63, 2, 2, 12449, 2086, 3, 2,
+	2, 2, 12450, 12451, 7, 96, 2, 2, 12451, 12452, 7, 63, 2, 2, 12452, 2088,
+	3, 2, 2, 2, 12453, 12454, 7, 126, 2, 2, 12454, 12455, 7, 63, 2, 2, 12455,
+	2090, 3, 2, 2, 2, 12456, 12457, 7, 44, 2, 2, 12457, 2092, 3, 2, 2, 2, 12458,
+	12459, 7, 49, 2, 2, 12459, 2094, 3, 2, 2, 2, 12460, 12461, 7, 39, 2, 2,
+	12461, 2096, 3, 2, 2, 2, 12462, 12463, 7, 45, 2, 2, 12463, 2098, 3, 2,
+	2, 2, 12464, 12465, 7, 47, 2, 2, 12465, 12466, 7, 47, 2, 2, 12466, 2100,
+	3, 2, 2, 2, 12467, 12468, 7, 47, 2, 2, 12468, 2102, 3, 2, 2, 2, 12469,
+	12470, 7, 70, 2, 2, 12470, 12471, 7, 75, 2, 2, 12471, 12472, 7, 88, 2,
+	2, 12472, 2104, 3, 2, 2, 2, 12473, 12474, 7, 79, 2, 2, 12474, 12475, 7,
+	81, 2, 2, 12475, 12476, 7, 70, 2, 2, 12476, 2106, 3, 2, 2, 2, 12477, 12478,
+	7, 63, 2, 2, 12478, 2108, 3, 2, 2, 2, 12479, 12480, 7, 64, 2, 2, 12480,
+	2110, 3, 2, 2, 2, 12481, 12482, 7, 62, 2, 2, 12482, 2112, 3, 2, 2, 2, 12483,
+	12484, 7, 35, 2, 2, 12484, 2114, 3, 2, 2, 2, 12485, 12486, 7, 128, 2, 2,
+	12486, 2116, 3, 2, 2, 2, 12487, 12488, 7, 126, 2, 2, 12488, 2118, 3, 2,
+	2, 2, 12489, 12490, 7, 40, 2, 2, 12490, 2120, 3, 2, 2, 2, 12491, 12492,
+	7, 96, 2, 2, 12492, 2122, 3, 2, 2, 2, 12493, 12494, 7, 48, 2, 2, 12494,
+	2124, 3, 2, 2, 2, 12495, 12496, 7, 42, 2, 2, 12496, 2126, 3, 2, 2, 2, 12497,
+	12498, 7, 43, 2, 2, 12498, 2128, 3, 2, 2, 2, 12499, 12500, 7, 46, 2, 2,
+	12500, 2130, 3, 2, 2, 2, 12501, 12502, 7, 61, 2, 2, 12502, 2132, 3, 2,
+	2, 2, 12503, 12504, 7, 66, 2, 2, 12504, 2134, 3, 2, 2, 2, 12505, 12506,
+	7, 50, 2, 2, 12506, 2136, 3, 2, 2, 2, 12507, 12508, 7, 51, 2, 2, 12508,
+	2138, 3, 2, 2, 2, 12509, 12510, 7, 52, 2, 2, 12510, 2140, 3, 2, 2, 2, 12511,
+	12512, 7, 41, 2, 2, 12512, 2142, 3, 2, 2, 2, 12513, 12514, 7, 36, 2, 2,
+	12514, 2144, 3, 2, 2, 2, 12515, 12516, 7, 98, 2, 2, 12516, 2146, 3, 2,
+	2, 2, 12517, 12518, 7, 60, 2, 2, 12518, 2148, 3, 2, 2, 2, 12519, 12523,
+	5, 2141, 1071, 2, 12520, 12523, 5, 2143, 1072, 2, 12521, 12523, 5, 2145,
+	1073, 2, 12522, 12519, 3, 2, 2, 2, 12522, 12520, 3, 2, 2, 2, 12522, 12521,
+	3, 2, 2, 2, 12523, 2150, 3, 2, 2, 2, 12524, 12525, 7, 98, 2, 2, 12525,
+	12526, 5, 2185, 1093, 2, 12526, 12527, 7, 98, 2, 2, 12527, 2152, 3, 2,
+	2, 2, 12528, 12530, 5, 2199, 1100, 2, 12529, 12528, 3, 2, 2, 2, 12530,
+	12531, 3, 2, 2, 2, 12531, 12529, 3, 2, 2, 2, 12531, 12532, 3, 2, 2, 2,
+	12532, 12533, 3, 2, 2, 2, 12533, 12534, 9, 5, 2, 2, 12534, 2154, 3, 2,
+	2, 2, 12535, 12536, 7, 80, 2, 2, 12536, 12537, 5, 2193, 1097, 2, 12537,
+	2156, 3, 2, 2, 2, 12538, 12542, 5, 2191, 1096, 2, 12539, 12542, 5, 2193,
+	1097, 2, 12540, 12542, 5, 2195, 1098, 2, 12541, 12538, 3, 2, 2, 2, 12541,
+	12539, 3, 2, 2, 2, 12541, 12540, 3, 2, 2, 2, 12542, 2158, 3, 2, 2, 2, 12543,
+	12545, 5, 2199, 1100, 2, 12544, 12543, 3, 2, 2, 2, 12545, 12546, 3, 2,
+	2, 2, 12546, 12544, 3, 2, 2, 2, 12546, 12547, 3, 2, 2, 2, 12547, 2160,
+	3, 2, 2, 2, 12548, 12549, 7, 90, 2, 2, 12549, 12553, 7, 41, 2, 2, 12550,
+	12551, 5, 2197, 1099, 2, 12551, 12552, 5, 2197, 1099, 2, 12552, 12554,
+	3, 2, 2, 2, 12553, 12550, 3, 2, 2, 2, 12554, 12555, 3, 2, 2, 2, 12555,
+	12553, 3, 2, 2, 2, 12555, 12556, 3, 2, 2, 2, 12556, 12557, 3, 2, 2, 2,
+	12557, 12558, 7, 41, 2, 2, 12558, 12568, 3, 2, 2, 2, 12559, 12560, 7, 50,
+	2, 2, 12560, 12561, 7, 90, 2, 2, 12561, 12563, 3, 2, 2, 2, 12562, 12564,
+	5, 2197, 1099, 2, 12563, 12562, 3, 2, 2, 2, 12564, 12565, 3, 2, 2, 2, 12565,
+	12563, 3, 2, 2, 2, 12565, 12566, 3, 2, 2, 2, 12566, 12568, 3, 2, 2, 2,
+	12567, 12548, 3, 2, 2, 2, 12567, 12559, 3, 2, 2, 2, 12568, 2162, 3, 2,
+	2, 2, 12569, 12571, 5, 2199, 1100, 2, 12570, 12569, 3, 2, 2, 2, 12571,
+	12572, 3, 2, 2, 2, 12572, 12570, 3, 2, 2, 2, 12572, 12573, 3, 2, 2, 2,
+	12573, 12575, 3, 2, 2, 2, 12574, 12570, 3, 2, 2, 2, 12574, 12575, 3, 2,
+	2, 2, 12575, 12576, 3, 2, 2, 2, 12576, 12578, 7, 48, 2, 2, 12577, 12579,
+	5, 2199, 1100, 2, 12578, 12577, 3, 2, 2, 2, 12579, 12580, 3, 2, 2, 2, 12580,
+	12578, 3, 2, 2, 2, 12580, 12581, 3, 2, 2, 2, 12581, 12613, 3, 2, 2, 2,
+	12582, 12584, 5, 2199, 1100, 2, 12583, 12582, 3, 2, 2, 2, 12584, 12585,
+	3, 2, 2, 2, 12585, 12583, 3, 2, 2, 2, 12585, 12586, 3, 2, 2, 2, 12586,
+	12587, 3, 2, 2, 2, 12587, 12588, 7, 48, 2, 2, 12588, 12589, 5, 2187, 1094,
+	2, 12589, 12613, 3, 2, 2, 2, 12590, 12592, 5, 2199, 1100, 2, 12591, 12590,
+	3, 2, 2, 2, 12592, 12593, 3, 2, 2, 2, 12593, 12591, 3, 2, 2, 2, 12593,
+	12594, 3, 2, 2, 2, 12594, 12596, 3, 2, 2, 2, 12595, 12591, 3, 2, 2, 2,
+	12595, 12596, 3, 2, 2, 2, 12596, 12597, 3, 2, 2, 2, 12597, 12599, 7, 48,
+	2, 2, 12598, 12600, 5, 2199, 1100, 2, 12599, 12598, 3, 2, 2, 2, 12600,
+	12601, 3, 2, 2, 2, 12601, 12599, 3, 2, 2, 2, 12601, 12602, 3, 2, 2, 2,
+	12602, 12603, 3, 2, 2, 2, 12603, 12604, 5, 2187, 1094, 2, 12604, 12613,
+	3, 2, 2, 2, 12605, 12607, 5, 2199, 1100, 2, 12606, 12605, 3, 2, 2, 2, 12607,
+	12608, 3, 2, 2, 2, 12608, 12606, 3, 2, 2, 2, 12608, 12609, 3, 2, 2, 2,
+	12609, 12610, 3, 2, 2, 2, 12610, 12611, 5, 2187, 1094, 2, 12611, 12613,
+	3, 2, 2, 2, 12612, 12574, 3, 2, 2, 2, 12612, 12583, 3, 2, 2, 2, 12612,
+	12595, 3, 2, 2, 2, 12612, 12606, 3, 2, 2, 2, 12613, 2164, 3, 2, 2, 2, 12614,
+	12615, 7, 94, 2, 2, 12615, 12616, 7, 80, 2, 2, 12616, 2166, 3, 2, 2, 2,
+	12617, 12618, 5, 2201, 1101, 2, 12618, 2168, 3, 2, 2, 2, 12619, 12620,
+	7, 97, 2, 2, 12620, 12621, 5, 2185, 1093, 2, 12621, 2170, 3, 2, 2, 2, 12622,
+	12623, 7, 48, 2, 2, 12623, 12624, 5, 2189, 1095, 2, 12624, 2172, 3, 2,
+	2, 2, 12625, 12626, 5, 2189, 1095, 2, 12626, 2174, 3, 2, 2, 2, 12627, 12629,
+	7, 98, 2, 2, 12628, 12630, 10, 6, 2, 2, 12629, 12628, 3, 2, 2, 2, 12630,
+	12631, 3, 2, 2, 2, 12631, 12629, 3, 2, 2, 2, 12631, 12632, 3, 2, 2, 2,
+	12632, 12633, 3, 2, 2, 2, 12633, 12634, 7, 98, 2, 2, 12634, 2176, 3, 2,
+	2, 2, 12635, 12640, 5, 2193, 1097, 2, 12636, 12640, 5, 2191, 1096, 2, 12637,
+	12640, 5, 2195, 1098, 2, 12638, 12640, 5, 2189, 1095, 2, 12639, 12635,
+	3, 2, 2, 2, 12639, 12636, 3, 2, 2, 2, 12639, 12637, 3, 2, 2, 2, 12639,
+	12638, 3, 2, 2, 2, 12640, 12641, 3, 2, 2, 2, 12641, 12647, 7, 66, 2, 2,
+	12642, 12648, 5, 2193, 1097, 2, 12643, 12648, 5, 2191, 1096, 2, 12644,
+	12648, 5, 2195, 1098, 2, 12645, 12648, 5, 2189, 1095, 2, 12646, 12648,
+	5, 2179, 1090, 2, 12647, 12642, 3, 2, 2, 2, 12647, 12643, 3, 2, 2, 2, 12647,
+	12644, 3, 2, 2, 2, 12647, 12645, 3, 2, 2, 2, 12647, 12646, 3, 2, 2, 2,
+	12648, 2178, 3, 2, 2, 2, 12649, 12651, 9, 7, 2, 2, 12650, 12649, 3, 2,
+	2, 2, 12651, 12652, 3, 2, 2, 2, 12652, 12650, 3, 2, 2, 2, 12652, 12653,
+	3, 2, 2, 2, 12653, 12654, 3, 2, 2, 2, 12654, 12656, 7, 48, 2, 2, 12655,
+	12657, 9, 8, 2, 2, 12656, 12655, 3, 2, 2, 2, 12657, 12658, 3, 2, 2, 2,
+	12658, 12656, 3, 2, 2, 2, 12658, 12659, 3, 2, 2, 2, 12659, 12672, 3, 2,
+	2, 2, 12660, 12662, 9, 9, 2, 2, 12661, 12660, 3, 2, 2, 2, 12662, 12663,
+	3, 2, 2, 2, 12663, 12661, 3, 2, 2, 2, 12663, 12664, 3, 2, 2, 2, 12664,
+	12665, 3, 2, 2, 2, 12665, 12667, 7, 60, 2, 2, 12666, 12668, 9, 9, 2, 2,
+	12667, 12666, 3, 2, 2, 2, 12668, 12669, 3, 2, 2, 2, 12669, 12667, 3, 2,
+	2, 2, 12669, 12670, 3, 2, 2, 2, 12670, 12672, 3, 2, 2, 2, 12671, 12650,
+	3, 2, 2, 2, 12671, 12661, 3, 2, 2, 2, 12672, 2180, 3, 2, 2, 2, 12673, 12682,
+	7, 66, 2, 2, 12674, 12676, 9, 10, 2, 2, 12675, 12674, 3, 2, 2, 2, 12676,
+	12677, 3, 2, 2, 2, 12677, 12675, 3, 2, 2, 2, 12677, 12678, 3, 2, 2, 2,
+	12678, 12683, 3, 2, 2, 2, 12679, 12683, 5, 2193, 1097, 2, 12680, 12683,
+	5, 2191, 1096, 2, 12681, 12683, 5, 2195, 1098, 2, 12682, 12675, 3, 2, 2,
+	2, 12682, 12679, 3, 2, 2, 2, 12682, 12680, 3, 2, 2, 2, 12682, 12681, 3,
+	2, 2, 2, 12683, 2182, 3, 2, 2, 2, 12684, 12685, 7, 66, 2, 2, 12685, 12692,
+	7, 66, 2, 2, 12686, 12688, 9, 10, 2, 2, 12687, 12686, 3, 2, 2, 2, 12688,
+	12689, 3, 2, 2, 2, 12689, 12687, 3, 2, 2, 2, 12689, 12690, 3, 2, 2, 2,
+	12690, 12693, 3, 2, 2, 2, 12691, 12693, 5, 2195, 1098, 2, 12692, 12687,
+	3, 2, 2, 2, 12692, 12691, 3, 2, 2, 2, 12693, 2184, 3, 2, 2, 2, 12694, 12736,
+	5, 1359, 680, 2, 12695, 12736, 5, 1361, 681, 2, 12696, 12736, 5, 1363,
+	682, 2, 12697, 12736, 5, 421, 211, 2, 12698, 12736, 5, 1365, 683, 2, 12699,
+	12736, 5, 1367, 684, 2, 12700, 12736, 5, 1369, 685, 2, 12701, 12736, 5,
+	1371, 686, 2, 12702, 12736, 5, 1373, 687, 2, 12703, 12736, 5, 1375, 688,
+	2, 12704, 12736, 5, 1377, 689, 2, 12705, 12736, 5, 1379, 690, 2, 12706,
+	12736, 5, 1381, 691, 2, 12707, 12736, 5, 1383, 692, 2, 12708, 12736, 5,
+	1385, 693, 2, 12709, 12736, 5, 1387, 694, 2, 12710, 12736, 5, 1389, 695,
+	2, 12711, 12736, 5, 1391, 696, 2, 12712, 12736, 5, 1393, 697, 2, 12713,
+	12736, 5, 1395, 698, 2, 12714, 12736, 5, 1397, 699, 2, 12715, 12736, 5,
+	1399, 700, 2, 12716, 12736, 5, 1401, 701, 2, 12717, 12736, 5, 1403, 702,
+	2, 12718, 12736, 5, 1405, 703, 2, 12719, 12736, 5, 1407, 704, 2, 12720,
+	12736, 5, 1409, 705, 2, 12721, 12736, 5, 1411, 706, 2, 12722, 12736, 5,
+	1413, 707, 2, 12723, 12736, 5, 1415, 708, 2, 12724, 12736, 5, 1417, 709,
+	2, 12725, 12736, 5, 1419, 710, 2, 12726, 12736, 5, 1421, 711, 2, 12727,
+	12736, 5, 1423, 712, 2, 12728, 12736, 5, 1425, 713, 2, 12729, 12736, 5,
+	1427, 714, 2, 12730, 12736, 5, 1429, 715, 2, 12731, 12736, 5, 1431, 716,
+	2, 12732, 12736, 5, 1433, 717, 2, 12733, 12736, 5, 1435, 718, 2, 12734,
+	12736, 5, 1437, 719, 2, 12735, 12694, 3, 2, 2, 2, 12735, 12695, 3, 2, 2,
+	2, 12735, 12696, 3, 2, 2, 2, 12735, 12697, 3, 2, 2, 2, 12735, 12698, 3,
+	2, 2, 2, 12735, 12699, 3, 2, 2, 2, 12735, 12700, 3, 2, 2, 2, 12735, 12701,
+	3, 2, 2, 2, 12735, 12702, 3, 2, 2, 2, 12735, 12703, 3, 2, 2, 2, 12735,
+	12704, 3, 2, 2, 2, 12735, 12705, 3, 2, 2, 2, 12735, 12706, 3, 2, 2, 2,
+	12735, 12707, 3, 2, 2, 2, 12735, 12708, 3, 2, 2, 2, 12735, 12709, 3, 2,
+	2, 2, 12735, 12710, 3, 2, 2, 2, 12735, 12711, 3, 2, 2, 2, 12735, 12712,
+	3, 2, 2, 2, 12735, 12713, 3, 2, 2, 2, 12735, 12714, 3, 2, 2, 2, 12735,
+	12715, 3, 2, 2, 2, 12735, 12716, 3, 2, 2, 2, 12735, 12717, 3, 2, 2, 2,
+	12735, 12718, 3, 2, 2, 2, 12735, 12719, 3, 2, 2, 2, 12735, 12720, 3, 2,
+	2, 2, 12735, 12721, 3, 2, 2, 2, 12735, 12722, 3, 2, 2, 2, 12735, 12723,
+	3, 2, 2, 2, 12735, 12724, 3, 2, 2, 2, 12735, 12725, 3, 2, 2, 2, 12735,
+	12726, 3, 2, 2, 2, 12735, 12727, 3, 2, 2, 2, 12735, 12728, 3, 2, 2, 2,
+	12735, 12729, 3, 2, 2, 2, 12735, 12730, 3, 2, 2, 2, 12735, 12731, 3, 2,
+	2, 2, 12735, 12732, 3, 2, 2, 2, 12735, 12733, 3, 2, 2, 2, 12735, 12734,
+	3, 2, 2, 2, 12736, 2186, 3, 2, 2, 2, 12737, 12739, 7, 71, 2, 2, 12738,
+	12740, 9, 11, 2, 2, 12739, 12738, 3, 2, 2, 2, 12739, 12740, 3, 2, 2, 2,
+	12740, 12742, 3, 2, 2, 2, 12741, 12743, 5, 2199, 1100, 2, 12742, 12741,
+	3, 2, 2, 2, 12743, 12744, 3, 2, 2, 2, 12744, 12742, 3, 2, 2, 2, 12744,
+	12745, 3, 2, 2, 2, 12745, 2188, 3, 2, 2, 2, 12746, 12748, 9, 12, 2, 2,
+	12747, 12746, 3, 2, 2, 2, 12748, 12751, 3, 2, 2, 2, 12749, 12750, 3, 2,
+	2, 2, 12749, 12747, 3, 2, 2, 2, 12750, 12753, 3, 2, 2, 2, 12751, 12749,
+	3, 2, 2, 2, 12752, 12754, 9, 13, 2, 2, 12753, 12752, 3, 2, 2, 2, 12754,
+	12755, 3, 2, 2, 2, 12755, 12756, 3, 2, 2, 2, 12755, 12753, 3, 2, 2, 2,
+	12756, 12760, 3, 2, 2, 2, 12757, 12759, 9, 12, 2, 2, 12758, 12757, 3, 2,
+	2, 2, 12759, 12762, 3, 2, 2, 2, 12760, 12758, 3, 2, 2, 2, 12760, 12761,
+	3, 2, 2, 2, 12761, 2190, 3, 2, 2, 2, 12762, 12760, 3, 2, 2, 2, 12763, 12771,
+	7, 36, 2, 2, 12764, 12765, 7, 94, 2, 2, 12765, 12770, 11, 2, 2, 2, 12766,
+	12767, 7, 36, 2, 2, 12767, 12770, 7, 36, 2, 2, 12768, 12770, 10, 14, 2,
+	2, 12769, 12764, 3, 2, 2, 2, 12769, 12766, 3, 2, 2, 2, 12769, 12768, 3,
+	2, 2, 2, 12770, 12773, 3, 2, 2, 2, 12771, 12769, 3, 2, 2, 2, 12771, 12772,
+	3, 2, 2, 2, 12772, 12774, 3, 2, 2, 2, 12773, 12771, 3, 2, 2, 2, 12774,
+	12775, 7, 36, 2, 2, 12775, 2192, 3, 2, 2, 2, 12776, 12784, 7, 41, 2, 2,
+	12777, 12778, 7, 94, 2, 2, 12778, 12783, 11, 2, 2, 2, 12779, 12780, 7,
+	41, 2, 2, 12780, 12783, 7, 41, 2, 2, 12781, 12783, 10, 15, 2, 2, 12782,
+	12777, 3, 2, 2, 2, 12782, 12779, 3, 2, 2, 2, 12782, 12781, 3, 2, 2, 2,
+	12783, 12786, 3, 2, 2, 2, 12784, 12782, 3, 2, 2, 2, 12784, 12785, 3, 2,
+	2, 2, 12785, 12787, 3, 2, 2, 2, 12786, 12784, 3, 2, 2, 2, 12787, 12788,
+	7, 41, 2, 2, 12788, 2194, 3, 2, 2, 2, 12789, 12797, 7, 98, 2, 2, 12790,
+	12791, 7, 94, 2, 2, 12791, 12796, 11, 2, 2, 2, 12792, 12793, 7, 98, 2,
+	2, 12793, 12796, 7, 98, 2, 2, 12794, 12796, 10, 16, 2, 2, 12795, 12790,
+	3, 2, 2, 2, 12795, 12792, 3, 2, 2, 2, 12795, 12794, 3, 2, 2, 2, 12796,
+	12799, 3, 2, 2, 2, 12797, 12795, 3, 2, 2, 2, 12797, 12798, 3, 2, 2, 2,
+	12798, 12800, 3, 2, 2, 2, 12799, 12797, 3, 2, 2, 2, 12800, 12801, 7, 98,
+	2, 2, 12801, 2196, 3, 2, 2, 2, 12802, 12803, 9, 17, 2, 2, 12803, 2198,
+	3, 2, 2, 2, 12804, 12805, 9, 7, 2, 2, 12805, 2200, 3, 2, 2, 2, 12806, 12807,
+	7, 68, 2, 2, 12807, 12809, 7, 41, 2, 2, 12808, 12810, 9, 18, 2, 2, 12809,
+	12808, 3, 2, 2, 2, 12810, 12811, 3, 2, 2, 2, 12811, 12809, 3, 2, 2, 2,
+	12811, 12812, 3, 2, 2, 2, 12812, 12813, 3, 2, 2, 2, 12813, 12814, 7, 41,
+	2, 2, 12814, 2202, 3, 2, 2, 2, 12815, 12816, 11, 2, 2, 2, 12816, 12817,
+	3, 2, 2, 2, 12817, 12818, 8, 1102, 4, 2, 12818, 2204, 3, 2, 2, 2, 56, 2,
+	2208, 2219, 2232, 2245, 2250, 2254, 2258, 2264, 2268, 2270, 8460, 8487,
+	12522, 12531, 12541, 12546, 12555, 12565, 12567, 12572, 12574, 12580, 12585,
+	12593, 12595, 12601, 12608, 12612, 12631, 12639, 12647, 12652, 12658, 12663,
+	12669, 12671, 12677, 12682, 12689, 12692, 12735, 12739, 12744, 12749, 12755,
+	12760, 12769, 12771, 12782, 12784, 12795, 12797, 12811, 5, 2, 3, 2, 2,
 	4, 2, 2, 5, 2,
 }
 
@@ -6062,11 +6068,11 @@ var lexerLiteralNames = []string{
 	"'FOUND'", "'FULL'", "'FUNCTION'", "'GENERAL'", "'GLOBAL'", "'GRANTS'",
 	"'GROUP_REPLICATION'", "'HANDLER'", "'HASH'", "'HELP'", "'HOST'", "'HOSTS'",
 	"'IDENTIFIED'", "'IGNORE_SERVER_IDS'", "'IMPORT'", "'INDEXES'", "'INITIAL_SIZE'",
-	"'INPLACE'", "'INSERT_METHOD'", "'INSTALL'", "'INSTANCE'", "'INVISIBLE'",
-	"'INVOKER'", "'IO'", "'IO_THREAD'", "'IPC'", "'ISOLATION'", "'ISSUER'",
-	"'JSON'", "'KEY_BLOCK_SIZE'", "'LANGUAGE'", "'LAST'", "'LEAVES'", "'LESS'",
-	"'LEVEL'", "'LIST'", "'LOCAL'", "'LOGFILE'", "'LOGS'", "'MASTER'", "'MASTER_AUTO_POSITION'",
-	"'MASTER_CONNECT_RETRY'", "'MASTER_DELAY'", "'MASTER_HEARTBEAT_PERIOD'",
+	"'INPLACE'", "'INSERT_METHOD'", "'INSTALL'", "'INSTANCE'", "'INSTANT'",
+	"'INVISIBLE'", "'INVOKER'", "'IO'", "'IO_THREAD'", "'IPC'", "'ISOLATION'",
+	"'ISSUER'", "'JSON'", "'KEY_BLOCK_SIZE'", "'LANGUAGE'", "'LAST'", "'LEAVES'",
+	"'LESS'", "'LEVEL'", "'LIST'", "'LOCAL'", "'LOGFILE'", "'LOGS'", "'MASTER'",
+	"'MASTER_AUTO_POSITION'", "'MASTER_CONNECT_RETRY'", "'MASTER_DELAY'", "'MASTER_HEARTBEAT_PERIOD'",
 	"'MASTER_HOST'", "'MASTER_LOG_FILE'", "'MASTER_LOG_POS'", "'MASTER_PASSWORD'",
 	"'MASTER_PORT'", "'MASTER_RETRY_COUNT'", "'MASTER_SSL'", "'MASTER_SSL_CA'",
 	"'MASTER_SSL_CAPATH'", "'MASTER_SSL_CERT'", "'MASTER_SSL_CIPHER'", "'MASTER_SSL_CRL'",
@@ -6255,8 +6261,8 @@ var lexerSymbolicNames = []string{
 	"FIRST", "FIXED", "FLUSH", "FOLLOWS", "FOUND", "FULL", "FUNCTION", "GENERAL",
 	"GLOBAL", "GRANTS", "GROUP_REPLICATION", "HANDLER", "HASH", "HELP", "HOST",
 	"HOSTS", "IDENTIFIED", "IGNORE_SERVER_IDS", "IMPORT", "INDEXES", "INITIAL_SIZE",
-	"INPLACE", "INSERT_METHOD", "INSTALL", "INSTANCE", "INVISIBLE", "INVOKER",
-	"IO", "IO_THREAD", "IPC", "ISOLATION", "ISSUER", "JSON", "KEY_BLOCK_SIZE",
+	"INPLACE", "INSERT_METHOD", "INSTALL", "INSTANCE", "INSTANT", "INVISIBLE",
+	"INVOKER", "IO", "IO_THREAD", "IPC", "ISOLATION", "ISSUER", "JSON", "KEY_BLOCK_SIZE",
 	"LANGUAGE", "LAST", "LEAVES", "LESS", "LEVEL", "LIST", "LOCAL", "LOGFILE",
 	"LOGS", "MASTER", "MASTER_AUTO_POSITION", "MASTER_CONNECT_RETRY", "MASTER_DELAY",
 	"MASTER_HEARTBEAT_PERIOD", "MASTER_HOST", "MASTER_LOG_FILE", "MASTER_LOG_POS",
@@ -6441,8 +6447,8 @@ var lexerRuleNames = []string{
 	"FIRST", "FIXED", "FLUSH", "FOLLOWS", "FOUND", "FULL", "FUNCTION", "GENERAL",
 	"GLOBAL", "GRANTS", "GROUP_REPLICATION", "HANDLER", "HASH", "HELP", "HOST",
 	"HOSTS", "IDENTIFIED", "IGNORE_SERVER_IDS", "IMPORT", "INDEXES", "INITIAL_SIZE",
-	"INPLACE", "INSERT_METHOD", "INSTALL", "INSTANCE", "INVISIBLE", "INVOKER",
-	"IO", "IO_THREAD", "IPC", "ISOLATION", "ISSUER", "JSON", "KEY_BLOCK_SIZE",
+	"INPLACE", "INSERT_METHOD", "INSTALL", "INSTANCE", "INSTANT", "INVISIBLE",
+	"INVOKER", "IO", "IO_THREAD", "IPC", "ISOLATION", "ISSUER", "JSON", "KEY_BLOCK_SIZE",
 	"LANGUAGE", "LAST", "LEAVES", "LESS", "LEVEL", "LIST", "LOCAL", "LOGFILE",
 	"LOGS", "MASTER", "MASTER_AUTO_POSITION", "MASTER_CONNECT_RETRY", "MASTER_DELAY",
 	"MASTER_HEARTBEAT_PERIOD", "MASTER_HOST", "MASTER_LOG_FILE", "MASTER_LOG_POS",
@@ -7021,678 +7027,679 @@ const (
 	MySqlLexerINSERT_METHOD                     = 416
 	MySqlLexerINSTALL                           = 417
 	MySqlLexerINSTANCE                          = 418
-	MySqlLexerINVISIBLE                         = 419
-	MySqlLexerINVOKER                           = 420
-	MySqlLexerIO                                = 421
-	MySqlLexerIO_THREAD                         = 422
-	MySqlLexerIPC                               = 423
-	MySqlLexerISOLATION                         = 424
-	MySqlLexerISSUER                            = 425
-	MySqlLexerJSON                              = 426
-	MySqlLexerKEY_BLOCK_SIZE                    = 427
-	MySqlLexerLANGUAGE                          = 428
-	MySqlLexerLAST                              = 429
-	MySqlLexerLEAVES                            = 430
-	MySqlLexerLESS                              = 431
-	MySqlLexerLEVEL                             = 432
-	MySqlLexerLIST                              = 433
-	MySqlLexerLOCAL                             = 434
-	MySqlLexerLOGFILE                           = 435
-	MySqlLexerLOGS                              = 436
-	MySqlLexerMASTER                            = 437
-	MySqlLexerMASTER_AUTO_POSITION              = 438
-	MySqlLexerMASTER_CONNECT_RETRY              = 439
-	MySqlLexerMASTER_DELAY                      = 440
-	MySqlLexerMASTER_HEARTBEAT_PERIOD           = 441
-	MySqlLexerMASTER_HOST                       = 442
-	MySqlLexerMASTER_LOG_FILE                   = 443
-	MySqlLexerMASTER_LOG_POS                    = 444
-	MySqlLexerMASTER_PASSWORD                   = 445
-	MySqlLexerMASTER_PORT                       = 446
-	MySqlLexerMASTER_RETRY_COUNT                = 447
-	MySqlLexerMASTER_SSL                        = 448
-	MySqlLexerMASTER_SSL_CA                     = 449
-	MySqlLexerMASTER_SSL_CAPATH                 = 450
-	MySqlLexerMASTER_SSL_CERT                   = 451
-	MySqlLexerMASTER_SSL_CIPHER                 = 452
-	MySqlLexerMASTER_SSL_CRL                    = 453
-	MySqlLexerMASTER_SSL_CRLPATH                = 454
-	MySqlLexerMASTER_SSL_KEY                    = 455
-	MySqlLexerMASTER_TLS_VERSION                = 456
-	MySqlLexerMASTER_USER                       = 457
-	MySqlLexerMAX_CONNECTIONS_PER_HOUR          = 458
-	MySqlLexerMAX_QUERIES_PER_HOUR              = 459
-	MySqlLexerMAX_ROWS                          = 460
-	MySqlLexerMAX_SIZE                          = 461
-	MySqlLexerMAX_UPDATES_PER_HOUR              = 462
-	MySqlLexerMAX_USER_CONNECTIONS              = 463
-	MySqlLexerMEDIUM                            = 464
-	MySqlLexerMEMBER                            = 465
-	MySqlLexerMERGE                             = 466
-	MySqlLexerMESSAGE_TEXT                      = 467
-	MySqlLexerMID                               = 468
-	MySqlLexerMIGRATE                           = 469
-	MySqlLexerMIN_ROWS                          = 470
-	MySqlLexerMODE                              = 471
-	MySqlLexerMODIFY                            = 472
-	MySqlLexerMUTEX                             = 473
-	MySqlLexerMYSQL                             = 474
-	MySqlLexerMYSQL_ERRNO                       = 475
-	MySqlLexerNAME                              = 476
-	MySqlLexerNAMES                             = 477
-	MySqlLexerNCHAR                             = 478
-	MySqlLexerNEVER                             = 479
-	MySqlLexerNEXT                              = 480
-	MySqlLexerNO                                = 481
-	MySqlLexerNODEGROUP                         = 482
-	MySqlLexerNONE                              = 483
-	MySqlLexerOFFLINE                           = 484
-	MySqlLexerOFFSET                            = 485
-	MySqlLexerOF                                = 486
-	MySqlLexerOJ                                = 487
-	MySqlLexerOLD_PASSWORD                      = 488
-	MySqlLexerONE                               = 489
-	MySqlLexerONLINE                            = 490
-	MySqlLexerONLY                              = 491
-	MySqlLexerOPEN                              = 492
-	MySqlLexerOPTIMIZER_COSTS                   = 493
-	MySqlLexerOPTIONS                           = 494
-	MySqlLexerOWNER                             = 495
-	MySqlLexerPACK_KEYS                         = 496
-	MySqlLexerPAGE                              = 497
-	MySqlLexerPARSER                            = 498
-	MySqlLexerPARTIAL                           = 499
-	MySqlLexerPARTITIONING                      = 500
-	MySqlLexerPARTITIONS                        = 501
-	MySqlLexerPASSWORD                          = 502
-	MySqlLexerPHASE                             = 503
-	MySqlLexerPLUGIN                            = 504
-	MySqlLexerPLUGIN_DIR                        = 505
-	MySqlLexerPLUGINS                           = 506
-	MySqlLexerPORT                              = 507
-	MySqlLexerPRECEDES                          = 508
-	MySqlLexerPREPARE                           = 509
-	MySqlLexerPRESERVE                          = 510
-	MySqlLexerPREV                              = 511
-	MySqlLexerPROCESSLIST                       = 512
-	MySqlLexerPROFILE                           = 513
-	MySqlLexerPROFILES                          = 514
-	MySqlLexerPROXY                             = 515
-	MySqlLexerQUERY                             = 516
-	MySqlLexerQUICK                             = 517
-	MySqlLexerREBUILD                           = 518
-	MySqlLexerRECOVER                           = 519
-	MySqlLexerREDO_BUFFER_SIZE                  = 520
-	MySqlLexerREDUNDANT                         = 521
-	MySqlLexerRELAY                             = 522
-	MySqlLexerRELAY_LOG_FILE                    = 523
-	MySqlLexerRELAY_LOG_POS                     = 524
-	MySqlLexerRELAYLOG                          = 525
-	MySqlLexerREMOVE                            = 526
-	MySqlLexerREORGANIZE                        = 527
-	MySqlLexerREPAIR                            = 528
-	MySqlLexerREPLICATE_DO_DB                   = 529
-	MySqlLexerREPLICATE_DO_TABLE                = 530
-	MySqlLexerREPLICATE_IGNORE_DB               = 531
-	MySqlLexerREPLICATE_IGNORE_TABLE            = 532
-	MySqlLexerREPLICATE_REWRITE_DB              = 533
-	MySqlLexerREPLICATE_WILD_DO_TABLE           = 534
-	MySqlLexerREPLICATE_WILD_IGNORE_TABLE       = 535
-	MySqlLexerREPLICATION                       = 536
-	MySqlLexerRESET                             = 537
-	MySqlLexerRESUME                            = 538
-	MySqlLexerRETURNED_SQLSTATE                 = 539
-	MySqlLexerRETURNING                         = 540
-	MySqlLexerRETURNS                           = 541
-	MySqlLexerROLE                              = 542
-	MySqlLexerROLLBACK                          = 543
-	MySqlLexerROLLUP                            = 544
-	MySqlLexerROTATE                            = 545
-	MySqlLexerROW                               = 546
-	MySqlLexerROWS                              = 547
-	MySqlLexerROW_FORMAT                        = 548
-	MySqlLexerSAVEPOINT                         = 549
-	MySqlLexerSCHEDULE                          = 550
-	MySqlLexerSECURITY                          = 551
-	MySqlLexerSERVER                            = 552
-	MySqlLexerSESSION                           = 553
-	MySqlLexerSHARE                             = 554
-	MySqlLexerSHARED                            = 555
-	MySqlLexerSIGNED                            = 556
-	MySqlLexerSIMPLE                            = 557
-	MySqlLexerSLAVE                             = 558
-	MySqlLexerSLOW                              = 559
-	MySqlLexerSNAPSHOT                          = 560
-	MySqlLexerSOCKET                            = 561
-	MySqlLexerSOME                              = 562
-	MySqlLexerSONAME                            = 563
-	MySqlLexerSOUNDS                            = 564
-	MySqlLexerSOURCE                            = 565
-	MySqlLexerSQL_AFTER_GTIDS                   = 566
-	MySqlLexerSQL_AFTER_MTS_GAPS                = 567
-	MySqlLexerSQL_BEFORE_GTIDS                  = 568
-	MySqlLexerSQL_BUFFER_RESULT                 = 569
-	MySqlLexerSQL_CACHE                         = 570
-	MySqlLexerSQL_NO_CACHE                      = 571
-	MySqlLexerSQL_THREAD                        = 572
-	MySqlLexerSTART                             = 573
-	MySqlLexerSTARTS                            = 574
-	MySqlLexerSTATS_AUTO_RECALC                 = 575
-	MySqlLexerSTATS_PERSISTENT                  = 576
-	MySqlLexerSTATS_SAMPLE_PAGES                = 577
-	MySqlLexerSTATUS                            = 578
-	MySqlLexerSTOP                              = 579
-	MySqlLexerSTORAGE                           = 580
-	MySqlLexerSTORED                            = 581
-	MySqlLexerSTRING                            = 582
-	MySqlLexerSUBCLASS_ORIGIN                   = 583
-	MySqlLexerSUBJECT                           = 584
-	MySqlLexerSUBPARTITION                      = 585
-	MySqlLexerSUBPARTITIONS                     = 586
-	MySqlLexerSUSPEND                           = 587
-	MySqlLexerSWAPS                             = 588
-	MySqlLexerSWITCHES                          = 589
-	MySqlLexerTABLE_NAME                        = 590
-	MySqlLexerTABLESPACE                        = 591
-	MySqlLexerTEMPORARY                         = 592
-	MySqlLexerTEMPTABLE                         = 593
-	MySqlLexerTHAN                              = 594
-	MySqlLexerTRADITIONAL                       = 595
-	MySqlLexerTRANSACTION                       = 596
-	MySqlLexerTRANSACTIONAL                     = 597
-	MySqlLexerTRIGGERS                          = 598
-	MySqlLexerTRUNCATE                          = 599
-	MySqlLexerUNDEFINED                         = 600
-	MySqlLexerUNDOFILE                          = 601
-	MySqlLexerUNDO_BUFFER_SIZE                  = 602
-	MySqlLexerUNINSTALL                         = 603
-	MySqlLexerUNKNOWN                           = 604
-	MySqlLexerUNTIL                             = 605
-	MySqlLexerUPGRADE                           = 606
-	MySqlLexerUSER                              = 607
-	MySqlLexerUSE_FRM                           = 608
-	MySqlLexerUSER_RESOURCES                    = 609
-	MySqlLexerVALIDATION                        = 610
-	MySqlLexerVALUE                             = 611
-	MySqlLexerVARIABLES                         = 612
-	MySqlLexerVIEW                              = 613
-	MySqlLexerVIRTUAL                           = 614
-	MySqlLexerVISIBLE                           = 615
-	MySqlLexerWAIT                              = 616
-	MySqlLexerWARNINGS                          = 617
-	MySqlLexerWITHOUT                           = 618
-	MySqlLexerWORK                              = 619
-	MySqlLexerWRAPPER                           = 620
-	MySqlLexerX509                              = 621
-	MySqlLexerXA                                = 622
-	MySqlLexerXML                               = 623
-	MySqlLexerEUR                               = 624
-	MySqlLexerUSA                               = 625
-	MySqlLexerJIS                               = 626
-	MySqlLexerISO                               = 627
-	MySqlLexerINTERNAL                          = 628
-	MySqlLexerQUARTER                           = 629
-	MySqlLexerMONTH                             = 630
-	MySqlLexerDAY                               = 631
-	MySqlLexerHOUR                              = 632
-	MySqlLexerMINUTE                            = 633
-	MySqlLexerWEEK                              = 634
-	MySqlLexerSECOND                            = 635
-	MySqlLexerMICROSECOND                       = 636
-	MySqlLexerTABLES                            = 637
-	MySqlLexerROUTINE                           = 638
-	MySqlLexerEXECUTE                           = 639
-	MySqlLexerFILE                              = 640
-	MySqlLexerPROCESS                           = 641
-	MySqlLexerRELOAD                            = 642
-	MySqlLexerSHUTDOWN                          = 643
-	MySqlLexerSUPER                             = 644
-	MySqlLexerPRIVILEGES                        = 645
-	MySqlLexerAPPLICATION_PASSWORD_ADMIN        = 646
-	MySqlLexerAUDIT_ADMIN                       = 647
-	MySqlLexerBACKUP_ADMIN                      = 648
-	MySqlLexerBINLOG_ADMIN                      = 649
-	MySqlLexerBINLOG_ENCRYPTION_ADMIN           = 650
-	MySqlLexerCLONE_ADMIN                       = 651
-	MySqlLexerCONNECTION_ADMIN                  = 652
-	MySqlLexerENCRYPTION_KEY_ADMIN              = 653
-	MySqlLexerFIREWALL_ADMIN                    = 654
-	MySqlLexerFIREWALL_USER                     = 655
-	MySqlLexerFLUSH_OPTIMIZER_COSTS             = 656
-	MySqlLexerFLUSH_STATUS                      = 657
-	MySqlLexerFLUSH_TABLES                      = 658
-	MySqlLexerFLUSH_USER_RESOURCES              = 659
-	MySqlLexerGROUP_REPLICATION_ADMIN           = 660
-	MySqlLexerINNODB_REDO_LOG_ARCHIVE           = 661
-	MySqlLexerINNODB_REDO_LOG_ENABLE            = 662
-	MySqlLexerNDB_STORED_USER                   = 663
-	MySqlLexerPERSIST_RO_VARIABLES_ADMIN        = 664
-	MySqlLexerREPLICATION_APPLIER               = 665
-	MySqlLexerREPLICATION_SLAVE_ADMIN           = 666
-	MySqlLexerRESOURCE_GROUP_ADMIN              = 667
-	MySqlLexerRESOURCE_GROUP_USER               = 668
-	MySqlLexerROLE_ADMIN                        = 669
-	MySqlLexerSERVICE_CONNECTION_ADMIN          = 670
-	MySqlLexerSESSION_VARIABLES_ADMIN           = 671
-	MySqlLexerSET_USER_ID                       = 672
-	MySqlLexerSHOW_ROUTINE                      = 673
-	MySqlLexerSYSTEM_VARIABLES_ADMIN            = 674
-	MySqlLexerTABLE_ENCRYPTION_ADMIN            = 675
-	MySqlLexerVERSION_TOKEN_ADMIN               = 676
-	MySqlLexerXA_RECOVER_ADMIN                  = 677
-	MySqlLexerARMSCII8                          = 678
-	MySqlLexerASCII                             = 679
-	MySqlLexerBIG5                              = 680
-	MySqlLexerCP1250                            = 681
-	MySqlLexerCP1251                            = 682
-	MySqlLexerCP1256                            = 683
-	MySqlLexerCP1257                            = 684
-	MySqlLexerCP850                             = 685
-	MySqlLexerCP852                             = 686
-	MySqlLexerCP866                             = 687
-	MySqlLexerCP932                             = 688
-	MySqlLexerDEC8                              = 689
-	MySqlLexerEUCJPMS                           = 690
-	MySqlLexerEUCKR                             = 691
-	MySqlLexerGB2312                            = 692
-	MySqlLexerGBK                               = 693
-	MySqlLexerGEOSTD8                           = 694
-	MySqlLexerGREEK                             = 695
-	MySqlLexerHEBREW                            = 696
-	MySqlLexerHP8                               = 697
-	MySqlLexerKEYBCS2                           = 698
-	MySqlLexerKOI8R                             = 699
-	MySqlLexerKOI8U                             = 700
-	MySqlLexerLATIN1                            = 701
-	MySqlLexerLATIN2                            = 702
-	MySqlLexerLATIN5                            = 703
-	MySqlLexerLATIN7                            = 704
-	MySqlLexerMACCE                             = 705
-	MySqlLexerMACROMAN                          = 706
-	MySqlLexerSJIS                              = 707
-	MySqlLexerSWE7                              = 708
-	MySqlLexerTIS620                            = 709
-	MySqlLexerUCS2                              = 710
-	MySqlLexerUJIS                              = 711
-	MySqlLexerUTF16                             = 712
-	MySqlLexerUTF16LE                           = 713
-	MySqlLexerUTF32                             = 714
-	MySqlLexerUTF8                              = 715
-	MySqlLexerUTF8MB3                           = 716
-	MySqlLexerUTF8MB4                           = 717
-	MySqlLexerARCHIVE                           = 718
-	MySqlLexerBLACKHOLE                         = 719
-	MySqlLexerCSV                               = 720
-	MySqlLexerFEDERATED                         = 721
-	MySqlLexerINNODB                            = 722
-	MySqlLexerMEMORY                            = 723
-	MySqlLexerMRG_MYISAM                        = 724
-	MySqlLexerMYISAM                            = 725
-	MySqlLexerNDB                               = 726
-	MySqlLexerNDBCLUSTER                        = 727
-	MySqlLexerPERFORMANCE_SCHEMA                = 728
-	MySqlLexerTOKUDB                            = 729
-	MySqlLexerREPEATABLE                        = 730
-	MySqlLexerCOMMITTED                         = 731
-	MySqlLexerUNCOMMITTED                       = 732
-	MySqlLexerSERIALIZABLE                      = 733
-	MySqlLexerGEOMETRYCOLLECTION                = 734
-	MySqlLexerGEOMCOLLECTION                    = 735
-	MySqlLexerGEOMETRY                          = 736
-	MySqlLexerLINESTRING                        = 737
-	MySqlLexerMULTILINESTRING                   = 738
-	MySqlLexerMULTIPOINT                        = 739
-	MySqlLexerMULTIPOLYGON                      = 740
-	MySqlLexerPOINT                             = 741
-	MySqlLexerPOLYGON                           = 742
-	MySqlLexerABS                               = 743
-	MySqlLexerACOS                              = 744
-	MySqlLexerADDDATE                           = 745
-	MySqlLexerADDTIME                           = 746
-	MySqlLexerAES_DECRYPT                       = 747
-	MySqlLexerAES_ENCRYPT                       = 748
-	MySqlLexerAREA                              = 749
-	MySqlLexerASBINARY                          = 750
-	MySqlLexerASIN                              = 751
-	MySqlLexerASTEXT                            = 752
-	MySqlLexerASWKB                             = 753
-	MySqlLexerASWKT                             = 754
-	MySqlLexerASYMMETRIC_DECRYPT                = 755
-	MySqlLexerASYMMETRIC_DERIVE                 = 756
-	MySqlLexerASYMMETRIC_ENCRYPT                = 757
-	MySqlLexerASYMMETRIC_SIGN                   = 758
-	MySqlLexerASYMMETRIC_VERIFY                 = 759
-	MySqlLexerATAN                              = 760
-	MySqlLexerATAN2                             = 761
-	MySqlLexerBENCHMARK                         = 762
-	MySqlLexerBIN                               = 763
-	MySqlLexerBIT_COUNT                         = 764
-	MySqlLexerBIT_LENGTH                        = 765
-	MySqlLexerBUFFER                            = 766
-	MySqlLexerCATALOG_NAME                      = 767
-	MySqlLexerCEIL                              = 768
-	MySqlLexerCEILING                           = 769
-	MySqlLexerCENTROID                          = 770
-	MySqlLexerCHARACTER_LENGTH                  = 771
-	MySqlLexerCHARSET                           = 772
-	MySqlLexerCHAR_LENGTH                       = 773
-	MySqlLexerCOERCIBILITY                      = 774
-	MySqlLexerCOLLATION                         = 775
-	MySqlLexerCOMPRESS                          = 776
-	MySqlLexerCONCAT                            = 777
-	MySqlLexerCONCAT_WS                         = 778
-	MySqlLexerCONNECTION_ID                     = 779
-	MySqlLexerCONV                              = 780
-	MySqlLexerCONVERT_TZ                        = 781
-	MySqlLexerCOS                               = 782
-	MySqlLexerCOT                               = 783
-	MySqlLexerCRC32                             = 784
-	MySqlLexerCREATE_ASYMMETRIC_PRIV_KEY        = 785
-	MySqlLexerCREATE_ASYMMETRIC_PUB_KEY         = 786
-	MySqlLexerCREATE_DH_PARAMETERS              = 787
-	MySqlLexerCREATE_DIGEST                     = 788
-	MySqlLexerCROSSES                           = 789
-	MySqlLexerDATEDIFF                          = 790
-	MySqlLexerDATE_FORMAT                       = 791
-	MySqlLexerDAYNAME                           = 792
-	MySqlLexerDAYOFMONTH                        = 793
-	MySqlLexerDAYOFWEEK                         = 794
-	MySqlLexerDAYOFYEAR                         = 795
-	MySqlLexerDECODE                            = 796
-	MySqlLexerDEGREES                           = 797
-	MySqlLexerDES_DECRYPT                       = 798
-	MySqlLexerDES_ENCRYPT                       = 799
-	MySqlLexerDIMENSION                         = 800
-	MySqlLexerDISJOINT                          = 801
-	MySqlLexerELT                               = 802
-	MySqlLexerENCODE                            = 803
-	MySqlLexerENCRYPT                           = 804
-	MySqlLexerENDPOINT                          = 805
-	MySqlLexerENVELOPE                          = 806
-	MySqlLexerEQUALS                            = 807
-	MySqlLexerEXP                               = 808
-	MySqlLexerEXPORT_SET                        = 809
-	MySqlLexerEXTERIORRING                      = 810
-	MySqlLexerEXTRACTVALUE                      = 811
-	MySqlLexerFIELD                             = 812
-	MySqlLexerFIND_IN_SET                       = 813
-	MySqlLexerFLOOR                             = 814
-	MySqlLexerFORMAT                            = 815
-	MySqlLexerFOUND_ROWS                        = 816
-	MySqlLexerFROM_BASE64                       = 817
-	MySqlLexerFROM_DAYS                         = 818
-	MySqlLexerFROM_UNIXTIME                     = 819
-	MySqlLexerGEOMCOLLFROMTEXT                  = 820
-	MySqlLexerGEOMCOLLFROMWKB                   = 821
-	MySqlLexerGEOMETRYCOLLECTIONFROMTEXT        = 822
-	MySqlLexerGEOMETRYCOLLECTIONFROMWKB         = 823
-	MySqlLexerGEOMETRYFROMTEXT                  = 824
-	MySqlLexerGEOMETRYFROMWKB                   = 825
-	MySqlLexerGEOMETRYN                         = 826
-	MySqlLexerGEOMETRYTYPE                      = 827
-	MySqlLexerGEOMFROMTEXT                      = 828
-	MySqlLexerGEOMFROMWKB                       = 829
-	MySqlLexerGET_FORMAT                        = 830
-	MySqlLexerGET_LOCK                          = 831
-	MySqlLexerGLENGTH                           = 832
-	MySqlLexerGREATEST                          = 833
-	MySqlLexerGTID_SUBSET                       = 834
-	MySqlLexerGTID_SUBTRACT                     = 835
-	MySqlLexerHEX                               = 836
-	MySqlLexerIFNULL                            = 837
-	MySqlLexerINET6_ATON                        = 838
-	MySqlLexerINET6_NTOA                        = 839
-	MySqlLexerINET_ATON                         = 840
-	MySqlLexerINET_NTOA                         = 841
-	MySqlLexerINSTR                             = 842
-	MySqlLexerINTERIORRINGN                     = 843
-	MySqlLexerINTERSECTS                        = 844
-	MySqlLexerISCLOSED                          = 845
-	MySqlLexerISEMPTY                           = 846
-	MySqlLexerISNULL                            = 847
-	MySqlLexerISSIMPLE                          = 848
-	MySqlLexerIS_FREE_LOCK                      = 849
-	MySqlLexerIS_IPV4                           = 850
-	MySqlLexerIS_IPV4_COMPAT                    = 851
-	MySqlLexerIS_IPV4_MAPPED                    = 852
-	MySqlLexerIS_IPV6                           = 853
-	MySqlLexerIS_USED_LOCK                      = 854
-	MySqlLexerLAST_INSERT_ID                    = 855
-	MySqlLexerLCASE                             = 856
-	MySqlLexerLEAST                             = 857
-	MySqlLexerLENGTH                            = 858
-	MySqlLexerLINEFROMTEXT                      = 859
-	MySqlLexerLINEFROMWKB                       = 860
-	MySqlLexerLINESTRINGFROMTEXT                = 861
-	MySqlLexerLINESTRINGFROMWKB                 = 862
-	MySqlLexerLN                                = 863
-	MySqlLexerLOAD_FILE                         = 864
-	MySqlLexerLOCATE                            = 865
-	MySqlLexerLOG                               = 866
-	MySqlLexerLOG10                             = 867
-	MySqlLexerLOG2                              = 868
-	MySqlLexerLOWER                             = 869
-	MySqlLexerLPAD                              = 870
-	MySqlLexerLTRIM                             = 871
-	MySqlLexerMAKEDATE                          = 872
-	MySqlLexerMAKETIME                          = 873
-	MySqlLexerMAKE_SET                          = 874
-	MySqlLexerMASTER_POS_WAIT                   = 875
-	MySqlLexerMBRCONTAINS                       = 876
-	MySqlLexerMBRDISJOINT                       = 877
-	MySqlLexerMBREQUAL                          = 878
-	MySqlLexerMBRINTERSECTS                     = 879
-	MySqlLexerMBROVERLAPS                       = 880
-	MySqlLexerMBRTOUCHES                        = 881
-	MySqlLexerMBRWITHIN                         = 882
-	MySqlLexerMD5                               = 883
-	MySqlLexerMLINEFROMTEXT                     = 884
-	MySqlLexerMLINEFROMWKB                      = 885
-	MySqlLexerMONTHNAME                         = 886
-	MySqlLexerMPOINTFROMTEXT                    = 887
-	MySqlLexerMPOINTFROMWKB                     = 888
-	MySqlLexerMPOLYFROMTEXT                     = 889
-	MySqlLexerMPOLYFROMWKB                      = 890
-	MySqlLexerMULTILINESTRINGFROMTEXT           = 891
-	MySqlLexerMULTILINESTRINGFROMWKB            = 892
-	MySqlLexerMULTIPOINTFROMTEXT                = 893
-	MySqlLexerMULTIPOINTFROMWKB                 = 894
-	MySqlLexerMULTIPOLYGONFROMTEXT              = 895
-	MySqlLexerMULTIPOLYGONFROMWKB               = 896
-	MySqlLexerNAME_CONST                        = 897
-	MySqlLexerNULLIF                            = 898
-	MySqlLexerNUMGEOMETRIES                     = 899
-	MySqlLexerNUMINTERIORRINGS                  = 900
-	MySqlLexerNUMPOINTS                         = 901
-	MySqlLexerOCT                               = 902
-	MySqlLexerOCTET_LENGTH                      = 903
-	MySqlLexerORD                               = 904
-	MySqlLexerOVERLAPS                          = 905
-	MySqlLexerPERIOD_ADD                        = 906
-	MySqlLexerPERIOD_DIFF                       = 907
-	MySqlLexerPI                                = 908
-	MySqlLexerPOINTFROMTEXT                     = 909
-	MySqlLexerPOINTFROMWKB                      = 910
-	MySqlLexerPOINTN                            = 911
-	MySqlLexerPOLYFROMTEXT                      = 912
-	MySqlLexerPOLYFROMWKB                       = 913
-	MySqlLexerPOLYGONFROMTEXT                   = 914
-	MySqlLexerPOLYGONFROMWKB                    = 915
-	MySqlLexerPOW                               = 916
-	MySqlLexerPOWER                             = 917
-	MySqlLexerQUOTE                             = 918
-	MySqlLexerRADIANS                           = 919
-	MySqlLexerRAND                              = 920
-	MySqlLexerRANDOM_BYTES                      = 921
-	MySqlLexerRELEASE_LOCK                      = 922
-	MySqlLexerREVERSE                           = 923
-	MySqlLexerROUND                             = 924
-	MySqlLexerROW_COUNT                         = 925
-	MySqlLexerRPAD                              = 926
-	MySqlLexerRTRIM                             = 927
-	MySqlLexerSEC_TO_TIME                       = 928
-	MySqlLexerSESSION_USER                      = 929
-	MySqlLexerSHA                               = 930
-	MySqlLexerSHA1                              = 931
-	MySqlLexerSHA2                              = 932
-	MySqlLexerSCHEMA_NAME                       = 933
-	MySqlLexerSIGN                              = 934
-	MySqlLexerSIN                               = 935
-	MySqlLexerSLEEP                             = 936
-	MySqlLexerSOUNDEX                           = 937
-	MySqlLexerSQL_THREAD_WAIT_AFTER_GTIDS       = 938
-	MySqlLexerSQRT                              = 939
-	MySqlLexerSRID                              = 940
-	MySqlLexerSTARTPOINT                        = 941
-	MySqlLexerSTRCMP                            = 942
-	MySqlLexerSTR_TO_DATE                       = 943
-	MySqlLexerST_AREA                           = 944
-	MySqlLexerST_ASBINARY                       = 945
-	MySqlLexerST_ASTEXT                         = 946
-	MySqlLexerST_ASWKB                          = 947
-	MySqlLexerST_ASWKT                          = 948
-	MySqlLexerST_BUFFER                         = 949
-	MySqlLexerST_CENTROID                       = 950
-	MySqlLexerST_CONTAINS                       = 951
-	MySqlLexerST_CROSSES                        = 952
-	MySqlLexerST_DIFFERENCE                     = 953
-	MySqlLexerST_DIMENSION                      = 954
-	MySqlLexerST_DISJOINT                       = 955
-	MySqlLexerST_DISTANCE                       = 956
-	MySqlLexerST_ENDPOINT                       = 957
-	MySqlLexerST_ENVELOPE                       = 958
-	MySqlLexerST_EQUALS                         = 959
-	MySqlLexerST_EXTERIORRING                   = 960
-	MySqlLexerST_GEOMCOLLFROMTEXT               = 961
-	MySqlLexerST_GEOMCOLLFROMTXT                = 962
-	MySqlLexerST_GEOMCOLLFROMWKB                = 963
-	MySqlLexerST_GEOMETRYCOLLECTIONFROMTEXT     = 964
-	MySqlLexerST_GEOMETRYCOLLECTIONFROMWKB      = 965
-	MySqlLexerST_GEOMETRYFROMTEXT               = 966
-	MySqlLexerST_GEOMETRYFROMWKB                = 967
-	MySqlLexerST_GEOMETRYN                      = 968
-	MySqlLexerST_GEOMETRYTYPE                   = 969
-	MySqlLexerST_GEOMFROMTEXT                   = 970
-	MySqlLexerST_GEOMFROMWKB                    = 971
-	MySqlLexerST_INTERIORRINGN                  = 972
-	MySqlLexerST_INTERSECTION                   = 973
-	MySqlLexerST_INTERSECTS                     = 974
-	MySqlLexerST_ISCLOSED                       = 975
-	MySqlLexerST_ISEMPTY                        = 976
-	MySqlLexerST_ISSIMPLE                       = 977
-	MySqlLexerST_LINEFROMTEXT                   = 978
-	MySqlLexerST_LINEFROMWKB                    = 979
-	MySqlLexerST_LINESTRINGFROMTEXT             = 980
-	MySqlLexerST_LINESTRINGFROMWKB              = 981
-	MySqlLexerST_NUMGEOMETRIES                  = 982
-	MySqlLexerST_NUMINTERIORRING                = 983
-	MySqlLexerST_NUMINTERIORRINGS               = 984
-	MySqlLexerST_NUMPOINTS                      = 985
-	MySqlLexerST_OVERLAPS                       = 986
-	MySqlLexerST_POINTFROMTEXT                  = 987
-	MySqlLexerST_POINTFROMWKB                   = 988
-	MySqlLexerST_POINTN                         = 989
-	MySqlLexerST_POLYFROMTEXT                   = 990
-	MySqlLexerST_POLYFROMWKB                    = 991
-	MySqlLexerST_POLYGONFROMTEXT                = 992
-	MySqlLexerST_POLYGONFROMWKB                 = 993
-	MySqlLexerST_SRID                           = 994
-	MySqlLexerST_STARTPOINT                     = 995
-	MySqlLexerST_SYMDIFFERENCE                  = 996
-	MySqlLexerST_TOUCHES                        = 997
-	MySqlLexerST_UNION                          = 998
-	MySqlLexerST_WITHIN                         = 999
-	MySqlLexerST_X                              = 1000
-	MySqlLexerST_Y                              = 1001
-	MySqlLexerSUBDATE                           = 1002
-	MySqlLexerSUBSTRING_INDEX                   = 1003
-	MySqlLexerSUBTIME                           = 1004
-	MySqlLexerSYSTEM_USER                       = 1005
-	MySqlLexerTAN                               = 1006
-	MySqlLexerTIMEDIFF                          = 1007
-	MySqlLexerTIMESTAMPADD                      = 1008
-	MySqlLexerTIMESTAMPDIFF                     = 1009
-	MySqlLexerTIME_FORMAT                       = 1010
-	MySqlLexerTIME_TO_SEC                       = 1011
-	MySqlLexerTOUCHES                           = 1012
-	MySqlLexerTO_BASE64                         = 1013
-	MySqlLexerTO_DAYS                           = 1014
-	MySqlLexerTO_SECONDS                        = 1015
-	MySqlLexerUCASE                             = 1016
-	MySqlLexerUNCOMPRESS                        = 1017
-	MySqlLexerUNCOMPRESSED_LENGTH               = 1018
-	MySqlLexerUNHEX                             = 1019
-	MySqlLexerUNIX_TIMESTAMP                    = 1020
-	MySqlLexerUPDATEXML                         = 1021
-	MySqlLexerUPPER                             = 1022
-	MySqlLexerUUID                              = 1023
-	MySqlLexerUUID_SHORT                        = 1024
-	MySqlLexerVALIDATE_PASSWORD_STRENGTH        = 1025
-	MySqlLexerVERSION                           = 1026
-	MySqlLexerWAIT_UNTIL_SQL_THREAD_AFTER_GTIDS = 1027
-	MySqlLexerWEEKDAY                           = 1028
-	MySqlLexerWEEKOFYEAR                        = 1029
-	MySqlLexerWEIGHT_STRING                     = 1030
-	MySqlLexerWITHIN                            = 1031
-	MySqlLexerYEARWEEK                          = 1032
-	MySqlLexerY_FUNCTION                        = 1033
-	MySqlLexerX_FUNCTION                        = 1034
-	MySqlLexerVAR_ASSIGN                        = 1035
-	MySqlLexerPLUS_ASSIGN                       = 1036
-	MySqlLexerMINUS_ASSIGN                      = 1037
-	MySqlLexerMULT_ASSIGN                       = 1038
-	MySqlLexerDIV_ASSIGN                        = 1039
-	MySqlLexerMOD_ASSIGN                        = 1040
-	MySqlLexerAND_ASSIGN                        = 1041
-	MySqlLexerXOR_ASSIGN                        = 1042
-	MySqlLexerOR_ASSIGN                         = 1043
-	MySqlLexerSTAR                              = 1044
-	MySqlLexerDIVIDE                            = 1045
-	MySqlLexerMODULE                            = 1046
-	MySqlLexerPLUS                              = 1047
-	MySqlLexerMINUSMINUS                        = 1048
-	MySqlLexerMINUS                             = 1049
-	MySqlLexerDIV                               = 1050
-	MySqlLexerMOD                               = 1051
-	MySqlLexerEQUAL_SYMBOL                      = 1052
-	MySqlLexerGREATER_SYMBOL                    = 1053
-	MySqlLexerLESS_SYMBOL                       = 1054
-	MySqlLexerEXCLAMATION_SYMBOL                = 1055
-	MySqlLexerBIT_NOT_OP                        = 1056
-	MySqlLexerBIT_OR_OP                         = 1057
-	MySqlLexerBIT_AND_OP                        = 1058
-	MySqlLexerBIT_XOR_OP                        = 1059
-	MySqlLexerDOT                               = 1060
-	MySqlLexerLR_BRACKET                        = 1061
-	MySqlLexerRR_BRACKET                        = 1062
-	MySqlLexerCOMMA                             = 1063
-	MySqlLexerSEMI                              = 1064
-	MySqlLexerAT_SIGN                           = 1065
-	MySqlLexerZERO_DECIMAL                      = 1066
-	MySqlLexerONE_DECIMAL                       = 1067
-	MySqlLexerTWO_DECIMAL                       = 1068
-	MySqlLexerSINGLE_QUOTE_SYMB                 = 1069
-	MySqlLexerDOUBLE_QUOTE_SYMB                 = 1070
-	MySqlLexerREVERSE_QUOTE_SYMB                = 1071
-	MySqlLexerCOLON_SYMB                        = 1072
-	MySqlLexerCHARSET_REVERSE_QOUTE_STRING      = 1073
-	MySqlLexerFILESIZE_LITERAL                  = 1074
-	MySqlLexerSTART_NATIONAL_STRING_LITERAL     = 1075
-	MySqlLexerSTRING_LITERAL                    = 1076
-	MySqlLexerDECIMAL_LITERAL                   = 1077
-	MySqlLexerHEXADECIMAL_LITERAL               = 1078
-	MySqlLexerREAL_LITERAL                      = 1079
-	MySqlLexerNULL_SPEC_LITERAL                 = 1080
-	MySqlLexerBIT_STRING                        = 1081
-	MySqlLexerSTRING_CHARSET_NAME               = 1082
-	MySqlLexerDOT_ID                            = 1083
-	MySqlLexerID                                = 1084
-	MySqlLexerREVERSE_QUOTE_ID                  = 1085
-	MySqlLexerSTRING_USER_NAME                  = 1086
-	MySqlLexerIP_ADDRESS                        = 1087
-	MySqlLexerLOCAL_ID                          = 1088
-	MySqlLexerGLOBAL_ID                         = 1089
-	MySqlLexerERROR_RECONGNIGION                = 1090
+	MySqlLexerINSTANT                           = 419
+	MySqlLexerINVISIBLE                         = 420
+	MySqlLexerINVOKER                           = 421
+	MySqlLexerIO                                = 422
+	MySqlLexerIO_THREAD                         = 423
+	MySqlLexerIPC                               = 424
+	MySqlLexerISOLATION                         = 425
+	MySqlLexerISSUER                            = 426
+	MySqlLexerJSON                              = 427
+	MySqlLexerKEY_BLOCK_SIZE                    = 428
+	MySqlLexerLANGUAGE                          = 429
+	MySqlLexerLAST                              = 430
+	MySqlLexerLEAVES                            = 431
+	MySqlLexerLESS                              = 432
+	MySqlLexerLEVEL                             = 433
+	MySqlLexerLIST                              = 434
+	MySqlLexerLOCAL                             = 435
+	MySqlLexerLOGFILE                           = 436
+	MySqlLexerLOGS                              = 437
+	MySqlLexerMASTER                            = 438
+	MySqlLexerMASTER_AUTO_POSITION              = 439
+	MySqlLexerMASTER_CONNECT_RETRY              = 440
+	MySqlLexerMASTER_DELAY                      = 441
+	MySqlLexerMASTER_HEARTBEAT_PERIOD           = 442
+	MySqlLexerMASTER_HOST                       = 443
+	MySqlLexerMASTER_LOG_FILE                   = 444
+	MySqlLexerMASTER_LOG_POS                    = 445
+	MySqlLexerMASTER_PASSWORD                   = 446
+	MySqlLexerMASTER_PORT                       = 447
+	MySqlLexerMASTER_RETRY_COUNT                = 448
+	MySqlLexerMASTER_SSL                        = 449
+	MySqlLexerMASTER_SSL_CA                     = 450
+	MySqlLexerMASTER_SSL_CAPATH                 = 451
+	MySqlLexerMASTER_SSL_CERT                   = 452
+	MySqlLexerMASTER_SSL_CIPHER                 = 453
+	MySqlLexerMASTER_SSL_CRL                    = 454
+	MySqlLexerMASTER_SSL_CRLPATH                = 455
+	MySqlLexerMASTER_SSL_KEY                    = 456
+	MySqlLexerMASTER_TLS_VERSION                = 457
+	MySqlLexerMASTER_USER                       = 458
+	MySqlLexerMAX_CONNECTIONS_PER_HOUR          = 459
+	MySqlLexerMAX_QUERIES_PER_HOUR              = 460
+	MySqlLexerMAX_ROWS                          = 461
+	MySqlLexerMAX_SIZE                          = 462
+	MySqlLexerMAX_UPDATES_PER_HOUR              = 463
+	MySqlLexerMAX_USER_CONNECTIONS              = 464
+	MySqlLexerMEDIUM                            = 465
+	MySqlLexerMEMBER                            = 466
+	MySqlLexerMERGE                             = 467
+	MySqlLexerMESSAGE_TEXT                      = 468
+	MySqlLexerMID                               = 469
+	MySqlLexerMIGRATE                           = 470
+	MySqlLexerMIN_ROWS                          = 471
+	MySqlLexerMODE                              = 472
+	MySqlLexerMODIFY                            = 473
+	MySqlLexerMUTEX                             = 474
+	MySqlLexerMYSQL                             = 475
+	MySqlLexerMYSQL_ERRNO                       = 476
+	MySqlLexerNAME                              = 477
+	MySqlLexerNAMES                             = 478
+	MySqlLexerNCHAR                             = 479
+	MySqlLexerNEVER                             = 480
+	MySqlLexerNEXT                              = 481
+	MySqlLexerNO                                = 482
+	MySqlLexerNODEGROUP                         = 483
+	MySqlLexerNONE                              = 484
+	MySqlLexerOFFLINE                           = 485
+	MySqlLexerOFFSET                            = 486
+	MySqlLexerOF                                = 487
+	MySqlLexerOJ                                = 488
+	MySqlLexerOLD_PASSWORD                      = 489
+	MySqlLexerONE                               = 490
+	MySqlLexerONLINE                            = 491
+	MySqlLexerONLY                              = 492
+	MySqlLexerOPEN                              = 493
+	MySqlLexerOPTIMIZER_COSTS                   = 494
+	MySqlLexerOPTIONS                           = 495
+	MySqlLexerOWNER                             = 496
+	MySqlLexerPACK_KEYS                         = 497
+	MySqlLexerPAGE                              = 498
+	MySqlLexerPARSER                            = 499
+	MySqlLexerPARTIAL                           = 500
+	MySqlLexerPARTITIONING                      = 501
+	MySqlLexerPARTITIONS                        = 502
+	MySqlLexerPASSWORD                          = 503
+	MySqlLexerPHASE                             = 504
+	MySqlLexerPLUGIN                            = 505
+	MySqlLexerPLUGIN_DIR                        = 506
+	MySqlLexerPLUGINS                           = 507
+	MySqlLexerPORT                              = 508
+	MySqlLexerPRECEDES                          = 509
+	MySqlLexerPREPARE                           = 510
+	MySqlLexerPRESERVE                          = 511
+	MySqlLexerPREV                              = 512
+	MySqlLexerPROCESSLIST                       = 513
+	MySqlLexerPROFILE                           = 514
+	MySqlLexerPROFILES                          = 515
+	MySqlLexerPROXY                             = 516
+	MySqlLexerQUERY                             = 517
+	MySqlLexerQUICK                             = 518
+	MySqlLexerREBUILD                           = 519
+	MySqlLexerRECOVER                           = 520
+	MySqlLexerREDO_BUFFER_SIZE                  = 521
+	MySqlLexerREDUNDANT                         = 522
+	MySqlLexerRELAY                             = 523
+	MySqlLexerRELAY_LOG_FILE                    = 524
+	MySqlLexerRELAY_LOG_POS                     = 525
+	MySqlLexerRELAYLOG                          = 526
+	MySqlLexerREMOVE                            = 527
+	MySqlLexerREORGANIZE                        = 528
+	MySqlLexerREPAIR                            = 529
+	MySqlLexerREPLICATE_DO_DB                   = 530
+	MySqlLexerREPLICATE_DO_TABLE                = 531
+	MySqlLexerREPLICATE_IGNORE_DB               = 532
+	MySqlLexerREPLICATE_IGNORE_TABLE            = 533
+	MySqlLexerREPLICATE_REWRITE_DB              = 534
+	MySqlLexerREPLICATE_WILD_DO_TABLE           = 535
+	MySqlLexerREPLICATE_WILD_IGNORE_TABLE       = 536
+	MySqlLexerREPLICATION                       = 537
+	MySqlLexerRESET                             = 538
+	MySqlLexerRESUME                            = 539
+	MySqlLexerRETURNED_SQLSTATE                 = 540
+	MySqlLexerRETURNING                         = 541
+	MySqlLexerRETURNS                           = 542
+	MySqlLexerROLE                              = 543
+	MySqlLexerROLLBACK                          = 544
+	MySqlLexerROLLUP                            = 545
+	MySqlLexerROTATE                            = 546
+	MySqlLexerROW                               = 547
+	MySqlLexerROWS                              = 548
+	MySqlLexerROW_FORMAT                        = 549
+	MySqlLexerSAVEPOINT                         = 550
+	MySqlLexerSCHEDULE                          = 551
+	MySqlLexerSECURITY                          = 552
+	MySqlLexerSERVER                            = 553
+	MySqlLexerSESSION                           = 554
+	MySqlLexerSHARE                             = 555
+	MySqlLexerSHARED                            = 556
+	MySqlLexerSIGNED                            = 557
+	MySqlLexerSIMPLE                            = 558
+	MySqlLexerSLAVE                             = 559
+	MySqlLexerSLOW                              = 560
+	MySqlLexerSNAPSHOT                          = 561
+	MySqlLexerSOCKET                            = 562
+	MySqlLexerSOME                              = 563
+	MySqlLexerSONAME                            = 564
+	MySqlLexerSOUNDS                            = 565
+	MySqlLexerSOURCE                            = 566
+	MySqlLexerSQL_AFTER_GTIDS                   = 567
+	MySqlLexerSQL_AFTER_MTS_GAPS                = 568
+	MySqlLexerSQL_BEFORE_GTIDS                  = 569
+	MySqlLexerSQL_BUFFER_RESULT                 = 570
+	MySqlLexerSQL_CACHE                         = 571
+	MySqlLexerSQL_NO_CACHE                      = 572
+	MySqlLexerSQL_THREAD                        = 573
+	MySqlLexerSTART                             = 574
+	MySqlLexerSTARTS                            = 575
+	MySqlLexerSTATS_AUTO_RECALC                 = 576
+	MySqlLexerSTATS_PERSISTENT                  = 577
+	MySqlLexerSTATS_SAMPLE_PAGES                = 578
+	MySqlLexerSTATUS                            = 579
+	MySqlLexerSTOP                              = 580
+	MySqlLexerSTORAGE                           = 581
+	MySqlLexerSTORED                            = 582
+	MySqlLexerSTRING                            = 583
+	MySqlLexerSUBCLASS_ORIGIN                   = 584
+	MySqlLexerSUBJECT                           = 585
+	MySqlLexerSUBPARTITION                      = 586
+	MySqlLexerSUBPARTITIONS                     = 587
+	MySqlLexerSUSPEND                           = 588
+	MySqlLexerSWAPS                             = 589
+	MySqlLexerSWITCHES                          = 590
+	MySqlLexerTABLE_NAME                        = 591
+	MySqlLexerTABLESPACE                        = 592
+	MySqlLexerTEMPORARY                         = 593
+	MySqlLexerTEMPTABLE                         = 594
+	MySqlLexerTHAN                              = 595
+	MySqlLexerTRADITIONAL                       = 596
+	MySqlLexerTRANSACTION                       = 597
+	MySqlLexerTRANSACTIONAL                     = 598
+	MySqlLexerTRIGGERS                          = 599
+	MySqlLexerTRUNCATE                          = 600
+	MySqlLexerUNDEFINED                         = 601
+	MySqlLexerUNDOFILE                          = 602
+	MySqlLexerUNDO_BUFFER_SIZE                  = 603
+	MySqlLexerUNINSTALL                         = 604
+	MySqlLexerUNKNOWN                           = 605
+	MySqlLexerUNTIL                             = 606
+	MySqlLexerUPGRADE                           = 607
+	MySqlLexerUSER                              = 608
+	MySqlLexerUSE_FRM                           = 609
+	MySqlLexerUSER_RESOURCES                    = 610
+	MySqlLexerVALIDATION                        = 611
+	MySqlLexerVALUE                             = 612
+	MySqlLexerVARIABLES                         = 613
+	MySqlLexerVIEW                              = 614
+	MySqlLexerVIRTUAL                           = 615
+	MySqlLexerVISIBLE                           = 616
+	MySqlLexerWAIT                              = 617
+	MySqlLexerWARNINGS                          = 618
+	MySqlLexerWITHOUT                           = 619
+	MySqlLexerWORK                              = 620
+	MySqlLexerWRAPPER                           = 621
+	MySqlLexerX509                              = 622
+	MySqlLexerXA                                = 623
+	MySqlLexerXML                               = 624
+	MySqlLexerEUR                               = 625
+	MySqlLexerUSA                               = 626
+	MySqlLexerJIS                               = 627
+	MySqlLexerISO                               = 628
+	MySqlLexerINTERNAL                          = 629
+	MySqlLexerQUARTER                           = 630
+	MySqlLexerMONTH                             = 631
+	MySqlLexerDAY                               = 632
+	MySqlLexerHOUR                              = 633
+	MySqlLexerMINUTE                            = 634
+	MySqlLexerWEEK                              = 635
+	MySqlLexerSECOND                            = 636
+	MySqlLexerMICROSECOND                       = 637
+	MySqlLexerTABLES                            = 638
+	MySqlLexerROUTINE                           = 639
+	MySqlLexerEXECUTE                           = 640
+	MySqlLexerFILE                              = 641
+	MySqlLexerPROCESS                           = 642
+	MySqlLexerRELOAD                            = 643
+	MySqlLexerSHUTDOWN                          = 644
+	MySqlLexerSUPER                             = 645
+	MySqlLexerPRIVILEGES                        = 646
+	MySqlLexerAPPLICATION_PASSWORD_ADMIN        = 647
+	MySqlLexerAUDIT_ADMIN                       = 648
+	MySqlLexerBACKUP_ADMIN                      = 649
+	MySqlLexerBINLOG_ADMIN                      = 650
+	MySqlLexerBINLOG_ENCRYPTION_ADMIN           = 651
+	MySqlLexerCLONE_ADMIN                       = 652
+	MySqlLexerCONNECTION_ADMIN                  = 653
+	MySqlLexerENCRYPTION_KEY_ADMIN              = 654
+	MySqlLexerFIREWALL_ADMIN                    = 655
+	MySqlLexerFIREWALL_USER                     = 656
+	MySqlLexerFLUSH_OPTIMIZER_COSTS             = 657
+	MySqlLexerFLUSH_STATUS                      = 658
+	MySqlLexerFLUSH_TABLES                      = 659
+	MySqlLexerFLUSH_USER_RESOURCES              = 660
+	MySqlLexerGROUP_REPLICATION_ADMIN           = 661
+	MySqlLexerINNODB_REDO_LOG_ARCHIVE           = 662
+	MySqlLexerINNODB_REDO_LOG_ENABLE            = 663
+	MySqlLexerNDB_STORED_USER                   = 664
+	MySqlLexerPERSIST_RO_VARIABLES_ADMIN        = 665
+	MySqlLexerREPLICATION_APPLIER               = 666
+	MySqlLexerREPLICATION_SLAVE_ADMIN           = 667
+	MySqlLexerRESOURCE_GROUP_ADMIN              = 668
+	MySqlLexerRESOURCE_GROUP_USER               = 669
+	MySqlLexerROLE_ADMIN                        = 670
+	MySqlLexerSERVICE_CONNECTION_ADMIN          = 671
+	MySqlLexerSESSION_VARIABLES_ADMIN           = 672
+	MySqlLexerSET_USER_ID                       = 673
+	MySqlLexerSHOW_ROUTINE                      = 674
+	MySqlLexerSYSTEM_VARIABLES_ADMIN            = 675
+	MySqlLexerTABLE_ENCRYPTION_ADMIN            = 676
+	MySqlLexerVERSION_TOKEN_ADMIN               = 677
+	MySqlLexerXA_RECOVER_ADMIN                  = 678
+	MySqlLexerARMSCII8                          = 679
+	MySqlLexerASCII                             = 680
+	MySqlLexerBIG5                              = 681
+	MySqlLexerCP1250                            = 682
+	MySqlLexerCP1251                            = 683
+	MySqlLexerCP1256                            = 684
+	MySqlLexerCP1257                            = 685
+	MySqlLexerCP850                             = 686
+	MySqlLexerCP852                             = 687
+	MySqlLexerCP866                             = 688
+	MySqlLexerCP932                             = 689
+	MySqlLexerDEC8                              = 690
+	MySqlLexerEUCJPMS                           = 691
+	MySqlLexerEUCKR                             = 692
+	MySqlLexerGB2312                            = 693
+	MySqlLexerGBK                               = 694
+	MySqlLexerGEOSTD8                           = 695
+	MySqlLexerGREEK                             = 696
+	MySqlLexerHEBREW                            = 697
+	MySqlLexerHP8                               = 698
+	MySqlLexerKEYBCS2                           = 699
+	MySqlLexerKOI8R                             = 700
+	MySqlLexerKOI8U                             = 701
+	MySqlLexerLATIN1                            = 702
+	MySqlLexerLATIN2                            = 703
+	MySqlLexerLATIN5                            = 704
+	MySqlLexerLATIN7                            = 705
+	MySqlLexerMACCE                             = 706
+	MySqlLexerMACROMAN                          = 707
+	MySqlLexerSJIS                              = 708
+	MySqlLexerSWE7                              = 709
+	MySqlLexerTIS620                            = 710
+	MySqlLexerUCS2                              = 711
+	MySqlLexerUJIS                              = 712
+	MySqlLexerUTF16                             = 713
+	MySqlLexerUTF16LE                           = 714
+	MySqlLexerUTF32                             = 715
+	MySqlLexerUTF8                              = 716
+	MySqlLexerUTF8MB3                           = 717
+	MySqlLexerUTF8MB4                           = 718
+	MySqlLexerARCHIVE                           = 719
+	MySqlLexerBLACKHOLE                         = 720
+	MySqlLexerCSV                               = 721
+	MySqlLexerFEDERATED                         = 722
+	MySqlLexerINNODB                            = 723
+	MySqlLexerMEMORY                            = 724
+	MySqlLexerMRG_MYISAM                        = 725
+	MySqlLexerMYISAM                            = 726
+	MySqlLexerNDB                               = 727
+	MySqlLexerNDBCLUSTER                        = 728
+	MySqlLexerPERFORMANCE_SCHEMA                = 729
+	MySqlLexerTOKUDB                            = 730
+	MySqlLexerREPEATABLE                        = 731
+	MySqlLexerCOMMITTED                         = 732
+	MySqlLexerUNCOMMITTED                       = 733
+	MySqlLexerSERIALIZABLE                      = 734
+	MySqlLexerGEOMETRYCOLLECTION                = 735
+	MySqlLexerGEOMCOLLECTION                    = 736
+	MySqlLexerGEOMETRY                          = 737
+	MySqlLexerLINESTRING                        = 738
+	MySqlLexerMULTILINESTRING                   = 739
+	MySqlLexerMULTIPOINT                        = 740
+	MySqlLexerMULTIPOLYGON                      = 741
+	MySqlLexerPOINT                             = 742
+	MySqlLexerPOLYGON                           = 743
+	MySqlLexerABS                               = 744
+	MySqlLexerACOS                              = 745
+	MySqlLexerADDDATE                           = 746
+	MySqlLexerADDTIME                           = 747
+	MySqlLexerAES_DECRYPT                       = 748
+	MySqlLexerAES_ENCRYPT                       = 749
+	MySqlLexerAREA                              = 750
+	MySqlLexerASBINARY                          = 751
+	MySqlLexerASIN                              = 752
+	MySqlLexerASTEXT                            = 753
+	MySqlLexerASWKB                             = 754
+	MySqlLexerASWKT                             = 755
+	MySqlLexerASYMMETRIC_DECRYPT                = 756
+	MySqlLexerASYMMETRIC_DERIVE                 = 757
+	MySqlLexerASYMMETRIC_ENCRYPT                = 758
+	MySqlLexerASYMMETRIC_SIGN                   = 759
+	MySqlLexerASYMMETRIC_VERIFY                 = 760
+	MySqlLexerATAN                              = 761
+	MySqlLexerATAN2                             = 762
+	MySqlLexerBENCHMARK                         = 763
+	MySqlLexerBIN                               = 764
+	MySqlLexerBIT_COUNT                         = 765
+	MySqlLexerBIT_LENGTH                        = 766
+	MySqlLexerBUFFER                            = 767
+	MySqlLexerCATALOG_NAME                      = 768
+	MySqlLexerCEIL                              = 769
+	MySqlLexerCEILING                           = 770
+	MySqlLexerCENTROID                          = 771
+	MySqlLexerCHARACTER_LENGTH                  = 772
+	MySqlLexerCHARSET                           = 773
+	MySqlLexerCHAR_LENGTH                       = 774
+	MySqlLexerCOERCIBILITY                      = 775
+	MySqlLexerCOLLATION                         = 776
+	MySqlLexerCOMPRESS                          = 777
+	MySqlLexerCONCAT                            = 778
+	MySqlLexerCONCAT_WS                         = 779
+	MySqlLexerCONNECTION_ID                     = 780
+	MySqlLexerCONV                              = 781
+	MySqlLexerCONVERT_TZ                        = 782
+	MySqlLexerCOS                               = 783
+	MySqlLexerCOT                               = 784
+	MySqlLexerCRC32                             = 785
+	MySqlLexerCREATE_ASYMMETRIC_PRIV_KEY        = 786
+	MySqlLexerCREATE_ASYMMETRIC_PUB_KEY         = 787
+	MySqlLexerCREATE_DH_PARAMETERS              = 788
+	MySqlLexerCREATE_DIGEST                     = 789
+	MySqlLexerCROSSES                           = 790
+	MySqlLexerDATEDIFF                          = 791
+	MySqlLexerDATE_FORMAT                       = 792
+	MySqlLexerDAYNAME                           = 793
+	MySqlLexerDAYOFMONTH                        = 794
+	MySqlLexerDAYOFWEEK                         = 795
+	MySqlLexerDAYOFYEAR                         = 796
+	MySqlLexerDECODE                            = 797
+	MySqlLexerDEGREES                           = 798
+	MySqlLexerDES_DECRYPT                       = 799
+	MySqlLexerDES_ENCRYPT                       = 800
+	MySqlLexerDIMENSION                         = 801
+	MySqlLexerDISJOINT                          = 802
+	MySqlLexerELT                               = 803
+	MySqlLexerENCODE                            = 804
+	MySqlLexerENCRYPT                           = 805
+	MySqlLexerENDPOINT                          = 806
+	MySqlLexerENVELOPE                          = 807
+	MySqlLexerEQUALS                            = 808
+	MySqlLexerEXP                               = 809
+	MySqlLexerEXPORT_SET                        = 810
+	MySqlLexerEXTERIORRING                      = 811
+	MySqlLexerEXTRACTVALUE                      = 812
+	MySqlLexerFIELD                             = 813
+	MySqlLexerFIND_IN_SET                       = 814
+	MySqlLexerFLOOR                             = 815
+	MySqlLexerFORMAT                            = 816
+	MySqlLexerFOUND_ROWS                        = 817
+	MySqlLexerFROM_BASE64                       = 818
+	MySqlLexerFROM_DAYS                         = 819
+	MySqlLexerFROM_UNIXTIME                     = 820
+	MySqlLexerGEOMCOLLFROMTEXT                  = 821
+	MySqlLexerGEOMCOLLFROMWKB                   = 822
+	MySqlLexerGEOMETRYCOLLECTIONFROMTEXT        = 823
+	MySqlLexerGEOMETRYCOLLECTIONFROMWKB         = 824
+	MySqlLexerGEOMETRYFROMTEXT                  = 825
+	MySqlLexerGEOMETRYFROMWKB                   = 826
+	MySqlLexerGEOMETRYN                         = 827
+	MySqlLexerGEOMETRYTYPE                      = 828
+	MySqlLexerGEOMFROMTEXT                      = 829
+	MySqlLexerGEOMFROMWKB                       = 830
+	MySqlLexerGET_FORMAT                        = 831
+	MySqlLexerGET_LOCK                          = 832
+	MySqlLexerGLENGTH                           = 833
+	MySqlLexerGREATEST                          = 834
+	MySqlLexerGTID_SUBSET                       = 835
+	MySqlLexerGTID_SUBTRACT                     = 836
+	MySqlLexerHEX                               = 837
+	MySqlLexerIFNULL                            = 838
+	MySqlLexerINET6_ATON                        = 839
+	MySqlLexerINET6_NTOA                        = 840
+	MySqlLexerINET_ATON                         = 841
+	MySqlLexerINET_NTOA                         = 842
+	MySqlLexerINSTR                             = 843
+	MySqlLexerINTERIORRINGN                     = 844
+	MySqlLexerINTERSECTS                        = 845
+	MySqlLexerISCLOSED                          = 846
+	MySqlLexerISEMPTY                           = 847
+	MySqlLexerISNULL                            = 848
+	MySqlLexerISSIMPLE                          = 849
+	MySqlLexerIS_FREE_LOCK                      = 850
+	MySqlLexerIS_IPV4                           = 851
+	MySqlLexerIS_IPV4_COMPAT                    = 852
+	MySqlLexerIS_IPV4_MAPPED                    = 853
+	MySqlLexerIS_IPV6                           = 854
+	MySqlLexerIS_USED_LOCK                      = 855
+	MySqlLexerLAST_INSERT_ID                    = 856
+	MySqlLexerLCASE                             = 857
+	MySqlLexerLEAST                             = 858
+	MySqlLexerLENGTH                            = 859
+	MySqlLexerLINEFROMTEXT                      = 860
+	MySqlLexerLINEFROMWKB                       = 861
+	MySqlLexerLINESTRINGFROMTEXT                = 862
+	MySqlLexerLINESTRINGFROMWKB                 = 863
+	MySqlLexerLN                                = 864
+	MySqlLexerLOAD_FILE                         = 865
+	MySqlLexerLOCATE                            = 866
+	MySqlLexerLOG                               = 867
+	MySqlLexerLOG10                             = 868
+	MySqlLexerLOG2                              = 869
+	MySqlLexerLOWER                             = 870
+	MySqlLexerLPAD                              = 871
+	MySqlLexerLTRIM                             = 872
+	MySqlLexerMAKEDATE                          = 873
+	MySqlLexerMAKETIME                          = 874
+	MySqlLexerMAKE_SET                          = 875
+	MySqlLexerMASTER_POS_WAIT                   = 876
+	MySqlLexerMBRCONTAINS                       = 877
+	MySqlLexerMBRDISJOINT                       = 878
+	MySqlLexerMBREQUAL                          = 879
+	MySqlLexerMBRINTERSECTS                     = 880
+	MySqlLexerMBROVERLAPS                       = 881
+	MySqlLexerMBRTOUCHES                        = 882
+	MySqlLexerMBRWITHIN                         = 883
+	MySqlLexerMD5                               = 884
+	MySqlLexerMLINEFROMTEXT                     = 885
+	MySqlLexerMLINEFROMWKB                      = 886
+	MySqlLexerMONTHNAME                         = 887
+	MySqlLexerMPOINTFROMTEXT                    = 888
+	MySqlLexerMPOINTFROMWKB                     = 889
+	MySqlLexerMPOLYFROMTEXT                     = 890
+	MySqlLexerMPOLYFROMWKB                      = 891
+	MySqlLexerMULTILINESTRINGFROMTEXT           = 892
+	MySqlLexerMULTILINESTRINGFROMWKB            = 893
+	MySqlLexerMULTIPOINTFROMTEXT                = 894
+	MySqlLexerMULTIPOINTFROMWKB                 = 895
+	MySqlLexerMULTIPOLYGONFROMTEXT              = 896
+	MySqlLexerMULTIPOLYGONFROMWKB               = 897
+	MySqlLexerNAME_CONST                        = 898
+	MySqlLexerNULLIF                            = 899
+	MySqlLexerNUMGEOMETRIES                     = 900
+	MySqlLexerNUMINTERIORRINGS                  = 901
+	MySqlLexerNUMPOINTS                         = 902
+	MySqlLexerOCT                               = 903
+	MySqlLexerOCTET_LENGTH                      = 904
+	MySqlLexerORD                               = 905
+	MySqlLexerOVERLAPS                          = 906
+	MySqlLexerPERIOD_ADD                        = 907
+	MySqlLexerPERIOD_DIFF                       = 908
+	MySqlLexerPI                                = 909
+	MySqlLexerPOINTFROMTEXT                     = 910
+	MySqlLexerPOINTFROMWKB                      = 911
+	MySqlLexerPOINTN                            = 912
+	MySqlLexerPOLYFROMTEXT                      = 913
+	MySqlLexerPOLYFROMWKB                       = 914
+	MySqlLexerPOLYGONFROMTEXT                   = 915
+	MySqlLexerPOLYGONFROMWKB                    = 916
+	MySqlLexerPOW                               = 917
+	MySqlLexerPOWER                             = 918
+	MySqlLexerQUOTE                             = 919
+	MySqlLexerRADIANS                           = 920
+	MySqlLexerRAND                              = 921
+	MySqlLexerRANDOM_BYTES                      = 922
+	MySqlLexerRELEASE_LOCK                      = 923
+	MySqlLexerREVERSE                           = 924
+	MySqlLexerROUND                             = 925
+	MySqlLexerROW_COUNT                         = 926
+	MySqlLexerRPAD                              = 927
+	MySqlLexerRTRIM                             = 928
+	MySqlLexerSEC_TO_TIME                       = 929
+	MySqlLexerSESSION_USER                      = 930
+	MySqlLexerSHA                               = 931
+	MySqlLexerSHA1                              = 932
+	MySqlLexerSHA2                              = 933
+	MySqlLexerSCHEMA_NAME                       = 934
+	MySqlLexerSIGN                              = 935
+	MySqlLexerSIN                               = 936
+	MySqlLexerSLEEP                             = 937
+	MySqlLexerSOUNDEX                           = 938
+	MySqlLexerSQL_THREAD_WAIT_AFTER_GTIDS       = 939
+	MySqlLexerSQRT                              = 940
+	MySqlLexerSRID                              = 941
+	MySqlLexerSTARTPOINT                        = 942
+	MySqlLexerSTRCMP                            = 943
+	MySqlLexerSTR_TO_DATE                       = 944
+	MySqlLexerST_AREA                           = 945
+	MySqlLexerST_ASBINARY                       = 946
+	MySqlLexerST_ASTEXT                         = 947
+	MySqlLexerST_ASWKB                          = 948
+	MySqlLexerST_ASWKT                          = 949
+	MySqlLexerST_BUFFER                         = 950
+	MySqlLexerST_CENTROID                       = 951
+	MySqlLexerST_CONTAINS                       = 952
+	MySqlLexerST_CROSSES                        = 953
+	MySqlLexerST_DIFFERENCE                     = 954
+	MySqlLexerST_DIMENSION                      = 955
+	MySqlLexerST_DISJOINT                       = 956
+	MySqlLexerST_DISTANCE                       = 957
+	MySqlLexerST_ENDPOINT                       = 958
+	MySqlLexerST_ENVELOPE                       = 959
+	MySqlLexerST_EQUALS                         = 960
+	MySqlLexerST_EXTERIORRING                   = 961
+	MySqlLexerST_GEOMCOLLFROMTEXT               = 962
+	MySqlLexerST_GEOMCOLLFROMTXT                = 963
+	MySqlLexerST_GEOMCOLLFROMWKB                = 964
+	MySqlLexerST_GEOMETRYCOLLECTIONFROMTEXT     = 965
+	MySqlLexerST_GEOMETRYCOLLECTIONFROMWKB      = 966
+	MySqlLexerST_GEOMETRYFROMTEXT               = 967
+	MySqlLexerST_GEOMETRYFROMWKB                = 968
+	MySqlLexerST_GEOMETRYN                      = 969
+	MySqlLexerST_GEOMETRYTYPE                   = 970
+	MySqlLexerST_GEOMFROMTEXT                   = 971
+	MySqlLexerST_GEOMFROMWKB                    = 972
+	MySqlLexerST_INTERIORRINGN                  = 973
+	MySqlLexerST_INTERSECTION                   = 974
+	MySqlLexerST_INTERSECTS                     = 975
+	MySqlLexerST_ISCLOSED                       = 976
+	MySqlLexerST_ISEMPTY                        = 977
+	MySqlLexerST_ISSIMPLE                       = 978
+	MySqlLexerST_LINEFROMTEXT                   = 979
+	MySqlLexerST_LINEFROMWKB                    = 980
+	MySqlLexerST_LINESTRINGFROMTEXT             = 981
+	MySqlLexerST_LINESTRINGFROMWKB              = 982
+	MySqlLexerST_NUMGEOMETRIES                  = 983
+	MySqlLexerST_NUMINTERIORRING                = 984
+	MySqlLexerST_NUMINTERIORRINGS               = 985
+	MySqlLexerST_NUMPOINTS                      = 986
+	MySqlLexerST_OVERLAPS                       = 987
+	MySqlLexerST_POINTFROMTEXT                  = 988
+	MySqlLexerST_POINTFROMWKB                   = 989
+	MySqlLexerST_POINTN                         = 990
+	MySqlLexerST_POLYFROMTEXT                   = 991
+	MySqlLexerST_POLYFROMWKB                    = 992
+	MySqlLexerST_POLYGONFROMTEXT                = 993
+	MySqlLexerST_POLYGONFROMWKB                 = 994
+	MySqlLexerST_SRID                           = 995
+	MySqlLexerST_STARTPOINT                     = 996
+	MySqlLexerST_SYMDIFFERENCE                  = 997
+	MySqlLexerST_TOUCHES                        = 998
+	MySqlLexerST_UNION                          = 999
+	MySqlLexerST_WITHIN                         = 1000
+	MySqlLexerST_X                              = 1001
+	MySqlLexerST_Y                              = 1002
+	MySqlLexerSUBDATE                           = 1003
+	MySqlLexerSUBSTRING_INDEX                   = 1004
+	MySqlLexerSUBTIME                           = 1005
+	MySqlLexerSYSTEM_USER                       = 1006
+	MySqlLexerTAN                               = 1007
+	MySqlLexerTIMEDIFF                          = 1008
+	MySqlLexerTIMESTAMPADD                      = 1009
+	MySqlLexerTIMESTAMPDIFF                     = 1010
+	MySqlLexerTIME_FORMAT                       = 1011
+	MySqlLexerTIME_TO_SEC                       = 1012
+	MySqlLexerTOUCHES                           = 1013
+	MySqlLexerTO_BASE64                         = 1014
+	MySqlLexerTO_DAYS                           = 1015
+	MySqlLexerTO_SECONDS                        = 1016
+	MySqlLexerUCASE                             = 1017
+	MySqlLexerUNCOMPRESS                        = 1018
+	MySqlLexerUNCOMPRESSED_LENGTH               = 1019
+	MySqlLexerUNHEX                             = 1020
+	MySqlLexerUNIX_TIMESTAMP                    = 1021
+	MySqlLexerUPDATEXML                         = 1022
+	MySqlLexerUPPER                             = 1023
+	MySqlLexerUUID                              = 1024
+	MySqlLexerUUID_SHORT                        = 1025
+	MySqlLexerVALIDATE_PASSWORD_STRENGTH        = 1026
+	MySqlLexerVERSION                           = 1027
+	MySqlLexerWAIT_UNTIL_SQL_THREAD_AFTER_GTIDS = 1028
+	MySqlLexerWEEKDAY                           = 1029
+	MySqlLexerWEEKOFYEAR                        = 1030
+	MySqlLexerWEIGHT_STRING                     = 1031
+	MySqlLexerWITHIN                            = 1032
+	MySqlLexerYEARWEEK                          = 1033
+	MySqlLexerY_FUNCTION                        = 1034
+	MySqlLexerX_FUNCTION                        = 1035
+	MySqlLexerVAR_ASSIGN                        = 1036
+	MySqlLexerPLUS_ASSIGN                       = 1037
+	MySqlLexerMINUS_ASSIGN                      = 1038
+	MySqlLexerMULT_ASSIGN                       = 1039
+	MySqlLexerDIV_ASSIGN                        = 1040
+	MySqlLexerMOD_ASSIGN                        = 1041
+	MySqlLexerAND_ASSIGN                        = 1042
+	MySqlLexerXOR_ASSIGN                        = 1043
+	MySqlLexerOR_ASSIGN                         = 1044
+	MySqlLexerSTAR                              = 1045
+	MySqlLexerDIVIDE                            = 1046
+	MySqlLexerMODULE                            = 1047
+	MySqlLexerPLUS                              = 1048
+	MySqlLexerMINUSMINUS                        = 1049
+	MySqlLexerMINUS                             = 1050
+	MySqlLexerDIV                               = 1051
+	MySqlLexerMOD                               = 1052
+	MySqlLexerEQUAL_SYMBOL                      = 1053
+	MySqlLexerGREATER_SYMBOL                    = 1054
+	MySqlLexerLESS_SYMBOL                       = 1055
+	MySqlLexerEXCLAMATION_SYMBOL                = 1056
+	MySqlLexerBIT_NOT_OP                        = 1057
+	MySqlLexerBIT_OR_OP                         = 1058
+	MySqlLexerBIT_AND_OP                        = 1059
+	MySqlLexerBIT_XOR_OP                        = 1060
+	MySqlLexerDOT                               = 1061
+	MySqlLexerLR_BRACKET                        = 1062
+	MySqlLexerRR_BRACKET                        = 1063
+	MySqlLexerCOMMA                             = 1064
+	MySqlLexerSEMI                              = 1065
+	MySqlLexerAT_SIGN                           = 1066
+	MySqlLexerZERO_DECIMAL                      = 1067
+	MySqlLexerONE_DECIMAL                       = 1068
+	MySqlLexerTWO_DECIMAL                       = 1069
+	MySqlLexerSINGLE_QUOTE_SYMB                 = 1070
+	MySqlLexerDOUBLE_QUOTE_SYMB                 = 1071
+	MySqlLexerREVERSE_QUOTE_SYMB                = 1072
+	MySqlLexerCOLON_SYMB                        = 1073
+	MySqlLexerCHARSET_REVERSE_QOUTE_STRING      = 1074
+	MySqlLexerFILESIZE_LITERAL                  = 1075
+	MySqlLexerSTART_NATIONAL_STRING_LITERAL     = 1076
+	MySqlLexerSTRING_LITERAL                    = 1077
+	MySqlLexerDECIMAL_LITERAL                   = 1078
+	MySqlLexerHEXADECIMAL_LITERAL               = 1079
+	MySqlLexerREAL_LITERAL                      = 1080
+	MySqlLexerNULL_SPEC_LITERAL                 = 1081
+	MySqlLexerBIT_STRING                        = 1082
+	MySqlLexerSTRING_CHARSET_NAME               = 1083
+	MySqlLexerDOT_ID                            = 1084
+	MySqlLexerID                                = 1085
+	MySqlLexerREVERSE_QUOTE_ID                  = 1086
+	MySqlLexerSTRING_USER_NAME                  = 1087
+	MySqlLexerIP_ADDRESS                        = 1088
+	MySqlLexerLOCAL_ID                          = 1089
+	MySqlLexerGLOBAL_ID                         = 1090
+	MySqlLexerERROR_RECONGNIGION                = 1091
 )
 
 // MySqlLexer channels.
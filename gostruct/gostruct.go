@@ -0,0 +1,275 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+// Package gostruct generates the Go struct definitions of parsed tables, the fields are tagged
+// with the configured tag sets, the comments of columns are kept as the comments of fields,
+// and the values of ENUM columns are declared as constants.
+package gostruct
+
+import (
+	"fmt"
+	"go/format"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/zeromicro/ddl-parser/gotype"
+	"github.com/zeromicro/ddl-parser/parser"
+)
+
+// Tag describes a tag set of struct fields.
+type Tag string
+
+const (
+	// TagDB tags the fields with the column names for database/sql based libraries, such as db:"id".
+	TagDB Tag = "db"
+	// TagJSON tags the fields with the column names for encoding/json, such as json:"id".
+	TagJSON Tag = "json"
+	// TagGorm tags the fields with the column names and attributes for gorm, such as
+	// gorm:"column:id;primaryKey;autoIncrement".
+	TagGorm Tag = "gorm"
+	// TagSqlx tags the fields with the column names for sqlx, it's the same as TagDB.
+	TagSqlx Tag = "sqlx"
+)
+
+// Generator generates the Go struct definitions, you can use NewGenerator to create an
+// instance with options, WithPackage option sets the package name, WithTags option sets the
+// tag sets of fields, WithMapper option sets the mapper of Go types, WithInitialisms option
+// adds the initialisms of names.
+type Generator struct {
+	packageName string
+	tags        []Tag
+	mapper      *gotype.Mapper
+	initialisms map[string]bool
+}
+
+// Option is the alias of function.
+type Option func(g *Generator)
+
+// NewGenerator creates an instance of Generator.
+func NewGenerator(options ...Option) *Generator {
+	g := &Generator{
+		packageName: "model",
+		tags:        []Tag{TagDB, TagJSON},
+		initialisms: make(map[string]bool),
+	}
+	for _, e := range commonInitialisms {
+		g.initialisms[e] = true
+	}
+	for _, opt := range options {
+		opt(g)
+	}
+
+	if g.mapper == nil {
+		g.mapper = gotype.NewMapper()
+	}
+
+	return g
+}
+
+// WithPackage is a Generator option to set the package name of generated code, the default
+// package name is model.
+func WithPackage(name string) Option {
+	return func(g *Generator) {
+		g.packageName = name
+	}
+}
+
+// WithTags is a Generator option to set the tag sets of fields, the default tag sets are
+// TagDB and TagJSON.
+func WithTags(tags ...Tag) Option {
+	return func(g *Generator) {
+		g.tags = tags
+	}
+}
+
+// WithMapper is a Generator option to set the mapper of Go types.
+func WithMapper(mapper *gotype.Mapper) Option {
+	return func(g *Generator) {
+		g.mapper = mapper
+	}
+}
+
+// WithInitialisms is a Generator option to add the initialisms which are written in upper
+// case, such as SKU.
+func WithInitialisms(initialisms ...string) Option {
+	return func(g *Generator) {
+		for _, e := range initialisms {
+			g.initialisms[strings.ToUpper(e)] = true
+		}
+	}
+}
+
+// Generate returns the formatted Go source which declares a struct for each table. The names
+// which collide with the declared names are suffixed with numbers, such as UserID2 for the
+// columns user_id and userId.
+func (g *Generator) Generate(tables ...*parser.Table) ([]byte, error) {
+	var body strings.Builder
+	imports := make(map[string]bool)
+	names := make(scope)
+	// the struct names are declared before the constants of ENUM values
+	structs := make([]string, len(tables))
+	for i, e := range tables {
+		name := g.camelCase(e.Name)
+		if len(name) == 0 {
+			name = fmt.Sprintf("Table%d", i+1)
+		}
+		structs[i] = names.declare(name)
+	}
+	for i, e := range tables {
+		g.writeTable(&body, structs[i], e, names, imports)
+	}
+
+	var b strings.Builder
+	b.WriteString("// Code generated by gostruct. DO NOT EDIT.\n\n")
+	b.WriteString("package " + g.packageName + "\n\n")
+	if len(imports) > 0 {
+		var list []string
+		for e := range imports {
+			list = append(list, e)
+		}
+		sort.Strings(list)
+
+		b.WriteString("import (\n")
+		for _, e := range list {
+			b.WriteString(strconv.Quote(e) + "\n")
+		}
+		b.WriteString(")\n\n")
+	}
+	b.WriteString(body.String())
+
+	ret, err := format.Source([]byte(b.String()))
+	if err != nil {
+		return nil, fmt.Errorf("format generated code: %w", err)
+	}
+
+	return ret, nil
+}
+
+func (g *Generator) writeTable(b *strings.Builder, name string, table *parser.Table, names scope,
+	imports map[string]bool) {
+	for i, e := range table.Columns {
+		if e.DataType != nil && e.DataType.Type() == parser.Enum {
+			g.writeEnum(b, name+g.fieldName(i, e), e, names)
+		}
+	}
+
+	fmt.Fprintf(b, "// %s describes the table %s.\n", name, table.Name)
+	if option := tableComment(table); len(option) > 0 {
+		writeComment(b, option)
+	}
+	b.WriteString("type " + name + " struct {\n")
+	fields := make(scope)
+	for i, e := range table.Columns {
+		tp := g.mapper.GoType(table, e)
+		if len(tp.Import) > 0 {
+			imports[tp.Import] = true
+		}
+
+		if e.Constraint != nil && len(e.Constraint.Comment) > 0 {
+			writeComment(b, e.Constraint.Comment)
+		}
+		fmt.Fprintf(b, "%s %s %s\n", fields.declare(g.fieldName(i, e)), tp.Name, g.tag(table, e))
+	}
+	b.WriteString("}\n\n")
+}
+
+// fieldName returns the name of field of the i-th column, such as Field1 if the name of column
+// has no letters or digits.
+func (g *Generator) fieldName(i int, column *parser.Column) string {
+	if name := g.camelCase(column.Name); len(name) > 0 {
+		return name
+	}
+
+	return fmt.Sprintf("Field%d", i+1)
+}
+
+// writeEnum writes the values of ENUM column as constants, such as UserStatusOn = "on".
+func (g *Generator) writeEnum(b *strings.Builder, prefix string, column *parser.Column, names scope) {
+	fmt.Fprintf(b, "// The values of %s.\n", prefix)
+	b.WriteString("const (\n")
+	for _, e := range column.DataType.Value() {
+		value := parser.Unescape(e)
+		name := g.camelCase(value)
+		if len(name) == 0 {
+			name = "Empty"
+		}
+		fmt.Fprintf(b, "%s = %s\n", names.declare(prefix+name), strconv.Quote(value))
+	}
+	b.WriteString(")\n\n")
+}
+
+// tag returns the tag of field, such as `db:"id" json:"id"`.
+func (g *Generator) tag(table *parser.Table, column *parser.Column) string {
+	var list []string
+	seen := make(map[string]bool)
+	for _, e := range g.tags {
+		key, value := string(e), column.Name
+		switch e {
+		case TagSqlx:
+			key = string(TagDB)
+		case TagGorm:
+			value = gormTag(table, column)
+		}
+		if seen[key] {
+			continue
+		}
+
+		seen[key] = true
+		list = append(list, key+":"+strconv.Quote(value))
+	}
+	if len(list) == 0 {
+		return ""
+	}
+
+	return "`" + strings.Join(list, " ") + "`"
+}
+
+func gormTag(table *parser.Table, column *parser.Column) string {
+	list := []string{"column:" + column.Name}
+	if table.IsPrimaryKey(column.Name) {
+		list = append(list, "primaryKey")
+	}
+
+	c := column.Constraint
+	if c != nil && c.AutoIncrement {
+		list = append(list, "autoIncrement")
+	}
+	if table.IsNotNull(column) {
+		list = append(list, "not null")
+	}
+	if c != nil && len(c.DefaultValue) > 0 && !strings.EqualFold(c.DefaultValue, "NULL") {
+		list = append(list, "default:"+strings.Trim(c.DefaultValue, "'"))
+	}
+
+	return strings.Join(list, ";")
+}
+
+func tableComment(table *parser.Table) string {
+	for _, e := range table.Options {
+		if e.Name == parser.TableOptionComment {
+			return e.Value
+		}
+	}
+
+	return ""
+}
+
+func writeComment(b *strings.Builder, comment string) {
+	for _, e := range strings.Split(parser.Unescape(comment), "\n") {
+		b.WriteString("// " + strings.TrimSpace(e) + "\n")
+	}
+}
@@ -0,0 +1,120 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package gostruct
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zeromicro/ddl-parser/internal/testutil"
+)
+
+const schemaSql = "CREATE TABLE `user_info` (\n" +
+	"  `id` bigint unsigned NOT NULL AUTO_INCREMENT,\n" +
+	"  `name` varchar(64) NOT NULL DEFAULT '' COMMENT 'user''s name',\n" +
+	"  `avatar_url` varchar(255),\n" +
+	"  `status` enum('on','off-line') NOT NULL DEFAULT 'on',\n" +
+	"  `created_at` datetime NOT NULL,\n" +
+	"  PRIMARY KEY (`id`)\n" +
+	") COMMENT='users';"
+
+func TestGenerator_Generate(t *testing.T) {
+	actual, err := NewGenerator().Generate(testutil.Tables(t, schemaSql)...)
+	assert.NoError(t, err)
+	assert.Equal(t, `// Code generated by gostruct. DO NOT EDIT.
+
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+// The values of UserInfoStatus.
+const (
+	UserInfoStatusOn      = "on"
+	UserInfoStatusOffLine = "off-line"
+)
+
+// UserInfo describes the table user_info.
+// users
+type UserInfo struct {
+	ID uint64 `+"`db:\"id\" json:\"id\"`"+`
+	// user's name
+	Name      string         `+"`db:\"name\" json:\"name\"`"+`
+	AvatarURL sql.NullString `+"`db:\"avatar_url\" json:\"avatar_url\"`"+`
+	Status    string         `+"`db:\"status\" json:\"status\"`"+`
+	CreatedAt time.Time      `+"`db:\"created_at\" json:\"created_at\"`"+`
+}
+`, string(actual))
+}
+
+func TestGenerator_options(t *testing.T) {
+	actual, err := NewGenerator(WithPackage("entity"), WithTags(TagSqlx, TagDB, TagGorm)).Generate(testutil.Tables(t, schemaSql)...)
+	assert.NoError(t, err)
+	assert.Contains(t, string(actual), "package entity\n")
+	assert.Contains(t, string(actual), "`db:\"id\" gorm:\"column:id;primaryKey;autoIncrement;not null\"`")
+	assert.Contains(t, string(actual), "`db:\"status\" gorm:\"column:status;not null;default:on\"`")
+
+	// the columns of primary key are implicitly NOT NULL
+	actual, err = NewGenerator(WithTags(TagGorm)).Generate(testutil.Tables(t, "CREATE TABLE `user` (`id` bigint PRIMARY KEY, `name` varchar(64));")...)
+	assert.NoError(t, err)
+	assert.Contains(t, string(actual), "`gorm:\"column:id;primaryKey;not null\"`")
+	assert.Contains(t, string(actual), "`gorm:\"column:name\"`")
+
+	actual, err = NewGenerator(WithTags(), WithInitialisms("info")).Generate(testutil.Tables(t, schemaSql)...)
+	assert.NoError(t, err)
+	assert.Contains(t, string(actual), "type UserINFO struct {\n\tID uint64\n\t// user's name\n\tName      string\n")
+}
+
+func TestGenerator_camelCase(t *testing.T) {
+	g := NewGenerator()
+	testData := map[string]string{
+		"id":          "ID",
+		"user_id":     "UserID",
+		"avatarUrl":   "AvatarURL",
+		"HTTP_STATUS": "HTTPStatus",
+		"2fa code":    "X2faCode",
+		"json-data":   "JSONData",
+		"名字":          "X名字",
+		"_":           "",
+	}
+	for name, expected := range testData {
+		assert.Equal(t, expected, g.camelCase(name), name)
+	}
+}
+
+func TestGenerator_unexportedNames(t *testing.T) {
+	tables := testutil.Tables(t, "CREATE TABLE `用户` (`_` int, `名字` varchar(64)); CREATE TABLE `__` (`id` bigint);")
+	actual, err := NewGenerator().Generate(tables...)
+	assert.NoError(t, err)
+	assert.Contains(t, string(actual), "type X用户 struct {\n"+
+		"\tField1 sql.NullInt32  `db:\"_\" json:\"_\"`\n"+
+		"\tX名字    sql.NullString `db:\"名字\" json:\"名字\"`\n}")
+	assert.Contains(t, string(actual), "type Table2 struct {\n")
+}
+
+func TestGenerator_collisions(t *testing.T) {
+	tables := testutil.Tables(t, "CREATE TABLE `user` ("+
+		"`user_id` bigint NOT NULL, `userId` bigint NOT NULL, `kind` enum('a-b','a_b') NOT NULL);"+
+		"CREATE TABLE `user_kind_a_b` (`id` bigint NOT NULL);")
+	actual, err := NewGenerator(WithTags()).Generate(tables...)
+	assert.NoError(t, err)
+	assert.Contains(t, string(actual), "\tUserKindAB2 = \"a-b\"\n\tUserKindAB3 = \"a_b\"\n")
+	assert.Contains(t, string(actual), "\tUserID  int64\n\tUserID2 int64\n")
+	assert.Contains(t, string(actual), "type UserKindAB struct {\n")
+}
@@ -0,0 +1,98 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package gostruct
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// commonInitialisms describes the initialisms which are written in upper case in Go names.
+var commonInitialisms = []string{
+	"ACL", "API", "ASCII", "CPU", "CSS", "DNS", "EOF", "GUID", "HTML", "HTTP", "HTTPS", "ID",
+	"IP", "JSON", "LHS", "QPS", "RAM", "RHS", "RPC", "SLA", "SMTP", "SQL", "SSH", "TCP", "TLS",
+	"TTL", "UDP", "UI", "UID", "UUID", "URI", "URL", "UTF8", "VM", "XML", "XMPP", "XSRF", "XSS",
+}
+
+// camelCase returns the exported Go name of the sql name, the words are split by the characters
+// which are not letters or digits and by the upper case letters, such as user_id to UserID,
+// avatarUrl to AvatarURL. The name is prefixed with X if it does not start with an upper case
+// letter, such as X2faCode and X名字, and it's empty if the sql name has no letters or digits.
+func (g *Generator) camelCase(name string) string {
+	var b strings.Builder
+	for _, word := range splitWords(name) {
+		if upper := strings.ToUpper(word); g.initialisms[upper] {
+			b.WriteString(upper)
+			continue
+		}
+
+		runes := []rune(strings.ToLower(word))
+		runes[0] = unicode.ToUpper(runes[0])
+		b.WriteString(string(runes))
+	}
+
+	ret := b.String()
+	if len(ret) > 0 && !unicode.IsUpper([]rune(ret)[0]) {
+		ret = "X" + ret
+	}
+
+	return ret
+}
+
+func splitWords(name string) []string {
+	var ret []string
+	var word []rune
+	runes := []rune(name)
+	for i, r := range runes {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			if len(word) > 0 {
+				ret = append(ret, string(word))
+			}
+			word = nil
+			continue
+		}
+
+		// a new word starts at an upper case letter after a lower case letter
+		if len(word) > 0 && unicode.IsUpper(r) && i > 0 && unicode.IsLower(runes[i-1]) {
+			ret = append(ret, string(word))
+			word = nil
+		}
+		word = append(word, r)
+	}
+	if len(word) > 0 {
+		ret = append(ret, string(word))
+	}
+
+	return ret
+}
+
+// scope describes the declared names of a Go scope, such as the package and the fields of
+// a struct.
+type scope map[string]bool
+
+// declare declares the name in scope, the name is suffixed with a number if it collides with
+// a declared name, such as UserID2 for both of user_id and userId.
+func (s scope) declare(name string) string {
+	ret := name
+	for i := 2; s[ret]; i++ {
+		ret = name + strconv.Itoa(i)
+	}
+	s[ret] = true
+
+	return ret
+}
@@ -0,0 +1,193 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+// Package gotype maps the columns of parsed tables to Go types, the nullability, the unsigned
+// attribute and the values of ENUM and SET are respected, the mapping can be overridden by
+// data type, by the pattern of column name and by table.
+package gotype
+
+import (
+	"path"
+	"strings"
+
+	"github.com/zeromicro/ddl-parser/parser"
+)
+
+// Type describes a Go type.
+type Type struct {
+	// Name describes the name of type in Go code, such as int64, sql.NullString, *time.Time
+	Name string
+	// Import describes the import path of the package which declares the type, such as
+	// database/sql, it's empty if the type is predeclared
+	Import string
+	// Values describes the unescaped values of ENUM or SET column, it's empty for the other columns
+	Values []string
+}
+
+// String returns the name of type.
+func (t Type) String() string {
+	return t.Name
+}
+
+// NullStyle describes how the nullable columns are mapped.
+type NullStyle int
+
+const (
+	// NullSQL maps the nullable columns to the types of database/sql, such as sql.NullString,
+	// the types which have no null type in database/sql are mapped to pointers.
+	NullSQL NullStyle = iota
+	// NullPointer maps the nullable columns to pointers, such as *string.
+	NullPointer
+)
+
+var (
+	sqlPackage     = "database/sql"
+	timePackage    = "time"
+	jsonPackage    = "encoding/json"
+	decimalPackage = "github.com/shopspring/decimal"
+)
+
+type override struct {
+	table   string
+	pattern string
+	tp      Type
+}
+
+// Mapper maps the columns to Go types, you can use NewMapper to create an instance with options,
+// WithNullStyle option sets how the nullable columns are mapped, WithKindType, WithColumnType
+// and WithTableColumnType options override the mapping.
+type Mapper struct {
+	nullStyle NullStyle
+	kinds     map[parser.Kind]Type
+	overrides []override
+}
+
+// Option is the alias of function.
+type Option func(m *Mapper)
+
+// NewMapper creates an instance of Mapper.
+func NewMapper(options ...Option) *Mapper {
+	m := &Mapper{kinds: make(map[parser.Kind]Type)}
+	for _, opt := range options {
+		opt(m)
+	}
+
+	return m
+}
+
+// WithNullStyle is a Mapper option to set how the nullable columns are mapped, the default
+// style is NullSQL.
+func WithNullStyle(style NullStyle) Option {
+	return func(m *Mapper) {
+		m.nullStyle = style
+	}
+}
+
+// WithKindType is a Mapper option to map the data type to tp, the nullable columns of the data
+// type are mapped to the pointer of tp.
+func WithKindType(kind parser.Kind, tp Type) Option {
+	return func(m *Mapper) {
+		m.kinds[kind] = tp
+	}
+}
+
+// WithColumnType is a Mapper option to map the columns whose name matches the pattern to tp
+// regardless of nullability, the pattern is matched case-insensitively by path.Match, such
+// as *_at, is_*.
+func WithColumnType(pattern string, tp Type) Option {
+	return WithTableColumnType("", pattern, tp)
+}
+
+// WithTableColumnType is a Mapper option to map the columns of table whose name matches the
+// pattern to tp regardless of nullability, the overrides of table take precedence over the
+// overrides of WithColumnType.
+func WithTableColumnType(table, pattern string, tp Type) Option {
+	return func(m *Mapper) {
+		o := override{table: strings.ToLower(table), pattern: strings.ToLower(pattern), tp: tp}
+		if len(table) > 0 {
+			m.overrides = append([]override{o}, m.overrides...)
+		} else {
+			m.overrides = append(m.overrides, o)
+		}
+	}
+}
+
+// GoType returns the Go type of column in table, the table can be nil if the column does not
+// belong to any table. The overrides by table and column take precedence over the overrides
+// by data type, the default mapping is used if no override matches.
+func (m *Mapper) GoType(table *parser.Table, column *parser.Column) Type {
+	if tp, ok := m.override(table, column); ok {
+		return tp
+	}
+
+	dataType := column.DataType
+	if dataType == nil {
+		return Type{Name: "interface{}"}
+	}
+
+	var values []string
+	if dataType.Type().IsEnumSet() {
+		for _, e := range dataType.Value() {
+			values = append(values, parser.Unescape(e))
+		}
+	}
+
+	notNull := table.IsNotNull(column)
+	if tp, ok := m.kinds[dataType.Type()]; ok {
+		tp.Values = values
+		if !notNull {
+			return pointer(tp)
+		}
+		return tp
+	}
+
+	tp, null := defaultType(dataType)
+	tp.Values = values
+	if notNull {
+		return tp
+	}
+	if m.nullStyle == NullPointer || null == nil {
+		return pointer(tp)
+	}
+
+	null.Values = values
+	return *null
+}
+
+func (m *Mapper) override(table *parser.Table, column *parser.Column) (Type, bool) {
+	name := strings.ToLower(column.Name)
+	for _, e := range m.overrides {
+		if len(e.table) > 0 && (table == nil || strings.ToLower(table.Name) != e.table) {
+			continue
+		}
+		if ok, _ := path.Match(e.pattern, name); ok {
+			return e.tp, true
+		}
+	}
+
+	return Type{}, false
+}
+
+// pointer returns the pointer of type, the slices and the pointers are returned as is since
+// they can be nil.
+func pointer(tp Type) Type {
+	if strings.HasPrefix(tp.Name, "*") || strings.HasPrefix(tp.Name, "[]") || tp.Name == "json.RawMessage" {
+		return tp
+	}
+
+	tp.Name = "*" + tp.Name
+	return tp
+}
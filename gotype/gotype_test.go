@@ -0,0 +1,107 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package gotype
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zeromicro/ddl-parser/internal/testutil"
+	"github.com/zeromicro/ddl-parser/parser"
+)
+
+const schemaSql = "CREATE TABLE `user` (\n" +
+	"  `id` bigint unsigned NOT NULL AUTO_INCREMENT,\n" +
+	"  `age` tinyint unsigned NOT NULL,\n" +
+	"  `level` int NULL,\n" +
+	"  `name` varchar(64) NOT NULL,\n" +
+	"  `nickname` varchar(64),\n" +
+	"  `status` enum('on','off') NOT NULL,\n" +
+	"  `balance` decimal(10,2),\n" +
+	"  `avatar` blob,\n" +
+	"  `profile` json,\n" +
+	"  `enabled` bool NOT NULL,\n" +
+	"  `created_at` datetime NOT NULL,\n" +
+	"  `deleted_at` datetime,\n" +
+	"  `org_id` bigint,\n" +
+	"  PRIMARY KEY (`id`)\n" +
+	");"
+
+func TestMapper_GoType(t *testing.T) {
+	table := testutil.Table(t, schemaSql)
+	t.Run("default", func(t *testing.T) {
+		m := NewMapper()
+		expected := map[string]string{
+			"id":         "uint64",
+			"age":        "uint8",
+			"level":      "sql.NullInt32",
+			"name":       "string",
+			"nickname":   "sql.NullString",
+			"status":     "string",
+			"balance":    "decimal.NullDecimal",
+			"avatar":     "[]byte",
+			"profile":    "json.RawMessage",
+			"enabled":    "bool",
+			"created_at": "time.Time",
+			"deleted_at": "sql.NullTime",
+			"org_id":     "sql.NullInt64",
+		}
+		for _, e := range table.Columns {
+			assert.Equal(t, expected[e.Name], m.GoType(table, e).Name, e.Name)
+		}
+
+		assert.Equal(t, "database/sql", m.GoType(table, table.Column("nickname")).Import)
+		assert.Equal(t, "time", m.GoType(table, table.Column("created_at")).Import)
+		assert.Equal(t, []string{"on", "off"}, m.GoType(table, table.Column("status")).Values)
+	})
+
+	t.Run("nullPointer", func(t *testing.T) {
+		m := NewMapper(WithNullStyle(NullPointer))
+		assert.Equal(t, "*string", m.GoType(table, table.Column("nickname")).Name)
+		assert.Equal(t, Type{Name: "*time.Time", Import: "time"}, m.GoType(table, table.Column("deleted_at")))
+		assert.Equal(t, "[]byte", m.GoType(table, table.Column("avatar")).Name)
+		assert.Equal(t, "string", m.GoType(table, table.Column("name")).Name)
+	})
+
+	t.Run("override", func(t *testing.T) {
+		m := NewMapper(
+			WithKindType(parser.Decimal, Type{Name: "float64"}),
+			WithColumnType("*_at", Type{Name: "int64"}),
+			WithColumnType("ID", Type{Name: "int64"}),
+			WithTableColumnType("USER", "created_at", Type{Name: "time.Time", Import: "time"}),
+			WithTableColumnType("org", "id", Type{Name: "uint32"}),
+		)
+		assert.Equal(t, "*float64", m.GoType(table, table.Column("balance")).Name)
+		assert.Equal(t, "int64", m.GoType(table, table.Column("deleted_at")).Name)
+		assert.Equal(t, "time.Time", m.GoType(table, table.Column("created_at")).Name)
+		assert.Equal(t, "int64", m.GoType(table, table.Column("id")).Name)
+		assert.Equal(t, "int64", m.GoType(nil, table.Column("id")).Name)
+	})
+
+	t.Run("implicitNotNull", func(t *testing.T) {
+		tables := testutil.Tables(t, "CREATE TABLE t (id int, code int PRIMARY KEY, no SERIAL);\n"+
+			"CREATE TABLE s (id int, PRIMARY KEY (id));")
+		table := tables[0]
+		m := NewMapper()
+		assert.Equal(t, "sql.NullInt32", m.GoType(table, table.Column("id")).Name)
+		assert.Equal(t, "int32", m.GoType(table, table.Column("code")).Name)
+		assert.Equal(t, "uint64", m.GoType(table, table.Column("no")).Name)
+
+		table = tables[1]
+		assert.Equal(t, "int32", m.GoType(table, table.Column("id")).Name)
+	})
+}
@@ -0,0 +1,83 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package gotype
+
+import "github.com/zeromicro/ddl-parser/parser"
+
+// defaultType returns the default Go type of data type and the type of nullable column, the
+// type of nullable column is nil if database/sql declares no null type for it.
+func defaultType(dataType parser.DataType) (Type, *Type) {
+	kind, unsigned := dataType.Type(), dataType.Unsigned()
+	switch kind {
+	case parser.Bool, parser.Boolean:
+		return basic("bool"), sqlType("sql.NullBool")
+	case parser.TinyInt, parser.Int1:
+		if unsigned {
+			return basic("uint8"), sqlType("sql.NullInt32")
+		}
+		return basic("int8"), sqlType("sql.NullInt32")
+	case parser.SmallInt, parser.Int2:
+		if unsigned {
+			return basic("uint16"), sqlType("sql.NullInt32")
+		}
+		return basic("int16"), sqlType("sql.NullInt32")
+	case parser.MediumInt, parser.MiddleInt, parser.Int3, parser.Int, parser.Integer, parser.Int4:
+		if unsigned {
+			return basic("uint32"), sqlType("sql.NullInt64")
+		}
+		return basic("int32"), sqlType("sql.NullInt32")
+	case parser.BigInt, parser.Int8:
+		if unsigned {
+			return basic("uint64"), nil
+		}
+		return basic("int64"), sqlType("sql.NullInt64")
+	case parser.Serial:
+		return basic("uint64"), nil
+	case parser.Float, parser.Float4:
+		return basic("float32"), sqlType("sql.NullFloat64")
+	case parser.Double, parser.Float8, parser.Real:
+		return basic("float64"), sqlType("sql.NullFloat64")
+	case parser.Year:
+		return basic("int16"), sqlType("sql.NullInt32")
+	case parser.Time:
+		// TIME ranges from '-838:59:59' to '838:59:59', it can not be represented by time.Time
+		return basic("string"), sqlType("sql.NullString")
+	case parser.Json:
+		return Type{Name: "json.RawMessage", Import: jsonPackage}, nil
+	}
+
+	switch {
+	case kind.IsFixedPoint():
+		return Type{Name: "decimal.Decimal", Import: decimalPackage},
+			&Type{Name: "decimal.NullDecimal", Import: decimalPackage}
+	case kind.IsTemporal():
+		return Type{Name: "time.Time", Import: timePackage}, sqlType("sql.NullTime")
+	case kind.IsString(), kind.IsEnumSet():
+		return basic("string"), sqlType("sql.NullString")
+	}
+
+	// BIT, the binary strings and the spatial data are scanned as bytes
+	return basic("[]byte"), nil
+}
+
+func basic(name string) Type {
+	return Type{Name: name}
+}
+
+func sqlType(name string) *Type {
+	return &Type{Name: name, Import: sqlPackage}
+}
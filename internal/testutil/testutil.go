@@ -0,0 +1,51 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+// Package testutil provides the helpers shared by the tests of packages.
+package testutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zeromicro/ddl-parser/parser"
+)
+
+// Tables returns the tables created by the statements of sql, the test fails if sql can not be
+// parsed.
+func Tables(t *testing.T, sql string, options ...parser.Option) []*parser.Table {
+	statements, err := parser.NewParser(options...).Statements("schema.sql", sql)
+	assert.NoError(t, err)
+
+	var ret []*parser.Table
+	for _, e := range statements {
+		if e.Table != nil {
+			ret = append(ret, e.Table)
+		}
+	}
+	return ret
+}
+
+// Table returns the first table created by the statements of sql, the test fails if sql can not
+// be parsed or creates no table.
+func Table(t *testing.T, sql string, options ...parser.Option) *parser.Table {
+	tables := Tables(t, sql, options...)
+	if !assert.NotEmpty(t, tables) {
+		t.FailNow()
+	}
+
+	return tables[0]
+}
@@ -0,0 +1,176 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+// Package jsonschema exports the parsed tables as JSON Schema or OpenAPI 3 components, the
+// constraints of columns are mapped to the validation keywords, such as maxLength from
+// VARCHAR(n), the integer bounds from the width and UNSIGNED, enum from the ENUM values,
+// and required from NOT NULL columns without default value.
+package jsonschema
+
+import (
+	"encoding/json"
+
+	"github.com/zeromicro/ddl-parser/parser"
+)
+
+// Dialect describes the dialect of exported schema.
+type Dialect int
+
+const (
+	// JSONSchema exports the tables as JSON Schema draft 2020-12, the nullable columns are
+	// declared with the type null.
+	JSONSchema Dialect = iota
+	// OpenAPI exports the tables as OpenAPI 3.0 components, the nullable columns are declared
+	// with nullable.
+	OpenAPI
+)
+
+// SchemaURI describes the URI of JSON Schema draft 2020-12.
+const SchemaURI = "https://json-schema.org/draft/2020-12/schema"
+
+// Schema describes a JSON Schema or an OpenAPI schema object.
+type Schema struct {
+	Schema      string             `json:"$schema,omitempty"`
+	Defs        map[string]*Schema `json:"$defs,omitempty"`
+	Title       string             `json:"title,omitempty"`
+	Description string             `json:"description,omitempty"`
+	// Type describes the type of value, it's a string or a list of strings
+	Type                 interface{}        `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	ContentEncoding      string             `json:"contentEncoding,omitempty"`
+	Nullable             bool               `json:"nullable,omitempty"`
+	MaxLength            int                `json:"maxLength,omitempty"`
+	Minimum              json.Number        `json:"minimum,omitempty"`
+	Maximum              json.Number        `json:"maximum,omitempty"`
+	Enum                 []interface{}      `json:"enum,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *bool              `json:"additionalProperties,omitempty"`
+}
+
+// Exporter exports the tables as schemas, you can use NewExporter to create an instance with
+// options, WithDialect option sets the dialect of schemas.
+type Exporter struct {
+	dialect Dialect
+}
+
+// Option is the alias of function.
+type Option func(e *Exporter)
+
+// NewExporter creates an instance of Exporter.
+func NewExporter(options ...Option) *Exporter {
+	e := &Exporter{}
+	for _, opt := range options {
+		opt(e)
+	}
+
+	return e
+}
+
+// WithDialect is an Exporter option to set the dialect of schemas, the default dialect is
+// JSONSchema.
+func WithDialect(dialect Dialect) Option {
+	return func(e *Exporter) {
+		e.dialect = dialect
+	}
+}
+
+// Export returns the indented JSON document of tables, the schemas of tables are declared in
+// $defs of JSON Schema, or in components.schemas of OpenAPI. The schemas are keyed by the names
+// of tables, or by schema.table if the schemas of tables are specified, such as shop.user.
+func (e *Exporter) Export(tables ...*parser.Table) ([]byte, error) {
+	schemas := make(map[string]*Schema)
+	for _, table := range tables {
+		key := table.Name
+		if len(table.Schema) > 0 {
+			key = table.Schema + "." + table.Name
+		}
+		schemas[key] = e.Schema(table)
+	}
+
+	if e.dialect == OpenAPI {
+		return json.MarshalIndent(map[string]interface{}{
+			"components": map[string]interface{}{"schemas": schemas},
+		}, "", "  ")
+	}
+
+	return json.MarshalIndent(&Schema{Schema: SchemaURI, Defs: schemas}, "", "  ")
+}
+
+// Schema returns the object schema of table, the columns are declared as properties.
+func (e *Exporter) Schema(table *parser.Table) *Schema {
+	additional := false
+	ret := Schema{
+		Title:                table.Name,
+		Type:                 "object",
+		Properties:           make(map[string]*Schema),
+		AdditionalProperties: &additional,
+	}
+	for _, option := range table.Options {
+		if option.Name == parser.TableOptionComment {
+			ret.Description = parser.Unescape(option.Value)
+		}
+	}
+
+	for _, column := range table.Columns {
+		ret.Properties[column.Name] = e.property(table, column)
+		if isRequired(table, column) {
+			ret.Required = append(ret.Required, column.Name)
+		}
+	}
+
+	return &ret
+}
+
+func (e *Exporter) property(table *parser.Table, column *parser.Column) *Schema {
+	ret := columnSchema(column.DataType)
+	if c := column.Constraint; c != nil && len(c.Comment) > 0 {
+		ret.Description = parser.Unescape(c.Comment)
+	}
+	if e.dialect == OpenAPI && len(ret.ContentEncoding) > 0 {
+		// OpenAPI 3.0 declares the base64 encoded string by the format byte
+		ret.Format, ret.ContentEncoding = "byte", ""
+	}
+	if table.IsNotNull(column) || ret.Type == nil {
+		return ret
+	}
+
+	if e.dialect == OpenAPI {
+		ret.Nullable = true
+		return ret
+	}
+
+	ret.Type = []string{ret.Type.(string), "null"}
+	if len(ret.Enum) > 0 {
+		ret.Enum = append(ret.Enum, nil)
+	}
+
+	return ret
+}
+
+// isRequired returns true if the column is NOT NULL without default value, the AUTO_INCREMENT
+// columns are not required since their values are generated.
+func isRequired(table *parser.Table, column *parser.Column) bool {
+	if !table.IsNotNull(column) {
+		return false
+	}
+	if column.DataType != nil && column.DataType.Type() == parser.Serial {
+		return false
+	}
+
+	c := column.Constraint
+	return c == nil || !(c.HasDefaultValue || c.AutoIncrement || c.SerialDefaultValue)
+}
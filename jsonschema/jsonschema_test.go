@@ -0,0 +1,105 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package jsonschema
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zeromicro/ddl-parser/internal/testutil"
+)
+
+const schemaSql = "CREATE TABLE `user` (\n" +
+	"  `id` bigint unsigned NOT NULL AUTO_INCREMENT,\n" +
+	"  `name` varchar(64) NOT NULL COMMENT 'user''s name',\n" +
+	"  `age` tinyint,\n" +
+	"  `status` enum('on','off') NOT NULL DEFAULT 'on',\n" +
+	"  `avatar` blob,\n" +
+	"  `created_at` datetime NOT NULL,\n" +
+	"  PRIMARY KEY (`id`)\n" +
+	") COMMENT='users';"
+
+func TestExporter_Export(t *testing.T) {
+	t.Run("jsonSchema", func(t *testing.T) {
+		actual, err := NewExporter().Export(testutil.Tables(t, schemaSql)...)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "$defs": {
+    "user": {
+      "title": "user",
+      "description": "users",
+      "type": "object",
+      "properties": {
+        "id": {"type": "integer", "minimum": 0, "maximum": 18446744073709551615},
+        "name": {"description": "user's name", "type": "string", "maxLength": 64},
+        "age": {"type": ["integer", "null"], "minimum": -128, "maximum": 127},
+        "status": {"type": "string", "enum": ["on", "off"]},
+        "avatar": {"type": ["string", "null"], "contentEncoding": "base64"},
+        "created_at": {"type": "string", "format": "date-time"}
+      },
+      "required": ["name", "created_at"],
+      "additionalProperties": false
+    }
+  }
+}`, string(actual))
+	})
+
+	t.Run("openAPI", func(t *testing.T) {
+		actual, err := NewExporter(WithDialect(OpenAPI)).Export(testutil.Tables(t, schemaSql)...)
+		assert.NoError(t, err)
+
+		var document struct {
+			Components struct {
+				Schemas map[string]*Schema `json:"schemas"`
+			} `json:"components"`
+		}
+		assert.NoError(t, json.Unmarshal(actual, &document))
+		user := document.Components.Schemas["user"]
+		assert.Equal(t, "integer", user.Properties["age"].Type)
+		assert.True(t, user.Properties["age"].Nullable)
+		assert.Equal(t, "byte", user.Properties["avatar"].Format)
+		assert.Empty(t, user.Properties["avatar"].ContentEncoding)
+		assert.False(t, user.Properties["id"].Nullable)
+	})
+
+	t.Run("schema", func(t *testing.T) {
+		tables := testutil.Tables(t, "CREATE TABLE `shop`.`user` (`id` bigint);CREATE TABLE `crm`.`user` (`id` bigint);CREATE TABLE `log` (`id` bigint);")
+		actual, err := NewExporter().Export(tables...)
+		assert.NoError(t, err)
+
+		var document Schema
+		assert.NoError(t, json.Unmarshal(actual, &document))
+		assert.Len(t, document.Defs, 3)
+		assert.Equal(t, "user", document.Defs["shop.user"].Title)
+		assert.Equal(t, "user", document.Defs["crm.user"].Title)
+		assert.Equal(t, "log", document.Defs["log"].Title)
+	})
+}
+
+func Test_columnSchema(t *testing.T) {
+	columns := testutil.Table(t, "CREATE TABLE t (\n"+
+		"  a bit(8), b mediumint unsigned, c decimal(10,2) unsigned, d date, e set('x','y'), f json\n"+
+		");").Columns
+	assert.Equal(t, &Schema{Type: "integer", Minimum: "0", Maximum: "255"}, columnSchema(columns[0].DataType))
+	assert.Equal(t, &Schema{Type: "integer", Minimum: "0", Maximum: "16777215"}, columnSchema(columns[1].DataType))
+	assert.Equal(t, &Schema{Type: "number", Minimum: "0"}, columnSchema(columns[2].DataType))
+	assert.Equal(t, &Schema{Type: "string", Format: "date"}, columnSchema(columns[3].DataType))
+	assert.Equal(t, &Schema{Type: "string"}, columnSchema(columns[4].DataType))
+	assert.Equal(t, &Schema{}, columnSchema(columns[5].DataType))
+}
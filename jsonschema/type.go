@@ -0,0 +1,113 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package jsonschema
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/zeromicro/ddl-parser/parser"
+)
+
+// integerBounds describes the signed bounds of integer types.
+var integerBounds = map[parser.Kind][2]string{
+	parser.TinyInt:   {"-128", "127"},
+	parser.Int1:      {"-128", "127"},
+	parser.SmallInt:  {"-32768", "32767"},
+	parser.Int2:      {"-32768", "32767"},
+	parser.MediumInt: {"-8388608", "8388607"},
+	parser.MiddleInt: {"-8388608", "8388607"},
+	parser.Int3:      {"-8388608", "8388607"},
+	parser.Int:       {"-2147483648", "2147483647"},
+	parser.Integer:   {"-2147483648", "2147483647"},
+	parser.Int4:      {"-2147483648", "2147483647"},
+	parser.BigInt:    {"-9223372036854775808", "9223372036854775807"},
+	parser.Int8:      {"-9223372036854775808", "9223372036854775807"},
+}
+
+// unsignedMaximums describes the maximums of unsigned integer types.
+var unsignedMaximums = map[parser.Kind]string{
+	parser.TinyInt:   "255",
+	parser.Int1:      "255",
+	parser.SmallInt:  "65535",
+	parser.Int2:      "65535",
+	parser.MediumInt: "16777215",
+	parser.MiddleInt: "16777215",
+	parser.Int3:      "16777215",
+	parser.Int:       "4294967295",
+	parser.Integer:   "4294967295",
+	parser.Int4:      "4294967295",
+	parser.BigInt:    "18446744073709551615",
+	parser.Int8:      "18446744073709551615",
+	parser.Serial:    "18446744073709551615",
+}
+
+// columnSchema returns the schema of data type, the type is nil if any JSON value is valid.
+func columnSchema(dataType parser.DataType) *Schema {
+	var ret Schema
+	if dataType == nil {
+		return &ret
+	}
+
+	kind := dataType.Type()
+	switch {
+	case kind == parser.Bool || kind == parser.Boolean:
+		ret.Type = "boolean"
+	case kind.IsInteger():
+		ret.Type = "integer"
+		if dataType.Unsigned() || kind == parser.Serial {
+			ret.Minimum, ret.Maximum = "0", json.Number(unsignedMaximums[kind])
+		} else {
+			bounds := integerBounds[kind]
+			ret.Minimum, ret.Maximum = json.Number(bounds[0]), json.Number(bounds[1])
+		}
+	case kind == parser.Bit:
+		length := dataType.Length()
+		if length == 0 {
+			length = 1
+		}
+		ret.Type = "integer"
+		ret.Minimum = "0"
+		ret.Maximum = json.Number(strconv.FormatUint(1<<uint(length)-1, 10))
+	case kind == parser.Year:
+		ret.Type = "integer"
+	case kind.IsFixedPoint(), kind.IsFloatingPoint():
+		ret.Type = "number"
+		if dataType.Unsigned() {
+			ret.Minimum = "0"
+		}
+	case kind == parser.Date:
+		ret.Type, ret.Format = "string", "date"
+	case kind == parser.DateTime || kind == parser.Timestamp:
+		ret.Type, ret.Format = "string", "date-time"
+	case kind == parser.Enum:
+		ret.Type = "string"
+		for _, e := range dataType.Value() {
+			ret.Enum = append(ret.Enum, parser.Unescape(e))
+		}
+	case kind == parser.Char || kind == parser.Character || kind == parser.VarChar ||
+		kind == parser.NChar || kind == parser.NVarChar:
+		ret.Type = "string"
+		ret.MaxLength = dataType.Length()
+	case kind.IsBinary():
+		ret.Type, ret.ContentEncoding = "string", "base64"
+	case kind.IsString(), kind == parser.Set, kind == parser.Time:
+		ret.Type = "string"
+	}
+
+	return &ret
+}
@@ -0,0 +1,201 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package lint
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"github.com/zeromicro/ddl-parser/gen"
+	"github.com/zeromicro/ddl-parser/parser"
+)
+
+// MaxIdentifierLength describes the maximum length of the names of tables, columns, indexes
+// and constraints in characters.
+const MaxIdentifierLength = 64
+
+// IdentifierKind describes why an identifier is unsafe.
+type IdentifierKind int
+
+const (
+	// Reserved describes the identifier is a reserved word of the target version, it breaks the
+	// statements which use it without quotes.
+	Reserved IdentifierKind = iota
+	// TooLong describes the identifier is longer than MaxIdentifierLength.
+	TooLong
+	// NeedQuote describes the identifier can not be used without quotes, such as the names with
+	// special characters and the keywords of MySqlLexer which are not accepted as identifiers.
+	NeedQuote
+)
+
+// IdentifierIssue describes an unsafe name of table, column, index or constraint.
+type IdentifierIssue struct {
+	Kind IdentifierKind
+	// Node describes the table, column, index or constraint which is named by the identifier
+	Node interface{}
+	// Object describes the kind of Node, such as table, column, index, constraint
+	Object string
+	Name   string
+	// Suggestion describes how to fix the identifier, such as quote it as `rank` or rename it
+	// to user_rank
+	Suggestion string
+}
+
+// IdentifierIssues returns the unsafe identifiers of table for the version of mysql, the names
+// of table, columns, indexes, foreign keys and check constraints are checked.
+func IdentifierIssues(table *parser.Table, version Version) []*IdentifierIssue {
+	var ret []*IdentifierIssue
+	check := func(node interface{}, object, name, rename string) {
+		if len(name) == 0 {
+			return
+		}
+
+		quoted := defaultPrinter.Identifier(name)
+		switch {
+		case len([]rune(name)) > MaxIdentifierLength:
+			ret = append(ret, &IdentifierIssue{
+				Kind:       TooLong,
+				Node:       node,
+				Object:     object,
+				Name:       name,
+				Suggestion: fmt.Sprintf("shorten it to at most %d characters, such as %s", MaxIdentifierLength, string([]rune(name)[:MaxIdentifierLength])),
+			})
+		case IsReserved(name, version):
+			ret = append(ret, &IdentifierIssue{
+				Kind:       Reserved,
+				Node:       node,
+				Object:     object,
+				Name:       name,
+				Suggestion: fmt.Sprintf("rename it to %s or always quote it as %s", rename, quoted),
+			})
+		case NeedsQuote(name):
+			ret = append(ret, &IdentifierIssue{
+				Kind:       NeedQuote,
+				Node:       node,
+				Object:     object,
+				Name:       name,
+				Suggestion: fmt.Sprintf("always quote it as %s", quoted),
+			})
+		}
+	}
+
+	check(table, "table", table.Name, "t_"+table.Name)
+	for _, e := range table.Columns {
+		check(e, "column", e.Name, singular(table.Name)+"_"+e.Name)
+	}
+	for _, e := range table.Indexes {
+		check(e, "index", e.Name, "idx_"+e.Name)
+	}
+	for _, e := range table.Constraints {
+		if e.ForeignKey != nil {
+			check(e, "constraint", e.ForeignKey.Name, "fk_"+e.ForeignKey.Name)
+		}
+		if e.Check != nil {
+			check(e, "constraint", e.Check.Name, "chk_"+e.Check.Name)
+		}
+	}
+
+	return ret
+}
+
+// IdentifierRule returns the rule identifier which reports the names which are reserved words
+// of the version of mysql, the names longer than MaxIdentifierLength and the names which need
+// quotes, see IdentifierIssues.
+func IdentifierRule(version Version) Rule {
+	return NewRule("identifier", Error, func(table *parser.Table) []*Problem {
+		var ret []*Problem
+		for _, e := range IdentifierIssues(table, version) {
+			var reason string
+			switch e.Kind {
+			case Reserved:
+				reason = fmt.Sprintf("is a reserved word in MySQL %s", version)
+			case TooLong:
+				reason = fmt.Sprintf("is longer than %d characters", MaxIdentifierLength)
+			default:
+				reason = "needs quotes"
+			}
+			ret = append(ret, &Problem{
+				Node:    e.Node,
+				Message: fmt.Sprintf("%s %s %s, %s", e.Object, e.Name, reason, e.Suggestion),
+			})
+		}
+
+		return ret
+	})
+}
+
+var (
+	quoteCache     = make(map[string]bool)
+	quoteCacheLock sync.Mutex
+)
+
+// NeedsQuote returns true if the name can not be used as an identifier without quotes, such as
+// the names with special characters, the names which start with digits only, and the keywords
+// of MySqlLexer which are not accepted as identifiers by MySqlParser, such as SELECT. The
+// reserved words of mysql are checked by IsReserved.
+func NeedsQuote(name string) bool {
+	if len(name) == 0 {
+		return true
+	}
+
+	digits := true
+	for _, c := range name {
+		switch {
+		case c >= '0' && c <= '9':
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c == '_', c == '$', c >= 0x80:
+			digits = false
+		default:
+			return true
+		}
+	}
+	if digits {
+		return true
+	}
+
+	lexer := gen.NewMySqlLexer(antlr.NewInputStream(strings.ToUpper(name)))
+	lexer.RemoveErrorListeners()
+	tokens := lexer.GetAllTokens()
+	if len(tokens) == 1 && tokens[0].GetTokenType() == gen.MySqlLexerID {
+		return false
+	}
+
+	// the keyword may be accepted as an identifier by MySqlParser, such as STATUS, DATE
+	key := strings.ToUpper(name)
+	quoteCacheLock.Lock()
+	defer quoteCacheLock.Unlock()
+	if ret, ok := quoteCache[key]; ok {
+		return ret
+	}
+
+	_, err := parser.NewParser().Statements("", fmt.Sprintf("CREATE TABLE t (%s INT);", name))
+	quoteCache[key] = err != nil
+	return err != nil
+}
+
+// singular returns the singular form of a plural table name naively, such as user of users.
+func singular(name string) string {
+	if strings.HasSuffix(name, "ies") && len(name) > 3 {
+		return name[:len(name)-3] + "y"
+	}
+	if strings.HasSuffix(name, "s") && !strings.HasSuffix(name, "ss") && len(name) > 1 {
+		return name[:len(name)-1]
+	}
+
+	return name
+}
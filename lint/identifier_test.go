@@ -0,0 +1,112 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package lint
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsReserved(t *testing.T) {
+	assert.True(t, IsReserved("order", MySQL57))
+	assert.False(t, IsReserved("rank", MySQL57))
+	assert.True(t, IsReserved("Rank", MySQL80))
+	assert.True(t, IsReserved("groups", MySQL84))
+	assert.True(t, IsReserved("analyse", MySQL57))
+	assert.False(t, IsReserved("analyse", MySQL80))
+	assert.False(t, IsReserved("qualify", MySQL80))
+	assert.True(t, IsReserved("qualify", MySQL84))
+	assert.True(t, IsReserved("master_bind", MySQL80))
+	assert.False(t, IsReserved("master_bind", MySQL84))
+	assert.True(t, IsReserved("function", "9.0"))
+	assert.False(t, IsReserved("status", MySQL84))
+	for _, e := range []string{"array", "member", "intersect"} {
+		assert.False(t, IsReserved(e, MySQL57), e)
+		assert.True(t, IsReserved(e, MySQL80), e)
+		assert.True(t, IsReserved(e, MySQL84), e)
+	}
+}
+
+func TestNeedsQuote(t *testing.T) {
+	for _, e := range []string{"id", "user_name", "$price", "1st", "status", "date", "名字"} {
+		assert.False(t, NeedsQuote(e), e)
+	}
+	for _, e := range []string{"", "123", "first-name", "user name", "select"} {
+		assert.True(t, NeedsQuote(e), e)
+	}
+}
+
+func TestIdentifierIssues(t *testing.T) {
+	long := strings.Repeat("a", 65)
+	table, _ := parseTable(t, "CREATE TABLE `groups` (\n"+
+		"  `id` INT PRIMARY KEY,\n"+
+		"  `rank` INT,\n"+
+		"  `first-name` VARCHAR(64),\n"+
+		"  `"+long+"` INT,\n"+
+		"  `status` INT,\n"+
+		"  KEY `function` (`rank`),\n"+
+		"  CONSTRAINT `over` CHECK (`rank` > 0)\n"+
+		");")
+
+	issues := IdentifierIssues(table, MySQL80)
+	var list []string
+	for _, e := range issues {
+		list = append(list, e.Object+" "+e.Name+": "+e.Suggestion)
+	}
+	assert.Equal(t, []string{
+		"table groups: rename it to t_groups or always quote it as `groups`",
+		"column rank: rename it to group_rank or always quote it as `rank`",
+		"column first-name: always quote it as `first-name`",
+		"column " + long + ": shorten it to at most 64 characters, such as " + long[:64],
+		"index function: rename it to idx_function or always quote it as `function`",
+		"constraint over: rename it to chk_over or always quote it as `over`",
+	}, list)
+	assert.Equal(t, []IdentifierKind{Reserved, Reserved, NeedQuote, TooLong, Reserved, Reserved}, []IdentifierKind{
+		issues[0].Kind, issues[1].Kind, issues[2].Kind, issues[3].Kind, issues[4].Kind, issues[5].Kind,
+	})
+	assert.Equal(t, table.Columns[1], issues[1].Node)
+
+	assert.Len(t, IdentifierIssues(table, MySQL57), 2)
+}
+
+func TestIdentifierIssues_member(t *testing.T) {
+	table, _ := parseTable(t, "CREATE TABLE `team` (`id` INT PRIMARY KEY, `member` INT);")
+	for _, version := range []Version{MySQL80, MySQL84} {
+		issues := IdentifierIssues(table, version)
+		if assert.Len(t, issues, 1, version) {
+			assert.Equal(t, Reserved, issues[0].Kind)
+			assert.Equal(t, "member", issues[0].Name)
+		}
+	}
+	assert.Empty(t, IdentifierIssues(table, MySQL57))
+}
+
+func TestIdentifierRule(t *testing.T) {
+	table, positions := parseTable(t, "CREATE TABLE `user` (\n"+
+		"  `id` INT PRIMARY KEY,\n"+
+		"  `rank` INT\n"+
+		");")
+
+	var list []string
+	for _, e := range NewLinter(WithRules(IdentifierRule(MySQL80))).LintTable(table, positions) {
+		list = append(list, e.Position.String()+" "+e.Message)
+	}
+	assert.Equal(t, []string{"3:2 column rank is a reserved word in MySQL 8.0, rename it to user_rank or always quote it as `rank`"}, list)
+	assert.Empty(t, NewLinter(WithRules(IdentifierRule(MySQL57))).LintTable(table, positions))
+}
@@ -0,0 +1,353 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package lint
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/zeromicro/ddl-parser/parser"
+)
+
+const (
+	// maxRowSize describes the maximum row size of mysql, the contents of BLOB and TEXT
+	// columns are not included.
+	maxRowSize = 65535
+	// maxColumns describes the maximum number of columns of InnoDB table.
+	maxColumns = 1017
+	// maxIndexes describes the maximum number of secondary indexes of InnoDB table.
+	maxIndexes = 64
+	// maxKeyParts describes the maximum number of columns of an index.
+	maxKeyParts = 16
+	// maxKeyLength describes the maximum key length of InnoDB index with 16KB pages.
+	maxKeyLength = 3072
+	// maxCompactKeyPart describes the maximum length of key part of REDUNDANT and COMPACT row
+	// formats.
+	maxCompactKeyPart = 767
+)
+
+// charsetMaxBytes describes the maximum bytes per character of the multibyte character sets,
+// the other character sets are single-byte.
+var charsetMaxBytes = map[string]int{
+	"big5":    2,
+	"cp932":   2,
+	"eucjpms": 3,
+	"euckr":   2,
+	"gb18030": 4,
+	"gb2312":  2,
+	"gbk":     2,
+	"sjis":    2,
+	"ucs2":    2,
+	"ujis":    3,
+	"utf16":   4,
+	"utf16le": 4,
+	"utf32":   4,
+	"utf8":    3,
+	"utf8mb3": 3,
+	"utf8mb4": 4,
+}
+
+// CharsetMaxBytes returns the maximum bytes per character of character set, such as 4 of utf8mb4.
+func CharsetMaxBytes(charset string) int {
+	if n, ok := charsetMaxBytes[strings.ToLower(charset)]; ok {
+		return n
+	}
+
+	return 1
+}
+
+// ColumnCharset returns the character set of column, it's inherited from the table if the
+// column does not specify it, and defaultCharset is returned if neither specifies it.
+func ColumnCharset(table *parser.Table, column *parser.Column, defaultCharset string) string {
+	if dataType := column.DataType; dataType != nil {
+		switch {
+		case dataType.Type() == parser.NChar || dataType.Type() == parser.NVarChar:
+			return "utf8mb3"
+		case len(dataType.Charset()) > 0:
+			return dataType.Charset()
+		case len(dataType.Collation()) > 0:
+			return collationCharset(dataType.Collation())
+		}
+	}
+
+	if charset := tableCharset(table); len(charset) > 0 {
+		return charset
+	}
+
+	return defaultCharset
+}
+
+func tableCharset(table *parser.Table) string {
+	if charset := table.Option(parser.TableOptionCharset); len(charset) > 0 {
+		return charset
+	}
+	if collation := table.Option(parser.TableOptionCollate); len(collation) > 0 {
+		return collationCharset(collation)
+	}
+
+	return ""
+}
+
+// collationCharset returns the character set implied by collation, such as utf8mb4 of utf8mb4_bin.
+func collationCharset(collation string) string {
+	return strings.SplitN(collation, "_", 2)[0]
+}
+
+// ColumnBytes returns the maximum bytes of column counted in the row size, the BLOB, TEXT, JSON
+// and spatial columns are counted as their length and pointer sizes since their contents are
+// stored separately.
+func ColumnBytes(table *parser.Table, column *parser.Column, defaultCharset string) int {
+	dataType := column.DataType
+	if dataType == nil {
+		return 0
+	}
+
+	maxBytes := CharsetMaxBytes(ColumnCharset(table, column, defaultCharset))
+	length := dataType.Length()
+	kind := dataType.Type()
+	switch kind {
+	case parser.TinyInt, parser.Int1, parser.Bool, parser.Boolean, parser.Year:
+		return 1
+	case parser.SmallInt, parser.Int2:
+		return 2
+	case parser.MediumInt, parser.MiddleInt, parser.Int3, parser.Date:
+		return 3
+	case parser.Int, parser.Integer, parser.Int4, parser.Float4:
+		return 4
+	case parser.BigInt, parser.Int8, parser.Serial, parser.Double, parser.Float8, parser.Real:
+		return 8
+	case parser.Float:
+		// FLOAT(p) is stored as DOUBLE if p is greater than 24
+		if length > 24 && dataType.Decimal() == 0 {
+			return 8
+		}
+		return 4
+	case parser.Bit:
+		if length == 0 {
+			length = 1
+		}
+		return (length + 7) / 8
+	case parser.Time:
+		return 3 + (length+1)/2
+	case parser.DateTime:
+		return 5 + (length+1)/2
+	case parser.Timestamp:
+		return 4 + (length+1)/2
+	case parser.Enum:
+		if len(dataType.Value()) > 255 {
+			return 2
+		}
+		return 1
+	case parser.Set:
+		switch n := (len(dataType.Value()) + 7) / 8; {
+		case n > 4:
+			return 8
+		case n == 0:
+			return 1
+		default:
+			return n
+		}
+	case parser.Char, parser.Character, parser.NChar:
+		if length == 0 {
+			length = 1
+		}
+		return length * maxBytes
+	case parser.Binary:
+		if length == 0 {
+			length = 1
+		}
+		return length
+	case parser.VarChar, parser.NVarChar:
+		return withLengthBytes(length * maxBytes)
+	case parser.VarBinary:
+		return withLengthBytes(length)
+	case parser.TinyText, parser.TinyBlob:
+		return 9
+	case parser.Text, parser.Blob:
+		return 10
+	case parser.MediumText, parser.MediumBlob, parser.LongVarChar, parser.LongVarBinary:
+		return 11
+	}
+
+	if kind.IsFixedPoint() {
+		return decimalBytes(length, dataType.Decimal())
+	}
+
+	// LONGTEXT, LONGBLOB, JSON and the spatial data are stored as LONGBLOB
+	return 12
+}
+
+// withLengthBytes returns the bytes of variable-length column including the bytes of length
+// prefix, the prefix is 2 bytes if the values may require more than 255 bytes.
+func withLengthBytes(n int) int {
+	if n > 255 {
+		return n + 2
+	}
+
+	return n + 1
+}
+
+// decimalBytes returns the bytes of DECIMAL(M,D), each multiple of nine digits requires four
+// bytes, and the leftover digits require a fraction of four bytes.
+func decimalBytes(precision, scale int) int {
+	if precision == 0 {
+		precision = 10
+	}
+
+	leftover := []int{0, 1, 1, 2, 2, 3, 3, 4, 4, 4}
+	integer, fraction := precision-scale, scale
+	return integer/9*4 + leftover[integer%9] + fraction/9*4 + leftover[fraction%9]
+}
+
+// RowSize returns the maximum row size of table in bytes, the bytes of NULL flags are included.
+func RowSize(table *parser.Table, defaultCharset string) int {
+	var size, nullable int
+	for _, e := range table.Columns {
+		size += ColumnBytes(table, e, defaultCharset)
+		if !table.IsNotNull(e) {
+			nullable++
+		}
+	}
+
+	return size + (nullable+7)/8
+}
+
+// SizeLimitRule returns the rule size-limit which reports the tables exceeding the limits of
+// InnoDB, such as the row size, the index key length, the number of columns and indexes, and
+// the BLOB and TEXT key parts without prefix length. The default character set is used by the
+// columns and tables which do not specify the character set.
+func SizeLimitRule(defaultCharset string) Rule {
+	return NewRule("size-limit", Error, func(table *parser.Table) []*Problem {
+		var ret []*Problem
+		if n := len(table.Columns); n > maxColumns {
+			ret = append(ret, &Problem{Node: table, Message: fmt.Sprintf("table %s has %d columns, the maximum is %d", table.Name, n, maxColumns)})
+		}
+		if size := RowSize(table, defaultCharset); size > maxRowSize {
+			ret = append(ret, &Problem{
+				Node:    table,
+				Message: fmt.Sprintf("row size of table %s is %d bytes, the maximum is %d, change some columns to TEXT or BLOB", table.Name, size, maxRowSize),
+			})
+		}
+
+		var secondary int
+		indexes := table.AllIndexes()
+		names := parser.IndexNames(indexes)
+		for i, index := range indexes {
+			if !index.Primary {
+				secondary++
+			}
+			ret = append(ret, indexProblems(table, index, names[i], defaultCharset)...)
+		}
+		if secondary > maxIndexes {
+			ret = append(ret, &Problem{Node: table, Message: fmt.Sprintf("table %s has %d secondary indexes, the maximum is %d", table.Name, secondary, maxIndexes)})
+		}
+
+		return ret
+	})
+}
+
+// indexProblems returns the problems of index, the name is the name of index in table, which
+// is named like mysql does if the index is unnamed.
+func indexProblems(table *parser.Table, index *parser.Index, name, defaultCharset string) []*Problem {
+	var ret []*Problem
+	node := indexNode(table, index)
+	if len(index.Columns) > maxKeyParts {
+		ret = append(ret, &Problem{Node: node, Message: fmt.Sprintf("index %s has %d columns, the maximum is %d", name, len(index.Columns), maxKeyParts)})
+	}
+	if index.Fulltext || index.Spatial {
+		return ret
+	}
+
+	maxKeyPart := maxKeyLength
+	switch strings.ToUpper(table.Option(parser.TableOptionRowFormat)) {
+	case "REDUNDANT", "COMPACT":
+		maxKeyPart = maxCompactKeyPart
+	}
+
+	var total int
+	oversize := false
+	for _, e := range index.Columns {
+		column := table.Column(e.Name)
+		if column == nil || column.DataType == nil {
+			continue
+		}
+
+		kind := column.DataType.Type()
+		maxBytes := CharsetMaxBytes(ColumnCharset(table, column, defaultCharset))
+		var length int
+		switch {
+		case kind == parser.Json:
+			ret = append(ret, &Problem{Node: node, Message: fmt.Sprintf("index %s can not index JSON column %s directly", name, e.Name)})
+			continue
+		case isBlob(kind) && e.Length == 0:
+			ret = append(ret, &Problem{Node: node, Message: fmt.Sprintf("index %s uses %s column %s without prefix length", name, kind, e.Name)})
+			continue
+		case e.Length > 0 && hasLength(kind) && column.DataType.Length() > 0 && e.Length > column.DataType.Length():
+			ret = append(ret, &Problem{
+				Node:    node,
+				Message: fmt.Sprintf("index %s uses prefix length %d which is longer than column %s", name, e.Length, e.Name),
+			})
+			continue
+		case e.Length > 0 && (kind.IsString() || kind.IsEnumSet()):
+			length = e.Length * maxBytes
+		case e.Length > 0:
+			length = e.Length
+		case kind == parser.VarChar || kind == parser.NVarChar:
+			length = column.DataType.Length() * maxBytes
+		case kind == parser.VarBinary:
+			length = column.DataType.Length()
+		default:
+			length = ColumnBytes(table, column, defaultCharset)
+		}
+
+		if length > maxKeyPart {
+			ret = append(ret, &Problem{
+				Node:    node,
+				Message: fmt.Sprintf("key part %s of index %s is %d bytes, the maximum is %d", e.Name, name, length, maxKeyPart),
+			})
+			oversize = true
+		}
+		total += length
+	}
+
+	if total > maxKeyLength && !oversize {
+		ret = append(ret, &Problem{Node: node, Message: fmt.Sprintf("index %s is %d bytes, the maximum is %d", name, total, maxKeyLength)})
+	}
+
+	return ret
+}
+
+// isBlob returns true if the data type requires the prefix length in index, such as TEXT, BLOB.
+func isBlob(kind parser.Kind) bool {
+	switch kind {
+	case parser.TinyText, parser.Text, parser.MediumText, parser.LongText, parser.LongVarChar,
+		parser.TinyBlob, parser.Blob, parser.MediumBlob, parser.LongBlob, parser.LongVarBinary:
+		return true
+	}
+
+	return kind.IsSpatial()
+}
+
+// hasLength returns true if the length of data type limits the prefix length of key part.
+func hasLength(kind parser.Kind) bool {
+	switch kind {
+	case parser.Char, parser.Character, parser.NChar, parser.VarChar, parser.NVarChar, parser.Binary, parser.VarBinary:
+		return true
+	}
+
+	return false
+}
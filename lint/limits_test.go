@@ -0,0 +1,98 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package lint
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zeromicro/ddl-parser/parser"
+)
+
+func parseTable(t *testing.T, sql string) (*parser.Table, *parser.Positions) {
+	statements, err := parser.NewParser().Statements("schema.sql", sql)
+	assert.NoError(t, err)
+	return statements[0].Table, statements[0].Positions
+}
+
+func TestColumnBytes(t *testing.T) {
+	table, _ := parseTable(t, "CREATE TABLE t (\n"+
+		"  a int, b bigint, c decimal(10,2), d decimal(18,9), e char(10), f varchar(100),\n"+
+		"  g varchar(100) CHARACTER SET latin1, h varbinary(300), i datetime(6), j text,\n"+
+		"  k bit(9), l enum('x','y'), m json, n nchar(10), o float(30), p timestamp\n"+
+		") DEFAULT CHARSET=utf8mb3;")
+
+	expected := []int{4, 8, 5, 8, 30, 302, 101, 302, 8, 10, 2, 1, 12, 30, 8, 4}
+	for i, e := range table.Columns {
+		assert.Equal(t, expected[i], ColumnBytes(table, e, "utf8mb4"), e.Name)
+	}
+	assert.Equal(t, "latin1", ColumnCharset(table, table.Columns[6], "utf8mb4"))
+	assert.Equal(t, 4, CharsetMaxBytes("UTF8MB4"))
+	assert.Equal(t, 1, CharsetMaxBytes("latin2"))
+	assert.Equal(t, 835+2, RowSize(table, "utf8mb4"))
+}
+
+func TestSizeLimitRule(t *testing.T) {
+	check := func(sql string) []string {
+		table, positions := parseTable(t, sql)
+		var ret []string
+		for _, e := range NewLinter(WithRules(SizeLimitRule("utf8mb4"))).LintTable(table, positions) {
+			ret = append(ret, e.Position.String()+" "+e.Message)
+		}
+		return ret
+	}
+
+	assert.Empty(t, check("CREATE TABLE t (id int PRIMARY KEY, name varchar(255), KEY (name));"))
+	assert.Equal(t, []string{
+		"1:0 row size of table t is 80004 bytes, the maximum is 65535, change some columns to TEXT or BLOB",
+	}, check("CREATE TABLE t (a varchar(10000) NOT NULL, b varchar(10000) NOT NULL);"))
+	assert.Equal(t, []string{
+		"3:2 index idx_a uses TEXT column a without prefix length",
+		"4:2 key part b of index idx_b is 4000 bytes, the maximum is 3072",
+		"5:2 index idx_c uses prefix length 20 which is longer than column c",
+		"6:2 index idx_bd is 3800 bytes, the maximum is 3072",
+		"7:2 index idx_j can not index JSON column j directly",
+	}, check("CREATE TABLE t (\n"+
+		"  a text, b varchar(1000), c varchar(10), d varchar(200), j json,\n"+
+		"  KEY idx_a (a),\n"+
+		"  KEY idx_b (b),\n"+
+		"  KEY idx_c (c(20)),\n"+
+		"  KEY idx_bd (b(750), d),\n"+
+		"  KEY idx_j (j),\n"+
+		"  FULLTEXT KEY ft_a (a)\n"+
+		");"))
+	assert.Equal(t, []string{
+		"1:16 key part name of index name is 1020 bytes, the maximum is 767",
+	}, check("CREATE TABLE t (name varchar(255) UNIQUE) ROW_FORMAT=COMPACT;"))
+	assert.Equal(t, []string{
+		"1:49 key part b of index a_2 is 4000 bytes, the maximum is 3072",
+	}, check("CREATE TABLE t (a int, b varchar(1000), KEY (a), KEY (a, b));"))
+
+	var columns, indexes []string
+	for i := 0; i < 1018; i++ {
+		columns = append(columns, fmt.Sprintf("c%d int", i))
+	}
+	for i := 0; i < 65; i++ {
+		indexes = append(indexes, fmt.Sprintf("KEY (c%d)", i))
+	}
+	assert.Equal(t, []string{
+		"1:0 table t has 1018 columns, the maximum is 1017",
+		"1:0 table t has 65 secondary indexes, the maximum is 64",
+	}, check("CREATE TABLE t ("+strings.Join(append(columns, indexes...), ", ")+");"))
+}
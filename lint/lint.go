@@ -0,0 +1,242 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+// Package lint checks the parsed tables against the conventions of schema, such as every table
+// has a primary key, the rules are pluggable by the Rule interface, the severity of each rule is
+// configurable, and the diagnostics can be suppressed by the comments in sql, such as
+//
+//	-- ddl-lint:ignore primary-key
+//	CREATE TABLE `log` (
+//	  `content` text -- ddl-lint:ignore column-comment
+//	);
+//
+// the comments before the statement suppress the diagnostics of table, the comments around a
+// column suppress the diagnostics of the column, the rule names are separated by commas, and
+// all rules are suppressed if no rule name is specified.
+package lint
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/zeromicro/ddl-parser/parser"
+)
+
+// Severity describes the severity of diagnostic.
+type Severity int
+
+const (
+	// Off disables the rule.
+	Off Severity = iota
+	// Info describes the diagnostic is a suggestion.
+	Info
+	// Warning describes the diagnostic should be fixed.
+	Warning
+	// Error describes the diagnostic must be fixed.
+	Error
+)
+
+var severityNames = map[Severity]string{
+	Off:     "off",
+	Info:    "info",
+	Warning: "warning",
+	Error:   "error",
+}
+
+// String returns the name of severity, such as warning.
+func (s Severity) String() string {
+	if name, ok := severityNames[s]; ok {
+		return name
+	}
+
+	return fmt.Sprintf("Severity(%d)", int(s))
+}
+
+// Problem describes a violation found by a rule, Node is the offending node of table, such as
+// *parser.Table, *parser.Column, *parser.TableConstraint and *parser.Index.
+type Problem struct {
+	Node    interface{}
+	Message string
+}
+
+// Rule checks a table, the built-in rules are returned by DefaultRules.
+type Rule interface {
+	// Name returns the name of rule which is used in the suppression comments, such as primary-key.
+	Name() string
+	// Severity returns the default severity of rule.
+	Severity() Severity
+	// Check returns the problems of table.
+	Check(table *parser.Table) []*Problem
+}
+
+// Diagnostic describes a problem reported by the linter.
+type Diagnostic struct {
+	// File describes the name of linted content, such as schema.sql
+	File     string
+	Position parser.Position
+	Rule     string
+	Severity Severity
+	Table    string
+	// Column describes the name of offending column, it's empty if the problem is not about a column
+	Column  string
+	Message string
+}
+
+// String returns the diagnostic as file:line:column: severity: message (rule).
+func (d *Diagnostic) String() string {
+	return fmt.Sprintf("%s:%s: %s: %s (%s)", d.File, d.Position, d.Severity, d.Message, d.Rule)
+}
+
+// Linter checks the tables by rules, you can use NewLinter to create an instance with options,
+// WithRules option sets the rules, WithSeverity option overrides the severity of a rule.
+type Linter struct {
+	rules      []Rule
+	severities map[string]Severity
+}
+
+// Option is the alias of function.
+type Option func(l *Linter)
+
+// NewLinter creates an instance of Linter.
+func NewLinter(options ...Option) *Linter {
+	l := &Linter{
+		rules:      DefaultRules(),
+		severities: make(map[string]Severity),
+	}
+	for _, opt := range options {
+		opt(l)
+	}
+
+	return l
+}
+
+// WithRules is a Linter option to set the rules, the default rules are DefaultRules.
+func WithRules(rules ...Rule) Option {
+	return func(l *Linter) {
+		l.rules = rules
+	}
+}
+
+// WithSeverity is a Linter option to override the severity of rule, the rule is disabled if
+// the severity is Off.
+func WithSeverity(rule string, severity Severity) Option {
+	return func(l *Linter) {
+		l.severities[rule] = severity
+	}
+}
+
+// Lint parses the sql content and checks the tables, the name is used as the file of
+// diagnostics, the diagnostics are sorted by position.
+func (l *Linter) Lint(name, content string) ([]*Diagnostic, error) {
+	statements, err := parser.NewParser().Statements(name, content)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret []*Diagnostic
+	for _, e := range statements {
+		if e.Table == nil {
+			continue
+		}
+
+		for _, d := range l.LintTable(e.Table, e.Positions) {
+			d.File = name
+			ret = append(ret, d)
+		}
+	}
+
+	sort.SliceStable(ret, func(i, j int) bool {
+		a, b := ret[i].Position, ret[j].Position
+		return a.Line < b.Line || a.Line == b.Line && a.Column < b.Column
+	})
+
+	return ret, nil
+}
+
+// LintTable checks the table, the positions can be nil if the positions of table are unknown.
+func (l *Linter) LintTable(table *parser.Table, positions *parser.Positions) []*Diagnostic {
+	ignored := ignoredRules(table.Comments)
+	var ret []*Diagnostic
+	for _, rule := range l.rules {
+		severity, ok := l.severities[rule.Name()]
+		if !ok {
+			severity = rule.Severity()
+		}
+		if severity == Off || ignored.contains(rule.Name()) {
+			continue
+		}
+
+		for _, problem := range rule.Check(table) {
+			d := Diagnostic{
+				Rule:     rule.Name(),
+				Severity: severity,
+				Table:    table.Name,
+				Message:  problem.Message,
+			}
+			if column, ok := problem.Node.(*parser.Column); ok {
+				if ignoredRules(column.Comments).contains(rule.Name()) {
+					continue
+				}
+				d.Column = column.Name
+			}
+
+			position, ok := positions.Of(problem.Node)
+			if !ok {
+				position, _ = positions.Of(table)
+			}
+			d.Position = position
+			ret = append(ret, &d)
+		}
+	}
+
+	return ret
+}
+
+const ignoreDirective = "ddl-lint:ignore"
+
+// ruleSet describes the suppressed rules, all rules are suppressed if it contains an empty name.
+type ruleSet map[string]bool
+
+func (s ruleSet) contains(rule string) bool {
+	return s[""] || s[rule]
+}
+
+// ignoredRules returns the rules suppressed by the comments, such as -- ddl-lint:ignore a, b.
+func ignoredRules(comments []string) ruleSet {
+	ret := make(ruleSet)
+	for _, e := range comments {
+		text := strings.TrimSpace(e)
+		text = strings.TrimSuffix(strings.TrimPrefix(text, "/*"), "*/")
+		text = strings.TrimLeft(strings.TrimPrefix(text, "--"), "# ")
+		text = strings.TrimSpace(text)
+		if !strings.HasPrefix(text, ignoreDirective) {
+			continue
+		}
+
+		names := strings.FieldsFunc(text[len(ignoreDirective):], func(r rune) bool {
+			return r == ',' || r == ' ' || r == '\t'
+		})
+		if len(names) == 0 {
+			ret[""] = true
+		}
+		for _, name := range names {
+			ret[name] = true
+		}
+	}
+
+	return ret
+}
@@ -0,0 +1,101 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package lint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zeromicro/ddl-parser/parser"
+)
+
+func TestLinter_Lint(t *testing.T) {
+	content := "CREATE TABLE `user` (\n" +
+		"  `id` bigint NOT NULL COMMENT 'id',\n" +
+		"  `name` varchar(64) CHARACTER SET latin1 NOT NULL,\n" +
+		"  `balance` double NOT NULL COMMENT 'balance',\n" +
+		"  `created_at` datetime NOT NULL COMMENT 'created',\n" +
+		"  `updated_at` datetime NOT NULL COMMENT 'updated',\n" +
+		"  PRIMARY KEY (`id`)\n" +
+		") DEFAULT CHARSET=utf8mb4 COMMENT='users';\n" +
+		"-- ddl-lint:ignore required-columns, table-comment\n" +
+		"CREATE TABLE `log` (\n" +
+		"  `content` text -- ddl-lint:ignore\n" +
+		") COLLATE=utf8mb4_bin;"
+
+	diagnostics, err := NewLinter().Lint("schema.sql", content)
+	assert.NoError(t, err)
+
+	var actual []string
+	for _, e := range diagnostics {
+		actual = append(actual, e.String())
+	}
+	assert.Equal(t, []string{
+		"schema.sql:3:2: warning: column name uses character set latin1, expected utf8mb4 (charset)",
+		"schema.sql:3:2: warning: column name has no comment (column-comment)",
+		"schema.sql:4:2: error: column balance stores money as DOUBLE, use DECIMAL instead (float-money)",
+		"schema.sql:10:0: error: table log has no primary key (primary-key)",
+	}, actual)
+	assert.Equal(t, "user", diagnostics[0].Table)
+	assert.Equal(t, "name", diagnostics[0].Column)
+
+	_, err = NewLinter().Lint("schema.sql", "CREATE TABLE")
+	assert.Error(t, err)
+}
+
+func TestLinter_options(t *testing.T) {
+	content := "CREATE TABLE `log` (`content` text) CHARSET=latin1;"
+	diagnostics, err := NewLinter(
+		WithRules(PrimaryKeyRule(), CharsetRule("utf8mb4", "latin1"), ColumnCommentRule()),
+		WithSeverity("primary-key", Warning),
+		WithSeverity("column-comment", Off),
+	).Lint("schema.sql", content)
+	assert.NoError(t, err)
+	assert.Equal(t, []*Diagnostic{{
+		File:     "schema.sql",
+		Position: parser.Position{Line: 1, Column: 0},
+		Rule:     "primary-key",
+		Severity: Warning,
+		Table:    "log",
+		Message:  "table log has no primary key",
+	}}, diagnostics)
+}
+
+func TestNewRule(t *testing.T) {
+	rule := NewRule("no-log", Info, func(table *parser.Table) []*Problem {
+		if table.Name == "log" {
+			return []*Problem{{Node: table.Columns[0], Message: "log table"}}
+		}
+		return nil
+	})
+
+	statements, err := parser.NewParser().Statements("schema.sql", "CREATE TABLE `log` (\n  `id` int\n);")
+	assert.NoError(t, err)
+	diagnostics := NewLinter(WithRules(rule)).LintTable(statements[0].Table, statements[0].Positions)
+	assert.Equal(t, "2:2", diagnostics[0].Position.String())
+	assert.Equal(t, "info", diagnostics[0].Severity.String())
+
+	diagnostics = NewLinter(WithRules(rule)).LintTable(statements[0].Table, nil)
+	assert.Equal(t, parser.Position{}, diagnostics[0].Position)
+}
+
+func Test_ignoredRules(t *testing.T) {
+	assert.True(t, ignoredRules([]string{"/* ddl-lint:ignore a,b */"}).contains("b"))
+	assert.True(t, ignoredRules([]string{"# ddl-lint:ignore"}).contains("c"))
+	assert.False(t, ignoredRules([]string{"-- ddl-lint:ignore a"}).contains("c"))
+	assert.False(t, ignoredRules([]string{"-- see ddl-lint:ignore"}).contains("c"))
+}
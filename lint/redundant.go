@@ -0,0 +1,190 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package lint
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/zeromicro/ddl-parser/parser"
+	"github.com/zeromicro/ddl-parser/printer"
+)
+
+// RedundancyKind describes why an index is redundant.
+type RedundancyKind int
+
+const (
+	// Duplicate describes the index has the same columns as another index.
+	Duplicate RedundancyKind = iota
+	// LeftPrefix describes the columns of BTREE index are the leftmost prefix of another BTREE
+	// index, the queries which use the index can use the other index. The FULLTEXT and SPATIAL
+	// indexes are never left prefixes since they can not be searched by the leftmost columns.
+	LeftPrefix
+	// DuplicatePrimaryKey describes the unique key has the same columns as the primary key.
+	DuplicatePrimaryKey
+)
+
+// Redundancy describes an index which can be dropped since another index covers it.
+type Redundancy struct {
+	Kind RedundancyKind
+	// Index describes the redundant index
+	Index *parser.Index
+	// Name describes the name of redundant index, mysql names the index by its first column if
+	// the name is not specified
+	Name string
+	// By describes the index which covers the redundant index
+	By *parser.Index
+	// ByName describes the name of the index which covers the redundant index
+	ByName string
+	// Drop describes the statement to drop the redundant index, such as
+	// ALTER TABLE `user` DROP INDEX `idx_name`;
+	Drop string
+}
+
+var defaultPrinter = printer.NewPrinter()
+
+// RedundantIndexes returns the redundant indexes of table, the primary key, the unique keys and
+// the secondary indexes are compared including the ones declared by column constraints, the
+// FULLTEXT and SPATIAL indexes are only compared with the indexes of the same type, and they are
+// only redundant if they duplicate another index.
+func RedundantIndexes(table *parser.Table) []*Redundancy {
+	indexes := table.AllIndexes()
+	names := parser.IndexNames(indexes)
+	redundant := make(map[int]bool)
+	var ret []*Redundancy
+	report := func(kind RedundancyKind, i, by int) {
+		redundant[i] = true
+		ret = append(ret, &Redundancy{
+			Kind:   kind,
+			Index:  indexes[i],
+			Name:   names[i],
+			By:     indexes[by],
+			ByName: names[by],
+			Drop:   fmt.Sprintf("ALTER TABLE %s DROP INDEX %s;", defaultPrinter.TableName(table), defaultPrinter.Identifier(names[i])),
+		})
+	}
+
+	for i, a := range indexes {
+		if a.Primary {
+			continue
+		}
+
+		for j, b := range indexes {
+			if i == j || redundant[j] || indexType(a) != indexType(b) {
+				continue
+			}
+
+			switch {
+			case sameIndexColumns(a.Columns, b.Columns):
+				switch {
+				case b.Primary && a.Unique:
+					report(DuplicatePrimaryKey, i, j)
+				case b.Primary, b.Unique && !a.Unique, rank(a) == rank(b) && j < i:
+					// the index which is weaker or declared later is dropped
+					report(Duplicate, i, j)
+				}
+			case indexType(a) == "BTREE" && !a.Unique && len(a.Columns) < len(b.Columns) &&
+				sameIndexColumns(a.Columns, b.Columns[:len(a.Columns)]):
+				report(LeftPrefix, i, j)
+			}
+			if redundant[i] {
+				break
+			}
+		}
+	}
+
+	return ret
+}
+
+// RedundantIndexRule returns the rule redundant-index which reports the indexes returned by
+// RedundantIndexes, the messages contain the statements to drop them.
+func RedundantIndexRule() Rule {
+	return NewRule("redundant-index", Warning, func(table *parser.Table) []*Problem {
+		var ret []*Problem
+		for _, e := range RedundantIndexes(table) {
+			var reason string
+			switch e.Kind {
+			case Duplicate:
+				reason = "duplicates index " + e.ByName
+			case LeftPrefix:
+				reason = "is a left prefix of index " + e.ByName
+			case DuplicatePrimaryKey:
+				reason = "duplicates the primary key"
+			}
+
+			ret = append(ret, &Problem{
+				Node:    indexNode(table, e.Index),
+				Message: fmt.Sprintf("index %s %s, drop it by %s", e.Name, reason, e.Drop),
+			})
+		}
+
+		return ret
+	})
+}
+
+// indexNode returns the node which declares the index, it's the column if the index is declared
+// by column constraint.
+func indexNode(table *parser.Table, index *parser.Index) interface{} {
+	for _, e := range table.Indexes {
+		if e == index {
+			return index
+		}
+	}
+	if column := table.Column(index.Columns[0].Name); column != nil {
+		return column
+	}
+
+	return table
+}
+
+func indexType(index *parser.Index) string {
+	switch {
+	case index.Fulltext:
+		return "FULLTEXT"
+	case index.Spatial:
+		return "SPATIAL"
+	}
+
+	return "BTREE"
+}
+
+// rank returns the strength of index, the primary key is stronger than the unique keys, the
+// unique keys are stronger than the secondary indexes.
+func rank(index *parser.Index) int {
+	switch {
+	case index.Primary:
+		return 2
+	case index.Unique:
+		return 1
+	}
+
+	return 0
+}
+
+func sameIndexColumns(a, b []*parser.IndexColumn) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if !strings.EqualFold(a[i].Name, b[i].Name) || a[i].Length != b[i].Length || a[i].Desc != b[i].Desc {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,80 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package lint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zeromicro/ddl-parser/parser"
+)
+
+func TestRedundantIndexes(t *testing.T) {
+	statements, err := parser.NewParser().Statements("schema.sql", "CREATE TABLE `user` (\n"+
+		"  `id` bigint NOT NULL,\n"+
+		"  `a` int NOT NULL,\n"+
+		"  `b` int NOT NULL,\n"+
+		"  `c` varchar(64) NOT NULL UNIQUE,\n"+
+		"  PRIMARY KEY (`id`),\n"+
+		"  UNIQUE KEY `uk_id` (`id`),\n"+
+		"  KEY (`a`),\n"+
+		"  KEY `idx_a_b` (`a`, `b`),\n"+
+		"  UNIQUE KEY `uk_a` (`a`),\n"+
+		"  KEY `idx_b` (`b`),\n"+
+		"  KEY `idx_b_2` (`b`),\n"+
+		"  KEY `idx_c` (`c`),\n"+
+		"  FULLTEXT KEY `ft_c` (`c`)\n"+
+		");")
+	assert.NoError(t, err)
+
+	table := statements[0].Table
+	var actual [][3]string
+	for _, e := range RedundantIndexes(table) {
+		actual = append(actual, [3]string{e.Name, e.ByName, e.Drop})
+		if e.Name == "uk_id" {
+			assert.Equal(t, DuplicatePrimaryKey, e.Kind)
+		}
+	}
+	assert.Equal(t, [][3]string{
+		{"uk_id", "PRIMARY", "ALTER TABLE `user` DROP INDEX `uk_id`;"},
+		{"a", "idx_a_b", "ALTER TABLE `user` DROP INDEX `a`;"},
+		{"idx_b_2", "idx_b", "ALTER TABLE `user` DROP INDEX `idx_b_2`;"},
+		{"idx_c", "c", "ALTER TABLE `user` DROP INDEX `idx_c`;"},
+	}, actual)
+
+	diagnostics := NewLinter(WithRules(RedundantIndexRule())).LintTable(table, statements[0].Positions)
+	assert.Len(t, diagnostics, 4)
+	assert.Equal(t, "8:2", diagnostics[1].Position.String())
+	assert.Equal(t, "index a is a left prefix of index idx_a_b, drop it by ALTER TABLE `user` DROP INDEX `a`;", diagnostics[1].Message)
+}
+
+func TestRedundantIndexes_fulltext(t *testing.T) {
+	statements, err := parser.NewParser().Statements("schema.sql", "CREATE TABLE `doc` (\n"+
+		"  `a` text NOT NULL,\n"+
+		"  `b` text NOT NULL,\n"+
+		"  FULLTEXT KEY `f1` (`b`),\n"+
+		"  FULLTEXT KEY `f2` (`b`, `a`),\n"+
+		"  FULLTEXT KEY `f3` (`b`)\n"+
+		");")
+	assert.NoError(t, err)
+
+	actual := RedundantIndexes(statements[0].Table)
+	assert.Len(t, actual, 1)
+	assert.Equal(t, Duplicate, actual[0].Kind)
+	assert.Equal(t, "f3", actual[0].Name)
+	assert.Equal(t, "f1", actual[0].ByName)
+}
@@ -0,0 +1,131 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package lint
+
+import "strings"
+
+// Version describes the version of mysql server, the reserved words differ between versions.
+type Version string
+
+const (
+	// MySQL57 describes mysql 5.7.
+	MySQL57 Version = "5.7"
+	// MySQL80 describes mysql 8.0.
+	MySQL80 Version = "8.0"
+	// MySQL84 describes mysql 8.4.
+	MySQL84 Version = "8.4"
+)
+
+// The reserved words are copied from the manuals rather than derived from grammar/MySqlLexer.g4,
+// since the lexer does not tell the reserved keywords from the nonreserved ones, and it lacks the
+// words which are reserved later than the grammar, such as ARRAY, INTERSECT and QUALIFY.
+
+// reserved57 describes the reserved words of mysql 5.7,
+// https://dev.mysql.com/doc/refman/5.7/en/keywords.html
+var reserved57 = []string{
+	"ACCESSIBLE", "ADD", "ALL", "ALTER", "ANALYZE", "AND", "AS", "ASC", "ASENSITIVE",
+	"BEFORE", "BETWEEN", "BIGINT", "BINARY", "BLOB", "BOTH", "BY",
+	"CALL", "CASCADE", "CASE", "CHANGE", "CHAR", "CHARACTER", "CHECK", "COLLATE", "COLUMN",
+	"CONDITION", "CONSTRAINT", "CONTINUE", "CONVERT", "CREATE", "CROSS", "CURRENT_DATE",
+	"CURRENT_TIME", "CURRENT_TIMESTAMP", "CURRENT_USER", "CURSOR",
+	"DATABASE", "DATABASES", "DAY_HOUR", "DAY_MICROSECOND", "DAY_MINUTE", "DAY_SECOND", "DEC",
+	"DECIMAL", "DECLARE", "DEFAULT", "DELAYED", "DELETE", "DESC", "DESCRIBE", "DETERMINISTIC",
+	"DISTINCT", "DISTINCTROW", "DIV", "DOUBLE", "DROP", "DUAL",
+	"EACH", "ELSE", "ELSEIF", "ENCLOSED", "ESCAPED", "EXISTS", "EXIT", "EXPLAIN",
+	"FALSE", "FETCH", "FLOAT", "FLOAT4", "FLOAT8", "FOR", "FORCE", "FOREIGN", "FROM", "FULLTEXT",
+	"GENERATED", "GET", "GRANT", "GROUP",
+	"HAVING", "HIGH_PRIORITY", "HOUR_MICROSECOND", "HOUR_MINUTE", "HOUR_SECOND",
+	"IF", "IGNORE", "IN", "INDEX", "INFILE", "INNER", "INOUT", "INSENSITIVE", "INSERT", "INT",
+	"INT1", "INT2", "INT3", "INT4", "INT8", "INTEGER", "INTERVAL", "INTO", "IO_AFTER_GTIDS",
+	"IO_BEFORE_GTIDS", "IS", "ITERATE",
+	"JOIN",
+	"KEY", "KEYS", "KILL",
+	"LEADING", "LEAVE", "LEFT", "LIKE", "LIMIT", "LINEAR", "LINES", "LOAD", "LOCALTIME",
+	"LOCALTIMESTAMP", "LOCK", "LONG", "LONGBLOB", "LONGTEXT", "LOOP", "LOW_PRIORITY",
+	"MASTER_BIND", "MASTER_SSL_VERIFY_SERVER_CERT", "MATCH", "MAXVALUE", "MEDIUMBLOB",
+	"MEDIUMINT", "MEDIUMTEXT", "MIDDLEINT", "MINUTE_MICROSECOND", "MINUTE_SECOND", "MOD", "MODIFIES",
+	"NATURAL", "NOT", "NO_WRITE_TO_BINLOG", "NULL", "NUMERIC",
+	"ON", "OPTIMIZE", "OPTIMIZER_COSTS", "OPTION", "OPTIONALLY", "OR", "ORDER", "OUT", "OUTER", "OUTFILE",
+	"PARTITION", "PRECISION", "PRIMARY", "PROCEDURE", "PURGE",
+	"RANGE", "READ", "READS", "READ_WRITE", "REAL", "REFERENCES", "REGEXP", "RELEASE", "RENAME",
+	"REPEAT", "REPLACE", "REQUIRE", "RESIGNAL", "RESTRICT", "RETURN", "REVOKE", "RIGHT", "RLIKE",
+	"SCHEMA", "SCHEMAS", "SECOND_MICROSECOND", "SELECT", "SENSITIVE", "SEPARATOR", "SET", "SHOW",
+	"SIGNAL", "SMALLINT", "SPATIAL", "SPECIFIC", "SQL", "SQLEXCEPTION", "SQLSTATE", "SQLWARNING",
+	"SQL_BIG_RESULT", "SQL_CALC_FOUND_ROWS", "SQL_SMALL_RESULT", "SSL", "STARTING", "STORED",
+	"STRAIGHT_JOIN",
+	"TABLE", "TERMINATED", "THEN", "TINYBLOB", "TINYINT", "TINYTEXT", "TO", "TRAILING", "TRIGGER", "TRUE",
+	"UNDO", "UNION", "UNIQUE", "UNLOCK", "UNSIGNED", "UPDATE", "USAGE", "USE", "USING", "UTC_DATE",
+	"UTC_TIME", "UTC_TIMESTAMP",
+	"VALUES", "VARBINARY", "VARCHAR", "VARCHARACTER", "VARYING", "VIRTUAL",
+	"WHEN", "WHERE", "WHILE", "WITH", "WRITE",
+	"XOR",
+	"YEAR_MONTH",
+	"ZEROFILL",
+}
+
+// removed80 describes the reserved words of mysql 5.7 which are removed in mysql 8.0.
+var removed80 = []string{"ANALYSE", "DES_KEY_FILE", "PARSE_GCOL_EXPR", "REDOFILE", "SQL_CACHE"}
+
+// added80 describes the words which become reserved in mysql 8.0 including its patch releases,
+// such as ARRAY and MEMBER in 8.0.17 and INTERSECT in 8.0.31,
+// https://dev.mysql.com/doc/refman/8.0/en/keywords.html
+var added80 = []string{
+	"ARRAY", "CUBE", "CUME_DIST", "DENSE_RANK", "EMPTY", "EXCEPT", "FIRST_VALUE", "FUNCTION",
+	"GROUPING", "GROUPS", "INTERSECT", "JSON_TABLE", "LAG", "LAST_VALUE", "LATERAL", "LEAD",
+	"MEMBER", "NTH_VALUE", "NTILE", "OF", "OVER", "PERCENT_RANK", "RANK", "RECURSIVE", "ROW",
+	"ROWS", "ROW_NUMBER", "SYSTEM", "WINDOW",
+}
+
+// removed84 describes the reserved words of mysql 8.0 which are removed in mysql 8.4.
+var removed84 = []string{"MASTER_BIND", "MASTER_SSL_VERIFY_SERVER_CERT"}
+
+// added84 describes the words which become reserved in mysql 8.4,
+// https://dev.mysql.com/doc/refman/8.4/en/keywords.html
+var added84 = []string{"MANUAL", "PARALLEL", "QUALIFY", "TABLESAMPLE"}
+
+var reservedWords = map[Version]map[string]bool{
+	MySQL57: wordSet(reserved57, []string{"ANALYSE", "PARSE_GCOL_EXPR"}, nil),
+	MySQL80: wordSet(reserved57, added80, removed80),
+	MySQL84: wordSet(reserved57, append(append([]string{}, added80...), added84...), append(append([]string{}, removed80...), removed84...)),
+}
+
+func wordSet(words, added, removed []string) map[string]bool {
+	ret := make(map[string]bool)
+	for _, e := range words {
+		ret[e] = true
+	}
+	for _, e := range added {
+		ret[e] = true
+	}
+	for _, e := range removed {
+		delete(ret, e)
+	}
+
+	return ret
+}
+
+// IsReserved returns true if the word is reserved in the version of mysql, the reserved words
+// must be quoted to be used as identifiers. The word is matched case-insensitively, the words
+// of mysql 8.0 are used if the version is unknown.
+func IsReserved(word string, version Version) bool {
+	words, ok := reservedWords[version]
+	if !ok {
+		words = reservedWords[MySQL80]
+	}
+
+	return words[strings.ToUpper(word)]
+}
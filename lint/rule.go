@@ -0,0 +1,182 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package lint
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/zeromicro/ddl-parser/parser"
+)
+
+// DefaultRules returns the built-in rules with their default configurations.
+func DefaultRules() []Rule {
+	return []Rule{
+		PrimaryKeyRule(),
+		CharsetRule("utf8mb4"),
+		RequiredColumnsRule("created_at", "updated_at"),
+		FloatMoneyRule("*price*", "*amount*", "*balance*", "*money*", "*cost*", "*fee*"),
+		TableCommentRule(),
+		ColumnCommentRule(),
+		RedundantIndexRule(),
+		SizeLimitRule("utf8mb4"),
+		IdentifierRule(MySQL80),
+	}
+}
+
+type rule struct {
+	name     string
+	severity Severity
+	check    func(table *parser.Table) []*Problem
+}
+
+func (r *rule) Name() string {
+	return r.name
+}
+
+func (r *rule) Severity() Severity {
+	return r.severity
+}
+
+func (r *rule) Check(table *parser.Table) []*Problem {
+	return r.check(table)
+}
+
+// NewRule creates a Rule with the name, the default severity and the check function.
+func NewRule(name string, severity Severity, check func(table *parser.Table) []*Problem) Rule {
+	return &rule{name: name, severity: severity, check: check}
+}
+
+// PrimaryKeyRule returns the rule primary-key which reports the tables without primary key.
+func PrimaryKeyRule() Rule {
+	return NewRule("primary-key", Error, func(table *parser.Table) []*Problem {
+		if len(table.PrimaryKey()) > 0 {
+			return nil
+		}
+
+		return []*Problem{{Node: table, Message: fmt.Sprintf("table %s has no primary key", table.Name)}}
+	})
+}
+
+// CharsetRule returns the rule charset which reports the tables and the columns whose character
+// sets are not allowed, the tables without character set are reported since they depend on the
+// default character set of server.
+func CharsetRule(charsets ...string) Rule {
+	allowed := func(charset string) bool {
+		for _, e := range charsets {
+			if strings.EqualFold(e, charset) {
+				return true
+			}
+		}
+		return false
+	}
+
+	return NewRule("charset", Warning, func(table *parser.Table) []*Problem {
+		var ret []*Problem
+		charset := table.Option(parser.TableOptionCharset)
+		if collation := table.Option(parser.TableOptionCollate); len(charset) == 0 && len(collation) > 0 {
+			// the character set is implied by the collation, such as utf8mb4 of utf8mb4_bin
+			charset = strings.SplitN(collation, "_", 2)[0]
+		}
+		if len(charset) == 0 {
+			ret = append(ret, &Problem{Node: table, Message: fmt.Sprintf("table %s has no character set", table.Name)})
+		} else if !allowed(charset) {
+			ret = append(ret, &Problem{
+				Node:    table,
+				Message: fmt.Sprintf("table %s uses character set %s, expected %s", table.Name, charset, strings.Join(charsets, ", ")),
+			})
+		}
+
+		for _, e := range table.Columns {
+			if e.DataType != nil && len(e.DataType.Charset()) > 0 && !allowed(e.DataType.Charset()) {
+				ret = append(ret, &Problem{
+					Node:    e,
+					Message: fmt.Sprintf("column %s uses character set %s, expected %s", e.Name, e.DataType.Charset(), strings.Join(charsets, ", ")),
+				})
+			}
+		}
+
+		return ret
+	})
+}
+
+// RequiredColumnsRule returns the rule required-columns which reports the tables without the
+// columns, such as created_at, updated_at.
+func RequiredColumnsRule(columns ...string) Rule {
+	return NewRule("required-columns", Warning, func(table *parser.Table) []*Problem {
+		var ret []*Problem
+		for _, e := range columns {
+			if table.Column(e) == nil {
+				ret = append(ret, &Problem{Node: table, Message: fmt.Sprintf("table %s has no column %s", table.Name, e)})
+			}
+		}
+
+		return ret
+	})
+}
+
+// FloatMoneyRule returns the rule float-money which reports the floating-point columns whose
+// names match the patterns, the patterns are matched case-insensitively by path.Match, the
+// monetary values should be stored as DECIMAL.
+func FloatMoneyRule(patterns ...string) Rule {
+	return NewRule("float-money", Error, func(table *parser.Table) []*Problem {
+		var ret []*Problem
+		for _, e := range table.Columns {
+			if e.DataType == nil || !e.DataType.Type().IsFloatingPoint() {
+				continue
+			}
+
+			for _, pattern := range patterns {
+				if ok, _ := path.Match(strings.ToLower(pattern), strings.ToLower(e.Name)); ok {
+					ret = append(ret, &Problem{
+						Node:    e,
+						Message: fmt.Sprintf("column %s stores money as %s, use DECIMAL instead", e.Name, e.DataType.Type()),
+					})
+					break
+				}
+			}
+		}
+
+		return ret
+	})
+}
+
+// TableCommentRule returns the rule table-comment which reports the tables without COMMENT.
+func TableCommentRule() Rule {
+	return NewRule("table-comment", Warning, func(table *parser.Table) []*Problem {
+		if len(table.Option(parser.TableOptionComment)) > 0 {
+			return nil
+		}
+
+		return []*Problem{{Node: table, Message: fmt.Sprintf("table %s has no comment", table.Name)}}
+	})
+}
+
+// ColumnCommentRule returns the rule column-comment which reports the columns without COMMENT.
+func ColumnCommentRule() Rule {
+	return NewRule("column-comment", Warning, func(table *parser.Table) []*Problem {
+		var ret []*Problem
+		for _, e := range table.Columns {
+			if e.Constraint == nil || len(e.Constraint.Comment) == 0 {
+				ret = append(ret, &Problem{Node: e, Message: fmt.Sprintf("column %s has no comment", e.Name)})
+			}
+		}
+
+		return ret
+	})
+}
@@ -0,0 +1,98 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package migrate
+
+import (
+	"github.com/zeromicro/ddl-parser/parser"
+	"github.com/zeromicro/ddl-parser/printer"
+)
+
+var defaultPrinter = printer.NewPrinter()
+
+func quote(name string) string {
+	return defaultPrinter.Identifier(name)
+}
+
+func tableName(table *parser.Table) string {
+	return defaultPrinter.TableName(table)
+}
+
+// columnDefinition returns the definition of column, the key attributes such as PRIMARY KEY
+// and UNIQUE are excluded since they are migrated as indexes.
+func columnDefinition(column *parser.Column) string {
+	var constraint parser.ColumnConstraint
+	if column.Constraint != nil {
+		constraint = *column.Constraint
+	}
+	constraint.Primary, constraint.Key, constraint.Unique = false, false, false
+
+	return defaultPrinter.ColumnDefinition(&parser.Column{
+		Name:       column.Name,
+		DataType:   column.DataType,
+		Constraint: &constraint,
+	})
+}
+
+// indexNames describes the names of indexes of a table, the unnamed indexes are named like
+// mysql does, such as a and a_2 for KEY (a) and KEY (a, b).
+type indexNames map[*parser.Index]string
+
+func newIndexNames(table *parser.Table) indexNames {
+	indexes := table.AllIndexes()
+	ret := make(indexNames)
+	for i, e := range parser.IndexNames(indexes) {
+		ret[indexes[i]] = e
+	}
+
+	return ret
+}
+
+// of returns the name of index, the indexes implied by column constraints are not in the
+// names since they are created by every call of AllIndexes, but they are always named.
+func (n indexNames) of(index *parser.Index) string {
+	if name, ok := n[index]; ok {
+		return name
+	}
+
+	return index.Name
+}
+
+func dropIndex(index *parser.Index, name string) string {
+	if index.Primary {
+		return "DROP PRIMARY KEY"
+	}
+
+	return "DROP INDEX " + quote(name)
+}
+
+func addIndex(index *parser.Index, name string) string {
+	if index.Primary {
+		return "ADD " + defaultPrinter.IndexDefinition(index)
+	}
+
+	named := *index
+	named.Name = name
+	return "ADD " + defaultPrinter.IndexDefinition(&named)
+}
+
+func addForeignKey(fk *parser.ForeignKey) string {
+	return "ADD " + defaultPrinter.ForeignKeyDefinition(fk)
+}
+
+func tableOption(name, value string) string {
+	return defaultPrinter.TableOption(&parser.TableOption{Name: name, Value: value})
+}
@@ -0,0 +1,307 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+// Package migrate generates the ALTER TABLE statements which transform one definition
+// of a table into another, it can be used to generate the migration files from a
+// declarative schema.sql.
+package migrate
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/zeromicro/ddl-parser/diff"
+	"github.com/zeromicro/ddl-parser/parser"
+)
+
+// Migration describes the statements to migrate a table, Up transforms the old definition
+// into the new one, Down transforms the new definition back into the old one.
+type Migration struct {
+	Up   []string
+	Down []string
+}
+
+// resetValues describes the values which reset the table options to their defaults when the
+// options are removed, the other options such as ENGINE and DATA DIRECTORY can not be reset.
+var resetValues = map[string]string{
+	parser.TableOptionCharset:   "DEFAULT",
+	parser.TableOptionComment:   "",
+	parser.TableOptionRowFormat: "DEFAULT",
+	"KEY_BLOCK_SIZE":            "0",
+	"STATS_PERSISTENT":          "DEFAULT",
+	"STATS_AUTO_RECALC":         "DEFAULT",
+	"STATS_SAMPLE_PAGES":        "DEFAULT",
+	"PACK_KEYS":                 "DEFAULT",
+	"MAX_ROWS":                  "0",
+	"MIN_ROWS":                  "0",
+	"AVG_ROW_LENGTH":            "0",
+	"CHECKSUM":                  "0",
+	"DELAY_KEY_WRITE":           "0",
+	"COMPRESSION":               "'None'",
+}
+
+// Table returns the migration between the table from and to, it returns an error if a
+// table option is removed which can not be reset.
+func Table(from, to *parser.Table, options ...diff.Option) (*Migration, error) {
+	up, err := AlterTable(diff.CompareTable(from, to, options...))
+	if err != nil {
+		return nil, err
+	}
+
+	down, err := AlterTable(diff.CompareTable(to, from, options...))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Migration{Up: up, Down: down}, nil
+}
+
+// AlterTable returns the statements which apply the changes of table, the foreign keys are
+// dropped before and added after the other changes since they depend on the indexes and
+// columns. It returns nil if there is no change, and returns an error if a table option is
+// removed which can not be reset.
+func AlterTable(d *diff.TableDiff) ([]string, error) {
+	var ret []string
+	name := tableName(d.From)
+	if d.Renamed() {
+		ret = append(ret, fmt.Sprintf("ALTER TABLE %s RENAME TO %s;", name, tableName(d.To)))
+		name = tableName(d.To)
+	}
+
+	var drops []string
+	for _, e := range d.DroppedForeignKeys {
+		drops = append(drops, "DROP FOREIGN KEY "+quote(foreignKeyName(d.From, e)))
+	}
+	if len(drops) > 0 {
+		ret = append(ret, alterTable(name, drops))
+	}
+
+	specs, err := alterSpecifications(d)
+	if err != nil {
+		return nil, err
+	}
+	if len(specs) > 0 {
+		ret = append(ret, alterTable(name, specs))
+	}
+
+	var adds []string
+	for _, e := range d.AddedForeignKeys {
+		adds = append(adds, addForeignKey(e))
+	}
+	if len(adds) > 0 {
+		ret = append(ret, alterTable(name, adds))
+	}
+
+	return ret, nil
+}
+
+func alterTable(name string, specs []string) string {
+	return fmt.Sprintf("ALTER TABLE %s\n  %s;", name, strings.Join(specs, ",\n  "))
+}
+
+func alterSpecifications(d *diff.TableDiff) ([]string, error) {
+	var specs []string
+	fromNames, toNames := newIndexNames(d.From), newIndexNames(d.To)
+	for _, e := range d.DroppedIndexes {
+		specs = append(specs, dropIndex(e, fromNames.of(e)))
+	}
+	for _, e := range d.ModifiedIndexes {
+		specs = append(specs, dropIndex(e.From, fromNames.of(e.From)))
+	}
+	for _, e := range d.DroppedColumns {
+		specs = append(specs, "DROP COLUMN "+quote(e.Name))
+	}
+
+	specs = append(specs, columnSpecifications(d)...)
+
+	for _, e := range d.ModifiedIndexes {
+		specs = append(specs, addIndex(e.To, toNames.of(e.To)))
+	}
+	for _, e := range d.AddedIndexes {
+		specs = append(specs, addIndex(e, toNames.of(e)))
+	}
+	options, err := optionSpecifications(d)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(specs, options...), nil
+}
+
+// optionSpecifications returns the table options which are modified, the removed options are
+// reset to their defaults, the removed COLLATE is reset by the character set.
+func optionSpecifications(d *diff.TableDiff) ([]string, error) {
+	var specs []string
+	charset := false
+	for _, e := range d.ModifiedOptions {
+		name, value := e.Name, e.To
+		if len(value) == 0 && name == parser.TableOptionCollate {
+			// the character set without collation uses its default collation
+			name, value = parser.TableOptionCharset, d.To.Option(parser.TableOptionCharset)
+		}
+		if len(value) == 0 {
+			reset, ok := resetValues[name]
+			if !ok {
+				return nil, fmt.Errorf("table option %s of table %s can not be reset", name, d.To.Name)
+			}
+			value = reset
+		}
+
+		if name == parser.TableOptionCharset {
+			if charset {
+				continue
+			}
+			charset = true
+		}
+		specs = append(specs, tableOption(name, value))
+	}
+
+	return specs, nil
+}
+
+// columnSpecifications returns the ADD, CHANGE and MODIFY specifications in the order of
+// the new definition, the position is specified by FIRST or AFTER if the column is not
+// appended to the end. The existing columns which are already in the new order keep their
+// positions, so that as few columns as possible are moved.
+func columnSpecifications(d *diff.TableDiff) []string {
+	renamed := make(map[string]*parser.Column)
+	renamedFrom := make(map[string]string)
+	for _, e := range d.RenamedColumns {
+		renamed[strings.ToLower(e.To.Name)] = e.From
+		renamedFrom[strings.ToLower(e.From.Name)] = strings.ToLower(e.To.Name)
+	}
+
+	// order describes the order of columns after the dropped columns are dropped and
+	// the renamed columns are renamed in place
+	var order []string
+	for _, e := range d.From.Columns {
+		key := strings.ToLower(e.Name)
+		if name, ok := renamedFrom[key]; ok {
+			order = append(order, name)
+		} else if d.To.Column(e.Name) != nil {
+			order = append(order, key)
+		}
+	}
+
+	stable := stableColumns(order, d.To)
+	var specs []string
+	for i, e := range d.To.Columns {
+		key := strings.ToLower(e.Name)
+		position := ""
+		if !stable[key] {
+			current := indexOf(order, key)
+			if current >= 0 {
+				order = append(order[:current], order[current+1:]...)
+			}
+
+			at := 0
+			if i > 0 {
+				at = indexOf(order, strings.ToLower(d.To.Columns[i-1].Name)) + 1
+			}
+			if at != current && !(current < 0 && at == len(order)) {
+				position = " FIRST"
+				if i > 0 {
+					position = " AFTER " + quote(d.To.Columns[i-1].Name)
+				}
+			}
+			order = append(order[:at], append([]string{key}, order[at:]...)...)
+		}
+
+		old := d.From.Column(e.Name)
+		switch {
+		case renamed[key] != nil:
+			specs = append(specs, "CHANGE COLUMN "+quote(renamed[key].Name)+" "+columnDefinition(e)+position)
+		case old == nil:
+			specs = append(specs, "ADD COLUMN "+columnDefinition(e)+position)
+		case columnDefinition(old) != columnDefinition(e) || len(position) > 0:
+			specs = append(specs, "MODIFY COLUMN "+columnDefinition(e)+position)
+		}
+	}
+
+	return specs
+}
+
+// stableColumns returns the columns which keep their positions, they are the longest
+// subsequence of order whose columns are in the same order as the table.
+func stableColumns(order []string, table *parser.Table) map[string]bool {
+	positions := make([]int, len(order))
+	for i, e := range order {
+		positions[i] = indexOfColumn(table, e)
+	}
+
+	// lengths[i] describes the length of the longest subsequence which ends at i, prev[i]
+	// describes the previous element of the subsequence
+	lengths, prev := make([]int, len(order)), make([]int, len(order))
+	last := -1
+	for i := range positions {
+		lengths[i], prev[i] = 1, -1
+		for j := 0; j < i; j++ {
+			if positions[j] < positions[i] && lengths[j]+1 > lengths[i] {
+				lengths[i], prev[i] = lengths[j]+1, j
+			}
+		}
+		if last < 0 || lengths[i] > lengths[last] {
+			last = i
+		}
+	}
+
+	ret := make(map[string]bool)
+	for i := last; i >= 0; i = prev[i] {
+		ret[order[i]] = true
+	}
+
+	return ret
+}
+
+func indexOfColumn(table *parser.Table, name string) int {
+	for i, e := range table.Columns {
+		if strings.EqualFold(e.Name, name) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// foreignKeyName returns the name of foreign key, mysql names the foreign key as
+// tbl_name_ibfk_N if the name is not specified.
+func foreignKeyName(table *parser.Table, fk *parser.ForeignKey) string {
+	if len(fk.Name) > 0 {
+		return fk.Name
+	}
+
+	n := 0
+	for _, e := range table.ForeignKeys() {
+		if len(e.Name) == 0 {
+			n++
+		}
+		if e == fk {
+			break
+		}
+	}
+
+	return fmt.Sprintf("%s_ibfk_%d", table.Name, n)
+}
+
+func indexOf(list []string, s string) int {
+	for i, e := range list {
+		if e == s {
+			return i
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,193 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package migrate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zeromicro/ddl-parser/diff"
+	"github.com/zeromicro/ddl-parser/internal/testutil"
+)
+
+func TestTable(t *testing.T) {
+	t.Run("noChange", func(t *testing.T) {
+		from := testutil.Table(t, "CREATE TABLE `user` (`id` bigint NOT NULL AUTO_INCREMENT PRIMARY KEY) AUTO_INCREMENT=10;")
+		to := testutil.Table(t, "create table user (id BIGINT not null auto_increment primary key);")
+		m, err := Table(from, to)
+		assert.Nil(t, err)
+		assert.Nil(t, m.Up)
+		assert.Nil(t, m.Down)
+	})
+
+	t.Run("columns", func(t *testing.T) {
+		from := testutil.Table(t, "CREATE TABLE `user` (\n"+
+			"  `id` bigint NOT NULL AUTO_INCREMENT,\n"+
+			"  `name` varchar(64) NOT NULL DEFAULT '',\n"+
+			"  `nick` varchar(64) NOT NULL DEFAULT '',\n"+
+			"  `age` int NOT NULL,\n"+
+			"  `remark` text,\n"+
+			"  PRIMARY KEY (`id`)\n"+
+			") ENGINE=InnoDB COMMENT='user';")
+		to := testutil.Table(t, "CREATE TABLE `user` (\n"+
+			"  `id` bigint NOT NULL AUTO_INCREMENT,\n"+
+			"  `name` varchar(128) NOT NULL DEFAULT '',\n"+
+			"  `nickname` varchar(64) NOT NULL DEFAULT '',\n"+
+			"  `email` varchar(255),\n"+
+			"  `age` int NOT NULL COMMENT 'age',\n"+
+			"  PRIMARY KEY (`id`)\n"+
+			") ENGINE=InnoDB;")
+		m, err := Table(from, to)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"ALTER TABLE `user`\n" +
+			"  DROP COLUMN `remark`,\n" +
+			"  MODIFY COLUMN `name` VARCHAR(128) NOT NULL DEFAULT '',\n" +
+			"  CHANGE COLUMN `nick` `nickname` VARCHAR(64) NOT NULL DEFAULT '',\n" +
+			"  ADD COLUMN `email` VARCHAR(255) AFTER `nickname`,\n" +
+			"  MODIFY COLUMN `age` INT NOT NULL COMMENT 'age',\n" +
+			"  COMMENT='';",
+		}, m.Up)
+		assert.Equal(t, []string{"ALTER TABLE `user`\n" +
+			"  DROP COLUMN `email`,\n" +
+			"  MODIFY COLUMN `name` VARCHAR(64) NOT NULL DEFAULT '',\n" +
+			"  CHANGE COLUMN `nickname` `nick` VARCHAR(64) NOT NULL DEFAULT '',\n" +
+			"  MODIFY COLUMN `age` INT NOT NULL,\n" +
+			"  ADD COLUMN `remark` TEXT,\n" +
+			"  COMMENT='user';",
+		}, m.Down)
+	})
+
+	t.Run("position", func(t *testing.T) {
+		from := testutil.Table(t, "CREATE TABLE `user` (`id` bigint, `name` varchar(64), `age` int);")
+		to := testutil.Table(t, "CREATE TABLE `user` (`age` int, `id` bigint, `name` varchar(64));")
+		m, err := Table(from, to)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"ALTER TABLE `user`\n  MODIFY COLUMN `age` INT FIRST;"}, m.Up)
+		assert.Equal(t, []string{"ALTER TABLE `user`\n  MODIFY COLUMN `age` INT AFTER `name`;"}, m.Down)
+
+		// only the moved column is modified
+		from = testutil.Table(t, "CREATE TABLE `user` (`a` int, `b` int, `c` int, `d` int, `e` int);")
+		to = testutil.Table(t, "CREATE TABLE `user` (`a` int, `c` int, `d` int, `b` int, `e` int, `f` int);")
+		m, err = Table(from, to)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"ALTER TABLE `user`\n" +
+			"  MODIFY COLUMN `b` INT AFTER `d`,\n" +
+			"  ADD COLUMN `f` INT;",
+		}, m.Up)
+		assert.Equal(t, []string{"ALTER TABLE `user`\n" +
+			"  DROP COLUMN `f`,\n" +
+			"  MODIFY COLUMN `b` INT AFTER `a`;",
+		}, m.Down)
+	})
+
+	t.Run("indexes", func(t *testing.T) {
+		from := testutil.Table(t, "CREATE TABLE `user` (\n"+
+			"  `id` bigint NOT NULL,\n"+
+			"  `name` varchar(64) NOT NULL,\n"+
+			"  `age` int NOT NULL,\n"+
+			"  PRIMARY KEY (`id`),\n"+
+			"  KEY `idx_name` (`name`),\n"+
+			"  KEY `idx_age` (`age`)\n"+
+			");")
+		to := testutil.Table(t, "CREATE TABLE `user` (\n"+
+			"  `id` bigint NOT NULL,\n"+
+			"  `name` varchar(64) NOT NULL,\n"+
+			"  `age` int NOT NULL,\n"+
+			"  PRIMARY KEY (`id`),\n"+
+			"  UNIQUE KEY `idx_name` (`name`(32)),\n"+
+			"  KEY `idx_name_age` (`name`, `age` DESC)\n"+
+			");")
+		m, err := Table(from, to)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"ALTER TABLE `user`\n" +
+			"  DROP INDEX `idx_age`,\n" +
+			"  DROP INDEX `idx_name`,\n" +
+			"  ADD UNIQUE KEY `idx_name` (`name`(32)),\n" +
+			"  ADD KEY `idx_name_age` (`name`, `age` DESC);",
+		}, m.Up)
+		assert.Equal(t, []string{"ALTER TABLE `user`\n" +
+			"  DROP INDEX `idx_name_age`,\n" +
+			"  DROP INDEX `idx_name`,\n" +
+			"  ADD KEY `idx_name` (`name`),\n" +
+			"  ADD KEY `idx_age` (`age`);",
+		}, m.Down)
+	})
+
+	t.Run("unnamedIndexes", func(t *testing.T) {
+		from := testutil.Table(t, "CREATE TABLE `user` (`a` int, `b` int, KEY (`a`), KEY (`a`, `b`));")
+		to := testutil.Table(t, "CREATE TABLE `user` (`a` int, `b` int, KEY (`a`));")
+		m, err := Table(from, to)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"ALTER TABLE `user`\n  DROP INDEX `a_2`;"}, m.Up)
+		assert.Equal(t, []string{"ALTER TABLE `user`\n  ADD KEY `a_2` (`a`, `b`);"}, m.Down)
+	})
+
+	t.Run("foreignKeys", func(t *testing.T) {
+		from := testutil.Table(t, "CREATE TABLE `user` (\n"+
+			"  `id` bigint NOT NULL PRIMARY KEY,\n"+
+			"  `org_id` bigint NOT NULL,\n"+
+			"  FOREIGN KEY (`org_id`) REFERENCES `org` (`id`)\n"+
+			");")
+		to := testutil.Table(t, "CREATE TABLE `member` (\n"+
+			"  `id` bigint NOT NULL PRIMARY KEY,\n"+
+			"  `org_id` bigint NOT NULL,\n"+
+			"  CONSTRAINT `fk_org` FOREIGN KEY (`org_id`) REFERENCES `org` (`id`) ON DELETE CASCADE\n"+
+			");")
+		m, err := Table(from, to)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{
+			"ALTER TABLE `user` RENAME TO `member`;",
+			"ALTER TABLE `member`\n  DROP FOREIGN KEY `user_ibfk_1`;",
+			"ALTER TABLE `member`\n  ADD CONSTRAINT `fk_org` FOREIGN KEY (`org_id`) REFERENCES `org` (`id`) ON DELETE CASCADE;",
+		}, m.Up)
+		assert.Equal(t, []string{
+			"ALTER TABLE `member` RENAME TO `user`;",
+			"ALTER TABLE `user`\n  DROP FOREIGN KEY `fk_org`;",
+			"ALTER TABLE `user`\n  ADD FOREIGN KEY (`org_id`) REFERENCES `org` (`id`);",
+		}, m.Down)
+	})
+	t.Run("options", func(t *testing.T) {
+		from := testutil.Table(t, "CREATE TABLE `user` (`id` bigint) ENGINE=InnoDB ROW_FORMAT=COMPRESSED KEY_BLOCK_SIZE=8 "+
+			"DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;")
+		to := testutil.Table(t, "CREATE TABLE `user` (`id` bigint) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;")
+		m, err := Table(from, to)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"ALTER TABLE `user`\n" +
+			"  ROW_FORMAT=DEFAULT,\n" +
+			"  KEY_BLOCK_SIZE=0,\n" +
+			"  DEFAULT CHARSET=utf8mb4;",
+		}, m.Up)
+		assert.Equal(t, []string{"ALTER TABLE `user`\n" +
+			"  ROW_FORMAT=COMPRESSED,\n" +
+			"  KEY_BLOCK_SIZE=8,\n" +
+			"  COLLATE=utf8mb4_bin;",
+		}, m.Down)
+
+		from = testutil.Table(t, "CREATE TABLE `user` (`id` bigint) COLLATE=utf8mb4_bin;")
+		to = testutil.Table(t, "CREATE TABLE `user` (`id` bigint);")
+		up, err := AlterTable(diff.CompareTable(from, to))
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"ALTER TABLE `user`\n  DEFAULT CHARSET=DEFAULT;"}, up)
+	})
+
+	t.Run("optionCanNotBeReset", func(t *testing.T) {
+		from := testutil.Table(t, "CREATE TABLE `user` (`id` bigint) ENGINE=MyISAM;")
+		to := testutil.Table(t, "CREATE TABLE `user` (`id` bigint);")
+		_, err := Table(from, to)
+		assert.EqualError(t, err, "table option ENGINE of table user can not be reset")
+	})
+}
@@ -0,0 +1,437 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package onlineddl
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/zeromicro/ddl-parser/diff"
+	"github.com/zeromicro/ddl-parser/lint"
+	"github.com/zeromicro/ddl-parser/parser"
+)
+
+// classifier classifies the specifications of an ALTER TABLE statement against the table.
+type classifier struct {
+	*Analyzer
+	table    *parser.Table
+	alter    *parser.AlterTable
+	problems []string
+}
+
+func instant(reason string) *Operation {
+	return &Operation{Algorithm: Instant, Reason: reason}
+}
+
+func inplace(rebuild bool, reason string) *Operation {
+	return &Operation{Algorithm: Inplace, Rebuild: rebuild, Reason: reason}
+}
+
+func copyTable(reason string) *Operation {
+	return &Operation{Algorithm: Copy, Lock: LockShared, Rebuild: true, Reason: reason}
+}
+
+// merge returns the operation with the slowest algorithm and the strongest lock of operations,
+// the reasons are joined.
+func merge(list ...*Operation) *Operation {
+	var ret Operation
+	var reasons []string
+	for _, e := range list {
+		if e.Algorithm > ret.Algorithm {
+			ret.Algorithm = e.Algorithm
+		}
+		if e.Lock > ret.Lock {
+			ret.Lock = e.Lock
+		}
+		ret.Rebuild = ret.Rebuild || e.Rebuild
+		reasons = append(reasons, e.Reason)
+	}
+	ret.Reason = strings.Join(reasons, "; ")
+
+	return &ret
+}
+
+func (c *classifier) classify(spec *parser.AlterSpecification) *Operation {
+	switch spec.Kind {
+	case parser.AlterTableOptions:
+		var list []*Operation
+		for _, e := range spec.Options {
+			list = append(list, c.tableOption(e))
+		}
+		return merge(list...)
+	case parser.AlterAddColumn:
+		return c.addColumn(spec)
+	case parser.AlterDropColumn:
+		return c.dropColumn(spec)
+	case parser.AlterRenameColumn:
+		if c.column(spec.Name) == nil {
+			return copyTable("the column is unknown")
+		}
+		return c.renameColumn(spec.Name)
+	case parser.AlterChangeColumn, parser.AlterModifyColumn:
+		return c.alterColumn(spec)
+	case parser.AlterSetDefault, parser.AlterDropDefault:
+		return instant("changing the default value only modifies the metadata")
+	case parser.AlterAddIndex:
+		return c.addIndex(spec.Index)
+	case parser.AlterDropPrimaryKey:
+		return c.dropPrimaryKey()
+	case parser.AlterDropIndex:
+		if strings.EqualFold(spec.Name, "PRIMARY") {
+			return c.dropPrimaryKey()
+		}
+		return inplace(false, "dropping a secondary index only modifies the metadata")
+	case parser.AlterRenameIndex:
+		return instant("renaming an index only modifies the metadata")
+	case parser.AlterIndexVisibility:
+		return instant("changing the visibility of index only modifies the metadata")
+	case parser.AlterAddForeignKey:
+		if c.foreignKeyChecks {
+			return copyTable("adding a foreign key copies the table unless foreign_key_checks is disabled")
+		}
+		return inplace(false, "adding a foreign key with foreign_key_checks disabled only modifies the metadata")
+	case parser.AlterDropForeignKey:
+		return inplace(false, "dropping a foreign key only modifies the metadata")
+	case parser.AlterAddCheck:
+		return copyTable("adding a check constraint validates the rows by copying the table")
+	case parser.AlterDropConstraint:
+		return inplace(false, "dropping a constraint only modifies the metadata")
+	case parser.AlterRenameTable:
+		return instant("renaming a table only modifies the metadata")
+	case parser.AlterConvertCharset:
+		return copyTable("converting the character set copies the table")
+	case parser.AlterDefaultCharset:
+		return c.tableOption(&parser.TableOption{Name: parser.TableOptionCharset, Value: spec.Charset})
+	case parser.AlterForce:
+		return inplace(true, "FORCE rebuilds the table")
+	}
+
+	return copyTable("the operation is not classified, it's assumed to copy the table")
+}
+
+func (c *classifier) column(name string) *parser.Column {
+	column := c.table.Column(name)
+	if column == nil {
+		c.problems = append(c.problems, fmt.Sprintf("Unknown column '%s' in '%s'", name, c.table.Name))
+	}
+
+	return column
+}
+
+// instantBlocker returns the reason why the columns can not be added or dropped instantly,
+// it returns empty if the columns can be.
+func (c *classifier) instantBlocker() string {
+	for _, e := range c.table.AllIndexes() {
+		if e.Fulltext {
+			return "the table has a FULLTEXT index"
+		}
+	}
+	if strings.EqualFold(c.table.Option(parser.TableOptionRowFormat), "COMPRESSED") {
+		return "the table uses ROW_FORMAT=COMPRESSED"
+	}
+
+	return ""
+}
+
+func (c *classifier) addColumn(spec *parser.AlterSpecification) *Operation {
+	column := spec.Column
+	constraint := column.Constraint
+	if constraint == nil {
+		constraint = &parser.ColumnConstraint{}
+	}
+
+	if constraint.AutoIncrement {
+		return &Operation{
+			Algorithm: Inplace,
+			Lock:      LockShared,
+			Rebuild:   true,
+			Reason:    "adding an AUTO_INCREMENT column rebuilds the table and blocks the writes",
+		}
+	}
+
+	if len(constraint.Generated) > 0 && constraint.Stored {
+		return copyTable("adding a STORED generated column copies the table")
+	}
+
+	var list []*Operation
+	lastColumn := c.lastColumn(spec)
+	last := len(lastColumn) == 0 || !spec.First && (len(spec.After) == 0 || strings.EqualFold(spec.After, lastColumn))
+	switch blocker := c.instantBlocker(); {
+	case len(constraint.Generated) > 0 && len(blocker) == 0 && (last && c.since(12) || c.since(29)):
+		list = append(list, instant("adding a VIRTUAL generated column only modifies the metadata"))
+	case len(constraint.Generated) > 0:
+		list = append(list, inplace(false, "adding a VIRTUAL generated column in place only modifies the metadata"))
+	case len(blocker) > 0:
+		list = append(list, inplace(true, "adding a column rebuilds the table since "+blocker))
+	case last && c.since(12):
+		list = append(list, instant("adding a column at the end of table is instant"))
+	case !last && c.since(29):
+		list = append(list, instant("adding a column in the middle of table is instant since 8.0.29"))
+	case !last:
+		list = append(list, inplace(true, "adding a column in the middle of table rebuilds the table before 8.0.29"))
+	default:
+		list = append(list, inplace(true, "adding a column rebuilds the table before 8.0.12"))
+	}
+
+	if constraint.Primary {
+		list = append(list, c.addIndex(&parser.Index{Primary: true, Columns: []*parser.IndexColumn{{Name: column.Name}}}))
+	} else if constraint.Unique || constraint.Key {
+		list = append(list, c.addIndex(&parser.Index{Unique: constraint.Unique, Columns: []*parser.IndexColumn{{Name: column.Name}}}))
+	}
+
+	return merge(list...)
+}
+
+// lastColumn returns the name of last column before the specification is performed, the columns
+// added at the end of table by the former specifications of statement are taken into account.
+func (c *classifier) lastColumn(spec *parser.AlterSpecification) string {
+	var ret string
+	if len(c.table.Columns) > 0 {
+		ret = c.table.Columns[len(c.table.Columns)-1].Name
+	}
+	for _, e := range c.alter.Specifications {
+		if e == spec {
+			break
+		}
+		if e.Kind == parser.AlterAddColumn && e.Column != nil && !e.First &&
+			(len(e.After) == 0 || strings.EqualFold(e.After, ret)) {
+			ret = e.Column.Name
+		}
+	}
+
+	return ret
+}
+
+func (c *classifier) dropColumn(spec *parser.AlterSpecification) *Operation {
+	if c.column(spec.Name) == nil {
+		return copyTable("the column is unknown")
+	}
+	if c.table.IsPrimaryKey(spec.Name) {
+		return inplace(true, "dropping a column of primary key rebuilds the table")
+	}
+
+	switch blocker := c.instantBlocker(); {
+	case len(blocker) > 0:
+		return inplace(true, "dropping a column rebuilds the table since "+blocker)
+	case c.since(29):
+		return instant("dropping a column is instant since 8.0.29")
+	default:
+		return inplace(true, "dropping a column rebuilds the table before 8.0.29")
+	}
+}
+
+func (c *classifier) renameColumn(name string) *Operation {
+	for _, e := range c.table.ForeignKeys() {
+		for _, column := range e.Columns {
+			if strings.EqualFold(column, name) {
+				return inplace(false, "renaming a column of foreign key is performed in place")
+			}
+		}
+	}
+
+	if c.since(28) {
+		return instant("renaming a column is instant since 8.0.28")
+	}
+
+	return inplace(false, "renaming a column only modifies the metadata")
+}
+
+// alterColumn classifies CHANGE COLUMN and MODIFY COLUMN by comparing the new definition of
+// column with the current one.
+func (c *classifier) alterColumn(spec *parser.AlterSpecification) *Operation {
+	old, column := c.column(spec.Name), spec.Column
+	if old == nil {
+		return copyTable("the column is unknown")
+	}
+
+	var list []*Operation
+	if !strings.EqualFold(old.Name, column.Name) {
+		list = append(list, c.renameColumn(old.Name))
+	}
+	if !diff.EqualDataType(old.DataType, column.DataType) {
+		list = append(list, c.changeDataType(old, column))
+	}
+
+	from, to := constraintOf(old), constraintOf(column)
+	switch notNull := c.table.IsPrimaryKey(old.Name) || from.NotNull; {
+	case notNull && !to.NotNull && !to.Primary:
+		list = append(list, inplace(true, "making a column NULL rebuilds the table"))
+	case !notNull && to.NotNull:
+		list = append(list, inplace(true, "making a column NOT NULL rebuilds the table"))
+	}
+	if from.AutoIncrement != to.AutoIncrement {
+		list = append(list, copyTable("changing the AUTO_INCREMENT attribute of column copies the table"))
+	}
+	if from.DefaultValue != to.DefaultValue || from.OnUpdate != to.OnUpdate {
+		list = append(list, instant("changing the default value only modifies the metadata"))
+	}
+	if from.Comment != to.Comment {
+		list = append(list, inplace(false, "changing the comment of column only modifies the metadata"))
+	}
+	if to.Primary && !from.Primary {
+		list = append(list, c.addIndex(&parser.Index{Primary: true, Columns: []*parser.IndexColumn{{Name: column.Name}}}))
+	} else if to.Unique && !from.Unique || to.Key && !from.Key {
+		list = append(list, c.addIndex(&parser.Index{Unique: to.Unique, Columns: []*parser.IndexColumn{{Name: column.Name}}}))
+	}
+	if c.moved(old, spec) {
+		list = append(list, inplace(true, "reordering columns rebuilds the table"))
+	}
+
+	if len(list) == 0 {
+		return instant("the definition of column is not changed")
+	}
+
+	return merge(list...)
+}
+
+func constraintOf(column *parser.Column) *parser.ColumnConstraint {
+	if column.Constraint == nil {
+		return &parser.ColumnConstraint{}
+	}
+
+	return column.Constraint
+}
+
+// moved returns true if the column is moved by FIRST or AFTER.
+func (c *classifier) moved(column *parser.Column, spec *parser.AlterSpecification) bool {
+	i := 0
+	for i < len(c.table.Columns) && c.table.Columns[i] != column {
+		i++
+	}
+
+	switch {
+	case spec.First:
+		return i != 0
+	case len(spec.After) > 0:
+		return i == 0 || !strings.EqualFold(c.table.Columns[i-1].Name, spec.After)
+	}
+
+	return false
+}
+
+// changeDataType classifies the change of data type, extending VARCHAR without changing the
+// bytes of length prefix is in place and appending the members of ENUM and SET without changing
+// the storage size is instant, the other changes copy the table.
+func (c *classifier) changeDataType(old, column *parser.Column) *Operation {
+	from, to := old.DataType, column.DataType
+	if from == nil || to == nil {
+		return copyTable("changing the data type copies the table")
+	}
+
+	switch kind := from.Type(); {
+	case kind != to.Type():
+	case kind.IsEnumSet():
+		if diff.IsAppended(from, to) && storageBytes(kind, len(from.Value())) == storageBytes(kind, len(to.Value())) {
+			return instant(fmt.Sprintf("appending members to %s only modifies the metadata", kind))
+		}
+	case kind == parser.VarChar || kind == parser.VarBinary:
+		if from.Unsigned() != to.Unsigned() || !strings.EqualFold(from.Charset(), to.Charset()) ||
+			!strings.EqualFold(from.Collation(), to.Collation()) || to.Length() < from.Length() {
+			break
+		}
+
+		size := 1
+		if kind == parser.VarChar {
+			size = lint.CharsetMaxBytes(lint.ColumnCharset(c.table, old, "utf8mb4"))
+		}
+		if (from.Length()*size < 256) == (to.Length()*size < 256) {
+			return inplace(false, fmt.Sprintf("extending %s without changing the bytes of length prefix is performed in place", kind))
+		}
+		return copyTable(fmt.Sprintf("extending %s across 256 bytes changes the bytes of length prefix and copies the table", kind))
+	}
+
+	return copyTable("changing the data type copies the table")
+}
+
+// storageBytes returns the storage size of ENUM or SET with n members.
+func storageBytes(kind parser.Kind, n int) int {
+	if kind == parser.Enum {
+		if n > 255 {
+			return 2
+		}
+		return 1
+	}
+
+	switch bytes := (n + 7) / 8; {
+	case bytes > 4:
+		return 8
+	case bytes == 0:
+		return 1
+	default:
+		return bytes
+	}
+}
+
+func (c *classifier) addIndex(index *parser.Index) *Operation {
+	switch {
+	case index.Primary:
+		return inplace(true, "adding a primary key rebuilds the table in place")
+	case index.Fulltext:
+		operation := inplace(false, "adding a FULLTEXT index blocks the writes")
+		operation.Lock = LockShared
+		for _, e := range c.table.AllIndexes() {
+			if e.Fulltext {
+				return operation
+			}
+		}
+		operation.Rebuild = true
+		operation.Reason = "adding the first FULLTEXT index rebuilds the table to add FTS_DOC_ID and blocks the writes"
+		return operation
+	case index.Spatial:
+		operation := inplace(false, "adding a SPATIAL index blocks the writes")
+		operation.Lock = LockShared
+		return operation
+	default:
+		return inplace(false, "adding a secondary index is performed in place")
+	}
+}
+
+// dropPrimaryKey classifies dropping the primary key, it's performed in place only if a new
+// primary key is added by the same statement.
+func (c *classifier) dropPrimaryKey() *Operation {
+	for _, e := range c.alter.Specifications {
+		if e.Kind == parser.AlterAddIndex && e.Index.Primary {
+			return inplace(true, "dropping the primary key and adding another rebuilds the table in place")
+		}
+	}
+
+	return copyTable("dropping the primary key without adding another copies the table")
+}
+
+func (c *classifier) tableOption(option *parser.TableOption) *Operation {
+	switch option.Name {
+	case parser.TableOptionEngine:
+		if engine := c.table.Option(parser.TableOptionEngine); len(engine) > 0 && !strings.EqualFold(engine, option.Value) ||
+			!strings.EqualFold(option.Value, "InnoDB") {
+			return copyTable("changing the storage engine copies the table")
+		}
+		return inplace(true, "ENGINE=InnoDB rebuilds the table")
+	case parser.TableOptionRowFormat, "KEY_BLOCK_SIZE":
+		return inplace(true, fmt.Sprintf("setting %s rebuilds the table", option.Name))
+	case parser.TableOptionCharset:
+		charset := c.table.Option(parser.TableOptionCharset)
+		if len(charset) == 0 || !strings.EqualFold(charset, option.Value) {
+			return inplace(true, "changing the character set of table rebuilds the table")
+		}
+		return inplace(false, "the character set of table is not changed")
+	case parser.TableOptionAutoIncrement, parser.TableOptionComment, parser.TableOptionCollate,
+		"STATS_PERSISTENT", "STATS_AUTO_RECALC", "STATS_SAMPLE_PAGES":
+		return inplace(false, fmt.Sprintf("setting %s only modifies the metadata", option.Name))
+	}
+
+	return copyTable(fmt.Sprintf("setting %s is not classified, it's assumed to copy the table", option.Name))
+}
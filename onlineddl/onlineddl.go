@@ -0,0 +1,286 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+// Package onlineddl classifies the specifications of ALTER TABLE statements by the algorithm
+// and the lock which InnoDB online DDL of mysql 8.0 uses, so the operations which rebuild the
+// table or block the concurrent writes can be found before running the migrations,
+// https://dev.mysql.com/doc/refman/8.0/en/innodb-online-ddl-operations.html
+package onlineddl
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/zeromicro/ddl-parser/parser"
+)
+
+// Algorithm describes the algorithm of ALTER TABLE, the algorithms are ordered from the fastest
+// to the slowest.
+type Algorithm int
+
+const (
+	// Instant describes the operation only modifies the metadata in the data dictionary.
+	Instant Algorithm = iota
+	// Inplace describes the operation is performed in place without copying the table, the
+	// table may be rebuilt in place.
+	Inplace
+	// Copy describes the operation copies the table to a new one.
+	Copy
+)
+
+// String returns the name of algorithm used by ALGORITHM clause, such as INPLACE.
+func (a Algorithm) String() string {
+	switch a {
+	case Instant:
+		return "INSTANT"
+	case Inplace:
+		return "INPLACE"
+	default:
+		return "COPY"
+	}
+}
+
+// Lock describes the lock which the operation takes during the alteration, the locks are
+// ordered from the weakest to the strongest, the exclusive metadata lock which is taken
+// briefly at the start and the end of operations is not included.
+type Lock int
+
+const (
+	// LockNone describes the concurrent reads and writes are permitted.
+	LockNone Lock = iota
+	// LockShared describes the concurrent reads are permitted and the writes are blocked.
+	LockShared
+	// LockExclusive describes the concurrent reads and writes are blocked.
+	LockExclusive
+)
+
+// String returns the name of lock used by LOCK clause, such as NONE.
+func (l Lock) String() string {
+	switch l {
+	case LockNone:
+		return "NONE"
+	case LockShared:
+		return "SHARED"
+	default:
+		return "EXCLUSIVE"
+	}
+}
+
+// Operation describes the classification of an alter specification.
+type Operation struct {
+	Specification *parser.AlterSpecification
+	Algorithm     Algorithm
+	Lock          Lock
+	// Rebuild describes whether the operation rebuilds the table
+	Rebuild bool
+	// Reason describes why the operation is classified as it is, such as
+	// adding a column at the end of table
+	Reason string
+}
+
+// Blocking returns true if the operation blocks the concurrent writes.
+func (o *Operation) Blocking() bool {
+	return o.Lock != LockNone
+}
+
+// Analysis describes the classification of an ALTER TABLE statement, mysql performs the
+// statement with the slowest algorithm and the strongest lock of its operations.
+type Analysis struct {
+	Table      string
+	Operations []*Operation
+	Algorithm  Algorithm
+	Lock       Lock
+	// Rebuild describes whether the statement rebuilds the table
+	Rebuild bool
+	// Problems describes the errors which mysql reports for the statement, such as the
+	// operations which are not supported by the ALGORITHM or LOCK clause of statement, and
+	// the columns or indexes which do not exist
+	Problems []string
+}
+
+// Blocking returns true if the statement blocks the concurrent writes.
+func (a *Analysis) Blocking() bool {
+	return a.Lock != LockNone
+}
+
+// Analyzer classifies the ALTER TABLE statements, you can use NewAnalyzer to create an
+// instance with options, WithVersion option sets the version of mysql 8.0, WithForeignKeyChecks
+// option sets whether foreign_key_checks is enabled.
+type Analyzer struct {
+	// patch describes the patch version of mysql 8.0, it's negative for the latest version
+	patch            int
+	foreignKeyChecks bool
+}
+
+// Option is the alias of function.
+type Option func(a *Analyzer)
+
+// NewAnalyzer creates an instance of Analyzer.
+func NewAnalyzer(options ...Option) *Analyzer {
+	a := &Analyzer{patch: -1, foreignKeyChecks: true}
+	for _, opt := range options {
+		opt(a)
+	}
+
+	return a
+}
+
+// WithVersion is an Analyzer option to set the version of mysql, such as 8.0.28, the instant
+// operations differ between the patch versions of mysql 8.0. The latest version is used by
+// default and for the versions later than 8.0.
+func WithVersion(version string) Option {
+	return func(a *Analyzer) {
+		a.patch = -1
+		if strings.HasPrefix(version, "8.0.") {
+			if patch, err := strconv.Atoi(strings.TrimPrefix(version, "8.0.")); err == nil {
+				a.patch = patch
+			}
+		}
+	}
+}
+
+// WithForeignKeyChecks is an Analyzer option to set whether foreign_key_checks is enabled, the
+// foreign keys can be added in place only if it's disabled. The default value is true.
+func WithForeignKeyChecks(enabled bool) Option {
+	return func(a *Analyzer) {
+		a.foreignKeyChecks = enabled
+	}
+}
+
+// since returns true if the version of mysql is 8.0.patch or later.
+func (a *Analyzer) since(patch int) bool {
+	return a.patch < 0 || a.patch >= patch
+}
+
+// Analyze classifies the specifications of ALTER TABLE statement which alters the table, the
+// ALGORITHM and LOCK clauses are checked against the operations.
+func (a *Analyzer) Analyze(table *parser.Table, alter *parser.AlterTable) *Analysis {
+	ret := Analysis{Table: table.Name}
+	c := &classifier{Analyzer: a, table: table, alter: alter}
+	var algorithm, lock string
+	for _, e := range alter.Specifications {
+		switch e.Kind {
+		case parser.AlterAlgorithm:
+			algorithm = e.Value
+			continue
+		case parser.AlterLock:
+			lock = e.Value
+			continue
+		}
+
+		operation := c.classify(e)
+		operation.Specification = e
+		ret.Operations = append(ret.Operations, operation)
+	}
+	if len(alter.Partition) > 0 {
+		ret.Operations = append(ret.Operations, &Operation{
+			Specification: &parser.AlterSpecification{Kind: parser.AlterOther, Text: alter.Partition},
+			Algorithm:     Copy,
+			Lock:          LockShared,
+			Rebuild:       true,
+			Reason:        "partitioning the table copies the table",
+		})
+	}
+	ret.Problems = c.problems
+
+	for _, e := range ret.Operations {
+		ret.Problems = append(ret.Problems, requestProblems(e, algorithm, lock)...)
+		if e.Algorithm > ret.Algorithm {
+			ret.Algorithm = e.Algorithm
+		}
+		if e.Lock > ret.Lock {
+			ret.Lock = e.Lock
+		}
+		ret.Rebuild = ret.Rebuild || e.Rebuild
+	}
+
+	// the ALGORITHM and LOCK clauses can request a slower algorithm and a stronger lock
+	switch algorithm {
+	case "COPY":
+		ret.Algorithm, ret.Rebuild = Copy, true
+		if ret.Lock < LockShared {
+			ret.Lock = LockShared
+		}
+	case "INPLACE":
+		if ret.Algorithm < Inplace {
+			ret.Algorithm = Inplace
+		}
+	}
+	switch lock {
+	case "SHARED":
+		if ret.Lock < LockShared {
+			ret.Lock = LockShared
+		}
+	case "EXCLUSIVE":
+		ret.Lock = LockExclusive
+	}
+
+	return &ret
+}
+
+// requestProblems returns the problems if the operation is not supported by the ALGORITHM
+// and LOCK clauses.
+func requestProblems(operation *Operation, algorithm, lock string) []string {
+	var ret []string
+	switch {
+	case algorithm == "INSTANT" && operation.Algorithm > Instant:
+		ret = append(ret, fmt.Sprintf("ALGORITHM=INSTANT is not supported for %s, try ALGORITHM=%s", operation.Specification.Text, operation.Algorithm))
+	case algorithm == "INPLACE" && operation.Algorithm == Copy:
+		ret = append(ret, fmt.Sprintf("ALGORITHM=INPLACE is not supported for %s, try ALGORITHM=COPY", operation.Specification.Text))
+	}
+	if lock == "NONE" && operation.Lock > LockNone || lock == "SHARED" && operation.Lock > LockShared {
+		ret = append(ret, fmt.Sprintf("LOCK=%s is not supported for %s, try LOCK=%s", lock, operation.Specification.Text, operation.Lock))
+	}
+
+	return ret
+}
+
+// AnalyzeSQL classifies the ALTER TABLE statements of content, the tables describe the current
+// definitions which are altered, each statement is classified against the current definitions.
+// The name is used as the prefix of error messages, such as the file name of content.
+func (a *Analyzer) AnalyzeSQL(tables []*parser.Table, name, content string) ([]*Analysis, error) {
+	statements, err := parser.NewParser().Statements(name, content)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret []*Analysis
+	for _, e := range statements {
+		if e.Alter == nil {
+			continue
+		}
+
+		table := findTable(tables, e.Alter)
+		if table == nil {
+			position, _ := e.Positions.Of(e.Alter)
+			return nil, fmt.Errorf("%s line %s table %s doesn't exist", name, position, e.Alter.Name)
+		}
+		ret = append(ret, a.Analyze(table, e.Alter))
+	}
+
+	return ret, nil
+}
+
+func findTable(tables []*parser.Table, alter *parser.AlterTable) *parser.Table {
+	for _, e := range tables {
+		if strings.EqualFold(e.Name, alter.Name) && (len(alter.Schema) == 0 || strings.EqualFold(e.Schema, alter.Schema)) {
+			return e
+		}
+	}
+
+	return nil
+}
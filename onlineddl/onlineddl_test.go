@@ -0,0 +1,189 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package onlineddl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zeromicro/ddl-parser/internal/testutil"
+)
+
+const schema = "CREATE TABLE `user` (\n" +
+	"  `id` BIGINT NOT NULL AUTO_INCREMENT,\n" +
+	"  `name` VARCHAR(32) NOT NULL DEFAULT '',\n" +
+	"  `email` VARCHAR(60),\n" +
+	"  `status` ENUM('on','off') DEFAULT 'on',\n" +
+	"  `org_id` BIGINT,\n" +
+	"  `bio` TEXT,\n" +
+	"  PRIMARY KEY (`id`),\n" +
+	"  KEY `idx_name` (`name`),\n" +
+	"  CONSTRAINT `fk_org` FOREIGN KEY (`org_id`) REFERENCES `org` (`id`)\n" +
+	") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;"
+
+func analyze(t *testing.T, sql string, options ...Option) []*Analysis {
+	ret, err := NewAnalyzer(options...).AnalyzeSQL(testutil.Tables(t, schema), "alter.sql", sql)
+	assert.NoError(t, err)
+	return ret
+}
+
+func TestAnalyzer_Analyze(t *testing.T) {
+	type expected struct {
+		algorithm Algorithm
+		lock      Lock
+		rebuild   bool
+	}
+
+	cases := map[string]expected{
+		"ADD COLUMN `age` INT":                                                 {Instant, LockNone, false},
+		"ADD COLUMN `age` INT AFTER `bio`":                                     {Instant, LockNone, false},
+		"ADD COLUMN `age` INT AFTER `id`":                                      {Instant, LockNone, false},
+		"ADD COLUMN `seq` INT AUTO_INCREMENT UNIQUE":                           {Inplace, LockShared, true},
+		"ADD COLUMN `total` INT AS (`id` + 1) STORED":                          {Copy, LockShared, true},
+		"ADD COLUMN `total` INT AS (`id` + 1) VIRTUAL":                         {Instant, LockNone, false},
+		"ADD COLUMN `total` INT AS (`id` + 1) AFTER `id`":                      {Instant, LockNone, false},
+		"ADD COLUMN `code` INT UNIQUE":                                         {Inplace, LockNone, false},
+		"DROP COLUMN `bio`":                                                    {Instant, LockNone, false},
+		"DROP COLUMN `id`":                                                     {Inplace, LockNone, true},
+		"RENAME COLUMN `name` TO `nick`":                                       {Instant, LockNone, false},
+		"RENAME COLUMN `org_id` TO `group_id`":                                 {Inplace, LockNone, false},
+		"CHANGE `name` `nick` VARCHAR(32) NOT NULL DEFAULT ''":                 {Instant, LockNone, false},
+		"MODIFY `name` VARCHAR(63) NOT NULL DEFAULT ''":                        {Inplace, LockNone, false},
+		"MODIFY `name` VARCHAR(64) NOT NULL DEFAULT ''":                        {Copy, LockShared, true},
+		"MODIFY `name` VARCHAR(16) NOT NULL DEFAULT ''":                        {Copy, LockShared, true},
+		"MODIFY `name` CHAR(32) NOT NULL DEFAULT ''":                           {Copy, LockShared, true},
+		"MODIFY `name` VARCHAR(32) NOT NULL DEFAULT 'x'":                       {Instant, LockNone, false},
+		"MODIFY `name` VARCHAR(32) DEFAULT ''":                                 {Inplace, LockNone, true},
+		"MODIFY `email` VARCHAR(60) NOT NULL":                                  {Inplace, LockNone, true},
+		"MODIFY `email` VARCHAR(60) FIRST":                                     {Inplace, LockNone, true},
+		"MODIFY `email` VARCHAR(60) AFTER `name`":                              {Instant, LockNone, false},
+		"MODIFY `email` VARCHAR(60) COMMENT 'email'":                           {Inplace, LockNone, false},
+		"MODIFY `status` ENUM('on','off','deleted') DEFAULT 'on'":              {Instant, LockNone, false},
+		"MODIFY `status` ENUM('off','on') DEFAULT 'on'":                        {Copy, LockShared, true},
+		"ALTER COLUMN `name` SET DEFAULT 'x'":                                  {Instant, LockNone, false},
+		"ALTER COLUMN `name` DROP DEFAULT":                                     {Instant, LockNone, false},
+		"ADD INDEX `idx_email` (`email`)":                                      {Inplace, LockNone, false},
+		"ADD UNIQUE KEY `uk_email` (`email`)":                                  {Inplace, LockNone, false},
+		"ADD FULLTEXT KEY `ft_bio` (`bio`)":                                    {Inplace, LockShared, true},
+		"DROP INDEX `idx_name`":                                                {Inplace, LockNone, false},
+		"RENAME INDEX `idx_name` TO `idx_nick`":                                {Instant, LockNone, false},
+		"ALTER INDEX `idx_name` INVISIBLE":                                     {Instant, LockNone, false},
+		"DROP PRIMARY KEY":                                                     {Copy, LockShared, true},
+		"DROP PRIMARY KEY, ADD PRIMARY KEY (`id`, `name`)":                     {Inplace, LockNone, true},
+		"ADD CONSTRAINT `fk_x` FOREIGN KEY (`org_id`) REFERENCES `org` (`id`)": {Copy, LockShared, true},
+		"DROP FOREIGN KEY `fk_org`":                                            {Inplace, LockNone, false},
+		"ADD CONSTRAINT `chk` CHECK (`id` > 0)":                                {Copy, LockShared, true},
+		"CONVERT TO CHARACTER SET utf8mb3":                                     {Copy, LockShared, true},
+		"DEFAULT CHARACTER SET = latin1":                                       {Inplace, LockNone, true},
+		"ENGINE=InnoDB":                                                        {Inplace, LockNone, true},
+		"ENGINE=MyISAM":                                                        {Copy, LockShared, true},
+		"ROW_FORMAT=DYNAMIC":                                                   {Inplace, LockNone, true},
+		"AUTO_INCREMENT=100, COMMENT='user'":                                   {Inplace, LockNone, false},
+		"RENAME TO `member`":                                                   {Instant, LockNone, false},
+		"FORCE":                                                                {Inplace, LockNone, true},
+		"ORDER BY `name`":                                                      {Copy, LockShared, true},
+		"ADD COLUMN `age` INT, ALGORITHM=INPLACE":                              {Inplace, LockNone, false},
+		"ADD COLUMN `age` INT, ALGORITHM=COPY":                                 {Copy, LockShared, true},
+		"ADD COLUMN `age` INT, ALGORITHM=INSTANT":                              {Instant, LockNone, false},
+		"ADD COLUMN `age` INT, LOCK=EXCLUSIVE":                                 {Instant, LockExclusive, false},
+	}
+	for sql, e := range cases {
+		list := analyze(t, "ALTER TABLE `user` "+sql+";")
+		if assert.Len(t, list, 1, sql) {
+			assert.Equal(t, e, expected{list[0].Algorithm, list[0].Lock, list[0].Rebuild}, sql)
+			assert.Empty(t, list[0].Problems, sql)
+		}
+	}
+}
+
+func TestAnalyzer_operations(t *testing.T) {
+	list := analyze(t, "ALTER TABLE `user`\n"+
+		"  ADD COLUMN `age` INT AFTER `id`,\n"+
+		"  MODIFY `name` VARCHAR(64) NOT NULL DEFAULT '',\n"+
+		"  ALGORITHM=INPLACE, LOCK=NONE;")
+	assert.Len(t, list, 1)
+	analysis := list[0]
+	assert.Equal(t, "user", analysis.Table)
+	assert.Len(t, analysis.Operations, 2)
+	assert.Equal(t, "ADD COLUMN `age` INT AFTER `id`", analysis.Operations[0].Specification.Text)
+	assert.Equal(t, "adding a column in the middle of table is instant since 8.0.29", analysis.Operations[0].Reason)
+	assert.False(t, analysis.Operations[0].Blocking())
+	assert.Equal(t, "extending VARCHAR across 256 bytes changes the bytes of length prefix and copies the table", analysis.Operations[1].Reason)
+	assert.True(t, analysis.Operations[1].Blocking())
+	assert.True(t, analysis.Blocking())
+	assert.Equal(t, []string{
+		"ALGORITHM=INPLACE is not supported for MODIFY `name` VARCHAR(64) NOT NULL DEFAULT '', try ALGORITHM=COPY",
+		"LOCK=NONE is not supported for MODIFY `name` VARCHAR(64) NOT NULL DEFAULT '', try LOCK=SHARED",
+	}, analysis.Problems)
+
+	list = analyze(t, "ALTER TABLE `user` ADD COLUMN `age` INT, ADD INDEX `idx_email` (`email`), ALGORITHM=INSTANT;")
+	assert.Equal(t, []string{
+		"ALGORITHM=INSTANT is not supported for ADD INDEX `idx_email` (`email`), try ALGORITHM=INPLACE",
+	}, list[0].Problems)
+
+	list = analyze(t, "ALTER TABLE `user` DROP COLUMN `unknown`;")
+	assert.Equal(t, []string{"Unknown column 'unknown' in 'user'"}, list[0].Problems)
+}
+
+func TestWithVersion(t *testing.T) {
+	list := analyze(t, "ALTER TABLE `user` ADD COLUMN `age` INT AFTER `id`, DROP COLUMN `bio`, RENAME COLUMN `name` TO `nick`;", WithVersion("8.0.27"))
+	assert.Equal(t, Inplace, list[0].Algorithm)
+	assert.True(t, list[0].Rebuild)
+	for _, e := range list[0].Operations {
+		assert.Equal(t, Inplace, e.Algorithm, e.Specification.Text)
+	}
+
+	list = analyze(t, "ALTER TABLE `user` ADD COLUMN `c` INT, ADD COLUMN `d` INT AFTER `c`;", WithVersion("8.0.20"))
+	assert.Equal(t, Instant, list[0].Algorithm)
+	list = analyze(t, "ALTER TABLE `user` ADD COLUMN `c` INT FIRST, ADD COLUMN `d` INT AFTER `c`;", WithVersion("8.0.20"))
+	assert.Equal(t, Inplace, list[0].Algorithm)
+
+	list = analyze(t, "ALTER TABLE `user` ADD COLUMN `total` INT AS (`id` + 1) AFTER `id`;", WithVersion("8.0.20"))
+	assert.Equal(t, Inplace, list[0].Algorithm)
+	assert.False(t, list[0].Rebuild)
+	list = analyze(t, "ALTER TABLE `user` ADD COLUMN `total` INT AS (`id` + 1);", WithVersion("8.0.20"))
+	assert.Equal(t, Instant, list[0].Algorithm)
+
+	list = analyze(t, "ALTER TABLE `user` ADD COLUMN `age` INT;", WithVersion("8.0.11"))
+	assert.Equal(t, Inplace, list[0].Algorithm)
+	list = analyze(t, "ALTER TABLE `user` ADD COLUMN `age` INT;", WithVersion("8.4.0"))
+	assert.Equal(t, Instant, list[0].Algorithm)
+}
+
+func TestWithForeignKeyChecks(t *testing.T) {
+	list := analyze(t, "ALTER TABLE `user` ADD FOREIGN KEY (`org_id`) REFERENCES `org` (`id`);", WithForeignKeyChecks(false))
+	assert.Equal(t, Inplace, list[0].Algorithm)
+	assert.Equal(t, LockNone, list[0].Lock)
+}
+
+func TestAnalyzer_AnalyzeSQL(t *testing.T) {
+	_, err := NewAnalyzer().AnalyzeSQL(nil, "alter.sql", "ALTER TABLE `user` FORCE;")
+	assert.EqualError(t, err, "alter.sql line 1:0 table user doesn't exist")
+
+	list, err := NewAnalyzer().AnalyzeSQL(nil, "alter.sql", "SELECT 1;")
+	assert.NoError(t, err)
+	assert.Empty(t, list)
+}
+
+func TestString(t *testing.T) {
+	assert.Equal(t, "INSTANT", Instant.String())
+	assert.Equal(t, "INPLACE", Inplace.String())
+	assert.Equal(t, "COPY", Copy.String())
+	assert.Equal(t, "NONE", LockNone.String())
+	assert.Equal(t, "SHARED", LockShared.String())
+	assert.Equal(t, "EXCLUSIVE", LockExclusive.String())
+}
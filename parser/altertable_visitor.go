@@ -0,0 +1,343 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package parser
+
+import (
+	"strings"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"github.com/zeromicro/ddl-parser/gen"
+)
+
+// AlterKind describes the kind of alter specification.
+type AlterKind int
+
+const (
+	// AlterTableOptions describes the table options, such as ENGINE=InnoDB, COMMENT='user'.
+	AlterTableOptions AlterKind = iota
+	// AlterAddColumn describes ADD COLUMN, the columns added in parentheses are described as
+	// a specification per column.
+	AlterAddColumn
+	// AlterAddIndex describes ADD INDEX, ADD PRIMARY KEY, ADD UNIQUE, ADD FULLTEXT and ADD SPATIAL.
+	AlterAddIndex
+	// AlterAddForeignKey describes ADD FOREIGN KEY.
+	AlterAddForeignKey
+	// AlterAddCheck describes ADD CHECK.
+	AlterAddCheck
+	// AlterAlgorithm describes ALGORITHM=INPLACE.
+	AlterAlgorithm
+	// AlterLock describes LOCK=NONE.
+	AlterLock
+	// AlterSetDefault describes ALTER COLUMN SET DEFAULT.
+	AlterSetDefault
+	// AlterDropDefault describes ALTER COLUMN DROP DEFAULT.
+	AlterDropDefault
+	// AlterChangeColumn describes CHANGE COLUMN.
+	AlterChangeColumn
+	// AlterModifyColumn describes MODIFY COLUMN.
+	AlterModifyColumn
+	// AlterRenameColumn describes RENAME COLUMN.
+	AlterRenameColumn
+	// AlterDropColumn describes DROP COLUMN.
+	AlterDropColumn
+	// AlterDropConstraint describes DROP CONSTRAINT and DROP CHECK.
+	AlterDropConstraint
+	// AlterDropPrimaryKey describes DROP PRIMARY KEY.
+	AlterDropPrimaryKey
+	// AlterDropIndex describes DROP INDEX.
+	AlterDropIndex
+	// AlterDropForeignKey describes DROP FOREIGN KEY.
+	AlterDropForeignKey
+	// AlterRenameIndex describes RENAME INDEX.
+	AlterRenameIndex
+	// AlterIndexVisibility describes ALTER INDEX VISIBLE and ALTER INDEX INVISIBLE.
+	AlterIndexVisibility
+	// AlterRenameTable describes RENAME TO.
+	AlterRenameTable
+	// AlterConvertCharset describes CONVERT TO CHARACTER SET.
+	AlterConvertCharset
+	// AlterDefaultCharset describes DEFAULT CHARACTER SET.
+	AlterDefaultCharset
+	// AlterForce describes FORCE.
+	AlterForce
+	// AlterOther describes the other specifications, such as the partition operations,
+	// ORDER BY, DISCARD TABLESPACE.
+	AlterOther
+)
+
+// AlterTable describes an ALTER TABLE statement.
+type AlterTable struct {
+	// Schema describes the db_name if the table is specified as db_name.tbl_name, otherwise it's empty.
+	Schema         string
+	Name           string
+	Specifications []*AlterSpecification
+	// Partition describes the literal of partition definitions, it's empty if the table is
+	// not partitioned by the statement
+	Partition string
+}
+
+// AlterSpecification describes a specification of ALTER TABLE statement, the fields which are
+// not used by the kind of specification are empty.
+type AlterSpecification struct {
+	Kind AlterKind
+	// Text describes the original text of specification, such as ADD COLUMN `age` INT
+	Text string
+	// Name describes the name of the column, index or constraint which is altered, it's the old
+	// name of CHANGE COLUMN, RENAME COLUMN and RENAME INDEX
+	Name string
+	// NewName describes the new name of CHANGE COLUMN, RENAME COLUMN, RENAME INDEX and RENAME TO
+	NewName string
+	// Column describes the new definition of ADD COLUMN, CHANGE COLUMN and MODIFY COLUMN
+	Column *Column
+	// First describes whether the column is placed by FIRST
+	First bool
+	// After describes the column of AFTER, it's empty if AFTER is not specified
+	After      string
+	Index      *Index
+	ForeignKey *ForeignKey
+	Check      *Check
+	Options    []*TableOption
+	// DefaultValue describes the literal of ALTER COLUMN SET DEFAULT
+	DefaultValue string
+	// Invisible describes whether ALTER INDEX makes the index invisible
+	Invisible bool
+	// Charset describes the character set of CONVERT TO CHARACTER SET and DEFAULT CHARACTER SET
+	Charset   string
+	Collation string
+	// Value describes the upper case value of ALGORITHM and LOCK, such as INPLACE, NONE
+	Value string
+}
+
+// visitAlterTable visits a parse tree produced by MySqlParser#alterTable.
+func (v *visitor) visitAlterTable(ctx *gen.AlterTableContext) *AlterTable {
+	v.trace("VisitAlterTable")
+	var ret AlterTable
+	ret.Schema, ret.Name = v.visitTableName(ctx.TableName())
+	for _, e := range ctx.AllAlterSpecification() {
+		ret.Specifications = append(ret.Specifications, v.visitAlterSpecification(e)...)
+	}
+	if partitionCtx, ok := ctx.PartitionDefinitions().(antlr.ParserRuleContext); ok {
+		ret.Partition = originalText(partitionCtx)
+	}
+
+	v.recordPosition(&ret, ctx)
+	return &ret
+}
+
+// visitAlterSpecification visits a parse tree produced by MySqlParser#alterSpecification.
+func (v *visitor) visitAlterSpecification(ctx gen.IAlterSpecificationContext) []*AlterSpecification {
+	v.trace("VisitAlterSpecification")
+	ruleCtx, ok := ctx.(antlr.ParserRuleContext)
+	if !ok {
+		return nil
+	}
+
+	ret := AlterSpecification{Text: originalText(ruleCtx), Kind: AlterOther}
+	switch tx := ctx.(type) {
+	case *gen.AlterByTableOptionContext:
+		ret.Kind = AlterTableOptions
+		for _, e := range tx.AllTableOption() {
+			ret.Options = append(ret.Options, v.visitTableOption(e))
+		}
+	case *gen.AlterByAddColumnContext:
+		ret.Kind = AlterAddColumn
+		uids := tx.AllUid()
+		ret.Column = v.visitColumn(uids[0], tx.ColumnDefinition())
+		ret.Name = ret.Column.Name
+		ret.First = tx.FIRST() != nil
+		if len(uids) > 1 {
+			ret.After = v.visitUid(uids[1])
+		}
+	case *gen.AlterByAddColumnsContext:
+		var list []*AlterSpecification
+		for i, e := range tx.AllUid() {
+			column := v.visitColumn(e, tx.ColumnDefinition(i))
+			spec := &AlterSpecification{Kind: AlterAddColumn, Text: ret.Text, Name: column.Name, Column: column}
+			v.recordPosition(spec, e.(antlr.ParserRuleContext))
+			list = append(list, spec)
+		}
+		return list
+	case *gen.AlterByAddIndexContext:
+		ret.Kind = AlterAddIndex
+		ret.Index = &Index{Columns: v.visitIndexColumns(tx.IndexColumnNames())}
+		if tx.Uid() != nil {
+			ret.Index.Name = v.visitUid(tx.Uid())
+		}
+		if tx.IndexType() != nil {
+			ret.Index.Using = v.visitIndexType(tx.IndexType())
+		}
+		v.visitIndexOptions(tx.AllIndexOption(), ret.Index)
+		ret.Name = ret.Index.Name
+	case *gen.AlterByAddPrimaryKeyContext:
+		ret.Kind = AlterAddIndex
+		ret.Index = &Index{Primary: true, Columns: v.visitIndexColumns(tx.IndexColumnNames())}
+		if tx.GetIndex() != nil {
+			ret.Index.Name = v.visitUid(tx.GetIndex())
+		} else if tx.GetName() != nil {
+			ret.Index.Name = v.visitUid(tx.GetName())
+		}
+		if tx.IndexType() != nil {
+			ret.Index.Using = v.visitIndexType(tx.IndexType())
+		}
+		v.visitIndexOptions(tx.AllIndexOption(), ret.Index)
+		ret.Name = ret.Index.Name
+	case *gen.AlterByAddUniqueKeyContext:
+		ret.Kind = AlterAddIndex
+		ret.Index = &Index{Unique: true, Columns: v.visitIndexColumns(tx.IndexColumnNames())}
+		if tx.GetIndexName() != nil {
+			ret.Index.Name = v.visitUid(tx.GetIndexName())
+		} else if tx.GetName() != nil {
+			ret.Index.Name = v.visitUid(tx.GetName())
+		}
+		if tx.IndexType() != nil {
+			ret.Index.Using = v.visitIndexType(tx.IndexType())
+		}
+		v.visitIndexOptions(tx.AllIndexOption(), ret.Index)
+		ret.Name = ret.Index.Name
+	case *gen.AlterByAddSpecialIndexContext:
+		ret.Kind = AlterAddIndex
+		ret.Index = &Index{
+			Fulltext: tx.FULLTEXT() != nil,
+			Spatial:  tx.SPATIAL() != nil,
+			Columns:  v.visitIndexColumns(tx.IndexColumnNames()),
+		}
+		if tx.Uid() != nil {
+			ret.Index.Name = v.visitUid(tx.Uid())
+		}
+		v.visitIndexOptions(tx.AllIndexOption(), ret.Index)
+		ret.Name = ret.Index.Name
+	case *gen.AlterByAddForeignKeyContext:
+		ret.Kind = AlterAddForeignKey
+		ret.ForeignKey = &ForeignKey{}
+		if tx.GetName() != nil {
+			ret.ForeignKey.Name = v.visitUid(tx.GetName())
+		} else if tx.GetIndexName() != nil {
+			ret.ForeignKey.Name = v.visitUid(tx.GetIndexName())
+		}
+		if indexColumnNamesCtx, ok := tx.IndexColumnNames().(*gen.IndexColumnNamesContext); ok {
+			ret.ForeignKey.Columns = v.visitIndexColumnNames(indexColumnNamesCtx)
+		}
+		if referenceCtx, ok := tx.ReferenceDefinition().(*gen.ReferenceDefinitionContext); ok {
+			v.visitReferenceDefinition(referenceCtx, ret.ForeignKey)
+		}
+		ret.Name = ret.ForeignKey.Name
+	case *gen.AlterByAddCheckTableConstraintContext:
+		ret.Kind = AlterAddCheck
+		ret.Check = &Check{}
+		if tx.GetName() != nil {
+			ret.Check.Name = v.visitUid(tx.GetName())
+		}
+		if expressionCtx, ok := tx.Expression().(antlr.ParserRuleContext); ok {
+			ret.Check.Expression = originalText(expressionCtx)
+		}
+		ret.Name = ret.Check.Name
+	case *gen.AlterBySetAlgorithmContext:
+		ret.Kind = AlterAlgorithm
+		ret.Value = strings.ToUpper(tx.GetAlgType().GetText())
+	case *gen.AlterByLockContext:
+		ret.Kind = AlterLock
+		ret.Value = strings.ToUpper(tx.GetLockType().GetText())
+	case *gen.AlterByChangeDefaultContext:
+		ret.Name = v.visitUid(tx.Uid())
+		ret.Kind = AlterDropDefault
+		if tx.SET() != nil {
+			ret.Kind = AlterSetDefault
+			ret.DefaultValue, _ = v.visitDefaultValue(tx.DefaultValue())
+		}
+	case *gen.AlterByChangeColumnContext:
+		ret.Kind = AlterChangeColumn
+		ret.Name = v.visitUid(tx.GetOldColumn())
+		ret.Column = v.visitColumn(tx.GetNewColumn(), tx.ColumnDefinition())
+		ret.NewName = ret.Column.Name
+		ret.First = tx.FIRST() != nil
+		if tx.GetAfterColumn() != nil {
+			ret.After = v.visitUid(tx.GetAfterColumn())
+		}
+	case *gen.AlterByModifyColumnContext:
+		ret.Kind = AlterModifyColumn
+		uids := tx.AllUid()
+		ret.Column = v.visitColumn(uids[0], tx.ColumnDefinition())
+		ret.Name = ret.Column.Name
+		ret.First = tx.FIRST() != nil
+		if len(uids) > 1 {
+			ret.After = v.visitUid(uids[1])
+		}
+	case *gen.AlterByRenameColumnContext:
+		ret.Kind = AlterRenameColumn
+		ret.Name = v.visitUid(tx.GetOldColumn())
+		ret.NewName = v.visitUid(tx.GetNewColumn())
+	case *gen.AlterByDropColumnContext:
+		ret.Kind = AlterDropColumn
+		ret.Name = v.visitUid(tx.Uid())
+	case *gen.AlterByDropConstraintCheckContext:
+		ret.Kind = AlterDropConstraint
+		ret.Name = v.visitUid(tx.Uid())
+	case *gen.AlterByDropPrimaryKeyContext:
+		ret.Kind = AlterDropPrimaryKey
+	case *gen.AlterByRenameIndexContext:
+		ret.Kind = AlterRenameIndex
+		ret.Name = v.visitUid(tx.Uid(0))
+		ret.NewName = v.visitUid(tx.Uid(1))
+	case *gen.AlterByAlterIndexVisibilityContext:
+		ret.Kind = AlterIndexVisibility
+		ret.Name = v.visitUid(tx.Uid())
+		ret.Invisible = tx.INVISIBLE() != nil
+	case *gen.AlterByDropIndexContext:
+		ret.Kind = AlterDropIndex
+		ret.Name = v.visitUid(tx.Uid())
+	case *gen.AlterByDropForeignKeyContext:
+		ret.Kind = AlterDropForeignKey
+		ret.Name = v.visitUid(tx.Uid())
+	case *gen.AlterByRenameContext:
+		ret.Kind = AlterRenameTable
+		if tx.Uid() != nil {
+			ret.NewName = v.visitUid(tx.Uid())
+		} else if fullIdCtx, ok := tx.FullId().(antlr.ParserRuleContext); ok {
+			ret.NewName = v.trimIdentifier(originalText(fullIdCtx))
+		}
+	case *gen.AlterByConvertCharsetContext:
+		ret.Kind = AlterConvertCharset
+		ret.Charset = v.visitCharsetName(tx.CharsetName())
+		if tx.CollationName() != nil {
+			ret.Collation = v.visitCollationName(tx.CollationName())
+		}
+	case *gen.AlterByDefaultCharsetContext:
+		ret.Kind = AlterDefaultCharset
+		ret.Charset = v.visitCharsetName(tx.CharsetName())
+		if tx.CollationName() != nil {
+			ret.Collation = v.visitCollationName(tx.CollationName())
+		}
+	case *gen.AlterByForceContext:
+		ret.Kind = AlterForce
+	}
+
+	v.recordPosition(&ret, ruleCtx)
+	return []*AlterSpecification{&ret}
+}
+
+// visitColumn visits the name and the definition of column.
+func (v *visitor) visitColumn(uid gen.IUidContext, ctx gen.IColumnDefinitionContext) *Column {
+	ret := Column{Name: v.visitUid(uid)}
+	if definitionCtx, ok := ctx.(*gen.ColumnDefinitionContext); ok {
+		if definition, ok := v.VisitColumnDefinition(definitionCtx).(*ColumnDefinition); ok {
+			ret.DataType = definition.DataType
+			ret.Constraint = definition.ColumnConstraint
+		}
+	}
+
+	return &ret
+}
@@ -0,0 +1,136 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVisitor_visitAlterTable(t *testing.T) {
+	sql := "ALTER TABLE `db`.`user`\n" +
+		"  ADD COLUMN `age` INT NOT NULL DEFAULT 0 AFTER `name`,\n" +
+		"  ADD (`a` INT, `b` TEXT),\n" +
+		"  ADD UNIQUE KEY `uk_name` (`name`(16)),\n" +
+		"  ADD PRIMARY KEY (`id`),\n" +
+		"  ADD FULLTEXT `ft_bio` (`bio`),\n" +
+		"  ADD CONSTRAINT `fk_org` FOREIGN KEY (`org_id`) REFERENCES `org` (`id`) ON DELETE CASCADE,\n" +
+		"  ADD CONSTRAINT `chk_age` CHECK (`age` > 0),\n" +
+		"  ALTER COLUMN `name` SET DEFAULT '',\n" +
+		"  ALTER `bio` DROP DEFAULT,\n" +
+		"  CHANGE `nick` `nickname` VARCHAR(32) FIRST,\n" +
+		"  MODIFY `email` VARCHAR(128) NOT NULL AFTER `id`,\n" +
+		"  RENAME COLUMN `x` TO `y`,\n" +
+		"  DROP COLUMN `old`,\n" +
+		"  DROP CHECK `chk_old`,\n" +
+		"  DROP PRIMARY KEY,\n" +
+		"  RENAME INDEX `idx_a` TO `idx_b`,\n" +
+		"  ALTER INDEX `idx_c` INVISIBLE,\n" +
+		"  DROP INDEX `idx_d`,\n" +
+		"  DROP FOREIGN KEY `fk_old`,\n" +
+		"  CONVERT TO CHARACTER SET utf8mb4 COLLATE utf8mb4_bin,\n" +
+		"  ENGINE=InnoDB COMMENT='user',\n" +
+		"  FORCE,\n" +
+		"  ALGORITHM=INPLACE,\n" +
+		"  LOCK=NONE,\n" +
+		"  RENAME TO `member`;"
+
+	statements, err := NewParser().Statements("schema.sql", sql)
+	assert.NoError(t, err)
+	assert.Len(t, statements, 1)
+	assert.Nil(t, statements[0].Table)
+
+	alter := statements[0].Alter
+	assert.Equal(t, "db", alter.Schema)
+	assert.Equal(t, "user", alter.Name)
+	specs := alter.Specifications
+	var kinds []AlterKind
+	for _, e := range specs {
+		kinds = append(kinds, e.Kind)
+	}
+	assert.Equal(t, []AlterKind{
+		AlterAddColumn, AlterAddColumn, AlterAddColumn, AlterAddIndex, AlterAddIndex, AlterAddIndex,
+		AlterAddForeignKey, AlterAddCheck, AlterSetDefault, AlterDropDefault, AlterChangeColumn,
+		AlterModifyColumn, AlterRenameColumn, AlterDropColumn, AlterDropConstraint, AlterDropPrimaryKey,
+		AlterRenameIndex, AlterIndexVisibility, AlterDropIndex, AlterDropForeignKey, AlterConvertCharset,
+		AlterTableOptions, AlterForce, AlterAlgorithm, AlterLock, AlterRenameTable,
+	}, kinds)
+
+	assert.Equal(t, "ADD COLUMN `age` INT NOT NULL DEFAULT 0 AFTER `name`", specs[0].Text)
+	assert.Equal(t, &Column{
+		Name:       "age",
+		DataType:   NewNormalDataType(Int, false, 0, 0, "", ""),
+		Constraint: &ColumnConstraint{NotNull: true, HasDefaultValue: true, DefaultValue: "0"},
+	}, specs[0].Column)
+	assert.Equal(t, "name", specs[0].After)
+	assert.Equal(t, "a", specs[1].Name)
+	assert.Equal(t, Text, specs[2].Column.DataType.Type())
+	assert.Equal(t, &Index{Name: "uk_name", Unique: true, Columns: []*IndexColumn{{Name: "name", Length: 16}}}, specs[3].Index)
+	assert.True(t, specs[4].Index.Primary)
+	assert.True(t, specs[5].Index.Fulltext)
+	assert.Equal(t, &ForeignKey{
+		Name:             "fk_org",
+		Columns:          []string{"org_id"},
+		ReferenceTable:   "org",
+		ReferenceColumns: []string{"id"},
+		OnDelete:         "CASCADE",
+	}, specs[6].ForeignKey)
+	assert.Equal(t, &Check{Name: "chk_age", Expression: "`age` > 0"}, specs[7].Check)
+	assert.Equal(t, "''", specs[8].DefaultValue)
+	assert.Equal(t, "bio", specs[9].Name)
+	assert.Equal(t, "nick", specs[10].Name)
+	assert.Equal(t, "nickname", specs[10].NewName)
+	assert.True(t, specs[10].First)
+	assert.Equal(t, "id", specs[11].After)
+	assert.Equal(t, "y", specs[12].NewName)
+	assert.Equal(t, "old", specs[13].Name)
+	assert.Equal(t, "chk_old", specs[14].Name)
+	assert.Equal(t, "idx_b", specs[16].NewName)
+	assert.True(t, specs[17].Invisible)
+	assert.Equal(t, "idx_d", specs[18].Name)
+	assert.Equal(t, "fk_old", specs[19].Name)
+	assert.Equal(t, "utf8mb4", specs[20].Charset)
+	assert.Equal(t, "utf8mb4_bin", specs[20].Collation)
+	assert.Equal(t, []*TableOption{{Name: TableOptionEngine, Value: "InnoDB"}, {Name: TableOptionComment, Value: "user"}}, specs[21].Options)
+	assert.Equal(t, "INPLACE", specs[23].Value)
+	assert.Equal(t, "NONE", specs[24].Value)
+	assert.Equal(t, "member", specs[25].NewName)
+
+	position, ok := statements[0].Positions.Of(alter)
+	assert.True(t, ok)
+	assert.Equal(t, Position{Line: 1, Column: 0}, position)
+	position, _ = statements[0].Positions.Of(specs[0])
+	assert.Equal(t, Position{Line: 2, Column: 2}, position)
+	position, _ = statements[0].Positions.Of(specs[2])
+	assert.Equal(t, Position{Line: 3, Column: 16}, position)
+}
+
+func TestVisitor_visitAlterTableNormalization(t *testing.T) {
+	statements, err := NewParser(WithNormalization(true)).Statements("schema.sql", "ALTER TABLE `user` MODIFY `age` INTEGER;")
+	assert.NoError(t, err)
+	assert.Equal(t, Int, statements[0].Alter.Specifications[0].Column.DataType.Type())
+}
+
+func TestVisitor_visitAlterTableInstant(t *testing.T) {
+	statements, err := NewParser().Statements("schema.sql", "ALTER TABLE `user` ADD COLUMN instant INT, ALGORITHM=instant;")
+	assert.NoError(t, err)
+	specs := statements[0].Alter.Specifications
+	assert.Equal(t, "instant", specs[0].Column.Name)
+	assert.Equal(t, AlterAlgorithm, specs[1].Kind)
+	assert.Equal(t, "INSTANT", specs[1].Value)
+}
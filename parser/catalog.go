@@ -0,0 +1,230 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package parser
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Catalog aggregates the tables parsed from sql, it can look up a table by schema
+// and name, and resolve the references between tables by foreign keys. The identifiers
+// of schema and table are matched by the semantics of lower_case_table_names,
+// https://dev.mysql.com/doc/refman/8.0/en/identifier-case-sensitivity.html
+type Catalog struct {
+	tables              []*Table
+	index               map[string]*Table
+	lowerCaseTableNames int
+	defaultSchema       string
+}
+
+// CatalogOption is the alias of function.
+type CatalogOption func(c *Catalog)
+
+// Reference describes a foreign key between the child table and the parent table.
+type Reference struct {
+	// Table describes the child table which declares the foreign key
+	Table *Table
+	// ForeignKey describes the foreign key declared in child table
+	ForeignKey *ForeignKey
+	// ReferenceTable describes the parent table, it's nil if the parent table
+	// does not exist in catalog
+	ReferenceTable *Table
+}
+
+// WithLowerCaseTableNames is a Catalog option to set the value of lower_case_table_names,
+// 0 means the names of schema and table are case-sensitive, 1 and 2 means they are
+// case-insensitive, the default value is 0.
+func WithLowerCaseTableNames(value int) CatalogOption {
+	return func(c *Catalog) {
+		c.lowerCaseTableNames = value
+	}
+}
+
+// WithDefaultSchema is a Catalog option to set the schema of tables which are not
+// specified as db_name.tbl_name.
+func WithDefaultSchema(schema string) CatalogOption {
+	return func(c *Catalog) {
+		c.defaultSchema = schema
+	}
+}
+
+// NewCatalog creates an instance of Catalog, it returns an error if there are
+// tables with the same name.
+func NewCatalog(tables []*Table, options ...CatalogOption) (*Catalog, error) {
+	c := &Catalog{
+		index: make(map[string]*Table),
+	}
+	for _, opt := range options {
+		opt(c)
+	}
+
+	for _, e := range tables {
+		key := c.key(c.SchemaOf(e), e.Name)
+		if _, ok := c.index[key]; ok {
+			return nil, fmt.Errorf("duplicate table %s", c.qualifiedName(e))
+		}
+
+		c.index[key] = e
+		c.tables = append(c.tables, e)
+	}
+
+	return c, nil
+}
+
+// Tables returns all tables in the order of declaration.
+func (c *Catalog) Tables() []*Table {
+	return c.tables
+}
+
+// SchemaOf returns the schema of table, it returns the default schema if the table is
+// not specified as db_name.tbl_name.
+func (c *Catalog) SchemaOf(table *Table) string {
+	if len(table.Schema) > 0 {
+		return table.Schema
+	}
+
+	return c.defaultSchema
+}
+
+// Table returns the table which matches the schema and name, an empty schema means
+// the default schema, it returns nil if the table does not exist.
+func (c *Catalog) Table(schema, name string) *Table {
+	if len(schema) == 0 {
+		schema = c.defaultSchema
+	}
+
+	return c.index[c.key(schema, name)]
+}
+
+// Column returns the column of table, it returns nil if the table or the column does not exist.
+func (c *Catalog) Column(schema, table, column string) *Column {
+	t := c.Table(schema, table)
+	if t == nil {
+		return nil
+	}
+
+	return t.Column(column)
+}
+
+// PrimaryKey returns the columns of primary key of table.
+func (c *Catalog) PrimaryKey(schema, table string) []*Column {
+	t := c.Table(schema, table)
+	if t == nil {
+		return nil
+	}
+
+	return columnsOf(t, t.PrimaryKey())
+}
+
+// UniqueKeys returns the columns of each unique key of table.
+func (c *Catalog) UniqueKeys(schema, table string) [][]*Column {
+	t := c.Table(schema, table)
+	if t == nil {
+		return nil
+	}
+
+	var ret [][]*Column
+	for _, e := range t.UniqueKeys() {
+		ret = append(ret, columnsOf(t, e))
+	}
+
+	return ret
+}
+
+// References returns the foreign keys which are declared in the table.
+func (c *Catalog) References(schema, table string) []*Reference {
+	t := c.Table(schema, table)
+	if t == nil {
+		return nil
+	}
+
+	var ret []*Reference
+	for _, e := range t.ForeignKeys() {
+		ret = append(ret, &Reference{
+			Table:          t,
+			ForeignKey:     e,
+			ReferenceTable: c.referenceTable(t, e),
+		})
+	}
+
+	return ret
+}
+
+// ReferencedBy returns the foreign keys which reference to the table, including
+// the self references.
+func (c *Catalog) ReferencedBy(schema, table string) []*Reference {
+	t := c.Table(schema, table)
+	if t == nil {
+		return nil
+	}
+
+	var ret []*Reference
+	for _, child := range c.tables {
+		for _, e := range child.ForeignKeys() {
+			if c.referenceTable(child, e) != t {
+				continue
+			}
+
+			ret = append(ret, &Reference{
+				Table:          child,
+				ForeignKey:     e,
+				ReferenceTable: t,
+			})
+		}
+	}
+
+	return ret
+}
+
+func (c *Catalog) referenceTable(child *Table, fk *ForeignKey) *Table {
+	schema := fk.ReferenceSchema
+	if len(schema) == 0 {
+		schema = c.SchemaOf(child)
+	}
+
+	return c.index[c.key(schema, fk.ReferenceTable)]
+}
+
+func (c *Catalog) key(schema, name string) string {
+	if c.lowerCaseTableNames != 0 {
+		schema = strings.ToLower(schema)
+		name = strings.ToLower(name)
+	}
+
+	return schema + "." + name
+}
+
+func (c *Catalog) qualifiedName(table *Table) string {
+	schema := c.SchemaOf(table)
+	if len(schema) == 0 {
+		return table.Name
+	}
+
+	return schema + "." + table.Name
+}
+
+func columnsOf(table *Table, names []string) []*Column {
+	var ret []*Column
+	for _, e := range names {
+		if column := table.Column(e); column != nil {
+			ret = append(ret, column)
+		}
+	}
+
+	return ret
+}
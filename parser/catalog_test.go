@@ -0,0 +1,130 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zeromicro/ddl-parser/gen"
+)
+
+const catalogSql = "CREATE TABLE `user` (\n" +
+	"  `id` bigint NOT NULL AUTO_INCREMENT,\n" +
+	"  `mobile` varchar(15) NOT NULL UNIQUE,\n" +
+	"  `Name` varchar(255) NOT NULL,\n" +
+	"  PRIMARY KEY (`id`)\n" +
+	");\n" +
+	"CREATE TABLE `shop`.`order` (\n" +
+	"  `id` bigint NOT NULL,\n" +
+	"  `user_id` bigint NOT NULL,\n" +
+	"  `parent_id` bigint,\n" +
+	"  PRIMARY KEY (`id`),\n" +
+	"  UNIQUE KEY `uk_user` (`user_id`, `id`),\n" +
+	"  CONSTRAINT `fk_user` FOREIGN KEY (`user_id`) REFERENCES `user` (`id`) ON DELETE CASCADE,\n" +
+	"  FOREIGN KEY (`parent_id`) REFERENCES `order` (`id`)\n" +
+	");"
+
+func parseTables(t *testing.T, sql string) []*Table {
+	p := NewParser()
+	accept := func(p *gen.MySqlParser, visitor *visitor) interface{} {
+		return p.Root().Accept(visitor)
+	}
+
+	v, err := p.testMysqlSyntax("test.sql", accept, sql)
+	assert.Nil(t, err)
+	createTables, ok := v.([]*CreateTable)
+	assert.True(t, ok)
+
+	var ret []*Table
+	for _, e := range createTables {
+		ret = append(ret, e.Convert())
+	}
+
+	return ret
+}
+
+func TestNewCatalog(t *testing.T) {
+	tables := parseTables(t, catalogSql)
+	assert.Equal(t, "", tables[0].Schema)
+	assert.Equal(t, "shop", tables[1].Schema)
+	assert.Equal(t, "order", tables[1].Name)
+
+	t.Run("lookup", func(t *testing.T) {
+		c, err := NewCatalog(tables, WithDefaultSchema("shop"))
+		assert.Nil(t, err)
+		assert.Len(t, c.Tables(), 2)
+		assert.Equal(t, tables[0], c.Table("", "user"))
+		assert.Equal(t, tables[0], c.Table("shop", "user"))
+		assert.Nil(t, c.Table("shop", "USER"))
+		assert.Nil(t, c.Table("other", "user"))
+		assert.Equal(t, "Name", c.Column("shop", "user", "name").Name)
+		assert.Nil(t, c.Column("shop", "user", "foo"))
+	})
+
+	t.Run("lowerCaseTableNames", func(t *testing.T) {
+		c, err := NewCatalog(tables, WithLowerCaseTableNames(1))
+		assert.Nil(t, err)
+		assert.Equal(t, tables[0], c.Table("", "USER"))
+		assert.Equal(t, tables[1], c.Table("SHOP", "Order"))
+	})
+
+	t.Run("duplicate", func(t *testing.T) {
+		_, err := NewCatalog(append(tables, &Table{Name: "User"}), WithLowerCaseTableNames(2))
+		assert.Error(t, err)
+
+		_, err = NewCatalog(append(tables, &Table{Name: "User"}))
+		assert.Nil(t, err)
+	})
+
+	t.Run("keys", func(t *testing.T) {
+		c, err := NewCatalog(tables)
+		assert.Nil(t, err)
+		pk := c.PrimaryKey("", "user")
+		assert.Len(t, pk, 1)
+		assert.Equal(t, "id", pk[0].Name)
+
+		uks := c.UniqueKeys("shop", "order")
+		assert.Len(t, uks, 1)
+		assert.Len(t, uks[0], 2)
+		assert.True(t, tables[1].IsUnique("id", "user_id"))
+		assert.True(t, tables[0].IsUnique("MOBILE"))
+		assert.False(t, tables[0].IsUnique("name"))
+		assert.True(t, tables[0].IsPrimaryKey("ID"))
+	})
+
+	t.Run("references", func(t *testing.T) {
+		c, err := NewCatalog(tables)
+		assert.Nil(t, err)
+		refs := c.References("shop", "order")
+		assert.Len(t, refs, 2)
+		// the parent table `user` is in default schema rather than shop
+		assert.Nil(t, refs[0].ReferenceTable)
+		assert.Equal(t, tables[1], refs[1].ReferenceTable)
+
+		c, err = NewCatalog(tables, WithDefaultSchema("shop"))
+		assert.Nil(t, err)
+		refs = c.ReferencedBy("", "user")
+		assert.Len(t, refs, 1)
+		assert.Equal(t, tables[1], refs[0].Table)
+		assert.Equal(t, "fk_user", refs[0].ForeignKey.Name)
+
+		refs = c.ReferencedBy("shop", "order")
+		assert.Len(t, refs, 1)
+		assert.Equal(t, []string{"parent_id"}, refs[0].ForeignKey.Columns)
+	})
+}
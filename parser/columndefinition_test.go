@@ -39,6 +39,7 @@ func TestVisitor_VisitColumnDefinition(t *testing.T) {
 	assert.Equal(t, ColumnConstraint{
 		NotNull:         true,
 		HasDefaultValue: true,
+		DefaultValue:    "'test default'",
 		Primary:         true,
 		Comment:         "test comment",
 	}, *columnDefinition.ColumnConstraint)
@@ -69,6 +70,7 @@ func TestVisitor_VisitColumnDefinition(t *testing.T) {
 	columnDefinition = v.(*ColumnDefinition)
 
 	assert.Equal(t, ColumnConstraint{
+		DefaultValue:  "NULL",
 		AutoIncrement: true,
 		Unique:        true,
 	}, *columnDefinition.ColumnConstraint)
@@ -80,7 +82,42 @@ func TestVisitor_VisitColumnDefinition(t *testing.T) {
 
 	assert.Equal(t, ColumnConstraint{
 		HasDefaultValue: true,
+		DefaultValue:    "''",
 		AutoIncrement:   true,
 		Unique:          true,
 	}, *columnDefinition.ColumnConstraint)
+
+	v, err = p.testMysqlSyntax("test.sql", accept, `timestamp(3) NOT NULL default current_timestamp(3) on update current_timestamp(3)`)
+	assert.Nil(t, err)
+	columnDefinition = v.(*ColumnDefinition)
+	assert.Equal(t, ColumnConstraint{
+		NotNull:         true,
+		HasDefaultValue: true,
+		DefaultValue:    "CURRENT_TIMESTAMP(3)",
+		OnUpdate:        "CURRENT_TIMESTAMP(3)",
+	}, *columnDefinition.ColumnConstraint)
+
+	v, err = p.testMysqlSyntax("test.sql", accept, `datetime NULL ON UPDATE NOW()`)
+	assert.Nil(t, err)
+	columnDefinition = v.(*ColumnDefinition)
+	assert.Equal(t, ColumnConstraint{
+		OnUpdate: "NOW()",
+	}, *columnDefinition.ColumnConstraint)
+
+	v, err = p.testMysqlSyntax("test.sql", accept, `int DEFAULT -1`)
+	assert.Nil(t, err)
+	columnDefinition = v.(*ColumnDefinition)
+	assert.Equal(t, "-1", columnDefinition.ColumnConstraint.DefaultValue)
+
+	v, err = p.testMysqlSyntax("test.sql", accept, `varchar(20) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin`)
+	assert.Nil(t, err)
+	columnDefinition = v.(*ColumnDefinition)
+	assert.Equal(t, "utf8mb4", columnDefinition.DataType.Charset())
+	assert.Equal(t, "utf8mb4_bin", columnDefinition.DataType.Collation())
+
+	v, err = p.testMysqlSyntax("test.sql", accept, `enum('a','b') CHARSET latin1 NOT NULL COLLATE latin1_bin`)
+	assert.Nil(t, err)
+	columnDefinition = v.(*ColumnDefinition)
+	assert.Equal(t, "latin1", columnDefinition.DataType.Charset())
+	assert.Equal(t, "latin1_bin", columnDefinition.DataType.Collation())
 }
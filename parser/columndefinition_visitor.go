@@ -19,6 +19,7 @@ package parser
 import (
 	"strings"
 
+	"github.com/antlr/antlr4/runtime/Go/antlr"
 	"github.com/zeromicro/ddl-parser/gen"
 )
 
@@ -30,11 +31,36 @@ type ColumnDefinition struct {
 type ColumnConstraint struct {
 	NotNull         bool
 	HasDefaultValue bool
-	AutoIncrement   bool
-	Primary         bool
-	Key             bool
-	Unique          bool
-	Comment         string
+	// DefaultValue describes the literal of default value, such as '', 0, NULL, CURRENT_TIMESTAMP,
+	// it's empty if the default value is not specified
+	DefaultValue  string
+	AutoIncrement bool
+	// OnUpdate describes the literal of ON UPDATE, such as CURRENT_TIMESTAMP, it's empty if
+	// ON UPDATE is not specified
+	OnUpdate string
+	Primary  bool
+	Key      bool
+	Unique   bool
+	Comment  string
+	// Generated describes the literal of expression of generated column, such as `price` * `quantity`,
+	// it's empty if the column is not a generated column
+	Generated string
+	// Stored describes whether the generated column is STORED, it's VIRTUAL by default
+	Stored bool
+	// CheckName describes the name of the column CHECK constraint, it's empty if the name is not specified
+	CheckName string
+	// Check describes the literal of expression of the column CHECK constraint, such as `age` > 0,
+	// it's empty if CHECK is not specified
+	Check string
+	// ColumnFormat describes the value of COLUMN_FORMAT in upper case, such as FIXED, DYNAMIC
+	// and DEFAULT, it's empty if COLUMN_FORMAT is not specified
+	ColumnFormat string
+	// Storage describes the value of STORAGE in upper case, such as DISK, MEMORY and DEFAULT,
+	// it's empty if STORAGE is not specified
+	Storage string
+	// SerialDefaultValue describes whether SERIAL DEFAULT VALUE is specified, which is an alias
+	// for NOT NULL AUTO_INCREMENT UNIQUE
+	SerialDefaultValue bool
 }
 
 type key bool
@@ -55,8 +81,17 @@ func (v *visitor) VisitColumnDefinition(ctx *gen.ColumnDefinitionContext) interf
 			constraint.NotNull = v.visitNullColumnConstraint(tx)
 		case *gen.DefaultColumnConstraintContext:
 			constraint.HasDefaultValue = v.visitDefaultColumnConstraint(tx)
+			defaultValue, onUpdate := v.visitDefaultValue(tx.DefaultValue())
+			constraint.DefaultValue = defaultValue
+			if len(onUpdate) > 0 {
+				constraint.OnUpdate = onUpdate
+			}
 		case *gen.AutoIncrementColumnConstraintContext:
-			constraint.AutoIncrement = v.visitAutoIncrementColumnConstraint(tx)
+			if tx.AUTO_INCREMENT() != nil {
+				constraint.AutoIncrement = v.visitAutoIncrementColumnConstraint(tx)
+			} else {
+				constraint.OnUpdate = v.visitCurrentTimestamp(tx.CurrentTimestamp())
+			}
 		case *gen.PrimaryKeyColumnConstraintContext:
 			ret := v.VisitPrimaryKeyColumnConstraint(tx)
 			if c, ok := ret.(*primary); ok {
@@ -69,6 +104,22 @@ func (v *visitor) VisitColumnDefinition(ctx *gen.ColumnDefinitionContext) interf
 			constraint.Unique = v.visitUniqueKeyColumnConstraint(tx)
 		case *gen.CommentColumnConstraintContext:
 			constraint.Comment = v.visitCommentColumnConstraint(tx)
+		case *gen.CollateColumnConstraintContext:
+			out.DataType = withCharset(out.DataType, "", v.visitCollationName(tx.CollationName()))
+		case *gen.GeneratedColumnConstraintContext:
+			constraint.Generated = v.visitExpression(tx.Expression())
+			constraint.Stored = tx.STORED() != nil
+		case *gen.CheckColumnConstraintContext:
+			if tx.GetName() != nil {
+				constraint.CheckName = v.visitUid(tx.GetName())
+			}
+			constraint.Check = v.visitExpression(tx.Expression())
+		case *gen.FormatColumnConstraintContext:
+			constraint.ColumnFormat = strings.ToUpper(tx.GetColformat().GetText())
+		case *gen.StorageColumnConstraintContext:
+			constraint.Storage = strings.ToUpper(tx.GetStorageval().GetText())
+		case *gen.SerialDefaultColumnConstraintContext:
+			constraint.SerialDefaultValue = true
 		case *gen.ReferenceColumnConstraintContext:
 			v.panicWithExpr(tx.GetStart(), "Unsupported reference definition")
 		}
@@ -78,6 +129,17 @@ func (v *visitor) VisitColumnDefinition(ctx *gen.ColumnDefinitionContext) interf
 	return &out
 }
 
+// visitExpression returns the original text of expression, such as `price` * `quantity`.
+func (v *visitor) visitExpression(ctx gen.IExpressionContext) string {
+	v.trace("VisitExpression")
+	expressionCtx, ok := ctx.(antlr.ParserRuleContext)
+	if !ok {
+		return ""
+	}
+
+	return v.verbatimText(expressionCtx)
+}
+
 // visitNullColumnConstraint visits a parse tree produced by MySqlParser#nullColumnConstraint.
 func (v *visitor) visitNullColumnConstraint(ctx *gen.NullColumnConstraintContext) bool {
 	v.trace("VisitNullColumnConstraint")
@@ -103,6 +165,50 @@ func (v *visitor) visitDefaultColumnConstraint(ctx *gen.DefaultColumnConstraintC
 	return true
 }
 
+// visitDefaultValue visits a parse tree produced by MySqlParser#defaultValue, it returns
+// the literal of default value and the literal of ON UPDATE.
+func (v *visitor) visitDefaultValue(ctx gen.IDefaultValueContext) (string, string) {
+	v.trace("VisitDefaultValue")
+	defaultValueCtx, ok := ctx.(*gen.DefaultValueContext)
+	if !ok {
+		return "", ""
+	}
+
+	if defaultValueCtx.ON() == nil {
+		return v.visitDefaultLiteral(defaultValueCtx, originalText(defaultValueCtx)), ""
+	}
+
+	stream := defaultValueCtx.GetStart().GetInputStream()
+	text := textBetween(stream, defaultValueCtx.GetStart().GetStart(), defaultValueCtx.ON().GetSymbol().GetStart()-1)
+	list := defaultValueCtx.AllCurrentTimestamp()
+	return v.visitDefaultLiteral(defaultValueCtx, text), v.visitCurrentTimestamp(list[len(list)-1])
+}
+
+// visitDefaultLiteral normalizes the keywords NULL and CURRENT_TIMESTAMP as upper case,
+// the other literals are kept as they are.
+func (v *visitor) visitDefaultLiteral(ctx *gen.DefaultValueContext, text string) string {
+	if ctx.NULL_LITERAL() != nil {
+		return strings.ToUpper(text)
+	}
+
+	if _, ok := ctx.GetChild(0).(*gen.CurrentTimestampContext); ok {
+		return strings.ToUpper(text)
+	}
+
+	return text
+}
+
+// visitCurrentTimestamp visits a parse tree produced by MySqlParser#currentTimestamp.
+func (v *visitor) visitCurrentTimestamp(ctx gen.ICurrentTimestampContext) string {
+	v.trace("VisitCurrentTimestamp")
+	currentTimestampCtx, ok := ctx.(*gen.CurrentTimestampContext)
+	if !ok {
+		return ""
+	}
+
+	return strings.ToUpper(originalText(currentTimestampCtx))
+}
+
 // visitAutoIncrementColumnConstraint visits a parse tree produced by MySqlParser#autoIncrementColumnConstraint.
 func (v *visitor) visitAutoIncrementColumnConstraint(_ *gen.AutoIncrementColumnConstraintContext) bool {
 	v.trace("VisitAutoIncrementColumnConstraint")
@@ -134,9 +240,7 @@ func (v *visitor) visitCommentColumnConstraint(ctx *gen.CommentColumnConstraintC
 	v.trace("VisitCommentColumnConstraint")
 	value := parseTerminalNode(
 		ctx.STRING_LITERAL(),
-		withTrim("`"),
-		withTrim(`"`),
-		withTrim(`'`),
+		withStringLiteral(),
 		withReplacer(`\r`, "", `\n`, ""),
 	)
 	return value
@@ -0,0 +1,139 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package parser
+
+import (
+	"strings"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"github.com/zeromicro/ddl-parser/gen"
+)
+
+// attachComments attaches the comments in the hidden channels to the nearest column, the
+// comments before the statement and the comments which are not near any column are attached
+// to the table. The comments in the original text kept by the parsed nodes, such as the
+// comments in the partition definitions, are not attached again.
+func (v *visitor) attachComments(ctx antlr.ParserRuleContext, definitions []*createDefinition, table *CreateTable) {
+	defer func() {
+		v.verbatim = nil
+	}()
+	if v.tokens == nil {
+		return
+	}
+
+	table.Comments = append(table.Comments, v.leadingComments(ctx)...)
+	for i := ctx.GetStart().GetTokenIndex(); i <= ctx.GetStop().GetTokenIndex(); i++ {
+		token := v.tokens.Get(i)
+		if !isComment(token) || v.isVerbatim(token) {
+			continue
+		}
+
+		if column := nearestColumn(definitions, token); column != nil {
+			column.Comments = append(column.Comments, commentText(token))
+		} else {
+			table.Comments = append(table.Comments, commentText(token))
+		}
+	}
+}
+
+// verbatimText returns the original text of ctx and records ctx, so that the comments in the
+// text are not attached to the columns or the table.
+func (v *visitor) verbatimText(ctx antlr.ParserRuleContext) string {
+	v.verbatim = append(v.verbatim, ctx)
+	return originalText(ctx)
+}
+
+// isVerbatim returns true if the token is in the original text kept by the parsed nodes.
+func (v *visitor) isVerbatim(token antlr.Token) bool {
+	index := token.GetTokenIndex()
+	for _, e := range v.verbatim {
+		if e.GetStart().GetTokenIndex() < index && index < e.GetStop().GetTokenIndex() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// leadingComments returns the comments between the previous statement and ctx.
+func (v *visitor) leadingComments(ctx antlr.ParserRuleContext) []string {
+	var ret []string
+	for _, e := range v.hiddenTokensToLeft(ctx.GetStart().GetTokenIndex()) {
+		if isComment(e) {
+			ret = append(ret, commentText(e))
+		}
+	}
+
+	return ret
+}
+
+func (v *visitor) hiddenTokensToLeft(index int) []antlr.Token {
+	if index < 0 {
+		return nil
+	}
+
+	return v.tokens.GetHiddenTokensToLeft(index, -1)
+}
+
+// nearestColumn returns the column which the comment describes, that is the column which
+// contains the comment, the column before the comment in the same line, or the column
+// after the comment.
+func nearestColumn(definitions []*createDefinition, comment antlr.Token) *ColumnDeclaration {
+	index := comment.GetTokenIndex()
+	var prev, next *createDefinition
+	for _, e := range definitions {
+		if e.ctx == nil {
+			continue
+		}
+
+		start, stop := e.ctx.GetStart().GetTokenIndex(), e.ctx.GetStop().GetTokenIndex()
+		switch {
+		case start < index && index < stop:
+			return e.ColumnDeclaration
+		case stop < index:
+			prev = e
+		case next == nil && start > index:
+			next = e
+		}
+	}
+
+	if prev != nil && prev.ColumnDeclaration != nil && prev.ctx.GetStop().GetLine() == comment.GetLine() {
+		return prev.ColumnDeclaration
+	}
+	if next != nil {
+		return next.ColumnDeclaration
+	}
+	if prev != nil {
+		return prev.ColumnDeclaration
+	}
+
+	return nil
+}
+
+func isComment(token antlr.Token) bool {
+	switch token.GetTokenType() {
+	case gen.MySqlLexerCOMMENT_INPUT, gen.MySqlLexerLINE_COMMENT, gen.MySqlLexerSPEC_MYSQL_COMMENT:
+		return true
+	}
+
+	return false
+}
+
+// commentText returns the text of comment, the line break of line comment is trimmed.
+func commentText(token antlr.Token) string {
+	return strings.TrimRight(token.GetText(), "\r\n")
+}
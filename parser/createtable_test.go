@@ -82,6 +82,7 @@ func TestVisitor_VisitCreateTable(t *testing.T) {
 							NotNull:         true,
 							Comment:         "学号",
 							HasDefaultValue: true,
+							DefaultValue:    "''",
 						},
 					},
 				},
@@ -90,7 +91,8 @@ func TestVisitor_VisitCreateTable(t *testing.T) {
 					ColumnDefinition: &ColumnDefinition{
 						DataType: &NormalDataType{tp: VarChar},
 						ColumnConstraint: &ColumnConstraint{
-							Comment: "用户名称",
+							DefaultValue: "NULL",
+							Comment:      "用户名称",
 						},
 					},
 				},
@@ -102,6 +104,7 @@ func TestVisitor_VisitCreateTable(t *testing.T) {
 							NotNull:         true,
 							Comment:         "用户密码",
 							HasDefaultValue: true,
+							DefaultValue:    "''",
 						},
 					},
 				},
@@ -118,8 +121,10 @@ func TestVisitor_VisitCreateTable(t *testing.T) {
 				{
 					Name: "create_time",
 					ColumnDefinition: &ColumnDefinition{
-						DataType:         &NormalDataType{tp: Timestamp},
-						ColumnConstraint: &ColumnConstraint{},
+						DataType: &NormalDataType{tp: Timestamp},
+						ColumnConstraint: &ColumnConstraint{
+							DefaultValue: "NULL",
+						},
 					},
 				},
 				{
@@ -128,6 +133,8 @@ func TestVisitor_VisitCreateTable(t *testing.T) {
 						DataType: &NormalDataType{tp: Timestamp},
 						ColumnConstraint: &ColumnConstraint{
 							HasDefaultValue: true,
+							DefaultValue:    "CURRENT_TIMESTAMP",
+							OnUpdate:        "CURRENT_TIMESTAMP",
 						},
 					},
 				},
@@ -147,6 +154,19 @@ func TestVisitor_VisitCreateTable(t *testing.T) {
 		assertCreateTableEqual(t, expected, table)
 	})
 
+	t.Run("columnCreateTable_partition", func(t *testing.T) {
+		v, err := p.testMysqlSyntax("test.sql", accept,
+			"CREATE TABLE `log` (`id` bigint NOT NULL, `created` date NOT NULL)\n"+
+				"PARTITION BY RANGE (YEAR(`created`)) (\n"+
+				"  PARTITION p0 VALUES LESS THAN (2020),\n"+
+				"  PARTITION p1 VALUES LESS THAN MAXVALUE\n);")
+		assert.Nil(t, err)
+		table := v.(*CreateTable).Convert()
+		assert.Equal(t, "PARTITION BY RANGE (YEAR(`created`)) (\n"+
+			"  PARTITION p0 VALUES LESS THAN (2020),\n"+
+			"  PARTITION p1 VALUES LESS THAN MAXVALUE\n)", table.Partition)
+	})
+
 	t.Run("columnCreateTable_every_case", func(t *testing.T) {
 		v, err := p.testMysqlSyntax("test.sql", accept,
 			`create table if not exists foo (
@@ -175,6 +195,7 @@ func TestVisitor_VisitCreateTable(t *testing.T) {
 						ColumnConstraint: &ColumnConstraint{
 							NotNull:         true,
 							HasDefaultValue: true,
+							DefaultValue:    "0",
 							AutoIncrement:   true,
 							Primary:         true,
 							Comment:         "id",
@@ -189,6 +210,7 @@ func TestVisitor_VisitCreateTable(t *testing.T) {
 							NotNull:         true,
 							Comment:         "班级id",
 							HasDefaultValue: true,
+							DefaultValue:    "''",
 						},
 					},
 				},
@@ -201,6 +223,7 @@ func TestVisitor_VisitCreateTable(t *testing.T) {
 							Key:             true,
 							Comment:         "姓名",
 							HasDefaultValue: true,
+							DefaultValue:    "''",
 						},
 					},
 				},
@@ -213,6 +236,7 @@ func TestVisitor_VisitCreateTable(t *testing.T) {
 							Unique:          true,
 							Comment:         "手机号",
 							HasDefaultValue: true,
+							DefaultValue:    "''",
 						},
 					},
 				},
@@ -226,6 +250,7 @@ func TestVisitor_VisitCreateTable(t *testing.T) {
 						ColumnConstraint: &ColumnConstraint{
 							NotNull:         true,
 							HasDefaultValue: true,
+							DefaultValue:    "'男'",
 							Comment:         "性别",
 						},
 					},
@@ -239,6 +264,7 @@ func TestVisitor_VisitCreateTable(t *testing.T) {
 						ColumnConstraint: &ColumnConstraint{
 							NotNull:         true,
 							HasDefaultValue: true,
+							DefaultValue:    "'false'",
 							Comment:         "标志位",
 						},
 					},
@@ -292,9 +318,31 @@ func TestGetTableFromCreateTable(t *testing.T) {
 	assert.Nil(t, err)
 	createTable, ok := v.(*CreateTable)
 	assert.True(t, ok)
-	assert.Equal(t, "foo`.`bar", createTable.Name)
+	assert.Equal(t, "foo", createTable.Schema)
+	assert.Equal(t, "bar", createTable.Name)
 	table := createTable.Convert()
+	assert.Equal(t, "foo", table.Schema)
 	assert.Equal(t, "bar", table.Name)
+
+	for _, sql := range []string{"CREATE TABLE foo.`bar` (`id` bigint);", "CREATE TABLE foo.bar (`id` bigint);"} {
+		v, err = p.testMysqlSyntax("test.sql", accept, sql)
+		assert.Nil(t, err)
+		table = v.(*CreateTable).Convert()
+		assert.Equal(t, "foo", table.Schema)
+		assert.Equal(t, "bar", table.Name)
+	}
+
+	// the dots in quoted identifiers are not separators
+	for sql, expected := range map[string][2]string{
+		"CREATE TABLE `a.b` (`id` bigint);":      {"", "a.b"},
+		"CREATE TABLE `db`.`a.b` (`id` bigint);": {"db", "a.b"},
+	} {
+		v, err = p.testMysqlSyntax("test.sql", accept, sql)
+		assert.Nil(t, err)
+		table = v.(*CreateTable).Convert()
+		assert.Equal(t, expected[0], table.Schema)
+		assert.Equal(t, expected[1], table.Name)
+	}
 }
 
 func assertCreateTableEqual(t *testing.T, expected, actual *CreateTable) {
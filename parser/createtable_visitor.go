@@ -17,27 +17,42 @@
 package parser
 
 import (
-	"strings"
-
+	"github.com/antlr/antlr4/runtime/Go/antlr"
 	"github.com/zeromicro/ddl-parser/gen"
 )
 
 type CreateTable struct {
-	// Name describes the literal of table, this name can be specified as db_name.tbl_name,
+	// Schema describes the db_name if the table is specified as db_name.tbl_name, otherwise it's empty,
 	// https://dev.mysql.com/doc/refman/8.0/en/create-table.html#create-table-name
+	Schema string
+	// Name describes the name of table without the schema
 	Name        string
 	Columns     []*ColumnDeclaration
 	Constraints []*TableConstraint
+	// Indexes describes the indexes declared by table constraint and index declaration,
+	// the indexes declared by column constraint are not included
+	Indexes []*Index
+	Options []*TableOption
+	// Partition describes the literal of partition definitions, such as
+	// PARTITION BY HASH(id) PARTITIONS 4, it's empty if the table is not partitioned
+	Partition string
+	// Comments describes the comments before the statement and the comments in the statement
+	// which are not attached to any column, such as -- user table
+	Comments []string
 }
 
 type ColumnDeclaration struct {
 	Name             string
 	ColumnDefinition *ColumnDefinition
+	// Comments describes the comments around the column declaration
+	Comments []string
 }
 
 type createDefinition struct {
 	ColumnDeclaration *ColumnDeclaration
 	TableConstraint   *TableConstraint
+	Index             *Index
+	ctx               antlr.ParserRuleContext
 }
 
 // visitCreateTable visits a parse tree produced by MySqlParser#createTable.
@@ -64,19 +79,35 @@ func (v *visitor) visitCreateTable(ctx gen.ICreateTableContext) *CreateTable {
 func (v *visitor) visitColumnCreateTable(ctx *gen.ColumnCreateTableContext) *CreateTable {
 	v.trace("VisitColumnCreateTable")
 	var ret CreateTable
-	tableName := ctx.TableName().GetText()
-	tableName = strings.Trim(tableName, "`")
-	tableName = strings.Trim(tableName, "'")
-	replacer := strings.NewReplacer("\r", "", "\n", "")
-	tableName = replacer.Replace(tableName)
-	ret.Name = tableName
+	ret.Schema, ret.Name = v.visitTableName(ctx.TableName())
+	if ctx.TEMPORARY() != nil {
+		v.unsupportedClause(ctx.TEMPORARY().GetSymbol(), "TEMPORARY")
+	}
+	if ctx.IfNotExists() != nil {
+		v.unsupportedClause(ctx.IfNotExists().GetStart(), "IF NOT EXISTS")
+	}
+	var definitions []*createDefinition
 	if ctx.CreateDefinitions() != nil {
 		if createDefinitionsContext, ok := ctx.CreateDefinitions().(*gen.CreateDefinitionsContext); ok {
-			definitions := v.visitCreateDefinitions(createDefinitionsContext)
+			definitions = v.visitCreateDefinitions(createDefinitionsContext)
 			v.convertCreateDefinition(definitions, &ret)
 		}
 	}
 
+	for _, e := range ctx.AllTableOption() {
+		ret.Options = append(ret.Options, v.visitTableOption(e))
+	}
+
+	if partitionCtx, ok := ctx.PartitionDefinitions().(antlr.ParserRuleContext); ok {
+		ret.Partition = v.verbatimText(partitionCtx)
+	}
+
+	v.attachComments(ctx, definitions, &ret)
+	v.recordPosition(&ret, ctx)
+	for _, e := range definitions {
+		v.recordDefinitionPosition(e)
+	}
+
 	return &ret
 }
 
@@ -94,10 +125,15 @@ func (v *visitor) visitCreateDefinitions(ctx *gen.CreateDefinitionsContext) []*c
 		case *ColumnDeclaration:
 			ret = append(ret, &createDefinition{
 				ColumnDeclaration: r,
+				ctx:               e,
 			})
-		case *TableConstraint:
+		case *createDefinition:
+			r.ctx = e
+			ret = append(ret, r)
+		case *Index:
 			ret = append(ret, &createDefinition{
-				TableConstraint: r,
+				Index: r,
+				ctx:   e,
 			})
 		}
 	}
@@ -123,13 +159,38 @@ func (v *visitor) VisitCreateDefinition(ctx gen.ICreateDefinitionContext) interf
 		return &ret
 	case *gen.ConstraintDeclarationContext:
 		if tx.TableConstraint() != nil {
-			return v.visitTableConstraint(tx.TableConstraint())
+			return &createDefinition{
+				TableConstraint: v.visitTableConstraint(tx.TableConstraint()),
+				Index:           v.visitTableConstraintIndex(tx.TableConstraint()),
+			}
+		}
+	case *gen.IndexDeclarationContext:
+		if tx.IndexColumnDefinition() != nil {
+			return v.visitIndexColumnDefinition(tx.IndexColumnDefinition())
 		}
 	}
 
 	return nil
 }
 
+func (v *visitor) recordDefinitionPosition(definition *createDefinition) {
+	if definition.ColumnDeclaration != nil {
+		v.recordPosition(definition.ColumnDeclaration, definition.ctx)
+	}
+	if definition.Index != nil {
+		v.recordPosition(definition.Index, definition.ctx)
+	}
+	if constraint := definition.TableConstraint; constraint != nil {
+		v.recordPosition(constraint, definition.ctx)
+		if constraint.ForeignKey != nil {
+			v.recordPosition(constraint.ForeignKey, definition.ctx)
+		}
+		if constraint.Check != nil {
+			v.recordPosition(constraint.Check, definition.ctx)
+		}
+	}
+}
+
 func (v *visitor) convertCreateDefinition(list []*createDefinition, table *CreateTable) {
 	for _, e := range list {
 		if e.ColumnDeclaration != nil {
@@ -138,28 +199,46 @@ func (v *visitor) convertCreateDefinition(list []*createDefinition, table *Creat
 		if e.TableConstraint != nil {
 			table.Constraints = append(table.Constraints, e.TableConstraint)
 		}
+		if e.Index != nil {
+			table.Indexes = append(table.Indexes, e.Index)
+		}
 	}
 }
 
 type Table struct {
+	// Schema describes the db_name if the table is specified as db_name.tbl_name, otherwise it's empty.
+	Schema      string
 	Name        string
 	Columns     []*Column
 	Constraints []*TableConstraint
+	// Indexes describes the indexes declared by table constraint and index declaration,
+	// the indexes declared by column constraint are not included, see AllIndexes
+	Indexes []*Index
+	Options []*TableOption
+	// Partition describes the literal of partition definitions, it's empty if the table
+	// is not partitioned
+	Partition string
+	// Comments describes the comments of statement which are not attached to any column
+	Comments []string
 }
 
 type Column struct {
 	Name       string
 	DataType   DataType
 	Constraint *ColumnConstraint
+	// Comments describes the comments around the column declaration, such as -- user name
+	Comments []string
 }
 
 func (c *CreateTable) Convert() *Table {
 	var ret Table
-	ret.Name = onlyTableName(c.Name)
+	ret.Schema = c.Schema
+	ret.Name = c.Name
 	for _, e := range c.Columns {
 		definition := e.ColumnDefinition
 		var data Column
 		data.Name = e.Name
+		data.Comments = e.Comments
 		if definition != nil {
 			data.DataType = definition.DataType
 			data.Constraint = definition.ColumnConstraint
@@ -168,12 +247,30 @@ func (c *CreateTable) Convert() *Table {
 	}
 
 	ret.Constraints = c.Constraints
+	ret.Indexes = c.Indexes
+	ret.Options = c.Options
+	ret.Partition = c.Partition
+	ret.Comments = c.Comments
 	return &ret
 }
 
-func onlyTableName(name string) string {
-	// antlr v4 parse talbe name `db_name`.`tbl_name` as string
-	// "db_name`.`tbl_name"
-	ss := strings.Split(name, "`.`")
-	return ss[len(ss)-1]
+// convert converts the CreateTable as Table, the table is normalized if the visitor normalizes,
+// the positions of CreateTable and ColumnDeclaration are recorded for Table and Column.
+func (v *visitor) convert(c *CreateTable) *Table {
+	table := c.Convert()
+	if v.normalize {
+		table.Normalize()
+	}
+	if v.positions != nil {
+		if position, ok := v.positions[c]; ok {
+			v.positions[table] = position
+		}
+		for i, e := range c.Columns {
+			if position, ok := v.positions[e]; ok {
+				v.positions[table.Columns[i]] = position
+			}
+		}
+	}
+
+	return table
 }
@@ -1,36 +0,0 @@
-/*
- * MIT License
- *
- * Copyright (c) 2021 zeromicro
- *
- * Permission is hereby granted, free of charge, to any person obtaining a copy
- * of this software and associated documentation files (the "Software"), to deal
- * in the Software without restriction, including without limitation the rights
- * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
- * copies of the Software, and to permit persons to whom the Software is
- * furnished to do so, subject to the following conditions:
- *
- * The above copyright notice and this permission notice shall be included in all
- * copies or substantial portions of the Software.
- */
-
-package parser
-
-import "testing"
-
-func Test_onlyTableName(t *testing.T) {
-	tests := []struct {
-		name string
-		want string
-	}{
-		{"foo", "foo"},
-		{"foo`.`bar", "bar"},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			if got := onlyTableName(tt.name); got != tt.want {
-				t.Errorf("onlyTableName() = %v, want %v", got, tt.want)
-			}
-		})
-	}
-}
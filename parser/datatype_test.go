@@ -30,7 +30,7 @@ func TestVisitor_VisitDataType(t *testing.T) {
 	}
 
 	t.Run("stringDataType", func(t *testing.T) {
-		testData := map[string]int{
+		testData := map[string]Kind{
 			`CHAR(10)`:      Char,
 			`CHARACTER(10)`: Character,
 			`VARCHAR(10)`:   VarChar,
@@ -51,7 +51,7 @@ func TestVisitor_VisitDataType(t *testing.T) {
 	})
 
 	t.Run("nationalStringDataType", func(t *testing.T) {
-		testData := map[string]int{
+		testData := map[string]Kind{
 			`NATIONAL VARCHAR(255)`:          NVarChar,
 			`NATIONAL CHARACTER(255) BINARY`: NChar,
 			`NCHAR VARCHAR(255) BINARY`:      NVarChar,
@@ -66,7 +66,7 @@ func TestVisitor_VisitDataType(t *testing.T) {
 	})
 
 	t.Run("nationalVaryingStringDataType", func(t *testing.T) {
-		testData := map[string]int{
+		testData := map[string]Kind{
 			`NATIONAL CHAR VARYING (255)`:             NVarChar,
 			`NATIONAL CHAR VARYING (255) BINARY`:      NVarChar,
 			`NATIONAL CHARACTER VARYING (255)`:        NVarChar,
@@ -81,7 +81,7 @@ func TestVisitor_VisitDataType(t *testing.T) {
 	})
 
 	t.Run("dimensionDataType", func(t *testing.T) {
-		testData := map[string]int{
+		testData := map[string]Kind{
 			`TINYINT(1)`:                       TinyInt,
 			`TINYINT(1) SIGNED`:                TinyInt,
 			`TINYINT(1) UNSIGNED`:              TinyInt,
@@ -184,7 +184,7 @@ func TestVisitor_VisitDataType(t *testing.T) {
 			assertTypeEqual(t, dataType, actual)
 		}
 
-		testData = map[string]int{
+		testData = map[string]Kind{
 			`TINYINT(1) UNSIGNED`: TinyInt,
 			`SMALLINT UNSIGNED`:   SmallInt,
 			`BIGINT UNSIGNED`:     BigInt,
@@ -197,7 +197,7 @@ func TestVisitor_VisitDataType(t *testing.T) {
 	})
 
 	t.Run("simpleDataType", func(t *testing.T) {
-		testData := map[string]int{
+		testData := map[string]Kind{
 			`DATE`:       Date,
 			`TINYBLOB`:   TinyBlob,
 			`MEDIUMBLOB`: MediumBlob,
@@ -238,7 +238,7 @@ func TestVisitor_VisitDataType(t *testing.T) {
 	})
 
 	t.Run("spatialDataType", func(t *testing.T) {
-		testData := map[string]int{
+		testData := map[string]Kind{
 			`GEOMETRYCOLLECTION`: GeometryCollection,
 			`GEOMCOLLECTION`:     GeomCollection,
 			`LINESTRING`:         LineString,
@@ -259,7 +259,7 @@ func TestVisitor_VisitDataType(t *testing.T) {
 	})
 
 	t.Run("longVarcharDataType ", func(t *testing.T) {
-		testData := map[string]int{
+		testData := map[string]Kind{
 			`LONG`:                LongVarChar,
 			`LONG VARCHAR`:        LongVarChar,
 			`LONG VARCHAR BINARY`: LongVarChar,
@@ -275,7 +275,7 @@ func TestVisitor_VisitDataType(t *testing.T) {
 	})
 
 	t.Run("longVarbinaryDataType ", func(t *testing.T) {
-		testData := map[string]int{
+		testData := map[string]Kind{
 			`LONG VARBINARY  `: LongVarBinary,
 		}
 
@@ -285,16 +285,38 @@ func TestVisitor_VisitDataType(t *testing.T) {
 			assertTypeEqual(t, dataType, actual)
 		}
 	})
+
+	t.Run("dimension", func(t *testing.T) {
+		testData := map[string][2]int{
+			`VARCHAR(255)`:               {255, 0},
+			`NATIONAL VARCHAR(64)`:       {64, 0},
+			`NATIONAL CHAR VARYING (32)`: {32, 0},
+			`INT(11) UNSIGNED`:           {11, 0},
+			`DECIMAL(10,2)`:              {10, 2},
+			`FLOAT(7)`:                   {7, 0},
+			`DOUBLE PRECISION (16,4)`:    {16, 4},
+			`DATETIME(6)`:                {6, 0},
+			`TEXT`:                       {0, 0},
+			`ENUM('a','b')`:              {0, 0},
+		}
+
+		for sql, dimension := range testData {
+			actual, err := p.testMysqlSyntax("test.sql", accept, sql)
+			assert.Nil(t, err)
+			assert.Equal(t, dimension[0], actual.(DataType).Length(), sql)
+			assert.Equal(t, dimension[1], actual.(DataType).Decimal(), sql)
+		}
+	})
 }
 
-func assertTypeEqual(t *testing.T, expected int, actual interface{}, unsigned ...bool) {
+func assertTypeEqual(t *testing.T, expected Kind, actual interface{}, unsigned ...bool) {
 	assert.Equal(t, expected, actual.(DataType).Type())
 	if len(unsigned) > 0 {
 		assert.Equal(t, unsigned[0], actual.(DataType).Unsigned())
 	}
 }
 
-func assertEnumTypeEqual(t *testing.T, expectedType int, values []string, actual interface{}) {
+func assertEnumTypeEqual(t *testing.T, expectedType Kind, values []string, actual interface{}) {
 	assert.Equal(t, expectedType, actual.(DataType).Type())
 	assert.Equal(t, values, actual.(DataType).Value())
 }
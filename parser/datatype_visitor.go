@@ -17,11 +17,13 @@
 package parser
 
 import (
+	"strconv"
+
 	"github.com/zeromicro/ddl-parser/gen"
 )
 
 const (
-	_ int = iota
+	_ Kind = iota
 	LongVarBinary
 	LongVarChar
 	GeometryCollection
@@ -85,10 +87,22 @@ const (
 
 // DataType describes the data type and value of the column in table
 type DataType interface {
-	Type() int
+	Type() Kind
 	Unsigned() bool
 	// Value returns the values if the data type is Enum or Set
 	Value() []string
+	// Length returns the length of data type, such as M in VARCHAR(M) and DECIMAL(M,D),
+	// it returns 0 if the length is not specified.
+	Length() int
+	// Decimal returns the number of digits after the decimal point, such as D in DECIMAL(M,D),
+	// it returns 0 if the decimal is not specified.
+	Decimal() int
+	// Charset returns the character set of string data type, it returns empty if the
+	// character set is not specified.
+	Charset() string
+	// Collation returns the collation of string data type, it returns empty if the
+	// collation is not specified.
+	Collation() string
 }
 
 var _ DataType = (*NormalDataType)(nil)
@@ -96,8 +110,12 @@ var _ DataType = (*EnumSetDataType)(nil)
 
 // NormalDataType describes the data type which not contains Enum and Set of column
 type NormalDataType struct {
-	tp       int
-	unsigned bool
+	tp        Kind
+	unsigned  bool
+	length    int
+	decimal   int
+	charset   string
+	collation string
 }
 
 // Unsigned returns true if the data type is unsigned.
@@ -106,7 +124,7 @@ func (n *NormalDataType) Unsigned() bool {
 }
 
 // Type returns the data type of column
-func (n *NormalDataType) Type() int {
+func (n *NormalDataType) Type() Kind {
 	return n.tp
 }
 
@@ -115,7 +133,27 @@ func (n *NormalDataType) Value() []string {
 	return nil
 }
 
-func with(tp int, unsigned bool, value ...string) DataType {
+// Length returns the length of data type.
+func (n *NormalDataType) Length() int {
+	return n.length
+}
+
+// Decimal returns the decimal of data type.
+func (n *NormalDataType) Decimal() int {
+	return n.decimal
+}
+
+// Charset returns the character set of data type.
+func (n *NormalDataType) Charset() string {
+	return n.charset
+}
+
+// Collation returns the collation of data type.
+func (n *NormalDataType) Collation() string {
+	return n.collation
+}
+
+func with(tp Kind, unsigned bool, value ...string) DataType {
 	if len(value) > 0 {
 		return &EnumSetDataType{
 			tp:    tp,
@@ -125,14 +163,68 @@ func with(tp int, unsigned bool, value ...string) DataType {
 	return &NormalDataType{tp: tp, unsigned: unsigned}
 }
 
+// NewNormalDataType creates a data type which is not Enum or Set, such as DECIMAL(10,2) UNSIGNED.
+func NewNormalDataType(tp Kind, unsigned bool, length, decimal int, charset, collation string) *NormalDataType {
+	return &NormalDataType{
+		tp:        tp,
+		unsigned:  unsigned,
+		length:    length,
+		decimal:   decimal,
+		charset:   charset,
+		collation: collation,
+	}
+}
+
+// NewEnumSetDataType creates a data type Enum or Set with values, such as ENUM('on','off').
+func NewEnumSetDataType(tp Kind, values []string, charset, collation string) *EnumSetDataType {
+	return &EnumSetDataType{
+		tp:        tp,
+		value:     values,
+		charset:   charset,
+		collation: collation,
+	}
+}
+
+func withDimension(dataType DataType, length, decimal int) DataType {
+	if n, ok := dataType.(*NormalDataType); ok {
+		n.length = length
+		n.decimal = decimal
+	}
+
+	return dataType
+}
+
+func withCharset(dataType DataType, charset, collation string) DataType {
+	switch t := dataType.(type) {
+	case *NormalDataType:
+		if len(charset) > 0 {
+			t.charset = charset
+		}
+		if len(collation) > 0 {
+			t.collation = collation
+		}
+	case *EnumSetDataType:
+		if len(charset) > 0 {
+			t.charset = charset
+		}
+		if len(collation) > 0 {
+			t.collation = collation
+		}
+	}
+
+	return dataType
+}
+
 // EnumSetDataType describes the data type  Enum and Set of column
 type EnumSetDataType struct {
-	tp    int
-	value []string
+	tp        Kind
+	value     []string
+	charset   string
+	collation string
 }
 
 // Type returns the data type of column
-func (e *EnumSetDataType) Type() int {
+func (e *EnumSetDataType) Type() Kind {
 	return e.tp
 }
 
@@ -146,6 +238,26 @@ func (e *EnumSetDataType) Value() []string {
 	return e.value
 }
 
+// Length returns 0 default
+func (e *EnumSetDataType) Length() int {
+	return 0
+}
+
+// Decimal returns 0 default
+func (e *EnumSetDataType) Decimal() int {
+	return 0
+}
+
+// Charset returns the character set of data type.
+func (e *EnumSetDataType) Charset() string {
+	return e.charset
+}
+
+// Collation returns the collation of data type.
+func (e *EnumSetDataType) Collation() string {
+	return e.collation
+}
+
 // visitDataType visits data type by switch-case
 func (v *visitor) visitDataType(ctx gen.IDataTypeContext) DataType {
 	v.trace("VisitDataType")
@@ -177,6 +289,21 @@ func (v *visitor) visitDataType(ctx gen.IDataTypeContext) DataType {
 // visitStringDataType visits a parse tree produced by MySqlParser#stringDataType.
 func (v *visitor) visitStringDataType(ctx *gen.StringDataTypeContext) DataType {
 	v.trace(`VisitStringDataType`)
+	if ctx.VARYING() != nil {
+		v.unsupportedClause(ctx.VARYING().GetSymbol(), "VARYING")
+	}
+	if ctx.GetBinaryType() != nil {
+		v.unsupportedClause(ctx.GetBinaryType(), "BINARY")
+	}
+	if ctx.GetCollateBinary() != nil {
+		v.unsupportedClause(ctx.GetCollateBinary(), "BINARY")
+	}
+	length := v.visitLengthOneDimension(ctx.LengthOneDimension())
+	dataType := withDimension(v.visitStringTypeName(ctx), length, 0)
+	return withCharset(dataType, v.visitCharsetName(ctx.CharsetName()), v.visitCollationName(ctx.CollationName()))
+}
+
+func (v *visitor) visitStringTypeName(ctx *gen.StringDataTypeContext) DataType {
 	text := parseToken(ctx.GetTypeName(), withUpperCase(), withTrim("`"))
 	switch text {
 	case `CHAR`:
@@ -208,12 +335,16 @@ func (v *visitor) visitStringDataType(ctx *gen.StringDataTypeContext) DataType {
 // visitNationalStringDataType visits a parse tree produced by MySqlParser#nationalVaryingStringDataType.
 func (v *visitor) visitNationalStringDataType(ctx *gen.NationalStringDataTypeContext) DataType {
 	v.trace(`VisitNationalStringDataType`)
+	if ctx.BINARY() != nil {
+		v.unsupportedClause(ctx.BINARY().GetSymbol(), "BINARY")
+	}
+	length := v.visitLengthOneDimension(ctx.LengthOneDimension())
 	text := parseToken(ctx.GetTypeName(), withUpperCase(), withTrim("`"))
 	switch text {
 	case `VARCHAR`:
-		return with(NVarChar, false)
+		return withDimension(with(NVarChar, false), length, 0)
 	case `CHARACTER`:
-		return with(NChar, false)
+		return withDimension(with(NChar, false), length, 0)
 	}
 
 	v.panicWithExpr(ctx.GetTypeName(), "invalid data type: "+text)
@@ -221,14 +352,34 @@ func (v *visitor) visitNationalStringDataType(ctx *gen.NationalStringDataTypeCon
 }
 
 // visitNationalVaryingStringDataType visits a parse tree produced by MySqlParser#nationalVaryingStringDataType.
-func (v *visitor) visitNationalVaryingStringDataType(_ *gen.NationalVaryingStringDataTypeContext) DataType {
+func (v *visitor) visitNationalVaryingStringDataType(ctx *gen.NationalVaryingStringDataTypeContext) DataType {
 	v.trace("VisitNationalVaryingStringDataType")
-	return with(NVarChar, false)
+	if ctx.BINARY() != nil {
+		v.unsupportedClause(ctx.BINARY().GetSymbol(), "BINARY")
+	}
+	length := v.visitLengthOneDimension(ctx.LengthOneDimension())
+	return withDimension(with(NVarChar, false), length, 0)
 }
 
 // visitDimensionDataType visits a parse tree produced by MySqlParser#dimensionDataType.
 func (v *visitor) visitDimensionDataType(ctx *gen.DimensionDataTypeContext) DataType {
 	v.trace("VisitDimensionDataType")
+	if ctx.ZEROFILL() != nil {
+		v.unsupportedClause(ctx.ZEROFILL().GetSymbol(), "ZEROFILL")
+	}
+	length := v.visitLengthOneDimension(ctx.LengthOneDimension())
+	decimal := 0
+	if dimensionCtx, ok := ctx.LengthTwoDimension().(*gen.LengthTwoDimensionContext); ok {
+		length, decimal = v.visitLengthTwoDimension(dimensionCtx)
+	}
+	if dimensionCtx, ok := ctx.LengthTwoOptionalDimension().(*gen.LengthTwoOptionalDimensionContext); ok {
+		length, decimal = v.visitLengthTwoDimension(dimensionCtx)
+	}
+
+	return withDimension(v.visitDimensionTypeName(ctx), length, decimal)
+}
+
+func (v *visitor) visitDimensionTypeName(ctx *gen.DimensionDataTypeContext) DataType {
 	text := parseToken(ctx.GetTypeName(), withUpperCase(), withTrim("`"))
 	unsigned := ctx.UNSIGNED() != nil
 	switch text {
@@ -329,6 +480,9 @@ func (v *visitor) visitSimpleDataType(ctx *gen.SimpleDataTypeContext) DataType {
 // visitCollectionDataType visits a parse tree produced by MySqlParser#collectionDataType.
 func (v *visitor) visitCollectionDataType(ctx *gen.CollectionDataTypeContext) DataType {
 	v.trace("VisitCollectionDataType")
+	if ctx.BINARY() != nil {
+		v.unsupportedClause(ctx.BINARY().GetSymbol(), "BINARY")
+	}
 	text := parseToken(
 		ctx.GetTypeName(),
 		withUpperCase(),
@@ -341,20 +495,19 @@ func (v *visitor) visitCollectionDataType(ctx *gen.CollectionDataTypeContext) Da
 		if ok {
 			for _, e := range optionsCtx.AllSTRING_LITERAL() {
 				value := parseTerminalNode(
-					e, withTrim("`"),
-					withTrim(`"`),
-					withTrim(`'`),
+					e, withStringLiteral(),
 				)
 				values = append(values, value)
 			}
 		}
 	}
 
+	charset := v.visitCharsetName(ctx.CharsetName())
 	switch text {
 	case `ENUM`:
-		return with(Enum, false, values...)
+		return withCharset(with(Enum, false, values...), charset, "")
 	case `SET`:
-		return with(Set, false, values...)
+		return withCharset(with(Set, false, values...), charset, "")
 	}
 
 	v.panicWithExpr(ctx.GetTypeName(), "invalid data type: "+text)
@@ -398,9 +551,13 @@ func (v *visitor) visitSpatialDataType(ctx *gen.SpatialDataTypeContext) DataType
 }
 
 // visitLongVarcharDataType visits a parse tree produced by MySqlParser#longVarcharDataType.
-func (v *visitor) visitLongVarcharDataType(_ *gen.LongVarcharDataTypeContext) DataType {
+func (v *visitor) visitLongVarcharDataType(ctx *gen.LongVarcharDataTypeContext) DataType {
 	v.trace("VisitLongVarcharDataType")
-	return with(LongVarChar, false)
+	if ctx.BINARY() != nil {
+		v.unsupportedClause(ctx.BINARY().GetSymbol(), "BINARY")
+	}
+	dataType := with(LongVarChar, false)
+	return withCharset(dataType, v.visitCharsetName(ctx.CharsetName()), v.visitCollationName(ctx.CollationName()))
 }
 
 // visitLongVarbinaryDataType visits a parse tree produced by MySqlParser#longVarbinaryDataType.
@@ -408,3 +565,65 @@ func (v *visitor) visitLongVarbinaryDataType(_ *gen.LongVarbinaryDataTypeContext
 	v.trace("VisitLongVarbinaryDataType")
 	return with(LongVarBinary, false)
 }
+
+// visitLengthOneDimension visits a parse tree produced by MySqlParser#lengthOneDimension.
+func (v *visitor) visitLengthOneDimension(ctx gen.ILengthOneDimensionContext) int {
+	v.trace("VisitLengthOneDimension")
+	dimensionCtx, ok := ctx.(*gen.LengthOneDimensionContext)
+	if !ok {
+		return 0
+	}
+
+	return v.visitDecimalLiteral(dimensionCtx.DecimalLiteral())
+}
+
+type lengthTwoDimensionContext interface {
+	AllDecimalLiteral() []gen.IDecimalLiteralContext
+}
+
+// visitLengthTwoDimension visits a parse tree produced by MySqlParser#lengthTwoDimension
+// and MySqlParser#lengthTwoOptionalDimension.
+func (v *visitor) visitLengthTwoDimension(ctx lengthTwoDimensionContext) (int, int) {
+	v.trace("VisitLengthTwoDimension")
+	var length, decimal int
+	list := ctx.AllDecimalLiteral()
+	if len(list) > 0 {
+		length = v.visitDecimalLiteral(list[0])
+	}
+	if len(list) > 1 {
+		decimal = v.visitDecimalLiteral(list[1])
+	}
+
+	return length, decimal
+}
+
+// visitDecimalLiteral visits a parse tree produced by MySqlParser#decimalLiteral.
+func (v *visitor) visitDecimalLiteral(ctx gen.IDecimalLiteralContext) int {
+	v.trace("VisitDecimalLiteral")
+	value, err := strconv.Atoi(ctx.GetText())
+	if err != nil {
+		v.panicWithExpr(ctx.GetStart(), "invalid decimal literal: "+ctx.GetText())
+	}
+
+	return value
+}
+
+// visitCharsetName visits a parse tree produced by MySqlParser#charsetName.
+func (v *visitor) visitCharsetName(ctx gen.ICharsetNameContext) string {
+	v.trace("VisitCharsetName")
+	if ctx == nil {
+		return ""
+	}
+
+	return parseToken(ctx.GetStart(), withTrim("`"), withTrim("'"), withTrim(`"`))
+}
+
+// visitCollationName visits a parse tree produced by MySqlParser#collationName.
+func (v *visitor) visitCollationName(ctx gen.ICollationNameContext) string {
+	v.trace("VisitCollationName")
+	if ctx == nil {
+		return ""
+	}
+
+	return parseToken(ctx.GetStart(), withTrim("`"), withTrim("'"), withTrim(`"`))
+}
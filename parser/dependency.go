@@ -0,0 +1,228 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package parser
+
+import "sort"
+
+// Dependencies describes the dependencies between the tables of catalog by foreign keys, the
+// child table depends on the parent table which it references.
+type Dependencies struct {
+	// CreateOrder describes the order to create the tables or to load their rows, the parent
+	// tables are created before the child tables, the tables without dependency keep the order
+	// of declaration
+	CreateOrder []*Table
+	// DropOrder describes the order to drop the tables or to delete their rows, it's the reverse
+	// of CreateOrder
+	DropOrder []*Table
+	// Cycles describes the tables which depend on each other, each cycle is a strongly connected
+	// component in the order of declaration, a table which references itself is a cycle too
+	Cycles [][]*Table
+	// Deferred describes the foreign keys which must be deferred to follow CreateOrder, such as
+	// adding them by ALTER TABLE after all tables are created, or disabling foreign_key_checks
+	// while loading the rows. The self references are always deferred since the rows of the
+	// table may reference each other, and they should be dropped before following DropOrder.
+	// The foreign keys are in the order of declaration of the child tables.
+	Deferred []*Reference
+}
+
+// Dependencies returns the dependencies between the tables by foreign keys, the foreign keys
+// which reference the tables out of catalog are ignored. The cycles are broken by deferring the
+// foreign keys of the first declared table in the cycle whose parents are created.
+func (c *Catalog) Dependencies() *Dependencies {
+	index := make(map[*Table]int)
+	for i, e := range c.tables {
+		index[e] = i
+	}
+
+	// parents[i] describes the references of table i to the other tables in catalog
+	parents := make([][]*Reference, len(c.tables))
+	var ret Dependencies
+	for i, child := range c.tables {
+		for _, e := range child.ForeignKeys() {
+			parent := c.referenceTable(child, e)
+			if parent == nil {
+				continue
+			}
+
+			ref := &Reference{Table: child, ForeignKey: e, ReferenceTable: parent}
+			if parent == child {
+				ret.Deferred = append(ret.Deferred, ref)
+				continue
+			}
+
+			parents[i] = append(parents[i], ref)
+		}
+	}
+
+	components := stronglyConnectedComponents(len(c.tables), func(i int) []int {
+		var ret []int
+		for _, e := range parents[i] {
+			ret = append(ret, index[e.ReferenceTable])
+		}
+		return ret
+	})
+	component := make([]int, len(c.tables))
+	for i, e := range components {
+		for _, v := range e {
+			component[v] = i
+		}
+	}
+	for _, e := range components {
+		if len(e) == 1 && !referencesItself(c, c.tables[e[0]]) {
+			continue
+		}
+
+		var cycle []*Table
+		for _, v := range e {
+			cycle = append(cycle, c.tables[v])
+		}
+		ret.Cycles = append(ret.Cycles, cycle)
+	}
+	sort.Slice(ret.Cycles, func(i, j int) bool {
+		return index[ret.Cycles[i][0]] < index[ret.Cycles[j][0]]
+	})
+
+	created := make([]bool, len(c.tables))
+	deferred := make(map[*Reference]bool)
+	ready := func(i int) bool {
+		for _, e := range parents[i] {
+			if !deferred[e] && !created[index[e.ReferenceTable]] {
+				return false
+			}
+		}
+		return true
+	}
+	for len(ret.CreateOrder) < len(c.tables) {
+		next := -1
+		for i := range c.tables {
+			if !created[i] && ready(i) {
+				next = i
+				break
+			}
+		}
+
+		if next < 0 {
+			// all the remaining tables wait for each other, one of the tables whose parents
+			// are created or in the same cycle breaks the cycle
+			for i := range c.tables {
+				if !created[i] && waitsInCycle(i, parents[i], component, index, created) {
+					next = i
+					break
+				}
+			}
+
+			for _, e := range parents[next] {
+				if !created[index[e.ReferenceTable]] {
+					deferred[e] = true
+					ret.Deferred = append(ret.Deferred, e)
+				}
+			}
+		}
+
+		created[next] = true
+		ret.CreateOrder = append(ret.CreateOrder, c.tables[next])
+	}
+
+	sort.SliceStable(ret.Deferred, func(i, j int) bool {
+		return index[ret.Deferred[i].Table] < index[ret.Deferred[j].Table]
+	})
+	for i := len(ret.CreateOrder) - 1; i >= 0; i-- {
+		ret.DropOrder = append(ret.DropOrder, ret.CreateOrder[i])
+	}
+
+	return &ret
+}
+
+// waitsInCycle returns true if the parents of table which are not created are in the same
+// strongly connected component as the table.
+func waitsInCycle(table int, parents []*Reference, component []int, index map[*Table]int, created []bool) bool {
+	for _, e := range parents {
+		parent := index[e.ReferenceTable]
+		if !created[parent] && component[parent] != component[table] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func referencesItself(c *Catalog, table *Table) bool {
+	for _, e := range table.ForeignKeys() {
+		if c.referenceTable(table, e) == table {
+			return true
+		}
+	}
+
+	return false
+}
+
+// stronglyConnectedComponents returns the strongly connected components of the graph by the
+// algorithm of Tarjan, the vertices of each component are sorted.
+func stronglyConnectedComponents(n int, edges func(v int) []int) [][]int {
+	var (
+		counter int
+		stack   []int
+		ret     [][]int
+		order   = make([]int, n)
+		low     = make([]int, n)
+		onStack = make([]bool, n)
+		visit   func(v int)
+	)
+
+	visit = func(v int) {
+		counter++
+		order[v], low[v] = counter, counter
+		stack = append(stack, v)
+		onStack[v] = true
+		for _, w := range edges(v) {
+			switch {
+			case order[w] == 0:
+				visit(w)
+				if low[w] < low[v] {
+					low[v] = low[w]
+				}
+			case onStack[w] && order[w] < low[v]:
+				low[v] = order[w]
+			}
+		}
+
+		if low[v] != order[v] {
+			return
+		}
+
+		var component []int
+		for {
+			w := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[w] = false
+			component = append(component, w)
+			if w == v {
+				break
+			}
+		}
+		sort.Ints(component)
+		ret = append(ret, component)
+	}
+
+	for v := 0; v < n; v++ {
+		if order[v] == 0 {
+			visit(v)
+		}
+	}
+
+	return ret
+}
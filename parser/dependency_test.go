@@ -0,0 +1,103 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCatalog_Dependencies(t *testing.T) {
+	names := func(tables []*Table) []string {
+		var ret []string
+		for _, e := range tables {
+			ret = append(ret, e.Name)
+		}
+		return ret
+	}
+
+	t.Run("cycles", func(t *testing.T) {
+		tables := parseTables(t, "CREATE TABLE `comment` (\n"+
+			"  `id` bigint NOT NULL PRIMARY KEY,\n"+
+			"  `post_id` bigint NOT NULL,\n"+
+			"  `user_id` bigint NOT NULL,\n"+
+			"  FOREIGN KEY (`post_id`) REFERENCES `post` (`id`),\n"+
+			"  FOREIGN KEY (`user_id`) REFERENCES `user` (`id`)\n"+
+			");\n"+
+			"CREATE TABLE `post` (\n"+
+			"  `id` bigint NOT NULL PRIMARY KEY,\n"+
+			"  `user_id` bigint NOT NULL,\n"+
+			"  `parent_id` bigint,\n"+
+			"  FOREIGN KEY (`user_id`) REFERENCES `user` (`id`),\n"+
+			"  CONSTRAINT `fk_parent` FOREIGN KEY (`parent_id`) REFERENCES `post` (`id`)\n"+
+			");\n"+
+			"CREATE TABLE `user` (\n"+
+			"  `id` bigint NOT NULL PRIMARY KEY,\n"+
+			"  `team_id` bigint,\n"+
+			"  CONSTRAINT `fk_team` FOREIGN KEY (`team_id`) REFERENCES `team` (`id`)\n"+
+			");\n"+
+			"CREATE TABLE `team` (\n"+
+			"  `id` bigint NOT NULL PRIMARY KEY,\n"+
+			"  `owner_id` bigint NOT NULL,\n"+
+			"  FOREIGN KEY (`owner_id`) REFERENCES `user` (`id`)\n"+
+			");\n"+
+			"CREATE TABLE `tag` (`id` bigint NOT NULL PRIMARY KEY);\n"+
+			"CREATE TABLE `ext` (\n"+
+			"  `id` bigint NOT NULL PRIMARY KEY,\n"+
+			"  FOREIGN KEY (`id`) REFERENCES `other`.`user` (`id`)\n"+
+			");")
+		c, err := NewCatalog(tables)
+		assert.Nil(t, err)
+
+		d := c.Dependencies()
+		assert.Equal(t, []string{"tag", "ext", "user", "post", "comment", "team"}, names(d.CreateOrder))
+		assert.Equal(t, []string{"team", "comment", "post", "user", "ext", "tag"}, names(d.DropOrder))
+		assert.Len(t, d.Cycles, 2)
+		assert.Equal(t, []string{"post"}, names(d.Cycles[0]))
+		assert.Equal(t, []string{"user", "team"}, names(d.Cycles[1]))
+		assert.Len(t, d.Deferred, 2)
+		assert.Equal(t, "post", d.Deferred[0].Table.Name)
+		assert.Equal(t, "fk_parent", d.Deferred[0].ForeignKey.Name)
+		assert.Equal(t, tables[1], d.Deferred[0].ReferenceTable)
+		assert.Equal(t, "user", d.Deferred[1].Table.Name)
+		assert.Equal(t, "fk_team", d.Deferred[1].ForeignKey.Name)
+		assert.Equal(t, tables[3], d.Deferred[1].ReferenceTable)
+	})
+
+	t.Run("selfReference", func(t *testing.T) {
+		c, err := NewCatalog(parseTables(t, catalogSql), WithDefaultSchema("shop"))
+		assert.Nil(t, err)
+
+		d := c.Dependencies()
+		assert.Equal(t, []string{"user", "order"}, names(d.CreateOrder))
+		assert.Equal(t, []string{"order", "user"}, names(d.DropOrder))
+		assert.Equal(t, []string{"order"}, names(d.Cycles[0]))
+		assert.Len(t, d.Deferred, 1)
+		assert.Equal(t, "order", d.Deferred[0].ReferenceTable.Name)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		c, err := NewCatalog(nil)
+		assert.Nil(t, err)
+
+		d := c.Dependencies()
+		assert.Empty(t, d.CreateOrder)
+		assert.Empty(t, d.Cycles)
+		assert.Empty(t, d.Deferred)
+	})
+}
@@ -0,0 +1,76 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zeromicro/ddl-parser/gen"
+)
+
+func TestVisitor_VisitIndexColumnDefinition(t *testing.T) {
+	p := NewParser(WithDebugMode(true))
+	accept := func(p *gen.MySqlParser, visitor *visitor) interface{} {
+		return visitor.visitIndexColumnDefinition(p.IndexColumnDefinition())
+	}
+
+	t.Run("simpleIndexDeclaration", func(t *testing.T) {
+		v, err := p.testMysqlSyntax("test.sql", accept,
+			"KEY `idx_name` USING HASH (`name`(10), `age` DESC) COMMENT 'name index' INVISIBLE")
+		assert.Nil(t, err)
+		assert.Equal(t, &Index{
+			Name: "idx_name",
+			Columns: []*IndexColumn{
+				{Name: "name", Length: 10},
+				{Name: "age", Desc: true},
+			},
+			Using:     "HASH",
+			Comment:   "name index",
+			Invisible: true,
+		}, v)
+	})
+
+	t.Run("specialIndexDeclaration", func(t *testing.T) {
+		v, err := p.testMysqlSyntax("test.sql", accept, "FULLTEXT INDEX (`content`)")
+		assert.Nil(t, err)
+		assert.Equal(t, &Index{
+			Fulltext: true,
+			Columns:  []*IndexColumn{{Name: "content"}},
+		}, v)
+	})
+}
+
+func TestTable_AllIndexes(t *testing.T) {
+	tables := parseTables(t, "CREATE TABLE `user` (\n"+
+		"  `id` bigint NOT NULL PRIMARY KEY,\n"+
+		"  `mobile` varchar(15) NOT NULL UNIQUE,\n"+
+		"  `name` varchar(255) NOT NULL,\n"+
+		"  CONSTRAINT `uk_name` UNIQUE (`name`),\n"+
+		"  INDEX `idx_name_mobile` (`name`, `mobile`)\n"+
+		");")
+	indexes := tables[0].AllIndexes()
+	assert.Len(t, indexes, 4)
+	assert.True(t, indexes[0].Primary)
+	assert.Equal(t, []string{"id"}, indexes[0].ColumnNames())
+	assert.True(t, indexes[1].Unique)
+	assert.Equal(t, "mobile", indexes[1].Name)
+	assert.Equal(t, "uk_name", indexes[2].Name)
+	assert.True(t, indexes[2].Unique)
+	assert.Equal(t, []string{"name", "mobile"}, indexes[3].ColumnNames())
+	assert.False(t, indexes[3].Unique)
+}
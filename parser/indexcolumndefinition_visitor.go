@@ -0,0 +1,194 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package parser
+
+import (
+	"github.com/zeromicro/ddl-parser/gen"
+)
+
+// Index describes an index of table, which can be declared by PRIMARY KEY, UNIQUE KEY,
+// KEY, INDEX, FULLTEXT and SPATIAL.
+type Index struct {
+	// Name describes the name of index, it's empty if the name is not specified
+	Name     string
+	Primary  bool
+	Unique   bool
+	Fulltext bool
+	Spatial  bool
+	Columns  []*IndexColumn
+	// Using describes the index type, such as BTREE, HASH
+	Using     string
+	Comment   string
+	Invisible bool
+}
+
+// IndexColumn describes a key part of index.
+type IndexColumn struct {
+	Name string
+	// Length describes the prefix length of key part, it's 0 if the length is not specified
+	Length int
+	Desc   bool
+}
+
+// ColumnNames returns the name of columns of index.
+func (i *Index) ColumnNames() []string {
+	var ret []string
+	for _, e := range i.Columns {
+		ret = append(ret, e.Name)
+	}
+
+	return ret
+}
+
+// visitIndexColumnDefinition visits a parse tree produced by MySqlParser#indexColumnDefinition.
+func (v *visitor) visitIndexColumnDefinition(ctx gen.IIndexColumnDefinitionContext) *Index {
+	v.trace("VisitIndexColumnDefinition")
+	var ret Index
+	switch tx := ctx.(type) {
+	case *gen.SimpleIndexDeclarationContext:
+		if tx.Uid() != nil {
+			ret.Name = v.visitUid(tx.Uid())
+		}
+		if tx.IndexType() != nil {
+			ret.Using = v.visitIndexType(tx.IndexType())
+		}
+		ret.Columns = v.visitIndexColumns(tx.IndexColumnNames())
+		v.visitIndexOptions(tx.AllIndexOption(), &ret)
+	case *gen.SpecialIndexDeclarationContext:
+		if tx.Uid() != nil {
+			ret.Name = v.visitUid(tx.Uid())
+		}
+		ret.Fulltext = tx.FULLTEXT() != nil
+		ret.Spatial = tx.SPATIAL() != nil
+		ret.Columns = v.visitIndexColumns(tx.IndexColumnNames())
+		v.visitIndexOptions(tx.AllIndexOption(), &ret)
+	default:
+		return nil
+	}
+
+	return &ret
+}
+
+// visitTableConstraintIndex visits the index of a parse tree produced by MySqlParser#tableConstraint,
+// it returns nil if the constraint is neither a primary key nor a unique key.
+func (v *visitor) visitTableConstraintIndex(ctx gen.ITableConstraintContext) *Index {
+	v.trace("VisitTableConstraintIndex")
+	var ret Index
+	switch tx := ctx.(type) {
+	case *gen.PrimaryKeyTableConstraintContext:
+		ret.Primary = true
+		if tx.GetIndex() != nil {
+			ret.Name = v.visitUid(tx.GetIndex())
+		} else if tx.GetName() != nil {
+			ret.Name = v.visitUid(tx.GetName())
+		}
+		if tx.IndexType() != nil {
+			ret.Using = v.visitIndexType(tx.IndexType())
+		}
+		ret.Columns = v.visitIndexColumns(tx.IndexColumnNames())
+		v.visitIndexOptions(tx.AllIndexOption(), &ret)
+	case *gen.UniqueKeyTableConstraintContext:
+		ret.Unique = true
+		if tx.GetIndex() != nil {
+			ret.Name = v.visitUid(tx.GetIndex())
+		} else if tx.GetName() != nil {
+			ret.Name = v.visitUid(tx.GetName())
+		}
+		if tx.IndexType() != nil {
+			ret.Using = v.visitIndexType(tx.IndexType())
+		}
+		ret.Columns = v.visitIndexColumns(tx.IndexColumnNames())
+		v.visitIndexOptions(tx.AllIndexOption(), &ret)
+	default:
+		return nil
+	}
+
+	return &ret
+}
+
+// visitIndexColumns visits a parse tree produced by MySqlParser#indexColumnNames with key parts.
+func (v *visitor) visitIndexColumns(ctx gen.IIndexColumnNamesContext) []*IndexColumn {
+	v.trace("VisitIndexColumns")
+	indexColumnNamesCtx, ok := ctx.(*gen.IndexColumnNamesContext)
+	if !ok {
+		return nil
+	}
+
+	var ret []*IndexColumn
+	for _, e := range indexColumnNamesCtx.AllIndexColumnName() {
+		indexCtx, ok := e.(*gen.IndexColumnNameContext)
+		if !ok {
+			continue
+		}
+
+		column := IndexColumn{
+			Name: v.visitIndexColumnName(indexCtx),
+			Desc: indexCtx.DESC() != nil,
+		}
+		if indexCtx.DecimalLiteral() != nil {
+			column.Length = v.visitDecimalLiteral(indexCtx.DecimalLiteral())
+		}
+		ret = append(ret, &column)
+	}
+
+	return ret
+}
+
+// visitIndexType visits a parse tree produced by MySqlParser#indexType.
+func (v *visitor) visitIndexType(ctx gen.IIndexTypeContext) string {
+	v.trace("VisitIndexType")
+	indexTypeCtx, ok := ctx.(*gen.IndexTypeContext)
+	if !ok {
+		return ""
+	}
+
+	if indexTypeCtx.HASH() != nil {
+		return "HASH"
+	}
+
+	return "BTREE"
+}
+
+// visitIndexOptions visits a parse tree produced by MySqlParser#indexOption.
+func (v *visitor) visitIndexOptions(list []gen.IIndexOptionContext, index *Index) {
+	v.trace("VisitIndexOptions")
+	for _, e := range list {
+		optionCtx, ok := e.(*gen.IndexOptionContext)
+		if !ok {
+			continue
+		}
+
+		switch {
+		case optionCtx.IndexType() != nil:
+			index.Using = v.visitIndexType(optionCtx.IndexType())
+		case optionCtx.COMMENT() != nil:
+			index.Comment = parseTerminalNode(
+				optionCtx.STRING_LITERAL(),
+				withStringLiteral(),
+				withReplacer(`\r`, "", `\n`, ""),
+			)
+		case optionCtx.INVISIBLE() != nil:
+			index.Invisible = true
+		case optionCtx.VISIBLE() != nil:
+			index.Invisible = false
+		case optionCtx.KEY_BLOCK_SIZE() != nil:
+			v.unsupportedClause(optionCtx.GetStart(), "KEY_BLOCK_SIZE")
+		case optionCtx.PARSER() != nil:
+			v.unsupportedClause(optionCtx.GetStart(), "WITH PARSER")
+		}
+	}
+}
@@ -0,0 +1,187 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package parser
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Kind describes the kind of data type, such as VarChar, BigInt.
+type Kind int
+
+type category int
+
+const (
+	_ category = iota
+	integerCategory
+	fixedPointCategory
+	floatingPointCategory
+	bitCategory
+	stringCategory
+	binaryCategory
+	temporalCategory
+	spatialCategory
+	jsonCategory
+	enumSetCategory
+)
+
+type kindInfo struct {
+	name     string
+	category category
+}
+
+var kinds = map[Kind]kindInfo{
+	LongVarBinary:      {"LONG VARBINARY", binaryCategory},
+	LongVarChar:        {"LONG VARCHAR", stringCategory},
+	GeometryCollection: {"GEOMETRYCOLLECTION", spatialCategory},
+	GeomCollection:     {"GEOMCOLLECTION", spatialCategory},
+	LineString:         {"LINESTRING", spatialCategory},
+	MultiLineString:    {"MULTILINESTRING", spatialCategory},
+	MultiPoint:         {"MULTIPOINT", spatialCategory},
+	MultiPolygon:       {"MULTIPOLYGON", spatialCategory},
+	Point:              {"POINT", spatialCategory},
+	Polygon:            {"POLYGON", spatialCategory},
+	Json:               {"JSON", jsonCategory},
+	Geometry:           {"GEOMETRY", spatialCategory},
+	Enum:               {"ENUM", enumSetCategory},
+	Set:                {"SET", enumSetCategory},
+	Bit:                {"BIT", bitCategory},
+	Time:               {"TIME", temporalCategory},
+	Timestamp:          {"TIMESTAMP", temporalCategory},
+	DateTime:           {"DATETIME", temporalCategory},
+	Binary:             {"BINARY", binaryCategory},
+	VarBinary:          {"VARBINARY", binaryCategory},
+	Blob:               {"BLOB", binaryCategory},
+	Year:               {"YEAR", temporalCategory},
+	Decimal:            {"DECIMAL", fixedPointCategory},
+	Dec:                {"DEC", fixedPointCategory},
+	Fixed:              {"FIXED", fixedPointCategory},
+	Numeric:            {"NUMERIC", fixedPointCategory},
+	Float:              {"FLOAT", floatingPointCategory},
+	Float4:             {"FLOAT4", floatingPointCategory},
+	Float8:             {"FLOAT8", floatingPointCategory},
+	Double:             {"DOUBLE", floatingPointCategory},
+	Real:               {"REAL", floatingPointCategory},
+	TinyInt:            {"TINYINT", integerCategory},
+	SmallInt:           {"SMALLINT", integerCategory},
+	MediumInt:          {"MEDIUMINT", integerCategory},
+	Int:                {"INT", integerCategory},
+	Integer:            {"INTEGER", integerCategory},
+	BigInt:             {"BIGINT", integerCategory},
+	MiddleInt:          {"MIDDLEINT", integerCategory},
+	Int1:               {"INT1", integerCategory},
+	Int2:               {"INT2", integerCategory},
+	Int3:               {"INT3", integerCategory},
+	Int4:               {"INT4", integerCategory},
+	Int8:               {"INT8", integerCategory},
+	Date:               {"DATE", temporalCategory},
+	TinyBlob:           {"TINYBLOB", binaryCategory},
+	MediumBlob:         {"MEDIUMBLOB", binaryCategory},
+	LongBlob:           {"LONGBLOB", binaryCategory},
+	Bool:               {"BOOL", integerCategory},
+	Boolean:            {"BOOLEAN", integerCategory},
+	Serial:             {"SERIAL", integerCategory},
+	NVarChar:           {"NVARCHAR", stringCategory},
+	NChar:              {"NCHAR", stringCategory},
+	Char:               {"CHAR", stringCategory},
+	Character:          {"CHARACTER", stringCategory},
+	VarChar:            {"VARCHAR", stringCategory},
+	TinyText:           {"TINYTEXT", stringCategory},
+	Text:               {"TEXT", stringCategory},
+	MediumText:         {"MEDIUMTEXT", stringCategory},
+	LongText:           {"LONGTEXT", stringCategory},
+}
+
+// String returns the name of data type in sql, such as VARCHAR, LONG VARBINARY.
+func (k Kind) String() string {
+	if info, ok := kinds[k]; ok {
+		return info.name
+	}
+
+	return "Kind(" + strconv.Itoa(int(k)) + ")"
+}
+
+// IsInteger returns true if the data type is an integer type, BOOL, BOOLEAN and SERIAL are
+// included.
+func (k Kind) IsInteger() bool {
+	return kinds[k].category == integerCategory
+}
+
+// IsFixedPoint returns true if the data type is a fixed-point type, such as DECIMAL, NUMERIC.
+func (k Kind) IsFixedPoint() bool {
+	return kinds[k].category == fixedPointCategory
+}
+
+// IsFloatingPoint returns true if the data type is a floating-point type, such as FLOAT, DOUBLE.
+func (k Kind) IsFloatingPoint() bool {
+	return kinds[k].category == floatingPointCategory
+}
+
+// IsNumeric returns true if the data type is an integer, fixed-point, floating-point or BIT type.
+func (k Kind) IsNumeric() bool {
+	switch kinds[k].category {
+	case integerCategory, fixedPointCategory, floatingPointCategory, bitCategory:
+		return true
+	}
+
+	return false
+}
+
+// IsString returns true if the data type is a character string type, such as VARCHAR, TEXT,
+// ENUM and SET are not included.
+func (k Kind) IsString() bool {
+	return kinds[k].category == stringCategory
+}
+
+// IsBinary returns true if the data type is a binary string type, such as VARBINARY, BLOB.
+func (k Kind) IsBinary() bool {
+	return kinds[k].category == binaryCategory
+}
+
+// IsTemporal returns true if the data type is a date and time type, such as DATETIME, YEAR.
+func (k Kind) IsTemporal() bool {
+	return kinds[k].category == temporalCategory
+}
+
+// IsSpatial returns true if the data type is a spatial type, such as GEOMETRY, POINT.
+func (k Kind) IsSpatial() bool {
+	return kinds[k].category == spatialCategory
+}
+
+// IsJSON returns true if the data type is JSON.
+func (k Kind) IsJSON() bool {
+	return kinds[k].category == jsonCategory
+}
+
+// IsEnumSet returns true if the data type is ENUM or SET.
+func (k Kind) IsEnumSet() bool {
+	return kinds[k].category == enumSetCategory
+}
+
+// LookupKind returns the kind of data type by the name in sql, the name is case-insensitive,
+// it returns false if the name is unknown.
+func LookupKind(name string) (Kind, bool) {
+	name = strings.ToUpper(strings.Join(strings.Fields(name), " "))
+	for k, e := range kinds {
+		if e.name == name {
+			return k, true
+		}
+	}
+
+	return 0, false
+}
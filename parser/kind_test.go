@@ -0,0 +1,77 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package parser
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKind_String(t *testing.T) {
+	assert.Equal(t, "INT", Int.String())
+	assert.Equal(t, "LONG VARBINARY", LongVarBinary.String())
+	assert.Equal(t, "BIGINT", fmt.Sprint(BigInt))
+	assert.Equal(t, "Kind(0)", Kind(0).String())
+
+	for k := LongVarBinary; k <= LongText; k++ {
+		actual, ok := LookupKind(k.String())
+		assert.True(t, ok)
+		assert.Equal(t, k, actual)
+	}
+}
+
+func TestLookupKind(t *testing.T) {
+	actual, ok := LookupKind(" long  varchar ")
+	assert.True(t, ok)
+	assert.Equal(t, LongVarChar, actual)
+
+	_, ok = LookupKind("HUGEINT")
+	assert.False(t, ok)
+}
+
+func TestKind_category(t *testing.T) {
+	assert.True(t, Int.IsInteger())
+	assert.True(t, Boolean.IsInteger())
+	assert.True(t, Serial.IsInteger())
+	assert.False(t, Decimal.IsInteger())
+	assert.True(t, Numeric.IsFixedPoint())
+	assert.True(t, Real.IsFloatingPoint())
+	assert.True(t, Bit.IsNumeric())
+	assert.True(t, Decimal.IsNumeric())
+	assert.False(t, Year.IsNumeric())
+	assert.True(t, NVarChar.IsString())
+	assert.False(t, Enum.IsString())
+	assert.True(t, LongVarBinary.IsBinary())
+	assert.True(t, Year.IsTemporal())
+	assert.True(t, GeomCollection.IsSpatial())
+	assert.True(t, Json.IsJSON())
+	assert.True(t, Set.IsEnumSet())
+	assert.False(t, Kind(0).IsInteger())
+
+	for k := LongVarBinary; k <= LongText; k++ {
+		var n int
+		for _, e := range []bool{k.IsInteger(), k.IsFixedPoint(), k.IsFloatingPoint(), k == Bit, k.IsString(),
+			k.IsBinary(), k.IsTemporal(), k.IsSpatial(), k.IsJSON(), k.IsEnumSet()} {
+			if e {
+				n++
+			}
+		}
+		assert.Equal(t, 1, n, k.String())
+	}
+}
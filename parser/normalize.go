@@ -0,0 +1,83 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package parser
+
+// kindAliases describes the aliases of data types and their canonical kinds in mysql, BOOL,
+// BOOLEAN and SERIAL are not included since they imply the dimension or constraints.
+var kindAliases = map[Kind]Kind{
+	Integer:        Int,
+	Int1:           TinyInt,
+	Int2:           SmallInt,
+	Int3:           MediumInt,
+	MiddleInt:      MediumInt,
+	Int4:           Int,
+	Int8:           BigInt,
+	Dec:            Decimal,
+	Fixed:          Decimal,
+	Numeric:        Decimal,
+	Float4:         Float,
+	Float8:         Double,
+	Real:           Double,
+	Character:      Char,
+	LongVarChar:    MediumText,
+	LongVarBinary:  MediumBlob,
+	GeomCollection: GeometryCollection,
+}
+
+// Normalize maps the aliases of data types in table to their canonical data types, such as
+// INTEGER to INT, NUMERIC to DECIMAL, BOOL to TINYINT(1), and expands SERIAL to BIGINT UNSIGNED
+// NOT NULL AUTO_INCREMENT UNIQUE, so the same columns declared with different aliases are equal.
+// SERIAL DEFAULT VALUE is expanded to NOT NULL AUTO_INCREMENT UNIQUE as well.
+func (t *Table) Normalize() {
+	for _, e := range t.Columns {
+		if e.Constraint != nil && e.Constraint.SerialDefaultValue {
+			e.Constraint.SerialDefaultValue = false
+			e.Constraint.NotNull = true
+			e.Constraint.AutoIncrement = true
+			e.Constraint.Unique = true
+		}
+		if e.DataType == nil {
+			continue
+		}
+
+		switch tp := e.DataType.Type(); tp {
+		case Bool, Boolean:
+			e.DataType = NewNormalDataType(TinyInt, false, 1, 0, "", "")
+		case Serial:
+			e.DataType = NewNormalDataType(BigInt, true, 0, 0, "", "")
+			if e.Constraint == nil {
+				e.Constraint = &ColumnConstraint{}
+			}
+			e.Constraint.NotNull = true
+			e.Constraint.AutoIncrement = true
+			e.Constraint.Unique = true
+		default:
+			if kind, ok := kindAliases[tp]; ok {
+				e.DataType = withKind(e.DataType, kind)
+			}
+		}
+	}
+}
+
+func withKind(dataType DataType, tp Kind) DataType {
+	if len(dataType.Value()) > 0 {
+		return NewEnumSetDataType(tp, dataType.Value(), dataType.Charset(), dataType.Collation())
+	}
+
+	return NewNormalDataType(tp, dataType.Unsigned(), dataType.Length(), dataType.Decimal(),
+		dataType.Charset(), dataType.Collation())
+}
@@ -0,0 +1,56 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTable_Normalize(t *testing.T) {
+	sql := "CREATE TABLE `user` (\n" +
+		"  `id` SERIAL,\n" +
+		"  `age` INTEGER(11) UNSIGNED NOT NULL,\n" +
+		"  `level` MIDDLEINT,\n" +
+		"  `balance` NUMERIC(10,2),\n" +
+		"  `score` REAL,\n" +
+		"  `enabled` BOOLEAN DEFAULT 1,\n" +
+		"  `code` CHARACTER(8) CHARACTER SET ascii,\n" +
+		"  `name` VARCHAR(64)\n" +
+		");"
+
+	statements, err := NewParser(WithNormalization(true)).Statements("schema.sql", sql)
+	assert.NoError(t, err)
+	table := statements[0].Table
+	assert.Equal(t, NewNormalDataType(BigInt, true, 0, 0, "", ""), table.Columns[0].DataType)
+	assert.Equal(t, &ColumnConstraint{NotNull: true, AutoIncrement: true, Unique: true}, table.Columns[0].Constraint)
+	assert.Equal(t, NewNormalDataType(Int, true, 11, 0, "", ""), table.Columns[1].DataType)
+	assert.True(t, table.Columns[1].Constraint.NotNull)
+	assert.Equal(t, NewNormalDataType(MediumInt, false, 0, 0, "", ""), table.Columns[2].DataType)
+	assert.Equal(t, NewNormalDataType(Decimal, false, 10, 2, "", ""), table.Columns[3].DataType)
+	assert.Equal(t, NewNormalDataType(Double, false, 0, 0, "", ""), table.Columns[4].DataType)
+	assert.Equal(t, NewNormalDataType(TinyInt, false, 1, 0, "", ""), table.Columns[5].DataType)
+	assert.Equal(t, "1", table.Columns[5].Constraint.DefaultValue)
+	assert.Equal(t, NewNormalDataType(Char, false, 8, 0, "ascii", ""), table.Columns[6].DataType)
+	assert.Equal(t, NewNormalDataType(VarChar, false, 64, 0, "", ""), table.Columns[7].DataType)
+
+	statements, err = NewParser().Statements("schema.sql", sql)
+	assert.NoError(t, err)
+	assert.Equal(t, Serial, statements[0].Table.Columns[0].DataType.Type())
+	assert.Equal(t, Integer, statements[0].Table.Columns[1].DataType.Type())
+}
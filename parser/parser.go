@@ -32,12 +32,16 @@ var (
 
 // Parser is the syntax entry to parse sql as AST, you can use NewParser to create
 // an instance with options, WithDebugMode option can parse sql with debug, WithLogger
-// option can print logs while parsing.
+// option can print logs while parsing, WithNormalization option maps the aliases of data
+// types to their canonical data types, WithValidation option checks the parsed tables
+// semantically.
 type Parser struct {
 	antlr.DefaultErrorListener
-	debug  bool
-	logger console.Console
-	prefix string
+	debug     bool
+	logger    console.Console
+	prefix    string
+	normalize bool
+	validate  bool
 }
 
 // Option is the alias of function.
@@ -85,6 +89,21 @@ func WithConsole(logger console.Console) Option {
 	}
 }
 
+// WithNormalization is a Parser option to normalize the parsed tables, see Table.Normalize.
+func WithNormalization(normalize bool) Option {
+	return func(p *Parser) {
+		p.normalize = normalize
+	}
+}
+
+// WithValidation is a Parser option to check the parsed tables semantically, the errors are
+// returned as ValidationErrors, see Table.Validate.
+func WithValidation(validate bool) Option {
+	return func(p *Parser) {
+		p.validate = validate
+	}
+}
+
 func (p *Parser) From(filename string) (ret []*Table, err error) {
 	if !filepath.IsAbs(filename) {
 		return nil, fmt.Errorf("%s is not a valid path", filename)
@@ -107,21 +126,9 @@ func (p *Parser) From(filename string) (ret []*Table, err error) {
 		return nil, err
 	}
 
-	prefix := filepath.Base(filename)
-	p.prefix = prefix
-	inputStream := antlr.NewInputStream(string(bytes))
-	caseChangingStream := newCaseChangingStream(inputStream, true)
-	lexer := gen.NewMySqlLexer(caseChangingStream)
-	lexer.RemoveErrorListeners()
-	tokens := antlr.NewCommonTokenStream(lexer, antlr.LexerDefaultTokenChannel)
-	mysqlParser := gen.NewMySqlParser(tokens)
-	mysqlParser.RemoveErrorListeners()
-	mysqlParser.AddErrorListener(p)
-
-	visitor := &visitor{
-		prefix: prefix,
-		debug:  p.debug,
-		logger: p.logger,
+	mysqlParser, visitor := p.newMysqlParser(filepath.Base(filename), string(bytes))
+	if p.validate {
+		visitor.positions = make(map[interface{}]Position)
 	}
 	v := mysqlParser.Root().Accept(visitor)
 	if v == nil {
@@ -134,12 +141,54 @@ func (p *Parser) From(filename string) (ret []*Table, err error) {
 	}
 
 	for _, e := range createTables {
-		ret = append(ret, e.Convert())
+		ret = append(ret, visitor.convert(e))
+	}
+
+	if p.validate {
+		positions := &Positions{nodes: visitor.positions}
+		var errs ValidationErrors
+		for _, e := range ret {
+			errs = append(errs, p.validateTable(e, positions)...)
+		}
+		if len(errs) > 0 {
+			return ret, errs
+		}
 	}
 
 	return
 }
 
+// validateTable validates the table and sets the prefix of Parser as the file of errors.
+func (p *Parser) validateTable(table *Table, positions *Positions) ValidationErrors {
+	errs := table.Validate(positions)
+	for _, e := range errs {
+		e.File = p.prefix
+	}
+
+	return errs
+}
+
+func (p *Parser) newMysqlParser(prefix, sql string) (*gen.MySqlParser, *visitor) {
+	p.prefix = prefix
+	inputStream := antlr.NewInputStream(sql)
+	caseChangingStream := newCaseChangingStream(inputStream, true)
+	lexer := gen.NewMySqlLexer(caseChangingStream)
+	lexer.RemoveErrorListeners()
+	tokens := antlr.NewCommonTokenStream(lexer, antlr.LexerDefaultTokenChannel)
+	mysqlParser := gen.NewMySqlParser(tokens)
+	mysqlParser.RemoveErrorListeners()
+	mysqlParser.AddErrorListener(p)
+
+	visitor := &visitor{
+		prefix:    prefix,
+		debug:     p.debug,
+		logger:    p.logger,
+		tokens:    tokens,
+		normalize: p.normalize,
+	}
+	return mysqlParser, visitor
+}
+
 // testMysqlSyntax tests the mysql syntax with unit test.
 func (p *Parser) testMysqlSyntax(prefix string, acceptor Acceptor, sql string) (v interface{}, err error) {
 	defer func() {
@@ -154,21 +203,7 @@ func (p *Parser) testMysqlSyntax(prefix string, acceptor Acceptor, sql string) (
 		}
 	}()
 
-	p.prefix = prefix
-	inputStream := antlr.NewInputStream(sql)
-	caseChangingStream := newCaseChangingStream(inputStream, true)
-	lexer := gen.NewMySqlLexer(caseChangingStream)
-	lexer.RemoveErrorListeners()
-	tokens := antlr.NewCommonTokenStream(lexer, antlr.LexerDefaultTokenChannel)
-	mysqlParser := gen.NewMySqlParser(tokens)
-	mysqlParser.RemoveErrorListeners()
-	mysqlParser.AddErrorListener(p)
-
-	visitor := &visitor{
-		prefix: prefix,
-		debug:  p.debug,
-		logger: p.logger,
-	}
+	mysqlParser, visitor := p.newMysqlParser(prefix, sql)
 	v = acceptor(mysqlParser, visitor)
 	return
 }
@@ -0,0 +1,62 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package parser
+
+import (
+	"fmt"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+// Position describes the position of a node in the sql content, the line starts from 1 and the
+// column starts from 0, the same as the positions in the syntax errors.
+type Position struct {
+	Line   int
+	Column int
+}
+
+// String returns the position as line:column.
+func (p Position) String() string {
+	return fmt.Sprintf("%d:%d", p.Line, p.Column)
+}
+
+// Positions describes the positions of the nodes parsed from a statement, the nodes are
+// *Table, *Column, *TableConstraint, *ForeignKey, *Check and *Index of CREATE TABLE statements,
+// and *AlterTable and *AlterSpecification of ALTER TABLE statements.
+type Positions struct {
+	nodes map[interface{}]Position
+}
+
+// Of returns the position of node, it returns false if the node is not parsed from the statement.
+func (p *Positions) Of(node interface{}) (Position, bool) {
+	if p == nil {
+		return Position{}, false
+	}
+
+	position, ok := p.nodes[node]
+	return position, ok
+}
+
+// recordPosition records the start position of ctx as the position of node if the visitor
+// records the positions.
+func (v *visitor) recordPosition(node interface{}, ctx antlr.ParserRuleContext) {
+	if v.positions == nil || ctx == nil || ctx.GetStart() == nil {
+		return
+	}
+
+	v.positions[node] = Position{Line: ctx.GetStart().GetLine(), Column: ctx.GetStart().GetColumn()}
+}
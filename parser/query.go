@@ -0,0 +1,109 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package parser
+
+import (
+	"fmt"
+
+	"github.com/zeromicro/ddl-parser/gen"
+)
+
+// Query describes a SELECT, INSERT, UPDATE or DELETE statement which is checked against the
+// tables of catalog.
+type Query struct {
+	// Text describes the original text of statement without the semicolon
+	Text string
+	// Columns describes the columns of the result set of SELECT statement, it's nil for the
+	// other statements
+	Columns []*ResultColumn
+}
+
+// ResultColumn describes a column of the result set of SELECT statement.
+type ResultColumn struct {
+	// Name describes the alias of column, or the name of column if there is no alias, or the
+	// text of expression
+	Name string
+	// Table describes the table of column, it's nil if the column is not a column of table
+	Table *Table
+	// Column describes the column of table, it's nil if the column is an expression
+	Column *Column
+	// DataType describes the data type of column, it's nil if the column is an expression
+	// whose data type is unknown
+	DataType DataType
+}
+
+// Queries parses the sql content as DML statements and checks them against the catalog, the
+// name is used as the prefix of error messages, such as the file name of content. The unknown
+// tables and columns, the ambiguous columns of joined tables and the mismatched count of inserted
+// values are returned as ValidationErrors with the queries. The statements which are not SELECT,
+// INSERT, UPDATE or DELETE statements are ignored.
+func (p *Parser) Queries(name, content string, catalog *Catalog) (ret []*Query, err error) {
+	defer func() {
+		p := recover()
+		if p != nil {
+			switch e := p.(type) {
+			case error:
+				err = e
+			default:
+				err = fmt.Errorf("%+v", p)
+			}
+		}
+	}()
+
+	mysqlParser, visitor := p.newMysqlParser(name, content)
+	rootCtx, ok := mysqlParser.Root().(*gen.RootContext)
+	if !ok {
+		return nil, nil
+	}
+
+	sqlStatementsCtx, ok := rootCtx.SqlStatements().(*gen.SqlStatementsContext)
+	if !ok {
+		return nil, nil
+	}
+
+	var errs ValidationErrors
+	for _, e := range sqlStatementsCtx.AllSqlStatement() {
+		sqlStatementCtx, ok := e.(*gen.SqlStatementContext)
+		if !ok {
+			continue
+		}
+
+		dmlCtx, ok := sqlStatementCtx.DmlStatement().(*gen.DmlStatementContext)
+		if !ok {
+			continue
+		}
+
+		checker := &queryChecker{visitor: visitor, catalog: catalog}
+		query := checker.visitDmlStatement(dmlCtx)
+		if query == nil {
+			continue
+		}
+
+		query.Text = statementText(sqlStatementCtx.GetStart(), sqlStatementCtx.GetStart().GetStart(), sqlStatementCtx.GetStop().GetStop())
+		ret = append(ret, query)
+		errs = append(errs, checker.errs...)
+	}
+
+	if len(errs) > 0 {
+		for _, e := range errs {
+			e.File = p.prefix
+		}
+		return ret, errs
+	}
+
+	return ret, nil
+}
@@ -0,0 +1,142 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const querySchemaSql = "CREATE TABLE `user` (\n" +
+	"  `id` bigint NOT NULL AUTO_INCREMENT,\n" +
+	"  `name` varchar(64) NOT NULL,\n" +
+	"  `team_id` bigint,\n" +
+	"  PRIMARY KEY (`id`)\n" +
+	");\n" +
+	"CREATE TABLE `team` (\n" +
+	"  `id` bigint NOT NULL,\n" +
+	"  `name` varchar(32) NOT NULL,\n" +
+	"  `created_at` datetime(3)\n" +
+	");\n" +
+	"CREATE TABLE `shop`.`order` (\n" +
+	"  `id` bigint NOT NULL,\n" +
+	"  `user_id` bigint NOT NULL,\n" +
+	"  `amount` decimal(10,2)\n" +
+	");"
+
+func queryCatalog(t *testing.T) *Catalog {
+	c, err := NewCatalog(parseTables(t, querySchemaSql))
+	assert.Nil(t, err)
+	return c
+}
+
+func TestParser_Queries(t *testing.T) {
+	c := queryCatalog(t)
+	p := NewParser()
+
+	t.Run("select", func(t *testing.T) {
+		queries, err := p.Queries("query.sql", "SELECT u.`id`, u.name AS user_name, t.*, COUNT(*) AS total, 1 + 1\n"+
+			"FROM `user` u LEFT JOIN team t ON t.id = u.team_id\n"+
+			"WHERE u.id > 0 AND EXISTS (SELECT 1 FROM shop.`order` o WHERE o.user_id = u.id)\n"+
+			"GROUP BY u.id HAVING total > 1 ORDER BY user_name;", c)
+		assert.Nil(t, err)
+		assert.Len(t, queries, 1)
+		assert.Equal(t, "SELECT u.`id`, u.name AS user_name, t.*, COUNT(*) AS total, 1 + 1\n"+
+			"FROM `user` u LEFT JOIN team t ON t.id = u.team_id\n"+
+			"WHERE u.id > 0 AND EXISTS (SELECT 1 FROM shop.`order` o WHERE o.user_id = u.id)\n"+
+			"GROUP BY u.id HAVING total > 1 ORDER BY user_name", queries[0].Text)
+
+		columns := queries[0].Columns
+		var names []string
+		for _, e := range columns {
+			names = append(names, e.Name)
+		}
+		assert.Equal(t, []string{"id", "user_name", "id", "name", "created_at", "total", "1 + 1"}, names)
+		assert.Equal(t, c.Table("", "user"), columns[0].Table)
+		assert.Equal(t, c.Column("", "user", "id"), columns[0].Column)
+		assert.Equal(t, NewNormalDataType(BigInt, false, 0, 0, "", ""), columns[0].DataType)
+		assert.Equal(t, NewNormalDataType(VarChar, false, 64, 0, "", ""), columns[1].DataType)
+		assert.Equal(t, c.Table("", "team"), columns[2].Table)
+		assert.Equal(t, NewNormalDataType(DateTime, false, 3, 0, "", ""), columns[4].DataType)
+		assert.Nil(t, columns[5].DataType)
+		assert.Nil(t, columns[6].Column)
+	})
+
+	t.Run("derived", func(t *testing.T) {
+		queries, err := p.Queries("query.sql", "SELECT d.uid, d.amount FROM (SELECT user_id AS uid, amount FROM shop.`order`) AS d;", c)
+		assert.Nil(t, err)
+		assert.Equal(t, "uid", queries[0].Columns[0].Name)
+		assert.Equal(t, NewNormalDataType(BigInt, false, 0, 0, "", ""), queries[0].Columns[0].DataType)
+		assert.Equal(t, NewNormalDataType(Decimal, false, 10, 2, "", ""), queries[0].Columns[1].DataType)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		queries, err := p.Queries("query.sql", "SELECT id, u.nick FROM user u JOIN team t ON t.id = u.team_id WHERE foo = 1;\n"+
+			"SELECT * FROM missing m WHERE m.anything = 1;\n"+
+			"SELECT name FROM user JOIN team USING (id) ORDER BY bar;\n"+
+			"SELECT x.* FROM user;", c)
+		assert.Len(t, queries, 4)
+		errs, ok := err.(ValidationErrors)
+		assert.True(t, ok)
+		assert.Equal(t, "query.sql line 1:7 Column 'id' in field list is ambiguous\n"+
+			"query.sql line 1:11 Unknown column 'u.nick' in 'field list'\n"+
+			"query.sql line 1:68 Unknown column 'foo' in 'where clause'\n"+
+			"query.sql line 2:14 Table 'missing' doesn't exist\n"+
+			"query.sql line 3:7 Column 'name' in field list is ambiguous\n"+
+			"query.sql line 3:52 Unknown column 'bar' in 'order clause'\n"+
+			"query.sql line 4:7 Unknown table 'x'", errs.Error())
+		assert.Equal(t, "missing", errs[3].Table)
+	})
+
+	t.Run("insert", func(t *testing.T) {
+		_, err := p.Queries("query.sql", "INSERT INTO user (id, name) VALUES (1, 'a'), (2);\n"+
+			"INSERT INTO user (id, nick) VALUES (1, 'a') ON DUPLICATE KEY UPDATE name = VALUES(name), age = 1;\n"+
+			"INSERT INTO team SELECT id, name FROM user;\n"+
+			"INSERT INTO team SET id = 1, name = 'a', foo = 2;\n"+
+			"REPLACE INTO user VALUES (1);", c)
+		assert.Equal(t, "query.sql line 1:46 Column count doesn't match value count at row 2\n"+
+			"query.sql line 2:22 Unknown column 'nick' in 'field list'\n"+
+			"query.sql line 2:89 Unknown column 'age' in 'field list'\n"+
+			"query.sql line 3:17 Column count doesn't match value count at row 1\n"+
+			"query.sql line 4:41 Unknown column 'foo' in 'field list'", err.Error())
+	})
+
+	t.Run("updateAndDelete", func(t *testing.T) {
+		_, err := p.Queries("query.sql", "UPDATE user SET name = 'a' WHERE id = 1 ORDER BY id;\n"+
+			"UPDATE user u JOIN team t ON t.id = u.team_id SET u.name = t.name, t.nick = '' WHERE t.id = 1;\n"+
+			"DELETE FROM team WHERE owner_id = 1;\n"+
+			"DELETE u FROM user u JOIN team t ON t.id = u.team_id WHERE t.id = 1;\n"+
+			"DELETE x FROM user u WHERE u.id = 1;", c)
+		assert.Equal(t, "query.sql line 2:67 Unknown column 't.nick' in 'field list'\n"+
+			"query.sql line 3:23 Unknown column 'owner_id' in 'where clause'\n"+
+			"query.sql line 5:7 Unknown table 'x' in MULTI DELETE", err.Error())
+	})
+
+	t.Run("correlated", func(t *testing.T) {
+		_, err := p.Queries("query.sql", "SELECT name FROM team t WHERE id IN (SELECT team_id FROM user WHERE user.name = t.name);\n"+
+			"SELECT id FROM user WHERE team_id = 1 UNION SELECT id FROM team ORDER BY id;", c)
+		assert.Nil(t, err)
+	})
+
+	t.Run("syntaxError", func(t *testing.T) {
+		_, err := p.Queries("query.sql", "SELECT FROM;", c)
+		assert.Error(t, err)
+		_, ok := err.(ValidationErrors)
+		assert.False(t, ok)
+	})
+}
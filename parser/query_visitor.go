@@ -0,0 +1,657 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package parser
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"github.com/zeromicro/ddl-parser/gen"
+)
+
+// the clauses in the error messages of mysql
+const (
+	clauseFieldList = "field list"
+	clauseFrom      = "from clause"
+	clauseOn        = "on clause"
+	clauseWhere     = "where clause"
+	clauseGroupBy   = "group statement"
+	clauseHaving    = "having clause"
+	clauseOrderBy   = "order clause"
+)
+
+// queryChecker checks the column references of a DML statement against the catalog.
+type queryChecker struct {
+	visitor *visitor
+	catalog *Catalog
+	errs    ValidationErrors
+}
+
+// querySource describes a table or a derived table in the FROM clause.
+type querySource struct {
+	// name describes the alias or the name of table which qualifies the columns
+	name   string
+	schema string
+	// alias describes whether the source is aliased, the aliased source can not be qualified
+	// by schema
+	alias bool
+	table *Table
+	// columns describes the columns of derived table
+	columns []*ResultColumn
+	// unknown describes whether the columns of source are unknown, such as the table which
+	// does not exist, the references to its columns are not checked
+	unknown bool
+}
+
+// queryScope describes the sources whose columns can be referenced by a query block, the
+// sources of the outer query blocks can be referenced by the correlated subqueries.
+type queryScope struct {
+	parent  *queryScope
+	sources []*querySource
+	// using describes the columns joined by USING or NATURAL JOIN, they are not ambiguous
+	using map[string]bool
+	// aliases describes the select elements which can be referenced by GROUP BY, HAVING and
+	// ORDER BY
+	aliases []*ResultColumn
+}
+
+// querySpecification describes MySqlParser#querySpecification and
+// MySqlParser#querySpecificationNointo.
+type querySpecification interface {
+	SelectElements() gen.ISelectElementsContext
+	FromClause() gen.IFromClauseContext
+	GroupByClause() gen.IGroupByClauseContext
+	HavingClause() gen.IHavingClauseContext
+	OrderByClause() gen.IOrderByClauseContext
+}
+
+func newQueryScope(parent *queryScope) *queryScope {
+	return &queryScope{parent: parent, using: make(map[string]bool)}
+}
+
+func (s *querySource) column(name string) *ResultColumn {
+	if s.table != nil {
+		column := s.table.Column(name)
+		if column == nil {
+			return nil
+		}
+
+		return &ResultColumn{Name: column.Name, Table: s.table, Column: column, DataType: column.DataType}
+	}
+
+	for _, e := range s.columns {
+		if strings.EqualFold(e.Name, name) {
+			return e
+		}
+	}
+
+	return nil
+}
+
+func (s *querySource) allColumns() []*ResultColumn {
+	if s.table == nil {
+		return s.columns
+	}
+
+	var ret []*ResultColumn
+	for _, e := range s.table.Columns {
+		ret = append(ret, &ResultColumn{Name: e.Name, Table: s.table, Column: e, DataType: e.DataType})
+	}
+
+	return ret
+}
+
+func (c *queryChecker) report(ctx antlr.ParserRuleContext, table, format string, args ...interface{}) {
+	c.errs = append(c.errs, &ValidationError{
+		Position: Position{Line: ctx.GetStart().GetLine(), Column: ctx.GetStart().GetColumn()},
+		Table:    table,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+// visitDmlStatement visits a parse tree produced by MySqlParser#dmlStatement, it returns nil
+// if the statement is not a SELECT, INSERT, UPDATE or DELETE statement.
+func (c *queryChecker) visitDmlStatement(ctx *gen.DmlStatementContext) *Query {
+	c.visitor.trace("VisitDmlStatement")
+	switch {
+	case ctx.SelectStatement() != nil:
+		columns, _ := c.visitSelectStatement(nil, ctx.SelectStatement())
+		return &Query{Columns: columns}
+	case ctx.InsertStatement() != nil:
+		c.visitInsertStatement(ctx.InsertStatement().(*gen.InsertStatementContext))
+	case ctx.UpdateStatement() != nil:
+		c.visitUpdateStatement(ctx.UpdateStatement().(*gen.UpdateStatementContext))
+	case ctx.DeleteStatement() != nil:
+		c.visitDeleteStatement(ctx.DeleteStatement().(*gen.DeleteStatementContext))
+	default:
+		return nil
+	}
+
+	return &Query{}
+}
+
+// visitSelectStatement visits a parse tree produced by MySqlParser#selectStatement, the parent
+// is the scope of the outer query block of subquery, it returns the result columns and whether
+// the result columns are complete, the columns of unknown tables are not included.
+func (c *queryChecker) visitSelectStatement(parent *queryScope, ctx gen.ISelectStatementContext) ([]*ResultColumn, bool) {
+	switch tx := ctx.(type) {
+	case *gen.SimpleSelectContext:
+		return c.visitQuerySpecification(parent, tx.QuerySpecification().(*gen.QuerySpecificationContext))
+	case *gen.ParenthesisSelectContext:
+		return c.visitQueryExpression(parent, tx.QueryExpression())
+	case *gen.UnionSelectContext:
+		columns, complete := c.visitQuerySpecification(parent, tx.QuerySpecificationNointo().(*gen.QuerySpecificationNointoContext))
+		for _, e := range tx.AllUnionStatement() {
+			unionCtx := e.(*gen.UnionStatementContext)
+			if unionCtx.QuerySpecificationNointo() != nil {
+				c.visitQuerySpecification(parent, unionCtx.QuerySpecificationNointo().(*gen.QuerySpecificationNointoContext))
+			} else {
+				c.visitQueryExpressionNointo(parent, unionCtx.QueryExpressionNointo())
+			}
+		}
+		if tx.QuerySpecification() != nil {
+			c.visitQuerySpecification(parent, tx.QuerySpecification().(*gen.QuerySpecificationContext))
+		}
+		if tx.QueryExpression() != nil {
+			c.visitQueryExpression(parent, tx.QueryExpression())
+		}
+		c.visitUnionOrderBy(parent, columns, complete, tx.OrderByClause())
+		return columns, complete
+	case *gen.UnionParenthesisSelectContext:
+		columns, complete := c.visitQueryExpressionNointo(parent, tx.QueryExpressionNointo())
+		for _, e := range tx.AllUnionParenthesis() {
+			c.visitQueryExpressionNointo(parent, e.(*gen.UnionParenthesisContext).QueryExpressionNointo())
+		}
+		if tx.QueryExpression() != nil {
+			c.visitQueryExpression(parent, tx.QueryExpression())
+		}
+		c.visitUnionOrderBy(parent, columns, complete, tx.OrderByClause())
+		return columns, complete
+	}
+
+	return nil, false
+}
+
+func (c *queryChecker) visitQueryExpression(parent *queryScope, ctx gen.IQueryExpressionContext) ([]*ResultColumn, bool) {
+	queryExpressionCtx := ctx.(*gen.QueryExpressionContext)
+	if queryExpressionCtx.QuerySpecification() != nil {
+		return c.visitQuerySpecification(parent, queryExpressionCtx.QuerySpecification().(*gen.QuerySpecificationContext))
+	}
+
+	return c.visitQueryExpression(parent, queryExpressionCtx.QueryExpression())
+}
+
+func (c *queryChecker) visitQueryExpressionNointo(parent *queryScope, ctx gen.IQueryExpressionNointoContext) ([]*ResultColumn, bool) {
+	queryExpressionCtx := ctx.(*gen.QueryExpressionNointoContext)
+	if queryExpressionCtx.QuerySpecificationNointo() != nil {
+		return c.visitQuerySpecification(parent, queryExpressionCtx.QuerySpecificationNointo().(*gen.QuerySpecificationNointoContext))
+	}
+
+	return c.visitQueryExpressionNointo(parent, queryExpressionCtx.QueryExpressionNointo())
+}
+
+// visitUnionOrderBy checks the ORDER BY of union, it references the result columns.
+func (c *queryChecker) visitUnionOrderBy(parent *queryScope, columns []*ResultColumn, complete bool, ctx gen.IOrderByClauseContext) {
+	if ctx == nil {
+		return
+	}
+
+	scope := newQueryScope(parent)
+	scope.sources = []*querySource{{alias: true, columns: columns, unknown: !complete}}
+	c.walk(scope, ctx, clauseOrderBy)
+}
+
+// visitQuerySpecification checks a query block, the FROM clause is visited first since the
+// other clauses reference its tables.
+func (c *queryChecker) visitQuerySpecification(parent *queryScope, ctx querySpecification) ([]*ResultColumn, bool) {
+	scope := newQueryScope(parent)
+	fromCtx, hasFrom := ctx.FromClause().(*gen.FromClauseContext)
+	if hasFrom {
+		c.visitTableSources(scope, fromCtx.TableSources())
+	}
+
+	columns, complete := c.visitSelectElements(scope, ctx.SelectElements().(*gen.SelectElementsContext))
+	if hasFrom && fromCtx.GetWhereExpr() != nil {
+		c.walk(scope, fromCtx.GetWhereExpr(), clauseWhere)
+	}
+
+	scope.aliases = columns
+	if ctx.GroupByClause() != nil {
+		c.walk(scope, ctx.GroupByClause(), clauseGroupBy)
+	}
+	if ctx.HavingClause() != nil {
+		c.walk(scope, ctx.HavingClause(), clauseHaving)
+	}
+	if ctx.OrderByClause() != nil {
+		c.walk(scope, ctx.OrderByClause(), clauseOrderBy)
+	}
+
+	return columns, complete
+}
+
+// visitSelectElements visits a parse tree produced by MySqlParser#selectElements, the stars
+// are expanded to the columns of tables.
+func (c *queryChecker) visitSelectElements(scope *queryScope, ctx *gen.SelectElementsContext) ([]*ResultColumn, bool) {
+	var ret []*ResultColumn
+	complete := true
+	if ctx.STAR() != nil {
+		for _, e := range scope.sources {
+			if e.unknown {
+				complete = false
+				continue
+			}
+
+			ret = append(ret, e.allColumns()...)
+		}
+	}
+
+	for _, e := range ctx.AllSelectElement() {
+		switch tx := e.(type) {
+		case *gen.SelectStarElementContext:
+			schema, table := c.visitor.visitFullId(tx.FullId())
+			sources := c.findSources(scope, schema, table)
+			if len(sources) == 0 {
+				c.report(tx, table, "Unknown table '%s'", qualifiedName(schema, table))
+			}
+			for _, source := range sources {
+				if source.unknown {
+					complete = false
+					continue
+				}
+
+				ret = append(ret, source.allColumns()...)
+			}
+		case *gen.SelectColumnElementContext:
+			column := &ResultColumn{}
+			if resolved := c.resolveColumn(scope, tx.FullColumnName().(*gen.FullColumnNameContext), clauseFieldList); resolved != nil {
+				*column = *resolved
+			}
+			if names := c.columnName(tx.FullColumnName().(*gen.FullColumnNameContext)); len(column.Name) == 0 {
+				column.Name = names[len(names)-1]
+			}
+			if tx.Uid() != nil {
+				column.Name = c.visitor.visitUid(tx.Uid())
+			}
+			ret = append(ret, column)
+		case *gen.SelectFunctionElementContext:
+			c.walk(scope, tx.FunctionCall(), clauseFieldList)
+			ret = append(ret, &ResultColumn{Name: c.elementName(tx.FunctionCall(), tx.Uid())})
+		case *gen.SelectExpressionElementContext:
+			c.walk(scope, tx.Expression(), clauseFieldList)
+			ret = append(ret, &ResultColumn{Name: c.elementName(tx.Expression(), tx.Uid())})
+		}
+	}
+
+	return ret, complete
+}
+
+// elementName returns the alias of select element, or the text of expression if there is
+// no alias.
+func (c *queryChecker) elementName(ctx antlr.ParserRuleContext, alias gen.IUidContext) string {
+	if alias != nil {
+		return c.visitor.visitUid(alias)
+	}
+
+	return statementText(ctx.GetStart(), ctx.GetStart().GetStart(), ctx.GetStop().GetStop())
+}
+
+// visitTableSources visits a parse tree produced by MySqlParser#tableSources, the tables are
+// added to the scope.
+func (c *queryChecker) visitTableSources(scope *queryScope, ctx gen.ITableSourcesContext) {
+	for _, e := range ctx.(*gen.TableSourcesContext).AllTableSource() {
+		switch tx := e.(type) {
+		case *gen.TableSourceBaseContext:
+			c.visitTableSourceItem(scope, tx.TableSourceItem())
+			c.visitJoinParts(scope, tx.AllJoinPart())
+		case *gen.TableSourceNestedContext:
+			c.visitTableSourceItem(scope, tx.TableSourceItem())
+			c.visitJoinParts(scope, tx.AllJoinPart())
+		}
+	}
+}
+
+func (c *queryChecker) visitTableSourceItem(scope *queryScope, ctx gen.ITableSourceItemContext) {
+	switch tx := ctx.(type) {
+	case *gen.AtomTableItemContext:
+		scope.sources = append(scope.sources, c.tableSource(tx.TableName(), tx.GetAlias()))
+	case *gen.SubqueryTableItemContext:
+		selectCtx := tx.SelectStatement()
+		if tx.GetParenthesisSubquery() != nil {
+			selectCtx = tx.GetParenthesisSubquery()
+		}
+
+		// the derived tables can not reference the tables of the outer query blocks
+		columns, complete := c.visitSelectStatement(nil, selectCtx)
+		scope.sources = append(scope.sources, &querySource{
+			name:    c.visitor.visitUid(tx.GetAlias()),
+			alias:   true,
+			columns: columns,
+			unknown: !complete,
+		})
+	case *gen.TableSourcesItemContext:
+		c.visitTableSources(scope, tx.TableSources())
+	}
+}
+
+func (c *queryChecker) visitJoinParts(scope *queryScope, list []gen.IJoinPartContext) {
+	for _, e := range list {
+		before := len(scope.sources)
+		switch tx := e.(type) {
+		case *gen.InnerJoinContext:
+			c.visitTableSourceItem(scope, tx.TableSourceItem())
+			c.visitJoinCondition(scope, before, tx.Expression(), tx.UidList())
+		case *gen.OuterJoinContext:
+			c.visitTableSourceItem(scope, tx.TableSourceItem())
+			c.visitJoinCondition(scope, before, tx.Expression(), tx.UidList())
+		case *gen.StraightJoinContext:
+			c.visitTableSourceItem(scope, tx.TableSourceItem())
+			c.visitJoinCondition(scope, before, tx.Expression(), nil)
+		case *gen.NaturalJoinContext:
+			c.visitTableSourceItem(scope, tx.TableSourceItem())
+			for _, source := range scope.sources[before:] {
+				for _, column := range source.allColumns() {
+					if len(c.findColumns(scope.sources[:before], column.Name)) > 0 {
+						scope.using[strings.ToLower(column.Name)] = true
+					}
+				}
+			}
+		}
+	}
+}
+
+// visitJoinCondition checks the ON expression or the USING columns of the tables joined after
+// the index before, the USING columns must exist in both sides of join.
+func (c *queryChecker) visitJoinCondition(scope *queryScope, before int, on gen.IExpressionContext, using gen.IUidListContext) {
+	if on != nil {
+		c.walk(scope, on, clauseOn)
+	}
+	if using == nil {
+		return
+	}
+
+	for _, e := range using.(*gen.UidListContext).AllUid() {
+		name := c.visitor.visitUid(e)
+		scope.using[strings.ToLower(name)] = true
+		if !c.hasColumn(scope.sources[:before], name) || !c.hasColumn(scope.sources[before:], name) {
+			c.report(e, "", "Unknown column '%s' in '%s'", name, clauseFrom)
+		}
+	}
+}
+
+// tableSource returns the source of table, the unknown table is reported.
+func (c *queryChecker) tableSource(ctx gen.ITableNameContext, alias gen.IUidContext) *querySource {
+	schema, name := c.visitor.visitTableName(ctx)
+	ret := &querySource{name: name, schema: schema, table: c.catalog.Table(schema, name)}
+	if len(schema) == 0 {
+		ret.schema = c.catalog.defaultSchema
+	}
+	if ret.table == nil {
+		ret.unknown = true
+		c.report(ctx, name, "Table '%s' doesn't exist", qualifiedName(ret.schema, name))
+	}
+	if alias != nil {
+		ret.name = c.visitor.visitUid(alias)
+		ret.alias = true
+	}
+
+	return ret
+}
+
+// findSources returns the sources of scope which match the qualifier of columns.
+func (c *queryChecker) findSources(scope *queryScope, schema, table string) []*querySource {
+	var ret []*querySource
+	for _, e := range scope.sources {
+		if len(schema) > 0 && (e.alias || c.catalog.key(schema, "") != c.catalog.key(e.schema, "")) {
+			continue
+		}
+
+		if c.catalog.key("", e.name) == c.catalog.key("", table) {
+			ret = append(ret, e)
+		}
+	}
+
+	return ret
+}
+
+func (c *queryChecker) findColumns(sources []*querySource, name string) []*ResultColumn {
+	var ret []*ResultColumn
+	for _, e := range sources {
+		if e.unknown {
+			continue
+		}
+
+		if column := e.column(name); column != nil {
+			ret = append(ret, column)
+		}
+	}
+
+	return ret
+}
+
+// hasColumn returns true if the column is found in the sources or the columns of sources are
+// unknown.
+func (c *queryChecker) hasColumn(sources []*querySource, name string) bool {
+	for _, e := range sources {
+		if e.unknown || e.column(name) != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// resolveColumn resolves the column reference from the innermost scope to the outermost scope,
+// it reports the unknown column and the ambiguous column, and returns nil if the column can not
+// be resolved.
+func (c *queryChecker) resolveColumn(scope *queryScope, ctx *gen.FullColumnNameContext, clause string) *ResultColumn {
+	names := c.columnName(ctx)
+	name := names[len(names)-1]
+	var schema, table string
+	switch len(names) {
+	case 2:
+		table = names[0]
+	case 3:
+		schema, table = names[0], names[1]
+	}
+
+	for s := scope; s != nil; s = s.parent {
+		sources := s.sources
+		if len(table) > 0 {
+			sources = c.findSources(s, schema, table)
+			if len(sources) == 0 {
+				continue
+			}
+		}
+
+		columns := c.findColumns(sources, name)
+		switch {
+		case len(columns) == 1:
+			return columns[0]
+		case len(columns) > 1 && len(table) == 0 && s.using[strings.ToLower(name)]:
+			return columns[0]
+		case len(columns) > 1:
+			c.report(ctx, table, "Column '%s' in %s is ambiguous", name, clause)
+			return nil
+		case c.hasColumn(sources, name):
+			// the columns of sources are unknown
+			return nil
+		}
+
+		if s == scope && len(table) == 0 && clause != clauseFieldList && clause != clauseWhere && clause != clauseOn {
+			for _, e := range s.aliases {
+				if strings.EqualFold(e.Name, name) {
+					return e
+				}
+			}
+		}
+		if len(table) > 0 {
+			// the qualified column is resolved by the innermost table which matches the qualifier
+			break
+		}
+	}
+
+	c.report(ctx, table, "Unknown column '%s' in '%s'", strings.Join(names, "."), clause)
+	return nil
+}
+
+// columnName returns the parts of MySqlParser#fullColumnName, such as the schema, the table
+// and the name of column.
+func (c *queryChecker) columnName(ctx *gen.FullColumnNameContext) []string {
+	var ret []string
+	for _, e := range ctx.GetChildren() {
+		switch tx := e.(type) {
+		case *gen.UidContext:
+			ret = append(ret, c.visitor.visitUid(tx))
+		case *gen.DottedIdContext:
+			ret = append(ret, c.visitor.trimIdentifier(strings.TrimPrefix(tx.GetText(), ".")))
+		case antlr.TerminalNode:
+			ret = append(ret, c.visitor.trimIdentifier(tx.GetText()))
+		}
+	}
+
+	return ret
+}
+
+// walk checks the column references and the subqueries in the parse tree.
+func (c *queryChecker) walk(scope *queryScope, tree antlr.Tree, clause string) {
+	switch tx := tree.(type) {
+	case *gen.FullColumnNameContext:
+		c.resolveColumn(scope, tx, clause)
+		return
+	case gen.ISelectStatementContext:
+		c.visitSelectStatement(scope, tx)
+		return
+	}
+
+	for _, e := range tree.GetChildren() {
+		c.walk(scope, e, clause)
+	}
+}
+
+// visitInsertStatement visits a parse tree produced by MySqlParser#insertStatement, the count
+// of values must match the count of columns.
+func (c *queryChecker) visitInsertStatement(ctx *gen.InsertStatementContext) {
+	source := c.tableSource(ctx.TableName(), nil)
+	scope := newQueryScope(nil)
+	scope.sources = []*querySource{source}
+	count := -1
+	if ctx.GetColumns() != nil {
+		uids := ctx.GetColumns().(*gen.UidListContext).AllUid()
+		count = len(uids)
+		for _, e := range uids {
+			if name := c.visitor.visitUid(e); !c.hasColumn(scope.sources, name) {
+				c.report(e, source.name, "Unknown column '%s' in '%s'", name, clauseFieldList)
+			}
+		}
+	} else if source.table != nil {
+		count = len(source.table.Columns)
+	}
+
+	for _, e := range ctx.AllUpdatedElement() {
+		c.visitUpdatedElement(scope, e.(*gen.UpdatedElementContext))
+	}
+
+	valueCtx, ok := ctx.InsertStatementValue().(*gen.InsertStatementValueContext)
+	if !ok {
+		return
+	}
+
+	if valueCtx.SelectStatement() != nil {
+		columns, complete := c.visitSelectStatement(nil, valueCtx.SelectStatement())
+		if complete && count >= 0 && len(columns) != count {
+			c.report(valueCtx, source.name, "Column count doesn't match value count at row 1")
+		}
+		return
+	}
+
+	for i, e := range valueCtx.AllExpressionsWithDefaults() {
+		c.walk(scope, e, clauseFieldList)
+		if n := len(e.(*gen.ExpressionsWithDefaultsContext).AllExpressionOrDefault()); count >= 0 && n != count {
+			c.report(e, source.name, "Column count doesn't match value count at row %d", i+1)
+		}
+	}
+}
+
+// visitUpdatedElement visits a parse tree produced by MySqlParser#updatedElement.
+func (c *queryChecker) visitUpdatedElement(scope *queryScope, ctx *gen.UpdatedElementContext) {
+	c.resolveColumn(scope, ctx.FullColumnName().(*gen.FullColumnNameContext), clauseFieldList)
+	if ctx.Expression() != nil {
+		c.walk(scope, ctx.Expression(), clauseFieldList)
+	}
+}
+
+// visitUpdateStatement visits a parse tree produced by MySqlParser#updateStatement.
+func (c *queryChecker) visitUpdateStatement(ctx *gen.UpdateStatementContext) {
+	scope := newQueryScope(nil)
+	switch tx := ctx.GetChild(0).(type) {
+	case *gen.SingleUpdateStatementContext:
+		scope.sources = append(scope.sources, c.tableSource(tx.TableName(), tx.Uid()))
+		for _, e := range tx.AllUpdatedElement() {
+			c.visitUpdatedElement(scope, e.(*gen.UpdatedElementContext))
+		}
+		if tx.Expression() != nil {
+			c.walk(scope, tx.Expression(), clauseWhere)
+		}
+		if tx.OrderByClause() != nil {
+			c.walk(scope, tx.OrderByClause(), clauseOrderBy)
+		}
+	case *gen.MultipleUpdateStatementContext:
+		c.visitTableSources(scope, tx.TableSources())
+		for _, e := range tx.AllUpdatedElement() {
+			c.visitUpdatedElement(scope, e.(*gen.UpdatedElementContext))
+		}
+		if tx.Expression() != nil {
+			c.walk(scope, tx.Expression(), clauseWhere)
+		}
+	}
+}
+
+// visitDeleteStatement visits a parse tree produced by MySqlParser#deleteStatement, the tables
+// to delete from must be the tables of multiple-table syntax.
+func (c *queryChecker) visitDeleteStatement(ctx *gen.DeleteStatementContext) {
+	scope := newQueryScope(nil)
+	switch tx := ctx.GetChild(0).(type) {
+	case *gen.SingleDeleteStatementContext:
+		scope.sources = append(scope.sources, c.tableSource(tx.TableName(), nil))
+		if tx.Expression() != nil {
+			c.walk(scope, tx.Expression(), clauseWhere)
+		}
+		if tx.OrderByClause() != nil {
+			c.walk(scope, tx.OrderByClause(), clauseOrderBy)
+		}
+	case *gen.MultipleDeleteStatementContext:
+		c.visitTableSources(scope, tx.TableSources())
+		for _, e := range tx.AllTableName() {
+			schema, name := c.visitor.visitTableName(e)
+			if len(c.findSources(scope, schema, name)) == 0 {
+				c.report(e, name, "Unknown table '%s' in MULTI DELETE", name)
+			}
+		}
+		if tx.Expression() != nil {
+			c.walk(scope, tx.Expression(), clauseWhere)
+		}
+	}
+}
+
+func qualifiedName(schema, name string) string {
+	if len(schema) == 0 {
+		return name
+	}
+
+	return schema + "." + name
+}
@@ -61,6 +61,7 @@ func TestVisitor_VisitSqlStatements(t *testing.T) {
 						ColumnConstraint: &ColumnConstraint{
 							NotNull:         true,
 							HasDefaultValue: true,
+							DefaultValue:    "0",
 							AutoIncrement:   false,
 							Primary:         true,
 							Comment:         "主键ID",
@@ -97,40 +98,45 @@ func TestVisitor_VisitSqlStatements(t *testing.T) {
 			Columns: []*Column{
 				{
 					Name:     "id",
-					DataType: &NormalDataType{tp: BigInt},
+					DataType: &NormalDataType{tp: BigInt, length: 11},
 					Constraint: &ColumnConstraint{
 						NotNull:         true,
 						HasDefaultValue: true,
+						DefaultValue:    "0",
 						Primary:         true,
 						Comment:         "主键ID",
 					},
 				},
 			},
+			Comments: []string{"-- user"},
 		}, userTable)
 		assert.Equal(t, &Table{
 			Name: "student",
 			Columns: []*Column{
 				{
 					Name:     "id",
-					DataType: &NormalDataType{tp: BigInt},
+					DataType: &NormalDataType{tp: BigInt, length: 11},
 					Constraint: &ColumnConstraint{
 						NotNull:         true,
 						HasDefaultValue: true,
+						DefaultValue:    "0",
 						Primary:         true,
 						Comment:         "主键ID",
 					},
 				},
 				{
 					Name:     "name",
-					DataType: &NormalDataType{tp: VarChar},
+					DataType: &NormalDataType{tp: VarChar, length: 10},
 					Constraint: &ColumnConstraint{
 						NotNull:         true,
 						HasDefaultValue: true,
+						DefaultValue:    "''",
 						Key:             true,
 						Comment:         "学生姓名",
 					},
 				},
 			},
+			Comments: []string{"-- student"},
 		}, studentTable)
 	})
 
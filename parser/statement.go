@@ -0,0 +1,209 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package parser
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"github.com/zeromicro/ddl-parser/gen"
+)
+
+// Statement describes a sql statement of the content, the statements can be used to rewrite
+// the content without losing the statements which are not CREATE TABLE statements.
+type Statement struct {
+	// Text describes the original text of statement, the leading comments and the semicolon
+	// are included
+	Text string
+	// Table describes the table created by the statement, it's nil if the statement is not
+	// a CREATE TABLE statement with create definitions
+	Table *Table
+	// Alter describes the ALTER TABLE statement, it's nil if the statement is not an ALTER
+	// TABLE statement
+	Alter *AlterTable
+	// Positions describes the positions of table and its definitions or the positions of alter
+	// table and its specifications in the content, it's nil if the statement has neither table
+	// nor alter table
+	Positions *Positions
+	// Unsupported describes the clauses of CREATE TABLE statement which are not modeled by Table,
+	// such as TEMPORARY and ZEROFILL, they are lost if the statement is printed from Table. It's
+	// empty if Table models the whole statement or the statement is not a CREATE TABLE statement
+	Unsupported []*UnsupportedClause
+}
+
+// UnsupportedClause describes a clause of statement which is not modeled by the parsed nodes.
+type UnsupportedClause struct {
+	Position Position
+	// Clause describes the upper case keywords of clause, such as TEMPORARY, IF NOT EXISTS
+	Clause string
+}
+
+// String returns the clause with its position, such as 1:7 TEMPORARY.
+func (c *UnsupportedClause) String() string {
+	return c.Position.String() + " " + c.Clause
+}
+
+// Statements parses the sql content as statements, the name is used as the prefix of error
+// messages, such as the file name of content. The comments after the last statement are
+// returned as a Statement without table. If the Parser validates, the statements are returned
+// with the ValidationErrors of their tables.
+func (p *Parser) Statements(name, content string) (ret []*Statement, err error) {
+	defer func() {
+		p := recover()
+		if p != nil {
+			switch e := p.(type) {
+			case error:
+				err = e
+			default:
+				err = fmt.Errorf("%+v", p)
+			}
+		}
+	}()
+
+	mysqlParser, visitor := p.newMysqlParser(name, content)
+	rootCtx, ok := mysqlParser.Root().(*gen.RootContext)
+	if !ok {
+		return nil, nil
+	}
+
+	if sqlStatementsCtx, ok := rootCtx.SqlStatements().(*gen.SqlStatementsContext); ok {
+		ret = visitor.visitStatements(sqlStatementsCtx)
+	}
+
+	eof := rootCtx.EOF().GetSymbol()
+	if comments := visitor.hiddenTokensToLeft(eof.GetTokenIndex()); len(comments) > 0 {
+		if text := statementText(eof, comments[0].GetStart(), eof.GetStart()-1); len(text) > 0 {
+			ret = append(ret, &Statement{Text: text})
+		}
+	}
+
+	if p.validate {
+		var errs ValidationErrors
+		for _, e := range ret {
+			if e.Table != nil {
+				errs = append(errs, p.validateTable(e.Table, e.Positions)...)
+			}
+		}
+		if len(errs) > 0 {
+			return ret, errs
+		}
+	}
+
+	return ret, nil
+}
+
+// visitStatements visits the statements of MySqlParser#sqlStatements, the empty statements
+// are included.
+func (v *visitor) visitStatements(ctx *gen.SqlStatementsContext) []*Statement {
+	var ret []*Statement
+	var start int
+	var last *Statement
+	for _, e := range ctx.GetChildren() {
+		switch tx := e.(type) {
+		case *gen.SqlStatementContext:
+			start = v.statementStart(tx)
+			v.positions = make(map[interface{}]Position)
+			v.unsupported = nil
+			last = &Statement{
+				Text:  statementText(tx.GetStart(), start, tx.GetStop().GetStop()),
+				Table: v.visitStatementTable(tx),
+				Alter: v.visitStatementAlter(tx),
+			}
+			if last.Table != nil || last.Alter != nil {
+				last.Positions = &Positions{nodes: v.positions}
+			}
+			if last.Table != nil {
+				last.Unsupported = v.unsupported
+			}
+			v.positions = nil
+			ret = append(ret, last)
+		case *gen.EmptyStatementContext:
+			// the semicolon after the statement may be parsed as an empty statement
+			if last != nil && v.statementStart(tx) == tx.GetStart().GetStart() {
+				if !strings.HasSuffix(last.Text, ";") {
+					last.Text = statementText(tx.GetStart(), start, tx.GetStop().GetStop())
+				}
+				continue
+			}
+
+			start = v.statementStart(tx)
+			last = &Statement{Text: statementText(tx.GetStart(), start, tx.GetStop().GetStop())}
+			ret = append(ret, last)
+		case antlr.TerminalNode:
+			// the semicolon and the double minus after the statement
+			if last != nil {
+				last.Text = statementText(tx.GetSymbol(), start, tx.GetSymbol().GetStop())
+			}
+		}
+	}
+
+	return ret
+}
+
+func (v *visitor) visitStatementTable(ctx *gen.SqlStatementContext) *Table {
+	ddlCtx, ok := ctx.DdlStatement().(*gen.DdlStatementContext)
+	if !ok {
+		return nil
+	}
+
+	createTableCtx, ok := ddlCtx.CreateTable().(*gen.ColumnCreateTableContext)
+	if !ok {
+		return nil
+	}
+
+	return v.convert(v.visitColumnCreateTable(createTableCtx))
+}
+
+func (v *visitor) visitStatementAlter(ctx *gen.SqlStatementContext) *AlterTable {
+	ddlCtx, ok := ctx.DdlStatement().(*gen.DdlStatementContext)
+	if !ok {
+		return nil
+	}
+
+	alterTableCtx, ok := ddlCtx.AlterTable().(*gen.AlterTableContext)
+	if !ok {
+		return nil
+	}
+
+	alter := v.visitAlterTable(alterTableCtx)
+	if v.normalize {
+		for _, e := range alter.Specifications {
+			if e.Column != nil {
+				(&Table{Columns: []*Column{e.Column}}).Normalize()
+			}
+		}
+	}
+
+	return alter
+}
+
+// statementStart returns the start index of the leading comments of statement in the char
+// stream, it returns the start index of statement if there is no leading comment.
+func (v *visitor) statementStart(ctx antlr.ParserRuleContext) int {
+	for _, e := range v.hiddenTokensToLeft(ctx.GetStart().GetTokenIndex()) {
+		if isComment(e) {
+			return e.GetStart()
+		}
+	}
+
+	return ctx.GetStart().GetStart()
+}
+
+func statementText(token antlr.Token, start, stop int) string {
+	return strings.TrimSpace(token.GetInputStream().GetText(start, stop))
+}
@@ -0,0 +1,72 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParser_Statements(t *testing.T) {
+	statements, err := NewParser().Statements("schema.sql", "SET NAMES utf8mb4;\n"+
+		"-- user table\n"+
+		"CREATE TABLE `user` (\n"+
+		"  `id` bigint NOT NULL, -- primary key\n"+
+		"  # the name of user\n"+
+		"  `name` varchar(64) /* nick name */ NOT NULL,\n"+
+		"  -- indexes\n"+
+		"  PRIMARY KEY (`id`)\n"+
+		");\n"+
+		"DROP TABLE `log`\n"+
+		";\n"+
+		"CREATE TABLE `log` LIKE `user`;\n"+
+		"/* the end */")
+	assert.Nil(t, err)
+	assert.Len(t, statements, 5)
+
+	assert.Equal(t, "SET NAMES utf8mb4;", statements[0].Text)
+	assert.Nil(t, statements[0].Table)
+
+	table := statements[1].Table
+	assert.Equal(t, "-- user table\nCREATE TABLE", statements[1].Text[:26])
+	assert.Equal(t, []string{"-- user table", "-- indexes"}, table.Comments)
+	assert.Equal(t, []string{"-- primary key"}, table.Columns[0].Comments)
+	assert.Equal(t, []string{"# the name of user", "/* nick name */"}, table.Columns[1].Comments)
+
+	positions := statements[1].Positions
+	position, ok := positions.Of(table)
+	assert.True(t, ok)
+	assert.Equal(t, Position{Line: 3, Column: 0}, position)
+	position, _ = positions.Of(table.Columns[1])
+	assert.Equal(t, "6:2", position.String())
+	position, _ = positions.Of(table.Constraints[0])
+	assert.Equal(t, Position{Line: 8, Column: 2}, position)
+	position, _ = positions.Of(table.Indexes[0])
+	assert.Equal(t, Position{Line: 8, Column: 2}, position)
+	_, ok = positions.Of(&Column{})
+	assert.False(t, ok)
+	assert.Nil(t, statements[0].Positions)
+
+	assert.Equal(t, "DROP TABLE `log`\n;", statements[2].Text)
+	assert.Equal(t, "CREATE TABLE `log` LIKE `user`;", statements[3].Text)
+	assert.Nil(t, statements[3].Table)
+	assert.Equal(t, "/* the end */", statements[4].Text)
+
+	_, err = NewParser().Statements("schema.sql", "CREATE TABLE")
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,208 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package parser
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Column returns the column which matches the name, column names are case-insensitive
+// in mysql, it returns nil if the column does not exist.
+func (t *Table) Column(name string) *Column {
+	for _, e := range t.Columns {
+		if strings.EqualFold(e.Name, name) {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// PrimaryKey returns the name of columns of primary key, the primary key can be
+// declared by column constraint or table constraint.
+func (t *Table) PrimaryKey() []string {
+	for _, e := range t.Constraints {
+		if len(e.ColumnPrimaryKey) > 0 {
+			return e.ColumnPrimaryKey
+		}
+	}
+
+	for _, e := range t.Columns {
+		// the key attribute PRIMARY KEY can also be specified as just KEY in column definition
+		if e.Constraint != nil && (e.Constraint.Primary || e.Constraint.Key) {
+			return []string{e.Name}
+		}
+	}
+
+	return nil
+}
+
+// UniqueKeys returns the name of columns of each unique key, the unique key can be
+// declared by column constraint or table constraint.
+func (t *Table) UniqueKeys() [][]string {
+	var ret [][]string
+	for _, e := range t.Columns {
+		if e.Constraint != nil && e.Constraint.Unique {
+			ret = append(ret, []string{e.Name})
+		}
+	}
+
+	for _, e := range t.Constraints {
+		if len(e.ColumnUniqueKey) > 0 {
+			ret = append(ret, e.ColumnUniqueKey)
+		}
+	}
+
+	return ret
+}
+
+// AllIndexes returns the indexes declared by table constraint and index declaration, and
+// the indexes implied by column constraint PRIMARY KEY, KEY and UNIQUE.
+func (t *Table) AllIndexes() []*Index {
+	var ret []*Index
+	for _, e := range t.Columns {
+		if e.Constraint == nil {
+			continue
+		}
+
+		if e.Constraint.Primary || e.Constraint.Key {
+			ret = append(ret, &Index{
+				Primary: true,
+				Columns: []*IndexColumn{{Name: e.Name}},
+			})
+		}
+		if e.Constraint.Unique {
+			ret = append(ret, &Index{
+				Name:    e.Name,
+				Unique:  true,
+				Columns: []*IndexColumn{{Name: e.Name}},
+			})
+		}
+	}
+
+	return append(ret, t.Indexes...)
+}
+
+// IndexNames returns the names of indexes in order, the primary key is named PRIMARY, and the
+// index without name is named by its first column as mysql does, a suffix such as _2 is appended
+// if the name exists, e.g. the unnamed indexes on (a) and (a, b) are named a and a_2.
+func IndexNames(indexes []*Index) []string {
+	used := make(map[string]bool)
+	for _, e := range indexes {
+		if len(e.Name) > 0 {
+			used[strings.ToLower(e.Name)] = true
+		}
+	}
+
+	var ret []string
+	for _, e := range indexes {
+		switch {
+		case e.Primary:
+			ret = append(ret, "PRIMARY")
+			continue
+		case len(e.Name) > 0:
+			ret = append(ret, e.Name)
+			continue
+		}
+
+		if len(e.Columns) == 0 {
+			ret = append(ret, "")
+			continue
+		}
+
+		name := e.Columns[0].Name
+		for i := 2; used[strings.ToLower(name)]; i++ {
+			name = fmt.Sprintf("%s_%d", e.Columns[0].Name, i)
+		}
+		used[strings.ToLower(name)] = true
+		ret = append(ret, name)
+	}
+
+	return ret
+}
+
+// ForeignKeys returns the foreign keys declared by table constraint.
+func (t *Table) ForeignKeys() []*ForeignKey {
+	var ret []*ForeignKey
+	for _, e := range t.Constraints {
+		if e.ForeignKey != nil {
+			ret = append(ret, e.ForeignKey)
+		}
+	}
+
+	return ret
+}
+
+// IsPrimaryKey returns true if the column is a part of primary key.
+func (t *Table) IsPrimaryKey(column string) bool {
+	return containsFold(t.PrimaryKey(), column)
+}
+
+// IsNotNull returns true if the column can not be NULL, the columns of primary key, SERIAL
+// columns and SERIAL DEFAULT VALUE columns are implicitly NOT NULL. The table can be nil, then
+// only the column itself is checked.
+func (t *Table) IsNotNull(column *Column) bool {
+	if column.DataType != nil && column.DataType.Type() == Serial {
+		return true
+	}
+	if c := column.Constraint; c != nil && (c.NotNull || c.Primary || c.SerialDefaultValue) {
+		return true
+	}
+
+	return t != nil && t.IsPrimaryKey(column.Name)
+}
+
+// IsUnique returns true if the columns are exactly the primary key or one of the unique keys,
+// the order of columns is ignored.
+func (t *Table) IsUnique(columns ...string) bool {
+	if sameColumns(t.PrimaryKey(), columns) {
+		return true
+	}
+
+	for _, e := range t.UniqueKeys() {
+		if sameColumns(e, columns) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func containsFold(list []string, s string) bool {
+	for _, e := range list {
+		if strings.EqualFold(e, s) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func sameColumns(a, b []string) bool {
+	if len(a) == 0 || len(a) != len(b) {
+		return false
+	}
+
+	for _, e := range a {
+		if !containsFold(b, e) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,45 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTable_IsNotNull(t *testing.T) {
+	tables := parseTables(t, "CREATE TABLE `user` (`id` bigint, `name` varchar(8) NOT NULL, "+
+		"`seq` serial, `no` bigint SERIAL DEFAULT VALUE, `nick` varchar(8), PRIMARY KEY (`ID`));"+
+		"CREATE TABLE `org` (`code` char(8) PRIMARY KEY);")
+	table := tables[0]
+	for _, e := range []string{"id", "name", "seq", "no"} {
+		assert.True(t, table.IsNotNull(table.Column(e)), e)
+	}
+	assert.False(t, table.IsNotNull(table.Column("nick")))
+	assert.True(t, tables[1].IsNotNull(tables[1].Column("code")))
+
+	var none *Table
+	assert.False(t, none.IsNotNull(table.Column("id")))
+	assert.True(t, none.IsNotNull(table.Column("name")))
+}
+
+func TestIndexNames(t *testing.T) {
+	tables := parseTables(t, "CREATE TABLE `user` (`id` bigint PRIMARY KEY, `a` int UNIQUE, `b` int, "+
+		"KEY (`a`), KEY `a_2` (`b`), KEY (`A`, `b`), KEY `idx_b` (`b`));")
+	assert.Equal(t, []string{"PRIMARY", "a", "a_3", "a_2", "A_4", "idx_b"}, IndexNames(tables[0].AllIndexes()))
+}
@@ -65,6 +65,39 @@ func TestVisitor_VisitTableConstraint(t *testing.T) {
 		assertEqualStringSlice(t, []string{"description_id"}, tc.ColumnPrimaryKey)
 	})
 
+	t.Run("foreignKeyTableConstraint", func(t *testing.T) {
+		v, err := p.testMysqlSyntax("test.sql", accept, "CONSTRAINT `fk_user` FOREIGN KEY (`user_id`, `org_id`) "+
+			"REFERENCES `foo`.`user` (`id`, `org_id`) ON UPDATE SET NULL ON DELETE NO ACTION")
+		assert.Nil(t, err)
+		tc, ok := v.(*TableConstraint)
+		assert.True(t, ok)
+		assert.Equal(t, &ForeignKey{
+			Name:             "fk_user",
+			Columns:          []string{"user_id", "org_id"},
+			ReferenceSchema:  "foo",
+			ReferenceTable:   "user",
+			ReferenceColumns: []string{"id", "org_id"},
+			OnDelete:         "NO ACTION",
+			OnUpdate:         "SET NULL",
+		}, tc.ForeignKey)
+
+		v, err = p.testMysqlSyntax("test.sql", accept, "FOREIGN KEY (user_id) REFERENCES foo.user (id)")
+		assert.Nil(t, err)
+		tc, ok = v.(*TableConstraint)
+		assert.True(t, ok)
+		assert.Equal(t, "foo", tc.ForeignKey.ReferenceSchema)
+		assert.Equal(t, "user", tc.ForeignKey.ReferenceTable)
+		assert.Empty(t, tc.ForeignKey.OnDelete)
+	})
+
+	t.Run("checkTableConstraint", func(t *testing.T) {
+		v, err := p.testMysqlSyntax("test.sql", accept, "CONSTRAINT `chk_age` CHECK (`age` > 0 AND `age` < 200)")
+		assert.Nil(t, err)
+		tc, ok := v.(*TableConstraint)
+		assert.True(t, ok)
+		assert.Equal(t, &Check{Name: "chk_age", Expression: "`age` > 0 AND `age` < 200"}, tc.Check)
+	})
+
 }
 
 func assertEqualStringSlice(t *testing.T, expected, actual []string) {
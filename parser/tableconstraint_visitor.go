@@ -19,6 +19,7 @@ package parser
 import (
 	"strings"
 
+	"github.com/antlr/antlr4/runtime/Go/antlr"
 	"github.com/zeromicro/ddl-parser/gen"
 )
 
@@ -27,6 +28,39 @@ type TableConstraint struct {
 	ColumnPrimaryKey []string
 	// ColumnUniqueKey describes the name of columns
 	ColumnUniqueKey []string
+	// ForeignKey describes the foreign key, it's nil if the constraint is not a foreign key
+	ForeignKey *ForeignKey
+	// Check describes the check constraint, it's nil if the constraint is not a check constraint
+	Check *Check
+}
+
+// Check describes a check constraint,
+// https://dev.mysql.com/doc/refman/8.0/en/create-table-check-constraints.html
+type Check struct {
+	// Name describes the constraint symbol, it's empty if the symbol is not specified
+	Name string
+	// Expression describes the literal of expression without the parentheses
+	Expression string
+}
+
+// ForeignKey describes a foreign key constraint which references to another table,
+// https://dev.mysql.com/doc/refman/8.0/en/create-table-foreign-keys.html
+type ForeignKey struct {
+	// Name describes the constraint symbol, it's empty if the symbol is not specified
+	Name string
+	// Columns describes the name of columns in the child table
+	Columns []string
+	// ReferenceSchema describes the schema of parent table, it's empty if the
+	// parent table is not specified as db_name.tbl_name
+	ReferenceSchema string
+	// ReferenceTable describes the name of parent table
+	ReferenceTable string
+	// ReferenceColumns describes the name of columns in the parent table
+	ReferenceColumns []string
+	// OnDelete describes the referential action of ON DELETE, such as CASCADE, SET NULL
+	OnDelete string
+	// OnUpdate describes the referential action of ON UPDATE, such as CASCADE, SET NULL
+	OnUpdate string
 }
 
 // visitTableConstraint visits a parse tree produced by MySqlParser#tableConstraint.
@@ -49,12 +83,121 @@ func (v *visitor) visitTableConstraint(ctx gen.ITableConstraintContext) *TableCo
 			}
 		}
 	case *gen.ForeignKeyTableConstraintContext:
-		v.panicWithExpr(tx.GetStart(), "Unsupported foreign key constraint")
+		ret.ForeignKey = v.visitForeignKeyTableConstraint(tx)
+	case *gen.CheckTableConstraintContext:
+		ret.Check = v.visitCheckTableConstraint(tx)
+	}
+
+	return &ret
+}
+
+// visitForeignKeyTableConstraint visits a parse tree produced by MySqlParser#foreignKeyTableConstraint.
+func (v *visitor) visitForeignKeyTableConstraint(ctx *gen.ForeignKeyTableConstraintContext) *ForeignKey {
+	v.trace("VisitForeignKeyTableConstraint")
+	var ret ForeignKey
+	if ctx.GetName() != nil {
+		ret.Name = v.visitUid(ctx.GetName())
+	} else if ctx.GetIndex() != nil {
+		ret.Name = v.visitUid(ctx.GetIndex())
+	}
+
+	if indexColumnNamesCtx, ok := ctx.IndexColumnNames().(*gen.IndexColumnNamesContext); ok {
+		ret.Columns = v.visitIndexColumnNames(indexColumnNamesCtx)
+	}
+
+	if referenceCtx, ok := ctx.ReferenceDefinition().(*gen.ReferenceDefinitionContext); ok {
+		v.visitReferenceDefinition(referenceCtx, &ret)
+	}
+
+	return &ret
+}
+
+// visitCheckTableConstraint visits a parse tree produced by MySqlParser#checkTableConstraint.
+func (v *visitor) visitCheckTableConstraint(ctx *gen.CheckTableConstraintContext) *Check {
+	v.trace("VisitCheckTableConstraint")
+	var ret Check
+	if ctx.GetName() != nil {
+		ret.Name = v.visitUid(ctx.GetName())
+	}
+	if expressionCtx, ok := ctx.Expression().(antlr.ParserRuleContext); ok {
+		ret.Expression = v.verbatimText(expressionCtx)
 	}
 
 	return &ret
 }
 
+// visitReferenceDefinition visits a parse tree produced by MySqlParser#referenceDefinition.
+func (v *visitor) visitReferenceDefinition(ctx *gen.ReferenceDefinitionContext, fk *ForeignKey) {
+	v.trace("VisitReferenceDefinition")
+	fk.ReferenceSchema, fk.ReferenceTable = v.visitTableName(ctx.TableName())
+	if ctx.MATCH() != nil {
+		v.unsupportedClause(ctx.MATCH().GetSymbol(), "MATCH")
+	}
+	if ctx.IndexColumnNames() != nil {
+		if indexColumnNamesCtx, ok := ctx.IndexColumnNames().(*gen.IndexColumnNamesContext); ok {
+			fk.ReferenceColumns = v.visitIndexColumnNames(indexColumnNamesCtx)
+		}
+	}
+
+	actionCtx, ok := ctx.ReferenceAction().(*gen.ReferenceActionContext)
+	if !ok {
+		return
+	}
+
+	if actionCtx.GetOnDelete() != nil {
+		fk.OnDelete = v.visitReferenceControlType(actionCtx.GetOnDelete())
+	}
+	if actionCtx.GetOnUpdate() != nil {
+		fk.OnUpdate = v.visitReferenceControlType(actionCtx.GetOnUpdate())
+	}
+}
+
+// visitReferenceControlType visits a parse tree produced by MySqlParser#referenceControlType.
+func (v *visitor) visitReferenceControlType(ctx gen.IReferenceControlTypeContext) string {
+	v.trace("VisitReferenceControlType")
+	var words []string
+	for _, e := range ctx.GetChildren() {
+		if node, ok := e.(antlr.TerminalNode); ok {
+			words = append(words, parseTerminalNode(node, withUpperCase()))
+		}
+	}
+
+	return strings.Join(words, " ")
+}
+
+// visitTableName visits a parse tree produced by MySqlParser#tableName, it returns
+// the schema and the name of table.
+func (v *visitor) visitTableName(ctx gen.ITableNameContext) (string, string) {
+	v.trace("VisitTableName")
+	tableNameCtx, ok := ctx.(*gen.TableNameContext)
+	if !ok {
+		return "", v.trimIdentifier(ctx.GetText())
+	}
+
+	return v.visitFullId(tableNameCtx.FullId())
+}
+
+// visitFullId visits a parse tree produced by MySqlParser#fullId, it returns the
+// qualifier and the name, such as the schema and the name of table.
+func (v *visitor) visitFullId(ctx gen.IFullIdContext) (string, string) {
+	fullIdCtx, ok := ctx.(*gen.FullIdContext)
+	if !ok {
+		return "", v.trimIdentifier(ctx.GetText())
+	}
+
+	uids := fullIdCtx.AllUid()
+	if len(uids) == 2 {
+		return v.visitUid(uids[0]), v.visitUid(uids[1])
+	}
+
+	name := v.visitUid(uids[0])
+	if fullIdCtx.DOT_ID() != nil {
+		return name, parseTerminalNode(fullIdCtx.DOT_ID(), withTrim("."), withTrim("`"))
+	}
+
+	return "", name
+}
+
 // visitIndexColumnNames visits a parse tree produced by MySqlParser#indexColumnNames.
 func (v *visitor) visitIndexColumnNames(ctx *gen.IndexColumnNamesContext) []string {
 	v.trace("VisitIndexColumnNames")
@@ -90,7 +233,10 @@ func (v *visitor) visitIndexColumnName(ctx *gen.IndexColumnNameContext) string {
 }
 
 func (v *visitor) visitUid(ctx gen.IUidContext) string {
-	str := ctx.GetText()
+	return v.trimIdentifier(ctx.GetText())
+}
+
+func (v *visitor) trimIdentifier(str string) string {
 	str = strings.Trim(str, "`")
 	str = strings.Trim(str, "'")
 	str = strings.NewReplacer("\r", "", "\n", "").Replace(str)
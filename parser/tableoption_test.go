@@ -0,0 +1,58 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVisitor_VisitTableOption(t *testing.T) {
+	tables := parseTables(t, "CREATE TABLE `user` (`id` bigint NOT NULL) "+
+		"ENGINE=InnoDB AUTO_INCREMENT=8 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci "+
+		"ROW_FORMAT=DYNAMIC KEY_BLOCK_SIZE 8 COMMENT='user table';")
+	assert.Equal(t, []*TableOption{
+		{Name: TableOptionEngine, Value: "InnoDB"},
+		{Name: TableOptionAutoIncrement, Value: "8"},
+		{Name: TableOptionCharset, Value: "utf8mb4"},
+		{Name: TableOptionCollate, Value: "utf8mb4_0900_ai_ci"},
+		{Name: TableOptionRowFormat, Value: "DYNAMIC"},
+		{Name: "KEY_BLOCK_SIZE", Value: "8"},
+		{Name: TableOptionComment, Value: "user table"},
+	}, tables[0].Options)
+	assert.Equal(t, "InnoDB", tables[0].Option("engine"))
+	assert.Equal(t, "", tables[0].Option("TABLESPACE"))
+
+	tables = parseTables(t, "CREATE TABLE `user` (`id` bigint NOT NULL) "+
+		"DATA DIRECTORY '/data' UNION=(`a`, `b`) STATS_PERSISTENT DEFAULT TABLESPACE `ts` STORAGE DISK;"+
+		"CREATE TABLE `log` (`id` bigint NOT NULL) STORAGE MEMORY;")
+	assert.Equal(t, []*TableOption{
+		{Name: "DATA DIRECTORY", Value: "'/data'"},
+		{Name: "UNION", Value: "(`a`, `b`)"},
+		{Name: "STATS_PERSISTENT", Value: "DEFAULT"},
+		{Name: TableOptionTablespace, Value: "`ts` STORAGE DISK"},
+	}, tables[0].Options)
+	assert.Equal(t, []*TableOption{
+		{Name: TableOptionStorage, Value: "MEMORY"},
+	}, tables[1].Options)
+
+	tables = parseTables(t, "CREATE TABLE `user` (`id` bigint NOT NULL) tablespace ts storage disk;"+
+		"CREATE TABLE `log` (`id` bigint NOT NULL) storage memory;")
+	assert.Equal(t, "ts STORAGE DISK", tables[0].Option(TableOptionTablespace))
+	assert.Equal(t, "MEMORY", tables[1].Option(TableOptionStorage))
+}
@@ -0,0 +1,162 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package parser
+
+import (
+	"strings"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"github.com/zeromicro/ddl-parser/gen"
+)
+
+const (
+	TableOptionEngine        = "ENGINE"
+	TableOptionAutoIncrement = "AUTO_INCREMENT"
+	TableOptionCharset       = "CHARSET"
+	TableOptionCollate       = "COLLATE"
+	TableOptionComment       = "COMMENT"
+	TableOptionRowFormat     = "ROW_FORMAT"
+	TableOptionTablespace    = "TABLESPACE"
+	TableOptionStorage       = "STORAGE"
+)
+
+// TableOption describes a table option such as ENGINE=InnoDB, the synonyms of option name
+// are normalized, e.g. DEFAULT CHARACTER SET is normalized as CHARSET.
+type TableOption struct {
+	// Name describes the upper case name of option, such as ENGINE, CHARSET, COMMENT
+	Name string
+	// Value describes the literal of option value, the quotes of COMMENT are trimmed, and the
+	// storage of TABLESPACE and STORAGE is upper case, such as `ts` STORAGE DISK
+	Value string
+}
+
+// Option returns the value of table option by name, it returns empty if the option
+// does not exist.
+func (t *Table) Option(name string) string {
+	for _, e := range t.Options {
+		if strings.EqualFold(e.Name, name) {
+			return e.Value
+		}
+	}
+
+	return ""
+}
+
+// visitTableOption visits a parse tree produced by MySqlParser#tableOption.
+func (v *visitor) visitTableOption(ctx gen.ITableOptionContext) *TableOption {
+	v.trace("VisitTableOption")
+	switch tx := ctx.(type) {
+	case *gen.TableOptionEngineContext:
+		return &TableOption{
+			Name:  TableOptionEngine,
+			Value: v.trimIdentifier(tx.EngineName().GetText()),
+		}
+	case *gen.TableOptionCharsetContext:
+		value := "DEFAULT"
+		if tx.CharsetName() != nil {
+			value = v.visitCharsetName(tx.CharsetName())
+		}
+		return &TableOption{
+			Name:  TableOptionCharset,
+			Value: value,
+		}
+	case *gen.TableOptionCollateContext:
+		return &TableOption{
+			Name:  TableOptionCollate,
+			Value: v.visitCollationName(tx.CollationName()),
+		}
+	case *gen.TableOptionCommentContext:
+		return &TableOption{
+			Name: TableOptionComment,
+			Value: parseTerminalNode(
+				tx.STRING_LITERAL(),
+				withStringLiteral(),
+				withReplacer(`\r`, "", `\n`, ""),
+			),
+		}
+	case *gen.TableOptionTablespaceContext:
+		storage := ""
+		if storageCtx, ok := tx.TablespaceStorage().(*gen.TablespaceStorageContext); ok {
+			storage = strings.ToUpper(storageCtx.GetChild(1).(antlr.TerminalNode).GetText())
+		}
+		if tx.TABLESPACE() == nil {
+			return &TableOption{
+				Name:  TableOptionStorage,
+				Value: storage,
+			}
+		}
+
+		value := originalText(tx.Uid().(antlr.ParserRuleContext))
+		if len(storage) > 0 {
+			value += " " + TableOptionStorage + " " + storage
+		}
+		return &TableOption{
+			Name:  TableOptionTablespace,
+			Value: value,
+		}
+	}
+
+	// the other options are kept as literal, such as KEY_BLOCK_SIZE=8, DATA DIRECTORY '/data'
+	children := ctx.GetChildren()
+
+	var name []string
+	value := ""
+	for i, e := range children {
+		node, ok := e.(antlr.TerminalNode)
+		if ok && node.GetText() == "=" {
+			if i+1 < len(children) {
+				value = textBetween(node.GetSymbol().GetInputStream(), startOf(children[i+1]), ctx.GetStop().GetStop())
+			}
+			break
+		}
+
+		if ok && i < len(children)-1 && isWord(node.GetText()) {
+			name = append(name, strings.ToUpper(node.GetText()))
+			continue
+		}
+
+		value = textBetween(ctx.GetStart().GetInputStream(), startOf(e), ctx.GetStop().GetStop())
+		break
+	}
+
+	return &TableOption{
+		Name:  strings.Join(name, " "),
+		Value: value,
+	}
+}
+
+// startOf returns the start index of the parse tree in the char stream.
+func startOf(tree antlr.Tree) int {
+	switch t := tree.(type) {
+	case antlr.TerminalNode:
+		return t.GetSymbol().GetStart()
+	case antlr.ParserRuleContext:
+		return t.GetStart().GetStart()
+	}
+
+	return -1
+}
+
+func isWord(text string) bool {
+	for _, c := range text {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c == '_') {
+			return false
+		}
+	}
+
+	return len(text) > 0
+}
@@ -65,3 +65,73 @@ func withReplacer(oldnew ...string) parseOption {
 		return strings.NewReplacer(oldnew...).Replace(text)
 	}
 }
+
+// withStringLiteral trims the quotes of string literal, the content of double-quoted literal is
+// converted to the form of single-quoted literal, such as a"b of "a""b", so the values can be
+// unescaped by Unescape and quoted by single quotes again.
+func withStringLiteral() parseOption {
+	return func(text string) string {
+		if len(text) < 2 || text[0] != text[len(text)-1] || (text[0] != '\'' && text[0] != '"') {
+			return text
+		}
+
+		content := text[1 : len(text)-1]
+		if text[0] == '\'' {
+			return content
+		}
+
+		var b strings.Builder
+		for i := 0; i < len(content); i++ {
+			switch c := content[i]; {
+			case c == '\\' && i+1 < len(content):
+				b.WriteByte(c)
+				b.WriteByte(content[i+1])
+				i++
+			case c == '"' && i+1 < len(content) && content[i+1] == '"':
+				b.WriteByte('"')
+				i++
+			case c == '\'':
+				b.WriteString("''")
+			default:
+				b.WriteByte(c)
+			}
+		}
+		return b.String()
+	}
+}
+
+// unescapers describes the replacers of escape sequences by the quote of string literal.
+var unescapers = map[byte]*strings.Replacer{
+	'\'': strings.NewReplacer(`''`, `'`, `\'`, `'`, `\"`, `"`, `\n`, "\n", `\r`, "\r", `\t`, "\t", `\\`, `\`),
+	'"':  strings.NewReplacer(`""`, `"`, `\'`, `'`, `\"`, `"`, `\n`, "\n", `\r`, "\r", `\t`, "\t", `\\`, `\`),
+}
+
+// Unescape returns the value of string literal whose quotes are trimmed, such as the comments
+// and the values of ENUM and SET, the doubled quotes and the escape sequences such as \' and \n
+// are unescaped. The parser keeps these literals in the form of single-quoted literal even if
+// they are double-quoted.
+func Unescape(s string) string {
+	return unescapers['\''].Replace(s)
+}
+
+// originalText returns the original text of the parse tree, the hidden tokens such as
+// whitespaces and comments are included, the line breaks are kept since a comment which starts
+// with -- or # ends at the line break.
+func originalText(ctx antlr.ParserRuleContext) string {
+	start, stop := ctx.GetStart(), ctx.GetStop()
+	if start == nil || stop == nil {
+		return ""
+	}
+
+	return textBetween(start.GetInputStream(), start.GetStart(), stop.GetStop())
+}
+
+func textBetween(stream antlr.CharStream, start, stop int) string {
+	if stream == nil || stop < start {
+		return ""
+	}
+
+	text := stream.GetText(start, stop)
+	text = strings.NewReplacer("\r\n", "\n", "\r", "\n").Replace(text)
+	return strings.TrimSpace(text)
+}
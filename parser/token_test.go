@@ -0,0 +1,47 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnescape(t *testing.T) {
+	assert.Equal(t, "it's", Unescape(`it''s`))
+	assert.Equal(t, "it's", Unescape(`it\'s`))
+	assert.Equal(t, `say "hi"`, Unescape(`say \"hi\"`))
+	assert.Equal(t, "a\nb\tc", Unescape(`a\nb\tc`))
+	assert.Equal(t, `a\'b`, Unescape(`a\\\'b`))
+	assert.Equal(t, `it's`, stringValue(`'it''s'`))
+	assert.Equal(t, `say "hi"`, stringValue(`"say ""hi"""`))
+	assert.Equal(t, `123`, stringValue(`123`))
+}
+
+func TestUnescape_doubleQuoted(t *testing.T) {
+	statements, err := NewParser().Statements("schema.sql",
+		`CREATE TABLE t (a ENUM("x""y", "it's") COMMENT "say ""hi""", KEY (a) COMMENT "b""c") COMMENT = "d""e";`)
+	assert.NoError(t, err)
+	table := statements[0].Table
+	assert.Equal(t, []string{`x"y`, `it''s`}, table.Columns[0].DataType.Value())
+	assert.Equal(t, `x"y`, Unescape(table.Columns[0].DataType.Value()[0]))
+	assert.Equal(t, `it's`, Unescape(table.Columns[0].DataType.Value()[1]))
+	assert.Equal(t, `say "hi"`, Unescape(table.Columns[0].Constraint.Comment))
+	assert.Equal(t, `b"c`, Unescape(table.Indexes[0].Comment))
+	assert.Equal(t, `d"e`, Unescape(table.Option(TableOptionComment)))
+}
@@ -0,0 +1,301 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package parser
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ValidationError describes a semantic error of table which mysql rejects, such as duplicate
+// column names.
+type ValidationError struct {
+	// File describes the prefix of error, such as the file name of content
+	File     string
+	Position Position
+	Table    string
+	Message  string
+}
+
+// Error returns the error in the format of syntax errors, such as
+// schema.sql line 3:2 Duplicate column name 'id'.
+func (e *ValidationError) Error() string {
+	if len(e.File) == 0 {
+		return fmt.Sprintf("%v:%v %s", e.Position.Line, e.Position.Column, e.Message)
+	}
+
+	return fmt.Sprintf("%v line %v:%v %s", e.File, e.Position.Line, e.Position.Column, e.Message)
+}
+
+// ValidationErrors describes the semantic errors of tables.
+type ValidationErrors []*ValidationError
+
+// Error returns the errors separated by line breaks.
+func (e ValidationErrors) Error() string {
+	var list []string
+	for _, err := range e {
+		list = append(list, err.Error())
+	}
+
+	return strings.Join(list, "\n")
+}
+
+// Validate checks the table semantically as mysql does, it reports the duplicate columns, the
+// keys of columns which do not exist, the multiple primary keys, the invalid AUTO_INCREMENT
+// columns and the invalid default values. The positions are used to locate the errors, it can
+// be nil if the positions are unknown.
+func (t *Table) Validate(positions *Positions) ValidationErrors {
+	var ret ValidationErrors
+	report := func(node interface{}, format string, args ...interface{}) {
+		position, ok := positions.Of(node)
+		if !ok {
+			position, _ = positions.Of(t)
+		}
+		ret = append(ret, &ValidationError{Position: position, Table: t.Name, Message: fmt.Sprintf(format, args...)})
+	}
+
+	seen := make(map[string]bool)
+	for _, e := range t.Columns {
+		if seen[strings.ToLower(e.Name)] {
+			report(e, "Duplicate column name '%s'", e.Name)
+		}
+		seen[strings.ToLower(e.Name)] = true
+	}
+
+	t.validateKeys(report)
+	t.validateAutoIncrement(report)
+	for _, e := range t.Columns {
+		validateDefaultValue(e, report)
+	}
+
+	return ret
+}
+
+type reporter func(node interface{}, format string, args ...interface{})
+
+func (t *Table) validateKeys(report reporter) {
+	var primary int
+	for _, e := range t.Columns {
+		if e.Constraint != nil && (e.Constraint.Primary || e.Constraint.Key) {
+			primary++
+			if primary > 1 {
+				report(e, "Multiple primary key defined")
+			}
+		}
+	}
+
+	for _, e := range t.Indexes {
+		if e.Primary {
+			primary++
+			if primary > 1 {
+				report(e, "Multiple primary key defined")
+			}
+		}
+		for _, column := range e.Columns {
+			if t.Column(column.Name) == nil {
+				report(e, "Key column '%s' doesn't exist in table", column.Name)
+			}
+		}
+	}
+
+	for _, e := range t.Constraints {
+		if e.ForeignKey == nil {
+			continue
+		}
+
+		for _, column := range e.ForeignKey.Columns {
+			if t.Column(column) == nil {
+				report(e, "Key column '%s' doesn't exist in table", column)
+			}
+		}
+	}
+}
+
+// validateAutoIncrement checks there is only one AUTO_INCREMENT column which is a numeric column
+// and the first column of an index.
+func (t *Table) validateAutoIncrement(report reporter) {
+	var count int
+	for _, e := range t.Columns {
+		if e.Constraint == nil || !e.Constraint.AutoIncrement {
+			continue
+		}
+
+		if e.DataType != nil && !e.DataType.Type().IsInteger() && !e.DataType.Type().IsFloatingPoint() {
+			report(e, "Incorrect column specifier for column '%s'", e.Name)
+			continue
+		}
+
+		count++
+		if count > 1 || !t.isFirstKeyColumn(e.Name) {
+			report(e, "Incorrect table definition; there can be only one auto column and it must be defined as a key")
+		}
+	}
+}
+
+func (t *Table) isFirstKeyColumn(column string) bool {
+	for _, e := range t.AllIndexes() {
+		if len(e.Columns) > 0 && strings.EqualFold(e.Columns[0].Name, column) {
+			return true
+		}
+	}
+
+	return false
+}
+
+var (
+	numberRegex    = regexp.MustCompile(`^[+-]?(\d+(\.\d*)?|\.\d+)([eE][+-]?\d+)?$`)
+	integerRegex   = regexp.MustCompile(`^[+-]?\d+$`)
+	bitRegex       = regexp.MustCompile(`^([bB]'[01]*'|0b[01]+)$`)
+	hexRegex       = regexp.MustCompile(`^([xX]'[0-9a-fA-F]*'|0x[0-9a-fA-F]+)$`)
+	stringRegex    = regexp.MustCompile(`^(_\w+|[nN])?('(.|\n)*'|"(.|\n)*")$`)
+	timestampRegex = regexp.MustCompile(`^(?i)(CURRENT_TIMESTAMP|NOW|LOCALTIME|LOCALTIMESTAMP)(\(\d*\))?$`)
+)
+
+// validateDefaultValue checks the default value matches the data type of column, the
+// expressions in parentheses are not checked.
+func validateDefaultValue(column *Column, report reporter) {
+	c, dataType := column.Constraint, column.DataType
+	if c == nil || dataType == nil {
+		return
+	}
+
+	kind := dataType.Type()
+	if len(c.OnUpdate) > 0 && kind != DateTime && kind != Timestamp {
+		report(column, "Invalid ON UPDATE clause for '%s' column", column.Name)
+	}
+
+	value := strings.TrimSpace(c.DefaultValue)
+	switch {
+	case len(value) == 0, strings.HasPrefix(value, "("):
+		return
+	case strings.EqualFold(value, "NULL"):
+		if c.NotNull {
+			report(column, "Invalid default value for '%s'", column.Name)
+		}
+		return
+	case isBlobKind(kind):
+		report(column, "BLOB, TEXT, GEOMETRY or JSON column '%s' can't have a default value", column.Name)
+		return
+	}
+
+	if !validDefaultValue(dataType, value) {
+		report(column, "Invalid default value for '%s'", column.Name)
+	}
+}
+
+func validDefaultValue(dataType DataType, value string) bool {
+	kind := dataType.Type()
+	isString := stringRegex.MatchString(value)
+	isNumber := numberRegex.MatchString(value) || strings.EqualFold(value, "TRUE") || strings.EqualFold(value, "FALSE")
+	isBits := bitRegex.MatchString(value) || hexRegex.MatchString(value)
+	switch {
+	case kind.IsInteger(), kind == Year:
+		if isString {
+			value = strings.TrimSpace(stringValue(value))
+			isNumber = numberRegex.MatchString(value)
+		}
+		return isBits || isNumber && inIntegerRange(kind, dataType.Unsigned(), value)
+	case kind.IsFixedPoint(), kind.IsFloatingPoint(), kind == Bit:
+		if isString {
+			return numberRegex.MatchString(strings.TrimSpace(stringValue(value)))
+		}
+		return isNumber || isBits
+	case kind == Enum:
+		if isString {
+			return containsFold(dataType.Value(), stringValue(value))
+		}
+		n, err := strconv.Atoi(value)
+		return err == nil && n >= 1 && n <= len(dataType.Value())
+	case kind == Set:
+		if !isString {
+			return isNumber
+		}
+		if s := stringValue(value); len(s) > 0 {
+			for _, e := range strings.Split(s, ",") {
+				if !containsFold(dataType.Value(), e) {
+					return false
+				}
+			}
+		}
+		return true
+	case kind == DateTime || kind == Timestamp:
+		return isString || isNumber || timestampRegex.MatchString(value)
+	case kind.IsTemporal():
+		return isString || isNumber
+	case kind == Char || kind == Character || kind == VarChar || kind == NChar || kind == NVarChar:
+		if isString && dataType.Length() > 0 {
+			return len([]rune(stringValue(value))) <= dataType.Length()
+		}
+		return isString || isNumber || isBits
+	}
+
+	return isString || isNumber || isBits
+}
+
+// inIntegerRange returns true if the integer literal is in the range of integer type, the
+// literals which are not integers are not checked.
+func inIntegerRange(kind Kind, unsigned bool, value string) bool {
+	if strings.EqualFold(value, "TRUE") || strings.EqualFold(value, "FALSE") || !integerRegex.MatchString(value) {
+		return true
+	}
+
+	bits := 64
+	switch kind {
+	case TinyInt, Int1, Bool, Boolean:
+		bits = 8
+	case SmallInt, Int2, Year:
+		bits = 16
+	case MediumInt, MiddleInt, Int3:
+		bits = 24
+	case Int, Integer, Int4:
+		bits = 32
+	}
+
+	if unsigned || kind == Serial {
+		n, err := strconv.ParseUint(strings.TrimPrefix(value, "+"), 10, 64)
+		return err == nil && n <= 1<<uint(bits)-1
+	}
+
+	n, err := strconv.ParseInt(value, 10, 64)
+	return err == nil && n >= -(1<<uint(bits-1)) && n <= 1<<uint(bits-1)-1
+}
+
+// stringValue returns the value of string literal, such as it's of 'it”s'.
+func stringValue(literal string) string {
+	i := strings.IndexAny(literal, `'"`)
+	if i < 0 {
+		return literal
+	}
+
+	literal = literal[i:]
+	if len(literal) < 2 {
+		return literal
+	}
+
+	return unescapers[literal[0]].Replace(literal[1 : len(literal)-1])
+}
+
+func isBlobKind(kind Kind) bool {
+	switch kind {
+	case TinyText, Text, MediumText, LongText, LongVarChar, TinyBlob, Blob, MediumBlob, LongBlob, LongVarBinary, Json:
+		return true
+	}
+
+	return kind.IsSpatial()
+}
@@ -0,0 +1,148 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package parser
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTable_Validate(t *testing.T) {
+	validate := func(sql string) []string {
+		statements, err := NewParser().Statements("schema.sql", sql)
+		assert.NoError(t, err)
+		var ret []string
+		for _, e := range statements[0].Table.Validate(statements[0].Positions) {
+			ret = append(ret, e.Error())
+		}
+		return ret
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		assert.Empty(t, validate("CREATE TABLE `user` (\n"+
+			"  `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,\n"+
+			"  `name` VARCHAR(8) NOT NULL DEFAULT 'it''s',\n"+
+			"  `age` TINYINT UNSIGNED DEFAULT '18',\n"+
+			"  `level` TINYINT DEFAULT -128,\n"+
+			"  `flags` BIT(8) DEFAULT b'01',\n"+
+			"  `balance` DECIMAL(10,2) DEFAULT 0.00,\n"+
+			"  `status` ENUM('on','off') DEFAULT 'ON',\n"+
+			"  `tags` SET('a','b') DEFAULT 'a,b',\n"+
+			"  `bio` TEXT DEFAULT ('none'),\n"+
+			"  `extra` JSON DEFAULT NULL,\n"+
+			"  `created_at` DATETIME(3) DEFAULT CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3),\n"+
+			"  `birthday` DATE DEFAULT '2000-01-01',\n"+
+			"  PRIMARY KEY (`id`)\n"+
+			");"))
+	})
+
+	t.Run("columns", func(t *testing.T) {
+		assert.Equal(t, []string{"3:2 Duplicate column name 'ID'"}, validate("CREATE TABLE `user` (\n"+
+			"  `id` INT,\n"+
+			"  `ID` INT\n"+
+			");"))
+	})
+
+	t.Run("keys", func(t *testing.T) {
+		assert.Equal(t, []string{
+			"3:2 Multiple primary key defined",
+			"3:2 Key column 'uid' doesn't exist in table",
+			"4:2 Key column 'org' doesn't exist in table",
+			"5:2 Key column 'org_id' doesn't exist in table",
+		}, validate("CREATE TABLE `user` (\n"+
+			"  `id` INT PRIMARY KEY,\n"+
+			"  PRIMARY KEY (`uid`),\n"+
+			"  KEY `idx_org` (`id`, `org`),\n"+
+			"  FOREIGN KEY (`org_id`) REFERENCES `org` (`id`)\n"+
+			");"))
+	})
+
+	t.Run("auto increment", func(t *testing.T) {
+		assert.Equal(t, []string{
+			"2:2 Incorrect column specifier for column 'code'",
+			"4:2 Incorrect table definition; there can be only one auto column and it must be defined as a key",
+			"5:2 Incorrect table definition; there can be only one auto column and it must be defined as a key",
+		}, validate("CREATE TABLE `user` (\n"+
+			"  `code` VARCHAR(8) AUTO_INCREMENT,\n"+
+			"  `id` INT AUTO_INCREMENT UNIQUE,\n"+
+			"  `seq` INT AUTO_INCREMENT,\n"+
+			"  `no` INT AUTO_INCREMENT,\n"+
+			"  KEY `idx_no` (`id`, `no`)\n"+
+			");"))
+	})
+
+	t.Run("default value", func(t *testing.T) {
+		assert.Equal(t, []string{
+			"2:2 Invalid default value for 'age'",
+			"3:2 Invalid default value for 'level'",
+			"4:2 Invalid default value for 'name'",
+			"5:2 Invalid default value for 'status'",
+			"6:2 Invalid default value for 'tags'",
+			"7:2 Invalid default value for 'id'",
+			"8:2 Invalid default value for 'birthday'",
+			"9:2 Invalid ON UPDATE clause for 'updated' column",
+			"10:2 BLOB, TEXT, GEOMETRY or JSON column 'bio' can't have a default value",
+			"11:2 BLOB, TEXT, GEOMETRY or JSON column 'extra' can't have a default value",
+		}, validate("CREATE TABLE `user` (\n"+
+			"  `age` INT DEFAULT 'abc',\n"+
+			"  `level` TINYINT UNSIGNED DEFAULT 256,\n"+
+			"  `name` CHAR(2) DEFAULT 'abc',\n"+
+			"  `status` ENUM('on','off') DEFAULT 'unknown',\n"+
+			"  `tags` SET('a','b') DEFAULT 'a,c',\n"+
+			"  `id` INT NOT NULL DEFAULT NULL,\n"+
+			"  `birthday` DATE DEFAULT CURRENT_TIMESTAMP,\n"+
+			"  `updated` INT ON UPDATE CURRENT_TIMESTAMP,\n"+
+			"  `bio` TEXT DEFAULT '',\n"+
+			"  `extra` JSON DEFAULT '{}'\n"+
+			");"))
+	})
+
+	t.Run("without positions", func(t *testing.T) {
+		statements, err := NewParser().Statements("schema.sql", "CREATE TABLE `user` (`id` INT, `id` INT);")
+		assert.NoError(t, err)
+		errs := statements[0].Table.Validate(nil)
+		assert.Equal(t, "0:0 Duplicate column name 'id'", errs.Error())
+	})
+}
+
+func TestWithValidation(t *testing.T) {
+	sql := "CREATE TABLE `user` (\n" +
+		"  `id` INT,\n" +
+		"  `id` INT\n" +
+		");"
+
+	statements, err := NewParser(WithValidation(true)).Statements("schema.sql", sql)
+	assert.Len(t, statements, 1)
+	assert.IsType(t, ValidationErrors{}, err)
+	assert.EqualError(t, err, "schema.sql line 3:2 Duplicate column name 'id'")
+
+	_, err = NewParser().Statements("schema.sql", sql)
+	assert.NoError(t, err)
+
+	filename := filepath.Join(t.TempDir(), "schema.sql")
+	assert.NoError(t, ioutil.WriteFile(filename, []byte(sql), 0o644))
+	tables, err := NewParser(WithValidation(true)).From(filename)
+	assert.Len(t, tables, 1)
+	assert.EqualError(t, err, "schema.sql line 3:2 Duplicate column name 'id'")
+
+	tables, err = NewParser().From(filename)
+	assert.Len(t, tables, 1)
+	assert.NoError(t, err)
+}
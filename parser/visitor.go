@@ -19,6 +19,7 @@ package parser
 import (
 	"fmt"
 
+	"github.com/antlr/antlr4/runtime/Go/antlr"
 	"github.com/zeromicro/ddl-parser/console"
 	"github.com/zeromicro/ddl-parser/gen"
 )
@@ -28,6 +29,17 @@ type visitor struct {
 	prefix string
 	debug  bool
 	logger console.Console
+	// tokens describes the token stream which contains the comments in hidden channels
+	tokens *antlr.CommonTokenStream
+	// normalize describes whether the aliases of data types are normalized
+	normalize bool
+	// positions describes the positions of the parsed nodes, it's nil if the positions are
+	// not recorded
+	positions map[interface{}]Position
+	// unsupported describes the clauses of the visiting statement which are not modeled
+	unsupported []*UnsupportedClause
+	// verbatim describes the parse trees whose original text is kept by the parsed nodes
+	verbatim []antlr.ParserRuleContext
 }
 
 func (v *visitor) trace(msg ...interface{}) {
@@ -36,6 +48,15 @@ func (v *visitor) trace(msg ...interface{}) {
 	}
 }
 
+// unsupportedClause records the clause which is not modeled by the parsed nodes, the token is
+// the first token of clause.
+func (v *visitor) unsupportedClause(token antlr.Token, clause string) {
+	v.unsupported = append(v.unsupported, &UnsupportedClause{
+		Position: Position{Line: token.GetLine(), Column: token.GetColumn()},
+		Clause:   clause,
+	})
+}
+
 func (v *visitor) panicWithExpr(expr Token, msg string) {
 	if len(v.prefix) == 0 {
 		err := fmt.Errorf("%v:%v %s", expr.GetLine(), expr.GetColumn(), msg)
@@ -44,7 +65,6 @@ func (v *visitor) panicWithExpr(expr Token, msg string) {
 		}
 
 		panic(err)
-		return
 	}
 
 	err := fmt.Errorf("%v line %v:%v %s", v.prefix, expr.GetLine(), expr.GetColumn(), msg)
@@ -0,0 +1,220 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package printer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/zeromicro/ddl-parser/parser"
+)
+
+// DataType returns the data type with its dimension, values, charset and collation,
+// such as DECIMAL(10,2) UNSIGNED, VARCHAR(64) CHARACTER SET utf8mb4.
+func (p *Printer) DataType(tp parser.DataType) string {
+	var b strings.Builder
+	b.WriteString(p.Keyword(tp.Type().String()))
+	switch {
+	case len(tp.Value()) > 0:
+		var values []string
+		for _, e := range tp.Value() {
+			values = append(values, p.String(e))
+		}
+		b.WriteString("(" + strings.Join(values, ",") + ")")
+	case tp.Decimal() > 0:
+		fmt.Fprintf(&b, "(%d,%d)", tp.Length(), tp.Decimal())
+	case tp.Length() > 0:
+		fmt.Fprintf(&b, "(%d)", tp.Length())
+	}
+
+	if tp.Unsigned() {
+		b.WriteString(p.Keyword(" UNSIGNED"))
+	}
+	if len(tp.Charset()) > 0 {
+		b.WriteString(p.Keyword(" CHARACTER SET ") + tp.Charset())
+	}
+	if len(tp.Collation()) > 0 {
+		b.WriteString(p.Keyword(" COLLATE ") + tp.Collation())
+	}
+
+	return b.String()
+}
+
+// ColumnDefinition returns the definition of column, such as
+// `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY.
+func (p *Printer) ColumnDefinition(column *parser.Column) string {
+	list := []string{p.Identifier(column.Name), p.DataType(column.DataType)}
+	constraint := column.Constraint
+	if constraint == nil {
+		constraint = &parser.ColumnConstraint{}
+	}
+
+	if len(constraint.Generated) > 0 {
+		generated := p.Keyword("GENERATED ALWAYS AS") + " (" + constraint.Generated + ")"
+		if constraint.Stored {
+			generated += p.Keyword(" STORED")
+		} else {
+			generated += p.Keyword(" VIRTUAL")
+		}
+		list = append(list, generated)
+	}
+	if constraint.NotNull {
+		list = append(list, p.Keyword("NOT NULL"))
+	}
+	if len(constraint.DefaultValue) > 0 {
+		list = append(list, p.Keyword("DEFAULT ")+constraint.DefaultValue)
+	}
+	if len(constraint.OnUpdate) > 0 {
+		list = append(list, p.Keyword("ON UPDATE ")+constraint.OnUpdate)
+	}
+	if constraint.AutoIncrement {
+		list = append(list, p.Keyword("AUTO_INCREMENT"))
+	}
+	if constraint.Primary {
+		list = append(list, p.Keyword("PRIMARY KEY"))
+	} else if constraint.Key {
+		list = append(list, p.Keyword("KEY"))
+	}
+	if constraint.Unique {
+		list = append(list, p.Keyword("UNIQUE KEY"))
+	}
+	if constraint.SerialDefaultValue {
+		list = append(list, p.Keyword("SERIAL DEFAULT VALUE"))
+	}
+	if len(constraint.Comment) > 0 {
+		list = append(list, p.Keyword("COMMENT ")+p.String(constraint.Comment))
+	}
+	if len(constraint.ColumnFormat) > 0 {
+		list = append(list, p.Keyword("COLUMN_FORMAT "+constraint.ColumnFormat))
+	}
+	if len(constraint.Storage) > 0 {
+		list = append(list, p.Keyword("STORAGE "+constraint.Storage))
+	}
+	if len(constraint.Check) > 0 {
+		list = append(list, p.CheckDefinition(&parser.Check{Name: constraint.CheckName, Expression: constraint.Check}))
+	}
+
+	return strings.Join(list, " ")
+}
+
+// IndexDefinition returns the definition of index, such as
+// UNIQUE KEY `idx_name` (`name`(16), `age` DESC) USING BTREE.
+func (p *Printer) IndexDefinition(index *parser.Index) string {
+	var b strings.Builder
+	switch {
+	case index.Primary:
+		b.WriteString(p.Keyword("PRIMARY KEY"))
+	case index.Unique:
+		b.WriteString(p.Keyword("UNIQUE KEY"))
+	case index.Fulltext:
+		b.WriteString(p.Keyword("FULLTEXT KEY"))
+	case index.Spatial:
+		b.WriteString(p.Keyword("SPATIAL KEY"))
+	default:
+		b.WriteString(p.Keyword("KEY"))
+	}
+	if len(index.Name) > 0 {
+		b.WriteString(" " + p.Identifier(index.Name))
+	}
+
+	var columns []string
+	for _, e := range index.Columns {
+		column := p.Identifier(e.Name)
+		if e.Length > 0 {
+			column += fmt.Sprintf("(%d)", e.Length)
+		}
+		if e.Desc {
+			column += p.Keyword(" DESC")
+		}
+		columns = append(columns, column)
+	}
+	b.WriteString(" (" + strings.Join(columns, ", ") + ")")
+
+	if len(index.Using) > 0 {
+		b.WriteString(p.Keyword(" USING " + index.Using))
+	}
+	if len(index.Comment) > 0 {
+		b.WriteString(p.Keyword(" COMMENT ") + p.String(index.Comment))
+	}
+	if index.Invisible {
+		b.WriteString(p.Keyword(" INVISIBLE"))
+	}
+
+	return b.String()
+}
+
+// ForeignKeyDefinition returns the definition of foreign key, such as
+// CONSTRAINT `fk_org` FOREIGN KEY (`org_id`) REFERENCES `org` (`id`) ON DELETE CASCADE.
+func (p *Printer) ForeignKeyDefinition(fk *parser.ForeignKey) string {
+	var b strings.Builder
+	if len(fk.Name) > 0 {
+		b.WriteString(p.Keyword("CONSTRAINT ") + p.Identifier(fk.Name) + " ")
+	}
+
+	b.WriteString(p.Keyword("FOREIGN KEY") + " (" + p.identifierList(fk.Columns) + ") " + p.Keyword("REFERENCES "))
+	if len(fk.ReferenceSchema) > 0 {
+		b.WriteString(p.Identifier(fk.ReferenceSchema) + ".")
+	}
+	b.WriteString(p.Identifier(fk.ReferenceTable) + " (" + p.identifierList(fk.ReferenceColumns) + ")")
+
+	if len(fk.OnDelete) > 0 {
+		b.WriteString(p.Keyword(" ON DELETE " + fk.OnDelete))
+	}
+	if len(fk.OnUpdate) > 0 {
+		b.WriteString(p.Keyword(" ON UPDATE " + fk.OnUpdate))
+	}
+
+	return b.String()
+}
+
+// CheckDefinition returns the definition of check constraint, such as
+// CONSTRAINT `chk_age` CHECK (`age` > 0).
+func (p *Printer) CheckDefinition(check *parser.Check) string {
+	var b strings.Builder
+	if len(check.Name) > 0 {
+		b.WriteString(p.Keyword("CONSTRAINT ") + p.Identifier(check.Name) + " ")
+	}
+	b.WriteString(p.Keyword("CHECK") + " (" + check.Expression + ")")
+
+	return b.String()
+}
+
+// TableOption returns the table option, such as ENGINE=InnoDB, COMMENT='user'. The keyword case
+// applies to the storage of TABLESPACE and STORAGE, such as STORAGE DISK, the other option values
+// are literals and printed as they are, e.g. ENGINE=InnoDB keeps its case in LowerCase mode.
+func (p *Printer) TableOption(option *parser.TableOption) string {
+	switch option.Name {
+	case parser.TableOptionComment:
+		return p.Keyword(option.Name) + "=" + p.String(option.Value)
+	case parser.TableOptionCharset:
+		return p.Keyword("DEFAULT CHARSET") + "=" + option.Value
+	case parser.TableOptionTablespace:
+		value := option.Value
+		if i := strings.LastIndex(strings.ToUpper(value), " STORAGE "); i > 0 {
+			value = value[:i] + p.Keyword(value[i:])
+		}
+		return p.Keyword(option.Name) + " " + value
+	case parser.TableOptionStorage:
+		return p.Keyword(option.Name + " " + option.Value)
+	}
+
+	if len(option.Value) == 0 {
+		return p.Keyword(option.Name)
+	}
+
+	return p.Keyword(option.Name) + "=" + option.Value
+}
@@ -0,0 +1,249 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+// Package printer renders the parsed tables back to canonical CREATE TABLE statements,
+// the printed sql can be parsed into the equal tables again.
+package printer
+
+import (
+	"strings"
+
+	"github.com/zeromicro/ddl-parser/parser"
+)
+
+// QuoteMode describes how the identifiers are quoted.
+type QuoteMode int
+
+const (
+	// QuoteAlways quotes all identifiers with backticks.
+	QuoteAlways QuoteMode = iota
+	// QuoteMinimal quotes the identifiers only if they are keywords or contain special characters.
+	QuoteMinimal
+)
+
+// KeywordCase describes the letter case of keywords.
+type KeywordCase int
+
+const (
+	// UpperCase prints the keywords in upper case, such as NOT NULL.
+	UpperCase KeywordCase = iota
+	// LowerCase prints the keywords in lower case, such as not null.
+	LowerCase
+)
+
+// Printer renders the tables as sql, you can use NewPrinter to create an instance with
+// options, WithQuoteMode option sets how the identifiers are quoted, WithKeywordCase
+// option sets the letter case of keywords, WithIndent option sets the indentation of
+// create definitions.
+type Printer struct {
+	quoteMode   QuoteMode
+	keywordCase KeywordCase
+	indent      string
+}
+
+// Option is the alias of function.
+type Option func(p *Printer)
+
+// NewPrinter creates an instance of Printer.
+func NewPrinter(options ...Option) *Printer {
+	p := &Printer{indent: "  "}
+	for _, opt := range options {
+		opt(p)
+	}
+
+	return p
+}
+
+// WithQuoteMode is a Printer option to set how the identifiers are quoted, the default
+// mode is QuoteAlways.
+func WithQuoteMode(mode QuoteMode) Option {
+	return func(p *Printer) {
+		p.quoteMode = mode
+	}
+}
+
+// WithKeywordCase is a Printer option to set the letter case of keywords, the default
+// case is UpperCase.
+func WithKeywordCase(keywordCase KeywordCase) Option {
+	return func(p *Printer) {
+		p.keywordCase = keywordCase
+	}
+}
+
+// WithIndent is a Printer option to set the indentation of create definitions, the
+// default indentation is two spaces.
+func WithIndent(indent string) Option {
+	return func(p *Printer) {
+		p.indent = indent
+	}
+}
+
+// Print returns the CREATE TABLE statements of tables, the statements are separated by
+// a blank line.
+func (p *Printer) Print(tables ...*parser.Table) string {
+	var list []string
+	for _, e := range tables {
+		list = append(list, p.CreateTable(e))
+	}
+
+	return strings.Join(list, "\n\n")
+}
+
+// CreateTable returns the CREATE TABLE statement of table, the comments of table are printed
+// before the statement, the comments of column are printed before the column.
+func (p *Printer) CreateTable(table *parser.Table) string {
+	var b strings.Builder
+	for _, e := range table.Comments {
+		b.WriteString(e + "\n")
+	}
+	b.WriteString(p.Keyword("CREATE TABLE ") + p.TableName(table) + " (\n")
+
+	definitions := p.createDefinitions(table)
+	for i, e := range definitions {
+		for _, comment := range e.comments {
+			b.WriteString(p.indent + comment + "\n")
+		}
+		b.WriteString(p.indent + e.text)
+		if i < len(definitions)-1 {
+			b.WriteString(",")
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString(")")
+
+	for _, e := range table.Options {
+		b.WriteString(" " + p.TableOption(e))
+	}
+	if len(table.Partition) > 0 {
+		b.WriteString("\n" + table.Partition)
+	}
+	b.WriteString(";")
+
+	return b.String()
+}
+
+type definition struct {
+	text     string
+	comments []string
+}
+
+// createDefinitions returns the columns, indexes and constraints of table, the order of
+// indexes and the order of constraints are kept.
+func (p *Printer) createDefinitions(table *parser.Table) []*definition {
+	var list []*definition
+	for _, e := range table.Columns {
+		list = append(list, &definition{text: p.ColumnDefinition(e), comments: e.Comments})
+	}
+
+	// the primary key and unique key constraints are printed as indexes
+	constraints := table.Constraints
+	for _, e := range table.Indexes {
+		if e.Primary || e.Unique {
+			for len(constraints) > 0 && !isKeyConstraint(constraints[0]) {
+				list = append(list, p.constraint(constraints[0])...)
+				constraints = constraints[1:]
+			}
+			if len(constraints) > 0 {
+				constraints = constraints[1:]
+			}
+		}
+
+		list = append(list, &definition{text: p.IndexDefinition(e)})
+	}
+	for _, e := range constraints {
+		if !isKeyConstraint(e) {
+			list = append(list, p.constraint(e)...)
+		}
+	}
+
+	return list
+}
+
+func (p *Printer) constraint(constraint *parser.TableConstraint) []*definition {
+	var list []*definition
+	if constraint.ForeignKey != nil {
+		list = append(list, &definition{text: p.ForeignKeyDefinition(constraint.ForeignKey)})
+	}
+	if constraint.Check != nil {
+		list = append(list, &definition{text: p.CheckDefinition(constraint.Check)})
+	}
+
+	return list
+}
+
+func isKeyConstraint(constraint *parser.TableConstraint) bool {
+	return len(constraint.ColumnPrimaryKey) > 0 || len(constraint.ColumnUniqueKey) > 0
+}
+
+// Keyword returns the keyword in the letter case of Printer.
+func (p *Printer) Keyword(keyword string) string {
+	if p.keywordCase == LowerCase {
+		return strings.ToLower(keyword)
+	}
+
+	return strings.ToUpper(keyword)
+}
+
+// TableName returns the quoted name of table, the schema is included if it's not empty.
+func (p *Printer) TableName(table *parser.Table) string {
+	if len(table.Schema) == 0 {
+		return p.Identifier(table.Name)
+	}
+
+	return p.Identifier(table.Schema) + "." + p.Identifier(table.Name)
+}
+
+// Identifier returns the identifier quoted according to the QuoteMode of Printer.
+func (p *Printer) Identifier(name string) string {
+	if p.quoteMode == QuoteMinimal && !needQuote(name) {
+		return name
+	}
+
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
+
+// String returns the single quoted string literal, the single quotes which are not escaped
+// are escaped.
+func (p *Printer) String(s string) string {
+	var b strings.Builder
+	b.WriteString("'")
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '\\' && i+1 < len(s):
+			b.WriteString(s[i : i+2])
+			i++
+		case s[i] == '\'' && i+1 < len(s) && s[i+1] == '\'':
+			b.WriteString("''")
+			i++
+		case s[i] == '\'':
+			b.WriteString("''")
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	b.WriteString("'")
+
+	return b.String()
+}
+
+func (p *Printer) identifierList(list []string) string {
+	var ret []string
+	for _, e := range list {
+		ret = append(ret, p.Identifier(e))
+	}
+
+	return strings.Join(ret, ", ")
+}
@@ -0,0 +1,100 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package printer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zeromicro/ddl-parser/internal/testutil"
+)
+
+const schemaSql = "CREATE TABLE `shop`.`user` (\n" +
+	"  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,\n" +
+	"  `name` varchar(64) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin NOT NULL DEFAULT '' COMMENT 'user''s name',\n" +
+	"  `status` enum('on','off') NOT NULL DEFAULT 'on',\n" +
+	"  `balance` decimal(10,2) DEFAULT NULL,\n" +
+	"  `org_id` bigint NOT NULL,\n" +
+	"  `code` char(8) UNIQUE,\n" +
+	"  `created` datetime(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3),\n" +
+	"  `total` decimal(12,2) GENERATED ALWAYS AS (`balance` * 2) STORED NOT NULL COLUMN_FORMAT FIXED,\n" +
+	"  `initial` char(1) AS (left(`name`, 1)) VIRTUAL STORAGE MEMORY,\n" +
+	"  `age` int CONSTRAINT `chk_age` CHECK (`age` > 0),\n" +
+	"  `seq` bigint unsigned SERIAL DEFAULT VALUE,\n" +
+	"  PRIMARY KEY (`id`),\n" +
+	"  CONSTRAINT `fk_org` FOREIGN KEY (`org_id`) REFERENCES `org` (`id`) ON DELETE CASCADE,\n" +
+	"  UNIQUE KEY `uk_name` (`name`(16)) USING BTREE COMMENT 'name',\n" +
+	"  KEY `idx_org` (`org_id`, `created` DESC),\n" +
+	"  FULLTEXT KEY (`name`),\n" +
+	"  CONSTRAINT `chk_balance` CHECK (`balance` >= 0)\n" +
+	") ENGINE=InnoDB AUTO_INCREMENT=100 DEFAULT CHARSET=utf8mb4 ROW_FORMAT=DYNAMIC COMMENT='user'\n" +
+	"PARTITION BY HASH (`id`) PARTITIONS 4;\n" +
+	"CREATE TABLE `order` (`id` bigint KEY, `user_id` bigint) TABLESPACE `ts` STORAGE DISK;"
+
+func TestPrinter_Print(t *testing.T) {
+	tables := testutil.Tables(t, schemaSql)
+	sql := NewPrinter().Print(tables...)
+	assert.Equal(t, "CREATE TABLE `shop`.`user` (\n"+
+		"  `id` BIGINT(20) UNSIGNED NOT NULL AUTO_INCREMENT,\n"+
+		"  `name` VARCHAR(64) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin NOT NULL DEFAULT '' COMMENT 'user''s name',\n"+
+		"  `status` ENUM('on','off') NOT NULL DEFAULT 'on',\n"+
+		"  `balance` DECIMAL(10,2) DEFAULT NULL,\n"+
+		"  `org_id` BIGINT NOT NULL,\n"+
+		"  `code` CHAR(8) UNIQUE KEY,\n"+
+		"  `created` DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3),\n"+
+		"  `total` DECIMAL(12,2) GENERATED ALWAYS AS (`balance` * 2) STORED NOT NULL COLUMN_FORMAT FIXED,\n"+
+		"  `initial` CHAR(1) GENERATED ALWAYS AS (left(`name`, 1)) VIRTUAL STORAGE MEMORY,\n"+
+		"  `age` INT CONSTRAINT `chk_age` CHECK (`age` > 0),\n"+
+		"  `seq` BIGINT UNSIGNED SERIAL DEFAULT VALUE,\n"+
+		"  PRIMARY KEY (`id`),\n"+
+		"  CONSTRAINT `fk_org` FOREIGN KEY (`org_id`) REFERENCES `org` (`id`) ON DELETE CASCADE,\n"+
+		"  UNIQUE KEY `uk_name` (`name`(16)) USING BTREE COMMENT 'name',\n"+
+		"  KEY `idx_org` (`org_id`, `created` DESC),\n"+
+		"  FULLTEXT KEY (`name`),\n"+
+		"  CONSTRAINT `chk_balance` CHECK (`balance` >= 0)\n"+
+		") ENGINE=InnoDB AUTO_INCREMENT=100 DEFAULT CHARSET=utf8mb4 ROW_FORMAT=DYNAMIC COMMENT='user'\n"+
+		"PARTITION BY HASH (`id`) PARTITIONS 4;\n\n"+
+		"CREATE TABLE `order` (\n"+
+		"  `id` BIGINT KEY,\n"+
+		"  `user_id` BIGINT\n"+
+		") TABLESPACE `ts` STORAGE DISK;", sql)
+
+	// parse -> print -> parse yields the equal tables
+	assert.Equal(t, tables, testutil.Tables(t, sql))
+}
+
+func TestPrinter_options(t *testing.T) {
+	tables := testutil.Tables(t, schemaSql)
+	p := NewPrinter(WithQuoteMode(QuoteMinimal), WithKeywordCase(LowerCase), WithIndent("\t"))
+	sql := p.Print(tables...)
+	assert.Equal(t, "create table `order` (\n"+
+		"\tid bigint key,\n"+
+		"\tuser_id bigint\n"+
+		") tablespace `ts` storage disk;", p.CreateTable(tables[1]))
+	assert.Equal(t, tables, testutil.Tables(t, sql))
+}
+
+func TestPrinter_Identifier(t *testing.T) {
+	p := NewPrinter(WithQuoteMode(QuoteMinimal))
+	assert.Equal(t, "user_id", p.Identifier("user_id"))
+	assert.Equal(t, "`order`", p.Identifier("order"))
+	assert.Equal(t, "`1st`", p.Identifier("1st"))
+	assert.Equal(t, "`user name`", p.Identifier("user name"))
+	assert.Equal(t, "`a``b`", NewPrinter().Identifier("a`b"))
+	assert.Equal(t, "'it''s'", p.String("it's"))
+	assert.Equal(t, `'it\'s'`, p.String(`it\'s`))
+}
@@ -0,0 +1,46 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package printer
+
+import (
+	"strings"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"github.com/zeromicro/ddl-parser/gen"
+)
+
+// needQuote returns true if the name is not a plain identifier, the name is lexed by
+// MySqlLexer, the keywords such as ORDER, STATUS are lexed as other tokens than ID.
+func needQuote(name string) bool {
+	if len(name) == 0 {
+		return true
+	}
+
+	for i, c := range name {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c == '_', c == '$':
+		case c >= '0' && c <= '9' && i > 0:
+		default:
+			return true
+		}
+	}
+
+	lexer := gen.NewMySqlLexer(antlr.NewInputStream(strings.ToUpper(name)))
+	lexer.RemoveErrorListeners()
+	tokens := lexer.GetAllTokens()
+	return len(tokens) != 1 || tokens[0].GetTokenType() != gen.MySqlLexerID
+}
@@ -0,0 +1,228 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+// Package protogen generates the proto3 messages of parsed tables, the columns are mapped to
+// the scalar types by their widths, the temporal columns are mapped to the well-known types,
+// the nullable columns are mapped to the wrappers, and the ENUM columns are mapped to enums.
+package protogen
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/zeromicro/ddl-parser/parser"
+)
+
+// Generator generates the proto3 messages, you can use NewGenerator to create an instance with
+// options, WithPackage option sets the proto package, WithGoPackage option sets the go_package
+// option, WithWrappers option sets whether the nullable columns are mapped to wrappers,
+// WithFieldNumber option pins the field number of column.
+type Generator struct {
+	packageName string
+	goPackage   string
+	wrappers    bool
+	numbers     map[string]map[string]int
+}
+
+// Option is the alias of function.
+type Option func(g *Generator)
+
+// NewGenerator creates an instance of Generator.
+func NewGenerator(options ...Option) *Generator {
+	g := &Generator{
+		packageName: "model",
+		wrappers:    true,
+		numbers:     make(map[string]map[string]int),
+	}
+	for _, opt := range options {
+		opt(g)
+	}
+
+	return g
+}
+
+// WithPackage is a Generator option to set the proto package, the default package is model.
+func WithPackage(name string) Option {
+	return func(g *Generator) {
+		g.packageName = name
+	}
+}
+
+// WithGoPackage is a Generator option to set the go_package option, the option is omitted
+// if it's empty.
+func WithGoPackage(goPackage string) Option {
+	return func(g *Generator) {
+		g.goPackage = goPackage
+	}
+}
+
+// WithWrappers is a Generator option to set whether the nullable columns are mapped to the
+// wrappers, such as google.protobuf.StringValue, it's enabled by default.
+func WithWrappers(wrappers bool) Option {
+	return func(g *Generator) {
+		g.wrappers = wrappers
+	}
+}
+
+// WithFieldNumber is a Generator option to pin the field number of column, so the numbers of
+// existing fields are kept while the columns are added or reordered. The columns which are
+// not pinned are numbered by their order with the numbers which are not pinned.
+func WithFieldNumber(table, column string, number int) Option {
+	return func(g *Generator) {
+		table = strings.ToLower(table)
+		if g.numbers[table] == nil {
+			g.numbers[table] = make(map[string]int)
+		}
+		g.numbers[table][strings.ToLower(column)] = number
+	}
+}
+
+// Generate returns the proto file which declares a message for each table. The names which
+// collide with the declared names are suffixed with numbers, such as user_id_2 for the columns
+// user_id and user-id, and the names without ASCII letters or digits fall back to the names
+// with numbers, such as Table1, field_1 and VALUE_1.
+func (g *Generator) Generate(tables ...*parser.Table) []byte {
+	var body strings.Builder
+	imports := make(map[string]bool)
+	messages := make(scope)
+	for i, e := range tables {
+		name := pascalCase(e.Name)
+		if len(name) == 0 {
+			name = fmt.Sprintf("Table%d", i+1)
+		}
+		g.writeMessage(&body, messages.declare(name, ""), e, imports)
+	}
+
+	var b strings.Builder
+	b.WriteString("syntax = \"proto3\";\n\n")
+	b.WriteString("package " + g.packageName + ";\n\n")
+	if len(imports) > 0 {
+		var list []string
+		for e := range imports {
+			list = append(list, e)
+		}
+		sort.Strings(list)
+
+		for _, e := range list {
+			b.WriteString("import \"" + e + "\";\n")
+		}
+		b.WriteString("\n")
+	}
+	if len(g.goPackage) > 0 {
+		b.WriteString("option go_package = \"" + g.goPackage + "\";\n\n")
+	}
+	b.WriteString(strings.TrimSuffix(body.String(), "\n"))
+
+	return []byte(b.String())
+}
+
+func (g *Generator) writeMessage(b *strings.Builder, name string, table *parser.Table, imports map[string]bool) {
+	fmt.Fprintf(b, "// %s describes the table %s.\n", name, table.Name)
+	for _, option := range table.Options {
+		if option.Name == parser.TableOptionComment {
+			writeComment(b, "", option.Value)
+		}
+	}
+	b.WriteString("message " + name + " {\n")
+
+	numbers := g.fieldNumbers(table)
+	fields := make(scope)
+	names := make([]string, len(table.Columns))
+	for i, e := range table.Columns {
+		name := snakeCase(e.Name)
+		if len(name) == 0 {
+			name = fmt.Sprintf("field_%d", numbers[i])
+		}
+		names[i] = fields.declare(name, "_")
+	}
+
+	// the enum values are siblings of the fields and the enums in the scope of message
+	values := make(scope)
+	for i, e := range table.Columns {
+		if e.DataType != nil && e.DataType.Type() == parser.Enum {
+			writeEnum(b, names[i], e, values)
+			b.WriteString("\n")
+		}
+	}
+
+	for i, e := range table.Columns {
+		tp, imp := g.fieldType(table, e, names[i])
+		if len(imp) > 0 {
+			imports[imp] = true
+		}
+
+		if e.Constraint != nil && len(e.Constraint.Comment) > 0 {
+			writeComment(b, "  ", e.Constraint.Comment)
+		}
+		fmt.Fprintf(b, "  %s %s = %d;\n", tp, names[i], numbers[i])
+	}
+	b.WriteString("}\n\n")
+}
+
+// writeEnum writes the enum of ENUM column whose field name is the given name, the zero value is
+// declared as UNSPECIFIED since the first value of proto3 enum must be zero. The empty value is
+// declared as EMPTY, and the value without ASCII letters or digits is declared by its number,
+// such as VALUE_1.
+func writeEnum(b *strings.Builder, field string, column *parser.Column, values scope) {
+	prefix := strings.ToUpper(field)
+	fmt.Fprintf(b, "  enum %s {\n", pascalCase(field))
+	fmt.Fprintf(b, "    %s = 0;\n", values.declare(prefix+"_UNSPECIFIED", "_"))
+	for i, e := range column.DataType.Value() {
+		value := parser.Unescape(e)
+		name := strings.ToUpper(snakeCase(value))
+		switch {
+		case len(value) == 0:
+			name = "EMPTY"
+		case len(name) == 0:
+			name = fmt.Sprintf("VALUE_%d", i+1)
+		}
+		fmt.Fprintf(b, "    %s = %d;\n", values.declare(prefix+"_"+name, "_"), i+1)
+	}
+	b.WriteString("  }\n")
+}
+
+// fieldNumbers returns the field numbers of columns in order.
+func (g *Generator) fieldNumbers(table *parser.Table) []int {
+	pinned := g.numbers[strings.ToLower(table.Name)]
+	used := make(map[int]bool)
+	for _, e := range pinned {
+		used[e] = true
+	}
+
+	var ret []int
+	next := 1
+	for _, e := range table.Columns {
+		if number, ok := pinned[strings.ToLower(e.Name)]; ok {
+			ret = append(ret, number)
+			continue
+		}
+
+		for used[next] || (next >= 19000 && next <= 19999) {
+			next++
+		}
+		used[next] = true
+		ret = append(ret, next)
+	}
+
+	return ret
+}
+
+func writeComment(b *strings.Builder, indent, comment string) {
+	for _, e := range strings.Split(parser.Unescape(comment), "\n") {
+		b.WriteString(indent + "// " + strings.TrimSpace(e) + "\n")
+	}
+}
@@ -0,0 +1,127 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package protogen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zeromicro/ddl-parser/internal/testutil"
+)
+
+const schemaSql = "CREATE TABLE `user_info` (\n" +
+	"  `id` bigint unsigned NOT NULL AUTO_INCREMENT,\n" +
+	"  `name` varchar(64) NOT NULL DEFAULT '' COMMENT 'user''s name',\n" +
+	"  `age` tinyint,\n" +
+	"  `balance` decimal(10,2) NOT NULL,\n" +
+	"  `status` enum('on','off-line') NOT NULL DEFAULT 'on',\n" +
+	"  `avatar` blob,\n" +
+	"  `created_at` datetime NOT NULL,\n" +
+	"  `duration` time,\n" +
+	"  PRIMARY KEY (`id`)\n" +
+	") COMMENT='users';"
+
+func TestGenerator_Generate(t *testing.T) {
+	actual := NewGenerator(WithPackage("user"), WithGoPackage("example.com/user")).Generate(testutil.Tables(t, schemaSql)...)
+	assert.Equal(t, `syntax = "proto3";
+
+package user;
+
+import "google/protobuf/duration.proto";
+import "google/protobuf/timestamp.proto";
+import "google/protobuf/wrappers.proto";
+
+option go_package = "example.com/user";
+
+// UserInfo describes the table user_info.
+// users
+message UserInfo {
+  enum Status {
+    STATUS_UNSPECIFIED = 0;
+    STATUS_ON = 1;
+    STATUS_OFF_LINE = 2;
+  }
+
+  uint64 id = 1;
+  // user's name
+  string name = 2;
+  google.protobuf.Int32Value age = 3;
+  string balance = 4;
+  Status status = 5;
+  google.protobuf.BytesValue avatar = 6;
+  google.protobuf.Timestamp created_at = 7;
+  google.protobuf.Duration duration = 8;
+}
+`, string(actual))
+}
+
+func TestGenerator_options(t *testing.T) {
+	actual := NewGenerator(
+		WithWrappers(false),
+		WithFieldNumber("USER_INFO", "Status", 2),
+		WithFieldNumber("user_info", "name", 10),
+	).Generate(testutil.Tables(t, schemaSql)...)
+	assert.Contains(t, string(actual), "package model;\n\n"+
+		"import \"google/protobuf/duration.proto\";\n"+
+		"import \"google/protobuf/timestamp.proto\";\n\n"+
+		"// UserInfo")
+	assert.Contains(t, string(actual), "  uint64 id = 1;\n"+
+		"  // user's name\n"+
+		"  string name = 10;\n"+
+		"  int32 age = 3;\n"+
+		"  string balance = 4;\n"+
+		"  Status status = 2;\n"+
+		"  bytes avatar = 5;\n")
+}
+
+func TestGenerator_names(t *testing.T) {
+	table := testutil.Table(t, "CREATE TABLE `用户` ("+
+		"`user_id` bigint NOT NULL, `user-id` bigint NOT NULL, `性别` enum('男','女') NOT NULL, "+
+		"`kind` enum('a-b','a_b','unspecified','') NOT NULL);")
+	actual := NewGenerator(WithWrappers(false)).Generate(table)
+	assert.Contains(t, string(actual), "message Table1 {\n"+
+		"  enum Field3 {\n"+
+		"    FIELD_3_UNSPECIFIED = 0;\n"+
+		"    FIELD_3_VALUE_1 = 1;\n"+
+		"    FIELD_3_VALUE_2 = 2;\n"+
+		"  }\n\n"+
+		"  enum Kind {\n"+
+		"    KIND_UNSPECIFIED = 0;\n"+
+		"    KIND_A_B = 1;\n"+
+		"    KIND_A_B_2 = 2;\n"+
+		"    KIND_UNSPECIFIED_2 = 3;\n"+
+		"    KIND_EMPTY = 4;\n"+
+		"  }\n\n"+
+		"  int64 user_id = 1;\n"+
+		"  int64 user_id_2 = 2;\n"+
+		"  Field3 field_3 = 3;\n"+
+		"  Kind kind = 4;\n"+
+		"}")
+}
+
+func Test_snakeCase(t *testing.T) {
+	testData := map[string]string{
+		"UserName":  "username",
+		"user-name": "user_name",
+		"_id_":      "id",
+		"2fa":       "x2fa",
+	}
+	for name, expected := range testData {
+		assert.Equal(t, expected, snakeCase(name), name)
+	}
+	assert.Equal(t, "UserInfo", pascalCase("user_info"))
+}
@@ -0,0 +1,144 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package protogen
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/zeromicro/ddl-parser/parser"
+)
+
+const (
+	timestampProto = "google/protobuf/timestamp.proto"
+	durationProto  = "google/protobuf/duration.proto"
+	wrappersProto  = "google/protobuf/wrappers.proto"
+)
+
+// scalarWrappers describes the wrappers of scalar types.
+var scalarWrappers = map[string]string{
+	"double": "google.protobuf.DoubleValue",
+	"float":  "google.protobuf.FloatValue",
+	"int64":  "google.protobuf.Int64Value",
+	"uint64": "google.protobuf.UInt64Value",
+	"int32":  "google.protobuf.Int32Value",
+	"uint32": "google.protobuf.UInt32Value",
+	"bool":   "google.protobuf.BoolValue",
+	"string": "google.protobuf.StringValue",
+	"bytes":  "google.protobuf.BytesValue",
+}
+
+// fieldType returns the type of field and the proto file to import, the import is empty if
+// the type is a scalar type or an enum, the enum is named after the field.
+func (g *Generator) fieldType(table *parser.Table, column *parser.Column, field string) (string, string) {
+	if column.DataType == nil {
+		return "string", ""
+	}
+
+	kind := column.DataType.Type()
+	switch {
+	case kind == parser.Enum:
+		return pascalCase(field), ""
+	case kind == parser.Time:
+		return "google.protobuf.Duration", durationProto
+	case kind.IsTemporal() && kind != parser.Year:
+		return "google.protobuf.Timestamp", timestampProto
+	}
+
+	tp := scalarType(column.DataType)
+	if g.wrappers && !table.IsNotNull(column) {
+		return scalarWrappers[tp], wrappersProto
+	}
+
+	return tp, ""
+}
+
+func scalarType(dataType parser.DataType) string {
+	kind, unsigned := dataType.Type(), dataType.Unsigned()
+	switch {
+	case kind == parser.Bool || kind == parser.Boolean:
+		return "bool"
+	case kind == parser.BigInt || kind == parser.Int8 || kind == parser.Serial || kind == parser.Bit:
+		if unsigned || kind == parser.Serial || kind == parser.Bit {
+			return "uint64"
+		}
+		return "int64"
+	case kind.IsInteger(), kind == parser.Year:
+		if unsigned {
+			return "uint32"
+		}
+		return "int32"
+	case kind == parser.Float || kind == parser.Float4:
+		return "float"
+	case kind.IsFloatingPoint():
+		return "double"
+	case kind.IsBinary(), kind.IsSpatial():
+		return "bytes"
+	}
+
+	// the fixed-point numbers are represented as strings to keep the precision
+	return "string"
+}
+
+// pascalCase returns the name of message or enum, such as user_info to UserInfo.
+func pascalCase(name string) string {
+	var b strings.Builder
+	for _, e := range strings.Split(snakeCase(name), "_") {
+		if len(e) > 0 {
+			b.WriteString(strings.ToUpper(e[:1]) + e[1:])
+		}
+	}
+
+	return b.String()
+}
+
+// snakeCase returns the name of field, the characters which are not letters or digits are
+// replaced by underscores, such as user-name to user_name.
+func snakeCase(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			b.WriteRune(r)
+		} else {
+			b.WriteRune('_')
+		}
+	}
+
+	ret := strings.Trim(b.String(), "_")
+	if len(ret) > 0 && unicode.IsDigit(rune(ret[0])) {
+		ret = "x" + ret
+	}
+
+	return ret
+}
+
+// scope describes the declared names of a proto scope, such as the messages of a file and the
+// fields of a message.
+type scope map[string]bool
+
+// declare declares the name in scope, the name is suffixed with the separator and a number if
+// it collides with a declared name, such as user_id_2 for both of user_id and user-id.
+func (s scope) declare(name, separator string) string {
+	ret := name
+	for i := 2; s[ret]; i++ {
+		ret = name + separator + strconv.Itoa(i)
+	}
+	s[ret] = true
+
+	return ret
+}
@@ -0,0 +1,242 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package schema
+
+import (
+	"fmt"
+
+	"github.com/zeromicro/ddl-parser/parser"
+)
+
+func newTable(table *parser.Table) *Table {
+	ret := Table{
+		Schema:    table.Schema,
+		Name:      table.Name,
+		Partition: table.Partition,
+		Comments:  table.Comments,
+	}
+	for _, e := range table.Columns {
+		ret.Columns = append(ret.Columns, newColumn(e))
+	}
+	for _, e := range table.Constraints {
+		ret.Constraints = append(ret.Constraints, newConstraint(e))
+	}
+	for _, e := range table.Indexes {
+		ret.Indexes = append(ret.Indexes, newIndex(e))
+	}
+	for _, e := range table.Options {
+		ret.Options = append(ret.Options, &Option{Name: e.Name, Value: e.Value})
+	}
+
+	return &ret
+}
+
+func newColumn(column *parser.Column) *Column {
+	ret := Column{
+		Name:     column.Name,
+		Comments: column.Comments,
+	}
+	if column.DataType != nil {
+		ret.Type = &DataType{
+			Name:      column.DataType.Type().String(),
+			Unsigned:  column.DataType.Unsigned(),
+			Length:    column.DataType.Length(),
+			Decimal:   column.DataType.Decimal(),
+			Values:    column.DataType.Value(),
+			Charset:   column.DataType.Charset(),
+			Collation: column.DataType.Collation(),
+		}
+	}
+	if c := column.Constraint; c != nil {
+		ret.NotNull = c.NotNull
+		ret.HasDefaultValue = c.HasDefaultValue
+		ret.DefaultValue = c.DefaultValue
+		ret.AutoIncrement = c.AutoIncrement
+		ret.OnUpdate = c.OnUpdate
+		ret.Primary = c.Primary
+		ret.Key = c.Key
+		ret.Unique = c.Unique
+		ret.Comment = c.Comment
+		ret.Generated = c.Generated
+		ret.Stored = c.Stored
+		ret.CheckName = c.CheckName
+		ret.Check = c.Check
+		ret.ColumnFormat = c.ColumnFormat
+		ret.Storage = c.Storage
+		ret.SerialDefaultValue = c.SerialDefaultValue
+	}
+
+	return &ret
+}
+
+func newConstraint(constraint *parser.TableConstraint) *Constraint {
+	ret := Constraint{
+		PrimaryKey: constraint.ColumnPrimaryKey,
+		UniqueKey:  constraint.ColumnUniqueKey,
+	}
+	if fk := constraint.ForeignKey; fk != nil {
+		ret.ForeignKey = &ForeignKey{
+			Name:             fk.Name,
+			Columns:          fk.Columns,
+			ReferenceSchema:  fk.ReferenceSchema,
+			ReferenceTable:   fk.ReferenceTable,
+			ReferenceColumns: fk.ReferenceColumns,
+			OnDelete:         fk.OnDelete,
+			OnUpdate:         fk.OnUpdate,
+		}
+	}
+	if check := constraint.Check; check != nil {
+		ret.Check = &Check{Name: check.Name, Expression: check.Expression}
+	}
+
+	return &ret
+}
+
+func newIndex(index *parser.Index) *Index {
+	ret := Index{
+		Name:      index.Name,
+		Primary:   index.Primary,
+		Unique:    index.Unique,
+		Fulltext:  index.Fulltext,
+		Spatial:   index.Spatial,
+		Using:     index.Using,
+		Comment:   index.Comment,
+		Invisible: index.Invisible,
+	}
+	for _, e := range index.Columns {
+		ret.Columns = append(ret.Columns, &IndexColumn{Name: e.Name, Length: e.Length, Desc: e.Desc})
+	}
+
+	return &ret
+}
+
+func (t *Table) table() (*parser.Table, error) {
+	ret := parser.Table{
+		Schema:    t.Schema,
+		Name:      t.Name,
+		Partition: t.Partition,
+		Comments:  t.Comments,
+	}
+	for _, e := range t.Columns {
+		column, err := e.column()
+		if err != nil {
+			return nil, fmt.Errorf("table %s: %w", t.Name, err)
+		}
+
+		ret.Columns = append(ret.Columns, column)
+	}
+	for _, e := range t.Constraints {
+		ret.Constraints = append(ret.Constraints, e.constraint())
+	}
+	for _, e := range t.Indexes {
+		ret.Indexes = append(ret.Indexes, e.index())
+	}
+	for _, e := range t.Options {
+		ret.Options = append(ret.Options, &parser.TableOption{Name: e.Name, Value: e.Value})
+	}
+
+	return &ret, nil
+}
+
+func (c *Column) column() (*parser.Column, error) {
+	ret := parser.Column{
+		Name: c.Name,
+		Constraint: &parser.ColumnConstraint{
+			NotNull:            c.NotNull,
+			HasDefaultValue:    c.HasDefaultValue,
+			DefaultValue:       c.DefaultValue,
+			AutoIncrement:      c.AutoIncrement,
+			OnUpdate:           c.OnUpdate,
+			Primary:            c.Primary,
+			Key:                c.Key,
+			Unique:             c.Unique,
+			Comment:            c.Comment,
+			Generated:          c.Generated,
+			Stored:             c.Stored,
+			CheckName:          c.CheckName,
+			Check:              c.Check,
+			ColumnFormat:       c.ColumnFormat,
+			Storage:            c.Storage,
+			SerialDefaultValue: c.SerialDefaultValue,
+		},
+		Comments: c.Comments,
+	}
+	if c.Type != nil {
+		dataType, err := c.Type.dataType()
+		if err != nil {
+			return nil, fmt.Errorf("column %s: %w", c.Name, err)
+		}
+
+		ret.DataType = dataType
+	}
+
+	return &ret, nil
+}
+
+func (d *DataType) dataType() (parser.DataType, error) {
+	tp, ok := parser.LookupKind(d.Name)
+	if !ok {
+		return nil, fmt.Errorf("unknown data type %q", d.Name)
+	}
+
+	if len(d.Values) > 0 {
+		return parser.NewEnumSetDataType(tp, d.Values, d.Charset, d.Collation), nil
+	}
+
+	return parser.NewNormalDataType(tp, d.Unsigned, d.Length, d.Decimal, d.Charset, d.Collation), nil
+}
+
+func (c *Constraint) constraint() *parser.TableConstraint {
+	ret := parser.TableConstraint{
+		ColumnPrimaryKey: c.PrimaryKey,
+		ColumnUniqueKey:  c.UniqueKey,
+	}
+	if fk := c.ForeignKey; fk != nil {
+		ret.ForeignKey = &parser.ForeignKey{
+			Name:             fk.Name,
+			Columns:          fk.Columns,
+			ReferenceSchema:  fk.ReferenceSchema,
+			ReferenceTable:   fk.ReferenceTable,
+			ReferenceColumns: fk.ReferenceColumns,
+			OnDelete:         fk.OnDelete,
+			OnUpdate:         fk.OnUpdate,
+		}
+	}
+	if check := c.Check; check != nil {
+		ret.Check = &parser.Check{Name: check.Name, Expression: check.Expression}
+	}
+
+	return &ret
+}
+
+func (i *Index) index() *parser.Index {
+	ret := parser.Index{
+		Name:      i.Name,
+		Primary:   i.Primary,
+		Unique:    i.Unique,
+		Fulltext:  i.Fulltext,
+		Spatial:   i.Spatial,
+		Using:     i.Using,
+		Comment:   i.Comment,
+		Invisible: i.Invisible,
+	}
+	for _, e := range i.Columns {
+		ret.Columns = append(ret.Columns, &parser.IndexColumn{Name: e.Name, Length: e.Length, Desc: e.Desc})
+	}
+
+	return &ret
+}
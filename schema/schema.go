@@ -0,0 +1,223 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+// Package schema encodes the parsed tables as JSON or YAML in a stable and versioned shape,
+// and decodes them back into the tables, so the tools which are not written in Go can consume
+// the output of parser. The shape of document is described by Document, the data types are
+// encoded with the names in sql, the empty fields are omitted, for example:
+//
+//	{
+//	  "version": 1,
+//	  "tables": [
+//	    {
+//	      "name": "user",
+//	      "columns": [
+//	        {
+//	          "name": "id",
+//	          "type": {"name": "BIGINT", "unsigned": true},
+//	          "notNull": true,
+//	          "autoIncrement": true
+//	        },
+//	        {
+//	          "name": "status",
+//	          "type": {"name": "ENUM", "values": ["on", "off"]},
+//	          "hasDefaultValue": true,
+//	          "defaultValue": "'on'"
+//	        }
+//	      ],
+//	      "constraints": [{"primaryKey": ["id"]}],
+//	      "indexes": [{"primary": true, "columns": [{"name": "id"}]}],
+//	      "options": [{"name": "ENGINE", "value": "InnoDB"}]
+//	    }
+//	  ]
+//	}
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/zeromicro/ddl-parser/parser"
+	"gopkg.in/yaml.v3"
+)
+
+// Version describes the version of document shape, it's increased if the shape is changed
+// incompatibly.
+const Version = 1
+
+// Document describes the encoded tables.
+type Document struct {
+	Version int      `json:"version" yaml:"version"`
+	Tables  []*Table `json:"tables" yaml:"tables"`
+}
+
+// Table describes the encoded parser.Table.
+type Table struct {
+	Schema      string        `json:"schema,omitempty" yaml:"schema,omitempty"`
+	Name        string        `json:"name" yaml:"name"`
+	Columns     []*Column     `json:"columns,omitempty" yaml:"columns,omitempty"`
+	Constraints []*Constraint `json:"constraints,omitempty" yaml:"constraints,omitempty"`
+	Indexes     []*Index      `json:"indexes,omitempty" yaml:"indexes,omitempty"`
+	Options     []*Option     `json:"options,omitempty" yaml:"options,omitempty"`
+	Partition   string        `json:"partition,omitempty" yaml:"partition,omitempty"`
+	Comments    []string      `json:"comments,omitempty" yaml:"comments,omitempty"`
+}
+
+// Column describes the encoded parser.Column, the fields of constraint are inlined.
+type Column struct {
+	Name               string    `json:"name" yaml:"name"`
+	Type               *DataType `json:"type,omitempty" yaml:"type,omitempty"`
+	NotNull            bool      `json:"notNull,omitempty" yaml:"notNull,omitempty"`
+	HasDefaultValue    bool      `json:"hasDefaultValue,omitempty" yaml:"hasDefaultValue,omitempty"`
+	DefaultValue       string    `json:"defaultValue,omitempty" yaml:"defaultValue,omitempty"`
+	AutoIncrement      bool      `json:"autoIncrement,omitempty" yaml:"autoIncrement,omitempty"`
+	OnUpdate           string    `json:"onUpdate,omitempty" yaml:"onUpdate,omitempty"`
+	Primary            bool      `json:"primary,omitempty" yaml:"primary,omitempty"`
+	Key                bool      `json:"key,omitempty" yaml:"key,omitempty"`
+	Unique             bool      `json:"unique,omitempty" yaml:"unique,omitempty"`
+	Comment            string    `json:"comment,omitempty" yaml:"comment,omitempty"`
+	Generated          string    `json:"generated,omitempty" yaml:"generated,omitempty"`
+	Stored             bool      `json:"stored,omitempty" yaml:"stored,omitempty"`
+	CheckName          string    `json:"checkName,omitempty" yaml:"checkName,omitempty"`
+	Check              string    `json:"check,omitempty" yaml:"check,omitempty"`
+	ColumnFormat       string    `json:"columnFormat,omitempty" yaml:"columnFormat,omitempty"`
+	Storage            string    `json:"storage,omitempty" yaml:"storage,omitempty"`
+	SerialDefaultValue bool      `json:"serialDefaultValue,omitempty" yaml:"serialDefaultValue,omitempty"`
+	Comments           []string  `json:"comments,omitempty" yaml:"comments,omitempty"`
+}
+
+// DataType describes the encoded parser.DataType, Name is the name of data type in sql,
+// such as VARCHAR, LONG VARBINARY.
+type DataType struct {
+	Name      string   `json:"name" yaml:"name"`
+	Unsigned  bool     `json:"unsigned,omitempty" yaml:"unsigned,omitempty"`
+	Length    int      `json:"length,omitempty" yaml:"length,omitempty"`
+	Decimal   int      `json:"decimal,omitempty" yaml:"decimal,omitempty"`
+	Values    []string `json:"values,omitempty" yaml:"values,omitempty"`
+	Charset   string   `json:"charset,omitempty" yaml:"charset,omitempty"`
+	Collation string   `json:"collation,omitempty" yaml:"collation,omitempty"`
+}
+
+// Constraint describes the encoded parser.TableConstraint, only one of the fields is set.
+type Constraint struct {
+	PrimaryKey []string    `json:"primaryKey,omitempty" yaml:"primaryKey,omitempty"`
+	UniqueKey  []string    `json:"uniqueKey,omitempty" yaml:"uniqueKey,omitempty"`
+	ForeignKey *ForeignKey `json:"foreignKey,omitempty" yaml:"foreignKey,omitempty"`
+	Check      *Check      `json:"check,omitempty" yaml:"check,omitempty"`
+}
+
+// ForeignKey describes the encoded parser.ForeignKey.
+type ForeignKey struct {
+	Name             string   `json:"name,omitempty" yaml:"name,omitempty"`
+	Columns          []string `json:"columns" yaml:"columns"`
+	ReferenceSchema  string   `json:"referenceSchema,omitempty" yaml:"referenceSchema,omitempty"`
+	ReferenceTable   string   `json:"referenceTable" yaml:"referenceTable"`
+	ReferenceColumns []string `json:"referenceColumns,omitempty" yaml:"referenceColumns,omitempty"`
+	OnDelete         string   `json:"onDelete,omitempty" yaml:"onDelete,omitempty"`
+	OnUpdate         string   `json:"onUpdate,omitempty" yaml:"onUpdate,omitempty"`
+}
+
+// Check describes the encoded parser.Check.
+type Check struct {
+	Name       string `json:"name,omitempty" yaml:"name,omitempty"`
+	Expression string `json:"expression" yaml:"expression"`
+}
+
+// Index describes the encoded parser.Index.
+type Index struct {
+	Name      string         `json:"name,omitempty" yaml:"name,omitempty"`
+	Primary   bool           `json:"primary,omitempty" yaml:"primary,omitempty"`
+	Unique    bool           `json:"unique,omitempty" yaml:"unique,omitempty"`
+	Fulltext  bool           `json:"fulltext,omitempty" yaml:"fulltext,omitempty"`
+	Spatial   bool           `json:"spatial,omitempty" yaml:"spatial,omitempty"`
+	Columns   []*IndexColumn `json:"columns" yaml:"columns"`
+	Using     string         `json:"using,omitempty" yaml:"using,omitempty"`
+	Comment   string         `json:"comment,omitempty" yaml:"comment,omitempty"`
+	Invisible bool           `json:"invisible,omitempty" yaml:"invisible,omitempty"`
+}
+
+// IndexColumn describes the encoded parser.IndexColumn.
+type IndexColumn struct {
+	Name   string `json:"name" yaml:"name"`
+	Length int    `json:"length,omitempty" yaml:"length,omitempty"`
+	Desc   bool   `json:"desc,omitempty" yaml:"desc,omitempty"`
+}
+
+// Option describes the encoded parser.TableOption.
+type Option struct {
+	Name  string `json:"name" yaml:"name"`
+	Value string `json:"value,omitempty" yaml:"value,omitempty"`
+}
+
+// MarshalJSON encodes the tables as an indented JSON document.
+func MarshalJSON(tables []*parser.Table) ([]byte, error) {
+	return json.MarshalIndent(NewDocument(tables), "", "  ")
+}
+
+// UnmarshalJSON decodes the JSON document as tables.
+func UnmarshalJSON(data []byte) ([]*parser.Table, error) {
+	var document Document
+	if err := json.Unmarshal(data, &document); err != nil {
+		return nil, err
+	}
+
+	return document.ToTables()
+}
+
+// MarshalYAML encodes the tables as a YAML document.
+func MarshalYAML(tables []*parser.Table) ([]byte, error) {
+	return yaml.Marshal(NewDocument(tables))
+}
+
+// UnmarshalYAML decodes the YAML document as tables.
+func UnmarshalYAML(data []byte) ([]*parser.Table, error) {
+	var document Document
+	if err := yaml.Unmarshal(data, &document); err != nil {
+		return nil, err
+	}
+
+	return document.ToTables()
+}
+
+// NewDocument creates a Document of the current Version from tables.
+func NewDocument(tables []*parser.Table) *Document {
+	document := Document{Version: Version, Tables: []*Table{}}
+	for _, e := range tables {
+		document.Tables = append(document.Tables, newTable(e))
+	}
+
+	return &document
+}
+
+// ToTables decodes the document as tables, it returns an error if the version of document
+// is not supported or the name of data type is unknown.
+func (d *Document) ToTables() ([]*parser.Table, error) {
+	if d.Version != Version {
+		return nil, fmt.Errorf("unsupported schema version %d, expected %d", d.Version, Version)
+	}
+
+	var ret []*parser.Table
+	for _, e := range d.Tables {
+		table, err := e.table()
+		if err != nil {
+			return nil, err
+		}
+
+		ret = append(ret, table)
+	}
+
+	return ret, nil
+}
@@ -0,0 +1,103 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zeromicro/ddl-parser/internal/testutil"
+	"github.com/zeromicro/ddl-parser/parser"
+)
+
+const schemaSql = "-- users of shop\n" +
+	"CREATE TABLE `shop`.`user` (\n" +
+	"  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,\n" +
+	"  `name` varchar(64) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin NOT NULL DEFAULT '' COMMENT 'name',\n" +
+	"  `status` enum('on','off') NOT NULL DEFAULT 'on',\n" +
+	"  `balance` decimal(10,2) DEFAULT NULL,\n" +
+	"  `org_id` bigint NOT NULL,\n" +
+	"  `created` datetime(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3),\n" +
+	"  `total` decimal(12,2) GENERATED ALWAYS AS (`balance` * 2) STORED,\n" +
+	"  `age` int CONSTRAINT `chk_age` CHECK (`age` > 0),\n" +
+	"  `code` char(8) COLUMN_FORMAT FIXED STORAGE DISK,\n" +
+	"  `seq` bigint SERIAL DEFAULT VALUE,\n" +
+	"  PRIMARY KEY (`id`),\n" +
+	"  CONSTRAINT `fk_org` FOREIGN KEY (`org_id`) REFERENCES `org` (`id`) ON DELETE CASCADE,\n" +
+	"  UNIQUE KEY `uk_name` (`name`(16)) USING BTREE COMMENT 'name',\n" +
+	"  KEY `idx_org` (`org_id`, `created` DESC),\n" +
+	"  CONSTRAINT `chk_balance` CHECK (`balance` >= 0)\n" +
+	") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='user';"
+
+func TestJSON(t *testing.T) {
+	tables := testutil.Tables(t, schemaSql)
+	data, err := MarshalJSON(tables)
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), `"version": 1`)
+	assert.Contains(t, string(data), `"name": "BIGINT"`)
+	assert.Contains(t, string(data), `"values": [`)
+	assert.Contains(t, string(data), `"generated": "`+"`balance` * 2"+`"`)
+	assert.Contains(t, string(data), `"checkName": "chk_age"`)
+	assert.Contains(t, string(data), `"columnFormat": "FIXED"`)
+	assert.Contains(t, string(data), `"serialDefaultValue": true`)
+
+	actual, err := UnmarshalJSON(data)
+	assert.NoError(t, err)
+	assert.Equal(t, tables, actual)
+}
+
+func TestYAML(t *testing.T) {
+	tables := testutil.Tables(t, schemaSql)
+	data, err := MarshalYAML(tables)
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), "version: 1\n")
+
+	actual, err := UnmarshalYAML(data)
+	assert.NoError(t, err)
+	assert.Equal(t, tables, actual)
+}
+
+func TestDocument_ToTables(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		data, err := MarshalJSON(nil)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"version": 1, "tables": []}`, string(data))
+
+		tables, err := UnmarshalJSON(data)
+		assert.NoError(t, err)
+		assert.Empty(t, tables)
+	})
+
+	t.Run("version", func(t *testing.T) {
+		_, err := UnmarshalJSON([]byte(`{"version": 2, "tables": []}`))
+		assert.Error(t, err)
+
+		_, err = UnmarshalYAML([]byte("tables: []\n"))
+		assert.Error(t, err)
+	})
+
+	t.Run("unknownType", func(t *testing.T) {
+		_, err := UnmarshalYAML([]byte("version: 1\ntables:\n  - name: user\n    columns:\n      - name: id\n        type:\n          name: HUGEINT\n"))
+		assert.Error(t, err)
+	})
+
+	t.Run("typeName", func(t *testing.T) {
+		tables, err := UnmarshalYAML([]byte("version: 1\ntables:\n  - name: user\n    columns:\n      - name: data\n        type:\n          name: long  varbinary\n"))
+		assert.NoError(t, err)
+		assert.Equal(t, parser.LongVarBinary, tables[0].Columns[0].DataType.Type())
+	})
+}
@@ -0,0 +1,304 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+// Package shard detects the sharded tables, such as order_00 ... order_63, the tables whose
+// names differ by a numeric suffix are grouped into a family which describes a logical table,
+// the members whose definitions deviate from the others are reported with their changes.
+package shard
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/zeromicro/ddl-parser/diff"
+	"github.com/zeromicro/ddl-parser/parser"
+)
+
+// Range describes the suffixes from From to To, both inclusive.
+type Range struct {
+	From int
+	To   int
+}
+
+// Member describes a sharded table of a family.
+type Member struct {
+	Table *parser.Table
+	// Suffix describes the numeric suffix of the name of table, such as 7 of order_07
+	Suffix int
+	// Diff describes the changes from the definition of family to the definition of table, it's
+	// nil if the table has the same definition as the family
+	Diff *diff.TableDiff
+}
+
+// Family describes a logical table which is sharded into the tables with numeric suffixes.
+type Family struct {
+	// Schema describes the schema of tables, it's empty if the tables are not specified as
+	// db_name.tbl_name
+	Schema string
+	// Name describes the name of logical table, it's the prefix of the names of tables without
+	// the trailing underscores, such as order of order_00
+	Name string
+	// Prefix describes the prefix of the names of tables, such as order_ of order_00
+	Prefix string
+	// Width describes the number of digits of the zero-padded suffixes, such as 2 of order_00,
+	// it's 0 if the suffixes are not padded or padded to different lengths
+	Width int
+	// Table describes the definition shared by the majority of members
+	Table *parser.Table
+	// Members describes the tables of family in the order of suffix
+	Members []*Member
+	// Ranges describes the consecutive ranges of the suffixes, such as 0-31 and 40-63 if the
+	// tables order_32 ... order_39 do not exist
+	Ranges []Range
+}
+
+// Option is the alias of function.
+type Option func(d *detector)
+
+type detector struct {
+	minMembers int
+	options    []diff.Option
+}
+
+// WithMinMembers is an option to set the minimum number of tables of a family, the default
+// value is 2.
+func WithMinMembers(n int) Option {
+	return func(d *detector) {
+		d.minMembers = n
+	}
+}
+
+// WithDiffOptions is an option to set the options to compare the definitions of members.
+func WithDiffOptions(options ...diff.Option) Option {
+	return func(d *detector) {
+		d.options = options
+	}
+}
+
+// Detect groups the tables whose names are the same prefix followed by different numeric
+// suffixes into families in the order of declaration, a group is a family only if the majority
+// of its tables share a definition, so the tables such as ipv4 and ipv6 are not grouped. The
+// tables which do not belong to any family are returned as the rest.
+func Detect(tables []*parser.Table, options ...Option) ([]*Family, []*parser.Table) {
+	d := &detector{minMembers: 2}
+	for _, opt := range options {
+		opt(d)
+	}
+
+	type group struct {
+		schema, prefix string
+		tables         []*parser.Table
+		suffixes       []string
+	}
+	var groups []*group
+	index := make(map[string]*group)
+	grouped := make(map[*parser.Table]*group)
+	for _, e := range tables {
+		prefix, suffix, ok := splitSuffix(e.Name)
+		if !ok {
+			continue
+		}
+
+		key := e.Schema + "." + prefix
+		g, ok := index[key]
+		if !ok {
+			g = &group{schema: e.Schema, prefix: prefix}
+			index[key] = g
+			groups = append(groups, g)
+		}
+		g.tables = append(g.tables, e)
+		g.suffixes = append(g.suffixes, suffix)
+		grouped[e] = g
+	}
+
+	var families []*Family
+	var rest []*parser.Table
+	for _, g := range groups {
+		var family *Family
+		if len(g.tables) >= d.minMembers {
+			family = d.family(g.schema, g.prefix, g.tables, g.suffixes)
+		}
+		if family == nil {
+			for _, e := range g.tables {
+				delete(grouped, e)
+			}
+			continue
+		}
+
+		families = append(families, family)
+	}
+	for _, e := range tables {
+		if grouped[e] == nil {
+			rest = append(rest, e)
+		}
+	}
+
+	return families, rest
+}
+
+// family returns the family of tables, it returns nil if no definition is shared by the majority
+// of tables.
+func (d *detector) family(schema, prefix string, tables []*parser.Table, suffixes []string) *Family {
+	ret := &Family{
+		Schema: schema,
+		Name:   strings.TrimRight(prefix, "_"),
+		Prefix: prefix,
+		Width:  width(suffixes),
+	}
+
+	// the tables are clustered by their definitions, the largest cluster is the definition of family
+	var clusters [][]*parser.Table
+	for _, e := range tables {
+		found := false
+		for i, c := range clusters {
+			if sameDefinition(diff.CompareTable(c[0], e, d.options...)) {
+				clusters[i] = append(c, e)
+				found = true
+				break
+			}
+		}
+		if !found {
+			clusters = append(clusters, []*parser.Table{e})
+		}
+	}
+	largest := clusters[0]
+	for _, c := range clusters[1:] {
+		if len(c) > len(largest) {
+			largest = c
+		}
+	}
+	if len(largest)*2 <= len(tables) {
+		return nil
+	}
+	ret.Table = largest[0]
+
+	for i, e := range tables {
+		n, _ := strconv.Atoi(suffixes[i])
+		member := &Member{Table: e, Suffix: n}
+		if tableDiff := diff.CompareTable(ret.Table, e, d.options...); !sameDefinition(tableDiff) {
+			member.Diff = tableDiff
+		}
+		ret.Members = append(ret.Members, member)
+	}
+	sort.SliceStable(ret.Members, func(i, j int) bool {
+		return ret.Members[i].Suffix < ret.Members[j].Suffix
+	})
+
+	for _, e := range ret.Members {
+		if last := len(ret.Ranges) - 1; last >= 0 && e.Suffix <= ret.Ranges[last].To+1 {
+			ret.Ranges[last].To = e.Suffix
+			continue
+		}
+
+		ret.Ranges = append(ret.Ranges, Range{From: e.Suffix, To: e.Suffix})
+	}
+
+	return ret
+}
+
+// Deviations returns the members whose definitions differ from the definition of family.
+func (f *Family) Deviations() []*Member {
+	var ret []*Member
+	for _, e := range f.Members {
+		if e.Diff != nil {
+			ret = append(ret, e)
+		}
+	}
+
+	return ret
+}
+
+// Logical returns a copy of the definition of family named as the logical table, such as order,
+// it can be used to generate one model for all tables of family.
+func (f *Family) Logical() *parser.Table {
+	ret := *f.Table
+	ret.Name = f.Name
+	return &ret
+}
+
+// TableName returns the name of the table with suffix, such as order_07 of 7, the name of member
+// is returned as declared if the member exists.
+func (f *Family) TableName(suffix int) string {
+	for _, e := range f.Members {
+		if e.Suffix == suffix {
+			return e.Table.Name
+		}
+	}
+
+	return f.Prefix + f.format(suffix)
+}
+
+// String returns the name pattern of family, such as order_[00-31,40-63].
+func (f *Family) String() string {
+	var ranges []string
+	for _, e := range f.Ranges {
+		if e.From == e.To {
+			ranges = append(ranges, f.format(e.From))
+			continue
+		}
+
+		ranges = append(ranges, f.format(e.From)+"-"+f.format(e.To))
+	}
+
+	return fmt.Sprintf("%s[%s]", f.Prefix, strings.Join(ranges, ","))
+}
+
+func (f *Family) format(suffix int) string {
+	return fmt.Sprintf("%0*d", f.Width, suffix)
+}
+
+// splitSuffix splits the name into the prefix and the numeric suffix, such as order_ and 07 of
+// order_07, it returns false if the name has no numeric suffix or consists of digits and
+// underscores only.
+func splitSuffix(name string) (prefix, suffix string, ok bool) {
+	i := len(name)
+	for i > 0 && name[i-1] >= '0' && name[i-1] <= '9' {
+		i--
+	}
+	// the suffix is limited to 9 digits to fit in int
+	if i == len(name) || len(name)-i > 9 || len(strings.TrimRight(name[:i], "_")) == 0 {
+		return "", "", false
+	}
+
+	return name[:i], name[i:], true
+}
+
+// width returns the number of digits of the zero-padded suffixes, such as 2 of 08 and 9, it
+// returns 0 if no suffix is padded or the suffixes are padded to different lengths.
+func width(suffixes []string) int {
+	ret := 0
+	for _, e := range suffixes {
+		if len(e) < 2 || e[0] != '0' {
+			continue
+		}
+		if ret > 0 && len(e) != ret {
+			return 0
+		}
+		ret = len(e)
+	}
+
+	return ret
+}
+
+// sameDefinition returns true if there is no change except the name of table.
+func sameDefinition(d *diff.TableDiff) bool {
+	return len(d.AddedColumns) == 0 && len(d.DroppedColumns) == 0 &&
+		len(d.RenamedColumns) == 0 && len(d.ModifiedColumns) == 0 &&
+		len(d.AddedIndexes) == 0 && len(d.DroppedIndexes) == 0 && len(d.ModifiedIndexes) == 0 &&
+		len(d.AddedForeignKeys) == 0 && len(d.DroppedForeignKeys) == 0 && len(d.ModifiedOptions) == 0
+}
@@ -0,0 +1,125 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package shard
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zeromicro/ddl-parser/internal/testutil"
+	"github.com/zeromicro/ddl-parser/parser"
+)
+
+func TestDetect(t *testing.T) {
+	var sql strings.Builder
+	sql.WriteString("CREATE TABLE `user` (`id` BIGINT NOT NULL PRIMARY KEY);\n")
+	for i := 0; i < 64; i++ {
+		if i >= 32 && i < 40 {
+			continue
+		}
+
+		column := ""
+		if i == 7 {
+			column = ", `extra` INT"
+		}
+		fmt.Fprintf(&sql, "CREATE TABLE `order_%02d` (`id` BIGINT NOT NULL PRIMARY KEY, `user_id` BIGINT NOT NULL%s) AUTO_INCREMENT = %d;\n", i, column, i+100)
+	}
+	sql.WriteString("CREATE TABLE `log2` (`id` BIGINT NOT NULL);\n" +
+		"CREATE TABLE `log1` (`id` BIGINT NOT NULL);\n" +
+		"CREATE TABLE `log10` (`id` BIGINT NOT NULL);\n" +
+		"CREATE TABLE `tmp_1` (`id` BIGINT NOT NULL);\n" +
+		"CREATE TABLE `shop`.`log3` (`id` BIGINT NOT NULL);\n" +
+		"CREATE TABLE `_1` (`id` BIGINT NOT NULL);\n" +
+		"CREATE TABLE `_2` (`id` BIGINT NOT NULL);")
+
+	tables := testutil.Tables(t, sql.String())
+	families, rest := Detect(tables)
+	assert.Len(t, families, 2)
+
+	order := families[0]
+	assert.Equal(t, "order", order.Name)
+	assert.Equal(t, "order_", order.Prefix)
+	assert.Equal(t, 2, order.Width)
+	assert.Equal(t, "order_00", order.Table.Name)
+	assert.Len(t, order.Members, 56)
+	assert.Equal(t, []Range{{From: 0, To: 31}, {From: 40, To: 63}}, order.Ranges)
+	assert.Equal(t, "order_[00-31,40-63]", order.String())
+	assert.Equal(t, "order_07", order.TableName(7))
+	assert.Equal(t, "order", order.Logical().Name)
+	deviations := order.Deviations()
+	assert.Len(t, deviations, 1)
+	assert.Equal(t, 7, deviations[0].Suffix)
+	assert.Equal(t, "extra", deviations[0].Diff.AddedColumns[0].Name)
+
+	log := families[1]
+	assert.Equal(t, "log", log.Name)
+	assert.Equal(t, 0, log.Width)
+	assert.Equal(t, []int{1, 2, 10}, []int{log.Members[0].Suffix, log.Members[1].Suffix, log.Members[2].Suffix})
+	assert.Equal(t, "log[1-2,10]", log.String())
+	assert.Empty(t, log.Deviations())
+
+	var names []string
+	for _, e := range rest {
+		names = append(names, e.Name)
+	}
+	assert.Equal(t, []string{"user", "tmp_1", "log3", "_1", "_2"}, names)
+
+	families, _ = Detect(tables, WithMinMembers(100))
+	assert.Empty(t, families)
+}
+
+func TestDetect_majority(t *testing.T) {
+	tables := testutil.Tables(t, "CREATE TABLE `t_0` (`id` INT);\n"+
+		"CREATE TABLE `t_1` (`id` BIGINT);\n"+
+		"CREATE TABLE `t_2` (`id` BIGINT);\n"+
+		"CREATE TABLE `t_3` (`id` BIGINT);")
+	families, rest := Detect(tables)
+	assert.Empty(t, rest)
+	assert.Len(t, families, 1)
+	assert.Equal(t, "t_1", families[0].Table.Name)
+	deviations := families[0].Deviations()
+	assert.Len(t, deviations, 1)
+	assert.Equal(t, "t_0", deviations[0].Table.Name)
+	assert.Equal(t, parser.Int, deviations[0].Diff.ModifiedColumns[0].To.DataType.Type())
+}
+
+func TestDetect_distinct(t *testing.T) {
+	tables := testutil.Tables(t, "CREATE TABLE `ipv4` (`ip` INT UNSIGNED);\n"+
+		"CREATE TABLE `ipv6` (`ip` BINARY(16));\n"+
+		"CREATE TABLE `oauth1` (`token` VARCHAR(64), `secret` VARCHAR(64));\n"+
+		"CREATE TABLE `oauth2` (`access_token` VARCHAR(255), `refresh_token` VARCHAR(255));")
+	families, rest := Detect(tables)
+	assert.Empty(t, families)
+	assert.Len(t, rest, 4)
+}
+
+func TestFamily_TableName(t *testing.T) {
+	tables := testutil.Tables(t, "CREATE TABLE `order_08` (`id` BIGINT);\n"+
+		"CREATE TABLE `order_9` (`id` BIGINT);\n"+
+		"CREATE TABLE `order_10` (`id` BIGINT);")
+	families, _ := Detect(tables)
+	if assert.Len(t, families, 1) {
+		order := families[0]
+		assert.Equal(t, 2, order.Width)
+		assert.Equal(t, "order_08", order.TableName(8))
+		assert.Equal(t, "order_9", order.TableName(9))
+		assert.Equal(t, "order_07", order.TableName(7))
+		assert.Equal(t, "order_[08-10]", order.String())
+	}
+}
@@ -0,0 +1,194 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+// Package tsgen generates the TypeScript declarations of parsed tables, each table is declared
+// as an interface or a type alias whose properties are the columns.
+package tsgen
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/zeromicro/ddl-parser/parser"
+)
+
+// Declaration describes how the tables are declared.
+type Declaration int
+
+const (
+	// Interface declares the tables as interfaces, such as export interface User {}.
+	Interface Declaration = iota
+	// TypeAlias declares the tables as type aliases, such as export type User = {}.
+	TypeAlias
+)
+
+// BigIntStyle describes how the 64-bit integers are mapped, the numbers of JavaScript can not
+// represent them precisely.
+type BigIntStyle int
+
+const (
+	// BigIntString maps the 64-bit integers to string.
+	BigIntString BigIntStyle = iota
+	// BigIntNative maps the 64-bit integers to bigint.
+	BigIntNative
+	// BigIntNumber maps the 64-bit integers to number, the large values lose precision.
+	BigIntNumber
+)
+
+// Generator generates the TypeScript declarations, you can use NewGenerator to create an
+// instance with options, WithDeclaration option sets how the tables are declared, WithBigInt
+// option sets how the 64-bit integers are mapped.
+type Generator struct {
+	declaration Declaration
+	bigInt      BigIntStyle
+}
+
+// Option is the alias of function.
+type Option func(g *Generator)
+
+// NewGenerator creates an instance of Generator.
+func NewGenerator(options ...Option) *Generator {
+	g := &Generator{}
+	for _, opt := range options {
+		opt(g)
+	}
+
+	return g
+}
+
+// WithDeclaration is a Generator option to set how the tables are declared, the default
+// declaration is Interface.
+func WithDeclaration(declaration Declaration) Option {
+	return func(g *Generator) {
+		g.declaration = declaration
+	}
+}
+
+// WithBigInt is a Generator option to set how the 64-bit integers are mapped, the default
+// style is BigIntString.
+func WithBigInt(style BigIntStyle) Option {
+	return func(g *Generator) {
+		g.bigInt = style
+	}
+}
+
+// Generate returns the TypeScript source which declares each table. The names of declarations
+// which collide with the declared names are suffixed with numbers, such as UserInfo2 for the
+// tables user_info and userInfo, and the names without letters or digits fall back to the names
+// with numbers, such as Table1.
+func (g *Generator) Generate(tables ...*parser.Table) []byte {
+	var b strings.Builder
+	b.WriteString("// Code generated by tsgen. DO NOT EDIT.\n")
+	names := make(map[string]bool)
+	for i, e := range tables {
+		name := pascalCase(e.Name)
+		if len(name) == 0 {
+			name = fmt.Sprintf("Table%d", i+1)
+		}
+		for j, base := 2, name; names[name]; j++ {
+			name = fmt.Sprintf("%s%d", base, j)
+		}
+		names[name] = true
+
+		b.WriteString("\n")
+		g.writeTable(&b, name, e)
+	}
+
+	return []byte(b.String())
+}
+
+func (g *Generator) writeTable(b *strings.Builder, name string, table *parser.Table) {
+	for _, option := range table.Options {
+		if option.Name == parser.TableOptionComment {
+			writeDoc(b, "", option.Value)
+		}
+	}
+
+	if g.declaration == TypeAlias {
+		b.WriteString("export type " + name + " = {\n")
+	} else {
+		b.WriteString("export interface " + name + " {\n")
+	}
+
+	for _, e := range table.Columns {
+		if e.Constraint != nil && len(e.Constraint.Comment) > 0 {
+			writeDoc(b, "  ", e.Constraint.Comment)
+		}
+
+		tp := g.columnType(e)
+		if !table.IsNotNull(e) {
+			tp += " | null"
+		}
+		fmt.Fprintf(b, "  %s: %s;\n", propertyName(e.Name), tp)
+	}
+
+	if g.declaration == TypeAlias {
+		b.WriteString("};\n")
+	} else {
+		b.WriteString("}\n")
+	}
+}
+
+// writeDoc writes the comment as JSDoc, such as /** user's name */.
+func writeDoc(b *strings.Builder, indent, comment string) {
+	lines := strings.Split(strings.ReplaceAll(parser.Unescape(comment), "*/", "*\\/"), "\n")
+	if len(lines) == 1 {
+		b.WriteString(indent + "/** " + strings.TrimSpace(lines[0]) + " */\n")
+		return
+	}
+
+	b.WriteString(indent + "/**\n")
+	for _, e := range lines {
+		b.WriteString(strings.TrimRight(indent+" * "+strings.TrimSpace(e), " ") + "\n")
+	}
+	b.WriteString(indent + " */\n")
+}
+
+var identifierRegex = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+// propertyName returns the name of property, it's quoted if it's not a valid identifier.
+func propertyName(name string) string {
+	if identifierRegex.MatchString(name) {
+		return name
+	}
+
+	return stringLiteral(name)
+}
+
+// pascalCase returns the name of declaration, such as user_info to UserInfo, the letters which
+// are not ASCII are kept since they are valid in TypeScript identifiers, such as 用户.
+func pascalCase(name string) string {
+	var b strings.Builder
+	for _, e := range strings.FieldsFunc(name, func(r rune) bool {
+		return !(unicode.IsLetter(r) || unicode.IsDigit(r))
+	}) {
+		runes := []rune(e)
+		b.WriteString(string(unicode.ToUpper(runes[0])) + string(runes[1:]))
+	}
+
+	ret := b.String()
+	if len(ret) > 0 && unicode.IsDigit([]rune(ret)[0]) {
+		ret = "T" + ret
+	}
+
+	return ret
+}
+
+func stringLiteral(s string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`, "\n", `\n`).Replace(s) + "'"
+}
@@ -0,0 +1,83 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package tsgen
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zeromicro/ddl-parser/internal/testutil"
+)
+
+const schemaSql = "CREATE TABLE `user_info` (\n" +
+	"  `id` bigint unsigned NOT NULL AUTO_INCREMENT,\n" +
+	"  `name` varchar(64) NOT NULL COMMENT 'user''s name',\n" +
+	"  `age` tinyint,\n" +
+	"  `balance` decimal(10,2) NOT NULL,\n" +
+	"  `status` enum('on','off') NOT NULL DEFAULT 'on',\n" +
+	"  `tags` set('a','b'),\n" +
+	"  `enabled` bool NOT NULL,\n" +
+	"  `profile` json,\n" +
+	"  `user-agent` varchar(255),\n" +
+	"  PRIMARY KEY (`id`)\n" +
+	") COMMENT='users';"
+
+func TestGenerator_Generate(t *testing.T) {
+	actual := NewGenerator().Generate(testutil.Tables(t, schemaSql)...)
+	assert.Equal(t, `// Code generated by tsgen. DO NOT EDIT.
+
+/** users */
+export interface UserInfo {
+  id: string;
+  /** user's name */
+  name: string;
+  age: number | null;
+  balance: string;
+  status: 'on' | 'off';
+  tags: string | null;
+  enabled: boolean;
+  profile: unknown | null;
+  'user-agent': string | null;
+}
+`, string(actual))
+}
+
+func TestGenerator_options(t *testing.T) {
+	actual := NewGenerator(WithDeclaration(TypeAlias), WithBigInt(BigIntNative)).Generate(testutil.Tables(t, schemaSql)...)
+	assert.Contains(t, string(actual), "export type UserInfo = {\n  id: bigint;\n")
+	assert.Contains(t, string(actual), "  'user-agent': string | null;\n};\n")
+
+	actual = NewGenerator(WithBigInt(BigIntNumber)).Generate(testutil.Tables(t, schemaSql)...)
+	assert.Contains(t, string(actual), "  id: number;\n")
+}
+
+func TestGenerator_names(t *testing.T) {
+	tables := testutil.Tables(t, "CREATE TABLE `用户` (`id` int);"+
+		"CREATE TABLE `-` (`id` int);CREATE TABLE `user_info` (`id` int);CREATE TABLE `userInfo` (`id` int);")
+	actual := string(NewGenerator().Generate(tables...))
+	assert.Contains(t, actual, "export interface 用户 {\n")
+	assert.Contains(t, actual, "export interface Table2 {\n")
+	assert.Contains(t, actual, "export interface UserInfo {\n")
+	assert.Contains(t, actual, "export interface UserInfo2 {\n")
+}
+
+func Test_writeDoc(t *testing.T) {
+	var b strings.Builder
+	writeDoc(&b, "  ", `first\nsecond */`)
+	assert.Equal(t, "  /**\n   * first\n   * second *\\/\n   */\n", b.String())
+}
@@ -0,0 +1,62 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2021 zeromicro
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ */
+
+package tsgen
+
+import (
+	"strings"
+
+	"github.com/zeromicro/ddl-parser/parser"
+)
+
+// columnType returns the TypeScript type of column without null.
+func (g *Generator) columnType(column *parser.Column) string {
+	if column.DataType == nil {
+		return "unknown"
+	}
+
+	kind := column.DataType.Type()
+	switch {
+	case kind == parser.Bool || kind == parser.Boolean:
+		return "boolean"
+	case kind == parser.BigInt || kind == parser.Int8 || kind == parser.Serial:
+		switch g.bigInt {
+		case BigIntNative:
+			return "bigint"
+		case BigIntNumber:
+			return "number"
+		}
+		return "string"
+	case kind.IsInteger(), kind.IsFloatingPoint(), kind == parser.Bit, kind == parser.Year:
+		return "number"
+	case kind == parser.Enum:
+		var values []string
+		for _, e := range column.DataType.Value() {
+			values = append(values, stringLiteral(parser.Unescape(e)))
+		}
+		if len(values) == 0 {
+			return "string"
+		}
+		return strings.Join(values, " | ")
+	case kind == parser.Json, kind.IsSpatial():
+		return "unknown"
+	}
+
+	// the fixed-point numbers, the temporal values, the strings and the binary strings are
+	// represented as strings, so are the values of SET column since the drivers return the
+	// combination of members as a comma separated string, such as 'a,b'
+	return "string"
+}